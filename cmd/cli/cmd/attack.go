@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 )
 
 // attackCmd represents the attack command
@@ -49,8 +50,10 @@ func runAttack(cmd *cobra.Command, args []string) error {
 
 	// Execute attack directly via ProcessMoves - server parses labels
 	resp, err := gc.Service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
-		GameId: gc.GameID,
-		DryRun: isDryrun(),
+		GameId:       gc.GameID,
+		DryRun:       isDryrun(),
+		DebugResolve: isDebugResolve(),
+		Coach:        isCoachEnabled(),
 		Moves: []*v1.GameMove{{
 			Player: gc.State.CurrentPlayer,
 			MoveType: &v1.GameMove_AttackUnit{
@@ -77,6 +80,8 @@ func runAttack(cmd *cobra.Command, args []string) error {
 			"dryrun":   isDryrun(),
 			"success":  true,
 			"changes":  formatChangesForJSON(resp.Moves),
+			"summary":  combatSummary(resp.Moves),
+			"coach":    coachVerdictsForJSON(resp.Moves),
 		}
 		return formatter.PrintJSON(data)
 	}
@@ -90,13 +95,35 @@ func runAttack(cmd *cobra.Command, args []string) error {
 	}
 	sb.WriteString(fmt.Sprintf("  Attacked from %s to %s\n", attackerLabel, targetLabel))
 
-	// Show changes from response (damage dealt, units killed, etc.)
+	// Show changes from response (damage dealt, units killed, etc.), with
+	// the battle recap on its own line after them
 	if len(resp.Moves) > 0 && len(resp.Moves[0].Changes) > 0 {
 		sb.WriteString("  Results:\n")
 		for _, change := range resp.Moves[0].Changes {
-			sb.WriteString(fmt.Sprintf("    - %s\n", formatChange(change)))
+			if change.GetUnitAttacked() == nil {
+				sb.WriteString(fmt.Sprintf("    - %s\n", formatChange(change)))
+			}
 		}
 	}
+	if summary := combatSummary(resp.Moves); summary != nil {
+		sb.WriteString(fmt.Sprintf("  Summary: %s\n", lib.FormatCombatSummary(summary)))
+	}
+
+	sb.WriteString(formatDryrunDiff(resp))
+	sb.WriteString(formatCoachVerdicts(resp.Moves))
+	sb.WriteString(formatMoveResolutions(resp.Resolutions))
 
 	return formatter.PrintText(sb.String())
 }
+
+// combatSummary returns the recap of the attack in moves, if there was one
+func combatSummary(moves []*v1.GameMove) *v1.CombatSummary {
+	for _, move := range moves {
+		for _, change := range move.Changes {
+			if attacked := change.GetUnitAttacked(); attacked != nil {
+				return attacked.Summary
+			}
+		}
+	}
+	return nil
+}
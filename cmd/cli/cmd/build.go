@@ -70,8 +70,10 @@ func runBuild(cmd *cobra.Command, args []string) error {
 
 	// Execute build directly via ProcessMoves - server parses labels
 	resp, err := gc.Service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
-		GameId: gc.GameID,
-		DryRun: isDryrun(),
+		GameId:       gc.GameID,
+		DryRun:       isDryrun(),
+		DebugResolve: isDebugResolve(),
+		Coach:        isCoachEnabled(),
 		Moves: []*v1.GameMove{{
 			Player: gc.State.CurrentPlayer,
 			MoveType: &v1.GameMove_BuildUnit{
@@ -99,6 +101,7 @@ func runBuild(cmd *cobra.Command, args []string) error {
 			"dryrun":    isDryrun(),
 			"success":   true,
 			"changes":   formatChangesForJSON(resp.Moves),
+			"coach":     coachVerdictsForJSON(resp.Moves),
 		}
 		return formatter.PrintJSON(data)
 	}
@@ -120,6 +123,10 @@ func runBuild(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	sb.WriteString(formatDryrunDiff(resp))
+	sb.WriteString(formatCoachVerdicts(resp.Moves))
+	sb.WriteString(formatMoveResolutions(resp.Resolutions))
+
 	return formatter.PrintText(sb.String())
 }
 
@@ -140,7 +147,7 @@ func parseUnitType(gc *GameContext, unitTypeArg string) (int32, error) {
 
 	// Search through all unit definitions
 	unitTypeArg = strings.ToLower(strings.TrimSpace(unitTypeArg))
-	for unitID, unitDef := range rulesEngine.RulesEngine.Units {
+	for unitID, unitDef := range rulesEngine.Rules().Units {
 		if strings.ToLower(unitDef.Name) == unitTypeArg {
 			return unitID, nil
 		}
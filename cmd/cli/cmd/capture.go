@@ -49,8 +49,10 @@ func runCapture(cmd *cobra.Command, args []string) error {
 
 	// Execute capture directly via ProcessMoves - server parses labels
 	resp, err := gc.Service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
-		GameId: gc.GameID,
-		DryRun: isDryrun(),
+		GameId:       gc.GameID,
+		DryRun:       isDryrun(),
+		DebugResolve: isDebugResolve(),
+		Coach:        isCoachEnabled(),
 		Moves: []*v1.GameMove{{
 			Player: gc.State.CurrentPlayer,
 			MoveType: &v1.GameMove_CaptureBuilding{
@@ -75,6 +77,7 @@ func runCapture(cmd *cobra.Command, args []string) error {
 			"dryrun":  isDryrun(),
 			"success": true,
 			"changes": formatChangesForJSON(resp.Moves),
+			"coach":   coachVerdictsForJSON(resp.Moves),
 		}
 		return formatter.PrintJSON(data)
 	}
@@ -97,5 +100,9 @@ func runCapture(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	sb.WriteString(formatDryrunDiff(resp))
+	sb.WriteString(formatCoachVerdicts(resp.Moves))
+	sb.WriteString(formatMoveResolutions(resp.Resolutions))
+
 	return formatter.PrintText(sb.String())
 }
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// coachCmd represents the coach command
+var coachCmd = &cobra.Command{
+	Use:   "coach [on|off]",
+	Short: "Turn coach mode on or off",
+	Long: `Turn coach mode on or off, or show whether it is on.
+With coach mode on, move, attack, build and capture report the coach's
+verdict on each move, flagging moves that leave the unit exposed to
+several enemies or likely to be destroyed next turn. Verdicts are only
+shown to you and are never saved with the game. Coach mode has no effect
+in rated games.
+
+Examples:
+  ww coach on     Review each of your moves
+  ww coach off    Stop reviewing moves
+  ww coach        Show whether coach mode is on`,
+	Args:      cobra.MaximumNArgs(1),
+	ValidArgs: []string{"on", "off"},
+	RunE:      runCoach,
+}
+
+func init() {
+	rootCmd.AddCommand(coachCmd)
+}
+
+func runCoach(cmd *cobra.Command, args []string) error {
+	store, err := getProfileStore()
+	if err != nil {
+		return err
+	}
+	config, err := store.LoadGlobalConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if len(args) == 1 {
+		switch args[0] {
+		case "on":
+			config.Coach = true
+		case "off":
+			config.Coach = false
+		default:
+			return fmt.Errorf("expected on or off, got %q", args[0])
+		}
+		if err := store.SaveGlobalConfig(config); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{"coach": config.Coach})
+	}
+	if config.Coach {
+		return formatter.PrintText("Coach mode is on")
+	}
+	return formatter.PrintText("Coach mode is off")
+}
+
+// isCoachEnabled returns whether the player turned coach mode on
+func isCoachEnabled() bool {
+	store, err := getProfileStore()
+	if err != nil {
+		return false
+	}
+	config, err := store.LoadGlobalConfig()
+	return err == nil && config.Coach
+}
+
+// formatCoachVerdicts formats the coach's verdicts on the moves, if any
+func formatCoachVerdicts(moves []*v1.GameMove) string {
+	var sb strings.Builder
+	for _, move := range moves {
+		verdict := move.CoachVerdict
+		if verdict == nil {
+			continue
+		}
+		if verdict.Flagged {
+			sb.WriteString(fmt.Sprintf("  Coach: Warning: %s\n", verdict.Message))
+		} else {
+			sb.WriteString(fmt.Sprintf("  Coach: %s\n", verdict.Message))
+		}
+	}
+	return sb.String()
+}
+
+// coachVerdictsForJSON converts the coach's verdicts to a JSON-friendly format
+func coachVerdictsForJSON(moves []*v1.GameMove) []map[string]any {
+	var verdicts []map[string]any
+	for _, move := range moves {
+		if verdict := move.CoachVerdict; verdict != nil {
+			verdicts = append(verdicts, map[string]any{
+				"flagged":         verdict.Flagged,
+				"message":         verdict.Message,
+				"threatened_by":   verdict.ThreatenedBy,
+				"expected_damage": verdict.ExpectedDamage,
+				"score_before":    verdict.ScoreBefore,
+				"score_after":     verdict.ScoreAfter,
+			})
+		}
+	}
+	return verdicts
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// constructCmd represents the construct command
+var constructCmd = &cobra.Command{
+	Use:   "construct <unit> <target>",
+	Short: "Construct terrain (eg a bridge) next to a unit",
+	Long: `Start constructing terrain on a tile adjacent to the unit, eg an
+Engineer building a bridge over water. Coins are paid up front and the
+tile converts after the number of turns given in the rules, as long as the
+unit does not move away.
+
+The <target> position can be a direction: L, R, TL, TR, BL, BR.
+
+Examples:
+  ww construct A1 R              Build on the tile to the right of A1
+  ww construct 3,4 4,4           Build on 4,4 with the unit at 3,4
+  ww construct A1 R --dryrun     Preview construction without saving`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConstruct,
+}
+
+func init() {
+	rootCmd.AddCommand(constructCmd)
+}
+
+func runConstruct(cmd *cobra.Command, args []string) error {
+	unitLabel := args[0]
+	targetLabel := args[1]
+
+	ctx := context.Background()
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+
+	if isVerbose() {
+		fmt.Printf("[VERBOSE] Constructing from %s at %s\n", unitLabel, targetLabel)
+	}
+
+	// Execute construction directly via ProcessMoves - server parses labels
+	resp, err := gc.Service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+		GameId:       gc.GameID,
+		DryRun:       isDryrun(),
+		DebugResolve: isDebugResolve(),
+		Moves: []*v1.GameMove{{
+			Player: gc.State.CurrentPlayer,
+			MoveType: &v1.GameMove_ConstructTerrain{
+				ConstructTerrain: &v1.ConstructTerrainAction{
+					Pos:    &v1.Position{Label: unitLabel},
+					Target: &v1.Position{Label: targetLabel},
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("construct failed: %w", err)
+	}
+
+	// Format output
+	formatter := NewOutputFormatter()
+
+	if formatter.JSON {
+		data := map[string]any{
+			"game_id": gc.GameID,
+			"action":  "construct",
+			"unit":    unitLabel,
+			"target":  targetLabel,
+			"dryrun":  isDryrun(),
+			"success": true,
+			"changes": formatChangesForJSON(resp.Moves),
+		}
+		return formatter.PrintJSON(data)
+	}
+
+	// Text output
+	var sb strings.Builder
+	if isDryrun() {
+		sb.WriteString("Construct (dryrun): Would succeed\n")
+	} else {
+		sb.WriteString("Construct: Success\n")
+	}
+
+	// Show changes from response
+	if len(resp.Moves) > 0 && len(resp.Moves[0].Changes) > 0 {
+		for _, change := range resp.Moves[0].Changes {
+			sb.WriteString(fmt.Sprintf("  %s\n", formatChange(change)))
+		}
+	}
+
+	sb.WriteString(formatMoveResolutions(resp.Resolutions))
+	return formatter.PrintText(sb.String())
+}
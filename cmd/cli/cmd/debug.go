@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"image/color"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// debugCmd groups commands for looking inside the engine
+var debugCmd = &cobra.Command{
+	Use:   "debug",
+	Short: "Inspect how the engine reached its answers",
+}
+
+// debugReachCmd represents the debug reach command
+var debugReachCmd = &cobra.Command{
+	Use:   "reach <position>",
+	Short: "Dump a unit's movement flood-fill",
+	Long: `Show every hex the movement flood-fill for a unit looked at: the cheapest
+cost it was reached at, the hex it was reached from, and why the fill did not
+expand past it (impassable, occupied, footprint, budget or hazard).
+
+Examples:
+  ww debug reach A1
+  ww debug reach A1 --render reach.png   Also save a heat map of the costs
+  ww debug reach A1 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDebugReach,
+}
+
+var reachRenderFile string
+
+func init() {
+	rootCmd.AddCommand(debugCmd)
+	debugCmd.AddCommand(debugReachCmd)
+	debugReachCmd.Flags().StringVar(&reachRenderFile, "render", "", "Save a PNG heat map of the reach to this file")
+}
+
+func runDebugReach(cmd *cobra.Command, args []string) error {
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+
+	trace, err := gc.RTGame.TraceReach(args[0])
+	if err != nil {
+		return err
+	}
+
+	if reachRenderFile != "" {
+		if err := renderReach(gc, trace, reachRenderFile); err != nil {
+			return err
+		}
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(trace)
+	}
+	text := FormatReachTrace(trace)
+	if reachRenderFile != "" {
+		text += fmt.Sprintf("\nHeat map saved to %s\n", reachRenderFile)
+	}
+	return formatter.PrintText(text)
+}
+
+// FormatReachTrace formats a reach trace as one line per hex, reached hexes
+// first
+func FormatReachTrace(trace *lib.ReachTrace) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Reach of %s from %s with %g movement (%d hexes)\n",
+		trace.Unit, trace.Source, trace.MaxMovement, len(trace.Hexes)))
+	sb.WriteString(fmt.Sprintf("%-10s %6s  %-10s %s\n", "HEX", "COST", "FROM", "STATUS"))
+	for _, hex := range trace.SortedHexes() {
+		status := "blocked"
+		if hex.Reached {
+			status = "reached"
+		}
+		if hex.Occupied {
+			status += ", occupied"
+		}
+		if hex.Stop != "" {
+			status += ", stop: " + string(hex.Stop)
+		}
+		from := fmt.Sprintf("%d,%d", hex.From.Q, hex.From.R)
+		if hex.Coord == trace.Source {
+			from = "-"
+		}
+		sb.WriteString(fmt.Sprintf("%-10s %6g  %-10s %s\n",
+			fmt.Sprintf("%d,%d", hex.Coord.Q, hex.Coord.R), hex.Cost, from, status))
+	}
+	return sb.String()
+}
+
+// reachHeatColor tints reached hexes from green at no cost to red at the
+// unit's full movement, and hexes the fill could not enter grey
+func reachHeatColor(hex *lib.ReachHex, maxMovement float64) color.NRGBA {
+	if !hex.Reached {
+		return color.NRGBA{R: 64, G: 64, B: 64, A: 144}
+	}
+	frac := 1.0
+	if maxMovement > 0 {
+		frac = min(hex.Cost/maxMovement, 1)
+	}
+	return color.NRGBA{R: uint8(255 * frac), G: uint8(255 * (1 - frac)), A: 144}
+}
+
+// renderReach saves the map with the reach drawn over it as a heat map
+func renderReach(gc *GameContext, trace *lib.ReachTrace, path string) error {
+	rulesEngine := gc.RTGame.GetRulesEngine()
+	renderer, err := themes.NewPNGWorldRenderer(themes.NewDefaultTheme(rulesEngine.GetCityTerrains()))
+	if err != nil {
+		return fmt.Errorf("failed to create renderer: %w", err)
+	}
+
+	options := lib.DefaultRenderOptions()
+	options.ShowUnitLabels = true
+	options.ShowCoords = true
+	options.UnitMaxHealth = rulesEngine.GetUnitMaxHealth()
+	options.Orientation = lib.GetOrientation(gc.Game.GetOrientation())
+	options.TileTints = map[lib.AxialCoord]color.NRGBA{}
+	for _, hex := range trace.Hexes {
+		options.TileTints[hex.Coord] = reachHeatColor(hex, trace.MaxMovement)
+	}
+
+	pngData, _, err := renderer.Render(gc.State.WorldData.TilesMap, gc.State.WorldData.UnitsMap, options)
+	if err != nil {
+		return fmt.Errorf("failed to render reach: %w", err)
+	}
+	if err := os.WriteFile(path, pngData, 0644); err != nil {
+		return fmt.Errorf("failed to write image to %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff <from-turn> <to-turn>",
+	Short: "Show everything that changed between two turns",
+	Long: `Show everything that changed in the game from the start of one turn to the
+start of another: units added, removed or changed, tiles that changed owner
+and players whose coins or status changed. Units built and killed in between
+are listed too.
+
+Examples:
+  ww diff 12 15          Changes from the start of turn 12 to the start of turn 15
+  ww diff 12 15 --json   Output as JSON`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDiff,
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	fromTurn, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid from turn %q", args[0])
+	}
+	toTurn, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid to turn %q", args[1])
+	}
+
+	ctx := context.Background()
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+
+	resp, err := gc.Service.GetStateDiff(ctx, &v1.GetStateDiffRequest{
+		GameId:   gc.GameID,
+		FromTurn: int32(fromTurn),
+		ToTurn:   int32(toTurn),
+	})
+	if err != nil {
+		return fmt.Errorf("diff failed: %w", err)
+	}
+	lines := formatStateDiff(resp.Diff)
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		data := map[string]any{
+			"game_id":   gc.GameID,
+			"from_turn": resp.Diff.FromTurn,
+			"to_turn":   resp.Diff.ToTurn,
+			"changes":   lines,
+		}
+		return formatter.PrintJSON(data)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Turn %d -> %d:\n", resp.Diff.FromTurn, resp.Diff.ToTurn))
+	if len(lines) == 0 {
+		sb.WriteString("  No changes\n")
+	}
+	for _, line := range lines {
+		sb.WriteString(fmt.Sprintf("  - %s\n", line))
+	}
+	return formatter.PrintText(sb.String())
+}
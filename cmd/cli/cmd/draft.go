@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// draftCmd represents the draft command
+var draftCmd = &cobra.Command{
+	Use:   "draft <ban|pick|pass> [unit]",
+	Short: "Ban or pick a unit type in the pre-game draft",
+	Long: `Take your turn in a game's pre-game draft. Players take turns banning unit
+types, which then can't be built by anyone, and then picking unit types that
+only they can build. The game starts once every player has drafted.
+
+Units can be given by ID or by name (or the start of a name).
+
+Examples:
+  ww draft ban tank           Ban tanks for this game
+  ww draft ban 19             Ban unit type 19
+  ww draft pick artillery     Pick artillery for yourself
+  ww draft pass               Skip your ban or pick`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runDraft,
+}
+
+func init() {
+	rootCmd.AddCommand(draftCmd)
+}
+
+func runDraft(cmd *cobra.Command, args []string) error {
+	verb := args[0]
+	switch {
+	case verb != "ban" && verb != "pick" && verb != "pass":
+		return fmt.Errorf("unknown draft action %q (expected ban, pick or pass)", verb)
+	case verb == "pass" && len(args) != 1:
+		return fmt.Errorf("pass takes no unit")
+	case verb != "pass" && len(args) != 2:
+		return fmt.Errorf("%s needs a unit", verb)
+	}
+
+	ctx := context.Background()
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+	if !gc.RTGame.IsDrafting() {
+		return fmt.Errorf("game %s is not drafting", gc.GameID)
+	}
+
+	action := &v1.DraftUnitAction{Pick: verb == "pick"}
+	if verb == "pass" {
+		action.Pick, _ = gc.RTGame.DraftRound()
+	} else {
+		unitDef, err := findUnitDefinition(gc.RTGame.GetRulesEngine(), args[1])
+		if err != nil {
+			return err
+		}
+		action.UnitType = unitDef.Id
+	}
+
+	resp, err := gc.Service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+		GameId: gc.GameID,
+		DryRun: isDryrun(),
+		Moves: []*v1.GameMove{{
+			Player:   gc.State.CurrentPlayer,
+			MoveType: &v1.GameMove_DraftUnit{DraftUnit: action},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("draft %s failed: %w", verb, err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"game_id":   gc.GameID,
+			"action":    "draft_" + verb,
+			"unit_type": action.UnitType,
+			"dryrun":    isDryrun(),
+			"success":   true,
+			"changes":   formatChangesForJSON(resp.Moves),
+		})
+	}
+
+	var sb strings.Builder
+	if isDryrun() {
+		sb.WriteString("Draft (dryrun): Would succeed\n")
+	} else {
+		sb.WriteString("Draft: Success\n")
+	}
+	if len(resp.Moves) > 0 {
+		for _, change := range resp.Moves[0].Changes {
+			sb.WriteString(fmt.Sprintf("  %s\n", formatChange(change)))
+			if drafted := change.GetUnitDrafted(); drafted != nil {
+				if drafted.DraftComplete {
+					sb.WriteString("  The draft is over, player 1 starts the game\n")
+				} else {
+					sb.WriteString(fmt.Sprintf("  Player %d drafts next\n", drafted.NextPlayer))
+				}
+			}
+		}
+	}
+	return formatter.PrintText(sb.String())
+}
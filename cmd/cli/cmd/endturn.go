@@ -8,23 +8,30 @@ import (
 	"github.com/spf13/cobra"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 )
 
+var endturnForce bool
+
 // endturnCmd represents the endturn command
 var endturnCmd = &cobra.Command{
 	Use:   "endturn",
 	Short: "End the current player's turn",
 	Long: `End the current player's turn and advance to the next player.
 All units for the new player will be reset with full movement points.
+Fails while the rules' mandatory actions (eg a pending retreat) are
+unresolved, unless --force is given.
 
 Examples:
   ww endturn
+  ww endturn --force     End the turn skipping mandatory actions
   ww endturn --dryrun    Preview turn transition without saving`,
 	RunE: runEndTurn,
 }
 
 func init() {
 	rootCmd.AddCommand(endturnCmd)
+	endturnCmd.Flags().BoolVar(&endturnForce, "force", false, "end the turn even if mandatory actions are pending")
 }
 
 func runEndTurn(cmd *cobra.Command, args []string) error {
@@ -49,7 +56,7 @@ func runEndTurn(cmd *cobra.Command, args []string) error {
 		Moves: []*v1.GameMove{{
 			Player: gc.State.CurrentPlayer,
 			MoveType: &v1.GameMove_EndTurn{
-				EndTurn: &v1.EndTurnAction{},
+				EndTurn: lib.NewEndTurnAction(gc.State, endturnForce),
 			},
 		}},
 	})
@@ -97,6 +104,9 @@ func runEndTurn(cmd *cobra.Command, args []string) error {
 	}
 	sb.WriteString(fmt.Sprintf("  Turn ended for player %d\n", previousPlayer))
 	sb.WriteString(fmt.Sprintf("  Now player %d's turn (turn %d)\n", newPlayer, newTurn))
+	if limit := gc.RTGame.MaxUnitsPerPlayer(); limit > 0 {
+		sb.WriteString(fmt.Sprintf("  Player %d units: %d/%d\n", newPlayer, gc.RTGame.PlayerUnitCount(newPlayer), limit))
+	}
 
 	return formatter.PrintText(sb.String())
 }
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/connectclient"
+)
+
+var (
+	forkAtMove    int32
+	forkAIPlayers []int32
+)
+
+// forkCmd represents the fork command
+var forkCmd = &cobra.Command{
+	Use:   "fork <game_id>",
+	Short: "Fork a game into a private copy to explore other lines",
+	Long: `Copy a game into a new private game where you play every seat, to try out
+other lines without touching the game itself. Forks are never rated and are
+left out of game listings.
+Requires LILBATTLE_SERVER to be set.
+
+Examples:
+  ww fork abc123                Fork the game as it is now
+  ww fork abc123 --move 40      Fork the game as it was after its first 40 moves
+  ww fork abc123 --ai 2         Let the AI play player 2 in the fork`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFork,
+}
+
+func init() {
+	rootCmd.AddCommand(forkCmd)
+	forkCmd.Flags().Int32Var(&forkAtMove, "move", 0, "number of moves to fork after (0 forks the game as it is now)")
+	forkCmd.Flags().Int32SliceVar(&forkAIPlayers, "ai", nil, "players the AI plays in the fork")
+}
+
+func runFork(cmd *cobra.Command, args []string) error {
+	sourceID := args[0]
+	serverURL := getServerURL()
+	if serverURL == "" {
+		return fmt.Errorf("LILBATTLE_SERVER is required for forking games (e.g., http://localhost:9080)")
+	}
+	token := GetTokenForProfile(getProfileName())
+	gamesClient := connectclient.NewConnectGamesClientWithAuth(GetAPIEndpoint(serverURL), token)
+
+	resp, err := gamesClient.ForkGame(context.Background(), &v1.ForkGameRequest{
+		GameId:    sourceID,
+		MoveIndex: forkAtMove,
+		AiPlayers: forkAIPlayers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fork game: %w", err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		data := map[string]any{
+			"game_id":             resp.Game.Id,
+			"name":                resp.Game.Name,
+			"forked_from_game_id": resp.Game.ForkedFromGameId,
+			"forked_at_move":      resp.Game.ForkedAtMove,
+			"turn":                resp.GameState.TurnCounter,
+		}
+		return formatter.PrintJSON(data)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Forked game: %s\n", resp.Game.Id))
+	sb.WriteString(fmt.Sprintf("  Name: %s\n", resp.Game.Name))
+	sb.WriteString(fmt.Sprintf("  From: %s after %d moves\n", resp.Game.ForkedFromGameId, resp.Game.ForkedAtMove))
+	sb.WriteString(fmt.Sprintf("  Turn: %d\n", resp.GameState.TurnCounter))
+	sb.WriteString(fmt.Sprintf("\nTo play: export LILBATTLE_GAME_ID=%s\n", resp.Game.Id))
+	return formatter.PrintText(sb.String())
+}
@@ -13,12 +13,14 @@ import (
 // healCmd represents the heal command
 var healCmd = &cobra.Command{
 	Use:   "heal <unit>",
-	Short: "Heal a unit on a friendly or neutral terrain",
-	Long: `Heal a unit that is on friendly or neutral terrain.
-The unit must not have moved or attacked this turn.
+	Short: "Heal a unit on friendly supply terrain",
+	Long: `Spend a unit's turn healing on friendly supply terrain (a tile its
+player owns, like a base or city).
+The unit must not have moved or attacked this turn, and cannot act after.
 Healing amount depends on the terrain type.
 Air units can only heal on Airport Bases.
-Units cannot heal on enemy-owned tiles.
+This is separate from the healing units get at the start of a turn when
+they were not used the turn before.
 
 Positions can be unit IDs (like A1) or coordinates (like 3,4).
 
@@ -49,8 +51,9 @@ func runHeal(cmd *cobra.Command, args []string) error {
 
 	// Execute heal directly via ProcessMoves - server parses labels
 	resp, err := gc.Service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
-		GameId: gc.GameID,
-		DryRun: isDryrun(),
+		GameId:       gc.GameID,
+		DryRun:       isDryrun(),
+		DebugResolve: isDebugResolve(),
 		Moves: []*v1.GameMove{{
 			Player: gc.State.CurrentPlayer,
 			MoveType: &v1.GameMove_HealUnit{
@@ -94,5 +97,6 @@ func runHeal(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	sb.WriteString(formatMoveResolutions(resp.Resolutions))
 	return formatter.PrintText(sb.String())
 }
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+var heatmapOutput string
+
+// renderHeatmapCmd represents the render-heatmap command
+var renderHeatmapCmd = &cobra.Command{
+	Use:   "render-heatmap <game_id>",
+	Short: "Render where a game's fighting happened",
+	Long: `Replay a game's history and render the map with each hex tinted by how much
+fighting happened on it: attacks into the hex, units destroyed on it and
+captures of it. The busiest hex is drawn the deepest red.
+
+Examples:
+  ww render-heatmap abc123 -o heat.png`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRenderHeatmap,
+}
+
+func init() {
+	rootCmd.AddCommand(renderHeatmapCmd)
+	renderHeatmapCmd.Flags().StringVarP(&heatmapOutput, "output", "o", "heatmap.png", "file to save the heatmap image to")
+}
+
+func runRenderHeatmap(cmd *cobra.Command, args []string) error {
+	gc, err := GetGameContextFor(args[0])
+	if err != nil {
+		return err
+	}
+	if gc.State.GetWorldData() == nil {
+		return fmt.Errorf("world data not available")
+	}
+
+	heatmap := lib.AccumulateHistoryActivity(gc.History)
+
+	rulesEngine := gc.RTGame.GetRulesEngine()
+	renderer, err := themes.NewPNGWorldRenderer(themes.NewDefaultTheme(rulesEngine.GetCityTerrains()))
+	if err != nil {
+		return fmt.Errorf("failed to create renderer: %w", err)
+	}
+	options := lib.DefaultRenderOptions()
+	options.Orientation = lib.GetOrientation(gc.Game.GetOrientation())
+
+	pngData, err := renderer.RenderHeatmap(gc.State.WorldData.TilesMap, heatmap.Intensities(), options)
+	if err != nil {
+		return fmt.Errorf("failed to render heatmap: %w", err)
+	}
+	if err := os.WriteFile(heatmapOutput, pngData, 0644); err != nil {
+		return fmt.Errorf("failed to write image to %s: %w", heatmapOutput, err)
+	}
+
+	attacks, kills, captures := 0, 0, 0
+	for _, activity := range heatmap {
+		attacks += activity.Attacks
+		kills += activity.Kills
+		captures += activity.Captures
+	}
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"game_id":  gc.GameID,
+			"output":   heatmapOutput,
+			"hexes":    len(heatmap),
+			"attacks":  attacks,
+			"kills":    kills,
+			"captures": captures,
+		})
+	}
+	return formatter.PrintText(fmt.Sprintf("Heatmap of %d attacks, %d kills and %d captures over %d hexes saved to %s",
+		attacks, kills, captures, len(heatmap), heatmapOutput))
+}
@@ -22,6 +22,7 @@ Examples:
   ww map --labels         # Show unit labels (Shortcut:MP/Health)
   ww map --no-labels      # Hide unit labels
   ww map --tile-labels    # Show tile labels (Shortcut)
+  ww map --health-bars    # Show health bars on damaged units
   ww map -o map.png       # Save to file instead of displaying`,
 	RunE: runMap,
 }
@@ -29,6 +30,7 @@ Examples:
 var (
 	showLabels     bool
 	showTileLabels bool
+	showHealthBars bool
 	outputFile     string
 )
 
@@ -36,6 +38,7 @@ func init() {
 	rootCmd.AddCommand(mapCmd)
 	mapCmd.Flags().BoolVar(&showLabels, "labels", true, "Show unit labels (Shortcut:MP/Health)")
 	mapCmd.Flags().BoolVar(&showTileLabels, "tile-labels", true, "Show tile labels (Shortcut)")
+	mapCmd.Flags().BoolVar(&showHealthBars, "health-bars", false, "Show health bars on damaged units")
 
 	// Default to environment variable if set
 	defaultOutput := os.Getenv("LILBATTLE_MAP_OUTPUT")
@@ -57,8 +60,9 @@ func runMap(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("world data not available")
 	}
 
-	// Create theme for rendering using cityTerrains from default rules engine
-	theme := themes.NewDefaultTheme(lib.DefaultRulesEngine().GetCityTerrains())
+	// Create theme for rendering using cityTerrains from the game's rules engine
+	rulesEngine := gc.RTGame.GetRulesEngine()
+	theme := themes.NewDefaultTheme(rulesEngine.GetCityTerrains())
 	renderer, err := themes.NewPNGWorldRenderer(theme)
 	if err != nil {
 		return fmt.Errorf("failed to create renderer: %w", err)
@@ -68,6 +72,9 @@ func runMap(cmd *cobra.Command, args []string) error {
 	options := lib.DefaultRenderOptions()
 	options.ShowUnitLabels = showLabels
 	options.ShowTileLabels = showTileLabels
+	options.ShowHealthBars = showHealthBars
+	options.UnitMaxHealth = rulesEngine.GetUnitMaxHealth()
+	options.Orientation = lib.GetOrientation(gc.Game.GetOrientation())
 
 	// Render the map
 	pngData, _, err := renderer.Render(state.WorldData.TilesMap, state.WorldData.UnitsMap, options)
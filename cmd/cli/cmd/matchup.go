@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// matchupCmd represents the matchup command
+var matchupCmd = &cobra.Command{
+	Use:   "matchup <attacker> <defender>",
+	Short: "Show the damage distribution of one unit type attacking another",
+	Long: `Show how much damage a full health attacker deals to a defender, straight
+from the rules: min, max and expected damage, the probability of each damage
+value, and the chance of killing the defender at each health level. Terrain
+and wound bonuses are not included. Does not need a game.
+
+Units can be given by ID or by name (case insensitive).
+
+Examples:
+  ww matchup 3 1
+  ww matchup "tank (basic)" striker
+  ww matchup artillery\ \(basic\) 3 --json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runMatchup,
+}
+
+func init() {
+	rootCmd.AddCommand(matchupCmd)
+}
+
+func runMatchup(cmd *cobra.Command, args []string) error {
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return err
+	}
+	attacker, err := findUnitDefinition(rulesEngine, args[0])
+	if err != nil {
+		return err
+	}
+	defender, err := findUnitDefinition(rulesEngine, args[1])
+	if err != nil {
+		return err
+	}
+
+	dist, canAttack := rulesEngine.GetCombatPrediction(attacker.Id, defender.Id)
+	if !canAttack {
+		return fmt.Errorf("%s cannot attack %s", attacker.Name, defender.Name)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		killProbabilities := map[int32]float64{}
+		for health := defender.Health; health > 0; health-- {
+			killProbabilities[health] = lib.KillProbability(dist, health)
+		}
+		return formatter.PrintJSON(map[string]any{
+			"attacker":         attacker.Id,
+			"defender":         defender.Id,
+			"distribution":     dist,
+			"kill_probability": killProbabilities,
+		})
+	}
+	return formatter.PrintText(FormatMatchup(attacker, defender, dist))
+}
+
+// FormatMatchup formats a damage distribution with the chance of killing the
+// defender at each of its health levels
+func FormatMatchup(attacker, defender *v1.UnitDefinition, dist *v1.DamageDistribution) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s (%d) attacking %s (%d)\n", attacker.Name, attacker.Id, defender.Name, defender.Id))
+	sb.WriteString(fmt.Sprintf("Damage: min %g, max %g, expected %.2f\n", dist.MinDamage, dist.MaxDamage, dist.ExpectedDamage))
+
+	totalWeight := 0.0
+	for _, damageRange := range dist.Ranges {
+		totalWeight += damageRange.Probability
+	}
+	sb.WriteString("Distribution:\n")
+	for _, damageRange := range dist.Ranges {
+		if damageRange.Probability <= 0 {
+			continue
+		}
+		probability := damageRange.Probability / totalWeight
+		label := fmt.Sprintf("%g", damageRange.MinValue)
+		if damageRange.MaxValue != damageRange.MinValue {
+			label = fmt.Sprintf("%g-%g", damageRange.MinValue, damageRange.MaxValue)
+		}
+		sb.WriteString(fmt.Sprintf("  %-5s %5.1f%% %s\n", label, probability*100, strings.Repeat("#", int(probability*50+0.5))))
+	}
+
+	sb.WriteString("Kill probability by defender health:\n")
+	for health := defender.Health; health > 0; health-- {
+		sb.WriteString(fmt.Sprintf("  %2d HP %5.1f%%\n", health, lib.KillProbability(dist, health)*100))
+	}
+	return sb.String()
+}
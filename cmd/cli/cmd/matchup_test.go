@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/turnforge/lilbattle/lib"
+)
+
+const matchupDamageJSON = `{
+  "unitUnitProperties": {
+    "1:1": {"attacker_id": 1, "defender_id": 1, "damage": {"min_damage": 3, "max_damage": 5, "ranges": [
+      {"min_value": 3, "max_value": 3, "probability": 0.25},
+      {"min_value": 4, "max_value": 4, "probability": 0.5},
+      {"min_value": 5, "max_value": 5, "probability": 0.25}
+    ]}}
+  }
+}`
+
+const expectedMatchup = `Custom Soldier (1) attacking Custom Soldier (1)
+Damage: min 3, max 5, expected 4.00
+Distribution:
+  3      25.0% #############
+  4      50.0% #########################
+  5      25.0% #############
+Kill probability by defender health:
+  10 HP   0.0%
+   9 HP   0.0%
+   8 HP   0.0%
+   7 HP   0.0%
+   6 HP   0.0%
+   5 HP  25.0%
+   4 HP  75.0%
+   3 HP 100.0%
+   2 HP 100.0%
+   1 HP 100.0%
+`
+
+func TestMatchupMatchesFixtureDistribution(t *testing.T) {
+	re, err := lib.LoadRulesEngineFromJSON([]byte(customRulesJSON), []byte(matchupDamageJSON))
+	if err != nil {
+		t.Fatalf("failed to load rules: %v", err)
+	}
+	unit, err := findUnitDefinition(re, "custom soldier")
+	if err != nil {
+		t.Fatalf("findUnitDefinition error: %v", err)
+	}
+	dist, canAttack := re.GetCombatPrediction(1, 1)
+	if !canAttack {
+		t.Fatal("expected unit 1 to be able to attack itself")
+	}
+
+	if got := FormatMatchup(unit, unit, dist); got != expectedMatchup {
+		t.Errorf("matchup output:\n%s\nwant:\n%s", got, expectedMatchup)
+	}
+}
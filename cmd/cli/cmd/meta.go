@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/connectclient"
+)
+
+// metaCmd groups the commands on statistics across games
+var metaCmd = &cobra.Command{
+	Use:   "meta",
+	Short: "Statistics aggregated across finished games",
+}
+
+// metaStatsCmd represents the meta stats command
+var metaStatsCmd = &cobra.Command{
+	Use:   "stats [world_id...]",
+	Short: "Show unit, terrain and game length statistics per world",
+	Long: `Show what the indexer has aggregated from the finished games of each
+world: how often each unit is built and how often its builders win, how
+often each terrain is captured, and how long games last.
+Requires LILBATTLE_SERVER to be set.
+
+Examples:
+  ww meta stats
+  ww meta stats 3f2a91c0 --json`,
+	RunE: runMetaStats,
+}
+
+func init() {
+	rootCmd.AddCommand(metaCmd)
+	metaCmd.AddCommand(metaStatsCmd)
+}
+
+func runMetaStats(cmd *cobra.Command, args []string) error {
+	serverURL := getServerURL()
+	if serverURL == "" {
+		return fmt.Errorf("LILBATTLE_SERVER is required for meta stats (e.g., http://localhost:9080)")
+	}
+	token := GetTokenForProfile(getProfileName())
+	client := connectclient.NewConnectIndexerClientWithAuth(GetAPIEndpoint(serverURL), token)
+	resp, err := client.GetMetaStats(context.Background(), &v1.GetMetaStatsRequest{WorldIds: args})
+	if err != nil {
+		return fmt.Errorf("failed to get meta stats: %w", err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{"worlds": resp.Worlds})
+	}
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return err
+	}
+	return formatter.PrintText(FormatMetaStats(rulesEngine, resp.Worlds))
+}
+
+// FormatMetaStats formats the meta statistics of worlds
+func FormatMetaStats(rulesEngine *lib.RulesEngine, worlds []*v1.WorldMetaStats) string {
+	var sb strings.Builder
+	if len(worlds) == 0 {
+		sb.WriteString("No finished games aggregated yet\n")
+	}
+	for _, world := range worlds {
+		sb.WriteString(fmt.Sprintf("World %s: %d games, %.1f turns on average\n", world.WorldId, world.Games, world.AverageGameLength))
+		if len(world.Units) > 0 {
+			sb.WriteString("  Units           built  per game  win rate when built\n")
+		}
+		for _, unit := range world.Units {
+			name := fmt.Sprintf("unit %d", unit.UnitType)
+			if unitDef, err := rulesEngine.GetUnitData(unit.UnitType); err == nil {
+				name = unitDef.Name
+			}
+			sb.WriteString(fmt.Sprintf("  %-15s %5d  %8.2f  %5.0f%% (%d/%d)\n", name, unit.UnitsBuilt, unit.BuildRate,
+				unit.WinRateWhenBuilt*100, unit.BuilderWins, unit.Builders))
+		}
+		if len(world.Terrains) > 0 {
+			sb.WriteString("  Terrain         captures  per game\n")
+		}
+		for _, terrain := range world.Terrains {
+			name := fmt.Sprintf("terrain %d", terrain.TileType)
+			if terrainDef, err := rulesEngine.GetTerrainData(terrain.TileType); err == nil {
+				name = terrainDef.Name
+			}
+			sb.WriteString(fmt.Sprintf("  %-15s %8d  %8.2f\n", name, terrain.Captures, terrain.CaptureRate))
+		}
+	}
+	return sb.String()
+}
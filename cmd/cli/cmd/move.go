@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 )
 
 // moveCmd represents the move command
@@ -50,8 +51,10 @@ func runMove(cmd *cobra.Command, args []string) error {
 
 	// Execute move directly via ProcessMoves - server parses labels
 	resp, err := gc.Service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
-		GameId: gc.GameID,
-		DryRun: isDryrun(),
+		GameId:       gc.GameID,
+		DryRun:       isDryrun(),
+		DebugResolve: isDebugResolve(),
+		Coach:        isCoachEnabled(),
 		Moves: []*v1.GameMove{{
 			Player: gc.State.CurrentPlayer,
 			MoveType: &v1.GameMove_MoveUnit{
@@ -78,6 +81,7 @@ func runMove(cmd *cobra.Command, args []string) error {
 			"dryrun":  isDryrun(),
 			"success": true,
 			"changes": formatChangesForJSON(resp.Moves),
+			"coach":   coachVerdictsForJSON(resp.Moves),
 		}
 		return formatter.PrintJSON(data)
 	}
@@ -99,6 +103,10 @@ func runMove(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	sb.WriteString(formatDryrunDiff(resp))
+	sb.WriteString(formatCoachVerdicts(resp.Moves))
+	sb.WriteString(formatMoveResolutions(resp.Resolutions))
+
 	return formatter.PrintText(sb.String())
 }
 
@@ -140,10 +148,105 @@ func formatChange(change *v1.WorldChange) string {
 		return fmt.Sprintf("Capture started at (%d,%d)", c.CaptureStarted.TileQ, c.CaptureStarted.TileR)
 	case *v1.WorldChange_TileCaptured:
 		return fmt.Sprintf("Tile captured at (%d,%d) by player %d", c.TileCaptured.TileQ, c.TileCaptured.TileR, c.TileCaptured.NewOwner)
+	case *v1.WorldChange_UnitTransformed:
+		u := c.UnitTransformed.UpdatedUnit
+		return fmt.Sprintf("Unit %s transformed from type %d to type %d (health %d)",
+			u.Shortcut, c.UnitTransformed.PreviousUnit.UnitType, u.UnitType, u.AvailableHealth)
 	case *v1.WorldChange_UnitHealed:
 		u := c.UnitHealed.UpdatedUnit
 		return fmt.Sprintf("Unit %s healed (+%d health, now %d)", u.Shortcut, c.UnitHealed.HealAmount, u.AvailableHealth)
+	case *v1.WorldChange_TerrainChanged:
+		prev := c.TerrainChanged.PreviousTile
+		upd := c.TerrainChanged.UpdatedTile
+		if prev.TileType == upd.TileType {
+			return fmt.Sprintf("Construction updated at (%d,%d)", upd.Q, upd.R)
+		}
+		return fmt.Sprintf("Terrain at (%d,%d) changed: %d -> %d", upd.Q, upd.R, prev.TileType, upd.TileType)
+	case *v1.WorldChange_UnitSubmerged:
+		u := c.UnitSubmerged.UpdatedUnit
+		if u.Submerged {
+			return fmt.Sprintf("Unit %s submerged", u.Shortcut)
+		}
+		return fmt.Sprintf("Unit %s surfaced", u.Shortcut)
+	case *v1.WorldChange_UnitDrafted:
+		d := c.UnitDrafted
+		switch {
+		case d.UnitType == 0:
+			return fmt.Sprintf("Player %d passed in the draft", d.PlayerId)
+		case d.Pick:
+			return fmt.Sprintf("Player %d picked unit type %d", d.PlayerId, d.UnitType)
+		}
+		return fmt.Sprintf("Player %d banned unit type %d", d.PlayerId, d.UnitType)
+	case *v1.WorldChange_TurnDelegated:
+		return fmt.Sprintf("Player %d delegated their turn to player %d", c.TurnDelegated.PlayerId, c.TurnDelegated.DelegatePlayerId)
+	case *v1.WorldChange_GameEvent:
+		return c.GameEvent.Description
+	case *v1.WorldChange_PlanSubmitted:
+		return lib.FormatPlanSubmitted(c.PlanSubmitted)
+	case *v1.WorldChange_UnitAttacked:
+		return lib.FormatCombatSummary(c.UnitAttacked.Summary)
+	case *v1.WorldChange_VictoryPointsScored:
+		return lib.FormatVictoryPointsScored(c.VictoryPointsScored)
 	default:
 		return fmt.Sprintf("%T", change.ChangeType)
 	}
 }
+
+// formatStateDiff formats a StateDiff for display, one line per difference
+func formatStateDiff(diff *v1.StateDiff) []string {
+	var lines []string
+	for _, d := range diff.GetUnits() {
+		switch d.Kind {
+		case v1.UnitDiffKind_UNIT_DIFF_KIND_ADDED:
+			lines = append(lines, fmt.Sprintf("Unit %s added at (%d,%d)", d.After.Shortcut, d.After.Q, d.After.R))
+		case v1.UnitDiffKind_UNIT_DIFF_KIND_REMOVED:
+			lines = append(lines, fmt.Sprintf("Unit %s removed from (%d,%d)", d.Before.Shortcut, d.Before.Q, d.Before.R))
+		case v1.UnitDiffKind_UNIT_DIFF_KIND_TRANSIENT:
+			lines = append(lines, fmt.Sprintf("Unit %s built at (%d,%d) and killed at (%d,%d)", d.Before.Shortcut, d.Before.Q, d.Before.R, d.After.Q, d.After.R))
+		default:
+			deltas := make([]string, len(d.Deltas))
+			for i, delta := range d.Deltas {
+				deltas[i] = fmt.Sprintf("%s %s -> %s", delta.Field, delta.Before, delta.After)
+			}
+			lines = append(lines, fmt.Sprintf("Unit %s changed: %s", d.After.Shortcut, strings.Join(deltas, ", ")))
+		}
+	}
+	for _, t := range diff.GetTiles() {
+		lines = append(lines, fmt.Sprintf("Tile at (%d,%d) owner: %d -> %d", t.Q, t.R, t.PreviousOwner, t.NewOwner))
+	}
+	for _, p := range diff.GetPlayers() {
+		if p.PreviousCoins != p.NewCoins {
+			lines = append(lines, fmt.Sprintf("Player %d coins: %d -> %d", p.PlayerId, p.PreviousCoins, p.NewCoins))
+		}
+		if p.WasActive && !p.IsActive {
+			lines = append(lines, fmt.Sprintf("Player %d eliminated", p.PlayerId))
+		} else if !p.WasActive && p.IsActive {
+			lines = append(lines, fmt.Sprintf("Player %d became active", p.PlayerId))
+		}
+	}
+	return lines
+}
+
+// formatMoveResolutions formats how the server resolved each move, if it was
+// asked to
+func formatMoveResolutions(resolutions []*v1.MoveResolution) string {
+	var sb strings.Builder
+	for _, resolution := range resolutions {
+		sb.WriteString(fmt.Sprintf("  Resolved: %s\n", lib.FormatMoveResolution(resolution)))
+	}
+	return sb.String()
+}
+
+// formatDryrunDiff formats what a dry run's moves would change, if anything
+func formatDryrunDiff(resp *v1.ProcessMovesResponse) string {
+	lines := formatStateDiff(resp.GetStateDiff())
+	if len(lines) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("  Would change:\n")
+	for _, line := range lines {
+		sb.WriteString(fmt.Sprintf("    - %s\n", line))
+	}
+	return sb.String()
+}
@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -20,6 +22,11 @@ var (
 	landbaseIncome    int32
 	navalbaseIncome   int32
 	airportbaseIncome int32
+	handicaps         []string
+	sandbox           bool
+	relaxTurnOrder    bool
+	relaxActionOrder  bool
+	infiniteCoins     bool
 )
 
 // newCmd represents the new command
@@ -34,7 +41,10 @@ Examples:
   ww new 01bdc3ce                              Create game from world
   ww new 01bdc3ce --name "My Game"             Create game with custom name
   ww new 01bdc3ce --starting-coins 200         Start with 200 coins per player
-  ww new 01bdc3ce --landbase-income 100        Set landbase income to 100`,
+  ww new 01bdc3ce --landbase-income 100        Set landbase income to 100
+  ww new 01bdc3ce --handicap 2:200             Give player 2 200 extra coins
+  ww new 01bdc3ce --handicap 2:0:1,1,3         Give player 2 two soldiers and a tank
+  ww new 01bdc3ce --sandbox --infinite-coins   Practice against yourself, with free builds`,
 	Args: cobra.ExactArgs(1),
 	RunE: runNew,
 }
@@ -48,6 +58,11 @@ func init() {
 	newCmd.Flags().Int32Var(&landbaseIncome, "landbase-income", 150, "income per landbase")
 	newCmd.Flags().Int32Var(&navalbaseIncome, "navalbase-income", 150, "income per navalbase")
 	newCmd.Flags().Int32Var(&airportbaseIncome, "airportbase-income", 150, "income per airport")
+	newCmd.Flags().StringArrayVar(&handicaps, "handicap", nil, "player handicap as player:extra_coins[:unit_type,...] (repeatable)")
+	newCmd.Flags().BoolVar(&sandbox, "sandbox", false, "create a sandbox where you control every seat and can spawn and delete units")
+	newCmd.Flags().BoolVar(&relaxTurnOrder, "relax-turn-order", false, "in a sandbox, let any player's units act on any turn")
+	newCmd.Flags().BoolVar(&relaxActionOrder, "relax-action-order", false, "in a sandbox, let units take their actions in any order")
+	newCmd.Flags().BoolVar(&infiniteCoins, "infinite-coins", false, "in a sandbox, make builds and constructions free")
 }
 
 func runNew(cmd *cobra.Command, args []string) error {
@@ -95,6 +110,18 @@ func runNew(cmd *cobra.Command, args []string) error {
 		fmt.Println()
 	}
 
+	for _, spec := range handicaps {
+		playerID, handicap, err := parseHandicap(spec)
+		if err != nil {
+			return err
+		}
+		i := slices.IndexFunc(players, func(p *v1.GamePlayer) bool { return p.PlayerId == playerID })
+		if i < 0 {
+			return fmt.Errorf("handicap for player %d, who is not in the world", playerID)
+		}
+		players[i].Handicap = handicap
+	}
+
 	// Build game name from world if not provided
 	name := gameName
 	if name == "" {
@@ -122,6 +149,15 @@ func runNew(cmd *cobra.Command, args []string) error {
 		},
 	}
 
+	if sandbox {
+		game.Config.Settings = &v1.GameSettings{Sandbox: &v1.SandboxSettings{
+			Enabled:          true,
+			RelaxTurnOrder:   relaxTurnOrder,
+			RelaxActionOrder: relaxActionOrder,
+			InfiniteCoins:    infiniteCoins,
+		}}
+	}
+
 	// Create the game
 	resp, err := gamesClient.CreateGame(ctx, &v1.CreateGameRequest{Game: game})
 	if err != nil {
@@ -159,36 +195,41 @@ func runNew(cmd *cobra.Command, args []string) error {
 // detectPlayersFromWorld scans world data and returns GamePlayer entries
 // for each player that owns at least one unit or tile
 func detectPlayersFromWorld(worldData *v1.WorldData) []*v1.GamePlayer {
-	// Ensure maps are initialized
-	lib.MigrateWorldData(worldData)
-
-	playerSet := make(map[int32]bool)
-
-	// Check tiles
-	for _, tile := range worldData.TilesMap {
-		if tile != nil && tile.Player > 0 {
-			playerSet[tile.Player] = true
-		}
+	var players []*v1.GamePlayer
+	for _, playerID := range lib.WorldDataPlayerIDs(worldData) {
+		players = append(players, &v1.GamePlayer{
+			PlayerId:   playerID,
+			PlayerType: "human",
+			IsActive:   true,
+		})
 	}
+	return players
+}
 
-	// Check units
-	for _, unit := range worldData.UnitsMap {
-		if unit != nil && unit.Player > 0 {
-			playerSet[unit.Player] = true
-		}
+// parseHandicap parses a --handicap value, player:extra_coins optionally
+// followed by :unit_type,unit_type,... for bonus units
+func parseHandicap(spec string) (int32, *v1.PlayerHandicap, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, nil, fmt.Errorf("invalid handicap %q: expected player:extra_coins[:unit_type,...]", spec)
 	}
-
-	// Build sorted player list
-	var players []*v1.GamePlayer
-	for playerID := int32(1); playerID <= 8; playerID++ {
-		if playerSet[playerID] {
-			players = append(players, &v1.GamePlayer{
-				PlayerId:   playerID,
-				PlayerType: "human",
-				IsActive:   true,
-			})
+	playerID, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid handicap player %q", parts[0])
+	}
+	coins, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid handicap coins %q", parts[1])
+	}
+	handicap := &v1.PlayerHandicap{ExtraCoins: int32(coins)}
+	if len(parts) == 3 {
+		for _, field := range strings.Split(parts[2], ",") {
+			unitType, err := strconv.ParseInt(field, 10, 32)
+			if err != nil {
+				return 0, nil, fmt.Errorf("invalid handicap unit type %q", field)
+			}
+			handicap.BonusUnits = append(handicap.BonusUnits, int32(unitType))
 		}
 	}
-
-	return players
+	return int32(playerID), handicap, nil
 }
@@ -103,12 +103,16 @@ func runOptions(cmd *cobra.Command, args []string) error {
 					unitName = unitDef.Name
 				}
 
-				options = append(options, map[string]any{
+				entry := map[string]any{
 					"type":      "build",
 					"unit_type": buildOpt.UnitType,
 					"unit_name": unitName,
 					"cost":      buildOpt.Cost,
-				})
+				}
+				if option.DisabledReason != "" {
+					entry["disabled_reason"] = option.DisabledReason
+				}
+				options = append(options, entry)
 			case *v1.GameOption_Capture:
 				captureOpt := opt.Capture
 				terrainName := fmt.Sprintf("type %d", captureOpt.TileType)
@@ -125,6 +129,21 @@ func runOptions(cmd *cobra.Command, args []string) error {
 					"tile_type":    captureOpt.TileType,
 					"terrain_name": terrainName,
 				})
+			case *v1.GameOption_Construct:
+				options = append(options, map[string]any{
+					"type":           "construct",
+					"q":              opt.Construct.Target.Q,
+					"r":              opt.Construct.Target.R,
+					"target_terrain": opt.Construct.TargetTerrain,
+					"cost":           opt.Construct.Cost,
+					"turns":          opt.Construct.Turns,
+					"description":    opt.Construct.Description,
+				})
+			case *v1.GameOption_Submerge:
+				options = append(options, map[string]any{
+					"type":     "submerge",
+					"submerge": opt.Submerge.Submerge,
+				})
 			case *v1.GameOption_EndTurn:
 				options = append(options, map[string]any{
 					"type": "endturn",
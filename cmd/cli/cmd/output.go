@@ -3,6 +3,8 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
@@ -88,8 +90,8 @@ func FormatOptionsResponse(gc *GameContext, position string, opts *v1.GetOptions
 	if unit != nil {
 		coord := lib.CoordFromInt32(unit.Q, unit.R)
 		sb.WriteString(fmt.Sprintf("Unit %s at %s:\n", position, coord.String()))
-		sb.WriteString(fmt.Sprintf("  Type: %d, HP: %d, Moves: %.1f\n\n",
-			unit.UnitType, unit.AvailableHealth, unit.DistanceLeft))
+		sb.WriteString(fmt.Sprintf("  Type: %d, HP: %d, Moves: %s\n\n",
+			unit.UnitType, unit.AvailableHealth, lib.FormatMovementPoints(unit.DistanceLeft)))
 	} else {
 		// No unit - show position for tile options
 		sb.WriteString(fmt.Sprintf("Tile %s:\n\n", position))
@@ -155,8 +157,8 @@ func FormatOptionsResponse(gc *GameContext, position string, opts *v1.GetOptions
 						details = append(details, fmt.Sprintf("Def:%d", unitDef.Defense))
 					}
 
-					sb.WriteString(fmt.Sprintf("%d. build %s (cost: %d, type: %d)\n",
-						i+1, unitName, buildOpt.Cost, buildOpt.UnitType))
+					sb.WriteString(fmt.Sprintf("%d. build %s (cost: %d, type: %d)%s\n",
+						i+1, unitName, buildOpt.Cost, buildOpt.UnitType, disabledSuffix(option)))
 
 					// Add details on next line
 					if len(details) > 0 {
@@ -174,8 +176,8 @@ func FormatOptionsResponse(gc *GameContext, position string, opts *v1.GetOptions
 			}
 
 			// Fallback if we couldn't get unit data
-			sb.WriteString(fmt.Sprintf("%d. build %s (cost: %d, type: %d)\n",
-				i+1, unitName, buildOpt.Cost, buildOpt.UnitType))
+			sb.WriteString(fmt.Sprintf("%d. build %s (cost: %d, type: %d)%s\n",
+				i+1, unitName, buildOpt.Cost, buildOpt.UnitType, disabledSuffix(option)))
 
 		case *v1.GameOption_Capture:
 			captureOpt := opt.Capture
@@ -192,6 +194,16 @@ func FormatOptionsResponse(gc *GameContext, position string, opts *v1.GetOptions
 			sb.WriteString(fmt.Sprintf("%d. capture %s at %s\n", i+1, terrainName, coord.String()))
 			sb.WriteString("   Capture completes next turn if unit survives\n")
 
+		case *v1.GameOption_Construct:
+			sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, opt.Construct.Description))
+
+		case *v1.GameOption_Submerge:
+			if opt.Submerge.Submerge {
+				sb.WriteString(fmt.Sprintf("%d. submerge\n", i+1))
+			} else {
+				sb.WriteString(fmt.Sprintf("%d. surface\n", i+1))
+			}
+
 		case *v1.GameOption_EndTurn:
 			sb.WriteString(fmt.Sprintf("%d. end turn\n", i+1))
 		}
@@ -200,8 +212,17 @@ func FormatOptionsResponse(gc *GameContext, position string, opts *v1.GetOptions
 	return sb.String()
 }
 
-// FormatGameStatus formats game status as text
-func FormatGameStatus(game *v1.Game, state *v1.GameState) string {
+// disabledSuffix notes why an option can't be taken, if it can't
+func disabledSuffix(option *v1.GameOption) string {
+	if option.DisabledReason == "" {
+		return ""
+	}
+	return " [disabled: " + option.DisabledReason + "]"
+}
+
+// FormatGameStatus formats game status as text.  unitCap is the most units
+// each player may have, or 0 if uncapped.
+func FormatGameStatus(game *v1.Game, state *v1.GameState, unitCap int32) string {
 	var sb strings.Builder
 
 	sb.WriteString(fmt.Sprintf("Game: %s\n", game.Name))
@@ -215,6 +236,9 @@ func FormatGameStatus(game *v1.Game, state *v1.GameState) string {
 	if state.WinningPlayer != 0 {
 		sb.WriteString(fmt.Sprintf("\nGame Over! Winner: Player %d\n", state.WinningPlayer))
 	}
+	if puzzle := game.GetConfig().GetSettings().GetPuzzle(); puzzle != nil {
+		sb.WriteString(FormatPuzzleStatus(puzzle, state))
+	}
 
 	// Count units per player
 	unitCounts := make(map[int32]int)
@@ -255,10 +279,17 @@ func FormatGameStatus(game *v1.Game, state *v1.GameState) string {
 				coins = playerState.Coins
 			}
 			sb.WriteString(fmt.Sprintf("    Coins: %d\n", coins))
-			sb.WriteString(fmt.Sprintf("    Units: %d\n", unitCounts[player.PlayerId]))
+			if unitCap > 0 {
+				sb.WriteString(fmt.Sprintf("    Units: %d/%d\n", unitCounts[player.PlayerId], unitCap))
+			} else {
+				sb.WriteString(fmt.Sprintf("    Units: %d\n", unitCounts[player.PlayerId]))
+			}
 			if tileCounts[player.PlayerId] > 0 {
 				sb.WriteString(fmt.Sprintf("    Tiles: %d\n", tileCounts[player.PlayerId]))
 			}
+			if target := lib.VictoryPointsToWin(game.Config); target > 0 {
+				sb.WriteString(fmt.Sprintf("    Victory points: %d/%d\n", state.PlayerStates[player.PlayerId].GetVictoryPoints(), target))
+			}
 			if player.TeamId > 0 {
 				sb.WriteString(fmt.Sprintf("    Team: %d\n", player.TeamId))
 			}
@@ -268,6 +299,40 @@ func FormatGameStatus(game *v1.Game, state *v1.GameState) string {
 	return sb.String()
 }
 
+// FormatTurnObligations formats what the current player should do before
+// ending their turn, marking the ones the rules make mandatory
+func FormatTurnObligations(obligations []*v1.TurnObligation) string {
+	if len(obligations) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("\nPending before ending the turn:\n")
+	for _, obligation := range obligations {
+		marker := ""
+		if obligation.Mandatory {
+			marker = " (mandatory)"
+		}
+		sb.WriteString(fmt.Sprintf("  %s%s\n", obligation.Description, marker))
+	}
+	return sb.String()
+}
+
+// obligationsForJSON converts turn obligations for JSON output
+func obligationsForJSON(obligations []*v1.TurnObligation) []map[string]any {
+	out := []map[string]any{}
+	for _, obligation := range obligations {
+		out = append(out, map[string]any{
+			"kind":        obligation.Kind,
+			"unit":        obligation.Unit.Label,
+			"q":           obligation.Unit.Q,
+			"r":           obligation.Unit.R,
+			"description": obligation.Description,
+			"mandatory":   obligation.Mandatory,
+		})
+	}
+	return out
+}
+
 // FormatUnitsWithContext formats all units as text using GameContext
 func FormatUnitsWithContext(gc *GameContext) string {
 	state := gc.State
@@ -323,9 +388,9 @@ func FormatUnitsWithContext(gc *GameContext) string {
 				unitName = fmt.Sprintf("%3d: %s", unit.UnitType, unitDef.Name)
 			}
 
-			sb.WriteString(fmt.Sprintf("  %s: %s at %s (HP: %d, Moves: %.1f)\n",
+			sb.WriteString(fmt.Sprintf("  %s: %s at %s (HP: %d, Moves: %s)\n",
 				unitID, unitName, coord.String(),
-				unit.AvailableHealth, unit.DistanceLeft))
+				unit.AvailableHealth, lib.FormatMovementPoints(unit.DistanceLeft)))
 		}
 		sb.WriteString("\n")
 	}
@@ -333,6 +398,190 @@ func FormatUnitsWithContext(gc *GameContext) string {
 	return sb.String()
 }
 
+// FormatUnitDefinitionList formats unit definitions as one line per unit
+func FormatUnitDefinitionList(units []*v1.UnitDefinition) string {
+	if len(units) == 0 {
+		return "No units defined\n"
+	}
+	var sb strings.Builder
+	for _, unitDef := range units {
+		sb.WriteString(fmt.Sprintf("%3d: %-24s Cost: %4d  Move: %-4g Range: %s\n",
+			unitDef.Id, unitDef.Name, unitDef.Coins, unitDef.MovementPoints, formatAttackRange(unitDef)))
+	}
+	return sb.String()
+}
+
+// FormatRulesAudit formats the rules audit warnings, one per line
+func FormatRulesAudit(warnings []lib.RulesWarning) string {
+	if len(warnings) == 0 {
+		return "No gaps in the unit data\n"
+	}
+	var sb strings.Builder
+	for _, w := range warnings {
+		sb.WriteString(w.String() + "\n")
+	}
+	return sb.String()
+}
+
+// FormatUnitDefinition formats the stats of a single unit definition
+func FormatUnitDefinition(unitDef *v1.UnitDefinition) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Unit %d: %s\n", unitDef.Id, unitDef.Name))
+	if unitDef.Description != "" {
+		sb.WriteString(fmt.Sprintf("  %s\n", unitDef.Description))
+	}
+	sb.WriteString(fmt.Sprintf("Class: %s (%s)\n", unitDef.UnitClass, unitDef.UnitTerrain))
+	sb.WriteString(fmt.Sprintf("Cost: %d\n", unitDef.Coins))
+	sb.WriteString(fmt.Sprintf("Health: %d\n", unitDef.Health))
+	sb.WriteString(fmt.Sprintf("Movement: %g\n", unitDef.MovementPoints))
+	if unitDef.RetreatPoints > 0 {
+		sb.WriteString(fmt.Sprintf("Retreat: %g\n", unitDef.RetreatPoints))
+	}
+	sb.WriteString(fmt.Sprintf("Defense: %d\n", unitDef.Defense))
+	sb.WriteString(fmt.Sprintf("Attack range: %s\n", formatAttackRange(unitDef)))
+	if unitDef.SplashDamage > 0 {
+		sb.WriteString(fmt.Sprintf("Splash damage: %d\n", unitDef.SplashDamage))
+	}
+	if len(unitDef.Properties) > 0 {
+		sb.WriteString(fmt.Sprintf("Properties: %s\n", strings.Join(unitDef.Properties, ", ")))
+	}
+
+	if len(unitDef.AttackVsClass) > 0 {
+		sb.WriteString("Attack vs class:\n")
+		for _, class := range slices.Sorted(maps.Keys(unitDef.AttackVsClass)) {
+			sb.WriteString(fmt.Sprintf("  %-12s %d\n", class, unitDef.AttackVsClass[class]))
+		}
+	}
+
+	if len(unitDef.ActionOrder) > 0 {
+		sb.WriteString("Action order:\n")
+		for i, step := range unitDef.ActionOrder {
+			sb.WriteString(fmt.Sprintf("  %d. %s\n", i+1, step))
+		}
+	}
+	return sb.String()
+}
+
+// formatAttackRange formats a unit's attack range as "max" or "min-max"
+func formatAttackRange(unitDef *v1.UnitDefinition) string {
+	if unitDef.MinAttackRange > 1 {
+		return fmt.Sprintf("%d-%d", unitDef.MinAttackRange, unitDef.AttackRange)
+	}
+	return fmt.Sprintf("%d", unitDef.AttackRange)
+}
+
+// FormatTerrainDefinitionList formats terrain definitions as one line per terrain
+func FormatTerrainDefinitionList(rulesEngine *lib.RulesEngine, terrains []*v1.TerrainDefinition) string {
+	if len(terrains) == 0 {
+		return "No terrains defined\n"
+	}
+	var sb strings.Builder
+	for _, terrainDef := range terrains {
+		line := fmt.Sprintf("%3d: %-18s %s", terrainDef.Id, terrainDef.Name, terrainTypeName(rulesEngine, terrainDef.Id))
+		if len(terrainCapturers(rulesEngine, terrainDef)) > 0 {
+			line = fmt.Sprintf("%-32s capturable", line)
+		}
+		sb.WriteString(line + "\n")
+	}
+	return sb.String()
+}
+
+// FormatTerrainDefinition formats a terrain definition with the movement cost
+// and defense bonus it gives each unit class. Costs that differ between units
+// of the same class are shown as a range.
+func FormatTerrainDefinition(rulesEngine *lib.RulesEngine, terrainDef *v1.TerrainDefinition) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Terrain %d: %s\n", terrainDef.Id, terrainDef.Name))
+	if terrainDef.Description != "" {
+		sb.WriteString(fmt.Sprintf("  %s\n", terrainDef.Description))
+	}
+	sb.WriteString(fmt.Sprintf("Type: %s\n", terrainTypeName(rulesEngine, terrainDef.Id)))
+	if terrainDef.IncomePerTurn > 0 {
+		sb.WriteString(fmt.Sprintf("Income: %d per turn\n", terrainDef.IncomePerTurn))
+	}
+	if terrainDef.VisionBonus > 0 {
+		sb.WriteString(fmt.Sprintf("Vision bonus: +%d sight range\n", terrainDef.VisionBonus))
+	}
+
+	if capturers := terrainCapturers(rulesEngine, terrainDef); len(capturers) > 0 {
+		sb.WriteString(fmt.Sprintf("Capturable: yes (by %s)\n", strings.Join(capturers, ", ")))
+	} else {
+		sb.WriteString("Capturable: no\n")
+	}
+
+	// Group the per-unit properties by unit class, e.g. "Light:Land"
+	moveCosts := map[string][]float64{}
+	defenseBonuses := map[string][]float64{}
+	var unlisted []string
+	for _, unitDef := range sortedUnitDefinitions(rulesEngine) {
+		class := unitDef.UnitClass + ":" + unitDef.UnitTerrain
+		props := terrainDef.UnitProperties[unitDef.Id]
+		if props == nil {
+			continue
+		}
+		moveCosts[class] = append(moveCosts[class], props.MovementCost)
+		defenseBonuses[class] = append(defenseBonuses[class], float64(props.DefenseBonus))
+	}
+	for _, unitDef := range rulesEngine.GetUnits() {
+		class := unitDef.UnitClass + ":" + unitDef.UnitTerrain
+		if _, ok := moveCosts[class]; !ok && !slices.Contains(unlisted, class) {
+			unlisted = append(unlisted, class)
+		}
+	}
+
+	if len(moveCosts) > 0 {
+		sb.WriteString("Movement cost by unit class:\n")
+		for _, class := range slices.Sorted(maps.Keys(moveCosts)) {
+			sb.WriteString(fmt.Sprintf("  %-12s %s\n", class, formatValueRange(moveCosts[class])))
+		}
+	}
+	if len(unlisted) > 0 {
+		slices.Sort(unlisted)
+		sb.WriteString(fmt.Sprintf("No movement rules for: %s\n", strings.Join(unlisted, ", ")))
+	}
+
+	var bonusLines []string
+	for _, class := range slices.Sorted(maps.Keys(defenseBonuses)) {
+		bonuses := defenseBonuses[class]
+		if slices.Min(bonuses) != 0 || slices.Max(bonuses) != 0 {
+			bonusLines = append(bonusLines, fmt.Sprintf("  %-12s %s\n", class, formatValueRange(bonuses)))
+		}
+	}
+	if len(bonusLines) > 0 {
+		sb.WriteString("Defense bonus by unit class:\n")
+		sb.WriteString(strings.Join(bonusLines, ""))
+	}
+	return sb.String()
+}
+
+// terrainTypeName returns the terrain's category, e.g. "city" or "water"
+func terrainTypeName(rulesEngine *lib.RulesEngine, terrainID int32) string {
+	terrainType := rulesEngine.GetTerrainType(terrainID)
+	if terrainType == v1.TerrainType_TERRAIN_TYPE_UNSPECIFIED {
+		return "unknown"
+	}
+	return strings.ToLower(strings.TrimPrefix(terrainType.String(), "TERRAIN_TYPE_"))
+}
+
+// terrainCapturers returns the names of the units that can capture a terrain
+func terrainCapturers(rulesEngine *lib.RulesEngine, terrainDef *v1.TerrainDefinition) (names []string) {
+	for _, unitDef := range sortedUnitDefinitions(rulesEngine) {
+		if props := terrainDef.UnitProperties[unitDef.Id]; props != nil && props.CanCapture {
+			names = append(names, unitDef.Name)
+		}
+	}
+	return
+}
+
+// formatValueRange formats values as a single value, or "min to max" when they differ
+func formatValueRange(values []float64) string {
+	lo, hi := slices.Min(values), slices.Max(values)
+	if lo == hi {
+		return fmt.Sprintf("%g", lo)
+	}
+	return fmt.Sprintf("%g to %g", lo, hi)
+}
+
 // FormatTilesWithContext formats all tiles as text using GameContext
 func FormatTilesWithContext(gc *GameContext) string {
 	state := gc.State
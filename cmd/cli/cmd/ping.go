@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/connectclient"
+)
+
+// pingCmd represents the ping command
+var pingCmd = &cobra.Command{
+	Use:   "ping <position> <attack|defend|danger>",
+	Short: "Mark a hex for your teammates",
+	Long: `Send a ping marking a hex for your teammates in a team game.
+Pings show up briefly on your teammates' boards and are kept in the
+team's recent pings for players who join later. Enemies never see them.
+Players can send a limited number of pings per minute.
+Requires LILBATTLE_SERVER to be set.
+
+Positions can be unit IDs (like A1) or coordinates (like 3,4).
+
+Examples:
+  ww ping 4,-2 defend     Ask teammates to defend hex 4,-2
+  ww ping B3 attack       Call an attack on unit B3`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPing,
+}
+
+func init() {
+	rootCmd.AddCommand(pingCmd)
+}
+
+func runPing(cmd *cobra.Command, args []string) error {
+	posLabel, pingType := args[0], args[1]
+	if !slices.Contains(services.PingTypes, pingType) {
+		return fmt.Errorf("unknown ping type %q (expected %s)", pingType, strings.Join(services.PingTypes, ", "))
+	}
+
+	ctx := context.Background()
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+	if !gc.IsRemote {
+		return fmt.Errorf("LILBATTLE_SERVER is required for pings (e.g., http://localhost:9080)")
+	}
+
+	target, err := gc.RTGame.Pos(posLabel)
+	if err != nil {
+		return fmt.Errorf("invalid position %q: %w", posLabel, err)
+	}
+
+	token := GetTokenForProfile(getProfileName())
+	syncClient := connectclient.NewConnectSyncClientWithAuth(GetAPIEndpoint(getServerURL()), token)
+	resp, err := syncClient.SendPing(ctx, &v1.SendPingRequest{
+		GameId:   gc.GameID,
+		Pos:      target.Position(),
+		PingType: pingType,
+	})
+	if err != nil {
+		return fmt.Errorf("ping failed: %w", err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"game_id":   gc.GameID,
+			"action":    "ping",
+			"q":         resp.Ping.Pos.Q,
+			"r":         resp.Ping.Pos.R,
+			"ping_type": resp.Ping.PingType,
+			"team_id":   resp.Ping.TeamId,
+		})
+	}
+	return formatter.PrintText(fmt.Sprintf("Pinged %s (%d,%d) for team %d: %s",
+		posLabel, resp.Ping.Pos.Q, resp.Ping.Pos.R, resp.Ping.TeamId, resp.Ping.PingType))
+}
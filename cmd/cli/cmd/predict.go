@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// predictCmd represents the predict command
+var predictCmd = &cobra.Command{
+	Use:   "predict <attacker> <target>",
+	Short: "Predict the outcome of an attack",
+	Long: `Predict the outcome of an attack without making it: the expected damage
+and kill chance both ways, and how much the target's terrain reduces the
+damage it takes.
+Positions can be unit IDs (like A1) or coordinates (like 3,4).
+
+Examples:
+  ww predict A1 B2
+  ww predict 3,4 5,6 --json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPredict,
+}
+
+func init() {
+	rootCmd.AddCommand(predictCmd)
+}
+
+func runPredict(cmd *cobra.Command, args []string) error {
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+
+	attacker, attackerTile, err := unitAndTileAt(gc, args[0])
+	if err != nil {
+		return err
+	}
+	defender, defenderTile, err := unitAndTileAt(gc, args[1])
+	if err != nil {
+		return err
+	}
+
+	resp, err := gc.Service.SimulateAttack(context.Background(), &v1.SimulateAttackRequest{
+		AttackerUnitType: attacker.UnitType,
+		AttackerTerrain:  attackerTile.TileType,
+		AttackerHealth:   attacker.AvailableHealth,
+		DefenderUnitType: defender.UnitType,
+		DefenderTerrain:  defenderTile.TileType,
+		DefenderHealth:   defender.AvailableHealth,
+		WoundBonus:       gc.RTGame.RulesEngine.CalculateWoundBonus(defender, lib.UnitGetCoord(attacker)),
+	})
+	if err != nil {
+		return fmt.Errorf("prediction failed: %w", err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"game_id":    gc.GameID,
+			"attacker":   args[0],
+			"target":     args[1],
+			"prediction": resp,
+		})
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s attacking %s\n", args[0], args[1]))
+	sb.WriteString(fmt.Sprintf("Expected damage: %.1f (kill chance %.1f%%)\n",
+		resp.AttackerMeanDamage, resp.AttackerKillProbability*100))
+	sb.WriteString(FormatTerrainDefense(resp.TerrainDefenseApplied) + "\n")
+	sb.WriteString(fmt.Sprintf("Counter-attack: expected damage %.1f (kill chance %.1f%%)\n",
+		resp.DefenderMeanDamage, resp.DefenderKillProbability*100))
+	return formatter.PrintText(sb.String())
+}
+
+// FormatTerrainDefense describes how much the defender's terrain changed the
+// expected damage
+func FormatTerrainDefense(applied float64) string {
+	percent := math.Round(math.Abs(applied) * 100)
+	switch {
+	case percent == 0:
+		return "No change due to terrain"
+	case applied > 0:
+		return fmt.Sprintf("Reduced by %.0f%% due to terrain", percent)
+	default:
+		return fmt.Sprintf("Increased by %.0f%% due to terrain", percent)
+	}
+}
+
+// unitAndTileAt returns the unit at a position and the tile it stands on
+func unitAndTileAt(gc *GameContext, label string) (*v1.Unit, *v1.Tile, error) {
+	target, err := gc.RTGame.Pos(label)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid position %q: %w", label, err)
+	}
+	unit := gc.RTGame.World.UnitAt(target.Coordinate)
+	if unit == nil {
+		return nil, nil, fmt.Errorf("no unit at %s", label)
+	}
+	tile := gc.RTGame.World.TileAt(target.Coordinate)
+	if tile == nil {
+		return nil, nil, fmt.Errorf("no tile at %s", label)
+	}
+	return unit, tile, nil
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import "testing"
+
+func TestFormatTerrainDefense(t *testing.T) {
+	tests := []struct {
+		applied float64
+		want    string
+	}{
+		{0.4, "Reduced by 40% due to terrain"},
+		{0.001, "No change due to terrain"},
+		{-0.15, "Increased by 15% due to terrain"},
+	}
+	for _, tt := range tests {
+		if got := FormatTerrainDefense(tt.applied); got != tt.want {
+			t.Errorf("FormatTerrainDefense(%v) = %q, want %q", tt.applied, got, tt.want)
+		}
+	}
+}
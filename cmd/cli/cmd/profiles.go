@@ -62,6 +62,7 @@ func FromServerCredential(cred *client.ServerCredential) *ProfileCredentials {
 // GlobalConfig stores global CLI configuration
 type GlobalConfig struct {
 	CurrentProfile string `json:"current_profile,omitempty"`
+	Coach          bool   `json:"coach,omitempty"` // Coach mode (see ww coach)
 }
 
 // ProfileStore manages profile storage
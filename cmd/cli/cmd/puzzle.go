@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/connectclient"
+)
+
+// puzzleCmd groups puzzle commands
+var puzzleCmd = &cobra.Command{
+	Use:   "puzzle",
+	Short: "Play puzzles",
+}
+
+// puzzleListCmd represents the puzzle list command
+var puzzleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the puzzles",
+	Args:  cobra.NoArgs,
+	RunE:  runPuzzleList,
+}
+
+// puzzlePlayCmd represents the puzzle play command
+var puzzlePlayCmd = &cobra.Command{
+	Use:   "play <puzzle_id>",
+	Short: "Start an attempt at a puzzle",
+	Long: `Start a new game from a puzzle's position with you as the solver. Reach the
+puzzle's goal within its turn budget to solve it; the opponent's turns are
+played for it as soon as you end yours.
+Requires LILBATTLE_SERVER to be set.
+
+Examples:
+  ww puzzle play 3f2a91c0
+  export LILBATTLE_GAME_ID=<game_id>   Then play it with ww attack, ww move, ...`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPuzzlePlay,
+}
+
+// puzzleAttemptsCmd represents the puzzle attempts command
+var puzzleAttemptsCmd = &cobra.Command{
+	Use:   "attempts [puzzle_id]",
+	Short: "Show your puzzle history",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runPuzzleAttempts,
+}
+
+func init() {
+	rootCmd.AddCommand(puzzleCmd)
+	puzzleCmd.AddCommand(puzzleListCmd)
+	puzzleCmd.AddCommand(puzzlePlayCmd)
+	puzzleCmd.AddCommand(puzzleAttemptsCmd)
+}
+
+// puzzlesClient returns a PuzzlesService client for the current profile
+func puzzlesClient() (*connectclient.ConnectPuzzlesClient, error) {
+	serverURL := getServerURL()
+	if serverURL == "" {
+		return nil, fmt.Errorf("LILBATTLE_SERVER is required for puzzles (e.g., http://localhost:9080)")
+	}
+	token := GetTokenForProfile(getProfileName())
+	return connectclient.NewConnectPuzzlesClientWithAuth(GetAPIEndpoint(serverURL), token), nil
+}
+
+func runPuzzleList(cmd *cobra.Command, args []string) error {
+	client, err := puzzlesClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.ListPuzzles(context.Background(), &v1.ListPuzzlesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list puzzles: %w", err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		var puzzles []map[string]any
+		for _, puzzle := range resp.Items {
+			puzzles = append(puzzles, map[string]any{
+				"id":          puzzle.Id,
+				"name":        puzzle.Name,
+				"difficulty":  puzzle.Difficulty,
+				"goal":        puzzle.Settings.GetGoal(),
+				"turn_budget": puzzle.Settings.GetTurnBudget(),
+			})
+		}
+		return formatter.PrintJSON(map[string]any{"puzzles": puzzles})
+	}
+
+	var sb strings.Builder
+	if len(resp.Items) == 0 {
+		sb.WriteString("No puzzles\n")
+	}
+	for _, puzzle := range resp.Items {
+		sb.WriteString(fmt.Sprintf("%s  %s", puzzle.Id, puzzle.Name))
+		if puzzle.Difficulty != "" {
+			sb.WriteString(fmt.Sprintf(" (%s)", puzzle.Difficulty))
+		}
+		sb.WriteString(fmt.Sprintf("\n    %s\n", formatPuzzleGoal(puzzle.Settings)))
+	}
+	return formatter.PrintText(sb.String())
+}
+
+func runPuzzlePlay(cmd *cobra.Command, args []string) error {
+	client, err := puzzlesClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.PlayPuzzle(context.Background(), &v1.PlayPuzzleRequest{PuzzleId: args[0]})
+	if err != nil {
+		return fmt.Errorf("failed to start puzzle: %w", err)
+	}
+	puzzle := resp.Game.Config.Settings.Puzzle
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"game_id":       resp.Game.Id,
+			"puzzle_id":     resp.Attempt.PuzzleId,
+			"goal":          puzzle.Goal,
+			"turn_budget":   puzzle.TurnBudget,
+			"solver_player": lib.PuzzleSolver(puzzle),
+			"result":        resp.GameState.PuzzleResult.String(),
+		})
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Started puzzle %s in game %s\n", resp.Attempt.PuzzleId, resp.Game.Id))
+	sb.WriteString(fmt.Sprintf("  You play as player %d\n", lib.PuzzleSolver(puzzle)))
+	sb.WriteString(FormatPuzzleStatus(puzzle, resp.GameState))
+	sb.WriteString(fmt.Sprintf("\nTo play: export LILBATTLE_GAME_ID=%s\n", resp.Game.Id))
+	return formatter.PrintText(sb.String())
+}
+
+func runPuzzleAttempts(cmd *cobra.Command, args []string) error {
+	client, err := puzzlesClient()
+	if err != nil {
+		return err
+	}
+	req := &v1.ListPuzzleAttemptsRequest{}
+	if len(args) > 0 {
+		req.PuzzleId = args[0]
+	}
+	resp, err := client.ListPuzzleAttempts(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to list puzzle attempts: %w", err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		var attempts []map[string]any
+		for _, attempt := range resp.Items {
+			attempts = append(attempts, map[string]any{
+				"puzzle_id":  attempt.PuzzleId,
+				"game_id":    attempt.GameId,
+				"result":     attempt.Result.String(),
+				"started_at": attempt.StartedAt.AsTime(),
+			})
+		}
+		return formatter.PrintJSON(map[string]any{"attempts": attempts})
+	}
+
+	var sb strings.Builder
+	if len(resp.Items) == 0 {
+		sb.WriteString("No attempts\n")
+	}
+	for _, attempt := range resp.Items {
+		result := "in progress"
+		switch attempt.Result {
+		case v1.PuzzleResult_PUZZLE_RESULT_SOLVED:
+			result = "solved"
+		case v1.PuzzleResult_PUZZLE_RESULT_FAILED:
+			result = "failed"
+		}
+		sb.WriteString(fmt.Sprintf("%s  puzzle %s, game %s: %s\n",
+			attempt.StartedAt.AsTime().Format("2006-01-02 15:04"), attempt.PuzzleId, attempt.GameId, result))
+	}
+	return formatter.PrintText(sb.String())
+}
+
+// formatPuzzleGoal describes a puzzle's goal and budget in one line
+func formatPuzzleGoal(puzzle *v1.PuzzleSettings) string {
+	return fmt.Sprintf("Goal: %s within %d turn(s)", puzzle.GetGoal(), puzzle.GetTurnBudget())
+}
+
+// FormatPuzzleStatus describes a puzzle game's goal and how it is going
+func FormatPuzzleStatus(puzzle *v1.PuzzleSettings, state *v1.GameState) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("\nPuzzle goal: %s\n", puzzle.Goal))
+	switch state.PuzzleResult {
+	case v1.PuzzleResult_PUZZLE_RESULT_SOLVED:
+		sb.WriteString("Puzzle solved!\n")
+	case v1.PuzzleResult_PUZZLE_RESULT_FAILED:
+		sb.WriteString("Puzzle failed\n")
+	default:
+		sb.WriteString(fmt.Sprintf("Turns left: %d of %d\n", lib.PuzzleTurnsLeft(puzzle, state), puzzle.TurnBudget))
+	}
+	return sb.String()
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/turnforge/lilbattle/lib"
 )
 
 var (
@@ -18,6 +19,8 @@ var (
 	verbose     bool
 	dryrun      bool
 	confirm     bool
+	rulesFile   string
+	damageFile  string
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -41,7 +44,10 @@ Global Flags:
   --profile string       Profile to use for authentication
   --json                 Output in JSON format
   --verbose              Show detailed debug information
-  --dryrun               Preview changes without saving to disk`,
+  --dryrun               Preview changes without saving to disk
+  --rules string         Rules JSON file to use instead of the built-in rules
+  --damage string        Damage JSON file to use with --rules
+  --thumbnail            Embed a PNG preview of the board in locally saved games`,
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -60,7 +66,11 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&jsonOut, "json", false, "output in JSON format")
 	rootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "show detailed debug information")
 	rootCmd.PersistentFlags().BoolVar(&dryrun, "dryrun", false, "preview changes without saving to disk")
+	rootCmd.PersistentFlags().Bool("debug-resolve", false, "show how the server resolved each move's positions and units")
 	rootCmd.PersistentFlags().BoolVar(&confirm, "confirm", true, "prompt for confirmation on destructive actions")
+	rootCmd.PersistentFlags().StringVar(&rulesFile, "rules", "", "rules JSON file to use instead of the built-in rules (env: LILBATTLE_RULES)")
+	rootCmd.PersistentFlags().StringVar(&damageFile, "damage", "", "damage JSON file to use with --rules (env: LILBATTLE_DAMAGE)")
+	rootCmd.PersistentFlags().Bool("thumbnail", false, "embed a small PNG preview of the board in locally saved games (env: LILBATTLE_THUMBNAIL)")
 
 	// Bind flags to viper
 	viper.BindPFlag("game-id", rootCmd.PersistentFlags().Lookup("game-id"))
@@ -69,7 +79,11 @@ func init() {
 	viper.BindPFlag("json", rootCmd.PersistentFlags().Lookup("json"))
 	viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
 	viper.BindPFlag("dryrun", rootCmd.PersistentFlags().Lookup("dryrun"))
+	viper.BindPFlag("debug-resolve", rootCmd.PersistentFlags().Lookup("debug-resolve"))
 	viper.BindPFlag("confirm", rootCmd.PersistentFlags().Lookup("confirm"))
+	viper.BindPFlag("rules", rootCmd.PersistentFlags().Lookup("rules"))
+	viper.BindPFlag("damage", rootCmd.PersistentFlags().Lookup("damage"))
+	viper.BindPFlag("thumbnail", rootCmd.PersistentFlags().Lookup("thumbnail"))
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -136,11 +150,30 @@ func isDryrun() bool {
 	return viper.GetBool("dryrun")
 }
 
+// isDebugResolve returns whether moves should report how they were resolved
+func isDebugResolve() bool {
+	return viper.GetBool("debug-resolve")
+}
+
 // shouldConfirm returns whether confirmation prompts should be shown
 func shouldConfirm() bool {
 	return viper.GetBool("confirm")
 }
 
+// getRulesEngine returns the rules engine loaded from --rules/--damage,
+// or the built-in rules when no rules file is given
+func getRulesEngine() (*lib.RulesEngine, error) {
+	rules := viper.GetString("rules")
+	if rules == "" {
+		return lib.DefaultRulesEngine(), nil
+	}
+	re, err := lib.LoadRulesEngineFromFile(rules, viper.GetString("damage"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rules: %w", err)
+	}
+	return re, nil
+}
+
 // getServerURL returns the server URL if configured, empty string for local mode
 // Precedence: --server flag > LILBATTLE_SERVER env > profile host
 func getServerURL() string {
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	strictAudit  bool
+	exportFormat string
+	exportWhat   string
+)
+
+// rulesCmd groups commands that inspect the rules data
+var rulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "Inspect the rules data",
+}
+
+// rulesAuditCmd reports gaps in the unit data
+var rulesAuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Report units missing data the engine relies on",
+	Long: `Audit every unit in the rules for an action order, a class and terrain
+classification, movement costs on at least one terrain and a max health.
+Gaps the rules file explains under "auditAnnotations" are listed as
+annotated. Does not need a game.
+
+With --strict the command fails when any gap is not annotated, for CI.
+
+Examples:
+  ww rules audit
+  ww rules audit --rules custom-rules.json --strict`,
+	Args: cobra.NoArgs,
+	RunE: runRulesAudit,
+}
+
+// rulesExportCmd prints stat tables generated from the rules
+var rulesExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export unit and terrain stat tables as Markdown or CSV",
+	Long: `Export a stat table generated from the active rules:
+
+  units     stats of every unit
+  terrains  terrain effects for each unit class (class and terrain, eg
+            "Light Land"), as the range over the class's units
+  movement  movement cost of every unit on every terrain
+  damage    expected damage of every unit against every other, with the
+            min–max damage
+
+Rows and columns are in ID order, so diffing two exports shows exactly what
+a balance change did. Does not need a game.
+
+Examples:
+  ww rules export --what units > units.md
+  ww rules export --format csv --what damage
+  ww rules export --rules custom-rules.json --what movement`,
+	Args: cobra.NoArgs,
+	RunE: runRulesExport,
+}
+
+func init() {
+	rootCmd.AddCommand(rulesCmd)
+	rulesCmd.AddCommand(rulesAuditCmd)
+	rulesCmd.AddCommand(rulesExportCmd)
+	rulesAuditCmd.Flags().BoolVar(&strictAudit, "strict", false, "fail if any gap is not annotated in the rules file")
+	rulesExportCmd.Flags().StringVar(&exportFormat, "format", "md", "output format: md or csv")
+	rulesExportCmd.Flags().StringVar(&exportWhat, "what", "units", "table to export: units, terrains, movement or damage")
+}
+
+func runRulesAudit(cmd *cobra.Command, args []string) error {
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return err
+	}
+	warnings := rulesEngine.GetRulesAudit()
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		err = formatter.PrintJSON(map[string]any{"warnings": warnings})
+	} else {
+		err = formatter.PrintText(FormatRulesAudit(warnings))
+	}
+	if err != nil || !strictAudit {
+		return err
+	}
+	return rulesEngine.CheckRulesAudit()
+}
+
+func runRulesExport(cmd *cobra.Command, args []string) error {
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return err
+	}
+	table, err := rulesEngine.ExportTable(exportWhat)
+	if err != nil {
+		return err
+	}
+	switch exportFormat {
+	case "md":
+		return table.WriteMarkdown(cmd.OutOrStdout())
+	case "csv":
+		return table.WriteCSV(cmd.OutOrStdout())
+	}
+	return fmt.Errorf("unknown format %q, expected md or csv", exportFormat)
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const customRulesJSON = `{
+  "terrainTypes": {"5": "nature"},
+  "terrains": {"5": {"id": 5, "name": "Grass"}},
+  "units": {"1": {"id": 1, "name": "Custom Soldier", "health": 10, "coins": 42, "movement_points": 7}},
+  "terrainUnitProperties": {"5:1": {"terrain_id": 5, "unit_id": 1, "movement_cost": 1}}
+}`
+
+func TestRulesFlagLoadsCustomRules(t *testing.T) {
+	rulesPath := filepath.Join(t.TempDir(), "custom-rules.json")
+	if err := os.WriteFile(rulesPath, []byte(customRulesJSON), 0644); err != nil {
+		t.Fatalf("failed to write rules file: %v", err)
+	}
+
+	flags := rootCmd.PersistentFlags()
+	if err := flags.Set("rules", rulesPath); err != nil {
+		t.Fatalf("failed to set --rules: %v", err)
+	}
+	defer flags.Set("rules", "")
+
+	re, err := getRulesEngine()
+	if err != nil {
+		t.Fatalf("getRulesEngine() error: %v", err)
+	}
+	unit, err := re.GetUnitData(1)
+	if err != nil {
+		t.Fatalf("GetUnitData(1) error: %v", err)
+	}
+	if unit.Name != "Custom Soldier" || unit.Coins != 42 || unit.MovementPoints != 7 {
+		t.Errorf("unit 1 = %q coins=%d movement=%v, want custom rules values", unit.Name, unit.Coins, unit.MovementPoints)
+	}
+}
+
+func TestRulesFlagMissingFile(t *testing.T) {
+	flags := rootCmd.PersistentFlags()
+	flags.Set("rules", filepath.Join(t.TempDir(), "missing.json"))
+	defer flags.Set("rules", "")
+
+	if _, err := getRulesEngine(); err == nil {
+		t.Error("getRulesEngine() should fail for a missing rules file")
+	}
+}
+
+func TestRulesAuditStrict(t *testing.T) {
+	rulesPath := filepath.Join(t.TempDir(), "custom-rules.json")
+	if err := os.WriteFile(rulesPath, []byte(customRulesJSON), 0644); err != nil {
+		t.Fatalf("failed to write rules file: %v", err)
+	}
+	flags := rootCmd.PersistentFlags()
+	flags.Set("rules", rulesPath)
+	defer flags.Set("rules", "")
+
+	// The custom soldier has no action order, class or terrain classification
+	if err := runRulesAudit(rulesAuditCmd, nil); err != nil {
+		t.Errorf("audit without --strict failed: %v", err)
+	}
+	strictAudit = true
+	defer func() { strictAudit = false }()
+	if err := runRulesAudit(rulesAuditCmd, nil); err == nil {
+		t.Error("strict audit passed custom rules with unannotated gaps")
+	}
+}
+
+func TestRulesExport(t *testing.T) {
+	var out bytes.Buffer
+	rulesExportCmd.SetOut(&out)
+	defer rulesExportCmd.SetOut(nil)
+	exportFormat, exportWhat = "csv", "movement"
+	defer func() { exportFormat, exportWhat = "md", "units" }()
+
+	if err := runRulesExport(rulesExportCmd, nil); err != nil {
+		t.Fatalf("rules export failed: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "Unit ID,Unit,Land Base,") {
+		t.Errorf("movement CSV starts %q, want a header of terrains", out.String()[:min(out.Len(), 40)])
+	}
+
+	exportFormat = "html"
+	if err := runRulesExport(rulesExportCmd, nil); err == nil {
+		t.Error("exporting as html succeeded")
+	}
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+var sandboxSpawnPlayer int32
+
+// sandboxCmd groups sandbox commands
+var sandboxCmd = &cobra.Command{
+	Use:   "sandbox",
+	Short: "Spawn and delete units in a sandbox game",
+	Long: `Place and remove units at will in a sandbox game, where you control every
+seat. Create a sandbox with ww new <world_id> --sandbox. These moves are
+rejected in regular games.`,
+}
+
+// sandboxSpawnCmd represents the sandbox spawn command
+var sandboxSpawnCmd = &cobra.Command{
+	Use:   "spawn <unit_type> <position>",
+	Short: "Place a unit on an empty tile",
+	Long: `Place a unit of any type for any player on an empty tile. The unit is ready
+to act straight away and costs nothing.
+
+Positions are coordinates (like 3,4).
+Unit types can be numeric IDs or unit names.
+
+Examples:
+  ww sandbox spawn tank 3,4 --player 2   Spawn a tank for player 2
+  ww sandbox spawn 1 0,2                 Spawn unit type 1 for the current player`,
+	Args: cobra.ExactArgs(2),
+	RunE: runSandboxSpawn,
+}
+
+// sandboxDeleteCmd represents the sandbox delete command
+var sandboxDeleteCmd = &cobra.Command{
+	Use:   "delete <position>",
+	Short: "Remove a unit from the board",
+	Long: `Remove any player's unit from the board.
+
+Positions can be unit IDs (like A1) or coordinates (like 3,4).
+
+Examples:
+  ww sandbox delete B1
+  ww sandbox delete 3,4 --dryrun   Preview without saving`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSandboxDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(sandboxCmd)
+	sandboxCmd.AddCommand(sandboxSpawnCmd)
+	sandboxCmd.AddCommand(sandboxDeleteCmd)
+	sandboxSpawnCmd.Flags().Int32Var(&sandboxSpawnPlayer, "player", 0, "player the unit belongs to (defaults to the current player)")
+}
+
+func runSandboxSpawn(cmd *cobra.Command, args []string) error {
+	position := args[1]
+
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+
+	unitType, err := parseUnitType(gc, args[0])
+	if err != nil {
+		return err
+	}
+
+	if isVerbose() {
+		fmt.Printf("[VERBOSE] Attempting to spawn unit type %d at %s\n", unitType, position)
+	}
+
+	return runSandboxMove(gc, "spawn", position, &v1.GameMove{
+		MoveType: &v1.GameMove_SpawnUnit{
+			SpawnUnit: &v1.SpawnUnitAction{
+				Pos:      &v1.Position{Label: position},
+				UnitType: unitType,
+				Player:   sandboxSpawnPlayer,
+			},
+		},
+	})
+}
+
+func runSandboxDelete(cmd *cobra.Command, args []string) error {
+	position := args[0]
+
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+
+	if isVerbose() {
+		fmt.Printf("[VERBOSE] Attempting to delete the unit at %s\n", position)
+	}
+
+	return runSandboxMove(gc, "delete", position, &v1.GameMove{
+		MoveType: &v1.GameMove_DeleteUnit{
+			DeleteUnit: &v1.DeleteUnitAction{Pos: &v1.Position{Label: position}},
+		},
+	})
+}
+
+// runSandboxMove makes a sandbox move as the current player and prints its
+// changes
+func runSandboxMove(gc *GameContext, action, position string, move *v1.GameMove) error {
+	move.Player = gc.State.CurrentPlayer
+	resp, err := gc.Service.ProcessMoves(context.Background(), &v1.ProcessMovesRequest{
+		GameId:       gc.GameID,
+		DryRun:       isDryrun(),
+		DebugResolve: isDebugResolve(),
+		Moves:        []*v1.GameMove{move},
+	})
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", action, err)
+	}
+
+	// Format output
+	formatter := NewOutputFormatter()
+
+	if formatter.JSON {
+		data := map[string]any{
+			"game_id":  gc.GameID,
+			"action":   action,
+			"position": position,
+			"dryrun":   isDryrun(),
+			"success":  true,
+			"changes":  formatChangesForJSON(resp.Moves),
+		}
+		return formatter.PrintJSON(data)
+	}
+
+	// Text output
+	var sb strings.Builder
+	title := strings.ToUpper(action[:1]) + action[1:]
+	if isDryrun() {
+		sb.WriteString(fmt.Sprintf("%s (dryrun): Would succeed\n", title))
+	} else {
+		sb.WriteString(fmt.Sprintf("%s: Success\n", title))
+	}
+
+	if len(resp.Moves) > 0 && len(resp.Moves[0].Changes) > 0 {
+		for _, change := range resp.Moves[0].Changes {
+			sb.WriteString(fmt.Sprintf("  %s\n", formatChange(change)))
+		}
+	}
+
+	sb.WriteString(formatMoveResolutions(resp.Resolutions))
+	return formatter.PrintText(sb.String())
+}
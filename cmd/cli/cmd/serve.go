@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	goal "github.com/panyam/goapplib"
+	"github.com/turnforge/lilbattle/services/server"
+	web "github.com/turnforge/lilbattle/web/server"
+)
+
+var (
+	serveLocal       bool
+	serveGrpcAddress string
+	serveWebAddress  string
+	serveDataDir     string
+	serveWebRoot     string
+	serveSeed        bool
+)
+
+// serveCmd represents the serve command
+var serveCmd = &cobra.Command{
+	Use:   "serve --local",
+	Short: "Run the whole LilBattle stack locally",
+	Long: `Run the backend and web app in one process for local development.
+Everything is stored on the file system under --data-dir (a fresh temp
+directory by default), so no database or .env file is needed. A couple of
+demo worlds and a demo game are created on startup.
+
+The web UI and the prebuilt WASM client are served from the web directory
+under --web-root, so run this from the repo root or point --web-root at it.
+Build the WASM client first with 'make wasm'.
+
+Sign in to the web UI as test@gmail.com to play the demo game.
+
+Examples:
+  ww serve --local                        Start with a temp data directory
+  ww serve --local --data-dir ./devdata   Keep data between runs
+  ww serve --local --web-port :8081       Serve the web UI on another port`,
+	Args: cobra.NoArgs,
+	RunE: runServe,
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().BoolVar(&serveLocal, "local", false, "run the all-in-one local stack")
+	serveCmd.Flags().StringVar(&serveGrpcAddress, "grpc-port", "localhost:9090", "address for the gRPC backend")
+	serveCmd.Flags().StringVar(&serveWebAddress, "web-port", "localhost:8080", "address for the web UI")
+	serveCmd.Flags().StringVar(&serveDataDir, "data-dir", "", "directory to store worlds, games and files in (default: a new temp directory)")
+	serveCmd.Flags().StringVar(&serveWebRoot, "web-root", ".", "directory containing the web/ templates and static assets")
+	serveCmd.Flags().BoolVar(&serveSeed, "seed", true, "create demo worlds and a demo game on startup")
+}
+
+// localServe is a running local stack
+type localServe struct {
+	backend *server.LocalBackend
+	seed    *server.DemoSeed
+	webErr  chan error
+	webStop chan bool
+}
+
+// Stop shuts down the web app and the backend
+func (l *localServe) Stop() {
+	close(l.webStop)
+	l.backend.Stop()
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	if !serveLocal {
+		return fmt.Errorf("only --local is supported; deployments run the backend binary")
+	}
+	if serveWebRoot != "" && serveWebRoot != "." {
+		if err := os.Chdir(serveWebRoot); err != nil {
+			return fmt.Errorf("invalid --web-root: %w", err)
+		}
+	}
+	if _, err := os.Stat(web.TEMPLATES_FOLDER); err != nil {
+		return fmt.Errorf("web templates not found at %s, run from the repo root or set --web-root", web.TEMPLATES_FOLDER)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	stack, err := startLocalServe(ctx, serveGrpcAddress, serveWebAddress, serveDataDir, serveSeed)
+	if err != nil {
+		return err
+	}
+	defer stack.Stop()
+
+	fmt.Print(stack.Summary(serveWebAddress))
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-stack.webErr:
+		return fmt.Errorf("web server stopped: %w", err)
+	}
+}
+
+// startLocalServe starts the backend and web app, storing data in dataDir
+// or a new temp directory, and seeds the demo worlds and game if asked to
+func startLocalServe(ctx context.Context, grpcAddress, webAddress, dataDir string, seed bool) (*localServe, error) {
+	if dataDir == "" {
+		var err error
+		if dataDir, err = os.MkdirTemp("", "lilbattle-local-"); err != nil {
+			return nil, fmt.Errorf("failed to create data directory: %w", err)
+		}
+	}
+
+	// The web app keeps users and sessions separately from the backend
+	if os.Getenv("LILBATTLE_USER_STORAGE_PATH") == "" {
+		os.Setenv("LILBATTLE_USER_STORAGE_PATH", filepath.Join(dataDir, "users"))
+	}
+	os.Setenv("ENABLE_TEST_AUTH", "true")
+
+	backend, err := server.StartLocalBackend(ctx, grpcAddress, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	stack := &localServe{backend: backend, webErr: make(chan error, 1), webStop: make(chan bool)}
+
+	if seed {
+		if stack.seed, err = backend.SeedDemo(ctx); err != nil {
+			backend.Stop()
+			return nil, err
+		}
+	}
+
+	webApp := &web.WebAppServer{
+		WebAppServer: goal.WebAppServer{
+			GrpcAddress:   grpcAddress,
+			Address:       webAddress,
+			AllowLocalDev: true,
+		},
+	}
+	go webApp.Start(ctx, stack.webErr, stack.webStop)
+	return stack, nil
+}
+
+// Summary describes where everything is running
+func (l *localServe) Summary(webAddress string) string {
+	webURL := "http://" + webAddress
+	if strings.HasPrefix(webAddress, ":") {
+		webURL = "http://localhost" + webAddress
+	}
+
+	var sb strings.Builder
+	sb.WriteString("LilBattle is running locally\n")
+	sb.WriteString(fmt.Sprintf("  Web UI:  %s\n", webURL))
+	sb.WriteString(fmt.Sprintf("  gRPC:    %s\n", l.backend.GrpcAddress))
+	sb.WriteString(fmt.Sprintf("  Data:    %s\n", l.backend.DataDir))
+	if _, err := os.Stat(filepath.Join(web.STATIC_FOLDER, "wasm", "lilbattle-cli.wasm")); err != nil {
+		sb.WriteString("  WASM:    not built, run 'make wasm' for the in-browser game engine\n")
+	}
+	if l.seed != nil {
+		for _, worldId := range l.seed.WorldIds {
+			sb.WriteString(fmt.Sprintf("  World:   %s/worlds/%s/view\n", webURL, worldId))
+		}
+		sb.WriteString(fmt.Sprintf("  Game:    %s/games/%s/view\n", webURL, l.seed.GameId))
+	}
+	sb.WriteString("Sign in as test@gmail.com to play. Press Ctrl+C to stop.\n")
+	return sb.String()
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/server"
+)
+
+// freeAddress returns a localhost address nothing is listening on
+func freeAddress(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func TestServeLocal_PlaysMoveOverGrpc(t *testing.T) {
+	// The web app loads its templates relative to the repo root
+	t.Chdir("../../..")
+
+	ctx := context.Background()
+	grpcAddress, webAddress := freeAddress(t), freeAddress(t)
+	stack, err := startLocalServe(ctx, grpcAddress, webAddress, t.TempDir(), true)
+	if err != nil {
+		t.Fatalf("startLocalServe failed: %v", err)
+	}
+	defer stack.Stop()
+
+	if len(stack.seed.WorldIds) != 2 || stack.seed.GameId == "" {
+		t.Fatalf("unexpected demo seed: %+v", stack.seed)
+	}
+	summary := stack.Summary(webAddress)
+	for _, want := range []string{"http://" + webAddress, grpcAddress, "/games/" + stack.seed.GameId + "/view"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("summary missing %q:\n%s", want, summary)
+		}
+	}
+
+	// Move player 1's soldier next to their base one hex east
+	games := stack.backend.ClientMgr.GetGamesSvcClient()
+	moveCtx := server.LocalContext(ctx)
+	_, err = games.ProcessMoves(moveCtx, &v1.ProcessMovesRequest{
+		GameId: stack.seed.GameId,
+		Moves: []*v1.GameMove{{
+			MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Q: 0, R: 1},
+				To:   &v1.Position{Q: 1, R: 1},
+			}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+
+	resp, err := games.GetGame(moveCtx, &v1.GetGameRequest{Id: stack.seed.GameId})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	units := resp.State.WorldData.UnitsMap
+	if units["1,1"] == nil || units["0,1"] != nil {
+		t.Errorf("soldier did not move from 0,1 to 1,1: %v", units)
+	}
+
+	// The web UI comes up alongside the backend, serving the static assets
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		httpResp, err := http.Get("http://" + webAddress + "/static/assets/themes/default/Tiles/5/0.png")
+		if err == nil {
+			httpResp.Body.Close()
+			if httpResp.StatusCode != http.StatusOK {
+				t.Errorf("web UI returned %d", httpResp.StatusCode)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("web UI never came up: %v", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
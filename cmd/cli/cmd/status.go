@@ -11,7 +11,7 @@ var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show current game status",
 	Long: `Display the current game state including turn number, current player,
-and game status.
+game status and the current player's pending obligations.
 
 Examples:
   ww status
@@ -36,6 +36,8 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("game metadata not initialized")
 	}
 
+	obligations := gc.RTGame.MandatoryActions(gc.State.CurrentPlayer)
+
 	// Format output
 	formatter := NewOutputFormatter()
 
@@ -93,11 +95,13 @@ func runStatus(cmd *cobra.Command, args []string) error {
 			"status":         gc.State.Status.String(),
 			"winning_player": gc.State.WinningPlayer,
 			"players":        players,
+			"obligations":    obligationsForJSON(obligations),
+			"max_units":      gc.RTGame.MaxUnitsPerPlayer(),
 		}
 		return formatter.PrintJSON(data)
 	}
 
 	// Text output
-	text := FormatGameStatus(gc.Game, gc.State)
+	text := FormatGameStatus(gc.Game, gc.State, gc.RTGame.MaxUnitsPerPlayer()) + FormatTurnObligations(obligations)
 	return formatter.PrintText(text)
 }
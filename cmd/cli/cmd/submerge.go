@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+var surface bool
+
+// submergeCmd represents the submerge command
+var submergeCmd = &cobra.Command{
+	Use:   "submerge <unit>",
+	Short: "Submerge or surface a Stealth-class unit",
+	Long: `Submerge a Stealth-class unit so it is hidden from enemies that are not
+adjacent to it, or surface it again with --surface.
+Submerged units cannot attack. The game must have stealth enabled.
+
+Positions can be unit IDs (like A1) or coordinates (like 3,4).
+
+Examples:
+  ww submerge A1              Submerge unit A1
+  ww submerge A1 --surface    Surface unit A1
+  ww submerge 3,4 --dryrun    Preview without saving`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSubmerge,
+}
+
+func init() {
+	rootCmd.AddCommand(submergeCmd)
+	submergeCmd.Flags().BoolVar(&surface, "surface", false, "surface the unit instead of submerging it")
+}
+
+func runSubmerge(cmd *cobra.Command, args []string) error {
+	unitLabel := args[0]
+	action, title := "submerge", "Submerge"
+	if surface {
+		action, title = "surface", "Surface"
+	}
+
+	ctx := context.Background()
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+
+	if isVerbose() {
+		fmt.Printf("[VERBOSE] Attempting %s at %s\n", action, unitLabel)
+	}
+
+	resp, err := gc.Service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+		GameId:       gc.GameID,
+		DryRun:       isDryrun(),
+		DebugResolve: isDebugResolve(),
+		Moves: []*v1.GameMove{{
+			Player: gc.State.CurrentPlayer,
+			MoveType: &v1.GameMove_SubmergeUnit{
+				SubmergeUnit: &v1.SubmergeUnitAction{
+					Pos:      &v1.Position{Label: unitLabel},
+					Submerge: !surface,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", action, err)
+	}
+
+	// Format output
+	formatter := NewOutputFormatter()
+
+	if formatter.JSON {
+		data := map[string]any{
+			"game_id": gc.GameID,
+			"action":  action,
+			"unit":    unitLabel,
+			"dryrun":  isDryrun(),
+			"success": true,
+			"changes": formatChangesForJSON(resp.Moves),
+		}
+		return formatter.PrintJSON(data)
+	}
+
+	// Text output
+	var sb strings.Builder
+	if isDryrun() {
+		sb.WriteString(fmt.Sprintf("%s (dryrun): Would succeed\n", title))
+	} else {
+		sb.WriteString(fmt.Sprintf("%s: Success\n", title))
+	}
+
+	if len(resp.Moves) > 0 && len(resp.Moves[0].Changes) > 0 {
+		for _, change := range resp.Moves[0].Changes {
+			sb.WriteString(fmt.Sprintf("  %s\n", formatChange(change)))
+		}
+	}
+
+	sb.WriteString(formatMoveResolutions(resp.Resolutions))
+	return formatter.PrintText(sb.String())
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// terrainsCmd groups the terrain definition commands
+var terrainsCmd = &cobra.Command{
+	Use:   "terrains",
+	Short: "Browse terrain definitions in the rules",
+	Long: `List and inspect the terrain types defined in the rules. These commands
+do not need a game.
+
+Examples:
+  ww terrains list
+  ww terrains show grass`,
+}
+
+// terrainsListCmd lists the terrain definitions in the rules
+var terrainsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all terrain definitions in the rules",
+	Long: `List every terrain type defined in the rules with its category and
+whether it can be captured.
+
+Examples:
+  ww terrains list
+  ww terrains list --json`,
+	Args: cobra.NoArgs,
+	RunE: runTerrainsList,
+}
+
+// terrainsShowCmd shows how a terrain affects each class of unit
+var terrainsShowCmd = &cobra.Command{
+	Use:   "show <name|id>",
+	Short: "Show movement costs and bonuses of a terrain",
+	Long: `Show a terrain definition from the rules: its category, whether it can
+be captured, and the movement cost and defense bonus it gives each unit
+class. Terrains can be given by ID or by name (case insensitive).
+
+Examples:
+  ww terrains show 7
+  ww terrains show mountains
+  ww terrains show "water (deep)" --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTerrainsShow,
+}
+
+func init() {
+	rootCmd.AddCommand(terrainsCmd)
+	terrainsCmd.AddCommand(terrainsListCmd)
+	terrainsCmd.AddCommand(terrainsShowCmd)
+}
+
+func runTerrainsList(cmd *cobra.Command, args []string) error {
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return err
+	}
+	terrains := sortedTerrainDefinitions(rulesEngine)
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{"terrains": terrains})
+	}
+	return formatter.PrintText(FormatTerrainDefinitionList(rulesEngine, terrains))
+}
+
+func runTerrainsShow(cmd *cobra.Command, args []string) error {
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return err
+	}
+	terrainDef, err := findTerrainDefinition(rulesEngine, args[0])
+	if err != nil {
+		return err
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(terrainDef)
+	}
+	return formatter.PrintText(FormatTerrainDefinition(rulesEngine, terrainDef))
+}
+
+// sortedTerrainDefinitions returns the terrain definitions ordered by ID
+func sortedTerrainDefinitions(rulesEngine *lib.RulesEngine) []*v1.TerrainDefinition {
+	terrains := slices.Collect(maps.Values(rulesEngine.GetTerrains()))
+	slices.SortFunc(terrains, func(a, b *v1.TerrainDefinition) int { return int(a.Id - b.Id) })
+	return terrains
+}
+
+// findTerrainDefinition looks a terrain up by ID or by name
+func findTerrainDefinition(rulesEngine *lib.RulesEngine, query string) (*v1.TerrainDefinition, error) {
+	if id, err := strconv.Atoi(query); err == nil {
+		return rulesEngine.GetTerrainData(int32(id))
+	}
+	return findByName(sortedTerrainDefinitions(rulesEngine), "terrain", query, (*v1.TerrainDefinition).GetName)
+}
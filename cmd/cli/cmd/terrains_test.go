@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/turnforge/lilbattle/lib"
+)
+
+func TestTerrainsShowPrintsMovementCosts(t *testing.T) {
+	re := lib.DefaultRulesEngine()
+	terrainDef, err := findTerrainDefinition(re, "land base")
+	if err != nil {
+		t.Fatalf("findTerrainDefinition error: %v", err)
+	}
+
+	out := FormatTerrainDefinition(re, terrainDef)
+	for _, want := range []string{
+		"Terrain 1: Land Base",
+		"Type: city\n",
+		"Capturable: yes (by Soldier (Basic),",
+		"Movement cost by unit class:\n",
+		"  Light:Land   1\n",
+		"  Heavy:Land   0.75\n",
+		"  Heavy:Air    1\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	mountains, err := findTerrainDefinition(re, "7")
+	if err != nil {
+		t.Fatalf("findTerrainDefinition error: %v", err)
+	}
+	out = FormatTerrainDefinition(re, mountains)
+	for _, want := range []string{"Capturable: no\n", "  Light:Land   2\n", "No movement rules for: Heavy:Land"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
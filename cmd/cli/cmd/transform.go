@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// transformCmd represents the transform command
+var transformCmd = &cobra.Command{
+	Use:   "transform <unit> <unit_type>",
+	Short: "Transform a unit into another unit type",
+	Long: `Transform a unit into another unit type its rules allow (listed in the
+unit's transforms_to). The unit keeps its position and its health is scaled
+to the new type's maximum. Transforming uses up the unit's turn.
+
+Positions can be unit IDs (like A1) or coordinates (like 3,4).
+Unit types can be numeric IDs or unit names.
+
+Examples:
+  ww transform A1 2                Transform unit A1 into unit type 2
+  ww transform A1 "soldier (advanced)"
+  ww transform 3,4 2 --dryrun      Preview without saving`,
+	Args: cobra.ExactArgs(2),
+	RunE: runTransform,
+}
+
+func init() {
+	rootCmd.AddCommand(transformCmd)
+}
+
+func runTransform(cmd *cobra.Command, args []string) error {
+	unitLabel := args[0]
+
+	ctx := context.Background()
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+
+	newType, err := parseUnitType(gc, args[1])
+	if err != nil {
+		return err
+	}
+
+	if isVerbose() {
+		fmt.Printf("[VERBOSE] Attempting to transform %s into unit type %d\n", unitLabel, newType)
+	}
+
+	resp, err := gc.Service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+		GameId:       gc.GameID,
+		DryRun:       isDryrun(),
+		DebugResolve: isDebugResolve(),
+		Moves: []*v1.GameMove{{
+			Player: gc.State.CurrentPlayer,
+			MoveType: &v1.GameMove_TransformUnit{
+				TransformUnit: &v1.TransformUnitAction{
+					Pos:         &v1.Position{Label: unitLabel},
+					NewUnitType: newType,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("transform failed: %w", err)
+	}
+
+	// Format output
+	formatter := NewOutputFormatter()
+
+	if formatter.JSON {
+		data := map[string]any{
+			"game_id":   gc.GameID,
+			"action":    "transform",
+			"unit":      unitLabel,
+			"unit_type": newType,
+			"dryrun":    isDryrun(),
+			"success":   true,
+			"changes":   formatChangesForJSON(resp.Moves),
+		}
+		return formatter.PrintJSON(data)
+	}
+
+	// Text output
+	var sb strings.Builder
+	if isDryrun() {
+		sb.WriteString("Transform (dryrun): Would succeed\n")
+	} else {
+		sb.WriteString("Transform: Success\n")
+	}
+
+	if len(resp.Moves) > 0 && len(resp.Moves[0].Changes) > 0 {
+		for _, change := range resp.Moves[0].Changes {
+			sb.WriteString(fmt.Sprintf("  %s\n", formatChange(change)))
+		}
+	}
+
+	sb.WriteString(formatMoveResolutions(resp.Resolutions))
+	return formatter.PrintText(sb.String())
+}
@@ -2,8 +2,14 @@ package cmd
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 
 	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 )
 
 // unitsCmd represents the units command
@@ -13,14 +19,96 @@ var unitsCmd = &cobra.Command{
 	Long: `Display all units grouped by player, showing their position, health,
 and remaining movement points.
 
+Use "ww units list" and "ww units show" to browse the unit definitions
+in the rules instead.
+
 Examples:
   ww units
   ww units --json`,
 	RunE: runUnits,
 }
 
+// unitsListCmd lists the unit definitions in the rules
+var unitsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all unit definitions in the rules",
+	Long: `List every unit type defined in the rules with its cost, movement
+and attack range. Does not need a game.
+
+Examples:
+  ww units list
+  ww units list --rules custom-rules.json`,
+	Args: cobra.NoArgs,
+	RunE: runUnitsList,
+}
+
+// unitsShowCmd shows the full stats of one unit definition
+var unitsShowCmd = &cobra.Command{
+	Use:   "show <name|id>",
+	Short: "Show the stats of a unit definition",
+	Long: `Show a unit definition from the rules: cost, movement, attack range,
+attack values against each unit class and the order of actions it may take
+in a turn. Units can be given by ID or by name (case insensitive). Does not
+need a game.
+
+Examples:
+  ww units show 3
+  ww units show "tank (basic)"
+  ww units show striker --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runUnitsShow,
+}
+
 func init() {
 	rootCmd.AddCommand(unitsCmd)
+	unitsCmd.AddCommand(unitsListCmd)
+	unitsCmd.AddCommand(unitsShowCmd)
+}
+
+func runUnitsList(cmd *cobra.Command, args []string) error {
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return err
+	}
+	units := sortedUnitDefinitions(rulesEngine)
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{"units": units})
+	}
+	return formatter.PrintText(FormatUnitDefinitionList(units))
+}
+
+func runUnitsShow(cmd *cobra.Command, args []string) error {
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return err
+	}
+	unitDef, err := findUnitDefinition(rulesEngine, args[0])
+	if err != nil {
+		return err
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(unitDef)
+	}
+	return formatter.PrintText(FormatUnitDefinition(unitDef))
+}
+
+// sortedUnitDefinitions returns the unit definitions ordered by ID
+func sortedUnitDefinitions(rulesEngine *lib.RulesEngine) []*v1.UnitDefinition {
+	units := slices.Collect(maps.Values(rulesEngine.GetUnits()))
+	slices.SortFunc(units, func(a, b *v1.UnitDefinition) int { return int(a.Id - b.Id) })
+	return units
+}
+
+// findUnitDefinition looks a unit up by ID or by name
+func findUnitDefinition(rulesEngine *lib.RulesEngine, query string) (*v1.UnitDefinition, error) {
+	if id, err := strconv.Atoi(query); err == nil {
+		return rulesEngine.GetUnitData(int32(id))
+	}
+	return findByName(sortedUnitDefinitions(rulesEngine), "unit", query, (*v1.UnitDefinition).GetName)
 }
 
 func runUnits(cmd *cobra.Command, args []string) error {
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/turnforge/lilbattle/lib"
+)
+
+func TestUnitsShowPrintsStats(t *testing.T) {
+	re := lib.DefaultRulesEngine()
+	unitDef, err := findUnitDefinition(re, "artillery (basic)")
+	if err != nil {
+		t.Fatalf("findUnitDefinition error: %v", err)
+	}
+	if unitDef.Id != 8 {
+		t.Fatalf("found unit %d, want 8", unitDef.Id)
+	}
+
+	out := FormatUnitDefinition(unitDef)
+	for _, want := range []string{
+		"Unit 8: Artillery (Basic)",
+		"Cost: 200\n",
+		"Movement: 3\n",
+		"Attack range: 2-3\n",
+		"  1. move|attack\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	for class, attack := range unitDef.AttackVsClass {
+		if !strings.Contains(out, class) {
+			t.Errorf("output missing attack vs %s (%d):\n%s", class, attack, out)
+		}
+	}
+}
+
+func TestFindUnitDefinition(t *testing.T) {
+	re := lib.DefaultRulesEngine()
+
+	if unitDef, err := findUnitDefinition(re, "3"); err != nil || unitDef.Name != "Tank (Basic)" {
+		t.Errorf("lookup by ID = %v, %v; want Tank (Basic)", unitDef, err)
+	}
+	if unitDef, err := findUnitDefinition(re, "strik"); err != nil || unitDef.Id != 5 {
+		t.Errorf("lookup by prefix = %v, %v; want Striker", unitDef, err)
+	}
+	if _, err := findUnitDefinition(re, "soldier"); err == nil {
+		t.Error("expected an ambiguous name to fail")
+	}
+	if _, err := findUnitDefinition(re, "dragon"); err == nil {
+		t.Error("expected an unknown name to fail")
+	}
+}
@@ -3,7 +3,9 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/spf13/viper"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	"github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/services"
@@ -28,10 +30,20 @@ func GetGameContext() (*GameContext, error) {
 	if err != nil {
 		return nil, err
 	}
+	return GetGameContextFor(id)
+}
 
+// GetGameContextFor loads the game with the given id, for commands that
+// take the game as an argument rather than from --game-id
+func GetGameContextFor(id string) (*GameContext, error) {
 	ctx := context.Background()
 	serverURL := getServerURL()
 
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return nil, err
+	}
+
 	// Create the appropriate GamesService
 	var svc services.GamesService
 	var isRemote bool
@@ -52,7 +64,10 @@ func GetGameContext() (*GameContext, error) {
 			fmt.Printf("[VERBOSE] Connecting to server: %s (profile: %s, auth: %v)\n", apiURL, profileName, token != "")
 		}
 	} else {
-		svc = fsbe.NewFSGamesService("", nil)
+		fsSvc := fsbe.NewFSGamesService("", nil)
+		fsSvc.RulesEngine = rulesEngine
+		fsSvc.Thumbnails = viper.GetBool("thumbnail")
+		svc = fsSvc
 		isRemote = false
 		if isVerbose() {
 			fmt.Println("[VERBOSE] Using local file storage")
@@ -70,6 +85,9 @@ func GetGameContext() (*GameContext, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get runtime game: %w", err)
 	}
+	// Remote games are processed with the server's rules; custom rules only
+	// affect local display and previews there.
+	rtGame.SetRulesEngine(rulesEngine)
 
 	return &GameContext{
 		Service:  svc,
@@ -81,3 +99,31 @@ func GetGameContext() (*GameContext, error) {
 		IsRemote: isRemote,
 	}, nil
 }
+
+// findByName returns the item whose name matches query exactly (ignoring
+// case), or the only item whose name starts with it. kind names the items
+// in error messages.
+func findByName[T any](items []T, kind, query string, name func(T) string) (T, error) {
+	var matches []T
+	for _, item := range items {
+		if strings.EqualFold(name(item), query) {
+			return item, nil
+		}
+		if strings.HasPrefix(strings.ToLower(name(item)), strings.ToLower(query)) {
+			matches = append(matches, item)
+		}
+	}
+
+	var zero T
+	switch len(matches) {
+	case 0:
+		return zero, fmt.Errorf("no %s named %q", kind, query)
+	case 1:
+		return matches[0], nil
+	}
+	names := make([]string, len(matches))
+	for i, item := range matches {
+		names[i] = name(item)
+	}
+	return zero, fmt.Errorf("%q matches several %ss: %s", query, kind, strings.Join(names, ", "))
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/connectclient"
+)
+
+var watchBinary bool
+
+// watchCmd represents the watch command
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Stream live updates for the game",
+	Long: `Subscribe to the game's live updates and print each one as JSON until
+interrupted. Requires LILBATTLE_SERVER to be set.
+
+Moves are received as JSON by default so they are easy to read. With --binary
+the server sends them as compressed binary proto instead; they are decoded
+before printing and the size received is shown.
+
+Examples:
+  ww watch
+  ww watch --binary`,
+	Args: cobra.NoArgs,
+	RunE: runWatch,
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+	watchCmd.Flags().BoolVar(&watchBinary, "binary", false, "receive moves as compressed binary proto")
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	gameID, err := getGameID()
+	if err != nil {
+		return err
+	}
+	serverURL := getServerURL()
+	if serverURL == "" {
+		return fmt.Errorf("LILBATTLE_SERVER is required to watch a game (e.g., http://localhost:9080)")
+	}
+
+	req := &v1.SubscribeRequest{GameId: gameID}
+	if watchBinary {
+		req.AcceptedEncodings = []string{services.EncodingProtoGzip}
+	}
+
+	token := GetTokenForProfile(getProfileName())
+	syncClient := connectclient.NewConnectSyncClientWithAuth(GetAPIEndpoint(serverURL), token)
+	stream, err := syncClient.Subscribe(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("subscribe failed: %w", err)
+	}
+	defer stream.Close()
+
+	for stream.Receive() {
+		update := stream.Msg()
+		if encoded := update.GetEncodedMoves(); encoded != nil {
+			moves, err := services.DecodeMovesPublished(encoded)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("# %d bytes of %s\n", len(encoded.Data), encoded.Encoding)
+			update = &v1.GameUpdate{
+				Sequence:   update.Sequence,
+				UpdateType: &v1.GameUpdate_MovesPublished{MovesPublished: moves},
+			}
+		}
+		data, err := protojson.Marshal(update)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	}
+	return stream.Err()
+}
@@ -0,0 +1,341 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/connectclient"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	rateHumanPlayer int32
+	rateSimulations int
+	rateMaxTurns    int32
+	rateSeed        int64
+
+	editFromFile string
+
+	importMapping   string
+	importHexSize   int
+	importTolerance float64
+	importDefault   string
+	importName      string
+	importOutput    string
+)
+
+// worldCmd groups world commands
+var worldCmd = &cobra.Command{
+	Use:   "world",
+	Short: "Manage worlds",
+}
+
+// worldRateCmd represents the world rate command
+var worldRateCmd = &cobra.Command{
+	Use:   "rate <world_id>",
+	Short: "Rate a world's difficulty with AI simulations",
+	Long: `Rate how hard a world is by playing it out with the baseline AI on
+every side. Reports the win rate of the human side, the average game length
+and a difficulty bucket, and stores the rating on the world so the lobby can
+show it (eg "Hard (AI wins 70%)").
+
+Ratings record the rules and AI version they were computed with and are
+hidden once either changes; re-run this command to refresh them.
+Requires LILBATTLE_SERVER to be set.
+
+Examples:
+  ww world rate 01bdc3ce                          Rate with player 1 as the human
+  ww world rate 01bdc3ce --human-player 2         Rate for the player 2 side
+  ww world rate 01bdc3ce --simulations 100        Play more games for a better estimate
+  ww world rate 01bdc3ce --dryrun                 Print the rating without saving it`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorldRate,
+}
+
+// worldEditCmd represents the world edit command
+var worldEditCmd = &cobra.Command{
+	Use:   "edit <world_id>",
+	Short: "Apply a batch of map edits to a world",
+	Long: `Apply a batch of map edits from a JSON file to a world. The file holds
+the body of an ApplyWorldEdits request, so scripts can build maps with the
+same edits the editor makes:
+
+  {"edits": [
+    {"paintTerrain": {"q": 0, "r": 0, "tileType": 1}},
+    {"paintTerrain": {"q": 1, "r": 0, "tileType": 5}},
+    {"setOwner": {"q": 0, "r": 0, "player": 1}},
+    {"placeUnit": {"q": 1, "r": 0, "unitType": 1, "player": 1}},
+    {"remove": {"q": 2, "r": 0, "removeTile": true}}
+  ]}
+
+Edits are applied atomically: if any is invalid none are, and the error
+names the first invalid edit. Each batch bumps the world version once.
+Requires LILBATTLE_SERVER to be set.
+
+Examples:
+  ww world edit 01bdc3ce --from-file edits.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorldEdit,
+}
+
+// worldImportImageCmd represents the world import-image command
+var worldImportImageCmd = &cobra.Command{
+	Use:   "import-image <image>",
+	Short: "Create a draft world from a drawing of its terrain",
+	Long: `Create a draft world from an image (PNG, JPEG or GIF) drawn in any paint
+program. The image is sampled at the center of each hex of a lattice of
+--hex-size pixel hexes laid over it, and each sampled color becomes the
+terrain of the nearest color in the mapping, a JSON object from "#rrggbb"
+colors to terrain IDs or names:
+
+  {"#4caf50": "grass", "#2196f3": 10, "#795548": "mountains"}
+
+Colors further than --tolerance from every mapped color become the --default
+terrain and are listed as warnings. Transparent pixels leave their hex off
+the map. The world is created on the server for further editing, or written
+as JSON world data with --output.
+Requires LILBATTLE_SERVER to be set unless --output is given.
+
+Examples:
+  ww world import-image map.png --mapping mapping.json --hex-size 12
+  ww world import-image map.png --mapping mapping.json --hex-size 12 -o world.json
+  ww world import-image map.png --mapping mapping.json --hex-size 12 --default ""`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorldImportImage,
+}
+
+func init() {
+	rootCmd.AddCommand(worldCmd)
+	worldCmd.AddCommand(worldRateCmd)
+	worldCmd.AddCommand(worldEditCmd)
+	worldEditCmd.Flags().StringVar(&editFromFile, "from-file", "", "JSON file with the edits to apply")
+	worldEditCmd.MarkFlagRequired("from-file")
+	worldCmd.AddCommand(worldImportImageCmd)
+	worldImportImageCmd.Flags().StringVar(&importMapping, "mapping", "", "JSON file mapping colors to terrains")
+	worldImportImageCmd.MarkFlagRequired("mapping")
+	worldImportImageCmd.Flags().IntVar(&importHexSize, "hex-size", 12, "width of a hex in image pixels")
+	worldImportImageCmd.Flags().Float64Var(&importTolerance, "tolerance", lib.DefaultImageColorTolerance, "largest RGB distance from a mapped color")
+	worldImportImageCmd.Flags().StringVar(&importDefault, "default", "grass", "terrain for unmapped colors (empty leaves those hexes out)")
+	worldImportImageCmd.Flags().StringVar(&importName, "name", "", "name of the new world (default the image's file name)")
+	worldImportImageCmd.Flags().StringVarP(&importOutput, "output", "o", "", "write the world data as JSON to this file instead of creating a world")
+	worldRateCmd.Flags().Int32Var(&rateHumanPlayer, "human-player", 1, "player slot taken by the human")
+	worldRateCmd.Flags().IntVar(&rateSimulations, "simulations", 20, "number of games to simulate")
+	worldRateCmd.Flags().Int32Var(&rateMaxTurns, "max-turns", 100, "turns after which a game counts as a draw")
+	worldRateCmd.Flags().Int64Var(&rateSeed, "seed", 1, "seed of the first simulated game")
+}
+
+func runWorldRate(cmd *cobra.Command, args []string) error {
+	worldID := args[0]
+	ctx := context.Background()
+
+	serverURL := getServerURL()
+	if serverURL == "" {
+		return fmt.Errorf("LILBATTLE_SERVER is required for rating worlds (e.g., http://localhost:9080)")
+	}
+
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return err
+	}
+
+	token := GetTokenForProfile(getProfileName())
+	worldsClient := connectclient.NewConnectWorldsClientWithAuth(GetAPIEndpoint(serverURL), token)
+
+	worldResp, err := worldsClient.GetWorld(ctx, &v1.GetWorldRequest{Id: worldID})
+	if err != nil {
+		return fmt.Errorf("failed to load world %s: %w", worldID, err)
+	}
+	if worldResp.WorldData == nil {
+		return fmt.Errorf("world %s has no data", worldID)
+	}
+
+	if isVerbose() {
+		fmt.Printf("[VERBOSE] Simulating %d game(s) of world %s\n", rateSimulations, worldID)
+	}
+
+	rating, err := lib.RateWorld(worldResp.WorldData, rateHumanPlayer, rulesEngine, lib.SimulationConfig{
+		Simulations: rateSimulations,
+		MaxTurns:    rateMaxTurns,
+		Seed:        rateSeed,
+		Config:      worldResp.World.GetDefaultGameConfig(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rate world %s: %w", worldID, err)
+	}
+
+	formatter := NewOutputFormatter()
+	if !formatter.Dryrun {
+		_, err := worldsClient.UpdateWorld(ctx, &v1.UpdateWorldRequest{
+			World: &v1.World{Id: worldID, Rating: rating},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save rating: %w", err)
+		}
+	}
+
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"world_id":       worldID,
+			"human_player":   rating.HumanPlayer,
+			"simulations":    rating.Simulations,
+			"human_win_rate": rating.HumanWinRate,
+			"ai_win_rate":    rating.AiWinRate,
+			"average_turns":  rating.AverageTurns,
+			"difficulty":     rating.Difficulty,
+			"label":          rating.Label,
+			"rules_hash":     rating.RulesHash,
+			"ai_version":     rating.AiVersion,
+		})
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("World %s: %s\n", worldID, rating.Label))
+	sb.WriteString(fmt.Sprintf("  Human player: %d\n", rating.HumanPlayer))
+	sb.WriteString(fmt.Sprintf("  Simulations: %d\n", rating.Simulations))
+	sb.WriteString(fmt.Sprintf("  Human wins: %.0f%%, AI wins: %.0f%%\n", rating.HumanWinRate*100, rating.AiWinRate*100))
+	sb.WriteString(fmt.Sprintf("  Average length: %.1f turns\n", rating.AverageTurns))
+	sb.WriteString(fmt.Sprintf("  Rules: %.12s, AI: %s", rating.RulesHash, rating.AiVersion))
+	return formatter.PrintText(sb.String())
+}
+
+func runWorldEdit(cmd *cobra.Command, args []string) error {
+	worldID := args[0]
+	ctx := context.Background()
+
+	serverURL := getServerURL()
+	if serverURL == "" {
+		return fmt.Errorf("LILBATTLE_SERVER is required for editing worlds (e.g., http://localhost:9080)")
+	}
+
+	data, err := os.ReadFile(editFromFile)
+	if err != nil {
+		return fmt.Errorf("failed to read edits: %w", err)
+	}
+	req := &v1.ApplyWorldEditsRequest{}
+	if err := protojson.Unmarshal(data, req); err != nil {
+		return fmt.Errorf("failed to parse edits in %s: %w", editFromFile, err)
+	}
+	req.WorldId = worldID
+
+	formatter := NewOutputFormatter()
+	if formatter.Dryrun {
+		return formatter.PrintText(fmt.Sprintf("Would apply %d edit(s) to world %s", len(req.Edits), worldID))
+	}
+
+	token := GetTokenForProfile(getProfileName())
+	worldsClient := connectclient.NewConnectWorldsClientWithAuth(GetAPIEndpoint(serverURL), token)
+	resp, err := worldsClient.ApplyWorldEdits(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to edit world %s: %w", worldID, err)
+	}
+
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"world_id": worldID,
+			"edits":    len(req.Edits),
+			"version":  resp.Version,
+		})
+	}
+	return formatter.PrintText(fmt.Sprintf("Applied %d edit(s) to world %s (version %d)", len(req.Edits), worldID, resp.Version))
+}
+
+func runWorldImportImage(cmd *cobra.Command, args []string) error {
+	imagePath := args[0]
+	ctx := context.Background()
+
+	rulesEngine, err := getRulesEngine()
+	if err != nil {
+		return err
+	}
+	mappingData, err := os.ReadFile(importMapping)
+	if err != nil {
+		return fmt.Errorf("failed to read mapping: %w", err)
+	}
+	mapping := lib.TerrainImageMapping{Tolerance: importTolerance}
+	if mapping.Colors, err = lib.ParseTerrainColors(mappingData, rulesEngine); err != nil {
+		return fmt.Errorf("failed to parse mapping in %s: %w", importMapping, err)
+	}
+	if importDefault != "" {
+		if mapping.DefaultTileType, err = lib.TerrainTypeByName(rulesEngine, importDefault); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return fmt.Errorf("failed to read image: %w", err)
+	}
+	defer file.Close()
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return fmt.Errorf("failed to decode image %s: %w", imagePath, err)
+	}
+	imported, err := lib.ImportTerrainImage(img, importHexSize, mapping)
+	if err != nil {
+		return err
+	}
+
+	var unmapped []string
+	for _, coord := range imported.Unmapped {
+		unmapped = append(unmapped, fmt.Sprintf("%d,%d", coord.Q, coord.R))
+	}
+	tiles := len(imported.WorldData.TilesMap)
+	formatter := NewOutputFormatter()
+	result := map[string]any{"tiles": tiles, "unmapped": unmapped}
+	var sb strings.Builder
+	if len(unmapped) > 0 {
+		sb.WriteString(fmt.Sprintf("Warning: %d hex(es) matched no mapped color and got the default terrain:\n  %s\n",
+			len(unmapped), strings.Join(unmapped, " ")))
+	}
+
+	switch {
+	case importOutput != "":
+		data, err := protojson.MarshalOptions{Indent: "  "}.Marshal(imported.WorldData)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(importOutput, data, 0644); err != nil {
+			return fmt.Errorf("failed to write world data: %w", err)
+		}
+		result["output"] = importOutput
+		sb.WriteString(fmt.Sprintf("Wrote %d tiles to %s\n", tiles, importOutput))
+	case formatter.Dryrun:
+		sb.WriteString(fmt.Sprintf("Would create a world of %d tiles\n", tiles))
+	default:
+		serverURL := getServerURL()
+		if serverURL == "" {
+			return fmt.Errorf("LILBATTLE_SERVER is required for creating worlds (e.g., http://localhost:9080), or use --output")
+		}
+		name := importName
+		if name == "" {
+			name = strings.TrimSuffix(filepath.Base(imagePath), filepath.Ext(imagePath))
+		}
+		token := GetTokenForProfile(getProfileName())
+		worldsClient := connectclient.NewConnectWorldsClientWithAuth(GetAPIEndpoint(serverURL), token)
+		resp, err := worldsClient.CreateWorld(ctx, &v1.CreateWorldRequest{
+			World:     &v1.World{Name: name},
+			WorldData: imported.WorldData,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create world: %w", err)
+		}
+		result["world_id"] = resp.World.Id
+		sb.WriteString(fmt.Sprintf("Created world %s (%s) with %d tiles\n", resp.World.Id, name, tiles))
+	}
+
+	if formatter.JSON {
+		return formatter.PrintJSON(result)
+	}
+	return formatter.PrintText(sb.String())
+}
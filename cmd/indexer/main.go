@@ -6,16 +6,23 @@ import (
 	"log"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
+	goal "github.com/panyam/goapplib"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
 	"github.com/turnforge/lilbattle/services/server"
 	"github.com/turnforge/lilbattle/utils"
 	web "github.com/turnforge/lilbattle/web/server"
+	"google.golang.org/grpc"
 )
 
 var (
 	grpcAddress    = flag.String("grpcAddress", DefaultServiceAddress(), "Address where the gRPC endpoint is running")
 	gatewayAddress = flag.String("gatewayAddress", DefaultGatewayAddress(), "Address where the http grpc gateway endpoint is running")
+	backendAddress = flag.String("backendAddress", DefaultBackendAddress(), "Address of the backend gRPC endpoint whose games are aggregated")
 )
 
 type Backend struct {
@@ -50,6 +57,14 @@ func DefaultServiceAddress() string {
 	return ":7070"
 }
 
+func DefaultBackendAddress() string {
+	addr := os.Getenv("LILBATTLE_GRPC_PORT")
+	if addr != "" {
+		return addr
+	}
+	return ":9090"
+}
+
 func parseFlags() {
 	envfile := ".env"
 	log.Println("Environment: ", os.Getenv("LILBATTLE_INDEXER_ENV"))
@@ -80,13 +95,26 @@ func (b *Backend) SetupApp() *utils.App {
 	app := &utils.App{Ctx: context.Background()}
 	log.Println("Grpc, Address: ", grpcAddress)
 	log.Println("gateway, Address: ", gatewayAddress)
-	app.AddServer(&server.Server{Address: b.GrpcAddress})
+	app.AddServer(&server.Server{
+		Address: b.GrpcAddress,
+		RegisterCallback: func(grpcSrv *grpc.Server) error {
+			indexer := fsbe.NewFSIndexerService("")
+			v1s.RegisterIndexerServiceServer(grpcSrv, indexer)
+
+			backend := services.NewClientMgr(*backendAddress)
+			metaStats := &services.MetaStatsAggregator{Games: backend.GetGamesSvcClient(), Store: indexer, Shards: 4}
+			go metaStats.Loop(app.Ctx, 10*time.Minute)
+			return nil
+		},
+	})
 
 	isDevMode := os.Getenv("WEEAR_INDEXER_ENV") == "dev"
 	app.AddServer(&web.IndexerAppServer{
-		GrpcAddress:   b.GrpcAddress,
-		Address:       b.GatewayAddress,
-		AllowLocalDev: isDevMode,
+		WebAppServer: goal.WebAppServer{
+			GrpcAddress:   b.GrpcAddress,
+			Address:       b.GatewayAddress,
+			AllowLocalDev: isDevMode,
+		},
 	})
 	b.App = app
 	return app
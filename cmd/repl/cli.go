@@ -38,20 +38,15 @@ func NewCLI(gameID string) (*CLI, error) {
 	homeDir, _ := os.UserHomeDir()
 	historyFile := filepath.Join(homeDir, ".lilbattle_cli_history")
 
-	// Configure readline with auto-complete for commands
-	completer := readline.NewPrefixCompleter(
-		readline.PcItem("options"),
-		readline.PcItem("optionsd"),
-		readline.PcItem("move"),
-		readline.PcItem("attack"),
-		readline.PcItem("end"),
-		readline.PcItem("status"),
-		readline.PcItem("units"),
-		readline.PcItem("player"),
-		readline.PcItem("help"),
-		readline.PcItem("quit"),
-		readline.PcItem("exit"),
-	)
+	cli := &CLI{
+		gameID:  gameID,
+		service: service,
+	}
+
+	// Complete commands, unit shortcuts and destinations from the game
+	completer := &gameCompleter{
+		game: func() (*lib.Game, error) { return cli.runtimeGame(context.Background()) },
+	}
 
 	// Create readline instance
 	rl, err := readline.NewEx(&readline.Config{
@@ -67,12 +62,18 @@ func NewCLI(gameID string) (*CLI, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create readline: %w", err)
 	}
+	cli.readline = rl
+
+	return cli, nil
+}
 
-	return &CLI{
-		gameID:   gameID,
-		service:  service,
-		readline: rl,
-	}, nil
+// runtimeGame loads the game's current state as a runtime game
+func (cli *CLI) runtimeGame(ctx context.Context) (*lib.Game, error) {
+	resp, err := cli.service.GetGame(ctx, &v1.GetGameRequest{Id: cli.gameID})
+	if err != nil {
+		return nil, err
+	}
+	return cli.service.GetRuntimeGame(resp.Game, resp.State)
 }
 
 // filterInput allows special characters in readline
@@ -150,24 +151,23 @@ func (cli *CLI) showOptions(position string, detailed bool) string {
 	ctx := context.Background()
 
 	// Get runtime game for parsing
-	rtGame, err := cli.service.GetRuntimeGameByID(ctx, cli.gameID)
+	rtGame, err := cli.runtimeGame(ctx)
 	if err != nil {
 		return fmt.Sprintf("Failed to get game: %v", err)
 	}
 
 	// Parse position (could be coordinate or unit ID)
-	target, err := lilbattle.ParsePositionOrUnit(rtGame, position)
+	target, err := lib.ParsePositionOrUnit(rtGame, position)
 	if err != nil {
 		return fmt.Sprintf("Invalid position: %v", err)
 	}
 
-	coord := target.GetCoordinate()
+	coord := target.Coordinate
 
 	// Get options at this position
 	resp, err := cli.service.GetOptionsAt(ctx, &v1.GetOptionsAtRequest{
 		GameId: cli.gameID,
-		Q:      int32(coord.Q),
-		R:      int32(coord.R),
+		Pos:    &v1.Position{Q: int32(coord.Q), R: int32(coord.R)},
 	})
 	if err != nil {
 		return fmt.Sprintf("Failed to get options: %v", err)
@@ -183,21 +183,21 @@ func (cli *CLI) showOptions(position string, detailed bool) string {
 		switch opt := option.OptionType.(type) {
 		case *v1.GameOption_Move:
 			moveOpt := opt.Move
-			targetCoord := lilbattle.CoordFromInt32(moveOpt.ToQ, moveOpt.ToR)
+			targetCoord := lib.CoordFromInt32(moveOpt.To.Q, moveOpt.To.R)
 
 			// Build the menu item with path if available
-			menuItem := fmt.Sprintf("%d. move to %s (cost: %d)",
+			menuItem := fmt.Sprintf("%d. move to %s (cost: %g)",
 				menuIndex, targetCoord.String(), moveOpt.MovementCost)
 
 			// Add path visualization if AllPaths is available
 			if resp.AllPaths != nil {
-				path := moveOpt.ReconstructedPath // lilbattle.ReconstructPath(resp.AllPaths, moveOpt.Q, moveOpt.R)
+				path := moveOpt.ReconstructedPath // lib.ReconstructPath(resp.AllPaths, moveOpt.Q, moveOpt.R)
 				if path != nil {
 					if detailed {
-						pathStr := lilbattle.FormatPathDetailed(path, "   ")
+						pathStr := lib.FormatPathDetailed(path, "   ")
 						menuItem += "\n" + pathStr
 					} else {
-						pathStr := lilbattle.FormatPathCompact(path)
+						pathStr := lib.FormatPathCompact(path)
 						menuItem += fmt.Sprintf("\n   Path: %s", pathStr)
 					}
 				}
@@ -214,7 +214,7 @@ func (cli *CLI) showOptions(position string, detailed bool) string {
 
 		case *v1.GameOption_Attack:
 			attackOpt := opt.Attack
-			targetCoord := lilbattle.CoordFromInt32(attackOpt.DefenderQ, attackOpt.DefenderR)
+			targetCoord := lib.CoordFromInt32(attackOpt.Defender.Q, attackOpt.Defender.R)
 			menuItems = append(menuItems, fmt.Sprintf("%d. attack %s (type %d, damage est: %d)",
 				menuIndex, targetCoord.String(), attackOpt.TargetUnitType, attackOpt.DamageEstimate))
 
@@ -251,14 +251,14 @@ func (cli *CLI) showOptions(position string, detailed bool) string {
 	}
 
 	// Show what's at this position
-	if target.IsUnit && target.Unit != nil {
+	if target.IsShortcut && target.Unit != nil {
 		unitID := target.Unit.Shortcut
 		if unitID == "" {
 			unitID = target.Raw
 		}
 		fmt.Printf("\nUnit %s at %s:\n", unitID, coord.String())
-		fmt.Printf("  Type: %d, HP: %d, Moves: %d\n",
-			target.Unit.UnitType, target.Unit.AvailableHealth, target.Unit.DistanceLeft)
+		fmt.Printf("  Type: %d, HP: %d, Moves: %s\n",
+			target.Unit.UnitType, target.Unit.AvailableHealth, lib.FormatMovementPoints(target.Unit.DistanceLeft))
 	} else {
 		fmt.Printf("\nPosition %s:\n", coord.String())
 	}
@@ -303,29 +303,29 @@ func (cli *CLI) showOptions(position string, detailed bool) string {
 func (cli *CLI) ParseFromAndToCoords(arg0, arg1 string) (fromCoord services.AxialCoord, toCoord services.AxialCoord, err error) {
 	// Get runtime game for parsing
 	ctx := context.Background()
-	rtGame, err := cli.service.GetRuntimeGameByID(ctx, cli.gameID)
+	rtGame, err := cli.runtimeGame(ctx)
 	if err != nil {
 		err = fmt.Errorf("Failed to get game: %v", err)
 		return
 	}
 
 	// Parse from position
-	fromTarget, err := lilbattle.ParsePositionOrUnit(rtGame, arg0)
+	fromTarget, err := lib.ParsePositionOrUnit(rtGame, arg0)
 	if err != nil {
 		err = fmt.Errorf("Invalid from position: %v", err)
 		return
 	}
 
-	fromCoord = fromTarget.GetCoordinate()
+	fromCoord = fromTarget.Coordinate
 
 	// Parse to position with context (supports directions like L, R, TL, etc.)
-	toTarget, err := lilbattle.ParsePositionOrUnitWithContext(rtGame, arg1, &fromCoord)
+	toTarget, err := lib.ParsePositionOrUnitWithContext(rtGame, arg1, &fromCoord)
 	if err != nil {
 		err = fmt.Errorf("Invalid to position: %v", err)
 		return
 	}
 
-	toCoord = toTarget.GetCoordinate()
+	toCoord = toTarget.Coordinate
 	return
 }
 
@@ -344,10 +344,8 @@ func (cli *CLI) handleMove(args []string) string {
 	move := &v1.GameMove{
 		MoveType: &v1.GameMove_MoveUnit{
 			MoveUnit: &v1.MoveUnitAction{
-				FromQ: int32(fromCoord.Q),
-				FromR: int32(fromCoord.R),
-				ToQ:   int32(toCoord.Q),
-				ToR:   int32(toCoord.R),
+				From: &v1.Position{Q: int32(fromCoord.Q), R: int32(fromCoord.R)},
+				To:   &v1.Position{Q: int32(toCoord.Q), R: int32(toCoord.R)},
 			},
 		},
 	}
@@ -370,10 +368,8 @@ func (cli *CLI) handleAttack(args []string) string {
 	move := &v1.GameMove{
 		MoveType: &v1.GameMove_AttackUnit{
 			AttackUnit: &v1.AttackUnitAction{
-				AttackerQ: int32(attackerCoord.Q),
-				AttackerR: int32(attackerCoord.R),
-				DefenderQ: int32(targetCoord.Q),
-				DefenderR: int32(targetCoord.R),
+				Attacker: &v1.Position{Q: int32(attackerCoord.Q), R: int32(attackerCoord.R)},
+				Defender: &v1.Position{Q: int32(targetCoord.Q), R: int32(targetCoord.R)},
 			},
 		},
 	}
@@ -409,7 +405,7 @@ func (cli *CLI) processMoves(moves []*v1.GameMove) string {
 	// Build result message
 	var result strings.Builder
 
-	for i, moveResult := range resp.MoveResults {
+	for i, moveResult := range resp.Moves {
 		result.WriteString(fmt.Sprintf("Move %d: ", i+1))
 
 		// Check if there are changes (success) or not (failure)
@@ -420,14 +416,14 @@ func (cli *CLI) processMoves(moves []*v1.GameMove) string {
 			if len(moves) > i {
 				switch action := moves[i].MoveType.(type) {
 				case *v1.GameMove_MoveUnit:
-					fromCoord := lilbattle.CoordFromInt32(action.MoveUnit.FromQ, action.MoveUnit.FromR)
-					toCoord := lilbattle.CoordFromInt32(action.MoveUnit.ToQ, action.MoveUnit.ToR)
+					fromCoord := lib.CoordFromInt32(action.MoveUnit.From.Q, action.MoveUnit.From.R)
+					toCoord := lib.CoordFromInt32(action.MoveUnit.To.Q, action.MoveUnit.To.R)
 					result.WriteString(fmt.Sprintf("  Moved unit from %s to %s\n",
 						fromCoord.String(), toCoord.String()))
 
 				case *v1.GameMove_AttackUnit:
-					attackerCoord := lilbattle.CoordFromInt32(action.AttackUnit.AttackerQ, action.AttackUnit.AttackerR)
-					targetCoord := lilbattle.CoordFromInt32(action.AttackUnit.DefenderQ, action.AttackUnit.DefenderR)
+					attackerCoord := lib.CoordFromInt32(action.AttackUnit.Attacker.Q, action.AttackUnit.Attacker.R)
+					targetCoord := lib.CoordFromInt32(action.AttackUnit.Defender.Q, action.AttackUnit.Defender.R)
 					result.WriteString(fmt.Sprintf("  Attacked from %s to %s\n",
 						attackerCoord.String(), targetCoord.String()))
 
@@ -521,7 +517,7 @@ func (cli *CLI) handleUnits() string {
 	ctx := context.Background()
 
 	// Get runtime game to get units with shortcuts
-	rtGame, err := cli.service.GetRuntimeGameByID(ctx, cli.gameID)
+	rtGame, err := cli.runtimeGame(ctx)
 	if err != nil {
 		return fmt.Sprintf("Failed to get game: %v", err)
 	}
@@ -544,16 +540,16 @@ func (cli *CLI) handleUnits() string {
 		result.WriteString(fmt.Sprintf("Player %s units:\n", playerLetter))
 
 		for _, unit := range units {
-			coord := lilbattle.CoordFromInt32(unit.Q, unit.R)
+			coord := lib.CoordFromInt32(unit.Q, unit.R)
 			// Use the actual shortcut from the unit
 			unitID := unit.Shortcut
 			if unitID == "" {
 				// Fallback if no shortcut (shouldn't happen)
 				unitID = fmt.Sprintf("%s?", playerLetter)
 			}
-			result.WriteString(fmt.Sprintf("  %s: Type %d at %s (HP: %d, Moves: %d)\n",
+			result.WriteString(fmt.Sprintf("  %s: Type %d at %s (HP: %d, Moves: %s)\n",
 				unitID, unit.UnitType, coord.String(),
-				unit.AvailableHealth, unit.DistanceLeft))
+				unit.AvailableHealth, lib.FormatMovementPoints(unit.DistanceLeft)))
 		}
 	}
 
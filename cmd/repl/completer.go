@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// replCommands lists the commands ExecuteCommand understands
+var replCommands = []string{
+	"options", "optionsd", "move", "attack", "end", "status", "units", "player", "help", "quit", "exit",
+}
+
+// unitCommands take one of the current player's units as their first argument
+var unitCommands = []string{"options", "optionsd", "move", "attack"}
+
+// gameCompleter tab-completes REPL lines from the game's current state:
+// command names first, then the current player's unit shortcuts, then where
+// the chosen unit can move to or attack
+type gameCompleter struct {
+	// game loads the game as it is now, so completions follow the moves made
+	game func() (*lib.Game, error)
+}
+
+// Do implements readline.AutoCompleter, returning what each candidate adds to
+// the word being typed
+func (c *gameCompleter) Do(line []rune, pos int) (newLine [][]rune, length int) {
+	word, candidates := c.complete(string(line[:pos]))
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, word) {
+			newLine = append(newLine, []rune(candidate[len(word):]+" "))
+		}
+	}
+	return newLine, len([]rune(word))
+}
+
+// complete returns the word being typed at the end of text and everything
+// that could go in its place
+func (c *gameCompleter) complete(text string) (word string, candidates []string) {
+	args := strings.Fields(text)
+	if len(args) > 0 && !strings.HasSuffix(text, " ") {
+		word, args = args[len(args)-1], args[:len(args)-1]
+	}
+	if len(args) == 0 {
+		return word, replCommands
+	}
+
+	command := strings.ToLower(args[0])
+	if !slices.Contains(unitCommands, command) || len(args) > 2 {
+		return word, nil
+	}
+	game, err := c.game()
+	if err != nil {
+		return word, nil
+	}
+	if len(args) == 1 {
+		return word, unitShortcuts(game)
+	}
+	switch command {
+	case "move":
+		return word, moveDestinations(game, args[1])
+	case "attack":
+		return word, attackTargets(game, args[1])
+	}
+	return word, nil
+}
+
+// unitShortcuts returns the shortcuts of the current player's units, sorted
+func unitShortcuts(game *lib.Game) (shortcuts []string) {
+	for _, unit := range game.World.GetPlayerUnits(int(game.CurrentPlayer)) {
+		if unit.Shortcut != "" {
+			shortcuts = append(shortcuts, unit.Shortcut)
+		}
+	}
+	slices.Sort(shortcuts)
+	return
+}
+
+// moveDestinations returns the coordinates the unit at position can move to
+func moveDestinations(game *lib.Game, position string) (destinations []string) {
+	resp, err := game.GetOptionsAt(position)
+	if err != nil {
+		return nil
+	}
+	for _, option := range resp.Options {
+		if move := option.GetMove(); move != nil {
+			destinations = append(destinations, fmt.Sprintf("%d,%d", move.To.Q, move.To.R))
+		}
+	}
+	return
+}
+
+// attackTargets returns the shortcuts of the units the unit at position can
+// attack
+func attackTargets(game *lib.Game, position string) (targets []string) {
+	resp, err := game.GetOptionsAt(position)
+	if err != nil {
+		return nil
+	}
+	for _, option := range resp.Options {
+		attack := option.GetAttack()
+		if attack == nil {
+			continue
+		}
+		if defender := game.World.UnitAt(lib.CoordFromInt32(attack.Defender.Q, attack.Defender.R)); defender != nil && defender.Shortcut != "" {
+			targets = append(targets, defender.Shortcut)
+		} else {
+			targets = append(targets, fmt.Sprintf("%d,%d", attack.Defender.Q, attack.Defender.R))
+		}
+	}
+	return
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// newTestCompleter returns a completer over the test game, where it is
+// player 1's turn with units A1-A3
+func newTestCompleter(t *testing.T) (*gameCompleter, *lib.Game) {
+	t.Helper()
+	cli := &CLI{gameID: "testgame", service: fsbe.NewFSGamesService("../../tests", nil)}
+	game, err := cli.runtimeGame(context.Background())
+	if err != nil {
+		t.Fatalf("failed to load the test game: %v", err)
+	}
+	return &gameCompleter{game: func() (*lib.Game, error) { return cli.runtimeGame(context.Background()) }}, game
+}
+
+// completions returns what the completer offers at the end of line
+func completions(c *gameCompleter, line string) (out []string) {
+	suffixes, _ := c.Do([]rune(line), len([]rune(line)))
+	for _, suffix := range suffixes {
+		out = append(out, string(suffix))
+	}
+	return
+}
+
+func TestGameCompleter_Commands(t *testing.T) {
+	c, _ := newTestCompleter(t)
+
+	if got := completions(c, "mo"); !slices.Equal(got, []string{"ve "}) {
+		t.Errorf("completing \"mo\" = %q, want [\"ve \"]", got)
+	}
+	if got := completions(c, "opt"); !slices.Equal(got, []string{"ions ", "ionsd "}) {
+		t.Errorf("completing \"opt\" = %q, want options and optionsd", got)
+	}
+	if got := completions(c, ""); len(got) != len(replCommands) {
+		t.Errorf("completing an empty line offered %d commands, want %d", len(got), len(replCommands))
+	}
+	if got := completions(c, "status "); len(got) != 0 {
+		t.Errorf("completing after status = %q, want nothing", got)
+	}
+}
+
+func TestGameCompleter_UnitShortcuts(t *testing.T) {
+	c, _ := newTestCompleter(t)
+
+	if got := completions(c, "move "); !slices.Equal(got, []string{"A1 ", "A2 ", "A3 "}) {
+		t.Errorf("completing a unit to move = %q, want player 1's A1-A3", got)
+	}
+	if got := completions(c, "attack A"); !slices.Equal(got, []string{"1 ", "2 ", "3 "}) {
+		t.Errorf("completing \"attack A\" = %q, want A1-A3", got)
+	}
+	if got, length := c.Do([]rune("move A"), 6); length != 1 || len(got) != 3 {
+		t.Errorf("completing \"move A\" replaced %d runes with %d candidates, want 1 and 3", length, len(got))
+	}
+}
+
+func TestGameCompleter_MoveDestinations(t *testing.T) {
+	c, game := newTestCompleter(t)
+
+	resp, err := game.GetOptionsAt("A3")
+	if err != nil {
+		t.Fatalf("GetOptionsAt failed: %v", err)
+	}
+	var want []string
+	for _, option := range resp.Options {
+		if move := option.GetMove(); move != nil {
+			want = append(want, fmt.Sprintf("%d,%d ", move.To.Q, move.To.R))
+		}
+	}
+	if len(want) == 0 {
+		t.Fatal("A3 has nowhere to move in the test game")
+	}
+
+	if got := completions(c, "move A3 "); !slices.Equal(got, want) {
+		t.Errorf("completing A3's destination = %q, want %q", got, want)
+	}
+	if got := completions(c, "move A3 1,2 "); len(got) != 0 {
+		t.Errorf("completing past the destination = %q, want nothing", got)
+	}
+}
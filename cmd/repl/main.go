@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/chzyer/readline"
+	"github.com/turnforge/lilbattle/lib"
 )
 
 // Version information
@@ -19,8 +22,10 @@ const (
 func main() {
 	// Command line flags
 	var (
-		help    = flag.Bool("help", false, "Show help information")
-		version = flag.Bool("version", false, "Show version information")
+		help       = flag.Bool("help", false, "Show help information")
+		version    = flag.Bool("version", false, "Show version information")
+		rulesFile  = flag.String("rules_file", "", "Rules JSON to play with and watch for changes instead of the embedded rules")
+		damageFile = flag.String("damage_file", "", "Damage JSON to load and watch along with -rules_file")
 	)
 	flag.Parse()
 
@@ -36,6 +41,19 @@ func main() {
 		return
 	}
 
+	// Games are local, so their rules can follow the rules file as it is edited
+	if *rulesFile != "" && os.Getenv("LILBATTLE_ENV") == "production" {
+		log.Println("Ignoring -rules_file in production")
+	} else if *rulesFile != "" {
+		lib.EnableRulesHotSwap()
+		stop, err := lib.WatchDefaultRules(*rulesFile, *damageFile, time.Second)
+		if err != nil {
+			log.Fatalf("Failed to load rules file: %v", err)
+		}
+		defer stop()
+		fmt.Printf("Watching rules file for changes: %s\n", *rulesFile)
+	}
+
 	// Get game ID from arguments
 	gameID := flag.Args()[0]
 
@@ -49,6 +67,7 @@ func main() {
 	fmt.Printf("LilBattle CLI - Game %s loaded\n", gameID)
 	fmt.Println("Type 'help' for available commands, 'quit' to exit")
 	fmt.Println("Use ↑/↓ arrow keys to navigate command history")
+	fmt.Println("Press Tab to complete commands, unit IDs and destinations")
 
 	// Execute any remaining command line arguments as commands
 	if len(flag.Args()) > 1 {
@@ -82,6 +101,8 @@ func showHelp() {
 	fmt.Println("OPTIONS:")
 	fmt.Println("  -help                Show this help")
 	fmt.Println("  -version             Show version information")
+	fmt.Println("  -rules_file <path>   Play with these rules, reloading them when the file changes")
+	fmt.Println("  -damage_file <path>  Damage JSON to load and watch along with -rules_file")
 	fmt.Println()
 
 	fmt.Println("GAME COMMANDS:")
@@ -105,15 +105,32 @@ func (b *BrowserTerrainStatsPanel) SetCurrentTile(ctx context.Context, tile *v1.
 }
 
 type BrowserCompactSummaryCardPanel struct {
-	services.PanelBase
+	services.BaseCompactSummaryCardPanel
 	GameViewerPage *wasmv1.GameViewerPageClient
 }
 
 func (b *BrowserCompactSummaryCardPanel) SetCurrentData(ctx context.Context, tile *v1.Tile, unit *v1.Unit) {
+	b.BaseCompactSummaryCardPanel.SetCurrentData(ctx, tile, unit)
+	b.render(ctx)
+}
+
+func (b *BrowserCompactSummaryCardPanel) ShowBattleSummary(ctx context.Context, summary *v1.CombatSummary) {
+	b.BaseCompactSummaryCardPanel.ShowBattleSummary(ctx, summary)
+	b.render(ctx)
+}
+
+// HideBattleSummary goes back to showing the selected tile and unit
+func (b *BrowserCompactSummaryCardPanel) HideBattleSummary(ctx context.Context) {
+	b.BaseCompactSummaryCardPanel.HideBattleSummary(ctx)
+	b.render(ctx)
+}
+
+func (b *BrowserCompactSummaryCardPanel) render(ctx context.Context) {
 	content := renderPanelTemplate(ctx, "CompactSummaryCard.templar.html", map[string]any{
-		"Tile":  tile,
-		"Unit":  unit,
-		"Theme": b.Theme,
+		"Tile":          b.Tile,
+		"Unit":          b.Unit,
+		"BattleSummary": b.BattleSummary,
+		"Theme":         b.Theme,
 	})
 	dispatch("SetCompactSummaryCard", func() {
 		b.GameViewerPage.SetCompactSummaryCard(ctx, &v1.SetContentRequest{
@@ -245,6 +262,14 @@ func (b *BrowserGameState) SetUnitAt(ctx context.Context, req *v1.SetUnitAtReque
 	return nil, nil
 }
 
+func (b *BrowserGameState) SetTileAt(ctx context.Context, req *v1.SetTileAtRequest) (*v1.SetTileAtResponse, error) {
+	b.BaseGameState.SetTileAt(ctx, req)
+	dispatch("SetTileAt", func() {
+		b.GameViewerPage.SetTileAt(ctx, req)
+	})
+	return nil, nil
+}
+
 func (b *BrowserGameState) RemoveUnitAt(ctx context.Context, req *v1.RemoveUnitAtRequest) (*v1.RemoveUnitAtResponse, error) {
 	b.BaseGameState.RemoveUnitAt(ctx, req)
 	dispatch("RemoveUnitAt", func() {
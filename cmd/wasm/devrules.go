@@ -0,0 +1,109 @@
+//go:build js && wasm && devrules
+// +build js,wasm,devrules
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"syscall/js"
+
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/singleton"
+)
+
+// Only compiled with -tags devrules so production bundles cannot swap rules.
+// Dev builds also log how the server resolved each move.
+func init() {
+	lib.EnableRulesHotSwap()
+	registerDevAPI = func(lilbattleObj js.Value, gamesService *singleton.SingletonGamesService, presenter *services.GameViewPresenter) {
+		presenter.DebugResolve = true
+		registerReloadRules(lilbattleObj, gamesService, presenter)
+		registerTraceReach(lilbattleObj, gamesService, presenter)
+	}
+}
+
+// registerReloadRules adds lilbattle.reloadRules(rulesBytes, damageBytes, applyToCurrentGame).
+// The rules are validated before being installed; on failure the current rules
+// stay active. The in-progress game is only switched over when the caller
+// passes applyToCurrentGame (after confirming with the user).
+func registerReloadRules(lilbattleObj js.Value, gamesService *singleton.SingletonGamesService, presenter *services.GameViewPresenter) {
+	lilbattleObj.Set("reloadRules", js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) < 1 {
+			return map[string]any{
+				"success": false,
+				"error":   "reloadRules requires at least 1 argument: rulesBytes, [damageBytes], [applyToCurrentGame]",
+			}
+		}
+
+		rulesBytes := make([]byte, args[0].Get("length").Int())
+		js.CopyBytesToGo(rulesBytes, args[0])
+
+		var damageBytes []byte
+		if len(args) > 1 && args[1].Truthy() {
+			damageBytes = make([]byte, args[1].Get("length").Int())
+			js.CopyBytesToGo(damageBytes, args[1])
+		}
+		applyToCurrentGame := len(args) > 2 && args[2].Truthy()
+
+		fresh, diff, err := lib.ReloadDefaultRulesFromJSON(rulesBytes, damageBytes)
+		if err != nil {
+			return map[string]any{
+				"success": false,
+				"error":   err.Error(),
+			}
+		}
+		for _, change := range diff {
+			fmt.Println("Rules changed:", change)
+		}
+
+		if applyToCurrentGame && gamesService.RuntimeGame != nil {
+			gamesService.RuntimeGame.RulesEngine = fresh
+			presenter.RulesEngine = fresh.Rules()
+		}
+
+		changes := make([]any, len(diff))
+		for i, change := range diff {
+			changes[i] = change
+		}
+		return map[string]any{
+			"success": true,
+			"changes": changes,
+		}
+	}))
+}
+
+// registerTraceReach adds lilbattle.traceReach(position), which returns the
+// loaded game's movement flood-fill for the unit at position as a JSON string,
+// for inspecting from the dev tools console.
+func registerTraceReach(lilbattleObj js.Value, gamesService *singleton.SingletonGamesService, presenter *services.GameViewPresenter) {
+	lilbattleObj.Set("traceReach", js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) != 1 {
+			return map[string]any{
+				"success": false,
+				"error":   "traceReach requires 1 argument: position",
+			}
+		}
+
+		trace, err := presenter.TraceReach(context.Background(), gamesService.SingletonGame.Id, args[0].String())
+		if err != nil {
+			return map[string]any{
+				"success": false,
+				"error":   err.Error(),
+			}
+		}
+		traceJSON, err := json.Marshal(trace)
+		if err != nil {
+			return map[string]any{
+				"success": false,
+				"error":   err.Error(),
+			}
+		}
+		return map[string]any{
+			"success": true,
+			"trace":   string(traceJSON),
+		}
+	}))
+}
@@ -0,0 +1,100 @@
+//go:build js && wasm
+// +build js,wasm
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"syscall/js"
+
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// registerImportTerrainImage adds lilbattle.importTerrainImage(imageBytes,
+// mappingJSON, options), which turns a PNG, JPEG or GIF drawing (a
+// Uint8Array) into draft world data for the editor. mappingJSON maps
+// "#rrggbb" colors to terrain IDs or names. Options (all optional):
+//
+//	hexSize        - width of a hex in image pixels (default 12)
+//	tolerance      - largest RGB distance from a mapped color (default 48)
+//	defaultTerrain - terrain ID or name of unmapped colors (default "grass",
+//	                 "" leaves those hexes off the map)
+//
+// Returns {success, worldData (JSON), unmapped: [{q, r}]} or {success, error}.
+func registerImportTerrainImage(lilbattleObj js.Value) {
+	lilbattleObj.Set("importTerrainImage", js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) < 2 {
+			return map[string]any{
+				"success": false,
+				"error":   "importTerrainImage requires 2 arguments: imageBytes, mappingJSON",
+			}
+		}
+		options := js.Undefined()
+		if len(args) >= 3 {
+			options = args[2]
+		}
+		result, err := importTerrainImage(args[0], args[1].String(), options)
+		if err != nil {
+			return map[string]any{"success": false, "error": err.Error()}
+		}
+		return result
+	}))
+}
+
+// importTerrainImage decodes the image and maps it to terrain with the shared
+// lib importer the CLI uses
+func importTerrainImage(imageBytes js.Value, mappingJSON string, options js.Value) (map[string]any, error) {
+	data := make([]byte, imageBytes.Get("length").Int())
+	js.CopyBytesToGo(data, imageBytes)
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	rulesEngine := lib.DefaultRulesEngine()
+	mapping := lib.TerrainImageMapping{Tolerance: lib.DefaultImageColorTolerance}
+	if mapping.Colors, err = lib.ParseTerrainColors([]byte(mappingJSON), rulesEngine); err != nil {
+		return nil, err
+	}
+	hexSize, defaultTerrain := 12, "grass"
+	if options.Truthy() {
+		if v := options.Get("hexSize"); v.Truthy() {
+			hexSize = v.Int()
+		}
+		if v := options.Get("tolerance"); v.Truthy() {
+			mapping.Tolerance = v.Float()
+		}
+		if v := options.Get("defaultTerrain"); !v.IsUndefined() && !v.IsNull() {
+			defaultTerrain = v.String()
+		}
+	}
+	if defaultTerrain != "" {
+		if mapping.DefaultTileType, err = lib.TerrainTypeByName(rulesEngine, defaultTerrain); err != nil {
+			return nil, err
+		}
+	}
+
+	imported, err := lib.ImportTerrainImage(img, hexSize, mapping)
+	if err != nil {
+		return nil, err
+	}
+	worldData, err := protojson.Marshal(imported.WorldData)
+	if err != nil {
+		return nil, err
+	}
+	unmapped := make([]any, len(imported.Unmapped))
+	for i, coord := range imported.Unmapped {
+		unmapped[i] = map[string]any{"q": coord.Q, "r": coord.R}
+	}
+	return map[string]any{
+		"success":   true,
+		"worldData": string(worldData),
+		"unmapped":  unmapped,
+	}, nil
+}
@@ -10,13 +10,16 @@ package main
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"syscall/js"
 
 	// Generated WASM exports
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	lilbattle_v1_services "github.com/turnforge/lilbattle/gen/wasm/go/lilbattle/v1/services"
+	"github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/services/singleton"
+	"google.golang.org/protobuf/proto"
 
 	// Service implementations
 	"github.com/turnforge/lilbattle/services"
@@ -37,6 +40,10 @@ func (s *SingletonInitializerService) InitializeSingleton(ctx context.Context, r
 	return &v1.InitializeSingletonResponse{Response: r1}, err
 }
 
+// registerDevAPI is set by dev-only builds (see devrules.go) to add extra JS
+// entry points. It stays nil in production builds.
+var registerDevAPI func(lilbattleObj js.Value, gamesService *singleton.SingletonGamesService, presenter *services.GameViewPresenter)
+
 func main() {
 	fmt.Println("LilBattle WASM module loading...")
 
@@ -156,6 +163,71 @@ func main() {
 		}
 	}))
 
+	// getTerrainBalance(worldDataBytes) returns each terrain's percentage of
+	// the map, keyed by terrain type, for the editor's terrain stats
+	lilbattleObj.Set("getTerrainBalance", js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) != 1 {
+			return map[string]any{
+				"success": false,
+				"error":   "getTerrainBalance requires 1 argument: worldDataBytes",
+			}
+		}
+
+		worldDataBytes := make([]byte, args[0].Get("length").Int())
+		js.CopyBytesToGo(worldDataBytes, args[0])
+		worldData := &v1.WorldData{}
+		if err := proto.Unmarshal(worldDataBytes, worldData); err != nil {
+			return map[string]any{
+				"success": false,
+				"error":   err.Error(),
+			}
+		}
+
+		world := lib.NewWorld("", worldData)
+		balance := map[string]any{}
+		for terrain, percent := range world.GetTerrainBalance() {
+			balance[strconv.Itoa(terrain)] = percent
+		}
+		result := map[string]any{
+			"success": true,
+			"balance": balance,
+		}
+		if terrain, percent, dominant := world.GetDominantTerrain(); dominant {
+			result["dominantTerrain"] = terrain
+			result["dominantPercent"] = percent
+		}
+		return result
+	}))
+
+	// getOrientation() describes the loaded game's hex layout so JS overlays
+	// can place hexes the same way the Go renderers do. Basis vectors and
+	// corners are in tile-size units.
+	lilbattleObj.Set("getOrientation", js.FuncOf(func(this js.Value, args []js.Value) any {
+		orientation := lib.GetOrientation(wasmGamesService.SingletonGame.GetOrientation())
+		corners := make([]any, len(orientation.Corners))
+		for i, corner := range orientation.Corners {
+			corners[i] = []any{corner[0], corner[1]}
+		}
+		return map[string]any{
+			"success": true,
+			"name":    orientation.Name,
+			"qBasis":  []any{orientation.QBasis[0], orientation.QBasis[1]},
+			"rBasis":  []any{orientation.RBasis[0], orientation.RBasis[1]},
+			"corners": corners,
+		}
+	}))
+
+	// apiVersion is the games API version this bundle was built against
+	lilbattleObj.Set("apiVersion", services.ApiVersion)
+
+	registerRenderToPNG(lilbattleObj, wasmGamesService)
+	registerSlotStore(lilbattleObj, wasmGameViewPresenter)
+	registerImportTerrainImage(lilbattleObj)
+
+	if registerDevAPI != nil {
+		registerDevAPI(lilbattleObj, wasmGamesService, wasmGameViewPresenter)
+	}
+
 	fmt.Println("LilBattle WASM module loaded successfully")
 
 	// Keep the WASM module running
@@ -0,0 +1,182 @@
+//go:build js && wasm
+// +build js,wasm
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"syscall/js"
+
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/singleton"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// registerRenderToPNG adds lilbattle.renderToPNG(width, height, options), which
+// renders the loaded game without a canvas and resolves to the PNG bytes as a
+// Uint8Array. Options (all optional):
+//
+//	theme     - theme name, must use PNG assets (default "default")
+//	grid      - outline every hex
+//	coords    - label every tile with its "Q,R" coordinate
+//	labels    - show tile and unit labels
+//	healthBars - show health bars on damaged units
+//	selected  - {q, r} of a tile to highlight as selected
+//	hover     - {q, r} of a tile to highlight as hovered
+//	crop      - trim the image to the map instead of padding to width x height
+//	assetBase - URL prefix assets are fetched from (default "", the page origin)
+//	loadAsset - function(webPath) returning the asset's bytes, or a promise of
+//	            them, used instead of fetch (eg to read files in Node)
+func registerRenderToPNG(lilbattleObj js.Value, gamesService *singleton.SingletonGamesService) {
+	lilbattleObj.Set("renderToPNG", js.FuncOf(func(this js.Value, args []js.Value) any {
+		var width, height int
+		options := js.Undefined()
+		if len(args) >= 2 {
+			width, height = args[0].Int(), args[1].Int()
+		}
+		if len(args) >= 3 {
+			options = args[2]
+		}
+
+		handler := js.FuncOf(func(this js.Value, promiseArgs []js.Value) any {
+			resolve, reject := promiseArgs[0], promiseArgs[1]
+			// Asset fetches block on JS promises, so render off the event loop
+			go func() {
+				data, err := renderToPNG(gamesService, width, height, options)
+				if err != nil {
+					reject.Invoke(js.Global().Get("Error").New(err.Error()))
+					return
+				}
+				out := js.Global().Get("Uint8Array").New(len(data))
+				js.CopyBytesToJS(out, data)
+				data = nil
+				// Large renders allocate canvases of many megabytes; collect
+				// them now instead of holding them until the next render
+				runtime.GC()
+				resolve.Invoke(out)
+			}()
+			return nil
+		})
+		defer handler.Release()
+		return js.Global().Get("Promise").New(handler)
+	}))
+}
+
+// renderToPNG renders the singleton game's current world with the PNG renderer
+func renderToPNG(gamesService *singleton.SingletonGamesService, width, height int, options js.Value) ([]byte, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("renderToPNG requires a positive width and height, got %dx%d", width, height)
+	}
+	worldData := gamesService.SingletonGameState.GetWorldData()
+	if len(worldData.GetTilesMap()) == 0 {
+		return nil, fmt.Errorf("no game loaded to render")
+	}
+
+	themeName := "default"
+	if theme := options.Get("theme"); options.Truthy() && theme.Truthy() {
+		themeName = theme.String()
+	}
+	rulesEngine := lib.DefaultRulesEngine()
+	theme, err := themes.CreateTheme(themeName, rulesEngine.GetCityTerrains())
+	if err != nil {
+		return nil, err
+	}
+	renderer, err := themes.NewPNGWorldRenderer(theme)
+	if err != nil {
+		return nil, fmt.Errorf("theme %q can't be rendered to PNG: %w", themeName, err)
+	}
+	renderer.SetAssetReader(func(webPath string) ([]byte, error) {
+		return readAsset(options, webPath)
+	})
+
+	renderOptions := lib.DefaultRenderOptions()
+	renderOptions.Orientation = lib.GetOrientation(gamesService.SingletonGame.GetOrientation())
+	renderOptions.UnitMaxHealth = rulesEngine.GetUnitMaxHealth()
+	crop := false
+	if options.Truthy() {
+		renderOptions.ShowGrid = options.Get("grid").Truthy()
+		renderOptions.ShowCoords = options.Get("coords").Truthy()
+		renderOptions.ShowTileLabels = options.Get("labels").Truthy()
+		renderOptions.ShowUnitLabels = options.Get("labels").Truthy()
+		renderOptions.ShowHealthBars = options.Get("healthBars").Truthy()
+		renderOptions.SelectedCoord = coordOption(options.Get("selected"))
+		renderOptions.HoverCoord = coordOption(options.Get("hover"))
+		crop = options.Get("crop").Truthy()
+	}
+
+	return renderer.RenderToFit(worldData.TilesMap, worldData.UnitsMap, renderOptions, width, height, crop)
+}
+
+// coordOption reads a {q, r} option, nil if it is not set
+func coordOption(value js.Value) *lib.AxialCoord {
+	if !value.Truthy() {
+		return nil
+	}
+	return &lib.AxialCoord{Q: value.Get("q").Int(), R: value.Get("r").Int()}
+}
+
+// readAsset loads an asset with the caller's loadAsset option, or fetches it
+// from assetBase
+func readAsset(options js.Value, webPath string) ([]byte, error) {
+	var result js.Value
+	var err error
+	if options.Truthy() && options.Get("loadAsset").Type() == js.TypeFunction {
+		result, err = awaitPromise(options.Get("loadAsset").Invoke(webPath))
+	} else {
+		assetBase := ""
+		if options.Truthy() && options.Get("assetBase").Truthy() {
+			assetBase = options.Get("assetBase").String()
+		}
+		var response js.Value
+		response, err = awaitPromise(js.Global().Call("fetch", assetBase+webPath))
+		if err != nil {
+			return nil, err
+		}
+		if !response.Get("ok").Bool() {
+			return nil, fmt.Errorf("fetching %s failed with status %d", webPath, response.Get("status").Int())
+		}
+		result, err = awaitPromise(response.Call("arrayBuffer"))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bytes := js.Global().Get("Uint8Array").New(result)
+	data := make([]byte, bytes.Get("length").Int())
+	js.CopyBytesToGo(data, bytes)
+	return data, nil
+}
+
+// awaitPromise blocks the calling goroutine until a promise settles. Values
+// that are not promises are returned as they are.
+func awaitPromise(value js.Value) (js.Value, error) {
+	if value.Type() != js.TypeObject || value.Get("then").Type() != js.TypeFunction {
+		return value, nil
+	}
+
+	var result js.Value
+	var err error
+	done := make(chan struct{})
+	onResolve := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) > 0 {
+			result = args[0]
+		}
+		close(done)
+		return nil
+	})
+	defer onResolve.Release()
+	onReject := js.FuncOf(func(this js.Value, args []js.Value) any {
+		err = fmt.Errorf("promise rejected")
+		if len(args) > 0 {
+			err = fmt.Errorf("%s", args[0].Call("toString").String())
+		}
+		close(done)
+		return nil
+	})
+	defer onReject.Release()
+
+	value.Call("then", onResolve, onReject)
+	<-done
+	return result, err
+}
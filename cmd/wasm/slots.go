@@ -0,0 +1,75 @@
+//go:build js && wasm
+// +build js,wasm
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"syscall/js"
+
+	"github.com/turnforge/lilbattle/services"
+)
+
+// registerSlotStore adds lilbattle.registerSlotStore(store), which the page
+// calls with the object its save slots are kept in (eg IndexedDB). The store
+// must provide these methods, each returning a value or a promise of one:
+//
+//	put(name, bytes) - save a Uint8Array under a slot name
+//	get(name)        - the Uint8Array saved under a slot name
+//	list()           - an array of the slot names
+//
+// Once registered the presenter's SaveSlot/LoadSlot/ListSlots use the store
+// and games are autosaved into it.
+func registerSlotStore(lilbattleObj js.Value, presenter *services.GameViewPresenter) {
+	lilbattleObj.Set("registerSlotStore", js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) != 1 || args[0].Type() != js.TypeObject {
+			return map[string]any{
+				"success": false,
+				"error":   "registerSlotStore requires 1 argument: store",
+			}
+		}
+		presenter.SlotStore = &jsSlotStore{store: args[0]}
+		return map[string]any{"success": true}
+	}))
+}
+
+// jsSlotStore is a services.SlotStore calling out to a store object in JS.
+// Its methods block on the store's promises so must not be called on the
+// event loop.
+type jsSlotStore struct {
+	store js.Value
+}
+
+func (s *jsSlotStore) PutSlot(ctx context.Context, name string, data []byte) error {
+	bytes := js.Global().Get("Uint8Array").New(len(data))
+	js.CopyBytesToJS(bytes, data)
+	_, err := awaitPromise(s.store.Call("put", name, bytes))
+	return err
+}
+
+func (s *jsSlotStore) GetSlot(ctx context.Context, name string) ([]byte, error) {
+	result, err := awaitPromise(s.store.Call("get", name))
+	if err != nil {
+		return nil, err
+	}
+	if result.IsUndefined() || result.IsNull() {
+		return nil, fmt.Errorf("no save slot named %q", name)
+	}
+	bytes := js.Global().Get("Uint8Array").New(result)
+	data := make([]byte, bytes.Get("length").Int())
+	js.CopyBytesToGo(data, bytes)
+	return data, nil
+}
+
+func (s *jsSlotStore) ListSlots(ctx context.Context) ([]string, error) {
+	result, err := awaitPromise(s.store.Call("list"))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, result.Length())
+	for i := range names {
+		names[i] = result.Index(i).String()
+	}
+	return names, nil
+}
@@ -36,6 +36,12 @@ type TileDatastore struct {
 	LastActedTurn int32 `datastore:"last_acted_turn"`
 
 	LastToppedupTurn int32 `datastore:"last_toppedup_turn"`
+
+	Construction ConstructionProgressDatastore `datastore:"construction"`
+
+	Hazard TileHazardDatastore `datastore:"hazard"`
+
+	VictoryPoints int32 `datastore:"victory_points"`
 }
 
 // CrossingDatastore is the Datastore entity for the source message.
@@ -78,6 +84,14 @@ type UnitDatastore struct {
 	ChosenAlternative string `datastore:"chosen_alternative"`
 
 	CaptureStartedTurn int32 `datastore:"capture_started_turn"`
+
+	Submerged bool `datastore:"submerged"`
+
+	Facing int32 `datastore:"facing"`
+
+	Id int32 `datastore:"id"`
+
+	StepActions int32 `datastore:"step_actions"`
 }
 
 // AttackRecordDatastore is the Datastore entity for the source message.
@@ -122,6 +136,18 @@ type WorldDatastore struct {
 	DefaultGameConfig GameConfigurationDatastore `datastore:"default_game_config,noindex"`
 
 	SearchIndexInfo IndexInfoDatastore `datastore:"search_index_info,flatten"`
+
+	Rating WorldRatingDatastore `datastore:"rating,noindex"`
+
+	RulesOverrides RulesOverridesDatastore `datastore:"rules_overrides,noindex"`
+
+	Orientation string `datastore:"orientation"`
+
+	DeletedAt time.Time `datastore:"deleted_at"`
+
+	RandomMap RandomMapDatastore `datastore:"random_map"`
+
+	AttachedGameId string `datastore:"attached_game_id"`
 }
 
 // Kind returns the Datastore kind name for WorldDatastore.
@@ -129,14 +155,52 @@ func (*WorldDatastore) Kind() string {
 	return "World"
 }
 
+// WorldRatingDatastore is the Datastore entity for the source message.
+type WorldRatingDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	HumanPlayer int32 `datastore:"human_player"`
+
+	Simulations int32 `datastore:"simulations"`
+
+	HumanWinRate float64 `datastore:"human_win_rate"`
+
+	AiWinRate float64 `datastore:"ai_win_rate"`
+
+	AverageTurns float64 `datastore:"average_turns"`
+
+	Difficulty string `datastore:"difficulty"`
+
+	Label string `datastore:"label"`
+
+	RulesHash string `datastore:"rules_hash"`
+
+	AiVersion string `datastore:"ai_version"`
+
+	RatedAt time.Time `datastore:"rated_at"`
+
+	WorldDataVersion int64 `datastore:"world_data_version"`
+}
+
+// RulesOverridesDatastore is the Datastore entity for the source message.
+type RulesOverridesDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	TerrainMovementCosts map[int32]float64 `datastore:"terrain_movement_costs,noindex"`
+
+	Income IncomeConfigDatastore `datastore:"income"`
+
+	MaxUnitsPerPlayer int32 `datastore:"max_units_per_player"`
+}
+
 // WorldDataDatastore is the Datastore entity for the source message.
 type WorldDataDatastore struct {
 	Key *datastore.Key `datastore:"-"`
 
-	WorldId string `datastore:"-"`
-
 	TilesMap map[string]TileDatastore `datastore:"tiles_map,noindex"`
 
+	WorldId string `datastore:"-"`
+
 	UnitsMap map[string]UnitDatastore `datastore:"units_map,noindex"`
 
 	ScreenshotIndexInfo IndexInfoDatastore `datastore:"screenshot_index_info,flatten"`
@@ -184,6 +248,16 @@ type GameDatastore struct {
 	PreviewUrls []string `datastore:"preview_urls,noindex"`
 
 	SearchIndexInfo IndexInfoDatastore `datastore:"search_index_info,flatten"`
+
+	Orientation string `datastore:"orientation"`
+
+	RandomMap RandomMapDatastore `datastore:"random_map"`
+
+	Thumbnail string `datastore:"thumbnail"`
+
+	ForkedFromGameId string `datastore:"forked_from_game_id"`
+
+	ForkedAtMove int32 `datastore:"forked_at_move"`
 }
 
 // Kind returns the Datastore kind name for GameDatastore.
@@ -220,6 +294,22 @@ type GameStateDatastore struct {
 	CurrentGroupNumber int64 `datastore:"current_group_number"`
 
 	PlayerStates map[int32]PlayerStateDatastore `datastore:"player_states,noindex"`
+
+	ClockStartedAt time.Time `datastore:"clock_started_at"`
+
+	ClockPaused bool `datastore:"clock_paused"`
+
+	PauseRequests []int32 `datastore:"pause_requests"`
+
+	DelegatedTo int32 `datastore:"delegated_to"`
+
+	Draft DraftStateDatastore `datastore:"draft"`
+
+	PuzzleResult models.PuzzleResult `datastore:"puzzle_result"`
+
+	NextUnitId int32 `datastore:"next_unit_id"`
+
+	TurnPlans [][]byte `datastore:"turn_plans,noindex"`
 }
 
 // Kind returns the Datastore kind name for GameStateDatastore.
@@ -238,6 +328,10 @@ type GameConfigurationDatastore struct {
 	IncomeConfigs IncomeConfigDatastore `datastore:"income_configs"`
 
 	Settings GameSettingsDatastore `datastore:"settings"`
+
+	WorldRulesOverrides RulesOverridesDatastore `datastore:"world_rules_overrides"`
+
+	RulesOverrides RulesOverridesDatastore `datastore:"rules_overrides"`
 }
 
 // IncomeConfigDatastore is the Datastore entity for the source message.
@@ -278,6 +372,17 @@ type GamePlayerDatastore struct {
 	IsActive bool `datastore:"is_active"`
 
 	StartingCoins int32 `datastore:"starting_coins"`
+
+	Handicap PlayerHandicapDatastore `datastore:"handicap"`
+}
+
+// PlayerHandicapDatastore is the Datastore entity for the source message.
+type PlayerHandicapDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	ExtraCoins int32 `datastore:"extra_coins"`
+
+	BonusUnits []int32 `datastore:"bonus_units"`
 }
 
 // GameTeamDatastore is the Datastore entity for the source message.
@@ -304,6 +409,28 @@ type GameSettingsDatastore struct {
 	TeamMode string `datastore:"team_mode"`
 
 	MaxTurns int32 `datastore:"max_turns"`
+
+	TimeBank TimeBankSettingsDatastore `datastore:"time_bank"`
+
+	StealthEnabled bool `datastore:"stealth_enabled"`
+
+	Rated bool `datastore:"rated"`
+
+	FogEnabled bool `datastore:"fog_enabled"`
+
+	Draft DraftSettingsDatastore `datastore:"draft"`
+
+	Puzzle PuzzleSettingsDatastore `datastore:"puzzle"`
+
+	AllowFriendlyFire bool `datastore:"allow_friendly_fire"`
+
+	VictoryPointsToWin int32 `datastore:"victory_points_to_win"`
+
+	SimultaneousTurns bool `datastore:"simultaneous_turns"`
+
+	SpectatorDelay SpectatorDelayDatastore `datastore:"spectator_delay"`
+
+	Sandbox SandboxSettingsDatastore `datastore:"sandbox"`
 }
 
 // PlayerStateDatastore is the Datastore entity for the source message.
@@ -313,24 +440,146 @@ type PlayerStateDatastore struct {
 	Coins int32 `datastore:"coins"`
 
 	IsActive bool `datastore:"is_active"`
+
+	TimeBankMs int64 `datastore:"time_bank_ms"`
+
+	VictoryPoints int32 `datastore:"victory_points"`
+
+	DiscoveredHexes []string `datastore:"discovered_hexes,noindex"`
 }
 
-// GameMoveDatastore is the Datastore entity for the source message.
-type GameMoveDatastore struct {
+// TimeBankSettingsDatastore is the Datastore entity for the source message.
+type TimeBankSettingsDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	InitialSeconds int32 `datastore:"initial_seconds"`
+
+	IncrementSeconds int32 `datastore:"increment_seconds"`
+
+	OnTimeout models.TimeoutAction `datastore:"on_timeout"`
+}
+
+// DraftSettingsDatastore is the Datastore entity for the source message.
+type DraftSettingsDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	BansPerPlayer int32 `datastore:"bans_per_player"`
+
+	PicksPerPlayer int32 `datastore:"picks_per_player"`
+}
+
+// DraftStateDatastore is the Datastore entity for the source message.
+type DraftStateDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	BannedUnits []int32 `datastore:"banned_units,noindex"`
+
+	PickedUnits map[int32]int32 `datastore:"picked_units,noindex"`
+
+	TurnsTaken int32 `datastore:"turns_taken"`
+}
+
+// PuzzleSettingsDatastore is the Datastore entity for the source message.
+type PuzzleSettingsDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Goal string `datastore:"goal"`
+
+	TurnBudget int32 `datastore:"turn_budget"`
+
+	SolverPlayer int32 `datastore:"solver_player"`
+
+	OpponentTurns []PuzzleOpponentTurnDatastore `datastore:"opponent_turns,noindex"`
+
+	OpponentAi bool `datastore:"opponent_ai"`
+
+	PuzzleId string `datastore:"puzzle_id"`
+}
+
+// PuzzleOpponentTurnDatastore is the Datastore entity for the source message.
+type PuzzleOpponentTurnDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Moves [][]byte `datastore:"moves,noindex"`
+}
+
+// SpectatorDelayDatastore is the Datastore entity for the source message.
+type SpectatorDelayDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Turns int32 `datastore:"turns"`
+
+	Seconds int32 `datastore:"seconds"`
+}
+
+// SandboxSettingsDatastore is the Datastore entity for the source message.
+type SandboxSettingsDatastore struct {
 	Key *datastore.Key `datastore:"-"`
 
+	Enabled bool `datastore:"enabled"`
+
+	RelaxTurnOrder bool `datastore:"relax_turn_order"`
+
+	RelaxActionOrder bool `datastore:"relax_action_order"`
+
+	InfiniteCoins bool `datastore:"infinite_coins"`
+}
+
+// ConstructionProgressDatastore is the Datastore entity for the source message.
+type ConstructionProgressDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	UnitQ int32 `datastore:"unit_q"`
+
+	UnitR int32 `datastore:"unit_r"`
+
 	Player int32 `datastore:"player"`
 
+	TargetTerrain int32 `datastore:"target_terrain"`
+
+	TurnsRemaining int32 `datastore:"turns_remaining"`
+
+	StartedTurn int32 `datastore:"started_turn"`
+
+	UnitId int32 `datastore:"unit_id"`
+}
+
+// RandomMapDatastore is the Datastore entity for the source message.
+type RandomMapDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Size string `datastore:"size"`
+
+	NumPlayers int32 `datastore:"num_players"`
+
+	Seed int64 `datastore:"seed"`
+}
+
+// TileHazardDatastore is the Datastore entity for the source message.
+type TileHazardDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	Damage int32 `datastore:"damage"`
+
+	StopsMovement bool `datastore:"stops_movement"`
+}
+
+// GameMoveDatastore is the Datastore entity for the source message.
+type GameMoveDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
 	GameId string `datastore:"game_id"`
 
+	Player int32 `datastore:"player"`
+
 	GroupNumber int64 `datastore:"group_number"`
 
 	MoveNumber int64 `datastore:"move_number"`
 
-	Timestamp time.Time `datastore:"timestamp"`
-
 	MoveType []byte `datastore:"move_type,noindex"`
 
+	Timestamp time.Time `datastore:"timestamp"`
+
 	SequenceNum int64 `datastore:"sequence_num"`
 
 	IsPermanent bool `datastore:"is_permanent"`
@@ -338,6 +587,8 @@ type GameMoveDatastore struct {
 	Changes [][]byte `datastore:"changes,noindex"`
 
 	Description string `datastore:"description"`
+
+	SubmittedBy int32 `datastore:"submitted_by"`
 }
 
 // Kind returns the Datastore kind name for GameMoveDatastore.
@@ -132,9 +132,23 @@ func TileToTileDatastore(
 		Shortcut:         src.Shortcut,
 		LastActedTurn:    src.LastActedTurn,
 		LastToppedupTurn: src.LastToppedupTurn,
+		VictoryPoints:    src.VictoryPoints,
 	}
 	out = dest
 
+	if src.Construction != nil {
+		_, err = ConstructionProgressToConstructionProgressDatastore(src.Construction, &out.Construction, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Construction: %w", err)
+		}
+	}
+	if src.Hazard != nil {
+		_, err = TileHazardToTileHazardDatastore(src.Hazard, &out.Hazard, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Hazard: %w", err)
+		}
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(src, dest); err != nil {
@@ -178,9 +192,20 @@ func TileFromTileDatastore(
 		Shortcut:         src.Shortcut,
 		LastActedTurn:    src.LastActedTurn,
 		LastToppedupTurn: src.LastToppedupTurn,
+		VictoryPoints:    src.VictoryPoints,
 	}
 	out = dest
 
+	out.Construction, err = ConstructionProgressFromConstructionProgressDatastore(nil, &src.Construction, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Construction: %w", err)
+	}
+
+	out.Hazard, err = TileHazardFromTileHazardDatastore(nil, &src.Hazard, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Hazard: %w", err)
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(dest, src); err != nil {
@@ -312,6 +337,10 @@ func UnitToUnitDatastore(
 		ProgressionStep:         src.ProgressionStep,
 		ChosenAlternative:       src.ChosenAlternative,
 		CaptureStartedTurn:      src.CaptureStartedTurn,
+		Submerged:               src.Submerged,
+		Facing:                  src.Facing,
+		Id:                      src.Id,
+		StepActions:             src.StepActions,
 	}
 	out = dest
 
@@ -374,6 +403,10 @@ func UnitFromUnitDatastore(
 		ProgressionStep:         src.ProgressionStep,
 		ChosenAlternative:       src.ChosenAlternative,
 		CaptureStartedTurn:      src.CaptureStartedTurn,
+		Submerged:               src.Submerged,
+		Facing:                  src.Facing,
+		Id:                      src.Id,
+		StepActions:             src.StepActions,
 	}
 	out = dest
 
@@ -509,15 +542,17 @@ func WorldToWorldDatastore(
 
 	// Initialize struct with inline values
 	*dest = WorldDatastore{
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		Version:        src.Version,
+		Id:             src.Id,
+		CreatorId:      src.CreatorId,
+		Name:           src.Name,
+		Description:    src.Description,
+		Tags:           src.Tags,
+		ImageUrl:       src.ImageUrl,
+		Difficulty:     src.Difficulty,
+		PreviewUrls:    src.PreviewUrls,
+		Orientation:    src.Orientation,
+		AttachedGameId: src.AttachedGameId,
 	}
 	out = dest
 
@@ -541,6 +576,29 @@ func WorldToWorldDatastore(
 			return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
 		}
 	}
+	if src.Rating != nil {
+		_, err = WorldRatingToWorldRatingDatastore(src.Rating, &out.Rating, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Rating: %w", err)
+		}
+	}
+	if src.RulesOverrides != nil {
+		_, err = RulesOverridesToRulesOverridesDatastore(src.RulesOverrides, &out.RulesOverrides, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting RulesOverrides: %w", err)
+		}
+	}
+
+	if src.DeletedAt != nil {
+		out.DeletedAt = converters.TimestampToTime(src.DeletedAt)
+	}
+
+	if src.RandomMap != nil {
+		_, err = RandomMapToRandomMapDatastore(src.RandomMap, &out.RandomMap, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting RandomMap: %w", err)
+		}
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -578,17 +636,20 @@ func WorldFromWorldDatastore(
 
 	// Initialize struct with inline values
 	*dest = models.World{
-		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
-		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		CreatedAt:      converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:      converters.TimeToTimestamp(src.UpdatedAt),
+		Version:        src.Version,
+		Id:             src.Id,
+		CreatorId:      src.CreatorId,
+		Name:           src.Name,
+		Description:    src.Description,
+		Tags:           src.Tags,
+		ImageUrl:       src.ImageUrl,
+		Difficulty:     src.Difficulty,
+		PreviewUrls:    src.PreviewUrls,
+		Orientation:    src.Orientation,
+		DeletedAt:      converters.TimeToTimestamp(src.DeletedAt),
+		AttachedGameId: src.AttachedGameId,
 	}
 	out = dest
 
@@ -602,6 +663,221 @@ func WorldFromWorldDatastore(
 		return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
 	}
 
+	out.Rating, err = WorldRatingFromWorldRatingDatastore(nil, &src.Rating, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Rating: %w", err)
+	}
+
+	out.RulesOverrides, err = RulesOverridesFromRulesOverridesDatastore(nil, &src.RulesOverrides, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting RulesOverrides: %w", err)
+	}
+
+	out.RandomMap, err = RandomMapFromRandomMapDatastore(nil, &src.RandomMap, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting RandomMap: %w", err)
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// WorldRatingToWorldRatingDatastore converts a WorldRating to WorldRatingDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source WorldRating message to convert from
+//   - dest: Destination WorldRatingDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted WorldRatingDatastore entity
+//   - Error if conversion fails
+func WorldRatingToWorldRatingDatastore(
+	src *models.WorldRating,
+	dest *WorldRatingDatastore,
+	decorator func(*models.WorldRating, *WorldRatingDatastore) error,
+) (out *WorldRatingDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &WorldRatingDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = WorldRatingDatastore{
+		HumanPlayer:      src.HumanPlayer,
+		Simulations:      src.Simulations,
+		HumanWinRate:     src.HumanWinRate,
+		AiWinRate:        src.AiWinRate,
+		AverageTurns:     src.AverageTurns,
+		Difficulty:       src.Difficulty,
+		Label:            src.Label,
+		RulesHash:        src.RulesHash,
+		AiVersion:        src.AiVersion,
+		WorldDataVersion: src.WorldDataVersion,
+	}
+	out = dest
+
+	if src.RatedAt != nil {
+		out.RatedAt = converters.TimestampToTime(src.RatedAt)
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// WorldRatingFromWorldRatingDatastore converts a WorldRatingDatastore back to WorldRating.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination WorldRating message (if nil, a new one is created)
+//   - src: Source WorldRatingDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted WorldRating message
+//   - Error if conversion fails
+func WorldRatingFromWorldRatingDatastore(
+	dest *models.WorldRating,
+	src *WorldRatingDatastore,
+	decorator func(*models.WorldRating, *WorldRatingDatastore) error,
+) (out *models.WorldRating, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.WorldRating{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.WorldRating{
+		HumanPlayer:      src.HumanPlayer,
+		Simulations:      src.Simulations,
+		HumanWinRate:     src.HumanWinRate,
+		AiWinRate:        src.AiWinRate,
+		AverageTurns:     src.AverageTurns,
+		Difficulty:       src.Difficulty,
+		Label:            src.Label,
+		RulesHash:        src.RulesHash,
+		AiVersion:        src.AiVersion,
+		RatedAt:          converters.TimeToTimestamp(src.RatedAt),
+		WorldDataVersion: src.WorldDataVersion,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// RulesOverridesToRulesOverridesDatastore converts a RulesOverrides to RulesOverridesDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source RulesOverrides message to convert from
+//   - dest: Destination RulesOverridesDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted RulesOverridesDatastore entity
+//   - Error if conversion fails
+func RulesOverridesToRulesOverridesDatastore(
+	src *models.RulesOverrides,
+	dest *RulesOverridesDatastore,
+	decorator func(*models.RulesOverrides, *RulesOverridesDatastore) error,
+) (out *RulesOverridesDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &RulesOverridesDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = RulesOverridesDatastore{
+		MaxUnitsPerPlayer: src.MaxUnitsPerPlayer,
+	}
+	out = dest
+
+	if src.TerrainMovementCosts != nil {
+		out.TerrainMovementCosts = src.TerrainMovementCosts
+	}
+
+	if src.Income != nil {
+		_, err = IncomeConfigToIncomeConfigDatastore(src.Income, &out.Income, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Income: %w", err)
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// RulesOverridesFromRulesOverridesDatastore converts a RulesOverridesDatastore back to RulesOverrides.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination RulesOverrides message (if nil, a new one is created)
+//   - src: Source RulesOverridesDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted RulesOverrides message
+//   - Error if conversion fails
+func RulesOverridesFromRulesOverridesDatastore(
+	dest *models.RulesOverrides,
+	src *RulesOverridesDatastore,
+	decorator func(*models.RulesOverrides, *RulesOverridesDatastore) error,
+) (out *models.RulesOverrides, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.RulesOverrides{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.RulesOverrides{
+		TerrainMovementCosts: src.TerrainMovementCosts,
+		MaxUnitsPerPlayer:    src.MaxUnitsPerPlayer,
+	}
+	out = dest
+
+	out.Income, err = IncomeConfigFromIncomeConfigDatastore(nil, &src.Income, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Income: %w", err)
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(dest, src); err != nil {
@@ -794,16 +1070,20 @@ func GameToGameDatastore(
 
 	// Initialize struct with inline values
 	*dest = GameDatastore{
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		WorldId:     src.WorldId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		Version:          src.Version,
+		Id:               src.Id,
+		CreatorId:        src.CreatorId,
+		WorldId:          src.WorldId,
+		Name:             src.Name,
+		Description:      src.Description,
+		Tags:             src.Tags,
+		ImageUrl:         src.ImageUrl,
+		Difficulty:       src.Difficulty,
+		PreviewUrls:      src.PreviewUrls,
+		Orientation:      src.Orientation,
+		Thumbnail:        src.Thumbnail,
+		ForkedFromGameId: src.ForkedFromGameId,
+		ForkedAtMove:     src.ForkedAtMove,
 	}
 	out = dest
 
@@ -827,6 +1107,12 @@ func GameToGameDatastore(
 			return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
 		}
 	}
+	if src.RandomMap != nil {
+		_, err = RandomMapToRandomMapDatastore(src.RandomMap, &out.RandomMap, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting RandomMap: %w", err)
+		}
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -864,18 +1150,22 @@ func GameFromGameDatastore(
 
 	// Initialize struct with inline values
 	*dest = models.Game{
-		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
-		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		WorldId:     src.WorldId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		CreatedAt:        converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:        converters.TimeToTimestamp(src.UpdatedAt),
+		Version:          src.Version,
+		Id:               src.Id,
+		CreatorId:        src.CreatorId,
+		WorldId:          src.WorldId,
+		Name:             src.Name,
+		Description:      src.Description,
+		Tags:             src.Tags,
+		ImageUrl:         src.ImageUrl,
+		Difficulty:       src.Difficulty,
+		PreviewUrls:      src.PreviewUrls,
+		Orientation:      src.Orientation,
+		Thumbnail:        src.Thumbnail,
+		ForkedFromGameId: src.ForkedFromGameId,
+		ForkedAtMove:     src.ForkedAtMove,
 	}
 	out = dest
 
@@ -889,6 +1179,11 @@ func GameFromGameDatastore(
 		return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
 	}
 
+	out.RandomMap, err = RandomMapFromRandomMapDatastore(nil, &src.RandomMap, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting RandomMap: %w", err)
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(dest, src); err != nil {
@@ -935,6 +1230,11 @@ func GameStateToGameStateDatastore(
 		WinningPlayer:      src.WinningPlayer,
 		WinningTeam:        src.WinningTeam,
 		CurrentGroupNumber: src.CurrentGroupNumber,
+		ClockPaused:        src.ClockPaused,
+		PauseRequests:      src.PauseRequests,
+		DelegatedTo:        src.DelegatedTo,
+		PuzzleResult:       src.PuzzleResult,
+		NextUnitId:         src.NextUnitId,
 	}
 	out = dest
 
@@ -949,6 +1249,17 @@ func GameStateToGameStateDatastore(
 		}
 	}
 
+	if src.ClockStartedAt != nil {
+		out.ClockStartedAt = converters.TimestampToTime(src.ClockStartedAt)
+	}
+
+	if src.Draft != nil {
+		_, err = DraftStateToDraftStateDatastore(src.Draft, &out.Draft, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Draft: %w", err)
+		}
+	}
+
 	if src.PlayerStates != nil {
 		out.PlayerStates = make(map[int32]PlayerStateDatastore, len(src.PlayerStates))
 		for key, value := range src.PlayerStates {
@@ -961,6 +1272,16 @@ func GameStateToGameStateDatastore(
 		}
 	}
 
+	if src.TurnPlans != nil {
+		out.TurnPlans = make([][]byte, len(src.TurnPlans))
+		for i, item := range src.TurnPlans {
+			_, err = converters.MessageToAnyBytesConverter(item, &out.TurnPlans[i], nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting TurnPlans[%d]: %w", i, err)
+			}
+		}
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(src, dest); err != nil {
@@ -1008,6 +1329,12 @@ func GameStateFromGameStateDatastore(
 		WinningPlayer:      src.WinningPlayer,
 		WinningTeam:        src.WinningTeam,
 		CurrentGroupNumber: src.CurrentGroupNumber,
+		ClockStartedAt:     converters.TimeToTimestamp(src.ClockStartedAt),
+		ClockPaused:        src.ClockPaused,
+		PauseRequests:      src.PauseRequests,
+		DelegatedTo:        src.DelegatedTo,
+		PuzzleResult:       src.PuzzleResult,
+		NextUnitId:         src.NextUnitId,
 	}
 	out = dest
 
@@ -1016,6 +1343,11 @@ func GameStateFromGameStateDatastore(
 		return nil, fmt.Errorf("converting WorldData: %w", err)
 	}
 
+	out.Draft, err = DraftStateFromDraftStateDatastore(nil, &src.Draft, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Draft: %w", err)
+	}
+
 	if src.PlayerStates != nil {
 		out.PlayerStates = make(map[int32]*models.PlayerState, len(src.PlayerStates))
 		for key, value := range src.PlayerStates {
@@ -1026,6 +1358,16 @@ func GameStateFromGameStateDatastore(
 		}
 	}
 
+	if src.TurnPlans != nil {
+		out.TurnPlans = make([]*models.TurnPlan, len(src.TurnPlans))
+		for i, item := range src.TurnPlans {
+			out.TurnPlans[i], err = converters.AnyBytesToMessageConverter[*models.TurnPlan](nil, &item, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting TurnPlans[%d]: %w", i, err)
+			}
+		}
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(dest, src); err != nil {
@@ -1076,6 +1418,18 @@ func GameConfigurationToGameConfigurationDatastore(
 			return nil, fmt.Errorf("converting Settings: %w", err)
 		}
 	}
+	if src.WorldRulesOverrides != nil {
+		_, err = RulesOverridesToRulesOverridesDatastore(src.WorldRulesOverrides, &out.WorldRulesOverrides, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting WorldRulesOverrides: %w", err)
+		}
+	}
+	if src.RulesOverrides != nil {
+		_, err = RulesOverridesToRulesOverridesDatastore(src.RulesOverrides, &out.RulesOverrides, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting RulesOverrides: %w", err)
+		}
+	}
 
 	if src.Players != nil {
 		out.Players = make([]GamePlayerDatastore, len(src.Players))
@@ -1144,6 +1498,16 @@ func GameConfigurationFromGameConfigurationDatastore(
 		return nil, fmt.Errorf("converting Settings: %w", err)
 	}
 
+	out.WorldRulesOverrides, err = RulesOverridesFromRulesOverridesDatastore(nil, &src.WorldRulesOverrides, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting WorldRulesOverrides: %w", err)
+	}
+
+	out.RulesOverrides, err = RulesOverridesFromRulesOverridesDatastore(nil, &src.RulesOverrides, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting RulesOverrides: %w", err)
+	}
+
 	if src.Players != nil {
 		out.Players = make([]*models.GamePlayer, len(src.Players))
 		for i, item := range src.Players {
@@ -1302,6 +1666,13 @@ func GamePlayerToGamePlayerDatastore(
 	}
 	out = dest
 
+	if src.Handicap != nil {
+		_, err = PlayerHandicapToPlayerHandicapDatastore(src.Handicap, &out.Handicap, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Handicap: %w", err)
+		}
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(src, dest); err != nil {
@@ -1349,6 +1720,11 @@ func GamePlayerFromGamePlayerDatastore(
 	}
 	out = dest
 
+	out.Handicap, err = PlayerHandicapFromPlayerHandicapDatastore(nil, &src.Handicap, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Handicap: %w", err)
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(dest, src); err != nil {
@@ -1359,36 +1735,34 @@ func GamePlayerFromGamePlayerDatastore(
 	return dest, nil
 }
 
-// GameTeamToGameTeamDatastore converts a GameTeam to GameTeamDatastore.
+// PlayerHandicapToPlayerHandicapDatastore converts a PlayerHandicap to PlayerHandicapDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - src: Source GameTeam message to convert from
-//   - dest: Destination GameTeamDatastore entity (if nil, a new one is created)
+//   - src: Source PlayerHandicap message to convert from
+//   - dest: Destination PlayerHandicapDatastore entity (if nil, a new one is created)
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted GameTeamDatastore entity
+//   - Converted PlayerHandicapDatastore entity
 //   - Error if conversion fails
-func GameTeamToGameTeamDatastore(
-	src *models.GameTeam,
-	dest *GameTeamDatastore,
-	decorator func(*models.GameTeam, *GameTeamDatastore) error,
-) (out *GameTeamDatastore, err error) {
+func PlayerHandicapToPlayerHandicapDatastore(
+	src *models.PlayerHandicap,
+	dest *PlayerHandicapDatastore,
+	decorator func(*models.PlayerHandicap, *PlayerHandicapDatastore) error,
+) (out *PlayerHandicapDatastore, err error) {
 	if src == nil {
 		return nil, nil
 	}
 	if dest == nil {
-		dest = &GameTeamDatastore{}
+		dest = &PlayerHandicapDatastore{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GameTeamDatastore{
-		TeamId:   src.TeamId,
-		Name:     src.Name,
-		Color:    src.Color,
-		IsActive: src.IsActive,
+	*dest = PlayerHandicapDatastore{
+		ExtraCoins: src.ExtraCoins,
+		BonusUnits: src.BonusUnits,
 	}
 	out = dest
 
@@ -1402,36 +1776,34 @@ func GameTeamToGameTeamDatastore(
 	return dest, nil
 }
 
-// GameTeamFromGameTeamDatastore converts a GameTeamDatastore back to GameTeam.
+// PlayerHandicapFromPlayerHandicapDatastore converts a PlayerHandicapDatastore back to PlayerHandicap.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - dest: Destination GameTeam message (if nil, a new one is created)
-//   - src: Source GameTeamDatastore entity to convert from
+//   - dest: Destination PlayerHandicap message (if nil, a new one is created)
+//   - src: Source PlayerHandicapDatastore entity to convert from
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted GameTeam message
+//   - Converted PlayerHandicap message
 //   - Error if conversion fails
-func GameTeamFromGameTeamDatastore(
-	dest *models.GameTeam,
-	src *GameTeamDatastore,
-	decorator func(*models.GameTeam, *GameTeamDatastore) error,
-) (out *models.GameTeam, err error) {
+func PlayerHandicapFromPlayerHandicapDatastore(
+	dest *models.PlayerHandicap,
+	src *PlayerHandicapDatastore,
+	decorator func(*models.PlayerHandicap, *PlayerHandicapDatastore) error,
+) (out *models.PlayerHandicap, err error) {
 	if src == nil {
 		return nil, nil
 	}
 	if dest == nil {
-		dest = &models.GameTeam{}
+		dest = &models.PlayerHandicap{}
 	}
 
 	// Initialize struct with inline values
-	*dest = models.GameTeam{
-		TeamId:   src.TeamId,
-		Name:     src.Name,
-		Color:    src.Color,
-		IsActive: src.IsActive,
+	*dest = models.PlayerHandicap{
+		ExtraCoins: src.ExtraCoins,
+		BonusUnits: src.BonusUnits,
 	}
 	out = dest
 
@@ -1445,39 +1817,162 @@ func GameTeamFromGameTeamDatastore(
 	return dest, nil
 }
 
-// GameSettingsToGameSettingsDatastore converts a GameSettings to GameSettingsDatastore.
+// GameTeamToGameTeamDatastore converts a GameTeam to GameTeamDatastore.
 //
 // The optional decorator function allows custom field transformations after conversion.
 //
 // Parameters:
-//   - src: Source GameSettings message to convert from
-//   - dest: Destination GameSettingsDatastore entity (if nil, a new one is created)
+//   - src: Source GameTeam message to convert from
+//   - dest: Destination GameTeamDatastore entity (if nil, a new one is created)
 //   - decorator: Optional function for custom transformations
 //
 // Returns:
-//   - Converted GameSettingsDatastore entity
+//   - Converted GameTeamDatastore entity
 //   - Error if conversion fails
-func GameSettingsToGameSettingsDatastore(
-	src *models.GameSettings,
-	dest *GameSettingsDatastore,
-	decorator func(*models.GameSettings, *GameSettingsDatastore) error,
-) (out *GameSettingsDatastore, err error) {
-	if src == nil {
-		return nil, nil
-	}
-	if dest == nil {
+func GameTeamToGameTeamDatastore(
+	src *models.GameTeam,
+	dest *GameTeamDatastore,
+	decorator func(*models.GameTeam, *GameTeamDatastore) error,
+) (out *GameTeamDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &GameTeamDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = GameTeamDatastore{
+		TeamId:   src.TeamId,
+		Name:     src.Name,
+		Color:    src.Color,
+		IsActive: src.IsActive,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// GameTeamFromGameTeamDatastore converts a GameTeamDatastore back to GameTeam.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination GameTeam message (if nil, a new one is created)
+//   - src: Source GameTeamDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameTeam message
+//   - Error if conversion fails
+func GameTeamFromGameTeamDatastore(
+	dest *models.GameTeam,
+	src *GameTeamDatastore,
+	decorator func(*models.GameTeam, *GameTeamDatastore) error,
+) (out *models.GameTeam, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.GameTeam{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.GameTeam{
+		TeamId:   src.TeamId,
+		Name:     src.Name,
+		Color:    src.Color,
+		IsActive: src.IsActive,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// GameSettingsToGameSettingsDatastore converts a GameSettings to GameSettingsDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source GameSettings message to convert from
+//   - dest: Destination GameSettingsDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted GameSettingsDatastore entity
+//   - Error if conversion fails
+func GameSettingsToGameSettingsDatastore(
+	src *models.GameSettings,
+	dest *GameSettingsDatastore,
+	decorator func(*models.GameSettings, *GameSettingsDatastore) error,
+) (out *GameSettingsDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
 		dest = &GameSettingsDatastore{}
 	}
 
 	// Initialize struct with inline values
 	*dest = GameSettingsDatastore{
-		AllowedUnits:  src.AllowedUnits,
-		TurnTimeLimit: src.TurnTimeLimit,
-		TeamMode:      src.TeamMode,
-		MaxTurns:      src.MaxTurns,
+		AllowedUnits:       src.AllowedUnits,
+		TurnTimeLimit:      src.TurnTimeLimit,
+		TeamMode:           src.TeamMode,
+		MaxTurns:           src.MaxTurns,
+		StealthEnabled:     src.StealthEnabled,
+		Rated:              src.Rated,
+		FogEnabled:         src.FogEnabled,
+		AllowFriendlyFire:  src.AllowFriendlyFire,
+		VictoryPointsToWin: src.VictoryPointsToWin,
+		SimultaneousTurns:  src.SimultaneousTurns,
 	}
 	out = dest
 
+	if src.TimeBank != nil {
+		_, err = TimeBankSettingsToTimeBankSettingsDatastore(src.TimeBank, &out.TimeBank, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting TimeBank: %w", err)
+		}
+	}
+	if src.Draft != nil {
+		_, err = DraftSettingsToDraftSettingsDatastore(src.Draft, &out.Draft, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Draft: %w", err)
+		}
+	}
+	if src.Puzzle != nil {
+		_, err = PuzzleSettingsToPuzzleSettingsDatastore(src.Puzzle, &out.Puzzle, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Puzzle: %w", err)
+		}
+	}
+	if src.SpectatorDelay != nil {
+		_, err = SpectatorDelayToSpectatorDelayDatastore(src.SpectatorDelay, &out.SpectatorDelay, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting SpectatorDelay: %w", err)
+		}
+	}
+	if src.Sandbox != nil {
+		_, err = SandboxSettingsToSandboxSettingsDatastore(src.Sandbox, &out.Sandbox, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Sandbox: %w", err)
+		}
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(src, dest); err != nil {
@@ -1514,13 +2009,44 @@ func GameSettingsFromGameSettingsDatastore(
 
 	// Initialize struct with inline values
 	*dest = models.GameSettings{
-		AllowedUnits:  src.AllowedUnits,
-		TurnTimeLimit: src.TurnTimeLimit,
-		TeamMode:      src.TeamMode,
-		MaxTurns:      src.MaxTurns,
+		AllowedUnits:       src.AllowedUnits,
+		TurnTimeLimit:      src.TurnTimeLimit,
+		TeamMode:           src.TeamMode,
+		MaxTurns:           src.MaxTurns,
+		StealthEnabled:     src.StealthEnabled,
+		Rated:              src.Rated,
+		FogEnabled:         src.FogEnabled,
+		AllowFriendlyFire:  src.AllowFriendlyFire,
+		VictoryPointsToWin: src.VictoryPointsToWin,
+		SimultaneousTurns:  src.SimultaneousTurns,
 	}
 	out = dest
 
+	out.TimeBank, err = TimeBankSettingsFromTimeBankSettingsDatastore(nil, &src.TimeBank, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting TimeBank: %w", err)
+	}
+
+	out.Draft, err = DraftSettingsFromDraftSettingsDatastore(nil, &src.Draft, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Draft: %w", err)
+	}
+
+	out.Puzzle, err = PuzzleSettingsFromPuzzleSettingsDatastore(nil, &src.Puzzle, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Puzzle: %w", err)
+	}
+
+	out.SpectatorDelay, err = SpectatorDelayFromSpectatorDelayDatastore(nil, &src.SpectatorDelay, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting SpectatorDelay: %w", err)
+	}
+
+	out.Sandbox, err = SandboxSettingsFromSandboxSettingsDatastore(nil, &src.Sandbox, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Sandbox: %w", err)
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(dest, src); err != nil {
@@ -1557,8 +2083,11 @@ func PlayerStateToPlayerStateDatastore(
 
 	// Initialize struct with inline values
 	*dest = PlayerStateDatastore{
-		Coins:    src.Coins,
-		IsActive: src.IsActive,
+		Coins:           src.Coins,
+		IsActive:        src.IsActive,
+		TimeBankMs:      src.TimeBankMs,
+		VictoryPoints:   src.VictoryPoints,
+		DiscoveredHexes: src.DiscoveredHexes,
 	}
 	out = dest
 
@@ -1598,8 +2127,894 @@ func PlayerStateFromPlayerStateDatastore(
 
 	// Initialize struct with inline values
 	*dest = models.PlayerState{
-		Coins:    src.Coins,
-		IsActive: src.IsActive,
+		Coins:           src.Coins,
+		IsActive:        src.IsActive,
+		TimeBankMs:      src.TimeBankMs,
+		VictoryPoints:   src.VictoryPoints,
+		DiscoveredHexes: src.DiscoveredHexes,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// TimeBankSettingsToTimeBankSettingsDatastore converts a TimeBankSettings to TimeBankSettingsDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source TimeBankSettings message to convert from
+//   - dest: Destination TimeBankSettingsDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted TimeBankSettingsDatastore entity
+//   - Error if conversion fails
+func TimeBankSettingsToTimeBankSettingsDatastore(
+	src *models.TimeBankSettings,
+	dest *TimeBankSettingsDatastore,
+	decorator func(*models.TimeBankSettings, *TimeBankSettingsDatastore) error,
+) (out *TimeBankSettingsDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &TimeBankSettingsDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = TimeBankSettingsDatastore{
+		InitialSeconds:   src.InitialSeconds,
+		IncrementSeconds: src.IncrementSeconds,
+		OnTimeout:        src.OnTimeout,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// TimeBankSettingsFromTimeBankSettingsDatastore converts a TimeBankSettingsDatastore back to TimeBankSettings.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination TimeBankSettings message (if nil, a new one is created)
+//   - src: Source TimeBankSettingsDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted TimeBankSettings message
+//   - Error if conversion fails
+func TimeBankSettingsFromTimeBankSettingsDatastore(
+	dest *models.TimeBankSettings,
+	src *TimeBankSettingsDatastore,
+	decorator func(*models.TimeBankSettings, *TimeBankSettingsDatastore) error,
+) (out *models.TimeBankSettings, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.TimeBankSettings{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.TimeBankSettings{
+		InitialSeconds:   src.InitialSeconds,
+		IncrementSeconds: src.IncrementSeconds,
+		OnTimeout:        src.OnTimeout,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// DraftSettingsToDraftSettingsDatastore converts a DraftSettings to DraftSettingsDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source DraftSettings message to convert from
+//   - dest: Destination DraftSettingsDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted DraftSettingsDatastore entity
+//   - Error if conversion fails
+func DraftSettingsToDraftSettingsDatastore(
+	src *models.DraftSettings,
+	dest *DraftSettingsDatastore,
+	decorator func(*models.DraftSettings, *DraftSettingsDatastore) error,
+) (out *DraftSettingsDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &DraftSettingsDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = DraftSettingsDatastore{
+		BansPerPlayer:  src.BansPerPlayer,
+		PicksPerPlayer: src.PicksPerPlayer,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// DraftSettingsFromDraftSettingsDatastore converts a DraftSettingsDatastore back to DraftSettings.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination DraftSettings message (if nil, a new one is created)
+//   - src: Source DraftSettingsDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted DraftSettings message
+//   - Error if conversion fails
+func DraftSettingsFromDraftSettingsDatastore(
+	dest *models.DraftSettings,
+	src *DraftSettingsDatastore,
+	decorator func(*models.DraftSettings, *DraftSettingsDatastore) error,
+) (out *models.DraftSettings, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.DraftSettings{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.DraftSettings{
+		BansPerPlayer:  src.BansPerPlayer,
+		PicksPerPlayer: src.PicksPerPlayer,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// DraftStateToDraftStateDatastore converts a DraftState to DraftStateDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source DraftState message to convert from
+//   - dest: Destination DraftStateDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted DraftStateDatastore entity
+//   - Error if conversion fails
+func DraftStateToDraftStateDatastore(
+	src *models.DraftState,
+	dest *DraftStateDatastore,
+	decorator func(*models.DraftState, *DraftStateDatastore) error,
+) (out *DraftStateDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &DraftStateDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = DraftStateDatastore{
+		BannedUnits: src.BannedUnits,
+		TurnsTaken:  src.TurnsTaken,
+	}
+	out = dest
+
+	if src.PickedUnits != nil {
+		out.PickedUnits = src.PickedUnits
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// DraftStateFromDraftStateDatastore converts a DraftStateDatastore back to DraftState.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination DraftState message (if nil, a new one is created)
+//   - src: Source DraftStateDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted DraftState message
+//   - Error if conversion fails
+func DraftStateFromDraftStateDatastore(
+	dest *models.DraftState,
+	src *DraftStateDatastore,
+	decorator func(*models.DraftState, *DraftStateDatastore) error,
+) (out *models.DraftState, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.DraftState{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.DraftState{
+		BannedUnits: src.BannedUnits,
+		PickedUnits: src.PickedUnits,
+		TurnsTaken:  src.TurnsTaken,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// PuzzleSettingsToPuzzleSettingsDatastore converts a PuzzleSettings to PuzzleSettingsDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source PuzzleSettings message to convert from
+//   - dest: Destination PuzzleSettingsDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted PuzzleSettingsDatastore entity
+//   - Error if conversion fails
+func PuzzleSettingsToPuzzleSettingsDatastore(
+	src *models.PuzzleSettings,
+	dest *PuzzleSettingsDatastore,
+	decorator func(*models.PuzzleSettings, *PuzzleSettingsDatastore) error,
+) (out *PuzzleSettingsDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &PuzzleSettingsDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = PuzzleSettingsDatastore{
+		Goal:         src.Goal,
+		TurnBudget:   src.TurnBudget,
+		SolverPlayer: src.SolverPlayer,
+		OpponentAi:   src.OpponentAi,
+		PuzzleId:     src.PuzzleId,
+	}
+	out = dest
+
+	if src.OpponentTurns != nil {
+		out.OpponentTurns = make([]PuzzleOpponentTurnDatastore, len(src.OpponentTurns))
+		for i, item := range src.OpponentTurns {
+			_, err = PuzzleOpponentTurnToPuzzleOpponentTurnDatastore(item, &out.OpponentTurns[i], nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting OpponentTurns[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// PuzzleSettingsFromPuzzleSettingsDatastore converts a PuzzleSettingsDatastore back to PuzzleSettings.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination PuzzleSettings message (if nil, a new one is created)
+//   - src: Source PuzzleSettingsDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted PuzzleSettings message
+//   - Error if conversion fails
+func PuzzleSettingsFromPuzzleSettingsDatastore(
+	dest *models.PuzzleSettings,
+	src *PuzzleSettingsDatastore,
+	decorator func(*models.PuzzleSettings, *PuzzleSettingsDatastore) error,
+) (out *models.PuzzleSettings, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.PuzzleSettings{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.PuzzleSettings{
+		Goal:         src.Goal,
+		TurnBudget:   src.TurnBudget,
+		SolverPlayer: src.SolverPlayer,
+		OpponentAi:   src.OpponentAi,
+		PuzzleId:     src.PuzzleId,
+	}
+	out = dest
+
+	if src.OpponentTurns != nil {
+		out.OpponentTurns = make([]*models.PuzzleOpponentTurn, len(src.OpponentTurns))
+		for i, item := range src.OpponentTurns {
+			out.OpponentTurns[i], err = PuzzleOpponentTurnFromPuzzleOpponentTurnDatastore(nil, &item, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting OpponentTurns[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// PuzzleOpponentTurnToPuzzleOpponentTurnDatastore converts a PuzzleOpponentTurn to PuzzleOpponentTurnDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source PuzzleOpponentTurn message to convert from
+//   - dest: Destination PuzzleOpponentTurnDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted PuzzleOpponentTurnDatastore entity
+//   - Error if conversion fails
+func PuzzleOpponentTurnToPuzzleOpponentTurnDatastore(
+	src *models.PuzzleOpponentTurn,
+	dest *PuzzleOpponentTurnDatastore,
+	decorator func(*models.PuzzleOpponentTurn, *PuzzleOpponentTurnDatastore) error,
+) (out *PuzzleOpponentTurnDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &PuzzleOpponentTurnDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = PuzzleOpponentTurnDatastore{}
+	out = dest
+
+	if src.Moves != nil {
+		out.Moves = make([][]byte, len(src.Moves))
+		for i, item := range src.Moves {
+			_, err = converters.MessageToAnyBytesConverter(item, &out.Moves[i], nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// PuzzleOpponentTurnFromPuzzleOpponentTurnDatastore converts a PuzzleOpponentTurnDatastore back to PuzzleOpponentTurn.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination PuzzleOpponentTurn message (if nil, a new one is created)
+//   - src: Source PuzzleOpponentTurnDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted PuzzleOpponentTurn message
+//   - Error if conversion fails
+func PuzzleOpponentTurnFromPuzzleOpponentTurnDatastore(
+	dest *models.PuzzleOpponentTurn,
+	src *PuzzleOpponentTurnDatastore,
+	decorator func(*models.PuzzleOpponentTurn, *PuzzleOpponentTurnDatastore) error,
+) (out *models.PuzzleOpponentTurn, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.PuzzleOpponentTurn{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.PuzzleOpponentTurn{}
+	out = dest
+
+	if src.Moves != nil {
+		out.Moves = make([]*models.GameMove, len(src.Moves))
+		for i, item := range src.Moves {
+			out.Moves[i], err = converters.AnyBytesToMessageConverter[*models.GameMove](nil, &item, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// SpectatorDelayToSpectatorDelayDatastore converts a SpectatorDelay to SpectatorDelayDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source SpectatorDelay message to convert from
+//   - dest: Destination SpectatorDelayDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted SpectatorDelayDatastore entity
+//   - Error if conversion fails
+func SpectatorDelayToSpectatorDelayDatastore(
+	src *models.SpectatorDelay,
+	dest *SpectatorDelayDatastore,
+	decorator func(*models.SpectatorDelay, *SpectatorDelayDatastore) error,
+) (out *SpectatorDelayDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &SpectatorDelayDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = SpectatorDelayDatastore{
+		Turns:   src.Turns,
+		Seconds: src.Seconds,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// SpectatorDelayFromSpectatorDelayDatastore converts a SpectatorDelayDatastore back to SpectatorDelay.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination SpectatorDelay message (if nil, a new one is created)
+//   - src: Source SpectatorDelayDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted SpectatorDelay message
+//   - Error if conversion fails
+func SpectatorDelayFromSpectatorDelayDatastore(
+	dest *models.SpectatorDelay,
+	src *SpectatorDelayDatastore,
+	decorator func(*models.SpectatorDelay, *SpectatorDelayDatastore) error,
+) (out *models.SpectatorDelay, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.SpectatorDelay{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.SpectatorDelay{
+		Turns:   src.Turns,
+		Seconds: src.Seconds,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// SandboxSettingsToSandboxSettingsDatastore converts a SandboxSettings to SandboxSettingsDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source SandboxSettings message to convert from
+//   - dest: Destination SandboxSettingsDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted SandboxSettingsDatastore entity
+//   - Error if conversion fails
+func SandboxSettingsToSandboxSettingsDatastore(
+	src *models.SandboxSettings,
+	dest *SandboxSettingsDatastore,
+	decorator func(*models.SandboxSettings, *SandboxSettingsDatastore) error,
+) (out *SandboxSettingsDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &SandboxSettingsDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = SandboxSettingsDatastore{
+		Enabled:          src.Enabled,
+		RelaxTurnOrder:   src.RelaxTurnOrder,
+		RelaxActionOrder: src.RelaxActionOrder,
+		InfiniteCoins:    src.InfiniteCoins,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// SandboxSettingsFromSandboxSettingsDatastore converts a SandboxSettingsDatastore back to SandboxSettings.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination SandboxSettings message (if nil, a new one is created)
+//   - src: Source SandboxSettingsDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted SandboxSettings message
+//   - Error if conversion fails
+func SandboxSettingsFromSandboxSettingsDatastore(
+	dest *models.SandboxSettings,
+	src *SandboxSettingsDatastore,
+	decorator func(*models.SandboxSettings, *SandboxSettingsDatastore) error,
+) (out *models.SandboxSettings, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.SandboxSettings{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.SandboxSettings{
+		Enabled:          src.Enabled,
+		RelaxTurnOrder:   src.RelaxTurnOrder,
+		RelaxActionOrder: src.RelaxActionOrder,
+		InfiniteCoins:    src.InfiniteCoins,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// ConstructionProgressToConstructionProgressDatastore converts a ConstructionProgress to ConstructionProgressDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source ConstructionProgress message to convert from
+//   - dest: Destination ConstructionProgressDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted ConstructionProgressDatastore entity
+//   - Error if conversion fails
+func ConstructionProgressToConstructionProgressDatastore(
+	src *models.ConstructionProgress,
+	dest *ConstructionProgressDatastore,
+	decorator func(*models.ConstructionProgress, *ConstructionProgressDatastore) error,
+) (out *ConstructionProgressDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &ConstructionProgressDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = ConstructionProgressDatastore{
+		UnitQ:          src.UnitQ,
+		UnitR:          src.UnitR,
+		Player:         src.Player,
+		TargetTerrain:  src.TargetTerrain,
+		TurnsRemaining: src.TurnsRemaining,
+		StartedTurn:    src.StartedTurn,
+		UnitId:         src.UnitId,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// ConstructionProgressFromConstructionProgressDatastore converts a ConstructionProgressDatastore back to ConstructionProgress.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination ConstructionProgress message (if nil, a new one is created)
+//   - src: Source ConstructionProgressDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted ConstructionProgress message
+//   - Error if conversion fails
+func ConstructionProgressFromConstructionProgressDatastore(
+	dest *models.ConstructionProgress,
+	src *ConstructionProgressDatastore,
+	decorator func(*models.ConstructionProgress, *ConstructionProgressDatastore) error,
+) (out *models.ConstructionProgress, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.ConstructionProgress{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.ConstructionProgress{
+		UnitQ:          src.UnitQ,
+		UnitR:          src.UnitR,
+		Player:         src.Player,
+		TargetTerrain:  src.TargetTerrain,
+		TurnsRemaining: src.TurnsRemaining,
+		StartedTurn:    src.StartedTurn,
+		UnitId:         src.UnitId,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// RandomMapToRandomMapDatastore converts a RandomMap to RandomMapDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source RandomMap message to convert from
+//   - dest: Destination RandomMapDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted RandomMapDatastore entity
+//   - Error if conversion fails
+func RandomMapToRandomMapDatastore(
+	src *models.RandomMap,
+	dest *RandomMapDatastore,
+	decorator func(*models.RandomMap, *RandomMapDatastore) error,
+) (out *RandomMapDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &RandomMapDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = RandomMapDatastore{
+		Size:       src.Size,
+		NumPlayers: src.NumPlayers,
+		Seed:       src.Seed,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// RandomMapFromRandomMapDatastore converts a RandomMapDatastore back to RandomMap.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination RandomMap message (if nil, a new one is created)
+//   - src: Source RandomMapDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted RandomMap message
+//   - Error if conversion fails
+func RandomMapFromRandomMapDatastore(
+	dest *models.RandomMap,
+	src *RandomMapDatastore,
+	decorator func(*models.RandomMap, *RandomMapDatastore) error,
+) (out *models.RandomMap, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.RandomMap{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.RandomMap{
+		Size:       src.Size,
+		NumPlayers: src.NumPlayers,
+		Seed:       src.Seed,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// TileHazardToTileHazardDatastore converts a TileHazard to TileHazardDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source TileHazard message to convert from
+//   - dest: Destination TileHazardDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted TileHazardDatastore entity
+//   - Error if conversion fails
+func TileHazardToTileHazardDatastore(
+	src *models.TileHazard,
+	dest *TileHazardDatastore,
+	decorator func(*models.TileHazard, *TileHazardDatastore) error,
+) (out *TileHazardDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &TileHazardDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = TileHazardDatastore{
+		Damage:        src.Damage,
+		StopsMovement: src.StopsMovement,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// TileHazardFromTileHazardDatastore converts a TileHazardDatastore back to TileHazard.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination TileHazard message (if nil, a new one is created)
+//   - src: Source TileHazardDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted TileHazard message
+//   - Error if conversion fails
+func TileHazardFromTileHazardDatastore(
+	dest *models.TileHazard,
+	src *TileHazardDatastore,
+	decorator func(*models.TileHazard, *TileHazardDatastore) error,
+) (out *models.TileHazard, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.TileHazard{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.TileHazard{
+		Damage:        src.Damage,
+		StopsMovement: src.StopsMovement,
 	}
 	out = dest
 
@@ -1645,6 +3060,7 @@ func GameMoveToGameMoveDatastore(
 		SequenceNum: src.SequenceNum,
 		IsPermanent: src.IsPermanent,
 		Description: src.Description,
+		SubmittedBy: src.SubmittedBy,
 	}
 	out = dest
 
@@ -1705,6 +3121,7 @@ func GameMoveFromGameMoveDatastore(
 		SequenceNum: src.SequenceNum,
 		IsPermanent: src.IsPermanent,
 		Description: src.Description,
+		SubmittedBy: src.SubmittedBy,
 	}
 	out = dest
 
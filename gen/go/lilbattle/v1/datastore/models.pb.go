@@ -227,8 +227,12 @@ type WorldDatastore struct {
 	DefaultGameConfig *GameConfigurationDatastore `protobuf:"bytes,4,opt,name=default_game_config,json=defaultGameConfig,proto3" json:"default_game_config,omitempty"`
 	// SearchIndexInfo - needs_indexing should be indexed for worker queries
 	SearchIndexInfo *IndexInfoDatastore `protobuf:"bytes,5,opt,name=search_index_info,json=searchIndexInfo,proto3" json:"search_index_info,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Rating as noindex embedded struct
+	Rating *WorldRatingDatastore `protobuf:"bytes,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Rules overrides as noindex embedded struct
+	RulesOverrides *RulesOverridesDatastore `protobuf:"bytes,7,opt,name=rules_overrides,json=rulesOverrides,proto3" json:"rules_overrides,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *WorldDatastore) Reset() {
@@ -296,6 +300,101 @@ func (x *WorldDatastore) GetSearchIndexInfo() *IndexInfoDatastore {
 	return nil
 }
 
+func (x *WorldDatastore) GetRating() *WorldRatingDatastore {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *WorldDatastore) GetRulesOverrides() *RulesOverridesDatastore {
+	if x != nil {
+		return x.RulesOverrides
+	}
+	return nil
+}
+
+type WorldRatingDatastore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldRatingDatastore) Reset() {
+	*x = WorldRatingDatastore{}
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldRatingDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldRatingDatastore) ProtoMessage() {}
+
+func (x *WorldRatingDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldRatingDatastore.ProtoReflect.Descriptor instead.
+func (*WorldRatingDatastore) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{6}
+}
+
+type RulesOverridesDatastore struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Movement costs as noindex
+	TerrainMovementCosts map[int32]float64 `protobuf:"bytes,1,rep,name=terrain_movement_costs,json=terrainMovementCosts,proto3" json:"terrain_movement_costs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RulesOverridesDatastore) Reset() {
+	*x = RulesOverridesDatastore{}
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RulesOverridesDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesOverridesDatastore) ProtoMessage() {}
+
+func (x *RulesOverridesDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesOverridesDatastore.ProtoReflect.Descriptor instead.
+func (*RulesOverridesDatastore) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RulesOverridesDatastore) GetTerrainMovementCosts() map[int32]float64 {
+	if x != nil {
+		return x.TerrainMovementCosts
+	}
+	return nil
+}
+
 // WorldDataDatastore stores the actual world map data
 type WorldDataDatastore struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -315,7 +414,7 @@ type WorldDataDatastore struct {
 
 func (x *WorldDataDatastore) Reset() {
 	*x = WorldDataDatastore{}
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[6]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -327,7 +426,7 @@ func (x *WorldDataDatastore) String() string {
 func (*WorldDataDatastore) ProtoMessage() {}
 
 func (x *WorldDataDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[6]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +439,7 @@ func (x *WorldDataDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorldDataDatastore.ProtoReflect.Descriptor instead.
 func (*WorldDataDatastore) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{6}
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WorldDataDatastore) GetWorldId() string {
@@ -399,7 +498,7 @@ type GameDatastore struct {
 
 func (x *GameDatastore) Reset() {
 	*x = GameDatastore{}
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[7]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -411,7 +510,7 @@ func (x *GameDatastore) String() string {
 func (*GameDatastore) ProtoMessage() {}
 
 func (x *GameDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[7]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +523,7 @@ func (x *GameDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameDatastore.ProtoReflect.Descriptor instead.
 func (*GameDatastore) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{7}
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GameDatastore) GetId() string {
@@ -484,7 +583,7 @@ type GameStateDatastore struct {
 
 func (x *GameStateDatastore) Reset() {
 	*x = GameStateDatastore{}
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[8]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +595,7 @@ func (x *GameStateDatastore) String() string {
 func (*GameStateDatastore) ProtoMessage() {}
 
 func (x *GameStateDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[8]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +608,7 @@ func (x *GameStateDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameStateDatastore.ProtoReflect.Descriptor instead.
 func (*GameStateDatastore) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{8}
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GameStateDatastore) GetGameId() string {
@@ -549,7 +648,7 @@ type GameConfigurationDatastore struct {
 
 func (x *GameConfigurationDatastore) Reset() {
 	*x = GameConfigurationDatastore{}
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[9]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -561,7 +660,7 @@ func (x *GameConfigurationDatastore) String() string {
 func (*GameConfigurationDatastore) ProtoMessage() {}
 
 func (x *GameConfigurationDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[9]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +673,7 @@ func (x *GameConfigurationDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameConfigurationDatastore.ProtoReflect.Descriptor instead.
 func (*GameConfigurationDatastore) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{9}
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GameConfigurationDatastore) GetPlayers() []*GamePlayerDatastore {
@@ -613,7 +712,7 @@ type IncomeConfigDatastore struct {
 
 func (x *IncomeConfigDatastore) Reset() {
 	*x = IncomeConfigDatastore{}
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[10]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -625,7 +724,7 @@ func (x *IncomeConfigDatastore) String() string {
 func (*IncomeConfigDatastore) ProtoMessage() {}
 
 func (x *IncomeConfigDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[10]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,7 +737,7 @@ func (x *IncomeConfigDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncomeConfigDatastore.ProtoReflect.Descriptor instead.
 func (*IncomeConfigDatastore) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{10}
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{12}
 }
 
 type GamePlayerDatastore struct {
@@ -649,7 +748,7 @@ type GamePlayerDatastore struct {
 
 func (x *GamePlayerDatastore) Reset() {
 	*x = GamePlayerDatastore{}
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[11]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -661,7 +760,7 @@ func (x *GamePlayerDatastore) String() string {
 func (*GamePlayerDatastore) ProtoMessage() {}
 
 func (x *GamePlayerDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[11]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +773,7 @@ func (x *GamePlayerDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GamePlayerDatastore.ProtoReflect.Descriptor instead.
 func (*GamePlayerDatastore) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{11}
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{13}
 }
 
 type GameTeamDatastore struct {
@@ -685,7 +784,7 @@ type GameTeamDatastore struct {
 
 func (x *GameTeamDatastore) Reset() {
 	*x = GameTeamDatastore{}
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[12]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -697,7 +796,7 @@ func (x *GameTeamDatastore) String() string {
 func (*GameTeamDatastore) ProtoMessage() {}
 
 func (x *GameTeamDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[12]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +809,7 @@ func (x *GameTeamDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameTeamDatastore.ProtoReflect.Descriptor instead.
 func (*GameTeamDatastore) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{12}
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{14}
 }
 
 type GameSettingsDatastore struct {
@@ -723,7 +822,7 @@ type GameSettingsDatastore struct {
 
 func (x *GameSettingsDatastore) Reset() {
 	*x = GameSettingsDatastore{}
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[13]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -735,7 +834,7 @@ func (x *GameSettingsDatastore) String() string {
 func (*GameSettingsDatastore) ProtoMessage() {}
 
 func (x *GameSettingsDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[13]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +847,7 @@ func (x *GameSettingsDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameSettingsDatastore.ProtoReflect.Descriptor instead.
 func (*GameSettingsDatastore) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{13}
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GameSettingsDatastore) GetAllowedUnits() []int32 {
@@ -766,7 +865,7 @@ type PlayerStateDatastore struct {
 
 func (x *PlayerStateDatastore) Reset() {
 	*x = PlayerStateDatastore{}
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[14]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -778,7 +877,7 @@ func (x *PlayerStateDatastore) String() string {
 func (*PlayerStateDatastore) ProtoMessage() {}
 
 func (x *PlayerStateDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[14]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +890,241 @@ func (x *PlayerStateDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerStateDatastore.ProtoReflect.Descriptor instead.
 func (*PlayerStateDatastore) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{14}
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{16}
+}
+
+type TimeBankSettingsDatastore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeBankSettingsDatastore) Reset() {
+	*x = TimeBankSettingsDatastore{}
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeBankSettingsDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeBankSettingsDatastore) ProtoMessage() {}
+
+func (x *TimeBankSettingsDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeBankSettingsDatastore.ProtoReflect.Descriptor instead.
+func (*TimeBankSettingsDatastore) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{17}
+}
+
+type DraftSettingsDatastore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftSettingsDatastore) Reset() {
+	*x = DraftSettingsDatastore{}
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftSettingsDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftSettingsDatastore) ProtoMessage() {}
+
+func (x *DraftSettingsDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftSettingsDatastore.ProtoReflect.Descriptor instead.
+func (*DraftSettingsDatastore) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{18}
+}
+
+type DraftStateDatastore struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// BannedUnits as noindex (array of ints)
+	BannedUnits []int32 `protobuf:"varint,1,rep,packed,name=banned_units,json=bannedUnits,proto3" json:"banned_units,omitempty"`
+	// Picks keyed by unit type as noindex
+	PickedUnits   map[int32]int32 `protobuf:"bytes,2,rep,name=picked_units,json=pickedUnits,proto3" json:"picked_units,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftStateDatastore) Reset() {
+	*x = DraftStateDatastore{}
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftStateDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftStateDatastore) ProtoMessage() {}
+
+func (x *DraftStateDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftStateDatastore.ProtoReflect.Descriptor instead.
+func (*DraftStateDatastore) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DraftStateDatastore) GetBannedUnits() []int32 {
+	if x != nil {
+		return x.BannedUnits
+	}
+	return nil
+}
+
+func (x *DraftStateDatastore) GetPickedUnits() map[int32]int32 {
+	if x != nil {
+		return x.PickedUnits
+	}
+	return nil
+}
+
+type SpectatorDelayDatastore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectatorDelayDatastore) Reset() {
+	*x = SpectatorDelayDatastore{}
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectatorDelayDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatorDelayDatastore) ProtoMessage() {}
+
+func (x *SpectatorDelayDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatorDelayDatastore.ProtoReflect.Descriptor instead.
+func (*SpectatorDelayDatastore) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{20}
+}
+
+type SandboxSettingsDatastore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SandboxSettingsDatastore) Reset() {
+	*x = SandboxSettingsDatastore{}
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SandboxSettingsDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SandboxSettingsDatastore) ProtoMessage() {}
+
+func (x *SandboxSettingsDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SandboxSettingsDatastore.ProtoReflect.Descriptor instead.
+func (*SandboxSettingsDatastore) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{21}
+}
+
+type ConstructionProgressDatastore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConstructionProgressDatastore) Reset() {
+	*x = ConstructionProgressDatastore{}
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConstructionProgressDatastore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConstructionProgressDatastore) ProtoMessage() {}
+
+func (x *ConstructionProgressDatastore) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConstructionProgressDatastore.ProtoReflect.Descriptor instead.
+func (*ConstructionProgressDatastore) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{22}
 }
 
 // GameMoveDatastore stores individual moves
@@ -808,14 +1141,16 @@ type GameMoveDatastore struct {
 	// Stored as bytes, noindex (too large)
 	MoveType *anypb.Any `protobuf:"bytes,4,opt,name=move_type,json=moveType,proto3" json:"move_type,omitempty"`
 	// Changes - stored as bytes array, noindex (too large)
-	Changes       []*anypb.Any `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	Changes []*anypb.Any `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Coach verdicts are only returned to the acting player, never stored
+	CoachVerdict  bool `protobuf:"varint,6,opt,name=coach_verdict,json=coachVerdict,proto3" json:"coach_verdict,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GameMoveDatastore) Reset() {
 	*x = GameMoveDatastore{}
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[15]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -827,7 +1162,7 @@ func (x *GameMoveDatastore) String() string {
 func (*GameMoveDatastore) ProtoMessage() {}
 
 func (x *GameMoveDatastore) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[15]
+	mi := &file_lilbattle_v1_datastore_models_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +1175,7 @@ func (x *GameMoveDatastore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMoveDatastore.ProtoReflect.Descriptor instead.
 func (*GameMoveDatastore) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{15}
+	return file_lilbattle_v1_datastore_models_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GameMoveDatastore) GetGameId() string {
@@ -878,6 +1213,13 @@ func (x *GameMoveDatastore) GetChanges() []*anypb.Any {
 	return nil
 }
 
+func (x *GameMoveDatastore) GetCoachVerdict() bool {
+	if x != nil {
+		return x.CoachVerdict
+	}
+	return false
+}
+
 var File_lilbattle_v1_datastore_models_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_datastore_models_proto_rawDesc = "" +
@@ -888,14 +1230,22 @@ const file_lilbattle_v1_datastore_models_proto_rawDesc = "" +
 	"\x11CrossingDatastore:\x1bҦ\x1d\x17*\x15lilbattle.v1.Crossing\"\x83\x01\n" +
 	"\rUnitDatastore\x12Y\n" +
 	"\x0eattack_history\x18\x01 \x03(\v2#.lilbattle.v1.AttackRecordDatastoreB\r\x92\xa6\x1d\tr\anoindexR\rattackHistory:\x17Ҧ\x1d\x13*\x11lilbattle.v1.Unit\"8\n" +
-	"\x15AttackRecordDatastore:\x1fҦ\x1d\x1b*\x19lilbattle.v1.AttackRecord\"\xe5\x02\n" +
+	"\x15AttackRecordDatastore:\x1fҦ\x1d\x1b*\x19lilbattle.v1.AttackRecord\"\x8f\x04\n" +
 	"\x0eWorldDatastore\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\x92\xa6\x1d\x03r\x01-R\x02id\x12!\n" +
 	"\x04tags\x18\x02 \x03(\tB\r\x92\xa6\x1d\tr\anoindexR\x04tags\x120\n" +
 	"\fpreview_urls\x18\x03 \x03(\tB\r\x92\xa6\x1d\tr\anoindexR\vpreviewUrls\x12g\n" +
 	"\x13default_game_config\x18\x04 \x01(\v2(.lilbattle.v1.GameConfigurationDatastoreB\r\x92\xa6\x1d\tr\anoindexR\x11defaultGameConfig\x12[\n" +
-	"\x11search_index_info\x18\x05 \x01(\v2 .lilbattle.v1.IndexInfoDatastoreB\r\x92\xa6\x1d\tr\aflattenR\x0fsearchIndexInfo:\x1fҦ\x1d\x1b\n" +
-	"\x05World*\x12lilbattle.v1.World\"\xef\x05\n" +
+	"\x11search_index_info\x18\x05 \x01(\v2 .lilbattle.v1.IndexInfoDatastoreB\r\x92\xa6\x1d\tr\aflattenR\x0fsearchIndexInfo\x12I\n" +
+	"\x06rating\x18\x06 \x01(\v2\".lilbattle.v1.WorldRatingDatastoreB\r\x92\xa6\x1d\tr\anoindexR\x06rating\x12]\n" +
+	"\x0frules_overrides\x18\a \x01(\v2%.lilbattle.v1.RulesOverridesDatastoreB\r\x92\xa6\x1d\tr\anoindexR\x0erulesOverrides:\x1fҦ\x1d\x1b\n" +
+	"\x05World*\x12lilbattle.v1.World\"6\n" +
+	"\x14WorldRatingDatastore:\x1eҦ\x1d\x1a*\x18lilbattle.v1.WorldRating\"\x8c\x02\n" +
+	"\x17RulesOverridesDatastore\x12\x84\x01\n" +
+	"\x16terrain_movement_costs\x18\x01 \x03(\v2?.lilbattle.v1.RulesOverridesDatastore.TerrainMovementCostsEntryB\r\x92\xa6\x1d\tr\anoindexR\x14terrainMovementCosts\x1aG\n" +
+	"\x19TerrainMovementCostsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01:!Ҧ\x1d\x1d*\x1blilbattle.v1.RulesOverrides\"\xef\x05\n" +
 	"\x12WorldDataDatastore\x12\"\n" +
 	"\bworld_id\x18\x01 \x01(\tB\a\x92\xa6\x1d\x03r\x01-R\aworldId\x12Z\n" +
 	"\ttiles_map\x18\x02 \x03(\v2..lilbattle.v1.WorldDataDatastore.TilesMapEntryB\r\x92\xa6\x1d\tr\anoindexR\btilesMap\x12Z\n" +
@@ -939,14 +1289,26 @@ const file_lilbattle_v1_datastore_models_proto_rawDesc = "" +
 	"\x11GameTeamDatastore:\x1bҦ\x1d\x17*\x15lilbattle.v1.GameTeam\"l\n" +
 	"\x15GameSettingsDatastore\x122\n" +
 	"\rallowed_units\x18\x01 \x03(\x05B\r\x92\xa6\x1d\tr\anoindexR\fallowedUnits:\x1fҦ\x1d\x1b*\x19lilbattle.v1.GameSettings\"6\n" +
-	"\x14PlayerStateDatastore:\x1eҦ\x1d\x1a*\x18lilbattle.v1.PlayerState\"\x98\x02\n" +
+	"\x14PlayerStateDatastore:\x1eҦ\x1d\x1a*\x18lilbattle.v1.PlayerState\"@\n" +
+	"\x19TimeBankSettingsDatastore:#Ҧ\x1d\x1f*\x1dlilbattle.v1.TimeBankSettings\":\n" +
+	"\x16DraftSettingsDatastore: Ҧ\x1d\x1c*\x1alilbattle.v1.DraftSettings\"\x8c\x02\n" +
+	"\x13DraftStateDatastore\x120\n" +
+	"\fbanned_units\x18\x01 \x03(\x05B\r\x92\xa6\x1d\tr\anoindexR\vbannedUnits\x12d\n" +
+	"\fpicked_units\x18\x02 \x03(\v22.lilbattle.v1.DraftStateDatastore.PickedUnitsEntryB\r\x92\xa6\x1d\tr\anoindexR\vpickedUnits\x1a>\n" +
+	"\x10PickedUnitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01:\x1dҦ\x1d\x19*\x17lilbattle.v1.DraftState\"<\n" +
+	"\x17SpectatorDelayDatastore:!Ҧ\x1d\x1d*\x1blilbattle.v1.SpectatorDelay\">\n" +
+	"\x18SandboxSettingsDatastore:\"Ҧ\x1d\x1e*\x1clilbattle.v1.SandboxSettings\"H\n" +
+	"\x1dConstructionProgressDatastore:'Ҧ\x1d#*!lilbattle.v1.ConstructionProgress\"\xc3\x02\n" +
 	"\x11GameMoveDatastore\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
 	"\fgroup_number\x18\x02 \x01(\x03R\vgroupNumber\x12\x1f\n" +
 	"\vmove_number\x18\x03 \x01(\x03R\n" +
 	"moveNumber\x12@\n" +
 	"\tmove_type\x18\x04 \x01(\v2\x14.google.protobuf.AnyB\r\x92\xa6\x1d\tr\anoindexR\bmoveType\x12=\n" +
-	"\achanges\x18\x05 \x03(\v2\x14.google.protobuf.AnyB\r\x92\xa6\x1d\tr\anoindexR\achanges:%Ҧ\x1d!\n" +
+	"\achanges\x18\x05 \x03(\v2\x14.google.protobuf.AnyB\r\x92\xa6\x1d\tr\anoindexR\achanges\x12)\n" +
+	"\rcoach_verdict\x18\x06 \x01(\bB\x04\xb8\xa6\x1d\x01R\fcoachVerdict:%Ҧ\x1d!\n" +
 	"\bGameMove*\x15lilbattle.v1.GameMoveB\xba\x01\n" +
 	"\x10com.lilbattle.v1B\vModelsProtoP\x01ZHgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/datastore;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
@@ -962,57 +1324,71 @@ func file_lilbattle_v1_datastore_models_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_datastore_models_proto_rawDescData
 }
 
-var file_lilbattle_v1_datastore_models_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_lilbattle_v1_datastore_models_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_lilbattle_v1_datastore_models_proto_goTypes = []any{
-	(*IndexInfoDatastore)(nil),         // 0: lilbattle.v1.IndexInfoDatastore
-	(*TileDatastore)(nil),              // 1: lilbattle.v1.TileDatastore
-	(*CrossingDatastore)(nil),          // 2: lilbattle.v1.CrossingDatastore
-	(*UnitDatastore)(nil),              // 3: lilbattle.v1.UnitDatastore
-	(*AttackRecordDatastore)(nil),      // 4: lilbattle.v1.AttackRecordDatastore
-	(*WorldDatastore)(nil),             // 5: lilbattle.v1.WorldDatastore
-	(*WorldDataDatastore)(nil),         // 6: lilbattle.v1.WorldDataDatastore
-	(*GameDatastore)(nil),              // 7: lilbattle.v1.GameDatastore
-	(*GameStateDatastore)(nil),         // 8: lilbattle.v1.GameStateDatastore
-	(*GameConfigurationDatastore)(nil), // 9: lilbattle.v1.GameConfigurationDatastore
-	(*IncomeConfigDatastore)(nil),      // 10: lilbattle.v1.IncomeConfigDatastore
-	(*GamePlayerDatastore)(nil),        // 11: lilbattle.v1.GamePlayerDatastore
-	(*GameTeamDatastore)(nil),          // 12: lilbattle.v1.GameTeamDatastore
-	(*GameSettingsDatastore)(nil),      // 13: lilbattle.v1.GameSettingsDatastore
-	(*PlayerStateDatastore)(nil),       // 14: lilbattle.v1.PlayerStateDatastore
-	(*GameMoveDatastore)(nil),          // 15: lilbattle.v1.GameMoveDatastore
-	nil,                                // 16: lilbattle.v1.WorldDataDatastore.TilesMapEntry
-	nil,                                // 17: lilbattle.v1.WorldDataDatastore.UnitsMapEntry
-	nil,                                // 18: lilbattle.v1.WorldDataDatastore.CrossingsEntry
-	nil,                                // 19: lilbattle.v1.GameStateDatastore.PlayerStatesEntry
-	(*anypb.Any)(nil),                  // 20: google.protobuf.Any
+	(*IndexInfoDatastore)(nil),            // 0: lilbattle.v1.IndexInfoDatastore
+	(*TileDatastore)(nil),                 // 1: lilbattle.v1.TileDatastore
+	(*CrossingDatastore)(nil),             // 2: lilbattle.v1.CrossingDatastore
+	(*UnitDatastore)(nil),                 // 3: lilbattle.v1.UnitDatastore
+	(*AttackRecordDatastore)(nil),         // 4: lilbattle.v1.AttackRecordDatastore
+	(*WorldDatastore)(nil),                // 5: lilbattle.v1.WorldDatastore
+	(*WorldRatingDatastore)(nil),          // 6: lilbattle.v1.WorldRatingDatastore
+	(*RulesOverridesDatastore)(nil),       // 7: lilbattle.v1.RulesOverridesDatastore
+	(*WorldDataDatastore)(nil),            // 8: lilbattle.v1.WorldDataDatastore
+	(*GameDatastore)(nil),                 // 9: lilbattle.v1.GameDatastore
+	(*GameStateDatastore)(nil),            // 10: lilbattle.v1.GameStateDatastore
+	(*GameConfigurationDatastore)(nil),    // 11: lilbattle.v1.GameConfigurationDatastore
+	(*IncomeConfigDatastore)(nil),         // 12: lilbattle.v1.IncomeConfigDatastore
+	(*GamePlayerDatastore)(nil),           // 13: lilbattle.v1.GamePlayerDatastore
+	(*GameTeamDatastore)(nil),             // 14: lilbattle.v1.GameTeamDatastore
+	(*GameSettingsDatastore)(nil),         // 15: lilbattle.v1.GameSettingsDatastore
+	(*PlayerStateDatastore)(nil),          // 16: lilbattle.v1.PlayerStateDatastore
+	(*TimeBankSettingsDatastore)(nil),     // 17: lilbattle.v1.TimeBankSettingsDatastore
+	(*DraftSettingsDatastore)(nil),        // 18: lilbattle.v1.DraftSettingsDatastore
+	(*DraftStateDatastore)(nil),           // 19: lilbattle.v1.DraftStateDatastore
+	(*SpectatorDelayDatastore)(nil),       // 20: lilbattle.v1.SpectatorDelayDatastore
+	(*SandboxSettingsDatastore)(nil),      // 21: lilbattle.v1.SandboxSettingsDatastore
+	(*ConstructionProgressDatastore)(nil), // 22: lilbattle.v1.ConstructionProgressDatastore
+	(*GameMoveDatastore)(nil),             // 23: lilbattle.v1.GameMoveDatastore
+	nil,                                   // 24: lilbattle.v1.RulesOverridesDatastore.TerrainMovementCostsEntry
+	nil,                                   // 25: lilbattle.v1.WorldDataDatastore.TilesMapEntry
+	nil,                                   // 26: lilbattle.v1.WorldDataDatastore.UnitsMapEntry
+	nil,                                   // 27: lilbattle.v1.WorldDataDatastore.CrossingsEntry
+	nil,                                   // 28: lilbattle.v1.GameStateDatastore.PlayerStatesEntry
+	nil,                                   // 29: lilbattle.v1.DraftStateDatastore.PickedUnitsEntry
+	(*anypb.Any)(nil),                     // 30: google.protobuf.Any
 }
 var file_lilbattle_v1_datastore_models_proto_depIdxs = []int32{
 	4,  // 0: lilbattle.v1.UnitDatastore.attack_history:type_name -> lilbattle.v1.AttackRecordDatastore
-	9,  // 1: lilbattle.v1.WorldDatastore.default_game_config:type_name -> lilbattle.v1.GameConfigurationDatastore
+	11, // 1: lilbattle.v1.WorldDatastore.default_game_config:type_name -> lilbattle.v1.GameConfigurationDatastore
 	0,  // 2: lilbattle.v1.WorldDatastore.search_index_info:type_name -> lilbattle.v1.IndexInfoDatastore
-	16, // 3: lilbattle.v1.WorldDataDatastore.tiles_map:type_name -> lilbattle.v1.WorldDataDatastore.TilesMapEntry
-	17, // 4: lilbattle.v1.WorldDataDatastore.units_map:type_name -> lilbattle.v1.WorldDataDatastore.UnitsMapEntry
-	18, // 5: lilbattle.v1.WorldDataDatastore.crossings:type_name -> lilbattle.v1.WorldDataDatastore.CrossingsEntry
-	0,  // 6: lilbattle.v1.WorldDataDatastore.screenshot_index_info:type_name -> lilbattle.v1.IndexInfoDatastore
-	9,  // 7: lilbattle.v1.GameDatastore.config:type_name -> lilbattle.v1.GameConfigurationDatastore
-	0,  // 8: lilbattle.v1.GameDatastore.search_index_info:type_name -> lilbattle.v1.IndexInfoDatastore
-	6,  // 9: lilbattle.v1.GameStateDatastore.world_data:type_name -> lilbattle.v1.WorldDataDatastore
-	19, // 10: lilbattle.v1.GameStateDatastore.player_states:type_name -> lilbattle.v1.GameStateDatastore.PlayerStatesEntry
-	11, // 11: lilbattle.v1.GameConfigurationDatastore.players:type_name -> lilbattle.v1.GamePlayerDatastore
-	12, // 12: lilbattle.v1.GameConfigurationDatastore.teams:type_name -> lilbattle.v1.GameTeamDatastore
-	10, // 13: lilbattle.v1.GameConfigurationDatastore.income_configs:type_name -> lilbattle.v1.IncomeConfigDatastore
-	13, // 14: lilbattle.v1.GameConfigurationDatastore.settings:type_name -> lilbattle.v1.GameSettingsDatastore
-	20, // 15: lilbattle.v1.GameMoveDatastore.move_type:type_name -> google.protobuf.Any
-	20, // 16: lilbattle.v1.GameMoveDatastore.changes:type_name -> google.protobuf.Any
-	1,  // 17: lilbattle.v1.WorldDataDatastore.TilesMapEntry.value:type_name -> lilbattle.v1.TileDatastore
-	3,  // 18: lilbattle.v1.WorldDataDatastore.UnitsMapEntry.value:type_name -> lilbattle.v1.UnitDatastore
-	2,  // 19: lilbattle.v1.WorldDataDatastore.CrossingsEntry.value:type_name -> lilbattle.v1.CrossingDatastore
-	14, // 20: lilbattle.v1.GameStateDatastore.PlayerStatesEntry.value:type_name -> lilbattle.v1.PlayerStateDatastore
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	6,  // 3: lilbattle.v1.WorldDatastore.rating:type_name -> lilbattle.v1.WorldRatingDatastore
+	7,  // 4: lilbattle.v1.WorldDatastore.rules_overrides:type_name -> lilbattle.v1.RulesOverridesDatastore
+	24, // 5: lilbattle.v1.RulesOverridesDatastore.terrain_movement_costs:type_name -> lilbattle.v1.RulesOverridesDatastore.TerrainMovementCostsEntry
+	25, // 6: lilbattle.v1.WorldDataDatastore.tiles_map:type_name -> lilbattle.v1.WorldDataDatastore.TilesMapEntry
+	26, // 7: lilbattle.v1.WorldDataDatastore.units_map:type_name -> lilbattle.v1.WorldDataDatastore.UnitsMapEntry
+	27, // 8: lilbattle.v1.WorldDataDatastore.crossings:type_name -> lilbattle.v1.WorldDataDatastore.CrossingsEntry
+	0,  // 9: lilbattle.v1.WorldDataDatastore.screenshot_index_info:type_name -> lilbattle.v1.IndexInfoDatastore
+	11, // 10: lilbattle.v1.GameDatastore.config:type_name -> lilbattle.v1.GameConfigurationDatastore
+	0,  // 11: lilbattle.v1.GameDatastore.search_index_info:type_name -> lilbattle.v1.IndexInfoDatastore
+	8,  // 12: lilbattle.v1.GameStateDatastore.world_data:type_name -> lilbattle.v1.WorldDataDatastore
+	28, // 13: lilbattle.v1.GameStateDatastore.player_states:type_name -> lilbattle.v1.GameStateDatastore.PlayerStatesEntry
+	13, // 14: lilbattle.v1.GameConfigurationDatastore.players:type_name -> lilbattle.v1.GamePlayerDatastore
+	14, // 15: lilbattle.v1.GameConfigurationDatastore.teams:type_name -> lilbattle.v1.GameTeamDatastore
+	12, // 16: lilbattle.v1.GameConfigurationDatastore.income_configs:type_name -> lilbattle.v1.IncomeConfigDatastore
+	15, // 17: lilbattle.v1.GameConfigurationDatastore.settings:type_name -> lilbattle.v1.GameSettingsDatastore
+	29, // 18: lilbattle.v1.DraftStateDatastore.picked_units:type_name -> lilbattle.v1.DraftStateDatastore.PickedUnitsEntry
+	30, // 19: lilbattle.v1.GameMoveDatastore.move_type:type_name -> google.protobuf.Any
+	30, // 20: lilbattle.v1.GameMoveDatastore.changes:type_name -> google.protobuf.Any
+	1,  // 21: lilbattle.v1.WorldDataDatastore.TilesMapEntry.value:type_name -> lilbattle.v1.TileDatastore
+	3,  // 22: lilbattle.v1.WorldDataDatastore.UnitsMapEntry.value:type_name -> lilbattle.v1.UnitDatastore
+	2,  // 23: lilbattle.v1.WorldDataDatastore.CrossingsEntry.value:type_name -> lilbattle.v1.CrossingDatastore
+	16, // 24: lilbattle.v1.GameStateDatastore.PlayerStatesEntry.value:type_name -> lilbattle.v1.PlayerStateDatastore
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_datastore_models_proto_init() }
@@ -1026,7 +1402,7 @@ func file_lilbattle_v1_datastore_models_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_datastore_models_proto_rawDesc), len(file_lilbattle_v1_datastore_models_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -278,6 +278,86 @@ func (x *WorldGORM) GetSearchIndexInfo() *IndexInfoGORM {
 	return nil
 }
 
+type WorldRatingGORM struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldRatingGORM) Reset() {
+	*x = WorldRatingGORM{}
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldRatingGORM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldRatingGORM) ProtoMessage() {}
+
+func (x *WorldRatingGORM) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldRatingGORM.ProtoReflect.Descriptor instead.
+func (*WorldRatingGORM) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{6}
+}
+
+type RulesOverridesGORM struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TerrainMovementCosts map[int32]float64      `protobuf:"bytes,1,rep,name=terrain_movement_costs,json=terrainMovementCosts,proto3" json:"terrain_movement_costs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RulesOverridesGORM) Reset() {
+	*x = RulesOverridesGORM{}
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RulesOverridesGORM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesOverridesGORM) ProtoMessage() {}
+
+func (x *RulesOverridesGORM) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesOverridesGORM.ProtoReflect.Descriptor instead.
+func (*RulesOverridesGORM) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RulesOverridesGORM) GetTerrainMovementCosts() map[int32]float64 {
+	if x != nil {
+		return x.TerrainMovementCosts
+	}
+	return nil
+}
+
 type WorldDataGORM struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
@@ -295,7 +375,7 @@ type WorldDataGORM struct {
 
 func (x *WorldDataGORM) Reset() {
 	*x = WorldDataGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[6]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -307,7 +387,7 @@ func (x *WorldDataGORM) String() string {
 func (*WorldDataGORM) ProtoMessage() {}
 
 func (x *WorldDataGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[6]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,7 +400,7 @@ func (x *WorldDataGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorldDataGORM.ProtoReflect.Descriptor instead.
 func (*WorldDataGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{6}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WorldDataGORM) GetWorldId() string {
@@ -376,7 +456,7 @@ type GameGORM struct {
 
 func (x *GameGORM) Reset() {
 	*x = GameGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[7]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -388,7 +468,7 @@ func (x *GameGORM) String() string {
 func (*GameGORM) ProtoMessage() {}
 
 func (x *GameGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[7]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,7 +481,7 @@ func (x *GameGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameGORM.ProtoReflect.Descriptor instead.
 func (*GameGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{7}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GameGORM) GetId() string {
@@ -453,7 +533,7 @@ type GameStateGORM struct {
 
 func (x *GameStateGORM) Reset() {
 	*x = GameStateGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[8]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +545,7 @@ func (x *GameStateGORM) String() string {
 func (*GameStateGORM) ProtoMessage() {}
 
 func (x *GameStateGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[8]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +558,7 @@ func (x *GameStateGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameStateGORM.ProtoReflect.Descriptor instead.
 func (*GameStateGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{8}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GameStateGORM) GetGameId() string {
@@ -514,7 +594,7 @@ type GameConfigurationGORM struct {
 
 func (x *GameConfigurationGORM) Reset() {
 	*x = GameConfigurationGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[9]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +606,7 @@ func (x *GameConfigurationGORM) String() string {
 func (*GameConfigurationGORM) ProtoMessage() {}
 
 func (x *GameConfigurationGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[9]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +619,7 @@ func (x *GameConfigurationGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameConfigurationGORM.ProtoReflect.Descriptor instead.
 func (*GameConfigurationGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{9}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GameConfigurationGORM) GetIncomeConfigs() *IncomeConfigGORM {
@@ -564,7 +644,7 @@ type IncomeConfigGORM struct {
 
 func (x *IncomeConfigGORM) Reset() {
 	*x = IncomeConfigGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[10]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -576,7 +656,7 @@ func (x *IncomeConfigGORM) String() string {
 func (*IncomeConfigGORM) ProtoMessage() {}
 
 func (x *IncomeConfigGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[10]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +669,7 @@ func (x *IncomeConfigGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncomeConfigGORM.ProtoReflect.Descriptor instead.
 func (*IncomeConfigGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{10}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{12}
 }
 
 type GamePlayerGORM struct {
@@ -600,7 +680,7 @@ type GamePlayerGORM struct {
 
 func (x *GamePlayerGORM) Reset() {
 	*x = GamePlayerGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[11]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -612,7 +692,7 @@ func (x *GamePlayerGORM) String() string {
 func (*GamePlayerGORM) ProtoMessage() {}
 
 func (x *GamePlayerGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[11]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +705,7 @@ func (x *GamePlayerGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GamePlayerGORM.ProtoReflect.Descriptor instead.
 func (*GamePlayerGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{11}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{13}
 }
 
 type GameTeamGORM struct {
@@ -636,7 +716,7 @@ type GameTeamGORM struct {
 
 func (x *GameTeamGORM) Reset() {
 	*x = GameTeamGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[12]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -648,7 +728,7 @@ func (x *GameTeamGORM) String() string {
 func (*GameTeamGORM) ProtoMessage() {}
 
 func (x *GameTeamGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[12]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,7 +741,7 @@ func (x *GameTeamGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameTeamGORM.ProtoReflect.Descriptor instead.
 func (*GameTeamGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{12}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{14}
 }
 
 type GameSettingsGORM struct {
@@ -674,7 +754,7 @@ type GameSettingsGORM struct {
 
 func (x *GameSettingsGORM) Reset() {
 	*x = GameSettingsGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[13]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -686,7 +766,7 @@ func (x *GameSettingsGORM) String() string {
 func (*GameSettingsGORM) ProtoMessage() {}
 
 func (x *GameSettingsGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[13]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +779,7 @@ func (x *GameSettingsGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameSettingsGORM.ProtoReflect.Descriptor instead.
 func (*GameSettingsGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{13}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GameSettingsGORM) GetAllowedUnits() []int32 {
@@ -717,7 +797,7 @@ type PlayerStateGORM struct {
 
 func (x *PlayerStateGORM) Reset() {
 	*x = PlayerStateGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[14]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -729,7 +809,7 @@ func (x *PlayerStateGORM) String() string {
 func (*PlayerStateGORM) ProtoMessage() {}
 
 func (x *PlayerStateGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[14]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,7 +822,239 @@ func (x *PlayerStateGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerStateGORM.ProtoReflect.Descriptor instead.
 func (*PlayerStateGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{14}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{16}
+}
+
+type TimeBankSettingsGORM struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeBankSettingsGORM) Reset() {
+	*x = TimeBankSettingsGORM{}
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeBankSettingsGORM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeBankSettingsGORM) ProtoMessage() {}
+
+func (x *TimeBankSettingsGORM) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeBankSettingsGORM.ProtoReflect.Descriptor instead.
+func (*TimeBankSettingsGORM) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{17}
+}
+
+type DraftSettingsGORM struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftSettingsGORM) Reset() {
+	*x = DraftSettingsGORM{}
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftSettingsGORM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftSettingsGORM) ProtoMessage() {}
+
+func (x *DraftSettingsGORM) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftSettingsGORM.ProtoReflect.Descriptor instead.
+func (*DraftSettingsGORM) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{18}
+}
+
+type DraftStateGORM struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BannedUnits   []int32                `protobuf:"varint,1,rep,packed,name=banned_units,json=bannedUnits,proto3" json:"banned_units,omitempty"`
+	PickedUnits   map[int32]int32        `protobuf:"bytes,2,rep,name=picked_units,json=pickedUnits,proto3" json:"picked_units,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftStateGORM) Reset() {
+	*x = DraftStateGORM{}
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftStateGORM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftStateGORM) ProtoMessage() {}
+
+func (x *DraftStateGORM) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftStateGORM.ProtoReflect.Descriptor instead.
+func (*DraftStateGORM) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DraftStateGORM) GetBannedUnits() []int32 {
+	if x != nil {
+		return x.BannedUnits
+	}
+	return nil
+}
+
+func (x *DraftStateGORM) GetPickedUnits() map[int32]int32 {
+	if x != nil {
+		return x.PickedUnits
+	}
+	return nil
+}
+
+type SpectatorDelayGORM struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectatorDelayGORM) Reset() {
+	*x = SpectatorDelayGORM{}
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectatorDelayGORM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatorDelayGORM) ProtoMessage() {}
+
+func (x *SpectatorDelayGORM) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatorDelayGORM.ProtoReflect.Descriptor instead.
+func (*SpectatorDelayGORM) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{20}
+}
+
+type SandboxSettingsGORM struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SandboxSettingsGORM) Reset() {
+	*x = SandboxSettingsGORM{}
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SandboxSettingsGORM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SandboxSettingsGORM) ProtoMessage() {}
+
+func (x *SandboxSettingsGORM) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SandboxSettingsGORM.ProtoReflect.Descriptor instead.
+func (*SandboxSettingsGORM) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{21}
+}
+
+type ConstructionProgressGORM struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConstructionProgressGORM) Reset() {
+	*x = ConstructionProgressGORM{}
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConstructionProgressGORM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConstructionProgressGORM) ProtoMessage() {}
+
+func (x *ConstructionProgressGORM) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConstructionProgressGORM.ProtoReflect.Descriptor instead.
+func (*ConstructionProgressGORM) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{22}
 }
 
 // GameWorldDataGORM is same as WorldDataGORM but without the
@@ -763,7 +1075,7 @@ type GameWorldDataGORM struct {
 
 func (x *GameWorldDataGORM) Reset() {
 	*x = GameWorldDataGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[15]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -775,7 +1087,7 @@ func (x *GameWorldDataGORM) String() string {
 func (*GameWorldDataGORM) ProtoMessage() {}
 
 func (x *GameWorldDataGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[15]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +1100,7 @@ func (x *GameWorldDataGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameWorldDataGORM.ProtoReflect.Descriptor instead.
 func (*GameWorldDataGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{15}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GameWorldDataGORM) GetScreenshotIndexInfo() *IndexInfoGORM {
@@ -828,7 +1140,7 @@ type GameMoveHistoryGORM struct {
 
 func (x *GameMoveHistoryGORM) Reset() {
 	*x = GameMoveHistoryGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[16]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -840,7 +1152,7 @@ func (x *GameMoveHistoryGORM) String() string {
 func (*GameMoveHistoryGORM) ProtoMessage() {}
 
 func (x *GameMoveHistoryGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[16]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,7 +1165,7 @@ func (x *GameMoveHistoryGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMoveHistoryGORM.ProtoReflect.Descriptor instead.
 func (*GameMoveHistoryGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{16}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{24}
 }
 
 // A move group - we can allow X moves in one "tick"
@@ -865,7 +1177,7 @@ type GameMoveGroupGORM struct {
 
 func (x *GameMoveGroupGORM) Reset() {
 	*x = GameMoveGroupGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[17]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -877,7 +1189,7 @@ func (x *GameMoveGroupGORM) String() string {
 func (*GameMoveGroupGORM) ProtoMessage() {}
 
 func (x *GameMoveGroupGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[17]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +1202,7 @@ func (x *GameMoveGroupGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMoveGroupGORM.ProtoReflect.Descriptor instead.
 func (*GameMoveGroupGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{17}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{25}
 }
 
 // *
@@ -907,15 +1219,17 @@ type GameMoveGORM struct {
 	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 	// Field named "move_type" matches the oneof name in source
 	// This automatically skips all oneof members (move_unit, attack_unit, end_turn, build_unit)
-	MoveType      *anypb.Any   `protobuf:"bytes,5,opt,name=move_type,json=moveType,proto3" json:"move_type,omitempty"`
-	Changes       []*anypb.Any `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	MoveType *anypb.Any   `protobuf:"bytes,5,opt,name=move_type,json=moveType,proto3" json:"move_type,omitempty"`
+	Changes  []*anypb.Any `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Coach verdicts are only returned to the acting player, never stored
+	CoachVerdict  bool `protobuf:"varint,7,opt,name=coach_verdict,json=coachVerdict,proto3" json:"coach_verdict,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GameMoveGORM) Reset() {
 	*x = GameMoveGORM{}
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[18]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -927,7 +1241,7 @@ func (x *GameMoveGORM) String() string {
 func (*GameMoveGORM) ProtoMessage() {}
 
 func (x *GameMoveGORM) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[18]
+	mi := &file_lilbattle_v1_gorm_models_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -940,7 +1254,7 @@ func (x *GameMoveGORM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMoveGORM.ProtoReflect.Descriptor instead.
 func (*GameMoveGORM) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{18}
+	return file_lilbattle_v1_gorm_models_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GameMoveGORM) GetGameId() string {
@@ -985,6 +1299,13 @@ func (x *GameMoveGORM) GetChanges() []*anypb.Any {
 	return nil
 }
 
+func (x *GameMoveGORM) GetCoachVerdict() bool {
+	if x != nil {
+		return x.CoachVerdict
+	}
+	return false
+}
+
 var File_lilbattle_v1_gorm_models_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_gorm_models_proto_rawDesc = "" +
@@ -1006,7 +1327,15 @@ const file_lilbattle_v1_gorm_models_proto_rawDesc = "" +
 	"\x04tags\x18\a \x03(\tB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\x04tags\x128\n" +
 	"\fpreview_urls\x18\v \x03(\tB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\vpreviewUrls\x12u\n" +
 	"\x11search_index_info\x18\r \x01(\v2\x1b.lilbattle.v1.IndexInfoGORMB,\x92\xa6\x1d(R\bembeddedR\x1cembeddedPrefix:search_index_R\x0fsearchIndexInfo: ʦ\x1d\x1c\n" +
-	"\x12lilbattle.v1.World\x12\x06worlds\"\x8f\x06\n" +
+	"\x12lilbattle.v1.World\x12\x06worlds\"3\n" +
+	"\x0fWorldRatingGORM: ʦ\x1d\x1c\n" +
+	"\x18lilbattle.v1.WorldRating \x01\"\x8c\x02\n" +
+	"\x12RulesOverridesGORM\x12\x87\x01\n" +
+	"\x16terrain_movement_costs\x18\x01 \x03(\v2:.lilbattle.v1.RulesOverridesGORM.TerrainMovementCostsEntryB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\x14terrainMovementCosts\x1aG\n" +
+	"\x19TerrainMovementCostsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01:#ʦ\x1d\x1f\n" +
+	"\x1blilbattle.v1.RulesOverrides \x01\"\x8f\x06\n" +
 	"\rWorldDataGORM\x12+\n" +
 	"\bworld_id\x18\x01 \x01(\tB\x10\x92\xa6\x1d\fR\n" +
 	"primaryKeyR\aworldId\x12_\n" +
@@ -1059,7 +1388,24 @@ const file_lilbattle_v1_gorm_models_proto_rawDesc = "" +
 	"\rallowed_units\x18\x01 \x03(\x05B\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\fallowedUnits:\x1fʦ\x1d\x1b\n" +
 	"\x19lilbattle.v1.GameSettings\"3\n" +
 	"\x0fPlayerStateGORM: ʦ\x1d\x1c\n" +
-	"\x18lilbattle.v1.PlayerState \x01\"\xe4\x05\n" +
+	"\x18lilbattle.v1.PlayerState \x01\"=\n" +
+	"\x14TimeBankSettingsGORM:%ʦ\x1d!\n" +
+	"\x1dlilbattle.v1.TimeBankSettings \x01\"7\n" +
+	"\x11DraftSettingsGORM:\"ʦ\x1d\x1e\n" +
+	"\x1alilbattle.v1.DraftSettings \x01\"\x94\x02\n" +
+	"\x0eDraftStateGORM\x128\n" +
+	"\fbanned_units\x18\x01 \x03(\x05B\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\vbannedUnits\x12g\n" +
+	"\fpicked_units\x18\x02 \x03(\v2-.lilbattle.v1.DraftStateGORM.PickedUnitsEntryB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\vpickedUnits\x1a>\n" +
+	"\x10PickedUnitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01:\x1fʦ\x1d\x1b\n" +
+	"\x17lilbattle.v1.DraftState \x01\"9\n" +
+	"\x12SpectatorDelayGORM:#ʦ\x1d\x1f\n" +
+	"\x1blilbattle.v1.SpectatorDelay \x01\";\n" +
+	"\x13SandboxSettingsGORM:$ʦ\x1d \n" +
+	"\x1clilbattle.v1.SandboxSettings \x01\"E\n" +
+	"\x18ConstructionProgressGORM:)ʦ\x1d%\n" +
+	"!lilbattle.v1.ConstructionProgress \x01\"\xe4\x05\n" +
 	"\x11GameWorldDataGORM\x12\x81\x01\n" +
 	"\x15screenshot_index_info\x18\x04 \x01(\v2\x1b.lilbattle.v1.IndexInfoGORMB0\x92\xa6\x1d,R\bembeddedR embeddedPrefix:screenshot_index_R\x13screenshotIndexInfo\x12c\n" +
 	"\tcrossings\x18\x05 \x03(\v2..lilbattle.v1.GameWorldDataGORM.CrossingsEntryB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\tcrossings\x12a\n" +
@@ -1078,7 +1424,7 @@ const file_lilbattle_v1_gorm_models_proto_rawDesc = "" +
 	"\x13GameMoveHistoryGORM:\"ʦ\x1d\x1e\n" +
 	"\x1clilbattle.v1.GameMoveHistory\"5\n" +
 	"\x11GameMoveGroupGORM: ʦ\x1d\x1c\n" +
-	"\x1alilbattle.v1.GameMoveGroup\"\xe3\x03\n" +
+	"\x1alilbattle.v1.GameMoveGroup\"\x8e\x04\n" +
 	"\fGameMoveGORM\x12o\n" +
 	"\agame_id\x18\x01 \x01(\tBV\x92\xa6\x1dRR\n" +
 	"primaryKeyR\x1cindex:idx_game_moves_game_idR&index:idx_game_moves_lookup,priority:1R\x06gameId\x12[\n" +
@@ -1089,7 +1435,8 @@ const file_lilbattle_v1_gorm_models_proto_rawDesc = "" +
 	"moveNumber\x12\x18\n" +
 	"\aversion\x18\x04 \x01(\x03R\aversion\x12H\n" +
 	"\tmove_type\x18\x05 \x01(\v2\x14.google.protobuf.AnyB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\bmoveType\x12E\n" +
-	"\achanges\x18\x06 \x03(\v2\x14.google.protobuf.AnyB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\achanges:'ʦ\x1d#\n" +
+	"\achanges\x18\x06 \x03(\v2\x14.google.protobuf.AnyB\x15\x92\xa6\x1d\x11R\x0fserializer:jsonR\achanges\x12)\n" +
+	"\rcoach_verdict\x18\a \x01(\bB\x04\xb8\xa6\x1d\x01R\fcoachVerdict:'ʦ\x1d#\n" +
 	"\x15lilbattle.v1.GameMove\x12\n" +
 	"game_movesB\xb5\x01\n" +
 	"\x10com.lilbattle.v1B\vModelsProtoP\x01ZCgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/gorm;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
@@ -1106,65 +1453,77 @@ func file_lilbattle_v1_gorm_models_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_gorm_models_proto_rawDescData
 }
 
-var file_lilbattle_v1_gorm_models_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_lilbattle_v1_gorm_models_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_lilbattle_v1_gorm_models_proto_goTypes = []any{
-	(*IndexInfoGORM)(nil),         // 0: lilbattle.v1.IndexInfoGORM
-	(*TileGORM)(nil),              // 1: lilbattle.v1.TileGORM
-	(*CrossingGORM)(nil),          // 2: lilbattle.v1.CrossingGORM
-	(*UnitGORM)(nil),              // 3: lilbattle.v1.UnitGORM
-	(*AttackRecordGORM)(nil),      // 4: lilbattle.v1.AttackRecordGORM
-	(*WorldGORM)(nil),             // 5: lilbattle.v1.WorldGORM
-	(*WorldDataGORM)(nil),         // 6: lilbattle.v1.WorldDataGORM
-	(*GameGORM)(nil),              // 7: lilbattle.v1.GameGORM
-	(*GameStateGORM)(nil),         // 8: lilbattle.v1.GameStateGORM
-	(*GameConfigurationGORM)(nil), // 9: lilbattle.v1.GameConfigurationGORM
-	(*IncomeConfigGORM)(nil),      // 10: lilbattle.v1.IncomeConfigGORM
-	(*GamePlayerGORM)(nil),        // 11: lilbattle.v1.GamePlayerGORM
-	(*GameTeamGORM)(nil),          // 12: lilbattle.v1.GameTeamGORM
-	(*GameSettingsGORM)(nil),      // 13: lilbattle.v1.GameSettingsGORM
-	(*PlayerStateGORM)(nil),       // 14: lilbattle.v1.PlayerStateGORM
-	(*GameWorldDataGORM)(nil),     // 15: lilbattle.v1.GameWorldDataGORM
-	(*GameMoveHistoryGORM)(nil),   // 16: lilbattle.v1.GameMoveHistoryGORM
-	(*GameMoveGroupGORM)(nil),     // 17: lilbattle.v1.GameMoveGroupGORM
-	(*GameMoveGORM)(nil),          // 18: lilbattle.v1.GameMoveGORM
-	nil,                           // 19: lilbattle.v1.WorldDataGORM.CrossingsEntry
-	nil,                           // 20: lilbattle.v1.WorldDataGORM.TilesMapEntry
-	nil,                           // 21: lilbattle.v1.WorldDataGORM.UnitsMapEntry
-	nil,                           // 22: lilbattle.v1.GameStateGORM.PlayerStatesEntry
-	nil,                           // 23: lilbattle.v1.GameWorldDataGORM.CrossingsEntry
-	nil,                           // 24: lilbattle.v1.GameWorldDataGORM.TilesMapEntry
-	nil,                           // 25: lilbattle.v1.GameWorldDataGORM.UnitsMapEntry
-	(*anypb.Any)(nil),             // 26: google.protobuf.Any
+	(*IndexInfoGORM)(nil),            // 0: lilbattle.v1.IndexInfoGORM
+	(*TileGORM)(nil),                 // 1: lilbattle.v1.TileGORM
+	(*CrossingGORM)(nil),             // 2: lilbattle.v1.CrossingGORM
+	(*UnitGORM)(nil),                 // 3: lilbattle.v1.UnitGORM
+	(*AttackRecordGORM)(nil),         // 4: lilbattle.v1.AttackRecordGORM
+	(*WorldGORM)(nil),                // 5: lilbattle.v1.WorldGORM
+	(*WorldRatingGORM)(nil),          // 6: lilbattle.v1.WorldRatingGORM
+	(*RulesOverridesGORM)(nil),       // 7: lilbattle.v1.RulesOverridesGORM
+	(*WorldDataGORM)(nil),            // 8: lilbattle.v1.WorldDataGORM
+	(*GameGORM)(nil),                 // 9: lilbattle.v1.GameGORM
+	(*GameStateGORM)(nil),            // 10: lilbattle.v1.GameStateGORM
+	(*GameConfigurationGORM)(nil),    // 11: lilbattle.v1.GameConfigurationGORM
+	(*IncomeConfigGORM)(nil),         // 12: lilbattle.v1.IncomeConfigGORM
+	(*GamePlayerGORM)(nil),           // 13: lilbattle.v1.GamePlayerGORM
+	(*GameTeamGORM)(nil),             // 14: lilbattle.v1.GameTeamGORM
+	(*GameSettingsGORM)(nil),         // 15: lilbattle.v1.GameSettingsGORM
+	(*PlayerStateGORM)(nil),          // 16: lilbattle.v1.PlayerStateGORM
+	(*TimeBankSettingsGORM)(nil),     // 17: lilbattle.v1.TimeBankSettingsGORM
+	(*DraftSettingsGORM)(nil),        // 18: lilbattle.v1.DraftSettingsGORM
+	(*DraftStateGORM)(nil),           // 19: lilbattle.v1.DraftStateGORM
+	(*SpectatorDelayGORM)(nil),       // 20: lilbattle.v1.SpectatorDelayGORM
+	(*SandboxSettingsGORM)(nil),      // 21: lilbattle.v1.SandboxSettingsGORM
+	(*ConstructionProgressGORM)(nil), // 22: lilbattle.v1.ConstructionProgressGORM
+	(*GameWorldDataGORM)(nil),        // 23: lilbattle.v1.GameWorldDataGORM
+	(*GameMoveHistoryGORM)(nil),      // 24: lilbattle.v1.GameMoveHistoryGORM
+	(*GameMoveGroupGORM)(nil),        // 25: lilbattle.v1.GameMoveGroupGORM
+	(*GameMoveGORM)(nil),             // 26: lilbattle.v1.GameMoveGORM
+	nil,                              // 27: lilbattle.v1.RulesOverridesGORM.TerrainMovementCostsEntry
+	nil,                              // 28: lilbattle.v1.WorldDataGORM.CrossingsEntry
+	nil,                              // 29: lilbattle.v1.WorldDataGORM.TilesMapEntry
+	nil,                              // 30: lilbattle.v1.WorldDataGORM.UnitsMapEntry
+	nil,                              // 31: lilbattle.v1.GameStateGORM.PlayerStatesEntry
+	nil,                              // 32: lilbattle.v1.DraftStateGORM.PickedUnitsEntry
+	nil,                              // 33: lilbattle.v1.GameWorldDataGORM.CrossingsEntry
+	nil,                              // 34: lilbattle.v1.GameWorldDataGORM.TilesMapEntry
+	nil,                              // 35: lilbattle.v1.GameWorldDataGORM.UnitsMapEntry
+	(*anypb.Any)(nil),                // 36: google.protobuf.Any
 }
 var file_lilbattle_v1_gorm_models_proto_depIdxs = []int32{
 	0,  // 0: lilbattle.v1.WorldGORM.search_index_info:type_name -> lilbattle.v1.IndexInfoGORM
-	19, // 1: lilbattle.v1.WorldDataGORM.crossings:type_name -> lilbattle.v1.WorldDataGORM.CrossingsEntry
-	0,  // 2: lilbattle.v1.WorldDataGORM.screenshot_index_info:type_name -> lilbattle.v1.IndexInfoGORM
-	20, // 3: lilbattle.v1.WorldDataGORM.tiles_map:type_name -> lilbattle.v1.WorldDataGORM.TilesMapEntry
-	21, // 4: lilbattle.v1.WorldDataGORM.units_map:type_name -> lilbattle.v1.WorldDataGORM.UnitsMapEntry
-	0,  // 5: lilbattle.v1.GameGORM.search_index_info:type_name -> lilbattle.v1.IndexInfoGORM
-	15, // 6: lilbattle.v1.GameStateGORM.world_data:type_name -> lilbattle.v1.GameWorldDataGORM
-	22, // 7: lilbattle.v1.GameStateGORM.player_states:type_name -> lilbattle.v1.GameStateGORM.PlayerStatesEntry
-	10, // 8: lilbattle.v1.GameConfigurationGORM.income_configs:type_name -> lilbattle.v1.IncomeConfigGORM
-	13, // 9: lilbattle.v1.GameConfigurationGORM.settings:type_name -> lilbattle.v1.GameSettingsGORM
-	0,  // 10: lilbattle.v1.GameWorldDataGORM.screenshot_index_info:type_name -> lilbattle.v1.IndexInfoGORM
-	23, // 11: lilbattle.v1.GameWorldDataGORM.crossings:type_name -> lilbattle.v1.GameWorldDataGORM.CrossingsEntry
-	24, // 12: lilbattle.v1.GameWorldDataGORM.tiles_map:type_name -> lilbattle.v1.GameWorldDataGORM.TilesMapEntry
-	25, // 13: lilbattle.v1.GameWorldDataGORM.units_map:type_name -> lilbattle.v1.GameWorldDataGORM.UnitsMapEntry
-	26, // 14: lilbattle.v1.GameMoveGORM.move_type:type_name -> google.protobuf.Any
-	26, // 15: lilbattle.v1.GameMoveGORM.changes:type_name -> google.protobuf.Any
-	2,  // 16: lilbattle.v1.WorldDataGORM.CrossingsEntry.value:type_name -> lilbattle.v1.CrossingGORM
-	1,  // 17: lilbattle.v1.WorldDataGORM.TilesMapEntry.value:type_name -> lilbattle.v1.TileGORM
-	3,  // 18: lilbattle.v1.WorldDataGORM.UnitsMapEntry.value:type_name -> lilbattle.v1.UnitGORM
-	14, // 19: lilbattle.v1.GameStateGORM.PlayerStatesEntry.value:type_name -> lilbattle.v1.PlayerStateGORM
-	2,  // 20: lilbattle.v1.GameWorldDataGORM.CrossingsEntry.value:type_name -> lilbattle.v1.CrossingGORM
-	1,  // 21: lilbattle.v1.GameWorldDataGORM.TilesMapEntry.value:type_name -> lilbattle.v1.TileGORM
-	3,  // 22: lilbattle.v1.GameWorldDataGORM.UnitsMapEntry.value:type_name -> lilbattle.v1.UnitGORM
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	27, // 1: lilbattle.v1.RulesOverridesGORM.terrain_movement_costs:type_name -> lilbattle.v1.RulesOverridesGORM.TerrainMovementCostsEntry
+	28, // 2: lilbattle.v1.WorldDataGORM.crossings:type_name -> lilbattle.v1.WorldDataGORM.CrossingsEntry
+	0,  // 3: lilbattle.v1.WorldDataGORM.screenshot_index_info:type_name -> lilbattle.v1.IndexInfoGORM
+	29, // 4: lilbattle.v1.WorldDataGORM.tiles_map:type_name -> lilbattle.v1.WorldDataGORM.TilesMapEntry
+	30, // 5: lilbattle.v1.WorldDataGORM.units_map:type_name -> lilbattle.v1.WorldDataGORM.UnitsMapEntry
+	0,  // 6: lilbattle.v1.GameGORM.search_index_info:type_name -> lilbattle.v1.IndexInfoGORM
+	23, // 7: lilbattle.v1.GameStateGORM.world_data:type_name -> lilbattle.v1.GameWorldDataGORM
+	31, // 8: lilbattle.v1.GameStateGORM.player_states:type_name -> lilbattle.v1.GameStateGORM.PlayerStatesEntry
+	12, // 9: lilbattle.v1.GameConfigurationGORM.income_configs:type_name -> lilbattle.v1.IncomeConfigGORM
+	15, // 10: lilbattle.v1.GameConfigurationGORM.settings:type_name -> lilbattle.v1.GameSettingsGORM
+	32, // 11: lilbattle.v1.DraftStateGORM.picked_units:type_name -> lilbattle.v1.DraftStateGORM.PickedUnitsEntry
+	0,  // 12: lilbattle.v1.GameWorldDataGORM.screenshot_index_info:type_name -> lilbattle.v1.IndexInfoGORM
+	33, // 13: lilbattle.v1.GameWorldDataGORM.crossings:type_name -> lilbattle.v1.GameWorldDataGORM.CrossingsEntry
+	34, // 14: lilbattle.v1.GameWorldDataGORM.tiles_map:type_name -> lilbattle.v1.GameWorldDataGORM.TilesMapEntry
+	35, // 15: lilbattle.v1.GameWorldDataGORM.units_map:type_name -> lilbattle.v1.GameWorldDataGORM.UnitsMapEntry
+	36, // 16: lilbattle.v1.GameMoveGORM.move_type:type_name -> google.protobuf.Any
+	36, // 17: lilbattle.v1.GameMoveGORM.changes:type_name -> google.protobuf.Any
+	2,  // 18: lilbattle.v1.WorldDataGORM.CrossingsEntry.value:type_name -> lilbattle.v1.CrossingGORM
+	1,  // 19: lilbattle.v1.WorldDataGORM.TilesMapEntry.value:type_name -> lilbattle.v1.TileGORM
+	3,  // 20: lilbattle.v1.WorldDataGORM.UnitsMapEntry.value:type_name -> lilbattle.v1.UnitGORM
+	16, // 21: lilbattle.v1.GameStateGORM.PlayerStatesEntry.value:type_name -> lilbattle.v1.PlayerStateGORM
+	2,  // 22: lilbattle.v1.GameWorldDataGORM.CrossingsEntry.value:type_name -> lilbattle.v1.CrossingGORM
+	1,  // 23: lilbattle.v1.GameWorldDataGORM.TilesMapEntry.value:type_name -> lilbattle.v1.TileGORM
+	3,  // 24: lilbattle.v1.GameWorldDataGORM.UnitsMapEntry.value:type_name -> lilbattle.v1.UnitGORM
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_gorm_models_proto_init() }
@@ -1178,7 +1537,7 @@ func file_lilbattle_v1_gorm_models_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_gorm_models_proto_rawDesc), len(file_lilbattle_v1_gorm_models_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -31,7 +31,11 @@ type ListGamesRequest struct {
 	// Pagination info
 	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	// May be filter by owner id
-	OwnerId       string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Only list games played on this world
+	WorldId string `protobuf:"bytes,3,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Also list games forked with ForkGame, which are left out by default
+	IncludeForks  bool `protobuf:"varint,4,opt,name=include_forks,json=includeForks,proto3" json:"include_forks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -80,6 +84,20 @@ func (x *ListGamesRequest) GetOwnerId() string {
 	return ""
 }
 
+func (x *ListGamesRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetIncludeForks() bool {
+	if x != nil {
+		return x.IncludeForks
+	}
+	return false
+}
+
 type ListGamesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*Game                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -806,7 +824,15 @@ type ProcessMovesRequest struct {
 	// the changes.
 	ExpectedResponse *ProcessMovesResponse `protobuf:"bytes,3,opt,name=expected_response,json=expectedResponse,proto3" json:"expected_response,omitempty"`
 	// Whether to only perform a dryrun and return results instead of comitting it
-	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Attach coach verdicts to the returned moves. Ignored in rated games.
+	Coach bool `protobuf:"varint,5,opt,name=coach,proto3" json:"coach,omitempty"`
+	// API version the client was built against. 0 for clients from before API
+	// versioning, which are treated as version 1.
+	ApiVersion int32 `protobuf:"varint,6,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// Report how each move's positions were resolved, to diagnose a move that
+	// did something other than what was typed
+	DebugResolve  bool `protobuf:"varint,7,opt,name=debug_resolve,json=debugResolve,proto3" json:"debug_resolve,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -869,13 +895,40 @@ func (x *ProcessMovesRequest) GetDryRun() bool {
 	return false
 }
 
+func (x *ProcessMovesRequest) GetCoach() bool {
+	if x != nil {
+		return x.Coach
+	}
+	return false
+}
+
+func (x *ProcessMovesRequest) GetApiVersion() int32 {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return 0
+}
+
+func (x *ProcessMovesRequest) GetDebugResolve() bool {
+	if x != nil {
+		return x.DebugResolve
+	}
+	return false
+}
+
 // *
 // Response after adding moves to game.
 type ProcessMovesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// *
 	// Returns the moves that were passed in along wth changes and other data filled in.
-	Moves         []*GameMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	Moves []*GameMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	// The server's API versions, so clients can tell when they are behind
+	ServerInfo *ServerInfo `protobuf:"bytes,4,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+	// What the moves would change, for dry runs
+	StateDiff *StateDiff `protobuf:"bytes,5,opt,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"`
+	// How each move was resolved, when debug_resolve was set
+	Resolutions   []*MoveResolution `protobuf:"bytes,6,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -917,31 +970,55 @@ func (x *ProcessMovesResponse) GetMoves() []*GameMove {
 	return nil
 }
 
+func (x *ProcessMovesResponse) GetServerInfo() *ServerInfo {
+	if x != nil {
+		return x.ServerInfo
+	}
+	return nil
+}
+
+func (x *ProcessMovesResponse) GetStateDiff() *StateDiff {
+	if x != nil {
+		return x.StateDiff
+	}
+	return nil
+}
+
+func (x *ProcessMovesResponse) GetResolutions() []*MoveResolution {
+	if x != nil {
+		return x.Resolutions
+	}
+	return nil
+}
+
 // *
-// Request to get the game's latest state
-type GetGameStateRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// *
-	// Game ID to add moves to
-	GameId        string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+// How a move's positions were resolved, against the game as it was just
+// before the move was made
+type MoveResolution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MoveIndex     int32                  `protobuf:"varint,1,opt,name=move_index,json=moveIndex,proto3" json:"move_index,omitempty"`            // Index of the move in the request
+	Source        *ResolvedPosition      `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                                    // The acting unit's or tile's position
+	Target        *ResolvedPosition      `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`                                    // The destination or target, if the move has one
+	MatchedOption *GameOption            `protobuf:"bytes,4,opt,name=matched_option,json=matchedOption,proto3" json:"matched_option,omitempty"` // The option at the source the move matched, if any
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                                      // Why the move failed, if it did
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameStateRequest) Reset() {
-	*x = GetGameStateRequest{}
+func (x *MoveResolution) Reset() {
+	*x = MoveResolution{}
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameStateRequest) String() string {
+func (x *MoveResolution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameStateRequest) ProtoMessage() {}
+func (*MoveResolution) ProtoMessage() {}
 
-func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveResolution) ProtoReflect() protoreflect.Message {
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -953,41 +1030,76 @@ func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
-func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveResolution.ProtoReflect.Descriptor instead.
+func (*MoveResolution) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetGameStateRequest) GetGameId() string {
+func (x *MoveResolution) GetMoveIndex() int32 {
 	if x != nil {
-		return x.GameId
+		return x.MoveIndex
+	}
+	return 0
+}
+
+func (x *MoveResolution) GetSource() *ResolvedPosition {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *MoveResolution) GetTarget() *ResolvedPosition {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *MoveResolution) GetMatchedOption() *GameOption {
+	if x != nil {
+		return x.MatchedOption
+	}
+	return nil
+}
+
+func (x *MoveResolution) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
 // *
-// Response holding latest game state
-type GetGameStateResponse struct {
+// A position as given in a move and what it resolved to
+type ResolvedPosition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         *GameState             `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // The label as given, or "q,r" for coordinates
+	Resolved      bool                   `protobuf:"varint,2,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	Q             int32                  `protobuf:"varint,3,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,4,opt,name=r,proto3" json:"r,omitempty"`
+	UnitId        int32                  `protobuf:"varint,5,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"` // The unit at the position, if any
+	UnitShortcut  string                 `protobuf:"bytes,6,opt,name=unit_shortcut,json=unitShortcut,proto3" json:"unit_shortcut,omitempty"`
+	UnitPlayer    int32                  `protobuf:"varint,7,opt,name=unit_player,json=unitPlayer,proto3" json:"unit_player,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"` // Why the position did not resolve
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameStateResponse) Reset() {
-	*x = GetGameStateResponse{}
+func (x *ResolvedPosition) Reset() {
+	*x = ResolvedPosition{}
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameStateResponse) String() string {
+func (x *ResolvedPosition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameStateResponse) ProtoMessage() {}
+func (*ResolvedPosition) ProtoMessage() {}
 
-func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
+func (x *ResolvedPosition) ProtoReflect() protoreflect.Message {
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -999,47 +1111,95 @@ func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
-func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolvedPosition.ProtoReflect.Descriptor instead.
+func (*ResolvedPosition) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetGameStateResponse) GetState() *GameState {
+func (x *ResolvedPosition) GetInput() string {
 	if x != nil {
-		return x.State
+		return x.Input
 	}
-	return nil
+	return ""
+}
+
+func (x *ResolvedPosition) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *ResolvedPosition) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *ResolvedPosition) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *ResolvedPosition) GetUnitId() int32 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+func (x *ResolvedPosition) GetUnitShortcut() string {
+	if x != nil {
+		return x.UnitShortcut
+	}
+	return ""
+}
+
+func (x *ResolvedPosition) GetUnitPlayer() int32 {
+	if x != nil {
+		return x.UnitPlayer
+	}
+	return 0
+}
+
+func (x *ResolvedPosition) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
 // *
-// Request to list moves for a game
-type ListMovesRequest struct {
+// API versions the server implements and accepts
+type ServerInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// *
-	// Game ID to add moves to
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// Gets moves >= from_group
-	FromGroup int64 `protobuf:"varint,2,opt,name=from_group,json=fromGroup,proto3" json:"from_group,omitempty"`
-	// Gets moves <= to_group
-	ToGroup       int64 `protobuf:"varint,3,opt,name=to_group,json=toGroup,proto3" json:"to_group,omitempty"`
+	// API version the server implements
+	ApiVersion int32 `protobuf:"varint,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// Oldest client API version still accepted. Older clients must refresh.
+	MinApiVersion int32 `protobuf:"varint,2,opt,name=min_api_version,json=minApiVersion,proto3" json:"min_api_version,omitempty"`
+	// APIs that behave differently for, or will stop accepting, older clients
+	Deprecations  []*ApiDeprecation `protobuf:"bytes,3,rep,name=deprecations,proto3" json:"deprecations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMovesRequest) Reset() {
-	*x = ListMovesRequest{}
+func (x *ServerInfo) Reset() {
+	*x = ServerInfo{}
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMovesRequest) String() string {
+func (x *ServerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMovesRequest) ProtoMessage() {}
+func (*ServerInfo) ProtoMessage() {}
 
-func (x *ListMovesRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1051,57 +1211,62 @@ func (x *ListMovesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMovesRequest.ProtoReflect.Descriptor instead.
-func (*ListMovesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
+func (*ServerInfo) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListMovesRequest) GetGameId() string {
+func (x *ServerInfo) GetApiVersion() int32 {
 	if x != nil {
-		return x.GameId
+		return x.ApiVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *ListMovesRequest) GetFromGroup() int64 {
+func (x *ServerInfo) GetMinApiVersion() int32 {
 	if x != nil {
-		return x.FromGroup
+		return x.MinApiVersion
 	}
 	return 0
 }
 
-func (x *ListMovesRequest) GetToGroup() int64 {
+func (x *ServerInfo) GetDeprecations() []*ApiDeprecation {
 	if x != nil {
-		return x.ToGroup
+		return x.Deprecations
 	}
-	return 0
+	return nil
 }
 
 // *
-// Response after adding moves to game.
-type ListMovesResponse struct {
+// An API shape older clients still use through the compatibility shims
+type ApiDeprecation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether there are more moves before this
-	HasMore       bool             `protobuf:"varint,1,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	MoveGroups    []*GameMoveGroup `protobuf:"bytes,2,rep,name=move_groups,json=moveGroups,proto3" json:"move_groups,omitempty"`
+	// The deprecated request or field, eg "ProcessMovesRequest.moves.player"
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// API version that deprecated it
+	DeprecatedIn int32 `protobuf:"varint,2,opt,name=deprecated_in,json=deprecatedIn,proto3" json:"deprecated_in,omitempty"`
+	// API version that will stop accepting it
+	RemovedIn int32 `protobuf:"varint,3,opt,name=removed_in,json=removedIn,proto3" json:"removed_in,omitempty"`
+	// What clients should do instead
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMovesResponse) Reset() {
-	*x = ListMovesResponse{}
+func (x *ApiDeprecation) Reset() {
+	*x = ApiDeprecation{}
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMovesResponse) String() string {
+func (x *ApiDeprecation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMovesResponse) ProtoMessage() {}
+func (*ApiDeprecation) ProtoMessage() {}
 
-func (x *ListMovesResponse) ProtoReflect() protoreflect.Message {
+func (x *ApiDeprecation) ProtoReflect() protoreflect.Message {
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1113,49 +1278,64 @@ func (x *ListMovesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMovesResponse.ProtoReflect.Descriptor instead.
-func (*ListMovesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApiDeprecation.ProtoReflect.Descriptor instead.
+func (*ApiDeprecation) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListMovesResponse) GetHasMore() bool {
+func (x *ApiDeprecation) GetApi() string {
 	if x != nil {
-		return x.HasMore
+		return x.Api
 	}
-	return false
+	return ""
 }
 
-func (x *ListMovesResponse) GetMoveGroups() []*GameMoveGroup {
+func (x *ApiDeprecation) GetDeprecatedIn() int32 {
 	if x != nil {
-		return x.MoveGroups
+		return x.DeprecatedIn
 	}
-	return nil
+	return 0
+}
+
+func (x *ApiDeprecation) GetRemovedIn() int32 {
+	if x != nil {
+		return x.RemovedIn
+	}
+	return 0
+}
+
+func (x *ApiDeprecation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
 // *
-// Request to get all available options at a position
-type GetOptionsAtRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Pos           *Position              `protobuf:"bytes,2,opt,name=pos,proto3" json:"pos,omitempty"`
+// Request to get the game's latest state
+type GetGameStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// *
+	// Game ID to add moves to
+	GameId        string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOptionsAtRequest) Reset() {
-	*x = GetOptionsAtRequest{}
+func (x *GetGameStateRequest) Reset() {
+	*x = GetGameStateRequest{}
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOptionsAtRequest) String() string {
+func (x *GetGameStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOptionsAtRequest) ProtoMessage() {}
+func (*GetGameStateRequest) ProtoMessage() {}
 
-func (x *GetOptionsAtRequest) ProtoReflect() protoreflect.Message {
+func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1167,52 +1347,45 @@ func (x *GetOptionsAtRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOptionsAtRequest.ProtoReflect.Descriptor instead.
-func (*GetOptionsAtRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
+func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetOptionsAtRequest) GetGameId() string {
+func (x *GetGameStateRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-func (x *GetOptionsAtRequest) GetPos() *Position {
-	if x != nil {
-		return x.Pos
-	}
-	return nil
-}
-
 // *
-// Response with all available options at a position
-type GetOptionsAtResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Options         []*GameOption          `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
-	CurrentPlayer   int32                  `protobuf:"varint,2,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`       // debug: current player in game
-	GameInitialized bool                   `protobuf:"varint,3,opt,name=game_initialized,json=gameInitialized,proto3" json:"game_initialized,omitempty"` // debug: whether game is properly initialized
-	// A Path from source to dest along with cost on each tile for tracking
-	AllPaths      *AllPaths `protobuf:"bytes,5,opt,name=all_paths,json=allPaths,proto3" json:"all_paths,omitempty"`
+// Response holding latest game state
+type GetGameStateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	State *GameState             `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// Remaining time bank (ms) per player as of now, when time banks are enabled
+	RemainingTimeMs map[int32]int64 `protobuf:"bytes,2,rep,name=remaining_time_ms,json=remainingTimeMs,proto3" json:"remaining_time_ms,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Set when the game looks stuck, see ClaimNoContactDraw
+	StuckWarning  *StuckAnalysis `protobuf:"bytes,3,opt,name=stuck_warning,json=stuckWarning,proto3" json:"stuck_warning,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOptionsAtResponse) Reset() {
-	*x = GetOptionsAtResponse{}
+func (x *GetGameStateResponse) Reset() {
+	*x = GetGameStateResponse{}
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOptionsAtResponse) String() string {
+func (x *GetGameStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOptionsAtResponse) ProtoMessage() {}
+func (*GetGameStateResponse) ProtoMessage() {}
 
-func (x *GetOptionsAtResponse) ProtoReflect() protoreflect.Message {
+func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1224,42 +1397,284 @@ func (x *GetOptionsAtResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOptionsAtResponse.ProtoReflect.Descriptor instead.
-func (*GetOptionsAtResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
+func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetOptionsAtResponse) GetOptions() []*GameOption {
+func (x *GetGameStateResponse) GetState() *GameState {
 	if x != nil {
-		return x.Options
+		return x.State
 	}
 	return nil
 }
 
-func (x *GetOptionsAtResponse) GetCurrentPlayer() int32 {
-	if x != nil {
-		return x.CurrentPlayer
-	}
-	return 0
-}
-
-func (x *GetOptionsAtResponse) GetGameInitialized() bool {
+func (x *GetGameStateResponse) GetRemainingTimeMs() map[int32]int64 {
 	if x != nil {
-		return x.GameInitialized
+		return x.RemainingTimeMs
 	}
-	return false
+	return nil
 }
 
-func (x *GetOptionsAtResponse) GetAllPaths() *AllPaths {
+func (x *GetGameStateResponse) GetStuckWarning() *StuckAnalysis {
 	if x != nil {
-		return x.AllPaths
+		return x.StuckWarning
 	}
 	return nil
 }
 
 // *
-// A single game option available at a position
-type GameOption struct {
+// Request to list moves for a game
+type ListMovesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// *
+	// Game ID to add moves to
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Gets moves >= from_group
+	FromGroup int64 `protobuf:"varint,2,opt,name=from_group,json=fromGroup,proto3" json:"from_group,omitempty"`
+	// Gets moves <= to_group
+	ToGroup       int64 `protobuf:"varint,3,opt,name=to_group,json=toGroup,proto3" json:"to_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMovesRequest) Reset() {
+	*x = ListMovesRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMovesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMovesRequest) ProtoMessage() {}
+
+func (x *ListMovesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMovesRequest.ProtoReflect.Descriptor instead.
+func (*ListMovesRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListMovesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ListMovesRequest) GetFromGroup() int64 {
+	if x != nil {
+		return x.FromGroup
+	}
+	return 0
+}
+
+func (x *ListMovesRequest) GetToGroup() int64 {
+	if x != nil {
+		return x.ToGroup
+	}
+	return 0
+}
+
+// *
+// Response after adding moves to game.
+type ListMovesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether there are more moves before this
+	HasMore       bool             `protobuf:"varint,1,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	MoveGroups    []*GameMoveGroup `protobuf:"bytes,2,rep,name=move_groups,json=moveGroups,proto3" json:"move_groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMovesResponse) Reset() {
+	*x = ListMovesResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMovesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMovesResponse) ProtoMessage() {}
+
+func (x *ListMovesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMovesResponse.ProtoReflect.Descriptor instead.
+func (*ListMovesResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListMovesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListMovesResponse) GetMoveGroups() []*GameMoveGroup {
+	if x != nil {
+		return x.MoveGroups
+	}
+	return nil
+}
+
+// *
+// Request to get all available options at a position
+type GetOptionsAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Pos           *Position              `protobuf:"bytes,2,opt,name=pos,proto3" json:"pos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOptionsAtRequest) Reset() {
+	*x = GetOptionsAtRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionsAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionsAtRequest) ProtoMessage() {}
+
+func (x *GetOptionsAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionsAtRequest.ProtoReflect.Descriptor instead.
+func (*GetOptionsAtRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetOptionsAtRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetOptionsAtRequest) GetPos() *Position {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+// *
+// Response with all available options at a position
+type GetOptionsAtResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Options in canonical order: by type (move, attack, capture, build, heal,
+	// construct, submerge, end turn), then by target coordinate (r, then q).
+	// Builds are ordered by unit cost, then unit type.
+	Options         []*GameOption `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	CurrentPlayer   int32         `protobuf:"varint,2,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`       // debug: current player in game
+	GameInitialized bool          `protobuf:"varint,3,opt,name=game_initialized,json=gameInitialized,proto3" json:"game_initialized,omitempty"` // debug: whether game is properly initialized
+	// A Path from source to dest along with cost on each tile for tracking
+	AllPaths      *AllPaths `protobuf:"bytes,5,opt,name=all_paths,json=allPaths,proto3" json:"all_paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOptionsAtResponse) Reset() {
+	*x = GetOptionsAtResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionsAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionsAtResponse) ProtoMessage() {}
+
+func (x *GetOptionsAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionsAtResponse.ProtoReflect.Descriptor instead.
+func (*GetOptionsAtResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetOptionsAtResponse) GetOptions() []*GameOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *GetOptionsAtResponse) GetCurrentPlayer() int32 {
+	if x != nil {
+		return x.CurrentPlayer
+	}
+	return 0
+}
+
+func (x *GetOptionsAtResponse) GetGameInitialized() bool {
+	if x != nil {
+		return x.GameInitialized
+	}
+	return false
+}
+
+func (x *GetOptionsAtResponse) GetAllPaths() *AllPaths {
+	if x != nil {
+		return x.AllPaths
+	}
+	return nil
+}
+
+// *
+// A single game option available at a position
+type GameOption struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to OptionType:
 	//
@@ -1269,26 +1684,891 @@ type GameOption struct {
 	//	*GameOption_Capture
 	//	*GameOption_EndTurn
 	//	*GameOption_Heal
-	OptionType    isGameOption_OptionType `protobuf_oneof:"option_type"`
+	//	*GameOption_Construct
+	//	*GameOption_Submerge
+	OptionType isGameOption_OptionType `protobuf_oneof:"option_type"`
+	// Set when the option is listed but can't be taken right now, eg a build
+	// at the unit cap, saying why
+	DisabledReason string `protobuf:"bytes,9,opt,name=disabled_reason,json=disabledReason,proto3" json:"disabled_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GameOption) Reset() {
+	*x = GameOption{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOption) ProtoMessage() {}
+
+func (x *GameOption) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOption.ProtoReflect.Descriptor instead.
+func (*GameOption) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GameOption) GetOptionType() isGameOption_OptionType {
+	if x != nil {
+		return x.OptionType
+	}
+	return nil
+}
+
+func (x *GameOption) GetMove() *MoveUnitAction {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Move); ok {
+			return x.Move
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetAttack() *AttackUnitAction {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Attack); ok {
+			return x.Attack
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetBuild() *BuildUnitAction {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Build); ok {
+			return x.Build
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetCapture() *CaptureBuildingAction {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Capture); ok {
+			return x.Capture
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetEndTurn() *EndTurnAction {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_EndTurn); ok {
+			return x.EndTurn
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetHeal() *HealUnitAction {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Heal); ok {
+			return x.Heal
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetConstruct() *ConstructTerrainAction {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Construct); ok {
+			return x.Construct
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetSubmerge() *SubmergeUnitAction {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Submerge); ok {
+			return x.Submerge
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetDisabledReason() string {
+	if x != nil {
+		return x.DisabledReason
+	}
+	return ""
+}
+
+type isGameOption_OptionType interface {
+	isGameOption_OptionType()
+}
+
+type GameOption_Move struct {
+	Move *MoveUnitAction `protobuf:"bytes,1,opt,name=move,proto3,oneof"`
+}
+
+type GameOption_Attack struct {
+	Attack *AttackUnitAction `protobuf:"bytes,2,opt,name=attack,proto3,oneof"`
+}
+
+type GameOption_Build struct {
+	Build *BuildUnitAction `protobuf:"bytes,3,opt,name=build,proto3,oneof"`
+}
+
+type GameOption_Capture struct {
+	Capture *CaptureBuildingAction `protobuf:"bytes,4,opt,name=capture,proto3,oneof"`
+}
+
+type GameOption_EndTurn struct {
+	EndTurn *EndTurnAction `protobuf:"bytes,5,opt,name=end_turn,json=endTurn,proto3,oneof"`
+}
+
+type GameOption_Heal struct {
+	Heal *HealUnitAction `protobuf:"bytes,6,opt,name=heal,proto3,oneof"`
+}
+
+type GameOption_Construct struct {
+	Construct *ConstructTerrainAction `protobuf:"bytes,7,opt,name=construct,proto3,oneof"`
+}
+
+type GameOption_Submerge struct {
+	Submerge *SubmergeUnitAction `protobuf:"bytes,8,opt,name=submerge,proto3,oneof"`
+}
+
+func (*GameOption_Move) isGameOption_OptionType() {}
+
+func (*GameOption_Attack) isGameOption_OptionType() {}
+
+func (*GameOption_Build) isGameOption_OptionType() {}
+
+func (*GameOption_Capture) isGameOption_OptionType() {}
+
+func (*GameOption_EndTurn) isGameOption_OptionType() {}
+
+func (*GameOption_Heal) isGameOption_OptionType() {}
+
+func (*GameOption_Construct) isGameOption_OptionType() {}
+
+func (*GameOption_Submerge) isGameOption_OptionType() {}
+
+// *
+// Request for simulating combat between two units
+type SimulateAttackRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AttackerUnitType int32                  `protobuf:"varint,1,opt,name=attacker_unit_type,json=attackerUnitType,proto3" json:"attacker_unit_type,omitempty"`
+	AttackerTerrain  int32                  `protobuf:"varint,2,opt,name=attacker_terrain,json=attackerTerrain,proto3" json:"attacker_terrain,omitempty"`
+	AttackerHealth   int32                  `protobuf:"varint,3,opt,name=attacker_health,json=attackerHealth,proto3" json:"attacker_health,omitempty"`
+	DefenderUnitType int32                  `protobuf:"varint,4,opt,name=defender_unit_type,json=defenderUnitType,proto3" json:"defender_unit_type,omitempty"`
+	DefenderTerrain  int32                  `protobuf:"varint,5,opt,name=defender_terrain,json=defenderTerrain,proto3" json:"defender_terrain,omitempty"`
+	DefenderHealth   int32                  `protobuf:"varint,6,opt,name=defender_health,json=defenderHealth,proto3" json:"defender_health,omitempty"`
+	WoundBonus       int32                  `protobuf:"varint,7,opt,name=wound_bonus,json=woundBonus,proto3" json:"wound_bonus,omitempty"`
+	NumSimulations   int32                  `protobuf:"varint,8,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Default: 1000
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SimulateAttackRequest) Reset() {
+	*x = SimulateAttackRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateAttackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateAttackRequest) ProtoMessage() {}
+
+func (x *SimulateAttackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateAttackRequest.ProtoReflect.Descriptor instead.
+func (*SimulateAttackRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SimulateAttackRequest) GetAttackerUnitType() int32 {
+	if x != nil {
+		return x.AttackerUnitType
+	}
+	return 0
+}
+
+func (x *SimulateAttackRequest) GetAttackerTerrain() int32 {
+	if x != nil {
+		return x.AttackerTerrain
+	}
+	return 0
+}
+
+func (x *SimulateAttackRequest) GetAttackerHealth() int32 {
+	if x != nil {
+		return x.AttackerHealth
+	}
+	return 0
+}
+
+func (x *SimulateAttackRequest) GetDefenderUnitType() int32 {
+	if x != nil {
+		return x.DefenderUnitType
+	}
+	return 0
+}
+
+func (x *SimulateAttackRequest) GetDefenderTerrain() int32 {
+	if x != nil {
+		return x.DefenderTerrain
+	}
+	return 0
+}
+
+func (x *SimulateAttackRequest) GetDefenderHealth() int32 {
+	if x != nil {
+		return x.DefenderHealth
+	}
+	return 0
+}
+
+func (x *SimulateAttackRequest) GetWoundBonus() int32 {
+	if x != nil {
+		return x.WoundBonus
+	}
+	return 0
+}
+
+func (x *SimulateAttackRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+// *
+// Response containing damage distribution statistics
+type SimulateAttackResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Damage distributions: damage_value -> number_of_occurrences
+	AttackerDamageDistribution map[int32]int32 `protobuf:"bytes,1,rep,name=attacker_damage_distribution,json=attackerDamageDistribution,proto3" json:"attacker_damage_distribution,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	DefenderDamageDistribution map[int32]int32 `protobuf:"bytes,2,rep,name=defender_damage_distribution,json=defenderDamageDistribution,proto3" json:"defender_damage_distribution,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Statistical summary
+	AttackerMeanDamage      float64 `protobuf:"fixed64,3,opt,name=attacker_mean_damage,json=attackerMeanDamage,proto3" json:"attacker_mean_damage,omitempty"`
+	DefenderMeanDamage      float64 `protobuf:"fixed64,4,opt,name=defender_mean_damage,json=defenderMeanDamage,proto3" json:"defender_mean_damage,omitempty"`
+	AttackerKillProbability float64 `protobuf:"fixed64,5,opt,name=attacker_kill_probability,json=attackerKillProbability,proto3" json:"attacker_kill_probability,omitempty"`
+	DefenderKillProbability float64 `protobuf:"fixed64,6,opt,name=defender_kill_probability,json=defenderKillProbability,proto3" json:"defender_kill_probability,omitempty"`
+	// Fraction of the attacker's expected damage prevented by the defender's
+	// terrain (0.4 = reduced by 40%, negative when the terrain hurts defense)
+	TerrainDefenseApplied float64 `protobuf:"fixed64,7,opt,name=terrain_defense_applied,json=terrainDefenseApplied,proto3" json:"terrain_defense_applied,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SimulateAttackResponse) Reset() {
+	*x = SimulateAttackResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateAttackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateAttackResponse) ProtoMessage() {}
+
+func (x *SimulateAttackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateAttackResponse.ProtoReflect.Descriptor instead.
+func (*SimulateAttackResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SimulateAttackResponse) GetAttackerDamageDistribution() map[int32]int32 {
+	if x != nil {
+		return x.AttackerDamageDistribution
+	}
+	return nil
+}
+
+func (x *SimulateAttackResponse) GetDefenderDamageDistribution() map[int32]int32 {
+	if x != nil {
+		return x.DefenderDamageDistribution
+	}
+	return nil
+}
+
+func (x *SimulateAttackResponse) GetAttackerMeanDamage() float64 {
+	if x != nil {
+		return x.AttackerMeanDamage
+	}
+	return 0
+}
+
+func (x *SimulateAttackResponse) GetDefenderMeanDamage() float64 {
+	if x != nil {
+		return x.DefenderMeanDamage
+	}
+	return 0
+}
+
+func (x *SimulateAttackResponse) GetAttackerKillProbability() float64 {
+	if x != nil {
+		return x.AttackerKillProbability
+	}
+	return 0
+}
+
+func (x *SimulateAttackResponse) GetDefenderKillProbability() float64 {
+	if x != nil {
+		return x.DefenderKillProbability
+	}
+	return 0
+}
+
+func (x *SimulateAttackResponse) GetTerrainDefenseApplied() float64 {
+	if x != nil {
+		return x.TerrainDefenseApplied
+	}
+	return 0
+}
+
+// *
+// Request for simulating fix (repair) between two units
+type SimulateFixRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FixingUnitType   int32                  `protobuf:"varint,1,opt,name=fixing_unit_type,json=fixingUnitType,proto3" json:"fixing_unit_type,omitempty"`       // Unit type performing the fix
+	FixingUnitHealth int32                  `protobuf:"varint,2,opt,name=fixing_unit_health,json=fixingUnitHealth,proto3" json:"fixing_unit_health,omitempty"` // Health of the fixing unit (Hf)
+	InjuredUnitType  int32                  `protobuf:"varint,3,opt,name=injured_unit_type,json=injuredUnitType,proto3" json:"injured_unit_type,omitempty"`    // Unit type being repaired (for display)
+	NumSimulations   int32                  `protobuf:"varint,4,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"`         // Default: 1000
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SimulateFixRequest) Reset() {
+	*x = SimulateFixRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateFixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateFixRequest) ProtoMessage() {}
+
+func (x *SimulateFixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateFixRequest.ProtoReflect.Descriptor instead.
+func (*SimulateFixRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SimulateFixRequest) GetFixingUnitType() int32 {
+	if x != nil {
+		return x.FixingUnitType
+	}
+	return 0
+}
+
+func (x *SimulateFixRequest) GetFixingUnitHealth() int32 {
+	if x != nil {
+		return x.FixingUnitHealth
+	}
+	return 0
+}
+
+func (x *SimulateFixRequest) GetInjuredUnitType() int32 {
+	if x != nil {
+		return x.InjuredUnitType
+	}
+	return 0
+}
+
+func (x *SimulateFixRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+// *
+// Response containing health restoration distribution statistics
+type SimulateFixResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Health restoration distribution: health_restored -> number_of_occurrences
+	HealingDistribution map[int32]int32 `protobuf:"bytes,1,rep,name=healing_distribution,json=healingDistribution,proto3" json:"healing_distribution,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Statistical summary
+	MeanHealing float64 `protobuf:"fixed64,2,opt,name=mean_healing,json=meanHealing,proto3" json:"mean_healing,omitempty"`
+	// The fix value (F) of the fixing unit type
+	FixValue      int32 `protobuf:"varint,3,opt,name=fix_value,json=fixValue,proto3" json:"fix_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateFixResponse) Reset() {
+	*x = SimulateFixResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateFixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateFixResponse) ProtoMessage() {}
+
+func (x *SimulateFixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateFixResponse.ProtoReflect.Descriptor instead.
+func (*SimulateFixResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SimulateFixResponse) GetHealingDistribution() map[int32]int32 {
+	if x != nil {
+		return x.HealingDistribution
+	}
+	return nil
+}
+
+func (x *SimulateFixResponse) GetMeanHealing() float64 {
+	if x != nil {
+		return x.MeanHealing
+	}
+	return 0
+}
+
+func (x *SimulateFixResponse) GetFixValue() int32 {
+	if x != nil {
+		return x.FixValue
+	}
+	return 0
+}
+
+// *
+// Request to join a game as a player
+// User must be authenticated to join a game.
+// The player slot must be "open" (player_type = "open") to be joinable.
+type JoinGameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the game to join
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The player ID (slot) to join as (1-based)
+	// Must be an open slot (player_type = "open")
+	PlayerId      int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGameRequest) Reset() {
+	*x = JoinGameRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameRequest) ProtoMessage() {}
+
+func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
+func (*JoinGameRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *JoinGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+// *
+// Response after joining a game
+type JoinGameResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated game with the user assigned to the player slot
+	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	// The player ID that was joined
+	PlayerId      int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGameResponse) Reset() {
+	*x = JoinGameResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameResponse) ProtoMessage() {}
+
+func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
+func (*JoinGameResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *JoinGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *JoinGameResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+// *
+// Request to pause or resume a game's clock
+type SetClockPausedRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The player making the request (1-based)
+	PlayerId int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// true to request a pause, false to resume (or withdraw a pause request)
+	Paused        bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClockPausedRequest) Reset() {
+	*x = SetClockPausedRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClockPausedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClockPausedRequest) ProtoMessage() {}
+
+func (x *SetClockPausedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClockPausedRequest.ProtoReflect.Descriptor instead.
+func (*SetClockPausedRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetClockPausedRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetClockPausedRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *SetClockPausedRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// *
+// Response after a pause/resume request
+type SetClockPausedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the clock is now paused
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Players still waiting on the others to agree to a pause
+	PauseRequests []int32 `protobuf:"varint,2,rep,packed,name=pause_requests,json=pauseRequests,proto3" json:"pause_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClockPausedResponse) Reset() {
+	*x = SetClockPausedResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClockPausedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClockPausedResponse) ProtoMessage() {}
+
+func (x *SetClockPausedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClockPausedResponse.ProtoReflect.Descriptor instead.
+func (*SetClockPausedResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetClockPausedResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *SetClockPausedResponse) GetPauseRequests() []int32 {
+	if x != nil {
+		return x.PauseRequests
+	}
+	return nil
+}
+
+// *
+// Request to hand the rest of the current turn over to a teammate
+type DelegateTurnRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The current player delegating their turn (1-based)
+	PlayerId int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// The teammate taking over the turn
+	DelegatePlayerId int32 `protobuf:"varint,3,opt,name=delegate_player_id,json=delegatePlayerId,proto3" json:"delegate_player_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DelegateTurnRequest) Reset() {
+	*x = DelegateTurnRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegateTurnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateTurnRequest) ProtoMessage() {}
+
+func (x *DelegateTurnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateTurnRequest.ProtoReflect.Descriptor instead.
+func (*DelegateTurnRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DelegateTurnRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *DelegateTurnRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *DelegateTurnRequest) GetDelegatePlayerId() int32 {
+	if x != nil {
+		return x.DelegatePlayerId
+	}
+	return 0
+}
+
+// *
+// Response after delegating a turn
+type DelegateTurnResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The teammate now controlling the turn
+	DelegatedTo   int32 `protobuf:"varint,1,opt,name=delegated_to,json=delegatedTo,proto3" json:"delegated_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelegateTurnResponse) Reset() {
+	*x = DelegateTurnResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegateTurnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateTurnResponse) ProtoMessage() {}
+
+func (x *DelegateTurnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateTurnResponse.ProtoReflect.Descriptor instead.
+func (*DelegateTurnResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DelegateTurnResponse) GetDelegatedTo() int32 {
+	if x != nil {
+		return x.DelegatedTo
+	}
+	return 0
+}
+
+// *
+// Request to end a stuck game as a draw
+type ClaimNoContactDrawRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The player claiming the draw (1-based, 0 = the caller's first seat)
+	PlayerId      int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameOption) Reset() {
-	*x = GameOption{}
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[22]
+func (x *ClaimNoContactDrawRequest) Reset() {
+	*x = ClaimNoContactDrawRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameOption) String() string {
+func (x *ClaimNoContactDrawRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameOption) ProtoMessage() {}
+func (*ClaimNoContactDrawRequest) ProtoMessage() {}
 
-func (x *GameOption) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[22]
+func (x *ClaimNoContactDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1299,143 +2579,347 @@ func (x *GameOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameOption.ProtoReflect.Descriptor instead.
-func (*GameOption) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ClaimNoContactDrawRequest.ProtoReflect.Descriptor instead.
+func (*ClaimNoContactDrawRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GameOption) GetOptionType() isGameOption_OptionType {
+func (x *ClaimNoContactDrawRequest) GetGameId() string {
 	if x != nil {
-		return x.OptionType
+		return x.GameId
 	}
-	return nil
+	return ""
 }
 
-func (x *GameOption) GetMove() *MoveUnitAction {
+func (x *ClaimNoContactDrawRequest) GetPlayerId() int32 {
 	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_Move); ok {
-			return x.Move
-		}
+		return x.PlayerId
 	}
-	return nil
+	return 0
 }
 
-func (x *GameOption) GetAttack() *AttackUnitAction {
+// *
+// Response after a draw claim
+type ClaimNoContactDrawResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The analysis the claim was accepted on
+	Analysis      *StuckAnalysis `protobuf:"bytes,1,opt,name=analysis,proto3" json:"analysis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimNoContactDrawResponse) Reset() {
+	*x = ClaimNoContactDrawResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimNoContactDrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimNoContactDrawResponse) ProtoMessage() {}
+
+func (x *ClaimNoContactDrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[38]
 	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_Attack); ok {
-			return x.Attack
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GameOption) GetBuild() *BuildUnitAction {
+// Deprecated: Use ClaimNoContactDrawResponse.ProtoReflect.Descriptor instead.
+func (*ClaimNoContactDrawResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ClaimNoContactDrawResponse) GetAnalysis() *StuckAnalysis {
 	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_Build); ok {
-			return x.Build
-		}
+		return x.Analysis
 	}
 	return nil
 }
 
-func (x *GameOption) GetCapture() *CaptureBuildingAction {
+// *
+// Request to ban or pick a unit type during the pre-game draft
+type DraftUnitRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The drafting player (1-based)
+	PlayerId int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Unit type to ban or pick (0 = pass)
+	UnitType int32 `protobuf:"varint,3,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	// True to pick the unit type, false to ban it
+	Pick          bool `protobuf:"varint,4,opt,name=pick,proto3" json:"pick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftUnitRequest) Reset() {
+	*x = DraftUnitRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftUnitRequest) ProtoMessage() {}
+
+func (x *DraftUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[39]
 	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_Capture); ok {
-			return x.Capture
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GameOption) GetEndTurn() *EndTurnAction {
+// Deprecated: Use DraftUnitRequest.ProtoReflect.Descriptor instead.
+func (*DraftUnitRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DraftUnitRequest) GetGameId() string {
 	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_EndTurn); ok {
-			return x.EndTurn
-		}
+		return x.GameId
 	}
-	return nil
+	return ""
 }
 
-func (x *GameOption) GetHeal() *HealUnitAction {
+func (x *DraftUnitRequest) GetPlayerId() int32 {
 	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_Heal); ok {
-			return x.Heal
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *DraftUnitRequest) GetUnitType() int32 {
+	if x != nil {
+		return x.UnitType
+	}
+	return 0
+}
+
+func (x *DraftUnitRequest) GetPick() bool {
+	if x != nil {
+		return x.Pick
+	}
+	return false
+}
+
+// *
+// Response after a draft turn
+type DraftUnitResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Draft results so far
+	Draft *DraftState `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	// Player drafting next, or the first player to move once the draft is over
+	CurrentPlayer int32 `protobuf:"varint,2,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
+	// Whether the draft is over and the game has started
+	DraftComplete bool `protobuf:"varint,3,opt,name=draft_complete,json=draftComplete,proto3" json:"draft_complete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftUnitResponse) Reset() {
+	*x = DraftUnitResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftUnitResponse) ProtoMessage() {}
+
+func (x *DraftUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftUnitResponse.ProtoReflect.Descriptor instead.
+func (*DraftUnitResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DraftUnitResponse) GetDraft() *DraftState {
+	if x != nil {
+		return x.Draft
 	}
 	return nil
 }
 
-type isGameOption_OptionType interface {
-	isGameOption_OptionType()
+func (x *DraftUnitResponse) GetCurrentPlayer() int32 {
+	if x != nil {
+		return x.CurrentPlayer
+	}
+	return 0
 }
 
-type GameOption_Move struct {
-	Move *MoveUnitAction `protobuf:"bytes,1,opt,name=move,proto3,oneof"`
+func (x *DraftUnitResponse) GetDraftComplete() bool {
+	if x != nil {
+		return x.DraftComplete
+	}
+	return false
 }
 
-type GameOption_Attack struct {
-	Attack *AttackUnitAction `protobuf:"bytes,2,opt,name=attack,proto3,oneof"`
+// *
+// Request for what changed in a game between two turns
+type GetStateDiffRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The diff runs from the start of from_turn to the start of to_turn. A
+	// to_turn past the current turn runs to the current state.
+	FromTurn      int32 `protobuf:"varint,2,opt,name=from_turn,json=fromTurn,proto3" json:"from_turn,omitempty"`
+	ToTurn        int32 `protobuf:"varint,3,opt,name=to_turn,json=toTurn,proto3" json:"to_turn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type GameOption_Build struct {
-	Build *BuildUnitAction `protobuf:"bytes,3,opt,name=build,proto3,oneof"`
+func (x *GetStateDiffRequest) Reset() {
+	*x = GetStateDiffRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GameOption_Capture struct {
-	Capture *CaptureBuildingAction `protobuf:"bytes,4,opt,name=capture,proto3,oneof"`
+func (x *GetStateDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GameOption_EndTurn struct {
-	EndTurn *EndTurnAction `protobuf:"bytes,5,opt,name=end_turn,json=endTurn,proto3,oneof"`
+func (*GetStateDiffRequest) ProtoMessage() {}
+
+func (x *GetStateDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type GameOption_Heal struct {
-	Heal *HealUnitAction `protobuf:"bytes,6,opt,name=heal,proto3,oneof"`
+// Deprecated: Use GetStateDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetStateDiffRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (*GameOption_Move) isGameOption_OptionType() {}
+func (x *GetStateDiffRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
 
-func (*GameOption_Attack) isGameOption_OptionType() {}
+func (x *GetStateDiffRequest) GetFromTurn() int32 {
+	if x != nil {
+		return x.FromTurn
+	}
+	return 0
+}
 
-func (*GameOption_Build) isGameOption_OptionType() {}
+func (x *GetStateDiffRequest) GetToTurn() int32 {
+	if x != nil {
+		return x.ToTurn
+	}
+	return 0
+}
 
-func (*GameOption_Capture) isGameOption_OptionType() {}
+// *
+// Response holding the changes between two turns
+type GetStateDiffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diff          *StateDiff             `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func (*GameOption_EndTurn) isGameOption_OptionType() {}
+func (x *GetStateDiffResponse) Reset() {
+	*x = GetStateDiffResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (*GameOption_Heal) isGameOption_OptionType() {}
+func (x *GetStateDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateDiffResponse) ProtoMessage() {}
+
+func (x *GetStateDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetStateDiffResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetStateDiffResponse) GetDiff() *StateDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
 
 // *
-// Request for simulating combat between two units
-type SimulateAttackRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AttackerUnitType int32                  `protobuf:"varint,1,opt,name=attacker_unit_type,json=attackerUnitType,proto3" json:"attacker_unit_type,omitempty"`
-	AttackerTerrain  int32                  `protobuf:"varint,2,opt,name=attacker_terrain,json=attackerTerrain,proto3" json:"attacker_terrain,omitempty"`
-	AttackerHealth   int32                  `protobuf:"varint,3,opt,name=attacker_health,json=attackerHealth,proto3" json:"attacker_health,omitempty"`
-	DefenderUnitType int32                  `protobuf:"varint,4,opt,name=defender_unit_type,json=defenderUnitType,proto3" json:"defender_unit_type,omitempty"`
-	DefenderTerrain  int32                  `protobuf:"varint,5,opt,name=defender_terrain,json=defenderTerrain,proto3" json:"defender_terrain,omitempty"`
-	DefenderHealth   int32                  `protobuf:"varint,6,opt,name=defender_health,json=defenderHealth,proto3" json:"defender_health,omitempty"`
-	WoundBonus       int32                  `protobuf:"varint,7,opt,name=wound_bonus,json=woundBonus,proto3" json:"wound_bonus,omitempty"`
-	NumSimulations   int32                  `protobuf:"varint,8,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Default: 1000
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Request for a game's state at the start of a player's turn
+type GetStateAtTurnRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Turn   int32                  `protobuf:"varint,2,opt,name=turn,proto3" json:"turn,omitempty"`
+	// Player whose turn it was. 0 is the start of the turn.
+	Player        int32 `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulateAttackRequest) Reset() {
-	*x = SimulateAttackRequest{}
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[23]
+func (x *GetStateAtTurnRequest) Reset() {
+	*x = GetStateAtTurnRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulateAttackRequest) String() string {
+func (x *GetStateAtTurnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulateAttackRequest) ProtoMessage() {}
+func (*GetStateAtTurnRequest) ProtoMessage() {}
 
-func (x *SimulateAttackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[23]
+func (x *GetStateAtTurnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1446,98 +2930,135 @@ func (x *SimulateAttackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulateAttackRequest.ProtoReflect.Descriptor instead.
-func (*SimulateAttackRequest) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetStateAtTurnRequest.ProtoReflect.Descriptor instead.
+func (*GetStateAtTurnRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *SimulateAttackRequest) GetAttackerUnitType() int32 {
+func (x *GetStateAtTurnRequest) GetGameId() string {
 	if x != nil {
-		return x.AttackerUnitType
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-func (x *SimulateAttackRequest) GetAttackerTerrain() int32 {
+func (x *GetStateAtTurnRequest) GetTurn() int32 {
 	if x != nil {
-		return x.AttackerTerrain
+		return x.Turn
 	}
 	return 0
 }
 
-func (x *SimulateAttackRequest) GetAttackerHealth() int32 {
+func (x *GetStateAtTurnRequest) GetPlayer() int32 {
 	if x != nil {
-		return x.AttackerHealth
+		return x.Player
 	}
 	return 0
 }
 
-func (x *SimulateAttackRequest) GetDefenderUnitType() int32 {
+// *
+// Response holding a game's state at the start of a player's turn
+type GetStateAtTurnResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	State *GameState             `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// Turn and player of the snapshot the state was rebuilt from, 0 if it was
+	// replayed from the start of the game
+	SnapshotTurn   int32 `protobuf:"varint,2,opt,name=snapshot_turn,json=snapshotTurn,proto3" json:"snapshot_turn,omitempty"`
+	SnapshotPlayer int32 `protobuf:"varint,3,opt,name=snapshot_player,json=snapshotPlayer,proto3" json:"snapshot_player,omitempty"`
+	// Moves replayed on top of the snapshot
+	ReplayedMoves int32 `protobuf:"varint,4,opt,name=replayed_moves,json=replayedMoves,proto3" json:"replayed_moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateAtTurnResponse) Reset() {
+	*x = GetStateAtTurnResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateAtTurnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateAtTurnResponse) ProtoMessage() {}
+
+func (x *GetStateAtTurnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[44]
 	if x != nil {
-		return x.DefenderUnitType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SimulateAttackRequest) GetDefenderTerrain() int32 {
+// Deprecated: Use GetStateAtTurnResponse.ProtoReflect.Descriptor instead.
+func (*GetStateAtTurnResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetStateAtTurnResponse) GetState() *GameState {
 	if x != nil {
-		return x.DefenderTerrain
+		return x.State
 	}
-	return 0
+	return nil
 }
 
-func (x *SimulateAttackRequest) GetDefenderHealth() int32 {
+func (x *GetStateAtTurnResponse) GetSnapshotTurn() int32 {
 	if x != nil {
-		return x.DefenderHealth
+		return x.SnapshotTurn
 	}
 	return 0
 }
 
-func (x *SimulateAttackRequest) GetWoundBonus() int32 {
+func (x *GetStateAtTurnResponse) GetSnapshotPlayer() int32 {
 	if x != nil {
-		return x.WoundBonus
+		return x.SnapshotPlayer
 	}
 	return 0
 }
 
-func (x *SimulateAttackRequest) GetNumSimulations() int32 {
+func (x *GetStateAtTurnResponse) GetReplayedMoves() int32 {
 	if x != nil {
-		return x.NumSimulations
+		return x.ReplayedMoves
 	}
 	return 0
 }
 
 // *
-// Response containing damage distribution statistics
-type SimulateAttackResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Damage distributions: damage_value -> number_of_occurrences
-	AttackerDamageDistribution map[int32]int32 `protobuf:"bytes,1,rep,name=attacker_damage_distribution,json=attackerDamageDistribution,proto3" json:"attacker_damage_distribution,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	DefenderDamageDistribution map[int32]int32 `protobuf:"bytes,2,rep,name=defender_damage_distribution,json=defenderDamageDistribution,proto3" json:"defender_damage_distribution,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	// Statistical summary
-	AttackerMeanDamage      float64 `protobuf:"fixed64,3,opt,name=attacker_mean_damage,json=attackerMeanDamage,proto3" json:"attacker_mean_damage,omitempty"`
-	DefenderMeanDamage      float64 `protobuf:"fixed64,4,opt,name=defender_mean_damage,json=defenderMeanDamage,proto3" json:"defender_mean_damage,omitempty"`
-	AttackerKillProbability float64 `protobuf:"fixed64,5,opt,name=attacker_kill_probability,json=attackerKillProbability,proto3" json:"attacker_kill_probability,omitempty"`
-	DefenderKillProbability float64 `protobuf:"fixed64,6,opt,name=defender_kill_probability,json=defenderKillProbability,proto3" json:"defender_kill_probability,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+// Request to fork a game into a private copy where the caller plays every
+// seat
+type ForkGameRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Number of the source game's moves the fork starts after. 0 forks the
+	// game as it is now.
+	MoveIndex int32 `protobuf:"varint,2,opt,name=move_index,json=moveIndex,proto3" json:"move_index,omitempty"`
+	// Seats the AI plays in the fork. The caller plays all the others.
+	AiPlayers     []int32 `protobuf:"varint,3,rep,packed,name=ai_players,json=aiPlayers,proto3" json:"ai_players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulateAttackResponse) Reset() {
-	*x = SimulateAttackResponse{}
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[24]
+func (x *ForkGameRequest) Reset() {
+	*x = ForkGameRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulateAttackResponse) String() string {
+func (x *ForkGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulateAttackResponse) ProtoMessage() {}
+func (*ForkGameRequest) ProtoMessage() {}
 
-func (x *SimulateAttackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[24]
+func (x *ForkGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1548,80 +3069,117 @@ func (x *SimulateAttackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulateAttackResponse.ProtoReflect.Descriptor instead.
-func (*SimulateAttackResponse) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ForkGameRequest.ProtoReflect.Descriptor instead.
+func (*ForkGameRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SimulateAttackResponse) GetAttackerDamageDistribution() map[int32]int32 {
+func (x *ForkGameRequest) GetGameId() string {
 	if x != nil {
-		return x.AttackerDamageDistribution
+		return x.GameId
 	}
-	return nil
+	return ""
 }
 
-func (x *SimulateAttackResponse) GetDefenderDamageDistribution() map[int32]int32 {
+func (x *ForkGameRequest) GetMoveIndex() int32 {
 	if x != nil {
-		return x.DefenderDamageDistribution
+		return x.MoveIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *SimulateAttackResponse) GetAttackerMeanDamage() float64 {
+func (x *ForkGameRequest) GetAiPlayers() []int32 {
 	if x != nil {
-		return x.AttackerMeanDamage
+		return x.AiPlayers
 	}
-	return 0
+	return nil
 }
 
-func (x *SimulateAttackResponse) GetDefenderMeanDamage() float64 {
+// *
+// Response holding the new fork
+type ForkGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	GameState     *GameState             `protobuf:"bytes,2,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForkGameResponse) Reset() {
+	*x = ForkGameResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkGameResponse) ProtoMessage() {}
+
+func (x *ForkGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[46]
 	if x != nil {
-		return x.DefenderMeanDamage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SimulateAttackResponse) GetAttackerKillProbability() float64 {
+// Deprecated: Use ForkGameResponse.ProtoReflect.Descriptor instead.
+func (*ForkGameResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ForkGameResponse) GetGame() *Game {
 	if x != nil {
-		return x.AttackerKillProbability
+		return x.Game
 	}
-	return 0
+	return nil
 }
 
-func (x *SimulateAttackResponse) GetDefenderKillProbability() float64 {
+func (x *ForkGameResponse) GetGameState() *GameState {
 	if x != nil {
-		return x.DefenderKillProbability
+		return x.GameState
 	}
-	return 0
+	return nil
 }
 
 // *
-// Request for simulating fix (repair) between two units
-type SimulateFixRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	FixingUnitType   int32                  `protobuf:"varint,1,opt,name=fixing_unit_type,json=fixingUnitType,proto3" json:"fixing_unit_type,omitempty"`       // Unit type performing the fix
-	FixingUnitHealth int32                  `protobuf:"varint,2,opt,name=fixing_unit_health,json=fixingUnitHealth,proto3" json:"fixing_unit_health,omitempty"` // Health of the fixing unit (Hf)
-	InjuredUnitType  int32                  `protobuf:"varint,3,opt,name=injured_unit_type,json=injuredUnitType,proto3" json:"injured_unit_type,omitempty"`    // Unit type being repaired (for display)
-	NumSimulations   int32                  `protobuf:"varint,4,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"`         // Default: 1000
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// How a user is told about their games
+type NotificationPreferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "email", "webhook" or "none"
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// "instant", or batched into "hourly" or "daily" digests
+	Immediacy string `protobuf:"bytes,2,opt,name=immediacy,proto3" json:"immediacy,omitempty"`
+	// Address emails go to, for the "email" channel
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// URL events are posted to, for the "webhook" channel
+	WebhookUrl    string `protobuf:"bytes,4,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulateFixRequest) Reset() {
-	*x = SimulateFixRequest{}
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[25]
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulateFixRequest) String() string {
+func (x *NotificationPreferences) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulateFixRequest) ProtoMessage() {}
+func (*NotificationPreferences) ProtoMessage() {}
 
-func (x *SimulateFixRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[25]
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1632,68 +3190,62 @@ func (x *SimulateFixRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulateFixRequest.ProtoReflect.Descriptor instead.
-func (*SimulateFixRequest) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SimulateFixRequest) GetFixingUnitType() int32 {
+func (x *NotificationPreferences) GetChannel() string {
 	if x != nil {
-		return x.FixingUnitType
+		return x.Channel
 	}
-	return 0
+	return ""
 }
 
-func (x *SimulateFixRequest) GetFixingUnitHealth() int32 {
+func (x *NotificationPreferences) GetImmediacy() string {
 	if x != nil {
-		return x.FixingUnitHealth
+		return x.Immediacy
 	}
-	return 0
+	return ""
 }
 
-func (x *SimulateFixRequest) GetInjuredUnitType() int32 {
+func (x *NotificationPreferences) GetEmail() string {
 	if x != nil {
-		return x.InjuredUnitType
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-func (x *SimulateFixRequest) GetNumSimulations() int32 {
+func (x *NotificationPreferences) GetWebhookUrl() string {
 	if x != nil {
-		return x.NumSimulations
+		return x.WebhookUrl
 	}
-	return 0
+	return ""
 }
 
 // *
-// Response containing health restoration distribution statistics
-type SimulateFixResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Health restoration distribution: health_restored -> number_of_occurrences
-	HealingDistribution map[int32]int32 `protobuf:"bytes,1,rep,name=healing_distribution,json=healingDistribution,proto3" json:"healing_distribution,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	// Statistical summary
-	MeanHealing float64 `protobuf:"fixed64,2,opt,name=mean_healing,json=meanHealing,proto3" json:"mean_healing,omitempty"`
-	// The fix value (F) of the fixing unit type
-	FixValue      int32 `protobuf:"varint,3,opt,name=fix_value,json=fixValue,proto3" json:"fix_value,omitempty"`
+// Request for the caller's notification preferences
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulateFixResponse) Reset() {
-	*x = SimulateFixResponse{}
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[26]
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulateFixResponse) String() string {
+func (x *GetNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulateFixResponse) ProtoMessage() {}
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *SimulateFixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[26]
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1704,62 +3256,81 @@ func (x *SimulateFixResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulateFixResponse.ProtoReflect.Descriptor instead.
-func (*SimulateFixResponse) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SimulateFixResponse) GetHealingDistribution() map[int32]int32 {
-	if x != nil {
-		return x.HealingDistribution
-	}
-	return nil
+// *
+// Response holding the caller's notification preferences
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulateFixResponse) GetMeanHealing() float64 {
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[49]
 	if x != nil {
-		return x.MeanHealing
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SimulateFixResponse) GetFixValue() int32 {
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
 	if x != nil {
-		return x.FixValue
+		return x.Preferences
 	}
-	return 0
+	return nil
 }
 
 // *
-// Request to join a game as a player
-// User must be authenticated to join a game.
-// The player slot must be "open" (player_type = "open") to be joinable.
-type JoinGameRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the game to join
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// The player ID (slot) to join as (1-based)
-	// Must be an open slot (player_type = "open")
-	PlayerId      int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+// Request to replace the caller's notification preferences
+type SetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinGameRequest) Reset() {
-	*x = JoinGameRequest{}
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[27]
+func (x *SetNotificationPreferencesRequest) Reset() {
+	*x = SetNotificationPreferencesRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinGameRequest) String() string {
+func (x *SetNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinGameRequest) ProtoMessage() {}
+func (*SetNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[27]
+func (x *SetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1770,52 +3341,42 @@ func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
-func (*JoinGameRequest) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *JoinGameRequest) GetGameId() string {
-	if x != nil {
-		return x.GameId
-	}
-	return ""
+// Deprecated: Use SetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *JoinGameRequest) GetPlayerId() int32 {
+func (x *SetNotificationPreferencesRequest) GetPreferences() *NotificationPreferences {
 	if x != nil {
-		return x.PlayerId
+		return x.Preferences
 	}
-	return 0
+	return nil
 }
 
 // *
-// Response after joining a game
-type JoinGameResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The updated game with the user assigned to the player slot
-	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
-	// The player ID that was joined
-	PlayerId      int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+// Response holding the caller's new notification preferences
+type SetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinGameResponse) Reset() {
-	*x = JoinGameResponse{}
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[28]
+func (x *SetNotificationPreferencesResponse) Reset() {
+	*x = SetNotificationPreferencesResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinGameResponse) String() string {
+func (x *SetNotificationPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinGameResponse) ProtoMessage() {}
+func (*SetNotificationPreferencesResponse) ProtoMessage() {}
 
-func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[28]
+func (x *SetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1826,35 +3387,30 @@ func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
-func (*JoinGameResponse) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *JoinGameResponse) GetGame() *Game {
+func (x *SetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
 	if x != nil {
-		return x.Game
+		return x.Preferences
 	}
 	return nil
 }
 
-func (x *JoinGameResponse) GetPlayerId() int32 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
-}
-
 var File_lilbattle_v1_models_games_service_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_models_games_service_proto_rawDesc = "" +
 	"\n" +
-	"'lilbattle/v1/models/games_service.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\"g\n" +
+	"'lilbattle/v1/models/games_service.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\"\xa7\x01\n" +
 	"\x10ListGamesRequest\x128\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
 	"pagination\x12\x19\n" +
-	"\bowner_id\x18\x02 \x01(\tR\aownerId\"\x7f\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x19\n" +
+	"\bworld_id\x18\x03 \x01(\tR\aworldId\x12#\n" +
+	"\rinclude_forks\x18\x04 \x01(\bR\fincludeForks\"\x7f\n" +
 	"\x11ListGamesResponse\x12(\n" +
 	"\x05items\x18\x01 \x03(\v2\x12.lilbattle.v1.GameR\x05items\x12@\n" +
 	"\n" +
@@ -1906,18 +3462,61 @@ const file_lilbattle_v1_models_games_service_proto_rawDesc = "" +
 	"\ffield_errors\x18\x03 \x03(\v21.lilbattle.v1.CreateGameResponse.FieldErrorsEntryR\vfieldErrors\x1a>\n" +
 	"\x10FieldErrorsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc6\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa2\x02\n" +
 	"\x13ProcessMovesRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12,\n" +
 	"\x05moves\x18\x02 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\x12O\n" +
 	"\x11expected_response\x18\x03 \x01(\v2\".lilbattle.v1.ProcessMovesResponseR\x10expectedResponse\x12\x17\n" +
-	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"D\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05coach\x18\x05 \x01(\bR\x05coach\x12\x1f\n" +
+	"\vapi_version\x18\x06 \x01(\x05R\n" +
+	"apiVersion\x12#\n" +
+	"\rdebug_resolve\x18\a \x01(\bR\fdebugResolve\"\xf7\x01\n" +
 	"\x14ProcessMovesResponse\x12,\n" +
-	"\x05moves\x18\x03 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\".\n" +
+	"\x05moves\x18\x03 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\x129\n" +
+	"\vserver_info\x18\x04 \x01(\v2\x18.lilbattle.v1.ServerInfoR\n" +
+	"serverInfo\x126\n" +
+	"\n" +
+	"state_diff\x18\x05 \x01(\v2\x17.lilbattle.v1.StateDiffR\tstateDiff\x12>\n" +
+	"\vresolutions\x18\x06 \x03(\v2\x1c.lilbattle.v1.MoveResolutionR\vresolutions\"\xf6\x01\n" +
+	"\x0eMoveResolution\x12\x1d\n" +
+	"\n" +
+	"move_index\x18\x01 \x01(\x05R\tmoveIndex\x126\n" +
+	"\x06source\x18\x02 \x01(\v2\x1e.lilbattle.v1.ResolvedPositionR\x06source\x126\n" +
+	"\x06target\x18\x03 \x01(\v2\x1e.lilbattle.v1.ResolvedPositionR\x06target\x12?\n" +
+	"\x0ematched_option\x18\x04 \x01(\v2\x18.lilbattle.v1.GameOptionR\rmatchedOption\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xd5\x01\n" +
+	"\x10ResolvedPosition\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\x12\x1a\n" +
+	"\bresolved\x18\x02 \x01(\bR\bresolved\x12\f\n" +
+	"\x01q\x18\x03 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x04 \x01(\x05R\x01r\x12\x17\n" +
+	"\aunit_id\x18\x05 \x01(\x05R\x06unitId\x12#\n" +
+	"\runit_shortcut\x18\x06 \x01(\tR\funitShortcut\x12\x1f\n" +
+	"\vunit_player\x18\a \x01(\x05R\n" +
+	"unitPlayer\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"\x97\x01\n" +
+	"\n" +
+	"ServerInfo\x12\x1f\n" +
+	"\vapi_version\x18\x01 \x01(\x05R\n" +
+	"apiVersion\x12&\n" +
+	"\x0fmin_api_version\x18\x02 \x01(\x05R\rminApiVersion\x12@\n" +
+	"\fdeprecations\x18\x03 \x03(\v2\x1c.lilbattle.v1.ApiDeprecationR\fdeprecations\"\x80\x01\n" +
+	"\x0eApiDeprecation\x12\x10\n" +
+	"\x03api\x18\x01 \x01(\tR\x03api\x12#\n" +
+	"\rdeprecated_in\x18\x02 \x01(\x05R\fdeprecatedIn\x12\x1d\n" +
+	"\n" +
+	"removed_in\x18\x03 \x01(\x05R\tremovedIn\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\".\n" +
 	"\x13GetGameStateRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\"E\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"\xb0\x02\n" +
 	"\x14GetGameStateResponse\x12-\n" +
-	"\x05state\x18\x01 \x01(\v2\x17.lilbattle.v1.GameStateR\x05state\"e\n" +
+	"\x05state\x18\x01 \x01(\v2\x17.lilbattle.v1.GameStateR\x05state\x12c\n" +
+	"\x11remaining_time_ms\x18\x02 \x03(\v27.lilbattle.v1.GetGameStateResponse.RemainingTimeMsEntryR\x0fremainingTimeMs\x12@\n" +
+	"\rstuck_warning\x18\x03 \x01(\v2\x1b.lilbattle.v1.StuckAnalysisR\fstuckWarning\x1aB\n" +
+	"\x14RemainingTimeMsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"e\n" +
 	"\x10ListMovesRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1d\n" +
 	"\n" +
@@ -1934,7 +3533,7 @@ const file_lilbattle_v1_models_games_service_proto_rawDesc = "" +
 	"\aoptions\x18\x01 \x03(\v2\x18.lilbattle.v1.GameOptionR\aoptions\x12%\n" +
 	"\x0ecurrent_player\x18\x02 \x01(\x05R\rcurrentPlayer\x12)\n" +
 	"\x10game_initialized\x18\x03 \x01(\bR\x0fgameInitialized\x123\n" +
-	"\tall_paths\x18\x05 \x01(\v2\x16.lilbattle.v1.AllPathsR\ballPaths\"\xef\x02\n" +
+	"\tall_paths\x18\x05 \x01(\v2\x16.lilbattle.v1.AllPathsR\ballPaths\"\x9e\x04\n" +
 	"\n" +
 	"GameOption\x122\n" +
 	"\x04move\x18\x01 \x01(\v2\x1c.lilbattle.v1.MoveUnitActionH\x00R\x04move\x128\n" +
@@ -1942,7 +3541,10 @@ const file_lilbattle_v1_models_games_service_proto_rawDesc = "" +
 	"\x05build\x18\x03 \x01(\v2\x1d.lilbattle.v1.BuildUnitActionH\x00R\x05build\x12?\n" +
 	"\acapture\x18\x04 \x01(\v2#.lilbattle.v1.CaptureBuildingActionH\x00R\acapture\x128\n" +
 	"\bend_turn\x18\x05 \x01(\v2\x1b.lilbattle.v1.EndTurnActionH\x00R\aendTurn\x122\n" +
-	"\x04heal\x18\x06 \x01(\v2\x1c.lilbattle.v1.HealUnitActionH\x00R\x04healB\r\n" +
+	"\x04heal\x18\x06 \x01(\v2\x1c.lilbattle.v1.HealUnitActionH\x00R\x04heal\x12D\n" +
+	"\tconstruct\x18\a \x01(\v2$.lilbattle.v1.ConstructTerrainActionH\x00R\tconstruct\x12>\n" +
+	"\bsubmerge\x18\b \x01(\v2 .lilbattle.v1.SubmergeUnitActionH\x00R\bsubmerge\x12'\n" +
+	"\x0fdisabled_reason\x18\t \x01(\tR\x0edisabledReasonB\r\n" +
 	"\voption_type\"\xe5\x02\n" +
 	"\x15SimulateAttackRequest\x12,\n" +
 	"\x12attacker_unit_type\x18\x01 \x01(\x05R\x10attackerUnitType\x12)\n" +
@@ -1953,14 +3555,15 @@ const file_lilbattle_v1_models_games_service_proto_rawDesc = "" +
 	"\x0fdefender_health\x18\x06 \x01(\x05R\x0edefenderHealth\x12\x1f\n" +
 	"\vwound_bonus\x18\a \x01(\x05R\n" +
 	"woundBonus\x12'\n" +
-	"\x0fnum_simulations\x18\b \x01(\x05R\x0enumSimulations\"\xa4\x05\n" +
+	"\x0fnum_simulations\x18\b \x01(\x05R\x0enumSimulations\"\xdc\x05\n" +
 	"\x16SimulateAttackResponse\x12\x86\x01\n" +
 	"\x1cattacker_damage_distribution\x18\x01 \x03(\v2D.lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntryR\x1aattackerDamageDistribution\x12\x86\x01\n" +
 	"\x1cdefender_damage_distribution\x18\x02 \x03(\v2D.lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntryR\x1adefenderDamageDistribution\x120\n" +
 	"\x14attacker_mean_damage\x18\x03 \x01(\x01R\x12attackerMeanDamage\x120\n" +
 	"\x14defender_mean_damage\x18\x04 \x01(\x01R\x12defenderMeanDamage\x12:\n" +
 	"\x19attacker_kill_probability\x18\x05 \x01(\x01R\x17attackerKillProbability\x12:\n" +
-	"\x19defender_kill_probability\x18\x06 \x01(\x01R\x17defenderKillProbability\x1aM\n" +
+	"\x19defender_kill_probability\x18\x06 \x01(\x01R\x17defenderKillProbability\x126\n" +
+	"\x17terrain_defense_applied\x18\a \x01(\x01R\x15terrainDefenseApplied\x1aM\n" +
 	"\x1fAttackerDamageDistributionEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aM\n" +
@@ -1984,7 +3587,72 @@ const file_lilbattle_v1_models_games_service_proto_rawDesc = "" +
 	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\"W\n" +
 	"\x10JoinGameResponse\x12&\n" +
 	"\x04game\x18\x01 \x01(\v2\x12.lilbattle.v1.GameR\x04game\x12\x1b\n" +
-	"\tplayer_id\x18\x02 \x01(\x05R\bplayerIdB\xbd\x01\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\"e\n" +
+	"\x15SetClockPausedRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12\x16\n" +
+	"\x06paused\x18\x03 \x01(\bR\x06paused\"W\n" +
+	"\x16SetClockPausedResponse\x12\x16\n" +
+	"\x06paused\x18\x01 \x01(\bR\x06paused\x12%\n" +
+	"\x0epause_requests\x18\x02 \x03(\x05R\rpauseRequests\"y\n" +
+	"\x13DelegateTurnRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12,\n" +
+	"\x12delegate_player_id\x18\x03 \x01(\x05R\x10delegatePlayerId\"9\n" +
+	"\x14DelegateTurnResponse\x12!\n" +
+	"\fdelegated_to\x18\x01 \x01(\x05R\vdelegatedTo\"Q\n" +
+	"\x19ClaimNoContactDrawRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\"U\n" +
+	"\x1aClaimNoContactDrawResponse\x127\n" +
+	"\banalysis\x18\x01 \x01(\v2\x1b.lilbattle.v1.StuckAnalysisR\banalysis\"y\n" +
+	"\x10DraftUnitRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12\x1b\n" +
+	"\tunit_type\x18\x03 \x01(\x05R\bunitType\x12\x12\n" +
+	"\x04pick\x18\x04 \x01(\bR\x04pick\"\x91\x01\n" +
+	"\x11DraftUnitResponse\x12.\n" +
+	"\x05draft\x18\x01 \x01(\v2\x18.lilbattle.v1.DraftStateR\x05draft\x12%\n" +
+	"\x0ecurrent_player\x18\x02 \x01(\x05R\rcurrentPlayer\x12%\n" +
+	"\x0edraft_complete\x18\x03 \x01(\bR\rdraftComplete\"d\n" +
+	"\x13GetStateDiffRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tfrom_turn\x18\x02 \x01(\x05R\bfromTurn\x12\x17\n" +
+	"\ato_turn\x18\x03 \x01(\x05R\x06toTurn\"C\n" +
+	"\x14GetStateDiffResponse\x12+\n" +
+	"\x04diff\x18\x01 \x01(\v2\x17.lilbattle.v1.StateDiffR\x04diff\"\\\n" +
+	"\x15GetStateAtTurnRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x12\n" +
+	"\x04turn\x18\x02 \x01(\x05R\x04turn\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\x05R\x06player\"\xbc\x01\n" +
+	"\x16GetStateAtTurnResponse\x12-\n" +
+	"\x05state\x18\x01 \x01(\v2\x17.lilbattle.v1.GameStateR\x05state\x12#\n" +
+	"\rsnapshot_turn\x18\x02 \x01(\x05R\fsnapshotTurn\x12'\n" +
+	"\x0fsnapshot_player\x18\x03 \x01(\x05R\x0esnapshotPlayer\x12%\n" +
+	"\x0ereplayed_moves\x18\x04 \x01(\x05R\rreplayedMoves\"h\n" +
+	"\x0fForkGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1d\n" +
+	"\n" +
+	"move_index\x18\x02 \x01(\x05R\tmoveIndex\x12\x1d\n" +
+	"\n" +
+	"ai_players\x18\x03 \x03(\x05R\taiPlayers\"r\n" +
+	"\x10ForkGameResponse\x12&\n" +
+	"\x04game\x18\x01 \x01(\v2\x12.lilbattle.v1.GameR\x04game\x126\n" +
+	"\n" +
+	"game_state\x18\x02 \x01(\v2\x17.lilbattle.v1.GameStateR\tgameState\"\x88\x01\n" +
+	"\x17NotificationPreferences\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x1c\n" +
+	"\timmediacy\x18\x02 \x01(\tR\timmediacy\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1f\n" +
+	"\vwebhook_url\x18\x04 \x01(\tR\n" +
+	"webhookUrl\"#\n" +
+	"!GetNotificationPreferencesRequest\"m\n" +
+	"\"GetNotificationPreferencesResponse\x12G\n" +
+	"\vpreferences\x18\x01 \x01(\v2%.lilbattle.v1.NotificationPreferencesR\vpreferences\"l\n" +
+	"!SetNotificationPreferencesRequest\x12G\n" +
+	"\vpreferences\x18\x01 \x01(\v2%.lilbattle.v1.NotificationPreferencesR\vpreferences\"m\n" +
+	"\"SetNotificationPreferencesResponse\x12G\n" +
+	"\vpreferences\x18\x01 \x01(\v2%.lilbattle.v1.NotificationPreferencesR\vpreferencesB\xbd\x01\n" +
 	"\x10com.lilbattle.v1B\x11GamesServiceProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var (
@@ -1999,100 +3667,149 @@ func file_lilbattle_v1_models_games_service_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_models_games_service_proto_rawDescData
 }
 
-var file_lilbattle_v1_models_games_service_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_lilbattle_v1_models_games_service_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_lilbattle_v1_models_games_service_proto_goTypes = []any{
-	(*ListGamesRequest)(nil),       // 0: lilbattle.v1.ListGamesRequest
-	(*ListGamesResponse)(nil),      // 1: lilbattle.v1.ListGamesResponse
-	(*GetGameRequest)(nil),         // 2: lilbattle.v1.GetGameRequest
-	(*GetGameResponse)(nil),        // 3: lilbattle.v1.GetGameResponse
-	(*GetGameContentRequest)(nil),  // 4: lilbattle.v1.GetGameContentRequest
-	(*GetGameContentResponse)(nil), // 5: lilbattle.v1.GetGameContentResponse
-	(*UpdateGameRequest)(nil),      // 6: lilbattle.v1.UpdateGameRequest
-	(*UpdateGameResponse)(nil),     // 7: lilbattle.v1.UpdateGameResponse
-	(*DeleteGameRequest)(nil),      // 8: lilbattle.v1.DeleteGameRequest
-	(*DeleteGameResponse)(nil),     // 9: lilbattle.v1.DeleteGameResponse
-	(*GetGamesRequest)(nil),        // 10: lilbattle.v1.GetGamesRequest
-	(*GetGamesResponse)(nil),       // 11: lilbattle.v1.GetGamesResponse
-	(*CreateGameRequest)(nil),      // 12: lilbattle.v1.CreateGameRequest
-	(*CreateGameResponse)(nil),     // 13: lilbattle.v1.CreateGameResponse
-	(*ProcessMovesRequest)(nil),    // 14: lilbattle.v1.ProcessMovesRequest
-	(*ProcessMovesResponse)(nil),   // 15: lilbattle.v1.ProcessMovesResponse
-	(*GetGameStateRequest)(nil),    // 16: lilbattle.v1.GetGameStateRequest
-	(*GetGameStateResponse)(nil),   // 17: lilbattle.v1.GetGameStateResponse
-	(*ListMovesRequest)(nil),       // 18: lilbattle.v1.ListMovesRequest
-	(*ListMovesResponse)(nil),      // 19: lilbattle.v1.ListMovesResponse
-	(*GetOptionsAtRequest)(nil),    // 20: lilbattle.v1.GetOptionsAtRequest
-	(*GetOptionsAtResponse)(nil),   // 21: lilbattle.v1.GetOptionsAtResponse
-	(*GameOption)(nil),             // 22: lilbattle.v1.GameOption
-	(*SimulateAttackRequest)(nil),  // 23: lilbattle.v1.SimulateAttackRequest
-	(*SimulateAttackResponse)(nil), // 24: lilbattle.v1.SimulateAttackResponse
-	(*SimulateFixRequest)(nil),     // 25: lilbattle.v1.SimulateFixRequest
-	(*SimulateFixResponse)(nil),    // 26: lilbattle.v1.SimulateFixResponse
-	(*JoinGameRequest)(nil),        // 27: lilbattle.v1.JoinGameRequest
-	(*JoinGameResponse)(nil),       // 28: lilbattle.v1.JoinGameResponse
-	nil,                            // 29: lilbattle.v1.GetGamesResponse.GamesEntry
-	nil,                            // 30: lilbattle.v1.CreateGameResponse.FieldErrorsEntry
-	nil,                            // 31: lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntry
-	nil,                            // 32: lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntry
-	nil,                            // 33: lilbattle.v1.SimulateFixResponse.HealingDistributionEntry
-	(*Pagination)(nil),             // 34: lilbattle.v1.Pagination
-	(*Game)(nil),                   // 35: lilbattle.v1.Game
-	(*PaginationResponse)(nil),     // 36: lilbattle.v1.PaginationResponse
-	(*GameState)(nil),              // 37: lilbattle.v1.GameState
-	(*GameMoveHistory)(nil),        // 38: lilbattle.v1.GameMoveHistory
-	(*fieldmaskpb.FieldMask)(nil),  // 39: google.protobuf.FieldMask
-	(*GameMove)(nil),               // 40: lilbattle.v1.GameMove
-	(*GameMoveGroup)(nil),          // 41: lilbattle.v1.GameMoveGroup
-	(*Position)(nil),               // 42: lilbattle.v1.Position
-	(*AllPaths)(nil),               // 43: lilbattle.v1.AllPaths
-	(*MoveUnitAction)(nil),         // 44: lilbattle.v1.MoveUnitAction
-	(*AttackUnitAction)(nil),       // 45: lilbattle.v1.AttackUnitAction
-	(*BuildUnitAction)(nil),        // 46: lilbattle.v1.BuildUnitAction
-	(*CaptureBuildingAction)(nil),  // 47: lilbattle.v1.CaptureBuildingAction
-	(*EndTurnAction)(nil),          // 48: lilbattle.v1.EndTurnAction
-	(*HealUnitAction)(nil),         // 49: lilbattle.v1.HealUnitAction
+	(*ListGamesRequest)(nil),                   // 0: lilbattle.v1.ListGamesRequest
+	(*ListGamesResponse)(nil),                  // 1: lilbattle.v1.ListGamesResponse
+	(*GetGameRequest)(nil),                     // 2: lilbattle.v1.GetGameRequest
+	(*GetGameResponse)(nil),                    // 3: lilbattle.v1.GetGameResponse
+	(*GetGameContentRequest)(nil),              // 4: lilbattle.v1.GetGameContentRequest
+	(*GetGameContentResponse)(nil),             // 5: lilbattle.v1.GetGameContentResponse
+	(*UpdateGameRequest)(nil),                  // 6: lilbattle.v1.UpdateGameRequest
+	(*UpdateGameResponse)(nil),                 // 7: lilbattle.v1.UpdateGameResponse
+	(*DeleteGameRequest)(nil),                  // 8: lilbattle.v1.DeleteGameRequest
+	(*DeleteGameResponse)(nil),                 // 9: lilbattle.v1.DeleteGameResponse
+	(*GetGamesRequest)(nil),                    // 10: lilbattle.v1.GetGamesRequest
+	(*GetGamesResponse)(nil),                   // 11: lilbattle.v1.GetGamesResponse
+	(*CreateGameRequest)(nil),                  // 12: lilbattle.v1.CreateGameRequest
+	(*CreateGameResponse)(nil),                 // 13: lilbattle.v1.CreateGameResponse
+	(*ProcessMovesRequest)(nil),                // 14: lilbattle.v1.ProcessMovesRequest
+	(*ProcessMovesResponse)(nil),               // 15: lilbattle.v1.ProcessMovesResponse
+	(*MoveResolution)(nil),                     // 16: lilbattle.v1.MoveResolution
+	(*ResolvedPosition)(nil),                   // 17: lilbattle.v1.ResolvedPosition
+	(*ServerInfo)(nil),                         // 18: lilbattle.v1.ServerInfo
+	(*ApiDeprecation)(nil),                     // 19: lilbattle.v1.ApiDeprecation
+	(*GetGameStateRequest)(nil),                // 20: lilbattle.v1.GetGameStateRequest
+	(*GetGameStateResponse)(nil),               // 21: lilbattle.v1.GetGameStateResponse
+	(*ListMovesRequest)(nil),                   // 22: lilbattle.v1.ListMovesRequest
+	(*ListMovesResponse)(nil),                  // 23: lilbattle.v1.ListMovesResponse
+	(*GetOptionsAtRequest)(nil),                // 24: lilbattle.v1.GetOptionsAtRequest
+	(*GetOptionsAtResponse)(nil),               // 25: lilbattle.v1.GetOptionsAtResponse
+	(*GameOption)(nil),                         // 26: lilbattle.v1.GameOption
+	(*SimulateAttackRequest)(nil),              // 27: lilbattle.v1.SimulateAttackRequest
+	(*SimulateAttackResponse)(nil),             // 28: lilbattle.v1.SimulateAttackResponse
+	(*SimulateFixRequest)(nil),                 // 29: lilbattle.v1.SimulateFixRequest
+	(*SimulateFixResponse)(nil),                // 30: lilbattle.v1.SimulateFixResponse
+	(*JoinGameRequest)(nil),                    // 31: lilbattle.v1.JoinGameRequest
+	(*JoinGameResponse)(nil),                   // 32: lilbattle.v1.JoinGameResponse
+	(*SetClockPausedRequest)(nil),              // 33: lilbattle.v1.SetClockPausedRequest
+	(*SetClockPausedResponse)(nil),             // 34: lilbattle.v1.SetClockPausedResponse
+	(*DelegateTurnRequest)(nil),                // 35: lilbattle.v1.DelegateTurnRequest
+	(*DelegateTurnResponse)(nil),               // 36: lilbattle.v1.DelegateTurnResponse
+	(*ClaimNoContactDrawRequest)(nil),          // 37: lilbattle.v1.ClaimNoContactDrawRequest
+	(*ClaimNoContactDrawResponse)(nil),         // 38: lilbattle.v1.ClaimNoContactDrawResponse
+	(*DraftUnitRequest)(nil),                   // 39: lilbattle.v1.DraftUnitRequest
+	(*DraftUnitResponse)(nil),                  // 40: lilbattle.v1.DraftUnitResponse
+	(*GetStateDiffRequest)(nil),                // 41: lilbattle.v1.GetStateDiffRequest
+	(*GetStateDiffResponse)(nil),               // 42: lilbattle.v1.GetStateDiffResponse
+	(*GetStateAtTurnRequest)(nil),              // 43: lilbattle.v1.GetStateAtTurnRequest
+	(*GetStateAtTurnResponse)(nil),             // 44: lilbattle.v1.GetStateAtTurnResponse
+	(*ForkGameRequest)(nil),                    // 45: lilbattle.v1.ForkGameRequest
+	(*ForkGameResponse)(nil),                   // 46: lilbattle.v1.ForkGameResponse
+	(*NotificationPreferences)(nil),            // 47: lilbattle.v1.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),  // 48: lilbattle.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil), // 49: lilbattle.v1.GetNotificationPreferencesResponse
+	(*SetNotificationPreferencesRequest)(nil),  // 50: lilbattle.v1.SetNotificationPreferencesRequest
+	(*SetNotificationPreferencesResponse)(nil), // 51: lilbattle.v1.SetNotificationPreferencesResponse
+	nil,                            // 52: lilbattle.v1.GetGamesResponse.GamesEntry
+	nil,                            // 53: lilbattle.v1.CreateGameResponse.FieldErrorsEntry
+	nil,                            // 54: lilbattle.v1.GetGameStateResponse.RemainingTimeMsEntry
+	nil,                            // 55: lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntry
+	nil,                            // 56: lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntry
+	nil,                            // 57: lilbattle.v1.SimulateFixResponse.HealingDistributionEntry
+	(*Pagination)(nil),             // 58: lilbattle.v1.Pagination
+	(*Game)(nil),                   // 59: lilbattle.v1.Game
+	(*PaginationResponse)(nil),     // 60: lilbattle.v1.PaginationResponse
+	(*GameState)(nil),              // 61: lilbattle.v1.GameState
+	(*GameMoveHistory)(nil),        // 62: lilbattle.v1.GameMoveHistory
+	(*fieldmaskpb.FieldMask)(nil),  // 63: google.protobuf.FieldMask
+	(*GameMove)(nil),               // 64: lilbattle.v1.GameMove
+	(*StateDiff)(nil),              // 65: lilbattle.v1.StateDiff
+	(*StuckAnalysis)(nil),          // 66: lilbattle.v1.StuckAnalysis
+	(*GameMoveGroup)(nil),          // 67: lilbattle.v1.GameMoveGroup
+	(*Position)(nil),               // 68: lilbattle.v1.Position
+	(*AllPaths)(nil),               // 69: lilbattle.v1.AllPaths
+	(*MoveUnitAction)(nil),         // 70: lilbattle.v1.MoveUnitAction
+	(*AttackUnitAction)(nil),       // 71: lilbattle.v1.AttackUnitAction
+	(*BuildUnitAction)(nil),        // 72: lilbattle.v1.BuildUnitAction
+	(*CaptureBuildingAction)(nil),  // 73: lilbattle.v1.CaptureBuildingAction
+	(*EndTurnAction)(nil),          // 74: lilbattle.v1.EndTurnAction
+	(*HealUnitAction)(nil),         // 75: lilbattle.v1.HealUnitAction
+	(*ConstructTerrainAction)(nil), // 76: lilbattle.v1.ConstructTerrainAction
+	(*SubmergeUnitAction)(nil),     // 77: lilbattle.v1.SubmergeUnitAction
+	(*DraftState)(nil),             // 78: lilbattle.v1.DraftState
 }
 var file_lilbattle_v1_models_games_service_proto_depIdxs = []int32{
-	34, // 0: lilbattle.v1.ListGamesRequest.pagination:type_name -> lilbattle.v1.Pagination
-	35, // 1: lilbattle.v1.ListGamesResponse.items:type_name -> lilbattle.v1.Game
-	36, // 2: lilbattle.v1.ListGamesResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
-	35, // 3: lilbattle.v1.GetGameResponse.game:type_name -> lilbattle.v1.Game
-	37, // 4: lilbattle.v1.GetGameResponse.state:type_name -> lilbattle.v1.GameState
-	38, // 5: lilbattle.v1.GetGameResponse.history:type_name -> lilbattle.v1.GameMoveHistory
-	35, // 6: lilbattle.v1.UpdateGameRequest.new_game:type_name -> lilbattle.v1.Game
-	37, // 7: lilbattle.v1.UpdateGameRequest.new_state:type_name -> lilbattle.v1.GameState
-	38, // 8: lilbattle.v1.UpdateGameRequest.new_history:type_name -> lilbattle.v1.GameMoveHistory
-	39, // 9: lilbattle.v1.UpdateGameRequest.update_mask:type_name -> google.protobuf.FieldMask
-	35, // 10: lilbattle.v1.UpdateGameResponse.game:type_name -> lilbattle.v1.Game
-	29, // 11: lilbattle.v1.GetGamesResponse.games:type_name -> lilbattle.v1.GetGamesResponse.GamesEntry
-	35, // 12: lilbattle.v1.CreateGameRequest.game:type_name -> lilbattle.v1.Game
-	35, // 13: lilbattle.v1.CreateGameResponse.game:type_name -> lilbattle.v1.Game
-	37, // 14: lilbattle.v1.CreateGameResponse.game_state:type_name -> lilbattle.v1.GameState
-	30, // 15: lilbattle.v1.CreateGameResponse.field_errors:type_name -> lilbattle.v1.CreateGameResponse.FieldErrorsEntry
-	40, // 16: lilbattle.v1.ProcessMovesRequest.moves:type_name -> lilbattle.v1.GameMove
+	58, // 0: lilbattle.v1.ListGamesRequest.pagination:type_name -> lilbattle.v1.Pagination
+	59, // 1: lilbattle.v1.ListGamesResponse.items:type_name -> lilbattle.v1.Game
+	60, // 2: lilbattle.v1.ListGamesResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
+	59, // 3: lilbattle.v1.GetGameResponse.game:type_name -> lilbattle.v1.Game
+	61, // 4: lilbattle.v1.GetGameResponse.state:type_name -> lilbattle.v1.GameState
+	62, // 5: lilbattle.v1.GetGameResponse.history:type_name -> lilbattle.v1.GameMoveHistory
+	59, // 6: lilbattle.v1.UpdateGameRequest.new_game:type_name -> lilbattle.v1.Game
+	61, // 7: lilbattle.v1.UpdateGameRequest.new_state:type_name -> lilbattle.v1.GameState
+	62, // 8: lilbattle.v1.UpdateGameRequest.new_history:type_name -> lilbattle.v1.GameMoveHistory
+	63, // 9: lilbattle.v1.UpdateGameRequest.update_mask:type_name -> google.protobuf.FieldMask
+	59, // 10: lilbattle.v1.UpdateGameResponse.game:type_name -> lilbattle.v1.Game
+	52, // 11: lilbattle.v1.GetGamesResponse.games:type_name -> lilbattle.v1.GetGamesResponse.GamesEntry
+	59, // 12: lilbattle.v1.CreateGameRequest.game:type_name -> lilbattle.v1.Game
+	59, // 13: lilbattle.v1.CreateGameResponse.game:type_name -> lilbattle.v1.Game
+	61, // 14: lilbattle.v1.CreateGameResponse.game_state:type_name -> lilbattle.v1.GameState
+	53, // 15: lilbattle.v1.CreateGameResponse.field_errors:type_name -> lilbattle.v1.CreateGameResponse.FieldErrorsEntry
+	64, // 16: lilbattle.v1.ProcessMovesRequest.moves:type_name -> lilbattle.v1.GameMove
 	15, // 17: lilbattle.v1.ProcessMovesRequest.expected_response:type_name -> lilbattle.v1.ProcessMovesResponse
-	40, // 18: lilbattle.v1.ProcessMovesResponse.moves:type_name -> lilbattle.v1.GameMove
-	37, // 19: lilbattle.v1.GetGameStateResponse.state:type_name -> lilbattle.v1.GameState
-	41, // 20: lilbattle.v1.ListMovesResponse.move_groups:type_name -> lilbattle.v1.GameMoveGroup
-	42, // 21: lilbattle.v1.GetOptionsAtRequest.pos:type_name -> lilbattle.v1.Position
-	22, // 22: lilbattle.v1.GetOptionsAtResponse.options:type_name -> lilbattle.v1.GameOption
-	43, // 23: lilbattle.v1.GetOptionsAtResponse.all_paths:type_name -> lilbattle.v1.AllPaths
-	44, // 24: lilbattle.v1.GameOption.move:type_name -> lilbattle.v1.MoveUnitAction
-	45, // 25: lilbattle.v1.GameOption.attack:type_name -> lilbattle.v1.AttackUnitAction
-	46, // 26: lilbattle.v1.GameOption.build:type_name -> lilbattle.v1.BuildUnitAction
-	47, // 27: lilbattle.v1.GameOption.capture:type_name -> lilbattle.v1.CaptureBuildingAction
-	48, // 28: lilbattle.v1.GameOption.end_turn:type_name -> lilbattle.v1.EndTurnAction
-	49, // 29: lilbattle.v1.GameOption.heal:type_name -> lilbattle.v1.HealUnitAction
-	31, // 30: lilbattle.v1.SimulateAttackResponse.attacker_damage_distribution:type_name -> lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntry
-	32, // 31: lilbattle.v1.SimulateAttackResponse.defender_damage_distribution:type_name -> lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntry
-	33, // 32: lilbattle.v1.SimulateFixResponse.healing_distribution:type_name -> lilbattle.v1.SimulateFixResponse.HealingDistributionEntry
-	35, // 33: lilbattle.v1.JoinGameResponse.game:type_name -> lilbattle.v1.Game
-	35, // 34: lilbattle.v1.GetGamesResponse.GamesEntry.value:type_name -> lilbattle.v1.Game
-	35, // [35:35] is the sub-list for method output_type
-	35, // [35:35] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	64, // 18: lilbattle.v1.ProcessMovesResponse.moves:type_name -> lilbattle.v1.GameMove
+	18, // 19: lilbattle.v1.ProcessMovesResponse.server_info:type_name -> lilbattle.v1.ServerInfo
+	65, // 20: lilbattle.v1.ProcessMovesResponse.state_diff:type_name -> lilbattle.v1.StateDiff
+	16, // 21: lilbattle.v1.ProcessMovesResponse.resolutions:type_name -> lilbattle.v1.MoveResolution
+	17, // 22: lilbattle.v1.MoveResolution.source:type_name -> lilbattle.v1.ResolvedPosition
+	17, // 23: lilbattle.v1.MoveResolution.target:type_name -> lilbattle.v1.ResolvedPosition
+	26, // 24: lilbattle.v1.MoveResolution.matched_option:type_name -> lilbattle.v1.GameOption
+	19, // 25: lilbattle.v1.ServerInfo.deprecations:type_name -> lilbattle.v1.ApiDeprecation
+	61, // 26: lilbattle.v1.GetGameStateResponse.state:type_name -> lilbattle.v1.GameState
+	54, // 27: lilbattle.v1.GetGameStateResponse.remaining_time_ms:type_name -> lilbattle.v1.GetGameStateResponse.RemainingTimeMsEntry
+	66, // 28: lilbattle.v1.GetGameStateResponse.stuck_warning:type_name -> lilbattle.v1.StuckAnalysis
+	67, // 29: lilbattle.v1.ListMovesResponse.move_groups:type_name -> lilbattle.v1.GameMoveGroup
+	68, // 30: lilbattle.v1.GetOptionsAtRequest.pos:type_name -> lilbattle.v1.Position
+	26, // 31: lilbattle.v1.GetOptionsAtResponse.options:type_name -> lilbattle.v1.GameOption
+	69, // 32: lilbattle.v1.GetOptionsAtResponse.all_paths:type_name -> lilbattle.v1.AllPaths
+	70, // 33: lilbattle.v1.GameOption.move:type_name -> lilbattle.v1.MoveUnitAction
+	71, // 34: lilbattle.v1.GameOption.attack:type_name -> lilbattle.v1.AttackUnitAction
+	72, // 35: lilbattle.v1.GameOption.build:type_name -> lilbattle.v1.BuildUnitAction
+	73, // 36: lilbattle.v1.GameOption.capture:type_name -> lilbattle.v1.CaptureBuildingAction
+	74, // 37: lilbattle.v1.GameOption.end_turn:type_name -> lilbattle.v1.EndTurnAction
+	75, // 38: lilbattle.v1.GameOption.heal:type_name -> lilbattle.v1.HealUnitAction
+	76, // 39: lilbattle.v1.GameOption.construct:type_name -> lilbattle.v1.ConstructTerrainAction
+	77, // 40: lilbattle.v1.GameOption.submerge:type_name -> lilbattle.v1.SubmergeUnitAction
+	55, // 41: lilbattle.v1.SimulateAttackResponse.attacker_damage_distribution:type_name -> lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntry
+	56, // 42: lilbattle.v1.SimulateAttackResponse.defender_damage_distribution:type_name -> lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntry
+	57, // 43: lilbattle.v1.SimulateFixResponse.healing_distribution:type_name -> lilbattle.v1.SimulateFixResponse.HealingDistributionEntry
+	59, // 44: lilbattle.v1.JoinGameResponse.game:type_name -> lilbattle.v1.Game
+	66, // 45: lilbattle.v1.ClaimNoContactDrawResponse.analysis:type_name -> lilbattle.v1.StuckAnalysis
+	78, // 46: lilbattle.v1.DraftUnitResponse.draft:type_name -> lilbattle.v1.DraftState
+	65, // 47: lilbattle.v1.GetStateDiffResponse.diff:type_name -> lilbattle.v1.StateDiff
+	61, // 48: lilbattle.v1.GetStateAtTurnResponse.state:type_name -> lilbattle.v1.GameState
+	59, // 49: lilbattle.v1.ForkGameResponse.game:type_name -> lilbattle.v1.Game
+	61, // 50: lilbattle.v1.ForkGameResponse.game_state:type_name -> lilbattle.v1.GameState
+	47, // 51: lilbattle.v1.GetNotificationPreferencesResponse.preferences:type_name -> lilbattle.v1.NotificationPreferences
+	47, // 52: lilbattle.v1.SetNotificationPreferencesRequest.preferences:type_name -> lilbattle.v1.NotificationPreferences
+	47, // 53: lilbattle.v1.SetNotificationPreferencesResponse.preferences:type_name -> lilbattle.v1.NotificationPreferences
+	59, // 54: lilbattle.v1.GetGamesResponse.GamesEntry.value:type_name -> lilbattle.v1.Game
+	55, // [55:55] is the sub-list for method output_type
+	55, // [55:55] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_games_service_proto_init() }
@@ -2101,13 +3818,15 @@ func file_lilbattle_v1_models_games_service_proto_init() {
 		return
 	}
 	file_lilbattle_v1_models_models_proto_init()
-	file_lilbattle_v1_models_games_service_proto_msgTypes[22].OneofWrappers = []any{
+	file_lilbattle_v1_models_games_service_proto_msgTypes[26].OneofWrappers = []any{
 		(*GameOption_Move)(nil),
 		(*GameOption_Attack)(nil),
 		(*GameOption_Build)(nil),
 		(*GameOption_Capture)(nil),
 		(*GameOption_EndTurn)(nil),
 		(*GameOption_Heal)(nil),
+		(*GameOption_Construct)(nil),
+		(*GameOption_Submerge)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2115,7 +3834,7 @@ func file_lilbattle_v1_models_games_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_games_service_proto_rawDesc), len(file_lilbattle_v1_models_games_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1031,7 +1031,7 @@ type HighlightSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Q     int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
 	R     int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
-	Type  string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // "selection", "movement", "attack", "build", "exhausted", "capturing"
+	Type  string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // "selection", "movement", "attack", "build", "exhausted", "capturing", "ping-<type>"
 	// Types that are valid to be assigned to Action:
 	//
 	//	*HighlightSpec_Move
@@ -1933,6 +1933,169 @@ func (*ShowCaptureEffectResponse) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_gameviewerpage_proto_rawDescGZIP(), []int{38}
 }
 
+// Request to show coach mode's verdict on the player's last move
+type ShowCoachVerdictRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verdict       *CoachVerdict          `protobuf:"bytes,1,opt,name=verdict,proto3" json:"verdict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowCoachVerdictRequest) Reset() {
+	*x = ShowCoachVerdictRequest{}
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowCoachVerdictRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowCoachVerdictRequest) ProtoMessage() {}
+
+func (x *ShowCoachVerdictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowCoachVerdictRequest.ProtoReflect.Descriptor instead.
+func (*ShowCoachVerdictRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_gameviewerpage_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ShowCoachVerdictRequest) GetVerdict() *CoachVerdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return nil
+}
+
+type ShowCoachVerdictResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowCoachVerdictResponse) Reset() {
+	*x = ShowCoachVerdictResponse{}
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowCoachVerdictResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowCoachVerdictResponse) ProtoMessage() {}
+
+func (x *ShowCoachVerdictResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowCoachVerdictResponse.ProtoReflect.Descriptor instead.
+func (*ShowCoachVerdictResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_gameviewerpage_proto_rawDescGZIP(), []int{40}
+}
+
+// Request to prompt the player about obligations still pending when they
+// tried to end their turn
+type ShowTurnObligationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Obligations   []*TurnObligation      `protobuf:"bytes,1,rep,name=obligations,proto3" json:"obligations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowTurnObligationsRequest) Reset() {
+	*x = ShowTurnObligationsRequest{}
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowTurnObligationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowTurnObligationsRequest) ProtoMessage() {}
+
+func (x *ShowTurnObligationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowTurnObligationsRequest.ProtoReflect.Descriptor instead.
+func (*ShowTurnObligationsRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_gameviewerpage_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ShowTurnObligationsRequest) GetObligations() []*TurnObligation {
+	if x != nil {
+		return x.Obligations
+	}
+	return nil
+}
+
+type ShowTurnObligationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowTurnObligationsResponse) Reset() {
+	*x = ShowTurnObligationsResponse{}
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowTurnObligationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowTurnObligationsResponse) ProtoMessage() {}
+
+func (x *ShowTurnObligationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowTurnObligationsResponse.ProtoReflect.Descriptor instead.
+func (*ShowTurnObligationsResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_gameviewerpage_proto_rawDescGZIP(), []int{42}
+}
+
 // Request to set allowed panels and their order
 type SetAllowedPanelsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1943,7 +2106,7 @@ type SetAllowedPanelsRequest struct {
 
 func (x *SetAllowedPanelsRequest) Reset() {
 	*x = SetAllowedPanelsRequest{}
-	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[39]
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1955,7 +2118,7 @@ func (x *SetAllowedPanelsRequest) String() string {
 func (*SetAllowedPanelsRequest) ProtoMessage() {}
 
 func (x *SetAllowedPanelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[39]
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,7 +2131,7 @@ func (x *SetAllowedPanelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAllowedPanelsRequest.ProtoReflect.Descriptor instead.
 func (*SetAllowedPanelsRequest) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_gameviewerpage_proto_rawDescGZIP(), []int{39}
+	return file_lilbattle_v1_models_gameviewerpage_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SetAllowedPanelsRequest) GetPanelIds() []string {
@@ -1986,7 +2149,7 @@ type SetAllowedPanelsResponse struct {
 
 func (x *SetAllowedPanelsResponse) Reset() {
 	*x = SetAllowedPanelsResponse{}
-	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[40]
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1998,7 +2161,7 @@ func (x *SetAllowedPanelsResponse) String() string {
 func (*SetAllowedPanelsResponse) ProtoMessage() {}
 
 func (x *SetAllowedPanelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[40]
+	mi := &file_lilbattle_v1_models_gameviewerpage_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2011,7 +2174,7 @@ func (x *SetAllowedPanelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAllowedPanelsResponse.ProtoReflect.Descriptor instead.
 func (*SetAllowedPanelsResponse) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_gameviewerpage_proto_rawDescGZIP(), []int{40}
+	return file_lilbattle_v1_models_gameviewerpage_proto_rawDescGZIP(), []int{44}
 }
 
 var File_lilbattle_v1_models_gameviewerpage_proto protoreflect.FileDescriptor
@@ -2117,7 +2280,13 @@ const file_lilbattle_v1_models_gameviewerpage_proto_rawDesc = "" +
 	"\x18ShowCaptureEffectRequest\x12\f\n" +
 	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
 	"\x01r\x18\x02 \x01(\x05R\x01r\"\x1b\n" +
-	"\x19ShowCaptureEffectResponse\"6\n" +
+	"\x19ShowCaptureEffectResponse\"O\n" +
+	"\x17ShowCoachVerdictRequest\x124\n" +
+	"\averdict\x18\x01 \x01(\v2\x1a.lilbattle.v1.CoachVerdictR\averdict\"\x1a\n" +
+	"\x18ShowCoachVerdictResponse\"\\\n" +
+	"\x1aShowTurnObligationsRequest\x12>\n" +
+	"\vobligations\x18\x01 \x03(\v2\x1c.lilbattle.v1.TurnObligationR\vobligations\"\x1d\n" +
+	"\x1bShowTurnObligationsResponse\"6\n" +
 	"\x17SetAllowedPanelsRequest\x12\x1b\n" +
 	"\tpanel_ids\x18\x01 \x03(\tR\bpanelIds\"\x1a\n" +
 	"\x18SetAllowedPanelsResponseB\xbf\x01\n" +
@@ -2135,76 +2304,84 @@ func file_lilbattle_v1_models_gameviewerpage_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_models_gameviewerpage_proto_rawDescData
 }
 
-var file_lilbattle_v1_models_gameviewerpage_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_lilbattle_v1_models_gameviewerpage_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_lilbattle_v1_models_gameviewerpage_proto_goTypes = []any{
-	(*EmptyRequest)(nil),              // 0: lilbattle.v1.EmptyRequest
-	(*EmptyResponse)(nil),             // 1: lilbattle.v1.EmptyResponse
-	(*SetContentRequest)(nil),         // 2: lilbattle.v1.SetContentRequest
-	(*SetContentResponse)(nil),        // 3: lilbattle.v1.SetContentResponse
-	(*ShowBuildOptionsRequest)(nil),   // 4: lilbattle.v1.ShowBuildOptionsRequest
-	(*ShowBuildOptionsResponse)(nil),  // 5: lilbattle.v1.ShowBuildOptionsResponse
-	(*LogMessageRequest)(nil),         // 6: lilbattle.v1.LogMessageRequest
-	(*LogMessageResponse)(nil),        // 7: lilbattle.v1.LogMessageResponse
-	(*SetGameStateRequest)(nil),       // 8: lilbattle.v1.SetGameStateRequest
-	(*SetGameStateResponse)(nil),      // 9: lilbattle.v1.SetGameStateResponse
-	(*UpdateGameStatusRequest)(nil),   // 10: lilbattle.v1.UpdateGameStatusRequest
-	(*UpdateGameStatusResponse)(nil),  // 11: lilbattle.v1.UpdateGameStatusResponse
-	(*SetTileAtRequest)(nil),          // 12: lilbattle.v1.SetTileAtRequest
-	(*SetTileAtResponse)(nil),         // 13: lilbattle.v1.SetTileAtResponse
-	(*SetUnitAtRequest)(nil),          // 14: lilbattle.v1.SetUnitAtRequest
-	(*SetUnitAtResponse)(nil),         // 15: lilbattle.v1.SetUnitAtResponse
-	(*RemoveTileAtRequest)(nil),       // 16: lilbattle.v1.RemoveTileAtRequest
-	(*RemoveTileAtResponse)(nil),      // 17: lilbattle.v1.RemoveTileAtResponse
-	(*RemoveUnitAtRequest)(nil),       // 18: lilbattle.v1.RemoveUnitAtRequest
-	(*RemoveUnitAtResponse)(nil),      // 19: lilbattle.v1.RemoveUnitAtResponse
-	(*ShowHighlightsRequest)(nil),     // 20: lilbattle.v1.ShowHighlightsRequest
-	(*ShowHighlightsResponse)(nil),    // 21: lilbattle.v1.ShowHighlightsResponse
-	(*HighlightSpec)(nil),             // 22: lilbattle.v1.HighlightSpec
-	(*ClearHighlightsRequest)(nil),    // 23: lilbattle.v1.ClearHighlightsRequest
-	(*ClearHighlightsResponse)(nil),   // 24: lilbattle.v1.ClearHighlightsResponse
-	(*ShowPathRequest)(nil),           // 25: lilbattle.v1.ShowPathRequest
-	(*ShowPathResponse)(nil),          // 26: lilbattle.v1.ShowPathResponse
-	(*ClearPathsRequest)(nil),         // 27: lilbattle.v1.ClearPathsRequest
-	(*ClearPathsResponse)(nil),        // 28: lilbattle.v1.ClearPathsResponse
-	(*MoveUnitRequest)(nil),           // 29: lilbattle.v1.MoveUnitRequest
-	(*MoveUnitResponse)(nil),          // 30: lilbattle.v1.MoveUnitResponse
-	(*HexCoord)(nil),                  // 31: lilbattle.v1.HexCoord
-	(*ShowAttackEffectRequest)(nil),   // 32: lilbattle.v1.ShowAttackEffectRequest
-	(*SplashTarget)(nil),              // 33: lilbattle.v1.SplashTarget
-	(*ShowAttackEffectResponse)(nil),  // 34: lilbattle.v1.ShowAttackEffectResponse
-	(*ShowHealEffectRequest)(nil),     // 35: lilbattle.v1.ShowHealEffectRequest
-	(*ShowHealEffectResponse)(nil),    // 36: lilbattle.v1.ShowHealEffectResponse
-	(*ShowCaptureEffectRequest)(nil),  // 37: lilbattle.v1.ShowCaptureEffectRequest
-	(*ShowCaptureEffectResponse)(nil), // 38: lilbattle.v1.ShowCaptureEffectResponse
-	(*SetAllowedPanelsRequest)(nil),   // 39: lilbattle.v1.SetAllowedPanelsRequest
-	(*SetAllowedPanelsResponse)(nil),  // 40: lilbattle.v1.SetAllowedPanelsResponse
-	(*Game)(nil),                      // 41: lilbattle.v1.Game
-	(*GameState)(nil),                 // 42: lilbattle.v1.GameState
-	(*Tile)(nil),                      // 43: lilbattle.v1.Tile
-	(*Unit)(nil),                      // 44: lilbattle.v1.Unit
-	(*MoveUnitAction)(nil),            // 45: lilbattle.v1.MoveUnitAction
-	(*AttackUnitAction)(nil),          // 46: lilbattle.v1.AttackUnitAction
-	(*BuildUnitAction)(nil),           // 47: lilbattle.v1.BuildUnitAction
-	(*CaptureBuildingAction)(nil),     // 48: lilbattle.v1.CaptureBuildingAction
+	(*EmptyRequest)(nil),                // 0: lilbattle.v1.EmptyRequest
+	(*EmptyResponse)(nil),               // 1: lilbattle.v1.EmptyResponse
+	(*SetContentRequest)(nil),           // 2: lilbattle.v1.SetContentRequest
+	(*SetContentResponse)(nil),          // 3: lilbattle.v1.SetContentResponse
+	(*ShowBuildOptionsRequest)(nil),     // 4: lilbattle.v1.ShowBuildOptionsRequest
+	(*ShowBuildOptionsResponse)(nil),    // 5: lilbattle.v1.ShowBuildOptionsResponse
+	(*LogMessageRequest)(nil),           // 6: lilbattle.v1.LogMessageRequest
+	(*LogMessageResponse)(nil),          // 7: lilbattle.v1.LogMessageResponse
+	(*SetGameStateRequest)(nil),         // 8: lilbattle.v1.SetGameStateRequest
+	(*SetGameStateResponse)(nil),        // 9: lilbattle.v1.SetGameStateResponse
+	(*UpdateGameStatusRequest)(nil),     // 10: lilbattle.v1.UpdateGameStatusRequest
+	(*UpdateGameStatusResponse)(nil),    // 11: lilbattle.v1.UpdateGameStatusResponse
+	(*SetTileAtRequest)(nil),            // 12: lilbattle.v1.SetTileAtRequest
+	(*SetTileAtResponse)(nil),           // 13: lilbattle.v1.SetTileAtResponse
+	(*SetUnitAtRequest)(nil),            // 14: lilbattle.v1.SetUnitAtRequest
+	(*SetUnitAtResponse)(nil),           // 15: lilbattle.v1.SetUnitAtResponse
+	(*RemoveTileAtRequest)(nil),         // 16: lilbattle.v1.RemoveTileAtRequest
+	(*RemoveTileAtResponse)(nil),        // 17: lilbattle.v1.RemoveTileAtResponse
+	(*RemoveUnitAtRequest)(nil),         // 18: lilbattle.v1.RemoveUnitAtRequest
+	(*RemoveUnitAtResponse)(nil),        // 19: lilbattle.v1.RemoveUnitAtResponse
+	(*ShowHighlightsRequest)(nil),       // 20: lilbattle.v1.ShowHighlightsRequest
+	(*ShowHighlightsResponse)(nil),      // 21: lilbattle.v1.ShowHighlightsResponse
+	(*HighlightSpec)(nil),               // 22: lilbattle.v1.HighlightSpec
+	(*ClearHighlightsRequest)(nil),      // 23: lilbattle.v1.ClearHighlightsRequest
+	(*ClearHighlightsResponse)(nil),     // 24: lilbattle.v1.ClearHighlightsResponse
+	(*ShowPathRequest)(nil),             // 25: lilbattle.v1.ShowPathRequest
+	(*ShowPathResponse)(nil),            // 26: lilbattle.v1.ShowPathResponse
+	(*ClearPathsRequest)(nil),           // 27: lilbattle.v1.ClearPathsRequest
+	(*ClearPathsResponse)(nil),          // 28: lilbattle.v1.ClearPathsResponse
+	(*MoveUnitRequest)(nil),             // 29: lilbattle.v1.MoveUnitRequest
+	(*MoveUnitResponse)(nil),            // 30: lilbattle.v1.MoveUnitResponse
+	(*HexCoord)(nil),                    // 31: lilbattle.v1.HexCoord
+	(*ShowAttackEffectRequest)(nil),     // 32: lilbattle.v1.ShowAttackEffectRequest
+	(*SplashTarget)(nil),                // 33: lilbattle.v1.SplashTarget
+	(*ShowAttackEffectResponse)(nil),    // 34: lilbattle.v1.ShowAttackEffectResponse
+	(*ShowHealEffectRequest)(nil),       // 35: lilbattle.v1.ShowHealEffectRequest
+	(*ShowHealEffectResponse)(nil),      // 36: lilbattle.v1.ShowHealEffectResponse
+	(*ShowCaptureEffectRequest)(nil),    // 37: lilbattle.v1.ShowCaptureEffectRequest
+	(*ShowCaptureEffectResponse)(nil),   // 38: lilbattle.v1.ShowCaptureEffectResponse
+	(*ShowCoachVerdictRequest)(nil),     // 39: lilbattle.v1.ShowCoachVerdictRequest
+	(*ShowCoachVerdictResponse)(nil),    // 40: lilbattle.v1.ShowCoachVerdictResponse
+	(*ShowTurnObligationsRequest)(nil),  // 41: lilbattle.v1.ShowTurnObligationsRequest
+	(*ShowTurnObligationsResponse)(nil), // 42: lilbattle.v1.ShowTurnObligationsResponse
+	(*SetAllowedPanelsRequest)(nil),     // 43: lilbattle.v1.SetAllowedPanelsRequest
+	(*SetAllowedPanelsResponse)(nil),    // 44: lilbattle.v1.SetAllowedPanelsResponse
+	(*Game)(nil),                        // 45: lilbattle.v1.Game
+	(*GameState)(nil),                   // 46: lilbattle.v1.GameState
+	(*Tile)(nil),                        // 47: lilbattle.v1.Tile
+	(*Unit)(nil),                        // 48: lilbattle.v1.Unit
+	(*MoveUnitAction)(nil),              // 49: lilbattle.v1.MoveUnitAction
+	(*AttackUnitAction)(nil),            // 50: lilbattle.v1.AttackUnitAction
+	(*BuildUnitAction)(nil),             // 51: lilbattle.v1.BuildUnitAction
+	(*CaptureBuildingAction)(nil),       // 52: lilbattle.v1.CaptureBuildingAction
+	(*CoachVerdict)(nil),                // 53: lilbattle.v1.CoachVerdict
+	(*TurnObligation)(nil),              // 54: lilbattle.v1.TurnObligation
 }
 var file_lilbattle_v1_models_gameviewerpage_proto_depIdxs = []int32{
-	41, // 0: lilbattle.v1.SetGameStateRequest.game:type_name -> lilbattle.v1.Game
-	42, // 1: lilbattle.v1.SetGameStateRequest.state:type_name -> lilbattle.v1.GameState
-	43, // 2: lilbattle.v1.SetTileAtRequest.tile:type_name -> lilbattle.v1.Tile
-	44, // 3: lilbattle.v1.SetUnitAtRequest.unit:type_name -> lilbattle.v1.Unit
+	45, // 0: lilbattle.v1.SetGameStateRequest.game:type_name -> lilbattle.v1.Game
+	46, // 1: lilbattle.v1.SetGameStateRequest.state:type_name -> lilbattle.v1.GameState
+	47, // 2: lilbattle.v1.SetTileAtRequest.tile:type_name -> lilbattle.v1.Tile
+	48, // 3: lilbattle.v1.SetUnitAtRequest.unit:type_name -> lilbattle.v1.Unit
 	22, // 4: lilbattle.v1.ShowHighlightsRequest.highlights:type_name -> lilbattle.v1.HighlightSpec
-	45, // 5: lilbattle.v1.HighlightSpec.move:type_name -> lilbattle.v1.MoveUnitAction
-	46, // 6: lilbattle.v1.HighlightSpec.attack:type_name -> lilbattle.v1.AttackUnitAction
-	47, // 7: lilbattle.v1.HighlightSpec.build:type_name -> lilbattle.v1.BuildUnitAction
-	48, // 8: lilbattle.v1.HighlightSpec.capture:type_name -> lilbattle.v1.CaptureBuildingAction
-	44, // 9: lilbattle.v1.MoveUnitRequest.unit:type_name -> lilbattle.v1.Unit
+	49, // 5: lilbattle.v1.HighlightSpec.move:type_name -> lilbattle.v1.MoveUnitAction
+	50, // 6: lilbattle.v1.HighlightSpec.attack:type_name -> lilbattle.v1.AttackUnitAction
+	51, // 7: lilbattle.v1.HighlightSpec.build:type_name -> lilbattle.v1.BuildUnitAction
+	52, // 8: lilbattle.v1.HighlightSpec.capture:type_name -> lilbattle.v1.CaptureBuildingAction
+	48, // 9: lilbattle.v1.MoveUnitRequest.unit:type_name -> lilbattle.v1.Unit
 	31, // 10: lilbattle.v1.MoveUnitRequest.path:type_name -> lilbattle.v1.HexCoord
 	33, // 11: lilbattle.v1.ShowAttackEffectRequest.splash_targets:type_name -> lilbattle.v1.SplashTarget
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	53, // 12: lilbattle.v1.ShowCoachVerdictRequest.verdict:type_name -> lilbattle.v1.CoachVerdict
+	54, // 13: lilbattle.v1.ShowTurnObligationsRequest.obligations:type_name -> lilbattle.v1.TurnObligation
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_gameviewerpage_proto_init() }
@@ -2225,7 +2402,7 @@ func file_lilbattle_v1_models_gameviewerpage_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_gameviewerpage_proto_rawDesc), len(file_lilbattle_v1_models_gameviewerpage_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   41,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1135,6 +1135,558 @@ func (x *GetIndexRecordsLROResponse) GetLro() *IndexRecordsLRO {
 	return nil
 }
 
+// Statistics of one world, aggregated over the finished games played on it
+type WorldMetaStats struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Number of finished games aggregated
+	Games int32 `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	// Turns played over all the games
+	TotalTurns int32 `protobuf:"varint,3,opt,name=total_turns,json=totalTurns,proto3" json:"total_turns,omitempty"`
+	// Average number of turns a game lasts
+	AverageGameLength float64                `protobuf:"fixed64,4,opt,name=average_game_length,json=averageGameLength,proto3" json:"average_game_length,omitempty"`
+	Units             []*UnitMetaStats       `protobuf:"bytes,5,rep,name=units,proto3" json:"units,omitempty"`
+	Terrains          []*TerrainMetaStats    `protobuf:"bytes,6,rep,name=terrains,proto3" json:"terrains,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WorldMetaStats) Reset() {
+	*x = WorldMetaStats{}
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldMetaStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldMetaStats) ProtoMessage() {}
+
+func (x *WorldMetaStats) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldMetaStats.ProtoReflect.Descriptor instead.
+func (*WorldMetaStats) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_indexer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WorldMetaStats) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *WorldMetaStats) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *WorldMetaStats) GetTotalTurns() int32 {
+	if x != nil {
+		return x.TotalTurns
+	}
+	return 0
+}
+
+func (x *WorldMetaStats) GetAverageGameLength() float64 {
+	if x != nil {
+		return x.AverageGameLength
+	}
+	return 0
+}
+
+func (x *WorldMetaStats) GetUnits() []*UnitMetaStats {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *WorldMetaStats) GetTerrains() []*TerrainMetaStats {
+	if x != nil {
+		return x.Terrains
+	}
+	return nil
+}
+
+func (x *WorldMetaStats) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// How often a unit type is built, and how its builders fare
+type UnitMetaStats struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UnitType int32                  `protobuf:"varint,1,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	// Units of this type built over all games
+	UnitsBuilt int32 `protobuf:"varint,2,opt,name=units_built,json=unitsBuilt,proto3" json:"units_built,omitempty"`
+	// Seats (a player in a game) that built at least one
+	Builders int32 `protobuf:"varint,3,opt,name=builders,proto3" json:"builders,omitempty"`
+	// Builders that won their game
+	BuilderWins int32 `protobuf:"varint,4,opt,name=builder_wins,json=builderWins,proto3" json:"builder_wins,omitempty"`
+	// Units built per game
+	BuildRate float64 `protobuf:"fixed64,5,opt,name=build_rate,json=buildRate,proto3" json:"build_rate,omitempty"`
+	// Fraction of builders that won
+	WinRateWhenBuilt float64 `protobuf:"fixed64,6,opt,name=win_rate_when_built,json=winRateWhenBuilt,proto3" json:"win_rate_when_built,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UnitMetaStats) Reset() {
+	*x = UnitMetaStats{}
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitMetaStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitMetaStats) ProtoMessage() {}
+
+func (x *UnitMetaStats) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitMetaStats.ProtoReflect.Descriptor instead.
+func (*UnitMetaStats) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_indexer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnitMetaStats) GetUnitType() int32 {
+	if x != nil {
+		return x.UnitType
+	}
+	return 0
+}
+
+func (x *UnitMetaStats) GetUnitsBuilt() int32 {
+	if x != nil {
+		return x.UnitsBuilt
+	}
+	return 0
+}
+
+func (x *UnitMetaStats) GetBuilders() int32 {
+	if x != nil {
+		return x.Builders
+	}
+	return 0
+}
+
+func (x *UnitMetaStats) GetBuilderWins() int32 {
+	if x != nil {
+		return x.BuilderWins
+	}
+	return 0
+}
+
+func (x *UnitMetaStats) GetBuildRate() float64 {
+	if x != nil {
+		return x.BuildRate
+	}
+	return 0
+}
+
+func (x *UnitMetaStats) GetWinRateWhenBuilt() float64 {
+	if x != nil {
+		return x.WinRateWhenBuilt
+	}
+	return 0
+}
+
+// How often tiles of a terrain type are captured
+type TerrainMetaStats struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TileType int32                  `protobuf:"varint,1,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"`
+	Captures int32                  `protobuf:"varint,2,opt,name=captures,proto3" json:"captures,omitempty"`
+	// Captures per game
+	CaptureRate   float64 `protobuf:"fixed64,3,opt,name=capture_rate,json=captureRate,proto3" json:"capture_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerrainMetaStats) Reset() {
+	*x = TerrainMetaStats{}
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerrainMetaStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerrainMetaStats) ProtoMessage() {}
+
+func (x *TerrainMetaStats) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerrainMetaStats.ProtoReflect.Descriptor instead.
+func (*TerrainMetaStats) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_indexer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TerrainMetaStats) GetTileType() int32 {
+	if x != nil {
+		return x.TileType
+	}
+	return 0
+}
+
+func (x *TerrainMetaStats) GetCaptures() int32 {
+	if x != nil {
+		return x.Captures
+	}
+	return 0
+}
+
+func (x *TerrainMetaStats) GetCaptureRate() float64 {
+	if x != nil {
+		return x.CaptureRate
+	}
+	return 0
+}
+
+// What one finished game contributes to its world's statistics. Kept so
+// aggregating a game again replaces its contribution instead of adding it
+// twice.
+type GameMetaSummary struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GameId  string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	WorldId string                 `protobuf:"bytes,2,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Turns   int32                  `protobuf:"varint,3,opt,name=turns,proto3" json:"turns,omitempty"`
+	Seats   []*SeatMetaSummary     `protobuf:"bytes,4,rep,name=seats,proto3" json:"seats,omitempty"`
+	// Captures by tile type
+	Captures      map[int32]int32 `protobuf:"bytes,5,rep,name=captures,proto3" json:"captures,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMetaSummary) Reset() {
+	*x = GameMetaSummary{}
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMetaSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMetaSummary) ProtoMessage() {}
+
+func (x *GameMetaSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMetaSummary.ProtoReflect.Descriptor instead.
+func (*GameMetaSummary) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_indexer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GameMetaSummary) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameMetaSummary) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GameMetaSummary) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+func (x *GameMetaSummary) GetSeats() []*SeatMetaSummary {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *GameMetaSummary) GetCaptures() map[int32]int32 {
+	if x != nil {
+		return x.Captures
+	}
+	return nil
+}
+
+// What one seat of a finished game built
+type SeatMetaSummary struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Player int32                  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`
+	Won    bool                   `protobuf:"varint,2,opt,name=won,proto3" json:"won,omitempty"`
+	// Units built by unit type
+	UnitsBuilt    map[int32]int32 `protobuf:"bytes,3,rep,name=units_built,json=unitsBuilt,proto3" json:"units_built,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatMetaSummary) Reset() {
+	*x = SeatMetaSummary{}
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatMetaSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMetaSummary) ProtoMessage() {}
+
+func (x *SeatMetaSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMetaSummary.ProtoReflect.Descriptor instead.
+func (*SeatMetaSummary) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_indexer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SeatMetaSummary) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *SeatMetaSummary) GetWon() bool {
+	if x != nil {
+		return x.Won
+	}
+	return false
+}
+
+func (x *SeatMetaSummary) GetUnitsBuilt() map[int32]int32 {
+	if x != nil {
+		return x.UnitsBuilt
+	}
+	return nil
+}
+
+// How far aggregation has got through one shard of the games. Every game in
+// the shard up to and including the last one is aggregated, except the
+// pending ones.
+type MetaStatsCursor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shard         int32                  `protobuf:"varint,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	LastGameId    string                 `protobuf:"bytes,2,opt,name=last_game_id,json=lastGameId,proto3" json:"last_game_id,omitempty"`
+	LastCreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_created_at,json=lastCreatedAt,proto3" json:"last_created_at,omitempty"`
+	// Games up to the last one that were still being played, aggregated once
+	// they finish
+	PendingGameIds []string `protobuf:"bytes,4,rep,name=pending_game_ids,json=pendingGameIds,proto3" json:"pending_game_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MetaStatsCursor) Reset() {
+	*x = MetaStatsCursor{}
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetaStatsCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetaStatsCursor) ProtoMessage() {}
+
+func (x *MetaStatsCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetaStatsCursor.ProtoReflect.Descriptor instead.
+func (*MetaStatsCursor) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_indexer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MetaStatsCursor) GetShard() int32 {
+	if x != nil {
+		return x.Shard
+	}
+	return 0
+}
+
+func (x *MetaStatsCursor) GetLastGameId() string {
+	if x != nil {
+		return x.LastGameId
+	}
+	return ""
+}
+
+func (x *MetaStatsCursor) GetLastCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCreatedAt
+	}
+	return nil
+}
+
+func (x *MetaStatsCursor) GetPendingGameIds() []string {
+	if x != nil {
+		return x.PendingGameIds
+	}
+	return nil
+}
+
+type GetMetaStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Worlds to get statistics of, all worlds with finished games if empty
+	WorldIds      []string `protobuf:"bytes,1,rep,name=world_ids,json=worldIds,proto3" json:"world_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetaStatsRequest) Reset() {
+	*x = GetMetaStatsRequest{}
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetaStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetaStatsRequest) ProtoMessage() {}
+
+func (x *GetMetaStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetaStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMetaStatsRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_indexer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMetaStatsRequest) GetWorldIds() []string {
+	if x != nil {
+		return x.WorldIds
+	}
+	return nil
+}
+
+type GetMetaStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Worlds        []*WorldMetaStats      `protobuf:"bytes,1,rep,name=worlds,proto3" json:"worlds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetaStatsResponse) Reset() {
+	*x = GetMetaStatsResponse{}
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetaStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetaStatsResponse) ProtoMessage() {}
+
+func (x *GetMetaStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_indexer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetaStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMetaStatsResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_indexer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetMetaStatsResponse) GetWorlds() []*WorldMetaStats {
+	if x != nil {
+		return x.Worlds
+	}
+	return nil
+}
+
 var File_lilbattle_v1_models_indexer_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_models_indexer_proto_rawDesc = "" +
@@ -1233,7 +1785,57 @@ const file_lilbattle_v1_models_indexer_proto_rawDesc = "" +
 	"\x19GetIndexRecordsLRORequest\x12\x15\n" +
 	"\x06lro_id\x18\x01 \x01(\tR\x05lroId\"M\n" +
 	"\x1aGetIndexRecordsLROResponse\x12/\n" +
-	"\x03lro\x18\x01 \x01(\v2\x1d.lilbattle.v1.IndexRecordsLROR\x03lro*\x95\x01\n" +
+	"\x03lro\x18\x01 \x01(\v2\x1d.lilbattle.v1.IndexRecordsLROR\x03lro\"\xbc\x02\n" +
+	"\x0eWorldMetaStats\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x14\n" +
+	"\x05games\x18\x02 \x01(\x05R\x05games\x12\x1f\n" +
+	"\vtotal_turns\x18\x03 \x01(\x05R\n" +
+	"totalTurns\x12.\n" +
+	"\x13average_game_length\x18\x04 \x01(\x01R\x11averageGameLength\x121\n" +
+	"\x05units\x18\x05 \x03(\v2\x1b.lilbattle.v1.UnitMetaStatsR\x05units\x12:\n" +
+	"\bterrains\x18\x06 \x03(\v2\x1e.lilbattle.v1.TerrainMetaStatsR\bterrains\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xda\x01\n" +
+	"\rUnitMetaStats\x12\x1b\n" +
+	"\tunit_type\x18\x01 \x01(\x05R\bunitType\x12\x1f\n" +
+	"\vunits_built\x18\x02 \x01(\x05R\n" +
+	"unitsBuilt\x12\x1a\n" +
+	"\bbuilders\x18\x03 \x01(\x05R\bbuilders\x12!\n" +
+	"\fbuilder_wins\x18\x04 \x01(\x05R\vbuilderWins\x12\x1d\n" +
+	"\n" +
+	"build_rate\x18\x05 \x01(\x01R\tbuildRate\x12-\n" +
+	"\x13win_rate_when_built\x18\x06 \x01(\x01R\x10winRateWhenBuilt\"n\n" +
+	"\x10TerrainMetaStats\x12\x1b\n" +
+	"\ttile_type\x18\x01 \x01(\x05R\btileType\x12\x1a\n" +
+	"\bcaptures\x18\x02 \x01(\x05R\bcaptures\x12!\n" +
+	"\fcapture_rate\x18\x03 \x01(\x01R\vcaptureRate\"\x96\x02\n" +
+	"\x0fGameMetaSummary\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x19\n" +
+	"\bworld_id\x18\x02 \x01(\tR\aworldId\x12\x14\n" +
+	"\x05turns\x18\x03 \x01(\x05R\x05turns\x123\n" +
+	"\x05seats\x18\x04 \x03(\v2\x1d.lilbattle.v1.SeatMetaSummaryR\x05seats\x12G\n" +
+	"\bcaptures\x18\x05 \x03(\v2+.lilbattle.v1.GameMetaSummary.CapturesEntryR\bcaptures\x1a;\n" +
+	"\rCapturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xca\x01\n" +
+	"\x0fSeatMetaSummary\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\x05R\x06player\x12\x10\n" +
+	"\x03won\x18\x02 \x01(\bR\x03won\x12N\n" +
+	"\vunits_built\x18\x03 \x03(\v2-.lilbattle.v1.SeatMetaSummary.UnitsBuiltEntryR\n" +
+	"unitsBuilt\x1a=\n" +
+	"\x0fUnitsBuiltEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xb7\x01\n" +
+	"\x0fMetaStatsCursor\x12\x14\n" +
+	"\x05shard\x18\x01 \x01(\x05R\x05shard\x12 \n" +
+	"\flast_game_id\x18\x02 \x01(\tR\n" +
+	"lastGameId\x12B\n" +
+	"\x0flast_created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlastCreatedAt\x12(\n" +
+	"\x10pending_game_ids\x18\x04 \x03(\tR\x0ependingGameIds\"2\n" +
+	"\x13GetMetaStatsRequest\x12\x1b\n" +
+	"\tworld_ids\x18\x01 \x03(\tR\bworldIds\"L\n" +
+	"\x14GetMetaStatsResponse\x124\n" +
+	"\x06worlds\x18\x01 \x03(\v2\x1c.lilbattle.v1.WorldMetaStatsR\x06worlds*\x95\x01\n" +
 	"\vIndexStatus\x12\x1c\n" +
 	"\x18INDEX_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14INDEX_STATUS_PENDING\x10\x01\x12\x19\n" +
@@ -1255,7 +1857,7 @@ func file_lilbattle_v1_models_indexer_proto_rawDescGZIP() []byte {
 }
 
 var file_lilbattle_v1_models_indexer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_lilbattle_v1_models_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_lilbattle_v1_models_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_lilbattle_v1_models_indexer_proto_goTypes = []any{
 	(IndexStatus)(0),                      // 0: lilbattle.v1.IndexStatus
 	(*IndexState)(nil),                    // 1: lilbattle.v1.IndexState
@@ -1276,41 +1878,59 @@ var file_lilbattle_v1_models_indexer_proto_goTypes = []any{
 	(*UpdateIndexRecordsLROResponse)(nil), // 16: lilbattle.v1.UpdateIndexRecordsLROResponse
 	(*GetIndexRecordsLRORequest)(nil),     // 17: lilbattle.v1.GetIndexRecordsLRORequest
 	(*GetIndexRecordsLROResponse)(nil),    // 18: lilbattle.v1.GetIndexRecordsLROResponse
-	nil,                                   // 19: lilbattle.v1.GetIndexStatesResponse.StatesEntry
-	(*timestamppb.Timestamp)(nil),         // 20: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),         // 21: google.protobuf.FieldMask
-	(*anypb.Any)(nil),                     // 22: google.protobuf.Any
+	(*WorldMetaStats)(nil),                // 19: lilbattle.v1.WorldMetaStats
+	(*UnitMetaStats)(nil),                 // 20: lilbattle.v1.UnitMetaStats
+	(*TerrainMetaStats)(nil),              // 21: lilbattle.v1.TerrainMetaStats
+	(*GameMetaSummary)(nil),               // 22: lilbattle.v1.GameMetaSummary
+	(*SeatMetaSummary)(nil),               // 23: lilbattle.v1.SeatMetaSummary
+	(*MetaStatsCursor)(nil),               // 24: lilbattle.v1.MetaStatsCursor
+	(*GetMetaStatsRequest)(nil),           // 25: lilbattle.v1.GetMetaStatsRequest
+	(*GetMetaStatsResponse)(nil),          // 26: lilbattle.v1.GetMetaStatsResponse
+	nil,                                   // 27: lilbattle.v1.GetIndexStatesResponse.StatesEntry
+	nil,                                   // 28: lilbattle.v1.GameMetaSummary.CapturesEntry
+	nil,                                   // 29: lilbattle.v1.SeatMetaSummary.UnitsBuiltEntry
+	(*timestamppb.Timestamp)(nil),         // 30: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 31: google.protobuf.FieldMask
+	(*anypb.Any)(nil),                     // 32: google.protobuf.Any
 }
 var file_lilbattle_v1_models_indexer_proto_depIdxs = []int32{
-	20, // 0: lilbattle.v1.IndexState.created_at:type_name -> google.protobuf.Timestamp
-	20, // 1: lilbattle.v1.IndexState.updated_at:type_name -> google.protobuf.Timestamp
-	20, // 2: lilbattle.v1.IndexState.indexed_at:type_name -> google.protobuf.Timestamp
+	30, // 0: lilbattle.v1.IndexState.created_at:type_name -> google.protobuf.Timestamp
+	30, // 1: lilbattle.v1.IndexState.updated_at:type_name -> google.protobuf.Timestamp
+	30, // 2: lilbattle.v1.IndexState.indexed_at:type_name -> google.protobuf.Timestamp
 	0,  // 3: lilbattle.v1.IndexState.status:type_name -> lilbattle.v1.IndexStatus
 	1,  // 4: lilbattle.v1.EnsureIndexStateRequest.index_state:type_name -> lilbattle.v1.IndexState
-	21, // 5: lilbattle.v1.EnsureIndexStateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	31, // 5: lilbattle.v1.EnsureIndexStateRequest.update_mask:type_name -> google.protobuf.FieldMask
 	1,  // 6: lilbattle.v1.EnsureIndexStateResponse.index_state:type_name -> lilbattle.v1.IndexState
 	1,  // 7: lilbattle.v1.IndexStateList.states:type_name -> lilbattle.v1.IndexState
-	19, // 8: lilbattle.v1.GetIndexStatesResponse.states:type_name -> lilbattle.v1.GetIndexStatesResponse.StatesEntry
-	20, // 9: lilbattle.v1.ListIndexStatesRequest.updated_before:type_name -> google.protobuf.Timestamp
-	20, // 10: lilbattle.v1.ListIndexStatesRequest.updated_after:type_name -> google.protobuf.Timestamp
+	27, // 8: lilbattle.v1.GetIndexStatesResponse.states:type_name -> lilbattle.v1.GetIndexStatesResponse.StatesEntry
+	30, // 9: lilbattle.v1.ListIndexStatesRequest.updated_before:type_name -> google.protobuf.Timestamp
+	30, // 10: lilbattle.v1.ListIndexStatesRequest.updated_after:type_name -> google.protobuf.Timestamp
 	1,  // 11: lilbattle.v1.ListIndexStatesResponse.items:type_name -> lilbattle.v1.IndexState
-	20, // 12: lilbattle.v1.IndexRecord.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 13: lilbattle.v1.IndexRecord.entity_data:type_name -> google.protobuf.Any
-	20, // 14: lilbattle.v1.IndexRecordsLRO.created_at:type_name -> google.protobuf.Timestamp
-	20, // 15: lilbattle.v1.IndexRecordsLRO.updated_at:type_name -> google.protobuf.Timestamp
+	30, // 12: lilbattle.v1.IndexRecord.updated_at:type_name -> google.protobuf.Timestamp
+	32, // 13: lilbattle.v1.IndexRecord.entity_data:type_name -> google.protobuf.Any
+	30, // 14: lilbattle.v1.IndexRecordsLRO.created_at:type_name -> google.protobuf.Timestamp
+	30, // 15: lilbattle.v1.IndexRecordsLRO.updated_at:type_name -> google.protobuf.Timestamp
 	11, // 16: lilbattle.v1.IndexRecordsLRO.records:type_name -> lilbattle.v1.IndexRecord
 	12, // 17: lilbattle.v1.CreateIndexRecordsLRORequest.lro:type_name -> lilbattle.v1.IndexRecordsLRO
 	12, // 18: lilbattle.v1.CreateIndexRecordsLROResponse.lro:type_name -> lilbattle.v1.IndexRecordsLRO
 	12, // 19: lilbattle.v1.UpdateIndexRecordsLRORequest.lro:type_name -> lilbattle.v1.IndexRecordsLRO
-	21, // 20: lilbattle.v1.UpdateIndexRecordsLRORequest.update_mask:type_name -> google.protobuf.FieldMask
+	31, // 20: lilbattle.v1.UpdateIndexRecordsLRORequest.update_mask:type_name -> google.protobuf.FieldMask
 	12, // 21: lilbattle.v1.UpdateIndexRecordsLROResponse.lro:type_name -> lilbattle.v1.IndexRecordsLRO
 	12, // 22: lilbattle.v1.GetIndexRecordsLROResponse.lro:type_name -> lilbattle.v1.IndexRecordsLRO
-	1,  // 23: lilbattle.v1.GetIndexStatesResponse.StatesEntry.value:type_name -> lilbattle.v1.IndexState
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	20, // 23: lilbattle.v1.WorldMetaStats.units:type_name -> lilbattle.v1.UnitMetaStats
+	21, // 24: lilbattle.v1.WorldMetaStats.terrains:type_name -> lilbattle.v1.TerrainMetaStats
+	30, // 25: lilbattle.v1.WorldMetaStats.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 26: lilbattle.v1.GameMetaSummary.seats:type_name -> lilbattle.v1.SeatMetaSummary
+	28, // 27: lilbattle.v1.GameMetaSummary.captures:type_name -> lilbattle.v1.GameMetaSummary.CapturesEntry
+	29, // 28: lilbattle.v1.SeatMetaSummary.units_built:type_name -> lilbattle.v1.SeatMetaSummary.UnitsBuiltEntry
+	30, // 29: lilbattle.v1.MetaStatsCursor.last_created_at:type_name -> google.protobuf.Timestamp
+	19, // 30: lilbattle.v1.GetMetaStatsResponse.worlds:type_name -> lilbattle.v1.WorldMetaStats
+	1,  // 31: lilbattle.v1.GetIndexStatesResponse.StatesEntry.value:type_name -> lilbattle.v1.IndexState
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_indexer_proto_init() }
@@ -1325,7 +1945,7 @@ func file_lilbattle_v1_models_indexer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_indexer_proto_rawDesc), len(file_lilbattle_v1_models_indexer_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -141,6 +141,10 @@ const (
 	GameStatus_GAME_STATUS_PLAYING     GameStatus = 1
 	GameStatus_GAME_STATUS_PAUSED      GameStatus = 2
 	GameStatus_GAME_STATUS_ENDED       GameStatus = 3
+	// Players are banning and picking unit types before the game starts
+	GameStatus_GAME_STATUS_DRAFTING GameStatus = 4
+	// A random map game waiting for its open seats to be filled
+	GameStatus_GAME_STATUS_WAITING GameStatus = 5
 )
 
 // Enum value maps for GameStatus.
@@ -150,12 +154,16 @@ var (
 		1: "GAME_STATUS_PLAYING",
 		2: "GAME_STATUS_PAUSED",
 		3: "GAME_STATUS_ENDED",
+		4: "GAME_STATUS_DRAFTING",
+		5: "GAME_STATUS_WAITING",
 	}
 	GameStatus_value = map[string]int32{
 		"GAME_STATUS_UNSPECIFIED": 0,
 		"GAME_STATUS_PLAYING":     1,
 		"GAME_STATUS_PAUSED":      2,
 		"GAME_STATUS_ENDED":       3,
+		"GAME_STATUS_DRAFTING":    4,
+		"GAME_STATUS_WAITING":     5,
 	}
 )
 
@@ -186,6 +194,160 @@ func (GameStatus) EnumDescriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{2}
 }
 
+// What happens when a player's time bank runs out
+type TimeoutAction int32
+
+const (
+	// The player's turn is ended for them
+	TimeoutAction_TIMEOUT_ACTION_AUTO_END_TURN TimeoutAction = 0
+	// The player is eliminated from the game
+	TimeoutAction_TIMEOUT_ACTION_FORFEIT TimeoutAction = 1
+)
+
+// Enum value maps for TimeoutAction.
+var (
+	TimeoutAction_name = map[int32]string{
+		0: "TIMEOUT_ACTION_AUTO_END_TURN",
+		1: "TIMEOUT_ACTION_FORFEIT",
+	}
+	TimeoutAction_value = map[string]int32{
+		"TIMEOUT_ACTION_AUTO_END_TURN": 0,
+		"TIMEOUT_ACTION_FORFEIT":       1,
+	}
+)
+
+func (x TimeoutAction) Enum() *TimeoutAction {
+	p := new(TimeoutAction)
+	*p = x
+	return p
+}
+
+func (x TimeoutAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeoutAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_lilbattle_v1_models_models_proto_enumTypes[3].Descriptor()
+}
+
+func (TimeoutAction) Type() protoreflect.EnumType {
+	return &file_lilbattle_v1_models_models_proto_enumTypes[3]
+}
+
+func (x TimeoutAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeoutAction.Descriptor instead.
+func (TimeoutAction) EnumDescriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{3}
+}
+
+type PuzzleResult int32
+
+const (
+	PuzzleResult_PUZZLE_RESULT_UNSPECIFIED PuzzleResult = 0
+	PuzzleResult_PUZZLE_RESULT_SOLVED      PuzzleResult = 1
+	PuzzleResult_PUZZLE_RESULT_FAILED      PuzzleResult = 2
+)
+
+// Enum value maps for PuzzleResult.
+var (
+	PuzzleResult_name = map[int32]string{
+		0: "PUZZLE_RESULT_UNSPECIFIED",
+		1: "PUZZLE_RESULT_SOLVED",
+		2: "PUZZLE_RESULT_FAILED",
+	}
+	PuzzleResult_value = map[string]int32{
+		"PUZZLE_RESULT_UNSPECIFIED": 0,
+		"PUZZLE_RESULT_SOLVED":      1,
+		"PUZZLE_RESULT_FAILED":      2,
+	}
+)
+
+func (x PuzzleResult) Enum() *PuzzleResult {
+	p := new(PuzzleResult)
+	*p = x
+	return p
+}
+
+func (x PuzzleResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PuzzleResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_lilbattle_v1_models_models_proto_enumTypes[4].Descriptor()
+}
+
+func (PuzzleResult) Type() protoreflect.EnumType {
+	return &file_lilbattle_v1_models_models_proto_enumTypes[4]
+}
+
+func (x PuzzleResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PuzzleResult.Descriptor instead.
+func (PuzzleResult) EnumDescriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{4}
+}
+
+type UnitDiffKind int32
+
+const (
+	UnitDiffKind_UNIT_DIFF_KIND_UNSPECIFIED UnitDiffKind = 0
+	UnitDiffKind_UNIT_DIFF_KIND_ADDED       UnitDiffKind = 1
+	UnitDiffKind_UNIT_DIFF_KIND_REMOVED     UnitDiffKind = 2
+	UnitDiffKind_UNIT_DIFF_KIND_CHANGED     UnitDiffKind = 3
+	// Built and killed between the two states, so in neither of them
+	UnitDiffKind_UNIT_DIFF_KIND_TRANSIENT UnitDiffKind = 4
+)
+
+// Enum value maps for UnitDiffKind.
+var (
+	UnitDiffKind_name = map[int32]string{
+		0: "UNIT_DIFF_KIND_UNSPECIFIED",
+		1: "UNIT_DIFF_KIND_ADDED",
+		2: "UNIT_DIFF_KIND_REMOVED",
+		3: "UNIT_DIFF_KIND_CHANGED",
+		4: "UNIT_DIFF_KIND_TRANSIENT",
+	}
+	UnitDiffKind_value = map[string]int32{
+		"UNIT_DIFF_KIND_UNSPECIFIED": 0,
+		"UNIT_DIFF_KIND_ADDED":       1,
+		"UNIT_DIFF_KIND_REMOVED":     2,
+		"UNIT_DIFF_KIND_CHANGED":     3,
+		"UNIT_DIFF_KIND_TRANSIENT":   4,
+	}
+)
+
+func (x UnitDiffKind) Enum() *UnitDiffKind {
+	p := new(UnitDiffKind)
+	*p = x
+	return p
+}
+
+func (x UnitDiffKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnitDiffKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_lilbattle_v1_models_models_proto_enumTypes[5].Descriptor()
+}
+
+func (UnitDiffKind) Type() protoreflect.EnumType {
+	return &file_lilbattle_v1_models_models_proto_enumTypes[5]
+}
+
+func (x UnitDiffKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnitDiffKind.Descriptor instead.
+func (UnitDiffKind) EnumDescriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{5}
+}
+
 type PathDirection int32
 
 const (
@@ -231,11 +393,11 @@ func (x PathDirection) String() string {
 }
 
 func (PathDirection) Descriptor() protoreflect.EnumDescriptor {
-	return file_lilbattle_v1_models_models_proto_enumTypes[3].Descriptor()
+	return file_lilbattle_v1_models_models_proto_enumTypes[6].Descriptor()
 }
 
 func (PathDirection) Type() protoreflect.EnumType {
-	return &file_lilbattle_v1_models_models_proto_enumTypes[3]
+	return &file_lilbattle_v1_models_models_proto_enumTypes[6]
 }
 
 func (x PathDirection) Number() protoreflect.EnumNumber {
@@ -244,7 +406,7 @@ func (x PathDirection) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PathDirection.Descriptor instead.
 func (PathDirection) EnumDescriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{3}
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{6}
 }
 
 type IndexInfo struct {
@@ -482,8 +644,22 @@ type World struct {
 	// Default game configs
 	DefaultGameConfig *GameConfiguration `protobuf:"bytes,12,opt,name=default_game_config,json=defaultGameConfig,proto3" json:"default_game_config,omitempty"`
 	SearchIndexInfo   *IndexInfo         `protobuf:"bytes,13,opt,name=search_index_info,json=searchIndexInfo,proto3" json:"search_index_info,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Difficulty rating from AI-vs-AI simulations (see `ww world rate`)
+	Rating *WorldRating `protobuf:"bytes,14,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Rules tweaks for games played on this world
+	RulesOverrides *RulesOverrides `protobuf:"bytes,15,opt,name=rules_overrides,json=rulesOverrides,proto3" json:"rules_overrides,omitempty"`
+	// Hex layout, "pointy" (default) or "flat"
+	Orientation string `protobuf:"bytes,16,opt,name=orientation,proto3" json:"orientation,omitempty"`
+	// Set when the world was deleted while games were still using it.  Deleted
+	// worlds are hidden from listings but kept until their last game is gone.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Generator parameters and seed when this world is a random map
+	RandomMap *RandomMap `protobuf:"bytes,18,opt,name=random_map,json=randomMap,proto3" json:"random_map,omitempty"`
+	// Game a random map was generated for.  Attached worlds are hidden from
+	// listings and can't be fetched until their game starts.
+	AttachedGameId string `protobuf:"bytes,19,opt,name=attached_game_id,json=attachedGameId,proto3" json:"attached_game_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *World) Reset() {
@@ -607,37 +783,77 @@ func (x *World) GetSearchIndexInfo() *IndexInfo {
 	return nil
 }
 
-type WorldData struct {
+func (x *World) GetRating() *WorldRating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *World) GetRulesOverrides() *RulesOverrides {
+	if x != nil {
+		return x.RulesOverrides
+	}
+	return nil
+}
+
+func (x *World) GetOrientation() string {
+	if x != nil {
+		return x.Orientation
+	}
+	return ""
+}
+
+func (x *World) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *World) GetRandomMap() *RandomMap {
+	if x != nil {
+		return x.RandomMap
+	}
+	return nil
+}
+
+func (x *World) GetAttachedGameId() string {
+	if x != nil {
+		return x.AttachedGameId
+	}
+	return ""
+}
+
+// *
+// Parameters of a procedurally generated map.  The same size, player count
+// and seed always generate the same world, so a revealed seed lets players
+// check the map wasn't tampered with.
+type RandomMap struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// New map-based storage (key = "q,r" coordinate string)
-	TilesMap map[string]*Tile `protobuf:"bytes,1,rep,name=tiles_map,json=tilesMap,proto3" json:"tiles_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	UnitsMap map[string]*Unit `protobuf:"bytes,2,rep,name=units_map,json=unitsMap,proto3" json:"units_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// When this world data was updated (may have happened without world updating)
-	ScreenshotIndexInfo *IndexInfo `protobuf:"bytes,3,opt,name=screenshot_index_info,json=screenshotIndexInfo,proto3" json:"screenshot_index_info,omitempty"`
-	// We will only update if hash's are different
-	ContentHash string `protobuf:"bytes,4,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
-	// Version for Optimistic concurrent locking
-	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
-	// Improvement layer - crossings (roads on land, bridges on water)
-	Crossings     map[string]*Crossing `protobuf:"bytes,8,rep,name=crossings,proto3" json:"crossings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // key = "q,r", value = crossing with connectivity
+	// "small", "medium" or "large"
+	Size       string `protobuf:"bytes,1,opt,name=size,proto3" json:"size,omitempty"`
+	NumPlayers int32  `protobuf:"varint,2,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`
+	// Chosen by the server at creation.  Games only reveal it once they start.
+	Seed          int64 `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorldData) Reset() {
-	*x = WorldData{}
+func (x *RandomMap) Reset() {
+	*x = RandomMap{}
 	mi := &file_lilbattle_v1_models_models_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorldData) String() string {
+func (x *RandomMap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorldData) ProtoMessage() {}
+func (*RandomMap) ProtoMessage() {}
 
-func (x *WorldData) ProtoReflect() protoreflect.Message {
+func (x *RandomMap) ProtoReflect() protoreflect.Message {
 	mi := &file_lilbattle_v1_models_models_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -649,80 +865,63 @@ func (x *WorldData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorldData.ProtoReflect.Descriptor instead.
-func (*WorldData) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandomMap.ProtoReflect.Descriptor instead.
+func (*RandomMap) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *WorldData) GetTilesMap() map[string]*Tile {
-	if x != nil {
-		return x.TilesMap
-	}
-	return nil
-}
-
-func (x *WorldData) GetUnitsMap() map[string]*Unit {
-	if x != nil {
-		return x.UnitsMap
-	}
-	return nil
-}
-
-func (x *WorldData) GetScreenshotIndexInfo() *IndexInfo {
+func (x *RandomMap) GetSize() string {
 	if x != nil {
-		return x.ScreenshotIndexInfo
-	}
-	return nil
-}
-
-func (x *WorldData) GetContentHash() string {
-	if x != nil {
-		return x.ContentHash
+		return x.Size
 	}
 	return ""
 }
 
-func (x *WorldData) GetVersion() int64 {
+func (x *RandomMap) GetNumPlayers() int32 {
 	if x != nil {
-		return x.Version
+		return x.NumPlayers
 	}
 	return 0
 }
 
-func (x *WorldData) GetCrossings() map[string]*Crossing {
+func (x *RandomMap) GetSeed() int64 {
 	if x != nil {
-		return x.Crossings
+		return x.Seed
 	}
-	return nil
+	return 0
 }
 
-// Crossing with explicit connectivity data
-// Each crossing stores which of its 6 hex neighbors it connects to
-type Crossing struct {
+// *
+// Light rules tweaks scoped to a world or a game, eg "swamps cost 3 for
+// everyone here".  Only movement costs, income and the unit cap can be
+// overridden; combat is never changed.  Game-level overrides take precedence over world-level
+// ones, which take precedence over the base rules.
+type RulesOverrides struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Type  CrossingType           `protobuf:"varint,1,opt,name=type,proto3,enum=lilbattle.v1.CrossingType" json:"type,omitempty"`
-	// 6 booleans for hex neighbors in order matching AxialNeighborDeltas:
-	// 0: LEFT (-1,0), 1: TOP_LEFT (0,-1), 2: TOP_RIGHT (1,-1),
-	// 3: RIGHT (1,0), 4: BOTTOM_RIGHT (0,1), 5: BOTTOM_LEFT (-1,1)
-	ConnectsTo    []bool `protobuf:"varint,2,rep,packed,name=connects_to,json=connectsTo,proto3" json:"connects_to,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Movement cost on a terrain for every unit that can enter it (terrain_id -> cost)
+	TerrainMovementCosts map[int32]float64 `protobuf:"bytes,1,rep,name=terrain_movement_costs,json=terrainMovementCosts,proto3" json:"terrain_movement_costs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Income values; only the non-zero fields override the game's income config
+	Income *IncomeConfig `protobuf:"bytes,2,opt,name=income,proto3" json:"income,omitempty"`
+	// Replaces the rules' cap on units per player when non-zero
+	MaxUnitsPerPlayer int32 `protobuf:"varint,3,opt,name=max_units_per_player,json=maxUnitsPerPlayer,proto3" json:"max_units_per_player,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Crossing) Reset() {
-	*x = Crossing{}
+func (x *RulesOverrides) Reset() {
+	*x = RulesOverrides{}
 	mi := &file_lilbattle_v1_models_models_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Crossing) String() string {
+func (x *RulesOverrides) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Crossing) ProtoMessage() {}
+func (*RulesOverrides) ProtoMessage() {}
 
-func (x *Crossing) ProtoReflect() protoreflect.Message {
+func (x *RulesOverrides) ProtoReflect() protoreflect.Message {
 	mi := &file_lilbattle_v1_models_models_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -734,60 +933,77 @@ func (x *Crossing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Crossing.ProtoReflect.Descriptor instead.
-func (*Crossing) Descriptor() ([]byte, []int) {
+// Deprecated: Use RulesOverrides.ProtoReflect.Descriptor instead.
+func (*RulesOverrides) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Crossing) GetType() CrossingType {
+func (x *RulesOverrides) GetTerrainMovementCosts() map[int32]float64 {
 	if x != nil {
-		return x.Type
+		return x.TerrainMovementCosts
 	}
-	return CrossingType_CROSSING_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *Crossing) GetConnectsTo() []bool {
+func (x *RulesOverrides) GetIncome() *IncomeConfig {
 	if x != nil {
-		return x.ConnectsTo
+		return x.Income
 	}
 	return nil
 }
 
-type Tile struct {
+func (x *RulesOverrides) GetMaxUnitsPerPlayer() int32 {
+	if x != nil {
+		return x.MaxUnitsPerPlayer
+	}
+	return 0
+}
+
+// *
+// Difficulty of a world estimated by playing it out with the baseline AI on
+// every side. A rating is only meaningful for the world data, rules and AI
+// it was computed with and should be ignored once any of them changes.
+type WorldRating struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Q and R in Cubed coordinates
-	Q        int32 `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
-	R        int32 `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
-	TileType int32 `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"` // Tile type
-	// Whether the tile itself belongs to a player
-	Player   int32  `protobuf:"varint,4,opt,name=player,proto3" json:"player,omitempty"`
-	Shortcut string `protobuf:"bytes,5,opt,name=shortcut,proto3" json:"shortcut,omitempty"` // A short cut like A1 or S2 etc for quick access
-	// Keep track of turns when the move was last made and when a "top up" was last done on/for this tile.
-	// This helps us not having to "top up" or "reset" the stats at the end
-	// of each turn.  Instead as the game turn is incremented we can do a
-	// lazy reset for any unit or tile where unit_or_tile.last_toppedup_turn < game.curent_turn
-	// So we just have to increment the game_turn and the unit is automaticaly flagged as
-	// needing a top up of its health/balance/movement etc
-	LastActedTurn    int32 `protobuf:"varint,6,opt,name=last_acted_turn,json=lastActedTurn,proto3" json:"last_acted_turn,omitempty"`          // Which turn this unit was created/last acted on (ie movemade)
-	LastToppedupTurn int32 `protobuf:"varint,7,opt,name=last_toppedup_turn,json=lastToppedupTurn,proto3" json:"last_toppedup_turn,omitempty"` // When the last top up happened
+	// Player slot a human would take; every other slot is played by the AI
+	HumanPlayer int32 `protobuf:"varint,1,opt,name=human_player,json=humanPlayer,proto3" json:"human_player,omitempty"`
+	// Number of simulated games
+	Simulations int32 `protobuf:"varint,2,opt,name=simulations,proto3" json:"simulations,omitempty"`
+	// Fraction of simulations won by the human side
+	HumanWinRate float64 `protobuf:"fixed64,3,opt,name=human_win_rate,json=humanWinRate,proto3" json:"human_win_rate,omitempty"`
+	// Fraction of simulations won by an AI side
+	AiWinRate float64 `protobuf:"fixed64,4,opt,name=ai_win_rate,json=aiWinRate,proto3" json:"ai_win_rate,omitempty"`
+	// Average number of turns a simulated game lasted
+	AverageTurns float64 `protobuf:"fixed64,5,opt,name=average_turns,json=averageTurns,proto3" json:"average_turns,omitempty"`
+	// Difficulty bucket, eg "Hard"
+	Difficulty string `protobuf:"bytes,6,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Display label, eg "Hard (AI wins 70%)"
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	// Hash of the rules the simulations were played with
+	RulesHash string `protobuf:"bytes,8,opt,name=rules_hash,json=rulesHash,proto3" json:"rules_hash,omitempty"`
+	// Version of the AI that played the simulations
+	AiVersion string                 `protobuf:"bytes,9,opt,name=ai_version,json=aiVersion,proto3" json:"ai_version,omitempty"`
+	RatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=rated_at,json=ratedAt,proto3" json:"rated_at,omitempty"`
+	// Version of the world data the simulations were played on
+	WorldDataVersion int64 `protobuf:"varint,11,opt,name=world_data_version,json=worldDataVersion,proto3" json:"world_data_version,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Tile) Reset() {
-	*x = Tile{}
+func (x *WorldRating) Reset() {
+	*x = WorldRating{}
 	mi := &file_lilbattle_v1_models_models_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tile) String() string {
+func (x *WorldRating) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tile) ProtoMessage() {}
+func (*WorldRating) ProtoMessage() {}
 
-func (x *Tile) ProtoReflect() protoreflect.Message {
+func (x *WorldRating) ProtoReflect() protoreflect.Message {
 	mi := &file_lilbattle_v1_models_models_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -799,113 +1015,277 @@ func (x *Tile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tile.ProtoReflect.Descriptor instead.
-func (*Tile) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorldRating.ProtoReflect.Descriptor instead.
+func (*WorldRating) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Tile) GetQ() int32 {
+func (x *WorldRating) GetHumanPlayer() int32 {
 	if x != nil {
-		return x.Q
+		return x.HumanPlayer
 	}
 	return 0
 }
 
-func (x *Tile) GetR() int32 {
+func (x *WorldRating) GetSimulations() int32 {
 	if x != nil {
-		return x.R
+		return x.Simulations
 	}
 	return 0
 }
 
-func (x *Tile) GetTileType() int32 {
+func (x *WorldRating) GetHumanWinRate() float64 {
 	if x != nil {
-		return x.TileType
+		return x.HumanWinRate
 	}
 	return 0
 }
 
-func (x *Tile) GetPlayer() int32 {
+func (x *WorldRating) GetAiWinRate() float64 {
 	if x != nil {
-		return x.Player
+		return x.AiWinRate
 	}
 	return 0
 }
 
-func (x *Tile) GetShortcut() string {
+func (x *WorldRating) GetAverageTurns() float64 {
 	if x != nil {
-		return x.Shortcut
+		return x.AverageTurns
+	}
+	return 0
+}
+
+func (x *WorldRating) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
 	}
 	return ""
 }
 
-func (x *Tile) GetLastActedTurn() int32 {
+func (x *WorldRating) GetLabel() string {
 	if x != nil {
-		return x.LastActedTurn
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-func (x *Tile) GetLastToppedupTurn() int32 {
+func (x *WorldRating) GetRulesHash() string {
 	if x != nil {
-		return x.LastToppedupTurn
+		return x.RulesHash
 	}
-	return 0
+	return ""
 }
 
-type Unit struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Q and R in Cubed coordinates
-	Q        int32  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
-	R        int32  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
-	Player   int32  `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
-	UnitType int32  `protobuf:"varint,4,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
-	Shortcut string `protobuf:"bytes,5,opt,name=shortcut,proto3" json:"shortcut,omitempty"` // A short cut like A1 or S2 etc for quick access
-	// Runtime state fields
-	AvailableHealth int32   `protobuf:"varint,6,opt,name=available_health,json=availableHealth,proto3" json:"available_health,omitempty"` // Current health points
-	DistanceLeft    float64 `protobuf:"fixed64,7,opt,name=distance_left,json=distanceLeft,proto3" json:"distance_left,omitempty"`         // Movement points remaining this turn
+func (x *WorldRating) GetAiVersion() string {
+	if x != nil {
+		return x.AiVersion
+	}
+	return ""
+}
+
+func (x *WorldRating) GetRatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RatedAt
+	}
+	return nil
+}
+
+func (x *WorldRating) GetWorldDataVersion() int64 {
+	if x != nil {
+		return x.WorldDataVersion
+	}
+	return 0
+}
+
+type WorldData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// New map-based storage (key = "q,r" coordinate string)
+	TilesMap map[string]*Tile `protobuf:"bytes,1,rep,name=tiles_map,json=tilesMap,proto3" json:"tiles_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UnitsMap map[string]*Unit `protobuf:"bytes,2,rep,name=units_map,json=unitsMap,proto3" json:"units_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// When this world data was updated (may have happened without world updating)
+	ScreenshotIndexInfo *IndexInfo `protobuf:"bytes,3,opt,name=screenshot_index_info,json=screenshotIndexInfo,proto3" json:"screenshot_index_info,omitempty"`
+	// We will only update if hash's are different
+	ContentHash string `protobuf:"bytes,4,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// Version for Optimistic concurrent locking
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// Improvement layer - crossings (roads on land, bridges on water)
+	Crossings     map[string]*Crossing `protobuf:"bytes,8,rep,name=crossings,proto3" json:"crossings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // key = "q,r", value = crossing with connectivity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldData) Reset() {
+	*x = WorldData{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldData) ProtoMessage() {}
+
+func (x *WorldData) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldData.ProtoReflect.Descriptor instead.
+func (*WorldData) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WorldData) GetTilesMap() map[string]*Tile {
+	if x != nil {
+		return x.TilesMap
+	}
+	return nil
+}
+
+func (x *WorldData) GetUnitsMap() map[string]*Unit {
+	if x != nil {
+		return x.UnitsMap
+	}
+	return nil
+}
+
+func (x *WorldData) GetScreenshotIndexInfo() *IndexInfo {
+	if x != nil {
+		return x.ScreenshotIndexInfo
+	}
+	return nil
+}
+
+func (x *WorldData) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *WorldData) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *WorldData) GetCrossings() map[string]*Crossing {
+	if x != nil {
+		return x.Crossings
+	}
+	return nil
+}
+
+// Crossing with explicit connectivity data
+// Each crossing stores which of its 6 hex neighbors it connects to
+type Crossing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  CrossingType           `protobuf:"varint,1,opt,name=type,proto3,enum=lilbattle.v1.CrossingType" json:"type,omitempty"`
+	// 6 booleans for hex neighbors in order matching AxialNeighborDeltas:
+	// 0: LEFT (-1,0), 1: TOP_LEFT (0,-1), 2: TOP_RIGHT (1,-1),
+	// 3: RIGHT (1,0), 4: BOTTOM_RIGHT (0,1), 5: BOTTOM_LEFT (-1,1)
+	ConnectsTo    []bool `protobuf:"varint,2,rep,packed,name=connects_to,json=connectsTo,proto3" json:"connects_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Crossing) Reset() {
+	*x = Crossing{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Crossing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Crossing) ProtoMessage() {}
+
+func (x *Crossing) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Crossing.ProtoReflect.Descriptor instead.
+func (*Crossing) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Crossing) GetType() CrossingType {
+	if x != nil {
+		return x.Type
+	}
+	return CrossingType_CROSSING_TYPE_UNSPECIFIED
+}
+
+func (x *Crossing) GetConnectsTo() []bool {
+	if x != nil {
+		return x.ConnectsTo
+	}
+	return nil
+}
+
+type Tile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Q and R in Cubed coordinates
+	Q        int32 `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R        int32 `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	TileType int32 `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"` // Tile type
+	// Whether the tile itself belongs to a player
+	Player   int32  `protobuf:"varint,4,opt,name=player,proto3" json:"player,omitempty"`
+	Shortcut string `protobuf:"bytes,5,opt,name=shortcut,proto3" json:"shortcut,omitempty"` // A short cut like A1 or S2 etc for quick access
 	// Keep track of turns when the move was last made and when a "top up" was last done on/for this tile.
 	// This helps us not having to "top up" or "reset" the stats at the end
 	// of each turn.  Instead as the game turn is incremented we can do a
 	// lazy reset for any unit or tile where unit_or_tile.last_toppedup_turn < game.curent_turn
 	// So we just have to increment the game_turn and the unit is automaticaly flagged as
 	// needing a top up of its health/balance/movement etc
-	LastActedTurn    int32 `protobuf:"varint,8,opt,name=last_acted_turn,json=lastActedTurn,proto3" json:"last_acted_turn,omitempty"`          // Which turn this unit was created/last acted on (ie movemade)
-	LastToppedupTurn int32 `protobuf:"varint,9,opt,name=last_toppedup_turn,json=lastToppedupTurn,proto3" json:"last_toppedup_turn,omitempty"` // When the last top up happened
-	// Details around wound bonus tracking for this turn
-	AttacksReceivedThisTurn int32           `protobuf:"varint,10,opt,name=attacks_received_this_turn,json=attacksReceivedThisTurn,proto3" json:"attacks_received_this_turn,omitempty"` // Total number of attacks received this turn
-	AttackHistory           []*AttackRecord `protobuf:"bytes,11,rep,name=attack_history,json=attackHistory,proto3" json:"attack_history,omitempty"`                                    // Detailed attack history for wound bonus calculation
-	// Action progression tracking - index into UnitDefinition.action_order
-	// Indicates which step in the action sequence the unit is currently on
-	// Reset to 0 at turn start via TopUpUnitIfNeeded()
-	ProgressionStep int32 `protobuf:"varint,12,opt,name=progression_step,json=progressionStep,proto3" json:"progression_step,omitempty"`
-	// When current step has pipe-separated alternatives (e.g., "attack|capture"),
-	// this tracks which alternative the user chose, preventing switching mid-step
-	// Cleared when advancing to next step
-	ChosenAlternative string `protobuf:"bytes,13,opt,name=chosen_alternative,json=chosenAlternative,proto3" json:"chosen_alternative,omitempty"`
-	// Turn when this unit started capturing a building (0 = not capturing)
-	// Capture completes at the start of the capturing player's next turn
-	// if the unit is still alive on the tile
-	CaptureStartedTurn int32 `protobuf:"varint,14,opt,name=capture_started_turn,json=captureStartedTurn,proto3" json:"capture_started_turn,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	LastActedTurn    int32 `protobuf:"varint,6,opt,name=last_acted_turn,json=lastActedTurn,proto3" json:"last_acted_turn,omitempty"`          // Which turn this unit was created/last acted on (ie movemade)
+	LastToppedupTurn int32 `protobuf:"varint,7,opt,name=last_toppedup_turn,json=lastToppedupTurn,proto3" json:"last_toppedup_turn,omitempty"` // When the last top up happened
+	// Set while a unit is constructing on this tile (see ConstructTerrainAction)
+	Construction *ConstructionProgress `protobuf:"bytes,8,opt,name=construction,proto3" json:"construction,omitempty"`
+	// Effect on units that move onto this tile, eg a minefield
+	Hazard *TileHazard `protobuf:"bytes,9,opt,name=hazard,proto3" json:"hazard,omitempty"`
+	// Victory points this tile's holder scores at the end of each of their
+	// turns (0 = not a victory point marker)
+	VictoryPoints int32 `protobuf:"varint,10,opt,name=victory_points,json=victoryPoints,proto3" json:"victory_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Unit) Reset() {
-	*x = Unit{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[7]
+func (x *Tile) Reset() {
+	*x = Tile{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Unit) String() string {
+func (x *Tile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Unit) ProtoMessage() {}
+func (*Tile) ProtoMessage() {}
 
-func (x *Unit) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[7]
+func (x *Tile) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,134 +1296,3667 @@ func (x *Unit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Unit.ProtoReflect.Descriptor instead.
-func (*Unit) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Tile.ProtoReflect.Descriptor instead.
+func (*Tile) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Unit) GetQ() int32 {
+func (x *Tile) GetQ() int32 {
 	if x != nil {
 		return x.Q
 	}
 	return 0
 }
 
-func (x *Unit) GetR() int32 {
+func (x *Tile) GetR() int32 {
 	if x != nil {
 		return x.R
 	}
 	return 0
 }
 
-func (x *Unit) GetPlayer() int32 {
+func (x *Tile) GetTileType() int32 {
 	if x != nil {
-		return x.Player
+		return x.TileType
 	}
 	return 0
 }
 
-func (x *Unit) GetUnitType() int32 {
+func (x *Tile) GetPlayer() int32 {
 	if x != nil {
-		return x.UnitType
+		return x.Player
 	}
 	return 0
 }
 
-func (x *Unit) GetShortcut() string {
+func (x *Tile) GetShortcut() string {
 	if x != nil {
 		return x.Shortcut
 	}
 	return ""
 }
 
-func (x *Unit) GetAvailableHealth() int32 {
+func (x *Tile) GetLastActedTurn() int32 {
 	if x != nil {
-		return x.AvailableHealth
+		return x.LastActedTurn
 	}
 	return 0
 }
 
-func (x *Unit) GetDistanceLeft() float64 {
+func (x *Tile) GetLastToppedupTurn() int32 {
 	if x != nil {
-		return x.DistanceLeft
+		return x.LastToppedupTurn
 	}
 	return 0
 }
 
-func (x *Unit) GetLastActedTurn() int32 {
+func (x *Tile) GetConstruction() *ConstructionProgress {
 	if x != nil {
-		return x.LastActedTurn
+		return x.Construction
 	}
-	return 0
+	return nil
 }
 
-func (x *Unit) GetLastToppedupTurn() int32 {
+func (x *Tile) GetHazard() *TileHazard {
 	if x != nil {
-		return x.LastToppedupTurn
+		return x.Hazard
 	}
-	return 0
+	return nil
 }
 
-func (x *Unit) GetAttacksReceivedThisTurn() int32 {
+func (x *Tile) GetVictoryPoints() int32 {
 	if x != nil {
-		return x.AttacksReceivedThisTurn
+		return x.VictoryPoints
 	}
 	return 0
 }
 
-func (x *Unit) GetAttackHistory() []*AttackRecord {
-	if x != nil {
-		return x.AttackHistory
-	}
-	return nil
+// A trap or hazard that affects units entering a tile
+type TileHazard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Damage        int32                  `protobuf:"varint,1,opt,name=damage,proto3" json:"damage,omitempty"`                                    // Health a unit loses when it enters the tile
+	StopsMovement bool                   `protobuf:"varint,2,opt,name=stops_movement,json=stopsMovement,proto3" json:"stops_movement,omitempty"` // Units entering the tile lose their remaining movement
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TileHazard) Reset() {
+	*x = TileHazard{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TileHazard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TileHazard) ProtoMessage() {}
+
+func (x *TileHazard) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TileHazard.ProtoReflect.Descriptor instead.
+func (*TileHazard) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TileHazard) GetDamage() int32 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *TileHazard) GetStopsMovement() bool {
+	if x != nil {
+		return x.StopsMovement
+	}
+	return false
+}
+
+// Tracks an in-progress terrain construction on a tile
+type ConstructionProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the constructing unit.  Construction is cancelled if the
+	// unit moves away or is destroyed.
+	UnitQ          int32 `protobuf:"varint,1,opt,name=unit_q,json=unitQ,proto3" json:"unit_q,omitempty"`
+	UnitR          int32 `protobuf:"varint,2,opt,name=unit_r,json=unitR,proto3" json:"unit_r,omitempty"`
+	Player         int32 `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
+	TargetTerrain  int32 `protobuf:"varint,4,opt,name=target_terrain,json=targetTerrain,proto3" json:"target_terrain,omitempty"`    // Terrain type the tile becomes on completion
+	TurnsRemaining int32 `protobuf:"varint,5,opt,name=turns_remaining,json=turnsRemaining,proto3" json:"turns_remaining,omitempty"` // Player turns left before completion
+	StartedTurn    int32 `protobuf:"varint,6,opt,name=started_turn,json=startedTurn,proto3" json:"started_turn,omitempty"`
+	// Stable ID of the constructing unit, so a unit that later stands on its
+	// position does not carry on with the construction (0 = not recorded)
+	UnitId        int32 `protobuf:"varint,7,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConstructionProgress) Reset() {
+	*x = ConstructionProgress{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConstructionProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConstructionProgress) ProtoMessage() {}
+
+func (x *ConstructionProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConstructionProgress.ProtoReflect.Descriptor instead.
+func (*ConstructionProgress) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConstructionProgress) GetUnitQ() int32 {
+	if x != nil {
+		return x.UnitQ
+	}
+	return 0
+}
+
+func (x *ConstructionProgress) GetUnitR() int32 {
+	if x != nil {
+		return x.UnitR
+	}
+	return 0
+}
+
+func (x *ConstructionProgress) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *ConstructionProgress) GetTargetTerrain() int32 {
+	if x != nil {
+		return x.TargetTerrain
+	}
+	return 0
+}
+
+func (x *ConstructionProgress) GetTurnsRemaining() int32 {
+	if x != nil {
+		return x.TurnsRemaining
+	}
+	return 0
+}
+
+func (x *ConstructionProgress) GetStartedTurn() int32 {
+	if x != nil {
+		return x.StartedTurn
+	}
+	return 0
+}
+
+func (x *ConstructionProgress) GetUnitId() int32 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+type Unit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Q and R in Cubed coordinates
+	Q        int32  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R        int32  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	Player   int32  `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
+	UnitType int32  `protobuf:"varint,4,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	Shortcut string `protobuf:"bytes,5,opt,name=shortcut,proto3" json:"shortcut,omitempty"` // A short cut like A1 or S2 etc for quick access
+	// Runtime state fields
+	AvailableHealth int32   `protobuf:"varint,6,opt,name=available_health,json=availableHealth,proto3" json:"available_health,omitempty"` // Current health points
+	DistanceLeft    float64 `protobuf:"fixed64,7,opt,name=distance_left,json=distanceLeft,proto3" json:"distance_left,omitempty"`         // Movement points remaining this turn
+	// Keep track of turns when the move was last made and when a "top up" was last done on/for this tile.
+	// This helps us not having to "top up" or "reset" the stats at the end
+	// of each turn.  Instead as the game turn is incremented we can do a
+	// lazy reset for any unit or tile where unit_or_tile.last_toppedup_turn < game.curent_turn
+	// So we just have to increment the game_turn and the unit is automaticaly flagged as
+	// needing a top up of its health/balance/movement etc
+	LastActedTurn    int32 `protobuf:"varint,8,opt,name=last_acted_turn,json=lastActedTurn,proto3" json:"last_acted_turn,omitempty"`          // Which turn this unit was created/last acted on (ie movemade)
+	LastToppedupTurn int32 `protobuf:"varint,9,opt,name=last_toppedup_turn,json=lastToppedupTurn,proto3" json:"last_toppedup_turn,omitempty"` // When the last top up happened
+	// Details around wound bonus tracking for this turn
+	AttacksReceivedThisTurn int32           `protobuf:"varint,10,opt,name=attacks_received_this_turn,json=attacksReceivedThisTurn,proto3" json:"attacks_received_this_turn,omitempty"` // Total number of attacks received this turn
+	AttackHistory           []*AttackRecord `protobuf:"bytes,11,rep,name=attack_history,json=attackHistory,proto3" json:"attack_history,omitempty"`                                    // Detailed attack history for wound bonus calculation
+	// Action progression tracking - index into UnitDefinition.action_order
+	// Indicates which step in the action sequence the unit is currently on
+	// Reset to 0 at turn start via TopUpUnitIfNeeded()
+	ProgressionStep int32 `protobuf:"varint,12,opt,name=progression_step,json=progressionStep,proto3" json:"progression_step,omitempty"`
+	// When current step has pipe-separated alternatives (e.g., "attack|capture"),
+	// this tracks which alternative the user chose, preventing switching mid-step
+	// Cleared when advancing to next step
+	ChosenAlternative string `protobuf:"bytes,13,opt,name=chosen_alternative,json=chosenAlternative,proto3" json:"chosen_alternative,omitempty"`
+	// Turn when this unit started capturing a building (0 = not capturing)
+	// Capture completes at the start of the capturing player's next turn
+	// if the unit is still alive on the tile
+	CaptureStartedTurn int32 `protobuf:"varint,14,opt,name=capture_started_turn,json=captureStartedTurn,proto3" json:"capture_started_turn,omitempty"`
+	// Stealth-class units can submerge (see SubmergeUnitAction).  Submerged
+	// units are hidden from enemies that are not adjacent and cannot attack.
+	Submerged bool `protobuf:"varint,15,opt,name=submerged,proto3" json:"submerged,omitempty"`
+	// Direction a multi-hex unit faces (0-5 as in NeighborDirection), set from
+	// the last step of its path
+	Facing int32 `protobuf:"varint,16,opt,name=facing,proto3" json:"facing,omitempty"`
+	// Stable ID assigned when the unit is created.  Unlike the shortcut it never
+	// changes and is never reused after the unit is removed (0 = not assigned).
+	Id int32 `protobuf:"varint,17,opt,name=id,proto3" json:"id,omitempty"`
+	// Times the unit has performed a single-shot action at its current step,
+	// counted against UnitDefinition.action_limits
+	// Cleared when advancing to next step
+	StepActions   int32 `protobuf:"varint,18,opt,name=step_actions,json=stepActions,proto3" json:"step_actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Unit) Reset() {
+	*x = Unit{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Unit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unit) ProtoMessage() {}
+
+func (x *Unit) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unit.ProtoReflect.Descriptor instead.
+func (*Unit) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Unit) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *Unit) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *Unit) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *Unit) GetUnitType() int32 {
+	if x != nil {
+		return x.UnitType
+	}
+	return 0
+}
+
+func (x *Unit) GetShortcut() string {
+	if x != nil {
+		return x.Shortcut
+	}
+	return ""
+}
+
+func (x *Unit) GetAvailableHealth() int32 {
+	if x != nil {
+		return x.AvailableHealth
+	}
+	return 0
+}
+
+func (x *Unit) GetDistanceLeft() float64 {
+	if x != nil {
+		return x.DistanceLeft
+	}
+	return 0
+}
+
+func (x *Unit) GetLastActedTurn() int32 {
+	if x != nil {
+		return x.LastActedTurn
+	}
+	return 0
+}
+
+func (x *Unit) GetLastToppedupTurn() int32 {
+	if x != nil {
+		return x.LastToppedupTurn
+	}
+	return 0
+}
+
+func (x *Unit) GetAttacksReceivedThisTurn() int32 {
+	if x != nil {
+		return x.AttacksReceivedThisTurn
+	}
+	return 0
+}
+
+func (x *Unit) GetAttackHistory() []*AttackRecord {
+	if x != nil {
+		return x.AttackHistory
+	}
+	return nil
+}
+
+func (x *Unit) GetProgressionStep() int32 {
+	if x != nil {
+		return x.ProgressionStep
+	}
+	return 0
+}
+
+func (x *Unit) GetChosenAlternative() string {
+	if x != nil {
+		return x.ChosenAlternative
+	}
+	return ""
+}
+
+func (x *Unit) GetCaptureStartedTurn() int32 {
+	if x != nil {
+		return x.CaptureStartedTurn
+	}
+	return 0
+}
+
+func (x *Unit) GetSubmerged() bool {
+	if x != nil {
+		return x.Submerged
+	}
+	return false
+}
+
+func (x *Unit) GetFacing() int32 {
+	if x != nil {
+		return x.Facing
+	}
+	return 0
+}
+
+func (x *Unit) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Unit) GetStepActions() int32 {
+	if x != nil {
+		return x.StepActions
+	}
+	return 0
+}
+
+type AttackRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`                                     // Attacker's Q coordinate
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`                                     // Attacker's R coordinate
+	IsRanged      bool                   `protobuf:"varint,3,opt,name=is_ranged,json=isRanged,proto3" json:"is_ranged,omitempty"`       // Whether attacker is ranged 2+ tiles away
+	TurnNumber    int32                  `protobuf:"varint,4,opt,name=turn_number,json=turnNumber,proto3" json:"turn_number,omitempty"` // Which turn this attack occured
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttackRecord) Reset() {
+	*x = AttackRecord{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackRecord) ProtoMessage() {}
+
+func (x *AttackRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackRecord.ProtoReflect.Descriptor instead.
+func (*AttackRecord) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AttackRecord) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *AttackRecord) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *AttackRecord) GetIsRanged() bool {
+	if x != nil {
+		return x.IsRanged
+	}
+	return false
+}
+
+func (x *AttackRecord) GetTurnNumber() int32 {
+	if x != nil {
+		return x.TurnNumber
+	}
+	return 0
+}
+
+// Rules engine terrain definition
+type TerrainDefinition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`    // Terrain type ID
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Display name (e.g., "Grass", "Mountain")
+	// double base_move_cost = 3;     // Base movement cost
+	// double defense_bonus = 4;      // Defense bonus multiplier (0.0 to 1.0)
+	Type        int32  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`              // Terrain category type
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"` // Human-readable description
+	// How this terrain impacts
+	UnitProperties map[int32]*TerrainUnitProperties `protobuf:"bytes,7,rep,name=unit_properties,json=unitProperties,proto3" json:"unit_properties,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// List of units that can be built on this terrain
+	BuildableUnitIds []int32 `protobuf:"varint,8,rep,packed,name=buildable_unit_ids,json=buildableUnitIds,proto3" json:"buildable_unit_ids,omitempty"`
+	IncomePerTurn    int32   `protobuf:"varint,9,opt,name=income_per_turn,json=incomePerTurn,proto3" json:"income_per_turn,omitempty"`
+	// Extra hexes of sight range for units standing on this terrain
+	VisionBonus   int32 `protobuf:"varint,10,opt,name=vision_bonus,json=visionBonus,proto3" json:"vision_bonus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerrainDefinition) Reset() {
+	*x = TerrainDefinition{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerrainDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerrainDefinition) ProtoMessage() {}
+
+func (x *TerrainDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerrainDefinition.ProtoReflect.Descriptor instead.
+func (*TerrainDefinition) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TerrainDefinition) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TerrainDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TerrainDefinition) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *TerrainDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TerrainDefinition) GetUnitProperties() map[int32]*TerrainUnitProperties {
+	if x != nil {
+		return x.UnitProperties
+	}
+	return nil
+}
+
+func (x *TerrainDefinition) GetBuildableUnitIds() []int32 {
+	if x != nil {
+		return x.BuildableUnitIds
+	}
+	return nil
+}
+
+func (x *TerrainDefinition) GetIncomePerTurn() int32 {
+	if x != nil {
+		return x.IncomePerTurn
+	}
+	return 0
+}
+
+func (x *TerrainDefinition) GetVisionBonus() int32 {
+	if x != nil {
+		return x.VisionBonus
+	}
+	return 0
+}
+
+// Rules engine unit definition
+type UnitDefinition struct {
+	state             protoimpl.MessageState           `protogen:"open.v1"`
+	Id                int32                            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                  // Unit type ID
+	Name              string                           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                               // Display name (e.g., "Infantry", "Tank")
+	Description       string                           `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                 //
+	Health            int32                            `protobuf:"varint,4,opt,name=health,proto3" json:"health,omitempty"`                                          // Maximum health points
+	Coins             int32                            `protobuf:"varint,5,opt,name=coins,proto3" json:"coins,omitempty"`                                            // how much it costs to build
+	MovementPoints    float64                          `protobuf:"fixed64,6,opt,name=movement_points,json=movementPoints,proto3" json:"movement_points,omitempty"`   // Maximum movement per turn
+	RetreatPoints     float64                          `protobuf:"fixed64,7,opt,name=retreat_points,json=retreatPoints,proto3" json:"retreat_points,omitempty"`      // Movement points available after attacking
+	Defense           int32                            `protobuf:"varint,8,opt,name=defense,proto3" json:"defense,omitempty"`                                        // Base defense value
+	AttackRange       int32                            `protobuf:"varint,9,opt,name=attack_range,json=attackRange,proto3" json:"attack_range,omitempty"`             // Max Attack range in tiles
+	MinAttackRange    int32                            `protobuf:"varint,10,opt,name=min_attack_range,json=minAttackRange,proto3" json:"min_attack_range,omitempty"` // Minimum attack range in tile radius if specified (otherwise - will be 1
+	SplashDamage      int32                            `protobuf:"varint,11,opt,name=splash_damage,json=splashDamage,proto3" json:"splash_damage,omitempty"`         // Splash damage amount
+	TerrainProperties map[int32]*TerrainUnitProperties `protobuf:"bytes,12,rep,name=terrain_properties,json=terrainProperties,proto3" json:"terrain_properties,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Properties        []string                         `protobuf:"bytes,13,rep,name=properties,proto3" json:"properties,omitempty"` // Special properties/abilities
+	// Unit classification for attack calculations
+	UnitClass   string `protobuf:"bytes,14,opt,name=unit_class,json=unitClass,proto3" json:"unit_class,omitempty"`       // "Light", "Heavy", or "Stealth"
+	UnitTerrain string `protobuf:"bytes,15,opt,name=unit_terrain,json=unitTerrain,proto3" json:"unit_terrain,omitempty"` // "Air", "Land", or "Water"
+	// Attack table: base attack values against different unit classes
+	// Key format: "Light:Air", "Heavy:Land", "Stealth:Water", etc.
+	// Value 0 or missing key means "n/a" (cannot attack)
+	AttackVsClass map[string]int32 `protobuf:"bytes,16,rep,name=attack_vs_class,json=attackVsClass,proto3" json:"attack_vs_class,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Ordered list of allowed actions this turn
+	// Examples:
+	//
+	//	["move", "attack"] - can move then attack
+	//	["move", "attack|capture"] - can move then either attack or capture
+	//	["attack"] - can only attack (no movement)
+	//
+	// Default if empty: ["move", "attack|capture"]
+	ActionOrder []string `protobuf:"bytes,17,rep,name=action_order,json=actionOrder,proto3" json:"action_order,omitempty"`
+	// How many times each action type can be performed per turn
+	// Key: action name, Value: max count
+	// Example: {"attack": 2} means can attack twice
+	// Default if not specified: 1 per action type
+	ActionLimits map[string]int32 `protobuf:"bytes,18,rep,name=action_limits,json=actionLimits,proto3" json:"action_limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Fix value for units that can repair other units (Medic, Engineer, etc.)
+	// Used in fix calculation: p = 0.05 * fix_value
+	// Default 0 means unit cannot fix
+	FixValue int32 `protobuf:"varint,19,opt,name=fix_value,json=fixValue,proto3" json:"fix_value,omitempty"`
+	// Terrain conversions this unit can construct on an adjacent tile (eg
+	// Engineers building bridges over water).  Empty means the unit cannot
+	// construct anything.
+	Constructions []*TerrainConversion `protobuf:"bytes,20,rep,name=constructions,proto3" json:"constructions,omitempty"`
+	// How many hexes away the unit can see when fog of war is enabled
+	// Default 0 means DefaultSightRange
+	SightRange int32 `protobuf:"varint,21,opt,name=sight_range,json=sightRange,proto3" json:"sight_range,omitempty"`
+	// Extra hexes the unit covers besides its own, relative to it when facing
+	// LEFT.  Only used when the rules enable multi_hex_units.
+	Footprint []*HexOffset `protobuf:"bytes,22,rep,name=footprint,proto3" json:"footprint,omitempty"`
+	// Unit types this unit can transform into (eg infantry upgrading to
+	// mechanized).  Empty means the unit cannot transform.
+	TransformsTo  []int32 `protobuf:"varint,23,rep,packed,name=transforms_to,json=transformsTo,proto3" json:"transforms_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnitDefinition) Reset() {
+	*x = UnitDefinition{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitDefinition) ProtoMessage() {}
+
+func (x *UnitDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitDefinition.ProtoReflect.Descriptor instead.
+func (*UnitDefinition) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UnitDefinition) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UnitDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UnitDefinition) GetHealth() int32 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetCoins() int32 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetMovementPoints() float64 {
+	if x != nil {
+		return x.MovementPoints
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetRetreatPoints() float64 {
+	if x != nil {
+		return x.RetreatPoints
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetDefense() int32 {
+	if x != nil {
+		return x.Defense
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetAttackRange() int32 {
+	if x != nil {
+		return x.AttackRange
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetMinAttackRange() int32 {
+	if x != nil {
+		return x.MinAttackRange
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetSplashDamage() int32 {
+	if x != nil {
+		return x.SplashDamage
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetTerrainProperties() map[int32]*TerrainUnitProperties {
+	if x != nil {
+		return x.TerrainProperties
+	}
+	return nil
+}
+
+func (x *UnitDefinition) GetProperties() []string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *UnitDefinition) GetUnitClass() string {
+	if x != nil {
+		return x.UnitClass
+	}
+	return ""
+}
+
+func (x *UnitDefinition) GetUnitTerrain() string {
+	if x != nil {
+		return x.UnitTerrain
+	}
+	return ""
+}
+
+func (x *UnitDefinition) GetAttackVsClass() map[string]int32 {
+	if x != nil {
+		return x.AttackVsClass
+	}
+	return nil
+}
+
+func (x *UnitDefinition) GetActionOrder() []string {
+	if x != nil {
+		return x.ActionOrder
+	}
+	return nil
+}
+
+func (x *UnitDefinition) GetActionLimits() map[string]int32 {
+	if x != nil {
+		return x.ActionLimits
+	}
+	return nil
+}
+
+func (x *UnitDefinition) GetFixValue() int32 {
+	if x != nil {
+		return x.FixValue
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetConstructions() []*TerrainConversion {
+	if x != nil {
+		return x.Constructions
+	}
+	return nil
+}
+
+func (x *UnitDefinition) GetSightRange() int32 {
+	if x != nil {
+		return x.SightRange
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetFootprint() []*HexOffset {
+	if x != nil {
+		return x.Footprint
+	}
+	return nil
+}
+
+func (x *UnitDefinition) GetTransformsTo() []int32 {
+	if x != nil {
+		return x.TransformsTo
+	}
+	return nil
+}
+
+// An offset from a hex in axial coordinates
+type HexOffset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dq            int32                  `protobuf:"varint,1,opt,name=dq,proto3" json:"dq,omitempty"`
+	Dr            int32                  `protobuf:"varint,2,opt,name=dr,proto3" json:"dr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HexOffset) Reset() {
+	*x = HexOffset{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HexOffset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HexOffset) ProtoMessage() {}
+
+func (x *HexOffset) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HexOffset.ProtoReflect.Descriptor instead.
+func (*HexOffset) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HexOffset) GetDq() int32 {
+	if x != nil {
+		return x.Dq
+	}
+	return 0
+}
+
+func (x *HexOffset) GetDr() int32 {
+	if x != nil {
+		return x.Dr
+	}
+	return 0
+}
+
+// A terrain conversion a unit can perform via ConstructTerrainAction
+type TerrainConversion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromTerrain   int32                  `protobuf:"varint,1,opt,name=from_terrain,json=fromTerrain,proto3" json:"from_terrain,omitempty"` // Terrain type the target tile must currently be
+	ToTerrain     int32                  `protobuf:"varint,2,opt,name=to_terrain,json=toTerrain,proto3" json:"to_terrain,omitempty"`       // Terrain type the tile becomes once complete
+	Coins         int32                  `protobuf:"varint,3,opt,name=coins,proto3" json:"coins,omitempty"`                                // Cost paid up front when construction starts
+	Turns         int32                  `protobuf:"varint,4,opt,name=turns,proto3" json:"turns,omitempty"`                                // Number of the player's turns until completion
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                   // Short display name, eg "bridge"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerrainConversion) Reset() {
+	*x = TerrainConversion{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerrainConversion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerrainConversion) ProtoMessage() {}
+
+func (x *TerrainConversion) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerrainConversion.ProtoReflect.Descriptor instead.
+func (*TerrainConversion) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TerrainConversion) GetFromTerrain() int32 {
+	if x != nil {
+		return x.FromTerrain
+	}
+	return 0
+}
+
+func (x *TerrainConversion) GetToTerrain() int32 {
+	if x != nil {
+		return x.ToTerrain
+	}
+	return 0
+}
+
+func (x *TerrainConversion) GetCoins() int32 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
+}
+
+func (x *TerrainConversion) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+func (x *TerrainConversion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Properties that are specific to unit on a particular terrain
+type TerrainUnitProperties struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TerrainId      int32                  `protobuf:"varint,1,opt,name=terrain_id,json=terrainId,proto3" json:"terrain_id,omitempty"` // Renamed from tile_id for clarity
+	UnitId         int32                  `protobuf:"varint,2,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	MovementCost   float64                `protobuf:"fixed64,3,opt,name=movement_cost,json=movementCost,proto3" json:"movement_cost,omitempty"`         // Movement cost for this unit on this terrain
+	HealingBonus   int32                  `protobuf:"varint,4,opt,name=healing_bonus,json=healingBonus,proto3" json:"healing_bonus,omitempty"`          // How much healing per turn this tile would offer this unit
+	CanBuild       bool                   `protobuf:"varint,5,opt,name=can_build,json=canBuild,proto3" json:"can_build,omitempty"`                      // Whether this particular terrain can build this given unit
+	CanCapture     bool                   `protobuf:"varint,6,opt,name=can_capture,json=canCapture,proto3" json:"can_capture,omitempty"`                // Whether this particular unit can capture this terrain/building type
+	AttackBonus    int32                  `protobuf:"varint,7,opt,name=attack_bonus,json=attackBonus,proto3" json:"attack_bonus,omitempty"`             // How much more attack this terrain gives to this unit
+	DefenseBonus   int32                  `protobuf:"varint,8,opt,name=defense_bonus,json=defenseBonus,proto3" json:"defense_bonus,omitempty"`          // How much more defense this terrain gives to this unit
+	AttackRange    int32                  `protobuf:"varint,9,opt,name=attack_range,json=attackRange,proto3" json:"attack_range,omitempty"`             // Max Attack range in tiles
+	MinAttackRange int32                  `protobuf:"varint,10,opt,name=min_attack_range,json=minAttackRange,proto3" json:"min_attack_range,omitempty"` // Minimum attack range in tile radius if specified (otherwise - will be 1
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TerrainUnitProperties) Reset() {
+	*x = TerrainUnitProperties{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerrainUnitProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerrainUnitProperties) ProtoMessage() {}
+
+func (x *TerrainUnitProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerrainUnitProperties.ProtoReflect.Descriptor instead.
+func (*TerrainUnitProperties) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TerrainUnitProperties) GetTerrainId() int32 {
+	if x != nil {
+		return x.TerrainId
+	}
+	return 0
+}
+
+func (x *TerrainUnitProperties) GetUnitId() int32 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+func (x *TerrainUnitProperties) GetMovementCost() float64 {
+	if x != nil {
+		return x.MovementCost
+	}
+	return 0
+}
+
+func (x *TerrainUnitProperties) GetHealingBonus() int32 {
+	if x != nil {
+		return x.HealingBonus
+	}
+	return 0
+}
+
+func (x *TerrainUnitProperties) GetCanBuild() bool {
+	if x != nil {
+		return x.CanBuild
+	}
+	return false
+}
+
+func (x *TerrainUnitProperties) GetCanCapture() bool {
+	if x != nil {
+		return x.CanCapture
+	}
+	return false
+}
+
+func (x *TerrainUnitProperties) GetAttackBonus() int32 {
+	if x != nil {
+		return x.AttackBonus
+	}
+	return 0
+}
+
+func (x *TerrainUnitProperties) GetDefenseBonus() int32 {
+	if x != nil {
+		return x.DefenseBonus
+	}
+	return 0
+}
+
+func (x *TerrainUnitProperties) GetAttackRange() int32 {
+	if x != nil {
+		return x.AttackRange
+	}
+	return 0
+}
+
+func (x *TerrainUnitProperties) GetMinAttackRange() int32 {
+	if x != nil {
+		return x.MinAttackRange
+	}
+	return 0
+}
+
+// Properties for unit-vs-unit combat interactions
+type UnitUnitProperties struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AttackerId      int32                  `protobuf:"varint,1,opt,name=attacker_id,json=attackerId,proto3" json:"attacker_id,omitempty"`
+	DefenderId      int32                  `protobuf:"varint,2,opt,name=defender_id,json=defenderId,proto3" json:"defender_id,omitempty"`
+	AttackOverride  *int32                 `protobuf:"varint,3,opt,name=attack_override,json=attackOverride,proto3,oneof" json:"attack_override,omitempty"`    // Optional attack override for this Attacker/Defender combo
+	DefenseOverride *int32                 `protobuf:"varint,4,opt,name=defense_override,json=defenseOverride,proto3,oneof" json:"defense_override,omitempty"` // Optional defense override for this Attacker/Defender combo
+	Damage          *DamageDistribution    `protobuf:"bytes,5,opt,name=damage,proto3" json:"damage,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnitUnitProperties) Reset() {
+	*x = UnitUnitProperties{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitUnitProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitUnitProperties) ProtoMessage() {}
+
+func (x *UnitUnitProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitUnitProperties.ProtoReflect.Descriptor instead.
+func (*UnitUnitProperties) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnitUnitProperties) GetAttackerId() int32 {
+	if x != nil {
+		return x.AttackerId
+	}
+	return 0
+}
+
+func (x *UnitUnitProperties) GetDefenderId() int32 {
+	if x != nil {
+		return x.DefenderId
+	}
+	return 0
+}
+
+func (x *UnitUnitProperties) GetAttackOverride() int32 {
+	if x != nil && x.AttackOverride != nil {
+		return *x.AttackOverride
+	}
+	return 0
+}
+
+func (x *UnitUnitProperties) GetDefenseOverride() int32 {
+	if x != nil && x.DefenseOverride != nil {
+		return *x.DefenseOverride
+	}
+	return 0
+}
+
+func (x *UnitUnitProperties) GetDamage() *DamageDistribution {
+	if x != nil {
+		return x.Damage
+	}
+	return nil
+}
+
+// Damage distribution for combat calculations
+type DamageDistribution struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MinDamage      float64                `protobuf:"fixed64,1,opt,name=min_damage,json=minDamage,proto3" json:"min_damage,omitempty"`
+	MaxDamage      float64                `protobuf:"fixed64,2,opt,name=max_damage,json=maxDamage,proto3" json:"max_damage,omitempty"`
+	ExpectedDamage float64                `protobuf:"fixed64,3,opt,name=expected_damage,json=expectedDamage,proto3" json:"expected_damage,omitempty"`
+	Ranges         []*DamageRange         `protobuf:"bytes,4,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DamageDistribution) Reset() {
+	*x = DamageDistribution{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DamageDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DamageDistribution) ProtoMessage() {}
+
+func (x *DamageDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DamageDistribution.ProtoReflect.Descriptor instead.
+func (*DamageDistribution) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DamageDistribution) GetMinDamage() float64 {
+	if x != nil {
+		return x.MinDamage
+	}
+	return 0
+}
+
+func (x *DamageDistribution) GetMaxDamage() float64 {
+	if x != nil {
+		return x.MaxDamage
+	}
+	return 0
+}
+
+func (x *DamageDistribution) GetExpectedDamage() float64 {
+	if x != nil {
+		return x.ExpectedDamage
+	}
+	return 0
+}
+
+func (x *DamageDistribution) GetRanges() []*DamageRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+type DamageRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinValue      float64                `protobuf:"fixed64,1,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue      float64                `protobuf:"fixed64,2,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Probability   float64                `protobuf:"fixed64,3,opt,name=probability,proto3" json:"probability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DamageRange) Reset() {
+	*x = DamageRange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DamageRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DamageRange) ProtoMessage() {}
+
+func (x *DamageRange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DamageRange.ProtoReflect.Descriptor instead.
+func (*DamageRange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DamageRange) GetMinValue() float64 {
+	if x != nil {
+		return x.MinValue
+	}
+	return 0
+}
+
+func (x *DamageRange) GetMaxValue() float64 {
+	if x != nil {
+		return x.MaxValue
+	}
+	return 0
+}
+
+func (x *DamageRange) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+// Main rules engine definition - centralized source of truth
+type RulesEngine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Core entity definitions
+	Units    map[int32]*UnitDefinition    `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Terrains map[int32]*TerrainDefinition `protobuf:"bytes,2,rep,name=terrains,proto3" json:"terrains,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Centralized property definitions (source of truth)
+	// Key format: "terrain_id:unit_id" (e.g., "1:3" for terrain 1, unit 3)
+	TerrainUnitProperties map[string]*TerrainUnitProperties `protobuf:"bytes,3,rep,name=terrain_unit_properties,json=terrainUnitProperties,proto3" json:"terrain_unit_properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Key format: "attacker_id:defender_id" (e.g., "1:2" for unit 1 attacking unit 2)
+	UnitUnitProperties map[string]*UnitUnitProperties `protobuf:"bytes,4,rep,name=unit_unit_properties,json=unitUnitProperties,proto3" json:"unit_unit_properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Terrain type classifications (terrain_id -> TerrainType)
+	// Used to determine if a terrain is city, nature, bridge, water, or road
+	TerrainTypes map[int32]TerrainType `protobuf:"bytes,5,rep,name=terrain_types,json=terrainTypes,proto3" json:"terrain_types,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=lilbattle.v1.TerrainType"`
+	// Experimental: units with a footprint cover more than one hex
+	MultiHexUnits bool `protobuf:"varint,6,opt,name=multi_hex_units,json=multiHexUnits,proto3" json:"multi_hex_units,omitempty"`
+	// Kinds of pending obligation (eg "retreat") that must be resolved before a
+	// player can end their turn.  Obligations not listed are optional.
+	MandatoryActions []string `protobuf:"bytes,7,rep,name=mandatory_actions,json=mandatoryActions,proto3" json:"mandatory_actions,omitempty"`
+	// Most units a player may have on the board at once.  0 means no cap.
+	MaxUnitsPerPlayer int32 `protobuf:"varint,8,opt,name=max_units_per_player,json=maxUnitsPerPlayer,proto3" json:"max_units_per_player,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RulesEngine) Reset() {
+	*x = RulesEngine{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RulesEngine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesEngine) ProtoMessage() {}
+
+func (x *RulesEngine) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesEngine.ProtoReflect.Descriptor instead.
+func (*RulesEngine) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RulesEngine) GetUnits() map[int32]*UnitDefinition {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *RulesEngine) GetTerrains() map[int32]*TerrainDefinition {
+	if x != nil {
+		return x.Terrains
+	}
+	return nil
+}
+
+func (x *RulesEngine) GetTerrainUnitProperties() map[string]*TerrainUnitProperties {
+	if x != nil {
+		return x.TerrainUnitProperties
+	}
+	return nil
+}
+
+func (x *RulesEngine) GetUnitUnitProperties() map[string]*UnitUnitProperties {
+	if x != nil {
+		return x.UnitUnitProperties
+	}
+	return nil
+}
+
+func (x *RulesEngine) GetTerrainTypes() map[int32]TerrainType {
+	if x != nil {
+		return x.TerrainTypes
+	}
+	return nil
+}
+
+func (x *RulesEngine) GetMultiHexUnits() bool {
+	if x != nil {
+		return x.MultiHexUnits
+	}
+	return false
+}
+
+func (x *RulesEngine) GetMandatoryActions() []string {
+	if x != nil {
+		return x.MandatoryActions
+	}
+	return nil
+}
+
+func (x *RulesEngine) GetMaxUnitsPerPlayer() int32 {
+	if x != nil {
+		return x.MaxUnitsPerPlayer
+	}
+	return 0
+}
+
+// Describes a game and its metadata
+type Game struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Version number for optimistic locking
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Unique ID for the game
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// User who started/created the game
+	CreatorId string `protobuf:"bytes,5,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// The world this game was created from
+	WorldId string `protobuf:"bytes,6,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Name if items have names
+	Name string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	// Description if game has a description
+	Description string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	// Some tags
+	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// A possible image url
+	ImageUrl string `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// Difficulty - example attribute
+	Difficulty string `protobuf:"bytes,11,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Game configuration
+	Config *GameConfiguration `protobuf:"bytes,12,opt,name=config,proto3" json:"config,omitempty"`
+	// URL to screenshot/preview image (defaults to /games/{id}/screenshots/{screenshotName})
+	// Can be overridden to point to CDN or external hosting
+	PreviewUrls     []string   `protobuf:"bytes,13,rep,name=preview_urls,json=previewUrls,proto3" json:"preview_urls,omitempty"`
+	SearchIndexInfo *IndexInfo `protobuf:"bytes,15,opt,name=search_index_info,json=searchIndexInfo,proto3" json:"search_index_info,omitempty"`
+	// Hex layout inherited from the world, "pointy" (default) or "flat"
+	Orientation string `protobuf:"bytes,16,opt,name=orientation,proto3" json:"orientation,omitempty"`
+	// Set to create the game on a freshly generated map instead of world_id
+	RandomMap *RandomMap `protobuf:"bytes,17,opt,name=random_map,json=randomMap,proto3" json:"random_map,omitempty"`
+	// Small base64 encoded PNG of the board when the game was last saved, so
+	// game lists can show a preview without loading the game's state.  Only
+	// set when the save path has thumbnails turned on.
+	Thumbnail string `protobuf:"bytes,18,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	// Game this one was forked from with ForkGame, if any.  Forks are private
+	// copies for exploring lines: they are never rated and are left out of
+	// game listings unless asked for.
+	ForkedFromGameId string `protobuf:"bytes,19,opt,name=forked_from_game_id,json=forkedFromGameId,proto3" json:"forked_from_game_id,omitempty"`
+	// How many of the source game's moves the fork started after
+	ForkedAtMove  int32 `protobuf:"varint,20,opt,name=forked_at_move,json=forkedAtMove,proto3" json:"forked_at_move,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Game) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Game) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Game) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Game) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Game) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Game) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *Game) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Game) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Game) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Game) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Game) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *Game) GetConfig() *GameConfiguration {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Game) GetPreviewUrls() []string {
+	if x != nil {
+		return x.PreviewUrls
+	}
+	return nil
+}
+
+func (x *Game) GetSearchIndexInfo() *IndexInfo {
+	if x != nil {
+		return x.SearchIndexInfo
+	}
+	return nil
+}
+
+func (x *Game) GetOrientation() string {
+	if x != nil {
+		return x.Orientation
+	}
+	return ""
+}
+
+func (x *Game) GetRandomMap() *RandomMap {
+	if x != nil {
+		return x.RandomMap
+	}
+	return nil
+}
+
+func (x *Game) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
+func (x *Game) GetForkedFromGameId() string {
+	if x != nil {
+		return x.ForkedFromGameId
+	}
+	return ""
+}
+
+func (x *Game) GetForkedAtMove() int32 {
+	if x != nil {
+		return x.ForkedAtMove
+	}
+	return 0
+}
+
+type GameConfiguration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Player configuration
+	Players []*GamePlayer `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// Team configuration
+	Teams []*GameTeam `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	// Various kinds of per turn income configs
+	IncomeConfigs *IncomeConfig `protobuf:"bytes,3,opt,name=income_configs,json=incomeConfigs,proto3" json:"income_configs,omitempty"`
+	// Game settings
+	Settings *GameSettings `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+	// Rules overrides of the world the game was created from
+	WorldRulesOverrides *RulesOverrides `protobuf:"bytes,5,opt,name=world_rules_overrides,json=worldRulesOverrides,proto3" json:"world_rules_overrides,omitempty"`
+	// Rules overrides for this game alone; these win over the world's
+	RulesOverrides *RulesOverrides `protobuf:"bytes,6,opt,name=rules_overrides,json=rulesOverrides,proto3" json:"rules_overrides,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GameConfiguration) Reset() {
+	*x = GameConfiguration{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameConfiguration) ProtoMessage() {}
+
+func (x *GameConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameConfiguration.ProtoReflect.Descriptor instead.
+func (*GameConfiguration) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GameConfiguration) GetPlayers() []*GamePlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameConfiguration) GetTeams() []*GameTeam {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *GameConfiguration) GetIncomeConfigs() *IncomeConfig {
+	if x != nil {
+		return x.IncomeConfigs
+	}
+	return nil
+}
+
+func (x *GameConfiguration) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *GameConfiguration) GetWorldRulesOverrides() *RulesOverrides {
+	if x != nil {
+		return x.WorldRulesOverrides
+	}
+	return nil
+}
+
+func (x *GameConfiguration) GetRulesOverrides() *RulesOverrides {
+	if x != nil {
+		return x.RulesOverrides
+	}
+	return nil
+}
+
+type IncomeConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How much starting coins to give each player at the start of the agme
+	StartingCoins int32 `protobuf:"varint,1,opt,name=starting_coins,json=startingCoins,proto3" json:"starting_coins,omitempty"`
+	// Income each player just for being in the game each turn
+	GameIncome int32 `protobuf:"varint,2,opt,name=game_income,json=gameIncome,proto3" json:"game_income,omitempty"`
+	// Income from each landbase per turn
+	LandbaseIncome int32 `protobuf:"varint,3,opt,name=landbase_income,json=landbaseIncome,proto3" json:"landbase_income,omitempty"`
+	// Income from each navalbase per turn
+	NavalbaseIncome int32 `protobuf:"varint,4,opt,name=navalbase_income,json=navalbaseIncome,proto3" json:"navalbase_income,omitempty"`
+	// Income from each airport base per turn
+	AirportbaseIncome int32 `protobuf:"varint,5,opt,name=airportbase_income,json=airportbaseIncome,proto3" json:"airportbase_income,omitempty"`
+	// Income from each missile silo per turn
+	MissilesiloIncome int32 `protobuf:"varint,6,opt,name=missilesilo_income,json=missilesiloIncome,proto3" json:"missilesilo_income,omitempty"`
+	// Income from each mine per turn
+	MinesIncome   int32 `protobuf:"varint,7,opt,name=mines_income,json=minesIncome,proto3" json:"mines_income,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncomeConfig) Reset() {
+	*x = IncomeConfig{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncomeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomeConfig) ProtoMessage() {}
+
+func (x *IncomeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomeConfig.ProtoReflect.Descriptor instead.
+func (*IncomeConfig) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IncomeConfig) GetStartingCoins() int32 {
+	if x != nil {
+		return x.StartingCoins
+	}
+	return 0
+}
+
+func (x *IncomeConfig) GetGameIncome() int32 {
+	if x != nil {
+		return x.GameIncome
+	}
+	return 0
+}
+
+func (x *IncomeConfig) GetLandbaseIncome() int32 {
+	if x != nil {
+		return x.LandbaseIncome
+	}
+	return 0
+}
+
+func (x *IncomeConfig) GetNavalbaseIncome() int32 {
+	if x != nil {
+		return x.NavalbaseIncome
+	}
+	return 0
+}
+
+func (x *IncomeConfig) GetAirportbaseIncome() int32 {
+	if x != nil {
+		return x.AirportbaseIncome
+	}
+	return 0
+}
+
+func (x *IncomeConfig) GetMissilesiloIncome() int32 {
+	if x != nil {
+		return x.MissilesiloIncome
+	}
+	return 0
+}
+
+func (x *IncomeConfig) GetMinesIncome() int32 {
+	if x != nil {
+		return x.MinesIncome
+	}
+	return 0
+}
+
+type GamePlayer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Player ID (1-based)
+	PlayerId int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// ID of the system user that is assigned to this game player.  This is the "auth" user
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Player type
+	PlayerType string `protobuf:"bytes,3,opt,name=player_type,json=playerType,proto3" json:"player_type,omitempty"` // "human", "ai", "open"
+	// Player color
+	Color string `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	// Team ID (0 = no team, 1+ = team number)
+	TeamId int32 `protobuf:"varint,5,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Nickname for the player in this game
+	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether play is still in the game - can this just be inferred?
+	IsActive bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// How many coins the player started off with
+	StartingCoins int32 `protobuf:"varint,8,opt,name=starting_coins,json=startingCoins,proto3" json:"starting_coins,omitempty"`
+	// Head start for asymmetric play, eg a beginner against an expert
+	Handicap      *PlayerHandicap `protobuf:"bytes,10,opt,name=handicap,proto3" json:"handicap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GamePlayer) Reset() {
+	*x = GamePlayer{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GamePlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamePlayer) ProtoMessage() {}
+
+func (x *GamePlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamePlayer.ProtoReflect.Descriptor instead.
+func (*GamePlayer) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GamePlayer) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetPlayerType() string {
+	if x != nil {
+		return x.PlayerType
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *GamePlayer) GetStartingCoins() int32 {
+	if x != nil {
+		return x.StartingCoins
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetHandicap() *PlayerHandicap {
+	if x != nil {
+		return x.Handicap
+	}
+	return nil
+}
+
+// A head start given to one player when the game is created
+type PlayerHandicap struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Coins added to the player's starting coins
+	ExtraCoins int32 `protobuf:"varint,1,opt,name=extra_coins,json=extraCoins,proto3" json:"extra_coins,omitempty"`
+	// Unit types placed for the player, on free tiles the player owns and
+	// then on free tiles next to them
+	BonusUnits    []int32 `protobuf:"varint,2,rep,packed,name=bonus_units,json=bonusUnits,proto3" json:"bonus_units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerHandicap) Reset() {
+	*x = PlayerHandicap{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerHandicap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerHandicap) ProtoMessage() {}
+
+func (x *PlayerHandicap) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerHandicap.ProtoReflect.Descriptor instead.
+func (*PlayerHandicap) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PlayerHandicap) GetExtraCoins() int32 {
+	if x != nil {
+		return x.ExtraCoins
+	}
+	return 0
+}
+
+func (x *PlayerHandicap) GetBonusUnits() []int32 {
+	if x != nil {
+		return x.BonusUnits
+	}
+	return nil
+}
+
+type GameTeam struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the team within the game (unique to the game)
+	TeamId int32 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Name of the team - in a game
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Just a color for this team
+	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	// Whether team has active players - can also be inferred
+	IsActive      bool `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTeam) Reset() {
+	*x = GameTeam{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTeam) ProtoMessage() {}
+
+func (x *GameTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTeam.ProtoReflect.Descriptor instead.
+func (*GameTeam) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GameTeam) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GameTeam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameTeam) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *GameTeam) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type GameSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of allowed unit type IDs
+	AllowedUnits []int32 `protobuf:"varint,1,rep,packed,name=allowed_units,json=allowedUnits,proto3" json:"allowed_units,omitempty"`
+	// Turn time limit in seconds (0 = no limit)
+	TurnTimeLimit int32 `protobuf:"varint,2,opt,name=turn_time_limit,json=turnTimeLimit,proto3" json:"turn_time_limit,omitempty"`
+	// Team mode
+	TeamMode string `protobuf:"bytes,3,opt,name=team_mode,json=teamMode,proto3" json:"team_mode,omitempty"` // "ffa" or "teams"
+	// Maximum number of turns (0 = unlimited)
+	MaxTurns int32 `protobuf:"varint,4,opt,name=max_turns,json=maxTurns,proto3" json:"max_turns,omitempty"`
+	// Chess-clock style time banks (unset = no clock)
+	TimeBank *TimeBankSettings `protobuf:"bytes,5,opt,name=time_bank,json=timeBank,proto3" json:"time_bank,omitempty"`
+	// Allow Stealth-class units to submerge
+	StealthEnabled bool `protobuf:"varint,6,opt,name=stealth_enabled,json=stealthEnabled,proto3" json:"stealth_enabled,omitempty"`
+	// Rated games disable learning aids such as coach mode
+	Rated bool `protobuf:"varint,7,opt,name=rated,proto3" json:"rated,omitempty"`
+	// Fog of war: players only see hexes within sight range of their units
+	// and bases
+	FogEnabled bool `protobuf:"varint,8,opt,name=fog_enabled,json=fogEnabled,proto3" json:"fog_enabled,omitempty"`
+	// Pre-game draft where players ban or pick unit types (unset = no draft)
+	Draft *DraftSettings `protobuf:"bytes,9,opt,name=draft,proto3" json:"draft,omitempty"`
+	// Makes the game a puzzle: a fixed position where the solver has to reach
+	// a goal within a turn budget (unset = a regular game)
+	Puzzle *PuzzleSettings `protobuf:"bytes,10,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	// Units may attack other units of their own player, eg for puzzle maps
+	AllowFriendlyFire bool `protobuf:"varint,11,opt,name=allow_friendly_fire,json=allowFriendlyFire,proto3" json:"allow_friendly_fire,omitempty"`
+	// A player wins once they have scored this many victory points from the
+	// world's victory point markers (0 = no points-based win)
+	VictoryPointsToWin int32 `protobuf:"varint,12,opt,name=victory_points_to_win,json=victoryPointsToWin,proto3" json:"victory_points_to_win,omitempty"`
+	// Experimental: every player plans their turn's moves and attacks at once
+	// and the plans resolve together once all are in (see TurnPlan)
+	SimultaneousTurns bool `protobuf:"varint,13,opt,name=simultaneous_turns,json=simultaneousTurns,proto3" json:"simultaneous_turns,omitempty"`
+	// Holds back what spectators see of the game so streamers can't be
+	// ghosted (unset = spectators watch live)
+	SpectatorDelay *SpectatorDelay `protobuf:"bytes,14,opt,name=spectator_delay,json=spectatorDelay,proto3" json:"spectator_delay,omitempty"`
+	// Sandbox mode, where the creator controls every seat to try things out.
+	// Sandbox games are never rated or counted in stats.
+	Sandbox       *SandboxSettings `protobuf:"bytes,15,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameSettings) Reset() {
+	*x = GameSettings{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSettings) ProtoMessage() {}
+
+func (x *GameSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSettings.ProtoReflect.Descriptor instead.
+func (*GameSettings) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GameSettings) GetAllowedUnits() []int32 {
+	if x != nil {
+		return x.AllowedUnits
+	}
+	return nil
+}
+
+func (x *GameSettings) GetTurnTimeLimit() int32 {
+	if x != nil {
+		return x.TurnTimeLimit
+	}
+	return 0
+}
+
+func (x *GameSettings) GetTeamMode() string {
+	if x != nil {
+		return x.TeamMode
+	}
+	return ""
+}
+
+func (x *GameSettings) GetMaxTurns() int32 {
+	if x != nil {
+		return x.MaxTurns
+	}
+	return 0
+}
+
+func (x *GameSettings) GetTimeBank() *TimeBankSettings {
+	if x != nil {
+		return x.TimeBank
+	}
+	return nil
+}
+
+func (x *GameSettings) GetStealthEnabled() bool {
+	if x != nil {
+		return x.StealthEnabled
+	}
+	return false
+}
+
+func (x *GameSettings) GetRated() bool {
+	if x != nil {
+		return x.Rated
+	}
+	return false
+}
+
+func (x *GameSettings) GetFogEnabled() bool {
+	if x != nil {
+		return x.FogEnabled
+	}
+	return false
+}
+
+func (x *GameSettings) GetDraft() *DraftSettings {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *GameSettings) GetPuzzle() *PuzzleSettings {
+	if x != nil {
+		return x.Puzzle
+	}
+	return nil
+}
+
+func (x *GameSettings) GetAllowFriendlyFire() bool {
+	if x != nil {
+		return x.AllowFriendlyFire
+	}
+	return false
+}
+
+func (x *GameSettings) GetVictoryPointsToWin() int32 {
+	if x != nil {
+		return x.VictoryPointsToWin
+	}
+	return 0
+}
+
+func (x *GameSettings) GetSimultaneousTurns() bool {
+	if x != nil {
+		return x.SimultaneousTurns
+	}
+	return false
+}
+
+func (x *GameSettings) GetSpectatorDelay() *SpectatorDelay {
+	if x != nil {
+		return x.SpectatorDelay
+	}
+	return nil
+}
+
+func (x *GameSettings) GetSandbox() *SandboxSettings {
+	if x != nil {
+		return x.Sandbox
+	}
+	return nil
+}
+
+// How far behind the game spectators are kept. Moves reach spectators once
+// they are both `turns` turns and `seconds` seconds old; players always get
+// them live.
+type SpectatorDelay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Turns         int32                  `protobuf:"varint,1,opt,name=turns,proto3" json:"turns,omitempty"`
+	Seconds       int32                  `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectatorDelay) Reset() {
+	*x = SpectatorDelay{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectatorDelay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatorDelay) ProtoMessage() {}
+
+func (x *SpectatorDelay) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatorDelay.ProtoReflect.Descriptor instead.
+func (*SpectatorDelay) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SpectatorDelay) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+func (x *SpectatorDelay) GetSeconds() int32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+// Sandbox configuration. Sandbox games also accept SpawnUnitAction and
+// DeleteUnitAction moves.
+type SandboxSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Makes the game a sandbox
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Units and bases of any player can act whoever's turn it is, as their
+	// owner
+	RelaxTurnOrder bool `protobuf:"varint,2,opt,name=relax_turn_order,json=relaxTurnOrder,proto3" json:"relax_turn_order,omitempty"`
+	// Units may take the actions of their action_order in any order
+	RelaxActionOrder bool `protobuf:"varint,3,opt,name=relax_action_order,json=relaxActionOrder,proto3" json:"relax_action_order,omitempty"`
+	// Builds and constructions cost nothing
+	InfiniteCoins bool `protobuf:"varint,4,opt,name=infinite_coins,json=infiniteCoins,proto3" json:"infinite_coins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SandboxSettings) Reset() {
+	*x = SandboxSettings{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SandboxSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SandboxSettings) ProtoMessage() {}
+
+func (x *SandboxSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SandboxSettings.ProtoReflect.Descriptor instead.
+func (*SandboxSettings) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SandboxSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SandboxSettings) GetRelaxTurnOrder() bool {
+	if x != nil {
+		return x.RelaxTurnOrder
+	}
+	return false
+}
+
+func (x *SandboxSettings) GetRelaxActionOrder() bool {
+	if x != nil {
+		return x.RelaxActionOrder
+	}
+	return false
+}
+
+func (x *SandboxSettings) GetInfiniteCoins() bool {
+	if x != nil {
+		return x.InfiniteCoins
+	}
+	return false
+}
+
+// Draft configuration. Seats take turns, in player order, to first ban and
+// then pick unit types from the rules catalog.
+type DraftSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unit types each player bans. Banned units can't be built by anyone.
+	BansPerPlayer int32 `protobuf:"varint,1,opt,name=bans_per_player,json=bansPerPlayer,proto3" json:"bans_per_player,omitempty"`
+	// Unit types each player picks after the bans. Only the player that picked
+	// a unit type can build it.
+	PicksPerPlayer int32 `protobuf:"varint,2,opt,name=picks_per_player,json=picksPerPlayer,proto3" json:"picks_per_player,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DraftSettings) Reset() {
+	*x = DraftSettings{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftSettings) ProtoMessage() {}
+
+func (x *DraftSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftSettings.ProtoReflect.Descriptor instead.
+func (*DraftSettings) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DraftSettings) GetBansPerPlayer() int32 {
+	if x != nil {
+		return x.BansPerPlayer
+	}
+	return 0
+}
+
+func (x *DraftSettings) GetPicksPerPlayer() int32 {
+	if x != nil {
+		return x.PicksPerPlayer
+	}
+	return 0
+}
+
+// Time bank configuration. Each player starts with initial_seconds and gains
+// increment_seconds after each turn they complete in time.
+type TimeBankSettings struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InitialSeconds   int32                  `protobuf:"varint,1,opt,name=initial_seconds,json=initialSeconds,proto3" json:"initial_seconds,omitempty"`
+	IncrementSeconds int32                  `protobuf:"varint,2,opt,name=increment_seconds,json=incrementSeconds,proto3" json:"increment_seconds,omitempty"`
+	OnTimeout        TimeoutAction          `protobuf:"varint,3,opt,name=on_timeout,json=onTimeout,proto3,enum=lilbattle.v1.TimeoutAction" json:"on_timeout,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TimeBankSettings) Reset() {
+	*x = TimeBankSettings{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeBankSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeBankSettings) ProtoMessage() {}
+
+func (x *TimeBankSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeBankSettings.ProtoReflect.Descriptor instead.
+func (*TimeBankSettings) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TimeBankSettings) GetInitialSeconds() int32 {
+	if x != nil {
+		return x.InitialSeconds
+	}
+	return 0
+}
+
+func (x *TimeBankSettings) GetIncrementSeconds() int32 {
+	if x != nil {
+		return x.IncrementSeconds
+	}
+	return 0
+}
+
+func (x *TimeBankSettings) GetOnTimeout() TimeoutAction {
+	if x != nil {
+		return x.OnTimeout
+	}
+	return TimeoutAction_TIMEOUT_ACTION_AUTO_END_TURN
+}
+
+// Runtime state for a player during the game
+// This is separate from GamePlayer (which is player configuration)
+// PlayerState is indexed by player_id in the player_states map
+type PlayerState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current coin balance (changes during gameplay via building, income, etc.)
+	Coins int32 `protobuf:"varint,1,opt,name=coins,proto3" json:"coins,omitempty"`
+	// Whether player is still active in the game (not eliminated)
+	IsActive bool `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Remaining time bank in milliseconds as of GameState.clock_started_at
+	// (for the current player) or the end of their last turn (for others)
+	TimeBankMs int64 `protobuf:"varint,3,opt,name=time_bank_ms,json=timeBankMs,proto3" json:"time_bank_ms,omitempty"`
+	// Victory points scored so far from victory point markers
+	VictoryPoints int32 `protobuf:"varint,4,opt,name=victory_points,json=victoryPoints,proto3" json:"victory_points,omitempty"`
+	// Hexes the player has seen at some point in a fog of war game, as "q,r"
+	// keys, so explored areas can be shown dimmed rather than hidden
+	DiscoveredHexes []string `protobuf:"bytes,5,rep,name=discovered_hexes,json=discoveredHexes,proto3" json:"discovered_hexes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlayerState) Reset() {
+	*x = PlayerState{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerState) ProtoMessage() {}
+
+func (x *PlayerState) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerState.ProtoReflect.Descriptor instead.
+func (*PlayerState) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PlayerState) GetCoins() int32 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
+}
+
+func (x *PlayerState) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *PlayerState) GetTimeBankMs() int64 {
+	if x != nil {
+		return x.TimeBankMs
+	}
+	return 0
+}
+
+func (x *PlayerState) GetVictoryPoints() int32 {
+	if x != nil {
+		return x.VictoryPoints
+	}
+	return 0
+}
+
+func (x *PlayerState) GetDiscoveredHexes() []string {
+	if x != nil {
+		return x.DiscoveredHexes
+	}
+	return nil
+}
+
+// Holds the game's Active/Current state (eg world state)
+type GameState struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// ID of the game whos state is being tracked
+	GameId        string `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	TurnCounter   int32  `protobuf:"varint,4,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"`
+	CurrentPlayer int32  `protobuf:"varint,5,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
+	// Current world state
+	WorldData *WorldData `protobuf:"bytes,6,opt,name=world_data,json=worldData,proto3" json:"world_data,omitempty"`
+	// Current state hash for validation
+	StateHash string `protobuf:"bytes,8,opt,name=state_hash,json=stateHash,proto3" json:"state_hash,omitempty"`
+	// Version number for optimistic locking
+	Version int64      `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	Status  GameStatus `protobuf:"varint,10,opt,name=status,proto3,enum=lilbattle.v1.GameStatus" json:"status,omitempty"`
+	// Only set after a win has been possible
+	Finished           bool  `protobuf:"varint,11,opt,name=finished,proto3" json:"finished,omitempty"`
+	WinningPlayer      int32 `protobuf:"varint,12,opt,name=winning_player,json=winningPlayer,proto3" json:"winning_player,omitempty"`
+	WinningTeam        int32 `protobuf:"varint,13,opt,name=winning_team,json=winningTeam,proto3" json:"winning_team,omitempty"`
+	CurrentGroupNumber int64 `protobuf:"varint,14,opt,name=current_group_number,json=currentGroupNumber,proto3" json:"current_group_number,omitempty"`
+	// Per-player runtime state, keyed by player_id (1-based)
+	// This holds mutable player state like coins that changes during gameplay
+	PlayerStates map[int32]*PlayerState `protobuf:"bytes,15,rep,name=player_states,json=playerStates,proto3" json:"player_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// When the current player's time bank was last charged. Unset while the
+	// clock is paused or when time banks are disabled.
+	ClockStartedAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=clock_started_at,json=clockStartedAt,proto3" json:"clock_started_at,omitempty"`
+	// Whether the clock has been paused by agreement of all players
+	ClockPaused bool `protobuf:"varint,17,opt,name=clock_paused,json=clockPaused,proto3" json:"clock_paused,omitempty"`
+	// Players that have asked to pause the clock
+	PauseRequests []int32 `protobuf:"varint,18,rep,packed,name=pause_requests,json=pauseRequests,proto3" json:"pause_requests,omitempty"`
+	// Teammate controlling the rest of the current player's turn (0 = none).
+	// Revoked when the turn ends.
+	DelegatedTo int32 `protobuf:"varint,19,opt,name=delegated_to,json=delegatedTo,proto3" json:"delegated_to,omitempty"`
+	// Draft results, set when the game was created with a draft phase
+	Draft *DraftState `protobuf:"bytes,20,opt,name=draft,proto3" json:"draft,omitempty"`
+	// How a puzzle game ended (unset until it does)
+	PuzzleResult PuzzleResult `protobuf:"varint,21,opt,name=puzzle_result,json=puzzleResult,proto3,enum=lilbattle.v1.PuzzleResult" json:"puzzle_result,omitempty"`
+	// ID the next unit created in this game gets (see Unit.id)
+	NextUnitId int32 `protobuf:"varint,22,opt,name=next_unit_id,json=nextUnitId,proto3" json:"next_unit_id,omitempty"`
+	// Plans submitted for the current turn of a simultaneous turns game,
+	// kept until every player's plan is in and they resolve
+	TurnPlans     []*TurnPlan `protobuf:"bytes,23,rep,name=turn_plans,json=turnPlans,proto3" json:"turn_plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameState) Reset() {
+	*x = GameState{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState) ProtoMessage() {}
+
+func (x *GameState) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GameState) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GameState) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameState) GetTurnCounter() int32 {
+	if x != nil {
+		return x.TurnCounter
+	}
+	return 0
+}
+
+func (x *GameState) GetCurrentPlayer() int32 {
+	if x != nil {
+		return x.CurrentPlayer
+	}
+	return 0
+}
+
+func (x *GameState) GetWorldData() *WorldData {
+	if x != nil {
+		return x.WorldData
+	}
+	return nil
+}
+
+func (x *GameState) GetStateHash() string {
+	if x != nil {
+		return x.StateHash
+	}
+	return ""
+}
+
+func (x *GameState) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GameState) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *GameState) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *GameState) GetWinningPlayer() int32 {
+	if x != nil {
+		return x.WinningPlayer
+	}
+	return 0
+}
+
+func (x *GameState) GetWinningTeam() int32 {
+	if x != nil {
+		return x.WinningTeam
+	}
+	return 0
+}
+
+func (x *GameState) GetCurrentGroupNumber() int64 {
+	if x != nil {
+		return x.CurrentGroupNumber
+	}
+	return 0
+}
+
+func (x *GameState) GetPlayerStates() map[int32]*PlayerState {
+	if x != nil {
+		return x.PlayerStates
+	}
+	return nil
+}
+
+func (x *GameState) GetClockStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClockStartedAt
+	}
+	return nil
+}
+
+func (x *GameState) GetClockPaused() bool {
+	if x != nil {
+		return x.ClockPaused
+	}
+	return false
+}
+
+func (x *GameState) GetPauseRequests() []int32 {
+	if x != nil {
+		return x.PauseRequests
+	}
+	return nil
+}
+
+func (x *GameState) GetDelegatedTo() int32 {
+	if x != nil {
+		return x.DelegatedTo
+	}
+	return 0
+}
+
+func (x *GameState) GetDraft() *DraftState {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *GameState) GetPuzzleResult() PuzzleResult {
+	if x != nil {
+		return x.PuzzleResult
+	}
+	return PuzzleResult_PUZZLE_RESULT_UNSPECIFIED
+}
+
+func (x *GameState) GetNextUnitId() int32 {
+	if x != nil {
+		return x.NextUnitId
+	}
+	return 0
+}
+
+func (x *GameState) GetTurnPlans() []*TurnPlan {
+	if x != nil {
+		return x.TurnPlans
+	}
+	return nil
+}
+
+// A puzzle: the solver has to reach the goal from the game's starting
+// position within the turn budget, against a scripted opponent. The goal is
+// checked after every move; reaching it wins the game, and ending the last
+// turn of the budget without reaching it loses it.
+type PuzzleSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Goal in the assert condition language, eg "notexists unit B1" or
+	// "player 2 [unit_count==0]"
+	Goal string `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	// Turns the solver has to reach the goal in
+	TurnBudget int32 `protobuf:"varint,2,opt,name=turn_budget,json=turnBudget,proto3" json:"turn_budget,omitempty"`
+	// Player the solver plays as (1 if unset)
+	SolverPlayer int32 `protobuf:"varint,3,opt,name=solver_player,json=solverPlayer,proto3" json:"solver_player,omitempty"`
+	// The opponent's moves for each of its turns, in order. A move with a
+	// player is only played by that player.
+	OpponentTurns []*PuzzleOpponentTurn `protobuf:"bytes,4,rep,name=opponent_turns,json=opponentTurns,proto3" json:"opponent_turns,omitempty"`
+	// Whether the baseline AI plays the opponent's turns past the script.
+	// Otherwise those turns are ended without moves.
+	OpponentAi bool `protobuf:"varint,5,opt,name=opponent_ai,json=opponentAi,proto3" json:"opponent_ai,omitempty"`
+	// Curated puzzle the game was started from, if any
+	PuzzleId      string `protobuf:"bytes,6,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleSettings) Reset() {
+	*x = PuzzleSettings{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleSettings) ProtoMessage() {}
+
+func (x *PuzzleSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleSettings.ProtoReflect.Descriptor instead.
+func (*PuzzleSettings) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PuzzleSettings) GetGoal() string {
+	if x != nil {
+		return x.Goal
+	}
+	return ""
+}
+
+func (x *PuzzleSettings) GetTurnBudget() int32 {
+	if x != nil {
+		return x.TurnBudget
+	}
+	return 0
+}
+
+func (x *PuzzleSettings) GetSolverPlayer() int32 {
+	if x != nil {
+		return x.SolverPlayer
+	}
+	return 0
+}
+
+func (x *PuzzleSettings) GetOpponentTurns() []*PuzzleOpponentTurn {
+	if x != nil {
+		return x.OpponentTurns
+	}
+	return nil
+}
+
+func (x *PuzzleSettings) GetOpponentAi() bool {
+	if x != nil {
+		return x.OpponentAi
+	}
+	return false
+}
+
+func (x *PuzzleSettings) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+// The moves of one of a puzzle opponent's turns
+type PuzzleOpponentTurn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moves         []*GameMove            `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleOpponentTurn) Reset() {
+	*x = PuzzleOpponentTurn{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleOpponentTurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleOpponentTurn) ProtoMessage() {}
+
+func (x *PuzzleOpponentTurn) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleOpponentTurn.ProtoReflect.Descriptor instead.
+func (*PuzzleOpponentTurn) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PuzzleOpponentTurn) GetMoves() []*GameMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+// Progress and results of the pre-game draft
+type DraftState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unit types nobody can build
+	BannedUnits []int32 `protobuf:"varint,1,rep,packed,name=banned_units,json=bannedUnits,proto3" json:"banned_units,omitempty"`
+	// Unit types only one player can build, keyed by unit type
+	PickedUnits map[int32]int32 `protobuf:"bytes,2,rep,name=picked_units,json=pickedUnits,proto3" json:"picked_units,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Number of draft turns taken so far (including passes)
+	TurnsTaken    int32 `protobuf:"varint,3,opt,name=turns_taken,json=turnsTaken,proto3" json:"turns_taken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftState) Reset() {
+	*x = DraftState{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftState) ProtoMessage() {}
+
+func (x *DraftState) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftState.ProtoReflect.Descriptor instead.
+func (*DraftState) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DraftState) GetBannedUnits() []int32 {
+	if x != nil {
+		return x.BannedUnits
+	}
+	return nil
+}
+
+func (x *DraftState) GetPickedUnits() map[int32]int32 {
+	if x != nil {
+		return x.PickedUnits
+	}
+	return nil
+}
+
+func (x *DraftState) GetTurnsTaken() int32 {
+	if x != nil {
+		return x.TurnsTaken
+	}
+	return 0
+}
+
+// A player's locked in plan for a turn of a simultaneous turns game
+type TurnPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        int32                  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`
+	Moves         []*PlannedMove         `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurnPlan) Reset() {
+	*x = TurnPlan{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnPlan) ProtoMessage() {}
+
+func (x *TurnPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnPlan.ProtoReflect.Descriptor instead.
+func (*TurnPlan) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TurnPlan) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *TurnPlan) GetMoves() []*PlannedMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+// A move in a turn plan, with the unit making it so the move still finds
+// its unit if an earlier move left it somewhere other than planned
+type PlannedMove struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Move          *GameMove              `protobuf:"bytes,1,opt,name=move,proto3" json:"move,omitempty"`
+	UnitId        int32                  `protobuf:"varint,2,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedMove) Reset() {
+	*x = PlannedMove{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedMove) ProtoMessage() {}
+
+func (x *PlannedMove) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedMove.ProtoReflect.Descriptor instead.
+func (*PlannedMove) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PlannedMove) GetMove() *GameMove {
+	if x != nil {
+		return x.Move
+	}
+	return nil
+}
+
+func (x *PlannedMove) GetUnitId() int32 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+// Whether a game has stalled: no player can make contact with an enemy or
+// capture anything, so all that is left is ending turns
+type StuckAnalysis struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the game is stuck
+	Stuck bool `protobuf:"varint,1,opt,name=stuck,proto3" json:"stuck,omitempty"`
+	// Player the analysis was made for
+	Player int32 `protobuf:"varint,2,opt,name=player,proto3" json:"player,omitempty"`
+	// Units of the player that can still reach an enemy or a building to capture
+	ActionableUnits int32 `protobuf:"varint,3,opt,name=actionable_units,json=actionableUnits,proto3" json:"actionable_units,omitempty"`
+	// Units the player can afford to build (now or from their income) that
+	// could reach an enemy or a building to capture
+	AffordableBuilds int32 `protobuf:"varint,4,opt,name=affordable_builds,json=affordableBuilds,proto3" json:"affordable_builds,omitempty"`
+	// Buildings the player does not own that one of their units can reach and capture
+	IncomeGrowthPaths int32 `protobuf:"varint,5,opt,name=income_growth_paths,json=incomeGrowthPaths,proto3" json:"income_growth_paths,omitempty"`
+	// Whether no unit of any player can reach an opposing unit
+	ForcesUnreachable bool `protobuf:"varint,6,opt,name=forces_unreachable,json=forcesUnreachable,proto3" json:"forces_unreachable,omitempty"`
+	// Human readable explanation
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StuckAnalysis) Reset() {
+	*x = StuckAnalysis{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StuckAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StuckAnalysis) ProtoMessage() {}
+
+func (x *StuckAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StuckAnalysis.ProtoReflect.Descriptor instead.
+func (*StuckAnalysis) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StuckAnalysis) GetStuck() bool {
+	if x != nil {
+		return x.Stuck
+	}
+	return false
+}
+
+func (x *StuckAnalysis) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *StuckAnalysis) GetActionableUnits() int32 {
+	if x != nil {
+		return x.ActionableUnits
+	}
+	return 0
+}
+
+func (x *StuckAnalysis) GetAffordableBuilds() int32 {
+	if x != nil {
+		return x.AffordableBuilds
+	}
+	return 0
+}
+
+func (x *StuckAnalysis) GetIncomeGrowthPaths() int32 {
+	if x != nil {
+		return x.IncomeGrowthPaths
+	}
+	return 0
+}
+
+func (x *StuckAnalysis) GetForcesUnreachable() bool {
+	if x != nil {
+		return x.ForcesUnreachable
+	}
+	return false
+}
+
+func (x *StuckAnalysis) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Everything that changed between two states of a game, eg two turns
+type StateDiff struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	FromTurn int32                  `protobuf:"varint,1,opt,name=from_turn,json=fromTurn,proto3" json:"from_turn,omitempty"`
+	ToTurn   int32                  `protobuf:"varint,2,opt,name=to_turn,json=toTurn,proto3" json:"to_turn,omitempty"`
+	// Units added, removed or changed, by shortcut
+	Units []*UnitDiff `protobuf:"bytes,3,rep,name=units,proto3" json:"units,omitempty"`
+	// Tiles that changed owner
+	Tiles []*TileOwnerDiff `protobuf:"bytes,4,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	// Players whose coins or status changed
+	Players       []*PlayerDiff `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateDiff) Reset() {
+	*x = StateDiff{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateDiff) ProtoMessage() {}
+
+func (x *StateDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateDiff.ProtoReflect.Descriptor instead.
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StateDiff) GetFromTurn() int32 {
+	if x != nil {
+		return x.FromTurn
+	}
+	return 0
+}
+
+func (x *StateDiff) GetToTurn() int32 {
+	if x != nil {
+		return x.ToTurn
+	}
+	return 0
+}
+
+func (x *StateDiff) GetUnits() []*UnitDiff {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *StateDiff) GetTiles() []*TileOwnerDiff {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *StateDiff) GetPlayers() []*PlayerDiff {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type UnitDiff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  UnitDiffKind           `protobuf:"varint,1,opt,name=kind,proto3,enum=lilbattle.v1.UnitDiffKind" json:"kind,omitempty"`
+	// The unit in the first state (for transient units, as it was built)
+	Before *Unit `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	// The unit in the second state (for transient units, as it was killed)
+	After *Unit `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	// Fields that changed, for changed units
+	Deltas        []*FieldDelta `protobuf:"bytes,4,rep,name=deltas,proto3" json:"deltas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnitDiff) Reset() {
+	*x = UnitDiff{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitDiff) ProtoMessage() {}
+
+func (x *UnitDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitDiff.ProtoReflect.Descriptor instead.
+func (*UnitDiff) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UnitDiff) GetKind() UnitDiffKind {
+	if x != nil {
+		return x.Kind
+	}
+	return UnitDiffKind_UNIT_DIFF_KIND_UNSPECIFIED
+}
+
+func (x *UnitDiff) GetBefore() *Unit {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *UnitDiff) GetAfter() *Unit {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *UnitDiff) GetDeltas() []*FieldDelta {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+type FieldDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldDelta) Reset() {
+	*x = FieldDelta{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDelta) ProtoMessage() {}
+
+func (x *FieldDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDelta.ProtoReflect.Descriptor instead.
+func (*FieldDelta) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FieldDelta) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldDelta) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldDelta) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type TileOwnerDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	TileType      int32                  `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"`
+	PreviousOwner int32                  `protobuf:"varint,4,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	NewOwner      int32                  `protobuf:"varint,5,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TileOwnerDiff) Reset() {
+	*x = TileOwnerDiff{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TileOwnerDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TileOwnerDiff) ProtoMessage() {}
+
+func (x *TileOwnerDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TileOwnerDiff.ProtoReflect.Descriptor instead.
+func (*TileOwnerDiff) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TileOwnerDiff) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *TileOwnerDiff) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *TileOwnerDiff) GetTileType() int32 {
+	if x != nil {
+		return x.TileType
+	}
+	return 0
+}
+
+func (x *TileOwnerDiff) GetPreviousOwner() int32 {
+	if x != nil {
+		return x.PreviousOwner
+	}
+	return 0
+}
+
+func (x *TileOwnerDiff) GetNewOwner() int32 {
+	if x != nil {
+		return x.NewOwner
+	}
+	return 0
+}
+
+type PlayerDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PreviousCoins int32                  `protobuf:"varint,2,opt,name=previous_coins,json=previousCoins,proto3" json:"previous_coins,omitempty"`
+	NewCoins      int32                  `protobuf:"varint,3,opt,name=new_coins,json=newCoins,proto3" json:"new_coins,omitempty"`
+	WasActive     bool                   `protobuf:"varint,4,opt,name=was_active,json=wasActive,proto3" json:"was_active,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerDiff) Reset() {
+	*x = PlayerDiff{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDiff) ProtoMessage() {}
+
+func (x *PlayerDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDiff.ProtoReflect.Descriptor instead.
+func (*PlayerDiff) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PlayerDiff) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerDiff) GetPreviousCoins() int32 {
+	if x != nil {
+		return x.PreviousCoins
+	}
+	return 0
+}
+
+func (x *PlayerDiff) GetNewCoins() int32 {
+	if x != nil {
+		return x.NewCoins
+	}
+	return 0
+}
+
+func (x *PlayerDiff) GetWasActive() bool {
+	if x != nil {
+		return x.WasActive
+	}
+	return false
+}
+
+func (x *PlayerDiff) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+// Holds the game's move history (can be used as a replay log)
+type GameMoveHistory struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Move history for the game
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Each entry in our history is a "group" of moves
+	Groups        []*GameMoveGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMoveHistory) Reset() {
+	*x = GameMoveHistory{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMoveHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Unit) GetProgressionStep() int32 {
+func (*GameMoveHistory) ProtoMessage() {}
+
+func (x *GameMoveHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[47]
 	if x != nil {
-		return x.ProgressionStep
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Unit) GetChosenAlternative() string {
+// Deprecated: Use GameMoveHistory.ProtoReflect.Descriptor instead.
+func (*GameMoveHistory) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GameMoveHistory) GetGameId() string {
 	if x != nil {
-		return x.ChosenAlternative
+		return x.GameId
 	}
 	return ""
 }
 
-func (x *Unit) GetCaptureStartedTurn() int32 {
+func (x *GameMoveHistory) GetGroups() []*GameMoveGroup {
 	if x != nil {
-		return x.CaptureStartedTurn
+		return x.Groups
 	}
-	return 0
+	return nil
 }
 
-type AttackRecord struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`                                     // Attacker's Q coordinate
-	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`                                     // Attacker's R coordinate
-	IsRanged      bool                   `protobuf:"varint,3,opt,name=is_ranged,json=isRanged,proto3" json:"is_ranged,omitempty"`       // Whether attacker is ranged 2+ tiles away
-	TurnNumber    int32                  `protobuf:"varint,4,opt,name=turn_number,json=turnNumber,proto3" json:"turn_number,omitempty"` // Which turn this attack occured
+// A move group - we can allow X moves in one "tick"
+type GameMoveGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When the moves happened (or were submitted)
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	// Group number within the game - will be monotonically increasing
+	GroupNumber int64 `protobuf:"varint,4,opt,name=group_number,json=groupNumber,proto3" json:"group_number,omitempty"`
+	// *
+	// List of moves to add -
+	Moves         []*GameMove `protobuf:"bytes,5,rep,name=moves,proto3" json:"moves,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttackRecord) Reset() {
-	*x = AttackRecord{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[8]
+func (x *GameMoveGroup) Reset() {
+	*x = GameMoveGroup{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackRecord) String() string {
+func (x *GameMoveGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackRecord) ProtoMessage() {}
+func (*GameMoveGroup) ProtoMessage() {}
 
-func (x *AttackRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[8]
+func (x *GameMoveGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,72 +4967,69 @@ func (x *AttackRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackRecord.ProtoReflect.Descriptor instead.
-func (*AttackRecord) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GameMoveGroup.ProtoReflect.Descriptor instead.
+func (*GameMoveGroup) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *AttackRecord) GetQ() int32 {
+func (x *GameMoveGroup) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Q
+		return x.StartedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *AttackRecord) GetR() int32 {
+func (x *GameMoveGroup) GetEndedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.R
+		return x.EndedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *AttackRecord) GetIsRanged() bool {
+func (x *GameMoveGroup) GetGroupNumber() int64 {
 	if x != nil {
-		return x.IsRanged
+		return x.GroupNumber
 	}
-	return false
+	return 0
 }
 
-func (x *AttackRecord) GetTurnNumber() int32 {
+func (x *GameMoveGroup) GetMoves() []*GameMove {
 	if x != nil {
-		return x.TurnNumber
+		return x.Moves
 	}
-	return 0
+	return nil
 }
 
-// Rules engine terrain definition
-type TerrainDefinition struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`    // Terrain type ID
-	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Display name (e.g., "Grass", "Mountain")
-	// double base_move_cost = 3;     // Base movement cost
-	// double defense_bonus = 4;      // Defense bonus multiplier (0.0 to 1.0)
-	Type        int32  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`              // Terrain category type
-	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"` // Human-readable description
-	// How this terrain impacts
-	UnitProperties map[int32]*TerrainUnitProperties `protobuf:"bytes,7,rep,name=unit_properties,json=unitProperties,proto3" json:"unit_properties,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// List of units that can be built on this terrain
-	BuildableUnitIds []int32 `protobuf:"varint,8,rep,packed,name=buildable_unit_ids,json=buildableUnitIds,proto3" json:"buildable_unit_ids,omitempty"`
-	IncomePerTurn    int32   `protobuf:"varint,9,opt,name=income_per_turn,json=incomePerTurn,proto3" json:"income_per_turn,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// *
+// The state of a game at the start of a player's turn, saved so history
+// queries need not replay the game from the start
+type TurnSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TurnCounter   int32                  `protobuf:"varint,1,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"`
+	CurrentPlayer int32                  `protobuf:"varint,2,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
+	// Number of the last move group applied to the state
+	GroupNumber int64 `protobuf:"varint,3,opt,name=group_number,json=groupNumber,proto3" json:"group_number,omitempty"`
+	// The GameState, gzipped wire format
+	State         []byte `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TerrainDefinition) Reset() {
-	*x = TerrainDefinition{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[9]
+func (x *TurnSnapshot) Reset() {
+	*x = TurnSnapshot{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TerrainDefinition) String() string {
+func (x *TurnSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TerrainDefinition) ProtoMessage() {}
+func (*TurnSnapshot) ProtoMessage() {}
 
-func (x *TerrainDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[9]
+func (x *TurnSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,120 +5040,157 @@ func (x *TerrainDefinition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TerrainDefinition.ProtoReflect.Descriptor instead.
-func (*TerrainDefinition) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TurnSnapshot.ProtoReflect.Descriptor instead.
+func (*TurnSnapshot) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *TerrainDefinition) GetId() int32 {
+func (x *TurnSnapshot) GetTurnCounter() int32 {
 	if x != nil {
-		return x.Id
+		return x.TurnCounter
 	}
 	return 0
 }
 
-func (x *TerrainDefinition) GetName() string {
+func (x *TurnSnapshot) GetCurrentPlayer() int32 {
 	if x != nil {
-		return x.Name
+		return x.CurrentPlayer
 	}
-	return ""
+	return 0
 }
 
-func (x *TerrainDefinition) GetType() int32 {
+func (x *TurnSnapshot) GetGroupNumber() int64 {
 	if x != nil {
-		return x.Type
+		return x.GroupNumber
 	}
 	return 0
 }
 
-func (x *TerrainDefinition) GetDescription() string {
+func (x *TurnSnapshot) GetState() []byte {
 	if x != nil {
-		return x.Description
+		return x.State
 	}
-	return ""
+	return nil
 }
 
-func (x *TerrainDefinition) GetUnitProperties() map[int32]*TerrainUnitProperties {
+// *
+// A game's turn snapshots in turn and player order
+type TurnSnapshots struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Snapshots     []*TurnSnapshot        `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurnSnapshots) Reset() {
+	*x = TurnSnapshots{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnSnapshots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnSnapshots) ProtoMessage() {}
+
+func (x *TurnSnapshots) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[50]
 	if x != nil {
-		return x.UnitProperties
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *TerrainDefinition) GetBuildableUnitIds() []int32 {
+// Deprecated: Use TurnSnapshots.ProtoReflect.Descriptor instead.
+func (*TurnSnapshots) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TurnSnapshots) GetGameId() string {
 	if x != nil {
-		return x.BuildableUnitIds
+		return x.GameId
 	}
-	return nil
+	return ""
 }
 
-func (x *TerrainDefinition) GetIncomePerTurn() int32 {
+func (x *TurnSnapshots) GetSnapshots() []*TurnSnapshot {
 	if x != nil {
-		return x.IncomePerTurn
+		return x.Snapshots
 	}
-	return 0
+	return nil
 }
 
-// Rules engine unit definition
-type UnitDefinition struct {
-	state             protoimpl.MessageState           `protogen:"open.v1"`
-	Id                int32                            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                  // Unit type ID
-	Name              string                           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                               // Display name (e.g., "Infantry", "Tank")
-	Description       string                           `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                 //
-	Health            int32                            `protobuf:"varint,4,opt,name=health,proto3" json:"health,omitempty"`                                          // Maximum health points
-	Coins             int32                            `protobuf:"varint,5,opt,name=coins,proto3" json:"coins,omitempty"`                                            // how much it costs to build
-	MovementPoints    float64                          `protobuf:"fixed64,6,opt,name=movement_points,json=movementPoints,proto3" json:"movement_points,omitempty"`   // Maximum movement per turn
-	RetreatPoints     float64                          `protobuf:"fixed64,7,opt,name=retreat_points,json=retreatPoints,proto3" json:"retreat_points,omitempty"`      // Movement points available after attacking
-	Defense           int32                            `protobuf:"varint,8,opt,name=defense,proto3" json:"defense,omitempty"`                                        // Base defense value
-	AttackRange       int32                            `protobuf:"varint,9,opt,name=attack_range,json=attackRange,proto3" json:"attack_range,omitempty"`             // Max Attack range in tiles
-	MinAttackRange    int32                            `protobuf:"varint,10,opt,name=min_attack_range,json=minAttackRange,proto3" json:"min_attack_range,omitempty"` // Minimum attack range in tile radius if specified (otherwise - will be 1
-	SplashDamage      int32                            `protobuf:"varint,11,opt,name=splash_damage,json=splashDamage,proto3" json:"splash_damage,omitempty"`         // Splash damage amount
-	TerrainProperties map[int32]*TerrainUnitProperties `protobuf:"bytes,12,rep,name=terrain_properties,json=terrainProperties,proto3" json:"terrain_properties,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Properties        []string                         `protobuf:"bytes,13,rep,name=properties,proto3" json:"properties,omitempty"` // Special properties/abilities
-	// Unit classification for attack calculations
-	UnitClass   string `protobuf:"bytes,14,opt,name=unit_class,json=unitClass,proto3" json:"unit_class,omitempty"`       // "Light", "Heavy", or "Stealth"
-	UnitTerrain string `protobuf:"bytes,15,opt,name=unit_terrain,json=unitTerrain,proto3" json:"unit_terrain,omitempty"` // "Air", "Land", or "Water"
-	// Attack table: base attack values against different unit classes
-	// Key format: "Light:Air", "Heavy:Land", "Stealth:Water", etc.
-	// Value 0 or missing key means "n/a" (cannot attack)
-	AttackVsClass map[string]int32 `protobuf:"bytes,16,rep,name=attack_vs_class,json=attackVsClass,proto3" json:"attack_vs_class,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	// Ordered list of allowed actions this turn
-	// Examples:
-	//
-	//	["move", "attack"] - can move then attack
-	//	["move", "attack|capture"] - can move then either attack or capture
-	//	["attack"] - can only attack (no movement)
+// *
+// Represents a single move which can be one of many actions in the game
+type GameMove struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Player int32                  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"` // The player making the move
+	// Generated by the server
+	GroupNumber int64 `protobuf:"varint,2,opt,name=group_number,json=groupNumber,proto3" json:"group_number,omitempty"`
+	// Generated by the server - will be monotonically increasing within the group
+	MoveNumber int64                  `protobuf:"varint,3,opt,name=move_number,json=moveNumber,proto3" json:"move_number,omitempty"`
+	Timestamp  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are valid to be assigned to MoveType:
 	//
-	// Default if empty: ["move", "attack|capture"]
-	ActionOrder []string `protobuf:"bytes,17,rep,name=action_order,json=actionOrder,proto3" json:"action_order,omitempty"`
-	// How many times each action type can be performed per turn
-	// Key: action name, Value: max count
-	// Example: {"attack": 2} means can attack twice
-	// Default if not specified: 1 per action type
-	ActionLimits map[string]int32 `protobuf:"bytes,18,rep,name=action_limits,json=actionLimits,proto3" json:"action_limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	// Fix value for units that can repair other units (Medic, Engineer, etc.)
-	// Used in fix calculation: p = 0.05 * fix_value
-	// Default 0 means unit cannot fix
-	FixValue      int32 `protobuf:"varint,19,opt,name=fix_value,json=fixValue,proto3" json:"fix_value,omitempty"`
+	//	*GameMove_MoveUnit
+	//	*GameMove_AttackUnit
+	//	*GameMove_EndTurn
+	//	*GameMove_BuildUnit
+	//	*GameMove_CaptureBuilding
+	//	*GameMove_HealUnit
+	//	*GameMove_FixUnit
+	//	*GameMove_ConstructTerrain
+	//	*GameMove_SubmergeUnit
+	//	*GameMove_DelegateTurn
+	//	*GameMove_DraftUnit
+	//	*GameMove_TransformUnit
+	//	*GameMove_SubmitPlan
+	//	*GameMove_SpawnUnit
+	//	*GameMove_DeleteUnit
+	MoveType isGameMove_MoveType `protobuf_oneof:"move_type"`
+	// A monotonically increasing and unique (within the game) sequence number for the move
+	// This is generated by the server
+	SequenceNum int64 `protobuf:"varint,9,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	// Whether the result is permenant and can be undone.
+	// Just moving a unit for example is not permanent, but attacking a unit
+	// would be (ie a player cannot undo it).  This is also determined by the server/validator
+	IsPermanent bool `protobuf:"varint,10,opt,name=is_permanent,json=isPermanent,proto3" json:"is_permanent,omitempty"`
+	// The corresponding "result" for the move.  This can be "proposed" or can be evaluated.
+	// Keeping this colocated with the Move for consistency and simplicity
+	Changes []*WorldChange `protobuf:"bytes,11,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Human redable description for say recording "commands" if any
+	Description string `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	// Teammate that submitted this move on the player's behalf (0 = the player)
+	SubmittedBy int32 `protobuf:"varint,19,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	// Coach mode's verdict on the move. Only returned to the acting player in
+	// the ProcessMoves response; never stored or broadcast.
+	CoachVerdict  *CoachVerdict `protobuf:"bytes,20,opt,name=coach_verdict,json=coachVerdict,proto3" json:"coach_verdict,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnitDefinition) Reset() {
-	*x = UnitDefinition{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[10]
+func (x *GameMove) Reset() {
+	*x = GameMove{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnitDefinition) String() string {
+func (x *GameMove) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnitDefinition) ProtoMessage() {}
+func (*GameMove) ProtoMessage() {}
 
-func (x *UnitDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[10]
+func (x *GameMove) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1254,364 +5201,350 @@ func (x *UnitDefinition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnitDefinition.ProtoReflect.Descriptor instead.
-func (*UnitDefinition) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GameMove.ProtoReflect.Descriptor instead.
+func (*GameMove) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *UnitDefinition) GetId() int32 {
+func (x *GameMove) GetPlayer() int32 {
 	if x != nil {
-		return x.Id
+		return x.Player
 	}
 	return 0
 }
 
-func (x *UnitDefinition) GetName() string {
+func (x *GameMove) GetGroupNumber() int64 {
 	if x != nil {
-		return x.Name
+		return x.GroupNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *UnitDefinition) GetDescription() string {
+func (x *GameMove) GetMoveNumber() int64 {
 	if x != nil {
-		return x.Description
+		return x.MoveNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *UnitDefinition) GetHealth() int32 {
+func (x *GameMove) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Health
+		return x.Timestamp
 	}
-	return 0
+	return nil
 }
 
-func (x *UnitDefinition) GetCoins() int32 {
+func (x *GameMove) GetMoveType() isGameMove_MoveType {
 	if x != nil {
-		return x.Coins
+		return x.MoveType
 	}
-	return 0
+	return nil
 }
 
-func (x *UnitDefinition) GetMovementPoints() float64 {
+func (x *GameMove) GetMoveUnit() *MoveUnitAction {
 	if x != nil {
-		return x.MovementPoints
+		if x, ok := x.MoveType.(*GameMove_MoveUnit); ok {
+			return x.MoveUnit
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *UnitDefinition) GetRetreatPoints() float64 {
+func (x *GameMove) GetAttackUnit() *AttackUnitAction {
 	if x != nil {
-		return x.RetreatPoints
+		if x, ok := x.MoveType.(*GameMove_AttackUnit); ok {
+			return x.AttackUnit
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *UnitDefinition) GetDefense() int32 {
+func (x *GameMove) GetEndTurn() *EndTurnAction {
 	if x != nil {
-		return x.Defense
+		if x, ok := x.MoveType.(*GameMove_EndTurn); ok {
+			return x.EndTurn
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *UnitDefinition) GetAttackRange() int32 {
+func (x *GameMove) GetBuildUnit() *BuildUnitAction {
 	if x != nil {
-		return x.AttackRange
+		if x, ok := x.MoveType.(*GameMove_BuildUnit); ok {
+			return x.BuildUnit
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *UnitDefinition) GetMinAttackRange() int32 {
+func (x *GameMove) GetCaptureBuilding() *CaptureBuildingAction {
 	if x != nil {
-		return x.MinAttackRange
+		if x, ok := x.MoveType.(*GameMove_CaptureBuilding); ok {
+			return x.CaptureBuilding
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *UnitDefinition) GetSplashDamage() int32 {
+func (x *GameMove) GetHealUnit() *HealUnitAction {
 	if x != nil {
-		return x.SplashDamage
+		if x, ok := x.MoveType.(*GameMove_HealUnit); ok {
+			return x.HealUnit
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *UnitDefinition) GetTerrainProperties() map[int32]*TerrainUnitProperties {
+func (x *GameMove) GetFixUnit() *FixUnitAction {
 	if x != nil {
-		return x.TerrainProperties
+		if x, ok := x.MoveType.(*GameMove_FixUnit); ok {
+			return x.FixUnit
+		}
 	}
 	return nil
 }
 
-func (x *UnitDefinition) GetProperties() []string {
+func (x *GameMove) GetConstructTerrain() *ConstructTerrainAction {
 	if x != nil {
-		return x.Properties
+		if x, ok := x.MoveType.(*GameMove_ConstructTerrain); ok {
+			return x.ConstructTerrain
+		}
 	}
 	return nil
 }
 
-func (x *UnitDefinition) GetUnitClass() string {
+func (x *GameMove) GetSubmergeUnit() *SubmergeUnitAction {
 	if x != nil {
-		return x.UnitClass
+		if x, ok := x.MoveType.(*GameMove_SubmergeUnit); ok {
+			return x.SubmergeUnit
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *UnitDefinition) GetUnitTerrain() string {
+func (x *GameMove) GetDelegateTurn() *DelegateTurnAction {
 	if x != nil {
-		return x.UnitTerrain
+		if x, ok := x.MoveType.(*GameMove_DelegateTurn); ok {
+			return x.DelegateTurn
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *UnitDefinition) GetAttackVsClass() map[string]int32 {
+func (x *GameMove) GetDraftUnit() *DraftUnitAction {
 	if x != nil {
-		return x.AttackVsClass
+		if x, ok := x.MoveType.(*GameMove_DraftUnit); ok {
+			return x.DraftUnit
+		}
 	}
 	return nil
 }
 
-func (x *UnitDefinition) GetActionOrder() []string {
+func (x *GameMove) GetTransformUnit() *TransformUnitAction {
 	if x != nil {
-		return x.ActionOrder
+		if x, ok := x.MoveType.(*GameMove_TransformUnit); ok {
+			return x.TransformUnit
+		}
 	}
 	return nil
 }
 
-func (x *UnitDefinition) GetActionLimits() map[string]int32 {
+func (x *GameMove) GetSubmitPlan() *SubmitPlanAction {
 	if x != nil {
-		return x.ActionLimits
+		if x, ok := x.MoveType.(*GameMove_SubmitPlan); ok {
+			return x.SubmitPlan
+		}
 	}
 	return nil
 }
 
-func (x *UnitDefinition) GetFixValue() int32 {
+func (x *GameMove) GetSpawnUnit() *SpawnUnitAction {
 	if x != nil {
-		return x.FixValue
+		if x, ok := x.MoveType.(*GameMove_SpawnUnit); ok {
+			return x.SpawnUnit
+		}
 	}
-	return 0
-}
-
-// Properties that are specific to unit on a particular terrain
-type TerrainUnitProperties struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TerrainId      int32                  `protobuf:"varint,1,opt,name=terrain_id,json=terrainId,proto3" json:"terrain_id,omitempty"` // Renamed from tile_id for clarity
-	UnitId         int32                  `protobuf:"varint,2,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
-	MovementCost   float64                `protobuf:"fixed64,3,opt,name=movement_cost,json=movementCost,proto3" json:"movement_cost,omitempty"`         // Movement cost for this unit on this terrain
-	HealingBonus   int32                  `protobuf:"varint,4,opt,name=healing_bonus,json=healingBonus,proto3" json:"healing_bonus,omitempty"`          // How much healing per turn this tile would offer this unit
-	CanBuild       bool                   `protobuf:"varint,5,opt,name=can_build,json=canBuild,proto3" json:"can_build,omitempty"`                      // Whether this particular terrain can build this given unit
-	CanCapture     bool                   `protobuf:"varint,6,opt,name=can_capture,json=canCapture,proto3" json:"can_capture,omitempty"`                // Whether this particular unit can capture this terrain/building type
-	AttackBonus    int32                  `protobuf:"varint,7,opt,name=attack_bonus,json=attackBonus,proto3" json:"attack_bonus,omitempty"`             // How much more attack this terrain gives to this unit
-	DefenseBonus   int32                  `protobuf:"varint,8,opt,name=defense_bonus,json=defenseBonus,proto3" json:"defense_bonus,omitempty"`          // How much more defense this terrain gives to this unit
-	AttackRange    int32                  `protobuf:"varint,9,opt,name=attack_range,json=attackRange,proto3" json:"attack_range,omitempty"`             // Max Attack range in tiles
-	MinAttackRange int32                  `protobuf:"varint,10,opt,name=min_attack_range,json=minAttackRange,proto3" json:"min_attack_range,omitempty"` // Minimum attack range in tile radius if specified (otherwise - will be 1
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *TerrainUnitProperties) Reset() {
-	*x = TerrainUnitProperties{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *TerrainUnitProperties) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*TerrainUnitProperties) ProtoMessage() {}
-
-func (x *TerrainUnitProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[11]
+func (x *GameMove) GetDeleteUnit() *DeleteUnitAction {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.MoveType.(*GameMove_DeleteUnit); ok {
+			return x.DeleteUnit
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TerrainUnitProperties.ProtoReflect.Descriptor instead.
-func (*TerrainUnitProperties) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{11}
+	return nil
 }
 
-func (x *TerrainUnitProperties) GetTerrainId() int32 {
+func (x *GameMove) GetSequenceNum() int64 {
 	if x != nil {
-		return x.TerrainId
+		return x.SequenceNum
 	}
 	return 0
 }
 
-func (x *TerrainUnitProperties) GetUnitId() int32 {
+func (x *GameMove) GetIsPermanent() bool {
 	if x != nil {
-		return x.UnitId
+		return x.IsPermanent
 	}
-	return 0
+	return false
 }
 
-func (x *TerrainUnitProperties) GetMovementCost() float64 {
+func (x *GameMove) GetChanges() []*WorldChange {
 	if x != nil {
-		return x.MovementCost
+		return x.Changes
 	}
-	return 0
+	return nil
 }
 
-func (x *TerrainUnitProperties) GetHealingBonus() int32 {
+func (x *GameMove) GetDescription() string {
 	if x != nil {
-		return x.HealingBonus
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *TerrainUnitProperties) GetCanBuild() bool {
+func (x *GameMove) GetSubmittedBy() int32 {
 	if x != nil {
-		return x.CanBuild
+		return x.SubmittedBy
 	}
-	return false
+	return 0
 }
 
-func (x *TerrainUnitProperties) GetCanCapture() bool {
+func (x *GameMove) GetCoachVerdict() *CoachVerdict {
 	if x != nil {
-		return x.CanCapture
+		return x.CoachVerdict
 	}
-	return false
+	return nil
 }
 
-func (x *TerrainUnitProperties) GetAttackBonus() int32 {
-	if x != nil {
-		return x.AttackBonus
-	}
-	return 0
+type isGameMove_MoveType interface {
+	isGameMove_MoveType()
 }
 
-func (x *TerrainUnitProperties) GetDefenseBonus() int32 {
-	if x != nil {
-		return x.DefenseBonus
-	}
-	return 0
+type GameMove_MoveUnit struct {
+	MoveUnit *MoveUnitAction `protobuf:"bytes,5,opt,name=move_unit,json=moveUnit,proto3,oneof"`
 }
 
-func (x *TerrainUnitProperties) GetAttackRange() int32 {
-	if x != nil {
-		return x.AttackRange
-	}
-	return 0
+type GameMove_AttackUnit struct {
+	AttackUnit *AttackUnitAction `protobuf:"bytes,6,opt,name=attack_unit,json=attackUnit,proto3,oneof"`
 }
 
-func (x *TerrainUnitProperties) GetMinAttackRange() int32 {
-	if x != nil {
-		return x.MinAttackRange
-	}
-	return 0
+type GameMove_EndTurn struct {
+	EndTurn *EndTurnAction `protobuf:"bytes,7,opt,name=end_turn,json=endTurn,proto3,oneof"`
 }
 
-// Properties for unit-vs-unit combat interactions
-type UnitUnitProperties struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	AttackerId      int32                  `protobuf:"varint,1,opt,name=attacker_id,json=attackerId,proto3" json:"attacker_id,omitempty"`
-	DefenderId      int32                  `protobuf:"varint,2,opt,name=defender_id,json=defenderId,proto3" json:"defender_id,omitempty"`
-	AttackOverride  *int32                 `protobuf:"varint,3,opt,name=attack_override,json=attackOverride,proto3,oneof" json:"attack_override,omitempty"`    // Optional attack override for this Attacker/Defender combo
-	DefenseOverride *int32                 `protobuf:"varint,4,opt,name=defense_override,json=defenseOverride,proto3,oneof" json:"defense_override,omitempty"` // Optional defense override for this Attacker/Defender combo
-	Damage          *DamageDistribution    `protobuf:"bytes,5,opt,name=damage,proto3" json:"damage,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GameMove_BuildUnit struct {
+	BuildUnit *BuildUnitAction `protobuf:"bytes,8,opt,name=build_unit,json=buildUnit,proto3,oneof"`
 }
 
-func (x *UnitUnitProperties) Reset() {
-	*x = UnitUnitProperties{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+type GameMove_CaptureBuilding struct {
+	CaptureBuilding *CaptureBuildingAction `protobuf:"bytes,13,opt,name=capture_building,json=captureBuilding,proto3,oneof"`
 }
 
-func (x *UnitUnitProperties) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type GameMove_HealUnit struct {
+	HealUnit *HealUnitAction `protobuf:"bytes,14,opt,name=heal_unit,json=healUnit,proto3,oneof"`
 }
 
-func (*UnitUnitProperties) ProtoMessage() {}
+type GameMove_FixUnit struct {
+	FixUnit *FixUnitAction `protobuf:"bytes,15,opt,name=fix_unit,json=fixUnit,proto3,oneof"`
+}
 
-func (x *UnitUnitProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[12]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type GameMove_ConstructTerrain struct {
+	ConstructTerrain *ConstructTerrainAction `protobuf:"bytes,16,opt,name=construct_terrain,json=constructTerrain,proto3,oneof"`
 }
 
-// Deprecated: Use UnitUnitProperties.ProtoReflect.Descriptor instead.
-func (*UnitUnitProperties) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{12}
+type GameMove_SubmergeUnit struct {
+	SubmergeUnit *SubmergeUnitAction `protobuf:"bytes,17,opt,name=submerge_unit,json=submergeUnit,proto3,oneof"`
+}
+
+type GameMove_DelegateTurn struct {
+	DelegateTurn *DelegateTurnAction `protobuf:"bytes,18,opt,name=delegate_turn,json=delegateTurn,proto3,oneof"`
 }
 
-func (x *UnitUnitProperties) GetAttackerId() int32 {
-	if x != nil {
-		return x.AttackerId
-	}
-	return 0
+type GameMove_DraftUnit struct {
+	DraftUnit *DraftUnitAction `protobuf:"bytes,21,opt,name=draft_unit,json=draftUnit,proto3,oneof"`
 }
 
-func (x *UnitUnitProperties) GetDefenderId() int32 {
-	if x != nil {
-		return x.DefenderId
-	}
-	return 0
+type GameMove_TransformUnit struct {
+	TransformUnit *TransformUnitAction `protobuf:"bytes,22,opt,name=transform_unit,json=transformUnit,proto3,oneof"`
 }
 
-func (x *UnitUnitProperties) GetAttackOverride() int32 {
-	if x != nil && x.AttackOverride != nil {
-		return *x.AttackOverride
-	}
-	return 0
+type GameMove_SubmitPlan struct {
+	SubmitPlan *SubmitPlanAction `protobuf:"bytes,23,opt,name=submit_plan,json=submitPlan,proto3,oneof"`
 }
 
-func (x *UnitUnitProperties) GetDefenseOverride() int32 {
-	if x != nil && x.DefenseOverride != nil {
-		return *x.DefenseOverride
-	}
-	return 0
+type GameMove_SpawnUnit struct {
+	SpawnUnit *SpawnUnitAction `protobuf:"bytes,24,opt,name=spawn_unit,json=spawnUnit,proto3,oneof"`
 }
 
-func (x *UnitUnitProperties) GetDamage() *DamageDistribution {
-	if x != nil {
-		return x.Damage
-	}
-	return nil
+type GameMove_DeleteUnit struct {
+	DeleteUnit *DeleteUnitAction `protobuf:"bytes,25,opt,name=delete_unit,json=deleteUnit,proto3,oneof"`
 }
 
-// Damage distribution for combat calculations
-type DamageDistribution struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	MinDamage      float64                `protobuf:"fixed64,1,opt,name=min_damage,json=minDamage,proto3" json:"min_damage,omitempty"`
-	MaxDamage      float64                `protobuf:"fixed64,2,opt,name=max_damage,json=maxDamage,proto3" json:"max_damage,omitempty"`
-	ExpectedDamage float64                `protobuf:"fixed64,3,opt,name=expected_damage,json=expectedDamage,proto3" json:"expected_damage,omitempty"`
-	Ranges         []*DamageRange         `protobuf:"bytes,4,rep,name=ranges,proto3" json:"ranges,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (*GameMove_MoveUnit) isGameMove_MoveType() {}
+
+func (*GameMove_AttackUnit) isGameMove_MoveType() {}
+
+func (*GameMove_EndTurn) isGameMove_MoveType() {}
+
+func (*GameMove_BuildUnit) isGameMove_MoveType() {}
+
+func (*GameMove_CaptureBuilding) isGameMove_MoveType() {}
+
+func (*GameMove_HealUnit) isGameMove_MoveType() {}
+
+func (*GameMove_FixUnit) isGameMove_MoveType() {}
+
+func (*GameMove_ConstructTerrain) isGameMove_MoveType() {}
+
+func (*GameMove_SubmergeUnit) isGameMove_MoveType() {}
+
+func (*GameMove_DelegateTurn) isGameMove_MoveType() {}
+
+func (*GameMove_DraftUnit) isGameMove_MoveType() {}
+
+func (*GameMove_TransformUnit) isGameMove_MoveType() {}
+
+func (*GameMove_SubmitPlan) isGameMove_MoveType() {}
+
+func (*GameMove_SpawnUnit) isGameMove_MoveType() {}
+
+func (*GameMove_DeleteUnit) isGameMove_MoveType() {}
+
+// Coach mode's assessment of a move
+type CoachVerdict struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the move looks like a mistake
+	Flagged bool `protobuf:"varint,1,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	// Short human readable explanation
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Enemy units that can attack the acting unit next turn
+	ThreatenedBy int32 `protobuf:"varint,3,opt,name=threatened_by,json=threatenedBy,proto3" json:"threatened_by,omitempty"`
+	// Damage the acting unit is expected to take if they all attack it
+	ExpectedDamage float64 `protobuf:"fixed64,4,opt,name=expected_damage,json=expectedDamage,proto3" json:"expected_damage,omitempty"`
+	// Position score for the acting player before and after the move
+	ScoreBefore   float64 `protobuf:"fixed64,5,opt,name=score_before,json=scoreBefore,proto3" json:"score_before,omitempty"`
+	ScoreAfter    float64 `protobuf:"fixed64,6,opt,name=score_after,json=scoreAfter,proto3" json:"score_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DamageDistribution) Reset() {
-	*x = DamageDistribution{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[13]
+func (x *CoachVerdict) Reset() {
+	*x = CoachVerdict{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DamageDistribution) String() string {
+func (x *CoachVerdict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DamageDistribution) ProtoMessage() {}
+func (*CoachVerdict) ProtoMessage() {}
 
-func (x *DamageDistribution) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[13]
+func (x *CoachVerdict) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1622,63 +5555,82 @@ func (x *DamageDistribution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DamageDistribution.ProtoReflect.Descriptor instead.
-func (*DamageDistribution) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CoachVerdict.ProtoReflect.Descriptor instead.
+func (*CoachVerdict) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *DamageDistribution) GetMinDamage() float64 {
+func (x *CoachVerdict) GetFlagged() bool {
 	if x != nil {
-		return x.MinDamage
+		return x.Flagged
 	}
-	return 0
+	return false
 }
 
-func (x *DamageDistribution) GetMaxDamage() float64 {
+func (x *CoachVerdict) GetMessage() string {
 	if x != nil {
-		return x.MaxDamage
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CoachVerdict) GetThreatenedBy() int32 {
+	if x != nil {
+		return x.ThreatenedBy
 	}
 	return 0
 }
 
-func (x *DamageDistribution) GetExpectedDamage() float64 {
+func (x *CoachVerdict) GetExpectedDamage() float64 {
 	if x != nil {
 		return x.ExpectedDamage
 	}
 	return 0
 }
 
-func (x *DamageDistribution) GetRanges() []*DamageRange {
+func (x *CoachVerdict) GetScoreBefore() float64 {
 	if x != nil {
-		return x.Ranges
+		return x.ScoreBefore
 	}
-	return nil
+	return 0
 }
 
-type DamageRange struct {
+func (x *CoachVerdict) GetScoreAfter() float64 {
+	if x != nil {
+		return x.ScoreAfter
+	}
+	return 0
+}
+
+// A unified "Position" type that can be used to
+// specify locations via "string shortcuts" like A1, "3,2", "r2,4" (for row/col)
+// or even "relative" positions like "L,TL,TR,R"  in the shortcut field.
+// Or string q/r coordinates in the q and r fields.  This can also be used
+// in the "response" to resolve a shortcut -> q,r
+type Position struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MinValue      float64                `protobuf:"fixed64,1,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
-	MaxValue      float64                `protobuf:"fixed64,2,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
-	Probability   float64                `protobuf:"fixed64,3,opt,name=probability,proto3" json:"probability,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Q             int32                  `protobuf:"varint,2,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,3,opt,name=r,proto3" json:"r,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DamageRange) Reset() {
-	*x = DamageRange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[14]
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DamageRange) String() string {
+func (x *Position) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DamageRange) ProtoMessage() {}
+func (*Position) ProtoMessage() {}
 
-func (x *DamageRange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[14]
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,65 +5641,64 @@ func (x *DamageRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DamageRange.ProtoReflect.Descriptor instead.
-func (*DamageRange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *DamageRange) GetMinValue() float64 {
+func (x *Position) GetLabel() string {
 	if x != nil {
-		return x.MinValue
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-func (x *DamageRange) GetMaxValue() float64 {
+func (x *Position) GetQ() int32 {
 	if x != nil {
-		return x.MaxValue
+		return x.Q
 	}
 	return 0
 }
 
-func (x *DamageRange) GetProbability() float64 {
+func (x *Position) GetR() int32 {
 	if x != nil {
-		return x.Probability
+		return x.R
 	}
 	return 0
 }
 
-// Main rules engine definition - centralized source of truth
-type RulesEngine struct {
+// *
+// Move unit from one position to another
+type MoveUnitAction struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Core entity definitions
-	Units    map[int32]*UnitDefinition    `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Terrains map[int32]*TerrainDefinition `protobuf:"bytes,2,rep,name=terrains,proto3" json:"terrains,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Centralized property definitions (source of truth)
-	// Key format: "terrain_id:unit_id" (e.g., "1:3" for terrain 1, unit 3)
-	TerrainUnitProperties map[string]*TerrainUnitProperties `protobuf:"bytes,3,rep,name=terrain_unit_properties,json=terrainUnitProperties,proto3" json:"terrain_unit_properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Key format: "attacker_id:defender_id" (e.g., "1:2" for unit 1 attacking unit 2)
-	UnitUnitProperties map[string]*UnitUnitProperties `protobuf:"bytes,4,rep,name=unit_unit_properties,json=unitUnitProperties,proto3" json:"unit_unit_properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Terrain type classifications (terrain_id -> TerrainType)
-	// Used to determine if a terrain is city, nature, bridge, water, or road
-	TerrainTypes  map[int32]TerrainType `protobuf:"bytes,5,rep,name=terrain_types,json=terrainTypes,proto3" json:"terrain_types,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=lilbattle.v1.TerrainType"`
+	From  *Position              `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *Position              `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Optional fields that can be used for showing move options as well as debugging
+	MovementCost float64 `protobuf:"fixed64,3,opt,name=movement_cost,json=movementCost,proto3" json:"movement_cost,omitempty"`
+	// Debug fields
+	ReconstructedPath *Path `protobuf:"bytes,4,opt,name=reconstructed_path,json=reconstructedPath,proto3" json:"reconstructed_path,omitempty"`
+	// Hexes currently hidden by fog of war that the unit would see from the
+	// destination (only set in options when fog is enabled)
+	RevealCount   int32 `protobuf:"varint,5,opt,name=reveal_count,json=revealCount,proto3" json:"reveal_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RulesEngine) Reset() {
-	*x = RulesEngine{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[15]
+func (x *MoveUnitAction) Reset() {
+	*x = MoveUnitAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RulesEngine) String() string {
+func (x *MoveUnitAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RulesEngine) ProtoMessage() {}
+func (*MoveUnitAction) ProtoMessage() {}
 
-func (x *RulesEngine) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[15]
+func (x *MoveUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,94 +5709,76 @@ func (x *RulesEngine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RulesEngine.ProtoReflect.Descriptor instead.
-func (*RulesEngine) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MoveUnitAction.ProtoReflect.Descriptor instead.
+func (*MoveUnitAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *RulesEngine) GetUnits() map[int32]*UnitDefinition {
+func (x *MoveUnitAction) GetFrom() *Position {
 	if x != nil {
-		return x.Units
+		return x.From
 	}
 	return nil
 }
 
-func (x *RulesEngine) GetTerrains() map[int32]*TerrainDefinition {
+func (x *MoveUnitAction) GetTo() *Position {
 	if x != nil {
-		return x.Terrains
+		return x.To
 	}
 	return nil
 }
 
-func (x *RulesEngine) GetTerrainUnitProperties() map[string]*TerrainUnitProperties {
+func (x *MoveUnitAction) GetMovementCost() float64 {
 	if x != nil {
-		return x.TerrainUnitProperties
+		return x.MovementCost
 	}
-	return nil
+	return 0
 }
 
-func (x *RulesEngine) GetUnitUnitProperties() map[string]*UnitUnitProperties {
+func (x *MoveUnitAction) GetReconstructedPath() *Path {
 	if x != nil {
-		return x.UnitUnitProperties
+		return x.ReconstructedPath
 	}
 	return nil
 }
 
-func (x *RulesEngine) GetTerrainTypes() map[int32]TerrainType {
+func (x *MoveUnitAction) GetRevealCount() int32 {
 	if x != nil {
-		return x.TerrainTypes
+		return x.RevealCount
 	}
-	return nil
+	return 0
 }
 
-// Describes a game and its metadata
-type Game struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// Version number for optimistic locking
-	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	// Unique ID for the game
-	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	// User who started/created the game
-	CreatorId string `protobuf:"bytes,5,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	// The world this game was created from
-	WorldId string `protobuf:"bytes,6,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
-	// Name if items have names
-	Name string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	// Description if game has a description
-	Description string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	// Some tags
-	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	// A possible image url
-	ImageUrl string `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	// Difficulty - example attribute
-	Difficulty string `protobuf:"bytes,11,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	// Game configuration
-	Config *GameConfiguration `protobuf:"bytes,12,opt,name=config,proto3" json:"config,omitempty"`
-	// URL to screenshot/preview image (defaults to /games/{id}/screenshots/{screenshotName})
-	// Can be overridden to point to CDN or external hosting
-	PreviewUrls     []string   `protobuf:"bytes,13,rep,name=preview_urls,json=previewUrls,proto3" json:"preview_urls,omitempty"`
-	SearchIndexInfo *IndexInfo `protobuf:"bytes,15,opt,name=search_index_info,json=searchIndexInfo,proto3" json:"search_index_info,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// *
+// Attack with one unit against another
+type AttackUnitAction struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Attacker *Position              `protobuf:"bytes,1,opt,name=attacker,proto3" json:"attacker,omitempty"`
+	Defender *Position              `protobuf:"bytes,2,opt,name=defender,proto3" json:"defender,omitempty"`
+	// Optional fields for presenting during "options" and debugging
+	TargetUnitType   int32 `protobuf:"varint,7,opt,name=target_unit_type,json=targetUnitType,proto3" json:"target_unit_type,omitempty"`
+	TargetUnitHealth int32 `protobuf:"varint,8,opt,name=target_unit_health,json=targetUnitHealth,proto3" json:"target_unit_health,omitempty"`
+	CanAttack        bool  `protobuf:"varint,9,opt,name=can_attack,json=canAttack,proto3" json:"can_attack,omitempty"`
+	DamageEstimate   int32 `protobuf:"varint,10,opt,name=damage_estimate,json=damageEstimate,proto3" json:"damage_estimate,omitempty"` // Estimated damage this attack would deal
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Game) Reset() {
-	*x = Game{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[16]
+func (x *AttackUnitAction) Reset() {
+	*x = AttackUnitAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Game) String() string {
+func (x *AttackUnitAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Game) ProtoMessage() {}
+func (*AttackUnitAction) ProtoMessage() {}
 
-func (x *Game) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[16]
+func (x *AttackUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1856,138 +5789,140 @@ func (x *Game) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Game.ProtoReflect.Descriptor instead.
-func (*Game) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *Game) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use AttackUnitAction.ProtoReflect.Descriptor instead.
+func (*AttackUnitAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AttackUnitAction) GetAttacker() *Position {
 	if x != nil {
-		return x.CreatedAt
+		return x.Attacker
 	}
 	return nil
 }
 
-func (x *Game) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *AttackUnitAction) GetDefender() *Position {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Defender
 	}
 	return nil
 }
 
-func (x *Game) GetVersion() int64 {
+func (x *AttackUnitAction) GetTargetUnitType() int32 {
 	if x != nil {
-		return x.Version
+		return x.TargetUnitType
 	}
 	return 0
 }
 
-func (x *Game) GetId() string {
+func (x *AttackUnitAction) GetTargetUnitHealth() int32 {
 	if x != nil {
-		return x.Id
+		return x.TargetUnitHealth
 	}
-	return ""
+	return 0
 }
 
-func (x *Game) GetCreatorId() string {
+func (x *AttackUnitAction) GetCanAttack() bool {
 	if x != nil {
-		return x.CreatorId
+		return x.CanAttack
 	}
-	return ""
+	return false
 }
 
-func (x *Game) GetWorldId() string {
+func (x *AttackUnitAction) GetDamageEstimate() int32 {
 	if x != nil {
-		return x.WorldId
+		return x.DamageEstimate
 	}
-	return ""
+	return 0
 }
 
-func (x *Game) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// *
+// An action to build a unit (at a city tile)
+type BuildUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"`
+	UnitType      int32                  `protobuf:"varint,2,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	Cost          int32                  `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Game) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *BuildUnitAction) Reset() {
+	*x = BuildUnitAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Game) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *BuildUnitAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Game) GetImageUrl() string {
+func (*BuildUnitAction) ProtoMessage() {}
+
+func (x *BuildUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[56]
 	if x != nil {
-		return x.ImageUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Game) GetDifficulty() string {
-	if x != nil {
-		return x.Difficulty
-	}
-	return ""
+// Deprecated: Use BuildUnitAction.ProtoReflect.Descriptor instead.
+func (*BuildUnitAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *Game) GetConfig() *GameConfiguration {
+func (x *BuildUnitAction) GetPos() *Position {
 	if x != nil {
-		return x.Config
+		return x.Pos
 	}
 	return nil
 }
 
-func (x *Game) GetPreviewUrls() []string {
+func (x *BuildUnitAction) GetUnitType() int32 {
 	if x != nil {
-		return x.PreviewUrls
+		return x.UnitType
 	}
-	return nil
+	return 0
 }
 
-func (x *Game) GetSearchIndexInfo() *IndexInfo {
+func (x *BuildUnitAction) GetCost() int32 {
 	if x != nil {
-		return x.SearchIndexInfo
+		return x.Cost
 	}
-	return nil
+	return 0
 }
 
-type GameConfiguration struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Player configuration
-	Players []*GamePlayer `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
-	// Team configuration
-	Teams []*GameTeam `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
-	// Various kinds of per turn income configs
-	IncomeConfigs *IncomeConfig `protobuf:"bytes,3,opt,name=income_configs,json=incomeConfigs,proto3" json:"income_configs,omitempty"`
-	// Game settings
-	Settings      *GameSettings `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+// *
+// A move where a unit can capture a building
+type CaptureBuildingAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"`
+	TileType      int32                  `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameConfiguration) Reset() {
-	*x = GameConfiguration{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[17]
+func (x *CaptureBuildingAction) Reset() {
+	*x = CaptureBuildingAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameConfiguration) String() string {
+func (x *CaptureBuildingAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameConfiguration) ProtoMessage() {}
+func (*CaptureBuildingAction) ProtoMessage() {}
 
-func (x *GameConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[17]
+func (x *CaptureBuildingAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,74 +5933,56 @@ func (x *GameConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameConfiguration.ProtoReflect.Descriptor instead.
-func (*GameConfiguration) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *GameConfiguration) GetPlayers() []*GamePlayer {
-	if x != nil {
-		return x.Players
-	}
-	return nil
-}
-
-func (x *GameConfiguration) GetTeams() []*GameTeam {
-	if x != nil {
-		return x.Teams
-	}
-	return nil
+// Deprecated: Use CaptureBuildingAction.ProtoReflect.Descriptor instead.
+func (*CaptureBuildingAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GameConfiguration) GetIncomeConfigs() *IncomeConfig {
+func (x *CaptureBuildingAction) GetPos() *Position {
 	if x != nil {
-		return x.IncomeConfigs
+		return x.Pos
 	}
 	return nil
 }
 
-func (x *GameConfiguration) GetSettings() *GameSettings {
+func (x *CaptureBuildingAction) GetTileType() int32 {
 	if x != nil {
-		return x.Settings
+		return x.TileType
 	}
-	return nil
+	return 0
 }
 
-type IncomeConfig struct {
+// *
+// End current player's turn
+type EndTurnAction struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// How much starting coins to give each player at the start of the agme
-	StartingCoins int32 `protobuf:"varint,1,opt,name=starting_coins,json=startingCoins,proto3" json:"starting_coins,omitempty"`
-	// Income each player just for being in the game each turn
-	GameIncome int32 `protobuf:"varint,2,opt,name=game_income,json=gameIncome,proto3" json:"game_income,omitempty"`
-	// Income from each landbase per turn
-	LandbaseIncome int32 `protobuf:"varint,3,opt,name=landbase_income,json=landbaseIncome,proto3" json:"landbase_income,omitempty"`
-	// Income from each navalbase per turn
-	NavalbaseIncome int32 `protobuf:"varint,4,opt,name=navalbase_income,json=navalbaseIncome,proto3" json:"navalbase_income,omitempty"`
-	// Income from each airport base per turn
-	AirportbaseIncome int32 `protobuf:"varint,5,opt,name=airportbase_income,json=airportbaseIncome,proto3" json:"airportbase_income,omitempty"`
-	// Income from each missile silo per turn
-	MissilesiloIncome int32 `protobuf:"varint,6,opt,name=missilesilo_income,json=missilesiloIncome,proto3" json:"missilesilo_income,omitempty"`
-	// Income from each mine per turn
-	MinesIncome   int32 `protobuf:"varint,7,opt,name=mines_income,json=minesIncome,proto3" json:"mines_income,omitempty"`
+	// End the turn even if mandatory actions are still pending
+	Force bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"`
+	// The turn the client believes it is ending (its turn counter and current
+	// player).  An EndTurn sent for any other turn is rejected, so a click
+	// racing the time bank or a retried request can't end the next player's
+	// turn too.  Left unset (0) the turn isn't checked.
+	TurnCounter   int32 `protobuf:"varint,2,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"`
+	Player        int32 `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncomeConfig) Reset() {
-	*x = IncomeConfig{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[18]
+func (x *EndTurnAction) Reset() {
+	*x = EndTurnAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncomeConfig) String() string {
+func (x *EndTurnAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncomeConfig) ProtoMessage() {}
+func (*EndTurnAction) ProtoMessage() {}
 
-func (x *IncomeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[18]
+func (x *EndTurnAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2076,97 +5993,129 @@ func (x *IncomeConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncomeConfig.ProtoReflect.Descriptor instead.
-func (*IncomeConfig) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use EndTurnAction.ProtoReflect.Descriptor instead.
+func (*EndTurnAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *IncomeConfig) GetStartingCoins() int32 {
+func (x *EndTurnAction) GetForce() bool {
 	if x != nil {
-		return x.StartingCoins
+		return x.Force
 	}
-	return 0
+	return false
 }
 
-func (x *IncomeConfig) GetGameIncome() int32 {
+func (x *EndTurnAction) GetTurnCounter() int32 {
 	if x != nil {
-		return x.GameIncome
+		return x.TurnCounter
 	}
 	return 0
 }
 
-func (x *IncomeConfig) GetLandbaseIncome() int32 {
+func (x *EndTurnAction) GetPlayer() int32 {
 	if x != nil {
-		return x.LandbaseIncome
+		return x.Player
 	}
 	return 0
 }
 
-func (x *IncomeConfig) GetNavalbaseIncome() int32 {
-	if x != nil {
-		return x.NavalbaseIncome
-	}
-	return 0
+// *
+// Something a player is expected to do before ending their turn, such as
+// retreating a unit after it attacked
+type TurnObligation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // The obligation's action, eg "retreat"
+	Unit          *Position              `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"` // The unit with the obligation
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Mandatory     bool                   `protobuf:"varint,4,opt,name=mandatory,proto3" json:"mandatory,omitempty"` // The rules require it before ending the turn
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncomeConfig) GetAirportbaseIncome() int32 {
+func (x *TurnObligation) Reset() {
+	*x = TurnObligation{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnObligation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnObligation) ProtoMessage() {}
+
+func (x *TurnObligation) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[59]
 	if x != nil {
-		return x.AirportbaseIncome
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *IncomeConfig) GetMissilesiloIncome() int32 {
+// Deprecated: Use TurnObligation.ProtoReflect.Descriptor instead.
+func (*TurnObligation) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TurnObligation) GetKind() string {
 	if x != nil {
-		return x.MissilesiloIncome
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *IncomeConfig) GetMinesIncome() int32 {
+func (x *TurnObligation) GetUnit() *Position {
 	if x != nil {
-		return x.MinesIncome
+		return x.Unit
 	}
-	return 0
+	return nil
 }
 
-type GamePlayer struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Player ID (1-based)
-	PlayerId int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	// ID of the system user that is assigned to this game player.  This is the "auth" user
-	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Player type
-	PlayerType string `protobuf:"bytes,3,opt,name=player_type,json=playerType,proto3" json:"player_type,omitempty"` // "human", "ai", "open"
-	// Player color
-	Color string `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
-	// Team ID (0 = no team, 1+ = team number)
-	TeamId int32 `protobuf:"varint,5,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	// Nickname for the player in this game
-	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	// Whether play is still in the game - can this just be inferred?
-	IsActive bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	// How many coins the player started off with
-	StartingCoins int32 `protobuf:"varint,8,opt,name=starting_coins,json=startingCoins,proto3" json:"starting_coins,omitempty"`
+func (x *TurnObligation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TurnObligation) GetMandatory() bool {
+	if x != nil {
+		return x.Mandatory
+	}
+	return false
+}
+
+// *
+// Heal a unit - player manually chooses to heal instead of attacking/moving
+// Auto-healing at turn start is handled separately in TopUpUnitIfNeeded
+type HealUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"`                                  // Position of unit to heal
+	HealAmount    int32                  `protobuf:"varint,2,opt,name=heal_amount,json=healAmount,proto3" json:"heal_amount,omitempty"` // Amount of health to restore
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GamePlayer) Reset() {
-	*x = GamePlayer{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[19]
+func (x *HealUnitAction) Reset() {
+	*x = HealUnitAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GamePlayer) String() string {
+func (x *HealUnitAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GamePlayer) ProtoMessage() {}
+func (*HealUnitAction) ProtoMessage() {}
 
-func (x *GamePlayer) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[19]
+func (x *HealUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2177,96 +6126,105 @@ func (x *GamePlayer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GamePlayer.ProtoReflect.Descriptor instead.
-func (*GamePlayer) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use HealUnitAction.ProtoReflect.Descriptor instead.
+func (*HealUnitAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GamePlayer) GetPlayerId() int32 {
+func (x *HealUnitAction) GetPos() *Position {
 	if x != nil {
-		return x.PlayerId
+		return x.Pos
 	}
-	return 0
+	return nil
 }
 
-func (x *GamePlayer) GetUserId() string {
+func (x *HealUnitAction) GetHealAmount() int32 {
 	if x != nil {
-		return x.UserId
+		return x.HealAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *GamePlayer) GetPlayerType() string {
-	if x != nil {
-		return x.PlayerType
-	}
-	return ""
+// *
+// Transform a unit into another unit type its definition allows
+type TransformUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"` // Position of unit to transform
+	NewUnitType   int32                  `protobuf:"varint,2,opt,name=new_unit_type,json=newUnitType,proto3" json:"new_unit_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GamePlayer) GetColor() string {
-	if x != nil {
-		return x.Color
-	}
-	return ""
+func (x *TransformUnitAction) Reset() {
+	*x = TransformUnitAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GamePlayer) GetTeamId() int32 {
-	if x != nil {
-		return x.TeamId
-	}
-	return 0
+func (x *TransformUnitAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GamePlayer) GetName() string {
+func (*TransformUnitAction) ProtoMessage() {}
+
+func (x *TransformUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[61]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GamePlayer) GetIsActive() bool {
+// Deprecated: Use TransformUnitAction.ProtoReflect.Descriptor instead.
+func (*TransformUnitAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TransformUnitAction) GetPos() *Position {
 	if x != nil {
-		return x.IsActive
+		return x.Pos
 	}
-	return false
+	return nil
 }
 
-func (x *GamePlayer) GetStartingCoins() int32 {
+func (x *TransformUnitAction) GetNewUnitType() int32 {
 	if x != nil {
-		return x.StartingCoins
+		return x.NewUnitType
 	}
 	return 0
 }
 
-type GameTeam struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the team within the game (unique to the game)
-	TeamId int32 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	// Name of the team - in a game
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Just a color for this team
-	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
-	// Whether team has active players - can also be inferred
-	IsActive      bool `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+// *
+// Sandbox only: place a unit of any type for any player on an empty tile
+type SpawnUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"` // Position to place the unit at
+	UnitType      int32                  `protobuf:"varint,2,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	Player        int32                  `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"` // Owner of the unit (0 = the current player)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameTeam) Reset() {
-	*x = GameTeam{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[20]
+func (x *SpawnUnitAction) Reset() {
+	*x = SpawnUnitAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameTeam) String() string {
+func (x *SpawnUnitAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameTeam) ProtoMessage() {}
+func (*SpawnUnitAction) ProtoMessage() {}
 
-func (x *GameTeam) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[20]
+func (x *SpawnUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2277,68 +6235,56 @@ func (x *GameTeam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameTeam.ProtoReflect.Descriptor instead.
-func (*GameTeam) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *GameTeam) GetTeamId() int32 {
-	if x != nil {
-		return x.TeamId
-	}
-	return 0
+// Deprecated: Use SpawnUnitAction.ProtoReflect.Descriptor instead.
+func (*SpawnUnitAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GameTeam) GetName() string {
+func (x *SpawnUnitAction) GetPos() *Position {
 	if x != nil {
-		return x.Name
+		return x.Pos
 	}
-	return ""
+	return nil
 }
 
-func (x *GameTeam) GetColor() string {
+func (x *SpawnUnitAction) GetUnitType() int32 {
 	if x != nil {
-		return x.Color
+		return x.UnitType
 	}
-	return ""
+	return 0
 }
 
-func (x *GameTeam) GetIsActive() bool {
+func (x *SpawnUnitAction) GetPlayer() int32 {
 	if x != nil {
-		return x.IsActive
+		return x.Player
 	}
-	return false
+	return 0
 }
 
-type GameSettings struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of allowed unit type IDs
-	AllowedUnits []int32 `protobuf:"varint,1,rep,packed,name=allowed_units,json=allowedUnits,proto3" json:"allowed_units,omitempty"`
-	// Turn time limit in seconds (0 = no limit)
-	TurnTimeLimit int32 `protobuf:"varint,2,opt,name=turn_time_limit,json=turnTimeLimit,proto3" json:"turn_time_limit,omitempty"`
-	// Team mode
-	TeamMode string `protobuf:"bytes,3,opt,name=team_mode,json=teamMode,proto3" json:"team_mode,omitempty"` // "ffa" or "teams"
-	// Maximum number of turns (0 = unlimited)
-	MaxTurns      int32 `protobuf:"varint,4,opt,name=max_turns,json=maxTurns,proto3" json:"max_turns,omitempty"`
+// *
+// Sandbox only: remove a unit from the board
+type DeleteUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"` // Position of unit to remove
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameSettings) Reset() {
-	*x = GameSettings{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[21]
+func (x *DeleteUnitAction) Reset() {
+	*x = DeleteUnitAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameSettings) String() string {
+func (x *DeleteUnitAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameSettings) ProtoMessage() {}
+func (*DeleteUnitAction) ProtoMessage() {}
 
-func (x *GameSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[21]
+func (x *DeleteUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2349,67 +6295,45 @@ func (x *GameSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameSettings.ProtoReflect.Descriptor instead.
-func (*GameSettings) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DeleteUnitAction.ProtoReflect.Descriptor instead.
+func (*DeleteUnitAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GameSettings) GetAllowedUnits() []int32 {
+func (x *DeleteUnitAction) GetPos() *Position {
 	if x != nil {
-		return x.AllowedUnits
+		return x.Pos
 	}
 	return nil
 }
 
-func (x *GameSettings) GetTurnTimeLimit() int32 {
-	if x != nil {
-		return x.TurnTimeLimit
-	}
-	return 0
-}
-
-func (x *GameSettings) GetTeamMode() string {
-	if x != nil {
-		return x.TeamMode
-	}
-	return ""
-}
-
-func (x *GameSettings) GetMaxTurns() int32 {
-	if x != nil {
-		return x.MaxTurns
-	}
-	return 0
-}
-
-// Runtime state for a player during the game
-// This is separate from GamePlayer (which is player configuration)
-// PlayerState is indexed by player_id in the player_states map
-type PlayerState struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Current coin balance (changes during gameplay via building, income, etc.)
-	Coins int32 `protobuf:"varint,1,opt,name=coins,proto3" json:"coins,omitempty"`
-	// Whether player is still active in the game (not eliminated)
-	IsActive      bool `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+// *
+// Fix (repair) another friendly unit - used by Medic, Engineer, Stratotanker, Tugboat, Aircraft Carrier
+// The fixer must be adjacent to the target unit
+type FixUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fixer         *Position              `protobuf:"bytes,1,opt,name=fixer,proto3" json:"fixer,omitempty"`                           // Position of the unit doing the fixing
+	Target        *Position              `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`                         // Position of the friendly unit being fixed
+	FixAmount     int32                  `protobuf:"varint,3,opt,name=fix_amount,json=fixAmount,proto3" json:"fix_amount,omitempty"` // Amount of health to restore (optional, server calculates if not provided)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerState) Reset() {
-	*x = PlayerState{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[22]
+func (x *FixUnitAction) Reset() {
+	*x = FixUnitAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerState) String() string {
+func (x *FixUnitAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerState) ProtoMessage() {}
+func (*FixUnitAction) ProtoMessage() {}
 
-func (x *PlayerState) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[22]
+func (x *FixUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2420,67 +6344,63 @@ func (x *PlayerState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerState.ProtoReflect.Descriptor instead.
-func (*PlayerState) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use FixUnitAction.ProtoReflect.Descriptor instead.
+func (*FixUnitAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *PlayerState) GetCoins() int32 {
+func (x *FixUnitAction) GetFixer() *Position {
 	if x != nil {
-		return x.Coins
+		return x.Fixer
 	}
-	return 0
+	return nil
 }
 
-func (x *PlayerState) GetIsActive() bool {
+func (x *FixUnitAction) GetTarget() *Position {
 	if x != nil {
-		return x.IsActive
+		return x.Target
 	}
-	return false
+	return nil
 }
 
-// Holds the game's Active/Current state (eg world state)
-type GameState struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// ID of the game whos state is being tracked
-	GameId        string `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	TurnCounter   int32  `protobuf:"varint,4,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"`
-	CurrentPlayer int32  `protobuf:"varint,5,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
-	// Current world state
-	WorldData *WorldData `protobuf:"bytes,6,opt,name=world_data,json=worldData,proto3" json:"world_data,omitempty"`
-	// Current state hash for validation
-	StateHash string `protobuf:"bytes,8,opt,name=state_hash,json=stateHash,proto3" json:"state_hash,omitempty"`
-	// Version number for optimistic locking
-	Version int64      `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
-	Status  GameStatus `protobuf:"varint,10,opt,name=status,proto3,enum=lilbattle.v1.GameStatus" json:"status,omitempty"`
-	// Only set after a win has been possible
-	Finished           bool  `protobuf:"varint,11,opt,name=finished,proto3" json:"finished,omitempty"`
-	WinningPlayer      int32 `protobuf:"varint,12,opt,name=winning_player,json=winningPlayer,proto3" json:"winning_player,omitempty"`
-	WinningTeam        int32 `protobuf:"varint,13,opt,name=winning_team,json=winningTeam,proto3" json:"winning_team,omitempty"`
-	CurrentGroupNumber int64 `protobuf:"varint,14,opt,name=current_group_number,json=currentGroupNumber,proto3" json:"current_group_number,omitempty"`
-	// Per-player runtime state, keyed by player_id (1-based)
-	// This holds mutable player state like coins that changes during gameplay
-	PlayerStates  map[int32]*PlayerState `protobuf:"bytes,15,rep,name=player_states,json=playerStates,proto3" json:"player_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *FixUnitAction) GetFixAmount() int32 {
+	if x != nil {
+		return x.FixAmount
+	}
+	return 0
+}
+
+// *
+// Construct terrain (eg a bridge) on a tile adjacent to the constructing unit.
+// Coins are paid up front and the tile converts after the configured number of turns
+// as long as the unit stays in place.
+type ConstructTerrainAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"`                                           // Position of the constructing unit
+	Target        *Position              `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`                                     // Tile being converted
+	TargetTerrain int32                  `protobuf:"varint,3,opt,name=target_terrain,json=targetTerrain,proto3" json:"target_terrain,omitempty"` // Terrain type the tile becomes
+	Cost          int32                  `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`                                        // Coins paid (filled in by the server)
+	Turns         int32                  `protobuf:"varint,5,opt,name=turns,proto3" json:"turns,omitempty"`                                      // Turns until completion (filled in by the server)
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                           // Human readable summary, eg "build bridge R (2 turns, 150c)"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameState) Reset() {
-	*x = GameState{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[23]
+func (x *ConstructTerrainAction) Reset() {
+	*x = ConstructTerrainAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameState) String() string {
+func (x *ConstructTerrainAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameState) ProtoMessage() {}
+func (*ConstructTerrainAction) ProtoMessage() {}
 
-func (x *GameState) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[23]
+func (x *ConstructTerrainAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2491,128 +6411,132 @@ func (x *GameState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
-func (*GameState) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ConstructTerrainAction.ProtoReflect.Descriptor instead.
+func (*ConstructTerrainAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GameState) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ConstructTerrainAction) GetPos() *Position {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Pos
 	}
 	return nil
 }
 
-func (x *GameState) GetGameId() string {
+func (x *ConstructTerrainAction) GetTarget() *Position {
 	if x != nil {
-		return x.GameId
+		return x.Target
 	}
-	return ""
+	return nil
 }
 
-func (x *GameState) GetTurnCounter() int32 {
+func (x *ConstructTerrainAction) GetTargetTerrain() int32 {
 	if x != nil {
-		return x.TurnCounter
+		return x.TargetTerrain
 	}
 	return 0
 }
 
-func (x *GameState) GetCurrentPlayer() int32 {
+func (x *ConstructTerrainAction) GetCost() int32 {
 	if x != nil {
-		return x.CurrentPlayer
+		return x.Cost
 	}
 	return 0
 }
 
-func (x *GameState) GetWorldData() *WorldData {
+func (x *ConstructTerrainAction) GetTurns() int32 {
 	if x != nil {
-		return x.WorldData
+		return x.Turns
 	}
-	return nil
+	return 0
 }
 
-func (x *GameState) GetStateHash() string {
+func (x *ConstructTerrainAction) GetDescription() string {
 	if x != nil {
-		return x.StateHash
+		return x.Description
 	}
 	return ""
 }
 
-func (x *GameState) GetVersion() int64 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
+// *
+// Submerge or surface a Stealth-class unit (requires stealth_enabled in the
+// game settings).  Does not use up the unit's move or attack.
+type SubmergeUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"`            // Position of the unit
+	Submerge      bool                   `protobuf:"varint,2,opt,name=submerge,proto3" json:"submerge,omitempty"` // True to submerge, false to surface
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameState) GetStatus() GameStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GameStatus_GAME_STATUS_UNSPECIFIED
+func (x *SubmergeUnitAction) Reset() {
+	*x = SubmergeUnitAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameState) GetFinished() bool {
-	if x != nil {
-		return x.Finished
-	}
-	return false
+func (x *SubmergeUnitAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GameState) GetWinningPlayer() int32 {
+func (*SubmergeUnitAction) ProtoMessage() {}
+
+func (x *SubmergeUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[66]
 	if x != nil {
-		return x.WinningPlayer
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GameState) GetWinningTeam() int32 {
-	if x != nil {
-		return x.WinningTeam
-	}
-	return 0
+// Deprecated: Use SubmergeUnitAction.ProtoReflect.Descriptor instead.
+func (*SubmergeUnitAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GameState) GetCurrentGroupNumber() int64 {
+func (x *SubmergeUnitAction) GetPos() *Position {
 	if x != nil {
-		return x.CurrentGroupNumber
+		return x.Pos
 	}
-	return 0
+	return nil
 }
 
-func (x *GameState) GetPlayerStates() map[int32]*PlayerState {
+func (x *SubmergeUnitAction) GetSubmerge() bool {
 	if x != nil {
-		return x.PlayerStates
+		return x.Submerge
 	}
-	return nil
+	return false
 }
 
-// Holds the game's move history (can be used as a replay log)
-type GameMoveHistory struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Move history for the game
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// Each entry in our history is a "group" of moves
-	Groups        []*GameMoveGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// *
+// Hand the rest of the current turn over to a teammate (team games only)
+type DelegateTurnAction struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DelegatePlayerId int32                  `protobuf:"varint,1,opt,name=delegate_player_id,json=delegatePlayerId,proto3" json:"delegate_player_id,omitempty"` // Teammate taking over the turn
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GameMoveHistory) Reset() {
-	*x = GameMoveHistory{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[24]
+func (x *DelegateTurnAction) Reset() {
+	*x = DelegateTurnAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameMoveHistory) String() string {
+func (x *DelegateTurnAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameMoveHistory) ProtoMessage() {}
+func (*DelegateTurnAction) ProtoMessage() {}
 
-func (x *GameMoveHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[24]
+func (x *DelegateTurnAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2623,55 +6547,43 @@ func (x *GameMoveHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameMoveHistory.ProtoReflect.Descriptor instead.
-func (*GameMoveHistory) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *GameMoveHistory) GetGameId() string {
-	if x != nil {
-		return x.GameId
-	}
-	return ""
+// Deprecated: Use DelegateTurnAction.ProtoReflect.Descriptor instead.
+func (*DelegateTurnAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GameMoveHistory) GetGroups() []*GameMoveGroup {
+func (x *DelegateTurnAction) GetDelegatePlayerId() int32 {
 	if x != nil {
-		return x.Groups
+		return x.DelegatePlayerId
 	}
-	return nil
+	return 0
 }
 
-// A move group - we can allow X moves in one "tick"
-type GameMoveGroup struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// When the moves happened (or were submitted)
-	StartedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	EndedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
-	// Group number within the game - will be monotonically increasing
-	GroupNumber int64 `protobuf:"varint,4,opt,name=group_number,json=groupNumber,proto3" json:"group_number,omitempty"`
-	// *
-	// List of moves to add -
-	Moves         []*GameMove `protobuf:"bytes,5,rep,name=moves,proto3" json:"moves,omitempty"`
+// *
+// Ban or pick a unit type during the pre-game draft
+type DraftUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnitType      int32                  `protobuf:"varint,1,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"` // Unit type to ban or pick (0 = pass)
+	Pick          bool                   `protobuf:"varint,2,opt,name=pick,proto3" json:"pick,omitempty"`                         // True to pick, false to ban
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameMoveGroup) Reset() {
-	*x = GameMoveGroup{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[25]
+func (x *DraftUnitAction) Reset() {
+	*x = DraftUnitAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameMoveGroup) String() string {
+func (x *DraftUnitAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameMoveGroup) ProtoMessage() {}
+func (*DraftUnitAction) ProtoMessage() {}
 
-func (x *GameMoveGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[25]
+func (x *DraftUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2682,33 +6594,66 @@ func (x *GameMoveGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameMoveGroup.ProtoReflect.Descriptor instead.
-func (*GameMoveGroup) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DraftUnitAction.ProtoReflect.Descriptor instead.
+func (*DraftUnitAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GameMoveGroup) GetStartedAt() *timestamppb.Timestamp {
+func (x *DraftUnitAction) GetUnitType() int32 {
 	if x != nil {
-		return x.StartedAt
+		return x.UnitType
 	}
-	return nil
+	return 0
 }
 
-func (x *GameMoveGroup) GetEndedAt() *timestamppb.Timestamp {
+func (x *DraftUnitAction) GetPick() bool {
 	if x != nil {
-		return x.EndedAt
+		return x.Pick
 	}
-	return nil
+	return false
 }
 
-func (x *GameMoveGroup) GetGroupNumber() int64 {
+// *
+// Lock in the player's whole turn in a simultaneous turns game: moves and
+// attacks, in the order the player wants them made
+type SubmitPlanAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moves         []*GameMove            `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitPlanAction) Reset() {
+	*x = SubmitPlanAction{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitPlanAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPlanAction) ProtoMessage() {}
+
+func (x *SubmitPlanAction) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[69]
 	if x != nil {
-		return x.GroupNumber
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GameMoveGroup) GetMoves() []*GameMove {
+// Deprecated: Use SubmitPlanAction.ProtoReflect.Descriptor instead.
+func (*SubmitPlanAction) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SubmitPlanAction) GetMoves() []*GameMove {
 	if x != nil {
 		return x.Moves
 	}
@@ -2716,56 +6661,52 @@ func (x *GameMoveGroup) GetMoves() []*GameMove {
 }
 
 // *
-// Represents a single move which can be one of many actions in the game
-type GameMove struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	Player int32                  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"` // The player making the move
-	// Generated by the server
-	GroupNumber int64 `protobuf:"varint,2,opt,name=group_number,json=groupNumber,proto3" json:"group_number,omitempty"`
-	// Generated by the server - will be monotonically increasing within the group
-	MoveNumber int64                  `protobuf:"varint,3,opt,name=move_number,json=moveNumber,proto3" json:"move_number,omitempty"`
-	Timestamp  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Types that are valid to be assigned to MoveType:
+// Represents a change to the game world
+type WorldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When did this change happen
 	//
-	//	*GameMove_MoveUnit
-	//	*GameMove_AttackUnit
-	//	*GameMove_EndTurn
-	//	*GameMove_BuildUnit
-	//	*GameMove_CaptureBuilding
-	//	*GameMove_HealUnit
-	//	*GameMove_FixUnit
-	MoveType isGameMove_MoveType `protobuf_oneof:"move_type"`
-	// A monotonically increasing and unique (within the game) sequence number for the move
-	// This is generated by the server
-	SequenceNum int64 `protobuf:"varint,9,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
-	// Whether the result is permenant and can be undone.
-	// Just moving a unit for example is not permanent, but attacking a unit
-	// would be (ie a player cannot undo it).  This is also determined by the server/validator
-	IsPermanent bool `protobuf:"varint,10,opt,name=is_permanent,json=isPermanent,proto3" json:"is_permanent,omitempty"`
-	// The corresponding "result" for the move.  This can be "proposed" or can be evaluated.
-	// Keeping this colocated with the Move for consistency and simplicity
-	Changes []*WorldChange `protobuf:"bytes,11,rep,name=changes,proto3" json:"changes,omitempty"`
-	// Human redable description for say recording "commands" if any
-	Description   string `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	// Types that are valid to be assigned to ChangeType:
+	//
+	//	*WorldChange_UnitMoved
+	//	*WorldChange_UnitDamaged
+	//	*WorldChange_UnitKilled
+	//	*WorldChange_PlayerChanged
+	//	*WorldChange_UnitBuilt
+	//	*WorldChange_CoinsChanged
+	//	*WorldChange_TileCaptured
+	//	*WorldChange_CaptureStarted
+	//	*WorldChange_UnitHealed
+	//	*WorldChange_UnitFixed
+	//	*WorldChange_TerrainChanged
+	//	*WorldChange_UnitSubmerged
+	//	*WorldChange_TurnDelegated
+	//	*WorldChange_UnitDrafted
+	//	*WorldChange_GameEvent
+	//	*WorldChange_UnitTransformed
+	//	*WorldChange_VictoryPointsScored
+	//	*WorldChange_UnitAttacked
+	//	*WorldChange_PlanSubmitted
+	ChangeType    isWorldChange_ChangeType `protobuf_oneof:"change_type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameMove) Reset() {
-	*x = GameMove{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[26]
+func (x *WorldChange) Reset() {
+	*x = WorldChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameMove) String() string {
+func (x *WorldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameMove) ProtoMessage() {}
+func (*WorldChange) ProtoMessage() {}
 
-func (x *GameMove) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[26]
+func (x *WorldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,212 +6717,332 @@ func (x *GameMove) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameMove.ProtoReflect.Descriptor instead.
-func (*GameMove) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use WorldChange.ProtoReflect.Descriptor instead.
+func (*WorldChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *WorldChange) GetChangeType() isWorldChange_ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return nil
+}
+
+func (x *WorldChange) GetUnitMoved() *UnitMovedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_UnitMoved); ok {
+			return x.UnitMoved
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetUnitDamaged() *UnitDamagedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_UnitDamaged); ok {
+			return x.UnitDamaged
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetUnitKilled() *UnitKilledChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_UnitKilled); ok {
+			return x.UnitKilled
+		}
+	}
+	return nil
 }
 
-func (x *GameMove) GetPlayer() int32 {
+func (x *WorldChange) GetPlayerChanged() *PlayerChangedChange {
 	if x != nil {
-		return x.Player
+		if x, ok := x.ChangeType.(*WorldChange_PlayerChanged); ok {
+			return x.PlayerChanged
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *GameMove) GetGroupNumber() int64 {
+func (x *WorldChange) GetUnitBuilt() *UnitBuiltChange {
 	if x != nil {
-		return x.GroupNumber
+		if x, ok := x.ChangeType.(*WorldChange_UnitBuilt); ok {
+			return x.UnitBuilt
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *GameMove) GetMoveNumber() int64 {
+func (x *WorldChange) GetCoinsChanged() *CoinsChangedChange {
 	if x != nil {
-		return x.MoveNumber
+		if x, ok := x.ChangeType.(*WorldChange_CoinsChanged); ok {
+			return x.CoinsChanged
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *GameMove) GetTimestamp() *timestamppb.Timestamp {
+func (x *WorldChange) GetTileCaptured() *TileCapturedChange {
 	if x != nil {
-		return x.Timestamp
+		if x, ok := x.ChangeType.(*WorldChange_TileCaptured); ok {
+			return x.TileCaptured
+		}
 	}
 	return nil
 }
 
-func (x *GameMove) GetMoveType() isGameMove_MoveType {
+func (x *WorldChange) GetCaptureStarted() *CaptureStartedChange {
 	if x != nil {
-		return x.MoveType
+		if x, ok := x.ChangeType.(*WorldChange_CaptureStarted); ok {
+			return x.CaptureStarted
+		}
 	}
 	return nil
 }
 
-func (x *GameMove) GetMoveUnit() *MoveUnitAction {
+func (x *WorldChange) GetUnitHealed() *UnitHealedChange {
 	if x != nil {
-		if x, ok := x.MoveType.(*GameMove_MoveUnit); ok {
-			return x.MoveUnit
+		if x, ok := x.ChangeType.(*WorldChange_UnitHealed); ok {
+			return x.UnitHealed
 		}
 	}
 	return nil
 }
 
-func (x *GameMove) GetAttackUnit() *AttackUnitAction {
+func (x *WorldChange) GetUnitFixed() *UnitFixedChange {
 	if x != nil {
-		if x, ok := x.MoveType.(*GameMove_AttackUnit); ok {
-			return x.AttackUnit
+		if x, ok := x.ChangeType.(*WorldChange_UnitFixed); ok {
+			return x.UnitFixed
 		}
 	}
 	return nil
 }
 
-func (x *GameMove) GetEndTurn() *EndTurnAction {
+func (x *WorldChange) GetTerrainChanged() *TerrainChangedChange {
 	if x != nil {
-		if x, ok := x.MoveType.(*GameMove_EndTurn); ok {
-			return x.EndTurn
+		if x, ok := x.ChangeType.(*WorldChange_TerrainChanged); ok {
+			return x.TerrainChanged
 		}
 	}
 	return nil
 }
 
-func (x *GameMove) GetBuildUnit() *BuildUnitAction {
+func (x *WorldChange) GetUnitSubmerged() *UnitSubmergedChange {
 	if x != nil {
-		if x, ok := x.MoveType.(*GameMove_BuildUnit); ok {
-			return x.BuildUnit
+		if x, ok := x.ChangeType.(*WorldChange_UnitSubmerged); ok {
+			return x.UnitSubmerged
 		}
 	}
 	return nil
 }
 
-func (x *GameMove) GetCaptureBuilding() *CaptureBuildingAction {
+func (x *WorldChange) GetTurnDelegated() *TurnDelegatedChange {
 	if x != nil {
-		if x, ok := x.MoveType.(*GameMove_CaptureBuilding); ok {
-			return x.CaptureBuilding
+		if x, ok := x.ChangeType.(*WorldChange_TurnDelegated); ok {
+			return x.TurnDelegated
 		}
 	}
 	return nil
 }
 
-func (x *GameMove) GetHealUnit() *HealUnitAction {
+func (x *WorldChange) GetUnitDrafted() *UnitDraftedChange {
 	if x != nil {
-		if x, ok := x.MoveType.(*GameMove_HealUnit); ok {
-			return x.HealUnit
+		if x, ok := x.ChangeType.(*WorldChange_UnitDrafted); ok {
+			return x.UnitDrafted
 		}
 	}
 	return nil
 }
 
-func (x *GameMove) GetFixUnit() *FixUnitAction {
+func (x *WorldChange) GetGameEvent() *GameEventChange {
 	if x != nil {
-		if x, ok := x.MoveType.(*GameMove_FixUnit); ok {
-			return x.FixUnit
+		if x, ok := x.ChangeType.(*WorldChange_GameEvent); ok {
+			return x.GameEvent
 		}
 	}
 	return nil
 }
 
-func (x *GameMove) GetSequenceNum() int64 {
+func (x *WorldChange) GetUnitTransformed() *UnitTransformedChange {
 	if x != nil {
-		return x.SequenceNum
+		if x, ok := x.ChangeType.(*WorldChange_UnitTransformed); ok {
+			return x.UnitTransformed
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *GameMove) GetIsPermanent() bool {
+func (x *WorldChange) GetVictoryPointsScored() *VictoryPointsScoredChange {
 	if x != nil {
-		return x.IsPermanent
+		if x, ok := x.ChangeType.(*WorldChange_VictoryPointsScored); ok {
+			return x.VictoryPointsScored
+		}
 	}
-	return false
+	return nil
 }
 
-func (x *GameMove) GetChanges() []*WorldChange {
+func (x *WorldChange) GetUnitAttacked() *UnitAttackedChange {
 	if x != nil {
-		return x.Changes
+		if x, ok := x.ChangeType.(*WorldChange_UnitAttacked); ok {
+			return x.UnitAttacked
+		}
 	}
 	return nil
 }
 
-func (x *GameMove) GetDescription() string {
+func (x *WorldChange) GetPlanSubmitted() *PlanSubmittedChange {
 	if x != nil {
-		return x.Description
+		if x, ok := x.ChangeType.(*WorldChange_PlanSubmitted); ok {
+			return x.PlanSubmitted
+		}
 	}
-	return ""
+	return nil
 }
 
-type isGameMove_MoveType interface {
-	isGameMove_MoveType()
+type isWorldChange_ChangeType interface {
+	isWorldChange_ChangeType()
 }
 
-type GameMove_MoveUnit struct {
-	MoveUnit *MoveUnitAction `protobuf:"bytes,5,opt,name=move_unit,json=moveUnit,proto3,oneof"`
+type WorldChange_UnitMoved struct {
+	UnitMoved *UnitMovedChange `protobuf:"bytes,1,opt,name=unit_moved,json=unitMoved,proto3,oneof"`
 }
 
-type GameMove_AttackUnit struct {
-	AttackUnit *AttackUnitAction `protobuf:"bytes,6,opt,name=attack_unit,json=attackUnit,proto3,oneof"`
+type WorldChange_UnitDamaged struct {
+	UnitDamaged *UnitDamagedChange `protobuf:"bytes,2,opt,name=unit_damaged,json=unitDamaged,proto3,oneof"`
 }
 
-type GameMove_EndTurn struct {
-	EndTurn *EndTurnAction `protobuf:"bytes,7,opt,name=end_turn,json=endTurn,proto3,oneof"`
+type WorldChange_UnitKilled struct {
+	UnitKilled *UnitKilledChange `protobuf:"bytes,3,opt,name=unit_killed,json=unitKilled,proto3,oneof"`
 }
 
-type GameMove_BuildUnit struct {
-	BuildUnit *BuildUnitAction `protobuf:"bytes,8,opt,name=build_unit,json=buildUnit,proto3,oneof"`
+type WorldChange_PlayerChanged struct {
+	PlayerChanged *PlayerChangedChange `protobuf:"bytes,4,opt,name=player_changed,json=playerChanged,proto3,oneof"`
 }
 
-type GameMove_CaptureBuilding struct {
-	CaptureBuilding *CaptureBuildingAction `protobuf:"bytes,13,opt,name=capture_building,json=captureBuilding,proto3,oneof"`
+type WorldChange_UnitBuilt struct {
+	UnitBuilt *UnitBuiltChange `protobuf:"bytes,5,opt,name=unit_built,json=unitBuilt,proto3,oneof"`
 }
 
-type GameMove_HealUnit struct {
-	HealUnit *HealUnitAction `protobuf:"bytes,14,opt,name=heal_unit,json=healUnit,proto3,oneof"`
+type WorldChange_CoinsChanged struct {
+	CoinsChanged *CoinsChangedChange `protobuf:"bytes,6,opt,name=coins_changed,json=coinsChanged,proto3,oneof"`
 }
 
-type GameMove_FixUnit struct {
-	FixUnit *FixUnitAction `protobuf:"bytes,15,opt,name=fix_unit,json=fixUnit,proto3,oneof"`
+type WorldChange_TileCaptured struct {
+	TileCaptured *TileCapturedChange `protobuf:"bytes,7,opt,name=tile_captured,json=tileCaptured,proto3,oneof"`
 }
 
-func (*GameMove_MoveUnit) isGameMove_MoveType() {}
+type WorldChange_CaptureStarted struct {
+	CaptureStarted *CaptureStartedChange `protobuf:"bytes,8,opt,name=capture_started,json=captureStarted,proto3,oneof"`
+}
 
-func (*GameMove_AttackUnit) isGameMove_MoveType() {}
+type WorldChange_UnitHealed struct {
+	UnitHealed *UnitHealedChange `protobuf:"bytes,9,opt,name=unit_healed,json=unitHealed,proto3,oneof"`
+}
 
-func (*GameMove_EndTurn) isGameMove_MoveType() {}
+type WorldChange_UnitFixed struct {
+	UnitFixed *UnitFixedChange `protobuf:"bytes,10,opt,name=unit_fixed,json=unitFixed,proto3,oneof"`
+}
 
-func (*GameMove_BuildUnit) isGameMove_MoveType() {}
+type WorldChange_TerrainChanged struct {
+	TerrainChanged *TerrainChangedChange `protobuf:"bytes,11,opt,name=terrain_changed,json=terrainChanged,proto3,oneof"`
+}
 
-func (*GameMove_CaptureBuilding) isGameMove_MoveType() {}
+type WorldChange_UnitSubmerged struct {
+	UnitSubmerged *UnitSubmergedChange `protobuf:"bytes,12,opt,name=unit_submerged,json=unitSubmerged,proto3,oneof"`
+}
 
-func (*GameMove_HealUnit) isGameMove_MoveType() {}
+type WorldChange_TurnDelegated struct {
+	TurnDelegated *TurnDelegatedChange `protobuf:"bytes,13,opt,name=turn_delegated,json=turnDelegated,proto3,oneof"`
+}
 
-func (*GameMove_FixUnit) isGameMove_MoveType() {}
+type WorldChange_UnitDrafted struct {
+	UnitDrafted *UnitDraftedChange `protobuf:"bytes,14,opt,name=unit_drafted,json=unitDrafted,proto3,oneof"`
+}
 
-// A unified "Position" type that can be used to
-// specify locations via "string shortcuts" like A1, "3,2", "r2,4" (for row/col)
-// or even "relative" positions like "L,TL,TR,R"  in the shortcut field.
-// Or string q/r coordinates in the q and r fields.  This can also be used
-// in the "response" to resolve a shortcut -> q,r
-type Position struct {
+type WorldChange_GameEvent struct {
+	GameEvent *GameEventChange `protobuf:"bytes,15,opt,name=game_event,json=gameEvent,proto3,oneof"`
+}
+
+type WorldChange_UnitTransformed struct {
+	UnitTransformed *UnitTransformedChange `protobuf:"bytes,16,opt,name=unit_transformed,json=unitTransformed,proto3,oneof"`
+}
+
+type WorldChange_VictoryPointsScored struct {
+	VictoryPointsScored *VictoryPointsScoredChange `protobuf:"bytes,17,opt,name=victory_points_scored,json=victoryPointsScored,proto3,oneof"`
+}
+
+type WorldChange_UnitAttacked struct {
+	UnitAttacked *UnitAttackedChange `protobuf:"bytes,18,opt,name=unit_attacked,json=unitAttacked,proto3,oneof"`
+}
+
+type WorldChange_PlanSubmitted struct {
+	PlanSubmitted *PlanSubmittedChange `protobuf:"bytes,19,opt,name=plan_submitted,json=planSubmitted,proto3,oneof"`
+}
+
+func (*WorldChange_UnitMoved) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitDamaged) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitKilled) isWorldChange_ChangeType() {}
+
+func (*WorldChange_PlayerChanged) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitBuilt) isWorldChange_ChangeType() {}
+
+func (*WorldChange_CoinsChanged) isWorldChange_ChangeType() {}
+
+func (*WorldChange_TileCaptured) isWorldChange_ChangeType() {}
+
+func (*WorldChange_CaptureStarted) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitHealed) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitFixed) isWorldChange_ChangeType() {}
+
+func (*WorldChange_TerrainChanged) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitSubmerged) isWorldChange_ChangeType() {}
+
+func (*WorldChange_TurnDelegated) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitDrafted) isWorldChange_ChangeType() {}
+
+func (*WorldChange_GameEvent) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitTransformed) isWorldChange_ChangeType() {}
+
+func (*WorldChange_VictoryPointsScored) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitAttacked) isWorldChange_ChangeType() {}
+
+func (*WorldChange_PlanSubmitted) isWorldChange_ChangeType() {}
+
+// *
+// The world changes a game applied, in order, one entry per processed move.
+// Games only keep a change log once it is enabled (for auditing).
+type ChangeLog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Q             int32                  `protobuf:"varint,2,opt,name=q,proto3" json:"q,omitempty"`
-	R             int32                  `protobuf:"varint,3,opt,name=r,proto3" json:"r,omitempty"`
+	Entries       []*ChangeLogEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Position) Reset() {
-	*x = Position{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[27]
+func (x *ChangeLog) Reset() {
+	*x = ChangeLog{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Position) String() string {
+func (x *ChangeLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Position) ProtoMessage() {}
+func (*ChangeLog) ProtoMessage() {}
 
-func (x *Position) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[27]
+func (x *ChangeLog) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2992,61 +7053,107 @@ func (x *Position) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Position.ProtoReflect.Descriptor instead.
-func (*Position) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ChangeLog.ProtoReflect.Descriptor instead.
+func (*ChangeLog) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Position) GetLabel() string {
+func (x *ChangeLog) GetEntries() []*ChangeLogEntry {
 	if x != nil {
-		return x.Label
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-func (x *Position) GetQ() int32 {
+type ChangeLogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        int32                  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`                              // Player whose turn it was
+	TurnCounter   int32                  `protobuf:"varint,2,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"` // Turn the move was made in
+	Changes       []*WorldChange         `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeLogEntry) Reset() {
+	*x = ChangeLogEntry{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeLogEntry) ProtoMessage() {}
+
+func (x *ChangeLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[72]
 	if x != nil {
-		return x.Q
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeLogEntry.ProtoReflect.Descriptor instead.
+func (*ChangeLogEntry) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ChangeLogEntry) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
 	}
 	return 0
 }
 
-func (x *Position) GetR() int32 {
+func (x *ChangeLogEntry) GetTurnCounter() int32 {
 	if x != nil {
-		return x.R
+		return x.TurnCounter
 	}
 	return 0
 }
 
+func (x *ChangeLogEntry) GetChanges() []*WorldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
 // *
-// Move unit from one position to another
-type MoveUnitAction struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	From  *Position              `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To    *Position              `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	// Optional fields that can be used for showing move options as well as debugging
-	MovementCost float64 `protobuf:"fixed64,3,opt,name=movement_cost,json=movementCost,proto3" json:"movement_cost,omitempty"`
-	// Debug fields
-	ReconstructedPath *Path `protobuf:"bytes,4,opt,name=reconstructed_path,json=reconstructedPath,proto3" json:"reconstructed_path,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// A player banned or picked a unit type during the draft
+type UnitDraftedChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	UnitType      int32                  `protobuf:"varint,2,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"` // 0 if the player passed
+	Pick          bool                   `protobuf:"varint,3,opt,name=pick,proto3" json:"pick,omitempty"`
+	NextPlayer    int32                  `protobuf:"varint,4,opt,name=next_player,json=nextPlayer,proto3" json:"next_player,omitempty"`          // Player drafting next, or player 1 once the draft is over
+	DraftComplete bool                   `protobuf:"varint,5,opt,name=draft_complete,json=draftComplete,proto3" json:"draft_complete,omitempty"` // The draft is over and the game has started
+	TurnsTaken    int32                  `protobuf:"varint,6,opt,name=turns_taken,json=turnsTaken,proto3" json:"turns_taken,omitempty"`          // Draft turns taken, including this one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveUnitAction) Reset() {
-	*x = MoveUnitAction{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[28]
+func (x *UnitDraftedChange) Reset() {
+	*x = UnitDraftedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveUnitAction) String() string {
+func (x *UnitDraftedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveUnitAction) ProtoMessage() {}
+func (*UnitDraftedChange) ProtoMessage() {}
 
-func (x *MoveUnitAction) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[28]
+func (x *UnitDraftedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3057,69 +7164,81 @@ func (x *MoveUnitAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveUnitAction.ProtoReflect.Descriptor instead.
-func (*MoveUnitAction) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UnitDraftedChange.ProtoReflect.Descriptor instead.
+func (*UnitDraftedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *MoveUnitAction) GetFrom() *Position {
+func (x *UnitDraftedChange) GetPlayerId() int32 {
 	if x != nil {
-		return x.From
+		return x.PlayerId
 	}
-	return nil
+	return 0
 }
 
-func (x *MoveUnitAction) GetTo() *Position {
+func (x *UnitDraftedChange) GetUnitType() int32 {
 	if x != nil {
-		return x.To
+		return x.UnitType
 	}
-	return nil
+	return 0
 }
 
-func (x *MoveUnitAction) GetMovementCost() float64 {
+func (x *UnitDraftedChange) GetPick() bool {
 	if x != nil {
-		return x.MovementCost
+		return x.Pick
+	}
+	return false
+}
+
+func (x *UnitDraftedChange) GetNextPlayer() int32 {
+	if x != nil {
+		return x.NextPlayer
 	}
 	return 0
 }
 
-func (x *MoveUnitAction) GetReconstructedPath() *Path {
+func (x *UnitDraftedChange) GetDraftComplete() bool {
 	if x != nil {
-		return x.ReconstructedPath
+		return x.DraftComplete
 	}
-	return nil
+	return false
+}
+
+func (x *UnitDraftedChange) GetTurnsTaken() int32 {
+	if x != nil {
+		return x.TurnsTaken
+	}
+	return 0
 }
 
 // *
-// Attack with one unit against another
-type AttackUnitAction struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Attacker *Position              `protobuf:"bytes,1,opt,name=attacker,proto3" json:"attacker,omitempty"`
-	Defender *Position              `protobuf:"bytes,2,opt,name=defender,proto3" json:"defender,omitempty"`
-	// Optional fields for presenting during "options" and debugging
-	TargetUnitType   int32 `protobuf:"varint,7,opt,name=target_unit_type,json=targetUnitType,proto3" json:"target_unit_type,omitempty"`
-	TargetUnitHealth int32 `protobuf:"varint,8,opt,name=target_unit_health,json=targetUnitHealth,proto3" json:"target_unit_health,omitempty"`
-	CanAttack        bool  `protobuf:"varint,9,opt,name=can_attack,json=canAttack,proto3" json:"can_attack,omitempty"`
-	DamageEstimate   int32 `protobuf:"varint,10,opt,name=damage_estimate,json=damageEstimate,proto3" json:"damage_estimate,omitempty"` // Estimated damage this attack would deal
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// A player locked in their plan for a simultaneous turn.  The plan itself
+// stays private; once the last plan is in, the changes of resolving them all
+// follow this one.
+type PlanSubmittedChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlannedMoves  int32                  `protobuf:"varint,2,opt,name=planned_moves,json=plannedMoves,proto3" json:"planned_moves,omitempty"` // Number of moves and attacks planned
+	Resolved      bool                   `protobuf:"varint,3,opt,name=resolved,proto3" json:"resolved,omitempty"`                             // This was the last plan and the turn resolved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttackUnitAction) Reset() {
-	*x = AttackUnitAction{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[29]
+func (x *PlanSubmittedChange) Reset() {
+	*x = PlanSubmittedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackUnitAction) String() string {
+func (x *PlanSubmittedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackUnitAction) ProtoMessage() {}
+func (*PlanSubmittedChange) ProtoMessage() {}
 
-func (x *AttackUnitAction) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[29]
+func (x *PlanSubmittedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3130,79 +7249,60 @@ func (x *AttackUnitAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackUnitAction.ProtoReflect.Descriptor instead.
-func (*AttackUnitAction) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *AttackUnitAction) GetAttacker() *Position {
-	if x != nil {
-		return x.Attacker
-	}
-	return nil
-}
-
-func (x *AttackUnitAction) GetDefender() *Position {
-	if x != nil {
-		return x.Defender
-	}
-	return nil
+// Deprecated: Use PlanSubmittedChange.ProtoReflect.Descriptor instead.
+func (*PlanSubmittedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *AttackUnitAction) GetTargetUnitType() int32 {
+func (x *PlanSubmittedChange) GetPlayerId() int32 {
 	if x != nil {
-		return x.TargetUnitType
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *AttackUnitAction) GetTargetUnitHealth() int32 {
+func (x *PlanSubmittedChange) GetPlannedMoves() int32 {
 	if x != nil {
-		return x.TargetUnitHealth
+		return x.PlannedMoves
 	}
 	return 0
 }
 
-func (x *AttackUnitAction) GetCanAttack() bool {
+func (x *PlanSubmittedChange) GetResolved() bool {
 	if x != nil {
-		return x.CanAttack
+		return x.Resolved
 	}
 	return false
 }
 
-func (x *AttackUnitAction) GetDamageEstimate() int32 {
-	if x != nil {
-		return x.DamageEstimate
-	}
-	return 0
-}
-
 // *
-// An action to build a unit (at a city tile)
-type BuildUnitAction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"`
-	UnitType      int32                  `protobuf:"varint,2,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
-	Cost          int32                  `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Something notable happened that did not change the world, eg a player
+// forced their turn to end with mandatory actions pending
+type GameEventChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventType      string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	PlayerId       int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	SkippedActions []*TurnObligation      `protobuf:"bytes,4,rep,name=skipped_actions,json=skippedActions,proto3" json:"skipped_actions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *BuildUnitAction) Reset() {
-	*x = BuildUnitAction{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[30]
+func (x *GameEventChange) Reset() {
+	*x = GameEventChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildUnitAction) String() string {
+func (x *GameEventChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildUnitAction) ProtoMessage() {}
+func (*GameEventChange) ProtoMessage() {}
 
-func (x *BuildUnitAction) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[30]
+func (x *GameEventChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3213,57 +7313,64 @@ func (x *BuildUnitAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildUnitAction.ProtoReflect.Descriptor instead.
-func (*BuildUnitAction) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GameEventChange.ProtoReflect.Descriptor instead.
+func (*GameEventChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *BuildUnitAction) GetPos() *Position {
+func (x *GameEventChange) GetEventType() string {
 	if x != nil {
-		return x.Pos
+		return x.EventType
 	}
-	return nil
+	return ""
 }
 
-func (x *BuildUnitAction) GetUnitType() int32 {
+func (x *GameEventChange) GetPlayerId() int32 {
 	if x != nil {
-		return x.UnitType
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *BuildUnitAction) GetCost() int32 {
+func (x *GameEventChange) GetDescription() string {
 	if x != nil {
-		return x.Cost
+		return x.Description
 	}
-	return 0
+	return ""
+}
+
+func (x *GameEventChange) GetSkippedActions() []*TurnObligation {
+	if x != nil {
+		return x.SkippedActions
+	}
+	return nil
 }
 
 // *
-// A move where a unit can capture a building
-type CaptureBuildingAction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"`
-	TileType      int32                  `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// A player handed the rest of their turn over to a teammate
+type TurnDelegatedChange struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId         int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	DelegatePlayerId int32                  `protobuf:"varint,2,opt,name=delegate_player_id,json=delegatePlayerId,proto3" json:"delegate_player_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CaptureBuildingAction) Reset() {
-	*x = CaptureBuildingAction{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[31]
+func (x *TurnDelegatedChange) Reset() {
+	*x = TurnDelegatedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CaptureBuildingAction) String() string {
+func (x *TurnDelegatedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CaptureBuildingAction) ProtoMessage() {}
+func (*TurnDelegatedChange) ProtoMessage() {}
 
-func (x *CaptureBuildingAction) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[31]
+func (x *TurnDelegatedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3274,48 +7381,50 @@ func (x *CaptureBuildingAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CaptureBuildingAction.ProtoReflect.Descriptor instead.
-func (*CaptureBuildingAction) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use TurnDelegatedChange.ProtoReflect.Descriptor instead.
+func (*TurnDelegatedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *CaptureBuildingAction) GetPos() *Position {
+func (x *TurnDelegatedChange) GetPlayerId() int32 {
 	if x != nil {
-		return x.Pos
+		return x.PlayerId
 	}
-	return nil
+	return 0
 }
 
-func (x *CaptureBuildingAction) GetTileType() int32 {
+func (x *TurnDelegatedChange) GetDelegatePlayerId() int32 {
 	if x != nil {
-		return x.TileType
+		return x.DelegatePlayerId
 	}
 	return 0
 }
 
 // *
-// End current player's turn
-type EndTurnAction struct {
+// A unit submerged or surfaced
+type UnitSubmergedChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreviousUnit  *Unit                  `protobuf:"bytes,1,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
+	UpdatedUnit   *Unit                  `protobuf:"bytes,2,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EndTurnAction) Reset() {
-	*x = EndTurnAction{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[32]
+func (x *UnitSubmergedChange) Reset() {
+	*x = UnitSubmergedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EndTurnAction) String() string {
+func (x *UnitSubmergedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EndTurnAction) ProtoMessage() {}
+func (*UnitSubmergedChange) ProtoMessage() {}
 
-func (x *EndTurnAction) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[32]
+func (x *UnitSubmergedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3326,37 +7435,51 @@ func (x *EndTurnAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EndTurnAction.ProtoReflect.Descriptor instead.
-func (*EndTurnAction) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use UnitSubmergedChange.ProtoReflect.Descriptor instead.
+func (*UnitSubmergedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UnitSubmergedChange) GetPreviousUnit() *Unit {
+	if x != nil {
+		return x.PreviousUnit
+	}
+	return nil
+}
+
+func (x *UnitSubmergedChange) GetUpdatedUnit() *Unit {
+	if x != nil {
+		return x.UpdatedUnit
+	}
+	return nil
 }
 
 // *
-// Heal a unit - player manually chooses to heal instead of attacking/moving
-// Auto-healing at turn start is handled separately in TopUpUnitIfNeeded
-type HealUnitAction struct {
+// A tile's terrain or construction state changed (construction started,
+// completed or cancelled).  Clients should re-render the hex.
+type TerrainChangedChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pos           *Position              `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"`                                  // Position of unit to heal
-	HealAmount    int32                  `protobuf:"varint,2,opt,name=heal_amount,json=healAmount,proto3" json:"heal_amount,omitempty"` // Amount of health to restore
+	PreviousTile  *Tile                  `protobuf:"bytes,1,opt,name=previous_tile,json=previousTile,proto3" json:"previous_tile,omitempty"`
+	UpdatedTile   *Tile                  `protobuf:"bytes,2,opt,name=updated_tile,json=updatedTile,proto3" json:"updated_tile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealUnitAction) Reset() {
-	*x = HealUnitAction{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[33]
+func (x *TerrainChangedChange) Reset() {
+	*x = TerrainChangedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealUnitAction) String() string {
+func (x *TerrainChangedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealUnitAction) ProtoMessage() {}
+func (*TerrainChangedChange) ProtoMessage() {}
 
-func (x *HealUnitAction) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[33]
+func (x *TerrainChangedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3367,52 +7490,51 @@ func (x *HealUnitAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealUnitAction.ProtoReflect.Descriptor instead.
-func (*HealUnitAction) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use TerrainChangedChange.ProtoReflect.Descriptor instead.
+func (*TerrainChangedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *HealUnitAction) GetPos() *Position {
+func (x *TerrainChangedChange) GetPreviousTile() *Tile {
 	if x != nil {
-		return x.Pos
+		return x.PreviousTile
 	}
 	return nil
 }
 
-func (x *HealUnitAction) GetHealAmount() int32 {
+func (x *TerrainChangedChange) GetUpdatedTile() *Tile {
 	if x != nil {
-		return x.HealAmount
+		return x.UpdatedTile
 	}
-	return 0
+	return nil
 }
 
 // *
-// Fix (repair) another friendly unit - used by Medic, Engineer, Stratotanker, Tugboat, Aircraft Carrier
-// The fixer must be adjacent to the target unit
-type FixUnitAction struct {
+// A unit was healed
+type UnitHealedChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Fixer         *Position              `protobuf:"bytes,1,opt,name=fixer,proto3" json:"fixer,omitempty"`                           // Position of the unit doing the fixing
-	Target        *Position              `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`                         // Position of the friendly unit being fixed
-	FixAmount     int32                  `protobuf:"varint,3,opt,name=fix_amount,json=fixAmount,proto3" json:"fix_amount,omitempty"` // Amount of health to restore (optional, server calculates if not provided)
+	PreviousUnit  *Unit                  `protobuf:"bytes,1,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"` // Unit state before healing
+	UpdatedUnit   *Unit                  `protobuf:"bytes,2,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`    // Unit state after healing
+	HealAmount    int32                  `protobuf:"varint,3,opt,name=heal_amount,json=healAmount,proto3" json:"heal_amount,omitempty"`      // Amount healed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FixUnitAction) Reset() {
-	*x = FixUnitAction{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[34]
+func (x *UnitHealedChange) Reset() {
+	*x = UnitHealedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FixUnitAction) String() string {
+func (x *UnitHealedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FixUnitAction) ProtoMessage() {}
+func (*UnitHealedChange) ProtoMessage() {}
 
-func (x *FixUnitAction) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[34]
+func (x *UnitHealedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3423,70 +7545,57 @@ func (x *FixUnitAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FixUnitAction.ProtoReflect.Descriptor instead.
-func (*FixUnitAction) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use UnitHealedChange.ProtoReflect.Descriptor instead.
+func (*UnitHealedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *FixUnitAction) GetFixer() *Position {
+func (x *UnitHealedChange) GetPreviousUnit() *Unit {
 	if x != nil {
-		return x.Fixer
+		return x.PreviousUnit
 	}
 	return nil
 }
 
-func (x *FixUnitAction) GetTarget() *Position {
+func (x *UnitHealedChange) GetUpdatedUnit() *Unit {
 	if x != nil {
-		return x.Target
+		return x.UpdatedUnit
 	}
 	return nil
 }
 
-func (x *FixUnitAction) GetFixAmount() int32 {
+func (x *UnitHealedChange) GetHealAmount() int32 {
 	if x != nil {
-		return x.FixAmount
+		return x.HealAmount
 	}
-	return 0
-}
-
-// *
-// Represents a change to the game world
-type WorldChange struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// When did this change happen
-	//
-	// Types that are valid to be assigned to ChangeType:
-	//
-	//	*WorldChange_UnitMoved
-	//	*WorldChange_UnitDamaged
-	//	*WorldChange_UnitKilled
-	//	*WorldChange_PlayerChanged
-	//	*WorldChange_UnitBuilt
-	//	*WorldChange_CoinsChanged
-	//	*WorldChange_TileCaptured
-	//	*WorldChange_CaptureStarted
-	//	*WorldChange_UnitHealed
-	//	*WorldChange_UnitFixed
-	ChangeType    isWorldChange_ChangeType `protobuf_oneof:"change_type"`
+	return 0
+}
+
+// *
+// A unit was transformed into another unit type
+type UnitTransformedChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreviousUnit  *Unit                  `protobuf:"bytes,1,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
+	UpdatedUnit   *Unit                  `protobuf:"bytes,2,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorldChange) Reset() {
-	*x = WorldChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[35]
+func (x *UnitTransformedChange) Reset() {
+	*x = UnitTransformedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorldChange) String() string {
+func (x *UnitTransformedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorldChange) ProtoMessage() {}
+func (*UnitTransformedChange) ProtoMessage() {}
 
-func (x *WorldChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[35]
+func (x *UnitTransformedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3497,198 +7606,232 @@ func (x *WorldChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorldChange.ProtoReflect.Descriptor instead.
-func (*WorldChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use UnitTransformedChange.ProtoReflect.Descriptor instead.
+func (*UnitTransformedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *WorldChange) GetChangeType() isWorldChange_ChangeType {
+func (x *UnitTransformedChange) GetPreviousUnit() *Unit {
 	if x != nil {
-		return x.ChangeType
+		return x.PreviousUnit
 	}
 	return nil
 }
 
-func (x *WorldChange) GetUnitMoved() *UnitMovedChange {
+func (x *UnitTransformedChange) GetUpdatedUnit() *Unit {
 	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_UnitMoved); ok {
-			return x.UnitMoved
-		}
+		return x.UpdatedUnit
 	}
 	return nil
 }
 
-func (x *WorldChange) GetUnitDamaged() *UnitDamagedChange {
-	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_UnitDamaged); ok {
-			return x.UnitDamaged
-		}
-	}
-	return nil
+// *
+// A unit was fixed (repaired) by another unit
+type UnitFixedChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FixerUnit      *Unit                  `protobuf:"bytes,1,opt,name=fixer_unit,json=fixerUnit,proto3" json:"fixer_unit,omitempty"`                // Unit that performed the fix
+	PreviousTarget *Unit                  `protobuf:"bytes,2,opt,name=previous_target,json=previousTarget,proto3" json:"previous_target,omitempty"` // Target unit state before fix
+	UpdatedTarget  *Unit                  `protobuf:"bytes,3,opt,name=updated_target,json=updatedTarget,proto3" json:"updated_target,omitempty"`    // Target unit state after fix
+	FixAmount      int32                  `protobuf:"varint,4,opt,name=fix_amount,json=fixAmount,proto3" json:"fix_amount,omitempty"`               // Amount of health restored
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *WorldChange) GetUnitKilled() *UnitKilledChange {
-	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_UnitKilled); ok {
-			return x.UnitKilled
-		}
-	}
-	return nil
+func (x *UnitFixedChange) Reset() {
+	*x = UnitFixedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorldChange) GetPlayerChanged() *PlayerChangedChange {
-	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_PlayerChanged); ok {
-			return x.PlayerChanged
-		}
-	}
-	return nil
+func (x *UnitFixedChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *WorldChange) GetUnitBuilt() *UnitBuiltChange {
+func (*UnitFixedChange) ProtoMessage() {}
+
+func (x *UnitFixedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[81]
 	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_UnitBuilt); ok {
-			return x.UnitBuilt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *WorldChange) GetCoinsChanged() *CoinsChangedChange {
-	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_CoinsChanged); ok {
-			return x.CoinsChanged
-		}
-	}
-	return nil
+// Deprecated: Use UnitFixedChange.ProtoReflect.Descriptor instead.
+func (*UnitFixedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *WorldChange) GetTileCaptured() *TileCapturedChange {
+func (x *UnitFixedChange) GetFixerUnit() *Unit {
 	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_TileCaptured); ok {
-			return x.TileCaptured
-		}
+		return x.FixerUnit
 	}
 	return nil
 }
 
-func (x *WorldChange) GetCaptureStarted() *CaptureStartedChange {
+func (x *UnitFixedChange) GetPreviousTarget() *Unit {
 	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_CaptureStarted); ok {
-			return x.CaptureStarted
-		}
+		return x.PreviousTarget
 	}
 	return nil
 }
 
-func (x *WorldChange) GetUnitHealed() *UnitHealedChange {
+func (x *UnitFixedChange) GetUpdatedTarget() *Unit {
 	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_UnitHealed); ok {
-			return x.UnitHealed
-		}
+		return x.UpdatedTarget
 	}
 	return nil
 }
 
-func (x *WorldChange) GetUnitFixed() *UnitFixedChange {
+func (x *UnitFixedChange) GetFixAmount() int32 {
 	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_UnitFixed); ok {
-			return x.UnitFixed
-		}
+		return x.FixAmount
 	}
-	return nil
+	return 0
 }
 
-type isWorldChange_ChangeType interface {
-	isWorldChange_ChangeType()
+// *
+// A unit moved from one position to another
+type UnitMovedChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Complete unit state before the move
+	PreviousUnit *Unit `protobuf:"bytes,6,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
+	// Complete unit state after the move (includes updated position, distanceLeft, etc.)
+	UpdatedUnit   *Unit `protobuf:"bytes,7,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type WorldChange_UnitMoved struct {
-	UnitMoved *UnitMovedChange `protobuf:"bytes,1,opt,name=unit_moved,json=unitMoved,proto3,oneof"`
+func (x *UnitMovedChange) Reset() {
+	*x = UnitMovedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type WorldChange_UnitDamaged struct {
-	UnitDamaged *UnitDamagedChange `protobuf:"bytes,2,opt,name=unit_damaged,json=unitDamaged,proto3,oneof"`
+func (x *UnitMovedChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type WorldChange_UnitKilled struct {
-	UnitKilled *UnitKilledChange `protobuf:"bytes,3,opt,name=unit_killed,json=unitKilled,proto3,oneof"`
-}
+func (*UnitMovedChange) ProtoMessage() {}
 
-type WorldChange_PlayerChanged struct {
-	PlayerChanged *PlayerChangedChange `protobuf:"bytes,4,opt,name=player_changed,json=playerChanged,proto3,oneof"`
+func (x *UnitMovedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type WorldChange_UnitBuilt struct {
-	UnitBuilt *UnitBuiltChange `protobuf:"bytes,5,opt,name=unit_built,json=unitBuilt,proto3,oneof"`
+// Deprecated: Use UnitMovedChange.ProtoReflect.Descriptor instead.
+func (*UnitMovedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{82}
 }
 
-type WorldChange_CoinsChanged struct {
-	CoinsChanged *CoinsChangedChange `protobuf:"bytes,6,opt,name=coins_changed,json=coinsChanged,proto3,oneof"`
+func (x *UnitMovedChange) GetPreviousUnit() *Unit {
+	if x != nil {
+		return x.PreviousUnit
+	}
+	return nil
 }
 
-type WorldChange_TileCaptured struct {
-	TileCaptured *TileCapturedChange `protobuf:"bytes,7,opt,name=tile_captured,json=tileCaptured,proto3,oneof"`
+func (x *UnitMovedChange) GetUpdatedUnit() *Unit {
+	if x != nil {
+		return x.UpdatedUnit
+	}
+	return nil
 }
 
-type WorldChange_CaptureStarted struct {
-	CaptureStarted *CaptureStartedChange `protobuf:"bytes,8,opt,name=capture_started,json=captureStarted,proto3,oneof"`
+// *
+// A unit took damage
+type UnitDamagedChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Complete unit state before taking damage
+	PreviousUnit *Unit `protobuf:"bytes,6,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
+	// Complete unit state after taking damage
+	UpdatedUnit   *Unit `protobuf:"bytes,7,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type WorldChange_UnitHealed struct {
-	UnitHealed *UnitHealedChange `protobuf:"bytes,9,opt,name=unit_healed,json=unitHealed,proto3,oneof"`
+func (x *UnitDamagedChange) Reset() {
+	*x = UnitDamagedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type WorldChange_UnitFixed struct {
-	UnitFixed *UnitFixedChange `protobuf:"bytes,10,opt,name=unit_fixed,json=unitFixed,proto3,oneof"`
+func (x *UnitDamagedChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorldChange_UnitMoved) isWorldChange_ChangeType() {}
-
-func (*WorldChange_UnitDamaged) isWorldChange_ChangeType() {}
-
-func (*WorldChange_UnitKilled) isWorldChange_ChangeType() {}
-
-func (*WorldChange_PlayerChanged) isWorldChange_ChangeType() {}
-
-func (*WorldChange_UnitBuilt) isWorldChange_ChangeType() {}
-
-func (*WorldChange_CoinsChanged) isWorldChange_ChangeType() {}
+func (*UnitDamagedChange) ProtoMessage() {}
 
-func (*WorldChange_TileCaptured) isWorldChange_ChangeType() {}
+func (x *UnitDamagedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*WorldChange_CaptureStarted) isWorldChange_ChangeType() {}
+// Deprecated: Use UnitDamagedChange.ProtoReflect.Descriptor instead.
+func (*UnitDamagedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{83}
+}
 
-func (*WorldChange_UnitHealed) isWorldChange_ChangeType() {}
+func (x *UnitDamagedChange) GetPreviousUnit() *Unit {
+	if x != nil {
+		return x.PreviousUnit
+	}
+	return nil
+}
 
-func (*WorldChange_UnitFixed) isWorldChange_ChangeType() {}
+func (x *UnitDamagedChange) GetUpdatedUnit() *Unit {
+	if x != nil {
+		return x.UpdatedUnit
+	}
+	return nil
+}
 
 // *
-// A unit was healed
-type UnitHealedChange struct {
+// A unit attacked another.  The damage itself is applied by the
+// UnitDamaged and UnitKilled changes recorded with it; this is the recap.
+type UnitAttackedChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PreviousUnit  *Unit                  `protobuf:"bytes,1,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"` // Unit state before healing
-	UpdatedUnit   *Unit                  `protobuf:"bytes,2,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`    // Unit state after healing
-	HealAmount    int32                  `protobuf:"varint,3,opt,name=heal_amount,json=healAmount,proto3" json:"heal_amount,omitempty"`      // Amount healed
+	Summary       *CombatSummary         `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnitHealedChange) Reset() {
-	*x = UnitHealedChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[36]
+func (x *UnitAttackedChange) Reset() {
+	*x = UnitAttackedChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnitHealedChange) String() string {
+func (x *UnitAttackedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnitHealedChange) ProtoMessage() {}
+func (*UnitAttackedChange) ProtoMessage() {}
 
-func (x *UnitHealedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[36]
+func (x *UnitAttackedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3699,59 +7842,66 @@ func (x *UnitHealedChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnitHealedChange.ProtoReflect.Descriptor instead.
-func (*UnitHealedChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *UnitHealedChange) GetPreviousUnit() *Unit {
-	if x != nil {
-		return x.PreviousUnit
-	}
-	return nil
+// Deprecated: Use UnitAttackedChange.ProtoReflect.Descriptor instead.
+func (*UnitAttackedChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *UnitHealedChange) GetUpdatedUnit() *Unit {
+func (x *UnitAttackedChange) GetSummary() *CombatSummary {
 	if x != nil {
-		return x.UpdatedUnit
+		return x.Summary
 	}
 	return nil
 }
 
-func (x *UnitHealedChange) GetHealAmount() int32 {
-	if x != nil {
-		return x.HealAmount
-	}
-	return 0
-}
-
 // *
-// A unit was fixed (repaired) by another unit
-type UnitFixedChange struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	FixerUnit      *Unit                  `protobuf:"bytes,1,opt,name=fixer_unit,json=fixerUnit,proto3" json:"fixer_unit,omitempty"`                // Unit that performed the fix
-	PreviousTarget *Unit                  `protobuf:"bytes,2,opt,name=previous_target,json=previousTarget,proto3" json:"previous_target,omitempty"` // Target unit state before fix
-	UpdatedTarget  *Unit                  `protobuf:"bytes,3,opt,name=updated_target,json=updatedTarget,proto3" json:"updated_target,omitempty"`    // Target unit state after fix
-	FixAmount      int32                  `protobuf:"varint,4,opt,name=fix_amount,json=fixAmount,proto3" json:"fix_amount,omitempty"`               // Amount of health restored
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// How an attack played out, for kill feeds and battle recaps
+type CombatSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Both units as they were before the attack
+	Attacker *Unit `protobuf:"bytes,1,opt,name=attacker,proto3" json:"attacker,omitempty"`
+	Defender *Unit `protobuf:"bytes,2,opt,name=defender,proto3" json:"defender,omitempty"`
+	// Damage dealt to the defender, and the mean of its damage distribution
+	Damage         int32   `protobuf:"varint,3,opt,name=damage,proto3" json:"damage,omitempty"`
+	ExpectedDamage float64 `protobuf:"fixed64,4,opt,name=expected_damage,json=expectedDamage,proto3" json:"expected_damage,omitempty"`
+	// Whether the defender struck back, and the damage it dealt the attacker
+	Countered             bool    `protobuf:"varint,5,opt,name=countered,proto3" json:"countered,omitempty"`
+	CounterDamage         int32   `protobuf:"varint,6,opt,name=counter_damage,json=counterDamage,proto3" json:"counter_damage,omitempty"`
+	ExpectedCounterDamage float64 `protobuf:"fixed64,7,opt,name=expected_counter_damage,json=expectedCounterDamage,proto3" json:"expected_counter_damage,omitempty"`
+	// Terrain modifiers applied: the attack bonus of the attacker's tile and
+	// the defense bonus of the defender's tile, then the same the other way
+	// round for the counter attack
+	AttackTerrainBonus         int32 `protobuf:"varint,8,opt,name=attack_terrain_bonus,json=attackTerrainBonus,proto3" json:"attack_terrain_bonus,omitempty"`
+	DefenseTerrainBonus        int32 `protobuf:"varint,9,opt,name=defense_terrain_bonus,json=defenseTerrainBonus,proto3" json:"defense_terrain_bonus,omitempty"`
+	CounterAttackTerrainBonus  int32 `protobuf:"varint,10,opt,name=counter_attack_terrain_bonus,json=counterAttackTerrainBonus,proto3" json:"counter_attack_terrain_bonus,omitempty"`
+	CounterDefenseTerrainBonus int32 `protobuf:"varint,11,opt,name=counter_defense_terrain_bonus,json=counterDefenseTerrainBonus,proto3" json:"counter_defense_terrain_bonus,omitempty"`
+	WoundBonus                 int32 `protobuf:"varint,12,opt,name=wound_bonus,json=woundBonus,proto3" json:"wound_bonus,omitempty"`
+	// Health of both units after the attack (0 = killed)
+	AttackerHealth int32 `protobuf:"varint,13,opt,name=attacker_health,json=attackerHealth,proto3" json:"attacker_health,omitempty"`
+	DefenderHealth int32 `protobuf:"varint,14,opt,name=defender_health,json=defenderHealth,proto3" json:"defender_health,omitempty"`
+	// Damage is the rounded expected damage rather than rolled
+	Estimated bool `protobuf:"varint,15,opt,name=estimated,proto3" json:"estimated,omitempty"`
+	// The defender survived and can attack the attacker on its turn
+	RevengeAvailable bool `protobuf:"varint,16,opt,name=revenge_available,json=revengeAvailable,proto3" json:"revenge_available,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UnitFixedChange) Reset() {
-	*x = UnitFixedChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[37]
+func (x *CombatSummary) Reset() {
+	*x = CombatSummary{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnitFixedChange) String() string {
+func (x *CombatSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnitFixedChange) ProtoMessage() {}
+func (*CombatSummary) ProtoMessage() {}
 
-func (x *UnitFixedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[37]
+func (x *CombatSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3762,149 +7912,121 @@ func (x *UnitFixedChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnitFixedChange.ProtoReflect.Descriptor instead.
-func (*UnitFixedChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use CombatSummary.ProtoReflect.Descriptor instead.
+func (*CombatSummary) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *UnitFixedChange) GetFixerUnit() *Unit {
+func (x *CombatSummary) GetAttacker() *Unit {
 	if x != nil {
-		return x.FixerUnit
+		return x.Attacker
 	}
 	return nil
 }
 
-func (x *UnitFixedChange) GetPreviousTarget() *Unit {
+func (x *CombatSummary) GetDefender() *Unit {
 	if x != nil {
-		return x.PreviousTarget
+		return x.Defender
 	}
 	return nil
 }
 
-func (x *UnitFixedChange) GetUpdatedTarget() *Unit {
+func (x *CombatSummary) GetDamage() int32 {
 	if x != nil {
-		return x.UpdatedTarget
+		return x.Damage
 	}
-	return nil
+	return 0
 }
 
-func (x *UnitFixedChange) GetFixAmount() int32 {
+func (x *CombatSummary) GetExpectedDamage() float64 {
 	if x != nil {
-		return x.FixAmount
+		return x.ExpectedDamage
 	}
 	return 0
 }
 
-// *
-// A unit moved from one position to another
-type UnitMovedChange struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Complete unit state before the move
-	PreviousUnit *Unit `protobuf:"bytes,6,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
-	// Complete unit state after the move (includes updated position, distanceLeft, etc.)
-	UpdatedUnit   *Unit `protobuf:"bytes,7,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UnitMovedChange) Reset() {
-	*x = UnitMovedChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[38]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UnitMovedChange) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CombatSummary) GetCountered() bool {
+	if x != nil {
+		return x.Countered
+	}
+	return false
 }
 
-func (*UnitMovedChange) ProtoMessage() {}
-
-func (x *UnitMovedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[38]
+func (x *CombatSummary) GetCounterDamage() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CounterDamage
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UnitMovedChange.ProtoReflect.Descriptor instead.
-func (*UnitMovedChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{38}
+func (x *CombatSummary) GetExpectedCounterDamage() float64 {
+	if x != nil {
+		return x.ExpectedCounterDamage
+	}
+	return 0
 }
 
-func (x *UnitMovedChange) GetPreviousUnit() *Unit {
+func (x *CombatSummary) GetAttackTerrainBonus() int32 {
 	if x != nil {
-		return x.PreviousUnit
+		return x.AttackTerrainBonus
 	}
-	return nil
+	return 0
 }
 
-func (x *UnitMovedChange) GetUpdatedUnit() *Unit {
+func (x *CombatSummary) GetDefenseTerrainBonus() int32 {
 	if x != nil {
-		return x.UpdatedUnit
+		return x.DefenseTerrainBonus
 	}
-	return nil
+	return 0
 }
 
-// *
-// A unit took damage
-type UnitDamagedChange struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Complete unit state before taking damage
-	PreviousUnit *Unit `protobuf:"bytes,6,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
-	// Complete unit state after taking damage
-	UpdatedUnit   *Unit `protobuf:"bytes,7,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CombatSummary) GetCounterAttackTerrainBonus() int32 {
+	if x != nil {
+		return x.CounterAttackTerrainBonus
+	}
+	return 0
 }
 
-func (x *UnitDamagedChange) Reset() {
-	*x = UnitDamagedChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CombatSummary) GetCounterDefenseTerrainBonus() int32 {
+	if x != nil {
+		return x.CounterDefenseTerrainBonus
+	}
+	return 0
 }
 
-func (x *UnitDamagedChange) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CombatSummary) GetWoundBonus() int32 {
+	if x != nil {
+		return x.WoundBonus
+	}
+	return 0
 }
 
-func (*UnitDamagedChange) ProtoMessage() {}
-
-func (x *UnitDamagedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[39]
+func (x *CombatSummary) GetAttackerHealth() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AttackerHealth
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UnitDamagedChange.ProtoReflect.Descriptor instead.
-func (*UnitDamagedChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{39}
+func (x *CombatSummary) GetDefenderHealth() int32 {
+	if x != nil {
+		return x.DefenderHealth
+	}
+	return 0
 }
 
-func (x *UnitDamagedChange) GetPreviousUnit() *Unit {
+func (x *CombatSummary) GetEstimated() bool {
 	if x != nil {
-		return x.PreviousUnit
+		return x.Estimated
 	}
-	return nil
+	return false
 }
 
-func (x *UnitDamagedChange) GetUpdatedUnit() *Unit {
+func (x *CombatSummary) GetRevengeAvailable() bool {
 	if x != nil {
-		return x.UpdatedUnit
+		return x.RevengeAvailable
 	}
-	return nil
+	return false
 }
 
 // *
@@ -3919,7 +8041,7 @@ type UnitKilledChange struct {
 
 func (x *UnitKilledChange) Reset() {
 	*x = UnitKilledChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[40]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3931,7 +8053,7 @@ func (x *UnitKilledChange) String() string {
 func (*UnitKilledChange) ProtoMessage() {}
 
 func (x *UnitKilledChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[40]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3944,7 +8066,7 @@ func (x *UnitKilledChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnitKilledChange.ProtoReflect.Descriptor instead.
 func (*UnitKilledChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{40}
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *UnitKilledChange) GetPreviousUnit() *Unit {
@@ -3963,14 +8085,20 @@ type PlayerChangedChange struct {
 	PreviousTurn   int32                  `protobuf:"varint,3,opt,name=previous_turn,json=previousTurn,proto3" json:"previous_turn,omitempty"`
 	NewTurn        int32                  `protobuf:"varint,4,opt,name=new_turn,json=newTurn,proto3" json:"new_turn,omitempty"`
 	// Units that had their movement/health reset for the new turn
-	ResetUnits    []*Unit `protobuf:"bytes,5,rep,name=reset_units,json=resetUnits,proto3" json:"reset_units,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ResetUnits []*Unit `protobuf:"bytes,5,rep,name=reset_units,json=resetUnits,proto3" json:"reset_units,omitempty"`
+	// Updated time banks (ms) by player when time banks are enabled
+	TimeBanksMs map[int32]int64 `protobuf:"bytes,6,rep,name=time_banks_ms,json=timeBanksMs,proto3" json:"time_banks_ms,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// When the new player's clock started
+	ClockStartedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=clock_started_at,json=clockStartedAt,proto3" json:"clock_started_at,omitempty"`
+	// Whether the previous player forfeited by running out of time
+	PreviousPlayerForfeited bool `protobuf:"varint,8,opt,name=previous_player_forfeited,json=previousPlayerForfeited,proto3" json:"previous_player_forfeited,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *PlayerChangedChange) Reset() {
 	*x = PlayerChangedChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[41]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3982,7 +8110,7 @@ func (x *PlayerChangedChange) String() string {
 func (*PlayerChangedChange) ProtoMessage() {}
 
 func (x *PlayerChangedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[41]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3995,7 +8123,7 @@ func (x *PlayerChangedChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerChangedChange.ProtoReflect.Descriptor instead.
 func (*PlayerChangedChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{41}
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *PlayerChangedChange) GetPreviousPlayer() int32 {
@@ -4033,6 +8161,27 @@ func (x *PlayerChangedChange) GetResetUnits() []*Unit {
 	return nil
 }
 
+func (x *PlayerChangedChange) GetTimeBanksMs() map[int32]int64 {
+	if x != nil {
+		return x.TimeBanksMs
+	}
+	return nil
+}
+
+func (x *PlayerChangedChange) GetClockStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClockStartedAt
+	}
+	return nil
+}
+
+func (x *PlayerChangedChange) GetPreviousPlayerForfeited() bool {
+	if x != nil {
+		return x.PreviousPlayerForfeited
+	}
+	return false
+}
+
 // *
 // A new unit was built at a tile
 type UnitBuiltChange struct {
@@ -4052,7 +8201,7 @@ type UnitBuiltChange struct {
 
 func (x *UnitBuiltChange) Reset() {
 	*x = UnitBuiltChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[42]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4064,7 +8213,7 @@ func (x *UnitBuiltChange) String() string {
 func (*UnitBuiltChange) ProtoMessage() {}
 
 func (x *UnitBuiltChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[42]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4077,7 +8226,7 @@ func (x *UnitBuiltChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnitBuiltChange.ProtoReflect.Descriptor instead.
 func (*UnitBuiltChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{42}
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *UnitBuiltChange) GetUnit() *Unit {
@@ -4115,6 +8264,78 @@ func (x *UnitBuiltChange) GetPlayerCoins() int32 {
 	return 0
 }
 
+// *
+// A player scored victory points for the markers they held at the end of
+// their turn
+type VictoryPointsScoredChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId       int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PreviousPoints int32                  `protobuf:"varint,2,opt,name=previous_points,json=previousPoints,proto3" json:"previous_points,omitempty"`
+	NewPoints      int32                  `protobuf:"varint,3,opt,name=new_points,json=newPoints,proto3" json:"new_points,omitempty"`
+	// The marker tiles that scored
+	Markers       []*Tile `protobuf:"bytes,4,rep,name=markers,proto3" json:"markers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VictoryPointsScoredChange) Reset() {
+	*x = VictoryPointsScoredChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VictoryPointsScoredChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VictoryPointsScoredChange) ProtoMessage() {}
+
+func (x *VictoryPointsScoredChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VictoryPointsScoredChange.ProtoReflect.Descriptor instead.
+func (*VictoryPointsScoredChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *VictoryPointsScoredChange) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *VictoryPointsScoredChange) GetPreviousPoints() int32 {
+	if x != nil {
+		return x.PreviousPoints
+	}
+	return 0
+}
+
+func (x *VictoryPointsScoredChange) GetNewPoints() int32 {
+	if x != nil {
+		return x.NewPoints
+	}
+	return 0
+}
+
+func (x *VictoryPointsScoredChange) GetMarkers() []*Tile {
+	if x != nil {
+		return x.Markers
+	}
+	return nil
+}
+
 // *
 // A player's coin balance changed
 type CoinsChangedChange struct {
@@ -4133,7 +8354,7 @@ type CoinsChangedChange struct {
 
 func (x *CoinsChangedChange) Reset() {
 	*x = CoinsChangedChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[43]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4145,7 +8366,7 @@ func (x *CoinsChangedChange) String() string {
 func (*CoinsChangedChange) ProtoMessage() {}
 
 func (x *CoinsChangedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[43]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4158,7 +8379,7 @@ func (x *CoinsChangedChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CoinsChangedChange.ProtoReflect.Descriptor instead.
 func (*CoinsChangedChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{43}
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *CoinsChangedChange) GetPlayerId() int32 {
@@ -4210,7 +8431,7 @@ type TileCapturedChange struct {
 
 func (x *TileCapturedChange) Reset() {
 	*x = TileCapturedChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[44]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4222,7 +8443,7 @@ func (x *TileCapturedChange) String() string {
 func (*TileCapturedChange) ProtoMessage() {}
 
 func (x *TileCapturedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[44]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4235,7 +8456,7 @@ func (x *TileCapturedChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TileCapturedChange.ProtoReflect.Descriptor instead.
 func (*TileCapturedChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{44}
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *TileCapturedChange) GetCapturingUnit() *Unit {
@@ -4299,7 +8520,7 @@ type CaptureStartedChange struct {
 
 func (x *CaptureStartedChange) Reset() {
 	*x = CaptureStartedChange{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[45]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4311,7 +8532,7 @@ func (x *CaptureStartedChange) String() string {
 func (*CaptureStartedChange) ProtoMessage() {}
 
 func (x *CaptureStartedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[45]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4324,7 +8545,7 @@ func (x *CaptureStartedChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CaptureStartedChange.ProtoReflect.Descriptor instead.
 func (*CaptureStartedChange) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{45}
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *CaptureStartedChange) GetCapturingUnit() *Unit {
@@ -4377,7 +8598,7 @@ type AllPaths struct {
 
 func (x *AllPaths) Reset() {
 	*x = AllPaths{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[46]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4389,7 +8610,7 @@ func (x *AllPaths) String() string {
 func (*AllPaths) ProtoMessage() {}
 
 func (x *AllPaths) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[46]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4402,7 +8623,7 @@ func (x *AllPaths) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllPaths.ProtoReflect.Descriptor instead.
 func (*AllPaths) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{46}
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *AllPaths) GetSourceQ() int32 {
@@ -4444,7 +8665,7 @@ type PathEdge struct {
 
 func (x *PathEdge) Reset() {
 	*x = PathEdge{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[47]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4456,7 +8677,7 @@ func (x *PathEdge) String() string {
 func (*PathEdge) ProtoMessage() {}
 
 func (x *PathEdge) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[47]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4469,7 +8690,7 @@ func (x *PathEdge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PathEdge.ProtoReflect.Descriptor instead.
 func (*PathEdge) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{47}
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *PathEdge) GetFromQ() int32 {
@@ -4551,7 +8772,7 @@ type Path struct {
 
 func (x *Path) Reset() {
 	*x = Path{}
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[48]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4563,7 +8784,7 @@ func (x *Path) String() string {
 func (*Path) ProtoMessage() {}
 
 func (x *Path) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_models_proto_msgTypes[48]
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4576,7 +8797,7 @@ func (x *Path) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Path.ProtoReflect.Descriptor instead.
 func (*Path) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{48}
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *Path) GetEdges() []*PathEdge {
@@ -4619,7 +8840,7 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\rnext_page_key\x18\x02 \x01(\tR\vnextPageKey\x12(\n" +
 	"\x10next_page_offset\x18\x03 \x01(\x05R\x0enextPageOffset\x12\x19\n" +
 	"\bhas_more\x18\x04 \x01(\bR\ahasMore\x12#\n" +
-	"\rtotal_results\x18\x05 \x01(\x05R\ftotalResults\"\x86\x04\n" +
+	"\rtotal_results\x18\x05 \x01(\x05R\ftotalResults\"\xbf\x06\n" +
 	"\x05World\x129\n" +
 	"\n" +
 	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
@@ -4639,7 +8860,44 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"difficulty\x12!\n" +
 	"\fpreview_urls\x18\v \x03(\tR\vpreviewUrls\x12O\n" +
 	"\x13default_game_config\x18\f \x01(\v2\x1f.lilbattle.v1.GameConfigurationR\x11defaultGameConfig\x12C\n" +
-	"\x11search_index_info\x18\r \x01(\v2\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\"\xdb\x04\n" +
+	"\x11search_index_info\x18\r \x01(\v2\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\x121\n" +
+	"\x06rating\x18\x0e \x01(\v2\x19.lilbattle.v1.WorldRatingR\x06rating\x12E\n" +
+	"\x0frules_overrides\x18\x0f \x01(\v2\x1c.lilbattle.v1.RulesOverridesR\x0erulesOverrides\x12 \n" +
+	"\vorientation\x18\x10 \x01(\tR\vorientation\x129\n" +
+	"\n" +
+	"deleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x126\n" +
+	"\n" +
+	"random_map\x18\x12 \x01(\v2\x17.lilbattle.v1.RandomMapR\trandomMap\x12(\n" +
+	"\x10attached_game_id\x18\x13 \x01(\tR\x0eattachedGameId\"T\n" +
+	"\tRandomMap\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\tR\x04size\x12\x1f\n" +
+	"\vnum_players\x18\x02 \x01(\x05R\n" +
+	"numPlayers\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\x03R\x04seed\"\xac\x02\n" +
+	"\x0eRulesOverrides\x12l\n" +
+	"\x16terrain_movement_costs\x18\x01 \x03(\v26.lilbattle.v1.RulesOverrides.TerrainMovementCostsEntryR\x14terrainMovementCosts\x122\n" +
+	"\x06income\x18\x02 \x01(\v2\x1a.lilbattle.v1.IncomeConfigR\x06income\x12/\n" +
+	"\x14max_units_per_player\x18\x03 \x01(\x05R\x11maxUnitsPerPlayer\x1aG\n" +
+	"\x19TerrainMovementCostsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x96\x03\n" +
+	"\vWorldRating\x12!\n" +
+	"\fhuman_player\x18\x01 \x01(\x05R\vhumanPlayer\x12 \n" +
+	"\vsimulations\x18\x02 \x01(\x05R\vsimulations\x12$\n" +
+	"\x0ehuman_win_rate\x18\x03 \x01(\x01R\fhumanWinRate\x12\x1e\n" +
+	"\vai_win_rate\x18\x04 \x01(\x01R\taiWinRate\x12#\n" +
+	"\raverage_turns\x18\x05 \x01(\x01R\faverageTurns\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x06 \x01(\tR\n" +
+	"difficulty\x12\x14\n" +
+	"\x05label\x18\a \x01(\tR\x05label\x12\x1d\n" +
+	"\n" +
+	"rules_hash\x18\b \x01(\tR\trulesHash\x12\x1d\n" +
+	"\n" +
+	"ai_version\x18\t \x01(\tR\taiVersion\x125\n" +
+	"\brated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\aratedAt\x12,\n" +
+	"\x12world_data_version\x18\v \x01(\x03R\x10worldDataVersion\"\xdb\x04\n" +
 	"\tWorldData\x12B\n" +
 	"\ttiles_map\x18\x01 \x03(\v2%.lilbattle.v1.WorldData.TilesMapEntryR\btilesMap\x12B\n" +
 	"\tunits_map\x18\x02 \x03(\v2%.lilbattle.v1.WorldData.UnitsMapEntryR\bunitsMap\x12K\n" +
@@ -4659,7 +8917,7 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\bCrossing\x12.\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1a.lilbattle.v1.CrossingTypeR\x04type\x12\x1f\n" +
 	"\vconnects_to\x18\x02 \x03(\bR\n" +
-	"connectsTo\"\xc9\x01\n" +
+	"connectsTo\"\xea\x02\n" +
 	"\x04Tile\x12\f\n" +
 	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
 	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
@@ -4667,7 +8925,23 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x06player\x18\x04 \x01(\x05R\x06player\x12\x1a\n" +
 	"\bshortcut\x18\x05 \x01(\tR\bshortcut\x12&\n" +
 	"\x0flast_acted_turn\x18\x06 \x01(\x05R\rlastActedTurn\x12,\n" +
-	"\x12last_toppedup_turn\x18\a \x01(\x05R\x10lastToppedupTurn\"\xa5\x04\n" +
+	"\x12last_toppedup_turn\x18\a \x01(\x05R\x10lastToppedupTurn\x12F\n" +
+	"\fconstruction\x18\b \x01(\v2\".lilbattle.v1.ConstructionProgressR\fconstruction\x120\n" +
+	"\x06hazard\x18\t \x01(\v2\x18.lilbattle.v1.TileHazardR\x06hazard\x12%\n" +
+	"\x0evictory_points\x18\n" +
+	" \x01(\x05R\rvictoryPoints\"K\n" +
+	"\n" +
+	"TileHazard\x12\x16\n" +
+	"\x06damage\x18\x01 \x01(\x05R\x06damage\x12%\n" +
+	"\x0estops_movement\x18\x02 \x01(\bR\rstopsMovement\"\xe8\x01\n" +
+	"\x14ConstructionProgress\x12\x15\n" +
+	"\x06unit_q\x18\x01 \x01(\x05R\x05unitQ\x12\x15\n" +
+	"\x06unit_r\x18\x02 \x01(\x05R\x05unitR\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\x05R\x06player\x12%\n" +
+	"\x0etarget_terrain\x18\x04 \x01(\x05R\rtargetTerrain\x12'\n" +
+	"\x0fturns_remaining\x18\x05 \x01(\x05R\x0eturnsRemaining\x12!\n" +
+	"\fstarted_turn\x18\x06 \x01(\x05R\vstartedTurn\x12\x17\n" +
+	"\aunit_id\x18\a \x01(\x05R\x06unitId\"\x8e\x05\n" +
 	"\x04Unit\x12\f\n" +
 	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
 	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x16\n" +
@@ -4683,13 +8957,17 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x0eattack_history\x18\v \x03(\v2\x1a.lilbattle.v1.AttackRecordR\rattackHistory\x12)\n" +
 	"\x10progression_step\x18\f \x01(\x05R\x0fprogressionStep\x12-\n" +
 	"\x12chosen_alternative\x18\r \x01(\tR\x11chosenAlternative\x120\n" +
-	"\x14capture_started_turn\x18\x0e \x01(\x05R\x12captureStartedTurn\"h\n" +
+	"\x14capture_started_turn\x18\x0e \x01(\x05R\x12captureStartedTurn\x12\x1c\n" +
+	"\tsubmerged\x18\x0f \x01(\bR\tsubmerged\x12\x16\n" +
+	"\x06facing\x18\x10 \x01(\x05R\x06facing\x12\x0e\n" +
+	"\x02id\x18\x11 \x01(\x05R\x02id\x12!\n" +
+	"\fstep_actions\x18\x12 \x01(\x05R\vstepActions\"h\n" +
 	"\fAttackRecord\x12\f\n" +
 	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
 	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
 	"\tis_ranged\x18\x03 \x01(\bR\bisRanged\x12\x1f\n" +
 	"\vturn_number\x18\x04 \x01(\x05R\n" +
-	"turnNumber\"\x89\x03\n" +
+	"turnNumber\"\xac\x03\n" +
 	"\x11TerrainDefinition\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -4697,10 +8975,12 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\\\n" +
 	"\x0funit_properties\x18\a \x03(\v23.lilbattle.v1.TerrainDefinition.UnitPropertiesEntryR\x0eunitProperties\x12,\n" +
 	"\x12buildable_unit_ids\x18\b \x03(\x05R\x10buildableUnitIds\x12&\n" +
-	"\x0fincome_per_turn\x18\t \x01(\x05R\rincomePerTurn\x1af\n" +
+	"\x0fincome_per_turn\x18\t \x01(\x05R\rincomePerTurn\x12!\n" +
+	"\fvision_bonus\x18\n" +
+	" \x01(\x05R\vvisionBonus\x1af\n" +
 	"\x13UnitPropertiesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x129\n" +
-	"\x05value\x18\x02 \x01(\v2#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x028\x01\"\x82\b\n" +
+	"\x05value\x18\x02 \x01(\v2#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x028\x01\"\xc6\t\n" +
 	"\x0eUnitDefinition\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -4724,7 +9004,12 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x0fattack_vs_class\x18\x10 \x03(\v2/.lilbattle.v1.UnitDefinition.AttackVsClassEntryR\rattackVsClass\x12!\n" +
 	"\faction_order\x18\x11 \x03(\tR\vactionOrder\x12S\n" +
 	"\raction_limits\x18\x12 \x03(\v2..lilbattle.v1.UnitDefinition.ActionLimitsEntryR\factionLimits\x12\x1b\n" +
-	"\tfix_value\x18\x13 \x01(\x05R\bfixValue\x1ai\n" +
+	"\tfix_value\x18\x13 \x01(\x05R\bfixValue\x12E\n" +
+	"\rconstructions\x18\x14 \x03(\v2\x1f.lilbattle.v1.TerrainConversionR\rconstructions\x12\x1f\n" +
+	"\vsight_range\x18\x15 \x01(\x05R\n" +
+	"sightRange\x125\n" +
+	"\tfootprint\x18\x16 \x03(\v2\x17.lilbattle.v1.HexOffsetR\tfootprint\x12#\n" +
+	"\rtransforms_to\x18\x17 \x03(\x05R\ftransformsTo\x1ai\n" +
 	"\x16TerrainPropertiesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x129\n" +
 	"\x05value\x18\x02 \x01(\v2#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x028\x01\x1a@\n" +
@@ -4733,7 +9018,17 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a?\n" +
 	"\x11ActionLimitsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xec\x02\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"+\n" +
+	"\tHexOffset\x12\x0e\n" +
+	"\x02dq\x18\x01 \x01(\x05R\x02dq\x12\x0e\n" +
+	"\x02dr\x18\x02 \x01(\x05R\x02dr\"\x95\x01\n" +
+	"\x11TerrainConversion\x12!\n" +
+	"\ffrom_terrain\x18\x01 \x01(\x05R\vfromTerrain\x12\x1d\n" +
+	"\n" +
+	"to_terrain\x18\x02 \x01(\x05R\ttoTerrain\x12\x14\n" +
+	"\x05coins\x18\x03 \x01(\x05R\x05coins\x12\x14\n" +
+	"\x05turns\x18\x04 \x01(\x05R\x05turns\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\"\xec\x02\n" +
 	"\x15TerrainUnitProperties\x12\x1d\n" +
 	"\n" +
 	"terrain_id\x18\x01 \x01(\x05R\tterrainId\x12\x17\n" +
@@ -4768,13 +9063,16 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\vDamageRange\x12\x1b\n" +
 	"\tmin_value\x18\x01 \x01(\x01R\bminValue\x12\x1b\n" +
 	"\tmax_value\x18\x02 \x01(\x01R\bmaxValue\x12 \n" +
-	"\vprobability\x18\x03 \x01(\x01R\vprobability\"\x9d\a\n" +
+	"\vprobability\x18\x03 \x01(\x01R\vprobability\"\xa3\b\n" +
 	"\vRulesEngine\x12:\n" +
 	"\x05units\x18\x01 \x03(\v2$.lilbattle.v1.RulesEngine.UnitsEntryR\x05units\x12C\n" +
 	"\bterrains\x18\x02 \x03(\v2'.lilbattle.v1.RulesEngine.TerrainsEntryR\bterrains\x12l\n" +
 	"\x17terrain_unit_properties\x18\x03 \x03(\v24.lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntryR\x15terrainUnitProperties\x12c\n" +
 	"\x14unit_unit_properties\x18\x04 \x03(\v21.lilbattle.v1.RulesEngine.UnitUnitPropertiesEntryR\x12unitUnitProperties\x12P\n" +
-	"\rterrain_types\x18\x05 \x03(\v2+.lilbattle.v1.RulesEngine.TerrainTypesEntryR\fterrainTypes\x1aV\n" +
+	"\rterrain_types\x18\x05 \x03(\v2+.lilbattle.v1.RulesEngine.TerrainTypesEntryR\fterrainTypes\x12&\n" +
+	"\x0fmulti_hex_units\x18\x06 \x01(\bR\rmultiHexUnits\x12+\n" +
+	"\x11mandatory_actions\x18\a \x03(\tR\x10mandatoryActions\x12/\n" +
+	"\x14max_units_per_player\x18\b \x01(\x05R\x11maxUnitsPerPlayer\x1aV\n" +
 	"\n" +
 	"UnitsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x122\n" +
@@ -4790,7 +9088,7 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2 .lilbattle.v1.UnitUnitPropertiesR\x05value:\x028\x01\x1aZ\n" +
 	"\x11TerrainTypesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\x0e2\x19.lilbattle.v1.TerrainTypeR\x05value:\x028\x01\"\x88\x04\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x19.lilbattle.v1.TerrainTypeR\x05value:\x028\x01\"\xd5\x05\n" +
 	"\x04Game\x129\n" +
 	"\n" +
 	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
@@ -4811,12 +9109,20 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"difficulty\x127\n" +
 	"\x06config\x18\f \x01(\v2\x1f.lilbattle.v1.GameConfigurationR\x06config\x12!\n" +
 	"\fpreview_urls\x18\r \x03(\tR\vpreviewUrls\x12C\n" +
-	"\x11search_index_info\x18\x0f \x01(\v2\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\"\xf0\x01\n" +
+	"\x11search_index_info\x18\x0f \x01(\v2\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\x12 \n" +
+	"\vorientation\x18\x10 \x01(\tR\vorientation\x126\n" +
+	"\n" +
+	"random_map\x18\x11 \x01(\v2\x17.lilbattle.v1.RandomMapR\trandomMap\x12\x1c\n" +
+	"\tthumbnail\x18\x12 \x01(\tR\tthumbnail\x12-\n" +
+	"\x13forked_from_game_id\x18\x13 \x01(\tR\x10forkedFromGameId\x12$\n" +
+	"\x0eforked_at_move\x18\x14 \x01(\x05R\fforkedAtMove\"\x89\x03\n" +
 	"\x11GameConfiguration\x122\n" +
 	"\aplayers\x18\x01 \x03(\v2\x18.lilbattle.v1.GamePlayerR\aplayers\x12,\n" +
 	"\x05teams\x18\x02 \x03(\v2\x16.lilbattle.v1.GameTeamR\x05teams\x12A\n" +
 	"\x0eincome_configs\x18\x03 \x01(\v2\x1a.lilbattle.v1.IncomeConfigR\rincomeConfigs\x126\n" +
-	"\bsettings\x18\x04 \x01(\v2\x1a.lilbattle.v1.GameSettingsR\bsettings\"\xab\x02\n" +
+	"\bsettings\x18\x04 \x01(\v2\x1a.lilbattle.v1.GameSettingsR\bsettings\x12P\n" +
+	"\x15world_rules_overrides\x18\x05 \x01(\v2\x1c.lilbattle.v1.RulesOverridesR\x13worldRulesOverrides\x12E\n" +
+	"\x0frules_overrides\x18\x06 \x01(\v2\x1c.lilbattle.v1.RulesOverridesR\x0erulesOverrides\"\xab\x02\n" +
 	"\fIncomeConfig\x12%\n" +
 	"\x0estarting_coins\x18\x01 \x01(\x05R\rstartingCoins\x12\x1f\n" +
 	"\vgame_income\x18\x02 \x01(\x05R\n" +
@@ -4825,7 +9131,7 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x10navalbase_income\x18\x04 \x01(\x05R\x0fnavalbaseIncome\x12-\n" +
 	"\x12airportbase_income\x18\x05 \x01(\x05R\x11airportbaseIncome\x12-\n" +
 	"\x12missilesilo_income\x18\x06 \x01(\x05R\x11missilesiloIncome\x12!\n" +
-	"\fmines_income\x18\a \x01(\x05R\vminesIncome\"\xea\x01\n" +
+	"\fmines_income\x18\a \x01(\x05R\vminesIncome\"\xa4\x02\n" +
 	"\n" +
 	"GamePlayer\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x17\n" +
@@ -4836,20 +9142,60 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\ateam_id\x18\x05 \x01(\x05R\x06teamId\x12\x12\n" +
 	"\x04name\x18\x06 \x01(\tR\x04name\x12\x1b\n" +
 	"\tis_active\x18\a \x01(\bR\bisActive\x12%\n" +
-	"\x0estarting_coins\x18\b \x01(\x05R\rstartingCoins\"j\n" +
+	"\x0estarting_coins\x18\b \x01(\x05R\rstartingCoins\x128\n" +
+	"\bhandicap\x18\n" +
+	" \x01(\v2\x1c.lilbattle.v1.PlayerHandicapR\bhandicap\"R\n" +
+	"\x0ePlayerHandicap\x12\x1f\n" +
+	"\vextra_coins\x18\x01 \x01(\x05R\n" +
+	"extraCoins\x12\x1f\n" +
+	"\vbonus_units\x18\x02 \x03(\x05R\n" +
+	"bonusUnits\"j\n" +
 	"\bGameTeam\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05color\x18\x03 \x01(\tR\x05color\x12\x1b\n" +
-	"\tis_active\x18\x04 \x01(\bR\bisActive\"\x95\x01\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\"\xad\x05\n" +
 	"\fGameSettings\x12#\n" +
 	"\rallowed_units\x18\x01 \x03(\x05R\fallowedUnits\x12&\n" +
 	"\x0fturn_time_limit\x18\x02 \x01(\x05R\rturnTimeLimit\x12\x1b\n" +
 	"\tteam_mode\x18\x03 \x01(\tR\bteamMode\x12\x1b\n" +
-	"\tmax_turns\x18\x04 \x01(\x05R\bmaxTurns\"@\n" +
+	"\tmax_turns\x18\x04 \x01(\x05R\bmaxTurns\x12;\n" +
+	"\ttime_bank\x18\x05 \x01(\v2\x1e.lilbattle.v1.TimeBankSettingsR\btimeBank\x12'\n" +
+	"\x0fstealth_enabled\x18\x06 \x01(\bR\x0estealthEnabled\x12\x14\n" +
+	"\x05rated\x18\a \x01(\bR\x05rated\x12\x1f\n" +
+	"\vfog_enabled\x18\b \x01(\bR\n" +
+	"fogEnabled\x121\n" +
+	"\x05draft\x18\t \x01(\v2\x1b.lilbattle.v1.DraftSettingsR\x05draft\x124\n" +
+	"\x06puzzle\x18\n" +
+	" \x01(\v2\x1c.lilbattle.v1.PuzzleSettingsR\x06puzzle\x12.\n" +
+	"\x13allow_friendly_fire\x18\v \x01(\bR\x11allowFriendlyFire\x121\n" +
+	"\x15victory_points_to_win\x18\f \x01(\x05R\x12victoryPointsToWin\x12-\n" +
+	"\x12simultaneous_turns\x18\r \x01(\bR\x11simultaneousTurns\x12E\n" +
+	"\x0fspectator_delay\x18\x0e \x01(\v2\x1c.lilbattle.v1.SpectatorDelayR\x0espectatorDelay\x127\n" +
+	"\asandbox\x18\x0f \x01(\v2\x1d.lilbattle.v1.SandboxSettingsR\asandbox\"@\n" +
+	"\x0eSpectatorDelay\x12\x14\n" +
+	"\x05turns\x18\x01 \x01(\x05R\x05turns\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\x05R\aseconds\"\xaa\x01\n" +
+	"\x0fSandboxSettings\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12(\n" +
+	"\x10relax_turn_order\x18\x02 \x01(\bR\x0erelaxTurnOrder\x12,\n" +
+	"\x12relax_action_order\x18\x03 \x01(\bR\x10relaxActionOrder\x12%\n" +
+	"\x0einfinite_coins\x18\x04 \x01(\bR\rinfiniteCoins\"a\n" +
+	"\rDraftSettings\x12&\n" +
+	"\x0fbans_per_player\x18\x01 \x01(\x05R\rbansPerPlayer\x12(\n" +
+	"\x10picks_per_player\x18\x02 \x01(\x05R\x0epicksPerPlayer\"\xa4\x01\n" +
+	"\x10TimeBankSettings\x12'\n" +
+	"\x0finitial_seconds\x18\x01 \x01(\x05R\x0einitialSeconds\x12+\n" +
+	"\x11increment_seconds\x18\x02 \x01(\x05R\x10incrementSeconds\x12:\n" +
+	"\n" +
+	"on_timeout\x18\x03 \x01(\x0e2\x1b.lilbattle.v1.TimeoutActionR\tonTimeout\"\xb4\x01\n" +
 	"\vPlayerState\x12\x14\n" +
 	"\x05coins\x18\x01 \x01(\x05R\x05coins\x12\x1b\n" +
-	"\tis_active\x18\x02 \x01(\bR\bisActive\"\x90\x05\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12 \n" +
+	"\ftime_bank_ms\x18\x03 \x01(\x03R\n" +
+	"timeBankMs\x12%\n" +
+	"\x0evictory_points\x18\x04 \x01(\x05R\rvictoryPoints\x12)\n" +
+	"\x10discovered_hexes\x18\x05 \x03(\tR\x0fdiscoveredHexes\"\x8d\b\n" +
 	"\tGameState\x129\n" +
 	"\n" +
 	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
@@ -4867,10 +9213,84 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x0ewinning_player\x18\f \x01(\x05R\rwinningPlayer\x12!\n" +
 	"\fwinning_team\x18\r \x01(\x05R\vwinningTeam\x120\n" +
 	"\x14current_group_number\x18\x0e \x01(\x03R\x12currentGroupNumber\x12N\n" +
-	"\rplayer_states\x18\x0f \x03(\v2).lilbattle.v1.GameState.PlayerStatesEntryR\fplayerStates\x1aZ\n" +
+	"\rplayer_states\x18\x0f \x03(\v2).lilbattle.v1.GameState.PlayerStatesEntryR\fplayerStates\x12D\n" +
+	"\x10clock_started_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x0eclockStartedAt\x12!\n" +
+	"\fclock_paused\x18\x11 \x01(\bR\vclockPaused\x12%\n" +
+	"\x0epause_requests\x18\x12 \x03(\x05R\rpauseRequests\x12!\n" +
+	"\fdelegated_to\x18\x13 \x01(\x05R\vdelegatedTo\x12.\n" +
+	"\x05draft\x18\x14 \x01(\v2\x18.lilbattle.v1.DraftStateR\x05draft\x12?\n" +
+	"\rpuzzle_result\x18\x15 \x01(\x0e2\x1a.lilbattle.v1.PuzzleResultR\fpuzzleResult\x12 \n" +
+	"\fnext_unit_id\x18\x16 \x01(\x05R\n" +
+	"nextUnitId\x125\n" +
+	"\n" +
+	"turn_plans\x18\x17 \x03(\v2\x16.lilbattle.v1.TurnPlanR\tturnPlans\x1aZ\n" +
 	"\x11PlayerStatesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.lilbattle.v1.PlayerStateR\x05value:\x028\x01\"_\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.lilbattle.v1.PlayerStateR\x05value:\x028\x01\"\xf1\x01\n" +
+	"\x0ePuzzleSettings\x12\x12\n" +
+	"\x04goal\x18\x01 \x01(\tR\x04goal\x12\x1f\n" +
+	"\vturn_budget\x18\x02 \x01(\x05R\n" +
+	"turnBudget\x12#\n" +
+	"\rsolver_player\x18\x03 \x01(\x05R\fsolverPlayer\x12G\n" +
+	"\x0eopponent_turns\x18\x04 \x03(\v2 .lilbattle.v1.PuzzleOpponentTurnR\ropponentTurns\x12\x1f\n" +
+	"\vopponent_ai\x18\x05 \x01(\bR\n" +
+	"opponentAi\x12\x1b\n" +
+	"\tpuzzle_id\x18\x06 \x01(\tR\bpuzzleId\"B\n" +
+	"\x12PuzzleOpponentTurn\x12,\n" +
+	"\x05moves\x18\x01 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\"\xde\x01\n" +
+	"\n" +
+	"DraftState\x12!\n" +
+	"\fbanned_units\x18\x01 \x03(\x05R\vbannedUnits\x12L\n" +
+	"\fpicked_units\x18\x02 \x03(\v2).lilbattle.v1.DraftState.PickedUnitsEntryR\vpickedUnits\x12\x1f\n" +
+	"\vturns_taken\x18\x03 \x01(\x05R\n" +
+	"turnsTaken\x1a>\n" +
+	"\x10PickedUnitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"S\n" +
+	"\bTurnPlan\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\x05R\x06player\x12/\n" +
+	"\x05moves\x18\x02 \x03(\v2\x19.lilbattle.v1.PlannedMoveR\x05moves\"R\n" +
+	"\vPlannedMove\x12*\n" +
+	"\x04move\x18\x01 \x01(\v2\x16.lilbattle.v1.GameMoveR\x04move\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x05R\x06unitId\"\x8c\x02\n" +
+	"\rStuckAnalysis\x12\x14\n" +
+	"\x05stuck\x18\x01 \x01(\bR\x05stuck\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\x05R\x06player\x12)\n" +
+	"\x10actionable_units\x18\x03 \x01(\x05R\x0factionableUnits\x12+\n" +
+	"\x11affordable_builds\x18\x04 \x01(\x05R\x10affordableBuilds\x12.\n" +
+	"\x13income_growth_paths\x18\x05 \x01(\x05R\x11incomeGrowthPaths\x12-\n" +
+	"\x12forces_unreachable\x18\x06 \x01(\bR\x11forcesUnreachable\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"\xd6\x01\n" +
+	"\tStateDiff\x12\x1b\n" +
+	"\tfrom_turn\x18\x01 \x01(\x05R\bfromTurn\x12\x17\n" +
+	"\ato_turn\x18\x02 \x01(\x05R\x06toTurn\x12,\n" +
+	"\x05units\x18\x03 \x03(\v2\x16.lilbattle.v1.UnitDiffR\x05units\x121\n" +
+	"\x05tiles\x18\x04 \x03(\v2\x1b.lilbattle.v1.TileOwnerDiffR\x05tiles\x122\n" +
+	"\aplayers\x18\x05 \x03(\v2\x18.lilbattle.v1.PlayerDiffR\aplayers\"\xc2\x01\n" +
+	"\bUnitDiff\x12.\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1a.lilbattle.v1.UnitDiffKindR\x04kind\x12*\n" +
+	"\x06before\x18\x02 \x01(\v2\x12.lilbattle.v1.UnitR\x06before\x12(\n" +
+	"\x05after\x18\x03 \x01(\v2\x12.lilbattle.v1.UnitR\x05after\x120\n" +
+	"\x06deltas\x18\x04 \x03(\v2\x18.lilbattle.v1.FieldDeltaR\x06deltas\"P\n" +
+	"\n" +
+	"FieldDelta\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\x8c\x01\n" +
+	"\rTileOwnerDiff\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
+	"\ttile_type\x18\x03 \x01(\x05R\btileType\x12%\n" +
+	"\x0eprevious_owner\x18\x04 \x01(\x05R\rpreviousOwner\x12\x1b\n" +
+	"\tnew_owner\x18\x05 \x01(\x05R\bnewOwner\"\xa9\x01\n" +
+	"\n" +
+	"PlayerDiff\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12%\n" +
+	"\x0eprevious_coins\x18\x02 \x01(\x05R\rpreviousCoins\x12\x1b\n" +
+	"\tnew_coins\x18\x03 \x01(\x05R\bnewCoins\x12\x1d\n" +
+	"\n" +
+	"was_active\x18\x04 \x01(\bR\twasActive\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\"_\n" +
 	"\x0fGameMoveHistory\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x123\n" +
 	"\x06groups\x18\x02 \x03(\v2\x1b.lilbattle.v1.GameMoveGroupR\x06groups\"\xd2\x01\n" +
@@ -4879,7 +9299,15 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
 	"\bended_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12!\n" +
 	"\fgroup_number\x18\x04 \x01(\x03R\vgroupNumber\x12,\n" +
-	"\x05moves\x18\x05 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\"\x8d\x06\n" +
+	"\x05moves\x18\x05 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\"\x91\x01\n" +
+	"\fTurnSnapshot\x12!\n" +
+	"\fturn_counter\x18\x01 \x01(\x05R\vturnCounter\x12%\n" +
+	"\x0ecurrent_player\x18\x02 \x01(\x05R\rcurrentPlayer\x12!\n" +
+	"\fgroup_number\x18\x03 \x01(\x03R\vgroupNumber\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\fR\x05state\"b\n" +
+	"\rTurnSnapshots\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x128\n" +
+	"\tsnapshots\x18\x02 \x03(\v2\x1a.lilbattle.v1.TurnSnapshotR\tsnapshots\"\xaa\v\n" +
 	"\bGameMove\x12\x16\n" +
 	"\x06player\x18\x01 \x01(\x05R\x06player\x12!\n" +
 	"\fgroup_number\x18\x02 \x01(\x03R\vgroupNumber\x12\x1f\n" +
@@ -4894,22 +9322,45 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"build_unit\x18\b \x01(\v2\x1d.lilbattle.v1.BuildUnitActionH\x00R\tbuildUnit\x12P\n" +
 	"\x10capture_building\x18\r \x01(\v2#.lilbattle.v1.CaptureBuildingActionH\x00R\x0fcaptureBuilding\x12;\n" +
 	"\theal_unit\x18\x0e \x01(\v2\x1c.lilbattle.v1.HealUnitActionH\x00R\bhealUnit\x128\n" +
-	"\bfix_unit\x18\x0f \x01(\v2\x1b.lilbattle.v1.FixUnitActionH\x00R\afixUnit\x12!\n" +
+	"\bfix_unit\x18\x0f \x01(\v2\x1b.lilbattle.v1.FixUnitActionH\x00R\afixUnit\x12S\n" +
+	"\x11construct_terrain\x18\x10 \x01(\v2$.lilbattle.v1.ConstructTerrainActionH\x00R\x10constructTerrain\x12G\n" +
+	"\rsubmerge_unit\x18\x11 \x01(\v2 .lilbattle.v1.SubmergeUnitActionH\x00R\fsubmergeUnit\x12G\n" +
+	"\rdelegate_turn\x18\x12 \x01(\v2 .lilbattle.v1.DelegateTurnActionH\x00R\fdelegateTurn\x12>\n" +
+	"\n" +
+	"draft_unit\x18\x15 \x01(\v2\x1d.lilbattle.v1.DraftUnitActionH\x00R\tdraftUnit\x12J\n" +
+	"\x0etransform_unit\x18\x16 \x01(\v2!.lilbattle.v1.TransformUnitActionH\x00R\rtransformUnit\x12A\n" +
+	"\vsubmit_plan\x18\x17 \x01(\v2\x1e.lilbattle.v1.SubmitPlanActionH\x00R\n" +
+	"submitPlan\x12>\n" +
+	"\n" +
+	"spawn_unit\x18\x18 \x01(\v2\x1d.lilbattle.v1.SpawnUnitActionH\x00R\tspawnUnit\x12A\n" +
+	"\vdelete_unit\x18\x19 \x01(\v2\x1e.lilbattle.v1.DeleteUnitActionH\x00R\n" +
+	"deleteUnit\x12!\n" +
 	"\fsequence_num\x18\t \x01(\x03R\vsequenceNum\x12!\n" +
 	"\fis_permanent\x18\n" +
 	" \x01(\bR\visPermanent\x123\n" +
 	"\achanges\x18\v \x03(\v2\x19.lilbattle.v1.WorldChangeR\achanges\x12 \n" +
-	"\vdescription\x18\f \x01(\tR\vdescriptionB\v\n" +
-	"\tmove_type\"<\n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\x12!\n" +
+	"\fsubmitted_by\x18\x13 \x01(\x05R\vsubmittedBy\x12?\n" +
+	"\rcoach_verdict\x18\x14 \x01(\v2\x1a.lilbattle.v1.CoachVerdictR\fcoachVerdictB\v\n" +
+	"\tmove_type\"\xd4\x01\n" +
+	"\fCoachVerdict\x12\x18\n" +
+	"\aflagged\x18\x01 \x01(\bR\aflagged\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\rthreatened_by\x18\x03 \x01(\x05R\fthreatenedBy\x12'\n" +
+	"\x0fexpected_damage\x18\x04 \x01(\x01R\x0eexpectedDamage\x12!\n" +
+	"\fscore_before\x18\x05 \x01(\x01R\vscoreBefore\x12\x1f\n" +
+	"\vscore_after\x18\x06 \x01(\x01R\n" +
+	"scoreAfter\"<\n" +
 	"\bPosition\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\tR\x05label\x12\f\n" +
 	"\x01q\x18\x02 \x01(\x05R\x01q\x12\f\n" +
-	"\x01r\x18\x03 \x01(\x05R\x01r\"\xcc\x01\n" +
+	"\x01r\x18\x03 \x01(\x05R\x01r\"\xef\x01\n" +
 	"\x0eMoveUnitAction\x12*\n" +
 	"\x04from\x18\x01 \x01(\v2\x16.lilbattle.v1.PositionR\x04from\x12&\n" +
 	"\x02to\x18\x02 \x01(\v2\x16.lilbattle.v1.PositionR\x02to\x12#\n" +
 	"\rmovement_cost\x18\x03 \x01(\x01R\fmovementCost\x12A\n" +
-	"\x12reconstructed_path\x18\x04 \x01(\v2\x12.lilbattle.v1.PathR\x11reconstructedPath\"\x9a\x02\n" +
+	"\x12reconstructed_path\x18\x04 \x01(\v2\x12.lilbattle.v1.PathR\x11reconstructedPath\x12!\n" +
+	"\freveal_count\x18\x05 \x01(\x05R\vrevealCount\"\x9a\x02\n" +
 	"\x10AttackUnitAction\x122\n" +
 	"\battacker\x18\x01 \x01(\v2\x16.lilbattle.v1.PositionR\battacker\x122\n" +
 	"\bdefender\x18\x02 \x01(\v2\x16.lilbattle.v1.PositionR\bdefender\x12(\n" +
@@ -4925,17 +9376,51 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x04cost\x18\x03 \x01(\x05R\x04cost\"^\n" +
 	"\x15CaptureBuildingAction\x12(\n" +
 	"\x03pos\x18\x01 \x01(\v2\x16.lilbattle.v1.PositionR\x03pos\x12\x1b\n" +
-	"\ttile_type\x18\x03 \x01(\x05R\btileType\"\x0f\n" +
-	"\rEndTurnAction\"[\n" +
+	"\ttile_type\x18\x03 \x01(\x05R\btileType\"`\n" +
+	"\rEndTurnAction\x12\x14\n" +
+	"\x05force\x18\x01 \x01(\bR\x05force\x12!\n" +
+	"\fturn_counter\x18\x02 \x01(\x05R\vturnCounter\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\x05R\x06player\"\x90\x01\n" +
+	"\x0eTurnObligation\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12*\n" +
+	"\x04unit\x18\x02 \x01(\v2\x16.lilbattle.v1.PositionR\x04unit\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tmandatory\x18\x04 \x01(\bR\tmandatory\"[\n" +
 	"\x0eHealUnitAction\x12(\n" +
 	"\x03pos\x18\x01 \x01(\v2\x16.lilbattle.v1.PositionR\x03pos\x12\x1f\n" +
 	"\vheal_amount\x18\x02 \x01(\x05R\n" +
-	"healAmount\"\x8c\x01\n" +
+	"healAmount\"c\n" +
+	"\x13TransformUnitAction\x12(\n" +
+	"\x03pos\x18\x01 \x01(\v2\x16.lilbattle.v1.PositionR\x03pos\x12\"\n" +
+	"\rnew_unit_type\x18\x02 \x01(\x05R\vnewUnitType\"p\n" +
+	"\x0fSpawnUnitAction\x12(\n" +
+	"\x03pos\x18\x01 \x01(\v2\x16.lilbattle.v1.PositionR\x03pos\x12\x1b\n" +
+	"\tunit_type\x18\x02 \x01(\x05R\bunitType\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\x05R\x06player\"<\n" +
+	"\x10DeleteUnitAction\x12(\n" +
+	"\x03pos\x18\x01 \x01(\v2\x16.lilbattle.v1.PositionR\x03pos\"\x8c\x01\n" +
 	"\rFixUnitAction\x12,\n" +
 	"\x05fixer\x18\x01 \x01(\v2\x16.lilbattle.v1.PositionR\x05fixer\x12.\n" +
 	"\x06target\x18\x02 \x01(\v2\x16.lilbattle.v1.PositionR\x06target\x12\x1d\n" +
 	"\n" +
-	"fix_amount\x18\x03 \x01(\x05R\tfixAmount\"\xd5\x05\n" +
+	"fix_amount\x18\x03 \x01(\x05R\tfixAmount\"\xe5\x01\n" +
+	"\x16ConstructTerrainAction\x12(\n" +
+	"\x03pos\x18\x01 \x01(\v2\x16.lilbattle.v1.PositionR\x03pos\x12.\n" +
+	"\x06target\x18\x02 \x01(\v2\x16.lilbattle.v1.PositionR\x06target\x12%\n" +
+	"\x0etarget_terrain\x18\x03 \x01(\x05R\rtargetTerrain\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x05R\x04cost\x12\x14\n" +
+	"\x05turns\x18\x05 \x01(\x05R\x05turns\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"Z\n" +
+	"\x12SubmergeUnitAction\x12(\n" +
+	"\x03pos\x18\x01 \x01(\v2\x16.lilbattle.v1.PositionR\x03pos\x12\x1a\n" +
+	"\bsubmerge\x18\x02 \x01(\bR\bsubmerge\"B\n" +
+	"\x12DelegateTurnAction\x12,\n" +
+	"\x12delegate_player_id\x18\x01 \x01(\x05R\x10delegatePlayerId\"B\n" +
+	"\x0fDraftUnitAction\x12\x1b\n" +
+	"\tunit_type\x18\x01 \x01(\x05R\bunitType\x12\x12\n" +
+	"\x04pick\x18\x02 \x01(\bR\x04pick\"@\n" +
+	"\x10SubmitPlanAction\x12,\n" +
+	"\x05moves\x18\x01 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\"\x88\v\n" +
 	"\vWorldChange\x12>\n" +
 	"\n" +
 	"unit_moved\x18\x01 \x01(\v2\x1d.lilbattle.v1.UnitMovedChangeH\x00R\tunitMoved\x12D\n" +
@@ -4952,13 +9437,60 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"unitHealed\x12>\n" +
 	"\n" +
 	"unit_fixed\x18\n" +
-	" \x01(\v2\x1d.lilbattle.v1.UnitFixedChangeH\x00R\tunitFixedB\r\n" +
-	"\vchange_type\"\xa3\x01\n" +
+	" \x01(\v2\x1d.lilbattle.v1.UnitFixedChangeH\x00R\tunitFixed\x12M\n" +
+	"\x0fterrain_changed\x18\v \x01(\v2\".lilbattle.v1.TerrainChangedChangeH\x00R\x0eterrainChanged\x12J\n" +
+	"\x0eunit_submerged\x18\f \x01(\v2!.lilbattle.v1.UnitSubmergedChangeH\x00R\runitSubmerged\x12J\n" +
+	"\x0eturn_delegated\x18\r \x01(\v2!.lilbattle.v1.TurnDelegatedChangeH\x00R\rturnDelegated\x12D\n" +
+	"\funit_drafted\x18\x0e \x01(\v2\x1f.lilbattle.v1.UnitDraftedChangeH\x00R\vunitDrafted\x12>\n" +
+	"\n" +
+	"game_event\x18\x0f \x01(\v2\x1d.lilbattle.v1.GameEventChangeH\x00R\tgameEvent\x12P\n" +
+	"\x10unit_transformed\x18\x10 \x01(\v2#.lilbattle.v1.UnitTransformedChangeH\x00R\x0funitTransformed\x12]\n" +
+	"\x15victory_points_scored\x18\x11 \x01(\v2'.lilbattle.v1.VictoryPointsScoredChangeH\x00R\x13victoryPointsScored\x12G\n" +
+	"\runit_attacked\x18\x12 \x01(\v2 .lilbattle.v1.UnitAttackedChangeH\x00R\funitAttacked\x12J\n" +
+	"\x0eplan_submitted\x18\x13 \x01(\v2!.lilbattle.v1.PlanSubmittedChangeH\x00R\rplanSubmittedB\r\n" +
+	"\vchange_type\"C\n" +
+	"\tChangeLog\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.lilbattle.v1.ChangeLogEntryR\aentries\"\x80\x01\n" +
+	"\x0eChangeLogEntry\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\x05R\x06player\x12!\n" +
+	"\fturn_counter\x18\x02 \x01(\x05R\vturnCounter\x123\n" +
+	"\achanges\x18\x03 \x03(\v2\x19.lilbattle.v1.WorldChangeR\achanges\"\xca\x01\n" +
+	"\x11UnitDraftedChange\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x1b\n" +
+	"\tunit_type\x18\x02 \x01(\x05R\bunitType\x12\x12\n" +
+	"\x04pick\x18\x03 \x01(\bR\x04pick\x12\x1f\n" +
+	"\vnext_player\x18\x04 \x01(\x05R\n" +
+	"nextPlayer\x12%\n" +
+	"\x0edraft_complete\x18\x05 \x01(\bR\rdraftComplete\x12\x1f\n" +
+	"\vturns_taken\x18\x06 \x01(\x05R\n" +
+	"turnsTaken\"s\n" +
+	"\x13PlanSubmittedChange\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12#\n" +
+	"\rplanned_moves\x18\x02 \x01(\x05R\fplannedMoves\x12\x1a\n" +
+	"\bresolved\x18\x03 \x01(\bR\bresolved\"\xb6\x01\n" +
+	"\x0fGameEventChange\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12E\n" +
+	"\x0fskipped_actions\x18\x04 \x03(\v2\x1c.lilbattle.v1.TurnObligationR\x0eskippedActions\"`\n" +
+	"\x13TurnDelegatedChange\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12,\n" +
+	"\x12delegate_player_id\x18\x02 \x01(\x05R\x10delegatePlayerId\"\x85\x01\n" +
+	"\x13UnitSubmergedChange\x127\n" +
+	"\rprevious_unit\x18\x01 \x01(\v2\x12.lilbattle.v1.UnitR\fpreviousUnit\x125\n" +
+	"\fupdated_unit\x18\x02 \x01(\v2\x12.lilbattle.v1.UnitR\vupdatedUnit\"\x86\x01\n" +
+	"\x14TerrainChangedChange\x127\n" +
+	"\rprevious_tile\x18\x01 \x01(\v2\x12.lilbattle.v1.TileR\fpreviousTile\x125\n" +
+	"\fupdated_tile\x18\x02 \x01(\v2\x12.lilbattle.v1.TileR\vupdatedTile\"\xa3\x01\n" +
 	"\x10UnitHealedChange\x127\n" +
 	"\rprevious_unit\x18\x01 \x01(\v2\x12.lilbattle.v1.UnitR\fpreviousUnit\x125\n" +
 	"\fupdated_unit\x18\x02 \x01(\v2\x12.lilbattle.v1.UnitR\vupdatedUnit\x12\x1f\n" +
 	"\vheal_amount\x18\x03 \x01(\x05R\n" +
-	"healAmount\"\xdb\x01\n" +
+	"healAmount\"\x87\x01\n" +
+	"\x15UnitTransformedChange\x127\n" +
+	"\rprevious_unit\x18\x01 \x01(\v2\x12.lilbattle.v1.UnitR\fpreviousUnit\x125\n" +
+	"\fupdated_unit\x18\x02 \x01(\v2\x12.lilbattle.v1.UnitR\vupdatedUnit\"\xdb\x01\n" +
 	"\x0fUnitFixedChange\x121\n" +
 	"\n" +
 	"fixer_unit\x18\x01 \x01(\v2\x12.lilbattle.v1.UnitR\tfixerUnit\x12;\n" +
@@ -4972,8 +9504,29 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x11UnitDamagedChange\x127\n" +
 	"\rprevious_unit\x18\x06 \x01(\v2\x12.lilbattle.v1.UnitR\fpreviousUnit\x125\n" +
 	"\fupdated_unit\x18\a \x01(\v2\x12.lilbattle.v1.UnitR\vupdatedUnit\"K\n" +
+	"\x12UnitAttackedChange\x125\n" +
+	"\asummary\x18\x01 \x01(\v2\x1b.lilbattle.v1.CombatSummaryR\asummary\"\xd5\x05\n" +
+	"\rCombatSummary\x12.\n" +
+	"\battacker\x18\x01 \x01(\v2\x12.lilbattle.v1.UnitR\battacker\x12.\n" +
+	"\bdefender\x18\x02 \x01(\v2\x12.lilbattle.v1.UnitR\bdefender\x12\x16\n" +
+	"\x06damage\x18\x03 \x01(\x05R\x06damage\x12'\n" +
+	"\x0fexpected_damage\x18\x04 \x01(\x01R\x0eexpectedDamage\x12\x1c\n" +
+	"\tcountered\x18\x05 \x01(\bR\tcountered\x12%\n" +
+	"\x0ecounter_damage\x18\x06 \x01(\x05R\rcounterDamage\x126\n" +
+	"\x17expected_counter_damage\x18\a \x01(\x01R\x15expectedCounterDamage\x120\n" +
+	"\x14attack_terrain_bonus\x18\b \x01(\x05R\x12attackTerrainBonus\x122\n" +
+	"\x15defense_terrain_bonus\x18\t \x01(\x05R\x13defenseTerrainBonus\x12?\n" +
+	"\x1ccounter_attack_terrain_bonus\x18\n" +
+	" \x01(\x05R\x19counterAttackTerrainBonus\x12A\n" +
+	"\x1dcounter_defense_terrain_bonus\x18\v \x01(\x05R\x1acounterDefenseTerrainBonus\x12\x1f\n" +
+	"\vwound_bonus\x18\f \x01(\x05R\n" +
+	"woundBonus\x12'\n" +
+	"\x0fattacker_health\x18\r \x01(\x05R\x0eattackerHealth\x12'\n" +
+	"\x0fdefender_health\x18\x0e \x01(\x05R\x0edefenderHealth\x12\x1c\n" +
+	"\testimated\x18\x0f \x01(\bR\testimated\x12+\n" +
+	"\x11revenge_available\x18\x10 \x01(\bR\x10revengeAvailable\"K\n" +
 	"\x10UnitKilledChange\x127\n" +
-	"\rprevious_unit\x18\x06 \x01(\v2\x12.lilbattle.v1.UnitR\fpreviousUnit\"\xd2\x01\n" +
+	"\rprevious_unit\x18\x06 \x01(\v2\x12.lilbattle.v1.UnitR\fpreviousUnit\"\xec\x03\n" +
 	"\x13PlayerChangedChange\x12'\n" +
 	"\x0fprevious_player\x18\x01 \x01(\x05R\x0epreviousPlayer\x12\x1d\n" +
 	"\n" +
@@ -4981,14 +9534,26 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\rprevious_turn\x18\x03 \x01(\x05R\fpreviousTurn\x12\x19\n" +
 	"\bnew_turn\x18\x04 \x01(\x05R\anewTurn\x123\n" +
 	"\vreset_units\x18\x05 \x03(\v2\x12.lilbattle.v1.UnitR\n" +
-	"resetUnits\"\xa9\x01\n" +
+	"resetUnits\x12V\n" +
+	"\rtime_banks_ms\x18\x06 \x03(\v22.lilbattle.v1.PlayerChangedChange.TimeBanksMsEntryR\vtimeBanksMs\x12D\n" +
+	"\x10clock_started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0eclockStartedAt\x12:\n" +
+	"\x19previous_player_forfeited\x18\b \x01(\bR\x17previousPlayerForfeited\x1a>\n" +
+	"\x10TimeBanksMsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xa9\x01\n" +
 	"\x0fUnitBuiltChange\x12&\n" +
 	"\x04unit\x18\x01 \x01(\v2\x12.lilbattle.v1.UnitR\x04unit\x12\x15\n" +
 	"\x06tile_q\x18\x02 \x01(\x05R\x05tileQ\x12\x15\n" +
 	"\x06tile_r\x18\x03 \x01(\x05R\x05tileR\x12\x1d\n" +
 	"\n" +
 	"coins_cost\x18\x04 \x01(\x05R\tcoinsCost\x12!\n" +
-	"\fplayer_coins\x18\x05 \x01(\x05R\vplayerCoins\"\x8d\x01\n" +
+	"\fplayer_coins\x18\x05 \x01(\x05R\vplayerCoins\"\xae\x01\n" +
+	"\x19VictoryPointsScoredChange\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12'\n" +
+	"\x0fprevious_points\x18\x02 \x01(\x05R\x0epreviousPoints\x12\x1d\n" +
+	"\n" +
+	"new_points\x18\x03 \x01(\x05R\tnewPoints\x12,\n" +
+	"\amarkers\x18\x04 \x03(\v2\x12.lilbattle.v1.TileR\amarkers\"\x8d\x01\n" +
 	"\x12CoinsChangedChange\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12%\n" +
 	"\x0eprevious_coins\x18\x02 \x01(\x05R\rpreviousCoins\x12\x1b\n" +
@@ -5044,13 +9609,28 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x13TERRAIN_TYPE_NATURE\x10\x02\x12\x17\n" +
 	"\x13TERRAIN_TYPE_BRIDGE\x10\x03\x12\x16\n" +
 	"\x12TERRAIN_TYPE_WATER\x10\x04\x12\x15\n" +
-	"\x11TERRAIN_TYPE_ROAD\x10\x05*q\n" +
+	"\x11TERRAIN_TYPE_ROAD\x10\x05*\xa4\x01\n" +
 	"\n" +
 	"GameStatus\x12\x1b\n" +
 	"\x17GAME_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13GAME_STATUS_PLAYING\x10\x01\x12\x16\n" +
 	"\x12GAME_STATUS_PAUSED\x10\x02\x12\x15\n" +
-	"\x11GAME_STATUS_ENDED\x10\x03*\xde\x01\n" +
+	"\x11GAME_STATUS_ENDED\x10\x03\x12\x18\n" +
+	"\x14GAME_STATUS_DRAFTING\x10\x04\x12\x17\n" +
+	"\x13GAME_STATUS_WAITING\x10\x05*M\n" +
+	"\rTimeoutAction\x12 \n" +
+	"\x1cTIMEOUT_ACTION_AUTO_END_TURN\x10\x00\x12\x1a\n" +
+	"\x16TIMEOUT_ACTION_FORFEIT\x10\x01*a\n" +
+	"\fPuzzleResult\x12\x1d\n" +
+	"\x19PUZZLE_RESULT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PUZZLE_RESULT_SOLVED\x10\x01\x12\x18\n" +
+	"\x14PUZZLE_RESULT_FAILED\x10\x02*\x9e\x01\n" +
+	"\fUnitDiffKind\x12\x1e\n" +
+	"\x1aUNIT_DIFF_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14UNIT_DIFF_KIND_ADDED\x10\x01\x12\x1a\n" +
+	"\x16UNIT_DIFF_KIND_REMOVED\x10\x02\x12\x1a\n" +
+	"\x16UNIT_DIFF_KIND_CHANGED\x10\x03\x12\x1c\n" +
+	"\x18UNIT_DIFF_KIND_TRANSIENT\x10\x04*\xde\x01\n" +
 	"\rPathDirection\x12\x1e\n" +
 	"\x1aPATH_DIRECTION_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13PATH_DIRECTION_LEFT\x10\x01\x12\x1b\n" +
@@ -5073,181 +9653,313 @@ func file_lilbattle_v1_models_models_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_models_models_proto_rawDescData
 }
 
-var file_lilbattle_v1_models_models_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_lilbattle_v1_models_models_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_lilbattle_v1_models_models_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_lilbattle_v1_models_models_proto_msgTypes = make([]protoimpl.MessageInfo, 113)
 var file_lilbattle_v1_models_models_proto_goTypes = []any{
-	(CrossingType)(0),             // 0: lilbattle.v1.CrossingType
-	(TerrainType)(0),              // 1: lilbattle.v1.TerrainType
-	(GameStatus)(0),               // 2: lilbattle.v1.GameStatus
-	(PathDirection)(0),            // 3: lilbattle.v1.PathDirection
-	(*IndexInfo)(nil),             // 4: lilbattle.v1.IndexInfo
-	(*Pagination)(nil),            // 5: lilbattle.v1.Pagination
-	(*PaginationResponse)(nil),    // 6: lilbattle.v1.PaginationResponse
-	(*World)(nil),                 // 7: lilbattle.v1.World
-	(*WorldData)(nil),             // 8: lilbattle.v1.WorldData
-	(*Crossing)(nil),              // 9: lilbattle.v1.Crossing
-	(*Tile)(nil),                  // 10: lilbattle.v1.Tile
-	(*Unit)(nil),                  // 11: lilbattle.v1.Unit
-	(*AttackRecord)(nil),          // 12: lilbattle.v1.AttackRecord
-	(*TerrainDefinition)(nil),     // 13: lilbattle.v1.TerrainDefinition
-	(*UnitDefinition)(nil),        // 14: lilbattle.v1.UnitDefinition
-	(*TerrainUnitProperties)(nil), // 15: lilbattle.v1.TerrainUnitProperties
-	(*UnitUnitProperties)(nil),    // 16: lilbattle.v1.UnitUnitProperties
-	(*DamageDistribution)(nil),    // 17: lilbattle.v1.DamageDistribution
-	(*DamageRange)(nil),           // 18: lilbattle.v1.DamageRange
-	(*RulesEngine)(nil),           // 19: lilbattle.v1.RulesEngine
-	(*Game)(nil),                  // 20: lilbattle.v1.Game
-	(*GameConfiguration)(nil),     // 21: lilbattle.v1.GameConfiguration
-	(*IncomeConfig)(nil),          // 22: lilbattle.v1.IncomeConfig
-	(*GamePlayer)(nil),            // 23: lilbattle.v1.GamePlayer
-	(*GameTeam)(nil),              // 24: lilbattle.v1.GameTeam
-	(*GameSettings)(nil),          // 25: lilbattle.v1.GameSettings
-	(*PlayerState)(nil),           // 26: lilbattle.v1.PlayerState
-	(*GameState)(nil),             // 27: lilbattle.v1.GameState
-	(*GameMoveHistory)(nil),       // 28: lilbattle.v1.GameMoveHistory
-	(*GameMoveGroup)(nil),         // 29: lilbattle.v1.GameMoveGroup
-	(*GameMove)(nil),              // 30: lilbattle.v1.GameMove
-	(*Position)(nil),              // 31: lilbattle.v1.Position
-	(*MoveUnitAction)(nil),        // 32: lilbattle.v1.MoveUnitAction
-	(*AttackUnitAction)(nil),      // 33: lilbattle.v1.AttackUnitAction
-	(*BuildUnitAction)(nil),       // 34: lilbattle.v1.BuildUnitAction
-	(*CaptureBuildingAction)(nil), // 35: lilbattle.v1.CaptureBuildingAction
-	(*EndTurnAction)(nil),         // 36: lilbattle.v1.EndTurnAction
-	(*HealUnitAction)(nil),        // 37: lilbattle.v1.HealUnitAction
-	(*FixUnitAction)(nil),         // 38: lilbattle.v1.FixUnitAction
-	(*WorldChange)(nil),           // 39: lilbattle.v1.WorldChange
-	(*UnitHealedChange)(nil),      // 40: lilbattle.v1.UnitHealedChange
-	(*UnitFixedChange)(nil),       // 41: lilbattle.v1.UnitFixedChange
-	(*UnitMovedChange)(nil),       // 42: lilbattle.v1.UnitMovedChange
-	(*UnitDamagedChange)(nil),     // 43: lilbattle.v1.UnitDamagedChange
-	(*UnitKilledChange)(nil),      // 44: lilbattle.v1.UnitKilledChange
-	(*PlayerChangedChange)(nil),   // 45: lilbattle.v1.PlayerChangedChange
-	(*UnitBuiltChange)(nil),       // 46: lilbattle.v1.UnitBuiltChange
-	(*CoinsChangedChange)(nil),    // 47: lilbattle.v1.CoinsChangedChange
-	(*TileCapturedChange)(nil),    // 48: lilbattle.v1.TileCapturedChange
-	(*CaptureStartedChange)(nil),  // 49: lilbattle.v1.CaptureStartedChange
-	(*AllPaths)(nil),              // 50: lilbattle.v1.AllPaths
-	(*PathEdge)(nil),              // 51: lilbattle.v1.PathEdge
-	(*Path)(nil),                  // 52: lilbattle.v1.Path
-	nil,                           // 53: lilbattle.v1.WorldData.TilesMapEntry
-	nil,                           // 54: lilbattle.v1.WorldData.UnitsMapEntry
-	nil,                           // 55: lilbattle.v1.WorldData.CrossingsEntry
-	nil,                           // 56: lilbattle.v1.TerrainDefinition.UnitPropertiesEntry
-	nil,                           // 57: lilbattle.v1.UnitDefinition.TerrainPropertiesEntry
-	nil,                           // 58: lilbattle.v1.UnitDefinition.AttackVsClassEntry
-	nil,                           // 59: lilbattle.v1.UnitDefinition.ActionLimitsEntry
-	nil,                           // 60: lilbattle.v1.RulesEngine.UnitsEntry
-	nil,                           // 61: lilbattle.v1.RulesEngine.TerrainsEntry
-	nil,                           // 62: lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry
-	nil,                           // 63: lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry
-	nil,                           // 64: lilbattle.v1.RulesEngine.TerrainTypesEntry
-	nil,                           // 65: lilbattle.v1.GameState.PlayerStatesEntry
-	nil,                           // 66: lilbattle.v1.AllPaths.EdgesEntry
-	(*timestamppb.Timestamp)(nil), // 67: google.protobuf.Timestamp
+	(CrossingType)(0),                 // 0: lilbattle.v1.CrossingType
+	(TerrainType)(0),                  // 1: lilbattle.v1.TerrainType
+	(GameStatus)(0),                   // 2: lilbattle.v1.GameStatus
+	(TimeoutAction)(0),                // 3: lilbattle.v1.TimeoutAction
+	(PuzzleResult)(0),                 // 4: lilbattle.v1.PuzzleResult
+	(UnitDiffKind)(0),                 // 5: lilbattle.v1.UnitDiffKind
+	(PathDirection)(0),                // 6: lilbattle.v1.PathDirection
+	(*IndexInfo)(nil),                 // 7: lilbattle.v1.IndexInfo
+	(*Pagination)(nil),                // 8: lilbattle.v1.Pagination
+	(*PaginationResponse)(nil),        // 9: lilbattle.v1.PaginationResponse
+	(*World)(nil),                     // 10: lilbattle.v1.World
+	(*RandomMap)(nil),                 // 11: lilbattle.v1.RandomMap
+	(*RulesOverrides)(nil),            // 12: lilbattle.v1.RulesOverrides
+	(*WorldRating)(nil),               // 13: lilbattle.v1.WorldRating
+	(*WorldData)(nil),                 // 14: lilbattle.v1.WorldData
+	(*Crossing)(nil),                  // 15: lilbattle.v1.Crossing
+	(*Tile)(nil),                      // 16: lilbattle.v1.Tile
+	(*TileHazard)(nil),                // 17: lilbattle.v1.TileHazard
+	(*ConstructionProgress)(nil),      // 18: lilbattle.v1.ConstructionProgress
+	(*Unit)(nil),                      // 19: lilbattle.v1.Unit
+	(*AttackRecord)(nil),              // 20: lilbattle.v1.AttackRecord
+	(*TerrainDefinition)(nil),         // 21: lilbattle.v1.TerrainDefinition
+	(*UnitDefinition)(nil),            // 22: lilbattle.v1.UnitDefinition
+	(*HexOffset)(nil),                 // 23: lilbattle.v1.HexOffset
+	(*TerrainConversion)(nil),         // 24: lilbattle.v1.TerrainConversion
+	(*TerrainUnitProperties)(nil),     // 25: lilbattle.v1.TerrainUnitProperties
+	(*UnitUnitProperties)(nil),        // 26: lilbattle.v1.UnitUnitProperties
+	(*DamageDistribution)(nil),        // 27: lilbattle.v1.DamageDistribution
+	(*DamageRange)(nil),               // 28: lilbattle.v1.DamageRange
+	(*RulesEngine)(nil),               // 29: lilbattle.v1.RulesEngine
+	(*Game)(nil),                      // 30: lilbattle.v1.Game
+	(*GameConfiguration)(nil),         // 31: lilbattle.v1.GameConfiguration
+	(*IncomeConfig)(nil),              // 32: lilbattle.v1.IncomeConfig
+	(*GamePlayer)(nil),                // 33: lilbattle.v1.GamePlayer
+	(*PlayerHandicap)(nil),            // 34: lilbattle.v1.PlayerHandicap
+	(*GameTeam)(nil),                  // 35: lilbattle.v1.GameTeam
+	(*GameSettings)(nil),              // 36: lilbattle.v1.GameSettings
+	(*SpectatorDelay)(nil),            // 37: lilbattle.v1.SpectatorDelay
+	(*SandboxSettings)(nil),           // 38: lilbattle.v1.SandboxSettings
+	(*DraftSettings)(nil),             // 39: lilbattle.v1.DraftSettings
+	(*TimeBankSettings)(nil),          // 40: lilbattle.v1.TimeBankSettings
+	(*PlayerState)(nil),               // 41: lilbattle.v1.PlayerState
+	(*GameState)(nil),                 // 42: lilbattle.v1.GameState
+	(*PuzzleSettings)(nil),            // 43: lilbattle.v1.PuzzleSettings
+	(*PuzzleOpponentTurn)(nil),        // 44: lilbattle.v1.PuzzleOpponentTurn
+	(*DraftState)(nil),                // 45: lilbattle.v1.DraftState
+	(*TurnPlan)(nil),                  // 46: lilbattle.v1.TurnPlan
+	(*PlannedMove)(nil),               // 47: lilbattle.v1.PlannedMove
+	(*StuckAnalysis)(nil),             // 48: lilbattle.v1.StuckAnalysis
+	(*StateDiff)(nil),                 // 49: lilbattle.v1.StateDiff
+	(*UnitDiff)(nil),                  // 50: lilbattle.v1.UnitDiff
+	(*FieldDelta)(nil),                // 51: lilbattle.v1.FieldDelta
+	(*TileOwnerDiff)(nil),             // 52: lilbattle.v1.TileOwnerDiff
+	(*PlayerDiff)(nil),                // 53: lilbattle.v1.PlayerDiff
+	(*GameMoveHistory)(nil),           // 54: lilbattle.v1.GameMoveHistory
+	(*GameMoveGroup)(nil),             // 55: lilbattle.v1.GameMoveGroup
+	(*TurnSnapshot)(nil),              // 56: lilbattle.v1.TurnSnapshot
+	(*TurnSnapshots)(nil),             // 57: lilbattle.v1.TurnSnapshots
+	(*GameMove)(nil),                  // 58: lilbattle.v1.GameMove
+	(*CoachVerdict)(nil),              // 59: lilbattle.v1.CoachVerdict
+	(*Position)(nil),                  // 60: lilbattle.v1.Position
+	(*MoveUnitAction)(nil),            // 61: lilbattle.v1.MoveUnitAction
+	(*AttackUnitAction)(nil),          // 62: lilbattle.v1.AttackUnitAction
+	(*BuildUnitAction)(nil),           // 63: lilbattle.v1.BuildUnitAction
+	(*CaptureBuildingAction)(nil),     // 64: lilbattle.v1.CaptureBuildingAction
+	(*EndTurnAction)(nil),             // 65: lilbattle.v1.EndTurnAction
+	(*TurnObligation)(nil),            // 66: lilbattle.v1.TurnObligation
+	(*HealUnitAction)(nil),            // 67: lilbattle.v1.HealUnitAction
+	(*TransformUnitAction)(nil),       // 68: lilbattle.v1.TransformUnitAction
+	(*SpawnUnitAction)(nil),           // 69: lilbattle.v1.SpawnUnitAction
+	(*DeleteUnitAction)(nil),          // 70: lilbattle.v1.DeleteUnitAction
+	(*FixUnitAction)(nil),             // 71: lilbattle.v1.FixUnitAction
+	(*ConstructTerrainAction)(nil),    // 72: lilbattle.v1.ConstructTerrainAction
+	(*SubmergeUnitAction)(nil),        // 73: lilbattle.v1.SubmergeUnitAction
+	(*DelegateTurnAction)(nil),        // 74: lilbattle.v1.DelegateTurnAction
+	(*DraftUnitAction)(nil),           // 75: lilbattle.v1.DraftUnitAction
+	(*SubmitPlanAction)(nil),          // 76: lilbattle.v1.SubmitPlanAction
+	(*WorldChange)(nil),               // 77: lilbattle.v1.WorldChange
+	(*ChangeLog)(nil),                 // 78: lilbattle.v1.ChangeLog
+	(*ChangeLogEntry)(nil),            // 79: lilbattle.v1.ChangeLogEntry
+	(*UnitDraftedChange)(nil),         // 80: lilbattle.v1.UnitDraftedChange
+	(*PlanSubmittedChange)(nil),       // 81: lilbattle.v1.PlanSubmittedChange
+	(*GameEventChange)(nil),           // 82: lilbattle.v1.GameEventChange
+	(*TurnDelegatedChange)(nil),       // 83: lilbattle.v1.TurnDelegatedChange
+	(*UnitSubmergedChange)(nil),       // 84: lilbattle.v1.UnitSubmergedChange
+	(*TerrainChangedChange)(nil),      // 85: lilbattle.v1.TerrainChangedChange
+	(*UnitHealedChange)(nil),          // 86: lilbattle.v1.UnitHealedChange
+	(*UnitTransformedChange)(nil),     // 87: lilbattle.v1.UnitTransformedChange
+	(*UnitFixedChange)(nil),           // 88: lilbattle.v1.UnitFixedChange
+	(*UnitMovedChange)(nil),           // 89: lilbattle.v1.UnitMovedChange
+	(*UnitDamagedChange)(nil),         // 90: lilbattle.v1.UnitDamagedChange
+	(*UnitAttackedChange)(nil),        // 91: lilbattle.v1.UnitAttackedChange
+	(*CombatSummary)(nil),             // 92: lilbattle.v1.CombatSummary
+	(*UnitKilledChange)(nil),          // 93: lilbattle.v1.UnitKilledChange
+	(*PlayerChangedChange)(nil),       // 94: lilbattle.v1.PlayerChangedChange
+	(*UnitBuiltChange)(nil),           // 95: lilbattle.v1.UnitBuiltChange
+	(*VictoryPointsScoredChange)(nil), // 96: lilbattle.v1.VictoryPointsScoredChange
+	(*CoinsChangedChange)(nil),        // 97: lilbattle.v1.CoinsChangedChange
+	(*TileCapturedChange)(nil),        // 98: lilbattle.v1.TileCapturedChange
+	(*CaptureStartedChange)(nil),      // 99: lilbattle.v1.CaptureStartedChange
+	(*AllPaths)(nil),                  // 100: lilbattle.v1.AllPaths
+	(*PathEdge)(nil),                  // 101: lilbattle.v1.PathEdge
+	(*Path)(nil),                      // 102: lilbattle.v1.Path
+	nil,                               // 103: lilbattle.v1.RulesOverrides.TerrainMovementCostsEntry
+	nil,                               // 104: lilbattle.v1.WorldData.TilesMapEntry
+	nil,                               // 105: lilbattle.v1.WorldData.UnitsMapEntry
+	nil,                               // 106: lilbattle.v1.WorldData.CrossingsEntry
+	nil,                               // 107: lilbattle.v1.TerrainDefinition.UnitPropertiesEntry
+	nil,                               // 108: lilbattle.v1.UnitDefinition.TerrainPropertiesEntry
+	nil,                               // 109: lilbattle.v1.UnitDefinition.AttackVsClassEntry
+	nil,                               // 110: lilbattle.v1.UnitDefinition.ActionLimitsEntry
+	nil,                               // 111: lilbattle.v1.RulesEngine.UnitsEntry
+	nil,                               // 112: lilbattle.v1.RulesEngine.TerrainsEntry
+	nil,                               // 113: lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry
+	nil,                               // 114: lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry
+	nil,                               // 115: lilbattle.v1.RulesEngine.TerrainTypesEntry
+	nil,                               // 116: lilbattle.v1.GameState.PlayerStatesEntry
+	nil,                               // 117: lilbattle.v1.DraftState.PickedUnitsEntry
+	nil,                               // 118: lilbattle.v1.PlayerChangedChange.TimeBanksMsEntry
+	nil,                               // 119: lilbattle.v1.AllPaths.EdgesEntry
+	(*timestamppb.Timestamp)(nil),     // 120: google.protobuf.Timestamp
 }
 var file_lilbattle_v1_models_models_proto_depIdxs = []int32{
-	67, // 0: lilbattle.v1.IndexInfo.last_updated_at:type_name -> google.protobuf.Timestamp
-	67, // 1: lilbattle.v1.IndexInfo.last_indexed_at:type_name -> google.protobuf.Timestamp
-	67, // 2: lilbattle.v1.World.created_at:type_name -> google.protobuf.Timestamp
-	67, // 3: lilbattle.v1.World.updated_at:type_name -> google.protobuf.Timestamp
-	21, // 4: lilbattle.v1.World.default_game_config:type_name -> lilbattle.v1.GameConfiguration
-	4,  // 5: lilbattle.v1.World.search_index_info:type_name -> lilbattle.v1.IndexInfo
-	53, // 6: lilbattle.v1.WorldData.tiles_map:type_name -> lilbattle.v1.WorldData.TilesMapEntry
-	54, // 7: lilbattle.v1.WorldData.units_map:type_name -> lilbattle.v1.WorldData.UnitsMapEntry
-	4,  // 8: lilbattle.v1.WorldData.screenshot_index_info:type_name -> lilbattle.v1.IndexInfo
-	55, // 9: lilbattle.v1.WorldData.crossings:type_name -> lilbattle.v1.WorldData.CrossingsEntry
-	0,  // 10: lilbattle.v1.Crossing.type:type_name -> lilbattle.v1.CrossingType
-	12, // 11: lilbattle.v1.Unit.attack_history:type_name -> lilbattle.v1.AttackRecord
-	56, // 12: lilbattle.v1.TerrainDefinition.unit_properties:type_name -> lilbattle.v1.TerrainDefinition.UnitPropertiesEntry
-	57, // 13: lilbattle.v1.UnitDefinition.terrain_properties:type_name -> lilbattle.v1.UnitDefinition.TerrainPropertiesEntry
-	58, // 14: lilbattle.v1.UnitDefinition.attack_vs_class:type_name -> lilbattle.v1.UnitDefinition.AttackVsClassEntry
-	59, // 15: lilbattle.v1.UnitDefinition.action_limits:type_name -> lilbattle.v1.UnitDefinition.ActionLimitsEntry
-	17, // 16: lilbattle.v1.UnitUnitProperties.damage:type_name -> lilbattle.v1.DamageDistribution
-	18, // 17: lilbattle.v1.DamageDistribution.ranges:type_name -> lilbattle.v1.DamageRange
-	60, // 18: lilbattle.v1.RulesEngine.units:type_name -> lilbattle.v1.RulesEngine.UnitsEntry
-	61, // 19: lilbattle.v1.RulesEngine.terrains:type_name -> lilbattle.v1.RulesEngine.TerrainsEntry
-	62, // 20: lilbattle.v1.RulesEngine.terrain_unit_properties:type_name -> lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry
-	63, // 21: lilbattle.v1.RulesEngine.unit_unit_properties:type_name -> lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry
-	64, // 22: lilbattle.v1.RulesEngine.terrain_types:type_name -> lilbattle.v1.RulesEngine.TerrainTypesEntry
-	67, // 23: lilbattle.v1.Game.created_at:type_name -> google.protobuf.Timestamp
-	67, // 24: lilbattle.v1.Game.updated_at:type_name -> google.protobuf.Timestamp
-	21, // 25: lilbattle.v1.Game.config:type_name -> lilbattle.v1.GameConfiguration
-	4,  // 26: lilbattle.v1.Game.search_index_info:type_name -> lilbattle.v1.IndexInfo
-	23, // 27: lilbattle.v1.GameConfiguration.players:type_name -> lilbattle.v1.GamePlayer
-	24, // 28: lilbattle.v1.GameConfiguration.teams:type_name -> lilbattle.v1.GameTeam
-	22, // 29: lilbattle.v1.GameConfiguration.income_configs:type_name -> lilbattle.v1.IncomeConfig
-	25, // 30: lilbattle.v1.GameConfiguration.settings:type_name -> lilbattle.v1.GameSettings
-	67, // 31: lilbattle.v1.GameState.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 32: lilbattle.v1.GameState.world_data:type_name -> lilbattle.v1.WorldData
-	2,  // 33: lilbattle.v1.GameState.status:type_name -> lilbattle.v1.GameStatus
-	65, // 34: lilbattle.v1.GameState.player_states:type_name -> lilbattle.v1.GameState.PlayerStatesEntry
-	29, // 35: lilbattle.v1.GameMoveHistory.groups:type_name -> lilbattle.v1.GameMoveGroup
-	67, // 36: lilbattle.v1.GameMoveGroup.started_at:type_name -> google.protobuf.Timestamp
-	67, // 37: lilbattle.v1.GameMoveGroup.ended_at:type_name -> google.protobuf.Timestamp
-	30, // 38: lilbattle.v1.GameMoveGroup.moves:type_name -> lilbattle.v1.GameMove
-	67, // 39: lilbattle.v1.GameMove.timestamp:type_name -> google.protobuf.Timestamp
-	32, // 40: lilbattle.v1.GameMove.move_unit:type_name -> lilbattle.v1.MoveUnitAction
-	33, // 41: lilbattle.v1.GameMove.attack_unit:type_name -> lilbattle.v1.AttackUnitAction
-	36, // 42: lilbattle.v1.GameMove.end_turn:type_name -> lilbattle.v1.EndTurnAction
-	34, // 43: lilbattle.v1.GameMove.build_unit:type_name -> lilbattle.v1.BuildUnitAction
-	35, // 44: lilbattle.v1.GameMove.capture_building:type_name -> lilbattle.v1.CaptureBuildingAction
-	37, // 45: lilbattle.v1.GameMove.heal_unit:type_name -> lilbattle.v1.HealUnitAction
-	38, // 46: lilbattle.v1.GameMove.fix_unit:type_name -> lilbattle.v1.FixUnitAction
-	39, // 47: lilbattle.v1.GameMove.changes:type_name -> lilbattle.v1.WorldChange
-	31, // 48: lilbattle.v1.MoveUnitAction.from:type_name -> lilbattle.v1.Position
-	31, // 49: lilbattle.v1.MoveUnitAction.to:type_name -> lilbattle.v1.Position
-	52, // 50: lilbattle.v1.MoveUnitAction.reconstructed_path:type_name -> lilbattle.v1.Path
-	31, // 51: lilbattle.v1.AttackUnitAction.attacker:type_name -> lilbattle.v1.Position
-	31, // 52: lilbattle.v1.AttackUnitAction.defender:type_name -> lilbattle.v1.Position
-	31, // 53: lilbattle.v1.BuildUnitAction.pos:type_name -> lilbattle.v1.Position
-	31, // 54: lilbattle.v1.CaptureBuildingAction.pos:type_name -> lilbattle.v1.Position
-	31, // 55: lilbattle.v1.HealUnitAction.pos:type_name -> lilbattle.v1.Position
-	31, // 56: lilbattle.v1.FixUnitAction.fixer:type_name -> lilbattle.v1.Position
-	31, // 57: lilbattle.v1.FixUnitAction.target:type_name -> lilbattle.v1.Position
-	42, // 58: lilbattle.v1.WorldChange.unit_moved:type_name -> lilbattle.v1.UnitMovedChange
-	43, // 59: lilbattle.v1.WorldChange.unit_damaged:type_name -> lilbattle.v1.UnitDamagedChange
-	44, // 60: lilbattle.v1.WorldChange.unit_killed:type_name -> lilbattle.v1.UnitKilledChange
-	45, // 61: lilbattle.v1.WorldChange.player_changed:type_name -> lilbattle.v1.PlayerChangedChange
-	46, // 62: lilbattle.v1.WorldChange.unit_built:type_name -> lilbattle.v1.UnitBuiltChange
-	47, // 63: lilbattle.v1.WorldChange.coins_changed:type_name -> lilbattle.v1.CoinsChangedChange
-	48, // 64: lilbattle.v1.WorldChange.tile_captured:type_name -> lilbattle.v1.TileCapturedChange
-	49, // 65: lilbattle.v1.WorldChange.capture_started:type_name -> lilbattle.v1.CaptureStartedChange
-	40, // 66: lilbattle.v1.WorldChange.unit_healed:type_name -> lilbattle.v1.UnitHealedChange
-	41, // 67: lilbattle.v1.WorldChange.unit_fixed:type_name -> lilbattle.v1.UnitFixedChange
-	11, // 68: lilbattle.v1.UnitHealedChange.previous_unit:type_name -> lilbattle.v1.Unit
-	11, // 69: lilbattle.v1.UnitHealedChange.updated_unit:type_name -> lilbattle.v1.Unit
-	11, // 70: lilbattle.v1.UnitFixedChange.fixer_unit:type_name -> lilbattle.v1.Unit
-	11, // 71: lilbattle.v1.UnitFixedChange.previous_target:type_name -> lilbattle.v1.Unit
-	11, // 72: lilbattle.v1.UnitFixedChange.updated_target:type_name -> lilbattle.v1.Unit
-	11, // 73: lilbattle.v1.UnitMovedChange.previous_unit:type_name -> lilbattle.v1.Unit
-	11, // 74: lilbattle.v1.UnitMovedChange.updated_unit:type_name -> lilbattle.v1.Unit
-	11, // 75: lilbattle.v1.UnitDamagedChange.previous_unit:type_name -> lilbattle.v1.Unit
-	11, // 76: lilbattle.v1.UnitDamagedChange.updated_unit:type_name -> lilbattle.v1.Unit
-	11, // 77: lilbattle.v1.UnitKilledChange.previous_unit:type_name -> lilbattle.v1.Unit
-	11, // 78: lilbattle.v1.PlayerChangedChange.reset_units:type_name -> lilbattle.v1.Unit
-	11, // 79: lilbattle.v1.UnitBuiltChange.unit:type_name -> lilbattle.v1.Unit
-	11, // 80: lilbattle.v1.TileCapturedChange.capturing_unit:type_name -> lilbattle.v1.Unit
-	11, // 81: lilbattle.v1.CaptureStartedChange.capturing_unit:type_name -> lilbattle.v1.Unit
-	66, // 82: lilbattle.v1.AllPaths.edges:type_name -> lilbattle.v1.AllPaths.EdgesEntry
-	51, // 83: lilbattle.v1.Path.edges:type_name -> lilbattle.v1.PathEdge
-	3,  // 84: lilbattle.v1.Path.directions:type_name -> lilbattle.v1.PathDirection
-	10, // 85: lilbattle.v1.WorldData.TilesMapEntry.value:type_name -> lilbattle.v1.Tile
-	11, // 86: lilbattle.v1.WorldData.UnitsMapEntry.value:type_name -> lilbattle.v1.Unit
-	9,  // 87: lilbattle.v1.WorldData.CrossingsEntry.value:type_name -> lilbattle.v1.Crossing
-	15, // 88: lilbattle.v1.TerrainDefinition.UnitPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
-	15, // 89: lilbattle.v1.UnitDefinition.TerrainPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
-	14, // 90: lilbattle.v1.RulesEngine.UnitsEntry.value:type_name -> lilbattle.v1.UnitDefinition
-	13, // 91: lilbattle.v1.RulesEngine.TerrainsEntry.value:type_name -> lilbattle.v1.TerrainDefinition
-	15, // 92: lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
-	16, // 93: lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry.value:type_name -> lilbattle.v1.UnitUnitProperties
-	1,  // 94: lilbattle.v1.RulesEngine.TerrainTypesEntry.value:type_name -> lilbattle.v1.TerrainType
-	26, // 95: lilbattle.v1.GameState.PlayerStatesEntry.value:type_name -> lilbattle.v1.PlayerState
-	51, // 96: lilbattle.v1.AllPaths.EdgesEntry.value:type_name -> lilbattle.v1.PathEdge
-	97, // [97:97] is the sub-list for method output_type
-	97, // [97:97] is the sub-list for method input_type
-	97, // [97:97] is the sub-list for extension type_name
-	97, // [97:97] is the sub-list for extension extendee
-	0,  // [0:97] is the sub-list for field type_name
+	120, // 0: lilbattle.v1.IndexInfo.last_updated_at:type_name -> google.protobuf.Timestamp
+	120, // 1: lilbattle.v1.IndexInfo.last_indexed_at:type_name -> google.protobuf.Timestamp
+	120, // 2: lilbattle.v1.World.created_at:type_name -> google.protobuf.Timestamp
+	120, // 3: lilbattle.v1.World.updated_at:type_name -> google.protobuf.Timestamp
+	31,  // 4: lilbattle.v1.World.default_game_config:type_name -> lilbattle.v1.GameConfiguration
+	7,   // 5: lilbattle.v1.World.search_index_info:type_name -> lilbattle.v1.IndexInfo
+	13,  // 6: lilbattle.v1.World.rating:type_name -> lilbattle.v1.WorldRating
+	12,  // 7: lilbattle.v1.World.rules_overrides:type_name -> lilbattle.v1.RulesOverrides
+	120, // 8: lilbattle.v1.World.deleted_at:type_name -> google.protobuf.Timestamp
+	11,  // 9: lilbattle.v1.World.random_map:type_name -> lilbattle.v1.RandomMap
+	103, // 10: lilbattle.v1.RulesOverrides.terrain_movement_costs:type_name -> lilbattle.v1.RulesOverrides.TerrainMovementCostsEntry
+	32,  // 11: lilbattle.v1.RulesOverrides.income:type_name -> lilbattle.v1.IncomeConfig
+	120, // 12: lilbattle.v1.WorldRating.rated_at:type_name -> google.protobuf.Timestamp
+	104, // 13: lilbattle.v1.WorldData.tiles_map:type_name -> lilbattle.v1.WorldData.TilesMapEntry
+	105, // 14: lilbattle.v1.WorldData.units_map:type_name -> lilbattle.v1.WorldData.UnitsMapEntry
+	7,   // 15: lilbattle.v1.WorldData.screenshot_index_info:type_name -> lilbattle.v1.IndexInfo
+	106, // 16: lilbattle.v1.WorldData.crossings:type_name -> lilbattle.v1.WorldData.CrossingsEntry
+	0,   // 17: lilbattle.v1.Crossing.type:type_name -> lilbattle.v1.CrossingType
+	18,  // 18: lilbattle.v1.Tile.construction:type_name -> lilbattle.v1.ConstructionProgress
+	17,  // 19: lilbattle.v1.Tile.hazard:type_name -> lilbattle.v1.TileHazard
+	20,  // 20: lilbattle.v1.Unit.attack_history:type_name -> lilbattle.v1.AttackRecord
+	107, // 21: lilbattle.v1.TerrainDefinition.unit_properties:type_name -> lilbattle.v1.TerrainDefinition.UnitPropertiesEntry
+	108, // 22: lilbattle.v1.UnitDefinition.terrain_properties:type_name -> lilbattle.v1.UnitDefinition.TerrainPropertiesEntry
+	109, // 23: lilbattle.v1.UnitDefinition.attack_vs_class:type_name -> lilbattle.v1.UnitDefinition.AttackVsClassEntry
+	110, // 24: lilbattle.v1.UnitDefinition.action_limits:type_name -> lilbattle.v1.UnitDefinition.ActionLimitsEntry
+	24,  // 25: lilbattle.v1.UnitDefinition.constructions:type_name -> lilbattle.v1.TerrainConversion
+	23,  // 26: lilbattle.v1.UnitDefinition.footprint:type_name -> lilbattle.v1.HexOffset
+	27,  // 27: lilbattle.v1.UnitUnitProperties.damage:type_name -> lilbattle.v1.DamageDistribution
+	28,  // 28: lilbattle.v1.DamageDistribution.ranges:type_name -> lilbattle.v1.DamageRange
+	111, // 29: lilbattle.v1.RulesEngine.units:type_name -> lilbattle.v1.RulesEngine.UnitsEntry
+	112, // 30: lilbattle.v1.RulesEngine.terrains:type_name -> lilbattle.v1.RulesEngine.TerrainsEntry
+	113, // 31: lilbattle.v1.RulesEngine.terrain_unit_properties:type_name -> lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry
+	114, // 32: lilbattle.v1.RulesEngine.unit_unit_properties:type_name -> lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry
+	115, // 33: lilbattle.v1.RulesEngine.terrain_types:type_name -> lilbattle.v1.RulesEngine.TerrainTypesEntry
+	120, // 34: lilbattle.v1.Game.created_at:type_name -> google.protobuf.Timestamp
+	120, // 35: lilbattle.v1.Game.updated_at:type_name -> google.protobuf.Timestamp
+	31,  // 36: lilbattle.v1.Game.config:type_name -> lilbattle.v1.GameConfiguration
+	7,   // 37: lilbattle.v1.Game.search_index_info:type_name -> lilbattle.v1.IndexInfo
+	11,  // 38: lilbattle.v1.Game.random_map:type_name -> lilbattle.v1.RandomMap
+	33,  // 39: lilbattle.v1.GameConfiguration.players:type_name -> lilbattle.v1.GamePlayer
+	35,  // 40: lilbattle.v1.GameConfiguration.teams:type_name -> lilbattle.v1.GameTeam
+	32,  // 41: lilbattle.v1.GameConfiguration.income_configs:type_name -> lilbattle.v1.IncomeConfig
+	36,  // 42: lilbattle.v1.GameConfiguration.settings:type_name -> lilbattle.v1.GameSettings
+	12,  // 43: lilbattle.v1.GameConfiguration.world_rules_overrides:type_name -> lilbattle.v1.RulesOverrides
+	12,  // 44: lilbattle.v1.GameConfiguration.rules_overrides:type_name -> lilbattle.v1.RulesOverrides
+	34,  // 45: lilbattle.v1.GamePlayer.handicap:type_name -> lilbattle.v1.PlayerHandicap
+	40,  // 46: lilbattle.v1.GameSettings.time_bank:type_name -> lilbattle.v1.TimeBankSettings
+	39,  // 47: lilbattle.v1.GameSettings.draft:type_name -> lilbattle.v1.DraftSettings
+	43,  // 48: lilbattle.v1.GameSettings.puzzle:type_name -> lilbattle.v1.PuzzleSettings
+	37,  // 49: lilbattle.v1.GameSettings.spectator_delay:type_name -> lilbattle.v1.SpectatorDelay
+	38,  // 50: lilbattle.v1.GameSettings.sandbox:type_name -> lilbattle.v1.SandboxSettings
+	3,   // 51: lilbattle.v1.TimeBankSettings.on_timeout:type_name -> lilbattle.v1.TimeoutAction
+	120, // 52: lilbattle.v1.GameState.updated_at:type_name -> google.protobuf.Timestamp
+	14,  // 53: lilbattle.v1.GameState.world_data:type_name -> lilbattle.v1.WorldData
+	2,   // 54: lilbattle.v1.GameState.status:type_name -> lilbattle.v1.GameStatus
+	116, // 55: lilbattle.v1.GameState.player_states:type_name -> lilbattle.v1.GameState.PlayerStatesEntry
+	120, // 56: lilbattle.v1.GameState.clock_started_at:type_name -> google.protobuf.Timestamp
+	45,  // 57: lilbattle.v1.GameState.draft:type_name -> lilbattle.v1.DraftState
+	4,   // 58: lilbattle.v1.GameState.puzzle_result:type_name -> lilbattle.v1.PuzzleResult
+	46,  // 59: lilbattle.v1.GameState.turn_plans:type_name -> lilbattle.v1.TurnPlan
+	44,  // 60: lilbattle.v1.PuzzleSettings.opponent_turns:type_name -> lilbattle.v1.PuzzleOpponentTurn
+	58,  // 61: lilbattle.v1.PuzzleOpponentTurn.moves:type_name -> lilbattle.v1.GameMove
+	117, // 62: lilbattle.v1.DraftState.picked_units:type_name -> lilbattle.v1.DraftState.PickedUnitsEntry
+	47,  // 63: lilbattle.v1.TurnPlan.moves:type_name -> lilbattle.v1.PlannedMove
+	58,  // 64: lilbattle.v1.PlannedMove.move:type_name -> lilbattle.v1.GameMove
+	50,  // 65: lilbattle.v1.StateDiff.units:type_name -> lilbattle.v1.UnitDiff
+	52,  // 66: lilbattle.v1.StateDiff.tiles:type_name -> lilbattle.v1.TileOwnerDiff
+	53,  // 67: lilbattle.v1.StateDiff.players:type_name -> lilbattle.v1.PlayerDiff
+	5,   // 68: lilbattle.v1.UnitDiff.kind:type_name -> lilbattle.v1.UnitDiffKind
+	19,  // 69: lilbattle.v1.UnitDiff.before:type_name -> lilbattle.v1.Unit
+	19,  // 70: lilbattle.v1.UnitDiff.after:type_name -> lilbattle.v1.Unit
+	51,  // 71: lilbattle.v1.UnitDiff.deltas:type_name -> lilbattle.v1.FieldDelta
+	55,  // 72: lilbattle.v1.GameMoveHistory.groups:type_name -> lilbattle.v1.GameMoveGroup
+	120, // 73: lilbattle.v1.GameMoveGroup.started_at:type_name -> google.protobuf.Timestamp
+	120, // 74: lilbattle.v1.GameMoveGroup.ended_at:type_name -> google.protobuf.Timestamp
+	58,  // 75: lilbattle.v1.GameMoveGroup.moves:type_name -> lilbattle.v1.GameMove
+	56,  // 76: lilbattle.v1.TurnSnapshots.snapshots:type_name -> lilbattle.v1.TurnSnapshot
+	120, // 77: lilbattle.v1.GameMove.timestamp:type_name -> google.protobuf.Timestamp
+	61,  // 78: lilbattle.v1.GameMove.move_unit:type_name -> lilbattle.v1.MoveUnitAction
+	62,  // 79: lilbattle.v1.GameMove.attack_unit:type_name -> lilbattle.v1.AttackUnitAction
+	65,  // 80: lilbattle.v1.GameMove.end_turn:type_name -> lilbattle.v1.EndTurnAction
+	63,  // 81: lilbattle.v1.GameMove.build_unit:type_name -> lilbattle.v1.BuildUnitAction
+	64,  // 82: lilbattle.v1.GameMove.capture_building:type_name -> lilbattle.v1.CaptureBuildingAction
+	67,  // 83: lilbattle.v1.GameMove.heal_unit:type_name -> lilbattle.v1.HealUnitAction
+	71,  // 84: lilbattle.v1.GameMove.fix_unit:type_name -> lilbattle.v1.FixUnitAction
+	72,  // 85: lilbattle.v1.GameMove.construct_terrain:type_name -> lilbattle.v1.ConstructTerrainAction
+	73,  // 86: lilbattle.v1.GameMove.submerge_unit:type_name -> lilbattle.v1.SubmergeUnitAction
+	74,  // 87: lilbattle.v1.GameMove.delegate_turn:type_name -> lilbattle.v1.DelegateTurnAction
+	75,  // 88: lilbattle.v1.GameMove.draft_unit:type_name -> lilbattle.v1.DraftUnitAction
+	68,  // 89: lilbattle.v1.GameMove.transform_unit:type_name -> lilbattle.v1.TransformUnitAction
+	76,  // 90: lilbattle.v1.GameMove.submit_plan:type_name -> lilbattle.v1.SubmitPlanAction
+	69,  // 91: lilbattle.v1.GameMove.spawn_unit:type_name -> lilbattle.v1.SpawnUnitAction
+	70,  // 92: lilbattle.v1.GameMove.delete_unit:type_name -> lilbattle.v1.DeleteUnitAction
+	77,  // 93: lilbattle.v1.GameMove.changes:type_name -> lilbattle.v1.WorldChange
+	59,  // 94: lilbattle.v1.GameMove.coach_verdict:type_name -> lilbattle.v1.CoachVerdict
+	60,  // 95: lilbattle.v1.MoveUnitAction.from:type_name -> lilbattle.v1.Position
+	60,  // 96: lilbattle.v1.MoveUnitAction.to:type_name -> lilbattle.v1.Position
+	102, // 97: lilbattle.v1.MoveUnitAction.reconstructed_path:type_name -> lilbattle.v1.Path
+	60,  // 98: lilbattle.v1.AttackUnitAction.attacker:type_name -> lilbattle.v1.Position
+	60,  // 99: lilbattle.v1.AttackUnitAction.defender:type_name -> lilbattle.v1.Position
+	60,  // 100: lilbattle.v1.BuildUnitAction.pos:type_name -> lilbattle.v1.Position
+	60,  // 101: lilbattle.v1.CaptureBuildingAction.pos:type_name -> lilbattle.v1.Position
+	60,  // 102: lilbattle.v1.TurnObligation.unit:type_name -> lilbattle.v1.Position
+	60,  // 103: lilbattle.v1.HealUnitAction.pos:type_name -> lilbattle.v1.Position
+	60,  // 104: lilbattle.v1.TransformUnitAction.pos:type_name -> lilbattle.v1.Position
+	60,  // 105: lilbattle.v1.SpawnUnitAction.pos:type_name -> lilbattle.v1.Position
+	60,  // 106: lilbattle.v1.DeleteUnitAction.pos:type_name -> lilbattle.v1.Position
+	60,  // 107: lilbattle.v1.FixUnitAction.fixer:type_name -> lilbattle.v1.Position
+	60,  // 108: lilbattle.v1.FixUnitAction.target:type_name -> lilbattle.v1.Position
+	60,  // 109: lilbattle.v1.ConstructTerrainAction.pos:type_name -> lilbattle.v1.Position
+	60,  // 110: lilbattle.v1.ConstructTerrainAction.target:type_name -> lilbattle.v1.Position
+	60,  // 111: lilbattle.v1.SubmergeUnitAction.pos:type_name -> lilbattle.v1.Position
+	58,  // 112: lilbattle.v1.SubmitPlanAction.moves:type_name -> lilbattle.v1.GameMove
+	89,  // 113: lilbattle.v1.WorldChange.unit_moved:type_name -> lilbattle.v1.UnitMovedChange
+	90,  // 114: lilbattle.v1.WorldChange.unit_damaged:type_name -> lilbattle.v1.UnitDamagedChange
+	93,  // 115: lilbattle.v1.WorldChange.unit_killed:type_name -> lilbattle.v1.UnitKilledChange
+	94,  // 116: lilbattle.v1.WorldChange.player_changed:type_name -> lilbattle.v1.PlayerChangedChange
+	95,  // 117: lilbattle.v1.WorldChange.unit_built:type_name -> lilbattle.v1.UnitBuiltChange
+	97,  // 118: lilbattle.v1.WorldChange.coins_changed:type_name -> lilbattle.v1.CoinsChangedChange
+	98,  // 119: lilbattle.v1.WorldChange.tile_captured:type_name -> lilbattle.v1.TileCapturedChange
+	99,  // 120: lilbattle.v1.WorldChange.capture_started:type_name -> lilbattle.v1.CaptureStartedChange
+	86,  // 121: lilbattle.v1.WorldChange.unit_healed:type_name -> lilbattle.v1.UnitHealedChange
+	88,  // 122: lilbattle.v1.WorldChange.unit_fixed:type_name -> lilbattle.v1.UnitFixedChange
+	85,  // 123: lilbattle.v1.WorldChange.terrain_changed:type_name -> lilbattle.v1.TerrainChangedChange
+	84,  // 124: lilbattle.v1.WorldChange.unit_submerged:type_name -> lilbattle.v1.UnitSubmergedChange
+	83,  // 125: lilbattle.v1.WorldChange.turn_delegated:type_name -> lilbattle.v1.TurnDelegatedChange
+	80,  // 126: lilbattle.v1.WorldChange.unit_drafted:type_name -> lilbattle.v1.UnitDraftedChange
+	82,  // 127: lilbattle.v1.WorldChange.game_event:type_name -> lilbattle.v1.GameEventChange
+	87,  // 128: lilbattle.v1.WorldChange.unit_transformed:type_name -> lilbattle.v1.UnitTransformedChange
+	96,  // 129: lilbattle.v1.WorldChange.victory_points_scored:type_name -> lilbattle.v1.VictoryPointsScoredChange
+	91,  // 130: lilbattle.v1.WorldChange.unit_attacked:type_name -> lilbattle.v1.UnitAttackedChange
+	81,  // 131: lilbattle.v1.WorldChange.plan_submitted:type_name -> lilbattle.v1.PlanSubmittedChange
+	79,  // 132: lilbattle.v1.ChangeLog.entries:type_name -> lilbattle.v1.ChangeLogEntry
+	77,  // 133: lilbattle.v1.ChangeLogEntry.changes:type_name -> lilbattle.v1.WorldChange
+	66,  // 134: lilbattle.v1.GameEventChange.skipped_actions:type_name -> lilbattle.v1.TurnObligation
+	19,  // 135: lilbattle.v1.UnitSubmergedChange.previous_unit:type_name -> lilbattle.v1.Unit
+	19,  // 136: lilbattle.v1.UnitSubmergedChange.updated_unit:type_name -> lilbattle.v1.Unit
+	16,  // 137: lilbattle.v1.TerrainChangedChange.previous_tile:type_name -> lilbattle.v1.Tile
+	16,  // 138: lilbattle.v1.TerrainChangedChange.updated_tile:type_name -> lilbattle.v1.Tile
+	19,  // 139: lilbattle.v1.UnitHealedChange.previous_unit:type_name -> lilbattle.v1.Unit
+	19,  // 140: lilbattle.v1.UnitHealedChange.updated_unit:type_name -> lilbattle.v1.Unit
+	19,  // 141: lilbattle.v1.UnitTransformedChange.previous_unit:type_name -> lilbattle.v1.Unit
+	19,  // 142: lilbattle.v1.UnitTransformedChange.updated_unit:type_name -> lilbattle.v1.Unit
+	19,  // 143: lilbattle.v1.UnitFixedChange.fixer_unit:type_name -> lilbattle.v1.Unit
+	19,  // 144: lilbattle.v1.UnitFixedChange.previous_target:type_name -> lilbattle.v1.Unit
+	19,  // 145: lilbattle.v1.UnitFixedChange.updated_target:type_name -> lilbattle.v1.Unit
+	19,  // 146: lilbattle.v1.UnitMovedChange.previous_unit:type_name -> lilbattle.v1.Unit
+	19,  // 147: lilbattle.v1.UnitMovedChange.updated_unit:type_name -> lilbattle.v1.Unit
+	19,  // 148: lilbattle.v1.UnitDamagedChange.previous_unit:type_name -> lilbattle.v1.Unit
+	19,  // 149: lilbattle.v1.UnitDamagedChange.updated_unit:type_name -> lilbattle.v1.Unit
+	92,  // 150: lilbattle.v1.UnitAttackedChange.summary:type_name -> lilbattle.v1.CombatSummary
+	19,  // 151: lilbattle.v1.CombatSummary.attacker:type_name -> lilbattle.v1.Unit
+	19,  // 152: lilbattle.v1.CombatSummary.defender:type_name -> lilbattle.v1.Unit
+	19,  // 153: lilbattle.v1.UnitKilledChange.previous_unit:type_name -> lilbattle.v1.Unit
+	19,  // 154: lilbattle.v1.PlayerChangedChange.reset_units:type_name -> lilbattle.v1.Unit
+	118, // 155: lilbattle.v1.PlayerChangedChange.time_banks_ms:type_name -> lilbattle.v1.PlayerChangedChange.TimeBanksMsEntry
+	120, // 156: lilbattle.v1.PlayerChangedChange.clock_started_at:type_name -> google.protobuf.Timestamp
+	19,  // 157: lilbattle.v1.UnitBuiltChange.unit:type_name -> lilbattle.v1.Unit
+	16,  // 158: lilbattle.v1.VictoryPointsScoredChange.markers:type_name -> lilbattle.v1.Tile
+	19,  // 159: lilbattle.v1.TileCapturedChange.capturing_unit:type_name -> lilbattle.v1.Unit
+	19,  // 160: lilbattle.v1.CaptureStartedChange.capturing_unit:type_name -> lilbattle.v1.Unit
+	119, // 161: lilbattle.v1.AllPaths.edges:type_name -> lilbattle.v1.AllPaths.EdgesEntry
+	101, // 162: lilbattle.v1.Path.edges:type_name -> lilbattle.v1.PathEdge
+	6,   // 163: lilbattle.v1.Path.directions:type_name -> lilbattle.v1.PathDirection
+	16,  // 164: lilbattle.v1.WorldData.TilesMapEntry.value:type_name -> lilbattle.v1.Tile
+	19,  // 165: lilbattle.v1.WorldData.UnitsMapEntry.value:type_name -> lilbattle.v1.Unit
+	15,  // 166: lilbattle.v1.WorldData.CrossingsEntry.value:type_name -> lilbattle.v1.Crossing
+	25,  // 167: lilbattle.v1.TerrainDefinition.UnitPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
+	25,  // 168: lilbattle.v1.UnitDefinition.TerrainPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
+	22,  // 169: lilbattle.v1.RulesEngine.UnitsEntry.value:type_name -> lilbattle.v1.UnitDefinition
+	21,  // 170: lilbattle.v1.RulesEngine.TerrainsEntry.value:type_name -> lilbattle.v1.TerrainDefinition
+	25,  // 171: lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
+	26,  // 172: lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry.value:type_name -> lilbattle.v1.UnitUnitProperties
+	1,   // 173: lilbattle.v1.RulesEngine.TerrainTypesEntry.value:type_name -> lilbattle.v1.TerrainType
+	41,  // 174: lilbattle.v1.GameState.PlayerStatesEntry.value:type_name -> lilbattle.v1.PlayerState
+	101, // 175: lilbattle.v1.AllPaths.EdgesEntry.value:type_name -> lilbattle.v1.PathEdge
+	176, // [176:176] is the sub-list for method output_type
+	176, // [176:176] is the sub-list for method input_type
+	176, // [176:176] is the sub-list for extension type_name
+	176, // [176:176] is the sub-list for extension extendee
+	0,   // [0:176] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_models_proto_init() }
@@ -5255,8 +9967,8 @@ func file_lilbattle_v1_models_models_proto_init() {
 	if File_lilbattle_v1_models_models_proto != nil {
 		return
 	}
-	file_lilbattle_v1_models_models_proto_msgTypes[12].OneofWrappers = []any{}
-	file_lilbattle_v1_models_models_proto_msgTypes[26].OneofWrappers = []any{
+	file_lilbattle_v1_models_models_proto_msgTypes[19].OneofWrappers = []any{}
+	file_lilbattle_v1_models_models_proto_msgTypes[51].OneofWrappers = []any{
 		(*GameMove_MoveUnit)(nil),
 		(*GameMove_AttackUnit)(nil),
 		(*GameMove_EndTurn)(nil),
@@ -5264,8 +9976,16 @@ func file_lilbattle_v1_models_models_proto_init() {
 		(*GameMove_CaptureBuilding)(nil),
 		(*GameMove_HealUnit)(nil),
 		(*GameMove_FixUnit)(nil),
-	}
-	file_lilbattle_v1_models_models_proto_msgTypes[35].OneofWrappers = []any{
+		(*GameMove_ConstructTerrain)(nil),
+		(*GameMove_SubmergeUnit)(nil),
+		(*GameMove_DelegateTurn)(nil),
+		(*GameMove_DraftUnit)(nil),
+		(*GameMove_TransformUnit)(nil),
+		(*GameMove_SubmitPlan)(nil),
+		(*GameMove_SpawnUnit)(nil),
+		(*GameMove_DeleteUnit)(nil),
+	}
+	file_lilbattle_v1_models_models_proto_msgTypes[70].OneofWrappers = []any{
 		(*WorldChange_UnitMoved)(nil),
 		(*WorldChange_UnitDamaged)(nil),
 		(*WorldChange_UnitKilled)(nil),
@@ -5276,14 +9996,23 @@ func file_lilbattle_v1_models_models_proto_init() {
 		(*WorldChange_CaptureStarted)(nil),
 		(*WorldChange_UnitHealed)(nil),
 		(*WorldChange_UnitFixed)(nil),
+		(*WorldChange_TerrainChanged)(nil),
+		(*WorldChange_UnitSubmerged)(nil),
+		(*WorldChange_TurnDelegated)(nil),
+		(*WorldChange_UnitDrafted)(nil),
+		(*WorldChange_GameEvent)(nil),
+		(*WorldChange_UnitTransformed)(nil),
+		(*WorldChange_VictoryPointsScored)(nil),
+		(*WorldChange_UnitAttacked)(nil),
+		(*WorldChange_PlanSubmitted)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_models_proto_rawDesc), len(file_lilbattle_v1_models_models_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   63,
+			NumEnums:      7,
+			NumMessages:   113,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -370,8 +370,10 @@ func (x *SceneClickedResponse) GetGameId() string {
 
 // Called when the end turn button was clicked
 type EndTurnButtonClickedRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// End the turn even if mandatory actions are pending
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -413,6 +415,13 @@ func (x *EndTurnButtonClickedRequest) GetGameId() string {
 	return ""
 }
 
+func (x *EndTurnButtonClickedRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 // Response of a turn option click
 type EndTurnButtonClickedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -681,8 +690,10 @@ func (x *InitializeGameResponse) GetGameName() string {
 
 // Called by browser after UI/scene is fully initialized and ready for visual updates
 type ClientReadyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Show coach verdicts after each of the player's moves
+	Coach         bool `protobuf:"varint,2,opt,name=coach,proto3" json:"coach,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -724,6 +735,13 @@ func (x *ClientReadyRequest) GetGameId() string {
 	return ""
 }
 
+func (x *ClientReadyRequest) GetCoach() bool {
+	if x != nil {
+		return x.Coach
+	}
+	return false
+}
+
 // Response for ClientReady
 type ClientReadyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -775,7 +793,10 @@ type ApplyRemoteChangesRequest struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	// The moves containing WorldChanges to apply
-	Moves         []*GameMove `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves,omitempty"`
+	Moves []*GameMove `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves,omitempty"`
+	// Encoded MovesPublished, as received by subscribers that negotiated a
+	// binary encoding. Used instead of moves when set.
+	EncodedMoves  *EncodedPayload `protobuf:"bytes,3,opt,name=encoded_moves,json=encodedMoves,proto3" json:"encoded_moves,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -824,6 +845,13 @@ func (x *ApplyRemoteChangesRequest) GetMoves() []*GameMove {
 	return nil
 }
 
+func (x *ApplyRemoteChangesRequest) GetEncodedMoves() *EncodedPayload {
+	if x != nil {
+		return x.EncodedMoves
+	}
+	return nil
+}
+
 // Response after applying remote changes
 type ApplyRemoteChangesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -888,11 +916,657 @@ func (x *ApplyRemoteChangesResponse) GetRequiresReload() bool {
 	return false
 }
 
+// Request to show a teammate's ping (received via SyncService subscription)
+type ShowPingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Ping          *Ping                  `protobuf:"bytes,2,opt,name=ping,proto3" json:"ping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowPingRequest) Reset() {
+	*x = ShowPingRequest{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowPingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowPingRequest) ProtoMessage() {}
+
+func (x *ShowPingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowPingRequest.ProtoReflect.Descriptor instead.
+func (*ShowPingRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ShowPingRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ShowPingRequest) GetPing() *Ping {
+	if x != nil {
+		return x.Ping
+	}
+	return nil
+}
+
+type ShowPingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowPingResponse) Reset() {
+	*x = ShowPingResponse{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowPingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowPingResponse) ProtoMessage() {}
+
+func (x *ShowPingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowPingResponse.ProtoReflect.Descriptor instead.
+func (*ShowPingResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{17}
+}
+
+// Everything needed to restore an offline game exactly as it was saved
+type GameBundle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format version of the bundle
+	Version int32            `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Game    *Game            `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	State   *GameState       `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	History *GameMoveHistory `protobuf:"bytes,4,opt,name=history,proto3" json:"history,omitempty"`
+	// Hash of the rules the game was saved with
+	RulesHash string `protobuf:"bytes,5,opt,name=rules_hash,json=rulesHash,proto3" json:"rules_hash,omitempty"`
+	// StateHash of the state under rules_hash, to detect corrupted saves
+	StateHash     string `protobuf:"bytes,6,opt,name=state_hash,json=stateHash,proto3" json:"state_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameBundle) Reset() {
+	*x = GameBundle{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameBundle) ProtoMessage() {}
+
+func (x *GameBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameBundle.ProtoReflect.Descriptor instead.
+func (*GameBundle) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GameBundle) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GameBundle) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GameBundle) GetState() *GameState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *GameBundle) GetHistory() *GameMoveHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *GameBundle) GetRulesHash() string {
+	if x != nil {
+		return x.RulesHash
+	}
+	return ""
+}
+
+func (x *GameBundle) GetStateHash() string {
+	if x != nil {
+		return x.StateHash
+	}
+	return ""
+}
+
+// Summary of a save slot for listing
+type SaveSlotInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	GameName      string                 `protobuf:"bytes,3,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
+	TurnCounter   int32                  `protobuf:"varint,4,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"`
+	CurrentPlayer int32                  `protobuf:"varint,5,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
+	// Why the slot cannot be loaded, if its data is corrupted
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSlotInfo) Reset() {
+	*x = SaveSlotInfo{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSlotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSlotInfo) ProtoMessage() {}
+
+func (x *SaveSlotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSlotInfo.ProtoReflect.Descriptor instead.
+func (*SaveSlotInfo) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SaveSlotInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveSlotInfo) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SaveSlotInfo) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *SaveSlotInfo) GetTurnCounter() int32 {
+	if x != nil {
+		return x.TurnCounter
+	}
+	return 0
+}
+
+func (x *SaveSlotInfo) GetCurrentPlayer() int32 {
+	if x != nil {
+		return x.CurrentPlayer
+	}
+	return 0
+}
+
+func (x *SaveSlotInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Request to save the current game into a named slot
+type SaveSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Slot          string                 `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSlotRequest) Reset() {
+	*x = SaveSlotRequest{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSlotRequest) ProtoMessage() {}
+
+func (x *SaveSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSlotRequest.ProtoReflect.Descriptor instead.
+func (*SaveSlotRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SaveSlotRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SaveSlotRequest) GetSlot() string {
+	if x != nil {
+		return x.Slot
+	}
+	return ""
+}
+
+type SaveSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          *SaveSlotInfo          `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSlotResponse) Reset() {
+	*x = SaveSlotResponse{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSlotResponse) ProtoMessage() {}
+
+func (x *SaveSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSlotResponse.ProtoReflect.Descriptor instead.
+func (*SaveSlotResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SaveSlotResponse) GetSlot() *SaveSlotInfo {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+// Request to replace the current game with the one saved in a slot
+type LoadSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Slot          string                 `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadSlotRequest) Reset() {
+	*x = LoadSlotRequest{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadSlotRequest) ProtoMessage() {}
+
+func (x *LoadSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadSlotRequest.ProtoReflect.Descriptor instead.
+func (*LoadSlotRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LoadSlotRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *LoadSlotRequest) GetSlot() string {
+	if x != nil {
+		return x.Slot
+	}
+	return ""
+}
+
+type LoadSlotResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Response      *InitializeGameResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadSlotResponse) Reset() {
+	*x = LoadSlotResponse{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadSlotResponse) ProtoMessage() {}
+
+func (x *LoadSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadSlotResponse.ProtoReflect.Descriptor instead.
+func (*LoadSlotResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LoadSlotResponse) GetResponse() *InitializeGameResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// Request to list the saved slots
+type ListSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSlotsRequest) Reset() {
+	*x = ListSlotsRequest{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlotsRequest) ProtoMessage() {}
+
+func (x *ListSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListSlotsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ListSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*SaveSlotInfo        `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSlotsResponse) Reset() {
+	*x = ListSlotsResponse{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlotsResponse) ProtoMessage() {}
+
+func (x *ListSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSlotsResponse) GetSlots() []*SaveSlotInfo {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// Request to fork the game being replayed at the move being viewed, to
+// explore other lines from there
+type ExploreFromHereRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Number of moves replayed up to the position being viewed
+	MoveIndex     int32 `protobuf:"varint,2,opt,name=move_index,json=moveIndex,proto3" json:"move_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExploreFromHereRequest) Reset() {
+	*x = ExploreFromHereRequest{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExploreFromHereRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExploreFromHereRequest) ProtoMessage() {}
+
+func (x *ExploreFromHereRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExploreFromHereRequest.ProtoReflect.Descriptor instead.
+func (*ExploreFromHereRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExploreFromHereRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ExploreFromHereRequest) GetMoveIndex() int32 {
+	if x != nil {
+		return x.MoveIndex
+	}
+	return 0
+}
+
+type ExploreFromHereResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new fork, which the page opens in place of the replay
+	Fork          *Game `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExploreFromHereResponse) Reset() {
+	*x = ExploreFromHereResponse{}
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExploreFromHereResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExploreFromHereResponse) ProtoMessage() {}
+
+func (x *ExploreFromHereResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_presenter_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExploreFromHereResponse.ProtoReflect.Descriptor instead.
+func (*ExploreFromHereResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_presenter_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExploreFromHereResponse) GetFork() *Game {
+	if x != nil {
+		return x.Fork
+	}
+	return nil
+}
+
 var File_lilbattle_v1_models_presenter_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_models_presenter_proto_rawDesc = "" +
 	"\n" +
-	"#lilbattle/v1/models/presenter.proto\x12\flilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xba\x01\n" +
+	"#lilbattle/v1/models/presenter.proto\x12\flilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a\x1elilbattle/v1/models/sync.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xba\x01\n" +
 	"\x1aInitializeSingletonRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
 	"\tgame_data\x18\x02 \x01(\tR\bgameData\x12\x1d\n" +
@@ -915,9 +1589,10 @@ const file_lilbattle_v1_models_presenter_proto_rawDesc = "" +
 	"\x03pos\x18\x02 \x01(\v2\x16.lilbattle.v1.PositionR\x03pos\x12\x14\n" +
 	"\x05layer\x18\x03 \x01(\tR\x05layer\"/\n" +
 	"\x14SceneClickedResponse\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\"6\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"L\n" +
 	"\x1bEndTurnButtonClickedRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\"7\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"7\n" +
 	"\x1cEndTurnButtonClickedResponse\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\"{\n" +
 	"\x19BuildOptionClickedRequest\x12\x17\n" +
@@ -932,18 +1607,61 @@ const file_lilbattle_v1_models_presenter_proto_rawDesc = "" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12%\n" +
 	"\x0ecurrent_player\x18\x03 \x01(\x05R\rcurrentPlayer\x12!\n" +
 	"\fturn_counter\x18\x04 \x01(\x05R\vturnCounter\x12\x1b\n" +
-	"\tgame_name\x18\x05 \x01(\tR\bgameName\"-\n" +
+	"\tgame_name\x18\x05 \x01(\tR\bgameName\"C\n" +
 	"\x12ClientReadyRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\"/\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x14\n" +
+	"\x05coach\x18\x02 \x01(\bR\x05coach\"/\n" +
 	"\x13ClientReadyResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"b\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa5\x01\n" +
 	"\x19ApplyRemoteChangesRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12,\n" +
-	"\x05moves\x18\x02 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\"u\n" +
+	"\x05moves\x18\x02 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\x12A\n" +
+	"\rencoded_moves\x18\x03 \x01(\v2\x1c.lilbattle.v1.EncodedPayloadR\fencodedMoves\"u\n" +
 	"\x1aApplyRemoteChangesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12'\n" +
-	"\x0frequires_reload\x18\x03 \x01(\bR\x0erequiresReloadB\xba\x01\n" +
+	"\x0frequires_reload\x18\x03 \x01(\bR\x0erequiresReload\"R\n" +
+	"\x0fShowPingRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12&\n" +
+	"\x04ping\x18\x02 \x01(\v2\x12.lilbattle.v1.PingR\x04ping\"\x12\n" +
+	"\x10ShowPingResponse\"\xf4\x01\n" +
+	"\n" +
+	"GameBundle\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12&\n" +
+	"\x04game\x18\x02 \x01(\v2\x12.lilbattle.v1.GameR\x04game\x12-\n" +
+	"\x05state\x18\x03 \x01(\v2\x17.lilbattle.v1.GameStateR\x05state\x127\n" +
+	"\ahistory\x18\x04 \x01(\v2\x1d.lilbattle.v1.GameMoveHistoryR\ahistory\x12\x1d\n" +
+	"\n" +
+	"rules_hash\x18\x05 \x01(\tR\trulesHash\x12\x1d\n" +
+	"\n" +
+	"state_hash\x18\x06 \x01(\tR\tstateHash\"\xb8\x01\n" +
+	"\fSaveSlotInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tgame_name\x18\x03 \x01(\tR\bgameName\x12!\n" +
+	"\fturn_counter\x18\x04 \x01(\x05R\vturnCounter\x12%\n" +
+	"\x0ecurrent_player\x18\x05 \x01(\x05R\rcurrentPlayer\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\">\n" +
+	"\x0fSaveSlotRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\tR\x04slot\"B\n" +
+	"\x10SaveSlotResponse\x12.\n" +
+	"\x04slot\x18\x01 \x01(\v2\x1a.lilbattle.v1.SaveSlotInfoR\x04slot\">\n" +
+	"\x0fLoadSlotRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\tR\x04slot\"T\n" +
+	"\x10LoadSlotResponse\x12@\n" +
+	"\bresponse\x18\x01 \x01(\v2$.lilbattle.v1.InitializeGameResponseR\bresponse\"+\n" +
+	"\x10ListSlotsRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"E\n" +
+	"\x11ListSlotsResponse\x120\n" +
+	"\x05slots\x18\x01 \x03(\v2\x1a.lilbattle.v1.SaveSlotInfoR\x05slots\"P\n" +
+	"\x16ExploreFromHereRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1d\n" +
+	"\n" +
+	"move_index\x18\x02 \x01(\x05R\tmoveIndex\"A\n" +
+	"\x17ExploreFromHereResponse\x12&\n" +
+	"\x04fork\x18\x01 \x01(\v2\x12.lilbattle.v1.GameR\x04forkB\xba\x01\n" +
 	"\x10com.lilbattle.v1B\x0ePresenterProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var (
@@ -958,7 +1676,7 @@ func file_lilbattle_v1_models_presenter_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_models_presenter_proto_rawDescData
 }
 
-var file_lilbattle_v1_models_presenter_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_lilbattle_v1_models_presenter_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_lilbattle_v1_models_presenter_proto_goTypes = []any{
 	(*InitializeSingletonRequest)(nil),   // 0: lilbattle.v1.InitializeSingletonRequest
 	(*InitializeSingletonResponse)(nil),  // 1: lilbattle.v1.InitializeSingletonResponse
@@ -976,20 +1694,46 @@ var file_lilbattle_v1_models_presenter_proto_goTypes = []any{
 	(*ClientReadyResponse)(nil),          // 13: lilbattle.v1.ClientReadyResponse
 	(*ApplyRemoteChangesRequest)(nil),    // 14: lilbattle.v1.ApplyRemoteChangesRequest
 	(*ApplyRemoteChangesResponse)(nil),   // 15: lilbattle.v1.ApplyRemoteChangesResponse
-	(*Position)(nil),                     // 16: lilbattle.v1.Position
-	(*GameMove)(nil),                     // 17: lilbattle.v1.GameMove
+	(*ShowPingRequest)(nil),              // 16: lilbattle.v1.ShowPingRequest
+	(*ShowPingResponse)(nil),             // 17: lilbattle.v1.ShowPingResponse
+	(*GameBundle)(nil),                   // 18: lilbattle.v1.GameBundle
+	(*SaveSlotInfo)(nil),                 // 19: lilbattle.v1.SaveSlotInfo
+	(*SaveSlotRequest)(nil),              // 20: lilbattle.v1.SaveSlotRequest
+	(*SaveSlotResponse)(nil),             // 21: lilbattle.v1.SaveSlotResponse
+	(*LoadSlotRequest)(nil),              // 22: lilbattle.v1.LoadSlotRequest
+	(*LoadSlotResponse)(nil),             // 23: lilbattle.v1.LoadSlotResponse
+	(*ListSlotsRequest)(nil),             // 24: lilbattle.v1.ListSlotsRequest
+	(*ListSlotsResponse)(nil),            // 25: lilbattle.v1.ListSlotsResponse
+	(*ExploreFromHereRequest)(nil),       // 26: lilbattle.v1.ExploreFromHereRequest
+	(*ExploreFromHereResponse)(nil),      // 27: lilbattle.v1.ExploreFromHereResponse
+	(*Position)(nil),                     // 28: lilbattle.v1.Position
+	(*GameMove)(nil),                     // 29: lilbattle.v1.GameMove
+	(*EncodedPayload)(nil),               // 30: lilbattle.v1.EncodedPayload
+	(*Ping)(nil),                         // 31: lilbattle.v1.Ping
+	(*Game)(nil),                         // 32: lilbattle.v1.Game
+	(*GameState)(nil),                    // 33: lilbattle.v1.GameState
+	(*GameMoveHistory)(nil),              // 34: lilbattle.v1.GameMoveHistory
 }
 var file_lilbattle_v1_models_presenter_proto_depIdxs = []int32{
 	11, // 0: lilbattle.v1.InitializeSingletonResponse.response:type_name -> lilbattle.v1.InitializeGameResponse
-	16, // 1: lilbattle.v1.TurnOptionClickedRequest.pos:type_name -> lilbattle.v1.Position
-	16, // 2: lilbattle.v1.SceneClickedRequest.pos:type_name -> lilbattle.v1.Position
-	16, // 3: lilbattle.v1.BuildOptionClickedRequest.pos:type_name -> lilbattle.v1.Position
-	17, // 4: lilbattle.v1.ApplyRemoteChangesRequest.moves:type_name -> lilbattle.v1.GameMove
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	28, // 1: lilbattle.v1.TurnOptionClickedRequest.pos:type_name -> lilbattle.v1.Position
+	28, // 2: lilbattle.v1.SceneClickedRequest.pos:type_name -> lilbattle.v1.Position
+	28, // 3: lilbattle.v1.BuildOptionClickedRequest.pos:type_name -> lilbattle.v1.Position
+	29, // 4: lilbattle.v1.ApplyRemoteChangesRequest.moves:type_name -> lilbattle.v1.GameMove
+	30, // 5: lilbattle.v1.ApplyRemoteChangesRequest.encoded_moves:type_name -> lilbattle.v1.EncodedPayload
+	31, // 6: lilbattle.v1.ShowPingRequest.ping:type_name -> lilbattle.v1.Ping
+	32, // 7: lilbattle.v1.GameBundle.game:type_name -> lilbattle.v1.Game
+	33, // 8: lilbattle.v1.GameBundle.state:type_name -> lilbattle.v1.GameState
+	34, // 9: lilbattle.v1.GameBundle.history:type_name -> lilbattle.v1.GameMoveHistory
+	19, // 10: lilbattle.v1.SaveSlotResponse.slot:type_name -> lilbattle.v1.SaveSlotInfo
+	11, // 11: lilbattle.v1.LoadSlotResponse.response:type_name -> lilbattle.v1.InitializeGameResponse
+	19, // 12: lilbattle.v1.ListSlotsResponse.slots:type_name -> lilbattle.v1.SaveSlotInfo
+	32, // 13: lilbattle.v1.ExploreFromHereResponse.fork:type_name -> lilbattle.v1.Game
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_presenter_proto_init() }
@@ -998,13 +1742,14 @@ func file_lilbattle_v1_models_presenter_proto_init() {
 		return
 	}
 	file_lilbattle_v1_models_models_proto_init()
+	file_lilbattle_v1_models_sync_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_presenter_proto_rawDesc), len(file_lilbattle_v1_models_presenter_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,1076 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: lilbattle/v1/models/puzzles_service.proto
+
+package lilbattlev1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A curated puzzle: a position on a world with a goal to reach in a number of
+// turns. Each play of a puzzle is a new game started from the world.
+type Puzzle struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatorId   string                 `protobuf:"bytes,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// World the puzzle's position is taken from
+	WorldId string `protobuf:"bytes,5,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// eg "easy", "medium", "hard"
+	Difficulty string `protobuf:"bytes,6,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Goal, budget and opponent of the puzzle
+	Settings      *PuzzleSettings        `protobuf:"bytes,7,opt,name=settings,proto3" json:"settings,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Puzzle) Reset() {
+	*x = Puzzle{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Puzzle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Puzzle) ProtoMessage() {}
+
+func (x *Puzzle) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Puzzle.ProtoReflect.Descriptor instead.
+func (*Puzzle) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Puzzle) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Puzzle) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Puzzle) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Puzzle) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Puzzle) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *Puzzle) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *Puzzle) GetSettings() *PuzzleSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Puzzle) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Puzzle) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A user's play of a puzzle
+type PuzzleAttempt struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PuzzleId string                 `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	// Game the attempt is played in
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Unspecified while the attempt is in progress
+	Result        PuzzleResult           `protobuf:"varint,4,opt,name=result,proto3,enum=lilbattle.v1.PuzzleResult" json:"result,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleAttempt) Reset() {
+	*x = PuzzleAttempt{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleAttempt) ProtoMessage() {}
+
+func (x *PuzzleAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleAttempt.ProtoReflect.Descriptor instead.
+func (*PuzzleAttempt) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PuzzleAttempt) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+func (x *PuzzleAttempt) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *PuzzleAttempt) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PuzzleAttempt) GetResult() PuzzleResult {
+	if x != nil {
+		return x.Result
+	}
+	return PuzzleResult_PUZZLE_RESULT_UNSPECIFIED
+}
+
+func (x *PuzzleAttempt) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *PuzzleAttempt) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// The attempts made at a puzzle
+type PuzzleAttempts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PuzzleAttempt       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleAttempts) Reset() {
+	*x = PuzzleAttempts{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleAttempts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleAttempts) ProtoMessage() {}
+
+func (x *PuzzleAttempts) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleAttempts.ProtoReflect.Descriptor instead.
+func (*PuzzleAttempts) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PuzzleAttempts) GetItems() []*PuzzleAttempt {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreatePuzzleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puzzle        *Puzzle                `protobuf:"bytes,1,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePuzzleRequest) Reset() {
+	*x = CreatePuzzleRequest{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePuzzleRequest) ProtoMessage() {}
+
+func (x *CreatePuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePuzzleRequest.ProtoReflect.Descriptor instead.
+func (*CreatePuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePuzzleRequest) GetPuzzle() *Puzzle {
+	if x != nil {
+		return x.Puzzle
+	}
+	return nil
+}
+
+type CreatePuzzleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puzzle        *Puzzle                `protobuf:"bytes,1,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePuzzleResponse) Reset() {
+	*x = CreatePuzzleResponse{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePuzzleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePuzzleResponse) ProtoMessage() {}
+
+func (x *CreatePuzzleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePuzzleResponse.ProtoReflect.Descriptor instead.
+func (*CreatePuzzleResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatePuzzleResponse) GetPuzzle() *Puzzle {
+	if x != nil {
+		return x.Puzzle
+	}
+	return nil
+}
+
+type GetPuzzleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPuzzleRequest) Reset() {
+	*x = GetPuzzleRequest{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPuzzleRequest) ProtoMessage() {}
+
+func (x *GetPuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPuzzleRequest.ProtoReflect.Descriptor instead.
+func (*GetPuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPuzzleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPuzzleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puzzle        *Puzzle                `protobuf:"bytes,1,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPuzzleResponse) Reset() {
+	*x = GetPuzzleResponse{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPuzzleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPuzzleResponse) ProtoMessage() {}
+
+func (x *GetPuzzleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPuzzleResponse.ProtoReflect.Descriptor instead.
+func (*GetPuzzleResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPuzzleResponse) GetPuzzle() *Puzzle {
+	if x != nil {
+		return x.Puzzle
+	}
+	return nil
+}
+
+type ListPuzzlesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pagination info
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// May be filter by creator id
+	CreatorId     string `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPuzzlesRequest) Reset() {
+	*x = ListPuzzlesRequest{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPuzzlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPuzzlesRequest) ProtoMessage() {}
+
+func (x *ListPuzzlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPuzzlesRequest.ProtoReflect.Descriptor instead.
+func (*ListPuzzlesRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPuzzlesRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListPuzzlesRequest) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+type ListPuzzlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Puzzle              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPuzzlesResponse) Reset() {
+	*x = ListPuzzlesResponse{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPuzzlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPuzzlesResponse) ProtoMessage() {}
+
+func (x *ListPuzzlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPuzzlesResponse.ProtoReflect.Descriptor instead.
+func (*ListPuzzlesResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPuzzlesResponse) GetItems() []*Puzzle {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListPuzzlesResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type UpdatePuzzleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Puzzle being updated. Unset fields are left as they are.
+	Puzzle        *Puzzle `protobuf:"bytes,1,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePuzzleRequest) Reset() {
+	*x = UpdatePuzzleRequest{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePuzzleRequest) ProtoMessage() {}
+
+func (x *UpdatePuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePuzzleRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdatePuzzleRequest) GetPuzzle() *Puzzle {
+	if x != nil {
+		return x.Puzzle
+	}
+	return nil
+}
+
+type UpdatePuzzleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puzzle        *Puzzle                `protobuf:"bytes,1,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePuzzleResponse) Reset() {
+	*x = UpdatePuzzleResponse{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePuzzleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePuzzleResponse) ProtoMessage() {}
+
+func (x *UpdatePuzzleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePuzzleResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePuzzleResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdatePuzzleResponse) GetPuzzle() *Puzzle {
+	if x != nil {
+		return x.Puzzle
+	}
+	return nil
+}
+
+type DeletePuzzleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePuzzleRequest) Reset() {
+	*x = DeletePuzzleRequest{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePuzzleRequest) ProtoMessage() {}
+
+func (x *DeletePuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePuzzleRequest.ProtoReflect.Descriptor instead.
+func (*DeletePuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeletePuzzleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePuzzleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePuzzleResponse) Reset() {
+	*x = DeletePuzzleResponse{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePuzzleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePuzzleResponse) ProtoMessage() {}
+
+func (x *DeletePuzzleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePuzzleResponse.ProtoReflect.Descriptor instead.
+func (*DeletePuzzleResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{12}
+}
+
+type PlayPuzzleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PuzzleId      string                 `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayPuzzleRequest) Reset() {
+	*x = PlayPuzzleRequest{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayPuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayPuzzleRequest) ProtoMessage() {}
+
+func (x *PlayPuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayPuzzleRequest.ProtoReflect.Descriptor instead.
+func (*PlayPuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlayPuzzleRequest) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+type PlayPuzzleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Game the puzzle is played in, with the solver's seat taken by the caller
+	Game          *Game          `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	GameState     *GameState     `protobuf:"bytes,2,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`
+	Attempt       *PuzzleAttempt `protobuf:"bytes,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayPuzzleResponse) Reset() {
+	*x = PlayPuzzleResponse{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayPuzzleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayPuzzleResponse) ProtoMessage() {}
+
+func (x *PlayPuzzleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayPuzzleResponse.ProtoReflect.Descriptor instead.
+func (*PlayPuzzleResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PlayPuzzleResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *PlayPuzzleResponse) GetGameState() *GameState {
+	if x != nil {
+		return x.GameState
+	}
+	return nil
+}
+
+func (x *PlayPuzzleResponse) GetAttempt() *PuzzleAttempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+type ListPuzzleAttemptsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only attempts at this puzzle (all puzzles if empty)
+	PuzzleId      string `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPuzzleAttemptsRequest) Reset() {
+	*x = ListPuzzleAttemptsRequest{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPuzzleAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPuzzleAttemptsRequest) ProtoMessage() {}
+
+func (x *ListPuzzleAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPuzzleAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*ListPuzzleAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListPuzzleAttemptsRequest) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+type ListPuzzleAttemptsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The caller's attempts, newest first
+	Items         []*PuzzleAttempt `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPuzzleAttemptsResponse) Reset() {
+	*x = ListPuzzleAttemptsResponse{}
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPuzzleAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPuzzleAttemptsResponse) ProtoMessage() {}
+
+func (x *ListPuzzleAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_puzzles_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPuzzleAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*ListPuzzleAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListPuzzleAttemptsResponse) GetItems() []*PuzzleAttempt {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_lilbattle_v1_models_puzzles_service_proto protoreflect.FileDescriptor
+
+const file_lilbattle_v1_models_puzzles_service_proto_rawDesc = "" +
+	"\n" +
+	")lilbattle/v1/models/puzzles_service.proto\x12\flilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a lilbattle/v1/models/models.proto\"\xd8\x02\n" +
+	"\x06Puzzle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\tR\tcreatorId\x12\x19\n" +
+	"\bworld_id\x18\x05 \x01(\tR\aworldId\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x06 \x01(\tR\n" +
+	"difficulty\x128\n" +
+	"\bsettings\x18\a \x01(\v2\x1c.lilbattle.v1.PuzzleSettingsR\bsettings\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8a\x02\n" +
+	"\rPuzzleAttempt\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x122\n" +
+	"\x06result\x18\x04 \x01(\x0e2\x1a.lilbattle.v1.PuzzleResultR\x06result\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"C\n" +
+	"\x0ePuzzleAttempts\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.lilbattle.v1.PuzzleAttemptR\x05items\"C\n" +
+	"\x13CreatePuzzleRequest\x12,\n" +
+	"\x06puzzle\x18\x01 \x01(\v2\x14.lilbattle.v1.PuzzleR\x06puzzle\"D\n" +
+	"\x14CreatePuzzleResponse\x12,\n" +
+	"\x06puzzle\x18\x01 \x01(\v2\x14.lilbattle.v1.PuzzleR\x06puzzle\"\"\n" +
+	"\x10GetPuzzleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x11GetPuzzleResponse\x12,\n" +
+	"\x06puzzle\x18\x01 \x01(\v2\x14.lilbattle.v1.PuzzleR\x06puzzle\"m\n" +
+	"\x12ListPuzzlesRequest\x128\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
+	"pagination\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\tR\tcreatorId\"\x83\x01\n" +
+	"\x13ListPuzzlesResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.lilbattle.v1.PuzzleR\x05items\x12@\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2 .lilbattle.v1.PaginationResponseR\n" +
+	"pagination\"C\n" +
+	"\x13UpdatePuzzleRequest\x12,\n" +
+	"\x06puzzle\x18\x01 \x01(\v2\x14.lilbattle.v1.PuzzleR\x06puzzle\"D\n" +
+	"\x14UpdatePuzzleResponse\x12,\n" +
+	"\x06puzzle\x18\x01 \x01(\v2\x14.lilbattle.v1.PuzzleR\x06puzzle\"%\n" +
+	"\x13DeletePuzzleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14DeletePuzzleResponse\"0\n" +
+	"\x11PlayPuzzleRequest\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\"\xab\x01\n" +
+	"\x12PlayPuzzleResponse\x12&\n" +
+	"\x04game\x18\x01 \x01(\v2\x12.lilbattle.v1.GameR\x04game\x126\n" +
+	"\n" +
+	"game_state\x18\x02 \x01(\v2\x17.lilbattle.v1.GameStateR\tgameState\x125\n" +
+	"\aattempt\x18\x03 \x01(\v2\x1b.lilbattle.v1.PuzzleAttemptR\aattempt\"8\n" +
+	"\x19ListPuzzleAttemptsRequest\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\"O\n" +
+	"\x1aListPuzzleAttemptsResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.lilbattle.v1.PuzzleAttemptR\x05itemsB\xbf\x01\n" +
+	"\x10com.lilbattle.v1B\x13PuzzlesServiceProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
+
+var (
+	file_lilbattle_v1_models_puzzles_service_proto_rawDescOnce sync.Once
+	file_lilbattle_v1_models_puzzles_service_proto_rawDescData []byte
+)
+
+func file_lilbattle_v1_models_puzzles_service_proto_rawDescGZIP() []byte {
+	file_lilbattle_v1_models_puzzles_service_proto_rawDescOnce.Do(func() {
+		file_lilbattle_v1_models_puzzles_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_puzzles_service_proto_rawDesc), len(file_lilbattle_v1_models_puzzles_service_proto_rawDesc)))
+	})
+	return file_lilbattle_v1_models_puzzles_service_proto_rawDescData
+}
+
+var file_lilbattle_v1_models_puzzles_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_lilbattle_v1_models_puzzles_service_proto_goTypes = []any{
+	(*Puzzle)(nil),                     // 0: lilbattle.v1.Puzzle
+	(*PuzzleAttempt)(nil),              // 1: lilbattle.v1.PuzzleAttempt
+	(*PuzzleAttempts)(nil),             // 2: lilbattle.v1.PuzzleAttempts
+	(*CreatePuzzleRequest)(nil),        // 3: lilbattle.v1.CreatePuzzleRequest
+	(*CreatePuzzleResponse)(nil),       // 4: lilbattle.v1.CreatePuzzleResponse
+	(*GetPuzzleRequest)(nil),           // 5: lilbattle.v1.GetPuzzleRequest
+	(*GetPuzzleResponse)(nil),          // 6: lilbattle.v1.GetPuzzleResponse
+	(*ListPuzzlesRequest)(nil),         // 7: lilbattle.v1.ListPuzzlesRequest
+	(*ListPuzzlesResponse)(nil),        // 8: lilbattle.v1.ListPuzzlesResponse
+	(*UpdatePuzzleRequest)(nil),        // 9: lilbattle.v1.UpdatePuzzleRequest
+	(*UpdatePuzzleResponse)(nil),       // 10: lilbattle.v1.UpdatePuzzleResponse
+	(*DeletePuzzleRequest)(nil),        // 11: lilbattle.v1.DeletePuzzleRequest
+	(*DeletePuzzleResponse)(nil),       // 12: lilbattle.v1.DeletePuzzleResponse
+	(*PlayPuzzleRequest)(nil),          // 13: lilbattle.v1.PlayPuzzleRequest
+	(*PlayPuzzleResponse)(nil),         // 14: lilbattle.v1.PlayPuzzleResponse
+	(*ListPuzzleAttemptsRequest)(nil),  // 15: lilbattle.v1.ListPuzzleAttemptsRequest
+	(*ListPuzzleAttemptsResponse)(nil), // 16: lilbattle.v1.ListPuzzleAttemptsResponse
+	(*PuzzleSettings)(nil),             // 17: lilbattle.v1.PuzzleSettings
+	(*timestamppb.Timestamp)(nil),      // 18: google.protobuf.Timestamp
+	(PuzzleResult)(0),                  // 19: lilbattle.v1.PuzzleResult
+	(*Pagination)(nil),                 // 20: lilbattle.v1.Pagination
+	(*PaginationResponse)(nil),         // 21: lilbattle.v1.PaginationResponse
+	(*Game)(nil),                       // 22: lilbattle.v1.Game
+	(*GameState)(nil),                  // 23: lilbattle.v1.GameState
+}
+var file_lilbattle_v1_models_puzzles_service_proto_depIdxs = []int32{
+	17, // 0: lilbattle.v1.Puzzle.settings:type_name -> lilbattle.v1.PuzzleSettings
+	18, // 1: lilbattle.v1.Puzzle.created_at:type_name -> google.protobuf.Timestamp
+	18, // 2: lilbattle.v1.Puzzle.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 3: lilbattle.v1.PuzzleAttempt.result:type_name -> lilbattle.v1.PuzzleResult
+	18, // 4: lilbattle.v1.PuzzleAttempt.started_at:type_name -> google.protobuf.Timestamp
+	18, // 5: lilbattle.v1.PuzzleAttempt.finished_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: lilbattle.v1.PuzzleAttempts.items:type_name -> lilbattle.v1.PuzzleAttempt
+	0,  // 7: lilbattle.v1.CreatePuzzleRequest.puzzle:type_name -> lilbattle.v1.Puzzle
+	0,  // 8: lilbattle.v1.CreatePuzzleResponse.puzzle:type_name -> lilbattle.v1.Puzzle
+	0,  // 9: lilbattle.v1.GetPuzzleResponse.puzzle:type_name -> lilbattle.v1.Puzzle
+	20, // 10: lilbattle.v1.ListPuzzlesRequest.pagination:type_name -> lilbattle.v1.Pagination
+	0,  // 11: lilbattle.v1.ListPuzzlesResponse.items:type_name -> lilbattle.v1.Puzzle
+	21, // 12: lilbattle.v1.ListPuzzlesResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
+	0,  // 13: lilbattle.v1.UpdatePuzzleRequest.puzzle:type_name -> lilbattle.v1.Puzzle
+	0,  // 14: lilbattle.v1.UpdatePuzzleResponse.puzzle:type_name -> lilbattle.v1.Puzzle
+	22, // 15: lilbattle.v1.PlayPuzzleResponse.game:type_name -> lilbattle.v1.Game
+	23, // 16: lilbattle.v1.PlayPuzzleResponse.game_state:type_name -> lilbattle.v1.GameState
+	1,  // 17: lilbattle.v1.PlayPuzzleResponse.attempt:type_name -> lilbattle.v1.PuzzleAttempt
+	1,  // 18: lilbattle.v1.ListPuzzleAttemptsResponse.items:type_name -> lilbattle.v1.PuzzleAttempt
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_lilbattle_v1_models_puzzles_service_proto_init() }
+func file_lilbattle_v1_models_puzzles_service_proto_init() {
+	if File_lilbattle_v1_models_puzzles_service_proto != nil {
+		return
+	}
+	file_lilbattle_v1_models_models_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_puzzles_service_proto_rawDesc), len(file_lilbattle_v1_models_puzzles_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lilbattle_v1_models_puzzles_service_proto_goTypes,
+		DependencyIndexes: file_lilbattle_v1_models_puzzles_service_proto_depIdxs,
+		MessageInfos:      file_lilbattle_v1_models_puzzles_service_proto_msgTypes,
+	}.Build()
+	File_lilbattle_v1_models_puzzles_service_proto = out.File
+	file_lilbattle_v1_models_puzzles_service_proto_goTypes = nil
+	file_lilbattle_v1_models_puzzles_service_proto_depIdxs = nil
+}
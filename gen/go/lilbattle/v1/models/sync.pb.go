@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -32,9 +33,13 @@ type SubscribeRequest struct {
 	// Resume from this sequence number (for reconnection).
 	// Server will send any missed updates since this sequence.
 	// Use 0 to start from current state.
-	FromSequence  int64 `protobuf:"varint,3,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	FromSequence int64 `protobuf:"varint,3,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	// Encodings the client can decode for move payloads, most preferred first
+	// (e.g. "proto+gzip"). Moves are sent as plain MovesPublished updates when
+	// none of them are supported.
+	AcceptedEncodings []string `protobuf:"bytes,4,rep,name=accepted_encodings,json=acceptedEncodings,proto3" json:"accepted_encodings,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SubscribeRequest) Reset() {
@@ -88,6 +93,13 @@ func (x *SubscribeRequest) GetFromSequence() int64 {
 	return 0
 }
 
+func (x *SubscribeRequest) GetAcceptedEncodings() []string {
+	if x != nil {
+		return x.AcceptedEncodings
+	}
+	return nil
+}
+
 // SubscribeResponse sent once at the start of the subscription
 type SubscribeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -96,9 +108,19 @@ type SubscribeResponse struct {
 	// Current game state (for initial load or catchup)
 	GameState *GameState `protobuf:"bytes,2,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`
 	// Game metadata
-	Game          *Game `protobuf:"bytes,3,opt,name=game,proto3" json:"game,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Game *Game `protobuf:"bytes,3,opt,name=game,proto3" json:"game,omitempty"`
+	// Recent pings from the subscriber's team, so players coming back to an
+	// async game can catch up on them
+	RecentPings []*Ping `protobuf:"bytes,4,rep,name=recent_pings,json=recentPings,proto3" json:"recent_pings,omitempty"`
+	// Encoding negotiated for this subscriber's move payloads ("json" when
+	// moves are sent as plain MovesPublished updates)
+	Encoding string `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// Set for spectators of a game with a spectator delay: moves reach them
+	// only once this old, and current_sequence is the last update released to
+	// them
+	SpectatorDelay *SpectatorDelay `protobuf:"bytes,6,opt,name=spectator_delay,json=spectatorDelay,proto3" json:"spectator_delay,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SubscribeResponse) Reset() {
@@ -152,6 +174,27 @@ func (x *SubscribeResponse) GetGame() *Game {
 	return nil
 }
 
+func (x *SubscribeResponse) GetRecentPings() []*Ping {
+	if x != nil {
+		return x.RecentPings
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *SubscribeResponse) GetSpectatorDelay() *SpectatorDelay {
+	if x != nil {
+		return x.SpectatorDelay
+	}
+	return nil
+}
+
 // GameUpdate is streamed to subscribers when game state changes
 type GameUpdate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -165,6 +208,9 @@ type GameUpdate struct {
 	//	*GameUpdate_PlayerLeft
 	//	*GameUpdate_GameEnded
 	//	*GameUpdate_InitialState
+	//	*GameUpdate_Ping
+	//	*GameUpdate_EncodedMoves
+	//	*GameUpdate_StuckWarning
 	UpdateType    isGameUpdate_UpdateType `protobuf_oneof:"update_type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -259,6 +305,33 @@ func (x *GameUpdate) GetInitialState() *SubscribeResponse {
 	return nil
 }
 
+func (x *GameUpdate) GetPing() *Ping {
+	if x != nil {
+		if x, ok := x.UpdateType.(*GameUpdate_Ping); ok {
+			return x.Ping
+		}
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetEncodedMoves() *EncodedPayload {
+	if x != nil {
+		if x, ok := x.UpdateType.(*GameUpdate_EncodedMoves); ok {
+			return x.EncodedMoves
+		}
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetStuckWarning() *StuckAnalysis {
+	if x != nil {
+		if x, ok := x.UpdateType.(*GameUpdate_StuckWarning); ok {
+			return x.StuckWarning
+		}
+	}
+	return nil
+}
+
 type isGameUpdate_UpdateType interface {
 	isGameUpdate_UpdateType()
 }
@@ -288,6 +361,21 @@ type GameUpdate_InitialState struct {
 	InitialState *SubscribeResponse `protobuf:"bytes,6,opt,name=initial_state,json=initialState,proto3,oneof"`
 }
 
+type GameUpdate_Ping struct {
+	// A teammate marked a hex (only delivered to the sender's team)
+	Ping *Ping `protobuf:"bytes,7,opt,name=ping,proto3,oneof"`
+}
+
+type GameUpdate_EncodedMoves struct {
+	// MovesPublished in the subscriber's negotiated encoding
+	EncodedMoves *EncodedPayload `protobuf:"bytes,8,opt,name=encoded_moves,json=encodedMoves,proto3,oneof"`
+}
+
+type GameUpdate_StuckWarning struct {
+	// The game looks stuck and may be ended with a no-contact draw
+	StuckWarning *StuckAnalysis `protobuf:"bytes,9,opt,name=stuck_warning,json=stuckWarning,proto3,oneof"`
+}
+
 func (*GameUpdate_MovesPublished) isGameUpdate_UpdateType() {}
 
 func (*GameUpdate_PlayerJoined) isGameUpdate_UpdateType() {}
@@ -298,6 +386,66 @@ func (*GameUpdate_GameEnded) isGameUpdate_UpdateType() {}
 
 func (*GameUpdate_InitialState) isGameUpdate_UpdateType() {}
 
+func (*GameUpdate_Ping) isGameUpdate_UpdateType() {}
+
+func (*GameUpdate_EncodedMoves) isGameUpdate_UpdateType() {}
+
+func (*GameUpdate_StuckWarning) isGameUpdate_UpdateType() {}
+
+// EncodedPayload carries a message in a compact encoding
+type EncodedPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How data is encoded, e.g. "proto+gzip" (gzip-compressed binary proto)
+	Encoding      string `protobuf:"bytes,1,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncodedPayload) Reset() {
+	*x = EncodedPayload{}
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncodedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodedPayload) ProtoMessage() {}
+
+func (x *EncodedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodedPayload.ProtoReflect.Descriptor instead.
+func (*EncodedPayload) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EncodedPayload) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *EncodedPayload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // MovesPublished indicates a player made moves
 type MovesPublished struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -313,7 +461,7 @@ type MovesPublished struct {
 
 func (x *MovesPublished) Reset() {
 	*x = MovesPublished{}
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[3]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -325,7 +473,7 @@ func (x *MovesPublished) String() string {
 func (*MovesPublished) ProtoMessage() {}
 
 func (x *MovesPublished) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[3]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +486,7 @@ func (x *MovesPublished) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MovesPublished.ProtoReflect.Descriptor instead.
 func (*MovesPublished) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{3}
+	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MovesPublished) GetPlayer() int32 {
@@ -373,7 +521,7 @@ type PlayerJoined struct {
 
 func (x *PlayerJoined) Reset() {
 	*x = PlayerJoined{}
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[4]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -385,7 +533,7 @@ func (x *PlayerJoined) String() string {
 func (*PlayerJoined) ProtoMessage() {}
 
 func (x *PlayerJoined) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[4]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +546,7 @@ func (x *PlayerJoined) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerJoined.ProtoReflect.Descriptor instead.
 func (*PlayerJoined) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{4}
+	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PlayerJoined) GetPlayerId() string {
@@ -426,7 +574,7 @@ type PlayerLeft struct {
 
 func (x *PlayerLeft) Reset() {
 	*x = PlayerLeft{}
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[5]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -438,7 +586,7 @@ func (x *PlayerLeft) String() string {
 func (*PlayerLeft) ProtoMessage() {}
 
 func (x *PlayerLeft) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[5]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -451,7 +599,7 @@ func (x *PlayerLeft) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerLeft.ProtoReflect.Descriptor instead.
 func (*PlayerLeft) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{5}
+	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PlayerLeft) GetPlayerId() string {
@@ -481,7 +629,7 @@ type GameEnded struct {
 
 func (x *GameEnded) Reset() {
 	*x = GameEnded{}
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[6]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -493,7 +641,7 @@ func (x *GameEnded) String() string {
 func (*GameEnded) ProtoMessage() {}
 
 func (x *GameEnded) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[6]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,7 +654,7 @@ func (x *GameEnded) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameEnded.ProtoReflect.Descriptor instead.
 func (*GameEnded) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{6}
+	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GameEnded) GetWinner() int32 {
@@ -537,7 +685,7 @@ type BroadcastRequest struct {
 
 func (x *BroadcastRequest) Reset() {
 	*x = BroadcastRequest{}
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[7]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -549,7 +697,7 @@ func (x *BroadcastRequest) String() string {
 func (*BroadcastRequest) ProtoMessage() {}
 
 func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[7]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +710,7 @@ func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastRequest.ProtoReflect.Descriptor instead.
 func (*BroadcastRequest) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{7}
+	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BroadcastRequest) GetGameId() string {
@@ -592,7 +740,7 @@ type BroadcastResponse struct {
 
 func (x *BroadcastResponse) Reset() {
 	*x = BroadcastResponse{}
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[8]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -604,7 +752,7 @@ func (x *BroadcastResponse) String() string {
 func (*BroadcastResponse) ProtoMessage() {}
 
 func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[8]
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +765,7 @@ func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastResponse.ProtoReflect.Descriptor instead.
 func (*BroadcastResponse) Descriptor() ([]byte, []int) {
-	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{8}
+	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BroadcastResponse) GetSubscriberCount() int32 {
@@ -634,20 +782,213 @@ func (x *BroadcastResponse) GetSequence() int64 {
 	return 0
 }
 
+// Ping is a short-lived alert marker a player places on a hex for their team
+type Ping struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Player that sent the ping
+	PlayerId int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Team the ping is visible to
+	TeamId int32 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Hex being marked
+	Pos *Position `protobuf:"bytes,3,opt,name=pos,proto3" json:"pos,omitempty"`
+	// "attack", "defend" or "danger"
+	PingType      string                 `protobuf:"bytes,4,opt,name=ping_type,json=pingType,proto3" json:"ping_type,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ping) Reset() {
+	*x = Ping{}
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ping) ProtoMessage() {}
+
+func (x *Ping) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ping.ProtoReflect.Descriptor instead.
+func (*Ping) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Ping) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *Ping) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Ping) GetPos() *Position {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+func (x *Ping) GetPingType() string {
+	if x != nil {
+		return x.PingType
+	}
+	return ""
+}
+
+func (x *Ping) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+// SendPingRequest marks a hex for the sender's teammates
+type SendPingRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Hex to mark (shortcut labels like "A1" or "4,-2" are resolved by the client)
+	Pos *Position `protobuf:"bytes,2,opt,name=pos,proto3" json:"pos,omitempty"`
+	// "attack", "defend" or "danger"
+	PingType      string `protobuf:"bytes,3,opt,name=ping_type,json=pingType,proto3" json:"ping_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPingRequest) Reset() {
+	*x = SendPingRequest{}
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPingRequest) ProtoMessage() {}
+
+func (x *SendPingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPingRequest.ProtoReflect.Descriptor instead.
+func (*SendPingRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SendPingRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SendPingRequest) GetPos() *Position {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+func (x *SendPingRequest) GetPingType() string {
+	if x != nil {
+		return x.PingType
+	}
+	return ""
+}
+
+// SendPingResponse after sending a ping
+type SendPingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ping          *Ping                  `protobuf:"bytes,1,opt,name=ping,proto3" json:"ping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPingResponse) Reset() {
+	*x = SendPingResponse{}
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPingResponse) ProtoMessage() {}
+
+func (x *SendPingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_sync_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPingResponse.ProtoReflect.Descriptor instead.
+func (*SendPingResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_sync_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SendPingResponse) GetPing() *Ping {
+	if x != nil {
+		return x.Ping
+	}
+	return nil
+}
+
 var File_lilbattle_v1_models_sync_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_models_sync_proto_rawDesc = "" +
 	"\n" +
-	"\x1elilbattle/v1/models/sync.proto\x12\flilbattle.v1\x1a lilbattle/v1/models/models.proto\"m\n" +
+	"\x1elilbattle/v1/models/sync.proto\x12\flilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a lilbattle/v1/models/models.proto\"\x9c\x01\n" +
 	"\x10SubscribeRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
 	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12#\n" +
-	"\rfrom_sequence\x18\x03 \x01(\x03R\ffromSequence\"\x9e\x01\n" +
+	"\rfrom_sequence\x18\x03 \x01(\x03R\ffromSequence\x12-\n" +
+	"\x12accepted_encodings\x18\x04 \x03(\tR\x11acceptedEncodings\"\xb8\x02\n" +
 	"\x11SubscribeResponse\x12)\n" +
 	"\x10current_sequence\x18\x01 \x01(\x03R\x0fcurrentSequence\x126\n" +
 	"\n" +
 	"game_state\x18\x02 \x01(\v2\x17.lilbattle.v1.GameStateR\tgameState\x12&\n" +
-	"\x04game\x18\x03 \x01(\v2\x12.lilbattle.v1.GameR\x04game\"\x82\x03\n" +
+	"\x04game\x18\x03 \x01(\v2\x12.lilbattle.v1.GameR\x04game\x125\n" +
+	"\frecent_pings\x18\x04 \x03(\v2\x12.lilbattle.v1.PingR\vrecentPings\x12\x1a\n" +
+	"\bencoding\x18\x05 \x01(\tR\bencoding\x12E\n" +
+	"\x0fspectator_delay\x18\x06 \x01(\v2\x1c.lilbattle.v1.SpectatorDelayR\x0espectatorDelay\"\xb5\x04\n" +
 	"\n" +
 	"GameUpdate\x12\x1a\n" +
 	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12G\n" +
@@ -657,8 +998,14 @@ const file_lilbattle_v1_models_sync_proto_rawDesc = "" +
 	"playerLeft\x128\n" +
 	"\n" +
 	"game_ended\x18\x05 \x01(\v2\x17.lilbattle.v1.GameEndedH\x00R\tgameEnded\x12F\n" +
-	"\rinitial_state\x18\x06 \x01(\v2\x1f.lilbattle.v1.SubscribeResponseH\x00R\finitialStateB\r\n" +
-	"\vupdate_type\"y\n" +
+	"\rinitial_state\x18\x06 \x01(\v2\x1f.lilbattle.v1.SubscribeResponseH\x00R\finitialState\x12(\n" +
+	"\x04ping\x18\a \x01(\v2\x12.lilbattle.v1.PingH\x00R\x04ping\x12C\n" +
+	"\rencoded_moves\x18\b \x01(\v2\x1c.lilbattle.v1.EncodedPayloadH\x00R\fencodedMoves\x12B\n" +
+	"\rstuck_warning\x18\t \x01(\v2\x1b.lilbattle.v1.StuckAnalysisH\x00R\fstuckWarningB\r\n" +
+	"\vupdate_type\"@\n" +
+	"\x0eEncodedPayload\x12\x1a\n" +
+	"\bencoding\x18\x01 \x01(\tR\bencoding\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"y\n" +
 	"\x0eMovesPublished\x12\x16\n" +
 	"\x06player\x18\x01 \x01(\x05R\x06player\x12,\n" +
 	"\x05moves\x18\x02 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\x12!\n" +
@@ -678,7 +1025,19 @@ const file_lilbattle_v1_models_sync_proto_rawDesc = "" +
 	"\x06update\x18\x02 \x01(\v2\x18.lilbattle.v1.GameUpdateR\x06update\"Z\n" +
 	"\x11BroadcastResponse\x12)\n" +
 	"\x10subscriber_count\x18\x01 \x01(\x05R\x0fsubscriberCount\x12\x1a\n" +
-	"\bsequence\x18\x02 \x01(\x03R\bsequenceB\xb5\x01\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\"\xb8\x01\n" +
+	"\x04Ping\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\x05R\x06teamId\x12(\n" +
+	"\x03pos\x18\x03 \x01(\v2\x16.lilbattle.v1.PositionR\x03pos\x12\x1b\n" +
+	"\tping_type\x18\x04 \x01(\tR\bpingType\x123\n" +
+	"\asent_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\"q\n" +
+	"\x0fSendPingRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12(\n" +
+	"\x03pos\x18\x02 \x01(\v2\x16.lilbattle.v1.PositionR\x03pos\x12\x1b\n" +
+	"\tping_type\x18\x03 \x01(\tR\bpingType\":\n" +
+	"\x10SendPingResponse\x12&\n" +
+	"\x04ping\x18\x01 \x01(\v2\x12.lilbattle.v1.PingR\x04pingB\xb5\x01\n" +
 	"\x10com.lilbattle.v1B\tSyncProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var (
@@ -693,36 +1052,53 @@ func file_lilbattle_v1_models_sync_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_models_sync_proto_rawDescData
 }
 
-var file_lilbattle_v1_models_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_lilbattle_v1_models_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_lilbattle_v1_models_sync_proto_goTypes = []any{
-	(*SubscribeRequest)(nil),  // 0: lilbattle.v1.SubscribeRequest
-	(*SubscribeResponse)(nil), // 1: lilbattle.v1.SubscribeResponse
-	(*GameUpdate)(nil),        // 2: lilbattle.v1.GameUpdate
-	(*MovesPublished)(nil),    // 3: lilbattle.v1.MovesPublished
-	(*PlayerJoined)(nil),      // 4: lilbattle.v1.PlayerJoined
-	(*PlayerLeft)(nil),        // 5: lilbattle.v1.PlayerLeft
-	(*GameEnded)(nil),         // 6: lilbattle.v1.GameEnded
-	(*BroadcastRequest)(nil),  // 7: lilbattle.v1.BroadcastRequest
-	(*BroadcastResponse)(nil), // 8: lilbattle.v1.BroadcastResponse
-	(*GameState)(nil),         // 9: lilbattle.v1.GameState
-	(*Game)(nil),              // 10: lilbattle.v1.Game
-	(*GameMove)(nil),          // 11: lilbattle.v1.GameMove
+	(*SubscribeRequest)(nil),      // 0: lilbattle.v1.SubscribeRequest
+	(*SubscribeResponse)(nil),     // 1: lilbattle.v1.SubscribeResponse
+	(*GameUpdate)(nil),            // 2: lilbattle.v1.GameUpdate
+	(*EncodedPayload)(nil),        // 3: lilbattle.v1.EncodedPayload
+	(*MovesPublished)(nil),        // 4: lilbattle.v1.MovesPublished
+	(*PlayerJoined)(nil),          // 5: lilbattle.v1.PlayerJoined
+	(*PlayerLeft)(nil),            // 6: lilbattle.v1.PlayerLeft
+	(*GameEnded)(nil),             // 7: lilbattle.v1.GameEnded
+	(*BroadcastRequest)(nil),      // 8: lilbattle.v1.BroadcastRequest
+	(*BroadcastResponse)(nil),     // 9: lilbattle.v1.BroadcastResponse
+	(*Ping)(nil),                  // 10: lilbattle.v1.Ping
+	(*SendPingRequest)(nil),       // 11: lilbattle.v1.SendPingRequest
+	(*SendPingResponse)(nil),      // 12: lilbattle.v1.SendPingResponse
+	(*GameState)(nil),             // 13: lilbattle.v1.GameState
+	(*Game)(nil),                  // 14: lilbattle.v1.Game
+	(*SpectatorDelay)(nil),        // 15: lilbattle.v1.SpectatorDelay
+	(*StuckAnalysis)(nil),         // 16: lilbattle.v1.StuckAnalysis
+	(*GameMove)(nil),              // 17: lilbattle.v1.GameMove
+	(*Position)(nil),              // 18: lilbattle.v1.Position
+	(*timestamppb.Timestamp)(nil), // 19: google.protobuf.Timestamp
 }
 var file_lilbattle_v1_models_sync_proto_depIdxs = []int32{
-	9,  // 0: lilbattle.v1.SubscribeResponse.game_state:type_name -> lilbattle.v1.GameState
-	10, // 1: lilbattle.v1.SubscribeResponse.game:type_name -> lilbattle.v1.Game
-	3,  // 2: lilbattle.v1.GameUpdate.moves_published:type_name -> lilbattle.v1.MovesPublished
-	4,  // 3: lilbattle.v1.GameUpdate.player_joined:type_name -> lilbattle.v1.PlayerJoined
-	5,  // 4: lilbattle.v1.GameUpdate.player_left:type_name -> lilbattle.v1.PlayerLeft
-	6,  // 5: lilbattle.v1.GameUpdate.game_ended:type_name -> lilbattle.v1.GameEnded
-	1,  // 6: lilbattle.v1.GameUpdate.initial_state:type_name -> lilbattle.v1.SubscribeResponse
-	11, // 7: lilbattle.v1.MovesPublished.moves:type_name -> lilbattle.v1.GameMove
-	2,  // 8: lilbattle.v1.BroadcastRequest.update:type_name -> lilbattle.v1.GameUpdate
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	13, // 0: lilbattle.v1.SubscribeResponse.game_state:type_name -> lilbattle.v1.GameState
+	14, // 1: lilbattle.v1.SubscribeResponse.game:type_name -> lilbattle.v1.Game
+	10, // 2: lilbattle.v1.SubscribeResponse.recent_pings:type_name -> lilbattle.v1.Ping
+	15, // 3: lilbattle.v1.SubscribeResponse.spectator_delay:type_name -> lilbattle.v1.SpectatorDelay
+	4,  // 4: lilbattle.v1.GameUpdate.moves_published:type_name -> lilbattle.v1.MovesPublished
+	5,  // 5: lilbattle.v1.GameUpdate.player_joined:type_name -> lilbattle.v1.PlayerJoined
+	6,  // 6: lilbattle.v1.GameUpdate.player_left:type_name -> lilbattle.v1.PlayerLeft
+	7,  // 7: lilbattle.v1.GameUpdate.game_ended:type_name -> lilbattle.v1.GameEnded
+	1,  // 8: lilbattle.v1.GameUpdate.initial_state:type_name -> lilbattle.v1.SubscribeResponse
+	10, // 9: lilbattle.v1.GameUpdate.ping:type_name -> lilbattle.v1.Ping
+	3,  // 10: lilbattle.v1.GameUpdate.encoded_moves:type_name -> lilbattle.v1.EncodedPayload
+	16, // 11: lilbattle.v1.GameUpdate.stuck_warning:type_name -> lilbattle.v1.StuckAnalysis
+	17, // 12: lilbattle.v1.MovesPublished.moves:type_name -> lilbattle.v1.GameMove
+	2,  // 13: lilbattle.v1.BroadcastRequest.update:type_name -> lilbattle.v1.GameUpdate
+	18, // 14: lilbattle.v1.Ping.pos:type_name -> lilbattle.v1.Position
+	19, // 15: lilbattle.v1.Ping.sent_at:type_name -> google.protobuf.Timestamp
+	18, // 16: lilbattle.v1.SendPingRequest.pos:type_name -> lilbattle.v1.Position
+	10, // 17: lilbattle.v1.SendPingResponse.ping:type_name -> lilbattle.v1.Ping
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_sync_proto_init() }
@@ -737,6 +1113,9 @@ func file_lilbattle_v1_models_sync_proto_init() {
 		(*GameUpdate_PlayerLeft)(nil),
 		(*GameUpdate_GameEnded)(nil),
 		(*GameUpdate_InitialState)(nil),
+		(*GameUpdate_Ping)(nil),
+		(*GameUpdate_EncodedMoves)(nil),
+		(*GameUpdate_StuckWarning)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -744,7 +1123,7 @@ func file_lilbattle_v1_models_sync_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_sync_proto_rawDesc), len(file_lilbattle_v1_models_sync_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
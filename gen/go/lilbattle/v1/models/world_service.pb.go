@@ -767,6 +767,836 @@ func (x *CreateWorldResponse) GetFieldErrors() map[string]string {
 	return nil
 }
 
+// *
+// Request to replace a world's rules overrides
+type SetWorldRulesOverridesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// The new overrides.  Empty overrides clear them.
+	Overrides     *RulesOverrides `protobuf:"bytes,2,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorldRulesOverridesRequest) Reset() {
+	*x = SetWorldRulesOverridesRequest{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorldRulesOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorldRulesOverridesRequest) ProtoMessage() {}
+
+func (x *SetWorldRulesOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorldRulesOverridesRequest.ProtoReflect.Descriptor instead.
+func (*SetWorldRulesOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetWorldRulesOverridesRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *SetWorldRulesOverridesRequest) GetOverrides() *RulesOverrides {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+type SetWorldRulesOverridesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         *World                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorldRulesOverridesResponse) Reset() {
+	*x = SetWorldRulesOverridesResponse{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorldRulesOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorldRulesOverridesResponse) ProtoMessage() {}
+
+func (x *SetWorldRulesOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorldRulesOverridesResponse.ProtoReflect.Descriptor instead.
+func (*SetWorldRulesOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetWorldRulesOverridesResponse) GetWorld() *World {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+// *
+// A single edit to a world's map.  Coordinates are axial q,r.
+type WorldEdit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Edit:
+	//
+	//	*WorldEdit_PaintTerrain
+	//	*WorldEdit_SetOwner
+	//	*WorldEdit_PlaceUnit
+	//	*WorldEdit_Remove
+	//	*WorldEdit_SetVictoryPoints
+	Edit          isWorldEdit_Edit `protobuf_oneof:"edit"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldEdit) Reset() {
+	*x = WorldEdit{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldEdit) ProtoMessage() {}
+
+func (x *WorldEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldEdit.ProtoReflect.Descriptor instead.
+func (*WorldEdit) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WorldEdit) GetEdit() isWorldEdit_Edit {
+	if x != nil {
+		return x.Edit
+	}
+	return nil
+}
+
+func (x *WorldEdit) GetPaintTerrain() *PaintTerrainEdit {
+	if x != nil {
+		if x, ok := x.Edit.(*WorldEdit_PaintTerrain); ok {
+			return x.PaintTerrain
+		}
+	}
+	return nil
+}
+
+func (x *WorldEdit) GetSetOwner() *SetOwnerEdit {
+	if x != nil {
+		if x, ok := x.Edit.(*WorldEdit_SetOwner); ok {
+			return x.SetOwner
+		}
+	}
+	return nil
+}
+
+func (x *WorldEdit) GetPlaceUnit() *PlaceUnitEdit {
+	if x != nil {
+		if x, ok := x.Edit.(*WorldEdit_PlaceUnit); ok {
+			return x.PlaceUnit
+		}
+	}
+	return nil
+}
+
+func (x *WorldEdit) GetRemove() *RemoveEdit {
+	if x != nil {
+		if x, ok := x.Edit.(*WorldEdit_Remove); ok {
+			return x.Remove
+		}
+	}
+	return nil
+}
+
+func (x *WorldEdit) GetSetVictoryPoints() *SetVictoryPointsEdit {
+	if x != nil {
+		if x, ok := x.Edit.(*WorldEdit_SetVictoryPoints); ok {
+			return x.SetVictoryPoints
+		}
+	}
+	return nil
+}
+
+type isWorldEdit_Edit interface {
+	isWorldEdit_Edit()
+}
+
+type WorldEdit_PaintTerrain struct {
+	PaintTerrain *PaintTerrainEdit `protobuf:"bytes,1,opt,name=paint_terrain,json=paintTerrain,proto3,oneof"`
+}
+
+type WorldEdit_SetOwner struct {
+	SetOwner *SetOwnerEdit `protobuf:"bytes,2,opt,name=set_owner,json=setOwner,proto3,oneof"`
+}
+
+type WorldEdit_PlaceUnit struct {
+	PlaceUnit *PlaceUnitEdit `protobuf:"bytes,3,opt,name=place_unit,json=placeUnit,proto3,oneof"`
+}
+
+type WorldEdit_Remove struct {
+	Remove *RemoveEdit `protobuf:"bytes,4,opt,name=remove,proto3,oneof"`
+}
+
+type WorldEdit_SetVictoryPoints struct {
+	SetVictoryPoints *SetVictoryPointsEdit `protobuf:"bytes,5,opt,name=set_victory_points,json=setVictoryPoints,proto3,oneof"`
+}
+
+func (*WorldEdit_PaintTerrain) isWorldEdit_Edit() {}
+
+func (*WorldEdit_SetOwner) isWorldEdit_Edit() {}
+
+func (*WorldEdit_PlaceUnit) isWorldEdit_Edit() {}
+
+func (*WorldEdit_Remove) isWorldEdit_Edit() {}
+
+func (*WorldEdit_SetVictoryPoints) isWorldEdit_Edit() {}
+
+// Sets the terrain of a hex, adding the tile if there is none
+type PaintTerrainEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	TileType      int32                  `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaintTerrainEdit) Reset() {
+	*x = PaintTerrainEdit{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaintTerrainEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaintTerrainEdit) ProtoMessage() {}
+
+func (x *PaintTerrainEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaintTerrainEdit.ProtoReflect.Descriptor instead.
+func (*PaintTerrainEdit) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PaintTerrainEdit) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *PaintTerrainEdit) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *PaintTerrainEdit) GetTileType() int32 {
+	if x != nil {
+		return x.TileType
+	}
+	return 0
+}
+
+// Sets the player owning a city tile (0 for neutral)
+type SetOwnerEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	Player        int32                  `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOwnerEdit) Reset() {
+	*x = SetOwnerEdit{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOwnerEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOwnerEdit) ProtoMessage() {}
+
+func (x *SetOwnerEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOwnerEdit.ProtoReflect.Descriptor instead.
+func (*SetOwnerEdit) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetOwnerEdit) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *SetOwnerEdit) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *SetOwnerEdit) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+// Places a unit on a tile, replacing any unit already there
+type PlaceUnitEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	UnitType      int32                  `protobuf:"varint,3,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	Player        int32                  `protobuf:"varint,4,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceUnitEdit) Reset() {
+	*x = PlaceUnitEdit{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceUnitEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceUnitEdit) ProtoMessage() {}
+
+func (x *PlaceUnitEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceUnitEdit.ProtoReflect.Descriptor instead.
+func (*PlaceUnitEdit) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PlaceUnitEdit) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *PlaceUnitEdit) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *PlaceUnitEdit) GetUnitType() int32 {
+	if x != nil {
+		return x.UnitType
+	}
+	return 0
+}
+
+func (x *PlaceUnitEdit) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+// Removes the unit on a hex, and the tile too if remove_tile is set
+type RemoveEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	RemoveTile    bool                   `protobuf:"varint,3,opt,name=remove_tile,json=removeTile,proto3" json:"remove_tile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEdit) Reset() {
+	*x = RemoveEdit{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEdit) ProtoMessage() {}
+
+func (x *RemoveEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEdit.ProtoReflect.Descriptor instead.
+func (*RemoveEdit) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveEdit) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *RemoveEdit) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *RemoveEdit) GetRemoveTile() bool {
+	if x != nil {
+		return x.RemoveTile
+	}
+	return false
+}
+
+// Makes a tile a victory point marker worth points per turn held (0 to
+// clear the marker)
+type SetVictoryPointsEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVictoryPointsEdit) Reset() {
+	*x = SetVictoryPointsEdit{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVictoryPointsEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVictoryPointsEdit) ProtoMessage() {}
+
+func (x *SetVictoryPointsEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVictoryPointsEdit.ProtoReflect.Descriptor instead.
+func (*SetVictoryPointsEdit) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetVictoryPointsEdit) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *SetVictoryPointsEdit) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *SetVictoryPointsEdit) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// *
+// Request to apply a batch of edits to a world.  The batch is applied
+// atomically: if any edit is invalid none are applied.
+type ApplyWorldEditsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Edits         []*WorldEdit           `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyWorldEditsRequest) Reset() {
+	*x = ApplyWorldEditsRequest{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyWorldEditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyWorldEditsRequest) ProtoMessage() {}
+
+func (x *ApplyWorldEditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyWorldEditsRequest.ProtoReflect.Descriptor instead.
+func (*ApplyWorldEditsRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ApplyWorldEditsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *ApplyWorldEditsRequest) GetEdits() []*WorldEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+type ApplyWorldEditsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The world data version after the batch
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyWorldEditsResponse) Reset() {
+	*x = ApplyWorldEditsResponse{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyWorldEditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyWorldEditsResponse) ProtoMessage() {}
+
+func (x *ApplyWorldEditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyWorldEditsResponse.ProtoReflect.Descriptor instead.
+func (*ApplyWorldEditsResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ApplyWorldEditsResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// An inclusive box of axial coordinates
+type HexRegion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinQ          int32                  `protobuf:"varint,1,opt,name=min_q,json=minQ,proto3" json:"min_q,omitempty"`
+	MaxQ          int32                  `protobuf:"varint,2,opt,name=max_q,json=maxQ,proto3" json:"max_q,omitempty"`
+	MinR          int32                  `protobuf:"varint,3,opt,name=min_r,json=minR,proto3" json:"min_r,omitempty"`
+	MaxR          int32                  `protobuf:"varint,4,opt,name=max_r,json=maxR,proto3" json:"max_r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HexRegion) Reset() {
+	*x = HexRegion{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HexRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HexRegion) ProtoMessage() {}
+
+func (x *HexRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HexRegion.ProtoReflect.Descriptor instead.
+func (*HexRegion) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HexRegion) GetMinQ() int32 {
+	if x != nil {
+		return x.MinQ
+	}
+	return 0
+}
+
+func (x *HexRegion) GetMaxQ() int32 {
+	if x != nil {
+		return x.MaxQ
+	}
+	return 0
+}
+
+func (x *HexRegion) GetMinR() int32 {
+	if x != nil {
+		return x.MinR
+	}
+	return 0
+}
+
+func (x *HexRegion) GetMaxR() int32 {
+	if x != nil {
+		return x.MaxR
+	}
+	return 0
+}
+
+// *
+// Request to read back the tiles and units of a world
+type GetWorldTilesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Only tiles and units inside the region.  Unset for the whole world.
+	Region        *HexRegion `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorldTilesRequest) Reset() {
+	*x = GetWorldTilesRequest{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorldTilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorldTilesRequest) ProtoMessage() {}
+
+func (x *GetWorldTilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorldTilesRequest.ProtoReflect.Descriptor instead.
+func (*GetWorldTilesRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetWorldTilesRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetWorldTilesRequest) GetRegion() *HexRegion {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+type GetWorldTilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tiles []*Tile                `protobuf:"bytes,1,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	Units []*Unit                `protobuf:"bytes,2,rep,name=units,proto3" json:"units,omitempty"`
+	// The world data version the tiles were read at
+	Version       int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorldTilesResponse) Reset() {
+	*x = GetWorldTilesResponse{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorldTilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorldTilesResponse) ProtoMessage() {}
+
+func (x *GetWorldTilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorldTilesResponse.ProtoReflect.Descriptor instead.
+func (*GetWorldTilesResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetWorldTilesResponse) GetTiles() []*Tile {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *GetWorldTilesResponse) GetUnits() []*Unit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *GetWorldTilesResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 var File_lilbattle_v1_models_world_service_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_models_world_service_proto_rawDesc = "" +
@@ -835,7 +1665,60 @@ const file_lilbattle_v1_models_world_service_proto_rawDesc = "" +
 	"\ffield_errors\x18\x03 \x03(\v22.lilbattle.v1.CreateWorldResponse.FieldErrorsEntryR\vfieldErrors\x1a>\n" +
 	"\x10FieldErrorsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\xbd\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"v\n" +
+	"\x1dSetWorldRulesOverridesRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12:\n" +
+	"\toverrides\x18\x02 \x01(\v2\x1c.lilbattle.v1.RulesOverridesR\toverrides\"K\n" +
+	"\x1eSetWorldRulesOverridesResponse\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\"\xdb\x02\n" +
+	"\tWorldEdit\x12E\n" +
+	"\rpaint_terrain\x18\x01 \x01(\v2\x1e.lilbattle.v1.PaintTerrainEditH\x00R\fpaintTerrain\x129\n" +
+	"\tset_owner\x18\x02 \x01(\v2\x1a.lilbattle.v1.SetOwnerEditH\x00R\bsetOwner\x12<\n" +
+	"\n" +
+	"place_unit\x18\x03 \x01(\v2\x1b.lilbattle.v1.PlaceUnitEditH\x00R\tplaceUnit\x122\n" +
+	"\x06remove\x18\x04 \x01(\v2\x18.lilbattle.v1.RemoveEditH\x00R\x06remove\x12R\n" +
+	"\x12set_victory_points\x18\x05 \x01(\v2\".lilbattle.v1.SetVictoryPointsEditH\x00R\x10setVictoryPointsB\x06\n" +
+	"\x04edit\"K\n" +
+	"\x10PaintTerrainEdit\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
+	"\ttile_type\x18\x03 \x01(\x05R\btileType\"B\n" +
+	"\fSetOwnerEdit\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\x05R\x06player\"`\n" +
+	"\rPlaceUnitEdit\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
+	"\tunit_type\x18\x03 \x01(\x05R\bunitType\x12\x16\n" +
+	"\x06player\x18\x04 \x01(\x05R\x06player\"I\n" +
+	"\n" +
+	"RemoveEdit\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1f\n" +
+	"\vremove_tile\x18\x03 \x01(\bR\n" +
+	"removeTile\"J\n" +
+	"\x14SetVictoryPointsEdit\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\"b\n" +
+	"\x16ApplyWorldEditsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12-\n" +
+	"\x05edits\x18\x02 \x03(\v2\x17.lilbattle.v1.WorldEditR\x05edits\"3\n" +
+	"\x17ApplyWorldEditsResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"_\n" +
+	"\tHexRegion\x12\x13\n" +
+	"\x05min_q\x18\x01 \x01(\x05R\x04minQ\x12\x13\n" +
+	"\x05max_q\x18\x02 \x01(\x05R\x04maxQ\x12\x13\n" +
+	"\x05min_r\x18\x03 \x01(\x05R\x04minR\x12\x13\n" +
+	"\x05max_r\x18\x04 \x01(\x05R\x04maxR\"b\n" +
+	"\x14GetWorldTilesRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12/\n" +
+	"\x06region\x18\x02 \x01(\v2\x17.lilbattle.v1.HexRegionR\x06region\"\x85\x01\n" +
+	"\x15GetWorldTilesResponse\x12(\n" +
+	"\x05tiles\x18\x01 \x03(\v2\x12.lilbattle.v1.TileR\x05tiles\x12(\n" +
+	"\x05units\x18\x02 \x03(\v2\x12.lilbattle.v1.UnitR\x05units\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversionB\xbd\x01\n" +
 	"\x10com.lilbattle.v1B\x11WorldServiceProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var (
@@ -850,52 +1733,79 @@ func file_lilbattle_v1_models_world_service_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_models_world_service_proto_rawDescData
 }
 
-var file_lilbattle_v1_models_world_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_lilbattle_v1_models_world_service_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_lilbattle_v1_models_world_service_proto_goTypes = []any{
-	(*WorldInfo)(nil),             // 0: lilbattle.v1.WorldInfo
-	(*ListWorldsRequest)(nil),     // 1: lilbattle.v1.ListWorldsRequest
-	(*ListWorldsResponse)(nil),    // 2: lilbattle.v1.ListWorldsResponse
-	(*GetWorldRequest)(nil),       // 3: lilbattle.v1.GetWorldRequest
-	(*GetWorldResponse)(nil),      // 4: lilbattle.v1.GetWorldResponse
-	(*UpdateWorldRequest)(nil),    // 5: lilbattle.v1.UpdateWorldRequest
-	(*UpdateWorldResponse)(nil),   // 6: lilbattle.v1.UpdateWorldResponse
-	(*DeleteWorldRequest)(nil),    // 7: lilbattle.v1.DeleteWorldRequest
-	(*DeleteWorldResponse)(nil),   // 8: lilbattle.v1.DeleteWorldResponse
-	(*GetWorldsRequest)(nil),      // 9: lilbattle.v1.GetWorldsRequest
-	(*GetWorldsResponse)(nil),     // 10: lilbattle.v1.GetWorldsResponse
-	(*CreateWorldRequest)(nil),    // 11: lilbattle.v1.CreateWorldRequest
-	(*CreateWorldResponse)(nil),   // 12: lilbattle.v1.CreateWorldResponse
-	nil,                           // 13: lilbattle.v1.GetWorldsResponse.WorldsEntry
-	nil,                           // 14: lilbattle.v1.CreateWorldResponse.FieldErrorsEntry
-	(*Pagination)(nil),            // 15: lilbattle.v1.Pagination
-	(*World)(nil),                 // 16: lilbattle.v1.World
-	(*PaginationResponse)(nil),    // 17: lilbattle.v1.PaginationResponse
-	(*WorldData)(nil),             // 18: lilbattle.v1.WorldData
-	(*fieldmaskpb.FieldMask)(nil), // 19: google.protobuf.FieldMask
+	(*WorldInfo)(nil),                      // 0: lilbattle.v1.WorldInfo
+	(*ListWorldsRequest)(nil),              // 1: lilbattle.v1.ListWorldsRequest
+	(*ListWorldsResponse)(nil),             // 2: lilbattle.v1.ListWorldsResponse
+	(*GetWorldRequest)(nil),                // 3: lilbattle.v1.GetWorldRequest
+	(*GetWorldResponse)(nil),               // 4: lilbattle.v1.GetWorldResponse
+	(*UpdateWorldRequest)(nil),             // 5: lilbattle.v1.UpdateWorldRequest
+	(*UpdateWorldResponse)(nil),            // 6: lilbattle.v1.UpdateWorldResponse
+	(*DeleteWorldRequest)(nil),             // 7: lilbattle.v1.DeleteWorldRequest
+	(*DeleteWorldResponse)(nil),            // 8: lilbattle.v1.DeleteWorldResponse
+	(*GetWorldsRequest)(nil),               // 9: lilbattle.v1.GetWorldsRequest
+	(*GetWorldsResponse)(nil),              // 10: lilbattle.v1.GetWorldsResponse
+	(*CreateWorldRequest)(nil),             // 11: lilbattle.v1.CreateWorldRequest
+	(*CreateWorldResponse)(nil),            // 12: lilbattle.v1.CreateWorldResponse
+	(*SetWorldRulesOverridesRequest)(nil),  // 13: lilbattle.v1.SetWorldRulesOverridesRequest
+	(*SetWorldRulesOverridesResponse)(nil), // 14: lilbattle.v1.SetWorldRulesOverridesResponse
+	(*WorldEdit)(nil),                      // 15: lilbattle.v1.WorldEdit
+	(*PaintTerrainEdit)(nil),               // 16: lilbattle.v1.PaintTerrainEdit
+	(*SetOwnerEdit)(nil),                   // 17: lilbattle.v1.SetOwnerEdit
+	(*PlaceUnitEdit)(nil),                  // 18: lilbattle.v1.PlaceUnitEdit
+	(*RemoveEdit)(nil),                     // 19: lilbattle.v1.RemoveEdit
+	(*SetVictoryPointsEdit)(nil),           // 20: lilbattle.v1.SetVictoryPointsEdit
+	(*ApplyWorldEditsRequest)(nil),         // 21: lilbattle.v1.ApplyWorldEditsRequest
+	(*ApplyWorldEditsResponse)(nil),        // 22: lilbattle.v1.ApplyWorldEditsResponse
+	(*HexRegion)(nil),                      // 23: lilbattle.v1.HexRegion
+	(*GetWorldTilesRequest)(nil),           // 24: lilbattle.v1.GetWorldTilesRequest
+	(*GetWorldTilesResponse)(nil),          // 25: lilbattle.v1.GetWorldTilesResponse
+	nil,                                    // 26: lilbattle.v1.GetWorldsResponse.WorldsEntry
+	nil,                                    // 27: lilbattle.v1.CreateWorldResponse.FieldErrorsEntry
+	(*Pagination)(nil),                     // 28: lilbattle.v1.Pagination
+	(*World)(nil),                          // 29: lilbattle.v1.World
+	(*PaginationResponse)(nil),             // 30: lilbattle.v1.PaginationResponse
+	(*WorldData)(nil),                      // 31: lilbattle.v1.WorldData
+	(*fieldmaskpb.FieldMask)(nil),          // 32: google.protobuf.FieldMask
+	(*RulesOverrides)(nil),                 // 33: lilbattle.v1.RulesOverrides
+	(*Tile)(nil),                           // 34: lilbattle.v1.Tile
+	(*Unit)(nil),                           // 35: lilbattle.v1.Unit
 }
 var file_lilbattle_v1_models_world_service_proto_depIdxs = []int32{
-	15, // 0: lilbattle.v1.ListWorldsRequest.pagination:type_name -> lilbattle.v1.Pagination
-	16, // 1: lilbattle.v1.ListWorldsResponse.items:type_name -> lilbattle.v1.World
-	17, // 2: lilbattle.v1.ListWorldsResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
-	16, // 3: lilbattle.v1.GetWorldResponse.world:type_name -> lilbattle.v1.World
-	18, // 4: lilbattle.v1.GetWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
-	16, // 5: lilbattle.v1.UpdateWorldRequest.world:type_name -> lilbattle.v1.World
-	18, // 6: lilbattle.v1.UpdateWorldRequest.world_data:type_name -> lilbattle.v1.WorldData
-	19, // 7: lilbattle.v1.UpdateWorldRequest.update_mask:type_name -> google.protobuf.FieldMask
-	16, // 8: lilbattle.v1.UpdateWorldResponse.world:type_name -> lilbattle.v1.World
-	18, // 9: lilbattle.v1.UpdateWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
-	13, // 10: lilbattle.v1.GetWorldsResponse.worlds:type_name -> lilbattle.v1.GetWorldsResponse.WorldsEntry
-	16, // 11: lilbattle.v1.CreateWorldRequest.world:type_name -> lilbattle.v1.World
-	18, // 12: lilbattle.v1.CreateWorldRequest.world_data:type_name -> lilbattle.v1.WorldData
-	16, // 13: lilbattle.v1.CreateWorldResponse.world:type_name -> lilbattle.v1.World
-	18, // 14: lilbattle.v1.CreateWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
-	14, // 15: lilbattle.v1.CreateWorldResponse.field_errors:type_name -> lilbattle.v1.CreateWorldResponse.FieldErrorsEntry
-	16, // 16: lilbattle.v1.GetWorldsResponse.WorldsEntry.value:type_name -> lilbattle.v1.World
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	28, // 0: lilbattle.v1.ListWorldsRequest.pagination:type_name -> lilbattle.v1.Pagination
+	29, // 1: lilbattle.v1.ListWorldsResponse.items:type_name -> lilbattle.v1.World
+	30, // 2: lilbattle.v1.ListWorldsResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
+	29, // 3: lilbattle.v1.GetWorldResponse.world:type_name -> lilbattle.v1.World
+	31, // 4: lilbattle.v1.GetWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
+	29, // 5: lilbattle.v1.UpdateWorldRequest.world:type_name -> lilbattle.v1.World
+	31, // 6: lilbattle.v1.UpdateWorldRequest.world_data:type_name -> lilbattle.v1.WorldData
+	32, // 7: lilbattle.v1.UpdateWorldRequest.update_mask:type_name -> google.protobuf.FieldMask
+	29, // 8: lilbattle.v1.UpdateWorldResponse.world:type_name -> lilbattle.v1.World
+	31, // 9: lilbattle.v1.UpdateWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
+	26, // 10: lilbattle.v1.GetWorldsResponse.worlds:type_name -> lilbattle.v1.GetWorldsResponse.WorldsEntry
+	29, // 11: lilbattle.v1.CreateWorldRequest.world:type_name -> lilbattle.v1.World
+	31, // 12: lilbattle.v1.CreateWorldRequest.world_data:type_name -> lilbattle.v1.WorldData
+	29, // 13: lilbattle.v1.CreateWorldResponse.world:type_name -> lilbattle.v1.World
+	31, // 14: lilbattle.v1.CreateWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
+	27, // 15: lilbattle.v1.CreateWorldResponse.field_errors:type_name -> lilbattle.v1.CreateWorldResponse.FieldErrorsEntry
+	33, // 16: lilbattle.v1.SetWorldRulesOverridesRequest.overrides:type_name -> lilbattle.v1.RulesOverrides
+	29, // 17: lilbattle.v1.SetWorldRulesOverridesResponse.world:type_name -> lilbattle.v1.World
+	16, // 18: lilbattle.v1.WorldEdit.paint_terrain:type_name -> lilbattle.v1.PaintTerrainEdit
+	17, // 19: lilbattle.v1.WorldEdit.set_owner:type_name -> lilbattle.v1.SetOwnerEdit
+	18, // 20: lilbattle.v1.WorldEdit.place_unit:type_name -> lilbattle.v1.PlaceUnitEdit
+	19, // 21: lilbattle.v1.WorldEdit.remove:type_name -> lilbattle.v1.RemoveEdit
+	20, // 22: lilbattle.v1.WorldEdit.set_victory_points:type_name -> lilbattle.v1.SetVictoryPointsEdit
+	15, // 23: lilbattle.v1.ApplyWorldEditsRequest.edits:type_name -> lilbattle.v1.WorldEdit
+	23, // 24: lilbattle.v1.GetWorldTilesRequest.region:type_name -> lilbattle.v1.HexRegion
+	34, // 25: lilbattle.v1.GetWorldTilesResponse.tiles:type_name -> lilbattle.v1.Tile
+	35, // 26: lilbattle.v1.GetWorldTilesResponse.units:type_name -> lilbattle.v1.Unit
+	29, // 27: lilbattle.v1.GetWorldsResponse.WorldsEntry.value:type_name -> lilbattle.v1.World
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_world_service_proto_init() }
@@ -904,13 +1814,20 @@ func file_lilbattle_v1_models_world_service_proto_init() {
 		return
 	}
 	file_lilbattle_v1_models_models_proto_init()
+	file_lilbattle_v1_models_world_service_proto_msgTypes[15].OneofWrappers = []any{
+		(*WorldEdit_PaintTerrain)(nil),
+		(*WorldEdit_SetOwner)(nil),
+		(*WorldEdit_PlaceUnit)(nil),
+		(*WorldEdit_Remove)(nil),
+		(*WorldEdit_SetVictoryPoints)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_world_service_proto_rawDesc), len(file_lilbattle_v1_models_world_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
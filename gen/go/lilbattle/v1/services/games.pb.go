@@ -29,7 +29,7 @@ var File_lilbattle_v1_services_games_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_services_games_proto_rawDesc = "" +
 	"\n" +
-	"!lilbattle/v1/services/games.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a'lilbattle/v1/models/games_service.proto2\x86\f\n" +
+	"!lilbattle/v1/services/games.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a'lilbattle/v1/models/games_service.proto2\xe4\x15\n" +
 	"\fGamesService\x12e\n" +
 	"\n" +
 	"CreateGame\x12\x1f.lilbattle.v1.CreateGameRequest\x1a .lilbattle.v1.CreateGameResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/games\x12e\n" +
@@ -46,37 +46,64 @@ const file_lilbattle_v1_services_games_proto_rawDesc = "" +
 	"\fGetOptionsAt\x12!.lilbattle.v1.GetOptionsAtRequest\x1a\".lilbattle.v1.GetOptionsAtResponse\"^\x82\xd3\xe4\x93\x02XZ)\x12'/v1/games/{game_id}/options/{pos.label}\x12+/v1/games/{game_id}/options/{pos.q}/{pos.r}\x12\x81\x01\n" +
 	"\x0eSimulateAttack\x12#.lilbattle.v1.SimulateAttackRequest\x1a$.lilbattle.v1.SimulateAttackResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/games/simulate_attack\x12u\n" +
 	"\vSimulateFix\x12 .lilbattle.v1.SimulateFixRequest\x1a!.lilbattle.v1.SimulateFixResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/games/simulate_fix\x12n\n" +
-	"\bJoinGame\x12\x1d.lilbattle.v1.JoinGameRequest\x1a\x1e.lilbattle.v1.JoinGameResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/games/{game_id}/joinB\xb8\x01\n" +
+	"\bJoinGame\x12\x1d.lilbattle.v1.JoinGameRequest\x1a\x1e.lilbattle.v1.JoinGameResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/games/{game_id}/join\x12\x87\x01\n" +
+	"\x0eSetClockPaused\x12#.lilbattle.v1.SetClockPausedRequest\x1a$.lilbattle.v1.SetClockPausedResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/games/{game_id}/clock:pause\x12\x83\x01\n" +
+	"\fDelegateTurn\x12!.lilbattle.v1.DelegateTurnRequest\x1a\".lilbattle.v1.DelegateTurnResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/games/{game_id}/turn:delegate\x12\x92\x01\n" +
+	"\x12ClaimNoContactDraw\x12'.lilbattle.v1.ClaimNoContactDrawRequest\x1a(.lilbattle.v1.ClaimNoContactDrawResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/games/{game_id}/draw:claim\x12r\n" +
+	"\tDraftUnit\x12\x1e.lilbattle.v1.DraftUnitRequest\x1a\x1f.lilbattle.v1.DraftUnitResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/games/{game_id}/draft\x12w\n" +
+	"\fGetStateDiff\x12!.lilbattle.v1.GetStateDiffRequest\x1a\".lilbattle.v1.GetStateDiffResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/games/{game_id}/diff\x12n\n" +
+	"\bForkGame\x12\x1d.lilbattle.v1.ForkGameRequest\x1a\x1e.lilbattle.v1.ForkGameResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/games/{game_id}/fork\x12\x86\x01\n" +
+	"\x0eGetStateAtTurn\x12#.lilbattle.v1.GetStateAtTurnRequest\x1a$.lilbattle.v1.GetStateAtTurnResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/games/{game_id}/state_at_turn\x12\xa5\x01\n" +
+	"\x1aGetNotificationPreferences\x12/.lilbattle.v1.GetNotificationPreferencesRequest\x1a0.lilbattle.v1.GetNotificationPreferencesResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/notification_preferences\x12\xa8\x01\n" +
+	"\x1aSetNotificationPreferences\x12/.lilbattle.v1.SetNotificationPreferencesRequest\x1a0.lilbattle.v1.SetNotificationPreferencesResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/notification_preferencesB\xb8\x01\n" +
 	"\x10com.lilbattle.v1B\n" +
 	"GamesProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var file_lilbattle_v1_services_games_proto_goTypes = []any{
-	(*models.CreateGameRequest)(nil),      // 0: lilbattle.v1.CreateGameRequest
-	(*models.GetGamesRequest)(nil),        // 1: lilbattle.v1.GetGamesRequest
-	(*models.ListGamesRequest)(nil),       // 2: lilbattle.v1.ListGamesRequest
-	(*models.GetGameRequest)(nil),         // 3: lilbattle.v1.GetGameRequest
-	(*models.DeleteGameRequest)(nil),      // 4: lilbattle.v1.DeleteGameRequest
-	(*models.UpdateGameRequest)(nil),      // 5: lilbattle.v1.UpdateGameRequest
-	(*models.GetGameStateRequest)(nil),    // 6: lilbattle.v1.GetGameStateRequest
-	(*models.ListMovesRequest)(nil),       // 7: lilbattle.v1.ListMovesRequest
-	(*models.ProcessMovesRequest)(nil),    // 8: lilbattle.v1.ProcessMovesRequest
-	(*models.GetOptionsAtRequest)(nil),    // 9: lilbattle.v1.GetOptionsAtRequest
-	(*models.SimulateAttackRequest)(nil),  // 10: lilbattle.v1.SimulateAttackRequest
-	(*models.SimulateFixRequest)(nil),     // 11: lilbattle.v1.SimulateFixRequest
-	(*models.JoinGameRequest)(nil),        // 12: lilbattle.v1.JoinGameRequest
-	(*models.CreateGameResponse)(nil),     // 13: lilbattle.v1.CreateGameResponse
-	(*models.GetGamesResponse)(nil),       // 14: lilbattle.v1.GetGamesResponse
-	(*models.ListGamesResponse)(nil),      // 15: lilbattle.v1.ListGamesResponse
-	(*models.GetGameResponse)(nil),        // 16: lilbattle.v1.GetGameResponse
-	(*models.DeleteGameResponse)(nil),     // 17: lilbattle.v1.DeleteGameResponse
-	(*models.UpdateGameResponse)(nil),     // 18: lilbattle.v1.UpdateGameResponse
-	(*models.GetGameStateResponse)(nil),   // 19: lilbattle.v1.GetGameStateResponse
-	(*models.ListMovesResponse)(nil),      // 20: lilbattle.v1.ListMovesResponse
-	(*models.ProcessMovesResponse)(nil),   // 21: lilbattle.v1.ProcessMovesResponse
-	(*models.GetOptionsAtResponse)(nil),   // 22: lilbattle.v1.GetOptionsAtResponse
-	(*models.SimulateAttackResponse)(nil), // 23: lilbattle.v1.SimulateAttackResponse
-	(*models.SimulateFixResponse)(nil),    // 24: lilbattle.v1.SimulateFixResponse
-	(*models.JoinGameResponse)(nil),       // 25: lilbattle.v1.JoinGameResponse
+	(*models.CreateGameRequest)(nil),                  // 0: lilbattle.v1.CreateGameRequest
+	(*models.GetGamesRequest)(nil),                    // 1: lilbattle.v1.GetGamesRequest
+	(*models.ListGamesRequest)(nil),                   // 2: lilbattle.v1.ListGamesRequest
+	(*models.GetGameRequest)(nil),                     // 3: lilbattle.v1.GetGameRequest
+	(*models.DeleteGameRequest)(nil),                  // 4: lilbattle.v1.DeleteGameRequest
+	(*models.UpdateGameRequest)(nil),                  // 5: lilbattle.v1.UpdateGameRequest
+	(*models.GetGameStateRequest)(nil),                // 6: lilbattle.v1.GetGameStateRequest
+	(*models.ListMovesRequest)(nil),                   // 7: lilbattle.v1.ListMovesRequest
+	(*models.ProcessMovesRequest)(nil),                // 8: lilbattle.v1.ProcessMovesRequest
+	(*models.GetOptionsAtRequest)(nil),                // 9: lilbattle.v1.GetOptionsAtRequest
+	(*models.SimulateAttackRequest)(nil),              // 10: lilbattle.v1.SimulateAttackRequest
+	(*models.SimulateFixRequest)(nil),                 // 11: lilbattle.v1.SimulateFixRequest
+	(*models.JoinGameRequest)(nil),                    // 12: lilbattle.v1.JoinGameRequest
+	(*models.SetClockPausedRequest)(nil),              // 13: lilbattle.v1.SetClockPausedRequest
+	(*models.DelegateTurnRequest)(nil),                // 14: lilbattle.v1.DelegateTurnRequest
+	(*models.ClaimNoContactDrawRequest)(nil),          // 15: lilbattle.v1.ClaimNoContactDrawRequest
+	(*models.DraftUnitRequest)(nil),                   // 16: lilbattle.v1.DraftUnitRequest
+	(*models.GetStateDiffRequest)(nil),                // 17: lilbattle.v1.GetStateDiffRequest
+	(*models.ForkGameRequest)(nil),                    // 18: lilbattle.v1.ForkGameRequest
+	(*models.GetStateAtTurnRequest)(nil),              // 19: lilbattle.v1.GetStateAtTurnRequest
+	(*models.GetNotificationPreferencesRequest)(nil),  // 20: lilbattle.v1.GetNotificationPreferencesRequest
+	(*models.SetNotificationPreferencesRequest)(nil),  // 21: lilbattle.v1.SetNotificationPreferencesRequest
+	(*models.CreateGameResponse)(nil),                 // 22: lilbattle.v1.CreateGameResponse
+	(*models.GetGamesResponse)(nil),                   // 23: lilbattle.v1.GetGamesResponse
+	(*models.ListGamesResponse)(nil),                  // 24: lilbattle.v1.ListGamesResponse
+	(*models.GetGameResponse)(nil),                    // 25: lilbattle.v1.GetGameResponse
+	(*models.DeleteGameResponse)(nil),                 // 26: lilbattle.v1.DeleteGameResponse
+	(*models.UpdateGameResponse)(nil),                 // 27: lilbattle.v1.UpdateGameResponse
+	(*models.GetGameStateResponse)(nil),               // 28: lilbattle.v1.GetGameStateResponse
+	(*models.ListMovesResponse)(nil),                  // 29: lilbattle.v1.ListMovesResponse
+	(*models.ProcessMovesResponse)(nil),               // 30: lilbattle.v1.ProcessMovesResponse
+	(*models.GetOptionsAtResponse)(nil),               // 31: lilbattle.v1.GetOptionsAtResponse
+	(*models.SimulateAttackResponse)(nil),             // 32: lilbattle.v1.SimulateAttackResponse
+	(*models.SimulateFixResponse)(nil),                // 33: lilbattle.v1.SimulateFixResponse
+	(*models.JoinGameResponse)(nil),                   // 34: lilbattle.v1.JoinGameResponse
+	(*models.SetClockPausedResponse)(nil),             // 35: lilbattle.v1.SetClockPausedResponse
+	(*models.DelegateTurnResponse)(nil),               // 36: lilbattle.v1.DelegateTurnResponse
+	(*models.ClaimNoContactDrawResponse)(nil),         // 37: lilbattle.v1.ClaimNoContactDrawResponse
+	(*models.DraftUnitResponse)(nil),                  // 38: lilbattle.v1.DraftUnitResponse
+	(*models.GetStateDiffResponse)(nil),               // 39: lilbattle.v1.GetStateDiffResponse
+	(*models.ForkGameResponse)(nil),                   // 40: lilbattle.v1.ForkGameResponse
+	(*models.GetStateAtTurnResponse)(nil),             // 41: lilbattle.v1.GetStateAtTurnResponse
+	(*models.GetNotificationPreferencesResponse)(nil), // 42: lilbattle.v1.GetNotificationPreferencesResponse
+	(*models.SetNotificationPreferencesResponse)(nil), // 43: lilbattle.v1.SetNotificationPreferencesResponse
 }
 var file_lilbattle_v1_services_games_proto_depIdxs = []int32{
 	0,  // 0: lilbattle.v1.GamesService.CreateGame:input_type -> lilbattle.v1.CreateGameRequest
@@ -92,21 +119,39 @@ var file_lilbattle_v1_services_games_proto_depIdxs = []int32{
 	10, // 10: lilbattle.v1.GamesService.SimulateAttack:input_type -> lilbattle.v1.SimulateAttackRequest
 	11, // 11: lilbattle.v1.GamesService.SimulateFix:input_type -> lilbattle.v1.SimulateFixRequest
 	12, // 12: lilbattle.v1.GamesService.JoinGame:input_type -> lilbattle.v1.JoinGameRequest
-	13, // 13: lilbattle.v1.GamesService.CreateGame:output_type -> lilbattle.v1.CreateGameResponse
-	14, // 14: lilbattle.v1.GamesService.GetGames:output_type -> lilbattle.v1.GetGamesResponse
-	15, // 15: lilbattle.v1.GamesService.ListGames:output_type -> lilbattle.v1.ListGamesResponse
-	16, // 16: lilbattle.v1.GamesService.GetGame:output_type -> lilbattle.v1.GetGameResponse
-	17, // 17: lilbattle.v1.GamesService.DeleteGame:output_type -> lilbattle.v1.DeleteGameResponse
-	18, // 18: lilbattle.v1.GamesService.UpdateGame:output_type -> lilbattle.v1.UpdateGameResponse
-	19, // 19: lilbattle.v1.GamesService.GetGameState:output_type -> lilbattle.v1.GetGameStateResponse
-	20, // 20: lilbattle.v1.GamesService.ListMoves:output_type -> lilbattle.v1.ListMovesResponse
-	21, // 21: lilbattle.v1.GamesService.ProcessMoves:output_type -> lilbattle.v1.ProcessMovesResponse
-	22, // 22: lilbattle.v1.GamesService.GetOptionsAt:output_type -> lilbattle.v1.GetOptionsAtResponse
-	23, // 23: lilbattle.v1.GamesService.SimulateAttack:output_type -> lilbattle.v1.SimulateAttackResponse
-	24, // 24: lilbattle.v1.GamesService.SimulateFix:output_type -> lilbattle.v1.SimulateFixResponse
-	25, // 25: lilbattle.v1.GamesService.JoinGame:output_type -> lilbattle.v1.JoinGameResponse
-	13, // [13:26] is the sub-list for method output_type
-	0,  // [0:13] is the sub-list for method input_type
+	13, // 13: lilbattle.v1.GamesService.SetClockPaused:input_type -> lilbattle.v1.SetClockPausedRequest
+	14, // 14: lilbattle.v1.GamesService.DelegateTurn:input_type -> lilbattle.v1.DelegateTurnRequest
+	15, // 15: lilbattle.v1.GamesService.ClaimNoContactDraw:input_type -> lilbattle.v1.ClaimNoContactDrawRequest
+	16, // 16: lilbattle.v1.GamesService.DraftUnit:input_type -> lilbattle.v1.DraftUnitRequest
+	17, // 17: lilbattle.v1.GamesService.GetStateDiff:input_type -> lilbattle.v1.GetStateDiffRequest
+	18, // 18: lilbattle.v1.GamesService.ForkGame:input_type -> lilbattle.v1.ForkGameRequest
+	19, // 19: lilbattle.v1.GamesService.GetStateAtTurn:input_type -> lilbattle.v1.GetStateAtTurnRequest
+	20, // 20: lilbattle.v1.GamesService.GetNotificationPreferences:input_type -> lilbattle.v1.GetNotificationPreferencesRequest
+	21, // 21: lilbattle.v1.GamesService.SetNotificationPreferences:input_type -> lilbattle.v1.SetNotificationPreferencesRequest
+	22, // 22: lilbattle.v1.GamesService.CreateGame:output_type -> lilbattle.v1.CreateGameResponse
+	23, // 23: lilbattle.v1.GamesService.GetGames:output_type -> lilbattle.v1.GetGamesResponse
+	24, // 24: lilbattle.v1.GamesService.ListGames:output_type -> lilbattle.v1.ListGamesResponse
+	25, // 25: lilbattle.v1.GamesService.GetGame:output_type -> lilbattle.v1.GetGameResponse
+	26, // 26: lilbattle.v1.GamesService.DeleteGame:output_type -> lilbattle.v1.DeleteGameResponse
+	27, // 27: lilbattle.v1.GamesService.UpdateGame:output_type -> lilbattle.v1.UpdateGameResponse
+	28, // 28: lilbattle.v1.GamesService.GetGameState:output_type -> lilbattle.v1.GetGameStateResponse
+	29, // 29: lilbattle.v1.GamesService.ListMoves:output_type -> lilbattle.v1.ListMovesResponse
+	30, // 30: lilbattle.v1.GamesService.ProcessMoves:output_type -> lilbattle.v1.ProcessMovesResponse
+	31, // 31: lilbattle.v1.GamesService.GetOptionsAt:output_type -> lilbattle.v1.GetOptionsAtResponse
+	32, // 32: lilbattle.v1.GamesService.SimulateAttack:output_type -> lilbattle.v1.SimulateAttackResponse
+	33, // 33: lilbattle.v1.GamesService.SimulateFix:output_type -> lilbattle.v1.SimulateFixResponse
+	34, // 34: lilbattle.v1.GamesService.JoinGame:output_type -> lilbattle.v1.JoinGameResponse
+	35, // 35: lilbattle.v1.GamesService.SetClockPaused:output_type -> lilbattle.v1.SetClockPausedResponse
+	36, // 36: lilbattle.v1.GamesService.DelegateTurn:output_type -> lilbattle.v1.DelegateTurnResponse
+	37, // 37: lilbattle.v1.GamesService.ClaimNoContactDraw:output_type -> lilbattle.v1.ClaimNoContactDrawResponse
+	38, // 38: lilbattle.v1.GamesService.DraftUnit:output_type -> lilbattle.v1.DraftUnitResponse
+	39, // 39: lilbattle.v1.GamesService.GetStateDiff:output_type -> lilbattle.v1.GetStateDiffResponse
+	40, // 40: lilbattle.v1.GamesService.ForkGame:output_type -> lilbattle.v1.ForkGameResponse
+	41, // 41: lilbattle.v1.GamesService.GetStateAtTurn:output_type -> lilbattle.v1.GetStateAtTurnResponse
+	42, // 42: lilbattle.v1.GamesService.GetNotificationPreferences:output_type -> lilbattle.v1.GetNotificationPreferencesResponse
+	43, // 43: lilbattle.v1.GamesService.SetNotificationPreferences:output_type -> lilbattle.v1.SetNotificationPreferencesResponse
+	22, // [22:44] is the sub-list for method output_type
+	0,  // [0:22] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -21,19 +21,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GamesService_CreateGame_FullMethodName     = "/lilbattle.v1.GamesService/CreateGame"
-	GamesService_GetGames_FullMethodName       = "/lilbattle.v1.GamesService/GetGames"
-	GamesService_ListGames_FullMethodName      = "/lilbattle.v1.GamesService/ListGames"
-	GamesService_GetGame_FullMethodName        = "/lilbattle.v1.GamesService/GetGame"
-	GamesService_DeleteGame_FullMethodName     = "/lilbattle.v1.GamesService/DeleteGame"
-	GamesService_UpdateGame_FullMethodName     = "/lilbattle.v1.GamesService/UpdateGame"
-	GamesService_GetGameState_FullMethodName   = "/lilbattle.v1.GamesService/GetGameState"
-	GamesService_ListMoves_FullMethodName      = "/lilbattle.v1.GamesService/ListMoves"
-	GamesService_ProcessMoves_FullMethodName   = "/lilbattle.v1.GamesService/ProcessMoves"
-	GamesService_GetOptionsAt_FullMethodName   = "/lilbattle.v1.GamesService/GetOptionsAt"
-	GamesService_SimulateAttack_FullMethodName = "/lilbattle.v1.GamesService/SimulateAttack"
-	GamesService_SimulateFix_FullMethodName    = "/lilbattle.v1.GamesService/SimulateFix"
-	GamesService_JoinGame_FullMethodName       = "/lilbattle.v1.GamesService/JoinGame"
+	GamesService_CreateGame_FullMethodName                 = "/lilbattle.v1.GamesService/CreateGame"
+	GamesService_GetGames_FullMethodName                   = "/lilbattle.v1.GamesService/GetGames"
+	GamesService_ListGames_FullMethodName                  = "/lilbattle.v1.GamesService/ListGames"
+	GamesService_GetGame_FullMethodName                    = "/lilbattle.v1.GamesService/GetGame"
+	GamesService_DeleteGame_FullMethodName                 = "/lilbattle.v1.GamesService/DeleteGame"
+	GamesService_UpdateGame_FullMethodName                 = "/lilbattle.v1.GamesService/UpdateGame"
+	GamesService_GetGameState_FullMethodName               = "/lilbattle.v1.GamesService/GetGameState"
+	GamesService_ListMoves_FullMethodName                  = "/lilbattle.v1.GamesService/ListMoves"
+	GamesService_ProcessMoves_FullMethodName               = "/lilbattle.v1.GamesService/ProcessMoves"
+	GamesService_GetOptionsAt_FullMethodName               = "/lilbattle.v1.GamesService/GetOptionsAt"
+	GamesService_SimulateAttack_FullMethodName             = "/lilbattle.v1.GamesService/SimulateAttack"
+	GamesService_SimulateFix_FullMethodName                = "/lilbattle.v1.GamesService/SimulateFix"
+	GamesService_JoinGame_FullMethodName                   = "/lilbattle.v1.GamesService/JoinGame"
+	GamesService_SetClockPaused_FullMethodName             = "/lilbattle.v1.GamesService/SetClockPaused"
+	GamesService_DelegateTurn_FullMethodName               = "/lilbattle.v1.GamesService/DelegateTurn"
+	GamesService_ClaimNoContactDraw_FullMethodName         = "/lilbattle.v1.GamesService/ClaimNoContactDraw"
+	GamesService_DraftUnit_FullMethodName                  = "/lilbattle.v1.GamesService/DraftUnit"
+	GamesService_GetStateDiff_FullMethodName               = "/lilbattle.v1.GamesService/GetStateDiff"
+	GamesService_ForkGame_FullMethodName                   = "/lilbattle.v1.GamesService/ForkGame"
+	GamesService_GetStateAtTurn_FullMethodName             = "/lilbattle.v1.GamesService/GetStateAtTurn"
+	GamesService_GetNotificationPreferences_FullMethodName = "/lilbattle.v1.GamesService/GetNotificationPreferences"
+	GamesService_SetNotificationPreferences_FullMethodName = "/lilbattle.v1.GamesService/SetNotificationPreferences"
 )
 
 // GamesServiceClient is the client API for GamesService service.
@@ -75,6 +84,40 @@ type GamesServiceClient interface {
 	// Join a game as an open player slot
 	// User must be authenticated. The player slot must be "open" to be joinable.
 	JoinGame(ctx context.Context, in *models.JoinGameRequest, opts ...grpc.CallOption) (*models.JoinGameResponse, error)
+	// *
+	// Pause or resume the game clock when time banks are enabled.
+	// Pausing requires every active player to ask; any player can resume.
+	SetClockPaused(ctx context.Context, in *models.SetClockPausedRequest, opts ...grpc.CallOption) (*models.SetClockPausedResponse, error)
+	// *
+	// Hand the rest of the current turn over to a teammate (team games only).
+	// The delegate may submit moves for the current player until the turn ends.
+	DelegateTurn(ctx context.Context, in *models.DelegateTurnRequest, opts ...grpc.CallOption) (*models.DelegateTurnResponse, error)
+	// *
+	// End a game that can no longer progress as a draw. Either player may
+	// claim it; the server checks that no player can make contact again.
+	ClaimNoContactDraw(ctx context.Context, in *models.ClaimNoContactDrawRequest, opts ...grpc.CallOption) (*models.ClaimNoContactDrawResponse, error)
+	// *
+	// Ban or pick a unit type during a game's pre-game draft. Seats take turns
+	// in player order; the game starts once every seat has drafted.
+	DraftUnit(ctx context.Context, in *models.DraftUnitRequest, opts ...grpc.CallOption) (*models.DraftUnitResponse, error)
+	// *
+	// Everything that changed in a game between two turns, reconstructed by
+	// replaying its move history
+	GetStateDiff(ctx context.Context, in *models.GetStateDiffRequest, opts ...grpc.CallOption) (*models.GetStateDiffResponse, error)
+	// *
+	// Copy a game, as it is now or as it was after a number of its moves,
+	// into a new private game where the caller plays every seat
+	ForkGame(ctx context.Context, in *models.ForkGameRequest, opts ...grpc.CallOption) (*models.ForkGameResponse, error)
+	// *
+	// A game's state at the start of a player's turn, rebuilt from the nearest
+	// saved turn snapshot or by replaying its move history
+	GetStateAtTurn(ctx context.Context, in *models.GetStateAtTurnRequest, opts ...grpc.CallOption) (*models.GetStateAtTurnResponse, error)
+	// *
+	// The caller's notification preferences
+	GetNotificationPreferences(ctx context.Context, in *models.GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*models.GetNotificationPreferencesResponse, error)
+	// *
+	// Replace the caller's notification preferences
+	SetNotificationPreferences(ctx context.Context, in *models.SetNotificationPreferencesRequest, opts ...grpc.CallOption) (*models.SetNotificationPreferencesResponse, error)
 }
 
 type gamesServiceClient struct {
@@ -215,6 +258,96 @@ func (c *gamesServiceClient) JoinGame(ctx context.Context, in *models.JoinGameRe
 	return out, nil
 }
 
+func (c *gamesServiceClient) SetClockPaused(ctx context.Context, in *models.SetClockPausedRequest, opts ...grpc.CallOption) (*models.SetClockPausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.SetClockPausedResponse)
+	err := c.cc.Invoke(ctx, GamesService_SetClockPaused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) DelegateTurn(ctx context.Context, in *models.DelegateTurnRequest, opts ...grpc.CallOption) (*models.DelegateTurnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.DelegateTurnResponse)
+	err := c.cc.Invoke(ctx, GamesService_DelegateTurn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) ClaimNoContactDraw(ctx context.Context, in *models.ClaimNoContactDrawRequest, opts ...grpc.CallOption) (*models.ClaimNoContactDrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ClaimNoContactDrawResponse)
+	err := c.cc.Invoke(ctx, GamesService_ClaimNoContactDraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) DraftUnit(ctx context.Context, in *models.DraftUnitRequest, opts ...grpc.CallOption) (*models.DraftUnitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.DraftUnitResponse)
+	err := c.cc.Invoke(ctx, GamesService_DraftUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) GetStateDiff(ctx context.Context, in *models.GetStateDiffRequest, opts ...grpc.CallOption) (*models.GetStateDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.GetStateDiffResponse)
+	err := c.cc.Invoke(ctx, GamesService_GetStateDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) ForkGame(ctx context.Context, in *models.ForkGameRequest, opts ...grpc.CallOption) (*models.ForkGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ForkGameResponse)
+	err := c.cc.Invoke(ctx, GamesService_ForkGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) GetStateAtTurn(ctx context.Context, in *models.GetStateAtTurnRequest, opts ...grpc.CallOption) (*models.GetStateAtTurnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.GetStateAtTurnResponse)
+	err := c.cc.Invoke(ctx, GamesService_GetStateAtTurn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) GetNotificationPreferences(ctx context.Context, in *models.GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*models.GetNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.GetNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, GamesService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) SetNotificationPreferences(ctx context.Context, in *models.SetNotificationPreferencesRequest, opts ...grpc.CallOption) (*models.SetNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.SetNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, GamesService_SetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GamesServiceServer is the server API for GamesService service.
 // All implementations should embed UnimplementedGamesServiceServer
 // for forward compatibility.
@@ -254,6 +387,40 @@ type GamesServiceServer interface {
 	// Join a game as an open player slot
 	// User must be authenticated. The player slot must be "open" to be joinable.
 	JoinGame(context.Context, *models.JoinGameRequest) (*models.JoinGameResponse, error)
+	// *
+	// Pause or resume the game clock when time banks are enabled.
+	// Pausing requires every active player to ask; any player can resume.
+	SetClockPaused(context.Context, *models.SetClockPausedRequest) (*models.SetClockPausedResponse, error)
+	// *
+	// Hand the rest of the current turn over to a teammate (team games only).
+	// The delegate may submit moves for the current player until the turn ends.
+	DelegateTurn(context.Context, *models.DelegateTurnRequest) (*models.DelegateTurnResponse, error)
+	// *
+	// End a game that can no longer progress as a draw. Either player may
+	// claim it; the server checks that no player can make contact again.
+	ClaimNoContactDraw(context.Context, *models.ClaimNoContactDrawRequest) (*models.ClaimNoContactDrawResponse, error)
+	// *
+	// Ban or pick a unit type during a game's pre-game draft. Seats take turns
+	// in player order; the game starts once every seat has drafted.
+	DraftUnit(context.Context, *models.DraftUnitRequest) (*models.DraftUnitResponse, error)
+	// *
+	// Everything that changed in a game between two turns, reconstructed by
+	// replaying its move history
+	GetStateDiff(context.Context, *models.GetStateDiffRequest) (*models.GetStateDiffResponse, error)
+	// *
+	// Copy a game, as it is now or as it was after a number of its moves,
+	// into a new private game where the caller plays every seat
+	ForkGame(context.Context, *models.ForkGameRequest) (*models.ForkGameResponse, error)
+	// *
+	// A game's state at the start of a player's turn, rebuilt from the nearest
+	// saved turn snapshot or by replaying its move history
+	GetStateAtTurn(context.Context, *models.GetStateAtTurnRequest) (*models.GetStateAtTurnResponse, error)
+	// *
+	// The caller's notification preferences
+	GetNotificationPreferences(context.Context, *models.GetNotificationPreferencesRequest) (*models.GetNotificationPreferencesResponse, error)
+	// *
+	// Replace the caller's notification preferences
+	SetNotificationPreferences(context.Context, *models.SetNotificationPreferencesRequest) (*models.SetNotificationPreferencesResponse, error)
 }
 
 // UnimplementedGamesServiceServer should be embedded to have
@@ -302,6 +469,33 @@ func (UnimplementedGamesServiceServer) SimulateFix(context.Context, *models.Simu
 func (UnimplementedGamesServiceServer) JoinGame(context.Context, *models.JoinGameRequest) (*models.JoinGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinGame not implemented")
 }
+func (UnimplementedGamesServiceServer) SetClockPaused(context.Context, *models.SetClockPausedRequest) (*models.SetClockPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClockPaused not implemented")
+}
+func (UnimplementedGamesServiceServer) DelegateTurn(context.Context, *models.DelegateTurnRequest) (*models.DelegateTurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateTurn not implemented")
+}
+func (UnimplementedGamesServiceServer) ClaimNoContactDraw(context.Context, *models.ClaimNoContactDrawRequest) (*models.ClaimNoContactDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimNoContactDraw not implemented")
+}
+func (UnimplementedGamesServiceServer) DraftUnit(context.Context, *models.DraftUnitRequest) (*models.DraftUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DraftUnit not implemented")
+}
+func (UnimplementedGamesServiceServer) GetStateDiff(context.Context, *models.GetStateDiffRequest) (*models.GetStateDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateDiff not implemented")
+}
+func (UnimplementedGamesServiceServer) ForkGame(context.Context, *models.ForkGameRequest) (*models.ForkGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkGame not implemented")
+}
+func (UnimplementedGamesServiceServer) GetStateAtTurn(context.Context, *models.GetStateAtTurnRequest) (*models.GetStateAtTurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateAtTurn not implemented")
+}
+func (UnimplementedGamesServiceServer) GetNotificationPreferences(context.Context, *models.GetNotificationPreferencesRequest) (*models.GetNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedGamesServiceServer) SetNotificationPreferences(context.Context, *models.SetNotificationPreferencesRequest) (*models.SetNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotificationPreferences not implemented")
+}
 func (UnimplementedGamesServiceServer) testEmbeddedByValue() {}
 
 // UnsafeGamesServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -556,6 +750,168 @@ func _GamesService_JoinGame_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GamesService_SetClockPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.SetClockPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).SetClockPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_SetClockPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).SetClockPaused(ctx, req.(*models.SetClockPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_DelegateTurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.DelegateTurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).DelegateTurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_DelegateTurn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).DelegateTurn(ctx, req.(*models.DelegateTurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_ClaimNoContactDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ClaimNoContactDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).ClaimNoContactDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_ClaimNoContactDraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).ClaimNoContactDraw(ctx, req.(*models.ClaimNoContactDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_DraftUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.DraftUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).DraftUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_DraftUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).DraftUnit(ctx, req.(*models.DraftUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_GetStateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetStateDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).GetStateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_GetStateDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).GetStateDiff(ctx, req.(*models.GetStateDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_ForkGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ForkGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).ForkGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_ForkGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).ForkGame(ctx, req.(*models.ForkGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_GetStateAtTurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetStateAtTurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).GetStateAtTurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_GetStateAtTurn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).GetStateAtTurn(ctx, req.(*models.GetStateAtTurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).GetNotificationPreferences(ctx, req.(*models.GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_SetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.SetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).SetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_SetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).SetNotificationPreferences(ctx, req.(*models.SetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GamesService_ServiceDesc is the grpc.ServiceDesc for GamesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -615,6 +971,42 @@ var GamesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "JoinGame",
 			Handler:    _GamesService_JoinGame_Handler,
 		},
+		{
+			MethodName: "SetClockPaused",
+			Handler:    _GamesService_SetClockPaused_Handler,
+		},
+		{
+			MethodName: "DelegateTurn",
+			Handler:    _GamesService_DelegateTurn_Handler,
+		},
+		{
+			MethodName: "ClaimNoContactDraw",
+			Handler:    _GamesService_ClaimNoContactDraw_Handler,
+		},
+		{
+			MethodName: "DraftUnit",
+			Handler:    _GamesService_DraftUnit_Handler,
+		},
+		{
+			MethodName: "GetStateDiff",
+			Handler:    _GamesService_GetStateDiff_Handler,
+		},
+		{
+			MethodName: "ForkGame",
+			Handler:    _GamesService_ForkGame_Handler,
+		},
+		{
+			MethodName: "GetStateAtTurn",
+			Handler:    _GamesService_GetStateAtTurn_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _GamesService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "SetNotificationPreferences",
+			Handler:    _GamesService_SetNotificationPreferences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lilbattle/v1/services/games.proto",
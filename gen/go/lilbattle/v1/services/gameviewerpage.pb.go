@@ -27,7 +27,7 @@ var File_lilbattle_v1_services_gameviewerpage_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_services_gameviewerpage_proto_rawDesc = "" +
 	"\n" +
-	"*lilbattle/v1/services/gameviewerpage.proto\x12\flilbattle.v1\x1a\x1bwasmjs/v1/annotations.proto\x1a lilbattle/v1/models/models.proto\x1a(lilbattle/v1/models/gameviewerpage.proto2\xb2\x10\n" +
+	"*lilbattle/v1/services/gameviewerpage.proto\x12\flilbattle.v1\x1a\x1bwasmjs/v1/annotations.proto\x1a lilbattle/v1/models/models.proto\x1a(lilbattle/v1/models/gameviewerpage.proto2\x81\x12\n" +
 	"\x0eGameViewerPage\x12Z\n" +
 	"\x15SetTurnOptionsContent\x12\x1f.lilbattle.v1.SetContentRequest\x1a .lilbattle.v1.SetContentResponse\x12a\n" +
 	"\x10ShowBuildOptions\x12%.lilbattle.v1.ShowBuildOptionsRequest\x1a&.lilbattle.v1.ShowBuildOptionsResponse\x12X\n" +
@@ -51,48 +51,54 @@ const file_lilbattle_v1_services_gameviewerpage_proto_rawDesc = "" +
 	"\x10ShowAttackEffect\x12%.lilbattle.v1.ShowAttackEffectRequest\x1a&.lilbattle.v1.ShowAttackEffectResponse\x12[\n" +
 	"\x0eShowHealEffect\x12#.lilbattle.v1.ShowHealEffectRequest\x1a$.lilbattle.v1.ShowHealEffectResponse\x12d\n" +
 	"\x11ShowCaptureEffect\x12&.lilbattle.v1.ShowCaptureEffectRequest\x1a'.lilbattle.v1.ShowCaptureEffectResponse\x12a\n" +
+	"\x10ShowCoachVerdict\x12%.lilbattle.v1.ShowCoachVerdictRequest\x1a&.lilbattle.v1.ShowCoachVerdictResponse\x12j\n" +
+	"\x13ShowTurnObligations\x12(.lilbattle.v1.ShowTurnObligationsRequest\x1a).lilbattle.v1.ShowTurnObligationsResponse\x12a\n" +
 	"\x10SetAllowedPanels\x12%.lilbattle.v1.SetAllowedPanelsRequest\x1a&.lilbattle.v1.SetAllowedPanelsResponse\x12O\n" +
 	"\n" +
 	"LogMessage\x12\x1f.lilbattle.v1.LogMessageRequest\x1a .lilbattle.v1.LogMessageResponse\x1a\x04\xc0\xb5\x18\x01B\xc1\x01\n" +
 	"\x10com.lilbattle.v1B\x13GameviewerpageProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var file_lilbattle_v1_services_gameviewerpage_proto_goTypes = []any{
-	(*models.SetContentRequest)(nil),         // 0: lilbattle.v1.SetContentRequest
-	(*models.ShowBuildOptionsRequest)(nil),   // 1: lilbattle.v1.ShowBuildOptionsRequest
-	(*models.SetGameStateRequest)(nil),       // 2: lilbattle.v1.SetGameStateRequest
-	(*models.UpdateGameStatusRequest)(nil),   // 3: lilbattle.v1.UpdateGameStatusRequest
-	(*models.SetTileAtRequest)(nil),          // 4: lilbattle.v1.SetTileAtRequest
-	(*models.SetUnitAtRequest)(nil),          // 5: lilbattle.v1.SetUnitAtRequest
-	(*models.RemoveTileAtRequest)(nil),       // 6: lilbattle.v1.RemoveTileAtRequest
-	(*models.RemoveUnitAtRequest)(nil),       // 7: lilbattle.v1.RemoveUnitAtRequest
-	(*models.ShowHighlightsRequest)(nil),     // 8: lilbattle.v1.ShowHighlightsRequest
-	(*models.ClearHighlightsRequest)(nil),    // 9: lilbattle.v1.ClearHighlightsRequest
-	(*models.ShowPathRequest)(nil),           // 10: lilbattle.v1.ShowPathRequest
-	(*models.ClearPathsRequest)(nil),         // 11: lilbattle.v1.ClearPathsRequest
-	(*models.MoveUnitRequest)(nil),           // 12: lilbattle.v1.MoveUnitRequest
-	(*models.ShowAttackEffectRequest)(nil),   // 13: lilbattle.v1.ShowAttackEffectRequest
-	(*models.ShowHealEffectRequest)(nil),     // 14: lilbattle.v1.ShowHealEffectRequest
-	(*models.ShowCaptureEffectRequest)(nil),  // 15: lilbattle.v1.ShowCaptureEffectRequest
-	(*models.SetAllowedPanelsRequest)(nil),   // 16: lilbattle.v1.SetAllowedPanelsRequest
-	(*models.LogMessageRequest)(nil),         // 17: lilbattle.v1.LogMessageRequest
-	(*models.SetContentResponse)(nil),        // 18: lilbattle.v1.SetContentResponse
-	(*models.ShowBuildOptionsResponse)(nil),  // 19: lilbattle.v1.ShowBuildOptionsResponse
-	(*models.SetGameStateResponse)(nil),      // 20: lilbattle.v1.SetGameStateResponse
-	(*models.UpdateGameStatusResponse)(nil),  // 21: lilbattle.v1.UpdateGameStatusResponse
-	(*models.SetTileAtResponse)(nil),         // 22: lilbattle.v1.SetTileAtResponse
-	(*models.SetUnitAtResponse)(nil),         // 23: lilbattle.v1.SetUnitAtResponse
-	(*models.RemoveTileAtResponse)(nil),      // 24: lilbattle.v1.RemoveTileAtResponse
-	(*models.RemoveUnitAtResponse)(nil),      // 25: lilbattle.v1.RemoveUnitAtResponse
-	(*models.ShowHighlightsResponse)(nil),    // 26: lilbattle.v1.ShowHighlightsResponse
-	(*models.ClearHighlightsResponse)(nil),   // 27: lilbattle.v1.ClearHighlightsResponse
-	(*models.ShowPathResponse)(nil),          // 28: lilbattle.v1.ShowPathResponse
-	(*models.ClearPathsResponse)(nil),        // 29: lilbattle.v1.ClearPathsResponse
-	(*models.MoveUnitResponse)(nil),          // 30: lilbattle.v1.MoveUnitResponse
-	(*models.ShowAttackEffectResponse)(nil),  // 31: lilbattle.v1.ShowAttackEffectResponse
-	(*models.ShowHealEffectResponse)(nil),    // 32: lilbattle.v1.ShowHealEffectResponse
-	(*models.ShowCaptureEffectResponse)(nil), // 33: lilbattle.v1.ShowCaptureEffectResponse
-	(*models.SetAllowedPanelsResponse)(nil),  // 34: lilbattle.v1.SetAllowedPanelsResponse
-	(*models.LogMessageResponse)(nil),        // 35: lilbattle.v1.LogMessageResponse
+	(*models.SetContentRequest)(nil),           // 0: lilbattle.v1.SetContentRequest
+	(*models.ShowBuildOptionsRequest)(nil),     // 1: lilbattle.v1.ShowBuildOptionsRequest
+	(*models.SetGameStateRequest)(nil),         // 2: lilbattle.v1.SetGameStateRequest
+	(*models.UpdateGameStatusRequest)(nil),     // 3: lilbattle.v1.UpdateGameStatusRequest
+	(*models.SetTileAtRequest)(nil),            // 4: lilbattle.v1.SetTileAtRequest
+	(*models.SetUnitAtRequest)(nil),            // 5: lilbattle.v1.SetUnitAtRequest
+	(*models.RemoveTileAtRequest)(nil),         // 6: lilbattle.v1.RemoveTileAtRequest
+	(*models.RemoveUnitAtRequest)(nil),         // 7: lilbattle.v1.RemoveUnitAtRequest
+	(*models.ShowHighlightsRequest)(nil),       // 8: lilbattle.v1.ShowHighlightsRequest
+	(*models.ClearHighlightsRequest)(nil),      // 9: lilbattle.v1.ClearHighlightsRequest
+	(*models.ShowPathRequest)(nil),             // 10: lilbattle.v1.ShowPathRequest
+	(*models.ClearPathsRequest)(nil),           // 11: lilbattle.v1.ClearPathsRequest
+	(*models.MoveUnitRequest)(nil),             // 12: lilbattle.v1.MoveUnitRequest
+	(*models.ShowAttackEffectRequest)(nil),     // 13: lilbattle.v1.ShowAttackEffectRequest
+	(*models.ShowHealEffectRequest)(nil),       // 14: lilbattle.v1.ShowHealEffectRequest
+	(*models.ShowCaptureEffectRequest)(nil),    // 15: lilbattle.v1.ShowCaptureEffectRequest
+	(*models.ShowCoachVerdictRequest)(nil),     // 16: lilbattle.v1.ShowCoachVerdictRequest
+	(*models.ShowTurnObligationsRequest)(nil),  // 17: lilbattle.v1.ShowTurnObligationsRequest
+	(*models.SetAllowedPanelsRequest)(nil),     // 18: lilbattle.v1.SetAllowedPanelsRequest
+	(*models.LogMessageRequest)(nil),           // 19: lilbattle.v1.LogMessageRequest
+	(*models.SetContentResponse)(nil),          // 20: lilbattle.v1.SetContentResponse
+	(*models.ShowBuildOptionsResponse)(nil),    // 21: lilbattle.v1.ShowBuildOptionsResponse
+	(*models.SetGameStateResponse)(nil),        // 22: lilbattle.v1.SetGameStateResponse
+	(*models.UpdateGameStatusResponse)(nil),    // 23: lilbattle.v1.UpdateGameStatusResponse
+	(*models.SetTileAtResponse)(nil),           // 24: lilbattle.v1.SetTileAtResponse
+	(*models.SetUnitAtResponse)(nil),           // 25: lilbattle.v1.SetUnitAtResponse
+	(*models.RemoveTileAtResponse)(nil),        // 26: lilbattle.v1.RemoveTileAtResponse
+	(*models.RemoveUnitAtResponse)(nil),        // 27: lilbattle.v1.RemoveUnitAtResponse
+	(*models.ShowHighlightsResponse)(nil),      // 28: lilbattle.v1.ShowHighlightsResponse
+	(*models.ClearHighlightsResponse)(nil),     // 29: lilbattle.v1.ClearHighlightsResponse
+	(*models.ShowPathResponse)(nil),            // 30: lilbattle.v1.ShowPathResponse
+	(*models.ClearPathsResponse)(nil),          // 31: lilbattle.v1.ClearPathsResponse
+	(*models.MoveUnitResponse)(nil),            // 32: lilbattle.v1.MoveUnitResponse
+	(*models.ShowAttackEffectResponse)(nil),    // 33: lilbattle.v1.ShowAttackEffectResponse
+	(*models.ShowHealEffectResponse)(nil),      // 34: lilbattle.v1.ShowHealEffectResponse
+	(*models.ShowCaptureEffectResponse)(nil),   // 35: lilbattle.v1.ShowCaptureEffectResponse
+	(*models.ShowCoachVerdictResponse)(nil),    // 36: lilbattle.v1.ShowCoachVerdictResponse
+	(*models.ShowTurnObligationsResponse)(nil), // 37: lilbattle.v1.ShowTurnObligationsResponse
+	(*models.SetAllowedPanelsResponse)(nil),    // 38: lilbattle.v1.SetAllowedPanelsResponse
+	(*models.LogMessageResponse)(nil),          // 39: lilbattle.v1.LogMessageResponse
 }
 var file_lilbattle_v1_services_gameviewerpage_proto_depIdxs = []int32{
 	0,  // 0: lilbattle.v1.GameViewerPage.SetTurnOptionsContent:input_type -> lilbattle.v1.SetContentRequest
@@ -116,33 +122,37 @@ var file_lilbattle_v1_services_gameviewerpage_proto_depIdxs = []int32{
 	13, // 18: lilbattle.v1.GameViewerPage.ShowAttackEffect:input_type -> lilbattle.v1.ShowAttackEffectRequest
 	14, // 19: lilbattle.v1.GameViewerPage.ShowHealEffect:input_type -> lilbattle.v1.ShowHealEffectRequest
 	15, // 20: lilbattle.v1.GameViewerPage.ShowCaptureEffect:input_type -> lilbattle.v1.ShowCaptureEffectRequest
-	16, // 21: lilbattle.v1.GameViewerPage.SetAllowedPanels:input_type -> lilbattle.v1.SetAllowedPanelsRequest
-	17, // 22: lilbattle.v1.GameViewerPage.LogMessage:input_type -> lilbattle.v1.LogMessageRequest
-	18, // 23: lilbattle.v1.GameViewerPage.SetTurnOptionsContent:output_type -> lilbattle.v1.SetContentResponse
-	19, // 24: lilbattle.v1.GameViewerPage.ShowBuildOptions:output_type -> lilbattle.v1.ShowBuildOptionsResponse
-	18, // 25: lilbattle.v1.GameViewerPage.SetUnitStatsContent:output_type -> lilbattle.v1.SetContentResponse
-	18, // 26: lilbattle.v1.GameViewerPage.SetDamageDistributionContent:output_type -> lilbattle.v1.SetContentResponse
-	18, // 27: lilbattle.v1.GameViewerPage.SetTerrainStatsContent:output_type -> lilbattle.v1.SetContentResponse
-	18, // 28: lilbattle.v1.GameViewerPage.SetCompactSummaryCard:output_type -> lilbattle.v1.SetContentResponse
-	18, // 29: lilbattle.v1.GameViewerPage.SetGameStatePanelContent:output_type -> lilbattle.v1.SetContentResponse
-	20, // 30: lilbattle.v1.GameViewerPage.SetGameState:output_type -> lilbattle.v1.SetGameStateResponse
-	21, // 31: lilbattle.v1.GameViewerPage.UpdateGameStatus:output_type -> lilbattle.v1.UpdateGameStatusResponse
-	22, // 32: lilbattle.v1.GameViewerPage.SetTileAt:output_type -> lilbattle.v1.SetTileAtResponse
-	23, // 33: lilbattle.v1.GameViewerPage.SetUnitAt:output_type -> lilbattle.v1.SetUnitAtResponse
-	24, // 34: lilbattle.v1.GameViewerPage.RemoveTileAt:output_type -> lilbattle.v1.RemoveTileAtResponse
-	25, // 35: lilbattle.v1.GameViewerPage.RemoveUnitAt:output_type -> lilbattle.v1.RemoveUnitAtResponse
-	26, // 36: lilbattle.v1.GameViewerPage.ShowHighlights:output_type -> lilbattle.v1.ShowHighlightsResponse
-	27, // 37: lilbattle.v1.GameViewerPage.ClearHighlights:output_type -> lilbattle.v1.ClearHighlightsResponse
-	28, // 38: lilbattle.v1.GameViewerPage.ShowPath:output_type -> lilbattle.v1.ShowPathResponse
-	29, // 39: lilbattle.v1.GameViewerPage.ClearPaths:output_type -> lilbattle.v1.ClearPathsResponse
-	30, // 40: lilbattle.v1.GameViewerPage.MoveUnit:output_type -> lilbattle.v1.MoveUnitResponse
-	31, // 41: lilbattle.v1.GameViewerPage.ShowAttackEffect:output_type -> lilbattle.v1.ShowAttackEffectResponse
-	32, // 42: lilbattle.v1.GameViewerPage.ShowHealEffect:output_type -> lilbattle.v1.ShowHealEffectResponse
-	33, // 43: lilbattle.v1.GameViewerPage.ShowCaptureEffect:output_type -> lilbattle.v1.ShowCaptureEffectResponse
-	34, // 44: lilbattle.v1.GameViewerPage.SetAllowedPanels:output_type -> lilbattle.v1.SetAllowedPanelsResponse
-	35, // 45: lilbattle.v1.GameViewerPage.LogMessage:output_type -> lilbattle.v1.LogMessageResponse
-	23, // [23:46] is the sub-list for method output_type
-	0,  // [0:23] is the sub-list for method input_type
+	16, // 21: lilbattle.v1.GameViewerPage.ShowCoachVerdict:input_type -> lilbattle.v1.ShowCoachVerdictRequest
+	17, // 22: lilbattle.v1.GameViewerPage.ShowTurnObligations:input_type -> lilbattle.v1.ShowTurnObligationsRequest
+	18, // 23: lilbattle.v1.GameViewerPage.SetAllowedPanels:input_type -> lilbattle.v1.SetAllowedPanelsRequest
+	19, // 24: lilbattle.v1.GameViewerPage.LogMessage:input_type -> lilbattle.v1.LogMessageRequest
+	20, // 25: lilbattle.v1.GameViewerPage.SetTurnOptionsContent:output_type -> lilbattle.v1.SetContentResponse
+	21, // 26: lilbattle.v1.GameViewerPage.ShowBuildOptions:output_type -> lilbattle.v1.ShowBuildOptionsResponse
+	20, // 27: lilbattle.v1.GameViewerPage.SetUnitStatsContent:output_type -> lilbattle.v1.SetContentResponse
+	20, // 28: lilbattle.v1.GameViewerPage.SetDamageDistributionContent:output_type -> lilbattle.v1.SetContentResponse
+	20, // 29: lilbattle.v1.GameViewerPage.SetTerrainStatsContent:output_type -> lilbattle.v1.SetContentResponse
+	20, // 30: lilbattle.v1.GameViewerPage.SetCompactSummaryCard:output_type -> lilbattle.v1.SetContentResponse
+	20, // 31: lilbattle.v1.GameViewerPage.SetGameStatePanelContent:output_type -> lilbattle.v1.SetContentResponse
+	22, // 32: lilbattle.v1.GameViewerPage.SetGameState:output_type -> lilbattle.v1.SetGameStateResponse
+	23, // 33: lilbattle.v1.GameViewerPage.UpdateGameStatus:output_type -> lilbattle.v1.UpdateGameStatusResponse
+	24, // 34: lilbattle.v1.GameViewerPage.SetTileAt:output_type -> lilbattle.v1.SetTileAtResponse
+	25, // 35: lilbattle.v1.GameViewerPage.SetUnitAt:output_type -> lilbattle.v1.SetUnitAtResponse
+	26, // 36: lilbattle.v1.GameViewerPage.RemoveTileAt:output_type -> lilbattle.v1.RemoveTileAtResponse
+	27, // 37: lilbattle.v1.GameViewerPage.RemoveUnitAt:output_type -> lilbattle.v1.RemoveUnitAtResponse
+	28, // 38: lilbattle.v1.GameViewerPage.ShowHighlights:output_type -> lilbattle.v1.ShowHighlightsResponse
+	29, // 39: lilbattle.v1.GameViewerPage.ClearHighlights:output_type -> lilbattle.v1.ClearHighlightsResponse
+	30, // 40: lilbattle.v1.GameViewerPage.ShowPath:output_type -> lilbattle.v1.ShowPathResponse
+	31, // 41: lilbattle.v1.GameViewerPage.ClearPaths:output_type -> lilbattle.v1.ClearPathsResponse
+	32, // 42: lilbattle.v1.GameViewerPage.MoveUnit:output_type -> lilbattle.v1.MoveUnitResponse
+	33, // 43: lilbattle.v1.GameViewerPage.ShowAttackEffect:output_type -> lilbattle.v1.ShowAttackEffectResponse
+	34, // 44: lilbattle.v1.GameViewerPage.ShowHealEffect:output_type -> lilbattle.v1.ShowHealEffectResponse
+	35, // 45: lilbattle.v1.GameViewerPage.ShowCaptureEffect:output_type -> lilbattle.v1.ShowCaptureEffectResponse
+	36, // 46: lilbattle.v1.GameViewerPage.ShowCoachVerdict:output_type -> lilbattle.v1.ShowCoachVerdictResponse
+	37, // 47: lilbattle.v1.GameViewerPage.ShowTurnObligations:output_type -> lilbattle.v1.ShowTurnObligationsResponse
+	38, // 48: lilbattle.v1.GameViewerPage.SetAllowedPanels:output_type -> lilbattle.v1.SetAllowedPanelsResponse
+	39, // 49: lilbattle.v1.GameViewerPage.LogMessage:output_type -> lilbattle.v1.LogMessageResponse
+	25, // [25:50] is the sub-list for method output_type
+	0,  // [0:25] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
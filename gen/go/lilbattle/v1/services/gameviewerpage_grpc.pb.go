@@ -42,6 +42,8 @@ const (
 	GameViewerPage_ShowAttackEffect_FullMethodName             = "/lilbattle.v1.GameViewerPage/ShowAttackEffect"
 	GameViewerPage_ShowHealEffect_FullMethodName               = "/lilbattle.v1.GameViewerPage/ShowHealEffect"
 	GameViewerPage_ShowCaptureEffect_FullMethodName            = "/lilbattle.v1.GameViewerPage/ShowCaptureEffect"
+	GameViewerPage_ShowCoachVerdict_FullMethodName             = "/lilbattle.v1.GameViewerPage/ShowCoachVerdict"
+	GameViewerPage_ShowTurnObligations_FullMethodName          = "/lilbattle.v1.GameViewerPage/ShowTurnObligations"
 	GameViewerPage_SetAllowedPanels_FullMethodName             = "/lilbattle.v1.GameViewerPage/SetAllowedPanels"
 	GameViewerPage_LogMessage_FullMethodName                   = "/lilbattle.v1.GameViewerPage/LogMessage"
 )
@@ -76,6 +78,10 @@ type GameViewerPageClient interface {
 	ShowAttackEffect(ctx context.Context, in *models.ShowAttackEffectRequest, opts ...grpc.CallOption) (*models.ShowAttackEffectResponse, error)
 	ShowHealEffect(ctx context.Context, in *models.ShowHealEffectRequest, opts ...grpc.CallOption) (*models.ShowHealEffectResponse, error)
 	ShowCaptureEffect(ctx context.Context, in *models.ShowCaptureEffectRequest, opts ...grpc.CallOption) (*models.ShowCaptureEffectResponse, error)
+	// Dismissible panel with coach mode's verdict on the last move
+	ShowCoachVerdict(ctx context.Context, in *models.ShowCoachVerdictRequest, opts ...grpc.CallOption) (*models.ShowCoachVerdictResponse, error)
+	// Prompt listing pending obligations, with an option to end the turn anyway
+	ShowTurnObligations(ctx context.Context, in *models.ShowTurnObligationsRequest, opts ...grpc.CallOption) (*models.ShowTurnObligationsResponse, error)
 	// Panel visibility and ordering
 	SetAllowedPanels(ctx context.Context, in *models.SetAllowedPanelsRequest, opts ...grpc.CallOption) (*models.SetAllowedPanelsResponse, error)
 	// Utility methods
@@ -300,6 +306,26 @@ func (c *gameViewerPageClient) ShowCaptureEffect(ctx context.Context, in *models
 	return out, nil
 }
 
+func (c *gameViewerPageClient) ShowCoachVerdict(ctx context.Context, in *models.ShowCoachVerdictRequest, opts ...grpc.CallOption) (*models.ShowCoachVerdictResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ShowCoachVerdictResponse)
+	err := c.cc.Invoke(ctx, GameViewerPage_ShowCoachVerdict_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameViewerPageClient) ShowTurnObligations(ctx context.Context, in *models.ShowTurnObligationsRequest, opts ...grpc.CallOption) (*models.ShowTurnObligationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ShowTurnObligationsResponse)
+	err := c.cc.Invoke(ctx, GameViewerPage_ShowTurnObligations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gameViewerPageClient) SetAllowedPanels(ctx context.Context, in *models.SetAllowedPanelsRequest, opts ...grpc.CallOption) (*models.SetAllowedPanelsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(models.SetAllowedPanelsResponse)
@@ -350,6 +376,10 @@ type GameViewerPageServer interface {
 	ShowAttackEffect(context.Context, *models.ShowAttackEffectRequest) (*models.ShowAttackEffectResponse, error)
 	ShowHealEffect(context.Context, *models.ShowHealEffectRequest) (*models.ShowHealEffectResponse, error)
 	ShowCaptureEffect(context.Context, *models.ShowCaptureEffectRequest) (*models.ShowCaptureEffectResponse, error)
+	// Dismissible panel with coach mode's verdict on the last move
+	ShowCoachVerdict(context.Context, *models.ShowCoachVerdictRequest) (*models.ShowCoachVerdictResponse, error)
+	// Prompt listing pending obligations, with an option to end the turn anyway
+	ShowTurnObligations(context.Context, *models.ShowTurnObligationsRequest) (*models.ShowTurnObligationsResponse, error)
 	// Panel visibility and ordering
 	SetAllowedPanels(context.Context, *models.SetAllowedPanelsRequest) (*models.SetAllowedPanelsResponse, error)
 	// Utility methods
@@ -426,6 +456,12 @@ func (UnimplementedGameViewerPageServer) ShowHealEffect(context.Context, *models
 func (UnimplementedGameViewerPageServer) ShowCaptureEffect(context.Context, *models.ShowCaptureEffectRequest) (*models.ShowCaptureEffectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCaptureEffect not implemented")
 }
+func (UnimplementedGameViewerPageServer) ShowCoachVerdict(context.Context, *models.ShowCoachVerdictRequest) (*models.ShowCoachVerdictResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowCoachVerdict not implemented")
+}
+func (UnimplementedGameViewerPageServer) ShowTurnObligations(context.Context, *models.ShowTurnObligationsRequest) (*models.ShowTurnObligationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowTurnObligations not implemented")
+}
 func (UnimplementedGameViewerPageServer) SetAllowedPanels(context.Context, *models.SetAllowedPanelsRequest) (*models.SetAllowedPanelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAllowedPanels not implemented")
 }
@@ -830,6 +866,42 @@ func _GameViewerPage_ShowCaptureEffect_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameViewerPage_ShowCoachVerdict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ShowCoachVerdictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameViewerPageServer).ShowCoachVerdict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameViewerPage_ShowCoachVerdict_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameViewerPageServer).ShowCoachVerdict(ctx, req.(*models.ShowCoachVerdictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameViewerPage_ShowTurnObligations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ShowTurnObligationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameViewerPageServer).ShowTurnObligations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameViewerPage_ShowTurnObligations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameViewerPageServer).ShowTurnObligations(ctx, req.(*models.ShowTurnObligationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GameViewerPage_SetAllowedPanels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(models.SetAllowedPanelsRequest)
 	if err := dec(in); err != nil {
@@ -957,6 +1029,14 @@ var GameViewerPage_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCaptureEffect",
 			Handler:    _GameViewerPage_ShowCaptureEffect_Handler,
 		},
+		{
+			MethodName: "ShowCoachVerdict",
+			Handler:    _GameViewerPage_ShowCoachVerdict_Handler,
+		},
+		{
+			MethodName: "ShowTurnObligations",
+			Handler:    _GameViewerPage_ShowTurnObligations_Handler,
+		},
 		{
 			MethodName: "SetAllowedPanels",
 			Handler:    _GameViewerPage_SetAllowedPanels_Handler,
@@ -27,12 +27,13 @@ var File_lilbattle_v1_services_indexer_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_services_indexer_proto_rawDesc = "" +
 	"\n" +
-	"#lilbattle/v1/services/indexer.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a!lilbattle/v1/models/indexer.proto2\xfe\x04\n" +
+	"#lilbattle/v1/services/indexer.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a!lilbattle/v1/models/indexer.proto2\xed\x05\n" +
 	"\x0eIndexerService\x12\xc4\x01\n" +
 	"\x10EnsureIndexState\x12%.lilbattle.v1.EnsureIndexStateRequest\x1a&.lilbattle.v1.EnsureIndexStateResponse\"a\x82\xd3\xe4\x93\x02[:\x01*\"V/v1/indexes/{index_state.entity_type}/{index_state.entity_id}/{index_state.index_type}\x12\x8a\x01\n" +
 	"\x0eGetIndexStates\x12#.lilbattle.v1.GetIndexStatesRequest\x1a$.lilbattle.v1.GetIndexStatesResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/indexes/{entity_type}/{entity_id}\x12\x81\x01\n" +
 	"\x0fListIndexStates\x12$.lilbattle.v1.ListIndexStatesRequest\x1a%.lilbattle.v1.ListIndexStatesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/indexes/{entity_type}\x12\x93\x01\n" +
-	"\x11DeleteIndexStates\x12&.lilbattle.v1.DeleteIndexStatesRequest\x1a'.lilbattle.v1.DeleteIndexStatesResponse\"-\x82\xd3\xe4\x93\x02'*%/v1/indexes/{entity_type}/{entity_id}B\xba\x01\n" +
+	"\x11DeleteIndexStates\x12&.lilbattle.v1.DeleteIndexStatesRequest\x1a'.lilbattle.v1.DeleteIndexStatesResponse\"-\x82\xd3\xe4\x93\x02'*%/v1/indexes/{entity_type}/{entity_id}\x12m\n" +
+	"\fGetMetaStats\x12!.lilbattle.v1.GetMetaStatsRequest\x1a\".lilbattle.v1.GetMetaStatsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/meta/statsB\xba\x01\n" +
 	"\x10com.lilbattle.v1B\fIndexerProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var file_lilbattle_v1_services_indexer_proto_goTypes = []any{
@@ -40,22 +41,26 @@ var file_lilbattle_v1_services_indexer_proto_goTypes = []any{
 	(*models.GetIndexStatesRequest)(nil),     // 1: lilbattle.v1.GetIndexStatesRequest
 	(*models.ListIndexStatesRequest)(nil),    // 2: lilbattle.v1.ListIndexStatesRequest
 	(*models.DeleteIndexStatesRequest)(nil),  // 3: lilbattle.v1.DeleteIndexStatesRequest
-	(*models.EnsureIndexStateResponse)(nil),  // 4: lilbattle.v1.EnsureIndexStateResponse
-	(*models.GetIndexStatesResponse)(nil),    // 5: lilbattle.v1.GetIndexStatesResponse
-	(*models.ListIndexStatesResponse)(nil),   // 6: lilbattle.v1.ListIndexStatesResponse
-	(*models.DeleteIndexStatesResponse)(nil), // 7: lilbattle.v1.DeleteIndexStatesResponse
+	(*models.GetMetaStatsRequest)(nil),       // 4: lilbattle.v1.GetMetaStatsRequest
+	(*models.EnsureIndexStateResponse)(nil),  // 5: lilbattle.v1.EnsureIndexStateResponse
+	(*models.GetIndexStatesResponse)(nil),    // 6: lilbattle.v1.GetIndexStatesResponse
+	(*models.ListIndexStatesResponse)(nil),   // 7: lilbattle.v1.ListIndexStatesResponse
+	(*models.DeleteIndexStatesResponse)(nil), // 8: lilbattle.v1.DeleteIndexStatesResponse
+	(*models.GetMetaStatsResponse)(nil),      // 9: lilbattle.v1.GetMetaStatsResponse
 }
 var file_lilbattle_v1_services_indexer_proto_depIdxs = []int32{
 	0, // 0: lilbattle.v1.IndexerService.EnsureIndexState:input_type -> lilbattle.v1.EnsureIndexStateRequest
 	1, // 1: lilbattle.v1.IndexerService.GetIndexStates:input_type -> lilbattle.v1.GetIndexStatesRequest
 	2, // 2: lilbattle.v1.IndexerService.ListIndexStates:input_type -> lilbattle.v1.ListIndexStatesRequest
 	3, // 3: lilbattle.v1.IndexerService.DeleteIndexStates:input_type -> lilbattle.v1.DeleteIndexStatesRequest
-	4, // 4: lilbattle.v1.IndexerService.EnsureIndexState:output_type -> lilbattle.v1.EnsureIndexStateResponse
-	5, // 5: lilbattle.v1.IndexerService.GetIndexStates:output_type -> lilbattle.v1.GetIndexStatesResponse
-	6, // 6: lilbattle.v1.IndexerService.ListIndexStates:output_type -> lilbattle.v1.ListIndexStatesResponse
-	7, // 7: lilbattle.v1.IndexerService.DeleteIndexStates:output_type -> lilbattle.v1.DeleteIndexStatesResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
+	4, // 4: lilbattle.v1.IndexerService.GetMetaStats:input_type -> lilbattle.v1.GetMetaStatsRequest
+	5, // 5: lilbattle.v1.IndexerService.EnsureIndexState:output_type -> lilbattle.v1.EnsureIndexStateResponse
+	6, // 6: lilbattle.v1.IndexerService.GetIndexStates:output_type -> lilbattle.v1.GetIndexStatesResponse
+	7, // 7: lilbattle.v1.IndexerService.ListIndexStates:output_type -> lilbattle.v1.ListIndexStatesResponse
+	8, // 8: lilbattle.v1.IndexerService.DeleteIndexStates:output_type -> lilbattle.v1.DeleteIndexStatesResponse
+	9, // 9: lilbattle.v1.IndexerService.GetMetaStats:output_type -> lilbattle.v1.GetMetaStatsResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
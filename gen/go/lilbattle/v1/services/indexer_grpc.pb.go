@@ -25,6 +25,7 @@ const (
 	IndexerService_GetIndexStates_FullMethodName    = "/lilbattle.v1.IndexerService/GetIndexStates"
 	IndexerService_ListIndexStates_FullMethodName   = "/lilbattle.v1.IndexerService/ListIndexStates"
 	IndexerService_DeleteIndexStates_FullMethodName = "/lilbattle.v1.IndexerService/DeleteIndexStates"
+	IndexerService_GetMetaStats_FullMethodName      = "/lilbattle.v1.IndexerService/GetMetaStats"
 )
 
 // IndexerServiceClient is the client API for IndexerService service.
@@ -43,6 +44,10 @@ type IndexerServiceClient interface {
 	// List index entity states by filtering
 	ListIndexStates(ctx context.Context, in *models.ListIndexStatesRequest, opts ...grpc.CallOption) (*models.ListIndexStatesResponse, error)
 	DeleteIndexStates(ctx context.Context, in *models.DeleteIndexStatesRequest, opts ...grpc.CallOption) (*models.DeleteIndexStatesResponse, error)
+	// *
+	// Get unit, terrain and game length statistics aggregated from finished
+	// games, per world
+	GetMetaStats(ctx context.Context, in *models.GetMetaStatsRequest, opts ...grpc.CallOption) (*models.GetMetaStatsResponse, error)
 }
 
 type indexerServiceClient struct {
@@ -93,6 +98,16 @@ func (c *indexerServiceClient) DeleteIndexStates(ctx context.Context, in *models
 	return out, nil
 }
 
+func (c *indexerServiceClient) GetMetaStats(ctx context.Context, in *models.GetMetaStatsRequest, opts ...grpc.CallOption) (*models.GetMetaStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.GetMetaStatsResponse)
+	err := c.cc.Invoke(ctx, IndexerService_GetMetaStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IndexerServiceServer is the server API for IndexerService service.
 // All implementations should embed UnimplementedIndexerServiceServer
 // for forward compatibility.
@@ -109,6 +124,10 @@ type IndexerServiceServer interface {
 	// List index entity states by filtering
 	ListIndexStates(context.Context, *models.ListIndexStatesRequest) (*models.ListIndexStatesResponse, error)
 	DeleteIndexStates(context.Context, *models.DeleteIndexStatesRequest) (*models.DeleteIndexStatesResponse, error)
+	// *
+	// Get unit, terrain and game length statistics aggregated from finished
+	// games, per world
+	GetMetaStats(context.Context, *models.GetMetaStatsRequest) (*models.GetMetaStatsResponse, error)
 }
 
 // UnimplementedIndexerServiceServer should be embedded to have
@@ -130,6 +149,9 @@ func (UnimplementedIndexerServiceServer) ListIndexStates(context.Context, *model
 func (UnimplementedIndexerServiceServer) DeleteIndexStates(context.Context, *models.DeleteIndexStatesRequest) (*models.DeleteIndexStatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteIndexStates not implemented")
 }
+func (UnimplementedIndexerServiceServer) GetMetaStats(context.Context, *models.GetMetaStatsRequest) (*models.GetMetaStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetaStats not implemented")
+}
 func (UnimplementedIndexerServiceServer) testEmbeddedByValue() {}
 
 // UnsafeIndexerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -222,6 +244,24 @@ func _IndexerService_DeleteIndexStates_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexerService_GetMetaStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetMetaStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServiceServer).GetMetaStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IndexerService_GetMetaStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServiceServer).GetMetaStats(ctx, req.(*models.GetMetaStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IndexerService_ServiceDesc is the grpc.ServiceDesc for IndexerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -245,6 +285,10 @@ var IndexerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteIndexStates",
 			Handler:    _IndexerService_DeleteIndexStates_Handler,
 		},
+		{
+			MethodName: "GetMetaStats",
+			Handler:    _IndexerService_GetMetaStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lilbattle/v1/services/indexer.proto",
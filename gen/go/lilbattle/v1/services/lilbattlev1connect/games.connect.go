@@ -66,6 +66,28 @@ const (
 	GamesServiceSimulateFixProcedure = "/lilbattle.v1.GamesService/SimulateFix"
 	// GamesServiceJoinGameProcedure is the fully-qualified name of the GamesService's JoinGame RPC.
 	GamesServiceJoinGameProcedure = "/lilbattle.v1.GamesService/JoinGame"
+	// GamesServiceSetClockPausedProcedure is the fully-qualified name of the GamesService's
+	// SetClockPaused RPC.
+	GamesServiceSetClockPausedProcedure = "/lilbattle.v1.GamesService/SetClockPaused"
+	// GamesServiceDelegateTurnProcedure is the fully-qualified name of the GamesService's DelegateTurn
+	// RPC.
+	GamesServiceDelegateTurnProcedure = "/lilbattle.v1.GamesService/DelegateTurn"
+	// GamesServiceClaimNoContactDrawProcedure is the fully-qualified name of the GamesService's
+	// ClaimNoContactDraw RPC.
+	GamesServiceClaimNoContactDrawProcedure = "/lilbattle.v1.GamesService/ClaimNoContactDraw"
+	// GamesServiceDraftUnitProcedure is the fully-qualified name of the GamesService's DraftUnit RPC.
+	GamesServiceDraftUnitProcedure = "/lilbattle.v1.GamesService/DraftUnit"
+	// GamesServiceGetStateDiffProcedure is the fully-qualified name of the GamesService's GetStateDiff
+	// RPC.
+	GamesServiceGetStateDiffProcedure = "/lilbattle.v1.GamesService/GetStateDiff"
+	// GamesServiceForkGameProcedure is the fully-qualified name of the GamesService's ForkGame RPC.
+	GamesServiceForkGameProcedure = "/lilbattle.v1.GamesService/ForkGame"
+	// GamesServiceGetStateAtTurnProcedure is the fully-qualified name of the GamesService's GetStateAtTurn RPC.
+	GamesServiceGetStateAtTurnProcedure = "/lilbattle.v1.GamesService/GetStateAtTurn"
+	// GamesServiceGetNotificationPreferencesProcedure is the fully-qualified name of the GamesService's GetNotificationPreferences RPC.
+	GamesServiceGetNotificationPreferencesProcedure = "/lilbattle.v1.GamesService/GetNotificationPreferences"
+	// GamesServiceSetNotificationPreferencesProcedure is the fully-qualified name of the GamesService's SetNotificationPreferences RPC.
+	GamesServiceSetNotificationPreferencesProcedure = "/lilbattle.v1.GamesService/SetNotificationPreferences"
 )
 
 // GamesServiceClient is a client for the lilbattle.v1.GamesService service.
@@ -103,6 +125,40 @@ type GamesServiceClient interface {
 	// Join a game as an open player slot
 	// User must be authenticated. The player slot must be "open" to be joinable.
 	JoinGame(context.Context, *connect.Request[models.JoinGameRequest]) (*connect.Response[models.JoinGameResponse], error)
+	// *
+	// Pause or resume the game clock when time banks are enabled.
+	// Pausing requires every active player to ask; any player can resume.
+	SetClockPaused(context.Context, *connect.Request[models.SetClockPausedRequest]) (*connect.Response[models.SetClockPausedResponse], error)
+	// *
+	// Hand the rest of the current turn over to a teammate (team games only).
+	// The delegate may submit moves for the current player until the turn ends.
+	DelegateTurn(context.Context, *connect.Request[models.DelegateTurnRequest]) (*connect.Response[models.DelegateTurnResponse], error)
+	// *
+	// End a game that can no longer progress as a draw. Either player may
+	// claim it; the server checks that no player can make contact again.
+	ClaimNoContactDraw(context.Context, *connect.Request[models.ClaimNoContactDrawRequest]) (*connect.Response[models.ClaimNoContactDrawResponse], error)
+	// *
+	// Ban or pick a unit type during a game's pre-game draft. Seats take turns
+	// in player order; the game starts once every seat has drafted.
+	DraftUnit(context.Context, *connect.Request[models.DraftUnitRequest]) (*connect.Response[models.DraftUnitResponse], error)
+	// *
+	// Everything that changed in a game between two turns, reconstructed by
+	// replaying its move history
+	GetStateDiff(context.Context, *connect.Request[models.GetStateDiffRequest]) (*connect.Response[models.GetStateDiffResponse], error)
+	// *
+	// Copy a game, as it is now or as it was after a number of its moves,
+	// into a new private game where the caller plays every seat
+	ForkGame(context.Context, *connect.Request[models.ForkGameRequest]) (*connect.Response[models.ForkGameResponse], error)
+	// *
+	// A game's state at the start of a player's turn, rebuilt from the nearest
+	// saved turn snapshot or by replaying its move history
+	GetStateAtTurn(context.Context, *connect.Request[models.GetStateAtTurnRequest]) (*connect.Response[models.GetStateAtTurnResponse], error)
+	// *
+	// The caller's notification preferences
+	GetNotificationPreferences(context.Context, *connect.Request[models.GetNotificationPreferencesRequest]) (*connect.Response[models.GetNotificationPreferencesResponse], error)
+	// *
+	// Replace the caller's notification preferences
+	SetNotificationPreferences(context.Context, *connect.Request[models.SetNotificationPreferencesRequest]) (*connect.Response[models.SetNotificationPreferencesResponse], error)
 }
 
 // NewGamesServiceClient constructs a client for the lilbattle.v1.GamesService service. By default,
@@ -194,24 +250,87 @@ func NewGamesServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(gamesServiceMethods.ByName("JoinGame")),
 			connect.WithClientOptions(opts...),
 		),
+		setClockPaused: connect.NewClient[models.SetClockPausedRequest, models.SetClockPausedResponse](
+			httpClient,
+			baseURL+GamesServiceSetClockPausedProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("SetClockPaused")),
+			connect.WithClientOptions(opts...),
+		),
+		delegateTurn: connect.NewClient[models.DelegateTurnRequest, models.DelegateTurnResponse](
+			httpClient,
+			baseURL+GamesServiceDelegateTurnProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("DelegateTurn")),
+			connect.WithClientOptions(opts...),
+		),
+		claimNoContactDraw: connect.NewClient[models.ClaimNoContactDrawRequest, models.ClaimNoContactDrawResponse](
+			httpClient,
+			baseURL+GamesServiceClaimNoContactDrawProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("ClaimNoContactDraw")),
+			connect.WithClientOptions(opts...),
+		),
+		draftUnit: connect.NewClient[models.DraftUnitRequest, models.DraftUnitResponse](
+			httpClient,
+			baseURL+GamesServiceDraftUnitProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("DraftUnit")),
+			connect.WithClientOptions(opts...),
+		),
+		getStateDiff: connect.NewClient[models.GetStateDiffRequest, models.GetStateDiffResponse](
+			httpClient,
+			baseURL+GamesServiceGetStateDiffProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("GetStateDiff")),
+			connect.WithClientOptions(opts...),
+		),
+		forkGame: connect.NewClient[models.ForkGameRequest, models.ForkGameResponse](
+			httpClient,
+			baseURL+GamesServiceForkGameProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("ForkGame")),
+			connect.WithClientOptions(opts...),
+		),
+		getStateAtTurn: connect.NewClient[models.GetStateAtTurnRequest, models.GetStateAtTurnResponse](
+			httpClient,
+			baseURL+GamesServiceGetStateAtTurnProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("GetStateAtTurn")),
+			connect.WithClientOptions(opts...),
+		),
+		getNotificationPreferences: connect.NewClient[models.GetNotificationPreferencesRequest, models.GetNotificationPreferencesResponse](
+			httpClient,
+			baseURL+GamesServiceGetNotificationPreferencesProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("GetNotificationPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		setNotificationPreferences: connect.NewClient[models.SetNotificationPreferencesRequest, models.SetNotificationPreferencesResponse](
+			httpClient,
+			baseURL+GamesServiceSetNotificationPreferencesProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("SetNotificationPreferences")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // gamesServiceClient implements GamesServiceClient.
 type gamesServiceClient struct {
-	createGame     *connect.Client[models.CreateGameRequest, models.CreateGameResponse]
-	getGames       *connect.Client[models.GetGamesRequest, models.GetGamesResponse]
-	listGames      *connect.Client[models.ListGamesRequest, models.ListGamesResponse]
-	getGame        *connect.Client[models.GetGameRequest, models.GetGameResponse]
-	deleteGame     *connect.Client[models.DeleteGameRequest, models.DeleteGameResponse]
-	updateGame     *connect.Client[models.UpdateGameRequest, models.UpdateGameResponse]
-	getGameState   *connect.Client[models.GetGameStateRequest, models.GetGameStateResponse]
-	listMoves      *connect.Client[models.ListMovesRequest, models.ListMovesResponse]
-	processMoves   *connect.Client[models.ProcessMovesRequest, models.ProcessMovesResponse]
-	getOptionsAt   *connect.Client[models.GetOptionsAtRequest, models.GetOptionsAtResponse]
-	simulateAttack *connect.Client[models.SimulateAttackRequest, models.SimulateAttackResponse]
-	simulateFix    *connect.Client[models.SimulateFixRequest, models.SimulateFixResponse]
-	joinGame       *connect.Client[models.JoinGameRequest, models.JoinGameResponse]
+	createGame                 *connect.Client[models.CreateGameRequest, models.CreateGameResponse]
+	getGames                   *connect.Client[models.GetGamesRequest, models.GetGamesResponse]
+	listGames                  *connect.Client[models.ListGamesRequest, models.ListGamesResponse]
+	getGame                    *connect.Client[models.GetGameRequest, models.GetGameResponse]
+	deleteGame                 *connect.Client[models.DeleteGameRequest, models.DeleteGameResponse]
+	updateGame                 *connect.Client[models.UpdateGameRequest, models.UpdateGameResponse]
+	getGameState               *connect.Client[models.GetGameStateRequest, models.GetGameStateResponse]
+	listMoves                  *connect.Client[models.ListMovesRequest, models.ListMovesResponse]
+	processMoves               *connect.Client[models.ProcessMovesRequest, models.ProcessMovesResponse]
+	getOptionsAt               *connect.Client[models.GetOptionsAtRequest, models.GetOptionsAtResponse]
+	simulateAttack             *connect.Client[models.SimulateAttackRequest, models.SimulateAttackResponse]
+	simulateFix                *connect.Client[models.SimulateFixRequest, models.SimulateFixResponse]
+	joinGame                   *connect.Client[models.JoinGameRequest, models.JoinGameResponse]
+	setClockPaused             *connect.Client[models.SetClockPausedRequest, models.SetClockPausedResponse]
+	delegateTurn               *connect.Client[models.DelegateTurnRequest, models.DelegateTurnResponse]
+	claimNoContactDraw         *connect.Client[models.ClaimNoContactDrawRequest, models.ClaimNoContactDrawResponse]
+	draftUnit                  *connect.Client[models.DraftUnitRequest, models.DraftUnitResponse]
+	getStateDiff               *connect.Client[models.GetStateDiffRequest, models.GetStateDiffResponse]
+	forkGame                   *connect.Client[models.ForkGameRequest, models.ForkGameResponse]
+	getStateAtTurn             *connect.Client[models.GetStateAtTurnRequest, models.GetStateAtTurnResponse]
+	getNotificationPreferences *connect.Client[models.GetNotificationPreferencesRequest, models.GetNotificationPreferencesResponse]
+	setNotificationPreferences *connect.Client[models.SetNotificationPreferencesRequest, models.SetNotificationPreferencesResponse]
 }
 
 // CreateGame calls lilbattle.v1.GamesService.CreateGame.
@@ -279,6 +398,51 @@ func (c *gamesServiceClient) JoinGame(ctx context.Context, req *connect.Request[
 	return c.joinGame.CallUnary(ctx, req)
 }
 
+// SetClockPaused calls lilbattle.v1.GamesService.SetClockPaused.
+func (c *gamesServiceClient) SetClockPaused(ctx context.Context, req *connect.Request[models.SetClockPausedRequest]) (*connect.Response[models.SetClockPausedResponse], error) {
+	return c.setClockPaused.CallUnary(ctx, req)
+}
+
+// DelegateTurn calls lilbattle.v1.GamesService.DelegateTurn.
+func (c *gamesServiceClient) DelegateTurn(ctx context.Context, req *connect.Request[models.DelegateTurnRequest]) (*connect.Response[models.DelegateTurnResponse], error) {
+	return c.delegateTurn.CallUnary(ctx, req)
+}
+
+// ClaimNoContactDraw calls lilbattle.v1.GamesService.ClaimNoContactDraw.
+func (c *gamesServiceClient) ClaimNoContactDraw(ctx context.Context, req *connect.Request[models.ClaimNoContactDrawRequest]) (*connect.Response[models.ClaimNoContactDrawResponse], error) {
+	return c.claimNoContactDraw.CallUnary(ctx, req)
+}
+
+// DraftUnit calls lilbattle.v1.GamesService.DraftUnit.
+func (c *gamesServiceClient) DraftUnit(ctx context.Context, req *connect.Request[models.DraftUnitRequest]) (*connect.Response[models.DraftUnitResponse], error) {
+	return c.draftUnit.CallUnary(ctx, req)
+}
+
+// GetStateDiff calls lilbattle.v1.GamesService.GetStateDiff.
+func (c *gamesServiceClient) GetStateDiff(ctx context.Context, req *connect.Request[models.GetStateDiffRequest]) (*connect.Response[models.GetStateDiffResponse], error) {
+	return c.getStateDiff.CallUnary(ctx, req)
+}
+
+// ForkGame calls lilbattle.v1.GamesService.ForkGame.
+func (c *gamesServiceClient) ForkGame(ctx context.Context, req *connect.Request[models.ForkGameRequest]) (*connect.Response[models.ForkGameResponse], error) {
+	return c.forkGame.CallUnary(ctx, req)
+}
+
+// GetStateAtTurn calls lilbattle.v1.GamesService.GetStateAtTurn.
+func (c *gamesServiceClient) GetStateAtTurn(ctx context.Context, req *connect.Request[models.GetStateAtTurnRequest]) (*connect.Response[models.GetStateAtTurnResponse], error) {
+	return c.getStateAtTurn.CallUnary(ctx, req)
+}
+
+// GetNotificationPreferences calls lilbattle.v1.GamesService.GetNotificationPreferences.
+func (c *gamesServiceClient) GetNotificationPreferences(ctx context.Context, req *connect.Request[models.GetNotificationPreferencesRequest]) (*connect.Response[models.GetNotificationPreferencesResponse], error) {
+	return c.getNotificationPreferences.CallUnary(ctx, req)
+}
+
+// SetNotificationPreferences calls lilbattle.v1.GamesService.SetNotificationPreferences.
+func (c *gamesServiceClient) SetNotificationPreferences(ctx context.Context, req *connect.Request[models.SetNotificationPreferencesRequest]) (*connect.Response[models.SetNotificationPreferencesResponse], error) {
+	return c.setNotificationPreferences.CallUnary(ctx, req)
+}
+
 // GamesServiceHandler is an implementation of the lilbattle.v1.GamesService service.
 type GamesServiceHandler interface {
 	// *
@@ -314,6 +478,40 @@ type GamesServiceHandler interface {
 	// Join a game as an open player slot
 	// User must be authenticated. The player slot must be "open" to be joinable.
 	JoinGame(context.Context, *connect.Request[models.JoinGameRequest]) (*connect.Response[models.JoinGameResponse], error)
+	// *
+	// Pause or resume the game clock when time banks are enabled.
+	// Pausing requires every active player to ask; any player can resume.
+	SetClockPaused(context.Context, *connect.Request[models.SetClockPausedRequest]) (*connect.Response[models.SetClockPausedResponse], error)
+	// *
+	// Hand the rest of the current turn over to a teammate (team games only).
+	// The delegate may submit moves for the current player until the turn ends.
+	DelegateTurn(context.Context, *connect.Request[models.DelegateTurnRequest]) (*connect.Response[models.DelegateTurnResponse], error)
+	// *
+	// End a game that can no longer progress as a draw. Either player may
+	// claim it; the server checks that no player can make contact again.
+	ClaimNoContactDraw(context.Context, *connect.Request[models.ClaimNoContactDrawRequest]) (*connect.Response[models.ClaimNoContactDrawResponse], error)
+	// *
+	// Ban or pick a unit type during a game's pre-game draft. Seats take turns
+	// in player order; the game starts once every seat has drafted.
+	DraftUnit(context.Context, *connect.Request[models.DraftUnitRequest]) (*connect.Response[models.DraftUnitResponse], error)
+	// *
+	// Everything that changed in a game between two turns, reconstructed by
+	// replaying its move history
+	GetStateDiff(context.Context, *connect.Request[models.GetStateDiffRequest]) (*connect.Response[models.GetStateDiffResponse], error)
+	// *
+	// Copy a game, as it is now or as it was after a number of its moves,
+	// into a new private game where the caller plays every seat
+	ForkGame(context.Context, *connect.Request[models.ForkGameRequest]) (*connect.Response[models.ForkGameResponse], error)
+	// *
+	// A game's state at the start of a player's turn, rebuilt from the nearest
+	// saved turn snapshot or by replaying its move history
+	GetStateAtTurn(context.Context, *connect.Request[models.GetStateAtTurnRequest]) (*connect.Response[models.GetStateAtTurnResponse], error)
+	// *
+	// The caller's notification preferences
+	GetNotificationPreferences(context.Context, *connect.Request[models.GetNotificationPreferencesRequest]) (*connect.Response[models.GetNotificationPreferencesResponse], error)
+	// *
+	// Replace the caller's notification preferences
+	SetNotificationPreferences(context.Context, *connect.Request[models.SetNotificationPreferencesRequest]) (*connect.Response[models.SetNotificationPreferencesResponse], error)
 }
 
 // NewGamesServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -401,6 +599,60 @@ func NewGamesServiceHandler(svc GamesServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(gamesServiceMethods.ByName("JoinGame")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gamesServiceSetClockPausedHandler := connect.NewUnaryHandler(
+		GamesServiceSetClockPausedProcedure,
+		svc.SetClockPaused,
+		connect.WithSchema(gamesServiceMethods.ByName("SetClockPaused")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceDelegateTurnHandler := connect.NewUnaryHandler(
+		GamesServiceDelegateTurnProcedure,
+		svc.DelegateTurn,
+		connect.WithSchema(gamesServiceMethods.ByName("DelegateTurn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceClaimNoContactDrawHandler := connect.NewUnaryHandler(
+		GamesServiceClaimNoContactDrawProcedure,
+		svc.ClaimNoContactDraw,
+		connect.WithSchema(gamesServiceMethods.ByName("ClaimNoContactDraw")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceDraftUnitHandler := connect.NewUnaryHandler(
+		GamesServiceDraftUnitProcedure,
+		svc.DraftUnit,
+		connect.WithSchema(gamesServiceMethods.ByName("DraftUnit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceGetStateDiffHandler := connect.NewUnaryHandler(
+		GamesServiceGetStateDiffProcedure,
+		svc.GetStateDiff,
+		connect.WithSchema(gamesServiceMethods.ByName("GetStateDiff")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceForkGameHandler := connect.NewUnaryHandler(
+		GamesServiceForkGameProcedure,
+		svc.ForkGame,
+		connect.WithSchema(gamesServiceMethods.ByName("ForkGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceGetStateAtTurnHandler := connect.NewUnaryHandler(
+		GamesServiceGetStateAtTurnProcedure,
+		svc.GetStateAtTurn,
+		connect.WithSchema(gamesServiceMethods.ByName("GetStateAtTurn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceGetNotificationPreferencesHandler := connect.NewUnaryHandler(
+		GamesServiceGetNotificationPreferencesProcedure,
+		svc.GetNotificationPreferences,
+		connect.WithSchema(gamesServiceMethods.ByName("GetNotificationPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceSetNotificationPreferencesHandler := connect.NewUnaryHandler(
+		GamesServiceSetNotificationPreferencesProcedure,
+		svc.SetNotificationPreferences,
+		connect.WithSchema(gamesServiceMethods.ByName("SetNotificationPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lilbattle.v1.GamesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GamesServiceCreateGameProcedure:
@@ -429,6 +681,24 @@ func NewGamesServiceHandler(svc GamesServiceHandler, opts ...connect.HandlerOpti
 			gamesServiceSimulateFixHandler.ServeHTTP(w, r)
 		case GamesServiceJoinGameProcedure:
 			gamesServiceJoinGameHandler.ServeHTTP(w, r)
+		case GamesServiceSetClockPausedProcedure:
+			gamesServiceSetClockPausedHandler.ServeHTTP(w, r)
+		case GamesServiceDelegateTurnProcedure:
+			gamesServiceDelegateTurnHandler.ServeHTTP(w, r)
+		case GamesServiceClaimNoContactDrawProcedure:
+			gamesServiceClaimNoContactDrawHandler.ServeHTTP(w, r)
+		case GamesServiceDraftUnitProcedure:
+			gamesServiceDraftUnitHandler.ServeHTTP(w, r)
+		case GamesServiceGetStateDiffProcedure:
+			gamesServiceGetStateDiffHandler.ServeHTTP(w, r)
+		case GamesServiceForkGameProcedure:
+			gamesServiceForkGameHandler.ServeHTTP(w, r)
+		case GamesServiceGetStateAtTurnProcedure:
+			gamesServiceGetStateAtTurnHandler.ServeHTTP(w, r)
+		case GamesServiceGetNotificationPreferencesProcedure:
+			gamesServiceGetNotificationPreferencesHandler.ServeHTTP(w, r)
+		case GamesServiceSetNotificationPreferencesProcedure:
+			gamesServiceSetNotificationPreferencesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -489,3 +759,39 @@ func (UnimplementedGamesServiceHandler) SimulateFix(context.Context, *connect.Re
 func (UnimplementedGamesServiceHandler) JoinGame(context.Context, *connect.Request[models.JoinGameRequest]) (*connect.Response[models.JoinGameResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.JoinGame is not implemented"))
 }
+
+func (UnimplementedGamesServiceHandler) SetClockPaused(context.Context, *connect.Request[models.SetClockPausedRequest]) (*connect.Response[models.SetClockPausedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.SetClockPaused is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) DelegateTurn(context.Context, *connect.Request[models.DelegateTurnRequest]) (*connect.Response[models.DelegateTurnResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.DelegateTurn is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) ClaimNoContactDraw(context.Context, *connect.Request[models.ClaimNoContactDrawRequest]) (*connect.Response[models.ClaimNoContactDrawResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.ClaimNoContactDraw is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) DraftUnit(context.Context, *connect.Request[models.DraftUnitRequest]) (*connect.Response[models.DraftUnitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.DraftUnit is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) GetStateDiff(context.Context, *connect.Request[models.GetStateDiffRequest]) (*connect.Response[models.GetStateDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.GetStateDiff is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) ForkGame(context.Context, *connect.Request[models.ForkGameRequest]) (*connect.Response[models.ForkGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.ForkGame is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) GetStateAtTurn(context.Context, *connect.Request[models.GetStateAtTurnRequest]) (*connect.Response[models.GetStateAtTurnResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.GetStateAtTurn is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) GetNotificationPreferences(context.Context, *connect.Request[models.GetNotificationPreferencesRequest]) (*connect.Response[models.GetNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.GetNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) SetNotificationPreferences(context.Context, *connect.Request[models.SetNotificationPreferencesRequest]) (*connect.Response[models.SetNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.SetNotificationPreferences is not implemented"))
+}
@@ -96,6 +96,12 @@ const (
 	// GameViewerPageShowCaptureEffectProcedure is the fully-qualified name of the GameViewerPage's
 	// ShowCaptureEffect RPC.
 	GameViewerPageShowCaptureEffectProcedure = "/lilbattle.v1.GameViewerPage/ShowCaptureEffect"
+	// GameViewerPageShowCoachVerdictProcedure is the fully-qualified name of the GameViewerPage's
+	// ShowCoachVerdict RPC.
+	GameViewerPageShowCoachVerdictProcedure = "/lilbattle.v1.GameViewerPage/ShowCoachVerdict"
+	// GameViewerPageShowTurnObligationsProcedure is the fully-qualified name of the GameViewerPage's
+	// ShowTurnObligations RPC.
+	GameViewerPageShowTurnObligationsProcedure = "/lilbattle.v1.GameViewerPage/ShowTurnObligations"
 	// GameViewerPageSetAllowedPanelsProcedure is the fully-qualified name of the GameViewerPage's
 	// SetAllowedPanels RPC.
 	GameViewerPageSetAllowedPanelsProcedure = "/lilbattle.v1.GameViewerPage/SetAllowedPanels"
@@ -132,6 +138,10 @@ type GameViewerPageClient interface {
 	ShowAttackEffect(context.Context, *connect.Request[models.ShowAttackEffectRequest]) (*connect.Response[models.ShowAttackEffectResponse], error)
 	ShowHealEffect(context.Context, *connect.Request[models.ShowHealEffectRequest]) (*connect.Response[models.ShowHealEffectResponse], error)
 	ShowCaptureEffect(context.Context, *connect.Request[models.ShowCaptureEffectRequest]) (*connect.Response[models.ShowCaptureEffectResponse], error)
+	// Dismissible panel with coach mode's verdict on the last move
+	ShowCoachVerdict(context.Context, *connect.Request[models.ShowCoachVerdictRequest]) (*connect.Response[models.ShowCoachVerdictResponse], error)
+	// Prompt listing pending obligations, with an option to end the turn anyway
+	ShowTurnObligations(context.Context, *connect.Request[models.ShowTurnObligationsRequest]) (*connect.Response[models.ShowTurnObligationsResponse], error)
 	// Panel visibility and ordering
 	SetAllowedPanels(context.Context, *connect.Request[models.SetAllowedPanelsRequest]) (*connect.Response[models.SetAllowedPanelsResponse], error)
 	// Utility methods
@@ -275,6 +285,18 @@ func NewGameViewerPageClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(gameViewerPageMethods.ByName("ShowCaptureEffect")),
 			connect.WithClientOptions(opts...),
 		),
+		showCoachVerdict: connect.NewClient[models.ShowCoachVerdictRequest, models.ShowCoachVerdictResponse](
+			httpClient,
+			baseURL+GameViewerPageShowCoachVerdictProcedure,
+			connect.WithSchema(gameViewerPageMethods.ByName("ShowCoachVerdict")),
+			connect.WithClientOptions(opts...),
+		),
+		showTurnObligations: connect.NewClient[models.ShowTurnObligationsRequest, models.ShowTurnObligationsResponse](
+			httpClient,
+			baseURL+GameViewerPageShowTurnObligationsProcedure,
+			connect.WithSchema(gameViewerPageMethods.ByName("ShowTurnObligations")),
+			connect.WithClientOptions(opts...),
+		),
 		setAllowedPanels: connect.NewClient[models.SetAllowedPanelsRequest, models.SetAllowedPanelsResponse](
 			httpClient,
 			baseURL+GameViewerPageSetAllowedPanelsProcedure,
@@ -313,6 +335,8 @@ type gameViewerPageClient struct {
 	showAttackEffect             *connect.Client[models.ShowAttackEffectRequest, models.ShowAttackEffectResponse]
 	showHealEffect               *connect.Client[models.ShowHealEffectRequest, models.ShowHealEffectResponse]
 	showCaptureEffect            *connect.Client[models.ShowCaptureEffectRequest, models.ShowCaptureEffectResponse]
+	showCoachVerdict             *connect.Client[models.ShowCoachVerdictRequest, models.ShowCoachVerdictResponse]
+	showTurnObligations          *connect.Client[models.ShowTurnObligationsRequest, models.ShowTurnObligationsResponse]
 	setAllowedPanels             *connect.Client[models.SetAllowedPanelsRequest, models.SetAllowedPanelsResponse]
 	logMessage                   *connect.Client[models.LogMessageRequest, models.LogMessageResponse]
 }
@@ -422,6 +446,16 @@ func (c *gameViewerPageClient) ShowCaptureEffect(ctx context.Context, req *conne
 	return c.showCaptureEffect.CallUnary(ctx, req)
 }
 
+// ShowCoachVerdict calls lilbattle.v1.GameViewerPage.ShowCoachVerdict.
+func (c *gameViewerPageClient) ShowCoachVerdict(ctx context.Context, req *connect.Request[models.ShowCoachVerdictRequest]) (*connect.Response[models.ShowCoachVerdictResponse], error) {
+	return c.showCoachVerdict.CallUnary(ctx, req)
+}
+
+// ShowTurnObligations calls lilbattle.v1.GameViewerPage.ShowTurnObligations.
+func (c *gameViewerPageClient) ShowTurnObligations(ctx context.Context, req *connect.Request[models.ShowTurnObligationsRequest]) (*connect.Response[models.ShowTurnObligationsResponse], error) {
+	return c.showTurnObligations.CallUnary(ctx, req)
+}
+
 // SetAllowedPanels calls lilbattle.v1.GameViewerPage.SetAllowedPanels.
 func (c *gameViewerPageClient) SetAllowedPanels(ctx context.Context, req *connect.Request[models.SetAllowedPanelsRequest]) (*connect.Response[models.SetAllowedPanelsResponse], error) {
 	return c.setAllowedPanels.CallUnary(ctx, req)
@@ -460,6 +494,10 @@ type GameViewerPageHandler interface {
 	ShowAttackEffect(context.Context, *connect.Request[models.ShowAttackEffectRequest]) (*connect.Response[models.ShowAttackEffectResponse], error)
 	ShowHealEffect(context.Context, *connect.Request[models.ShowHealEffectRequest]) (*connect.Response[models.ShowHealEffectResponse], error)
 	ShowCaptureEffect(context.Context, *connect.Request[models.ShowCaptureEffectRequest]) (*connect.Response[models.ShowCaptureEffectResponse], error)
+	// Dismissible panel with coach mode's verdict on the last move
+	ShowCoachVerdict(context.Context, *connect.Request[models.ShowCoachVerdictRequest]) (*connect.Response[models.ShowCoachVerdictResponse], error)
+	// Prompt listing pending obligations, with an option to end the turn anyway
+	ShowTurnObligations(context.Context, *connect.Request[models.ShowTurnObligationsRequest]) (*connect.Response[models.ShowTurnObligationsResponse], error)
 	// Panel visibility and ordering
 	SetAllowedPanels(context.Context, *connect.Request[models.SetAllowedPanelsRequest]) (*connect.Response[models.SetAllowedPanelsResponse], error)
 	// Utility methods
@@ -599,6 +637,18 @@ func NewGameViewerPageHandler(svc GameViewerPageHandler, opts ...connect.Handler
 		connect.WithSchema(gameViewerPageMethods.ByName("ShowCaptureEffect")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameViewerPageShowCoachVerdictHandler := connect.NewUnaryHandler(
+		GameViewerPageShowCoachVerdictProcedure,
+		svc.ShowCoachVerdict,
+		connect.WithSchema(gameViewerPageMethods.ByName("ShowCoachVerdict")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameViewerPageShowTurnObligationsHandler := connect.NewUnaryHandler(
+		GameViewerPageShowTurnObligationsProcedure,
+		svc.ShowTurnObligations,
+		connect.WithSchema(gameViewerPageMethods.ByName("ShowTurnObligations")),
+		connect.WithHandlerOptions(opts...),
+	)
 	gameViewerPageSetAllowedPanelsHandler := connect.NewUnaryHandler(
 		GameViewerPageSetAllowedPanelsProcedure,
 		svc.SetAllowedPanels,
@@ -655,6 +705,10 @@ func NewGameViewerPageHandler(svc GameViewerPageHandler, opts ...connect.Handler
 			gameViewerPageShowHealEffectHandler.ServeHTTP(w, r)
 		case GameViewerPageShowCaptureEffectProcedure:
 			gameViewerPageShowCaptureEffectHandler.ServeHTTP(w, r)
+		case GameViewerPageShowCoachVerdictProcedure:
+			gameViewerPageShowCoachVerdictHandler.ServeHTTP(w, r)
+		case GameViewerPageShowTurnObligationsProcedure:
+			gameViewerPageShowTurnObligationsHandler.ServeHTTP(w, r)
 		case GameViewerPageSetAllowedPanelsProcedure:
 			gameViewerPageSetAllowedPanelsHandler.ServeHTTP(w, r)
 		case GameViewerPageLogMessageProcedure:
@@ -752,6 +806,14 @@ func (UnimplementedGameViewerPageHandler) ShowCaptureEffect(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameViewerPage.ShowCaptureEffect is not implemented"))
 }
 
+func (UnimplementedGameViewerPageHandler) ShowCoachVerdict(context.Context, *connect.Request[models.ShowCoachVerdictRequest]) (*connect.Response[models.ShowCoachVerdictResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameViewerPage.ShowCoachVerdict is not implemented"))
+}
+
+func (UnimplementedGameViewerPageHandler) ShowTurnObligations(context.Context, *connect.Request[models.ShowTurnObligationsRequest]) (*connect.Response[models.ShowTurnObligationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameViewerPage.ShowTurnObligations is not implemented"))
+}
+
 func (UnimplementedGameViewerPageHandler) SetAllowedPanels(context.Context, *connect.Request[models.SetAllowedPanelsRequest]) (*connect.Response[models.SetAllowedPanelsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameViewerPage.SetAllowedPanels is not implemented"))
 }
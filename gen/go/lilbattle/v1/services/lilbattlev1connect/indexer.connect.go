@@ -47,6 +47,9 @@ const (
 	// IndexerServiceDeleteIndexStatesProcedure is the fully-qualified name of the IndexerService's
 	// DeleteIndexStates RPC.
 	IndexerServiceDeleteIndexStatesProcedure = "/lilbattle.v1.IndexerService/DeleteIndexStates"
+	// IndexerServiceGetMetaStatsProcedure is the fully-qualified name of the IndexerService's
+	// GetMetaStats RPC.
+	IndexerServiceGetMetaStatsProcedure = "/lilbattle.v1.IndexerService/GetMetaStats"
 )
 
 // IndexerServiceClient is a client for the lilbattle.v1.IndexerService service.
@@ -61,6 +64,10 @@ type IndexerServiceClient interface {
 	// List index entity states by filtering
 	ListIndexStates(context.Context, *connect.Request[models.ListIndexStatesRequest]) (*connect.Response[models.ListIndexStatesResponse], error)
 	DeleteIndexStates(context.Context, *connect.Request[models.DeleteIndexStatesRequest]) (*connect.Response[models.DeleteIndexStatesResponse], error)
+	// *
+	// Get unit, terrain and game length statistics aggregated from finished
+	// games, per world
+	GetMetaStats(context.Context, *connect.Request[models.GetMetaStatsRequest]) (*connect.Response[models.GetMetaStatsResponse], error)
 }
 
 // NewIndexerServiceClient constructs a client for the lilbattle.v1.IndexerService service. By
@@ -98,6 +105,12 @@ func NewIndexerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(indexerServiceMethods.ByName("DeleteIndexStates")),
 			connect.WithClientOptions(opts...),
 		),
+		getMetaStats: connect.NewClient[models.GetMetaStatsRequest, models.GetMetaStatsResponse](
+			httpClient,
+			baseURL+IndexerServiceGetMetaStatsProcedure,
+			connect.WithSchema(indexerServiceMethods.ByName("GetMetaStats")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -107,6 +120,7 @@ type indexerServiceClient struct {
 	getIndexStates    *connect.Client[models.GetIndexStatesRequest, models.GetIndexStatesResponse]
 	listIndexStates   *connect.Client[models.ListIndexStatesRequest, models.ListIndexStatesResponse]
 	deleteIndexStates *connect.Client[models.DeleteIndexStatesRequest, models.DeleteIndexStatesResponse]
+	getMetaStats      *connect.Client[models.GetMetaStatsRequest, models.GetMetaStatsResponse]
 }
 
 // EnsureIndexState calls lilbattle.v1.IndexerService.EnsureIndexState.
@@ -129,6 +143,11 @@ func (c *indexerServiceClient) DeleteIndexStates(ctx context.Context, req *conne
 	return c.deleteIndexStates.CallUnary(ctx, req)
 }
 
+// GetMetaStats calls lilbattle.v1.IndexerService.GetMetaStats.
+func (c *indexerServiceClient) GetMetaStats(ctx context.Context, req *connect.Request[models.GetMetaStatsRequest]) (*connect.Response[models.GetMetaStatsResponse], error) {
+	return c.getMetaStats.CallUnary(ctx, req)
+}
+
 // IndexerServiceHandler is an implementation of the lilbattle.v1.IndexerService service.
 type IndexerServiceHandler interface {
 	// *
@@ -141,6 +160,10 @@ type IndexerServiceHandler interface {
 	// List index entity states by filtering
 	ListIndexStates(context.Context, *connect.Request[models.ListIndexStatesRequest]) (*connect.Response[models.ListIndexStatesResponse], error)
 	DeleteIndexStates(context.Context, *connect.Request[models.DeleteIndexStatesRequest]) (*connect.Response[models.DeleteIndexStatesResponse], error)
+	// *
+	// Get unit, terrain and game length statistics aggregated from finished
+	// games, per world
+	GetMetaStats(context.Context, *connect.Request[models.GetMetaStatsRequest]) (*connect.Response[models.GetMetaStatsResponse], error)
 }
 
 // NewIndexerServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -174,6 +197,12 @@ func NewIndexerServiceHandler(svc IndexerServiceHandler, opts ...connect.Handler
 		connect.WithSchema(indexerServiceMethods.ByName("DeleteIndexStates")),
 		connect.WithHandlerOptions(opts...),
 	)
+	indexerServiceGetMetaStatsHandler := connect.NewUnaryHandler(
+		IndexerServiceGetMetaStatsProcedure,
+		svc.GetMetaStats,
+		connect.WithSchema(indexerServiceMethods.ByName("GetMetaStats")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lilbattle.v1.IndexerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case IndexerServiceEnsureIndexStateProcedure:
@@ -184,6 +213,8 @@ func NewIndexerServiceHandler(svc IndexerServiceHandler, opts ...connect.Handler
 			indexerServiceListIndexStatesHandler.ServeHTTP(w, r)
 		case IndexerServiceDeleteIndexStatesProcedure:
 			indexerServiceDeleteIndexStatesHandler.ServeHTTP(w, r)
+		case IndexerServiceGetMetaStatsProcedure:
+			indexerServiceGetMetaStatsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -208,3 +239,7 @@ func (UnimplementedIndexerServiceHandler) ListIndexStates(context.Context, *conn
 func (UnimplementedIndexerServiceHandler) DeleteIndexStates(context.Context, *connect.Request[models.DeleteIndexStatesRequest]) (*connect.Response[models.DeleteIndexStatesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.IndexerService.DeleteIndexStates is not implemented"))
 }
+
+func (UnimplementedIndexerServiceHandler) GetMetaStats(context.Context, *connect.Request[models.GetMetaStatsRequest]) (*connect.Response[models.GetMetaStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.IndexerService.GetMetaStats is not implemented"))
+}
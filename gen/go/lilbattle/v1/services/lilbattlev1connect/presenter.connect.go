@@ -62,6 +62,21 @@ const (
 	// GameViewPresenterApplyRemoteChangesProcedure is the fully-qualified name of the
 	// GameViewPresenter's ApplyRemoteChanges RPC.
 	GameViewPresenterApplyRemoteChangesProcedure = "/lilbattle.v1.GameViewPresenter/ApplyRemoteChanges"
+	// GameViewPresenterShowPingProcedure is the fully-qualified name of the GameViewPresenter's
+	// ShowPing RPC.
+	GameViewPresenterShowPingProcedure = "/lilbattle.v1.GameViewPresenter/ShowPing"
+	// GameViewPresenterSaveSlotProcedure is the fully-qualified name of the GameViewPresenter's
+	// SaveSlot RPC.
+	GameViewPresenterSaveSlotProcedure = "/lilbattle.v1.GameViewPresenter/SaveSlot"
+	// GameViewPresenterLoadSlotProcedure is the fully-qualified name of the GameViewPresenter's
+	// LoadSlot RPC.
+	GameViewPresenterLoadSlotProcedure = "/lilbattle.v1.GameViewPresenter/LoadSlot"
+	// GameViewPresenterListSlotsProcedure is the fully-qualified name of the GameViewPresenter's
+	// ListSlots RPC.
+	GameViewPresenterListSlotsProcedure = "/lilbattle.v1.GameViewPresenter/ListSlots"
+	// GameViewPresenterExploreFromHereProcedure is the fully-qualified name of the GameViewPresenter's
+	// ExploreFromHere RPC.
+	GameViewPresenterExploreFromHereProcedure = "/lilbattle.v1.GameViewPresenter/ExploreFromHere"
 )
 
 // SingletonInitializerServiceClient is a client for the lilbattle.v1.SingletonInitializerService
@@ -166,6 +181,24 @@ type GameViewPresenterClient interface {
 	// This updates local game state and triggers UI updates for the received WorldChanges.
 	// Used by viewers to apply moves made by other players.
 	ApplyRemoteChanges(context.Context, *connect.Request[models.ApplyRemoteChangesRequest]) (*connect.Response[models.ApplyRemoteChangesResponse], error)
+	// *
+	// Show a teammate's ping (received via SyncService subscription) as a
+	// transient marker that expires on its own.
+	ShowPing(context.Context, *connect.Request[models.ShowPingRequest]) (*connect.Response[models.ShowPingResponse], error)
+	// *
+	// Saves the current game into a named save slot in the browser
+	SaveSlot(context.Context, *connect.Request[models.SaveSlotRequest]) (*connect.Response[models.SaveSlotResponse], error)
+	// *
+	// Replaces the current game with the one saved in a slot. Corrupted
+	// slots are rejected without touching the current game.
+	LoadSlot(context.Context, *connect.Request[models.LoadSlotRequest]) (*connect.Response[models.LoadSlotResponse], error)
+	// *
+	// Lists the save slots in the browser
+	ListSlots(context.Context, *connect.Request[models.ListSlotsRequest]) (*connect.Response[models.ListSlotsResponse], error)
+	// *
+	// Forks the game being replayed at the move being viewed, so other lines
+	// can be explored from there
+	ExploreFromHere(context.Context, *connect.Request[models.ExploreFromHereRequest]) (*connect.Response[models.ExploreFromHereResponse], error)
 }
 
 // NewGameViewPresenterClient constructs a client for the lilbattle.v1.GameViewPresenter service. By
@@ -221,6 +254,36 @@ func NewGameViewPresenterClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(gameViewPresenterMethods.ByName("ApplyRemoteChanges")),
 			connect.WithClientOptions(opts...),
 		),
+		showPing: connect.NewClient[models.ShowPingRequest, models.ShowPingResponse](
+			httpClient,
+			baseURL+GameViewPresenterShowPingProcedure,
+			connect.WithSchema(gameViewPresenterMethods.ByName("ShowPing")),
+			connect.WithClientOptions(opts...),
+		),
+		saveSlot: connect.NewClient[models.SaveSlotRequest, models.SaveSlotResponse](
+			httpClient,
+			baseURL+GameViewPresenterSaveSlotProcedure,
+			connect.WithSchema(gameViewPresenterMethods.ByName("SaveSlot")),
+			connect.WithClientOptions(opts...),
+		),
+		loadSlot: connect.NewClient[models.LoadSlotRequest, models.LoadSlotResponse](
+			httpClient,
+			baseURL+GameViewPresenterLoadSlotProcedure,
+			connect.WithSchema(gameViewPresenterMethods.ByName("LoadSlot")),
+			connect.WithClientOptions(opts...),
+		),
+		listSlots: connect.NewClient[models.ListSlotsRequest, models.ListSlotsResponse](
+			httpClient,
+			baseURL+GameViewPresenterListSlotsProcedure,
+			connect.WithSchema(gameViewPresenterMethods.ByName("ListSlots")),
+			connect.WithClientOptions(opts...),
+		),
+		exploreFromHere: connect.NewClient[models.ExploreFromHereRequest, models.ExploreFromHereResponse](
+			httpClient,
+			baseURL+GameViewPresenterExploreFromHereProcedure,
+			connect.WithSchema(gameViewPresenterMethods.ByName("ExploreFromHere")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -233,6 +296,11 @@ type gameViewPresenterClient struct {
 	endTurnButtonClicked *connect.Client[models.EndTurnButtonClickedRequest, models.EndTurnButtonClickedResponse]
 	buildOptionClicked   *connect.Client[models.BuildOptionClickedRequest, models.BuildOptionClickedResponse]
 	applyRemoteChanges   *connect.Client[models.ApplyRemoteChangesRequest, models.ApplyRemoteChangesResponse]
+	showPing             *connect.Client[models.ShowPingRequest, models.ShowPingResponse]
+	saveSlot             *connect.Client[models.SaveSlotRequest, models.SaveSlotResponse]
+	loadSlot             *connect.Client[models.LoadSlotRequest, models.LoadSlotResponse]
+	listSlots            *connect.Client[models.ListSlotsRequest, models.ListSlotsResponse]
+	exploreFromHere      *connect.Client[models.ExploreFromHereRequest, models.ExploreFromHereResponse]
 }
 
 // InitializeGame calls lilbattle.v1.GameViewPresenter.InitializeGame.
@@ -270,6 +338,31 @@ func (c *gameViewPresenterClient) ApplyRemoteChanges(ctx context.Context, req *c
 	return c.applyRemoteChanges.CallUnary(ctx, req)
 }
 
+// ShowPing calls lilbattle.v1.GameViewPresenter.ShowPing.
+func (c *gameViewPresenterClient) ShowPing(ctx context.Context, req *connect.Request[models.ShowPingRequest]) (*connect.Response[models.ShowPingResponse], error) {
+	return c.showPing.CallUnary(ctx, req)
+}
+
+// SaveSlot calls lilbattle.v1.GameViewPresenter.SaveSlot.
+func (c *gameViewPresenterClient) SaveSlot(ctx context.Context, req *connect.Request[models.SaveSlotRequest]) (*connect.Response[models.SaveSlotResponse], error) {
+	return c.saveSlot.CallUnary(ctx, req)
+}
+
+// LoadSlot calls lilbattle.v1.GameViewPresenter.LoadSlot.
+func (c *gameViewPresenterClient) LoadSlot(ctx context.Context, req *connect.Request[models.LoadSlotRequest]) (*connect.Response[models.LoadSlotResponse], error) {
+	return c.loadSlot.CallUnary(ctx, req)
+}
+
+// ListSlots calls lilbattle.v1.GameViewPresenter.ListSlots.
+func (c *gameViewPresenterClient) ListSlots(ctx context.Context, req *connect.Request[models.ListSlotsRequest]) (*connect.Response[models.ListSlotsResponse], error) {
+	return c.listSlots.CallUnary(ctx, req)
+}
+
+// ExploreFromHere calls lilbattle.v1.GameViewPresenter.ExploreFromHere.
+func (c *gameViewPresenterClient) ExploreFromHere(ctx context.Context, req *connect.Request[models.ExploreFromHereRequest]) (*connect.Response[models.ExploreFromHereResponse], error) {
+	return c.exploreFromHere.CallUnary(ctx, req)
+}
+
 // GameViewPresenterHandler is an implementation of the lilbattle.v1.GameViewPresenter service.
 type GameViewPresenterHandler interface {
 	// *
@@ -300,6 +393,24 @@ type GameViewPresenterHandler interface {
 	// This updates local game state and triggers UI updates for the received WorldChanges.
 	// Used by viewers to apply moves made by other players.
 	ApplyRemoteChanges(context.Context, *connect.Request[models.ApplyRemoteChangesRequest]) (*connect.Response[models.ApplyRemoteChangesResponse], error)
+	// *
+	// Show a teammate's ping (received via SyncService subscription) as a
+	// transient marker that expires on its own.
+	ShowPing(context.Context, *connect.Request[models.ShowPingRequest]) (*connect.Response[models.ShowPingResponse], error)
+	// *
+	// Saves the current game into a named save slot in the browser
+	SaveSlot(context.Context, *connect.Request[models.SaveSlotRequest]) (*connect.Response[models.SaveSlotResponse], error)
+	// *
+	// Replaces the current game with the one saved in a slot. Corrupted
+	// slots are rejected without touching the current game.
+	LoadSlot(context.Context, *connect.Request[models.LoadSlotRequest]) (*connect.Response[models.LoadSlotResponse], error)
+	// *
+	// Lists the save slots in the browser
+	ListSlots(context.Context, *connect.Request[models.ListSlotsRequest]) (*connect.Response[models.ListSlotsResponse], error)
+	// *
+	// Forks the game being replayed at the move being viewed, so other lines
+	// can be explored from there
+	ExploreFromHere(context.Context, *connect.Request[models.ExploreFromHereRequest]) (*connect.Response[models.ExploreFromHereResponse], error)
 }
 
 // NewGameViewPresenterHandler builds an HTTP handler from the service implementation. It returns
@@ -351,6 +462,36 @@ func NewGameViewPresenterHandler(svc GameViewPresenterHandler, opts ...connect.H
 		connect.WithSchema(gameViewPresenterMethods.ByName("ApplyRemoteChanges")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameViewPresenterShowPingHandler := connect.NewUnaryHandler(
+		GameViewPresenterShowPingProcedure,
+		svc.ShowPing,
+		connect.WithSchema(gameViewPresenterMethods.ByName("ShowPing")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameViewPresenterSaveSlotHandler := connect.NewUnaryHandler(
+		GameViewPresenterSaveSlotProcedure,
+		svc.SaveSlot,
+		connect.WithSchema(gameViewPresenterMethods.ByName("SaveSlot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameViewPresenterLoadSlotHandler := connect.NewUnaryHandler(
+		GameViewPresenterLoadSlotProcedure,
+		svc.LoadSlot,
+		connect.WithSchema(gameViewPresenterMethods.ByName("LoadSlot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameViewPresenterListSlotsHandler := connect.NewUnaryHandler(
+		GameViewPresenterListSlotsProcedure,
+		svc.ListSlots,
+		connect.WithSchema(gameViewPresenterMethods.ByName("ListSlots")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameViewPresenterExploreFromHereHandler := connect.NewUnaryHandler(
+		GameViewPresenterExploreFromHereProcedure,
+		svc.ExploreFromHere,
+		connect.WithSchema(gameViewPresenterMethods.ByName("ExploreFromHere")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lilbattle.v1.GameViewPresenter/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GameViewPresenterInitializeGameProcedure:
@@ -367,6 +508,16 @@ func NewGameViewPresenterHandler(svc GameViewPresenterHandler, opts ...connect.H
 			gameViewPresenterBuildOptionClickedHandler.ServeHTTP(w, r)
 		case GameViewPresenterApplyRemoteChangesProcedure:
 			gameViewPresenterApplyRemoteChangesHandler.ServeHTTP(w, r)
+		case GameViewPresenterShowPingProcedure:
+			gameViewPresenterShowPingHandler.ServeHTTP(w, r)
+		case GameViewPresenterSaveSlotProcedure:
+			gameViewPresenterSaveSlotHandler.ServeHTTP(w, r)
+		case GameViewPresenterLoadSlotProcedure:
+			gameViewPresenterLoadSlotHandler.ServeHTTP(w, r)
+		case GameViewPresenterListSlotsProcedure:
+			gameViewPresenterListSlotsHandler.ServeHTTP(w, r)
+		case GameViewPresenterExploreFromHereProcedure:
+			gameViewPresenterExploreFromHereHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -403,3 +554,23 @@ func (UnimplementedGameViewPresenterHandler) BuildOptionClicked(context.Context,
 func (UnimplementedGameViewPresenterHandler) ApplyRemoteChanges(context.Context, *connect.Request[models.ApplyRemoteChangesRequest]) (*connect.Response[models.ApplyRemoteChangesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameViewPresenter.ApplyRemoteChanges is not implemented"))
 }
+
+func (UnimplementedGameViewPresenterHandler) ShowPing(context.Context, *connect.Request[models.ShowPingRequest]) (*connect.Response[models.ShowPingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameViewPresenter.ShowPing is not implemented"))
+}
+
+func (UnimplementedGameViewPresenterHandler) SaveSlot(context.Context, *connect.Request[models.SaveSlotRequest]) (*connect.Response[models.SaveSlotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameViewPresenter.SaveSlot is not implemented"))
+}
+
+func (UnimplementedGameViewPresenterHandler) LoadSlot(context.Context, *connect.Request[models.LoadSlotRequest]) (*connect.Response[models.LoadSlotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameViewPresenter.LoadSlot is not implemented"))
+}
+
+func (UnimplementedGameViewPresenterHandler) ListSlots(context.Context, *connect.Request[models.ListSlotsRequest]) (*connect.Response[models.ListSlotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameViewPresenter.ListSlots is not implemented"))
+}
+
+func (UnimplementedGameViewPresenterHandler) ExploreFromHere(context.Context, *connect.Request[models.ExploreFromHereRequest]) (*connect.Response[models.ExploreFromHereResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameViewPresenter.ExploreFromHere is not implemented"))
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lilbattle/v1/services/puzzles.proto
+
+package lilbattlev1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+	models "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	services "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PuzzlesServiceName is the fully-qualified name of the PuzzlesService service.
+	PuzzlesServiceName = "lilbattle.v1.PuzzlesService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PuzzlesServiceCreatePuzzleProcedure is the fully-qualified name of the PuzzlesService's
+	// CreatePuzzle RPC.
+	PuzzlesServiceCreatePuzzleProcedure = "/lilbattle.v1.PuzzlesService/CreatePuzzle"
+	// PuzzlesServiceListPuzzlesProcedure is the fully-qualified name of the PuzzlesService's
+	// ListPuzzles RPC.
+	PuzzlesServiceListPuzzlesProcedure = "/lilbattle.v1.PuzzlesService/ListPuzzles"
+	// PuzzlesServiceGetPuzzleProcedure is the fully-qualified name of the PuzzlesService's GetPuzzle
+	// RPC.
+	PuzzlesServiceGetPuzzleProcedure = "/lilbattle.v1.PuzzlesService/GetPuzzle"
+	// PuzzlesServiceUpdatePuzzleProcedure is the fully-qualified name of the PuzzlesService's
+	// UpdatePuzzle RPC.
+	PuzzlesServiceUpdatePuzzleProcedure = "/lilbattle.v1.PuzzlesService/UpdatePuzzle"
+	// PuzzlesServiceDeletePuzzleProcedure is the fully-qualified name of the PuzzlesService's
+	// DeletePuzzle RPC.
+	PuzzlesServiceDeletePuzzleProcedure = "/lilbattle.v1.PuzzlesService/DeletePuzzle"
+	// PuzzlesServicePlayPuzzleProcedure is the fully-qualified name of the PuzzlesService's PlayPuzzle
+	// RPC.
+	PuzzlesServicePlayPuzzleProcedure = "/lilbattle.v1.PuzzlesService/PlayPuzzle"
+	// PuzzlesServiceListPuzzleAttemptsProcedure is the fully-qualified name of the PuzzlesService's
+	// ListPuzzleAttempts RPC.
+	PuzzlesServiceListPuzzleAttemptsProcedure = "/lilbattle.v1.PuzzlesService/ListPuzzleAttempts"
+)
+
+// PuzzlesServiceClient is a client for the lilbattle.v1.PuzzlesService service.
+type PuzzlesServiceClient interface {
+	// *
+	// Create a new puzzle
+	CreatePuzzle(context.Context, *connect.Request[models.CreatePuzzleRequest]) (*connect.Response[models.CreatePuzzleResponse], error)
+	// ListPuzzles returns all puzzles
+	ListPuzzles(context.Context, *connect.Request[models.ListPuzzlesRequest]) (*connect.Response[models.ListPuzzlesResponse], error)
+	// GetPuzzle returns a specific puzzle
+	GetPuzzle(context.Context, *connect.Request[models.GetPuzzleRequest]) (*connect.Response[models.GetPuzzleResponse], error)
+	// *
+	// Update a puzzle. Only its creator can update it.
+	UpdatePuzzle(context.Context, *connect.Request[models.UpdatePuzzleRequest]) (*connect.Response[models.UpdatePuzzleResponse], error)
+	// *
+	// Delete a puzzle. Only its creator can delete it.
+	DeletePuzzle(context.Context, *connect.Request[models.DeletePuzzleRequest]) (*connect.Response[models.DeletePuzzleResponse], error)
+	// *
+	// Start a new attempt at a puzzle: a game from the puzzle's position with
+	// the caller as the solver
+	PlayPuzzle(context.Context, *connect.Request[models.PlayPuzzleRequest]) (*connect.Response[models.PlayPuzzleResponse], error)
+	// *
+	// List the caller's puzzle attempts and how they went
+	ListPuzzleAttempts(context.Context, *connect.Request[models.ListPuzzleAttemptsRequest]) (*connect.Response[models.ListPuzzleAttemptsResponse], error)
+}
+
+// NewPuzzlesServiceClient constructs a client for the lilbattle.v1.PuzzlesService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPuzzlesServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PuzzlesServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	puzzlesServiceMethods := services.File_lilbattle_v1_services_puzzles_proto.Services().ByName("PuzzlesService").Methods()
+	return &puzzlesServiceClient{
+		createPuzzle: connect.NewClient[models.CreatePuzzleRequest, models.CreatePuzzleResponse](
+			httpClient,
+			baseURL+PuzzlesServiceCreatePuzzleProcedure,
+			connect.WithSchema(puzzlesServiceMethods.ByName("CreatePuzzle")),
+			connect.WithClientOptions(opts...),
+		),
+		listPuzzles: connect.NewClient[models.ListPuzzlesRequest, models.ListPuzzlesResponse](
+			httpClient,
+			baseURL+PuzzlesServiceListPuzzlesProcedure,
+			connect.WithSchema(puzzlesServiceMethods.ByName("ListPuzzles")),
+			connect.WithClientOptions(opts...),
+		),
+		getPuzzle: connect.NewClient[models.GetPuzzleRequest, models.GetPuzzleResponse](
+			httpClient,
+			baseURL+PuzzlesServiceGetPuzzleProcedure,
+			connect.WithSchema(puzzlesServiceMethods.ByName("GetPuzzle")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePuzzle: connect.NewClient[models.UpdatePuzzleRequest, models.UpdatePuzzleResponse](
+			httpClient,
+			baseURL+PuzzlesServiceUpdatePuzzleProcedure,
+			connect.WithSchema(puzzlesServiceMethods.ByName("UpdatePuzzle")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePuzzle: connect.NewClient[models.DeletePuzzleRequest, models.DeletePuzzleResponse](
+			httpClient,
+			baseURL+PuzzlesServiceDeletePuzzleProcedure,
+			connect.WithSchema(puzzlesServiceMethods.ByName("DeletePuzzle")),
+			connect.WithClientOptions(opts...),
+		),
+		playPuzzle: connect.NewClient[models.PlayPuzzleRequest, models.PlayPuzzleResponse](
+			httpClient,
+			baseURL+PuzzlesServicePlayPuzzleProcedure,
+			connect.WithSchema(puzzlesServiceMethods.ByName("PlayPuzzle")),
+			connect.WithClientOptions(opts...),
+		),
+		listPuzzleAttempts: connect.NewClient[models.ListPuzzleAttemptsRequest, models.ListPuzzleAttemptsResponse](
+			httpClient,
+			baseURL+PuzzlesServiceListPuzzleAttemptsProcedure,
+			connect.WithSchema(puzzlesServiceMethods.ByName("ListPuzzleAttempts")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// puzzlesServiceClient implements PuzzlesServiceClient.
+type puzzlesServiceClient struct {
+	createPuzzle       *connect.Client[models.CreatePuzzleRequest, models.CreatePuzzleResponse]
+	listPuzzles        *connect.Client[models.ListPuzzlesRequest, models.ListPuzzlesResponse]
+	getPuzzle          *connect.Client[models.GetPuzzleRequest, models.GetPuzzleResponse]
+	updatePuzzle       *connect.Client[models.UpdatePuzzleRequest, models.UpdatePuzzleResponse]
+	deletePuzzle       *connect.Client[models.DeletePuzzleRequest, models.DeletePuzzleResponse]
+	playPuzzle         *connect.Client[models.PlayPuzzleRequest, models.PlayPuzzleResponse]
+	listPuzzleAttempts *connect.Client[models.ListPuzzleAttemptsRequest, models.ListPuzzleAttemptsResponse]
+}
+
+// CreatePuzzle calls lilbattle.v1.PuzzlesService.CreatePuzzle.
+func (c *puzzlesServiceClient) CreatePuzzle(ctx context.Context, req *connect.Request[models.CreatePuzzleRequest]) (*connect.Response[models.CreatePuzzleResponse], error) {
+	return c.createPuzzle.CallUnary(ctx, req)
+}
+
+// ListPuzzles calls lilbattle.v1.PuzzlesService.ListPuzzles.
+func (c *puzzlesServiceClient) ListPuzzles(ctx context.Context, req *connect.Request[models.ListPuzzlesRequest]) (*connect.Response[models.ListPuzzlesResponse], error) {
+	return c.listPuzzles.CallUnary(ctx, req)
+}
+
+// GetPuzzle calls lilbattle.v1.PuzzlesService.GetPuzzle.
+func (c *puzzlesServiceClient) GetPuzzle(ctx context.Context, req *connect.Request[models.GetPuzzleRequest]) (*connect.Response[models.GetPuzzleResponse], error) {
+	return c.getPuzzle.CallUnary(ctx, req)
+}
+
+// UpdatePuzzle calls lilbattle.v1.PuzzlesService.UpdatePuzzle.
+func (c *puzzlesServiceClient) UpdatePuzzle(ctx context.Context, req *connect.Request[models.UpdatePuzzleRequest]) (*connect.Response[models.UpdatePuzzleResponse], error) {
+	return c.updatePuzzle.CallUnary(ctx, req)
+}
+
+// DeletePuzzle calls lilbattle.v1.PuzzlesService.DeletePuzzle.
+func (c *puzzlesServiceClient) DeletePuzzle(ctx context.Context, req *connect.Request[models.DeletePuzzleRequest]) (*connect.Response[models.DeletePuzzleResponse], error) {
+	return c.deletePuzzle.CallUnary(ctx, req)
+}
+
+// PlayPuzzle calls lilbattle.v1.PuzzlesService.PlayPuzzle.
+func (c *puzzlesServiceClient) PlayPuzzle(ctx context.Context, req *connect.Request[models.PlayPuzzleRequest]) (*connect.Response[models.PlayPuzzleResponse], error) {
+	return c.playPuzzle.CallUnary(ctx, req)
+}
+
+// ListPuzzleAttempts calls lilbattle.v1.PuzzlesService.ListPuzzleAttempts.
+func (c *puzzlesServiceClient) ListPuzzleAttempts(ctx context.Context, req *connect.Request[models.ListPuzzleAttemptsRequest]) (*connect.Response[models.ListPuzzleAttemptsResponse], error) {
+	return c.listPuzzleAttempts.CallUnary(ctx, req)
+}
+
+// PuzzlesServiceHandler is an implementation of the lilbattle.v1.PuzzlesService service.
+type PuzzlesServiceHandler interface {
+	// *
+	// Create a new puzzle
+	CreatePuzzle(context.Context, *connect.Request[models.CreatePuzzleRequest]) (*connect.Response[models.CreatePuzzleResponse], error)
+	// ListPuzzles returns all puzzles
+	ListPuzzles(context.Context, *connect.Request[models.ListPuzzlesRequest]) (*connect.Response[models.ListPuzzlesResponse], error)
+	// GetPuzzle returns a specific puzzle
+	GetPuzzle(context.Context, *connect.Request[models.GetPuzzleRequest]) (*connect.Response[models.GetPuzzleResponse], error)
+	// *
+	// Update a puzzle. Only its creator can update it.
+	UpdatePuzzle(context.Context, *connect.Request[models.UpdatePuzzleRequest]) (*connect.Response[models.UpdatePuzzleResponse], error)
+	// *
+	// Delete a puzzle. Only its creator can delete it.
+	DeletePuzzle(context.Context, *connect.Request[models.DeletePuzzleRequest]) (*connect.Response[models.DeletePuzzleResponse], error)
+	// *
+	// Start a new attempt at a puzzle: a game from the puzzle's position with
+	// the caller as the solver
+	PlayPuzzle(context.Context, *connect.Request[models.PlayPuzzleRequest]) (*connect.Response[models.PlayPuzzleResponse], error)
+	// *
+	// List the caller's puzzle attempts and how they went
+	ListPuzzleAttempts(context.Context, *connect.Request[models.ListPuzzleAttemptsRequest]) (*connect.Response[models.ListPuzzleAttemptsResponse], error)
+}
+
+// NewPuzzlesServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPuzzlesServiceHandler(svc PuzzlesServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	puzzlesServiceMethods := services.File_lilbattle_v1_services_puzzles_proto.Services().ByName("PuzzlesService").Methods()
+	puzzlesServiceCreatePuzzleHandler := connect.NewUnaryHandler(
+		PuzzlesServiceCreatePuzzleProcedure,
+		svc.CreatePuzzle,
+		connect.WithSchema(puzzlesServiceMethods.ByName("CreatePuzzle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzlesServiceListPuzzlesHandler := connect.NewUnaryHandler(
+		PuzzlesServiceListPuzzlesProcedure,
+		svc.ListPuzzles,
+		connect.WithSchema(puzzlesServiceMethods.ByName("ListPuzzles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzlesServiceGetPuzzleHandler := connect.NewUnaryHandler(
+		PuzzlesServiceGetPuzzleProcedure,
+		svc.GetPuzzle,
+		connect.WithSchema(puzzlesServiceMethods.ByName("GetPuzzle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzlesServiceUpdatePuzzleHandler := connect.NewUnaryHandler(
+		PuzzlesServiceUpdatePuzzleProcedure,
+		svc.UpdatePuzzle,
+		connect.WithSchema(puzzlesServiceMethods.ByName("UpdatePuzzle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzlesServiceDeletePuzzleHandler := connect.NewUnaryHandler(
+		PuzzlesServiceDeletePuzzleProcedure,
+		svc.DeletePuzzle,
+		connect.WithSchema(puzzlesServiceMethods.ByName("DeletePuzzle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzlesServicePlayPuzzleHandler := connect.NewUnaryHandler(
+		PuzzlesServicePlayPuzzleProcedure,
+		svc.PlayPuzzle,
+		connect.WithSchema(puzzlesServiceMethods.ByName("PlayPuzzle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzlesServiceListPuzzleAttemptsHandler := connect.NewUnaryHandler(
+		PuzzlesServiceListPuzzleAttemptsProcedure,
+		svc.ListPuzzleAttempts,
+		connect.WithSchema(puzzlesServiceMethods.ByName("ListPuzzleAttempts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lilbattle.v1.PuzzlesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PuzzlesServiceCreatePuzzleProcedure:
+			puzzlesServiceCreatePuzzleHandler.ServeHTTP(w, r)
+		case PuzzlesServiceListPuzzlesProcedure:
+			puzzlesServiceListPuzzlesHandler.ServeHTTP(w, r)
+		case PuzzlesServiceGetPuzzleProcedure:
+			puzzlesServiceGetPuzzleHandler.ServeHTTP(w, r)
+		case PuzzlesServiceUpdatePuzzleProcedure:
+			puzzlesServiceUpdatePuzzleHandler.ServeHTTP(w, r)
+		case PuzzlesServiceDeletePuzzleProcedure:
+			puzzlesServiceDeletePuzzleHandler.ServeHTTP(w, r)
+		case PuzzlesServicePlayPuzzleProcedure:
+			puzzlesServicePlayPuzzleHandler.ServeHTTP(w, r)
+		case PuzzlesServiceListPuzzleAttemptsProcedure:
+			puzzlesServiceListPuzzleAttemptsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPuzzlesServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPuzzlesServiceHandler struct{}
+
+func (UnimplementedPuzzlesServiceHandler) CreatePuzzle(context.Context, *connect.Request[models.CreatePuzzleRequest]) (*connect.Response[models.CreatePuzzleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.PuzzlesService.CreatePuzzle is not implemented"))
+}
+
+func (UnimplementedPuzzlesServiceHandler) ListPuzzles(context.Context, *connect.Request[models.ListPuzzlesRequest]) (*connect.Response[models.ListPuzzlesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.PuzzlesService.ListPuzzles is not implemented"))
+}
+
+func (UnimplementedPuzzlesServiceHandler) GetPuzzle(context.Context, *connect.Request[models.GetPuzzleRequest]) (*connect.Response[models.GetPuzzleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.PuzzlesService.GetPuzzle is not implemented"))
+}
+
+func (UnimplementedPuzzlesServiceHandler) UpdatePuzzle(context.Context, *connect.Request[models.UpdatePuzzleRequest]) (*connect.Response[models.UpdatePuzzleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.PuzzlesService.UpdatePuzzle is not implemented"))
+}
+
+func (UnimplementedPuzzlesServiceHandler) DeletePuzzle(context.Context, *connect.Request[models.DeletePuzzleRequest]) (*connect.Response[models.DeletePuzzleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.PuzzlesService.DeletePuzzle is not implemented"))
+}
+
+func (UnimplementedPuzzlesServiceHandler) PlayPuzzle(context.Context, *connect.Request[models.PlayPuzzleRequest]) (*connect.Response[models.PlayPuzzleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.PuzzlesService.PlayPuzzle is not implemented"))
+}
+
+func (UnimplementedPuzzlesServiceHandler) ListPuzzleAttempts(context.Context, *connect.Request[models.ListPuzzleAttemptsRequest]) (*connect.Response[models.ListPuzzleAttemptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.PuzzlesService.ListPuzzleAttempts is not implemented"))
+}
@@ -41,6 +41,9 @@ const (
 	// GameSyncServiceBroadcastProcedure is the fully-qualified name of the GameSyncService's Broadcast
 	// RPC.
 	GameSyncServiceBroadcastProcedure = "/lilbattle.v1.GameSyncService/Broadcast"
+	// GameSyncServiceSendPingProcedure is the fully-qualified name of the GameSyncService's SendPing
+	// RPC.
+	GameSyncServiceSendPingProcedure = "/lilbattle.v1.GameSyncService/SendPing"
 )
 
 // GameSyncServiceClient is a client for the lilbattle.v1.GameSyncService service.
@@ -54,6 +57,10 @@ type GameSyncServiceClient interface {
 	// Called internally by GamesService after ProcessMoves succeeds.
 	// Not intended for direct client use.
 	Broadcast(context.Context, *connect.Request[models.BroadcastRequest]) (*connect.Response[models.BroadcastResponse], error)
+	// SendPing marks a hex with an alert for the sender's teammates. Pings are
+	// only delivered to subscribers on the same team and are rate limited per
+	// player.
+	SendPing(context.Context, *connect.Request[models.SendPingRequest]) (*connect.Response[models.SendPingResponse], error)
 }
 
 // NewGameSyncServiceClient constructs a client for the lilbattle.v1.GameSyncService service. By
@@ -79,6 +86,12 @@ func NewGameSyncServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(gameSyncServiceMethods.ByName("Broadcast")),
 			connect.WithClientOptions(opts...),
 		),
+		sendPing: connect.NewClient[models.SendPingRequest, models.SendPingResponse](
+			httpClient,
+			baseURL+GameSyncServiceSendPingProcedure,
+			connect.WithSchema(gameSyncServiceMethods.ByName("SendPing")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -86,6 +99,7 @@ func NewGameSyncServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 type gameSyncServiceClient struct {
 	subscribe *connect.Client[models.SubscribeRequest, models.GameUpdate]
 	broadcast *connect.Client[models.BroadcastRequest, models.BroadcastResponse]
+	sendPing  *connect.Client[models.SendPingRequest, models.SendPingResponse]
 }
 
 // Subscribe calls lilbattle.v1.GameSyncService.Subscribe.
@@ -98,6 +112,11 @@ func (c *gameSyncServiceClient) Broadcast(ctx context.Context, req *connect.Requ
 	return c.broadcast.CallUnary(ctx, req)
 }
 
+// SendPing calls lilbattle.v1.GameSyncService.SendPing.
+func (c *gameSyncServiceClient) SendPing(ctx context.Context, req *connect.Request[models.SendPingRequest]) (*connect.Response[models.SendPingResponse], error) {
+	return c.sendPing.CallUnary(ctx, req)
+}
+
 // GameSyncServiceHandler is an implementation of the lilbattle.v1.GameSyncService service.
 type GameSyncServiceHandler interface {
 	// Subscribe to game changes. Server streams GameUpdate messages to clients
@@ -109,6 +128,10 @@ type GameSyncServiceHandler interface {
 	// Called internally by GamesService after ProcessMoves succeeds.
 	// Not intended for direct client use.
 	Broadcast(context.Context, *connect.Request[models.BroadcastRequest]) (*connect.Response[models.BroadcastResponse], error)
+	// SendPing marks a hex with an alert for the sender's teammates. Pings are
+	// only delivered to subscribers on the same team and are rate limited per
+	// player.
+	SendPing(context.Context, *connect.Request[models.SendPingRequest]) (*connect.Response[models.SendPingResponse], error)
 }
 
 // NewGameSyncServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -130,12 +153,20 @@ func NewGameSyncServiceHandler(svc GameSyncServiceHandler, opts ...connect.Handl
 		connect.WithSchema(gameSyncServiceMethods.ByName("Broadcast")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameSyncServiceSendPingHandler := connect.NewUnaryHandler(
+		GameSyncServiceSendPingProcedure,
+		svc.SendPing,
+		connect.WithSchema(gameSyncServiceMethods.ByName("SendPing")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lilbattle.v1.GameSyncService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GameSyncServiceSubscribeProcedure:
 			gameSyncServiceSubscribeHandler.ServeHTTP(w, r)
 		case GameSyncServiceBroadcastProcedure:
 			gameSyncServiceBroadcastHandler.ServeHTTP(w, r)
+		case GameSyncServiceSendPingProcedure:
+			gameSyncServiceSendPingHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -152,3 +183,7 @@ func (UnimplementedGameSyncServiceHandler) Subscribe(context.Context, *connect.R
 func (UnimplementedGameSyncServiceHandler) Broadcast(context.Context, *connect.Request[models.BroadcastRequest]) (*connect.Response[models.BroadcastResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameSyncService.Broadcast is not implemented"))
 }
+
+func (UnimplementedGameSyncServiceHandler) SendPing(context.Context, *connect.Request[models.SendPingRequest]) (*connect.Response[models.SendPingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GameSyncService.SendPing is not implemented"))
+}
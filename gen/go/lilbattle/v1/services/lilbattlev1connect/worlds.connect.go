@@ -51,6 +51,15 @@ const (
 	// WorldsServiceUpdateWorldProcedure is the fully-qualified name of the WorldsService's UpdateWorld
 	// RPC.
 	WorldsServiceUpdateWorldProcedure = "/lilbattle.v1.WorldsService/UpdateWorld"
+	// WorldsServiceSetWorldRulesOverridesProcedure is the fully-qualified name of the WorldsService's
+	// SetWorldRulesOverrides RPC.
+	WorldsServiceSetWorldRulesOverridesProcedure = "/lilbattle.v1.WorldsService/SetWorldRulesOverrides"
+	// WorldsServiceApplyWorldEditsProcedure is the fully-qualified name of the WorldsService's
+	// ApplyWorldEdits RPC.
+	WorldsServiceApplyWorldEditsProcedure = "/lilbattle.v1.WorldsService/ApplyWorldEdits"
+	// WorldsServiceGetWorldTilesProcedure is the fully-qualified name of the WorldsService's
+	// GetWorldTiles RPC.
+	WorldsServiceGetWorldTilesProcedure = "/lilbattle.v1.WorldsService/GetWorldTiles"
 )
 
 // WorldsServiceClient is a client for the lilbattle.v1.WorldsService service.
@@ -70,6 +79,18 @@ type WorldsServiceClient interface {
 	DeleteWorld(context.Context, *connect.Request[models.DeleteWorldRequest]) (*connect.Response[models.DeleteWorldResponse], error)
 	// GetWorld returns a specific world with metadata
 	UpdateWorld(context.Context, *connect.Request[models.UpdateWorldRequest]) (*connect.Response[models.UpdateWorldResponse], error)
+	// *
+	// Replace a world's rules overrides (terrain movement costs and income).
+	// Overrides are validated against the rules and applied to games created
+	// from the world afterwards.
+	SetWorldRulesOverrides(context.Context, *connect.Request[models.SetWorldRulesOverridesRequest]) (*connect.Response[models.SetWorldRulesOverridesResponse], error)
+	// *
+	// Apply a batch of map edits (paint terrain, set owner, place or remove
+	// units) to a world.  Edits are validated and applied atomically, and the
+	// world data version is bumped once per batch.
+	ApplyWorldEdits(context.Context, *connect.Request[models.ApplyWorldEditsRequest]) (*connect.Response[models.ApplyWorldEditsResponse], error)
+	// GetWorldTiles returns the tiles and units of a world, optionally within a region
+	GetWorldTiles(context.Context, *connect.Request[models.GetWorldTilesRequest]) (*connect.Response[models.GetWorldTilesResponse], error)
 }
 
 // NewWorldsServiceClient constructs a client for the lilbattle.v1.WorldsService service. By
@@ -119,17 +140,38 @@ func NewWorldsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(worldsServiceMethods.ByName("UpdateWorld")),
 			connect.WithClientOptions(opts...),
 		),
+		setWorldRulesOverrides: connect.NewClient[models.SetWorldRulesOverridesRequest, models.SetWorldRulesOverridesResponse](
+			httpClient,
+			baseURL+WorldsServiceSetWorldRulesOverridesProcedure,
+			connect.WithSchema(worldsServiceMethods.ByName("SetWorldRulesOverrides")),
+			connect.WithClientOptions(opts...),
+		),
+		applyWorldEdits: connect.NewClient[models.ApplyWorldEditsRequest, models.ApplyWorldEditsResponse](
+			httpClient,
+			baseURL+WorldsServiceApplyWorldEditsProcedure,
+			connect.WithSchema(worldsServiceMethods.ByName("ApplyWorldEdits")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorldTiles: connect.NewClient[models.GetWorldTilesRequest, models.GetWorldTilesResponse](
+			httpClient,
+			baseURL+WorldsServiceGetWorldTilesProcedure,
+			connect.WithSchema(worldsServiceMethods.ByName("GetWorldTiles")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // worldsServiceClient implements WorldsServiceClient.
 type worldsServiceClient struct {
-	createWorld *connect.Client[models.CreateWorldRequest, models.CreateWorldResponse]
-	getWorlds   *connect.Client[models.GetWorldsRequest, models.GetWorldsResponse]
-	listWorlds  *connect.Client[models.ListWorldsRequest, models.ListWorldsResponse]
-	getWorld    *connect.Client[models.GetWorldRequest, models.GetWorldResponse]
-	deleteWorld *connect.Client[models.DeleteWorldRequest, models.DeleteWorldResponse]
-	updateWorld *connect.Client[models.UpdateWorldRequest, models.UpdateWorldResponse]
+	createWorld            *connect.Client[models.CreateWorldRequest, models.CreateWorldResponse]
+	getWorlds              *connect.Client[models.GetWorldsRequest, models.GetWorldsResponse]
+	listWorlds             *connect.Client[models.ListWorldsRequest, models.ListWorldsResponse]
+	getWorld               *connect.Client[models.GetWorldRequest, models.GetWorldResponse]
+	deleteWorld            *connect.Client[models.DeleteWorldRequest, models.DeleteWorldResponse]
+	updateWorld            *connect.Client[models.UpdateWorldRequest, models.UpdateWorldResponse]
+	setWorldRulesOverrides *connect.Client[models.SetWorldRulesOverridesRequest, models.SetWorldRulesOverridesResponse]
+	applyWorldEdits        *connect.Client[models.ApplyWorldEditsRequest, models.ApplyWorldEditsResponse]
+	getWorldTiles          *connect.Client[models.GetWorldTilesRequest, models.GetWorldTilesResponse]
 }
 
 // CreateWorld calls lilbattle.v1.WorldsService.CreateWorld.
@@ -162,6 +204,21 @@ func (c *worldsServiceClient) UpdateWorld(ctx context.Context, req *connect.Requ
 	return c.updateWorld.CallUnary(ctx, req)
 }
 
+// SetWorldRulesOverrides calls lilbattle.v1.WorldsService.SetWorldRulesOverrides.
+func (c *worldsServiceClient) SetWorldRulesOverrides(ctx context.Context, req *connect.Request[models.SetWorldRulesOverridesRequest]) (*connect.Response[models.SetWorldRulesOverridesResponse], error) {
+	return c.setWorldRulesOverrides.CallUnary(ctx, req)
+}
+
+// ApplyWorldEdits calls lilbattle.v1.WorldsService.ApplyWorldEdits.
+func (c *worldsServiceClient) ApplyWorldEdits(ctx context.Context, req *connect.Request[models.ApplyWorldEditsRequest]) (*connect.Response[models.ApplyWorldEditsResponse], error) {
+	return c.applyWorldEdits.CallUnary(ctx, req)
+}
+
+// GetWorldTiles calls lilbattle.v1.WorldsService.GetWorldTiles.
+func (c *worldsServiceClient) GetWorldTiles(ctx context.Context, req *connect.Request[models.GetWorldTilesRequest]) (*connect.Response[models.GetWorldTilesResponse], error) {
+	return c.getWorldTiles.CallUnary(ctx, req)
+}
+
 // WorldsServiceHandler is an implementation of the lilbattle.v1.WorldsService service.
 type WorldsServiceHandler interface {
 	// *
@@ -179,6 +236,18 @@ type WorldsServiceHandler interface {
 	DeleteWorld(context.Context, *connect.Request[models.DeleteWorldRequest]) (*connect.Response[models.DeleteWorldResponse], error)
 	// GetWorld returns a specific world with metadata
 	UpdateWorld(context.Context, *connect.Request[models.UpdateWorldRequest]) (*connect.Response[models.UpdateWorldResponse], error)
+	// *
+	// Replace a world's rules overrides (terrain movement costs and income).
+	// Overrides are validated against the rules and applied to games created
+	// from the world afterwards.
+	SetWorldRulesOverrides(context.Context, *connect.Request[models.SetWorldRulesOverridesRequest]) (*connect.Response[models.SetWorldRulesOverridesResponse], error)
+	// *
+	// Apply a batch of map edits (paint terrain, set owner, place or remove
+	// units) to a world.  Edits are validated and applied atomically, and the
+	// world data version is bumped once per batch.
+	ApplyWorldEdits(context.Context, *connect.Request[models.ApplyWorldEditsRequest]) (*connect.Response[models.ApplyWorldEditsResponse], error)
+	// GetWorldTiles returns the tiles and units of a world, optionally within a region
+	GetWorldTiles(context.Context, *connect.Request[models.GetWorldTilesRequest]) (*connect.Response[models.GetWorldTilesResponse], error)
 }
 
 // NewWorldsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -224,6 +293,24 @@ func NewWorldsServiceHandler(svc WorldsServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(worldsServiceMethods.ByName("UpdateWorld")),
 		connect.WithHandlerOptions(opts...),
 	)
+	worldsServiceSetWorldRulesOverridesHandler := connect.NewUnaryHandler(
+		WorldsServiceSetWorldRulesOverridesProcedure,
+		svc.SetWorldRulesOverrides,
+		connect.WithSchema(worldsServiceMethods.ByName("SetWorldRulesOverrides")),
+		connect.WithHandlerOptions(opts...),
+	)
+	worldsServiceApplyWorldEditsHandler := connect.NewUnaryHandler(
+		WorldsServiceApplyWorldEditsProcedure,
+		svc.ApplyWorldEdits,
+		connect.WithSchema(worldsServiceMethods.ByName("ApplyWorldEdits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	worldsServiceGetWorldTilesHandler := connect.NewUnaryHandler(
+		WorldsServiceGetWorldTilesProcedure,
+		svc.GetWorldTiles,
+		connect.WithSchema(worldsServiceMethods.ByName("GetWorldTiles")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lilbattle.v1.WorldsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WorldsServiceCreateWorldProcedure:
@@ -238,6 +325,12 @@ func NewWorldsServiceHandler(svc WorldsServiceHandler, opts ...connect.HandlerOp
 			worldsServiceDeleteWorldHandler.ServeHTTP(w, r)
 		case WorldsServiceUpdateWorldProcedure:
 			worldsServiceUpdateWorldHandler.ServeHTTP(w, r)
+		case WorldsServiceSetWorldRulesOverridesProcedure:
+			worldsServiceSetWorldRulesOverridesHandler.ServeHTTP(w, r)
+		case WorldsServiceApplyWorldEditsProcedure:
+			worldsServiceApplyWorldEditsHandler.ServeHTTP(w, r)
+		case WorldsServiceGetWorldTilesProcedure:
+			worldsServiceGetWorldTilesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -270,3 +363,15 @@ func (UnimplementedWorldsServiceHandler) DeleteWorld(context.Context, *connect.R
 func (UnimplementedWorldsServiceHandler) UpdateWorld(context.Context, *connect.Request[models.UpdateWorldRequest]) (*connect.Response[models.UpdateWorldResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.WorldsService.UpdateWorld is not implemented"))
 }
+
+func (UnimplementedWorldsServiceHandler) SetWorldRulesOverrides(context.Context, *connect.Request[models.SetWorldRulesOverridesRequest]) (*connect.Response[models.SetWorldRulesOverridesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.WorldsService.SetWorldRulesOverrides is not implemented"))
+}
+
+func (UnimplementedWorldsServiceHandler) ApplyWorldEdits(context.Context, *connect.Request[models.ApplyWorldEditsRequest]) (*connect.Response[models.ApplyWorldEditsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.WorldsService.ApplyWorldEdits is not implemented"))
+}
+
+func (UnimplementedWorldsServiceHandler) GetWorldTiles(context.Context, *connect.Request[models.GetWorldTilesRequest]) (*connect.Response[models.GetWorldTilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.WorldsService.GetWorldTiles is not implemented"))
+}
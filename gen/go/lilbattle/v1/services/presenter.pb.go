@@ -32,7 +32,7 @@ const file_lilbattle_v1_services_presenter_proto_rawDesc = "" +
 	"\n" +
 	"%lilbattle/v1/services/presenter.proto\x12\flilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a#lilbattle/v1/models/presenter.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bwasmjs/v1/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x8b\x01\n" +
 	"\x1bSingletonInitializerService\x12l\n" +
-	"\x13InitializeSingleton\x12(.lilbattle.v1.InitializeSingletonRequest\x1a).lilbattle.v1.InitializeSingletonResponse\"\x002\xba\b\n" +
+	"\x13InitializeSingleton\x12(.lilbattle.v1.InitializeSingletonRequest\x1a).lilbattle.v1.InitializeSingletonResponse\"\x002\x92\x0e\n" +
 	"\x11GameViewPresenter\x12]\n" +
 	"\x0eInitializeGame\x12#.lilbattle.v1.InitializeGameRequest\x1a$.lilbattle.v1.InitializeGameResponse\"\x00\x12X\n" +
 	"\vClientReady\x12 .lilbattle.v1.ClientReadyRequest\x1a!.lilbattle.v1.ClientReadyResponse\"\x04е\x18\x01\x12\x98\x01\n" +
@@ -40,7 +40,12 @@ const file_lilbattle_v1_services_presenter_proto_rawDesc = "" +
 	"\x11TurnOptionClicked\x12&.lilbattle.v1.TurnOptionClickedRequest\x1a'.lilbattle.v1.TurnOptionClickedResponse\"F\x82\xd3\xe4\x93\x02@:\x01*\";/v1/presenters/gameview/action:clicked:turnOption/{game_id}\x12\xb8\x01\n" +
 	"\x14EndTurnButtonClicked\x12).lilbattle.v1.EndTurnButtonClickedRequest\x1a*.lilbattle.v1.EndTurnButtonClickedResponse\"I\x82\xd3\xe4\x93\x02C:\x01*\">/v1/presenters/gameview/action:clicked:endTurnButton/{game_id}\x12\xb0\x01\n" +
 	"\x12BuildOptionClicked\x12'.lilbattle.v1.BuildOptionClickedRequest\x1a(.lilbattle.v1.BuildOptionClickedResponse\"G\x82\xd3\xe4\x93\x02A:\x01*\"</v1/presenters/gameview/action:clicked:buildOption/{game_id}\x12\xb3\x01\n" +
-	"\x12ApplyRemoteChanges\x12'.lilbattle.v1.ApplyRemoteChangesRequest\x1a(.lilbattle.v1.ApplyRemoteChangesResponse\"Jе\x18\x01\x82\xd3\xe4\x93\x02@:\x01*\";/v1/presenters/gameview/action:applyRemoteChanges/{game_id}B\xbc\x01\n" +
+	"\x12ApplyRemoteChanges\x12'.lilbattle.v1.ApplyRemoteChangesRequest\x1a(.lilbattle.v1.ApplyRemoteChangesResponse\"Jе\x18\x01\x82\xd3\xe4\x93\x02@:\x01*\";/v1/presenters/gameview/action:applyRemoteChanges/{game_id}\x12\x8b\x01\n" +
+	"\bShowPing\x12\x1d.lilbattle.v1.ShowPingRequest\x1a\x1e.lilbattle.v1.ShowPingResponse\"@е\x18\x01\x82\xd3\xe4\x93\x026:\x01*\"1/v1/presenters/gameview/action:showPing/{game_id}\x12\x8b\x01\n" +
+	"\bSaveSlot\x12\x1d.lilbattle.v1.SaveSlotRequest\x1a\x1e.lilbattle.v1.SaveSlotResponse\"@е\x18\x01\x82\xd3\xe4\x93\x026:\x01*\"1/v1/presenters/gameview/action:saveSlot/{game_id}\x12\x8b\x01\n" +
+	"\bLoadSlot\x12\x1d.lilbattle.v1.LoadSlotRequest\x1a\x1e.lilbattle.v1.LoadSlotResponse\"@е\x18\x01\x82\xd3\xe4\x93\x026:\x01*\"1/v1/presenters/gameview/action:loadSlot/{game_id}\x12\x81\x01\n" +
+	"\tListSlots\x12\x1e.lilbattle.v1.ListSlotsRequest\x1a\x1f.lilbattle.v1.ListSlotsResponse\"3е\x18\x01\x82\xd3\xe4\x93\x02)\x12'/v1/presenters/gameview/slots/{game_id}\x12\xa7\x01\n" +
+	"\x0fExploreFromHere\x12$.lilbattle.v1.ExploreFromHereRequest\x1a%.lilbattle.v1.ExploreFromHereResponse\"Gе\x18\x01\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/presenters/gameview/action:exploreFromHere/{game_id}B\xbc\x01\n" +
 	"\x10com.lilbattle.v1B\x0ePresenterProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var file_lilbattle_v1_services_presenter_proto_goTypes = []any{
@@ -52,14 +57,24 @@ var file_lilbattle_v1_services_presenter_proto_goTypes = []any{
 	(*models.EndTurnButtonClickedRequest)(nil),  // 5: lilbattle.v1.EndTurnButtonClickedRequest
 	(*models.BuildOptionClickedRequest)(nil),    // 6: lilbattle.v1.BuildOptionClickedRequest
 	(*models.ApplyRemoteChangesRequest)(nil),    // 7: lilbattle.v1.ApplyRemoteChangesRequest
-	(*models.InitializeSingletonResponse)(nil),  // 8: lilbattle.v1.InitializeSingletonResponse
-	(*models.InitializeGameResponse)(nil),       // 9: lilbattle.v1.InitializeGameResponse
-	(*models.ClientReadyResponse)(nil),          // 10: lilbattle.v1.ClientReadyResponse
-	(*models.SceneClickedResponse)(nil),         // 11: lilbattle.v1.SceneClickedResponse
-	(*models.TurnOptionClickedResponse)(nil),    // 12: lilbattle.v1.TurnOptionClickedResponse
-	(*models.EndTurnButtonClickedResponse)(nil), // 13: lilbattle.v1.EndTurnButtonClickedResponse
-	(*models.BuildOptionClickedResponse)(nil),   // 14: lilbattle.v1.BuildOptionClickedResponse
-	(*models.ApplyRemoteChangesResponse)(nil),   // 15: lilbattle.v1.ApplyRemoteChangesResponse
+	(*models.ShowPingRequest)(nil),              // 8: lilbattle.v1.ShowPingRequest
+	(*models.SaveSlotRequest)(nil),              // 9: lilbattle.v1.SaveSlotRequest
+	(*models.LoadSlotRequest)(nil),              // 10: lilbattle.v1.LoadSlotRequest
+	(*models.ListSlotsRequest)(nil),             // 11: lilbattle.v1.ListSlotsRequest
+	(*models.ExploreFromHereRequest)(nil),       // 12: lilbattle.v1.ExploreFromHereRequest
+	(*models.InitializeSingletonResponse)(nil),  // 13: lilbattle.v1.InitializeSingletonResponse
+	(*models.InitializeGameResponse)(nil),       // 14: lilbattle.v1.InitializeGameResponse
+	(*models.ClientReadyResponse)(nil),          // 15: lilbattle.v1.ClientReadyResponse
+	(*models.SceneClickedResponse)(nil),         // 16: lilbattle.v1.SceneClickedResponse
+	(*models.TurnOptionClickedResponse)(nil),    // 17: lilbattle.v1.TurnOptionClickedResponse
+	(*models.EndTurnButtonClickedResponse)(nil), // 18: lilbattle.v1.EndTurnButtonClickedResponse
+	(*models.BuildOptionClickedResponse)(nil),   // 19: lilbattle.v1.BuildOptionClickedResponse
+	(*models.ApplyRemoteChangesResponse)(nil),   // 20: lilbattle.v1.ApplyRemoteChangesResponse
+	(*models.ShowPingResponse)(nil),             // 21: lilbattle.v1.ShowPingResponse
+	(*models.SaveSlotResponse)(nil),             // 22: lilbattle.v1.SaveSlotResponse
+	(*models.LoadSlotResponse)(nil),             // 23: lilbattle.v1.LoadSlotResponse
+	(*models.ListSlotsResponse)(nil),            // 24: lilbattle.v1.ListSlotsResponse
+	(*models.ExploreFromHereResponse)(nil),      // 25: lilbattle.v1.ExploreFromHereResponse
 }
 var file_lilbattle_v1_services_presenter_proto_depIdxs = []int32{
 	0,  // 0: lilbattle.v1.SingletonInitializerService.InitializeSingleton:input_type -> lilbattle.v1.InitializeSingletonRequest
@@ -70,16 +85,26 @@ var file_lilbattle_v1_services_presenter_proto_depIdxs = []int32{
 	5,  // 5: lilbattle.v1.GameViewPresenter.EndTurnButtonClicked:input_type -> lilbattle.v1.EndTurnButtonClickedRequest
 	6,  // 6: lilbattle.v1.GameViewPresenter.BuildOptionClicked:input_type -> lilbattle.v1.BuildOptionClickedRequest
 	7,  // 7: lilbattle.v1.GameViewPresenter.ApplyRemoteChanges:input_type -> lilbattle.v1.ApplyRemoteChangesRequest
-	8,  // 8: lilbattle.v1.SingletonInitializerService.InitializeSingleton:output_type -> lilbattle.v1.InitializeSingletonResponse
-	9,  // 9: lilbattle.v1.GameViewPresenter.InitializeGame:output_type -> lilbattle.v1.InitializeGameResponse
-	10, // 10: lilbattle.v1.GameViewPresenter.ClientReady:output_type -> lilbattle.v1.ClientReadyResponse
-	11, // 11: lilbattle.v1.GameViewPresenter.SceneClicked:output_type -> lilbattle.v1.SceneClickedResponse
-	12, // 12: lilbattle.v1.GameViewPresenter.TurnOptionClicked:output_type -> lilbattle.v1.TurnOptionClickedResponse
-	13, // 13: lilbattle.v1.GameViewPresenter.EndTurnButtonClicked:output_type -> lilbattle.v1.EndTurnButtonClickedResponse
-	14, // 14: lilbattle.v1.GameViewPresenter.BuildOptionClicked:output_type -> lilbattle.v1.BuildOptionClickedResponse
-	15, // 15: lilbattle.v1.GameViewPresenter.ApplyRemoteChanges:output_type -> lilbattle.v1.ApplyRemoteChangesResponse
-	8,  // [8:16] is the sub-list for method output_type
-	0,  // [0:8] is the sub-list for method input_type
+	8,  // 8: lilbattle.v1.GameViewPresenter.ShowPing:input_type -> lilbattle.v1.ShowPingRequest
+	9,  // 9: lilbattle.v1.GameViewPresenter.SaveSlot:input_type -> lilbattle.v1.SaveSlotRequest
+	10, // 10: lilbattle.v1.GameViewPresenter.LoadSlot:input_type -> lilbattle.v1.LoadSlotRequest
+	11, // 11: lilbattle.v1.GameViewPresenter.ListSlots:input_type -> lilbattle.v1.ListSlotsRequest
+	12, // 12: lilbattle.v1.GameViewPresenter.ExploreFromHere:input_type -> lilbattle.v1.ExploreFromHereRequest
+	13, // 13: lilbattle.v1.SingletonInitializerService.InitializeSingleton:output_type -> lilbattle.v1.InitializeSingletonResponse
+	14, // 14: lilbattle.v1.GameViewPresenter.InitializeGame:output_type -> lilbattle.v1.InitializeGameResponse
+	15, // 15: lilbattle.v1.GameViewPresenter.ClientReady:output_type -> lilbattle.v1.ClientReadyResponse
+	16, // 16: lilbattle.v1.GameViewPresenter.SceneClicked:output_type -> lilbattle.v1.SceneClickedResponse
+	17, // 17: lilbattle.v1.GameViewPresenter.TurnOptionClicked:output_type -> lilbattle.v1.TurnOptionClickedResponse
+	18, // 18: lilbattle.v1.GameViewPresenter.EndTurnButtonClicked:output_type -> lilbattle.v1.EndTurnButtonClickedResponse
+	19, // 19: lilbattle.v1.GameViewPresenter.BuildOptionClicked:output_type -> lilbattle.v1.BuildOptionClickedResponse
+	20, // 20: lilbattle.v1.GameViewPresenter.ApplyRemoteChanges:output_type -> lilbattle.v1.ApplyRemoteChangesResponse
+	21, // 21: lilbattle.v1.GameViewPresenter.ShowPing:output_type -> lilbattle.v1.ShowPingResponse
+	22, // 22: lilbattle.v1.GameViewPresenter.SaveSlot:output_type -> lilbattle.v1.SaveSlotResponse
+	23, // 23: lilbattle.v1.GameViewPresenter.LoadSlot:output_type -> lilbattle.v1.LoadSlotResponse
+	24, // 24: lilbattle.v1.GameViewPresenter.ListSlots:output_type -> lilbattle.v1.ListSlotsResponse
+	25, // 25: lilbattle.v1.GameViewPresenter.ExploreFromHere:output_type -> lilbattle.v1.ExploreFromHereResponse
+	13, // [13:26] is the sub-list for method output_type
+	0,  // [0:13] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -128,6 +128,11 @@ const (
 	GameViewPresenter_EndTurnButtonClicked_FullMethodName = "/lilbattle.v1.GameViewPresenter/EndTurnButtonClicked"
 	GameViewPresenter_BuildOptionClicked_FullMethodName   = "/lilbattle.v1.GameViewPresenter/BuildOptionClicked"
 	GameViewPresenter_ApplyRemoteChanges_FullMethodName   = "/lilbattle.v1.GameViewPresenter/ApplyRemoteChanges"
+	GameViewPresenter_ShowPing_FullMethodName             = "/lilbattle.v1.GameViewPresenter/ShowPing"
+	GameViewPresenter_SaveSlot_FullMethodName             = "/lilbattle.v1.GameViewPresenter/SaveSlot"
+	GameViewPresenter_LoadSlot_FullMethodName             = "/lilbattle.v1.GameViewPresenter/LoadSlot"
+	GameViewPresenter_ListSlots_FullMethodName            = "/lilbattle.v1.GameViewPresenter/ListSlots"
+	GameViewPresenter_ExploreFromHere_FullMethodName      = "/lilbattle.v1.GameViewPresenter/ExploreFromHere"
 )
 
 // GameViewPresenterClient is the client API for GameViewPresenter service.
@@ -176,6 +181,24 @@ type GameViewPresenterClient interface {
 	// This updates local game state and triggers UI updates for the received WorldChanges.
 	// Used by viewers to apply moves made by other players.
 	ApplyRemoteChanges(ctx context.Context, in *models.ApplyRemoteChangesRequest, opts ...grpc.CallOption) (*models.ApplyRemoteChangesResponse, error)
+	// *
+	// Show a teammate's ping (received via SyncService subscription) as a
+	// transient marker that expires on its own.
+	ShowPing(ctx context.Context, in *models.ShowPingRequest, opts ...grpc.CallOption) (*models.ShowPingResponse, error)
+	// *
+	// Saves the current game into a named save slot in the browser
+	SaveSlot(ctx context.Context, in *models.SaveSlotRequest, opts ...grpc.CallOption) (*models.SaveSlotResponse, error)
+	// *
+	// Replaces the current game with the one saved in a slot. Corrupted
+	// slots are rejected without touching the current game.
+	LoadSlot(ctx context.Context, in *models.LoadSlotRequest, opts ...grpc.CallOption) (*models.LoadSlotResponse, error)
+	// *
+	// Lists the save slots in the browser
+	ListSlots(ctx context.Context, in *models.ListSlotsRequest, opts ...grpc.CallOption) (*models.ListSlotsResponse, error)
+	// *
+	// Forks the game being replayed at the move being viewed, so other lines
+	// can be explored from there
+	ExploreFromHere(ctx context.Context, in *models.ExploreFromHereRequest, opts ...grpc.CallOption) (*models.ExploreFromHereResponse, error)
 }
 
 type gameViewPresenterClient struct {
@@ -256,6 +279,56 @@ func (c *gameViewPresenterClient) ApplyRemoteChanges(ctx context.Context, in *mo
 	return out, nil
 }
 
+func (c *gameViewPresenterClient) ShowPing(ctx context.Context, in *models.ShowPingRequest, opts ...grpc.CallOption) (*models.ShowPingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ShowPingResponse)
+	err := c.cc.Invoke(ctx, GameViewPresenter_ShowPing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameViewPresenterClient) SaveSlot(ctx context.Context, in *models.SaveSlotRequest, opts ...grpc.CallOption) (*models.SaveSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.SaveSlotResponse)
+	err := c.cc.Invoke(ctx, GameViewPresenter_SaveSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameViewPresenterClient) LoadSlot(ctx context.Context, in *models.LoadSlotRequest, opts ...grpc.CallOption) (*models.LoadSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.LoadSlotResponse)
+	err := c.cc.Invoke(ctx, GameViewPresenter_LoadSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameViewPresenterClient) ListSlots(ctx context.Context, in *models.ListSlotsRequest, opts ...grpc.CallOption) (*models.ListSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListSlotsResponse)
+	err := c.cc.Invoke(ctx, GameViewPresenter_ListSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameViewPresenterClient) ExploreFromHere(ctx context.Context, in *models.ExploreFromHereRequest, opts ...grpc.CallOption) (*models.ExploreFromHereResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ExploreFromHereResponse)
+	err := c.cc.Invoke(ctx, GameViewPresenter_ExploreFromHere_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GameViewPresenterServer is the server API for GameViewPresenter service.
 // All implementations should embed UnimplementedGameViewPresenterServer
 // for forward compatibility.
@@ -302,6 +375,24 @@ type GameViewPresenterServer interface {
 	// This updates local game state and triggers UI updates for the received WorldChanges.
 	// Used by viewers to apply moves made by other players.
 	ApplyRemoteChanges(context.Context, *models.ApplyRemoteChangesRequest) (*models.ApplyRemoteChangesResponse, error)
+	// *
+	// Show a teammate's ping (received via SyncService subscription) as a
+	// transient marker that expires on its own.
+	ShowPing(context.Context, *models.ShowPingRequest) (*models.ShowPingResponse, error)
+	// *
+	// Saves the current game into a named save slot in the browser
+	SaveSlot(context.Context, *models.SaveSlotRequest) (*models.SaveSlotResponse, error)
+	// *
+	// Replaces the current game with the one saved in a slot. Corrupted
+	// slots are rejected without touching the current game.
+	LoadSlot(context.Context, *models.LoadSlotRequest) (*models.LoadSlotResponse, error)
+	// *
+	// Lists the save slots in the browser
+	ListSlots(context.Context, *models.ListSlotsRequest) (*models.ListSlotsResponse, error)
+	// *
+	// Forks the game being replayed at the move being viewed, so other lines
+	// can be explored from there
+	ExploreFromHere(context.Context, *models.ExploreFromHereRequest) (*models.ExploreFromHereResponse, error)
 }
 
 // UnimplementedGameViewPresenterServer should be embedded to have
@@ -332,6 +423,21 @@ func (UnimplementedGameViewPresenterServer) BuildOptionClicked(context.Context,
 func (UnimplementedGameViewPresenterServer) ApplyRemoteChanges(context.Context, *models.ApplyRemoteChangesRequest) (*models.ApplyRemoteChangesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyRemoteChanges not implemented")
 }
+func (UnimplementedGameViewPresenterServer) ShowPing(context.Context, *models.ShowPingRequest) (*models.ShowPingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowPing not implemented")
+}
+func (UnimplementedGameViewPresenterServer) SaveSlot(context.Context, *models.SaveSlotRequest) (*models.SaveSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSlot not implemented")
+}
+func (UnimplementedGameViewPresenterServer) LoadSlot(context.Context, *models.LoadSlotRequest) (*models.LoadSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadSlot not implemented")
+}
+func (UnimplementedGameViewPresenterServer) ListSlots(context.Context, *models.ListSlotsRequest) (*models.ListSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlots not implemented")
+}
+func (UnimplementedGameViewPresenterServer) ExploreFromHere(context.Context, *models.ExploreFromHereRequest) (*models.ExploreFromHereResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExploreFromHere not implemented")
+}
 func (UnimplementedGameViewPresenterServer) testEmbeddedByValue() {}
 
 // UnsafeGameViewPresenterServer may be embedded to opt out of forward compatibility for this service.
@@ -478,6 +584,96 @@ func _GameViewPresenter_ApplyRemoteChanges_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameViewPresenter_ShowPing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ShowPingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameViewPresenterServer).ShowPing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameViewPresenter_ShowPing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameViewPresenterServer).ShowPing(ctx, req.(*models.ShowPingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameViewPresenter_SaveSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.SaveSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameViewPresenterServer).SaveSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameViewPresenter_SaveSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameViewPresenterServer).SaveSlot(ctx, req.(*models.SaveSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameViewPresenter_LoadSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.LoadSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameViewPresenterServer).LoadSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameViewPresenter_LoadSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameViewPresenterServer).LoadSlot(ctx, req.(*models.LoadSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameViewPresenter_ListSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameViewPresenterServer).ListSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameViewPresenter_ListSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameViewPresenterServer).ListSlots(ctx, req.(*models.ListSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameViewPresenter_ExploreFromHere_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ExploreFromHereRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameViewPresenterServer).ExploreFromHere(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameViewPresenter_ExploreFromHere_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameViewPresenterServer).ExploreFromHere(ctx, req.(*models.ExploreFromHereRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GameViewPresenter_ServiceDesc is the grpc.ServiceDesc for GameViewPresenter service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -513,6 +709,26 @@ var GameViewPresenter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyRemoteChanges",
 			Handler:    _GameViewPresenter_ApplyRemoteChanges_Handler,
 		},
+		{
+			MethodName: "ShowPing",
+			Handler:    _GameViewPresenter_ShowPing_Handler,
+		},
+		{
+			MethodName: "SaveSlot",
+			Handler:    _GameViewPresenter_SaveSlot_Handler,
+		},
+		{
+			MethodName: "LoadSlot",
+			Handler:    _GameViewPresenter_LoadSlot_Handler,
+		},
+		{
+			MethodName: "ListSlots",
+			Handler:    _GameViewPresenter_ListSlots_Handler,
+		},
+		{
+			MethodName: "ExploreFromHere",
+			Handler:    _GameViewPresenter_ExploreFromHere_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lilbattle/v1/services/presenter.proto",
@@ -0,0 +1,101 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: lilbattle/v1/services/puzzles.proto
+
+package lilbattlev1
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	models "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_lilbattle_v1_services_puzzles_proto protoreflect.FileDescriptor
+
+const file_lilbattle_v1_services_puzzles_proto_rawDesc = "" +
+	"\n" +
+	"#lilbattle/v1/services/puzzles.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a)lilbattle/v1/models/puzzles_service.proto2\xc1\x06\n" +
+	"\x0ePuzzlesService\x12m\n" +
+	"\fCreatePuzzle\x12!.lilbattle.v1.CreatePuzzleRequest\x1a\".lilbattle.v1.CreatePuzzleResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/puzzles\x12g\n" +
+	"\vListPuzzles\x12 .lilbattle.v1.ListPuzzlesRequest\x1a!.lilbattle.v1.ListPuzzlesResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/puzzles\x12f\n" +
+	"\tGetPuzzle\x12\x1e.lilbattle.v1.GetPuzzleRequest\x1a\x1f.lilbattle.v1.GetPuzzleResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/puzzles/{id}\x12{\n" +
+	"\fUpdatePuzzle\x12!.lilbattle.v1.UpdatePuzzleRequest\x1a\".lilbattle.v1.UpdatePuzzleResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/v1/puzzles/{puzzle.id=*}\x12q\n" +
+	"\fDeletePuzzle\x12!.lilbattle.v1.DeletePuzzleRequest\x1a\".lilbattle.v1.DeletePuzzleResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/puzzles/{id=*}\x12x\n" +
+	"\n" +
+	"PlayPuzzle\x12\x1f.lilbattle.v1.PlayPuzzleRequest\x1a .lilbattle.v1.PlayPuzzleResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/puzzles/{puzzle_id}/play\x12\x84\x01\n" +
+	"\x12ListPuzzleAttempts\x12'.lilbattle.v1.ListPuzzleAttemptsRequest\x1a(.lilbattle.v1.ListPuzzleAttemptsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/puzzle_attemptsB\xba\x01\n" +
+	"\x10com.lilbattle.v1B\fPuzzlesProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
+
+var file_lilbattle_v1_services_puzzles_proto_goTypes = []any{
+	(*models.CreatePuzzleRequest)(nil),        // 0: lilbattle.v1.CreatePuzzleRequest
+	(*models.ListPuzzlesRequest)(nil),         // 1: lilbattle.v1.ListPuzzlesRequest
+	(*models.GetPuzzleRequest)(nil),           // 2: lilbattle.v1.GetPuzzleRequest
+	(*models.UpdatePuzzleRequest)(nil),        // 3: lilbattle.v1.UpdatePuzzleRequest
+	(*models.DeletePuzzleRequest)(nil),        // 4: lilbattle.v1.DeletePuzzleRequest
+	(*models.PlayPuzzleRequest)(nil),          // 5: lilbattle.v1.PlayPuzzleRequest
+	(*models.ListPuzzleAttemptsRequest)(nil),  // 6: lilbattle.v1.ListPuzzleAttemptsRequest
+	(*models.CreatePuzzleResponse)(nil),       // 7: lilbattle.v1.CreatePuzzleResponse
+	(*models.ListPuzzlesResponse)(nil),        // 8: lilbattle.v1.ListPuzzlesResponse
+	(*models.GetPuzzleResponse)(nil),          // 9: lilbattle.v1.GetPuzzleResponse
+	(*models.UpdatePuzzleResponse)(nil),       // 10: lilbattle.v1.UpdatePuzzleResponse
+	(*models.DeletePuzzleResponse)(nil),       // 11: lilbattle.v1.DeletePuzzleResponse
+	(*models.PlayPuzzleResponse)(nil),         // 12: lilbattle.v1.PlayPuzzleResponse
+	(*models.ListPuzzleAttemptsResponse)(nil), // 13: lilbattle.v1.ListPuzzleAttemptsResponse
+}
+var file_lilbattle_v1_services_puzzles_proto_depIdxs = []int32{
+	0,  // 0: lilbattle.v1.PuzzlesService.CreatePuzzle:input_type -> lilbattle.v1.CreatePuzzleRequest
+	1,  // 1: lilbattle.v1.PuzzlesService.ListPuzzles:input_type -> lilbattle.v1.ListPuzzlesRequest
+	2,  // 2: lilbattle.v1.PuzzlesService.GetPuzzle:input_type -> lilbattle.v1.GetPuzzleRequest
+	3,  // 3: lilbattle.v1.PuzzlesService.UpdatePuzzle:input_type -> lilbattle.v1.UpdatePuzzleRequest
+	4,  // 4: lilbattle.v1.PuzzlesService.DeletePuzzle:input_type -> lilbattle.v1.DeletePuzzleRequest
+	5,  // 5: lilbattle.v1.PuzzlesService.PlayPuzzle:input_type -> lilbattle.v1.PlayPuzzleRequest
+	6,  // 6: lilbattle.v1.PuzzlesService.ListPuzzleAttempts:input_type -> lilbattle.v1.ListPuzzleAttemptsRequest
+	7,  // 7: lilbattle.v1.PuzzlesService.CreatePuzzle:output_type -> lilbattle.v1.CreatePuzzleResponse
+	8,  // 8: lilbattle.v1.PuzzlesService.ListPuzzles:output_type -> lilbattle.v1.ListPuzzlesResponse
+	9,  // 9: lilbattle.v1.PuzzlesService.GetPuzzle:output_type -> lilbattle.v1.GetPuzzleResponse
+	10, // 10: lilbattle.v1.PuzzlesService.UpdatePuzzle:output_type -> lilbattle.v1.UpdatePuzzleResponse
+	11, // 11: lilbattle.v1.PuzzlesService.DeletePuzzle:output_type -> lilbattle.v1.DeletePuzzleResponse
+	12, // 12: lilbattle.v1.PuzzlesService.PlayPuzzle:output_type -> lilbattle.v1.PlayPuzzleResponse
+	13, // 13: lilbattle.v1.PuzzlesService.ListPuzzleAttempts:output_type -> lilbattle.v1.ListPuzzleAttemptsResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_lilbattle_v1_services_puzzles_proto_init() }
+func file_lilbattle_v1_services_puzzles_proto_init() {
+	if File_lilbattle_v1_services_puzzles_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_services_puzzles_proto_rawDesc), len(file_lilbattle_v1_services_puzzles_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lilbattle_v1_services_puzzles_proto_goTypes,
+		DependencyIndexes: file_lilbattle_v1_services_puzzles_proto_depIdxs,
+	}.Build()
+	File_lilbattle_v1_services_puzzles_proto = out.File
+	file_lilbattle_v1_services_puzzles_proto_goTypes = nil
+	file_lilbattle_v1_services_puzzles_proto_depIdxs = nil
+}
@@ -0,0 +1,379 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: lilbattle/v1/services/puzzles.proto
+
+package lilbattlev1
+
+import (
+	context "context"
+
+	models "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PuzzlesService_CreatePuzzle_FullMethodName       = "/lilbattle.v1.PuzzlesService/CreatePuzzle"
+	PuzzlesService_ListPuzzles_FullMethodName        = "/lilbattle.v1.PuzzlesService/ListPuzzles"
+	PuzzlesService_GetPuzzle_FullMethodName          = "/lilbattle.v1.PuzzlesService/GetPuzzle"
+	PuzzlesService_UpdatePuzzle_FullMethodName       = "/lilbattle.v1.PuzzlesService/UpdatePuzzle"
+	PuzzlesService_DeletePuzzle_FullMethodName       = "/lilbattle.v1.PuzzlesService/DeletePuzzle"
+	PuzzlesService_PlayPuzzle_FullMethodName         = "/lilbattle.v1.PuzzlesService/PlayPuzzle"
+	PuzzlesService_ListPuzzleAttempts_FullMethodName = "/lilbattle.v1.PuzzlesService/ListPuzzleAttempts"
+)
+
+// PuzzlesServiceClient is the client API for PuzzlesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PuzzlesService manages curated puzzles and the attempts players make at them
+type PuzzlesServiceClient interface {
+	// *
+	// Create a new puzzle
+	CreatePuzzle(ctx context.Context, in *models.CreatePuzzleRequest, opts ...grpc.CallOption) (*models.CreatePuzzleResponse, error)
+	// ListPuzzles returns all puzzles
+	ListPuzzles(ctx context.Context, in *models.ListPuzzlesRequest, opts ...grpc.CallOption) (*models.ListPuzzlesResponse, error)
+	// GetPuzzle returns a specific puzzle
+	GetPuzzle(ctx context.Context, in *models.GetPuzzleRequest, opts ...grpc.CallOption) (*models.GetPuzzleResponse, error)
+	// *
+	// Update a puzzle. Only its creator can update it.
+	UpdatePuzzle(ctx context.Context, in *models.UpdatePuzzleRequest, opts ...grpc.CallOption) (*models.UpdatePuzzleResponse, error)
+	// *
+	// Delete a puzzle. Only its creator can delete it.
+	DeletePuzzle(ctx context.Context, in *models.DeletePuzzleRequest, opts ...grpc.CallOption) (*models.DeletePuzzleResponse, error)
+	// *
+	// Start a new attempt at a puzzle: a game from the puzzle's position with
+	// the caller as the solver
+	PlayPuzzle(ctx context.Context, in *models.PlayPuzzleRequest, opts ...grpc.CallOption) (*models.PlayPuzzleResponse, error)
+	// *
+	// List the caller's puzzle attempts and how they went
+	ListPuzzleAttempts(ctx context.Context, in *models.ListPuzzleAttemptsRequest, opts ...grpc.CallOption) (*models.ListPuzzleAttemptsResponse, error)
+}
+
+type puzzlesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPuzzlesServiceClient(cc grpc.ClientConnInterface) PuzzlesServiceClient {
+	return &puzzlesServiceClient{cc}
+}
+
+func (c *puzzlesServiceClient) CreatePuzzle(ctx context.Context, in *models.CreatePuzzleRequest, opts ...grpc.CallOption) (*models.CreatePuzzleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.CreatePuzzleResponse)
+	err := c.cc.Invoke(ctx, PuzzlesService_CreatePuzzle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *puzzlesServiceClient) ListPuzzles(ctx context.Context, in *models.ListPuzzlesRequest, opts ...grpc.CallOption) (*models.ListPuzzlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListPuzzlesResponse)
+	err := c.cc.Invoke(ctx, PuzzlesService_ListPuzzles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *puzzlesServiceClient) GetPuzzle(ctx context.Context, in *models.GetPuzzleRequest, opts ...grpc.CallOption) (*models.GetPuzzleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.GetPuzzleResponse)
+	err := c.cc.Invoke(ctx, PuzzlesService_GetPuzzle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *puzzlesServiceClient) UpdatePuzzle(ctx context.Context, in *models.UpdatePuzzleRequest, opts ...grpc.CallOption) (*models.UpdatePuzzleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.UpdatePuzzleResponse)
+	err := c.cc.Invoke(ctx, PuzzlesService_UpdatePuzzle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *puzzlesServiceClient) DeletePuzzle(ctx context.Context, in *models.DeletePuzzleRequest, opts ...grpc.CallOption) (*models.DeletePuzzleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.DeletePuzzleResponse)
+	err := c.cc.Invoke(ctx, PuzzlesService_DeletePuzzle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *puzzlesServiceClient) PlayPuzzle(ctx context.Context, in *models.PlayPuzzleRequest, opts ...grpc.CallOption) (*models.PlayPuzzleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.PlayPuzzleResponse)
+	err := c.cc.Invoke(ctx, PuzzlesService_PlayPuzzle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *puzzlesServiceClient) ListPuzzleAttempts(ctx context.Context, in *models.ListPuzzleAttemptsRequest, opts ...grpc.CallOption) (*models.ListPuzzleAttemptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListPuzzleAttemptsResponse)
+	err := c.cc.Invoke(ctx, PuzzlesService_ListPuzzleAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PuzzlesServiceServer is the server API for PuzzlesService service.
+// All implementations should embed UnimplementedPuzzlesServiceServer
+// for forward compatibility.
+//
+// PuzzlesService manages curated puzzles and the attempts players make at them
+type PuzzlesServiceServer interface {
+	// *
+	// Create a new puzzle
+	CreatePuzzle(context.Context, *models.CreatePuzzleRequest) (*models.CreatePuzzleResponse, error)
+	// ListPuzzles returns all puzzles
+	ListPuzzles(context.Context, *models.ListPuzzlesRequest) (*models.ListPuzzlesResponse, error)
+	// GetPuzzle returns a specific puzzle
+	GetPuzzle(context.Context, *models.GetPuzzleRequest) (*models.GetPuzzleResponse, error)
+	// *
+	// Update a puzzle. Only its creator can update it.
+	UpdatePuzzle(context.Context, *models.UpdatePuzzleRequest) (*models.UpdatePuzzleResponse, error)
+	// *
+	// Delete a puzzle. Only its creator can delete it.
+	DeletePuzzle(context.Context, *models.DeletePuzzleRequest) (*models.DeletePuzzleResponse, error)
+	// *
+	// Start a new attempt at a puzzle: a game from the puzzle's position with
+	// the caller as the solver
+	PlayPuzzle(context.Context, *models.PlayPuzzleRequest) (*models.PlayPuzzleResponse, error)
+	// *
+	// List the caller's puzzle attempts and how they went
+	ListPuzzleAttempts(context.Context, *models.ListPuzzleAttemptsRequest) (*models.ListPuzzleAttemptsResponse, error)
+}
+
+// UnimplementedPuzzlesServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPuzzlesServiceServer struct{}
+
+func (UnimplementedPuzzlesServiceServer) CreatePuzzle(context.Context, *models.CreatePuzzleRequest) (*models.CreatePuzzleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePuzzle not implemented")
+}
+func (UnimplementedPuzzlesServiceServer) ListPuzzles(context.Context, *models.ListPuzzlesRequest) (*models.ListPuzzlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPuzzles not implemented")
+}
+func (UnimplementedPuzzlesServiceServer) GetPuzzle(context.Context, *models.GetPuzzleRequest) (*models.GetPuzzleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPuzzle not implemented")
+}
+func (UnimplementedPuzzlesServiceServer) UpdatePuzzle(context.Context, *models.UpdatePuzzleRequest) (*models.UpdatePuzzleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePuzzle not implemented")
+}
+func (UnimplementedPuzzlesServiceServer) DeletePuzzle(context.Context, *models.DeletePuzzleRequest) (*models.DeletePuzzleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePuzzle not implemented")
+}
+func (UnimplementedPuzzlesServiceServer) PlayPuzzle(context.Context, *models.PlayPuzzleRequest) (*models.PlayPuzzleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayPuzzle not implemented")
+}
+func (UnimplementedPuzzlesServiceServer) ListPuzzleAttempts(context.Context, *models.ListPuzzleAttemptsRequest) (*models.ListPuzzleAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPuzzleAttempts not implemented")
+}
+func (UnimplementedPuzzlesServiceServer) testEmbeddedByValue() {}
+
+// UnsafePuzzlesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PuzzlesServiceServer will
+// result in compilation errors.
+type UnsafePuzzlesServiceServer interface {
+	mustEmbedUnimplementedPuzzlesServiceServer()
+}
+
+func RegisterPuzzlesServiceServer(s grpc.ServiceRegistrar, srv PuzzlesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPuzzlesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PuzzlesService_ServiceDesc, srv)
+}
+
+func _PuzzlesService_CreatePuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.CreatePuzzleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuzzlesServiceServer).CreatePuzzle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PuzzlesService_CreatePuzzle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuzzlesServiceServer).CreatePuzzle(ctx, req.(*models.CreatePuzzleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PuzzlesService_ListPuzzles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListPuzzlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuzzlesServiceServer).ListPuzzles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PuzzlesService_ListPuzzles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuzzlesServiceServer).ListPuzzles(ctx, req.(*models.ListPuzzlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PuzzlesService_GetPuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetPuzzleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuzzlesServiceServer).GetPuzzle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PuzzlesService_GetPuzzle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuzzlesServiceServer).GetPuzzle(ctx, req.(*models.GetPuzzleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PuzzlesService_UpdatePuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.UpdatePuzzleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuzzlesServiceServer).UpdatePuzzle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PuzzlesService_UpdatePuzzle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuzzlesServiceServer).UpdatePuzzle(ctx, req.(*models.UpdatePuzzleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PuzzlesService_DeletePuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.DeletePuzzleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuzzlesServiceServer).DeletePuzzle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PuzzlesService_DeletePuzzle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuzzlesServiceServer).DeletePuzzle(ctx, req.(*models.DeletePuzzleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PuzzlesService_PlayPuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.PlayPuzzleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuzzlesServiceServer).PlayPuzzle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PuzzlesService_PlayPuzzle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuzzlesServiceServer).PlayPuzzle(ctx, req.(*models.PlayPuzzleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PuzzlesService_ListPuzzleAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListPuzzleAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuzzlesServiceServer).ListPuzzleAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PuzzlesService_ListPuzzleAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuzzlesServiceServer).ListPuzzleAttempts(ctx, req.(*models.ListPuzzleAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PuzzlesService_ServiceDesc is the grpc.ServiceDesc for PuzzlesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PuzzlesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lilbattle.v1.PuzzlesService",
+	HandlerType: (*PuzzlesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePuzzle",
+			Handler:    _PuzzlesService_CreatePuzzle_Handler,
+		},
+		{
+			MethodName: "ListPuzzles",
+			Handler:    _PuzzlesService_ListPuzzles_Handler,
+		},
+		{
+			MethodName: "GetPuzzle",
+			Handler:    _PuzzlesService_GetPuzzle_Handler,
+		},
+		{
+			MethodName: "UpdatePuzzle",
+			Handler:    _PuzzlesService_UpdatePuzzle_Handler,
+		},
+		{
+			MethodName: "DeletePuzzle",
+			Handler:    _PuzzlesService_DeletePuzzle_Handler,
+		},
+		{
+			MethodName: "PlayPuzzle",
+			Handler:    _PuzzlesService_PlayPuzzle_Handler,
+		},
+		{
+			MethodName: "ListPuzzleAttempts",
+			Handler:    _PuzzlesService_ListPuzzleAttempts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lilbattle/v1/services/puzzles.proto",
+}
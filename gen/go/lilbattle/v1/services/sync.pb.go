@@ -27,25 +27,30 @@ var File_lilbattle_v1_services_sync_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_services_sync_proto_rawDesc = "" +
 	"\n" +
-	" lilbattle/v1/services/sync.proto\x12\flilbattle.v1\x1a\x1elilbattle/v1/models/sync.proto\x1a\x1cgoogle/api/annotations.proto2\xd7\x01\n" +
+	" lilbattle/v1/services/sync.proto\x12\flilbattle.v1\x1a\x1elilbattle/v1/models/sync.proto\x1a\x1cgoogle/api/annotations.proto2\xcc\x02\n" +
 	"\x0fGameSyncService\x12G\n" +
 	"\tSubscribe\x12\x1e.lilbattle.v1.SubscribeRequest\x1a\x18.lilbattle.v1.GameUpdate0\x01\x12{\n" +
-	"\tBroadcast\x12\x1e.lilbattle.v1.BroadcastRequest\x1a\x1f.lilbattle.v1.BroadcastResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/sync/games/{game_id}/broadcastB\xb7\x01\n" +
+	"\tBroadcast\x12\x1e.lilbattle.v1.BroadcastRequest\x1a\x1f.lilbattle.v1.BroadcastResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/sync/games/{game_id}/broadcast\x12s\n" +
+	"\bSendPing\x12\x1d.lilbattle.v1.SendPingRequest\x1a\x1e.lilbattle.v1.SendPingResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/sync/games/{game_id}/pingB\xb7\x01\n" +
 	"\x10com.lilbattle.v1B\tSyncProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var file_lilbattle_v1_services_sync_proto_goTypes = []any{
 	(*models.SubscribeRequest)(nil),  // 0: lilbattle.v1.SubscribeRequest
 	(*models.BroadcastRequest)(nil),  // 1: lilbattle.v1.BroadcastRequest
-	(*models.GameUpdate)(nil),        // 2: lilbattle.v1.GameUpdate
-	(*models.BroadcastResponse)(nil), // 3: lilbattle.v1.BroadcastResponse
+	(*models.SendPingRequest)(nil),   // 2: lilbattle.v1.SendPingRequest
+	(*models.GameUpdate)(nil),        // 3: lilbattle.v1.GameUpdate
+	(*models.BroadcastResponse)(nil), // 4: lilbattle.v1.BroadcastResponse
+	(*models.SendPingResponse)(nil),  // 5: lilbattle.v1.SendPingResponse
 }
 var file_lilbattle_v1_services_sync_proto_depIdxs = []int32{
 	0, // 0: lilbattle.v1.GameSyncService.Subscribe:input_type -> lilbattle.v1.SubscribeRequest
 	1, // 1: lilbattle.v1.GameSyncService.Broadcast:input_type -> lilbattle.v1.BroadcastRequest
-	2, // 2: lilbattle.v1.GameSyncService.Subscribe:output_type -> lilbattle.v1.GameUpdate
-	3, // 3: lilbattle.v1.GameSyncService.Broadcast:output_type -> lilbattle.v1.BroadcastResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	2, // 2: lilbattle.v1.GameSyncService.SendPing:input_type -> lilbattle.v1.SendPingRequest
+	3, // 3: lilbattle.v1.GameSyncService.Subscribe:output_type -> lilbattle.v1.GameUpdate
+	4, // 4: lilbattle.v1.GameSyncService.Broadcast:output_type -> lilbattle.v1.BroadcastResponse
+	5, // 5: lilbattle.v1.GameSyncService.SendPing:output_type -> lilbattle.v1.SendPingResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
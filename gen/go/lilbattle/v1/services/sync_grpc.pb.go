@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	GameSyncService_Subscribe_FullMethodName = "/lilbattle.v1.GameSyncService/Subscribe"
 	GameSyncService_Broadcast_FullMethodName = "/lilbattle.v1.GameSyncService/Broadcast"
+	GameSyncService_SendPing_FullMethodName  = "/lilbattle.v1.GameSyncService/SendPing"
 )
 
 // GameSyncServiceClient is the client API for GameSyncService service.
@@ -57,6 +58,10 @@ type GameSyncServiceClient interface {
 	// Called internally by GamesService after ProcessMoves succeeds.
 	// Not intended for direct client use.
 	Broadcast(ctx context.Context, in *models.BroadcastRequest, opts ...grpc.CallOption) (*models.BroadcastResponse, error)
+	// SendPing marks a hex with an alert for the sender's teammates. Pings are
+	// only delivered to subscribers on the same team and are rate limited per
+	// player.
+	SendPing(ctx context.Context, in *models.SendPingRequest, opts ...grpc.CallOption) (*models.SendPingResponse, error)
 }
 
 type gameSyncServiceClient struct {
@@ -96,6 +101,16 @@ func (c *gameSyncServiceClient) Broadcast(ctx context.Context, in *models.Broadc
 	return out, nil
 }
 
+func (c *gameSyncServiceClient) SendPing(ctx context.Context, in *models.SendPingRequest, opts ...grpc.CallOption) (*models.SendPingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.SendPingResponse)
+	err := c.cc.Invoke(ctx, GameSyncService_SendPing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GameSyncServiceServer is the server API for GameSyncService service.
 // All implementations should embed UnimplementedGameSyncServiceServer
 // for forward compatibility.
@@ -128,6 +143,10 @@ type GameSyncServiceServer interface {
 	// Called internally by GamesService after ProcessMoves succeeds.
 	// Not intended for direct client use.
 	Broadcast(context.Context, *models.BroadcastRequest) (*models.BroadcastResponse, error)
+	// SendPing marks a hex with an alert for the sender's teammates. Pings are
+	// only delivered to subscribers on the same team and are rate limited per
+	// player.
+	SendPing(context.Context, *models.SendPingRequest) (*models.SendPingResponse, error)
 }
 
 // UnimplementedGameSyncServiceServer should be embedded to have
@@ -143,6 +162,9 @@ func (UnimplementedGameSyncServiceServer) Subscribe(*models.SubscribeRequest, gr
 func (UnimplementedGameSyncServiceServer) Broadcast(context.Context, *models.BroadcastRequest) (*models.BroadcastResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
 }
+func (UnimplementedGameSyncServiceServer) SendPing(context.Context, *models.SendPingRequest) (*models.SendPingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPing not implemented")
+}
 func (UnimplementedGameSyncServiceServer) testEmbeddedByValue() {}
 
 // UnsafeGameSyncServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +214,24 @@ func _GameSyncService_Broadcast_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameSyncService_SendPing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.SendPingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameSyncServiceServer).SendPing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameSyncService_SendPing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameSyncServiceServer).SendPing(ctx, req.(*models.SendPingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GameSyncService_ServiceDesc is the grpc.ServiceDesc for GameSyncService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +243,10 @@ var GameSyncService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Broadcast",
 			Handler:    _GameSyncService_Broadcast_Handler,
 		},
+		{
+			MethodName: "SendPing",
+			Handler:    _GameSyncService_SendPing_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
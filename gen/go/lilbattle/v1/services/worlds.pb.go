@@ -29,7 +29,7 @@ var File_lilbattle_v1_services_worlds_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_services_worlds_proto_rawDesc = "" +
 	"\n" +
-	"\"lilbattle/v1/services/worlds.proto\x12\flilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a'lilbattle/v1/models/world_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x95\x05\n" +
+	"\"lilbattle/v1/services/worlds.proto\x12\flilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a'lilbattle/v1/models/world_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xcb\b\n" +
 	"\rWorldsService\x12i\n" +
 	"\vCreateWorld\x12 .lilbattle.v1.CreateWorldRequest\x1a!.lilbattle.v1.CreateWorldResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v1/worlds\x12i\n" +
@@ -39,22 +39,31 @@ const file_lilbattle_v1_services_worlds_proto_rawDesc = "" +
 	"/v1/worlds\x12b\n" +
 	"\bGetWorld\x12\x1d.lilbattle.v1.GetWorldRequest\x1a\x1e.lilbattle.v1.GetWorldResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/worlds/{id}\x12m\n" +
 	"\vDeleteWorld\x12 .lilbattle.v1.DeleteWorldRequest\x1a!.lilbattle.v1.DeleteWorldResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/worlds/{id=*}\x12v\n" +
-	"\vUpdateWorld\x12 .lilbattle.v1.UpdateWorldRequest\x1a!.lilbattle.v1.UpdateWorldResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/worlds/{world.id=*}B\xb9\x01\n" +
+	"\vUpdateWorld\x12 .lilbattle.v1.UpdateWorldRequest\x1a!.lilbattle.v1.UpdateWorldResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/worlds/{world.id=*}\x12\xa7\x01\n" +
+	"\x16SetWorldRulesOverrides\x12+.lilbattle.v1.SetWorldRulesOverridesRequest\x1a,.lilbattle.v1.SetWorldRulesOverridesResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\x1a'/v1/worlds/{world_id=*}/rules-overrides\x12\x88\x01\n" +
+	"\x0fApplyWorldEdits\x12$.lilbattle.v1.ApplyWorldEditsRequest\x1a%.lilbattle.v1.ApplyWorldEditsResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/worlds/{world_id=*}/edits\x12\x7f\n" +
+	"\rGetWorldTiles\x12\".lilbattle.v1.GetWorldTilesRequest\x1a#.lilbattle.v1.GetWorldTilesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/worlds/{world_id=*}/tilesB\xb9\x01\n" +
 	"\x10com.lilbattle.v1B\vWorldsProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var file_lilbattle_v1_services_worlds_proto_goTypes = []any{
-	(*models.CreateWorldRequest)(nil),  // 0: lilbattle.v1.CreateWorldRequest
-	(*models.GetWorldsRequest)(nil),    // 1: lilbattle.v1.GetWorldsRequest
-	(*models.ListWorldsRequest)(nil),   // 2: lilbattle.v1.ListWorldsRequest
-	(*models.GetWorldRequest)(nil),     // 3: lilbattle.v1.GetWorldRequest
-	(*models.DeleteWorldRequest)(nil),  // 4: lilbattle.v1.DeleteWorldRequest
-	(*models.UpdateWorldRequest)(nil),  // 5: lilbattle.v1.UpdateWorldRequest
-	(*models.CreateWorldResponse)(nil), // 6: lilbattle.v1.CreateWorldResponse
-	(*models.GetWorldsResponse)(nil),   // 7: lilbattle.v1.GetWorldsResponse
-	(*models.ListWorldsResponse)(nil),  // 8: lilbattle.v1.ListWorldsResponse
-	(*models.GetWorldResponse)(nil),    // 9: lilbattle.v1.GetWorldResponse
-	(*models.DeleteWorldResponse)(nil), // 10: lilbattle.v1.DeleteWorldResponse
-	(*models.UpdateWorldResponse)(nil), // 11: lilbattle.v1.UpdateWorldResponse
+	(*models.CreateWorldRequest)(nil),             // 0: lilbattle.v1.CreateWorldRequest
+	(*models.GetWorldsRequest)(nil),               // 1: lilbattle.v1.GetWorldsRequest
+	(*models.ListWorldsRequest)(nil),              // 2: lilbattle.v1.ListWorldsRequest
+	(*models.GetWorldRequest)(nil),                // 3: lilbattle.v1.GetWorldRequest
+	(*models.DeleteWorldRequest)(nil),             // 4: lilbattle.v1.DeleteWorldRequest
+	(*models.UpdateWorldRequest)(nil),             // 5: lilbattle.v1.UpdateWorldRequest
+	(*models.SetWorldRulesOverridesRequest)(nil),  // 6: lilbattle.v1.SetWorldRulesOverridesRequest
+	(*models.ApplyWorldEditsRequest)(nil),         // 7: lilbattle.v1.ApplyWorldEditsRequest
+	(*models.GetWorldTilesRequest)(nil),           // 8: lilbattle.v1.GetWorldTilesRequest
+	(*models.CreateWorldResponse)(nil),            // 9: lilbattle.v1.CreateWorldResponse
+	(*models.GetWorldsResponse)(nil),              // 10: lilbattle.v1.GetWorldsResponse
+	(*models.ListWorldsResponse)(nil),             // 11: lilbattle.v1.ListWorldsResponse
+	(*models.GetWorldResponse)(nil),               // 12: lilbattle.v1.GetWorldResponse
+	(*models.DeleteWorldResponse)(nil),            // 13: lilbattle.v1.DeleteWorldResponse
+	(*models.UpdateWorldResponse)(nil),            // 14: lilbattle.v1.UpdateWorldResponse
+	(*models.SetWorldRulesOverridesResponse)(nil), // 15: lilbattle.v1.SetWorldRulesOverridesResponse
+	(*models.ApplyWorldEditsResponse)(nil),        // 16: lilbattle.v1.ApplyWorldEditsResponse
+	(*models.GetWorldTilesResponse)(nil),          // 17: lilbattle.v1.GetWorldTilesResponse
 }
 var file_lilbattle_v1_services_worlds_proto_depIdxs = []int32{
 	0,  // 0: lilbattle.v1.WorldsService.CreateWorld:input_type -> lilbattle.v1.CreateWorldRequest
@@ -63,14 +72,20 @@ var file_lilbattle_v1_services_worlds_proto_depIdxs = []int32{
 	3,  // 3: lilbattle.v1.WorldsService.GetWorld:input_type -> lilbattle.v1.GetWorldRequest
 	4,  // 4: lilbattle.v1.WorldsService.DeleteWorld:input_type -> lilbattle.v1.DeleteWorldRequest
 	5,  // 5: lilbattle.v1.WorldsService.UpdateWorld:input_type -> lilbattle.v1.UpdateWorldRequest
-	6,  // 6: lilbattle.v1.WorldsService.CreateWorld:output_type -> lilbattle.v1.CreateWorldResponse
-	7,  // 7: lilbattle.v1.WorldsService.GetWorlds:output_type -> lilbattle.v1.GetWorldsResponse
-	8,  // 8: lilbattle.v1.WorldsService.ListWorlds:output_type -> lilbattle.v1.ListWorldsResponse
-	9,  // 9: lilbattle.v1.WorldsService.GetWorld:output_type -> lilbattle.v1.GetWorldResponse
-	10, // 10: lilbattle.v1.WorldsService.DeleteWorld:output_type -> lilbattle.v1.DeleteWorldResponse
-	11, // 11: lilbattle.v1.WorldsService.UpdateWorld:output_type -> lilbattle.v1.UpdateWorldResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
+	6,  // 6: lilbattle.v1.WorldsService.SetWorldRulesOverrides:input_type -> lilbattle.v1.SetWorldRulesOverridesRequest
+	7,  // 7: lilbattle.v1.WorldsService.ApplyWorldEdits:input_type -> lilbattle.v1.ApplyWorldEditsRequest
+	8,  // 8: lilbattle.v1.WorldsService.GetWorldTiles:input_type -> lilbattle.v1.GetWorldTilesRequest
+	9,  // 9: lilbattle.v1.WorldsService.CreateWorld:output_type -> lilbattle.v1.CreateWorldResponse
+	10, // 10: lilbattle.v1.WorldsService.GetWorlds:output_type -> lilbattle.v1.GetWorldsResponse
+	11, // 11: lilbattle.v1.WorldsService.ListWorlds:output_type -> lilbattle.v1.ListWorldsResponse
+	12, // 12: lilbattle.v1.WorldsService.GetWorld:output_type -> lilbattle.v1.GetWorldResponse
+	13, // 13: lilbattle.v1.WorldsService.DeleteWorld:output_type -> lilbattle.v1.DeleteWorldResponse
+	14, // 14: lilbattle.v1.WorldsService.UpdateWorld:output_type -> lilbattle.v1.UpdateWorldResponse
+	15, // 15: lilbattle.v1.WorldsService.SetWorldRulesOverrides:output_type -> lilbattle.v1.SetWorldRulesOverridesResponse
+	16, // 16: lilbattle.v1.WorldsService.ApplyWorldEdits:output_type -> lilbattle.v1.ApplyWorldEditsResponse
+	17, // 17: lilbattle.v1.WorldsService.GetWorldTiles:output_type -> lilbattle.v1.GetWorldTilesResponse
+	9,  // [9:18] is the sub-list for method output_type
+	0,  // [0:9] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
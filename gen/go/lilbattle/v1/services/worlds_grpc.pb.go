@@ -21,12 +21,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorldsService_CreateWorld_FullMethodName = "/lilbattle.v1.WorldsService/CreateWorld"
-	WorldsService_GetWorlds_FullMethodName   = "/lilbattle.v1.WorldsService/GetWorlds"
-	WorldsService_ListWorlds_FullMethodName  = "/lilbattle.v1.WorldsService/ListWorlds"
-	WorldsService_GetWorld_FullMethodName    = "/lilbattle.v1.WorldsService/GetWorld"
-	WorldsService_DeleteWorld_FullMethodName = "/lilbattle.v1.WorldsService/DeleteWorld"
-	WorldsService_UpdateWorld_FullMethodName = "/lilbattle.v1.WorldsService/UpdateWorld"
+	WorldsService_CreateWorld_FullMethodName            = "/lilbattle.v1.WorldsService/CreateWorld"
+	WorldsService_GetWorlds_FullMethodName              = "/lilbattle.v1.WorldsService/GetWorlds"
+	WorldsService_ListWorlds_FullMethodName             = "/lilbattle.v1.WorldsService/ListWorlds"
+	WorldsService_GetWorld_FullMethodName               = "/lilbattle.v1.WorldsService/GetWorld"
+	WorldsService_DeleteWorld_FullMethodName            = "/lilbattle.v1.WorldsService/DeleteWorld"
+	WorldsService_UpdateWorld_FullMethodName            = "/lilbattle.v1.WorldsService/UpdateWorld"
+	WorldsService_SetWorldRulesOverrides_FullMethodName = "/lilbattle.v1.WorldsService/SetWorldRulesOverrides"
+	WorldsService_ApplyWorldEdits_FullMethodName        = "/lilbattle.v1.WorldsService/ApplyWorldEdits"
+	WorldsService_GetWorldTiles_FullMethodName          = "/lilbattle.v1.WorldsService/GetWorldTiles"
 )
 
 // WorldsServiceClient is the client API for WorldsService service.
@@ -50,6 +53,18 @@ type WorldsServiceClient interface {
 	DeleteWorld(ctx context.Context, in *models.DeleteWorldRequest, opts ...grpc.CallOption) (*models.DeleteWorldResponse, error)
 	// GetWorld returns a specific world with metadata
 	UpdateWorld(ctx context.Context, in *models.UpdateWorldRequest, opts ...grpc.CallOption) (*models.UpdateWorldResponse, error)
+	// *
+	// Replace a world's rules overrides (terrain movement costs and income).
+	// Overrides are validated against the rules and applied to games created
+	// from the world afterwards.
+	SetWorldRulesOverrides(ctx context.Context, in *models.SetWorldRulesOverridesRequest, opts ...grpc.CallOption) (*models.SetWorldRulesOverridesResponse, error)
+	// *
+	// Apply a batch of map edits (paint terrain, set owner, place or remove
+	// units) to a world.  Edits are validated and applied atomically, and the
+	// world data version is bumped once per batch.
+	ApplyWorldEdits(ctx context.Context, in *models.ApplyWorldEditsRequest, opts ...grpc.CallOption) (*models.ApplyWorldEditsResponse, error)
+	// GetWorldTiles returns the tiles and units of a world, optionally within a region
+	GetWorldTiles(ctx context.Context, in *models.GetWorldTilesRequest, opts ...grpc.CallOption) (*models.GetWorldTilesResponse, error)
 }
 
 type worldsServiceClient struct {
@@ -120,6 +135,36 @@ func (c *worldsServiceClient) UpdateWorld(ctx context.Context, in *models.Update
 	return out, nil
 }
 
+func (c *worldsServiceClient) SetWorldRulesOverrides(ctx context.Context, in *models.SetWorldRulesOverridesRequest, opts ...grpc.CallOption) (*models.SetWorldRulesOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.SetWorldRulesOverridesResponse)
+	err := c.cc.Invoke(ctx, WorldsService_SetWorldRulesOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldsServiceClient) ApplyWorldEdits(ctx context.Context, in *models.ApplyWorldEditsRequest, opts ...grpc.CallOption) (*models.ApplyWorldEditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ApplyWorldEditsResponse)
+	err := c.cc.Invoke(ctx, WorldsService_ApplyWorldEdits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldsServiceClient) GetWorldTiles(ctx context.Context, in *models.GetWorldTilesRequest, opts ...grpc.CallOption) (*models.GetWorldTilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.GetWorldTilesResponse)
+	err := c.cc.Invoke(ctx, WorldsService_GetWorldTiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorldsServiceServer is the server API for WorldsService service.
 // All implementations should embed UnimplementedWorldsServiceServer
 // for forward compatibility.
@@ -141,6 +186,18 @@ type WorldsServiceServer interface {
 	DeleteWorld(context.Context, *models.DeleteWorldRequest) (*models.DeleteWorldResponse, error)
 	// GetWorld returns a specific world with metadata
 	UpdateWorld(context.Context, *models.UpdateWorldRequest) (*models.UpdateWorldResponse, error)
+	// *
+	// Replace a world's rules overrides (terrain movement costs and income).
+	// Overrides are validated against the rules and applied to games created
+	// from the world afterwards.
+	SetWorldRulesOverrides(context.Context, *models.SetWorldRulesOverridesRequest) (*models.SetWorldRulesOverridesResponse, error)
+	// *
+	// Apply a batch of map edits (paint terrain, set owner, place or remove
+	// units) to a world.  Edits are validated and applied atomically, and the
+	// world data version is bumped once per batch.
+	ApplyWorldEdits(context.Context, *models.ApplyWorldEditsRequest) (*models.ApplyWorldEditsResponse, error)
+	// GetWorldTiles returns the tiles and units of a world, optionally within a region
+	GetWorldTiles(context.Context, *models.GetWorldTilesRequest) (*models.GetWorldTilesResponse, error)
 }
 
 // UnimplementedWorldsServiceServer should be embedded to have
@@ -168,6 +225,15 @@ func (UnimplementedWorldsServiceServer) DeleteWorld(context.Context, *models.Del
 func (UnimplementedWorldsServiceServer) UpdateWorld(context.Context, *models.UpdateWorldRequest) (*models.UpdateWorldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorld not implemented")
 }
+func (UnimplementedWorldsServiceServer) SetWorldRulesOverrides(context.Context, *models.SetWorldRulesOverridesRequest) (*models.SetWorldRulesOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorldRulesOverrides not implemented")
+}
+func (UnimplementedWorldsServiceServer) ApplyWorldEdits(context.Context, *models.ApplyWorldEditsRequest) (*models.ApplyWorldEditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyWorldEdits not implemented")
+}
+func (UnimplementedWorldsServiceServer) GetWorldTiles(context.Context, *models.GetWorldTilesRequest) (*models.GetWorldTilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorldTiles not implemented")
+}
 func (UnimplementedWorldsServiceServer) testEmbeddedByValue() {}
 
 // UnsafeWorldsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -296,6 +362,60 @@ func _WorldsService_UpdateWorld_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorldsService_SetWorldRulesOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.SetWorldRulesOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldsServiceServer).SetWorldRulesOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldsService_SetWorldRulesOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldsServiceServer).SetWorldRulesOverrides(ctx, req.(*models.SetWorldRulesOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldsService_ApplyWorldEdits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ApplyWorldEditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldsServiceServer).ApplyWorldEdits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldsService_ApplyWorldEdits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldsServiceServer).ApplyWorldEdits(ctx, req.(*models.ApplyWorldEditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldsService_GetWorldTiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetWorldTilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldsServiceServer).GetWorldTiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldsService_GetWorldTiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldsServiceServer).GetWorldTiles(ctx, req.(*models.GetWorldTilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorldsService_ServiceDesc is the grpc.ServiceDesc for WorldsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,6 +447,18 @@ var WorldsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWorld",
 			Handler:    _WorldsService_UpdateWorld_Handler,
 		},
+		{
+			MethodName: "SetWorldRulesOverrides",
+			Handler:    _WorldsService_SetWorldRulesOverrides_Handler,
+		},
+		{
+			MethodName: "ApplyWorldEdits",
+			Handler:    _WorldsService_ApplyWorldEdits_Handler,
+		},
+		{
+			MethodName: "GetWorldTiles",
+			Handler:    _WorldsService_GetWorldTiles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lilbattle/v1/services/worlds.proto",
@@ -101,9 +101,23 @@ func TileToTileGORM(
 		Shortcut:         src.Shortcut,
 		LastActedTurn:    src.LastActedTurn,
 		LastToppedupTurn: src.LastToppedupTurn,
+		VictoryPoints:    src.VictoryPoints,
 	}
 	out = dest
 
+	if src.Construction != nil {
+		_, err = ConstructionProgressToConstructionProgressGORM(src.Construction, &out.Construction, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Construction: %w", err)
+		}
+	}
+	if src.Hazard != nil {
+		_, err = TileHazardToTileHazardGORM(src.Hazard, &out.Hazard, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Hazard: %w", err)
+		}
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(src, dest); err != nil {
@@ -137,9 +151,19 @@ func TileFromTileGORM(
 		Shortcut:         src.Shortcut,
 		LastActedTurn:    src.LastActedTurn,
 		LastToppedupTurn: src.LastToppedupTurn,
+		VictoryPoints:    src.VictoryPoints,
 	}
 	out = dest
 
+	out.Construction, err = ConstructionProgressFromConstructionProgressGORM(nil, &src.Construction, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Construction: %w", err)
+	}
+	out.Hazard, err = TileHazardFromTileHazardGORM(nil, &src.Hazard, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Hazard: %w", err)
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(dest, src); err != nil {
@@ -241,6 +265,10 @@ func UnitToUnitGORM(
 		ProgressionStep:         src.ProgressionStep,
 		ChosenAlternative:       src.ChosenAlternative,
 		CaptureStartedTurn:      src.CaptureStartedTurn,
+		Submerged:               src.Submerged,
+		Facing:                  src.Facing,
+		Id:                      src.Id,
+		StepActions:             src.StepActions,
 	}
 	out = dest
 
@@ -293,6 +321,10 @@ func UnitFromUnitGORM(
 		ProgressionStep:         src.ProgressionStep,
 		ChosenAlternative:       src.ChosenAlternative,
 		CaptureStartedTurn:      src.CaptureStartedTurn,
+		Submerged:               src.Submerged,
+		Facing:                  src.Facing,
+		Id:                      src.Id,
+		StepActions:             src.StepActions,
 	}
 	out = dest
 
@@ -398,15 +430,17 @@ func WorldToWorldGORM(
 
 	// Initialize struct with inline values
 	*dest = WorldGORM{
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		Version:        src.Version,
+		Id:             src.Id,
+		CreatorId:      src.CreatorId,
+		Name:           src.Name,
+		Description:    src.Description,
+		Tags:           src.Tags,
+		ImageUrl:       src.ImageUrl,
+		Difficulty:     src.Difficulty,
+		PreviewUrls:    src.PreviewUrls,
+		Orientation:    src.Orientation,
+		AttachedGameId: src.AttachedGameId,
 	}
 	out = dest
 
@@ -430,6 +464,29 @@ func WorldToWorldGORM(
 			return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
 		}
 	}
+	if src.Rating != nil {
+		_, err = WorldRatingToWorldRatingGORM(src.Rating, &out.Rating, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Rating: %w", err)
+		}
+	}
+	if src.RulesOverrides != nil {
+		_, err = RulesOverridesToRulesOverridesGORM(src.RulesOverrides, &out.RulesOverrides, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting RulesOverrides: %w", err)
+		}
+	}
+
+	if src.DeletedAt != nil {
+		out.DeletedAt = converters.TimestampToTime(src.DeletedAt)
+	}
+
+	if src.RandomMap != nil {
+		_, err = RandomMapToRandomMapGORM(src.RandomMap, &out.RandomMap, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting RandomMap: %w", err)
+		}
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -457,17 +514,20 @@ func WorldFromWorldGORM(
 
 	// Initialize struct with inline values
 	*dest = models.World{
-		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
-		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		CreatedAt:      converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:      converters.TimeToTimestamp(src.UpdatedAt),
+		Version:        src.Version,
+		Id:             src.Id,
+		CreatorId:      src.CreatorId,
+		Name:           src.Name,
+		Description:    src.Description,
+		Tags:           src.Tags,
+		ImageUrl:       src.ImageUrl,
+		Difficulty:     src.Difficulty,
+		PreviewUrls:    src.PreviewUrls,
+		Orientation:    src.Orientation,
+		DeletedAt:      converters.TimeToTimestamp(src.DeletedAt),
+		AttachedGameId: src.AttachedGameId,
 	}
 	out = dest
 
@@ -479,6 +539,242 @@ func WorldFromWorldGORM(
 	if err != nil {
 		return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
 	}
+	out.Rating, err = WorldRatingFromWorldRatingGORM(nil, &src.Rating, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Rating: %w", err)
+	}
+	out.RulesOverrides, err = RulesOverridesFromRulesOverridesGORM(nil, &src.RulesOverrides, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting RulesOverrides: %w", err)
+	}
+	out.RandomMap, err = RandomMapFromRandomMapGORM(nil, &src.RandomMap, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting RandomMap: %w", err)
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// WorldRatingToWorldRatingGORM converts a models.WorldRating to WorldRatingGORM.
+// The optional decorator function allows custom field transformations.
+func WorldRatingToWorldRatingGORM(
+	src *models.WorldRating,
+	dest *WorldRatingGORM,
+	decorator func(*models.WorldRating, *WorldRatingGORM) error,
+) (out *WorldRatingGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &WorldRatingGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = WorldRatingGORM{
+		HumanPlayer:      src.HumanPlayer,
+		Simulations:      src.Simulations,
+		HumanWinRate:     src.HumanWinRate,
+		AiWinRate:        src.AiWinRate,
+		AverageTurns:     src.AverageTurns,
+		Difficulty:       src.Difficulty,
+		Label:            src.Label,
+		RulesHash:        src.RulesHash,
+		AiVersion:        src.AiVersion,
+		WorldDataVersion: src.WorldDataVersion,
+	}
+	out = dest
+
+	if src.RatedAt != nil {
+		out.RatedAt = converters.TimestampToTime(src.RatedAt)
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// WorldRatingFromWorldRatingGORM converts a WorldRatingGORM back to models.WorldRating.
+// The optional decorator function allows custom field transformations.
+func WorldRatingFromWorldRatingGORM(
+	dest *models.WorldRating,
+	src *WorldRatingGORM,
+	decorator func(dest *models.WorldRating, src *WorldRatingGORM) error,
+) (out *models.WorldRating, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.WorldRating{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.WorldRating{
+		HumanPlayer:      src.HumanPlayer,
+		Simulations:      src.Simulations,
+		HumanWinRate:     src.HumanWinRate,
+		AiWinRate:        src.AiWinRate,
+		AverageTurns:     src.AverageTurns,
+		Difficulty:       src.Difficulty,
+		Label:            src.Label,
+		RulesHash:        src.RulesHash,
+		AiVersion:        src.AiVersion,
+		RatedAt:          converters.TimeToTimestamp(src.RatedAt),
+		WorldDataVersion: src.WorldDataVersion,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// RandomMapToRandomMapGORM converts a models.RandomMap to RandomMapGORM.
+// The optional decorator function allows custom field transformations.
+func RandomMapToRandomMapGORM(
+	src *models.RandomMap,
+	dest *RandomMapGORM,
+	decorator func(*models.RandomMap, *RandomMapGORM) error,
+) (out *RandomMapGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &RandomMapGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = RandomMapGORM{
+		Size:       src.Size,
+		NumPlayers: src.NumPlayers,
+		Seed:       src.Seed,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// RandomMapFromRandomMapGORM converts a RandomMapGORM back to models.RandomMap.
+// The optional decorator function allows custom field transformations.
+func RandomMapFromRandomMapGORM(
+	dest *models.RandomMap,
+	src *RandomMapGORM,
+	decorator func(dest *models.RandomMap, src *RandomMapGORM) error,
+) (out *models.RandomMap, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.RandomMap{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.RandomMap{
+		Size:       src.Size,
+		NumPlayers: src.NumPlayers,
+		Seed:       src.Seed,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// RulesOverridesToRulesOverridesGORM converts a models.RulesOverrides to RulesOverridesGORM.
+// The optional decorator function allows custom field transformations.
+func RulesOverridesToRulesOverridesGORM(
+	src *models.RulesOverrides,
+	dest *RulesOverridesGORM,
+	decorator func(*models.RulesOverrides, *RulesOverridesGORM) error,
+) (out *RulesOverridesGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &RulesOverridesGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = RulesOverridesGORM{
+		MaxUnitsPerPlayer: src.MaxUnitsPerPlayer,
+	}
+	out = dest
+
+	if src.TerrainMovementCosts != nil {
+		out.TerrainMovementCosts = src.TerrainMovementCosts
+	}
+
+	if src.Income != nil {
+		_, err = IncomeConfigToIncomeConfigGORM(src.Income, &out.Income, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Income: %w", err)
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// RulesOverridesFromRulesOverridesGORM converts a RulesOverridesGORM back to models.RulesOverrides.
+// The optional decorator function allows custom field transformations.
+func RulesOverridesFromRulesOverridesGORM(
+	dest *models.RulesOverrides,
+	src *RulesOverridesGORM,
+	decorator func(dest *models.RulesOverrides, src *RulesOverridesGORM) error,
+) (out *models.RulesOverrides, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.RulesOverrides{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.RulesOverrides{
+		TerrainMovementCosts: src.TerrainMovementCosts,
+		MaxUnitsPerPlayer:    src.MaxUnitsPerPlayer,
+	}
+	out = dest
+
+	out.Income, err = IncomeConfigFromIncomeConfigGORM(nil, &src.Income, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Income: %w", err)
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -642,16 +938,20 @@ func GameToGameGORM(
 
 	// Initialize struct with inline values
 	*dest = GameGORM{
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		WorldId:     src.WorldId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		Version:          src.Version,
+		Id:               src.Id,
+		CreatorId:        src.CreatorId,
+		WorldId:          src.WorldId,
+		Name:             src.Name,
+		Description:      src.Description,
+		Tags:             src.Tags,
+		ImageUrl:         src.ImageUrl,
+		Difficulty:       src.Difficulty,
+		PreviewUrls:      src.PreviewUrls,
+		Orientation:      src.Orientation,
+		Thumbnail:        src.Thumbnail,
+		ForkedFromGameId: src.ForkedFromGameId,
+		ForkedAtMove:     src.ForkedAtMove,
 	}
 	out = dest
 
@@ -675,6 +975,12 @@ func GameToGameGORM(
 			return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
 		}
 	}
+	if src.RandomMap != nil {
+		_, err = RandomMapToRandomMapGORM(src.RandomMap, &out.RandomMap, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting RandomMap: %w", err)
+		}
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -702,18 +1008,22 @@ func GameFromGameGORM(
 
 	// Initialize struct with inline values
 	*dest = models.Game{
-		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
-		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		WorldId:     src.WorldId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		CreatedAt:        converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:        converters.TimeToTimestamp(src.UpdatedAt),
+		Version:          src.Version,
+		Id:               src.Id,
+		CreatorId:        src.CreatorId,
+		WorldId:          src.WorldId,
+		Name:             src.Name,
+		Description:      src.Description,
+		Tags:             src.Tags,
+		ImageUrl:         src.ImageUrl,
+		Difficulty:       src.Difficulty,
+		PreviewUrls:      src.PreviewUrls,
+		Orientation:      src.Orientation,
+		Thumbnail:        src.Thumbnail,
+		ForkedFromGameId: src.ForkedFromGameId,
+		ForkedAtMove:     src.ForkedAtMove,
 	}
 	out = dest
 
@@ -725,6 +1035,10 @@ func GameFromGameGORM(
 	if err != nil {
 		return nil, fmt.Errorf("converting SearchIndexInfo: %w", err)
 	}
+	out.RandomMap, err = RandomMapFromRandomMapGORM(nil, &src.RandomMap, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting RandomMap: %w", err)
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -762,6 +1076,11 @@ func GameStateToGameStateGORM(
 		WinningPlayer:      src.WinningPlayer,
 		WinningTeam:        src.WinningTeam,
 		CurrentGroupNumber: src.CurrentGroupNumber,
+		ClockPaused:        src.ClockPaused,
+		PauseRequests:      src.PauseRequests,
+		DelegatedTo:        src.DelegatedTo,
+		PuzzleResult:       src.PuzzleResult,
+		NextUnitId:         src.NextUnitId,
 	}
 	out = dest
 
@@ -776,6 +1095,17 @@ func GameStateToGameStateGORM(
 		}
 	}
 
+	if src.ClockStartedAt != nil {
+		out.ClockStartedAt = converters.TimestampToTime(src.ClockStartedAt)
+	}
+
+	if src.Draft != nil {
+		_, err = DraftStateToDraftStateGORM(src.Draft, &out.Draft, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Draft: %w", err)
+		}
+	}
+
 	if src.PlayerStates != nil {
 		out.PlayerStates = make(map[int32]PlayerStateGORM, len(src.PlayerStates))
 		for key, value := range src.PlayerStates {
@@ -788,6 +1118,16 @@ func GameStateToGameStateGORM(
 		}
 	}
 
+	if src.TurnPlans != nil {
+		out.TurnPlans = make([][]byte, len(src.TurnPlans))
+		for i, item := range src.TurnPlans {
+			_, err = converters.MessageToAnyBytesConverter(item, &out.TurnPlans[i], nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting TurnPlans[%d]: %w", i, err)
+			}
+		}
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(src, dest); err != nil {
@@ -825,6 +1165,12 @@ func GameStateFromGameStateGORM(
 		WinningPlayer:      src.WinningPlayer,
 		WinningTeam:        src.WinningTeam,
 		CurrentGroupNumber: src.CurrentGroupNumber,
+		ClockStartedAt:     converters.TimeToTimestamp(src.ClockStartedAt),
+		ClockPaused:        src.ClockPaused,
+		PauseRequests:      src.PauseRequests,
+		DelegatedTo:        src.DelegatedTo,
+		PuzzleResult:       src.PuzzleResult,
+		NextUnitId:         src.NextUnitId,
 	}
 	out = dest
 
@@ -832,6 +1178,10 @@ func GameStateFromGameStateGORM(
 	if err != nil {
 		return nil, fmt.Errorf("converting WorldData: %w", err)
 	}
+	out.Draft, err = DraftStateFromDraftStateGORM(nil, &src.Draft, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Draft: %w", err)
+	}
 
 	if src.PlayerStates != nil {
 		out.PlayerStates = make(map[int32]*models.PlayerState, len(src.PlayerStates))
@@ -843,6 +1193,16 @@ func GameStateFromGameStateGORM(
 		}
 	}
 
+	if src.TurnPlans != nil {
+		out.TurnPlans = make([]*models.TurnPlan, len(src.TurnPlans))
+		for i, item := range src.TurnPlans {
+			out.TurnPlans[i], err = converters.AnyBytesToMessageConverter[*models.TurnPlan](nil, &item, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting TurnPlans[%d]: %w", i, err)
+			}
+		}
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(dest, src); err != nil {
@@ -883,6 +1243,18 @@ func GameConfigurationToGameConfigurationGORM(
 			return nil, fmt.Errorf("converting Settings: %w", err)
 		}
 	}
+	if src.WorldRulesOverrides != nil {
+		_, err = RulesOverridesToRulesOverridesGORM(src.WorldRulesOverrides, &out.WorldRulesOverrides, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting WorldRulesOverrides: %w", err)
+		}
+	}
+	if src.RulesOverrides != nil {
+		_, err = RulesOverridesToRulesOverridesGORM(src.RulesOverrides, &out.RulesOverrides, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting RulesOverrides: %w", err)
+		}
+	}
 
 	if src.Players != nil {
 		out.Players = make([]GamePlayerGORM, len(src.Players))
@@ -939,6 +1311,14 @@ func GameConfigurationFromGameConfigurationGORM(
 	if err != nil {
 		return nil, fmt.Errorf("converting Settings: %w", err)
 	}
+	out.WorldRulesOverrides, err = RulesOverridesFromRulesOverridesGORM(nil, &src.WorldRulesOverrides, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting WorldRulesOverrides: %w", err)
+	}
+	out.RulesOverrides, err = RulesOverridesFromRulesOverridesGORM(nil, &src.RulesOverrides, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting RulesOverrides: %w", err)
+	}
 
 	if src.Players != nil {
 		out.Players = make([]*models.GamePlayer, len(src.Players))
@@ -1068,6 +1448,13 @@ func GamePlayerToGamePlayerGORM(
 	}
 	out = dest
 
+	if src.Handicap != nil {
+		_, err = PlayerHandicapToPlayerHandicapGORM(src.Handicap, &out.Handicap, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Handicap: %w", err)
+		}
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(src, dest); err != nil {
@@ -1105,6 +1492,11 @@ func GamePlayerFromGamePlayerGORM(
 	}
 	out = dest
 
+	out.Handicap, err = PlayerHandicapFromPlayerHandicapGORM(nil, &src.Handicap, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Handicap: %w", err)
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(dest, src); err != nil {
@@ -1115,26 +1507,24 @@ func GamePlayerFromGamePlayerGORM(
 	return out, nil
 }
 
-// GameTeamToGameTeamGORM converts a models.GameTeam to GameTeamGORM.
+// PlayerHandicapToPlayerHandicapGORM converts a models.PlayerHandicap to PlayerHandicapGORM.
 // The optional decorator function allows custom field transformations.
-func GameTeamToGameTeamGORM(
-	src *models.GameTeam,
-	dest *GameTeamGORM,
-	decorator func(*models.GameTeam, *GameTeamGORM) error,
-) (out *GameTeamGORM, err error) {
+func PlayerHandicapToPlayerHandicapGORM(
+	src *models.PlayerHandicap,
+	dest *PlayerHandicapGORM,
+	decorator func(*models.PlayerHandicap, *PlayerHandicapGORM) error,
+) (out *PlayerHandicapGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
 	if dest == nil {
-		dest = &GameTeamGORM{}
+		dest = &PlayerHandicapGORM{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GameTeamGORM{
-		TeamId:   src.TeamId,
-		Name:     src.Name,
-		Color:    src.Color,
-		IsActive: src.IsActive,
+	*dest = PlayerHandicapGORM{
+		ExtraCoins: src.ExtraCoins,
+		BonusUnits: src.BonusUnits,
 	}
 	out = dest
 
@@ -1148,26 +1538,24 @@ func GameTeamToGameTeamGORM(
 	return dest, nil
 }
 
-// GameTeamFromGameTeamGORM converts a GameTeamGORM back to models.GameTeam.
+// PlayerHandicapFromPlayerHandicapGORM converts a PlayerHandicapGORM back to models.PlayerHandicap.
 // The optional decorator function allows custom field transformations.
-func GameTeamFromGameTeamGORM(
-	dest *models.GameTeam,
-	src *GameTeamGORM,
-	decorator func(dest *models.GameTeam, src *GameTeamGORM) error,
-) (out *models.GameTeam, err error) {
+func PlayerHandicapFromPlayerHandicapGORM(
+	dest *models.PlayerHandicap,
+	src *PlayerHandicapGORM,
+	decorator func(dest *models.PlayerHandicap, src *PlayerHandicapGORM) error,
+) (out *models.PlayerHandicap, err error) {
 	if src == nil {
 		return nil, nil
 	}
 	if dest == nil {
-		dest = &models.GameTeam{}
+		dest = &models.PlayerHandicap{}
 	}
 
 	// Initialize struct with inline values
-	*dest = models.GameTeam{
-		TeamId:   src.TeamId,
-		Name:     src.Name,
-		Color:    src.Color,
-		IsActive: src.IsActive,
+	*dest = models.PlayerHandicap{
+		ExtraCoins: src.ExtraCoins,
+		BonusUnits: src.BonusUnits,
 	}
 	out = dest
 
@@ -1181,28 +1569,131 @@ func GameTeamFromGameTeamGORM(
 	return out, nil
 }
 
-// GameSettingsToGameSettingsGORM converts a models.GameSettings to GameSettingsGORM.
+// GameTeamToGameTeamGORM converts a models.GameTeam to GameTeamGORM.
 // The optional decorator function allows custom field transformations.
-func GameSettingsToGameSettingsGORM(
-	src *models.GameSettings,
-	dest *GameSettingsGORM,
-	decorator func(*models.GameSettings, *GameSettingsGORM) error,
-) (out *GameSettingsGORM, err error) {
+func GameTeamToGameTeamGORM(
+	src *models.GameTeam,
+	dest *GameTeamGORM,
+	decorator func(*models.GameTeam, *GameTeamGORM) error,
+) (out *GameTeamGORM, err error) {
 	if src == nil {
 		return nil, nil
 	}
 	if dest == nil {
-		dest = &GameSettingsGORM{}
+		dest = &GameTeamGORM{}
 	}
 
 	// Initialize struct with inline values
-	*dest = GameSettingsGORM{
-		AllowedUnits:  src.AllowedUnits,
-		TurnTimeLimit: src.TurnTimeLimit,
-		TeamMode:      src.TeamMode,
-		MaxTurns:      src.MaxTurns,
-	}
-	out = dest
+	*dest = GameTeamGORM{
+		TeamId:   src.TeamId,
+		Name:     src.Name,
+		Color:    src.Color,
+		IsActive: src.IsActive,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// GameTeamFromGameTeamGORM converts a GameTeamGORM back to models.GameTeam.
+// The optional decorator function allows custom field transformations.
+func GameTeamFromGameTeamGORM(
+	dest *models.GameTeam,
+	src *GameTeamGORM,
+	decorator func(dest *models.GameTeam, src *GameTeamGORM) error,
+) (out *models.GameTeam, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.GameTeam{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.GameTeam{
+		TeamId:   src.TeamId,
+		Name:     src.Name,
+		Color:    src.Color,
+		IsActive: src.IsActive,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// GameSettingsToGameSettingsGORM converts a models.GameSettings to GameSettingsGORM.
+// The optional decorator function allows custom field transformations.
+func GameSettingsToGameSettingsGORM(
+	src *models.GameSettings,
+	dest *GameSettingsGORM,
+	decorator func(*models.GameSettings, *GameSettingsGORM) error,
+) (out *GameSettingsGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &GameSettingsGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = GameSettingsGORM{
+		AllowedUnits:       src.AllowedUnits,
+		TurnTimeLimit:      src.TurnTimeLimit,
+		TeamMode:           src.TeamMode,
+		MaxTurns:           src.MaxTurns,
+		StealthEnabled:     src.StealthEnabled,
+		Rated:              src.Rated,
+		FogEnabled:         src.FogEnabled,
+		AllowFriendlyFire:  src.AllowFriendlyFire,
+		VictoryPointsToWin: src.VictoryPointsToWin,
+		SimultaneousTurns:  src.SimultaneousTurns,
+	}
+	out = dest
+
+	if src.TimeBank != nil {
+		_, err = TimeBankSettingsToTimeBankSettingsGORM(src.TimeBank, &out.TimeBank, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting TimeBank: %w", err)
+		}
+	}
+	if src.Draft != nil {
+		_, err = DraftSettingsToDraftSettingsGORM(src.Draft, &out.Draft, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Draft: %w", err)
+		}
+	}
+	if src.Puzzle != nil {
+		_, err = PuzzleSettingsToPuzzleSettingsGORM(src.Puzzle, &out.Puzzle, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Puzzle: %w", err)
+		}
+	}
+	if src.SpectatorDelay != nil {
+		_, err = SpectatorDelayToSpectatorDelayGORM(src.SpectatorDelay, &out.SpectatorDelay, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting SpectatorDelay: %w", err)
+		}
+	}
+	if src.Sandbox != nil {
+		_, err = SandboxSettingsToSandboxSettingsGORM(src.Sandbox, &out.Sandbox, nil)
+		if err != nil {
+			return nil, fmt.Errorf("converting Sandbox: %w", err)
+		}
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -1230,13 +1721,41 @@ func GameSettingsFromGameSettingsGORM(
 
 	// Initialize struct with inline values
 	*dest = models.GameSettings{
-		AllowedUnits:  src.AllowedUnits,
-		TurnTimeLimit: src.TurnTimeLimit,
-		TeamMode:      src.TeamMode,
-		MaxTurns:      src.MaxTurns,
+		AllowedUnits:       src.AllowedUnits,
+		TurnTimeLimit:      src.TurnTimeLimit,
+		TeamMode:           src.TeamMode,
+		MaxTurns:           src.MaxTurns,
+		StealthEnabled:     src.StealthEnabled,
+		Rated:              src.Rated,
+		FogEnabled:         src.FogEnabled,
+		AllowFriendlyFire:  src.AllowFriendlyFire,
+		VictoryPointsToWin: src.VictoryPointsToWin,
+		SimultaneousTurns:  src.SimultaneousTurns,
 	}
 	out = dest
 
+	out.TimeBank, err = TimeBankSettingsFromTimeBankSettingsGORM(nil, &src.TimeBank, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting TimeBank: %w", err)
+	}
+	out.Draft, err = DraftSettingsFromDraftSettingsGORM(nil, &src.Draft, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Draft: %w", err)
+	}
+	out.Puzzle, err = PuzzleSettingsFromPuzzleSettingsGORM(nil, &src.Puzzle, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Puzzle: %w", err)
+	}
+	out.SpectatorDelay, err = SpectatorDelayFromSpectatorDelayGORM(nil, &src.SpectatorDelay, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting SpectatorDelay: %w", err)
+	}
+
+	out.Sandbox, err = SandboxSettingsFromSandboxSettingsGORM(nil, &src.Sandbox, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting Sandbox: %w", err)
+	}
+
 	// Apply decorator if provided
 	if decorator != nil {
 		if err := decorator(dest, src); err != nil {
@@ -1263,8 +1782,11 @@ func PlayerStateToPlayerStateGORM(
 
 	// Initialize struct with inline values
 	*dest = PlayerStateGORM{
-		Coins:    src.Coins,
-		IsActive: src.IsActive,
+		Coins:           src.Coins,
+		IsActive:        src.IsActive,
+		TimeBankMs:      src.TimeBankMs,
+		VictoryPoints:   src.VictoryPoints,
+		DiscoveredHexes: src.DiscoveredHexes,
 	}
 	out = dest
 
@@ -1294,8 +1816,630 @@ func PlayerStateFromPlayerStateGORM(
 
 	// Initialize struct with inline values
 	*dest = models.PlayerState{
-		Coins:    src.Coins,
-		IsActive: src.IsActive,
+		Coins:           src.Coins,
+		IsActive:        src.IsActive,
+		TimeBankMs:      src.TimeBankMs,
+		VictoryPoints:   src.VictoryPoints,
+		DiscoveredHexes: src.DiscoveredHexes,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TimeBankSettingsToTimeBankSettingsGORM converts a models.TimeBankSettings to TimeBankSettingsGORM.
+// The optional decorator function allows custom field transformations.
+func TimeBankSettingsToTimeBankSettingsGORM(
+	src *models.TimeBankSettings,
+	dest *TimeBankSettingsGORM,
+	decorator func(*models.TimeBankSettings, *TimeBankSettingsGORM) error,
+) (out *TimeBankSettingsGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &TimeBankSettingsGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = TimeBankSettingsGORM{
+		InitialSeconds:   src.InitialSeconds,
+		IncrementSeconds: src.IncrementSeconds,
+		OnTimeout:        src.OnTimeout,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// TimeBankSettingsFromTimeBankSettingsGORM converts a TimeBankSettingsGORM back to models.TimeBankSettings.
+// The optional decorator function allows custom field transformations.
+func TimeBankSettingsFromTimeBankSettingsGORM(
+	dest *models.TimeBankSettings,
+	src *TimeBankSettingsGORM,
+	decorator func(dest *models.TimeBankSettings, src *TimeBankSettingsGORM) error,
+) (out *models.TimeBankSettings, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.TimeBankSettings{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.TimeBankSettings{
+		InitialSeconds:   src.InitialSeconds,
+		IncrementSeconds: src.IncrementSeconds,
+		OnTimeout:        src.OnTimeout,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// DraftSettingsToDraftSettingsGORM converts a models.DraftSettings to DraftSettingsGORM.
+// The optional decorator function allows custom field transformations.
+func DraftSettingsToDraftSettingsGORM(
+	src *models.DraftSettings,
+	dest *DraftSettingsGORM,
+	decorator func(*models.DraftSettings, *DraftSettingsGORM) error,
+) (out *DraftSettingsGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &DraftSettingsGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = DraftSettingsGORM{
+		BansPerPlayer:  src.BansPerPlayer,
+		PicksPerPlayer: src.PicksPerPlayer,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// DraftSettingsFromDraftSettingsGORM converts a DraftSettingsGORM back to models.DraftSettings.
+// The optional decorator function allows custom field transformations.
+func DraftSettingsFromDraftSettingsGORM(
+	dest *models.DraftSettings,
+	src *DraftSettingsGORM,
+	decorator func(dest *models.DraftSettings, src *DraftSettingsGORM) error,
+) (out *models.DraftSettings, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.DraftSettings{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.DraftSettings{
+		BansPerPlayer:  src.BansPerPlayer,
+		PicksPerPlayer: src.PicksPerPlayer,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// DraftStateToDraftStateGORM converts a models.DraftState to DraftStateGORM.
+// The optional decorator function allows custom field transformations.
+func DraftStateToDraftStateGORM(
+	src *models.DraftState,
+	dest *DraftStateGORM,
+	decorator func(*models.DraftState, *DraftStateGORM) error,
+) (out *DraftStateGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &DraftStateGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = DraftStateGORM{
+		BannedUnits: src.BannedUnits,
+		TurnsTaken:  src.TurnsTaken,
+	}
+	out = dest
+
+	if src.PickedUnits != nil {
+		out.PickedUnits = src.PickedUnits
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// DraftStateFromDraftStateGORM converts a DraftStateGORM back to models.DraftState.
+// The optional decorator function allows custom field transformations.
+func DraftStateFromDraftStateGORM(
+	dest *models.DraftState,
+	src *DraftStateGORM,
+	decorator func(dest *models.DraftState, src *DraftStateGORM) error,
+) (out *models.DraftState, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.DraftState{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.DraftState{
+		BannedUnits: src.BannedUnits,
+		PickedUnits: src.PickedUnits,
+		TurnsTaken:  src.TurnsTaken,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// PuzzleSettingsToPuzzleSettingsGORM converts a models.PuzzleSettings to PuzzleSettingsGORM.
+// The optional decorator function allows custom field transformations.
+func PuzzleSettingsToPuzzleSettingsGORM(
+	src *models.PuzzleSettings,
+	dest *PuzzleSettingsGORM,
+	decorator func(*models.PuzzleSettings, *PuzzleSettingsGORM) error,
+) (out *PuzzleSettingsGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &PuzzleSettingsGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = PuzzleSettingsGORM{
+		Goal:         src.Goal,
+		TurnBudget:   src.TurnBudget,
+		SolverPlayer: src.SolverPlayer,
+		OpponentAi:   src.OpponentAi,
+		PuzzleId:     src.PuzzleId,
+	}
+	out = dest
+
+	if src.OpponentTurns != nil {
+		out.OpponentTurns = make([]PuzzleOpponentTurnGORM, len(src.OpponentTurns))
+		for i, item := range src.OpponentTurns {
+			_, err = PuzzleOpponentTurnToPuzzleOpponentTurnGORM(item, &out.OpponentTurns[i], nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting OpponentTurns[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// PuzzleSettingsFromPuzzleSettingsGORM converts a PuzzleSettingsGORM back to models.PuzzleSettings.
+// The optional decorator function allows custom field transformations.
+func PuzzleSettingsFromPuzzleSettingsGORM(
+	dest *models.PuzzleSettings,
+	src *PuzzleSettingsGORM,
+	decorator func(dest *models.PuzzleSettings, src *PuzzleSettingsGORM) error,
+) (out *models.PuzzleSettings, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.PuzzleSettings{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.PuzzleSettings{
+		Goal:         src.Goal,
+		TurnBudget:   src.TurnBudget,
+		SolverPlayer: src.SolverPlayer,
+		OpponentAi:   src.OpponentAi,
+		PuzzleId:     src.PuzzleId,
+	}
+	out = dest
+
+	if src.OpponentTurns != nil {
+		out.OpponentTurns = make([]*models.PuzzleOpponentTurn, len(src.OpponentTurns))
+		for i, item := range src.OpponentTurns {
+			out.OpponentTurns[i], err = PuzzleOpponentTurnFromPuzzleOpponentTurnGORM(nil, &item, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting OpponentTurns[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// PuzzleOpponentTurnToPuzzleOpponentTurnGORM converts a models.PuzzleOpponentTurn to PuzzleOpponentTurnGORM.
+// The optional decorator function allows custom field transformations.
+func PuzzleOpponentTurnToPuzzleOpponentTurnGORM(
+	src *models.PuzzleOpponentTurn,
+	dest *PuzzleOpponentTurnGORM,
+	decorator func(*models.PuzzleOpponentTurn, *PuzzleOpponentTurnGORM) error,
+) (out *PuzzleOpponentTurnGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &PuzzleOpponentTurnGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = PuzzleOpponentTurnGORM{}
+	out = dest
+
+	if src.Moves != nil {
+		out.Moves = make([][]byte, len(src.Moves))
+		for i, item := range src.Moves {
+			_, err = converters.MessageToAnyBytesConverter(item, &out.Moves[i], nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// PuzzleOpponentTurnFromPuzzleOpponentTurnGORM converts a PuzzleOpponentTurnGORM back to models.PuzzleOpponentTurn.
+// The optional decorator function allows custom field transformations.
+func PuzzleOpponentTurnFromPuzzleOpponentTurnGORM(
+	dest *models.PuzzleOpponentTurn,
+	src *PuzzleOpponentTurnGORM,
+	decorator func(dest *models.PuzzleOpponentTurn, src *PuzzleOpponentTurnGORM) error,
+) (out *models.PuzzleOpponentTurn, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.PuzzleOpponentTurn{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.PuzzleOpponentTurn{}
+	out = dest
+
+	if src.Moves != nil {
+		out.Moves = make([]*models.GameMove, len(src.Moves))
+		for i, item := range src.Moves {
+			out.Moves[i], err = converters.AnyBytesToMessageConverter[*models.GameMove](nil, &item, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// SpectatorDelayToSpectatorDelayGORM converts a models.SpectatorDelay to SpectatorDelayGORM.
+// The optional decorator function allows custom field transformations.
+func SpectatorDelayToSpectatorDelayGORM(
+	src *models.SpectatorDelay,
+	dest *SpectatorDelayGORM,
+	decorator func(*models.SpectatorDelay, *SpectatorDelayGORM) error,
+) (out *SpectatorDelayGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &SpectatorDelayGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = SpectatorDelayGORM{
+		Turns:   src.Turns,
+		Seconds: src.Seconds,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// SpectatorDelayFromSpectatorDelayGORM converts a SpectatorDelayGORM back to models.SpectatorDelay.
+// The optional decorator function allows custom field transformations.
+func SpectatorDelayFromSpectatorDelayGORM(
+	dest *models.SpectatorDelay,
+	src *SpectatorDelayGORM,
+	decorator func(dest *models.SpectatorDelay, src *SpectatorDelayGORM) error,
+) (out *models.SpectatorDelay, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.SpectatorDelay{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.SpectatorDelay{
+		Turns:   src.Turns,
+		Seconds: src.Seconds,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// SandboxSettingsToSandboxSettingsGORM converts a models.SandboxSettings to SandboxSettingsGORM.
+// The optional decorator function allows custom field transformations.
+func SandboxSettingsToSandboxSettingsGORM(
+	src *models.SandboxSettings,
+	dest *SandboxSettingsGORM,
+	decorator func(*models.SandboxSettings, *SandboxSettingsGORM) error,
+) (out *SandboxSettingsGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &SandboxSettingsGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = SandboxSettingsGORM{
+		Enabled:          src.Enabled,
+		RelaxTurnOrder:   src.RelaxTurnOrder,
+		RelaxActionOrder: src.RelaxActionOrder,
+		InfiniteCoins:    src.InfiniteCoins,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// SandboxSettingsFromSandboxSettingsGORM converts a SandboxSettingsGORM back to models.SandboxSettings.
+// The optional decorator function allows custom field transformations.
+func SandboxSettingsFromSandboxSettingsGORM(
+	dest *models.SandboxSettings,
+	src *SandboxSettingsGORM,
+	decorator func(dest *models.SandboxSettings, src *SandboxSettingsGORM) error,
+) (out *models.SandboxSettings, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.SandboxSettings{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.SandboxSettings{
+		Enabled:          src.Enabled,
+		RelaxTurnOrder:   src.RelaxTurnOrder,
+		RelaxActionOrder: src.RelaxActionOrder,
+		InfiniteCoins:    src.InfiniteCoins,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// ConstructionProgressToConstructionProgressGORM converts a models.ConstructionProgress to ConstructionProgressGORM.
+// The optional decorator function allows custom field transformations.
+func ConstructionProgressToConstructionProgressGORM(
+	src *models.ConstructionProgress,
+	dest *ConstructionProgressGORM,
+	decorator func(*models.ConstructionProgress, *ConstructionProgressGORM) error,
+) (out *ConstructionProgressGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &ConstructionProgressGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = ConstructionProgressGORM{
+		UnitQ:          src.UnitQ,
+		UnitR:          src.UnitR,
+		Player:         src.Player,
+		TargetTerrain:  src.TargetTerrain,
+		TurnsRemaining: src.TurnsRemaining,
+		StartedTurn:    src.StartedTurn,
+		UnitId:         src.UnitId,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// ConstructionProgressFromConstructionProgressGORM converts a ConstructionProgressGORM back to models.ConstructionProgress.
+// The optional decorator function allows custom field transformations.
+func ConstructionProgressFromConstructionProgressGORM(
+	dest *models.ConstructionProgress,
+	src *ConstructionProgressGORM,
+	decorator func(dest *models.ConstructionProgress, src *ConstructionProgressGORM) error,
+) (out *models.ConstructionProgress, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.ConstructionProgress{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.ConstructionProgress{
+		UnitQ:          src.UnitQ,
+		UnitR:          src.UnitR,
+		Player:         src.Player,
+		TargetTerrain:  src.TargetTerrain,
+		TurnsRemaining: src.TurnsRemaining,
+		StartedTurn:    src.StartedTurn,
+		UnitId:         src.UnitId,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// TileHazardToTileHazardGORM converts a models.TileHazard to TileHazardGORM.
+// The optional decorator function allows custom field transformations.
+func TileHazardToTileHazardGORM(
+	src *models.TileHazard,
+	dest *TileHazardGORM,
+	decorator func(*models.TileHazard, *TileHazardGORM) error,
+) (out *TileHazardGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &TileHazardGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = TileHazardGORM{
+		Damage:        src.Damage,
+		StopsMovement: src.StopsMovement,
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// TileHazardFromTileHazardGORM converts a TileHazardGORM back to models.TileHazard.
+// The optional decorator function allows custom field transformations.
+func TileHazardFromTileHazardGORM(
+	dest *models.TileHazard,
+	src *TileHazardGORM,
+	decorator func(dest *models.TileHazard, src *TileHazardGORM) error,
+) (out *models.TileHazard, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.TileHazard{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.TileHazard{
+		Damage:        src.Damage,
+		StopsMovement: src.StopsMovement,
 	}
 	out = dest
 
@@ -1637,6 +2781,7 @@ func GameMoveToGameMoveGORM(
 		SequenceNum: src.SequenceNum,
 		IsPermanent: src.IsPermanent,
 		Description: src.Description,
+		SubmittedBy: src.SubmittedBy,
 	}
 	out = dest
 
@@ -1687,6 +2832,7 @@ func GameMoveFromGameMoveGORM(
 		SequenceNum: src.SequenceNum,
 		IsPermanent: src.IsPermanent,
 		Description: src.Description,
+		SubmittedBy: src.SubmittedBy,
 	}
 	out = dest
 
@@ -26,6 +26,9 @@ type TileGORM struct {
 	Shortcut         string
 	LastActedTurn    int32
 	LastToppedupTurn int32
+	Construction     ConstructionProgressGORM
+	Hazard           TileHazardGORM
+	VictoryPoints    int32
 }
 
 // Value implements driver.Valuer for TileGORM
@@ -98,6 +101,10 @@ type UnitGORM struct {
 	ProgressionStep         int32
 	ChosenAlternative       string
 	CaptureStartedTurn      int32
+	Submerged               bool
+	Facing                  int32
+	Id                      int32
+	StepActions             int32
 }
 
 // Value implements driver.Valuer for UnitGORM
@@ -171,6 +178,12 @@ type WorldGORM struct {
 	PreviewUrls       []string `gorm:"serializer:json"`
 	DefaultGameConfig GameConfigurationGORM
 	SearchIndexInfo   IndexInfoGORM `gorm:"embedded;embeddedPrefix:search_index_"`
+	Rating            WorldRatingGORM
+	RulesOverrides    RulesOverridesGORM
+	Orientation       string
+	DeletedAt         time.Time
+	RandomMap         RandomMapGORM
+	AttachedGameId    string
 }
 
 // TableName returns the table name for WorldGORM
@@ -178,6 +191,107 @@ func (*WorldGORM) TableName() string {
 	return "worlds"
 }
 
+// WorldRatingGORM is the GORM model for lilbattle.v1.WorldRating
+type WorldRatingGORM struct {
+	HumanPlayer      int32
+	Simulations      int32
+	HumanWinRate     float64
+	AiWinRate        float64
+	AverageTurns     float64
+	Difficulty       string
+	Label            string
+	RulesHash        string
+	AiVersion        string
+	RatedAt          time.Time
+	WorldDataVersion int64
+}
+
+// Value implements driver.Valuer for WorldRatingGORM
+func (m WorldRatingGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for WorldRatingGORM
+func (m *WorldRatingGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan WorldRatingGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// RandomMapGORM is the GORM model for lilbattle.v1.RandomMap
+type RandomMapGORM struct {
+	Size       string
+	NumPlayers int32
+	Seed       int64
+}
+
+// Value implements driver.Valuer for RandomMapGORM
+func (m RandomMapGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for RandomMapGORM
+func (m *RandomMapGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan RandomMapGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// RulesOverridesGORM is the GORM model for lilbattle.v1.RulesOverrides
+type RulesOverridesGORM struct {
+	TerrainMovementCosts map[int32]float64 `gorm:"serializer:json"`
+	Income               IncomeConfigGORM
+	MaxUnitsPerPlayer    int32
+}
+
+// Value implements driver.Valuer for RulesOverridesGORM
+func (m RulesOverridesGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for RulesOverridesGORM
+func (m *RulesOverridesGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan RulesOverridesGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
 // WorldDataGORM is the GORM model for lilbattle.v1.WorldData
 type WorldDataGORM struct {
 	TilesMap            map[string]TileGORM `gorm:"serializer:json"`
@@ -220,20 +334,25 @@ func (m *WorldDataGORM) Scan(value interface{}) error {
 
 // GameGORM is the GORM model for lilbattle.v1.Game
 type GameGORM struct {
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	Version         int64
-	Id              string `gorm:"primaryKey"`
-	CreatorId       string
-	WorldId         string `gorm:"index:idx_games_world_id"`
-	Name            string
-	Description     string
-	Tags            []string `gorm:"serializer:json"`
-	ImageUrl        string
-	Difficulty      string
-	Config          GameConfigurationGORM
-	PreviewUrls     []string      `gorm:"serializer:json"`
-	SearchIndexInfo IndexInfoGORM `gorm:"embedded;embeddedPrefix:search_index_"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Version          int64
+	Id               string `gorm:"primaryKey"`
+	CreatorId        string
+	WorldId          string `gorm:"index:idx_games_world_id"`
+	Name             string
+	Description      string
+	Tags             []string `gorm:"serializer:json"`
+	ImageUrl         string
+	Difficulty       string
+	Config           GameConfigurationGORM
+	PreviewUrls      []string      `gorm:"serializer:json"`
+	SearchIndexInfo  IndexInfoGORM `gorm:"embedded;embeddedPrefix:search_index_"`
+	Orientation      string
+	RandomMap        RandomMapGORM
+	Thumbnail        string
+	ForkedFromGameId string
+	ForkedAtMove     int32
 }
 
 // TableName returns the table name for GameGORM
@@ -256,6 +375,14 @@ type GameStateGORM struct {
 	WinningTeam        int32
 	CurrentGroupNumber int64
 	PlayerStates       map[int32]PlayerStateGORM `gorm:"serializer:json"`
+	ClockStartedAt     time.Time
+	ClockPaused        bool
+	PauseRequests      []int32 `gorm:"serializer:json"`
+	DelegatedTo        int32
+	Draft              DraftStateGORM
+	PuzzleResult       models.PuzzleResult
+	NextUnitId         int32
+	TurnPlans          [][]byte `gorm:"serializer:json"`
 }
 
 // TableName returns the table name for GameStateGORM
@@ -265,10 +392,12 @@ func (*GameStateGORM) TableName() string {
 
 // GameConfigurationGORM is the GORM model for lilbattle.v1.GameConfiguration
 type GameConfigurationGORM struct {
-	Players       []GamePlayerGORM
-	Teams         []GameTeamGORM
-	IncomeConfigs IncomeConfigGORM `gorm:"embedded"`
-	Settings      GameSettingsGORM
+	Players             []GamePlayerGORM
+	Teams               []GameTeamGORM
+	IncomeConfigs       IncomeConfigGORM `gorm:"embedded"`
+	Settings            GameSettingsGORM
+	WorldRulesOverrides RulesOverridesGORM
+	RulesOverrides      RulesOverridesGORM
 }
 
 // Value implements driver.Valuer for GameConfigurationGORM
@@ -340,6 +469,7 @@ type GamePlayerGORM struct {
 	Name          string
 	IsActive      bool
 	StartingCoins int32
+	Handicap      PlayerHandicapGORM
 }
 
 // Value implements driver.Valuer for GamePlayerGORM
@@ -366,6 +496,36 @@ func (m *GamePlayerGORM) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, m)
 }
 
+// PlayerHandicapGORM is the GORM model for lilbattle.v1.PlayerHandicap
+type PlayerHandicapGORM struct {
+	ExtraCoins int32
+	BonusUnits []int32
+}
+
+// Value implements driver.Valuer for PlayerHandicapGORM
+func (m PlayerHandicapGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for PlayerHandicapGORM
+func (m *PlayerHandicapGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan PlayerHandicapGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
 // GameTeamGORM is the GORM model for lilbattle.v1.GameTeam
 type GameTeamGORM struct {
 	TeamId   int32
@@ -400,16 +560,30 @@ func (m *GameTeamGORM) Scan(value interface{}) error {
 
 // GameSettingsGORM is the GORM model for lilbattle.v1.GameSettings
 type GameSettingsGORM struct {
-	AllowedUnits  []int32 `gorm:"serializer:json"`
-	TurnTimeLimit int32
-	TeamMode      string
-	MaxTurns      int32
+	AllowedUnits       []int32 `gorm:"serializer:json"`
+	TurnTimeLimit      int32
+	TeamMode           string
+	MaxTurns           int32
+	TimeBank           TimeBankSettingsGORM
+	StealthEnabled     bool
+	Rated              bool
+	FogEnabled         bool
+	Draft              DraftSettingsGORM
+	Puzzle             PuzzleSettingsGORM
+	AllowFriendlyFire  bool
+	VictoryPointsToWin int32
+	SimultaneousTurns  bool
+	SpectatorDelay     SpectatorDelayGORM
+	Sandbox            SandboxSettingsGORM
 }
 
 // PlayerStateGORM is the GORM model for lilbattle.v1.PlayerState
 type PlayerStateGORM struct {
-	Coins    int32
-	IsActive bool
+	Coins           int32
+	IsActive        bool
+	TimeBankMs      int64
+	VictoryPoints   int32
+	DiscoveredHexes []string `gorm:"serializer:json"`
 }
 
 // Value implements driver.Valuer for PlayerStateGORM
@@ -436,6 +610,288 @@ func (m *PlayerStateGORM) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, m)
 }
 
+// TimeBankSettingsGORM is the GORM model for lilbattle.v1.TimeBankSettings
+type TimeBankSettingsGORM struct {
+	InitialSeconds   int32
+	IncrementSeconds int32
+	OnTimeout        models.TimeoutAction
+}
+
+// Value implements driver.Valuer for TimeBankSettingsGORM
+func (m TimeBankSettingsGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for TimeBankSettingsGORM
+func (m *TimeBankSettingsGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan TimeBankSettingsGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// DraftSettingsGORM is the GORM model for lilbattle.v1.DraftSettings
+type DraftSettingsGORM struct {
+	BansPerPlayer  int32
+	PicksPerPlayer int32
+}
+
+// Value implements driver.Valuer for DraftSettingsGORM
+func (m DraftSettingsGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for DraftSettingsGORM
+func (m *DraftSettingsGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan DraftSettingsGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// DraftStateGORM is the GORM model for lilbattle.v1.DraftState
+type DraftStateGORM struct {
+	BannedUnits []int32         `gorm:"serializer:json"`
+	PickedUnits map[int32]int32 `gorm:"serializer:json"`
+	TurnsTaken  int32
+}
+
+// Value implements driver.Valuer for DraftStateGORM
+func (m DraftStateGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for DraftStateGORM
+func (m *DraftStateGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan DraftStateGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// PuzzleSettingsGORM is the GORM model for lilbattle.v1.PuzzleSettings
+type PuzzleSettingsGORM struct {
+	Goal          string
+	TurnBudget    int32
+	SolverPlayer  int32
+	OpponentTurns []PuzzleOpponentTurnGORM `gorm:"serializer:json"`
+	OpponentAi    bool
+	PuzzleId      string
+}
+
+// Value implements driver.Valuer for PuzzleSettingsGORM
+func (m PuzzleSettingsGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for PuzzleSettingsGORM
+func (m *PuzzleSettingsGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan PuzzleSettingsGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// PuzzleOpponentTurnGORM is the GORM model for lilbattle.v1.PuzzleOpponentTurn
+type PuzzleOpponentTurnGORM struct {
+	Moves [][]byte `gorm:"serializer:json"`
+}
+
+// Value implements driver.Valuer for PuzzleOpponentTurnGORM
+func (m PuzzleOpponentTurnGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for PuzzleOpponentTurnGORM
+func (m *PuzzleOpponentTurnGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan PuzzleOpponentTurnGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// SpectatorDelayGORM is the GORM model for lilbattle.v1.SpectatorDelay
+type SpectatorDelayGORM struct {
+	Turns   int32
+	Seconds int32
+}
+
+// Value implements driver.Valuer for SpectatorDelayGORM
+func (m SpectatorDelayGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for SpectatorDelayGORM
+func (m *SpectatorDelayGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan SpectatorDelayGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// SandboxSettingsGORM is the GORM model for lilbattle.v1.SandboxSettings
+type SandboxSettingsGORM struct {
+	Enabled          bool
+	RelaxTurnOrder   bool
+	RelaxActionOrder bool
+	InfiniteCoins    bool
+}
+
+// Value implements driver.Valuer for SandboxSettingsGORM
+func (m SandboxSettingsGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for SandboxSettingsGORM
+func (m *SandboxSettingsGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan SandboxSettingsGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// ConstructionProgressGORM is the GORM model for lilbattle.v1.ConstructionProgress
+type ConstructionProgressGORM struct {
+	UnitQ          int32
+	UnitR          int32
+	Player         int32
+	TargetTerrain  int32
+	TurnsRemaining int32
+	StartedTurn    int32
+	UnitId         int32
+}
+
+// Value implements driver.Valuer for ConstructionProgressGORM
+func (m ConstructionProgressGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for ConstructionProgressGORM
+func (m *ConstructionProgressGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan ConstructionProgressGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// TileHazardGORM is the GORM model for lilbattle.v1.TileHazard
+type TileHazardGORM struct {
+	Damage        int32
+	StopsMovement bool
+}
+
+// Value implements driver.Valuer for TileHazardGORM
+func (m TileHazardGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for TileHazardGORM
+func (m *TileHazardGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan TileHazardGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
 // GameWorldDataGORM is the GORM model for lilbattle.v1.WorldData
 type GameWorldDataGORM struct {
 	TilesMap            map[string]TileGORM `gorm:"serializer:json"`
@@ -462,10 +918,10 @@ type GameMoveGroupGORM struct {
 
 // GameMoveGORM is the GORM model for lilbattle.v1.GameMove
 type GameMoveGORM struct {
-	Player      int32
 	GameId      string `gorm:"primaryKey;index:idx_game_moves_game_id;index:idx_game_moves_lookup,priority:1"`
-	GroupNumber int64  `gorm:"primaryKey;index:idx_game_moves_lookup,priority:2"`
-	MoveNumber  int64  `gorm:"primaryKey"`
+	Player      int32
+	GroupNumber int64 `gorm:"primaryKey;index:idx_game_moves_lookup,priority:2"`
+	MoveNumber  int64 `gorm:"primaryKey"`
 	Timestamp   time.Time
 	Version     int64
 	MoveType    []byte `gorm:"serializer:json"`
@@ -473,6 +929,7 @@ type GameMoveGORM struct {
 	IsPermanent bool
 	Changes     [][]byte `gorm:"serializer:json"`
 	Description string
+	SubmittedBy int32
 }
 
 // TableName returns the table name for GameMoveGORM
@@ -219,6 +219,24 @@ func (c *GameViewerPageClient) ShowCaptureEffect(ctx context.Context, req *v1mod
 	)
 }
 
+// ShowCoachVerdict calls the browser-provided ShowCoachVerdict method synchronously.
+// The JavaScript implementation returns the result directly (SYNC invocation style).
+func (c *GameViewerPageClient) ShowCoachVerdict(ctx context.Context, req *v1models.ShowCoachVerdictRequest) (*v1models.ShowCoachVerdictResponse, error) {
+	// SYNC invocation style: browser method returns immediately
+	return wasm.CallBrowserService[*v1models.ShowCoachVerdictRequest, *v1models.ShowCoachVerdictResponse](
+		c.channel, ctx, "GameViewerPage", "showCoachVerdict", req,
+	)
+}
+
+// ShowTurnObligations calls the browser-provided ShowTurnObligations method synchronously.
+// The JavaScript implementation returns the result directly (SYNC invocation style).
+func (c *GameViewerPageClient) ShowTurnObligations(ctx context.Context, req *v1models.ShowTurnObligationsRequest) (*v1models.ShowTurnObligationsResponse, error) {
+	// SYNC invocation style: browser method returns immediately
+	return wasm.CallBrowserService[*v1models.ShowTurnObligationsRequest, *v1models.ShowTurnObligationsResponse](
+		c.channel, ctx, "GameViewerPage", "showTurnObligations", req,
+	)
+}
+
 // SetAllowedPanels calls the browser-provided SetAllowedPanels method synchronously.
 // The JavaScript implementation returns the result directly (SYNC invocation style).
 func (c *GameViewerPageClient) SetAllowedPanels(ctx context.Context, req *v1models.SetAllowedPanelsRequest) (*v1models.SetAllowedPanelsResponse, error) {
@@ -24,6 +24,7 @@ type Lilbattle_v1ServicesExports struct {
 	IndexerService              IndexerServiceServer
 	SingletonInitializerService SingletonInitializerServiceServer
 	GameViewPresenter           GameViewPresenterServer
+	PuzzlesService              PuzzlesServiceServer
 	GameSyncService             GameSyncServiceServer
 	WorldsService               WorldsServiceServer
 
@@ -93,6 +94,33 @@ func (exports *Lilbattle_v1ServicesExports) RegisterAPI() {
 			"joinGame": js.FuncOf(func(this js.Value, args []js.Value) any {
 				return exports.gamesServiceJoinGame(this, args)
 			}),
+			"setClockPaused": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceSetClockPaused(this, args)
+			}),
+			"delegateTurn": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceDelegateTurn(this, args)
+			}),
+			"claimNoContactDraw": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceClaimNoContactDraw(this, args)
+			}),
+			"draftUnit": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceDraftUnit(this, args)
+			}),
+			"getStateDiff": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceGetStateDiff(this, args)
+			}),
+			"forkGame": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceForkGame(this, args)
+			}),
+			"getStateAtTurn": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceGetStateAtTurn(this, args)
+			}),
+			"getNotificationPreferences": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceGetNotificationPreferences(this, args)
+			}),
+			"setNotificationPreferences": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceSetNotificationPreferences(this, args)
+			}),
 		},
 		"indexerService": map[string]interface{}{
 			"ensureIndexState": js.FuncOf(func(this js.Value, args []js.Value) any {
@@ -107,6 +135,9 @@ func (exports *Lilbattle_v1ServicesExports) RegisterAPI() {
 			"deleteIndexStates": js.FuncOf(func(this js.Value, args []js.Value) any {
 				return exports.indexerServiceDeleteIndexStates(this, args)
 			}),
+			"getMetaStats": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.indexerServiceGetMetaStats(this, args)
+			}),
 		},
 		"singletonInitializerService": map[string]interface{}{
 			"initializeSingleton": js.FuncOf(func(this js.Value, args []js.Value) any {
@@ -135,6 +166,44 @@ func (exports *Lilbattle_v1ServicesExports) RegisterAPI() {
 			"applyRemoteChanges": js.FuncOf(func(this js.Value, args []js.Value) any {
 				return exports.gameViewPresenterApplyRemoteChanges(this, args)
 			}),
+			"showPing": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gameViewPresenterShowPing(this, args)
+			}),
+			"saveSlot": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gameViewPresenterSaveSlot(this, args)
+			}),
+			"loadSlot": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gameViewPresenterLoadSlot(this, args)
+			}),
+			"listSlots": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gameViewPresenterListSlots(this, args)
+			}),
+			"exploreFromHere": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gameViewPresenterExploreFromHere(this, args)
+			}),
+		},
+		"puzzlesService": map[string]interface{}{
+			"createPuzzle": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.puzzlesServiceCreatePuzzle(this, args)
+			}),
+			"listPuzzles": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.puzzlesServiceListPuzzles(this, args)
+			}),
+			"getPuzzle": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.puzzlesServiceGetPuzzle(this, args)
+			}),
+			"updatePuzzle": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.puzzlesServiceUpdatePuzzle(this, args)
+			}),
+			"deletePuzzle": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.puzzlesServiceDeletePuzzle(this, args)
+			}),
+			"playPuzzle": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.puzzlesServicePlayPuzzle(this, args)
+			}),
+			"listPuzzleAttempts": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.puzzlesServiceListPuzzleAttempts(this, args)
+			}),
 		},
 		"gameSyncService": map[string]interface{}{
 			"subscribe": js.FuncOf(func(this js.Value, args []js.Value) any {
@@ -143,6 +212,9 @@ func (exports *Lilbattle_v1ServicesExports) RegisterAPI() {
 			"broadcast": js.FuncOf(func(this js.Value, args []js.Value) any {
 				return exports.gameSyncServiceBroadcast(this, args)
 			}),
+			"sendPing": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gameSyncServiceSendPing(this, args)
+			}),
 		},
 		"worldsService": map[string]interface{}{
 			"createWorld": js.FuncOf(func(this js.Value, args []js.Value) any {
@@ -163,6 +235,15 @@ func (exports *Lilbattle_v1ServicesExports) RegisterAPI() {
 			"updateWorld": js.FuncOf(func(this js.Value, args []js.Value) any {
 				return exports.worldsServiceUpdateWorld(this, args)
 			}),
+			"setWorldRulesOverrides": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.worldsServiceSetWorldRulesOverrides(this, args)
+			}),
+			"applyWorldEdits": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.worldsServiceApplyWorldEdits(this, args)
+			}),
+			"getWorldTiles": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.worldsServiceGetWorldTiles(this, args)
+			}),
 		},
 	}
 	js.Global().Set("lilbattle", js.ValueOf(lilbattle))
@@ -990,10 +1071,10 @@ func (exports *Lilbattle_v1ServicesExports) gamesServiceJoinGame(this js.Value,
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// indexerServiceEnsureIndexState handles the EnsureIndexState method for IndexerService
-func (exports *Lilbattle_v1ServicesExports) indexerServiceEnsureIndexState(this js.Value, args []js.Value) any {
-	if exports.IndexerService == nil {
-		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
+// gamesServiceSetClockPaused handles the SetClockPaused method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceSetClockPaused(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1006,7 +1087,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceEnsureIndexState(this
 	}
 
 	// Parse request
-	req := &v1models.EnsureIndexStateRequest{}
+	req := &v1models.SetClockPausedRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1020,7 +1101,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceEnsureIndexState(this
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.IndexerService.EnsureIndexState(ctx, req)
+	resp, err := exports.GamesService.SetClockPaused(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1038,10 +1119,10 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceEnsureIndexState(this
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// indexerServiceGetIndexStates handles the GetIndexStates method for IndexerService
-func (exports *Lilbattle_v1ServicesExports) indexerServiceGetIndexStates(this js.Value, args []js.Value) any {
-	if exports.IndexerService == nil {
-		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
+// gamesServiceDelegateTurn handles the DelegateTurn method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceDelegateTurn(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1054,7 +1135,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceGetIndexStates(this js
 	}
 
 	// Parse request
-	req := &v1models.GetIndexStatesRequest{}
+	req := &v1models.DelegateTurnRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1068,7 +1149,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceGetIndexStates(this js
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.IndexerService.GetIndexStates(ctx, req)
+	resp, err := exports.GamesService.DelegateTurn(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1086,10 +1167,10 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceGetIndexStates(this js
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// indexerServiceListIndexStates handles the ListIndexStates method for IndexerService
-func (exports *Lilbattle_v1ServicesExports) indexerServiceListIndexStates(this js.Value, args []js.Value) any {
-	if exports.IndexerService == nil {
-		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
+// gamesServiceClaimNoContactDraw handles the ClaimNoContactDraw method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceClaimNoContactDraw(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1102,7 +1183,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceListIndexStates(this j
 	}
 
 	// Parse request
-	req := &v1models.ListIndexStatesRequest{}
+	req := &v1models.ClaimNoContactDrawRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1116,7 +1197,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceListIndexStates(this j
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.IndexerService.ListIndexStates(ctx, req)
+	resp, err := exports.GamesService.ClaimNoContactDraw(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1134,10 +1215,10 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceListIndexStates(this j
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// indexerServiceDeleteIndexStates handles the DeleteIndexStates method for IndexerService
-func (exports *Lilbattle_v1ServicesExports) indexerServiceDeleteIndexStates(this js.Value, args []js.Value) any {
-	if exports.IndexerService == nil {
-		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
+// gamesServiceDraftUnit handles the DraftUnit method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceDraftUnit(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1150,7 +1231,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceDeleteIndexStates(this
 	}
 
 	// Parse request
-	req := &v1models.DeleteIndexStatesRequest{}
+	req := &v1models.DraftUnitRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1164,7 +1245,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceDeleteIndexStates(this
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.IndexerService.DeleteIndexStates(ctx, req)
+	resp, err := exports.GamesService.DraftUnit(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1182,10 +1263,10 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceDeleteIndexStates(this
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// singletonInitializerServiceInitializeSingleton handles the InitializeSingleton method for SingletonInitializerService
-func (exports *Lilbattle_v1ServicesExports) singletonInitializerServiceInitializeSingleton(this js.Value, args []js.Value) any {
-	if exports.SingletonInitializerService == nil {
-		return wasm.CreateJSResponse(false, "SingletonInitializerService not initialized", nil)
+// gamesServiceGetStateDiff handles the GetStateDiff method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceGetStateDiff(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1198,7 +1279,7 @@ func (exports *Lilbattle_v1ServicesExports) singletonInitializerServiceInitializ
 	}
 
 	// Parse request
-	req := &v1models.InitializeSingletonRequest{}
+	req := &v1models.GetStateDiffRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1212,7 +1293,7 @@ func (exports *Lilbattle_v1ServicesExports) singletonInitializerServiceInitializ
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.SingletonInitializerService.InitializeSingleton(ctx, req)
+	resp, err := exports.GamesService.GetStateDiff(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1230,10 +1311,10 @@ func (exports *Lilbattle_v1ServicesExports) singletonInitializerServiceInitializ
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterInitializeGame handles the InitializeGame method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterInitializeGame(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// gamesServiceForkGame handles the ForkGame method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceForkGame(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1246,7 +1327,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterInitializeGame(this
 	}
 
 	// Parse request
-	req := &v1models.InitializeGameRequest{}
+	req := &v1models.ForkGameRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1260,7 +1341,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterInitializeGame(this
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.GameViewPresenter.InitializeGame(ctx, req)
+	resp, err := exports.GamesService.ForkGame(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1278,70 +1359,58 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterInitializeGame(this
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterClientReady handles the ClientReady method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterClientReady(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// gamesServiceGetStateAtTurn handles the GetStateAtTurn method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceGetStateAtTurn(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
-	// Promise method: returns JS Promise, executes in goroutine
+	// Synchronous method
 	if len(args) < 1 {
-		return wasm.CreateRejectedPromise("Request JSON required")
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
 	}
 
 	requestJSON := args[0].String()
 	if requestJSON == "" {
-		return wasm.CreateRejectedPromise("Request JSON is empty")
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
 	}
 
 	// Parse request
-	req := &v1models.ClientReadyRequest{}
+	req := &v1models.GetStateAtTurnRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
-		AllowPartial:   true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
 	}); err != nil {
-		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
 	}
 
-	// Return Promise immediately, work happens in goroutine
-	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
-		go func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancel()
-
-			// Call service method
-			resp, err := exports.GameViewPresenter.ClientReady(ctx, req)
-			if err != nil {
-				reject(err.Error())
-				return
-			}
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
 
-			// Marshal response
-			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
-				UseProtoNames:   false,
-				EmitUnpopulated: true,
-				UseEnumNumbers:  false,
-			})
-			if err != nil {
-				reject(fmt.Sprintf("Failed to marshal response: %v", err))
-				return
-			}
+	// Call service method
+	resp, err := exports.GamesService.GetStateAtTurn(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
 
-			// Convert JSON to JavaScript object and resolve
-			var jsObject interface{}
-			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
-				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
-				return
-			}
-			resolve(js.ValueOf(jsObject))
-		}()
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
 	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterSceneClicked handles the SceneClicked method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSceneClicked(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// gamesServiceGetNotificationPreferences handles the GetNotificationPreferences method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceGetNotificationPreferences(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1354,7 +1423,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSceneClicked(this j
 	}
 
 	// Parse request
-	req := &v1models.SceneClickedRequest{}
+	req := &v1models.GetNotificationPreferencesRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1368,7 +1437,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSceneClicked(this j
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.GameViewPresenter.SceneClicked(ctx, req)
+	resp, err := exports.GamesService.GetNotificationPreferences(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1386,10 +1455,10 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSceneClicked(this j
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterTurnOptionClicked handles the TurnOptionClicked method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterTurnOptionClicked(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// gamesServiceSetNotificationPreferences handles the SetNotificationPreferences method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceSetNotificationPreferences(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1402,7 +1471,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterTurnOptionClicked(t
 	}
 
 	// Parse request
-	req := &v1models.TurnOptionClickedRequest{}
+	req := &v1models.SetNotificationPreferencesRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1416,7 +1485,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterTurnOptionClicked(t
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.GameViewPresenter.TurnOptionClicked(ctx, req)
+	resp, err := exports.GamesService.SetNotificationPreferences(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1434,10 +1503,10 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterTurnOptionClicked(t
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterEndTurnButtonClicked handles the EndTurnButtonClicked method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterEndTurnButtonClicked(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// indexerServiceEnsureIndexState handles the EnsureIndexState method for IndexerService
+func (exports *Lilbattle_v1ServicesExports) indexerServiceEnsureIndexState(this js.Value, args []js.Value) any {
+	if exports.IndexerService == nil {
+		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1450,7 +1519,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterEndTurnButtonClicke
 	}
 
 	// Parse request
-	req := &v1models.EndTurnButtonClickedRequest{}
+	req := &v1models.EnsureIndexStateRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1464,7 +1533,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterEndTurnButtonClicke
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.GameViewPresenter.EndTurnButtonClicked(ctx, req)
+	resp, err := exports.IndexerService.EnsureIndexState(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1482,10 +1551,10 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterEndTurnButtonClicke
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterBuildOptionClicked handles the BuildOptionClicked method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterBuildOptionClicked(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// indexerServiceGetIndexStates handles the GetIndexStates method for IndexerService
+func (exports *Lilbattle_v1ServicesExports) indexerServiceGetIndexStates(this js.Value, args []js.Value) any {
+	if exports.IndexerService == nil {
+		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1498,7 +1567,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterBuildOptionClicked(
 	}
 
 	// Parse request
-	req := &v1models.BuildOptionClickedRequest{}
+	req := &v1models.GetIndexStatesRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1512,7 +1581,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterBuildOptionClicked(
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.GameViewPresenter.BuildOptionClicked(ctx, req)
+	resp, err := exports.IndexerService.GetIndexStates(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1530,72 +1599,1200 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterBuildOptionClicked(
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterApplyRemoteChanges handles the ApplyRemoteChanges method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterApplyRemoteChanges(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// indexerServiceListIndexStates handles the ListIndexStates method for IndexerService
+func (exports *Lilbattle_v1ServicesExports) indexerServiceListIndexStates(this js.Value, args []js.Value) any {
+	if exports.IndexerService == nil {
+		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
 	}
-	// Promise method: returns JS Promise, executes in goroutine
+	// Synchronous method
 	if len(args) < 1 {
-		return wasm.CreateRejectedPromise("Request JSON required")
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
 	}
 
 	requestJSON := args[0].String()
 	if requestJSON == "" {
-		return wasm.CreateRejectedPromise("Request JSON is empty")
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
 	}
 
 	// Parse request
-	req := &v1models.ApplyRemoteChangesRequest{}
+	req := &v1models.ListIndexStatesRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
-		AllowPartial:   true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
 	}); err != nil {
-		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
 	}
 
-	// Return Promise immediately, work happens in goroutine
-	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
-		go func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancel()
-
-			// Call service method
-			resp, err := exports.GameViewPresenter.ApplyRemoteChanges(ctx, req)
-			if err != nil {
-				reject(err.Error())
-				return
-			}
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
 
-			// Marshal response
-			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
-				UseProtoNames:   false,
-				EmitUnpopulated: true,
-				UseEnumNumbers:  false,
-			})
-			if err != nil {
-				reject(fmt.Sprintf("Failed to marshal response: %v", err))
-				return
-			}
+	// Call service method
+	resp, err := exports.IndexerService.ListIndexStates(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
 
-			// Convert JSON to JavaScript object and resolve
-			var jsObject interface{}
-			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
-				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
-				return
-			}
-			resolve(js.ValueOf(jsObject))
-		}()
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
 	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameSyncServiceSubscribe handles the Subscribe method for GameSyncService
-func (exports *Lilbattle_v1ServicesExports) gameSyncServiceSubscribe(this js.Value, args []js.Value) any {
-	if exports.GameSyncService == nil {
-		return wasm.CreateJSResponse(false, "GameSyncService not initialized", nil)
+// indexerServiceDeleteIndexStates handles the DeleteIndexStates method for IndexerService
+func (exports *Lilbattle_v1ServicesExports) indexerServiceDeleteIndexStates(this js.Value, args []js.Value) any {
+	if exports.IndexerService == nil {
+		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
 	}
-	// Server streaming method: expect request JSON and callback function
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.DeleteIndexStatesRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.IndexerService.DeleteIndexStates(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// indexerServiceGetMetaStats handles the GetMetaStats method for IndexerService
+func (exports *Lilbattle_v1ServicesExports) indexerServiceGetMetaStats(this js.Value, args []js.Value) any {
+	if exports.IndexerService == nil {
+		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.GetMetaStatsRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.IndexerService.GetMetaStats(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// singletonInitializerServiceInitializeSingleton handles the InitializeSingleton method for SingletonInitializerService
+func (exports *Lilbattle_v1ServicesExports) singletonInitializerServiceInitializeSingleton(this js.Value, args []js.Value) any {
+	if exports.SingletonInitializerService == nil {
+		return wasm.CreateJSResponse(false, "SingletonInitializerService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.InitializeSingletonRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.SingletonInitializerService.InitializeSingleton(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterInitializeGame handles the InitializeGame method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterInitializeGame(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.InitializeGameRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameViewPresenter.InitializeGame(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterClientReady handles the ClientReady method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterClientReady(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Promise method: returns JS Promise, executes in goroutine
+	if len(args) < 1 {
+		return wasm.CreateRejectedPromise("Request JSON required")
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateRejectedPromise("Request JSON is empty")
+	}
+
+	// Parse request
+	req := &v1models.ClientReadyRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true,
+	}); err != nil {
+		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+	}
+
+	// Return Promise immediately, work happens in goroutine
+	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// Call service method
+			resp, err := exports.GameViewPresenter.ClientReady(ctx, req)
+			if err != nil {
+				reject(err.Error())
+				return
+			}
+
+			// Marshal response
+			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+				UseProtoNames:   false,
+				EmitUnpopulated: true,
+				UseEnumNumbers:  false,
+			})
+			if err != nil {
+				reject(fmt.Sprintf("Failed to marshal response: %v", err))
+				return
+			}
+
+			// Convert JSON to JavaScript object and resolve
+			var jsObject interface{}
+			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
+				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
+				return
+			}
+			resolve(js.ValueOf(jsObject))
+		}()
+	})
+}
+
+// gameViewPresenterSceneClicked handles the SceneClicked method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSceneClicked(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.SceneClickedRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameViewPresenter.SceneClicked(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterTurnOptionClicked handles the TurnOptionClicked method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterTurnOptionClicked(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.TurnOptionClickedRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameViewPresenter.TurnOptionClicked(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterEndTurnButtonClicked handles the EndTurnButtonClicked method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterEndTurnButtonClicked(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.EndTurnButtonClickedRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameViewPresenter.EndTurnButtonClicked(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterBuildOptionClicked handles the BuildOptionClicked method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterBuildOptionClicked(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.BuildOptionClickedRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameViewPresenter.BuildOptionClicked(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterApplyRemoteChanges handles the ApplyRemoteChanges method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterApplyRemoteChanges(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Promise method: returns JS Promise, executes in goroutine
+	if len(args) < 1 {
+		return wasm.CreateRejectedPromise("Request JSON required")
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateRejectedPromise("Request JSON is empty")
+	}
+
+	// Parse request
+	req := &v1models.ApplyRemoteChangesRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true,
+	}); err != nil {
+		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+	}
+
+	// Return Promise immediately, work happens in goroutine
+	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// Call service method
+			resp, err := exports.GameViewPresenter.ApplyRemoteChanges(ctx, req)
+			if err != nil {
+				reject(err.Error())
+				return
+			}
+
+			// Marshal response
+			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+				UseProtoNames:   false,
+				EmitUnpopulated: true,
+				UseEnumNumbers:  false,
+			})
+			if err != nil {
+				reject(fmt.Sprintf("Failed to marshal response: %v", err))
+				return
+			}
+
+			// Convert JSON to JavaScript object and resolve
+			var jsObject interface{}
+			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
+				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
+				return
+			}
+			resolve(js.ValueOf(jsObject))
+		}()
+	})
+}
+
+// gameViewPresenterShowPing handles the ShowPing method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterShowPing(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Promise method: returns JS Promise, executes in goroutine
+	if len(args) < 1 {
+		return wasm.CreateRejectedPromise("Request JSON required")
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateRejectedPromise("Request JSON is empty")
+	}
+
+	// Parse request
+	req := &v1models.ShowPingRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true,
+	}); err != nil {
+		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+	}
+
+	// Return Promise immediately, work happens in goroutine
+	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// Call service method
+			resp, err := exports.GameViewPresenter.ShowPing(ctx, req)
+			if err != nil {
+				reject(err.Error())
+				return
+			}
+
+			// Marshal response
+			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+				UseProtoNames:   false,
+				EmitUnpopulated: true,
+				UseEnumNumbers:  false,
+			})
+			if err != nil {
+				reject(fmt.Sprintf("Failed to marshal response: %v", err))
+				return
+			}
+
+			// Convert JSON to JavaScript object and resolve
+			var jsObject interface{}
+			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
+				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
+				return
+			}
+			resolve(js.ValueOf(jsObject))
+		}()
+	})
+}
+
+// gameViewPresenterSaveSlot handles the SaveSlot method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSaveSlot(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Promise method: returns JS Promise, executes in goroutine
+	if len(args) < 1 {
+		return wasm.CreateRejectedPromise("Request JSON required")
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateRejectedPromise("Request JSON is empty")
+	}
+
+	// Parse request
+	req := &v1models.SaveSlotRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true,
+	}); err != nil {
+		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+	}
+
+	// Return Promise immediately, work happens in goroutine
+	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// Call service method
+			resp, err := exports.GameViewPresenter.SaveSlot(ctx, req)
+			if err != nil {
+				reject(err.Error())
+				return
+			}
+
+			// Marshal response
+			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+				UseProtoNames:   false,
+				EmitUnpopulated: true,
+				UseEnumNumbers:  false,
+			})
+			if err != nil {
+				reject(fmt.Sprintf("Failed to marshal response: %v", err))
+				return
+			}
+
+			// Convert JSON to JavaScript object and resolve
+			var jsObject interface{}
+			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
+				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
+				return
+			}
+			resolve(js.ValueOf(jsObject))
+		}()
+	})
+}
+
+// gameViewPresenterLoadSlot handles the LoadSlot method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterLoadSlot(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Promise method: returns JS Promise, executes in goroutine
+	if len(args) < 1 {
+		return wasm.CreateRejectedPromise("Request JSON required")
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateRejectedPromise("Request JSON is empty")
+	}
+
+	// Parse request
+	req := &v1models.LoadSlotRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true,
+	}); err != nil {
+		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+	}
+
+	// Return Promise immediately, work happens in goroutine
+	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// Call service method
+			resp, err := exports.GameViewPresenter.LoadSlot(ctx, req)
+			if err != nil {
+				reject(err.Error())
+				return
+			}
+
+			// Marshal response
+			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+				UseProtoNames:   false,
+				EmitUnpopulated: true,
+				UseEnumNumbers:  false,
+			})
+			if err != nil {
+				reject(fmt.Sprintf("Failed to marshal response: %v", err))
+				return
+			}
+
+			// Convert JSON to JavaScript object and resolve
+			var jsObject interface{}
+			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
+				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
+				return
+			}
+			resolve(js.ValueOf(jsObject))
+		}()
+	})
+}
+
+// gameViewPresenterListSlots handles the ListSlots method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterListSlots(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Promise method: returns JS Promise, executes in goroutine
+	if len(args) < 1 {
+		return wasm.CreateRejectedPromise("Request JSON required")
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateRejectedPromise("Request JSON is empty")
+	}
+
+	// Parse request
+	req := &v1models.ListSlotsRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true,
+	}); err != nil {
+		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+	}
+
+	// Return Promise immediately, work happens in goroutine
+	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// Call service method
+			resp, err := exports.GameViewPresenter.ListSlots(ctx, req)
+			if err != nil {
+				reject(err.Error())
+				return
+			}
+
+			// Marshal response
+			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+				UseProtoNames:   false,
+				EmitUnpopulated: true,
+				UseEnumNumbers:  false,
+			})
+			if err != nil {
+				reject(fmt.Sprintf("Failed to marshal response: %v", err))
+				return
+			}
+
+			// Convert JSON to JavaScript object and resolve
+			var jsObject interface{}
+			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
+				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
+				return
+			}
+			resolve(js.ValueOf(jsObject))
+		}()
+	})
+}
+
+// gameViewPresenterExploreFromHere handles the ExploreFromHere method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterExploreFromHere(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Promise method: returns JS Promise, executes in goroutine
+	if len(args) < 1 {
+		return wasm.CreateRejectedPromise("Request JSON required")
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateRejectedPromise("Request JSON is empty")
+	}
+
+	// Parse request
+	req := &v1models.ExploreFromHereRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true,
+	}); err != nil {
+		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+	}
+
+	// Return Promise immediately, work happens in goroutine
+	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// Call service method
+			resp, err := exports.GameViewPresenter.ExploreFromHere(ctx, req)
+			if err != nil {
+				reject(err.Error())
+				return
+			}
+
+			// Marshal response
+			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+				UseProtoNames:   false,
+				EmitUnpopulated: true,
+				UseEnumNumbers:  false,
+			})
+			if err != nil {
+				reject(fmt.Sprintf("Failed to marshal response: %v", err))
+				return
+			}
+
+			// Convert JSON to JavaScript object and resolve
+			var jsObject interface{}
+			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
+				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
+				return
+			}
+			resolve(js.ValueOf(jsObject))
+		}()
+	})
+}
+
+// puzzlesServiceCreatePuzzle handles the CreatePuzzle method for PuzzlesService
+func (exports *Lilbattle_v1ServicesExports) puzzlesServiceCreatePuzzle(this js.Value, args []js.Value) any {
+	if exports.PuzzlesService == nil {
+		return wasm.CreateJSResponse(false, "PuzzlesService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.CreatePuzzleRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.PuzzlesService.CreatePuzzle(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// puzzlesServiceListPuzzles handles the ListPuzzles method for PuzzlesService
+func (exports *Lilbattle_v1ServicesExports) puzzlesServiceListPuzzles(this js.Value, args []js.Value) any {
+	if exports.PuzzlesService == nil {
+		return wasm.CreateJSResponse(false, "PuzzlesService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.ListPuzzlesRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.PuzzlesService.ListPuzzles(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// puzzlesServiceGetPuzzle handles the GetPuzzle method for PuzzlesService
+func (exports *Lilbattle_v1ServicesExports) puzzlesServiceGetPuzzle(this js.Value, args []js.Value) any {
+	if exports.PuzzlesService == nil {
+		return wasm.CreateJSResponse(false, "PuzzlesService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.GetPuzzleRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.PuzzlesService.GetPuzzle(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// puzzlesServiceUpdatePuzzle handles the UpdatePuzzle method for PuzzlesService
+func (exports *Lilbattle_v1ServicesExports) puzzlesServiceUpdatePuzzle(this js.Value, args []js.Value) any {
+	if exports.PuzzlesService == nil {
+		return wasm.CreateJSResponse(false, "PuzzlesService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.UpdatePuzzleRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.PuzzlesService.UpdatePuzzle(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// puzzlesServiceDeletePuzzle handles the DeletePuzzle method for PuzzlesService
+func (exports *Lilbattle_v1ServicesExports) puzzlesServiceDeletePuzzle(this js.Value, args []js.Value) any {
+	if exports.PuzzlesService == nil {
+		return wasm.CreateJSResponse(false, "PuzzlesService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.DeletePuzzleRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.PuzzlesService.DeletePuzzle(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// puzzlesServicePlayPuzzle handles the PlayPuzzle method for PuzzlesService
+func (exports *Lilbattle_v1ServicesExports) puzzlesServicePlayPuzzle(this js.Value, args []js.Value) any {
+	if exports.PuzzlesService == nil {
+		return wasm.CreateJSResponse(false, "PuzzlesService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.PlayPuzzleRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.PuzzlesService.PlayPuzzle(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// puzzlesServiceListPuzzleAttempts handles the ListPuzzleAttempts method for PuzzlesService
+func (exports *Lilbattle_v1ServicesExports) puzzlesServiceListPuzzleAttempts(this js.Value, args []js.Value) any {
+	if exports.PuzzlesService == nil {
+		return wasm.CreateJSResponse(false, "PuzzlesService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.ListPuzzleAttemptsRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.PuzzlesService.ListPuzzleAttempts(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameSyncServiceSubscribe handles the Subscribe method for GameSyncService
+func (exports *Lilbattle_v1ServicesExports) gameSyncServiceSubscribe(this js.Value, args []js.Value) any {
+	if exports.GameSyncService == nil {
+		return wasm.CreateJSResponse(false, "GameSyncService not initialized", nil)
+	}
+	// Server streaming method: expect request JSON and callback function
 	if len(args) < 2 {
 		return wasm.CreateJSResponse(false, "Request JSON and callback function required for streaming method", nil)
 	}
@@ -1695,6 +2892,54 @@ func (exports *Lilbattle_v1ServicesExports) gameSyncServiceBroadcast(this js.Val
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
+// gameSyncServiceSendPing handles the SendPing method for GameSyncService
+func (exports *Lilbattle_v1ServicesExports) gameSyncServiceSendPing(this js.Value, args []js.Value) any {
+	if exports.GameSyncService == nil {
+		return wasm.CreateJSResponse(false, "GameSyncService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.SendPingRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameSyncService.SendPing(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
 // worldsServiceCreateWorld handles the CreateWorld method for WorldsService
 func (exports *Lilbattle_v1ServicesExports) worldsServiceCreateWorld(this js.Value, args []js.Value) any {
 	if exports.WorldsService == nil {
@@ -1982,3 +3227,147 @@ func (exports *Lilbattle_v1ServicesExports) worldsServiceUpdateWorld(this js.Val
 
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
+
+// worldsServiceSetWorldRulesOverrides handles the SetWorldRulesOverrides method for WorldsService
+func (exports *Lilbattle_v1ServicesExports) worldsServiceSetWorldRulesOverrides(this js.Value, args []js.Value) any {
+	if exports.WorldsService == nil {
+		return wasm.CreateJSResponse(false, "WorldsService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.SetWorldRulesOverridesRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.WorldsService.SetWorldRulesOverrides(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// worldsServiceApplyWorldEdits handles the ApplyWorldEdits method for WorldsService
+func (exports *Lilbattle_v1ServicesExports) worldsServiceApplyWorldEdits(this js.Value, args []js.Value) any {
+	if exports.WorldsService == nil {
+		return wasm.CreateJSResponse(false, "WorldsService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.ApplyWorldEditsRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.WorldsService.ApplyWorldEdits(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// worldsServiceGetWorldTiles handles the GetWorldTiles method for WorldsService
+func (exports *Lilbattle_v1ServicesExports) worldsServiceGetWorldTiles(this js.Value, args []js.Value) any {
+	if exports.WorldsService == nil {
+		return wasm.CreateJSResponse(false, "WorldsService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.GetWorldTilesRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.WorldsService.GetWorldTiles(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
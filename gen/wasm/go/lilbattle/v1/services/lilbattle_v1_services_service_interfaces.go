@@ -65,6 +65,40 @@ type GamesServiceServer interface {
 	Join a game as an open player slot
 	User must be authenticated. The player slot must be "open" to be joinable. */
 	JoinGame(context.Context, *v1models.JoinGameRequest) (*v1models.JoinGameResponse, error)
+	/** *
+	Pause or resume the game clock when time banks are enabled.
+	Pausing requires every active player to ask; any player can resume. */
+	SetClockPaused(context.Context, *v1models.SetClockPausedRequest) (*v1models.SetClockPausedResponse, error)
+	/** *
+	Hand the rest of the current turn over to a teammate (team games only).
+	The delegate may submit moves for the current player until the turn ends. */
+	DelegateTurn(context.Context, *v1models.DelegateTurnRequest) (*v1models.DelegateTurnResponse, error)
+	/** *
+	End a game that can no longer progress as a draw. Either player may
+	claim it; the server checks that no player can make contact again. */
+	ClaimNoContactDraw(context.Context, *v1models.ClaimNoContactDrawRequest) (*v1models.ClaimNoContactDrawResponse, error)
+	/** *
+	Ban or pick a unit type during a game's pre-game draft. Seats take turns
+	in player order; the game starts once every seat has drafted. */
+	DraftUnit(context.Context, *v1models.DraftUnitRequest) (*v1models.DraftUnitResponse, error)
+	/** *
+	Everything that changed in a game between two turns, reconstructed by
+	replaying its move history */
+	GetStateDiff(context.Context, *v1models.GetStateDiffRequest) (*v1models.GetStateDiffResponse, error)
+	/** *
+	Copy a game, as it is now or as it was after a number of its moves,
+	into a new private game where the caller plays every seat */
+	ForkGame(context.Context, *v1models.ForkGameRequest) (*v1models.ForkGameResponse, error)
+	/** *
+	A game's state at the start of a player's turn, rebuilt from the nearest
+	saved turn snapshot or by replaying its move history */
+	GetStateAtTurn(context.Context, *v1models.GetStateAtTurnRequest) (*v1models.GetStateAtTurnResponse, error)
+	/** *
+	The caller's notification preferences */
+	GetNotificationPreferences(context.Context, *v1models.GetNotificationPreferencesRequest) (*v1models.GetNotificationPreferencesResponse, error)
+	/** *
+	Replace the caller's notification preferences */
+	SetNotificationPreferences(context.Context, *v1models.SetNotificationPreferencesRequest) (*v1models.SetNotificationPreferencesResponse, error)
 }
 
 // IndexerServiceServer is the server API for IndexerService service (WASM version without gRPC embedding).
@@ -79,6 +113,10 @@ type IndexerServiceServer interface {
 	List index entity states by filtering */
 	ListIndexStates(context.Context, *v1models.ListIndexStatesRequest) (*v1models.ListIndexStatesResponse, error)
 	DeleteIndexStates(context.Context, *v1models.DeleteIndexStatesRequest) (*v1models.DeleteIndexStatesResponse, error)
+	/** *
+	Get unit, terrain and game length statistics aggregated from finished
+	games, per world */
+	GetMetaStats(context.Context, *v1models.GetMetaStatsRequest) (*v1models.GetMetaStatsResponse, error)
 }
 
 // SingletonInitializerServiceServer is the server API for SingletonInitializerService service (WASM version without gRPC embedding).
@@ -116,6 +154,48 @@ type GameViewPresenterServer interface {
 	This updates local game state and triggers UI updates for the received WorldChanges.
 	Used by viewers to apply moves made by other players. */
 	ApplyRemoteChanges(context.Context, *v1models.ApplyRemoteChangesRequest) (*v1models.ApplyRemoteChangesResponse, error)
+	/** *
+	Show a teammate's ping (received via SyncService subscription) as a
+	transient marker that expires on its own. */
+	ShowPing(context.Context, *v1models.ShowPingRequest) (*v1models.ShowPingResponse, error)
+	/** *
+	Saves the current game into a named save slot in the browser */
+	SaveSlot(context.Context, *v1models.SaveSlotRequest) (*v1models.SaveSlotResponse, error)
+	/** *
+	Replaces the current game with the one saved in a slot. Corrupted
+	slots are rejected without touching the current game. */
+	LoadSlot(context.Context, *v1models.LoadSlotRequest) (*v1models.LoadSlotResponse, error)
+	/** *
+	Lists the save slots in the browser */
+	ListSlots(context.Context, *v1models.ListSlotsRequest) (*v1models.ListSlotsResponse, error)
+	/** *
+	Forks the game being replayed at the move being viewed, so other lines
+	can be explored from there */
+	ExploreFromHere(context.Context, *v1models.ExploreFromHereRequest) (*v1models.ExploreFromHereResponse, error)
+}
+
+// PuzzlesServiceServer is the server API for PuzzlesService service (WASM version without gRPC embedding).
+type PuzzlesServiceServer interface {
+	/** *
+	Create a new puzzle */
+	CreatePuzzle(context.Context, *v1models.CreatePuzzleRequest) (*v1models.CreatePuzzleResponse, error)
+	/** ListPuzzles returns all puzzles */
+	ListPuzzles(context.Context, *v1models.ListPuzzlesRequest) (*v1models.ListPuzzlesResponse, error)
+	/** GetPuzzle returns a specific puzzle */
+	GetPuzzle(context.Context, *v1models.GetPuzzleRequest) (*v1models.GetPuzzleResponse, error)
+	/** *
+	Update a puzzle. Only its creator can update it. */
+	UpdatePuzzle(context.Context, *v1models.UpdatePuzzleRequest) (*v1models.UpdatePuzzleResponse, error)
+	/** *
+	Delete a puzzle. Only its creator can delete it. */
+	DeletePuzzle(context.Context, *v1models.DeletePuzzleRequest) (*v1models.DeletePuzzleResponse, error)
+	/** *
+	Start a new attempt at a puzzle: a game from the puzzle's position with
+	the caller as the solver */
+	PlayPuzzle(context.Context, *v1models.PlayPuzzleRequest) (*v1models.PlayPuzzleResponse, error)
+	/** *
+	List the caller's puzzle attempts and how they went */
+	ListPuzzleAttempts(context.Context, *v1models.ListPuzzleAttemptsRequest) (*v1models.ListPuzzleAttemptsResponse, error)
 }
 
 // GameSyncServiceServer is the server API for GameSyncService service (WASM version without gRPC embedding).
@@ -129,6 +209,10 @@ type GameSyncServiceServer interface {
 	Called internally by GamesService after ProcessMoves succeeds.
 	Not intended for direct client use. */
 	Broadcast(context.Context, *v1models.BroadcastRequest) (*v1models.BroadcastResponse, error)
+	/** SendPing marks a hex with an alert for the sender's teammates. Pings are
+	only delivered to subscribers on the same team and are rate limited per
+	player. */
+	SendPing(context.Context, *v1models.SendPingRequest) (*v1models.SendPingResponse, error)
 }
 
 // WorldsServiceServer is the server API for WorldsService service (WASM version without gRPC embedding).
@@ -148,6 +232,18 @@ type WorldsServiceServer interface {
 	DeleteWorld(context.Context, *v1models.DeleteWorldRequest) (*v1models.DeleteWorldResponse, error)
 	/** GetWorld returns a specific world with metadata */
 	UpdateWorld(context.Context, *v1models.UpdateWorldRequest) (*v1models.UpdateWorldResponse, error)
+	/** *
+	Replace a world's rules overrides (terrain movement costs and income).
+	Overrides are validated against the rules and applied to games created
+	from the world afterwards. */
+	SetWorldRulesOverrides(context.Context, *v1models.SetWorldRulesOverridesRequest) (*v1models.SetWorldRulesOverridesResponse, error)
+	/** *
+	Apply a batch of map edits (paint terrain, set owner, place or remove
+	units) to a world.  Edits are validated and applied atomically, and the
+	world data version is bumped once per batch. */
+	ApplyWorldEdits(context.Context, *v1models.ApplyWorldEditsRequest) (*v1models.ApplyWorldEditsResponse, error)
+	/** GetWorldTiles returns the tiles and units of a world, optionally within a region */
+	GetWorldTiles(context.Context, *v1models.GetWorldTilesRequest) (*v1models.GetWorldTilesResponse, error)
 }
 
 // Server stream interfaces for streaming methods
@@ -0,0 +1,244 @@
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/gormbe"
+	"github.com/turnforge/lilbattle/services/server"
+)
+
+// newGame creates a two player game on the first demo world, with
+// server.LocalUserID in both seats
+func newGame(t *testing.T, ctx context.Context, name string) *v1.Game {
+	t.Helper()
+	resp, err := backend.ClientMgr.GetGamesSvcClient().CreateGame(ctx, &v1.CreateGameRequest{Game: &v1.Game{
+		WorldId:   seed.WorldIds[0],
+		Name:      name,
+		CreatorId: server.LocalUserID,
+		Config: &v1.GameConfiguration{
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, PlayerType: "human", UserId: server.LocalUserID, IsActive: true},
+				{PlayerId: 2, PlayerType: "human", UserId: server.LocalUserID, IsActive: true},
+			},
+			IncomeConfigs: &v1.IncomeConfig{
+				StartingCoins:  300,
+				GameIncome:     100,
+				LandbaseIncome: lib.DefaultLandbaseIncome,
+			},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	return resp.Game
+}
+
+// moveSoldier moves player 1's soldier north of their base one hex east
+func moveSoldier(t *testing.T, ctx context.Context, gameId string) {
+	t.Helper()
+	_, err := backend.ClientMgr.GetGamesSvcClient().ProcessMoves(ctx, &v1.ProcessMovesRequest{
+		GameId: gameId,
+		Moves: []*v1.GameMove{{
+			Player: 1,
+			MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Q: 0, R: 1},
+				To:   &v1.Position{Q: 1, R: 1},
+			}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+}
+
+// pgGames is the games service reading Postgres directly, past its cache
+func pgGames(t *testing.T) *gormbe.GamesService {
+	t.Helper()
+	games, ok := backend.Services.Games.(*gormbe.GamesService)
+	if !ok {
+		t.Fatalf("games service is %T, want the Postgres one", backend.Services.Games)
+	}
+	return games
+}
+
+func TestCreateGameAndMove_PersistedInPostgres(t *testing.T) {
+	ctx := server.LocalContext(context.Background())
+	game := newGame(t, ctx, "Persisted Moves")
+	moveSoldier(t, ctx, game.Id)
+
+	state, err := pgGames(t).LoadGameState(ctx, game.Id)
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	units := state.WorldData.UnitsMap
+	if units["1,1"] == nil || units["0,1"] != nil {
+		t.Errorf("stored state does not have the soldier moved from 0,1 to 1,1: %v", units)
+	}
+
+	history, err := pgGames(t).LoadGameHistory(ctx, game.Id)
+	if err != nil {
+		t.Fatalf("LoadGameHistory failed: %v", err)
+	}
+	if len(history.Groups) != 1 || len(history.Groups[0].Moves) != 1 || history.Groups[0].Moves[0].GetMoveUnit() == nil {
+		t.Errorf("stored history is %v, want one group with the move", history.Groups)
+	}
+
+	moves, err := backend.ClientMgr.GetGamesSvcClient().ListMoves(ctx, &v1.ListMovesRequest{GameId: game.Id})
+	if err != nil {
+		t.Fatalf("ListMoves failed: %v", err)
+	}
+	if len(moves.MoveGroups) != 1 {
+		t.Errorf("ListMoves returned %d groups, want 1", len(moves.MoveGroups))
+	}
+}
+
+func TestEndTurns_HistoryKeepsGroupOrder(t *testing.T) {
+	ctx := server.LocalContext(context.Background())
+	game := newGame(t, ctx, "Group Order")
+	games := backend.ClientMgr.GetGamesSvcClient()
+
+	moveSoldier(t, ctx, game.Id)
+	for player := int32(1); player <= 2; player++ {
+		_, err := games.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+			GameId: game.Id,
+			Moves: []*v1.GameMove{{
+				Player:   player,
+				MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}},
+			}},
+		})
+		if err != nil {
+			t.Fatalf("EndTurn for player %d failed: %v", player, err)
+		}
+	}
+
+	history, err := pgGames(t).LoadGameHistory(ctx, game.Id)
+	if err != nil {
+		t.Fatalf("LoadGameHistory failed: %v", err)
+	}
+	if len(history.Groups) != 3 {
+		t.Fatalf("stored history has %d groups, want 3", len(history.Groups))
+	}
+	for i := 1; i < len(history.Groups); i++ {
+		if history.Groups[i].GroupNumber <= history.Groups[i-1].GroupNumber {
+			t.Errorf("groups out of order: %d after %d", history.Groups[i].GroupNumber, history.Groups[i-1].GroupNumber)
+		}
+	}
+}
+
+func TestDeleteGame_RemovesStateAndMoves(t *testing.T) {
+	ctx := server.LocalContext(context.Background())
+	game := newGame(t, ctx, "Deleted")
+	moveSoldier(t, ctx, game.Id)
+
+	if _, err := backend.ClientMgr.GetGamesSvcClient().DeleteGame(ctx, &v1.DeleteGameRequest{Id: game.Id}); err != nil {
+		t.Fatalf("DeleteGame failed: %v", err)
+	}
+	if _, err := pgGames(t).LoadGame(ctx, game.Id); err == nil {
+		t.Error("game still stored after delete")
+	}
+	if _, err := pgGames(t).LoadGameState(ctx, game.Id); err == nil {
+		t.Error("game state still stored after delete")
+	}
+	history, err := pgGames(t).LoadGameHistory(ctx, game.Id)
+	if err != nil {
+		t.Fatalf("LoadGameHistory failed: %v", err)
+	}
+	if len(history.Groups) != 0 {
+		t.Errorf("%d move groups still stored after delete", len(history.Groups))
+	}
+}
+
+func TestSync_StreamsMovesToSubscribers(t *testing.T) {
+	ctx, cancel := context.WithTimeout(server.LocalContext(context.Background()), 30*time.Second)
+	defer cancel()
+	game := newGame(t, ctx, "Synced")
+
+	stream, err := backend.ClientMgr.GetGameSyncSvcClient().Subscribe(ctx, &v1.SubscribeRequest{GameId: game.Id, PlayerId: "2"})
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("receiving the initial state failed: %v", err)
+	}
+	if first.GetInitialState() == nil {
+		t.Fatalf("first update is %v, want the initial state", first)
+	}
+
+	moveSoldier(t, ctx, game.Id)
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("no moves received before: %v", err)
+		}
+		if published := update.GetMovesPublished(); published != nil {
+			if published.Player != 1 || len(published.Moves) != 1 || published.Moves[0].GetMoveUnit() == nil {
+				t.Errorf("published %v, want player 1's move", published)
+			}
+			return
+		}
+	}
+}
+
+func TestFileStore_ThumbnailUploadAndPresignedURL(t *testing.T) {
+	ctx := server.LocalContext(context.Background())
+	game := newGame(t, ctx, "Thumbnail")
+	resp, err := backend.ClientMgr.GetGamesSvcClient().GetGame(ctx, &v1.GetGameRequest{Id: game.Id})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	thumbnail, err := services.RenderThumbnail(resp.Game, resp.State, lib.DefaultRulesEngine())
+	if err != nil {
+		t.Fatalf("RenderThumbnail failed: %v", err)
+	}
+	png, err := base64.StdEncoding.DecodeString(thumbnail)
+	if err != nil {
+		t.Fatalf("thumbnail is not base64: %v", err)
+	}
+
+	files := backend.ClientMgr.GetFileStoreSvcClient()
+	path := "thumbnails/games/" + game.Id + ".png"
+	if _, err := files.PutFile(ctx, &v1.PutFileRequest{
+		File:    &v1.File{Path: path, ContentType: "image/png"},
+		Content: png,
+	}); err != nil {
+		t.Fatalf("PutFile failed: %v", err)
+	}
+
+	got, err := files.GetFile(ctx, &v1.GetFileRequest{Path: path, IncludeSignedUrls: true})
+	if err != nil {
+		t.Fatalf("GetFile failed: %v", err)
+	}
+	if got.File.FileSize != uint64(len(png)) {
+		t.Errorf("stored file is %d bytes, want %d", got.File.FileSize, len(png))
+	}
+	url := got.File.SignedUrls["15m"]
+	if url == "" {
+		t.Fatalf("no presigned URL in %v", got.File.SignedUrls)
+	}
+
+	httpResp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("fetching the presigned URL failed: %v", err)
+	}
+	defer httpResp.Body.Close()
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		t.Fatalf("reading the presigned URL failed: %v", err)
+	}
+	if httpResp.StatusCode != http.StatusOK || !bytes.Equal(body, png) {
+		t.Errorf("presigned URL returned %d with %d bytes, want the %d byte thumbnail", httpResp.StatusCode, len(body), len(png))
+	}
+}
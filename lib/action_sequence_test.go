@@ -1,11 +1,14 @@
-package lib
+package lib_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/testfixtures"
 )
 
 // Action sequence tests verify that the action_order progression system
@@ -93,7 +96,7 @@ type ScenarioSetup struct {
 // actionSequenceTestRunner executes action sequence tests
 type actionSequenceTestRunner struct {
 	t           *testing.T
-	game        *Game
+	game        *lib.Game
 	unit        *v1.Unit
 	enemy       *v1.Unit
 	friendly    *v1.Unit
@@ -122,33 +125,26 @@ func newActionSequenceTestRunner(t *testing.T, tc ActionSequenceTestCase) *actio
 		setup.FriendlyHealth = 5
 	}
 
-	// Build game world
-	builder := newTestGameBuilder().
-		grassTiles(6).
-		currentPlayer(1).
-		seed(42)
+	// Build game world, with rules of its own to change the unit's action_order
+	builder := testfixtures.NewGameBuilder().
+		GrassTiles(6).
+		CurrentPlayer(1).
+		Seed(42).
+		With(testfixtures.WithRules(testfixtures.DefaultRules()))
 
 	// Override tiles if needed
 	if setup.OnEnemyBase {
-		builder = newTestGameBuilder().
-			tile(0, 0, TileTypeLandBase, 2). // Enemy base at origin
-			grassTiles(5).
-			currentPlayer(1).
-			seed(42)
+		builder.Tile(0, 0, lib.TileTypeLandBase, 2) // Enemy base at origin
 	} else if setup.StartOnNeutral {
-		builder = newTestGameBuilder().
-			tile(0, 0, TileTypeLandBase, 0). // Neutral base at origin
-			grassTiles(5).
-			currentPlayer(1).
-			seed(42)
+		builder.Tile(0, 0, lib.TileTypeLandBase, 0) // Neutral base at origin
 	}
 
-	game := builder.build()
+	game := builder.Build()
 
 	// Create the test unit
 	// Set LastToppedupTurn to current turn to prevent TopUpUnitIfNeeded from resetting ProgressionStep
 	unit := &v1.Unit{
-		Q: 0, R: 0, Player: 1, UnitType: testUnitTypeSoldier,
+		Q: 0, R: 0, Player: 1, UnitType: testfixtures.UnitTypeSoldier,
 		Shortcut: "A1", AvailableHealth: setup.UnitHealth,
 		DistanceLeft: setup.UnitDistance, ProgressionStep: setup.StartStep,
 		LastToppedupTurn: 1, // Match game's TurnCounter to preserve ProgressionStep
@@ -156,7 +152,7 @@ func newActionSequenceTestRunner(t *testing.T, tc ActionSequenceTestCase) *actio
 	game.World.AddUnit(unit)
 
 	// Override action_order in rules engine
-	unitDef, _ := game.RulesEngine.GetUnitData(testUnitTypeSoldier)
+	unitDef, _ := game.RulesEngine.GetUnitData(testfixtures.UnitTypeSoldier)
 	unitDef.ActionOrder = tc.ActionOrder
 
 	// If pattern contains "fix", enable fix capability on the unit
@@ -178,7 +174,7 @@ func newActionSequenceTestRunner(t *testing.T, tc ActionSequenceTestCase) *actio
 	// Create enemy at specified distance (unless NoEnemy)
 	if !setup.NoEnemy {
 		enemy := &v1.Unit{
-			Q: int32(setup.EnemyDistance), R: 0, Player: 2, UnitType: testUnitTypeSoldier,
+			Q: int32(setup.EnemyDistance), R: 0, Player: 2, UnitType: testfixtures.UnitTypeSoldier,
 			Shortcut: "B1", AvailableHealth: 10, DistanceLeft: 3,
 		}
 		game.World.AddUnit(enemy)
@@ -187,7 +183,7 @@ func newActionSequenceTestRunner(t *testing.T, tc ActionSequenceTestCase) *actio
 		// Add second enemy if needed
 		if setup.SecondEnemy {
 			enemy2 := &v1.Unit{
-				Q: 0, R: int32(setup.EnemyDistance), Player: 2, UnitType: testUnitTypeSoldier,
+				Q: 0, R: int32(setup.EnemyDistance), Player: 2, UnitType: testfixtures.UnitTypeSoldier,
 				Shortcut: "B2", AvailableHealth: 10, DistanceLeft: 3,
 			}
 			game.World.AddUnit(enemy2)
@@ -195,11 +191,11 @@ func newActionSequenceTestRunner(t *testing.T, tc ActionSequenceTestCase) *actio
 	}
 
 	// Add damaged friendly unit if needed (for fix tests)
-	// Place at (2, 0) so it's adjacent to (1, 0) where unit moves to in "move then fix" tests
+	// Place at (0, 1) so it's adjacent to (1, 0) where unit moves to in "move then fix" tests
 	// For tests without move, it's also adjacent to (0, 0) where unit starts
 	if setup.DamagedFriendly {
 		friendly := &v1.Unit{
-			Q: -1, R: 0, Player: 1, UnitType: testUnitTypeSoldier,
+			Q: 0, R: 1, Player: 1, UnitType: testfixtures.UnitTypeSoldier,
 			Shortcut: "A2", AvailableHealth: setup.FriendlyHealth, DistanceLeft: 3,
 		}
 		game.World.AddUnit(friendly)
@@ -334,7 +330,7 @@ func (r *actionSequenceTestRunner) findMoveTarget(unit *v1.Unit) (int32, int32)
 
 	for _, d := range directions {
 		targetQ, targetR := unit.Q+d.dq, unit.R+d.dr
-		coord := AxialCoord{Q: int(targetQ), R: int(targetR)}
+		coord := lib.AxialCoord{Q: int(targetQ), R: int(targetR)}
 
 		// Check tile exists and is unoccupied
 		if tile := r.game.World.TileAt(coord); tile != nil {
@@ -394,12 +390,19 @@ func TestPattern_AttackOnly(t *testing.T) {
 				// After attack, progression_step = 1, no more actions allowed
 			},
 		},
-		// NOTE: Move currently succeeds because action_order not validated at ProcessMove level
 		{
-			Name:        "missile_move_not_enforced_currently",
+			Name:        "missile_cannot_move",
 			ActionOrder: PatternAttackOnly,
 			Steps: []ActionStep{
-				{Action: "move", ExpectError: false}, // Would fail if action_order enforced
+				{Action: "move", ExpectError: true}, // "move" is not in action_order
+			},
+		},
+		{
+			Name:        "missile_cannot_attack_twice",
+			ActionOrder: PatternAttackOnly,
+			Steps: []ActionStep{
+				{Action: "attack", ExpectError: false},
+				{Action: "attack", ExpectError: true}, // Attack step already used
 			},
 		},
 	}
@@ -445,7 +448,7 @@ func TestPattern_MoveAttack(t *testing.T) {
 				{Action: "move", ExpectError: false},
 				{Action: "attack", ExpectError: false},
 				// After attack, move step is ended even if movement points remain
-				// NOTE: Currently no validation prevents another move
+				{Action: "move", ExpectError: true},
 			},
 		},
 		{
@@ -490,6 +493,16 @@ func TestPattern_MoveAttackCapture(t *testing.T) {
 				{Action: "capture", ExpectError: false},
 			},
 		},
+		{
+			Name:        "soldier_capture_ends_turn_sequence",
+			ActionOrder: PatternMoveAttackCapture,
+			Setup:       &ScenarioSetup{OnEnemyBase: true},
+			Steps: []ActionStep{
+				{Action: "capture", ExpectError: false},
+				{Action: "attack", ExpectError: true}, // attack|capture step already used
+				{Action: "move", ExpectError: true},
+			},
+		},
 		{
 			Name:        "soldier_multiple_moves_before_capture",
 			ActionOrder: PatternMoveAttackCapture,
@@ -532,6 +545,15 @@ func TestPattern_MoveOrAttack(t *testing.T) {
 				{Action: "move", ExpectError: false},
 			},
 		},
+		{
+			Name:        "artillery_move_locks_out_attack",
+			ActionOrder: PatternMoveOrAttack,
+			Setup:       &ScenarioSetup{EnemyDistance: 2},
+			Steps: []ActionStep{
+				{Action: "move", ExpectError: false},
+				{Action: "attack", ExpectError: true}, // Chose to move at the move|attack step
+			},
+		},
 		{
 			Name:        "artillery_multiple_moves_allowed",
 			ActionOrder: PatternMoveOrAttack,
@@ -652,14 +674,13 @@ func TestPattern_Engineer(t *testing.T) {
 				{Action: "capture", ExpectError: false},
 			},
 		},
-		// Fix tests - skipped until ProcessFixUnit is implemented
 		{
 			Name:        "engineer_move_then_fix",
 			ActionOrder: PatternEngineer,
 			Setup:       &ScenarioSetup{DamagedFriendly: true, NoEnemy: true},
 			Steps: []ActionStep{
 				{Action: "move", ExpectError: false},
-				{Action: "fix", ExpectError: false}, // Will skip - not implemented yet
+				{Action: "fix", ExpectError: false},
 			},
 		},
 	}
@@ -688,14 +709,13 @@ func TestPattern_Support(t *testing.T) {
 				{Action: "attack", ExpectError: false},
 			},
 		},
-		// Fix tests - skipped until ProcessFixUnit is implemented
 		{
 			Name:        "support_move_then_fix",
 			ActionOrder: PatternSupport,
 			Setup:       &ScenarioSetup{DamagedFriendly: true, NoEnemy: true},
 			Steps: []ActionStep{
 				{Action: "move", ExpectError: false},
-				{Action: "fix", ExpectError: false}, // Will skip - not implemented yet
+				{Action: "fix", ExpectError: false},
 			},
 		},
 	}
@@ -731,13 +751,13 @@ func TestPattern_Medic(t *testing.T) {
 				{Action: "capture", ExpectError: false},
 			},
 		},
-		// Medic can fix at step 0 (move|fix) without moving - skipped until ProcessFixUnit implemented
+		// Medic can fix at step 0 (move|fix) without moving
 		{
 			Name:        "medic_fix_without_moving",
 			ActionOrder: PatternMedic,
 			Setup:       &ScenarioSetup{DamagedFriendly: true, NoEnemy: true},
 			Steps: []ActionStep{
-				{Action: "fix", ExpectError: false}, // Will skip - not implemented yet
+				{Action: "fix", ExpectError: false},
 			},
 		},
 		{
@@ -746,7 +766,7 @@ func TestPattern_Medic(t *testing.T) {
 			Setup:       &ScenarioSetup{DamagedFriendly: true, NoEnemy: true},
 			Steps: []ActionStep{
 				{Action: "move", ExpectError: false},
-				{Action: "fix", ExpectError: false}, // Will skip - not implemented yet
+				{Action: "fix", ExpectError: false},
 			},
 		},
 	}
@@ -774,13 +794,12 @@ func TestPattern_Carrier(t *testing.T) {
 				{Action: "attack", ExpectError: false},
 			},
 		},
-		// Fix tests - skipped until ProcessFixUnit is implemented
 		{
 			Name:        "carrier_fix_without_moving",
 			ActionOrder: PatternCarrier,
 			Setup:       &ScenarioSetup{DamagedFriendly: true, NoEnemy: true},
 			Steps: []ActionStep{
-				{Action: "fix", ExpectError: false}, // Will skip - not implemented yet
+				{Action: "fix", ExpectError: false},
 			},
 		},
 		{
@@ -789,7 +808,7 @@ func TestPattern_Carrier(t *testing.T) {
 			Setup:       &ScenarioSetup{DamagedFriendly: true, NoEnemy: true},
 			Steps: []ActionStep{
 				{Action: "move", ExpectError: false},
-				{Action: "fix", ExpectError: false}, // Will skip - not implemented yet
+				{Action: "fix", ExpectError: false},
 			},
 		},
 	}
@@ -846,7 +865,7 @@ func TestEndTurnBehavior(t *testing.T) {
 // =============================================================================
 
 func TestGetAllowedActionsForUnit_AllPatterns(t *testing.T) {
-	rulesEngine := DefaultRulesEngine()
+	rulesEngine := lib.DefaultRulesEngine()
 
 	tests := []struct {
 		name            string
@@ -1147,6 +1166,52 @@ func TestProgressionStepAdvancement(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Enforcement Tests
+// =============================================================================
+
+func TestProcessMove_RejectsActionOutsideProgression(t *testing.T) {
+	runner := newActionSequenceTestRunner(t, ActionSequenceTestCase{
+		Name:        "missile_move",
+		ActionOrder: PatternAttackOnly,
+	})
+
+	err := runner.executeAction("move")
+	if !errors.Is(err, lib.ErrInvalidActionForProgression) {
+		t.Fatalf("move for attack-only unit: got %v, want ErrInvalidActionForProgression", err)
+	}
+	if runner.game.World.UnitAt(lib.AxialCoord{Q: 0, R: 0}) == nil {
+		t.Error("rejected move should leave the unit in place")
+	}
+}
+
+func TestGetUnitOptions_MatchesProgression(t *testing.T) {
+	runner := newActionSequenceTestRunner(t, ActionSequenceTestCase{
+		Name:        "tank_after_attack",
+		ActionOrder: PatternMoveAttack,
+		Setup:       &ScenarioSetup{UnitDistance: 5},
+	})
+
+	// Attacking from the move step skips past both steps
+	if err := runner.executeAction("attack"); err != nil {
+		t.Fatalf("attack failed: %v", err)
+	}
+	if step := runner.getProgressionStep(); step != 2 {
+		t.Errorf("after attack from move step: progression_step = %d, want 2", step)
+	}
+
+	options, _, err := runner.game.GetUnitOptions(runner.findPlayerUnit(1))
+	if err != nil {
+		t.Fatalf("GetUnitOptions failed: %v", err)
+	}
+	for _, option := range options {
+		switch option.OptionType.(type) {
+		case *v1.GameOption_Move, *v1.GameOption_Attack:
+			t.Errorf("option %T offered after the action sequence is complete", option.OptionType)
+		}
+	}
+}
+
 // =============================================================================
 // Turn Reset Tests
 // =============================================================================
@@ -0,0 +1,34 @@
+package lib
+
+import "testing"
+
+// TestGetAdjacentEnemies_SkipsFriendlies tests a unit surrounded by two
+// enemies and a friendly only gets the enemies back
+func TestGetAdjacentEnemies_SkipsFriendlies(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 2, testUnitTypeSoldier).
+		unit(0, 1, 1, testUnitTypeSoldier).
+		unit(-1, 0, 2, testUnitTypeTank).
+		unit(2, 0, 2, testUnitTypeSoldier). // Not adjacent
+		currentPlayer(1).
+		build()
+
+	enemies := game.GetAdjacentEnemies(game.World.UnitAt(AxialCoord{Q: 0, R: 0}))
+	if len(enemies) != 2 {
+		t.Fatalf("got %d adjacent enemies, want 2", len(enemies))
+	}
+	found := map[AxialCoord]bool{}
+	for _, enemy := range enemies {
+		if enemy.Player != 2 {
+			t.Errorf("unit at (%d, %d) of player %d is not an enemy", enemy.Q, enemy.R, enemy.Player)
+		}
+		found[UnitGetCoord(enemy)] = true
+	}
+	for _, want := range []AxialCoord{{Q: 1, R: 0}, {Q: -1, R: 0}} {
+		if !found[want] {
+			t.Errorf("enemy at %v not returned", want)
+		}
+	}
+}
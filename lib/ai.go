@@ -0,0 +1,216 @@
+package lib
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Baseline AI
+// =============================================================================
+//
+// A deliberately simple greedy player used to play out simulations (eg for
+// world difficulty ratings). Units and tiles are visited in coordinate order
+// and every choice is tie-broken by position, so a game played with the same
+// seed always unfolds the same way.
+
+// BaselineAIVersion identifies the baseline AI's behaviour. Bump it whenever
+// PlayBaselineTurn changes so results computed with the old AI go stale.
+const BaselineAIVersion = "baseline-1"
+
+// PlayBaselineTurn plays the current player's turn with the baseline AI and
+// ends it. Each unit attacks the weakest enemy in range, otherwise captures
+// the building it stands on, otherwise advances on the nearest enemy unit or
+// building (attacking or capturing if that brings one within reach). Bases
+// then build the most expensive unit the player can afford. Actions the
+// rules reject are skipped. Returns the moves played, ending with the end
+// turn unless the game ended first.
+func (g *Game) PlayBaselineTurn() ([]*v1.GameMove, error) {
+	return g.PlayBaselineTurnContext(context.Background())
+}
+
+// PlayBaselineTurnContext is PlayBaselineTurn that stops with the context's
+// error, before ending the turn, once it is done.  The context is checked
+// before each unit plays.
+func (g *Game) PlayBaselineTurnContext(ctx context.Context) ([]*v1.GameMove, error) {
+	player := g.CurrentPlayer
+	g.baselineMoves = nil
+
+	var unitCoords []AxialCoord
+	for _, unit := range g.World.GetPlayerUnits(int(player)) {
+		unitCoords = append(unitCoords, UnitGetCoord(unit))
+	}
+	sortCoords(unitCoords)
+	for _, coord := range unitCoords {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Units may have been destroyed by an earlier counter attack
+		if unit := g.World.UnitAt(coord); unit != nil && unit.Player == player {
+			g.playBaselineUnit(unit)
+		}
+	}
+
+	g.playBaselineBuilds(player)
+
+	if g.GameState.Finished {
+		return g.baselineMoves, nil
+	}
+	endTurn := &v1.GameMove{Player: player, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	if err := g.ProcessMove(endTurn); err != nil {
+		return nil, err
+	}
+	return append(g.baselineMoves, endTurn), nil
+}
+
+func (g *Game) playBaselineUnit(unit *v1.Unit) {
+	if g.baselineAttack(unit) || g.baselineCapture(unit) {
+		return
+	}
+
+	targets := g.baselineTargets(unit.Player)
+	if len(targets) == 0 {
+		return
+	}
+	current := nearestDistance(UnitGetCoord(unit), targets)
+
+	var best *v1.MoveUnitAction
+	bestDistance := current
+	for _, option := range g.baselineOptions(unit) {
+		move := option.GetMove()
+		if move == nil {
+			continue
+		}
+		distance := nearestDistance(CoordFromInt32(move.To.Q, move.To.R), targets)
+		if distance < bestDistance || (best != nil && distance == bestDistance && MoveUnitActionLess(move, best)) {
+			best, bestDistance = move, distance
+		}
+	}
+	if best == nil {
+		return
+	}
+	if !g.tryBaselineMove(&v1.GameMove{MoveType: &v1.GameMove_MoveUnit{MoveUnit: best}}) {
+		return
+	}
+
+	if moved := g.World.UnitAt(CoordFromInt32(best.To.Q, best.To.R)); moved != nil && !g.baselineAttack(moved) {
+		g.baselineCapture(moved)
+	}
+}
+
+// baselineAttack attacks the weakest enemy in range, if any
+func (g *Game) baselineAttack(unit *v1.Unit) bool {
+	var best *v1.AttackUnitAction
+	for _, option := range g.baselineOptions(unit) {
+		attack := option.GetAttack()
+		if attack == nil {
+			continue
+		}
+		if best == nil || attack.TargetUnitHealth < best.TargetUnitHealth ||
+			(attack.TargetUnitHealth == best.TargetUnitHealth && AttackUnitActionLess(attack, best)) {
+			best = attack
+		}
+	}
+	return best != nil && g.tryBaselineMove(&v1.GameMove{MoveType: &v1.GameMove_AttackUnit{AttackUnit: best}})
+}
+
+// baselineCapture captures the building the unit stands on, if it can
+func (g *Game) baselineCapture(unit *v1.Unit) bool {
+	for _, option := range g.baselineOptions(unit) {
+		if capture := option.GetCapture(); capture != nil {
+			return g.tryBaselineMove(&v1.GameMove{MoveType: &v1.GameMove_CaptureBuilding{CaptureBuilding: capture}})
+		}
+	}
+	return false
+}
+
+// playBaselineBuilds builds the most expensive affordable unit on each free base
+func (g *Game) playBaselineBuilds(player int32) {
+	var baseCoords []AxialCoord
+	for coord, tile := range g.World.TilesByCoord() {
+		if tile.Player == player {
+			baseCoords = append(baseCoords, coord)
+		}
+	}
+	sortCoords(baseCoords)
+
+	for _, coord := range baseCoords {
+		if g.World.UnitAt(coord) != nil {
+			continue
+		}
+		options, err := g.GetTileOptions(g.World.TileAt(coord))
+		if err != nil {
+			continue
+		}
+		var best *v1.BuildUnitAction
+		for _, option := range options {
+			build := option.GetBuild()
+			if build == nil || option.DisabledReason != "" {
+				continue
+			}
+			if best == nil || build.Cost > best.Cost || (build.Cost == best.Cost && build.UnitType < best.UnitType) {
+				best = build
+			}
+		}
+		if best != nil {
+			g.tryBaselineMove(&v1.GameMove{MoveType: &v1.GameMove_BuildUnit{BuildUnit: best}})
+		}
+	}
+}
+
+// baselineTargets returns the positions of enemy units and buildings
+func (g *Game) baselineTargets(player int32) (targets []AxialCoord) {
+	for coord, unit := range g.World.UnitsByCoord() {
+		if unit.Player != player {
+			targets = append(targets, coord)
+		}
+	}
+	for coord, tile := range g.World.TilesByCoord() {
+		if tile.Player > 0 && tile.Player != player {
+			targets = append(targets, coord)
+		}
+	}
+	return
+}
+
+func (g *Game) baselineOptions(unit *v1.Unit) []*v1.GameOption {
+	if err := g.TopUpUnitIfNeeded(unit); err != nil {
+		return nil
+	}
+	options, _, err := g.GetUnitOptions(unit)
+	if err != nil {
+		return nil
+	}
+	return options
+}
+
+func (g *Game) tryBaselineMove(move *v1.GameMove) bool {
+	move.Player = g.CurrentPlayer
+	if g.GameState.Finished || g.ProcessMove(move) != nil {
+		return false
+	}
+	g.baselineMoves = append(g.baselineMoves, move)
+	return true
+}
+
+func nearestDistance(from AxialCoord, targets []AxialCoord) int {
+	nearest := -1
+	for _, target := range targets {
+		if distance := from.Distance(target); nearest < 0 || distance < nearest {
+			nearest = distance
+		}
+	}
+	return nearest
+}
+
+func sortCoords(coords []AxialCoord) {
+	slices.SortFunc(coords, func(a, b AxialCoord) int {
+		if a.Q != b.Q {
+			return cmp.Compare(a.Q, b.Q)
+		}
+		return cmp.Compare(a.R, b.R)
+	})
+}
@@ -0,0 +1,906 @@
+package lib
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Assertions
+// =============================================================================
+//
+// A small condition language over a game's state, used by `ww assert` and
+// for puzzle goals:
+//
+//	unit A1 [player==1, health>=5]
+//	tile 0,-1 [player==2]
+//	player 1 [coins>=100, unit_count==3]
+//	game [turn==5, current_player==2, status==playing]
+//	exists unit A1 A2
+//	notexists unit B3
+//	count units [player==1] == 3
+//	count tiles [tile_type==6] >= 2
+//
+// Units and tiles are identified by shortcut, Q,R or rRow,Col.
+
+// Operator represents a comparison operator
+type Operator int
+
+const (
+	OpSet   Operator = iota // = (set/capture value)
+	OpEq                    // ==
+	OpNe                    // !=
+	OpGt                    // >
+	OpGe                    // >=
+	OpLt                    // <
+	OpLe                    // <=
+	OpIn                    // in (a,b,c)
+	OpNotIn                 // notin (a,b,c)
+)
+
+func (o Operator) String() string {
+	switch o {
+	case OpSet:
+		return "="
+	case OpEq:
+		return "=="
+	case OpNe:
+		return "!="
+	case OpGt:
+		return ">"
+	case OpGe:
+		return ">="
+	case OpLt:
+		return "<"
+	case OpLe:
+		return "<="
+	case OpIn:
+		return "in"
+	case OpNotIn:
+		return "notin"
+	default:
+		return "?"
+	}
+}
+
+// Assertion represents a single assertion
+type Assertion struct {
+	Field    string
+	Operator Operator
+	Value    string   // For single value operators
+	Values   []string // For in/notin operators
+}
+
+// AssertionResult holds the result of evaluating an assertion
+type AssertionResult struct {
+	EntityType string // unit, tile, player, game
+	EntityID   string // A1, 0,-1, 1, etc.
+	Field      string
+	Operator   Operator
+	Expected   string
+	Actual     string
+	Passed     bool
+	IsSet      bool
+}
+
+func (r AssertionResult) String() string {
+	prefix := "PASS"
+	if !r.Passed {
+		prefix = "FAIL"
+	}
+	if r.IsSet {
+		prefix = "SET"
+	}
+
+	entityStr := r.EntityType
+	if r.EntityID != "" {
+		entityStr = fmt.Sprintf("%s.%s", r.EntityType, r.EntityID)
+	}
+
+	// For exists/notexists, no field is specified
+	if r.Field == "" {
+		return fmt.Sprintf("%s - %s %s", prefix, entityStr, r.Actual)
+	}
+
+	if r.IsSet {
+		return fmt.Sprintf("%s - %s.%s = %s", prefix, entityStr, r.Field, r.Actual)
+	}
+
+	if r.Operator == OpIn || r.Operator == OpNotIn {
+		return fmt.Sprintf("%s - %s.%s %s (%s) (actual: %s)", prefix, entityStr, r.Field, r.Operator, r.Expected, r.Actual)
+	}
+
+	// Show actual value when it differs from expected (for comparisons) or on failure
+	if r.Expected != r.Actual || !r.Passed {
+		return fmt.Sprintf("%s - %s.%s %s %s (actual: %s)", prefix, entityStr, r.Field, r.Operator, r.Expected, r.Actual)
+	}
+	return fmt.Sprintf("%s - %s.%s %s %s", prefix, entityStr, r.Field, r.Operator, r.Expected)
+}
+
+// AssertionContext is the game assertions are evaluated against
+type AssertionContext struct {
+	Game  *v1.Game
+	State *v1.GameState
+}
+
+// EvaluateAssertions parses and evaluates the assertions in input
+func (ac *AssertionContext) EvaluateAssertions(input string) ([]AssertionResult, error) {
+	input = strings.TrimSpace(input)
+	if strings.HasPrefix(input, "exists ") || strings.HasPrefix(input, "notexists ") {
+		return ac.evaluateExistsAssertions(input)
+	}
+	if strings.HasPrefix(input, "count ") {
+		return ac.evaluateCountAssertion(input)
+	}
+	return ac.evaluateEntityAssertions(input)
+}
+
+// Holds reports whether every assertion in input passes
+func (ac *AssertionContext) Holds(input string) (bool, error) {
+	results, err := ac.EvaluateAssertions(input)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range results {
+		if !r.Passed {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ValidateAssertions checks the syntax of the assertions in input without
+// evaluating them
+func ValidateAssertions(input string) error {
+	input = strings.TrimSpace(input)
+	if strings.HasPrefix(input, "exists ") || strings.HasPrefix(input, "notexists ") {
+		parts := strings.Fields(input)
+		if len(parts) < 3 {
+			return fmt.Errorf("exists requires entity type and at least one identifier")
+		}
+		if parts[1] != "unit" && parts[1] != "tile" {
+			return fmt.Errorf("exists only supports 'unit' and 'tile', got %q", parts[1])
+		}
+		return nil
+	}
+	if strings.HasPrefix(input, "count ") {
+		_, err := parseCountAssertion(input)
+		return err
+	}
+
+	matches := entityAssertionsPattern.FindAllStringSubmatch(input, -1)
+	if len(matches) == 0 {
+		return fmt.Errorf("no valid assertions found in: %s", input)
+	}
+	for _, match := range matches {
+		if _, err := ParseAssertions(match[3]); err != nil {
+			return fmt.Errorf("parsing assertions for %s %s: %w", match[1], strings.TrimSpace(match[2]), err)
+		}
+	}
+	return nil
+}
+
+func (ac *AssertionContext) evaluateExistsAssertions(input string) ([]AssertionResult, error) {
+	var results []AssertionResult
+	expectExists := strings.HasPrefix(input, "exists ")
+
+	// Remove prefix
+	if expectExists {
+		input = strings.TrimPrefix(input, "exists ")
+	} else {
+		input = strings.TrimPrefix(input, "notexists ")
+	}
+	input = strings.TrimSpace(input)
+
+	// Expect: unit A1 A2 B3 or tile H1 0,-1
+	parts := strings.Fields(input)
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("exists requires entity type and at least one identifier")
+	}
+
+	entityType := parts[0]
+	identifiers := parts[1:]
+
+	for _, id := range identifiers {
+		var exists bool
+		switch entityType {
+		case "unit":
+			_, exists = ac.FindUnit(id)
+		case "tile":
+			_, exists = ac.FindTile(id)
+		default:
+			return nil, fmt.Errorf("exists only supports 'unit' and 'tile', got %q", entityType)
+		}
+
+		passed := exists == expectExists
+		actual := "exists"
+		if !exists {
+			actual = "does not exist"
+		}
+		expected := "exists"
+		if !expectExists {
+			expected = "does not exist"
+		}
+
+		results = append(results, AssertionResult{
+			EntityType: entityType,
+			EntityID:   id,
+			Field:      "",
+			Operator:   OpEq,
+			Expected:   expected,
+			Actual:     actual,
+			Passed:     passed,
+		})
+	}
+
+	return results, nil
+}
+
+// countAssertion compares how many units or tiles match a predicate
+type countAssertion struct {
+	EntityType string      // units or tiles
+	Predicate  []Assertion // All must hold for an entity to be counted
+	Label      string      // eg units[player==1], to report results under
+	Comparison Assertion   // On the "count" field
+}
+
+var countAssertionPattern = regexp.MustCompile(`^count\s+(units?|tiles?)\s*\[([^\]]*)\]\s*(.*)$`)
+
+// parseCountAssertion parses "count units [player==1] == 3".  The predicate
+// uses the same syntax as unit and tile assertions, and the comparison any
+// operator, including = to just report the count.
+func parseCountAssertion(input string) (countAssertion, error) {
+	match := countAssertionPattern.FindStringSubmatch(strings.TrimSpace(input))
+	if match == nil {
+		return countAssertion{}, fmt.Errorf("count requires units or tiles, a [predicate] and a comparison, eg: count units [player==1] == 3")
+	}
+	entityType := strings.TrimSuffix(match[1], "s") + "s"
+
+	predicate, err := ParseAssertions(match[2])
+	if err != nil {
+		return countAssertion{}, fmt.Errorf("parsing count predicate: %w", err)
+	}
+	for _, a := range predicate {
+		if a.Operator == OpSet {
+			return countAssertion{}, fmt.Errorf("count predicate %s= has no value to match", a.Field)
+		}
+	}
+
+	comparison := strings.TrimSpace(match[3])
+	if comparison == "" {
+		return countAssertion{}, fmt.Errorf("count requires a comparison, eg: == 3")
+	}
+	// The comparison reads as an assertion on the count, eg "count >= 2"
+	compare, err := ParseAssertion("count " + comparison)
+	if err != nil {
+		return countAssertion{}, err
+	}
+
+	return countAssertion{
+		EntityType: entityType,
+		Predicate:  predicate,
+		Label:      fmt.Sprintf("%s[%s]", entityType, strings.TrimSpace(match[2])),
+		Comparison: compare,
+	}, nil
+}
+
+func (ac *AssertionContext) evaluateCountAssertion(input string) ([]AssertionResult, error) {
+	ca, err := parseCountAssertion(input)
+	if err != nil {
+		return nil, err
+	}
+
+	count := 0
+	if ac.State.WorldData != nil {
+		switch ca.EntityType {
+		case "units":
+			for _, unit := range ac.State.WorldData.UnitsMap {
+				if unit == nil {
+					continue
+				}
+				matched, err := matchesPredicate(ca.Predicate, func(field string) (string, error) {
+					return getUnitFieldValue(unit, field)
+				})
+				if err != nil {
+					return nil, err
+				}
+				if matched {
+					count++
+				}
+			}
+		case "tiles":
+			for _, tile := range ac.State.WorldData.TilesMap {
+				if tile == nil {
+					continue
+				}
+				matched, err := matchesPredicate(ca.Predicate, func(field string) (string, error) {
+					return getTileFieldValue(tile, field)
+				})
+				if err != nil {
+					return nil, err
+				}
+				if matched {
+					count++
+				}
+			}
+		}
+	}
+
+	result, err := evaluateComparison(ca.Label, "", ca.Comparison, fmt.Sprintf("%d", count))
+	if err != nil {
+		return nil, err
+	}
+	return []AssertionResult{result}, nil
+}
+
+// matchesPredicate reports whether every assertion in predicate holds for
+// an entity, reading its fields with fieldValue
+func matchesPredicate(predicate []Assertion, fieldValue func(field string) (string, error)) (bool, error) {
+	for _, a := range predicate {
+		actual, err := fieldValue(a.Field)
+		if err != nil {
+			return false, err
+		}
+		result, err := evaluateComparison("", "", a, actual)
+		if err != nil {
+			return false, err
+		}
+		if !result.Passed {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+var entityAssertionsPattern = regexp.MustCompile(`(unit|tile|player|game)\s+([^\[\]]+)?\s*\[([^\]]*)\]`)
+
+func (ac *AssertionContext) evaluateEntityAssertions(input string) ([]AssertionResult, error) {
+	var results []AssertionResult
+
+	// Find all entity blocks: entity id [...] or game [...]
+	// The brackets may contain spaces, so we need careful parsing
+	matches := entityAssertionsPattern.FindAllStringSubmatch(input, -1)
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no valid assertions found in: %s", input)
+	}
+
+	for _, match := range matches {
+		entityType := match[1]
+		entityID := strings.TrimSpace(match[2])
+		assertionsStr := match[3]
+
+		// Parse assertions within brackets
+		assertions, err := ParseAssertions(assertionsStr)
+		if err != nil {
+			return nil, fmt.Errorf("parsing assertions for %s %s: %w", entityType, entityID, err)
+		}
+
+		// Evaluate assertions
+		entityResults, err := ac.evaluateAssertions(entityType, entityID, assertions)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, entityResults...)
+	}
+
+	return results, nil
+}
+
+// ParseAssertions parses a comma separated list of assertions
+func ParseAssertions(input string) ([]Assertion, error) {
+	var assertions []Assertion
+
+	// Split by comma, but be careful with in (a,b,c) syntax
+	parts := splitAssertions(input)
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		assertion, err := ParseAssertion(part)
+		if err != nil {
+			return nil, err
+		}
+		assertions = append(assertions, assertion)
+	}
+
+	return assertions, nil
+}
+
+// splitAssertions splits by comma, but respects parentheses
+func splitAssertions(input string) []string {
+	var parts []string
+	var current strings.Builder
+	depth := 0
+
+	for _, ch := range input {
+		switch ch {
+		case '(':
+			depth++
+			current.WriteRune(ch)
+		case ')':
+			depth--
+			current.WriteRune(ch)
+		case ',':
+			if depth == 0 {
+				parts = append(parts, current.String())
+				current.Reset()
+			} else {
+				current.WriteRune(ch)
+			}
+		default:
+			current.WriteRune(ch)
+		}
+	}
+	if current.Len() > 0 {
+		parts = append(parts, current.String())
+	}
+	return parts
+}
+
+// ParseAssertion parses a single assertion such as "health>=5"
+func ParseAssertion(input string) (Assertion, error) {
+	input = strings.TrimSpace(input)
+
+	// Check for in/notin operators first (they contain spaces)
+	if idx := strings.Index(input, " notin "); idx > 0 {
+		field := strings.TrimSpace(input[:idx])
+		valuesStr := strings.TrimSpace(input[idx+7:])
+		values, err := parseValueSet(valuesStr)
+		if err != nil {
+			return Assertion{}, err
+		}
+		return Assertion{Field: field, Operator: OpNotIn, Values: values}, nil
+	}
+	if idx := strings.Index(input, " in "); idx > 0 {
+		field := strings.TrimSpace(input[:idx])
+		valuesStr := strings.TrimSpace(input[idx+4:])
+		values, err := parseValueSet(valuesStr)
+		if err != nil {
+			return Assertion{}, err
+		}
+		return Assertion{Field: field, Operator: OpIn, Values: values}, nil
+	}
+
+	// Check for text-based comparison operators first (shell-safe alternatives)
+	// These use space-delimited format like "health lt 5"
+	textOperators := []struct {
+		str string
+		op  Operator
+	}{
+		{" lte ", OpLe},
+		{" gte ", OpGe},
+		{" lt ", OpLt},
+		{" gt ", OpGt},
+		{" eq ", OpEq},
+		{" ne ", OpNe},
+	}
+
+	for _, op := range textOperators {
+		if idx := strings.Index(input, op.str); idx > 0 {
+			field := strings.TrimSpace(input[:idx])
+			value := strings.TrimSpace(input[idx+len(op.str):])
+			return Assertion{Field: field, Operator: op.op, Value: value}, nil
+		}
+	}
+
+	// Check for symbol-based comparison operators (order matters: >= before >, etc.)
+	operators := []struct {
+		str string
+		op  Operator
+	}{
+		{"==", OpEq},
+		{"!=", OpNe},
+		{">=", OpGe},
+		{"<=", OpLe},
+		{">", OpGt},
+		{"<", OpLt},
+		{"=", OpSet},
+	}
+
+	for _, op := range operators {
+		if idx := strings.Index(input, op.str); idx > 0 {
+			field := strings.TrimSpace(input[:idx])
+			value := strings.TrimSpace(input[idx+len(op.str):])
+			return Assertion{Field: field, Operator: op.op, Value: value}, nil
+		}
+	}
+
+	return Assertion{}, fmt.Errorf("invalid assertion syntax: %s", input)
+}
+
+func parseValueSet(input string) ([]string, error) {
+	input = strings.TrimSpace(input)
+	if !strings.HasPrefix(input, "(") || !strings.HasSuffix(input, ")") {
+		return nil, fmt.Errorf("value set must be in parentheses: %s", input)
+	}
+	inner := input[1 : len(input)-1]
+	parts := strings.Split(inner, ",")
+	var values []string
+	for _, p := range parts {
+		values = append(values, strings.TrimSpace(p))
+	}
+	return values, nil
+}
+
+func (ac *AssertionContext) evaluateAssertions(entityType, entityID string, assertions []Assertion) ([]AssertionResult, error) {
+	var results []AssertionResult
+
+	for _, a := range assertions {
+		var result AssertionResult
+		var err error
+
+		switch entityType {
+		case "unit":
+			result, err = ac.evaluateUnitAssertion(entityID, a)
+		case "tile":
+			result, err = ac.evaluateTileAssertion(entityID, a)
+		case "player":
+			result, err = ac.evaluatePlayerAssertion(entityID, a)
+		case "game":
+			result, err = ac.evaluateGameAssertion(a)
+		default:
+			return nil, fmt.Errorf("unknown entity type: %s", entityType)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// ParseCoordinate parses a coordinate string in Q,R or rRow,Col format
+func ParseCoordinate(input string) (AxialCoord, error) {
+	input = strings.TrimSpace(input)
+
+	// Check for row/col format (starts with 'r')
+	if strings.HasPrefix(strings.ToLower(input), "r") {
+		parts := strings.Split(input[1:], ",")
+		if len(parts) != 2 {
+			return AxialCoord{}, fmt.Errorf("row/col coordinate must have format rRow,Col")
+		}
+		row, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return AxialCoord{}, fmt.Errorf("invalid row: %s", parts[0])
+		}
+		col, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return AxialCoord{}, fmt.Errorf("invalid col: %s", parts[1])
+		}
+		return RowColToHex(row, col, UseEvenRowOffsetCoords), nil
+	}
+
+	// Parse Q,R format
+	parts := strings.Split(input, ",")
+	if len(parts) != 2 {
+		return AxialCoord{}, fmt.Errorf("coordinate must have format Q,R or rRow,Col")
+	}
+	q, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return AxialCoord{}, fmt.Errorf("invalid Q coordinate: %s", parts[0])
+	}
+	r, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return AxialCoord{}, fmt.Errorf("invalid R coordinate: %s", parts[1])
+	}
+	return AxialCoord{Q: q, R: r}, nil
+}
+
+// FindUnit finds a unit by shortcut or coordinate
+func (ac *AssertionContext) FindUnit(id string) (*v1.Unit, bool) {
+	if ac.State.WorldData == nil {
+		return nil, false
+	}
+
+	// Try shortcut lookup first
+	for _, unit := range ac.State.WorldData.UnitsMap {
+		if unit != nil && unit.Shortcut == id {
+			return unit, true
+		}
+	}
+
+	// Try to parse as coordinate
+	coord, err := ParseCoordinate(id)
+	if err == nil {
+		key := CoordKey(int32(coord.Q), int32(coord.R))
+		unit := ac.State.WorldData.UnitsMap[key]
+		return unit, unit != nil
+	}
+
+	return nil, false
+}
+
+// FindTile finds a tile by shortcut or coordinate
+func (ac *AssertionContext) FindTile(id string) (*v1.Tile, bool) {
+	if ac.State.WorldData == nil {
+		return nil, false
+	}
+
+	// Try shortcut lookup first
+	for _, tile := range ac.State.WorldData.TilesMap {
+		if tile != nil && tile.Shortcut == id {
+			return tile, true
+		}
+	}
+
+	// Try to parse as coordinate
+	coord, err := ParseCoordinate(id)
+	if err == nil {
+		key := CoordKey(int32(coord.Q), int32(coord.R))
+		tile := ac.State.WorldData.TilesMap[key]
+		return tile, tile != nil
+	}
+
+	return nil, false
+}
+
+func (ac *AssertionContext) evaluateUnitAssertion(id string, a Assertion) (AssertionResult, error) {
+	unit, exists := ac.FindUnit(id)
+	if !exists {
+		return AssertionResult{}, fmt.Errorf("unit %s not found", id)
+	}
+
+	// Get field value
+	actual, err := getUnitFieldValue(unit, a.Field)
+	if err != nil {
+		return AssertionResult{}, err
+	}
+
+	return evaluateComparison("unit", id, a, actual)
+}
+
+func getUnitFieldValue(unit *v1.Unit, field string) (string, error) {
+	switch field {
+	case "player":
+		return fmt.Sprintf("%d", unit.Player), nil
+	case "unit_type", "type":
+		return fmt.Sprintf("%d", unit.UnitType), nil
+	case "health", "available_health":
+		return fmt.Sprintf("%d", unit.AvailableHealth), nil
+	case "distance_left", "moves":
+		return FormatMovementPoints(unit.DistanceLeft), nil
+	case "progression_step", "step":
+		return fmt.Sprintf("%d", unit.ProgressionStep), nil
+	case "chosen_alternative":
+		return unit.ChosenAlternative, nil
+	case "q":
+		return fmt.Sprintf("%d", unit.Q), nil
+	case "r":
+		return fmt.Sprintf("%d", unit.R), nil
+	case "shortcut":
+		return unit.Shortcut, nil
+	default:
+		return "", fmt.Errorf("unknown unit field: %s", field)
+	}
+}
+
+func (ac *AssertionContext) evaluateTileAssertion(id string, a Assertion) (AssertionResult, error) {
+	tile, exists := ac.FindTile(id)
+	if !exists {
+		return AssertionResult{}, fmt.Errorf("tile %s not found", id)
+	}
+
+	// Get field value
+	actual, err := getTileFieldValue(tile, a.Field)
+	if err != nil {
+		return AssertionResult{}, err
+	}
+
+	return evaluateComparison("tile", id, a, actual)
+}
+
+func getTileFieldValue(tile *v1.Tile, field string) (string, error) {
+	switch field {
+	case "player":
+		return fmt.Sprintf("%d", tile.Player), nil
+	case "tile_type", "type":
+		return fmt.Sprintf("%d", tile.TileType), nil
+	case "q":
+		return fmt.Sprintf("%d", tile.Q), nil
+	case "r":
+		return fmt.Sprintf("%d", tile.R), nil
+	case "shortcut":
+		return tile.Shortcut, nil
+	default:
+		return "", fmt.Errorf("unknown tile field: %s", field)
+	}
+}
+
+func (ac *AssertionContext) evaluatePlayerAssertion(id string, a Assertion) (AssertionResult, error) {
+	playerID, err := strconv.Atoi(id)
+	if err != nil {
+		return AssertionResult{}, fmt.Errorf("invalid player ID: %s", id)
+	}
+
+	// Get field value
+	actual, err := ac.getPlayerFieldValue(int32(playerID), a.Field)
+	if err != nil {
+		return AssertionResult{}, err
+	}
+
+	return evaluateComparison("player", id, a, actual)
+}
+
+func (ac *AssertionContext) getPlayerFieldValue(playerID int32, field string) (string, error) {
+	switch field {
+	case "coins":
+		if ps := ac.State.PlayerStates[playerID]; ps != nil {
+			return fmt.Sprintf("%d", ps.Coins), nil
+		}
+		return "0", nil
+	case "unit_count":
+		count := 0
+		if ac.State.WorldData != nil {
+			for _, unit := range ac.State.WorldData.UnitsMap {
+				if unit != nil && unit.Player == playerID {
+					count++
+				}
+			}
+		}
+		return fmt.Sprintf("%d", count), nil
+	case "tile_count":
+		count := 0
+		if ac.State.WorldData != nil {
+			for _, tile := range ac.State.WorldData.TilesMap {
+				if tile != nil && tile.Player == playerID {
+					count++
+				}
+			}
+		}
+		return fmt.Sprintf("%d", count), nil
+	case "is_active":
+		if ac.Game != nil && ac.Game.Config != nil {
+			for _, p := range ac.Game.Config.Players {
+				if p.PlayerId == playerID {
+					return fmt.Sprintf("%t", p.IsActive), nil
+				}
+			}
+		}
+		return "false", nil
+	default:
+		return "", fmt.Errorf("unknown player field: %s", field)
+	}
+}
+
+func (ac *AssertionContext) evaluateGameAssertion(a Assertion) (AssertionResult, error) {
+	// Get field value
+	actual, err := ac.getGameFieldValue(a.Field)
+	if err != nil {
+		return AssertionResult{}, err
+	}
+
+	// Statuses can be given by name as well as number
+	if a.Field == "status" && a.Operator != OpSet {
+		if a.Value, err = resolveGameStatus(a.Value); err != nil {
+			return AssertionResult{}, err
+		}
+		for i, value := range a.Values {
+			if a.Values[i], err = resolveGameStatus(value); err != nil {
+				return AssertionResult{}, err
+			}
+		}
+	}
+
+	return evaluateComparison("game", "", a, actual)
+}
+
+func (ac *AssertionContext) getGameFieldValue(field string) (string, error) {
+	state := ac.State
+	switch field {
+	case "turn", "turn_counter":
+		return fmt.Sprintf("%d", state.TurnCounter), nil
+	case "current_player", "player":
+		return fmt.Sprintf("%d", state.CurrentPlayer), nil
+	case "status":
+		return fmt.Sprintf("%d", int32(state.Status)), nil
+	case "finished":
+		return fmt.Sprintf("%t", state.Finished), nil
+	case "winning_player":
+		return fmt.Sprintf("%d", state.WinningPlayer), nil
+	case "winning_team":
+		return fmt.Sprintf("%d", state.WinningTeam), nil
+	default:
+		return "", fmt.Errorf("unknown game field: %s", field)
+	}
+}
+
+// resolveGameStatus turns a status name such as "playing" or
+// "GAME_STATUS_ENDED" into its number, leaving numbers as they are
+func resolveGameStatus(value string) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+	if _, err := strconv.Atoi(value); err == nil {
+		return value, nil
+	}
+	name := strings.ToUpper(value)
+	if !strings.HasPrefix(name, "GAME_STATUS_") {
+		name = "GAME_STATUS_" + name
+	}
+	status, ok := v1.GameStatus_value[name]
+	if !ok {
+		return "", fmt.Errorf("unknown game status: %s", value)
+	}
+	return fmt.Sprintf("%d", status), nil
+}
+
+func evaluateComparison(entityType, entityID string, a Assertion, actual string) (AssertionResult, error) {
+	result := AssertionResult{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Field:      a.Field,
+		Operator:   a.Operator,
+		Actual:     actual,
+	}
+
+	// Set operator - capture current value
+	if a.Operator == OpSet {
+		result.IsSet = true
+		result.Passed = true
+		result.Expected = actual
+		return result, nil
+	}
+
+	// Set expected value(s)
+	if a.Operator == OpIn || a.Operator == OpNotIn {
+		result.Expected = strings.Join(a.Values, ",")
+	} else {
+		result.Expected = a.Value
+	}
+
+	// Evaluate based on operator
+	switch a.Operator {
+	case OpEq:
+		result.Passed = actual == a.Value
+	case OpNe:
+		result.Passed = actual != a.Value
+	case OpGt, OpGe, OpLt, OpLe:
+		passed, err := compareNumeric(actual, a.Value, a.Operator)
+		if err != nil {
+			return AssertionResult{}, err
+		}
+		result.Passed = passed
+	case OpIn:
+		result.Passed = slices.Contains(a.Values, actual)
+	case OpNotIn:
+		result.Passed = !slices.Contains(a.Values, actual)
+	}
+
+	return result, nil
+}
+
+func compareNumeric(actual, expected string, op Operator) (bool, error) {
+	// Try as float first (handles both int and float)
+	actualF, err := strconv.ParseFloat(actual, 64)
+	if err != nil {
+		return false, fmt.Errorf("cannot compare %q as number", actual)
+	}
+	expectedF, err := strconv.ParseFloat(expected, 64)
+	if err != nil {
+		return false, fmt.Errorf("cannot compare %q as number", expected)
+	}
+
+	switch op {
+	case OpGt:
+		return actualF > expectedF, nil
+	case OpGe:
+		return actualF >= expectedF, nil
+	case OpLt:
+		return actualF < expectedF, nil
+	case OpLe:
+		return actualF <= expectedF, nil
+	default:
+		return false, fmt.Errorf("unexpected operator for numeric comparison: %v", op)
+	}
+}
@@ -0,0 +1,550 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseAssertion_Equals(t *testing.T) {
+	tests := []struct {
+		input    string
+		field    string
+		operator Operator
+		value    string
+	}{
+		{"player==1", "player", OpEq, "1"},
+		{"health==10", "health", OpEq, "10"},
+		{"distance_left==0", "distance_left", OpEq, "0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			a, err := ParseAssertion(tc.input)
+			if err != nil {
+				t.Fatalf("ParseAssertion(%q) error: %v", tc.input, err)
+			}
+			if a.Field != tc.field {
+				t.Errorf("field = %q, want %q", a.Field, tc.field)
+			}
+			if a.Operator != tc.operator {
+				t.Errorf("operator = %v, want %v", a.Operator, tc.operator)
+			}
+			if a.Value != tc.value {
+				t.Errorf("value = %q, want %q", a.Value, tc.value)
+			}
+		})
+	}
+}
+
+func TestParseAssertion_Comparisons(t *testing.T) {
+	tests := []struct {
+		input    string
+		field    string
+		operator Operator
+		value    string
+	}{
+		{"health>=5", "health", OpGe, "5"},
+		{"health<=10", "health", OpLe, "10"},
+		{"health>0", "health", OpGt, "0"},
+		{"health<100", "health", OpLt, "100"},
+		{"player!=2", "player", OpNe, "2"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			a, err := ParseAssertion(tc.input)
+			if err != nil {
+				t.Fatalf("ParseAssertion(%q) error: %v", tc.input, err)
+			}
+			if a.Field != tc.field {
+				t.Errorf("field = %q, want %q", a.Field, tc.field)
+			}
+			if a.Operator != tc.operator {
+				t.Errorf("operator = %v, want %v", a.Operator, tc.operator)
+			}
+			if a.Value != tc.value {
+				t.Errorf("value = %q, want %q", a.Value, tc.value)
+			}
+		})
+	}
+}
+
+func TestParseAssertion_TextOperators(t *testing.T) {
+	tests := []struct {
+		input    string
+		field    string
+		operator Operator
+		value    string
+	}{
+		{"health gte 5", "health", OpGe, "5"},
+		{"health lte 10", "health", OpLe, "10"},
+		{"health gt 0", "health", OpGt, "0"},
+		{"health lt 100", "health", OpLt, "100"},
+		{"player eq 1", "player", OpEq, "1"},
+		{"player ne 2", "player", OpNe, "2"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			a, err := ParseAssertion(tc.input)
+			if err != nil {
+				t.Fatalf("ParseAssertion(%q) error: %v", tc.input, err)
+			}
+			if a.Field != tc.field {
+				t.Errorf("field = %q, want %q", a.Field, tc.field)
+			}
+			if a.Operator != tc.operator {
+				t.Errorf("operator = %v, want %v", a.Operator, tc.operator)
+			}
+			if a.Value != tc.value {
+				t.Errorf("value = %q, want %q", a.Value, tc.value)
+			}
+		})
+	}
+}
+
+func TestParseAssertion_Set(t *testing.T) {
+	a, err := ParseAssertion("health=")
+	if err != nil {
+		t.Fatalf("ParseAssertion error: %v", err)
+	}
+	if a.Field != "health" {
+		t.Errorf("field = %q, want %q", a.Field, "health")
+	}
+	if a.Operator != OpSet {
+		t.Errorf("operator = %v, want %v", a.Operator, OpSet)
+	}
+	if a.Value != "" {
+		t.Errorf("value = %q, want empty", a.Value)
+	}
+}
+
+func TestParseAssertion_InOperator(t *testing.T) {
+	a, err := ParseAssertion("health in (5,8,10)")
+	if err != nil {
+		t.Fatalf("ParseAssertion error: %v", err)
+	}
+	if a.Field != "health" {
+		t.Errorf("field = %q, want %q", a.Field, "health")
+	}
+	if a.Operator != OpIn {
+		t.Errorf("operator = %v, want %v", a.Operator, OpIn)
+	}
+	if len(a.Values) != 3 {
+		t.Errorf("values length = %d, want 3", len(a.Values))
+	}
+	expected := []string{"5", "8", "10"}
+	for i, v := range expected {
+		if a.Values[i] != v {
+			t.Errorf("values[%d] = %q, want %q", i, a.Values[i], v)
+		}
+	}
+}
+
+func TestParseAssertion_NotInOperator(t *testing.T) {
+	a, err := ParseAssertion("player notin (1,2)")
+	if err != nil {
+		t.Fatalf("ParseAssertion error: %v", err)
+	}
+	if a.Field != "player" {
+		t.Errorf("field = %q, want %q", a.Field, "player")
+	}
+	if a.Operator != OpNotIn {
+		t.Errorf("operator = %v, want %v", a.Operator, OpNotIn)
+	}
+	if len(a.Values) != 2 {
+		t.Errorf("values length = %d, want 2", len(a.Values))
+	}
+}
+
+func TestSplitAssertions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"player==1, health>=5", []string{"player==1", " health>=5"}},
+		{"health in (5,8,10), player==1", []string{"health in (5,8,10)", " player==1"}},
+		{"a==1, b in (1,2,3), c!=4", []string{"a==1", " b in (1,2,3)", " c!=4"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			parts := splitAssertions(tc.input)
+			if len(parts) != len(tc.expected) {
+				t.Fatalf("got %d parts, want %d: %v", len(parts), len(tc.expected), parts)
+			}
+			for i, p := range parts {
+				if p != tc.expected[i] {
+					t.Errorf("part[%d] = %q, want %q", i, p, tc.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseAssertions_Multiple(t *testing.T) {
+	assertions, err := ParseAssertions("player==1, health>=5, distance_left==0")
+	if err != nil {
+		t.Fatalf("ParseAssertions error: %v", err)
+	}
+	if len(assertions) != 3 {
+		t.Fatalf("got %d assertions, want 3", len(assertions))
+	}
+
+	// Check first assertion
+	if assertions[0].Field != "player" || assertions[0].Operator != OpEq || assertions[0].Value != "1" {
+		t.Errorf("assertion[0] = %+v, want player==1", assertions[0])
+	}
+
+	// Check second assertion
+	if assertions[1].Field != "health" || assertions[1].Operator != OpGe || assertions[1].Value != "5" {
+		t.Errorf("assertion[1] = %+v, want health>=5", assertions[1])
+	}
+
+	// Check third assertion
+	if assertions[2].Field != "distance_left" || assertions[2].Operator != OpEq || assertions[2].Value != "0" {
+		t.Errorf("assertion[2] = %+v, want distance_left==0", assertions[2])
+	}
+}
+
+func TestEvaluateComparison_Equals(t *testing.T) {
+	a := Assertion{Field: "health", Operator: OpEq, Value: "10"}
+
+	// Test pass
+	result, err := evaluateComparison("unit", "A1", a, "10")
+	if err != nil {
+		t.Fatalf("evaluateComparison error: %v", err)
+	}
+	if !result.Passed {
+		t.Error("expected pass for 10 == 10")
+	}
+
+	// Test fail
+	result, err = evaluateComparison("unit", "A1", a, "5")
+	if err != nil {
+		t.Fatalf("evaluateComparison error: %v", err)
+	}
+	if result.Passed {
+		t.Error("expected fail for 5 == 10")
+	}
+}
+
+func TestEvaluateComparison_GreaterOrEqual(t *testing.T) {
+	a := Assertion{Field: "health", Operator: OpGe, Value: "5"}
+
+	tests := []struct {
+		actual string
+		pass   bool
+	}{
+		{"10", true},
+		{"5", true},
+		{"4", false},
+		{"0", false},
+	}
+
+	for _, tc := range tests {
+		result, err := evaluateComparison("unit", "A1", a, tc.actual)
+		if err != nil {
+			t.Fatalf("evaluateComparison error: %v", err)
+		}
+		if result.Passed != tc.pass {
+			t.Errorf("health >= 5 with actual %s: got passed=%v, want %v", tc.actual, result.Passed, tc.pass)
+		}
+	}
+}
+
+func TestEvaluateComparison_In(t *testing.T) {
+	a := Assertion{Field: "health", Operator: OpIn, Values: []string{"5", "8", "10"}}
+
+	tests := []struct {
+		actual string
+		pass   bool
+	}{
+		{"5", true},
+		{"8", true},
+		{"10", true},
+		{"7", false},
+		{"0", false},
+	}
+
+	for _, tc := range tests {
+		result, err := evaluateComparison("unit", "A1", a, tc.actual)
+		if err != nil {
+			t.Fatalf("evaluateComparison error: %v", err)
+		}
+		if result.Passed != tc.pass {
+			t.Errorf("health in (5,8,10) with actual %s: got passed=%v, want %v", tc.actual, result.Passed, tc.pass)
+		}
+	}
+}
+
+func TestEvaluateComparison_Set(t *testing.T) {
+	a := Assertion{Field: "health", Operator: OpSet, Value: ""}
+
+	result, err := evaluateComparison("unit", "A1", a, "10")
+	if err != nil {
+		t.Fatalf("evaluateComparison error: %v", err)
+	}
+	if !result.Passed {
+		t.Error("set should always pass")
+	}
+	if !result.IsSet {
+		t.Error("expected IsSet to be true")
+	}
+	if result.Actual != "10" {
+		t.Errorf("actual = %q, want %q", result.Actual, "10")
+	}
+}
+
+func TestAssertionResult_String(t *testing.T) {
+	tests := []struct {
+		result   AssertionResult
+		expected string
+	}{
+		{
+			AssertionResult{EntityType: "unit", EntityID: "A1", Field: "health", Operator: OpEq, Expected: "10", Actual: "10", Passed: true},
+			"PASS - unit.A1.health == 10",
+		},
+		{
+			AssertionResult{EntityType: "unit", EntityID: "A1", Field: "health", Operator: OpGe, Expected: "5", Actual: "10", Passed: true},
+			"PASS - unit.A1.health >= 5 (actual: 10)",
+		},
+		{
+			AssertionResult{EntityType: "unit", EntityID: "A1", Field: "player", Operator: OpEq, Expected: "1", Actual: "2", Passed: false},
+			"FAIL - unit.A1.player == 1 (actual: 2)",
+		},
+		{
+			AssertionResult{EntityType: "game", EntityID: "", Field: "turn", Operator: OpEq, Expected: "5", Actual: "5", Passed: true},
+			"PASS - game.turn == 5",
+		},
+		{
+			AssertionResult{EntityType: "unit", EntityID: "A1", Field: "health", Operator: OpSet, Expected: "10", Actual: "10", Passed: true, IsSet: true},
+			"SET - unit.A1.health = 10",
+		},
+		{
+			// Exists check (no field)
+			AssertionResult{EntityType: "unit", EntityID: "A1", Field: "", Operator: OpEq, Expected: "exists", Actual: "exists", Passed: true},
+			"PASS - unit.A1 exists",
+		},
+		{
+			// Not exists check (no field)
+			AssertionResult{EntityType: "unit", EntityID: "B99", Field: "", Operator: OpEq, Expected: "does not exist", Actual: "does not exist", Passed: true},
+			"PASS - unit.B99 does not exist",
+		},
+	}
+
+	for _, tc := range tests {
+		got := tc.result.String()
+		if got != tc.expected {
+			t.Errorf("got %q, want %q", got, tc.expected)
+		}
+	}
+}
+
+func TestParseCoordinate(t *testing.T) {
+	tests := []struct {
+		input string
+		q     int
+		r     int
+	}{
+		{"0,0", 0, 0},
+		{"1,-1", 1, -1},
+		{"-2,3", -2, 3},
+		{"5,5", 5, 5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			coord, err := ParseCoordinate(tc.input)
+			if err != nil {
+				t.Fatalf("ParseCoordinate(%q) error: %v", tc.input, err)
+			}
+			if coord.Q != tc.q || coord.R != tc.r {
+				t.Errorf("got (%d,%d), want (%d,%d)", coord.Q, coord.R, tc.q, tc.r)
+			}
+		})
+	}
+}
+
+func TestParseRowColCoordinate(t *testing.T) {
+	// r4,5 should be parsed as row=4, col=5
+	coord, err := ParseCoordinate("r4,5")
+	if err != nil {
+		t.Fatalf("ParseCoordinate(r4,5) error: %v", err)
+	}
+	// The coordinate should be converted to Q,R
+	// Based on RowColToHex(4, 5) - row=4 is even, so q = 5 - (4-0)/2 = 5 - 2 = 3
+	// Actually let me verify: row=4, col=5
+	// x = col - (row-(row&1))/2 = 5 - (4-0)/2 = 5 - 2 = 3
+	// z = row = 4
+	// y = -x - z = -3 - 4 = -7
+	// q, r = CubeToAxial(x, y, z) = x, z = 3, 4
+	if coord.Q != 3 || coord.R != 4 {
+		t.Errorf("r4,5 got (%d,%d), want (3,4)", coord.Q, coord.R)
+	}
+}
+
+func TestEvaluateAssertions_GameStatus(t *testing.T) {
+	ac := &AssertionContext{State: &v1.GameState{Status: v1.GameStatus_GAME_STATUS_PLAYING}}
+
+	tests := []struct {
+		input string
+		pass  bool
+	}{
+		{"game [status==1]", true},
+		{"game [status==playing]", true},
+		{"game [status eq PLAYING]", true},
+		{"game [status==GAME_STATUS_PLAYING]", true},
+		{"game [status==ended]", false},
+		{"game [status==3]", false},
+		{"game [status!=ended]", true},
+		{"game [status in (paused,playing)]", true},
+		{"game [status notin (ended,2)]", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			passed, err := ac.Holds(tc.input)
+			if err != nil {
+				t.Fatalf("Holds(%q) error: %v", tc.input, err)
+			}
+			if passed != tc.pass {
+				t.Errorf("Holds(%q) = %v, want %v", tc.input, passed, tc.pass)
+			}
+		})
+	}
+
+	if _, err := ac.Holds("game [status==finished]"); err == nil {
+		t.Error("expected an error for an unknown status name")
+	}
+}
+
+// TestEvaluateAssertions_ProgressionAfterMoveThenAttack tests asserting a
+// unit's progression_step and chosen_alternative as it moves part of its
+// range and then attacks, with two attacks allowed at its attack step
+func TestEvaluateAssertions_ProgressionAfterMoveThenAttack(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(4).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	rules := proto.Clone(game.RulesEngine.Rules()).(*v1.RulesEngine)
+	rules.Units[testUnitTypeSoldier].ActionLimits = map[string]int32{"attack": 2}
+	game.RulesEngine = NewRulesEngineFrom(rules)
+	ac := &AssertionContext{Game: game.Game, State: game.GameState}
+
+	holds := func(input string) {
+		t.Helper()
+		results, err := ac.EvaluateAssertions(input)
+		if err != nil {
+			t.Fatalf("EvaluateAssertions(%q) error: %v", input, err)
+		}
+		for _, r := range results {
+			if !r.Passed {
+				t.Errorf("%s", r)
+			}
+		}
+	}
+
+	holds("unit A1 [progression_step==0, chosen_alternative==]")
+
+	move := &v1.GameMove{Player: 1, MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+		From: &v1.Position{Q: 0, R: 0},
+		To:   &v1.Position{Q: 1, R: 0},
+	}}}
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+	// Movement is left over so the unit is still on its move step
+	holds("unit A1 [progression_step==0, step==0, chosen_alternative==]")
+
+	attack := &v1.GameMove{Player: 1, MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+		Attacker: &v1.Position{Q: 1, R: 0},
+		Defender: &v1.Position{Q: 2, R: 0},
+	}}}
+	if err := game.ProcessMove(attack); err != nil {
+		t.Fatalf("attack failed: %v", err)
+	}
+	// With an attack left the unit stays on its "attack|capture" step,
+	// committed to attacking
+	holds("unit A1 [progression_step==1, chosen_alternative==attack]")
+	if _, err := game.Capture("A1"); !errors.Is(err, ErrInvalidActionForProgression) {
+		t.Errorf("capture after choosing to attack = %v, want ErrInvalidActionForProgression", err)
+	}
+
+	if err := game.ProcessMove(attack); err != nil {
+		t.Fatalf("second attack failed: %v", err)
+	}
+	// The second attack used up the step, so no alternative is left chosen
+	holds("unit A1 [progression_step==2, chosen_alternative==, progression_step gt 1]")
+	if err := game.ProcessMove(attack); !errors.Is(err, ErrInvalidActionForProgression) {
+		t.Errorf("third attack = %v, want ErrInvalidActionForProgression", err)
+	}
+}
+
+func TestEvaluateAssertions_Count(t *testing.T) {
+	game := newTestGameBuilder().
+		tile(0, 0, TileTypeLandBase, 1).
+		tile(2, 0, TileTypeLandBase, 2).
+		grassTiles(2).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 1, testUnitTypeSoldier).
+		unit(-1, 0, 1, testUnitTypeTank).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		build()
+	ac := &AssertionContext{Game: game.Game, State: game.GameState}
+
+	tests := []struct {
+		input  string
+		passed bool
+		actual string
+	}{
+		{"count units [player==1] == 3", true, "3"},
+		{"count units [player==1] == 2", false, "3"},
+		{"count units [player==2] gte 1", true, "1"},
+		{"count units [player==1, unit_type==1] == 2", true, "2"},
+		{"count units [player in (1,2)] > 3", true, "4"},
+		{"count units [] == 4", true, "4"},
+		{fmt.Sprintf("count tiles [tile_type==%d] >= 2", TileTypeLandBase), true, "2"},
+		{fmt.Sprintf("count tiles [tile_type==%d, player==1] == 1", TileTypeLandBase), true, "1"},
+		{fmt.Sprintf("count tiles [tile_type==%d] < 2", TileTypeLandBase), false, "2"},
+		{"count unit [player==3] == 0", true, "0"},
+		{"count tiles [player!=0] =", true, "2"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			results, err := ac.EvaluateAssertions(tc.input)
+			if err != nil {
+				t.Fatalf("EvaluateAssertions error: %v", err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("got %d results, want 1", len(results))
+			}
+			if results[0].Passed != tc.passed || results[0].Actual != tc.actual {
+				t.Errorf("got %s, want passed=%v with count %s", results[0], tc.passed, tc.actual)
+			}
+		})
+	}
+
+	for _, bad := range []string{
+		"count units == 3",
+		"count units [player==1]",
+		"count buildings [player==1] == 1",
+		"count units [player=] == 1",
+	} {
+		if _, err := ac.EvaluateAssertions(bad); err == nil {
+			t.Errorf("EvaluateAssertions(%q) should fail", bad)
+		}
+		if err := ValidateAssertions(bad); err == nil {
+			t.Errorf("ValidateAssertions(%q) should fail", bad)
+		}
+	}
+	if _, err := ac.EvaluateAssertions("count units [colour==red] == 1"); err == nil {
+		t.Error("counting on an unknown field should fail")
+	}
+}
@@ -0,0 +1,75 @@
+package lib
+
+import (
+	"cmp"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// AttackPosition is a tile a unit could attack a target from, with what the
+// unit would be standing on once it got there
+type AttackPosition struct {
+	Coord        AxialCoord
+	TileType     int32
+	DefenseBonus int32   // Terrain defense bonus for the unit on this tile
+	MovementCost float64 // Cost to get there, 0 for the unit's own tile
+}
+
+// GetAttackPositions returns the tiles unit can reach this turn and attack
+// target from, so the UI can offer the best approach. Positions are sorted
+// best first: highest defense bonus, then cheapest to reach.
+func (g *Game) GetAttackPositions(unit, target *v1.Unit) []AttackPosition {
+	if !g.areOpponents(unit.Player, target.Player) || unit.AvailableHealth <= 0 {
+		return nil
+	}
+	if _, canAttack := g.RulesEngine.GetCombatPrediction(unit.UnitType, target.UnitType); !canAttack {
+		return nil
+	}
+	unitDef := g.progressionUnitDef(unit)
+	if _, attackAllowed := g.resolveActionStep(unit, unitDef, "attack"); !attackAllowed {
+		return nil
+	}
+
+	minRange := max(int(unitDef.MinAttackRange), 1)
+	inRange := func(coord AxialCoord) bool {
+		d := CubeDistance(coord, UnitGetCoord(target))
+		return d >= minRange && d <= int(unitDef.AttackRange)
+	}
+
+	var positions []AttackPosition
+	add := func(coord AxialCoord, cost float64) {
+		if !inRange(coord) {
+			return
+		}
+		pos := AttackPosition{Coord: coord, MovementCost: cost}
+		if tile := g.World.TileAt(coord); tile != nil {
+			pos.TileType = tile.TileType
+		}
+		if props := g.RulesEngine.GetTerrainUnitPropertiesForUnit(pos.TileType, unit.UnitType); props != nil {
+			pos.DefenseBonus = props.DefenseBonus
+		}
+		positions = append(positions, pos)
+	}
+
+	add(UnitGetCoord(unit), 0)
+	if _, moveAllowed := g.resolveActionStep(unit, unitDef, "move"); moveAllowed && g.RulesEngine.HasMovementLeft(unit.DistanceLeft) {
+		if allPaths, err := g.RulesEngine.GetMovementOptions(g.World, unit, unit.DistanceLeft, false); err == nil {
+			for _, edge := range allPaths.Edges {
+				if !edge.IsOccupied {
+					add(CoordFromInt32(edge.ToQ, edge.ToR), edge.TotalCost)
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(positions, func(a, b AttackPosition) int {
+		return cmp.Or(
+			cmp.Compare(b.DefenseBonus, a.DefenseBonus),
+			cmp.Compare(a.MovementCost, b.MovementCost),
+			cmp.Compare(a.Coord.R, b.Coord.R),
+			cmp.Compare(a.Coord.Q, b.Coord.Q),
+		)
+	})
+	return positions
+}
@@ -0,0 +1,42 @@
+package lib
+
+import "testing"
+
+const testTileTypeForest = 9
+
+// TestGetAttackPositions_PrefersDefensiveTerrain tests a target that can be
+// approached from a grass tile or a forest tile lists the forest first
+func TestGetAttackPositions_PrefersDefensiveTerrain(t *testing.T) {
+	game := newTestGameBuilder().
+		tile(0, 0, TileTypeGrass, 0).
+		tile(1, -1, TileTypeGrass, 0).
+		tile(1, 0, testTileTypeForest, 0).
+		tile(2, -1, TileTypeGrass, 0).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, -1, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+
+	attacker := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	target := game.World.UnitAt(AxialCoord{Q: 2, R: -1})
+	positions := game.GetAttackPositions(attacker, target)
+	if len(positions) != 2 {
+		t.Fatalf("got %d attack positions, want 2: %+v", len(positions), positions)
+	}
+
+	forest, grass := positions[0], positions[1]
+	if forest.Coord != (AxialCoord{Q: 1, R: 0}) || forest.TileType != testTileTypeForest {
+		t.Errorf("best position is %+v, want the forest at (1, 0)", forest)
+	}
+	if grass.Coord != (AxialCoord{Q: 1, R: -1}) || grass.TileType != TileTypeGrass {
+		t.Errorf("second position is %+v, want the grass at (1, -1)", grass)
+	}
+	if forest.DefenseBonus <= grass.DefenseBonus {
+		t.Errorf("forest defense %d is not better than grass defense %d", forest.DefenseBonus, grass.DefenseBonus)
+	}
+
+	// Friendly units are never attack targets
+	if got := game.GetAttackPositions(target, target); got != nil {
+		t.Errorf("got attack positions against a friendly unit: %+v", got)
+	}
+}
@@ -0,0 +1,96 @@
+package lib
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Attack Range
+// =============================================================================
+//
+// A unit attacks hexes between its minimum and maximum range.  Indirect
+// units (a minimum range above 1, like artillery) fire over anything, so
+// their range is a ring with a hole in the middle.  Direct units need a
+// clear line of sight: every hex between them and the target must be on
+// the map and free of other units.
+
+// IsIndirectFire returns whether units of this type fire over obstacles,
+// ie cannot attack adjacent hexes
+func IsIndirectFire(unitDef *v1.UnitDefinition) bool {
+	return unitDef.MinAttackRange > 1
+}
+
+// HexLine returns the hexes on the straight line from a to b, both ends
+// included
+func HexLine(a, b AxialCoord) []AxialCoord {
+	n := a.Distance(b)
+	line := make([]AxialCoord, 0, n+1)
+	for i := 0; i <= n; i++ {
+		t := 0.0
+		if n > 0 {
+			t = float64(i) / float64(n)
+		}
+		// Nudge off hex edges so lines along them round consistently
+		q := float64(a.Q) + 1e-6 + float64(b.Q-a.Q)*t
+		r := float64(a.R) + 1e-6 + float64(b.R-a.R)*t
+		line = append(line, roundAxial(q, r))
+	}
+	return line
+}
+
+// HasLineOfSight returns whether every hex strictly between from and to is
+// on the map and free of units other than the one at from
+func (g *Game) HasLineOfSight(from, to AxialCoord) bool {
+	self := g.World.UnitAt(from)
+	line := HexLine(from, to)
+	for _, coord := range line[1 : len(line)-1] {
+		if g.World.TileAt(coord) == nil || g.RulesEngine.occupiedByOther(g.World, coord, self) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetAttackRangeTiles returns the hexes on the map the unit could attack
+// from where it stands, whether or not anything is there.  Used to show a
+// unit's range.
+func (g *Game) GetAttackRangeTiles(unit *v1.Unit) []AxialCoord {
+	unitDef := g.progressionUnitDef(unit)
+	minRange := max(int(unitDef.MinAttackRange), 1)
+	indirect := IsIndirectFire(unitDef)
+	from := UnitGetCoord(unit)
+
+	var tiles []AxialCoord
+	for radius := minRange; radius <= int(unitDef.AttackRange); radius++ {
+		for _, coord := range from.Ring(radius) {
+			if g.World.TileAt(coord) == nil {
+				continue
+			}
+			if !indirect && !g.HasLineOfSight(from, coord) {
+				continue
+			}
+			tiles = append(tiles, coord)
+		}
+	}
+	return tiles
+}
+
+// GetAttackableTiles returns the hexes in the unit's attack range holding an
+// enemy it can see and attack, or with friendly fire allowed one of its
+// player's other units
+func (g *Game) GetAttackableTiles(unit *v1.Unit) []AxialCoord {
+	var tiles []AxialCoord
+	for _, coord := range g.GetAttackRangeTiles(unit) {
+		target := g.RulesEngine.OccupantAt(g.World, coord)
+		if target == nil || target == unit || !g.IsUnitVisibleToPlayer(target, unit.Player) {
+			continue
+		}
+		if !g.areOpponents(unit.Player, target.Player) && !(target.Player == unit.Player && g.FriendlyFireAllowed()) {
+			continue
+		}
+		if _, canAttack := g.RulesEngine.GetCombatPrediction(unit.UnitType, target.UnitType); canAttack {
+			tiles = append(tiles, coord)
+		}
+	}
+	return tiles
+}
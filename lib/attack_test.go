@@ -402,3 +402,56 @@ func TestProcessAttackUnit_AttackHistoryUpdated(t *testing.T) {
 		t.Error("Attack should be recorded in defender's history")
 	}
 }
+
+// TestProcessAttackUnit_ScriptedRolls tests that combat draws its rolls from
+// an injected RNG, so a scripted sequence deals an exact damage
+func TestProcessAttackUnit_ScriptedRolls(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(2).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	attacker := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	defender := game.World.UnitAt(AxialCoord{Q: 1, R: 0})
+	attackerHealth, defenderHealth := attacker.AvailableHealth, defender.AvailableHealth
+
+	// Half of every health unit's 6 dice hit, so each side deals half its
+	// health, rounded down.  Both sides roll before damage is applied.
+	game.SetRNG(NewScriptedRNG(0, 0, 0, 0.999, 0.999, 0.999))
+	move := &v1.GameMove{
+		MoveType: &v1.GameMove_AttackUnit{
+			AttackUnit: &v1.AttackUnitAction{
+				Attacker: &v1.Position{Q: 0, R: 0},
+				Defender: &v1.Position{Q: 1, R: 0},
+			},
+		},
+	}
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+
+	damage := attackerHealth / 2
+	if got, want := game.World.UnitAt(AxialCoord{Q: 1, R: 0}).AvailableHealth, defenderHealth-damage; got != want {
+		t.Errorf("defender health = %d, want %d", got, want)
+	}
+	counterDamage := defenderHealth / 2
+	if got, want := game.World.UnitAt(AxialCoord{Q: 0, R: 0}).AvailableHealth, attackerHealth-counterDamage; got != want {
+		t.Errorf("attacker health = %d, want %d", got, want)
+	}
+
+	// Every die missing deals no damage at all
+	game = newTestGameBuilder().
+		grassTiles(2).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	game.SetRNG(NewScriptedRNG(0.999))
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+	if got := game.World.UnitAt(AxialCoord{Q: 1, R: 0}).AvailableHealth; got != defenderHealth {
+		t.Errorf("defender health after all misses = %d, want %d", got, defenderHealth)
+	}
+}
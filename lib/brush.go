@@ -0,0 +1,77 @@
+package lib
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Editor Brushes
+// =============================================================================
+//
+// Mirrors the world editor's brush tools (World.radialNeighbours and
+// World.floodNeighbors in web/pages/common/World.ts) so the tiles a brush
+// stroke would paint can be computed and previewed outside the browser.
+
+// Brush modes
+const (
+	BrushModeBrush = "brush" // Every hex within size of the center
+	BrushModeFill  = "fill"  // Hexes matching the center tile, flood filled within size
+)
+
+// BrushFootprint returns the hexes a brush of the given mode and size would
+// paint when applied at center, sorted by coordinate. Size 0 paints only the
+// center.
+func BrushFootprint(world *World, center AxialCoord, mode string, size int) []AxialCoord {
+	var out []AxialCoord
+	switch {
+	case size <= 0:
+		out = []AxialCoord{center}
+	case mode == BrushModeFill:
+		out = floodFootprint(world, center, size)
+	default:
+		out = center.Range(size)
+	}
+	sortCoords(out)
+	return out
+}
+
+// floodFootprint floods out from center over tiles with the same type and
+// owner as the center tile (or over empty hexes if the center is empty),
+// staying within size of the center in both q and r
+func floodFootprint(world *World, center AxialCoord, size int) []AxialCoord {
+	start := world.TileAt(center)
+	sameAsStart := func(tile *v1.Tile) bool {
+		if start == nil || tile == nil {
+			return start == tile
+		}
+		return tile.TileType == start.TileType && tile.Player == start.Player
+	}
+
+	visited := map[AxialCoord]bool{center: true}
+	queue := []AxialCoord{center}
+	var neighbors [6]AxialCoord
+	for i := 0; i < len(queue); i++ {
+		queue[i].Neighbors(&neighbors)
+		for _, next := range neighbors {
+			if visited[next] || abs(next.Q-center.Q) > size || abs(next.R-center.R) > size {
+				continue
+			}
+			if sameAsStart(world.TileAt(next)) {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return queue
+}
+
+// BrushPreview returns the tiles a brush stroke at center would paint, for
+// rendering as a preview with RenderOptions.PreviewTiles
+func BrushPreview(world *World, center AxialCoord, mode string, size int, tileType, player int32) []*v1.Tile {
+	footprint := BrushFootprint(world, center, mode, size)
+	tiles := make([]*v1.Tile, len(footprint))
+	for i, coord := range footprint {
+		tiles[i] = &v1.Tile{Q: int32(coord.Q), R: int32(coord.R), TileType: tileType, Player: player}
+	}
+	return tiles
+}
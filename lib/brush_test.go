@@ -0,0 +1,61 @@
+package lib
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestBrushFootprint_Size2 tests a size 2 brush paints every hex within 2
+func TestBrushFootprint_Size2(t *testing.T) {
+	world := NewWorld("brush", nil)
+	center := AxialCoord{Q: 3, R: -1}
+
+	footprint := BrushFootprint(world, center, BrushModeBrush, 2)
+	if len(footprint) != 19 {
+		t.Fatalf("size 2 footprint has %d hexes, want 19", len(footprint))
+	}
+	for _, coord := range footprint {
+		if center.Distance(coord) > 2 {
+			t.Errorf("footprint hex %v is %d from the center", coord, center.Distance(coord))
+		}
+	}
+
+	preview := BrushPreview(world, center, BrushModeBrush, 2, TileTypeGrass, 1)
+	if len(preview) != len(footprint) {
+		t.Fatalf("preview has %d tiles, footprint has %d hexes", len(preview), len(footprint))
+	}
+	for i, tile := range preview {
+		if coord := TileGetCoord(tile); coord != footprint[i] {
+			t.Errorf("preview tile %d at %v, want %v", i, coord, footprint[i])
+		}
+		if tile.TileType != TileTypeGrass || tile.Player != 1 {
+			t.Errorf("preview tile %d is type %d for player %d", i, tile.TileType, tile.Player)
+		}
+	}
+}
+
+// TestBrushFootprint_Fill tests fill stops at tiles unlike the center
+func TestBrushFootprint_Fill(t *testing.T) {
+	world := NewWorld("fill", nil)
+	for _, coord := range (AxialCoord{}).Range(3) {
+		world.SetTileType(coord, TileTypeGrass)
+	}
+	// A wall of water cutting off the hexes east of q=1
+	wall := []AxialCoord{{Q: 1, R: -1}, {Q: 1, R: 0}, {Q: 1, R: 1}, {Q: 2, R: -2}, {Q: 0, R: 2}}
+	for _, coord := range wall {
+		world.SetTileType(coord, TileTypeWaterRegular)
+	}
+
+	footprint := BrushFootprint(world, AxialCoord{}, BrushModeFill, 2)
+	for _, coord := range footprint {
+		if slices.Contains(wall, coord) {
+			t.Errorf("fill crossed the wall at %v", coord)
+		}
+		if coord.Q > 1 {
+			t.Errorf("fill reached %v beyond the wall", coord)
+		}
+	}
+	if !slices.Contains(footprint, AxialCoord{Q: -2, R: 0}) {
+		t.Error("fill did not reach (-2,0) on the center's side of the wall")
+	}
+}
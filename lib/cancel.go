@@ -0,0 +1,50 @@
+package lib
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// =============================================================================
+// Cancellation
+// =============================================================================
+//
+// The expensive entry points (movement flood-fills, replaying changes, AI
+// play outs and combat simulations) have Context variants that stop with
+// the context's error once it is cancelled or past its deadline, so a
+// cancelled RPC stops using CPU.  Tight loops (flood-fills, simulations)
+// check the context every CancelCheckInterval steps, so small computations
+// (eg reachability on everyday maps) never pay for the check; coarser ones
+// check before each move or unit.  The plain variants run with
+// context.Background and cannot be cancelled.
+
+// CancelCheckInterval is how many steps a long loop takes between checks of
+// its context
+const CancelCheckInterval = 256
+
+// reachProgress counts the tiles movement flood-fills have expanded, in
+// batches of CancelCheckInterval.  Tests read it to see a cancelled fill has
+// stopped.
+var reachProgress atomic.Int64
+
+// canceller checks a context every CancelCheckInterval steps
+type canceller struct {
+	ctx   context.Context
+	steps int
+
+	// Optional counter the steps are added to at each check
+	progress *atomic.Int64
+}
+
+// step counts a step of work, returning the context's error on every
+// CancelCheckInterval'th step once it is done
+func (c *canceller) step() error {
+	c.steps++
+	if c.steps%CancelCheckInterval != 0 {
+		return nil
+	}
+	if c.progress != nil {
+		c.progress.Add(CancelCheckInterval)
+	}
+	return c.ctx.Err()
+}
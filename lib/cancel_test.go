@@ -0,0 +1,50 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// TestMovementFillCancelled tests a flood-fill over a huge map stops promptly
+// once its context is cancelled, and does no more work after returning
+func TestMovementFillCancelled(t *testing.T) {
+	worldData := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for _, coord := range (AxialCoord{}).Range(300) {
+		worldData.TilesMap[CoordKeyFromAxial(coord)] = NewTile(coord, TileTypeGrass)
+	}
+	unit := NewUnit(UnitTypeSoldier, 1, AxialCoord{})
+	worldData.UnitsMap[CoordKeyFromAxial(AxialCoord{})] = unit
+	world := NewWorld("huge", worldData)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	start := reachProgress.Load()
+	go func() {
+		_, err := DefaultRulesEngine().GetMovementOptionsContext(ctx, world, unit, 1_000_000, false)
+		done <- err
+	}()
+
+	for reachProgress.Load() == start {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("cancelled fill returned %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("fill still running a second after it was cancelled")
+	}
+
+	stopped := reachProgress.Load()
+	time.Sleep(20 * time.Millisecond)
+	if after := reachProgress.Load(); after != stopped {
+		t.Errorf("fill expanded %d more tiles after returning", after-stopped)
+	}
+}
@@ -0,0 +1,31 @@
+package lib
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// EnableChangeLog starts recording the world changes of every move the game
+// processes from now on, for auditing alongside the move history
+func (g *Game) EnableChangeLog() {
+	if g.changeLog == nil {
+		g.changeLog = &v1.ChangeLog{}
+	}
+}
+
+// GetChangeLog returns the changes recorded since EnableChangeLog, or nil if
+// the change log isn't enabled. The log is a proto so it can be stored as is.
+func (g *Game) GetChangeLog() *v1.ChangeLog {
+	return g.changeLog
+}
+
+// recordChanges adds a processed move's changes to the change log
+func (g *Game) recordChanges(player, turnCounter int32, move *v1.GameMove) {
+	if g.changeLog == nil {
+		return
+	}
+	g.changeLog.Entries = append(g.changeLog.Entries, &v1.ChangeLogEntry{
+		Player:      player,
+		TurnCounter: turnCounter,
+		Changes:     move.Changes,
+	})
+}
@@ -0,0 +1,75 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChangeLog_ReplaysMoveOnClone(t *testing.T) {
+	builder := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		currentPlayer(1)
+	game, clone := builder.build(), builder.build()
+
+	if game.GetChangeLog() != nil {
+		t.Fatal("change log should be nil until enabled")
+	}
+	game.EnableChangeLog()
+
+	move := &v1.GameMove{
+		MoveType: &v1.GameMove_MoveUnit{
+			MoveUnit: &v1.MoveUnitAction{From: &v1.Position{Q: 0, R: 0}, To: &v1.Position{Q: 1, R: 0}},
+		},
+	}
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+
+	// The log survives a round trip through its wire format
+	data, err := proto.Marshal(game.GetChangeLog())
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	changeLog := &v1.ChangeLog{}
+	if err := proto.Unmarshal(data, changeLog); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(changeLog.Entries) != 1 {
+		t.Fatalf("change log has %d entries, want 1", len(changeLog.Entries))
+	}
+	entry := changeLog.Entries[0]
+	if entry.Player != 1 || entry.TurnCounter != 1 || len(entry.Changes) == 0 {
+		t.Fatalf("entry = player %d, turn %d, %d changes, want player 1, turn 1 with changes", entry.Player, entry.TurnCounter, len(entry.Changes))
+	}
+
+	if err := clone.ApplyChanges([]*v1.GameMove{{Changes: entry.Changes}}); err != nil {
+		t.Fatalf("ApplyChanges failed: %v", err)
+	}
+	if clone.World.UnitAt(AxialCoord{Q: 0, R: 0}) != nil {
+		t.Error("unit still at its starting hex on the clone")
+	}
+	moved, want := clone.World.UnitAt(AxialCoord{Q: 1, R: 0}), game.World.UnitAt(AxialCoord{Q: 1, R: 0})
+	if !proto.Equal(moved, want) {
+		t.Errorf("clone's unit = %v, want %v", moved, want)
+	}
+}
+
+func TestChangeLog_SkipsFailedMoves(t *testing.T) {
+	game := newTestGameBuilder().grassTiles(1).currentPlayer(1).build()
+	game.EnableChangeLog()
+
+	move := &v1.GameMove{
+		MoveType: &v1.GameMove_MoveUnit{
+			MoveUnit: &v1.MoveUnitAction{From: &v1.Position{Q: 0, R: 0}, To: &v1.Position{Q: 1, R: 0}},
+		},
+	}
+	if err := game.ProcessMove(move); err == nil {
+		t.Fatal("expected moving a missing unit to fail")
+	}
+	if entries := game.GetChangeLog().Entries; len(entries) != 0 {
+		t.Errorf("change log has %d entries after a failed move, want 0", len(entries))
+	}
+}
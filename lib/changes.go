@@ -1,28 +1,50 @@
 package lib
 
 import (
+	"context"
 	"fmt"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 )
 
 // ApplyChanges applies WorldChanges from processed moves to the game state.
+// It is the one code path for updating a game from a diff: the services use
+// it to commit a move transaction, and clients use it for remote changes.
 // This handles the transaction rollback pattern: after ProcessMoves operates on
 // a transaction snapshot, ApplyChanges applies the changes to the original world.
+//
+// Each change is checked against the game before it is applied, eg a moved
+// unit must be where the change says it was. The first invalid change stops
+// the batch with an error; the changes before it stay applied.
 func (g *Game) ApplyChanges(moves []*v1.GameMove) error {
+	return g.ApplyChangesContext(context.Background(), moves)
+}
+
+// ApplyChangesContext is ApplyChanges that checks the context before each
+// move and stops with its error once it is done, eg while replaying a long
+// game.  Moves already applied stay applied.
+func (g *Game) ApplyChangesContext(ctx context.Context, moves []*v1.GameMove) error {
 	// TRANSACTIONAL FIX: Temporary rollback to original world for ordered application
 	if parent := g.World.Pop(); parent != nil {
 		g.World = parent // Switch back to original world
 	}
 
+	// Hexes in sight before the moves count as discovered too, eg the ones
+	// seen from where a unit moves away from
+	g.discoverVisibleHexes()
+
 	// Apply each change to runtime game (now the original, not the transaction snapshot)
 	for _, moveResult := range moves {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		for _, change := range moveResult.Changes {
 			err := g.applyWorldChange(change)
 			if err != nil {
 				return fmt.Errorf("failed to apply world change: %w", err)
 			}
 		}
+		g.discoverVisibleHexes()
 	}
 
 	return nil
@@ -43,24 +65,87 @@ func (g *Game) applyWorldChange(change *v1.WorldChange) error {
 		return g.applyUnitBuilt(changeType.UnitBuilt)
 	case *v1.WorldChange_CoinsChanged:
 		return g.applyCoinsChanged(changeType.CoinsChanged)
+	case *v1.WorldChange_TerrainChanged:
+		return g.applyTerrainChanged(changeType.TerrainChanged)
+	case *v1.WorldChange_UnitSubmerged:
+		return g.applyUnitSubmerged(changeType.UnitSubmerged)
+	case *v1.WorldChange_TurnDelegated:
+		return g.applyTurnDelegated(changeType.TurnDelegated)
+	case *v1.WorldChange_UnitDrafted:
+		return g.applyUnitDrafted(changeType.UnitDrafted)
+	case *v1.WorldChange_GameEvent:
+		// Events are only a record, the world is unchanged
+		return nil
+	case *v1.WorldChange_UnitAttacked:
+		// The recap only, its UnitDamaged and UnitKilled changes do the damage
+		return nil
+	case *v1.WorldChange_UnitHealed:
+		return g.applyUnitHealed(changeType.UnitHealed)
+	case *v1.WorldChange_UnitTransformed:
+		return g.applyUnitTransformed(changeType.UnitTransformed)
+	case *v1.WorldChange_UnitFixed:
+		return g.applyUnitFixed(changeType.UnitFixed)
+	case *v1.WorldChange_CaptureStarted:
+		return g.applyCaptureStarted(changeType.CaptureStarted)
+	case *v1.WorldChange_TileCaptured:
+		return g.applyTileCaptured(changeType.TileCaptured)
+	case *v1.WorldChange_VictoryPointsScored:
+		return g.applyVictoryPointsScored(changeType.VictoryPointsScored)
+	case *v1.WorldChange_PlanSubmitted:
+		// Plans stay private to the game that checked them; once they have
+		// resolved, the changes that follow do the work
+		if changeType.PlanSubmitted.Resolved {
+			g.GameState.TurnPlans = nil
+		}
+		return nil
 	default:
 		return fmt.Errorf("unknown world change type")
 	}
 }
 
+// changedUnit finds the unit a change refers to, checking it belongs to the
+// same player.  Units are found by their stable ID, so a change still finds
+// its unit after earlier changes in the batch moved things around.  Changes
+// recorded before units had IDs find the unit at the change's position.
+func (g *Game) changedUnit(change *v1.Unit) (*v1.Unit, error) {
+	unit := g.findChangedUnit(change)
+	if unit == nil {
+		if change.Id > 0 {
+			return nil, fmt.Errorf("unit %d not found", change.Id)
+		}
+		return nil, fmt.Errorf("unit not found at %v", UnitGetCoord(change))
+	}
+	if unit.Player != change.Player {
+		return nil, fmt.Errorf("unit at %v belongs to player %d, not player %d", UnitGetCoord(unit), unit.Player, change.Player)
+	}
+	return unit, nil
+}
+
+// findChangedUnit finds the unit a change refers to by ID, or by position
+// when the change has no ID
+func (g *Game) findChangedUnit(change *v1.Unit) *v1.Unit {
+	if change.Id > 0 {
+		return g.World.GetUnitByID(change.Id)
+	}
+	return g.World.UnitAt(UnitGetCoord(change))
+}
+
 // applyUnitMoved moves a unit in the runtime game
 func (g *Game) applyUnitMoved(change *v1.UnitMovedChange) error {
 	if change.PreviousUnit == nil || change.UpdatedUnit == nil {
 		return fmt.Errorf("missing unit data in UnitMovedChange")
 	}
 
-	fromCoord := AxialCoord{Q: int(change.PreviousUnit.Q), R: int(change.PreviousUnit.R)}
 	toCoord := AxialCoord{Q: int(change.UpdatedUnit.Q), R: int(change.UpdatedUnit.R)}
 
 	// Move unit in runtime game
-	unit := g.World.UnitAt(fromCoord)
-	if unit == nil {
-		return fmt.Errorf("unit not found at %v", fromCoord)
+	unit, err := g.changedUnit(change.PreviousUnit)
+	if err != nil {
+		return err
+	}
+	fromCoord := UnitGetCoord(unit)
+	if toCoord != fromCoord && g.World.UnitAt(toCoord) != nil {
+		return fmt.Errorf("cannot move unit from %v to occupied %v", fromCoord, toCoord)
 	}
 
 	// Update unit with complete state from the change
@@ -70,6 +155,7 @@ func (g *Game) applyUnitMoved(change *v1.UnitMovedChange) error {
 	unit.LastToppedupTurn = change.UpdatedUnit.LastToppedupTurn
 	unit.ProgressionStep = change.UpdatedUnit.ProgressionStep
 	unit.ChosenAlternative = change.UpdatedUnit.ChosenAlternative
+	unit.StepActions = change.UpdatedUnit.StepActions
 
 	// Remove from old position and add to new position
 	return g.World.MoveUnit(unit, toCoord)
@@ -81,11 +167,9 @@ func (g *Game) applyUnitDamaged(change *v1.UnitDamagedChange) error {
 		return fmt.Errorf("missing updated unit data in UnitDamagedChange")
 	}
 
-	coord := AxialCoord{Q: int(change.UpdatedUnit.Q), R: int(change.UpdatedUnit.R)}
-
-	unit := g.World.UnitAt(coord)
-	if unit == nil {
-		return fmt.Errorf("unit not found at %v", coord)
+	unit, err := g.changedUnit(change.UpdatedUnit)
+	if err != nil {
+		return err
 	}
 
 	// Update unit with complete state from the change
@@ -95,6 +179,7 @@ func (g *Game) applyUnitDamaged(change *v1.UnitDamagedChange) error {
 	unit.LastToppedupTurn = change.UpdatedUnit.LastToppedupTurn
 	unit.ProgressionStep = change.UpdatedUnit.ProgressionStep
 	unit.ChosenAlternative = change.UpdatedUnit.ChosenAlternative
+	unit.StepActions = change.UpdatedUnit.StepActions
 	return nil
 }
 
@@ -104,31 +189,34 @@ func (g *Game) applyUnitKilled(change *v1.UnitKilledChange) error {
 		return fmt.Errorf("missing previous unit data in UnitKilledChange")
 	}
 
-	coord := AxialCoord{Q: int(change.PreviousUnit.Q), R: int(change.PreviousUnit.R)}
-	unit := g.World.UnitAt(coord)
-
-	err := g.World.RemoveUnit(unit)
+	unit, err := g.changedUnit(change.PreviousUnit)
 	if err != nil {
-		return fmt.Errorf("unit not found at %v", coord)
+		return err
 	}
-	return nil
+	return g.World.RemoveUnit(unit)
 }
 
 // applyPlayerChanged updates game state for turn/player changes
 func (g *Game) applyPlayerChanged(change *v1.PlayerChangedChange) error {
+	if change.NewPlayer < 1 || (g.NumPlayers() > 0 && change.NewPlayer > g.NumPlayers()) {
+		return fmt.Errorf("invalid next player %d", change.NewPlayer)
+	}
+	if change.NewTurn < 1 {
+		return fmt.Errorf("invalid turn %d", change.NewTurn)
+	}
+
 	g.CurrentPlayer = change.NewPlayer
 	g.TurnCounter = change.NewTurn
 
 	// Also update the protobuf GameState
 	g.GameState.CurrentPlayer = change.NewPlayer
 	g.GameState.TurnCounter = change.NewTurn
+	g.GameState.DelegatedTo = 0
 
 	// Apply reset units (for remote updates where units need topped-up values)
 	// The server has already calculated the new unit states; we apply them here
 	for _, resetUnit := range change.ResetUnits {
-		coord := AxialCoord{Q: int(resetUnit.Q), R: int(resetUnit.R)}
-		unit := g.World.UnitAt(coord)
-		if unit != nil {
+		if unit := g.findChangedUnit(resetUnit); unit != nil {
 			// Update unit with topped-up values from the change
 			unit.DistanceLeft = resetUnit.DistanceLeft
 			unit.AvailableHealth = resetUnit.AvailableHealth
@@ -137,6 +225,21 @@ func (g *Game) applyPlayerChanged(change *v1.PlayerChangedChange) error {
 		}
 	}
 
+	// Apply the clock hand-over when time banks are enabled
+	if change.TimeBanksMs != nil {
+		for playerId, bank := range change.TimeBanksMs {
+			if playerState := g.GameState.PlayerStates[playerId]; playerState != nil {
+				playerState.TimeBankMs = bank
+			}
+		}
+		g.GameState.ClockStartedAt = change.ClockStartedAt
+	}
+	if change.PreviousPlayerForfeited {
+		if playerState := g.GameState.PlayerStates[change.PreviousPlayer]; playerState != nil {
+			playerState.IsActive = false
+		}
+	}
+
 	return nil
 }
 
@@ -146,21 +249,36 @@ func (g *Game) applyUnitBuilt(change *v1.UnitBuiltChange) error {
 		return fmt.Errorf("missing unit data in UnitBuiltChange")
 	}
 
-	// Add the new unit to the runtime game
-	g.World.AddUnit(change.Unit)
-
-	// Update tile's last acted turn
+	if _, err := g.RulesEngine.GetUnitData(change.Unit.UnitType); err != nil {
+		return fmt.Errorf("unknown unit type %d", change.Unit.UnitType)
+	}
 	coord := AxialCoord{Q: int(change.TileQ), R: int(change.TileR)}
 	tile := g.World.TileAt(coord)
-	if tile != nil {
-		tile.LastActedTurn = g.TurnCounter
+	if tile == nil {
+		return fmt.Errorf("no tile at %v to build on", coord)
+	}
+	if g.World.UnitAt(UnitGetCoord(change.Unit)) != nil {
+		return fmt.Errorf("cannot build a unit on occupied %v", UnitGetCoord(change.Unit))
+	}
+	if change.Unit.Id > 0 && g.World.GetUnitByID(change.Unit.Id) != nil {
+		return fmt.Errorf("cannot build a unit with ID %d already in use", change.Unit.Id)
 	}
 
+	// Add the new unit to the runtime game
+	g.World.AddUnit(change.Unit)
+	g.noteUnitID(change.Unit.Id)
+
+	// Update tile's last acted turn
+	tile.LastActedTurn = g.TurnCounter
 	return nil
 }
 
 // applyCoinsChanged updates a player's coin balance in the runtime game
 func (g *Game) applyCoinsChanged(change *v1.CoinsChangedChange) error {
+	if change.NewCoins < 0 {
+		return fmt.Errorf("player %d can't have %d coins", change.PlayerId, change.NewCoins)
+	}
+
 	// Update player's coins in GameState.PlayerStates
 	if g.GameState.PlayerStates == nil {
 		g.GameState.PlayerStates = make(map[int32]*v1.PlayerState)
@@ -173,3 +291,137 @@ func (g *Game) applyCoinsChanged(change *v1.CoinsChangedChange) error {
 	playerState.Coins = change.NewCoins
 	return nil
 }
+
+// applyTerrainChanged replaces the tile with its updated state (terrain type
+// and construction progress)
+func (g *Game) applyTerrainChanged(change *v1.TerrainChangedChange) error {
+	if change.UpdatedTile == nil {
+		return fmt.Errorf("missing updated tile data in TerrainChangedChange")
+	}
+	if _, err := g.RulesEngine.GetTerrainData(change.UpdatedTile.TileType); err != nil {
+		return fmt.Errorf("unknown terrain type %d", change.UpdatedTile.TileType)
+	}
+	g.World.AddTile(copyTile(change.UpdatedTile))
+	return nil
+}
+
+// applyUnitSubmerged updates a unit's submerged state
+func (g *Game) applyUnitSubmerged(change *v1.UnitSubmergedChange) error {
+	if change.UpdatedUnit == nil {
+		return fmt.Errorf("missing updated unit data in UnitSubmergedChange")
+	}
+
+	unit, err := g.changedUnit(change.UpdatedUnit)
+	if err != nil {
+		return err
+	}
+	unit.Submerged = change.UpdatedUnit.Submerged
+	return nil
+}
+
+// applyTurnDelegated hands control of the current turn to a teammate
+func (g *Game) applyTurnDelegated(change *v1.TurnDelegatedChange) error {
+	g.GameState.DelegatedTo = change.DelegatePlayerId
+	return nil
+}
+
+// applyUnitDrafted records a draft turn and hands the draft, or the game once
+// the draft is over, to the next player
+func (g *Game) applyUnitDrafted(change *v1.UnitDraftedChange) error {
+	g.applyDraftTurn(change.PlayerId, change.UnitType, change.Pick, change.TurnsTaken)
+	g.GameState.CurrentPlayer = change.NextPlayer
+	if change.DraftComplete {
+		g.GameState.Status = v1.GameStatus_GAME_STATUS_PLAYING
+	}
+	return nil
+}
+
+// applyUnitHealed updates a unit that healed in place
+func (g *Game) applyUnitHealed(change *v1.UnitHealedChange) error {
+	if change.UpdatedUnit == nil {
+		return fmt.Errorf("missing updated unit data in UnitHealedChange")
+	}
+	unit, err := g.changedUnit(change.UpdatedUnit)
+	if err != nil {
+		return err
+	}
+	unit.AvailableHealth = change.UpdatedUnit.AvailableHealth
+	unit.LastActedTurn = change.UpdatedUnit.LastActedTurn
+	unit.DistanceLeft = change.UpdatedUnit.DistanceLeft
+	unit.ProgressionStep = change.UpdatedUnit.ProgressionStep
+	unit.ChosenAlternative = change.UpdatedUnit.ChosenAlternative
+	unit.StepActions = change.UpdatedUnit.StepActions
+	return nil
+}
+
+// applyUnitTransformed changes a unit's type in place
+func (g *Game) applyUnitTransformed(change *v1.UnitTransformedChange) error {
+	if change.UpdatedUnit == nil {
+		return fmt.Errorf("missing updated unit data in UnitTransformedChange")
+	}
+	unit, err := g.changedUnit(change.UpdatedUnit)
+	if err != nil {
+		return err
+	}
+	unit.UnitType = change.UpdatedUnit.UnitType
+	unit.AvailableHealth = change.UpdatedUnit.AvailableHealth
+	unit.Submerged = change.UpdatedUnit.Submerged
+	unit.LastActedTurn = change.UpdatedUnit.LastActedTurn
+	unit.DistanceLeft = change.UpdatedUnit.DistanceLeft
+	unit.ProgressionStep = change.UpdatedUnit.ProgressionStep
+	unit.ChosenAlternative = change.UpdatedUnit.ChosenAlternative
+	unit.StepActions = change.UpdatedUnit.StepActions
+	return nil
+}
+
+// applyUnitFixed updates both the fixing unit and the unit it repaired
+func (g *Game) applyUnitFixed(change *v1.UnitFixedChange) error {
+	if change.FixerUnit == nil || change.UpdatedTarget == nil {
+		return fmt.Errorf("missing unit data in UnitFixedChange")
+	}
+	fixer, err := g.changedUnit(change.FixerUnit)
+	if err != nil {
+		return err
+	}
+	target, err := g.changedUnit(change.UpdatedTarget)
+	if err != nil {
+		return err
+	}
+	fixer.LastActedTurn = change.FixerUnit.LastActedTurn
+	fixer.ProgressionStep = change.FixerUnit.ProgressionStep
+	fixer.ChosenAlternative = change.FixerUnit.ChosenAlternative
+	fixer.StepActions = change.FixerUnit.StepActions
+	target.AvailableHealth = change.UpdatedTarget.AvailableHealth
+	return nil
+}
+
+// applyCaptureStarted marks a unit as capturing the building it stands on
+func (g *Game) applyCaptureStarted(change *v1.CaptureStartedChange) error {
+	if change.CapturingUnit == nil {
+		return fmt.Errorf("missing unit data in CaptureStartedChange")
+	}
+	unit, err := g.changedUnit(change.CapturingUnit)
+	if err != nil {
+		return err
+	}
+	coord := AxialCoord{Q: int(change.TileQ), R: int(change.TileR)}
+	if coord != UnitGetCoord(unit) || g.World.TileAt(coord) == nil {
+		return fmt.Errorf("unit at %v can't capture a tile at %v", UnitGetCoord(unit), coord)
+	}
+	unit.CaptureStartedTurn = change.CapturingUnit.CaptureStartedTurn
+	return nil
+}
+
+// applyTileCaptured hands a captured tile to its new owner
+func (g *Game) applyTileCaptured(change *v1.TileCapturedChange) error {
+	coord := AxialCoord{Q: int(change.TileQ), R: int(change.TileR)}
+	tile := g.World.TileAt(coord)
+	if tile == nil {
+		return fmt.Errorf("no tile at %v to capture", coord)
+	}
+	if change.NewOwner < 0 {
+		return fmt.Errorf("invalid owner %d for the tile at %v", change.NewOwner, coord)
+	}
+	tile.Player = change.NewOwner
+	return nil
+}
@@ -0,0 +1,306 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// newChangesTestGame is a small grass map with a player 1 soldier at 0,0, a
+// player 2 soldier at 2,0 and a neutral base at 0,1
+func newChangesTestGame() *Game {
+	game := newTestGameBuilder().
+		tile(0, 1, TileTypeLandBase, 0).
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		coins(1, 300).
+		currentPlayer(1).
+		build()
+	game.Config = &v1.GameConfiguration{Players: []*v1.GamePlayer{{PlayerId: 1}, {PlayerId: 2}}}
+	return game
+}
+
+func applyChange(game *Game, change *v1.WorldChange) error {
+	return game.ApplyChanges([]*v1.GameMove{{Changes: []*v1.WorldChange{change}}})
+}
+
+func TestApplyChanges_EachChangeType(t *testing.T) {
+	soldier := func(q, r, player, health int32) *v1.Unit {
+		return &v1.Unit{Q: q, R: r, Player: player, UnitType: testUnitTypeSoldier, AvailableHealth: health}
+	}
+	unitAt := func(game *Game, q, r int) *v1.Unit {
+		return game.World.UnitAt(AxialCoord{Q: q, R: r})
+	}
+
+	tests := []struct {
+		name   string
+		change *v1.WorldChange
+		check  func(t *testing.T, game *Game)
+	}{
+		{
+			name: "unit moved",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{
+				PreviousUnit: soldier(0, 0, 1, 10), UpdatedUnit: soldier(1, 0, 1, 10),
+			}}},
+			check: func(t *testing.T, game *Game) {
+				if unitAt(game, 0, 0) != nil || unitAt(game, 1, 0) == nil {
+					t.Error("unit did not move from 0,0 to 1,0")
+				}
+			},
+		},
+		{
+			name: "unit damaged",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitDamaged{UnitDamaged: &v1.UnitDamagedChange{
+				PreviousUnit: soldier(2, 0, 2, 10), UpdatedUnit: soldier(2, 0, 2, 4),
+			}}},
+			check: func(t *testing.T, game *Game) {
+				if health := unitAt(game, 2, 0).AvailableHealth; health != 4 {
+					t.Errorf("health = %d, want 4", health)
+				}
+			},
+		},
+		{
+			name: "unit killed",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitKilled{UnitKilled: &v1.UnitKilledChange{
+				PreviousUnit: soldier(2, 0, 2, 10),
+			}}},
+			check: func(t *testing.T, game *Game) {
+				if unitAt(game, 2, 0) != nil {
+					t.Error("killed unit still on the map")
+				}
+			},
+		},
+		{
+			name: "unit healed",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitHealed{UnitHealed: &v1.UnitHealedChange{
+				UpdatedUnit: &v1.Unit{Q: 0, R: 0, Player: 1, UnitType: testUnitTypeSoldier, AvailableHealth: 10, LastActedTurn: 1, ProgressionStep: 1},
+			}}},
+			check: func(t *testing.T, game *Game) {
+				if unit := unitAt(game, 0, 0); unit.LastActedTurn != 1 || unit.ProgressionStep != 1 {
+					t.Errorf("healed unit = %v, want acted on turn 1 at step 1", unit)
+				}
+			},
+		},
+		{
+			name: "unit built",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitBuilt{UnitBuilt: &v1.UnitBuiltChange{
+				Unit: soldier(0, 1, 1, 10), TileQ: 0, TileR: 1,
+			}}},
+			check: func(t *testing.T, game *Game) {
+				if unit := unitAt(game, 0, 1); unit == nil || unit.Player != 1 {
+					t.Errorf("built unit = %v, want player 1's soldier", unit)
+				}
+			},
+		},
+		{
+			name: "coins changed",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_CoinsChanged{CoinsChanged: &v1.CoinsChangedChange{
+				PlayerId: 1, PreviousCoins: 300, NewCoins: 225,
+			}}},
+			check: func(t *testing.T, game *Game) {
+				if coins := game.GameState.PlayerStates[1].Coins; coins != 225 {
+					t.Errorf("coins = %d, want 225", coins)
+				}
+			},
+		},
+		{
+			name: "capture started",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_CaptureStarted{CaptureStarted: &v1.CaptureStartedChange{
+				CapturingUnit: &v1.Unit{Q: 2, R: 0, Player: 2, UnitType: testUnitTypeSoldier, CaptureStartedTurn: 1},
+				TileQ:         2, TileR: 0,
+			}}},
+			check: func(t *testing.T, game *Game) {
+				if turn := unitAt(game, 2, 0).CaptureStartedTurn; turn != 1 {
+					t.Errorf("capture started turn = %d, want 1", turn)
+				}
+			},
+		},
+		{
+			name: "tile captured",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_TileCaptured{TileCaptured: &v1.TileCapturedChange{
+				TileQ: 0, TileR: 1, TileType: TileTypeLandBase, NewOwner: 2,
+			}}},
+			check: func(t *testing.T, game *Game) {
+				if owner := game.World.TileAt(AxialCoord{Q: 0, R: 1}).Player; owner != 2 {
+					t.Errorf("tile owner = %d, want 2", owner)
+				}
+			},
+		},
+		{
+			name: "terrain changed",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_TerrainChanged{TerrainChanged: &v1.TerrainChangedChange{
+				UpdatedTile: &v1.Tile{Q: 1, R: 1, TileType: TileTypeLandBase},
+			}}},
+			check: func(t *testing.T, game *Game) {
+				if tileType := game.World.TileAt(AxialCoord{Q: 1, R: 1}).TileType; tileType != TileTypeLandBase {
+					t.Errorf("tile type = %d, want %d", tileType, TileTypeLandBase)
+				}
+			},
+		},
+		{
+			name: "player changed",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_PlayerChanged{PlayerChanged: &v1.PlayerChangedChange{
+				PreviousPlayer: 1, NewPlayer: 2, PreviousTurn: 1, NewTurn: 1,
+				ResetUnits: []*v1.Unit{{Q: 2, R: 0, Player: 2, AvailableHealth: 10, DistanceLeft: 3, LastToppedupTurn: 1}},
+			}}},
+			check: func(t *testing.T, game *Game) {
+				if game.CurrentPlayer != 2 {
+					t.Errorf("current player = %d, want 2", game.CurrentPlayer)
+				}
+				if unit := unitAt(game, 2, 0); unit.LastToppedupTurn != 1 {
+					t.Errorf("reset unit topped up on turn %d, want 1", unit.LastToppedupTurn)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			game := newChangesTestGame()
+			if err := applyChange(game, tc.change); err != nil {
+				t.Fatalf("ApplyChanges failed: %v", err)
+			}
+			tc.check(t, game)
+		})
+	}
+}
+
+func TestApplyChanges_RejectsInvalidChanges(t *testing.T) {
+	soldier := func(q, r, player int32) *v1.Unit {
+		return &v1.Unit{Q: q, R: r, Player: player, UnitType: testUnitTypeSoldier, AvailableHealth: 10}
+	}
+
+	tests := []struct {
+		name    string
+		change  *v1.WorldChange
+		wantErr string
+	}{
+		{
+			name: "move from an empty hex",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{
+				PreviousUnit: soldier(1, 1, 1), UpdatedUnit: soldier(1, 2, 1),
+			}}},
+			wantErr: "unit not found",
+		},
+		{
+			name: "move onto another unit",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{
+				PreviousUnit: soldier(0, 0, 1), UpdatedUnit: soldier(2, 0, 1),
+			}}},
+			wantErr: "occupied",
+		},
+		{
+			name: "damage the wrong player's unit",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitDamaged{UnitDamaged: &v1.UnitDamagedChange{
+				UpdatedUnit: soldier(0, 0, 2),
+			}}},
+			wantErr: "belongs to player 1",
+		},
+		{
+			name: "build on an occupied hex",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitBuilt{UnitBuilt: &v1.UnitBuiltChange{
+				Unit: soldier(0, 0, 1), TileQ: 0, TileR: 0,
+			}}},
+			wantErr: "occupied",
+		},
+		{
+			name: "negative coins",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_CoinsChanged{CoinsChanged: &v1.CoinsChangedChange{
+				PlayerId: 1, NewCoins: -5,
+			}}},
+			wantErr: "coins",
+		},
+		{
+			name: "hand the turn to a missing player",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_PlayerChanged{PlayerChanged: &v1.PlayerChangedChange{
+				PreviousPlayer: 1, NewPlayer: 3, NewTurn: 1,
+			}}},
+			wantErr: "invalid next player",
+		},
+		{
+			name: "move a unit ID that is not on the map",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{
+				PreviousUnit: &v1.Unit{Id: 99, Q: 0, R: 0, Player: 1}, UpdatedUnit: &v1.Unit{Id: 99, Q: 1, R: 0, Player: 1},
+			}}},
+			wantErr: "unit 99 not found",
+		},
+		{
+			name: "build a unit with an ID in use",
+			change: &v1.WorldChange{ChangeType: &v1.WorldChange_UnitBuilt{UnitBuilt: &v1.UnitBuiltChange{
+				Unit: &v1.Unit{Id: 1, Q: 0, R: 1, Player: 1, UnitType: testUnitTypeSoldier}, TileQ: 0, TileR: 1,
+			}}},
+			wantErr: "already in use",
+		},
+		{
+			name:    "empty change",
+			change:  &v1.WorldChange{},
+			wantErr: "unknown world change type",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			game := newChangesTestGame()
+			err := applyChange(game, tc.change)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("error = %v, want one containing %q", err, tc.wantErr)
+			}
+			if game.CurrentPlayer != 1 || game.World.UnitAt(AxialCoord{Q: 0, R: 0}) == nil {
+				t.Error("rejected change modified the game")
+			}
+		})
+	}
+}
+
+// TestApplyChanges_ResolvesUnitsByID tests a batch moving two units, where the
+// second unit moves into the hex the first just left, applies each change to
+// the unit it names by ID rather than whatever is at the recorded position
+func TestApplyChanges_ResolvesUnitsByID(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 1, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	first := game.World.UnitAt(AxialCoord{Q: 0, R: 0}).Id
+	second := game.World.UnitAt(AxialCoord{Q: 1, R: 0}).Id
+	soldier := func(id, q, r, health int32) *v1.Unit {
+		return &v1.Unit{Id: id, Q: q, R: r, Player: 1, UnitType: testUnitTypeSoldier, AvailableHealth: health}
+	}
+	moved := func(id, fromQ, toQ int32) *v1.WorldChange {
+		return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{
+			PreviousUnit: soldier(id, fromQ, 0, 10), UpdatedUnit: soldier(id, toQ, 0, 10),
+		}}}
+	}
+
+	err := game.ApplyChanges([]*v1.GameMove{
+		{Changes: []*v1.WorldChange{moved(second, 1, 2)}},
+		{Changes: []*v1.WorldChange{moved(first, 0, 1)}},
+		// Recorded where the second unit stood before the batch, which is
+		// now where the first unit is
+		{Changes: []*v1.WorldChange{{ChangeType: &v1.WorldChange_UnitDamaged{UnitDamaged: &v1.UnitDamagedChange{
+			PreviousUnit: soldier(second, 1, 0, 10), UpdatedUnit: soldier(second, 1, 0, 4),
+		}}}}},
+	})
+	if err != nil {
+		t.Fatalf("ApplyChanges failed: %v", err)
+	}
+
+	for _, want := range []struct {
+		id     int32
+		q      int32
+		health int32
+	}{{first, 1, 10}, {second, 2, 4}} {
+		unit := game.GetUnitByID(want.id)
+		if unit == nil {
+			t.Errorf("unit %d is gone", want.id)
+			continue
+		}
+		if unit.Q != want.q || unit.R != 0 || unit.AvailableHealth != want.health {
+			t.Errorf("unit %d is at (%d, %d) with %d health, want (%d, 0) with %d",
+				want.id, unit.Q, unit.R, unit.AvailableHealth, want.q, want.health)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package lib
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of wall-clock time for time-sensitive game mechanics
+// (eg time banks). Inject a FakeClock in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock reads the real time
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// FakeClock is a manually advanced clock for tests
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock creates a FakeClock set to the given time
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,238 @@
+package lib
+
+import (
+	"fmt"
+	"math"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Coach Mode
+// =============================================================================
+//
+// Coach mode reviews a player's moves as they make them. Each move is replayed
+// on a copy of the game so the position can be scored before and after it, and
+// the unit that acted is checked against the enemy units that could reach and
+// attack it on their next turn.
+
+// CoachMaxThreats is how many enemy units can threaten the unit that acted
+// before the coach flags the move
+const CoachMaxThreats = 2
+
+// Threat is an enemy unit that can attack a unit on its next turn
+type Threat struct {
+	Attacker *v1.Unit
+
+	// Position the attacker would strike from
+	From AxialCoord
+
+	// Damage the attack is expected to deal
+	ExpectedDamage float64
+}
+
+// enemyReach is where an enemy unit can be at the end of its next move
+type enemyReach struct {
+	unit      *v1.Unit
+	positions []AxialCoord
+}
+
+// enemyReaches returns, for every unit not owned by player, the positions it
+// can attack from next turn
+func (g *Game) enemyReaches(player int32) (reaches []enemyReach) {
+	var coords []AxialCoord
+	for coord, unit := range g.World.UnitsByCoord() {
+		if unit.Player != player {
+			coords = append(coords, coord)
+		}
+	}
+	sortCoords(coords)
+
+	for _, coord := range coords {
+		enemy := g.World.UnitAt(coord)
+		unitData, err := g.RulesEngine.GetUnitData(enemy.UnitType)
+		if err != nil {
+			continue
+		}
+		reach := enemyReach{unit: enemy, positions: []AxialCoord{coord}}
+		allPaths, err := g.RulesEngine.GetMovementOptions(g.World, enemy, unitData.MovementPoints, false)
+		if err == nil {
+			var moves []AxialCoord
+			for _, edge := range allPaths.Edges {
+				if !edge.IsOccupied {
+					moves = append(moves, CoordFromInt32(edge.ToQ, edge.ToR))
+				}
+			}
+			sortCoords(moves)
+			reach.positions = append(reach.positions, moves...)
+		}
+		reaches = append(reaches, reach)
+	}
+	return
+}
+
+// threatsTo returns the enemies in reaches that can attack unit
+func (g *Game) threatsTo(reaches []enemyReach, unit *v1.Unit) (threats []Threat) {
+	target := UnitGetCoord(unit)
+	for _, reach := range reaches {
+		if _, canAttack := g.RulesEngine.GetCombatPrediction(reach.unit.UnitType, unit.UnitType); !canAttack {
+			continue
+		}
+		unitData, err := g.RulesEngine.GetUnitData(reach.unit.UnitType)
+		if err != nil {
+			continue
+		}
+		for _, from := range reach.positions {
+			if distance := from.Distance(target); distance < 1 || distance > int(unitData.AttackRange) {
+				continue
+			}
+			damage, err := g.RulesEngine.ExpectedDamage(&CombatContext{
+				Attacker:       reach.unit,
+				AttackerTile:   g.World.TileAt(from),
+				AttackerHealth: reach.unit.AvailableHealth,
+				Defender:       unit,
+				DefenderTile:   g.World.TileAt(target),
+				DefenderHealth: unit.AvailableHealth,
+			})
+			if err == nil {
+				threats = append(threats, Threat{Attacker: reach.unit, From: from, ExpectedDamage: damage})
+			}
+			break
+		}
+	}
+	return
+}
+
+// UnitThreats returns the enemy units that can move into range of unit and
+// attack it on their next turn
+func (g *Game) UnitThreats(unit *v1.Unit) []Threat {
+	return g.threatsTo(g.enemyReaches(unit.Player), unit)
+}
+
+// GetThreatenedUnits returns the player's units that enemy units can attack
+// on their next turn, with the worst-case damage each could take: the
+// expected damage of every threat attacking it, at most the unit's health
+func (g *Game) GetThreatenedUnits(playerID int) map[*v1.Unit]int {
+	reaches := g.enemyReaches(int32(playerID))
+	threatened := make(map[*v1.Unit]int)
+	for _, unit := range g.World.GetPlayerUnits(playerID) {
+		threats := g.threatsTo(reaches, unit)
+		if len(threats) == 0 {
+			continue
+		}
+		total := 0.0
+		for _, threat := range threats {
+			total += threat.ExpectedDamage
+		}
+		health := unit.AvailableHealth
+		if health == 0 {
+			// Not yet topped up this game, so at full health
+			if unitData, err := g.RulesEngine.GetUnitData(unit.UnitType); err == nil {
+				health = unitData.Health
+			}
+		}
+		threatened[unit] = min(int(math.Ceil(total)), int(health))
+	}
+	return threatened
+}
+
+// EvaluatePosition scores a position for a player: the build cost of their
+// units scaled by health, less what they are expected to lose to enemy
+// attacks next turn, less the same measure of every opponent's units
+func (g *Game) EvaluatePosition(player int32) float64 {
+	reaches := g.enemyReaches(player)
+	score := 0.0
+	for _, unit := range g.World.UnitsByCoord() {
+		unitData, err := g.RulesEngine.GetUnitData(unit.UnitType)
+		if err != nil || unitData.Health <= 0 {
+			continue
+		}
+		value := float64(unitData.Coins) / float64(unitData.Health)
+		if unit.Player != player {
+			score -= value * float64(unit.AvailableHealth)
+			continue
+		}
+		expectedLoss := 0.0
+		for _, threat := range g.threatsTo(reaches, unit) {
+			expectedLoss += threat.ExpectedDamage
+		}
+		score += value * max(0, float64(unit.AvailableHealth)-expectedLoss)
+	}
+	return score
+}
+
+// CoachEvaluator reviews moves for coach mode. It keeps its own copy of the
+// game, so moves are evaluated without touching the game being played.
+type CoachEvaluator struct {
+	game *Game
+}
+
+// NewCoachEvaluator starts reviewing moves from the game's current position
+func NewCoachEvaluator(g *Game) *CoachEvaluator {
+	state := proto.Clone(g.GameState).(*v1.GameState)
+	worldData := proto.Clone(g.World.WorldData()).(*v1.WorldData)
+	state.WorldData = worldData
+	return &CoachEvaluator{
+		game: NewGame(g.Game, state, NewWorld("coach", worldData), g.RulesEngine, g.Seed),
+	}
+}
+
+// Evaluate applies an already processed move to the evaluator's copy of the
+// game and returns the coach's verdict on it
+func (c *CoachEvaluator) Evaluate(move *v1.GameMove) *v1.CoachVerdict {
+	player := c.game.CurrentPlayer
+	verdict := &v1.CoachVerdict{ScoreBefore: c.game.EvaluatePosition(player)}
+	if err := c.game.ApplyChanges([]*v1.GameMove{move}); err != nil {
+		verdict.Message = fmt.Sprintf("Could not review this move: %v", err)
+		return verdict
+	}
+	verdict.ScoreAfter = c.game.EvaluatePosition(player)
+	verdict.Message = "No obvious mistakes"
+
+	coord, ok := actingUnitCoord(move)
+	if !ok {
+		return verdict
+	}
+	unit := c.game.World.UnitAt(coord)
+	if unit == nil || unit.Player != player {
+		return verdict
+	}
+
+	threats := c.game.UnitThreats(unit)
+	verdict.ThreatenedBy = int32(len(threats))
+	for _, threat := range threats {
+		verdict.ExpectedDamage += threat.ExpectedDamage
+	}
+
+	name := fmt.Sprintf("Unit %d", unit.UnitType)
+	if unitData, err := c.game.RulesEngine.GetUnitData(unit.UnitType); err == nil {
+		name = unitData.Name
+	}
+	switch {
+	case len(threats) > CoachMaxThreats:
+		verdict.Flagged = true
+		verdict.Message = fmt.Sprintf("%s at (%d, %d) can be attacked by %d enemy units next turn (expected %.1f damage)",
+			name, coord.Q, coord.R, len(threats), verdict.ExpectedDamage)
+	case len(threats) > 0 && verdict.ExpectedDamage >= float64(unit.AvailableHealth):
+		verdict.Flagged = true
+		verdict.Message = fmt.Sprintf("%s at (%d, %d) is likely to be destroyed next turn (expected %.1f damage against %d health)",
+			name, coord.Q, coord.R, verdict.ExpectedDamage, unit.AvailableHealth)
+	}
+	return verdict
+}
+
+// actingUnitCoord returns where the unit that made a move stands afterwards
+func actingUnitCoord(move *v1.GameMove) (AxialCoord, bool) {
+	switch m := move.MoveType.(type) {
+	case *v1.GameMove_MoveUnit:
+		return CoordFromInt32(m.MoveUnit.To.Q, m.MoveUnit.To.R), true
+	case *v1.GameMove_AttackUnit:
+		return CoordFromInt32(m.AttackUnit.Attacker.Q, m.AttackUnit.Attacker.R), true
+	case *v1.GameMove_CaptureBuilding:
+		return CoordFromInt32(m.CaptureBuilding.Pos.Q, m.CaptureBuilding.Pos.R), true
+	case *v1.GameMove_BuildUnit:
+		return CoordFromInt32(m.BuildUnit.Pos.Q, m.BuildUnit.Pos.R), true
+	}
+	return AxialCoord{}, false
+}
@@ -10,34 +10,52 @@ import (
 // CalculateCombatDamage calculates damage using the new proto-based system
 // Returns (damage, canAttack, error) where canAttack indicates if the attack is possible
 func (re *RulesEngine) CalculateCombatDamage(attackerID, defenderID int32, rng *rand.Rand) (int, bool, error) {
-	// Create key for unit-unit combat properties
-	key := fmt.Sprintf("%d:%d", attackerID, defenderID)
-
-	props, exists := re.UnitUnitProperties[key]
-	if !exists || props.Damage == nil {
+	dist, canAttack := re.GetCombatPrediction(attackerID, defenderID)
+	if !canAttack {
 		// Attack is not possible between these unit types
 		return 0, false, nil
 	}
 
-	damage := re.rollDamageFromDistribution(props.Damage, rng)
+	damage := re.rollDamageFromDistribution(dist, rng)
 	return damage, true, nil
 }
 
 // GetCombatPrediction provides combat prediction using the new proto-based system
-// Returns (damage_distribution, canAttack) where canAttack indicates if the attack is possible
+// Returns (damage_distribution, canAttack) where canAttack indicates if the attack is possible.
+// When no pairwise distribution exists the attacker's class attack table is used instead.
 func (re *RulesEngine) GetCombatPrediction(attackerID, defenderID int32) (*v1.DamageDistribution, bool) {
 	// Create key for unit-unit combat properties
 	key := fmt.Sprintf("%d:%d", attackerID, defenderID)
 
-	props, exists := re.UnitUnitProperties[key]
+	props, exists := re.GetUnitUnitProperties()[key]
 	if !exists || props.Damage == nil {
-		// Attack is not possible between these unit types
-		return nil, false
+		return re.ClassDamageDistribution(attackerID, defenderID)
 	}
 
 	return props.Damage, true
 }
 
+// KillProbability returns the chance that a roll from dist deals at least
+// defenderHealth damage. Damage within a range is spread evenly, as in
+// rollDamageFromDistribution.
+func KillProbability(dist *v1.DamageDistribution, defenderHealth int32) float64 {
+	health := float64(defenderHealth)
+	totalWeight, killWeight := 0.0, 0.0
+	for _, damageRange := range dist.GetRanges() {
+		totalWeight += damageRange.Probability
+		switch {
+		case damageRange.MinValue >= health:
+			killWeight += damageRange.Probability
+		case damageRange.MaxValue > health:
+			killWeight += damageRange.Probability * (damageRange.MaxValue - health) / (damageRange.MaxValue - damageRange.MinValue)
+		}
+	}
+	if totalWeight <= 0 {
+		return 0
+	}
+	return killWeight / totalWeight
+}
+
 // rollDamageFromDistribution uses the proto damage distribution with ranges
 func (re *RulesEngine) rollDamageFromDistribution(dist *v1.DamageDistribution, rng *rand.Rand) int {
 	if dist == nil || len(dist.Ranges) == 0 {
@@ -101,7 +119,7 @@ func (re *RulesEngine) GetAttackOptions(world *World, unit *v1.Unit) ([]AxialCoo
 		}
 
 		// Check if there's an enemy unit at this position
-		targetUnit := world.UnitAt(targetCoord)
+		targetUnit := re.OccupantAt(world, targetCoord)
 		if targetUnit == nil {
 			continue // No unit to attack
 		}
@@ -130,7 +148,12 @@ func (re *RulesEngine) CanUnitAttackTarget(attacker *v1.Unit, target *v1.Unit) (
 	if attacker.Player == target.Player {
 		return false, nil // Same team
 	}
+	return re.canReachTarget(attacker, target)
+}
 
+// canReachTarget checks the attacker can attack the target's unit type and
+// has it in range, whoever owns it
+func (re *RulesEngine) canReachTarget(attacker *v1.Unit, target *v1.Unit) (bool, error) {
 	// Check if attacker can attack this unit type
 	_, canAttack := re.GetCombatPrediction(attacker.UnitType, target.UnitType)
 	if !canAttack {
@@ -138,9 +161,7 @@ func (re *RulesEngine) CanUnitAttackTarget(attacker *v1.Unit, target *v1.Unit) (
 	}
 
 	// Check range (using simple distance for now)
-	attackerCoord := UnitGetCoord(attacker)
-	targetCoord := UnitGetCoord(target)
-	distance := CubeDistance(attackerCoord, targetCoord)
+	distance := re.FootprintDistance(UnitGetCoord(attacker), target)
 	unitData, err := re.GetUnitData(attacker.UnitType)
 	if err != nil {
 		return false, err
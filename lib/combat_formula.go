@@ -1,6 +1,7 @@
 package lib
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/rand"
@@ -50,18 +51,7 @@ func (re *RulesEngine) CalculateHitProbability(ctx *CombatContext) (float64, err
 	}
 
 	// Get terrain bonuses
-	attackerTerrainProps := re.GetTerrainUnitPropertiesForUnit(ctx.AttackerTile.TileType, ctx.Attacker.UnitType)
-	defenderTerrainProps := re.GetTerrainUnitPropertiesForUnit(ctx.DefenderTile.TileType, ctx.Defender.UnitType)
-
-	var Ta int32 = 0 // Terrain attack bonus for attacker
-	var Td int32 = 0 // Terrain defense bonus for defender
-
-	if attackerTerrainProps != nil {
-		Ta = attackerTerrainProps.AttackBonus
-	}
-	if defenderTerrainProps != nil {
-		Td = defenderTerrainProps.DefenseBonus
-	}
+	Ta, Td := re.TerrainCombatBonuses(ctx)
 
 	// Get base defense (D)
 	D := defenderDef.Defense
@@ -82,6 +72,135 @@ func (re *RulesEngine) CalculateHitProbability(ctx *CombatContext) (float64, err
 	return p, nil
 }
 
+// TerrainCombatBonuses returns the terrain attack bonus of the attacker's
+// tile (Ta in the formula) and the terrain defense bonus of the defender's
+// tile (Td)
+func (re *RulesEngine) TerrainCombatBonuses(ctx *CombatContext) (attack, defense int32) {
+	if props := re.GetTerrainUnitPropertiesForUnit(ctx.AttackerTile.TileType, ctx.Attacker.UnitType); props != nil {
+		attack = props.AttackBonus
+	}
+	if props := re.GetTerrainUnitPropertiesForUnit(ctx.DefenderTile.TileType, ctx.Defender.UnitType); props != nil {
+		defense = props.DefenseBonus
+	}
+	return attack, defense
+}
+
+// ClassDamageDistribution computes the damage distribution of a full health
+// attacker against a defender from the attacker's attack_vs_class table,
+// ignoring terrain and wound bonuses. Returns false if the attacker has no
+// attack value against the defender's class.
+func (re *RulesEngine) ClassDamageDistribution(attackerID, defenderID int32) (*v1.DamageDistribution, bool) {
+	attackerDef, err := re.GetUnitData(attackerID)
+	if err != nil {
+		return nil, false
+	}
+	defenderDef, err := re.GetUnitData(defenderID)
+	if err != nil {
+		return nil, false
+	}
+
+	attackKey := fmt.Sprintf("%s:%s", defenderDef.UnitClass, defenderDef.UnitTerrain)
+	baseAttack, hasAttack := attackerDef.AttackVsClass[attackKey]
+	if !hasAttack {
+		return nil, false
+	}
+
+	// Same formula as CalculateHitProbability with no terrain or wound bonus
+	p := 0.05*float64(baseAttack-defenderDef.Defense) + 0.5
+	p = math.Max(0, math.Min(1, p))
+
+	dist := &v1.DamageDistribution{MinDamage: -1}
+	for damage, probability := range damageProbabilities(p, attackerDef.Health) {
+		if probability < 1e-9 {
+			continue
+		}
+		if dist.MinDamage < 0 {
+			dist.MinDamage = float64(damage)
+		}
+		dist.MaxDamage = float64(damage)
+		dist.ExpectedDamage += float64(damage) * probability
+		dist.Ranges = append(dist.Ranges, &v1.DamageRange{
+			MinValue:    float64(damage),
+			MaxValue:    float64(damage),
+			Probability: probability,
+		})
+	}
+	return dist, true
+}
+
+// damageProbabilities returns the probability of each damage value for an
+// attacker with the given health and hit probability p. There are 6 dice per
+// health unit and damage = hits / 6, so the binomial probabilities of every
+// hit count are summed into its damage bucket.
+func damageProbabilities(p float64, health int32) []float64 {
+	dice := int(health) * 6
+	damageProbs := make([]float64, health+1)
+	coefficient := 1.0 // C(dice, hits)
+	for hits := 0; hits <= dice; hits++ {
+		if hits > 0 {
+			coefficient = coefficient * float64(dice-hits+1) / float64(hits)
+		}
+		damageProbs[hits/6] += coefficient * math.Pow(p, float64(hits)) * math.Pow(1-p, float64(dice-hits))
+	}
+	return damageProbs
+}
+
+// ExpectedDamage returns the attacker's expected damage in a combat
+func (re *RulesEngine) ExpectedDamage(ctx *CombatContext) (float64, error) {
+	p, err := re.CalculateHitProbability(ctx)
+	if err != nil {
+		return 0, err
+	}
+	expected := 0.0
+	for damage, probability := range damageProbabilities(p, ctx.AttackerHealth) {
+		expected += float64(damage) * probability
+	}
+	return expected, nil
+}
+
+// TerrainDefenseApplied returns the fraction of the attacker's expected damage
+// that the defender's terrain prevents, e.g. 0.4 when the terrain cuts it by
+// 40%. It is negative when the terrain makes the defender easier to hit.
+func (re *RulesEngine) TerrainDefenseApplied(ctx *CombatContext) (float64, error) {
+	withTerrain, err := re.ExpectedDamage(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Tile type 0 has no terrain properties, so no defense bonus
+	openGround := *ctx
+	openGround.DefenderTile = &v1.Tile{Q: ctx.DefenderTile.Q, R: ctx.DefenderTile.R}
+	withoutTerrain, err := re.ExpectedDamage(&openGround)
+	if err != nil || withoutTerrain == 0 {
+		return 0, err
+	}
+	return 1 - withTerrain/withoutTerrain, nil
+}
+
+// CombatMode selects how a game resolves combat damage
+type CombatMode int
+
+const (
+	// CombatModeRandom rolls the dice for every attack
+	CombatModeRandom CombatMode = iota
+
+	// CombatModeExpected deals the attacker's expected damage, rounded, so
+	// combat plays out the same whatever the seed (eg for analysis and AI)
+	CombatModeExpected
+)
+
+// calculateDamage returns the damage an attack deals in the game's combat mode
+func (g *Game) calculateDamage(ctx *CombatContext) (int32, error) {
+	if g.CombatMode != CombatModeExpected {
+		return g.RulesEngine.SimulateCombatDamage(ctx, g.rng)
+	}
+	expected, err := g.RulesEngine.ExpectedDamage(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(math.Round(expected)), nil
+}
+
 // SimulateCombatDamage simulates combat damage by rolling dice according to the formula
 // For each health unit (Ha) of the attacker, roll 6 dice
 // In LilBattle, each health unit = 10 HP, so 100 HP = 10 health units
@@ -119,12 +238,18 @@ func (re *RulesEngine) SimulateCombatDamage(ctx *CombatContext, rng *rand.Rand)
 // GenerateDamageDistribution generates a damage distribution by running many simulations
 // This is useful for UI tooltips showing expected damage ranges
 func (re *RulesEngine) GenerateDamageDistribution(ctx *CombatContext, numSimulations int) (*v1.DamageDistribution, error) {
+	return re.GenerateDamageDistributionContext(context.Background(), ctx, numSimulations)
+}
+
+// GenerateDamageDistributionContext is GenerateDamageDistribution that stops
+// with the context's error once it is done
+func (re *RulesEngine) GenerateDamageDistributionContext(ctx context.Context, combat *CombatContext, numSimulations int) (*v1.DamageDistribution, error) {
 	if numSimulations <= 0 {
 		numSimulations = 10000 // Default number of simulations
 	}
 
 	// Validate hit probability calculation works
-	_, err := re.CalculateHitProbability(ctx)
+	_, err := re.CalculateHitProbability(combat)
 	if err != nil {
 		return nil, err
 	}
@@ -134,8 +259,12 @@ func (re *RulesEngine) GenerateDamageDistribution(ctx *CombatContext, numSimulat
 	damageCounts := make(map[int32]int)
 	totalDamage := float64(0)
 
+	check := canceller{ctx: ctx}
 	for range numSimulations {
-		damage, err := re.SimulateCombatDamage(ctx, simRng)
+		if err := check.step(); err != nil {
+			return nil, err
+		}
+		damage, err := re.SimulateCombatDamage(combat, simRng)
 		if err != nil {
 			return nil, err
 		}
@@ -183,7 +312,7 @@ func (re *RulesEngine) GenerateDamageDistribution(ctx *CombatContext, numSimulat
 // GetTerrainUnitPropertiesForUnit is a helper to get terrain-unit properties
 func (re *RulesEngine) GetTerrainUnitPropertiesForUnit(terrainID, unitID int32) *v1.TerrainUnitProperties {
 	key := fmt.Sprintf("%d:%d", terrainID, unitID)
-	return re.TerrainUnitProperties[key]
+	return re.GetTerrainUnitProperties()[key]
 }
 
 // CalculateWoundBonus calculates the wound bonus (B) based on attack history
@@ -316,6 +445,12 @@ func (re *RulesEngine) SimulateFixHealing(ctx *FixContext, rng *rand.Rand) int32
 // GenerateFixDistribution generates a distribution of possible healing outcomes
 // by running many simulations
 func (re *RulesEngine) GenerateFixDistribution(ctx *FixContext, numSimulations int) (*v1.DamageDistribution, error) {
+	return re.GenerateFixDistributionContext(context.Background(), ctx, numSimulations)
+}
+
+// GenerateFixDistributionContext is GenerateFixDistribution that stops with
+// the context's error once it is done
+func (re *RulesEngine) GenerateFixDistributionContext(ctx context.Context, fix *FixContext, numSimulations int) (*v1.DamageDistribution, error) {
 	if numSimulations <= 0 {
 		numSimulations = 10000 // Default number of simulations
 	}
@@ -325,8 +460,12 @@ func (re *RulesEngine) GenerateFixDistribution(ctx *FixContext, numSimulations i
 	healingCounts := make(map[int32]int)
 	totalHealing := float64(0)
 
+	check := canceller{ctx: ctx}
 	for range numSimulations {
-		healing := re.SimulateFixHealing(ctx, simRng)
+		if err := check.step(); err != nil {
+			return nil, err
+		}
+		healing := re.SimulateFixHealing(fix, simRng)
 		healingCounts[healing]++
 		totalHealing += float64(healing)
 	}
@@ -380,6 +519,20 @@ func (re *RulesEngine) CalculateSplashDamage(
 	adjacentUnits []*v1.Unit,
 	world *World,
 	rng *rand.Rand,
+) ([]*SplashDamageTarget, error) {
+	return re.calculateSplashDamage(attacker, attackerTile, defenderCoord, adjacentUnits, world, func(ctx *CombatContext) (int32, error) {
+		return re.SimulateCombatDamage(ctx, rng)
+	})
+}
+
+// calculateSplashDamage is CalculateSplashDamage with each splash roll made by damage
+func (re *RulesEngine) calculateSplashDamage(
+	attacker *v1.Unit,
+	attackerTile *v1.Tile,
+	defenderCoord AxialCoord,
+	adjacentUnits []*v1.Unit,
+	world *World,
+	damageFn func(*CombatContext) (int32, error),
 ) ([]*SplashDamageTarget, error) {
 	// Get attacker definition
 	attackerDef, err := re.GetUnitData(attacker.UnitType)
@@ -427,7 +580,7 @@ func (re *RulesEngine) CalculateSplashDamage(
 		// Run the formula splash_damage times
 		totalDamage := int32(0)
 		for i := int32(0); i < attackerDef.SplashDamage; i++ {
-			damage, err := re.SimulateCombatDamage(ctx, rng)
+			damage, err := damageFn(ctx)
 			if err != nil {
 				continue
 			}
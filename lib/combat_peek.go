@@ -0,0 +1,87 @@
+package lib
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// CombatResult is the expected outcome of an attack, worked out from the
+// damage probabilities rather than by rolling dice
+type CombatResult struct {
+	Damage          float64 // Expected damage dealt to the defender
+	KillProbability float64 // Chance the attack destroys the defender
+
+	Countered              bool    // Whether the defender strikes back
+	CounterDamage          float64 // Expected counter damage dealt to the attacker
+	CounterKillProbability float64 // Chance the counter destroys the attacker
+}
+
+// combatContexts returns the combat contexts of an attack and of the
+// defender's counter, nil when the defender cannot strike back.  Both sides
+// fight at the health they had before the attack.
+func (g *Game) combatContexts(attacker, defender *v1.Unit) (attackCtx, counterCtx *CombatContext) {
+	attackerCoord, defenderCoord := UnitGetCoord(attacker), UnitGetCoord(defender)
+	attackCtx = &CombatContext{
+		Attacker:       attacker,
+		AttackerTile:   g.World.TileAt(attackerCoord),
+		AttackerHealth: attacker.AvailableHealth,
+		Defender:       defender,
+		DefenderTile:   g.World.TileAt(defenderCoord),
+		DefenderHealth: defender.AvailableHealth,
+		WoundBonus:     g.RulesEngine.CalculateWoundBonus(defender, attackerCoord),
+	}
+	if canCounter, err := g.RulesEngine.CanUnitAttackTarget(defender, attacker); err == nil && canCounter && !defender.Submerged {
+		counterCtx = &CombatContext{
+			Attacker:       defender,
+			AttackerTile:   g.World.TileAt(defenderCoord),
+			AttackerHealth: defender.AvailableHealth,
+			Defender:       attacker,
+			DefenderTile:   g.World.TileAt(attackerCoord),
+			DefenderHealth: attacker.AvailableHealth,
+			WoundBonus:     0, // No wound bonus for counter-attacks
+		}
+	}
+	return attackCtx, counterCtx
+}
+
+// PeekCombat returns the expected outcome of attacker attacking defender
+// as they stand, for AI lookahead.  It neither rolls the game's RNG nor
+// changes either unit, so peeking any number of attacks leaves later real
+// combat exactly as it would have been.
+func (g *Game) PeekCombat(attacker, defender *v1.Unit) (*CombatResult, error) {
+	if !g.CanAttackUnit(attacker, defender) {
+		return nil, fmt.Errorf("attacker cannot attack defender")
+	}
+
+	attackCtx, counterCtx := g.combatContexts(attacker, defender)
+	result := &CombatResult{}
+	var err error
+	if result.Damage, result.KillProbability, err = g.RulesEngine.expectedCombat(attackCtx); err != nil {
+		return nil, fmt.Errorf("failed to predict combat damage: %w", err)
+	}
+	if counterCtx != nil {
+		// As in real combat, a counter that cannot be worked out deals nothing
+		if damage, kill, err := g.RulesEngine.expectedCombat(counterCtx); err == nil {
+			result.Countered = true
+			result.CounterDamage, result.CounterKillProbability = damage, kill
+		}
+	}
+	return result, nil
+}
+
+// expectedCombat returns the attacker's expected damage in a combat and
+// the chance it destroys the defender
+func (re *RulesEngine) expectedCombat(ctx *CombatContext) (expected, kill float64, err error) {
+	p, err := re.CalculateHitProbability(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	for damage, probability := range damageProbabilities(p, ctx.AttackerHealth) {
+		expected += float64(damage) * probability
+		if int32(damage) >= ctx.DefenderHealth {
+			kill += probability
+		}
+	}
+	return expected, kill, nil
+}
@@ -0,0 +1,77 @@
+package lib
+
+import (
+	"math"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestPeekCombat_LeavesRollsAlone tests that peeking at combat any number of
+// times changes neither the units nor the rolls of the real attack after it
+func TestPeekCombat_LeavesRollsAlone(t *testing.T) {
+	newGame := func() *Game {
+		return newTestGameBuilder().
+			grassTiles(2).
+			unit(0, 0, 1, testUnitTypeSoldier).
+			unit(1, 0, 2, testUnitTypeSoldier).
+			currentPlayer(1).
+			seed(7).
+			build()
+	}
+	attack := func(game *Game) (int32, int32) {
+		move := &v1.GameMove{
+			MoveType: &v1.GameMove_AttackUnit{
+				AttackUnit: &v1.AttackUnitAction{
+					Attacker: &v1.Position{Q: 0, R: 0},
+					Defender: &v1.Position{Q: 1, R: 0},
+				},
+			},
+		}
+		if err := game.ProcessMove(move); err != nil {
+			t.Fatalf("ProcessMove failed: %v", err)
+		}
+		return game.World.UnitAt(AxialCoord{Q: 0, R: 0}).GetAvailableHealth(),
+			game.World.UnitAt(AxialCoord{Q: 1, R: 0}).GetAvailableHealth()
+	}
+
+	wantAttacker, wantDefender := attack(newGame())
+
+	game := newGame()
+	attacker := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	defender := game.World.UnitAt(AxialCoord{Q: 1, R: 0})
+	before := []*v1.Unit{copyUnit(attacker), copyUnit(defender)}
+	var first *CombatResult
+	for i := range 50 {
+		result, err := game.PeekCombat(attacker, defender)
+		if err != nil {
+			t.Fatalf("PeekCombat failed: %v", err)
+		}
+		if first == nil {
+			first = result
+		} else if *result != *first {
+			t.Fatalf("peek %d = %+v, want the same as the first %+v", i, result, first)
+		}
+	}
+	if !proto.Equal(attacker, before[0]) || !proto.Equal(defender, before[1]) {
+		t.Error("PeekCombat changed the units")
+	}
+
+	expected, _ := game.RulesEngine.ExpectedDamage(&CombatContext{
+		Attacker: attacker, AttackerTile: game.World.TileAt(AxialCoord{Q: 0, R: 0}), AttackerHealth: attacker.AvailableHealth,
+		Defender: defender, DefenderTile: game.World.TileAt(AxialCoord{Q: 1, R: 0}), DefenderHealth: defender.AvailableHealth,
+	})
+	if math.Abs(first.Damage-expected) > 1e-9 || !first.Countered || first.CounterDamage <= 0 {
+		t.Errorf("peek = %+v, want expected damage %v and a counter", first, expected)
+	}
+
+	if gotAttacker, gotDefender := attack(game); gotAttacker != wantAttacker || gotDefender != wantDefender {
+		t.Errorf("after peeking, attack left healths %d/%d, want %d/%d as without peeking",
+			gotAttacker, gotDefender, wantAttacker, wantDefender)
+	}
+
+	if _, err := game.PeekCombat(defender, attacker); err == nil {
+		t.Error("PeekCombat allowed attacking out of turn")
+	}
+}
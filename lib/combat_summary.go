@@ -0,0 +1,101 @@
+package lib
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// newCombatSummary starts the recap of an attack from its combat contexts
+// and the damage rolled for them, before any damage is applied.
+// counterCtx is nil when the defender cannot strike back.
+func (g *Game) newCombatSummary(attackCtx, counterCtx *CombatContext, damage, counterDamage int32) *v1.CombatSummary {
+	summary := &v1.CombatSummary{
+		Attacker:   copyUnit(attackCtx.Attacker),
+		Defender:   copyUnit(attackCtx.Defender),
+		Damage:     damage,
+		WoundBonus: attackCtx.WoundBonus,
+		Estimated:  g.CombatMode == CombatModeExpected,
+	}
+	summary.ExpectedDamage, _ = g.RulesEngine.ExpectedDamage(attackCtx)
+	summary.AttackTerrainBonus, summary.DefenseTerrainBonus = g.RulesEngine.TerrainCombatBonuses(attackCtx)
+
+	if counterCtx != nil {
+		summary.Countered = true
+		summary.CounterDamage = counterDamage
+		summary.ExpectedCounterDamage, _ = g.RulesEngine.ExpectedDamage(counterCtx)
+		summary.CounterAttackTerrainBonus, summary.CounterDefenseTerrainBonus = g.RulesEngine.TerrainCombatBonuses(counterCtx)
+	}
+	return summary
+}
+
+// finishCombatSummary records how both units came out of the attack.
+// Revenge is available when both survived and the defender's unit type can
+// attack the attacker's, as it may move into range on its turn.
+func (g *Game) finishCombatSummary(summary *v1.CombatSummary, attacker, defender *v1.Unit) {
+	summary.AttackerHealth = attacker.AvailableHealth
+	summary.DefenderHealth = defender.AvailableHealth
+	if summary.AttackerHealth > 0 && summary.DefenderHealth > 0 {
+		_, summary.RevengeAvailable = g.RulesEngine.GetCombatPrediction(defender.UnitType, attacker.UnitType)
+	}
+}
+
+// FormatCombatSummary describes an attack in one line for kill feeds and
+// battle recaps, eg
+//
+//	A1 hit B2 for 4 (expected 3.6), took 2 back (expected 1.8); defense +2; B2 at 6, A1 at 8; revenge available
+func FormatCombatSummary(summary *v1.CombatSummary) string {
+	attacker := combatLabel(summary.Attacker)
+	defender := combatLabel(summary.Defender)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s hit %s for %d (expected %.1f", attacker, defender, summary.Damage, summary.ExpectedDamage)
+	if summary.Estimated {
+		sb.WriteString(", estimated")
+	}
+	sb.WriteString(")")
+	if summary.Countered {
+		fmt.Fprintf(&sb, ", took %d back (expected %.1f)", summary.CounterDamage, summary.ExpectedCounterDamage)
+	}
+
+	var modifiers []string
+	for _, m := range []struct {
+		name  string
+		bonus int32
+	}{
+		{"attack", summary.AttackTerrainBonus},
+		{"defense", summary.DefenseTerrainBonus},
+		{"counter attack", summary.CounterAttackTerrainBonus},
+		{"counter defense", summary.CounterDefenseTerrainBonus},
+		{"wound", summary.WoundBonus},
+	} {
+		if m.bonus != 0 {
+			modifiers = append(modifiers, fmt.Sprintf("%s %+d", m.name, m.bonus))
+		}
+	}
+	if len(modifiers) > 0 {
+		fmt.Fprintf(&sb, "; %s", strings.Join(modifiers, ", "))
+	}
+
+	fmt.Fprintf(&sb, "; %s, %s", combatOutcome(defender, summary.DefenderHealth), combatOutcome(attacker, summary.AttackerHealth))
+	if summary.RevengeAvailable {
+		sb.WriteString("; revenge available")
+	}
+	return sb.String()
+}
+
+// combatLabel names a unit by its shortcut, or its position if it has none
+func combatLabel(unit *v1.Unit) string {
+	if unit.Shortcut != "" {
+		return unit.Shortcut
+	}
+	return fmt.Sprintf("(%d,%d)", unit.Q, unit.R)
+}
+
+func combatOutcome(label string, health int32) string {
+	if health <= 0 {
+		return label + " killed"
+	}
+	return fmt.Sprintf("%s at %d", label, health)
+}
@@ -0,0 +1,162 @@
+package lib
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// attackAndSummarize attacks 1,0 from 0,0 and returns the move's combat
+// summary along with each unit's health after the applied changes
+func attackAndSummarize(t *testing.T, game *Game) (*v1.CombatSummary, map[AxialCoord]int32) {
+	t.Helper()
+	move := &v1.GameMove{
+		MoveType: &v1.GameMove_AttackUnit{
+			AttackUnit: &v1.AttackUnitAction{
+				Attacker: &v1.Position{Q: 0, R: 0},
+				Defender: &v1.Position{Q: 1, R: 0},
+			},
+		},
+	}
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+
+	var summary *v1.CombatSummary
+	health := map[AxialCoord]int32{}
+	for _, change := range move.Changes {
+		switch c := change.ChangeType.(type) {
+		case *v1.WorldChange_UnitDamaged:
+			health[UnitGetCoord(c.UnitDamaged.UpdatedUnit)] = c.UnitDamaged.UpdatedUnit.AvailableHealth
+		case *v1.WorldChange_UnitKilled:
+			health[UnitGetCoord(c.UnitKilled.PreviousUnit)] = 0
+		case *v1.WorldChange_UnitAttacked:
+			summary = c.UnitAttacked.Summary
+		}
+	}
+	if summary == nil {
+		t.Fatal("attack did not record a UnitAttacked change")
+	}
+	return summary, health
+}
+
+// checkSummaryMatchesChanges checks the summary's damage and healths are
+// the ones the attack's damage changes applied
+func checkSummaryMatchesChanges(t *testing.T, summary *v1.CombatSummary, health map[AxialCoord]int32) {
+	t.Helper()
+	defenderHealth, ok := health[AxialCoord{Q: 1, R: 0}]
+	if !ok {
+		defenderHealth = summary.Defender.AvailableHealth
+	}
+	attackerHealth, ok := health[AxialCoord{Q: 0, R: 0}]
+	if !ok {
+		attackerHealth = summary.Attacker.AvailableHealth
+	}
+	if summary.DefenderHealth != defenderHealth || summary.Damage != summary.Defender.AvailableHealth-defenderHealth {
+		t.Errorf("summary has defender at %d after %d damage, changes left it at %d",
+			summary.DefenderHealth, summary.Damage, defenderHealth)
+	}
+	if summary.AttackerHealth != attackerHealth || summary.CounterDamage != summary.Attacker.AvailableHealth-attackerHealth {
+		t.Errorf("summary has attacker at %d after %d counter damage, changes left it at %d",
+			summary.AttackerHealth, summary.CounterDamage, attackerHealth)
+	}
+}
+
+func TestCombatSummary_MatchesRolledChanges(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(2).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		seed(42).
+		build()
+	attackCtx := &CombatContext{
+		Attacker:       game.World.UnitAt(AxialCoord{Q: 0, R: 0}),
+		AttackerTile:   game.World.TileAt(AxialCoord{Q: 0, R: 0}),
+		AttackerHealth: 10,
+		Defender:       game.World.UnitAt(AxialCoord{Q: 1, R: 0}),
+		DefenderTile:   game.World.TileAt(AxialCoord{Q: 1, R: 0}),
+		DefenderHealth: 10,
+	}
+	expected, err := game.RulesEngine.ExpectedDamage(attackCtx)
+	if err != nil {
+		t.Fatalf("ExpectedDamage failed: %v", err)
+	}
+
+	summary, health := attackAndSummarize(t, game)
+	checkSummaryMatchesChanges(t, summary, health)
+
+	if summary.Attacker.Player != 1 || summary.Defender.Player != 2 {
+		t.Errorf("summary attacker/defender players are %d/%d, want 1/2", summary.Attacker.Player, summary.Defender.Player)
+	}
+	if summary.Estimated {
+		t.Error("rolled damage should not be marked estimated")
+	}
+	if math.Abs(summary.ExpectedDamage-expected) > 1e-9 {
+		t.Errorf("expected damage %v, want %v", summary.ExpectedDamage, expected)
+	}
+	if !summary.Countered || summary.ExpectedCounterDamage <= 0 {
+		t.Errorf("soldiers should counter each other, got countered=%v expected=%v", summary.Countered, summary.ExpectedCounterDamage)
+	}
+	wantRevenge := summary.AttackerHealth > 0 && summary.DefenderHealth > 0
+	if summary.RevengeAvailable != wantRevenge {
+		t.Errorf("revenge available = %v, want %v", summary.RevengeAvailable, wantRevenge)
+	}
+}
+
+func TestCombatSummary_EstimatedDistribution(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(2).
+		unit(0, 0, 1, testUnitTypeTank).
+		unit(1, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	game.CombatMode = CombatModeExpected
+
+	summary, health := attackAndSummarize(t, game)
+	checkSummaryMatchesChanges(t, summary, health)
+
+	if !summary.Estimated {
+		t.Error("expected combat mode damage should be marked estimated")
+	}
+	if summary.Damage != int32(math.Round(summary.ExpectedDamage)) {
+		t.Errorf("estimated damage %d is not the rounded expected damage %v", summary.Damage, summary.ExpectedDamage)
+	}
+	if summary.Countered && summary.CounterDamage != int32(math.Round(summary.ExpectedCounterDamage)) {
+		t.Errorf("estimated counter damage %d is not the rounded expected damage %v", summary.CounterDamage, summary.ExpectedCounterDamage)
+	}
+}
+
+func TestFormatCombatSummary(t *testing.T) {
+	got := FormatCombatSummary(&v1.CombatSummary{
+		Attacker:              &v1.Unit{Shortcut: "A1"},
+		Defender:              &v1.Unit{Shortcut: "B2"},
+		Damage:                4,
+		ExpectedDamage:        3.6,
+		Countered:             true,
+		CounterDamage:         2,
+		ExpectedCounterDamage: 1.8,
+		DefenseTerrainBonus:   2,
+		AttackerHealth:        8,
+		DefenderHealth:        6,
+		RevengeAvailable:      true,
+	})
+	want := "A1 hit B2 for 4 (expected 3.6), took 2 back (expected 1.8); defense +2; B2 at 6, A1 at 8; revenge available"
+	if got != want {
+		t.Errorf("got  %q\nwant %q", got, want)
+	}
+
+	killed := FormatCombatSummary(&v1.CombatSummary{
+		Attacker:       &v1.Unit{Q: 0, R: 0},
+		Defender:       &v1.Unit{Shortcut: "B2"},
+		Damage:         10,
+		ExpectedDamage: 9.5,
+		Estimated:      true,
+		AttackerHealth: 10,
+	})
+	if !strings.Contains(killed, "(0,0) hit B2 for 10 (expected 9.5, estimated)") || !strings.Contains(killed, "B2 killed") {
+		t.Errorf("unexpected summary %q", killed)
+	}
+}
@@ -0,0 +1,266 @@
+package lib
+
+import (
+	"fmt"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// copyTile creates a deep copy of a tile (including construction progress)
+// for recording in WorldChange objects
+func copyTile(tile *v1.Tile) *v1.Tile {
+	if tile == nil {
+		return nil
+	}
+	return proto.Clone(tile).(*v1.Tile)
+}
+
+// GetTerrainConversion returns the construction a unit type can perform on
+// the given terrain. If toTerrain is 0 the first matching conversion is used.
+func (re *RulesEngine) GetTerrainConversion(unitType, fromTerrain, toTerrain int32) *v1.TerrainConversion {
+	unitDef, err := re.GetUnitData(unitType)
+	if err != nil {
+		return nil
+	}
+	for _, conversion := range unitDef.Constructions {
+		if conversion.FromTerrain == fromTerrain && (toTerrain == 0 || conversion.ToTerrain == toTerrain) {
+			return conversion
+		}
+	}
+	return nil
+}
+
+// ProcessConstructTerrain starts converting a tile adjacent to the unit (eg
+// building a bridge over water). Coins are paid up front; the tile converts
+// after conversion.Turns of the player's turns as long as the unit stays put.
+func (g *Game) ProcessConstructTerrain(move *v1.GameMove, action *v1.ConstructTerrainAction) (err error) {
+	move.IsPermanent = true // Coins are spent
+
+	unitCoord, err := g.FromPos(action.Pos)
+	if err != nil {
+		return fmt.Errorf("invalid unit position: %w", err)
+	}
+	targetCoord, err := g.FromPosWithBase(action.Target, &unitCoord)
+	if err != nil {
+		return fmt.Errorf("invalid target position: %w", err)
+	}
+
+	unit := g.World.UnitAt(unitCoord)
+	if unit == nil {
+		return fmt.Errorf("no unit at position %v", unitCoord)
+	}
+	if unit.Player != g.CurrentPlayer {
+		return fmt.Errorf("unit does not belong to current player %d", g.CurrentPlayer)
+	}
+
+	// Apply lazy top-up pattern
+	if err := g.TopUpUnitIfNeeded(unit); err != nil {
+		return fmt.Errorf("failed to top-up unit: %w", err)
+	}
+
+	if CubeDistance(unitCoord, targetCoord) != 1 {
+		return fmt.Errorf("construction target must be adjacent to the unit")
+	}
+
+	tile := g.World.TileAt(targetCoord)
+	if tile == nil {
+		return fmt.Errorf("no tile at position %v", targetCoord)
+	}
+	if tile.Construction != nil {
+		return fmt.Errorf("tile at %v already has a construction in progress", targetCoord)
+	}
+	if g.World.UnitAt(targetCoord) != nil {
+		return fmt.Errorf("cannot construct on an occupied tile at %v", targetCoord)
+	}
+
+	unitDef, err := g.RulesEngine.GetUnitData(unit.UnitType)
+	if err != nil {
+		return fmt.Errorf("failed to get unit data: %w", err)
+	}
+	step, err := g.checkActionAllowed(unit, unitDef, "construct")
+	if err != nil {
+		return err
+	}
+	conversion := g.RulesEngine.GetTerrainConversion(unit.UnitType, tile.TileType, action.TargetTerrain)
+	if conversion == nil {
+		return fmt.Errorf("unit type %s cannot construct on tile type %d", unitDef.Name, tile.TileType)
+	}
+
+	playerState := g.GameState.PlayerStates[g.CurrentPlayer]
+	if playerState == nil {
+		return fmt.Errorf("player state not found for player %d", g.CurrentPlayer)
+	}
+	playerCoins := playerState.Coins
+	cost := g.coinCost(conversion.Coins)
+	if playerCoins < cost {
+		return fmt.Errorf("insufficient coins: need %d, have %d", cost, playerCoins)
+	}
+
+	action.TargetTerrain = conversion.ToTerrain
+	action.Cost = cost
+	action.Turns = conversion.Turns
+
+	playerState.Coins = playerCoins - cost
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_CoinsChanged{
+			CoinsChanged: &v1.CoinsChangedChange{
+				PlayerId:      g.CurrentPlayer,
+				PreviousCoins: playerCoins,
+				NewCoins:      playerState.Coins,
+				Reason:        "construct",
+			},
+		},
+	})
+
+	previousTile := copyTile(tile)
+	updatedTile := copyTile(tile)
+	updatedTile.Construction = &v1.ConstructionProgress{
+		UnitQ:          unit.Q,
+		UnitR:          unit.R,
+		Player:         unit.Player,
+		TargetTerrain:  conversion.ToTerrain,
+		TurnsRemaining: conversion.Turns,
+		StartedTurn:    g.TurnCounter,
+		UnitId:         unit.Id,
+	}
+	g.World.AddTile(updatedTile)
+
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_TerrainChanged{
+			TerrainChanged: &v1.TerrainChangedChange{
+				PreviousTile: previousTile,
+				UpdatedTile:  copyTile(updatedTile),
+			},
+		},
+	})
+
+	// Update progression: record chosen alternative and advance step
+	previousUnit := copyUnit(unit)
+	g.RulesEngine.AdvanceProgression(unit, unitDef, "construct", step)
+	unit.LastActedTurn = g.TurnCounter
+
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitMoved{
+			UnitMoved: &v1.UnitMovedChange{
+				PreviousUnit: previousUnit,
+				UpdatedUnit:  copyUnit(unit),
+			},
+		},
+	})
+
+	g.GameState.UpdatedAt = tspb.New(time.Now())
+	return nil
+}
+
+// isBuilder reports whether unit is the one carrying out the construction.
+// Constructions started before builders' IDs were recorded go by position.
+func isBuilder(construction *v1.ConstructionProgress, unit *v1.Unit) bool {
+	if unit == nil || unit.Q != construction.UnitQ || unit.R != construction.UnitR {
+		return false
+	}
+	return construction.UnitId == 0 || construction.UnitId == unit.Id
+}
+
+// cancelConstructionsBy cancels any construction being carried out by the
+// unit. Coins are not refunded.
+func (g *Game) cancelConstructionsBy(move *v1.GameMove, unit *v1.Unit) {
+	var neighbors [6]AxialCoord
+	UnitGetCoord(unit).Neighbors(&neighbors)
+	for _, coord := range neighbors {
+		tile := g.World.TileAt(coord)
+		if tile == nil || tile.Construction == nil || !isBuilder(tile.Construction, unit) {
+			continue
+		}
+		g.updateConstruction(move, tile, func(updated *v1.Tile) {
+			updated.Construction = nil
+		})
+	}
+}
+
+// advanceConstructions progresses the player's constructions at the start of
+// their turn. Constructions whose unit has left or died are cancelled, even if
+// another unit now stands in its place; those that reach zero turns remaining
+// convert the tile to the target terrain.
+func (g *Game) advanceConstructions(move *v1.GameMove, player int32) {
+	var tiles []*v1.Tile
+	for _, tile := range g.World.TilesByCoord() {
+		if tile.Construction != nil && tile.Construction.Player == player {
+			tiles = append(tiles, tile)
+		}
+	}
+
+	for _, tile := range tiles {
+		construction := tile.Construction
+		builder := g.World.UnitAt(CoordFromInt32(construction.UnitQ, construction.UnitR))
+		g.updateConstruction(move, tile, func(updated *v1.Tile) {
+			if !isBuilder(construction, builder) || builder.Player != player {
+				updated.Construction = nil
+				return
+			}
+			updated.Construction.TurnsRemaining--
+			if updated.Construction.TurnsRemaining <= 0 {
+				updated.TileType = construction.TargetTerrain
+				updated.Construction = nil
+			}
+		})
+	}
+}
+
+// updateConstruction applies update to a copy of tile, stores it in the
+// current world layer and records a TerrainChanged change
+func (g *Game) updateConstruction(move *v1.GameMove, tile *v1.Tile, update func(*v1.Tile)) {
+	previousTile := copyTile(tile)
+	updatedTile := copyTile(tile)
+	update(updatedTile)
+	g.World.AddTile(updatedTile)
+
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_TerrainChanged{
+			TerrainChanged: &v1.TerrainChangedChange{
+				PreviousTile: previousTile,
+				UpdatedTile:  copyTile(updatedTile),
+			},
+		},
+	})
+}
+
+// GetConstructOptions returns the constructions a unit can start on its
+// adjacent tiles given the current player's coins
+func (g *Game) GetConstructOptions(unit *v1.Unit, unitDef *v1.UnitDefinition) (options []*v1.GameOption) {
+	if len(unitDef.Constructions) == 0 {
+		return nil
+	}
+	playerCoins := g.spendableCoins(unit.Player)
+
+	unitCoord := UnitGetCoord(unit)
+	var neighbors [6]AxialCoord
+	unitCoord.Neighbors(&neighbors)
+	for dir, coord := range neighbors {
+		tile := g.World.TileAt(coord)
+		if tile == nil || tile.Construction != nil || g.World.UnitAt(coord) != nil {
+			continue
+		}
+		for _, conversion := range unitDef.Constructions {
+			if conversion.FromTerrain != tile.TileType || conversion.Coins > playerCoins {
+				continue
+			}
+			dirCode := DirectionToCode(NeighborDirection(dir))
+			options = append(options, &v1.GameOption{
+				OptionType: &v1.GameOption_Construct{
+					Construct: &v1.ConstructTerrainAction{
+						Pos:           &v1.Position{Label: unit.Shortcut, Q: unit.Q, R: unit.R},
+						Target:        &v1.Position{Label: dirCode, Q: int32(coord.Q), R: int32(coord.R)},
+						TargetTerrain: conversion.ToTerrain,
+						Cost:          g.coinCost(conversion.Coins),
+						Turns:         conversion.Turns,
+						Description:   fmt.Sprintf("build %s %s (%d turns, %dc)", conversion.Name, dirCode, conversion.Turns, g.coinCost(conversion.Coins)),
+					},
+				},
+			})
+		}
+	}
+	return
+}
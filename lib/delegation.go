@@ -0,0 +1,64 @@
+package lib
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Turn Delegation
+// =============================================================================
+//
+// In team games the current player can hand the rest of their turn over to a
+// teammate, who may then submit moves for the player's units until EndTurn.
+
+// IsTeamGame reports whether the game is played in teams
+func (g *Game) IsTeamGame() bool {
+	return g.Config.GetSettings().GetTeamMode() == "teams"
+}
+
+// AreTeammates reports whether two different players share a team
+func (g *Game) AreTeammates(playerId, otherPlayerId int32) bool {
+	if playerId == otherPlayerId {
+		return false
+	}
+	var team, otherTeam int32
+	for _, player := range g.Config.GetPlayers() {
+		switch player.PlayerId {
+		case playerId:
+			team = player.TeamId
+		case otherPlayerId:
+			otherTeam = player.TeamId
+		}
+	}
+	return team > 0 && team == otherTeam
+}
+
+// ProcessDelegateTurn hands control of the rest of the current turn to a
+// teammate. The delegation is revoked when the turn ends.
+func (g *Game) ProcessDelegateTurn(move *v1.GameMove, action *v1.DelegateTurnAction) (err error) {
+	if !g.IsTeamGame() {
+		return fmt.Errorf("turns can only be delegated in team games")
+	}
+	if move.Player != g.CurrentPlayer {
+		return fmt.Errorf("only the current player %d can delegate their turn", g.CurrentPlayer)
+	}
+	if !g.AreTeammates(g.CurrentPlayer, action.DelegatePlayerId) {
+		return fmt.Errorf("player %d is not a teammate of player %d", action.DelegatePlayerId, g.CurrentPlayer)
+	}
+	if playerState := g.GameState.PlayerStates[action.DelegatePlayerId]; playerState != nil && !playerState.IsActive {
+		return fmt.Errorf("player %d is no longer in the game", action.DelegatePlayerId)
+	}
+
+	g.GameState.DelegatedTo = action.DelegatePlayerId
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_TurnDelegated{
+			TurnDelegated: &v1.TurnDelegatedChange{
+				PlayerId:         g.CurrentPlayer,
+				DelegatePlayerId: action.DelegatePlayerId,
+			},
+		},
+	})
+	return nil
+}
@@ -0,0 +1,154 @@
+package lib
+
+import (
+	"fmt"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Draft
+// =============================================================================
+//
+// Games can open with a draft: seats take turns, in player order, to ban unit
+// types nobody may build and then to pick unit types only they may build. The
+// game stays in GAME_STATUS_DRAFTING until every seat has used its bans and
+// picks, after which player 1 takes the first turn. Draft turns run on the
+// time bank like any other turn; running out of time passes.
+
+// GetDraftSettings returns the configured draft settings, or nil if the game
+// starts without a draft
+func GetDraftSettings(config *v1.GameConfiguration) *v1.DraftSettings {
+	settings := config.GetSettings().GetDraft()
+	if settings.GetBansPerPlayer() <= 0 && settings.GetPicksPerPlayer() <= 0 {
+		return nil
+	}
+	return settings
+}
+
+// StartDraft puts a newly created game into its draft phase if it has one
+func StartDraft(state *v1.GameState, config *v1.GameConfiguration) {
+	if GetDraftSettings(config) == nil {
+		return
+	}
+	state.Status = v1.GameStatus_GAME_STATUS_DRAFTING
+	state.Draft = &v1.DraftState{PickedUnits: map[int32]int32{}}
+	state.CurrentPlayer = 1
+}
+
+// IsDrafting reports whether the game is still in its draft phase
+func (g *Game) IsDrafting() bool {
+	return g.GameState.Status == v1.GameStatus_GAME_STATUS_DRAFTING
+}
+
+// DraftRound reports whether the draft is in its pick round (rather than its
+// ban round) and whether it is over
+func (g *Game) DraftRound() (pick, done bool) {
+	settings := GetDraftSettings(g.Config)
+	seats := g.NumPlayers()
+	taken := g.GameState.GetDraft().GetTurnsTaken()
+	bans := settings.GetBansPerPlayer() * seats
+	return taken >= bans, taken >= bans+settings.GetPicksPerPlayer()*seats
+}
+
+// DraftAllows reports whether the draft lets player build unitType: it must
+// not be banned, nor picked by another player
+func DraftAllows(draft *v1.DraftState, player, unitType int32) bool {
+	if slices.Contains(draft.GetBannedUnits(), unitType) {
+		return false
+	}
+	picker, picked := draft.GetPickedUnits()[unitType]
+	return !picked || picker == player
+}
+
+// FilterBuildOptionsByDraft drops the unit types the draft keeps player from
+// building
+func FilterBuildOptionsByDraft(buildableUnits []int32, draft *v1.DraftState, player int32) []int32 {
+	if draft == nil {
+		return buildableUnits
+	}
+	var filtered []int32
+	for _, unitID := range buildableUnits {
+		if DraftAllows(draft, player, unitID) {
+			filtered = append(filtered, unitID)
+		}
+	}
+	return filtered
+}
+
+// ProcessDraftUnit bans or picks a unit type for the current seat (unit type
+// 0 passes) and hands the draft to the next seat, starting the game once
+// every seat has drafted
+func (g *Game) ProcessDraftUnit(move *v1.GameMove, action *v1.DraftUnitAction) (err error) {
+	if !g.IsDrafting() {
+		return fmt.Errorf("the game is not drafting")
+	}
+	if pick, _ := g.DraftRound(); action.Pick != pick {
+		if pick {
+			return fmt.Errorf("the ban round is over, pick a unit instead")
+		}
+		return fmt.Errorf("units can only be picked after the ban round")
+	}
+
+	draft := g.GameState.Draft
+	if action.UnitType != 0 {
+		if _, err := g.RulesEngine.GetUnitData(action.UnitType); err != nil {
+			return fmt.Errorf("unknown unit type %d", action.UnitType)
+		}
+		if slices.Contains(draft.BannedUnits, action.UnitType) {
+			return fmt.Errorf("unit type %d is already banned", action.UnitType)
+		}
+		if picker, picked := draft.PickedUnits[action.UnitType]; picked {
+			return fmt.Errorf("unit type %d was already picked by player %d", action.UnitType, picker)
+		}
+	}
+
+	player := g.CurrentPlayer
+	g.applyDraftTurn(player, action.UnitType, action.Pick, draft.TurnsTaken+1)
+	g.stopTurnClock(player)
+	_, done := g.DraftRound()
+	if done {
+		g.GameState.Status = v1.GameStatus_GAME_STATUS_PLAYING
+		g.GameState.CurrentPlayer = 1
+	} else {
+		g.GameState.CurrentPlayer = draft.TurnsTaken%g.NumPlayers() + 1
+	}
+	g.startTurnClock()
+
+	move.IsPermanent = true
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitDrafted{
+			UnitDrafted: &v1.UnitDraftedChange{
+				PlayerId:      player,
+				UnitType:      action.UnitType,
+				Pick:          action.Pick,
+				NextPlayer:    g.CurrentPlayer,
+				DraftComplete: done,
+				TurnsTaken:    draft.TurnsTaken,
+			},
+		},
+	})
+	return nil
+}
+
+// applyDraftTurn records one seat's ban or pick as draft turn turnsTaken.
+// Applying the same turn twice leaves the draft unchanged.
+func (g *Game) applyDraftTurn(player, unitType int32, pick bool, turnsTaken int32) {
+	draft := g.GameState.Draft
+	if draft == nil {
+		draft = &v1.DraftState{}
+		g.GameState.Draft = draft
+	}
+	if draft.PickedUnits == nil {
+		draft.PickedUnits = map[int32]int32{}
+	}
+	switch {
+	case unitType == 0:
+	case pick:
+		draft.PickedUnits[unitType] = player
+	case !slices.Contains(draft.BannedUnits, unitType):
+		draft.BannedUnits = append(draft.BannedUnits, unitType)
+	}
+	draft.TurnsTaken = turnsTaken
+}
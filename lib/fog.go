@@ -0,0 +1,183 @@
+package lib
+
+import (
+	"maps"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Fog of War
+// =============================================================================
+//
+// When enabled in the game settings, players only see the hexes within sight
+// range of their own units and bases. Enemy units outside that area are
+// hidden, and move options report how many hidden hexes the unit would
+// reveal from each destination.  Units on high ground, such as guard towers
+// and mountains, see farther by the terrain's vision bonus.
+//
+// Each player also keeps the hexes they have ever seen, saved with their
+// player state, so explored areas out of sight can be shown dimmed rather
+// than hidden.  They are added to as the changes of each move are applied,
+// so replaying a game rebuilds them.
+
+// DefaultSightRange is how far a unit sees when its definition has no
+// sight_range
+const DefaultSightRange = 2
+
+// BaseSightRange is how far a player's bases and other owned tiles see
+const BaseSightRange = 1
+
+// FogEnabled reports whether the game uses fog of war
+func (g *Game) FogEnabled() bool {
+	return g.Config.GetSettings().GetFogEnabled()
+}
+
+// SightRange returns how many hexes away units of this type can see
+func SightRange(unitDef *v1.UnitDefinition) int {
+	if unitDef.GetSightRange() > 0 {
+		return int(unitDef.SightRange)
+	}
+	return DefaultSightRange
+}
+
+// unitSightRange returns how far the unit sees when standing at coord: its
+// type's sight range plus the vision bonus of the terrain there
+func (g *Game) unitSightRange(unit *v1.Unit, coord AxialCoord) int {
+	sightRange := SightRange(g.progressionUnitDef(unit))
+	if tile := g.World.TileAt(coord); tile != nil {
+		if terrain, err := g.RulesEngine.GetTerrainData(tile.TileType); err == nil {
+			sightRange += int(terrain.VisionBonus)
+		}
+	}
+	return sightRange
+}
+
+// sightCache holds the on-map hexes within each sight radius of a coord.
+// It only depends on the map, so it is kept for a whole turn.
+type sightCache struct {
+	turn  int32
+	discs map[sightKey][]AxialCoord
+}
+
+type sightKey struct {
+	coord  AxialCoord
+	radius int
+}
+
+// hexesInSight returns the hexes on the map within sightRange of coord
+func (g *Game) hexesInSight(coord AxialCoord, sightRange int) []AxialCoord {
+	if g.sight == nil || g.sight.turn != g.TurnCounter {
+		g.sight = &sightCache{turn: g.TurnCounter, discs: map[sightKey][]AxialCoord{}}
+	}
+	key := sightKey{coord, sightRange}
+	if disc, ok := g.sight.discs[key]; ok {
+		return disc
+	}
+	var disc []AxialCoord
+	for _, c := range coord.Range(sightRange) {
+		if g.World.TileAt(c) != nil {
+			disc = append(disc, c)
+		}
+	}
+	g.sight.discs[key] = disc
+	return disc
+}
+
+// GetVisibleHexes returns the hexes the player can currently see: those in
+// sight of their units and of the tiles they own
+func (g *Game) GetVisibleHexes(player int32) map[AxialCoord]bool {
+	visible := map[AxialCoord]bool{}
+	for coord, unit := range g.World.UnitsByCoord() {
+		if unit.Player != player {
+			continue
+		}
+		for _, c := range g.hexesInSight(coord, g.unitSightRange(unit, coord)) {
+			visible[c] = true
+		}
+	}
+	for coord, tile := range g.World.TilesByCoord() {
+		if tile.Player != player {
+			continue
+		}
+		for _, c := range g.hexesInSight(coord, BaseSightRange) {
+			visible[c] = true
+		}
+	}
+	return visible
+}
+
+// GetDiscoveredHexes returns the hexes the player has seen at some point:
+// those saved with their player state and those they can see now
+func (g *Game) GetDiscoveredHexes(player int32) map[AxialCoord]bool {
+	discovered := savedDiscoveredHexes(g.PlayerStates[player])
+	for c := range g.GetVisibleHexes(player) {
+		discovered[c] = true
+	}
+	return discovered
+}
+
+// savedDiscoveredHexes parses the discovered hexes saved with a player state
+func savedDiscoveredHexes(state *v1.PlayerState) map[AxialCoord]bool {
+	discovered := map[AxialCoord]bool{}
+	for _, key := range state.GetDiscoveredHexes() {
+		if coord, err := ParseCoordKey(key); err == nil {
+			discovered[coord] = true
+		}
+	}
+	return discovered
+}
+
+// discoverVisibleHexes saves the hexes each player can see now with those
+// they have discovered before.  Only fog of war games track them.
+func (g *Game) discoverVisibleHexes() {
+	if !g.FogEnabled() {
+		return
+	}
+	for player, state := range g.PlayerStates {
+		if state == nil {
+			continue
+		}
+		discovered := savedDiscoveredHexes(state)
+		known := len(discovered)
+		for c := range g.GetVisibleHexes(player) {
+			discovered[c] = true
+		}
+		if len(discovered) == known {
+			continue
+		}
+
+		coords := slices.SortedFunc(maps.Keys(discovered), func(a, b AxialCoord) int {
+			if a.R != b.R {
+				return a.R - b.R
+			}
+			return a.Q - b.Q
+		})
+		state.DiscoveredHexes = make([]string, len(coords))
+		for i, c := range coords {
+			state.DiscoveredHexes[i] = CoordKeyFromAxial(c)
+		}
+	}
+}
+
+// fogVisibleHexes returns the hexes the player can see, or nil when fog of
+// war is off and everything is visible
+func (g *Game) fogVisibleHexes(player int32) map[AxialCoord]bool {
+	if !g.FogEnabled() {
+		return nil
+	}
+	return g.GetVisibleHexes(player)
+}
+
+// CountRevealedHexes returns how many of the hexes hidden from the unit's
+// owner (ie not in visible) the unit would see from dest
+func (g *Game) CountRevealedHexes(unit *v1.Unit, dest AxialCoord, visible map[AxialCoord]bool) int32 {
+	count := int32(0)
+	for _, c := range g.hexesInSight(dest, g.unitSightRange(unit, dest)) {
+		if !visible[c] {
+			count++
+		}
+	}
+	return count
+}
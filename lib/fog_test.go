@@ -0,0 +1,179 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// revealCounts returns the reveal count of each of the unit's move options
+// by destination
+func revealCounts(t *testing.T, game *Game, q, r int) map[AxialCoord]int32 {
+	t.Helper()
+	options, _, err := game.GetUnitOptions(game.World.UnitAt(AxialCoord{Q: q, R: r}))
+	if err != nil {
+		t.Fatalf("GetUnitOptions failed: %v", err)
+	}
+	counts := map[AxialCoord]int32{}
+	for _, option := range options {
+		if move, ok := option.OptionType.(*v1.GameOption_Move); ok {
+			counts[CoordFromInt32(move.Move.To.Q, move.Move.To.R)] = move.Move.RevealCount
+		}
+	}
+	return counts
+}
+
+// TestRevealCount_OpenGroundVsMapEdge tests that a step into open ground
+// reveals a full edge of the sight area while a step towards the map edge
+// reveals less, since part of the new area is off the map
+func TestRevealCount_OpenGroundVsMapEdge(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(4).
+		unitFull(2, 0, 1, testUnitTypeSoldier, "A1", 10, 1).
+		currentPlayer(1).
+		build()
+	game.Config.Settings.FogEnabled = true
+
+	counts := revealCounts(t, game, 2, 0)
+	// Moving west, the new sight area's leading edge is a full 2*range+1 hexes
+	if got, want := counts[AxialCoord{Q: 1, R: 0}], int32(2*DefaultSightRange+1); got != want {
+		t.Errorf("open ground reveal = %d, want %d", got, want)
+	}
+	// Moving east to q=3, the leading edge at q=5 is off the map
+	if edge, open := counts[AxialCoord{Q: 3, R: 0}], counts[AxialCoord{Q: 1, R: 0}]; edge >= open {
+		t.Errorf("map edge reveal = %d, want fewer than open ground's %d", edge, open)
+	}
+}
+
+// TestRevealCount_FogDisabled tests move options carry no reveal count
+// without fog of war
+func TestRevealCount_FogDisabled(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(4).
+		unitFull(0, 0, 1, testUnitTypeSoldier, "A1", 10, 1).
+		currentPlayer(1).
+		build()
+
+	for dest, count := range revealCounts(t, game, 0, 0) {
+		if count != 0 {
+			t.Errorf("reveal count to %v = %d without fog, want 0", dest, count)
+		}
+	}
+}
+
+// TestFog_HidesUnitsOutOfSight tests that enemies beyond sight range are
+// hidden only while fog of war is enabled
+func TestFog_HidesUnitsOutOfSight(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(5).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		unit(4, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	near := game.World.UnitAt(AxialCoord{Q: 2, R: 0})
+	far := game.World.UnitAt(AxialCoord{Q: 4, R: 0})
+
+	if !game.IsUnitVisibleToPlayer(far, 1) {
+		t.Error("far enemy should be visible without fog")
+	}
+
+	game.Config.Settings.FogEnabled = true
+	if !game.IsUnitVisibleToPlayer(near, 1) {
+		t.Error("enemy within sight range should be visible with fog")
+	}
+	if game.IsUnitVisibleToPlayer(far, 1) {
+		t.Error("enemy beyond sight range should be hidden by fog")
+	}
+	if got := len(game.GetVisibleUnitsForPlayer(1)); got != 2 {
+		t.Errorf("player 1 sees %d units, want 2", got)
+	}
+}
+
+// TestFog_HighGroundSeesFarther tests that a unit on a guard tower or
+// mountain sees farther than the same unit on grass
+func TestFog_HighGroundSeesFarther(t *testing.T) {
+	sightAt := func(tileType int32) (int, map[AxialCoord]bool) {
+		game := newTestGameBuilder().
+			tile(0, 0, tileType, 0).
+			grassTiles(6).
+			unit(0, 0, 1, testUnitTypeSoldier).
+			currentPlayer(1).
+			build()
+		unit := game.World.UnitAt(AxialCoord{})
+		return game.unitSightRange(unit, AxialCoord{}), game.GetVisibleHexes(1)
+	}
+
+	grassRange, grassVisible := sightAt(TileTypeGrass)
+	towerRange, towerVisible := sightAt(TileTypeGuardTower)
+	mountainRange, _ := sightAt(TileTypeMountains)
+	if towerRange != grassRange+int(DefaultVisionBonuses[TileTypeGuardTower]) {
+		t.Errorf("guard tower sight range = %d, want %d more than grass's %d", towerRange, DefaultVisionBonuses[TileTypeGuardTower], grassRange)
+	}
+	if mountainRange <= grassRange {
+		t.Errorf("mountain sight range = %d, want more than grass's %d", mountainRange, grassRange)
+	}
+
+	far := AxialCoord{Q: towerRange, R: 0}
+	if grassVisible[far] || !towerVisible[far] {
+		t.Errorf("hex %v visible from grass = %v and from the tower = %v, want only from the tower", far, grassVisible[far], towerVisible[far])
+	}
+	if len(towerVisible) <= len(grassVisible) {
+		t.Errorf("tower sees %d hexes, want more than grass's %d", len(towerVisible), len(grassVisible))
+	}
+}
+
+// TestFog_DiscoveredHexesOutlastSight tests that hexes a unit saw stay
+// discovered after it moves away, and are saved with the player state
+func TestFog_DiscoveredHexesOutlastSight(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(5).
+		unit(-4, 0, 1, testUnitTypeSoldier).
+		unit(4, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	game.Config.Settings.FogEnabled = true
+	behind := AxialCoord{Q: -5, R: 0}
+	if !game.GetVisibleHexes(1)[behind] {
+		t.Fatalf("hex %v should start in sight", behind)
+	}
+
+	// Processed on a transaction layer and applied, as the games service does
+	for _, move := range []*v1.GameMove{
+		{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{From: &v1.Position{Q: -4, R: 0}, To: &v1.Position{Q: -1, R: 0}}}},
+		{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}},
+	} {
+		game.World = game.World.Push()
+		if err := game.ProcessMove(move); err != nil {
+			t.Fatalf("ProcessMove failed: %v", err)
+		}
+		if err := game.ApplyChanges([]*v1.GameMove{move}); err != nil {
+			t.Fatalf("ApplyChanges failed: %v", err)
+		}
+	}
+
+	if game.GetVisibleHexes(1)[behind] {
+		t.Fatalf("hex %v should be out of sight after moving away", behind)
+	}
+	if !game.GetDiscoveredHexes(1)[behind] {
+		t.Errorf("hex %v was forgotten after the unit moved away", behind)
+	}
+	if game.GetDiscoveredHexes(2)[behind] {
+		t.Errorf("player 2 discovered %v without ever seeing it", behind)
+	}
+
+	// The discovered hexes come back with a saved and reloaded state
+	saved, err := proto.Marshal(game.GameState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := &v1.GameState{}
+	if err := proto.Unmarshal(saved, state); err != nil {
+		t.Fatal(err)
+	}
+	reloaded := NewGame(game.Game, state, NewWorld("reloaded", state.WorldData), game.RulesEngine, 0)
+	if !reloaded.GetDiscoveredHexes(1)[behind] {
+		t.Errorf("hex %v was not saved as discovered", behind)
+	}
+}
@@ -0,0 +1,112 @@
+package lib
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Multi-hex Units (experimental)
+// =============================================================================
+//
+// With MultiHexUnits enabled a unit type can declare a footprint: extra hexes
+// it covers besides its own, given relative to it when facing LEFT.  The unit
+// faces the direction of the last step it moved, and its footprint turns with
+// it.  Every hex of the footprint must be on the map and free of other units,
+// and attacks can target any of them.  With the flag off (the standard rules)
+// every unit covers exactly its own hex.
+
+// RotateHexOffset turns an offset by steps of 60 degrees, in the order of
+// NeighborDirection (LEFT turns to TOP_LEFT, TOP_LEFT to TOP_RIGHT, ...)
+func RotateHexOffset(offset AxialCoord, steps int) AxialCoord {
+	for range ((steps % 6) + 6) % 6 {
+		offset = AxialCoord{Q: -offset.R, R: offset.Q + offset.R}
+	}
+	return offset
+}
+
+// FootprintAt returns the hexes a unit of the given type covers with its own
+// hex at anchor, facing the given direction.  The anchor always comes first.
+func (re *RulesEngine) FootprintAt(unitType int32, anchor AxialCoord, facing NeighborDirection) []AxialCoord {
+	out := []AxialCoord{anchor}
+	if !re.GetMultiHexUnits() {
+		return out
+	}
+	unitDef, err := re.GetUnitData(unitType)
+	if err != nil {
+		return out
+	}
+	for _, offset := range unitDef.Footprint {
+		rotated := RotateHexOffset(AxialCoord{Q: int(offset.Dq), R: int(offset.Dr)}, int(facing))
+		out = append(out, AxialCoord{Q: anchor.Q + rotated.Q, R: anchor.R + rotated.R})
+	}
+	return out
+}
+
+// UnitFootprint returns the hexes a unit covers where it stands
+func (re *RulesEngine) UnitFootprint(unit *v1.Unit) []AxialCoord {
+	return re.FootprintAt(unit.UnitType, UnitGetCoord(unit), NeighborDirection(unit.Facing))
+}
+
+// HasFootprint returns whether units of the given type cover more than one hex
+func (re *RulesEngine) HasFootprint(unitType int32) bool {
+	if !re.GetMultiHexUnits() {
+		return false
+	}
+	unitDef, err := re.GetUnitData(unitType)
+	return err == nil && len(unitDef.Footprint) > 0
+}
+
+// OccupantAt returns the unit covering a hex, either standing on it or
+// covering it with its footprint
+func (re *RulesEngine) OccupantAt(world *World, coord AxialCoord) *v1.Unit {
+	if unit := world.UnitAt(coord); unit != nil || !re.GetMultiHexUnits() {
+		return unit
+	}
+	for _, unit := range world.UnitsByCoord() {
+		if !re.HasFootprint(unit.UnitType) {
+			continue
+		}
+		for _, covered := range re.UnitFootprint(unit)[1:] {
+			if covered == coord {
+				return unit
+			}
+		}
+	}
+	return nil
+}
+
+// footprintBlocked returns whether a unit of the given type cannot stand at
+// anchor facing the given direction because its footprint leaves the map or
+// overlaps another unit.  The unit's own hex is checked by the caller, and
+// self is ignored so a unit never blocks itself.
+func (re *RulesEngine) footprintBlocked(world *World, unitType int32, anchor AxialCoord, facing NeighborDirection, self *v1.Unit) bool {
+	if !re.HasFootprint(unitType) {
+		return false
+	}
+	for _, covered := range re.FootprintAt(unitType, anchor, facing)[1:] {
+		if world.TileAt(covered) == nil {
+			return true
+		}
+		if occupant := re.OccupantAt(world, covered); occupant != nil && occupant != self {
+			return true
+		}
+	}
+	return false
+}
+
+// occupiedByOther returns whether a hex is covered by a unit other than self
+func (re *RulesEngine) occupiedByOther(world *World, coord AxialCoord, self *v1.Unit) bool {
+	occupant := re.OccupantAt(world, coord)
+	return occupant != nil && occupant != self
+}
+
+// FootprintDistance returns the distance from a hex to the nearest hex a
+// unit covers
+func (re *RulesEngine) FootprintDistance(from AxialCoord, unit *v1.Unit) int {
+	footprint := re.UnitFootprint(unit)
+	dist := from.Distance(footprint[0])
+	for _, covered := range footprint[1:] {
+		dist = min(dist, from.Distance(covered))
+	}
+	return dist
+}
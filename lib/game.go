@@ -1,9 +1,12 @@
 package lib
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"math/rand"
+	"slices"
 	"time"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
@@ -31,6 +34,24 @@ type Game struct {
 
 	// Rules engine for data-driven game mechanics
 	RulesEngine *RulesEngine `json:"-"` // Rules engine for movement costs, combat, unit data
+
+	// How combat damage is resolved (random rolls by default)
+	CombatMode CombatMode `json:"-"`
+
+	// Clock for time banks (nil uses the system clock)
+	Clock Clock `json:"-"`
+
+	// Fog of war sight areas, cached for the current turn
+	sight *sightCache `json:"-"`
+
+	// Movement flood-fills for tactical queries, cached until the world changes
+	movement *movementCache `json:"-"`
+
+	// Changes applied by processed moves, nil unless the change log is enabled
+	changeLog *v1.ChangeLog `json:"-"`
+
+	// Moves the baseline AI played in the turn it is playing
+	baselineMoves []*v1.GameMove `json:"-"`
 }
 
 // NewGame creates a new game instance with the specified parameters
@@ -49,6 +70,7 @@ func NewGame(game *v1.Game, state *v1.GameState, world *World, rulesEngine *Rule
 		rng:         rand.New(rand.NewSource(seed)),
 		RulesEngine: rulesEngine,
 	}
+	out.assignUnitIDs()
 	return out
 }
 
@@ -101,6 +123,18 @@ func (g *Game) ArePlayersOnSameTeam(playerID1, playerID2 int) bool {
 	return g.Game.Config.Players[playerID1].TeamId == g.Game.Config.Players[playerID2].TeamId
 }
 
+// GetAdjacentEnemies returns the units next to unit that belong to its
+// opponents (teammates are not enemies in team games)
+func (g *Game) GetAdjacentEnemies(unit *v1.Unit) []*v1.Unit {
+	var enemies []*v1.Unit
+	for _, neighbor := range g.World.GetAdjacentUnits(UnitGetCoord(unit)) {
+		if g.areOpponents(unit.Player, neighbor.Player) {
+			enemies = append(enemies, neighbor)
+		}
+	}
+	return enemies
+}
+
 // =============================================================================
 // Helper Functions
 // =============================================================================
@@ -158,7 +192,7 @@ func (g *Game) TopUpUnitIfNeeded(unit *v1.Unit) error {
 	}
 
 	// Top-up movement points
-	unit.DistanceLeft = unitData.MovementPoints
+	unit.DistanceLeft = g.RulesEngine.RoundMovementPoints(unitData.MovementPoints)
 
 	// Top-up health (for new units or apply healing)
 	if unit.AvailableHealth == 0 {
@@ -188,6 +222,7 @@ func (g *Game) TopUpUnitIfNeeded(unit *v1.Unit) error {
 	// Reset action progression for new turn
 	unit.ProgressionStep = 0
 	unit.ChosenAlternative = ""
+	unit.StepActions = 0
 
 	// Check for pending capture completion
 	// If unit started capturing in a previous turn and survived, complete the capture
@@ -238,47 +273,48 @@ func (g *Game) calculateHealAmount(unit *v1.Unit, unitData *v1.UnitDefinition) i
 	}
 
 	// Rule 3: Air units can only heal on Airport Bases
-	// Check unit terrain type (Air, Land, Water)
-	if unitData.UnitTerrain == "Air" {
-		// Get terrain definition to check if it's an airport
+	return g.terrainHealingBonus(unit, unitData, tile)
+}
+
+// terrainHealingBonus returns how much health the unit restores per heal on
+// the tile, from the rules' healing bonus.  Air units only heal on Airport
+// Bases.
+func (g *Game) terrainHealingBonus(unit *v1.Unit, unitDef *v1.UnitDefinition, tile *v1.Tile) int32 {
+	if unitDef.UnitTerrain == "Air" {
 		terrainData, err := g.RulesEngine.GetTerrainData(tile.TileType)
-		if err != nil || terrainData == nil {
+		if err != nil || terrainData.Name != "Airport Base" {
 			return 0
 		}
-		// Check if terrain name contains "Airport" (Airport Base, etc.)
-		if terrainData.Name != "Airport Base" {
-			return 0 // Air units can only heal on Airport Base
-		}
 	}
 
-	// Look up healing bonus from TerrainUnitProperties
 	terrainProps := g.RulesEngine.GetTerrainUnitPropertiesForUnit(tile.TileType, unit.UnitType)
-	if terrainProps == nil || terrainProps.HealingBonus <= 0 {
-		return 0 // No healing available on this terrain for this unit
+	if terrainProps == nil {
+		return 0
 	}
-
 	return terrainProps.HealingBonus
 }
 
-// checkVictoryConditions checks if any player has won
-func (g *Game) checkVictoryConditions() (winner int32, hasWinner bool) {
-	// Simple victory condition: last player with units wins
-	playersWithUnits := 0
-	lastPlayerWithUnits := int32(-1)
-
-	for playerID := int32(1); playerID <= g.World.PlayerCount(); playerID++ {
-		units := g.World.GetPlayerUnits(int(playerID))
-		if len(units) > 0 {
-			playersWithUnits++
-			lastPlayerWithUnits = playerID
-		}
+// activeHealAmount returns how much health the unit restores by spending its
+// turn healing, or why it cannot.  Unlike healing at turn start, an active
+// heal needs friendly supply terrain (a tile the unit's player owns), works
+// whether or not the unit acted last turn and must be the unit's only action
+// this turn.
+func (g *Game) activeHealAmount(unit *v1.Unit, unitDef *v1.UnitDefinition) (int32, error) {
+	if unit.AvailableHealth >= unitDef.Health {
+		return 0, fmt.Errorf("unit already at max health")
 	}
-
-	if playersWithUnits == 1 {
-		return lastPlayerWithUnits, true
+	if unit.LastActedTurn >= g.TurnCounter || unit.ProgressionStep > 0 {
+		return 0, fmt.Errorf("unit has already acted this turn")
 	}
-
-	return -1, false
+	tile := g.World.TileAt(UnitGetCoord(unit))
+	if tile == nil || tile.Player != unit.Player {
+		return 0, fmt.Errorf("unit can only heal on friendly supply terrain")
+	}
+	healAmount := g.terrainHealingBonus(unit, unitDef, tile)
+	if healAmount <= 0 {
+		return 0, fmt.Errorf("unit cannot heal on this terrain")
+	}
+	return min(healAmount, unitDef.Health-unit.AvailableHealth), nil
 }
 
 // validateGameState validates the current game state
@@ -324,6 +360,13 @@ func (g *Game) SetRulesEngine(rulesEngine *RulesEngine) {
 	g.RulesEngine = rulesEngine
 }
 
+// SetRNG replaces the random number generator combat and fix rolls are
+// drawn from, eg so tests can script the rolls with NewScriptedRNG.  The
+// game's Seed is left as it was.
+func (g *Game) SetRNG(rng *rand.Rand) {
+	g.rng = rng
+}
+
 // LoadGame restores a game from saved JSON data
 func LoadGame(saveData []byte) (*Game, error) {
 	var game Game
@@ -455,10 +498,10 @@ func (g *Game) GetUnitsForPlayer(playerID int) []*v1.Unit {
 // IsUnitExhausted returns true if a unit should be shown as exhausted.
 // A unit is exhausted only if:
 // 1. It has been topped up this turn (LastToppedupTurn >= TurnCounter)
-// 2. AND it has no movement left (DistanceLeft <= 0)
+// 2. AND it has no movement left (less than a quarter of a point)
 // If LastToppedupTurn < TurnCounter, the unit will be topped up when accessed (lazy pattern).
 func (g *Game) IsUnitExhausted(unit *v1.Unit) bool {
-	return unit.LastToppedupTurn >= g.TurnCounter && unit.DistanceLeft <= 0
+	return unit.LastToppedupTurn >= g.TurnCounter && !g.RulesEngine.HasMovementLeft(unit.DistanceLeft)
 }
 
 // GetExhaustedUnits returns all units for the current player that are exhausted.
@@ -619,10 +662,158 @@ func (g *Game) Capture(unit string) ([]*v1.WorldChange, error) {
 	return move.Changes, nil
 }
 
+// HealUnit spends the unit's turn healing on friendly supply terrain.
+// unit: position string for the healing unit
+// Returns world changes from the heal.
+func (g *Game) HealUnit(unit string) ([]*v1.WorldChange, error) {
+	target, err := g.Pos(unit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unit position %q: %w", unit, err)
+	}
+	if target.Unit == nil {
+		return nil, fmt.Errorf("no unit at position %q", unit)
+	}
+
+	action := &v1.HealUnitAction{
+		Pos: target.Position(),
+	}
+
+	move := &v1.GameMove{
+		Player:   g.CurrentPlayer,
+		MoveType: &v1.GameMove_HealUnit{HealUnit: action},
+	}
+
+	if err := g.ProcessHealUnit(move, action); err != nil {
+		return nil, err
+	}
+
+	return move.Changes, nil
+}
+
+// TransformUnit turns the unit into another unit type its rules allow.
+// unit: position string for the transforming unit
+// newType: the unit type to transform into
+// Returns world changes from the transformation.
+func (g *Game) TransformUnit(unit string, newType int32) ([]*v1.WorldChange, error) {
+	target, err := g.Pos(unit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unit position %q: %w", unit, err)
+	}
+	if target.Unit == nil {
+		return nil, fmt.Errorf("no unit at position %q", unit)
+	}
+
+	action := &v1.TransformUnitAction{
+		Pos:         target.Position(),
+		NewUnitType: newType,
+	}
+
+	move := &v1.GameMove{
+		Player:   g.CurrentPlayer,
+		MoveType: &v1.GameMove_TransformUnit{TransformUnit: action},
+	}
+
+	if err := g.ProcessTransformUnit(move, action); err != nil {
+		return nil, err
+	}
+
+	return move.Changes, nil
+}
+
+// Construct starts converting terrain next to the unit at position.
+// unit: position string for the constructing unit
+// target: target tile (can be relative like "R", "TL")
+// Returns world changes from the construct action.
+func (g *Game) Construct(unit, target string) ([]*v1.WorldChange, error) {
+	src, err := g.Pos(unit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unit position %q: %w", unit, err)
+	}
+	if src.Unit == nil {
+		return nil, fmt.Errorf("no unit at position %q", unit)
+	}
+
+	dest, err := g.Pos(target, unit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target position %q: %w", target, err)
+	}
+
+	action := &v1.ConstructTerrainAction{
+		Pos:    src.Position(),
+		Target: dest.Position(),
+	}
+
+	move := &v1.GameMove{
+		Player:   g.CurrentPlayer,
+		MoveType: &v1.GameMove_ConstructTerrain{ConstructTerrain: action},
+	}
+
+	if err := g.ProcessConstructTerrain(move, action); err != nil {
+		return nil, err
+	}
+
+	return move.Changes, nil
+}
+
+// Submerge submerges (or surfaces) the Stealth-class unit at position.
+// unit: position string for the unit
+// submerge: true to submerge, false to surface
+// Returns world changes from the action.
+func (g *Game) Submerge(unit string, submerge bool) ([]*v1.WorldChange, error) {
+	src, err := g.Pos(unit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unit position %q: %w", unit, err)
+	}
+	if src.Unit == nil {
+		return nil, fmt.Errorf("no unit at position %q", unit)
+	}
+
+	action := &v1.SubmergeUnitAction{
+		Pos:      src.Position(),
+		Submerge: submerge,
+	}
+
+	move := &v1.GameMove{
+		Player:   g.CurrentPlayer,
+		MoveType: &v1.GameMove_SubmergeUnit{SubmergeUnit: action},
+	}
+
+	if err := g.ProcessSubmergeUnit(move, action); err != nil {
+		return nil, err
+	}
+
+	return move.Changes, nil
+}
+
+// DelegateTurn hands the rest of the current player's turn to a teammate
+func (g *Game) DelegateTurn(delegatePlayerId int32) ([]*v1.WorldChange, error) {
+	action := &v1.DelegateTurnAction{DelegatePlayerId: delegatePlayerId}
+	move := &v1.GameMove{
+		Player:   g.CurrentPlayer,
+		MoveType: &v1.GameMove_DelegateTurn{DelegateTurn: action},
+	}
+
+	if err := g.ProcessDelegateTurn(move, action); err != nil {
+		return nil, err
+	}
+
+	return move.Changes, nil
+}
+
 // EndTurn advances to next player.
 // Returns world changes from ending the turn.
 func (g *Game) EndTurn() ([]*v1.WorldChange, error) {
-	action := &v1.EndTurnAction{}
+	return g.endTurn(false)
+}
+
+// ForceEndTurn advances to next player even if mandatory actions are pending,
+// recording the ones skipped.
+func (g *Game) ForceEndTurn() ([]*v1.WorldChange, error) {
+	return g.endTurn(true)
+}
+
+func (g *Game) endTurn(force bool) ([]*v1.WorldChange, error) {
+	action := &v1.EndTurnAction{Force: force}
 
 	move := &v1.GameMove{
 		Player:   g.CurrentPlayer,
@@ -643,8 +834,15 @@ func (g *Game) EndTurn() ([]*v1.WorldChange, error) {
 
 // GetOptionsAt returns available options at a position.
 // position: position string ("A1", "3,4", "t:A1", etc.)
-// Returns the options response with available actions.
+// Returns the options response with available actions, in the canonical
+// order described by SortGameOptions.
 func (g *Game) GetOptionsAt(position string) (*v1.GetOptionsAtResponse, error) {
+	return g.GetOptionsAtContext(context.Background(), position)
+}
+
+// GetOptionsAtContext is GetOptionsAt that stops with the context's error
+// once it is done
+func (g *Game) GetOptionsAtContext(ctx context.Context, position string) (*v1.GetOptionsAtResponse, error) {
 	// Parse the position
 	target, err := g.Pos(position)
 	if err != nil {
@@ -659,6 +857,10 @@ func (g *Game) GetOptionsAt(position string) (*v1.GetOptionsAtResponse, error) {
 	unit := g.World.UnitAt(coord)
 	tile := g.World.TileAt(coord)
 
+	// Sandboxes may let any player's units and bases act
+	currentPlayer := g.CurrentPlayer
+	defer g.actAs(g.sandboxActor(unit, tile))()
+
 	// Lazy top-up if there's a unit
 	if unit != nil {
 		if err := g.TopUpUnitIfNeeded(unit); err != nil {
@@ -672,43 +874,76 @@ func (g *Game) GetOptionsAt(position string) (*v1.GetOptionsAtResponse, error) {
 	if unit == nil {
 		options, err = g.GetTileOptions(tile)
 	} else {
-		options, allPaths, err = g.GetUnitOptions(unit)
+		options, allPaths, err = g.GetUnitOptionsContext(ctx, unit)
 	}
 	if err != nil {
 		return nil, err
 	}
+	SortGameOptions(options)
 
 	return &v1.GetOptionsAtResponse{
 		Options:         options,
-		CurrentPlayer:   g.CurrentPlayer,
+		CurrentPlayer:   currentPlayer,
 		GameInitialized: g.World != nil,
 		AllPaths:        allPaths,
 	}, nil
 }
 
-// GetUnitOptions returns available options for a unit (move, attack, capture).
-func (g *Game) GetUnitOptions(unit *v1.Unit) (options []*v1.GameOption, allPaths *v1.AllPaths, err error) {
-	// Get unit definition for progression rules
+// progressionUnitDef returns the unit's definition for action progression,
+// falling back to the default action_order for unknown unit types
+func (g *Game) progressionUnitDef(unit *v1.Unit) *v1.UnitDefinition {
 	unitDef, err := g.RulesEngine.GetUnitData(unit.UnitType)
 	if err != nil {
-		unitDef = &v1.UnitDefinition{
+		return &v1.UnitDefinition{
 			ActionOrder: []string{"move", "attack|capture"},
 		}
 	}
+	return unitDef
+}
+
+// checkActionAllowed returns the progression step at which the unit performs
+// action, or ErrInvalidActionForProgression if its action_order doesn't
+// allow the action right now
+func (g *Game) checkActionAllowed(unit *v1.Unit, unitDef *v1.UnitDefinition, action string) (int32, error) {
+	step, ok := g.resolveActionStep(unit, unitDef, action)
+	if !ok {
+		return 0, fmt.Errorf("%w: unit at (%d, %d) cannot %s at progression step %d (allowed: %v)",
+			ErrInvalidActionForProgression, unit.Q, unit.R, action, unit.ProgressionStep,
+			g.RulesEngine.GetAllowedActionsForUnit(unit, unitDef))
+	}
+	return step, nil
+}
+
+// GetUnitOptions returns available options for a unit (move, attack, capture).
+// Only actions that ProcessMove would accept for the unit's progression
+// state are offered.
+func (g *Game) GetUnitOptions(unit *v1.Unit) (options []*v1.GameOption, allPaths *v1.AllPaths, err error) {
+	return g.GetUnitOptionsContext(context.Background(), unit)
+}
 
-	// Get allowed actions based on progression state
-	allowedActions := g.RulesEngine.GetAllowedActionsForUnit(unit, unitDef)
+// GetUnitOptionsContext is GetUnitOptions that stops with the context's
+// error once it is done
+func (g *Game) GetUnitOptionsContext(ctx context.Context, unit *v1.Unit) (options []*v1.GameOption, allPaths *v1.AllPaths, err error) {
+	// Get unit definition for progression rules
+	unitDef := g.progressionUnitDef(unit)
 
-	moveAllowed := ContainsAction(allowedActions, "move")
-	retreatAllowed := ContainsAction(allowedActions, "retreat")
+	_, moveAllowed := g.resolveActionStep(unit, unitDef, "move")
+	_, retreatAllowed := g.resolveActionStep(unit, unitDef, "retreat")
 
 	// Get movement options
-	if unit.AvailableHealth > 0 && unit.DistanceLeft > 0 && (moveAllowed || retreatAllowed) {
-		pathsResult, err := g.GetMovementOptions(unit.Q, unit.R, false)
+	if unit.AvailableHealth > 0 && g.RulesEngine.HasMovementLeft(unit.DistanceLeft) && (moveAllowed || retreatAllowed) {
+		pathsResult, err := g.GetMovementOptionsContext(ctx, unit.Q, unit.R, false)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, ctxErr
+		}
 		if err == nil {
 			allPaths = pathsResult
+			visible := g.fogVisibleHexes(unit.Player)
 
-			for _, edge := range allPaths.Edges {
+			// Visit edges in a fixed order so ties within the final sort
+			// never depend on map iteration
+			for _, key := range slices.Sorted(maps.Keys(allPaths.Edges)) {
+				edge := allPaths.Edges[key]
 				if edge.IsOccupied {
 					continue
 				}
@@ -724,6 +959,9 @@ func (g *Game) GetUnitOptions(unit *v1.Unit) (options []*v1.GameOption, allPaths
 					MovementCost:      edge.TotalCost,
 					ReconstructedPath: path,
 				}
+				if visible != nil {
+					moveAction.RevealCount = g.CountRevealedHexes(unit, CoordFromInt32(edge.ToQ, edge.ToR), visible)
+				}
 
 				options = append(options, &v1.GameOption{
 					OptionType: &v1.GameOption_Move{Move: moveAction},
@@ -732,26 +970,17 @@ func (g *Game) GetUnitOptions(unit *v1.Unit) (options []*v1.GameOption, allPaths
 		}
 	}
 
-	// Check if attack is allowed (including look-ahead for point-based steps)
-	attackAllowed := ContainsAction(allowedActions, "attack")
-	isPointBasedStep := moveAllowed || retreatAllowed
-	if isPointBasedStep && !attackAllowed {
-		nextStepUnit := &v1.Unit{
-			ProgressionStep:   unit.ProgressionStep + 1,
-			ChosenAlternative: "",
-			DistanceLeft:      0,
-		}
-		nextAllowedActions := g.RulesEngine.GetAllowedActionsForUnit(nextStepUnit, unitDef)
-		attackAllowed = ContainsAction(nextAllowedActions, "attack")
-	}
+	// Check if attack is allowed (including look-ahead past a move step)
+	_, attackAllowed := g.resolveActionStep(unit, unitDef, "attack")
 
-	// Get attack options
-	if unit.AvailableHealth > 0 && attackAllowed {
+	// Get attack options (submerged units cannot attack or see hidden targets)
+	if unit.AvailableHealth > 0 && attackAllowed && !unit.Submerged {
 		attackCoords, err := g.GetAttackOptions(unit.Q, unit.R)
 		if err == nil {
+			visible := g.fogVisibleHexes(unit.Player)
 			for _, coord := range attackCoords {
-				targetUnit := g.World.UnitAt(coord)
-				if targetUnit != nil {
+				targetUnit := g.RulesEngine.OccupantAt(g.World, coord)
+				if targetUnit != nil && g.isUnitVisible(targetUnit, unit.Player, visible) {
 					damageEstimate := int32(50) // TODO: Use proper damage calculation
 
 					attackAction := &v1.AttackUnitAction{
@@ -771,17 +1000,8 @@ func (g *Game) GetUnitOptions(unit *v1.Unit) (options []*v1.GameOption, allPaths
 		}
 	}
 
-	// Check if capture is allowed (including look-ahead)
-	captureAllowed := ContainsAction(allowedActions, "capture")
-	if isPointBasedStep && !captureAllowed {
-		nextStepUnit := &v1.Unit{
-			ProgressionStep:   unit.ProgressionStep + 1,
-			ChosenAlternative: "",
-			DistanceLeft:      0,
-		}
-		nextAllowedActions := g.RulesEngine.GetAllowedActionsForUnit(nextStepUnit, unitDef)
-		captureAllowed = ContainsAction(nextAllowedActions, "capture")
-	}
+	// Check if capture is allowed (including look-ahead past a move step)
+	_, captureAllowed := g.resolveActionStep(unit, unitDef, "capture")
 
 	// Get capture option
 	if unit.AvailableHealth > 0 && captureAllowed && unit.CaptureStartedTurn == 0 {
@@ -801,38 +1021,27 @@ func (g *Game) GetUnitOptions(unit *v1.Unit) (options []*v1.GameOption, allPaths
 		}
 	}
 
-	// Get heal option if unit is below max health and can heal on current terrain
-	// Heal is available if unit hasn't acted this turn yet
-	if unit.AvailableHealth > 0 && unit.AvailableHealth < unitDef.Health && unit.LastActedTurn < g.TurnCounter {
-		coord := CoordFromInt32(unit.Q, unit.R)
-		tile := g.World.TileAt(coord)
-		if tile != nil {
-			// Can't heal on enemy-owned tiles
-			if tile.Player == 0 || tile.Player == unit.Player {
-				canHeal := true
-				// Air units can only heal on Airport Base
-				if unitDef.UnitTerrain == "Air" {
-					terrainData, _ := g.RulesEngine.GetTerrainData(tile.TileType)
-					if terrainData == nil || terrainData.Name != "Airport Base" {
-						canHeal = false
-					}
-				}
-				if canHeal {
-					terrainProps := g.RulesEngine.GetTerrainUnitPropertiesForUnit(tile.TileType, unit.UnitType)
-					if terrainProps != nil && terrainProps.HealingBonus > 0 {
-						healAction := &v1.HealUnitAction{
-							Pos:        &v1.Position{Label: unit.Shortcut, Q: unit.Q, R: unit.R},
-							HealAmount: terrainProps.HealingBonus,
-						}
-						options = append(options, &v1.GameOption{
-							OptionType: &v1.GameOption_Heal{Heal: healAction},
-						})
-					}
-				}
-			}
-		}
+	// Get heal option if the unit can spend its turn healing where it stands
+	if healAmount, err := g.activeHealAmount(unit, unitDef); err == nil {
+		options = append(options, &v1.GameOption{
+			OptionType: &v1.GameOption_Heal{Heal: &v1.HealUnitAction{
+				Pos:        &v1.Position{Label: unit.Shortcut, Q: unit.Q, R: unit.R},
+				HealAmount: healAmount,
+			}},
+		})
+	}
+
+	// Check if construct is allowed (including look-ahead past a move step)
+	_, constructAllowed := g.resolveActionStep(unit, unitDef, "construct")
+
+	// Get construct options (eg "build bridge R (2 turns, 150c)")
+	if unit.AvailableHealth > 0 && constructAllowed {
+		options = append(options, g.GetConstructOptions(unit, unitDef)...)
 	}
 
+	// Get submerge/surface option for Stealth-class units
+	options = append(options, g.GetSubmergeOptions(unit, unitDef)...)
+
 	return
 }
 
@@ -858,21 +1067,26 @@ func (g *Game) GetTileOptions(tile *v1.Tile) (options []*v1.GameOption, err erro
 	}
 
 	// Get current player's coins
-	playerCoins := int32(0)
-	if playerState := g.GameState.PlayerStates[g.CurrentPlayer]; playerState != nil {
-		playerCoins = playerState.Coins
-	}
+	playerCoins := g.spendableCoins(g.CurrentPlayer)
 
 	// Get allowed actions for this tile
 	tileActions := g.RulesEngine.GetAllowedActionsForTile(tile, terrainDef, playerCoins)
 
 	for _, action := range tileActions {
 		if action == "build" {
-			// Filter buildable units by game's allowed units setting
+			// Filter buildable units by game's allowed units setting and draft
 			buildableUnits := FilterBuildOptionsByAllowedUnits(
 				terrainDef.BuildableUnitIds,
 				g.Config.Settings.GetAllowedUnits(),
 			)
+			buildableUnits = FilterBuildOptionsByDraft(buildableUnits, g.GameState.Draft, g.CurrentPlayer)
+
+			// Builds at the unit cap are still listed so players see why
+			// they can't build
+			disabledReason := ""
+			if err := g.checkUnitCap(g.CurrentPlayer); err != nil {
+				disabledReason = err.Error()
+			}
 
 			for _, unitTypeID := range buildableUnits {
 				unitDef, err := g.RulesEngine.GetUnitData(unitTypeID)
@@ -886,9 +1100,10 @@ func (g *Game) GetTileOptions(tile *v1.Tile) (options []*v1.GameOption, err erro
 							Build: &v1.BuildUnitAction{
 								Pos:      &v1.Position{Label: tile.Shortcut, Q: tile.Q, R: tile.R},
 								UnitType: unitTypeID,
-								Cost:     unitDef.Coins,
+								Cost:     g.coinCost(unitDef.Coins),
 							},
 						},
+						DisabledReason: disabledReason,
 					})
 				}
 			}
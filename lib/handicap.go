@@ -0,0 +1,94 @@
+package lib
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Player Handicaps
+// =============================================================================
+//
+// A player's handicap is a head start given when the game is created: extra
+// starting coins and bonus units.  Bonus units go on free tiles the player
+// owns and then on free tiles next to them, in coordinate order, skipping
+// tiles the unit cannot stand on.  A bonus unit with no free tile left is
+// dropped.  Like the world's own units they start with zero health and are
+// topped up lazily on the player's first turn.
+
+// ValidateHandicap checks a handicap against the rules it will be played with
+func (re *RulesEngine) ValidateHandicap(h *v1.PlayerHandicap) error {
+	if h.GetExtraCoins() < 0 {
+		return fmt.Errorf("extra coins cannot be negative, got %d", h.GetExtraCoins())
+	}
+	for _, unitType := range h.GetBonusUnits() {
+		if _, err := re.GetUnitData(unitType); err != nil {
+			return fmt.Errorf("bonus unit: %w", err)
+		}
+	}
+	return nil
+}
+
+// ApplyHandicaps gives each handicapped player in the config their extra
+// coins and places their bonus units.  The game's player states must already
+// be initialized.
+func ApplyHandicaps(state *v1.GameState, config *v1.GameConfiguration, rulesEngine *RulesEngine) {
+	world := NewWorld("", state.WorldData)
+	for _, player := range config.GetPlayers() {
+		handicap := player.GetHandicap()
+		if handicap == nil {
+			continue
+		}
+		if playerState := state.PlayerStates[player.PlayerId]; playerState != nil {
+			playerState.Coins += handicap.ExtraCoins
+		}
+		if len(handicap.BonusUnits) == 0 {
+			continue
+		}
+
+		spots := handicapSpots(world, player.PlayerId)
+		for _, unitType := range handicap.BonusUnits {
+			i := slices.IndexFunc(spots, func(coord AxialCoord) bool {
+				return world.UnitAt(coord) == nil &&
+					rulesEngine.CanUnitEnterTerrain(unitType, rulesEngine.GetEffectiveTileType(world, coord))
+			})
+			if i < 0 {
+				continue
+			}
+			world.AddUnit(NewUnit(int(unitType), int(player.PlayerId), spots[i]))
+		}
+	}
+}
+
+// handicapSpots lists where a player's bonus units may go, the tiles they
+// own followed by the tiles next to those, in coordinate order
+func handicapSpots(world *World, playerID int32) []AxialCoord {
+	byCoord := func(a, b AxialCoord) int { return cmp.Or(cmp.Compare(a.R, b.R), cmp.Compare(a.Q, b.Q)) }
+
+	var owned []AxialCoord
+	for coord, tile := range world.TilesByCoord() {
+		if tile.Player == playerID {
+			owned = append(owned, coord)
+		}
+	}
+	slices.SortFunc(owned, byCoord)
+
+	seen := make(map[AxialCoord]bool, len(owned))
+	for _, coord := range owned {
+		seen[coord] = true
+	}
+	var nearby []AxialCoord
+	for _, coord := range owned {
+		for _, next := range coord.Ring(1) {
+			if !seen[next] && world.TileAt(next) != nil {
+				seen[next] = true
+				nearby = append(nearby, next)
+			}
+		}
+	}
+	slices.SortFunc(nearby, byCoord)
+	return append(owned, nearby...)
+}
@@ -0,0 +1,48 @@
+package lib
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Tile Hazards
+// =============================================================================
+//
+// A tile can carry a hazard (a minefield, a trap) that affects units moving
+// onto it. Every hazard tile a unit enters on its path costs it the hazard's
+// damage, and a hazard that stops movement ends the move on that tile: the
+// unit can land there but not pass through.
+
+// HazardAt returns the hazard on the tile at coord, nil if there is none
+func (w *World) HazardAt(coord AxialCoord) *v1.TileHazard {
+	return w.TileAt(coord).GetHazard()
+}
+
+// StopsMovementAt reports whether units entering the tile at coord must stop there
+func (w *World) StopsMovementAt(coord AxialCoord) bool {
+	return w.HazardAt(coord).GetStopsMovement()
+}
+
+// pathHazards adds up the damage of every hazard tile entered along a path
+// and reports whether the last tile stops the unit
+func (w *World) pathHazards(path *v1.Path) (damage int32, stopped bool) {
+	for _, edge := range path.GetEdges() {
+		hazard := w.HazardAt(AxialCoord{Q: int(edge.ToQ), R: int(edge.ToR)})
+		damage += hazard.GetDamage()
+		stopped = hazard.GetStopsMovement()
+	}
+	return damage, stopped
+}
+
+// applyHazards damages and halts a unit that moved along path. It returns
+// false if the hazards killed the unit.
+func (g *Game) applyHazards(unit *v1.Unit, path *v1.Path) (alive bool) {
+	damage, stopped := g.World.pathHazards(path)
+	if stopped {
+		unit.DistanceLeft = 0
+	}
+	if damage > 0 {
+		unit.AvailableHealth = max(0, unit.AvailableHealth-damage)
+	}
+	return unit.AvailableHealth > 0
+}
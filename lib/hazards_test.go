@@ -0,0 +1,114 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+func moveUnitMove(fromQ, fromR, toQ, toR int32) *v1.GameMove {
+	return &v1.GameMove{
+		MoveType: &v1.GameMove_MoveUnit{
+			MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Q: fromQ, R: fromR},
+				To:   &v1.Position{Q: toQ, R: toR},
+			},
+		},
+	}
+}
+
+// TestHazard_MineDamagesAndStops tests a unit moving onto a minefield
+func TestHazard_MineDamagesAndStops(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	game.World.TileAt(AxialCoord{Q: 1, R: 0}).Hazard = &v1.TileHazard{Damage: 3, StopsMovement: true}
+
+	move := moveUnitMove(0, 0, 1, 0)
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+
+	unit := game.World.UnitAt(AxialCoord{Q: 1, R: 0})
+	if unit == nil {
+		t.Fatal("Unit not at the mine tile")
+	}
+	if unit.AvailableHealth != 7 {
+		t.Errorf("Expected health 7 after the mine, got %d", unit.AvailableHealth)
+	}
+	if unit.DistanceLeft != 0 {
+		t.Errorf("Expected movement to be stopped, got %f left", unit.DistanceLeft)
+	}
+
+	moved := move.Changes[0].GetUnitMoved()
+	if moved == nil || moved.UpdatedUnit.AvailableHealth != 7 {
+		t.Error("UnitMoved change should record the damaged unit")
+	}
+}
+
+// TestHazard_CannotPassThroughStop tests that a stopping hazard ends paths
+func TestHazard_CannotPassThroughStop(t *testing.T) {
+	game := newTestGameBuilder().
+		tile(0, 0, TileTypeGrass, 0).
+		tile(1, 0, TileTypeGrass, 0).
+		tile(2, 0, TileTypeGrass, 0).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	game.World.TileAt(AxialCoord{Q: 1, R: 0}).Hazard = &v1.TileHazard{StopsMovement: true}
+
+	if err := game.ProcessMove(moveUnitMove(0, 0, 2, 0)); err == nil {
+		t.Error("Should not move through a tile that stops movement")
+	}
+	if game.World.UnitAt(AxialCoord{Q: 0, R: 0}) == nil {
+		t.Error("Unit should not have moved")
+	}
+}
+
+// TestHazard_DamageOnlyDoesNotStop tests a hazard that damages without stopping
+func TestHazard_DamageOnlyDoesNotStop(t *testing.T) {
+	game := newTestGameBuilder().
+		tile(0, 0, TileTypeGrass, 0).
+		tile(1, 0, TileTypeGrass, 0).
+		tile(2, 0, TileTypeGrass, 0).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	game.World.TileAt(AxialCoord{Q: 1, R: 0}).Hazard = &v1.TileHazard{Damage: 2}
+
+	if err := game.ProcessMove(moveUnitMove(0, 0, 2, 0)); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+
+	unit := game.World.UnitAt(AxialCoord{Q: 2, R: 0})
+	if unit == nil {
+		t.Fatal("Unit not at destination")
+	}
+	if unit.AvailableHealth != 8 {
+		t.Errorf("Expected health 8 after crossing the hazard, got %d", unit.AvailableHealth)
+	}
+}
+
+// TestHazard_MineKillsUnit tests a unit destroyed by a minefield
+func TestHazard_MineKillsUnit(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unitFull(0, 0, 1, testUnitTypeSoldier, "", 2, 3).
+		currentPlayer(1).
+		build()
+	game.World.TileAt(AxialCoord{Q: 1, R: 0}).Hazard = &v1.TileHazard{Damage: 5, StopsMovement: true}
+
+	move := moveUnitMove(0, 0, 1, 0)
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+
+	if game.World.UnitAt(AxialCoord{Q: 1, R: 0}) != nil {
+		t.Error("Unit should have been destroyed by the mine")
+	}
+	if len(move.Changes) != 2 || move.Changes[1].GetUnitKilled() == nil {
+		t.Fatalf("Expected UnitMoved then UnitKilled changes, got %v", move.Changes)
+	}
+}
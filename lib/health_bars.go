@@ -0,0 +1,65 @@
+package lib
+
+import (
+	"image/color"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// DefaultUnitMaxHealth is the max health health bars assume for unit types
+// without one in RenderOptions.UnitMaxHealth
+const DefaultUnitMaxHealth = 10
+
+// HealthBarBand colors a health bar while a unit's health is at or above a
+// fraction of its max health
+type HealthBarBand struct {
+	MinFraction float64
+	Color       color.RGBA
+}
+
+// HealthBarStyle is how health bars are drawn
+type HealthBarStyle struct {
+	// Bands from the highest MinFraction down; health below every band takes
+	// the last band's color
+	Bands []HealthBarBand
+
+	// Color of the missing part of the bar
+	Background color.RGBA
+
+	// Bar height in pixels
+	Height int
+}
+
+// DefaultHealthBarStyle returns the default bands: green from 60% of max
+// health, yellow from 30% and red below
+func DefaultHealthBarStyle() *HealthBarStyle {
+	return &HealthBarStyle{
+		Bands: []HealthBarBand{
+			{MinFraction: 0.6, Color: color.RGBA{R: 0x22, G: 0xc5, B: 0x5e, A: 0xff}},
+			{MinFraction: 0.3, Color: color.RGBA{R: 0xea, G: 0xb3, B: 0x08, A: 0xff}},
+			{MinFraction: 0, Color: color.RGBA{R: 0xdc, G: 0x26, B: 0x26, A: 0xff}},
+		},
+		Background: color.RGBA{R: 0x1f, G: 0x29, B: 0x37, A: 0xcc},
+		Height:     4,
+	}
+}
+
+// ColorFor returns the bar color for health at a fraction of max health
+func (s *HealthBarStyle) ColorFor(fraction float64) color.RGBA {
+	for _, band := range s.Bands {
+		if fraction >= band.MinFraction {
+			return band.Color
+		}
+	}
+	return s.Bands[len(s.Bands)-1].Color
+}
+
+// HealthFraction returns a unit's health as a fraction of its type's max
+// health in the render options
+func (opts *RenderOptions) HealthFraction(unit *v1.Unit) float64 {
+	maxHealth, ok := opts.UnitMaxHealth[unit.UnitType]
+	if !ok || maxHealth <= 0 {
+		maxHealth = DefaultUnitMaxHealth
+	}
+	return min(float64(unit.AvailableHealth)/float64(maxHealth), 1)
+}
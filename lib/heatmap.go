@@ -0,0 +1,138 @@
+package lib
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Activity Heatmaps
+// =============================================================================
+//
+// An activity heatmap counts, per hex, the fighting that happened there over
+// a game: attacks into the hex, units destroyed on it and captures of it.
+// It is built from the changes recorded with each move so a finished game's
+// history is enough, and heatmaps of several games on the same world can be
+// added together for per-world aggregates.
+
+// HexActivity counts what happened on a single hex
+type HexActivity struct {
+	Attacks  int // Attacks made into the hex
+	Kills    int // Units destroyed on the hex
+	Captures int // Times the hex's building was captured
+}
+
+// Total is the hex's overall activity, each event counting once
+func (a *HexActivity) Total() int {
+	return a.Attacks + a.Kills + a.Captures
+}
+
+// ActivityHeatmap maps hexes to their activity.  Hexes nothing happened on
+// are absent.
+type ActivityHeatmap map[AxialCoord]*HexActivity
+
+// at returns the activity for coord, adding it if absent
+func (h ActivityHeatmap) at(coord AxialCoord) *HexActivity {
+	activity := h[coord]
+	if activity == nil {
+		activity = &HexActivity{}
+		h[coord] = activity
+	}
+	return activity
+}
+
+// AccumulateActivity builds the activity heatmap of the given moves, in the
+// order they were played
+func AccumulateActivity(moves []*v1.GameMove) ActivityHeatmap {
+	heatmap := ActivityHeatmap{}
+	for _, move := range moves {
+		heatmap.AddMove(move)
+	}
+	return heatmap
+}
+
+// AccumulateHistoryActivity builds the activity heatmap of a game's history
+func AccumulateHistoryActivity(history *v1.GameMoveHistory) ActivityHeatmap {
+	heatmap := ActivityHeatmap{}
+	for _, group := range history.GetGroups() {
+		for _, move := range group.Moves {
+			heatmap.AddMove(move)
+		}
+	}
+	return heatmap
+}
+
+// AddMove counts the attacks, kills and captures recorded with a move
+func (h ActivityHeatmap) AddMove(move *v1.GameMove) {
+	attacked := false
+	for _, change := range move.GetChanges() {
+		if summary := change.GetUnitAttacked().GetSummary(); summary != nil {
+			h.at(UnitGetCoord(summary.Defender)).Attacks++
+			attacked = true
+		}
+		if killed := change.GetUnitKilled(); killed != nil {
+			h.at(UnitGetCoord(killed.PreviousUnit)).Kills++
+		}
+		if captured := change.GetTileCaptured(); captured != nil {
+			h.at(CoordFromInt32(captured.TileQ, captured.TileR)).Captures++
+		}
+	}
+
+	// Attacks recorded before combat summaries only left the defender's
+	// damage or death behind
+	if move.GetAttackUnit() != nil && !attacked {
+		if defender := attackedUnit(move); defender != nil {
+			h.at(UnitGetCoord(defender)).Attacks++
+		}
+	}
+}
+
+// attackedUnit returns the first unit not of the moving player that an
+// attack move damaged or killed
+func attackedUnit(move *v1.GameMove) *v1.Unit {
+	for _, change := range move.GetChanges() {
+		unit := change.GetUnitDamaged().GetPreviousUnit()
+		if unit == nil {
+			unit = change.GetUnitKilled().GetPreviousUnit()
+		}
+		if unit != nil && unit.Player != move.Player {
+			return unit
+		}
+	}
+	return nil
+}
+
+// Add adds another heatmap's counts into this one, eg to aggregate the
+// games played on a world
+func (h ActivityHeatmap) Add(other ActivityHeatmap) {
+	for coord, activity := range other {
+		total := h.at(coord)
+		total.Attacks += activity.Attacks
+		total.Kills += activity.Kills
+		total.Captures += activity.Captures
+	}
+}
+
+// MaxTotal returns the highest total activity on any hex
+func (h ActivityHeatmap) MaxTotal() int {
+	maxTotal := 0
+	for _, activity := range h {
+		maxTotal = max(maxTotal, activity.Total())
+	}
+	return maxTotal
+}
+
+// Intensities returns each active hex's total activity normalized to the
+// busiest hex, which has intensity 1.  Hexes with no activity are absent.
+func (h ActivityHeatmap) Intensities() map[AxialCoord]float64 {
+	intensities := map[AxialCoord]float64{}
+	maxTotal := h.MaxTotal()
+	if maxTotal == 0 {
+		return intensities
+	}
+	for coord, activity := range h {
+		if total := activity.Total(); total > 0 {
+			intensities[coord] = float64(total) / float64(maxTotal)
+		}
+	}
+	return intensities
+}
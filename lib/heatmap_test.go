@@ -0,0 +1,67 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// TestAccumulateActivity_CornerFight tests that a game whose fighting all
+// happens in one corner peaks there and leaves the rest of the map cold
+func TestAccumulateActivity_CornerFight(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unit(-3, 0, 1, testUnitTypeSoldier).
+		unit(-3, 1, 2, testUnitTypeSoldier).
+		unit(3, -3, 1, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+
+	var moves []*v1.GameMove
+	for turn := 0; turn < 3 && game.World.UnitAt(AxialCoord{Q: -3, R: 1}) != nil; turn++ {
+		move := &v1.GameMove{
+			MoveType: &v1.GameMove_AttackUnit{
+				AttackUnit: &v1.AttackUnitAction{
+					Attacker: &v1.Position{Q: -3, R: 0},
+					Defender: &v1.Position{Q: -3, R: 1},
+				},
+			},
+		}
+		if err := game.ProcessMove(move); err != nil {
+			t.Fatalf("attack %d failed: %v", turn, err)
+		}
+		moves = append(moves, move)
+		for range 2 {
+			if err := game.ProcessMove(&v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}); err != nil {
+				t.Fatalf("end turn failed: %v", err)
+			}
+		}
+	}
+
+	heatmap := AccumulateActivity(moves)
+	defender := AxialCoord{Q: -3, R: 1}
+	if got := heatmap[defender]; got == nil || got.Attacks != len(moves) {
+		t.Fatalf("defender hex activity = %+v, want %d attacks", got, len(moves))
+	}
+
+	intensities := heatmap.Intensities()
+	if got := intensities[defender]; got != 1 {
+		t.Errorf("defender hex intensity = %v, want 1", got)
+	}
+	for coord := range game.World.TilesByCoord() {
+		if coord.Distance(defender) > 1 && intensities[coord] != 0 {
+			t.Errorf("hex %v away from the fight has intensity %v, want 0", coord, intensities[coord])
+		}
+	}
+
+	// Aggregating the same game twice keeps the same shape
+	total := ActivityHeatmap{}
+	total.Add(heatmap)
+	total.Add(heatmap)
+	if got := total[defender].Attacks; got != 2*len(moves) {
+		t.Errorf("aggregated attacks = %d, want %d", got, 2*len(moves))
+	}
+	if got := total.Intensities()[defender]; got != 1 {
+		t.Errorf("aggregated defender hex intensity = %v, want 1", got)
+	}
+}
@@ -2,6 +2,8 @@ package lib
 
 import (
 	"fmt"
+	"image/color"
+	"math"
 	"strings"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
@@ -396,10 +398,23 @@ const (
 type RenderOptions struct {
 	TileWidth           int  // Width of each hex tile in pixels
 	TileHeight          int  // Height of each hex tile in pixels
-	YIncrement          int  // Vertical spacing between rows (typically 3/4 of TileHeight for pointy-top)
+	YIncrement          int  // Spacing between rows, or columns for flat-top (typically 3/4 of the tile size)
 	ShowUnitLabels      bool // Show unit labels (Shortcut:MP/Health) below units
 	ShowTileLabels      bool // Show tile labels (Shortcut) below tile
+	ShowGrid            bool // Outline every tile's hex
+	ShowCoords          bool // Show each tile's "Q,R" coordinate
+	ShowHealthBars      bool // Show a health bar on units below their max health
 	EvenRowOffsetCoords bool
+	Orientation         *Orientation // Hex layout, pointy-top if nil
+
+	HoverCoord    *AxialCoord // Tile to highlight as hovered, if any
+	SelectedCoord *AxialCoord // Tile to highlight as selected, if any
+	PreviewTiles  []*v1.Tile  // Tiles drawn semi-transparently over the world, e.g. a brush preview
+
+	TileTints map[AxialCoord]color.NRGBA // Translucent colors drawn over tiles, e.g. a heat map
+
+	HealthBars    *HealthBarStyle // Health bar bands and colors, DefaultHealthBarStyle if nil
+	UnitMaxHealth map[int32]int32 // Max health by unit type (eg from the rules), DefaultUnitMaxHealth if missing
 }
 
 // DefaultRenderOptions returns standard rendering options
@@ -417,15 +432,12 @@ func HexToPixel(coord AxialCoord, opts *RenderOptions) (x, y int) {
 	if opts == nil {
 		opts = DefaultRenderOptions()
 	}
-	row, col := HexToRowCol(coord, opts.EvenRowOffsetCoords)
-	y = opts.YIncrement * row
-	x = opts.TileWidth * col
-	// Odd rows are offset by half a tile width
-	if (row & 1) == 1 {
-		if opts.EvenRowOffsetCoords {
-		} else {
-			x += opts.TileWidth / 2
-		}
+	qb, rb := opts.HexOrientation().Basis(opts)
+	x = int(math.Floor(float64(coord.Q)*qb[0] + float64(coord.R)*rb[0]))
+	y = int(math.Floor(float64(coord.Q)*qb[1] + float64(coord.R)*rb[1]))
+	// Even-row offset layouts shift odd rows by another half a tile width
+	if opts.EvenRowOffsetCoords && opts.HexOrientation() == PointyTop && coord.R&1 == 1 {
+		x += opts.TileWidth / 2
 	}
 	return x, y
 }
@@ -436,7 +448,8 @@ func HexToPixelInt32(q, r int32, opts *RenderOptions) (x, y int) {
 }
 
 // ComputeWorldBounds calculates the pixel bounding box for tiles and units
-// Returns bounds where (MinX, MinY) is the top-left corner of the top-left-most tile
+// Returns bounds where (MinX, MinY) is the top-left corner of the top-left-most tile.
+// An empty map has zeroed bounds rather than the sentinel extremes.
 func ComputeWorldBounds(tiles map[string]*v1.Tile, units map[string]*v1.Unit, opts *RenderOptions) WorldBounds {
 	if len(tiles) == 0 && len(units) == 0 {
 		return WorldBounds{}
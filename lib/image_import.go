@@ -0,0 +1,176 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Terrain Image Import
+// =============================================================================
+//
+// A map drawn in any paint program can be imported as a draft world: the
+// image is sampled at the center of each hex of a pointy-top lattice laid
+// over it (hex 0,0 in the top-left corner) and each sampled color becomes
+// the terrain of the nearest mapped color.  Transparent pixels leave their
+// hex off the map, so maps need not be rectangular.
+
+// DefaultImageColorTolerance is how far, in RGB units, a sampled color can
+// be from a mapped color and still take its terrain
+const DefaultImageColorTolerance = 48
+
+// TerrainColor is a color in an imported image and the terrain it stands for
+type TerrainColor struct {
+	Color    color.NRGBA
+	TileType int32
+}
+
+// TerrainImageMapping maps the colors of an imported image to terrains
+type TerrainImageMapping struct {
+	Colors []TerrainColor
+
+	// Largest distance from a sampled color to the nearest mapped color for
+	// the hex to take that color's terrain
+	Tolerance float64
+
+	// Terrain of the hexes whose color maps to none.  0 leaves them off the
+	// map.
+	DefaultTileType int32
+}
+
+// TerrainImageImport is a draft world imported from an image
+type TerrainImageImport struct {
+	WorldData *v1.WorldData
+
+	// Hexes whose color mapped to no terrain, in row order
+	Unmapped []AxialCoord
+}
+
+// ImportTerrainImage samples img on a lattice of hexes hexSize pixels wide
+// and maps the sampled colors to terrains
+func ImportTerrainImage(img image.Image, hexSize int, mapping TerrainImageMapping) (*TerrainImageImport, error) {
+	if hexSize < 4 {
+		return nil, fmt.Errorf("hex size must be at least 4 pixels, got %d", hexSize)
+	}
+	if len(mapping.Colors) == 0 {
+		return nil, fmt.Errorf("the color mapping is empty")
+	}
+	opts := &RenderOptions{TileWidth: hexSize, TileHeight: hexSize, YIncrement: hexSize * 3 / 4}
+	bounds := img.Bounds()
+	out := &TerrainImageImport{WorldData: &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}}
+
+	// Each row shifts half a hex right, so rows further down start at lower q
+	rows := bounds.Dy()/opts.YIncrement + 1
+	cols := bounds.Dx()/hexSize + 1
+	for r := 0; r <= rows; r++ {
+		for q := -r/2 - 1; q <= cols; q++ {
+			coord := AxialCoord{Q: q, R: r}
+			x, y := HexToPixel(coord, opts)
+			point := image.Pt(bounds.Min.X+x+hexSize/2, bounds.Min.Y+y+hexSize/2)
+			if !point.In(bounds) {
+				continue
+			}
+			sampled := color.NRGBAModel.Convert(img.At(point.X, point.Y)).(color.NRGBA)
+			if sampled.A == 0 {
+				continue
+			}
+			tileType, ok := mapping.terrainFor(sampled)
+			if !ok {
+				out.Unmapped = append(out.Unmapped, coord)
+				tileType = mapping.DefaultTileType
+			}
+			if tileType != 0 {
+				out.WorldData.TilesMap[CoordKeyFromAxial(coord)] = NewTile(coord, int(tileType))
+			}
+		}
+	}
+	if len(out.WorldData.TilesMap) == 0 {
+		return nil, fmt.Errorf("no hexes of the %dx%d image mapped to terrain", bounds.Dx(), bounds.Dy())
+	}
+	return out, nil
+}
+
+// terrainFor returns the terrain of the mapped color nearest to c, if it is
+// within the tolerance
+func (m TerrainImageMapping) terrainFor(c color.NRGBA) (int32, bool) {
+	best, bestDistance := int32(0), math.Inf(1)
+	for _, mapped := range m.Colors {
+		dr := float64(c.R) - float64(mapped.Color.R)
+		dg := float64(c.G) - float64(mapped.Color.G)
+		db := float64(c.B) - float64(mapped.Color.B)
+		if distance := math.Sqrt(dr*dr + dg*dg + db*db); distance < bestDistance {
+			best, bestDistance = mapped.TileType, distance
+		}
+	}
+	return best, bestDistance <= m.Tolerance
+}
+
+// ParseTerrainColors parses a color mapping of the form
+// {"#4caf50": "grass", "#2196f3": 10}, naming each terrain by its ID or by
+// its name in the rules (case insensitive)
+func ParseTerrainColors(data []byte, rulesEngine *RulesEngine) ([]TerrainColor, error) {
+	raw := map[string]any{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid color mapping: %w", err)
+	}
+	var colors []TerrainColor
+	for hex, terrain := range raw {
+		c, err := ParseHexColor(hex)
+		if err != nil {
+			return nil, err
+		}
+		var tileType int32
+		switch t := terrain.(type) {
+		case float64:
+			tileType = int32(t)
+		case string:
+			if tileType, err = TerrainTypeByName(rulesEngine, t); err != nil {
+				return nil, fmt.Errorf("color %s: %w", hex, err)
+			}
+		default:
+			return nil, fmt.Errorf("color %s: terrain must be an ID or a name, got %v", hex, terrain)
+		}
+		if _, err := rulesEngine.GetTerrainData(tileType); err != nil {
+			return nil, fmt.Errorf("color %s: %w", hex, err)
+		}
+		colors = append(colors, TerrainColor{Color: c, TileType: tileType})
+	}
+	// Keep ties between equally near colors deterministic
+	sort.Slice(colors, func(i, j int) bool {
+		a, b := colors[i].Color, colors[j].Color
+		return uint32(a.R)<<16|uint32(a.G)<<8|uint32(a.B) < uint32(b.R)<<16|uint32(b.G)<<8|uint32(b.B)
+	})
+	return colors, nil
+}
+
+// ParseHexColor parses a "#rrggbb" color
+func ParseHexColor(s string) (color.NRGBA, error) {
+	hex := strings.TrimPrefix(s, "#")
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if len(hex) != 6 || err != nil {
+		return color.NRGBA{}, fmt.Errorf("invalid color %q, want #rrggbb", s)
+	}
+	return color.NRGBA{R: uint8(value >> 16), G: uint8(value >> 8), B: uint8(value), A: 255}, nil
+}
+
+// TerrainTypeByName returns the ID of the terrain with the given ID or name
+// (case insensitive)
+func TerrainTypeByName(rulesEngine *RulesEngine, name string) (int32, error) {
+	if id, err := strconv.Atoi(name); err == nil {
+		return int32(id), nil
+	}
+	for id, terrain := range rulesEngine.GetTerrains() {
+		if strings.EqualFold(terrain.Name, name) {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown terrain %q", name)
+}
@@ -0,0 +1,99 @@
+package lib
+
+import (
+	"image"
+	"image/color"
+	"slices"
+	"testing"
+)
+
+// TestImportTerrainImage tests that a small image maps to the exact terrain
+// sampled at each hex center
+func TestImportTerrainImage(t *testing.T) {
+	green := color.NRGBA{R: 0x4c, G: 0xaf, B: 0x50, A: 255}
+	sand := color.NRGBA{R: 0xe0, G: 0xc0, B: 0x70, A: 255}
+	nearSand := color.NRGBA{R: 0xd8, G: 0xc8, B: 0x68, A: 255}
+	red := color.NRGBA{R: 0xff, A: 255}
+
+	// 8 pixel hexes over a 32x20 image put hex centers at y 4, 10 and 16:
+	// row 0 at x 4, 12, 20, 28 (q 0-3), row 1 at x 0, 8, 16, 24 (q -1 to 2)
+	// and row 2 at x 4, 12, 20, 28 (q -1 to 2)
+	img := image.NewNRGBA(image.Rect(0, 0, 32, 20))
+	for y := 0; y < 20; y++ {
+		for x := 0; x < 32; x++ {
+			img.SetNRGBA(x, y, green)
+		}
+	}
+	img.SetNRGBA(12, 4, sand)                // 1,0
+	img.SetNRGBA(16, 10, nearSand)           // 1,1
+	img.SetNRGBA(28, 16, red)                // 2,2
+	img.SetNRGBA(0, 10, color.NRGBA{})       // -1,1 is transparent
+	img.SetNRGBA(13, 4, red)                 // off center, not sampled
+	img.SetNRGBA(4, 16, color.NRGBA{A: 255}) // -1,2 is black
+
+	imported, err := ImportTerrainImage(img, 8, TerrainImageMapping{
+		Colors:          []TerrainColor{{Color: green, TileType: TileTypeGrass}, {Color: sand, TileType: TileTypeDesert}},
+		Tolerance:       DefaultImageColorTolerance,
+		DefaultTileType: TileTypeMountains,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[AxialCoord]int32{
+		{Q: 0, R: 0}: TileTypeGrass, {Q: 1, R: 0}: TileTypeDesert, {Q: 2, R: 0}: TileTypeGrass, {Q: 3, R: 0}: TileTypeGrass,
+		{Q: 0, R: 1}: TileTypeGrass, {Q: 1, R: 1}: TileTypeDesert, {Q: 2, R: 1}: TileTypeGrass,
+		{Q: -1, R: 2}: TileTypeMountains, {Q: 0, R: 2}: TileTypeGrass, {Q: 1, R: 2}: TileTypeGrass, {Q: 2, R: 2}: TileTypeMountains,
+	}
+	tiles := imported.WorldData.TilesMap
+	if len(tiles) != len(want) {
+		t.Errorf("imported %d tiles, want %d", len(tiles), len(want))
+	}
+	for coord, tileType := range want {
+		tile := tiles[CoordKeyFromAxial(coord)]
+		if tile == nil || tile.TileType != tileType {
+			t.Errorf("tile at %v = %v, want terrain %d", coord, tile, tileType)
+		}
+	}
+	if wantUnmapped := []AxialCoord{{Q: -1, R: 2}, {Q: 2, R: 2}}; !slices.Equal(imported.Unmapped, wantUnmapped) {
+		t.Errorf("unmapped hexes = %v, want %v", imported.Unmapped, wantUnmapped)
+	}
+
+	// Without a default terrain unmapped hexes are left off the map
+	imported, err = ImportTerrainImage(img, 8, TerrainImageMapping{
+		Colors:    []TerrainColor{{Color: green, TileType: TileTypeGrass}, {Color: sand, TileType: TileTypeDesert}},
+		Tolerance: DefaultImageColorTolerance,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported.WorldData.TilesMap) != len(want)-2 || len(imported.Unmapped) != 2 {
+		t.Errorf("imported %d tiles with %d unmapped, want %d with 2", len(imported.WorldData.TilesMap), len(imported.Unmapped), len(want)-2)
+	}
+}
+
+// TestParseTerrainColors tests that mappings name terrains by ID or name
+func TestParseTerrainColors(t *testing.T) {
+	rules := DefaultRulesEngine()
+	grass, err := rules.GetTerrainData(TileTypeGrass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	colors, err := ParseTerrainColors([]byte(`{"#00ff00": "`+grass.Name+`", "#0000FF": 10}`), rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []TerrainColor{
+		{Color: color.NRGBA{B: 255, A: 255}, TileType: 10},
+		{Color: color.NRGBA{G: 255, A: 255}, TileType: TileTypeGrass},
+	}
+	if !slices.Equal(colors, want) {
+		t.Errorf("colors = %v, want %v", colors, want)
+	}
+
+	for _, bad := range []string{`{"green": 5}`, `{"#00ff00": "lava lake"}`, `{"#00ff00": true}`, `{"#00ff00": 9999}`} {
+		if _, err := ParseTerrainColors([]byte(bad), rules); err == nil {
+			t.Errorf("mapping %s was accepted", bad)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Mandatory Actions
+// =============================================================================
+//
+// Some rules leave a unit with a follow-up to make before its player's turn
+// can end, like a unit that attacked and still has a retreat to make.  These
+// are the player's obligations.  The rules' mandatory_actions list which
+// kinds must be resolved before ending the turn; the rest are only reminders.
+
+// MandatoryRetreat is the obligation of a unit that attacked to spend its
+// retreat points, when it has a hex to retreat to
+const MandatoryRetreat = "retreat"
+
+// ErrMandatoryActionsPending is returned when ending a turn that still has
+// mandatory obligations, without forcing it
+var ErrMandatoryActionsPending = errors.New("mandatory actions pending")
+
+// GameEventMandatoryActionsSkipped is the event recorded when a player forces
+// their turn to end with mandatory actions pending
+const GameEventMandatoryActionsSkipped = "mandatory_actions_skipped"
+
+// IsActionMandatory returns whether the rules require obligations of this
+// kind to be resolved before ending a turn
+func (re *RulesEngine) IsActionMandatory(kind string) bool {
+	return slices.Contains(re.GetMandatoryActions(), kind)
+}
+
+// MandatoryActions returns the player's unresolved obligations, mandatory or
+// not.  Only the player whose turn it is can have any.
+func (g *Game) MandatoryActions(player int32) []*v1.TurnObligation {
+	if player != g.CurrentPlayer {
+		return nil
+	}
+	var obligations []*v1.TurnObligation
+	for _, unit := range g.World.GetPlayerUnits(int(player)) {
+		if g.hasPendingRetreat(unit) {
+			obligations = append(obligations, &v1.TurnObligation{
+				Kind:        MandatoryRetreat,
+				Unit:        &v1.Position{Label: unit.Shortcut, Q: unit.Q, R: unit.R},
+				Description: fmt.Sprintf("%s at %d,%d has a retreat to make", unitLabel(unit), unit.Q, unit.R),
+				Mandatory:   g.RulesEngine.IsActionMandatory(MandatoryRetreat),
+			})
+		}
+	}
+	return obligations
+}
+
+// hasPendingRetreat returns whether the unit is at the retreat step of its
+// action order this turn, with points left and somewhere to retreat to
+func (g *Game) hasPendingRetreat(unit *v1.Unit) bool {
+	// A unit not yet topped up this turn hasn't acted
+	if unit.LastToppedupTurn < g.TurnCounter || !g.RulesEngine.HasMovementLeft(unit.DistanceLeft) {
+		return false
+	}
+	actionOrder := unitActionOrder(g.progressionUnitDef(unit))
+	if int(unit.ProgressionStep) >= len(actionOrder) || actionOrder[unit.ProgressionStep] != "retreat" {
+		return false
+	}
+	paths, err := g.RulesEngine.GetMovementOptions(g.World, unit, unit.DistanceLeft, false)
+	if err != nil {
+		return false
+	}
+	for _, edge := range paths.Edges {
+		if !edge.IsOccupied {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingMandatoryActions returns the obligations that block the current
+// player from ending their turn
+func (g *Game) pendingMandatoryActions() (pending []*v1.TurnObligation) {
+	for _, obligation := range g.MandatoryActions(g.CurrentPlayer) {
+		if obligation.Mandatory {
+			pending = append(pending, obligation)
+		}
+	}
+	return
+}
+
+// FormatObligations joins the obligations' descriptions for messages
+func FormatObligations(obligations []*v1.TurnObligation) string {
+	descriptions := make([]string, len(obligations))
+	for i, obligation := range obligations {
+		descriptions[i] = obligation.Description
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+func unitLabel(unit *v1.Unit) string {
+	if unit.Shortcut != "" {
+		return unit.Shortcut
+	}
+	return fmt.Sprintf("unit type %d", unit.UnitType)
+}
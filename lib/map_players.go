@@ -0,0 +1,32 @@
+package lib
+
+import "slices"
+
+// GetStartingPlayers returns, in order, the players that have a base or a
+// unit placed on the map
+func (w *World) GetStartingPlayers() []int32 {
+	seen := map[int32]bool{}
+	for _, tile := range w.TilesByCoord() {
+		if tile.Player > 0 {
+			seen[tile.Player] = true
+		}
+	}
+	for _, unit := range w.UnitsByCoord() {
+		if unit.Player > 0 {
+			seen[unit.Player] = true
+		}
+	}
+
+	players := make([]int32, 0, len(seen))
+	for player := range seen {
+		players = append(players, player)
+	}
+	slices.Sort(players)
+	return players
+}
+
+// GetSupportedPlayerCount returns how many players the map supports, ie how
+// many distinct players have a start position (base or unit) on it
+func (w *World) GetSupportedPlayerCount() int {
+	return len(w.GetStartingPlayers())
+}
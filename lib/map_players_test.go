@@ -0,0 +1,30 @@
+package lib
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// TestGetSupportedPlayerCount_FourPlayers tests a map with start positions
+// for four players, some with only a base and some with only units
+func TestGetSupportedPlayerCount_FourPlayers(t *testing.T) {
+	world := NewWorld("four", nil)
+	for q := range 8 {
+		world.SetTileType(AxialCoord{Q: q, R: 0}, TileTypeGrass)
+	}
+	world.AddTile(&v1.Tile{Q: 0, R: 0, TileType: TileTypeLandBase, Player: 1})
+	world.AddTile(&v1.Tile{Q: 2, R: 0, TileType: TileTypeLandBase, Player: 2})
+	world.AddUnit(&v1.Unit{Q: 3, R: 0, Player: 2, UnitType: UnitTypeSoldier})
+	world.AddUnit(&v1.Unit{Q: 5, R: 0, Player: 3, UnitType: UnitTypeSoldier})
+	world.AddTile(&v1.Tile{Q: 7, R: 0, TileType: TileTypeLandBase, Player: 4})
+	world.AddTile(&v1.Tile{Q: 6, R: 0, TileType: TileTypeLandBase}) // Neutral
+
+	if got := world.GetSupportedPlayerCount(); got != 4 {
+		t.Errorf("GetSupportedPlayerCount() = %d, want 4", got)
+	}
+	if got := world.GetStartingPlayers(); !slices.Equal(got, []int32{1, 2, 3, 4}) {
+		t.Errorf("GetStartingPlayers() = %v, want [1 2 3 4]", got)
+	}
+}
@@ -0,0 +1,111 @@
+package lib
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ValidateMapForGame checks that a game for playerCount players can be
+// played on a map: every player has a start position, every base can be
+// reached by some opponent's units, and no unit is placed on terrain its
+// movement class cannot enter.  Returns every problem found, nil if there
+// are none.
+func (re *RulesEngine) ValidateMapForGame(world *World, playerCount int) []error {
+	if playerCount < 1 {
+		return []error{fmt.Errorf("a game needs at least 1 player, got %d", playerCount)}
+	}
+	var errs []error
+
+	starting := world.GetStartingPlayers()
+	for player := int32(1); player <= int32(playerCount); player++ {
+		if !slices.Contains(starting, player) {
+			errs = append(errs, fmt.Errorf("player %d has no start position (base or unit)", player))
+		}
+	}
+
+	for coord, unit := range world.UnitsByCoord() {
+		tile := world.TileAt(coord)
+		if tile == nil {
+			errs = append(errs, fmt.Errorf("player %d's unit at %v is off the map", unit.Player, coord))
+			continue
+		}
+		terrain := re.GetEffectiveTileType(world, coord)
+		if !re.CanUnitEnterTerrain(unit.UnitType, terrain) {
+			errs = append(errs, fmt.Errorf("player %d's %s at %v is on %s, which it cannot enter",
+				unit.Player, re.unitName(unit.UnitType), coord, re.terrainName(terrain)))
+		}
+	}
+
+	if playerCount > 1 {
+		errs = append(errs, re.unreachableBases(world, playerCount)...)
+	}
+	return errs
+}
+
+// unreachableBases returns an error for each base of the first playerCount
+// players that no opposing unit, placed or buildable, could ever reach
+func (re *RulesEngine) unreachableBases(world *World, playerCount int) (errs []error) {
+	r := newReachAnalyzer(&Game{World: world, RulesEngine: re})
+
+	// Where each player's forces start from: their units, and the units each
+	// of their bases can build
+	type force struct {
+		unitType int32
+		from     AxialCoord
+	}
+	forces := map[int32][]force{}
+	var bases []AxialCoord
+	for coord, unit := range world.UnitsByCoord() {
+		forces[unit.Player] = append(forces[unit.Player], force{unit.UnitType, coord})
+	}
+	for coord, tile := range world.TilesByCoord() {
+		if tile.Player < 1 || tile.Player > int32(playerCount) {
+			continue
+		}
+		terrainDef, err := re.GetTerrainData(tile.TileType)
+		if err != nil || len(terrainDef.BuildableUnitIds) == 0 {
+			continue
+		}
+		bases = append(bases, coord)
+		for _, unitType := range terrainDef.BuildableUnitIds {
+			forces[tile.Player] = append(forces[tile.Player], force{unitType, coord})
+		}
+	}
+
+	for _, base := range bases {
+		owner := world.TileAt(base).Player
+		reached := false
+		for player := int32(1); player <= int32(playerCount) && !reached; player++ {
+			if player == owner {
+				continue
+			}
+			for _, f := range forces[player] {
+				if r.reach(f.unitType, f.from)[base] {
+					reached = true
+					break
+				}
+			}
+		}
+		if !reached {
+			errs = append(errs, fmt.Errorf("player %d's %s at %v cannot be reached by any opponent",
+				owner, re.terrainName(world.TileAt(base).TileType), base))
+		}
+	}
+	return errs
+}
+
+// unitName returns a unit type's name for messages
+func (re *RulesEngine) unitName(unitType int32) string {
+	if unitDef, err := re.GetUnitData(unitType); err == nil {
+		return unitDef.Name
+	}
+	return fmt.Sprintf("unit type %d", unitType)
+}
+
+// terrainName returns a terrain's name for messages
+func (re *RulesEngine) terrainName(terrainID int32) string {
+	if terrainDef, err := re.GetTerrainData(terrainID); err == nil {
+		return terrainDef.Name
+	}
+	return fmt.Sprintf("terrain %d", terrainID)
+}
@@ -0,0 +1,76 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestValidateMapForGame tests the problems found on proposed maps
+func TestValidateMapForGame(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		builder     *testGameBuilder
+		playerCount int
+		want        []string
+	}{
+		{
+			name: "valid",
+			builder: newTestGameBuilder().
+				tile(-2, 0, TileTypeLandBase, 1).
+				tile(2, 0, TileTypeLandBase, 2).
+				grassTiles(2).
+				unit(-1, 0, 1, testUnitTypeSoldier).
+				unit(1, 0, 2, testUnitTypeTank),
+			playerCount: 2,
+		},
+		{
+			name: "missing start",
+			builder: newTestGameBuilder().
+				tile(-2, 0, TileTypeLandBase, 1).
+				tile(2, 0, TileTypeLandBase, 2).
+				grassTiles(2),
+			playerCount: 3,
+			want:        []string{"player 3 has no start position"},
+		},
+		{
+			name: "tank on water",
+			builder: newTestGameBuilder().
+				tile(-2, 0, TileTypeLandBase, 1).
+				tile(2, 0, TileTypeLandBase, 2).
+				tile(1, 0, TileTypeWaterRegular, 0).
+				grassTiles(2).
+				unit(1, 0, 2, testUnitTypeTank),
+			playerCount: 2,
+			want:        []string{"player 2's Striker at (1,0) is on Water"},
+		},
+		{
+			// Hovercraft cross water, so the islands have no tiles between them
+			name: "bases on separate islands",
+			builder: newTestGameBuilder().
+				tile(-2, 0, TileTypeLandBase, 1).
+				tile(-1, 0, TileTypeGrass, 0).
+				tile(1, 0, TileTypeGrass, 0).
+				tile(2, 0, TileTypeLandBase, 2),
+			playerCount: 2,
+			want:        []string{"at (-2,0) cannot be reached", "at (2,0) cannot be reached"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			game := tc.builder.build()
+			errs := game.RulesEngine.ValidateMapForGame(game.World, tc.playerCount)
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			all := strings.Join(got, "\n")
+			if len(tc.want) == 0 && len(errs) > 0 {
+				t.Fatalf("valid map has problems:\n%s", all)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(all, want) {
+					t.Errorf("problems do not mention %q:\n%s", want, all)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package lib
+
+import (
+	"fmt"
+	"math/rand"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// RandomMapRadius is the hex radius of the maps generated for each size
+var RandomMapRadius = map[string]int{
+	"small":  5,
+	"medium": 8,
+	"large":  11,
+}
+
+const (
+	MinRandomMapPlayers = 2
+	MaxRandomMapPlayers = 6
+
+	tileTypeMountains = 7
+	tileTypeForest    = 9
+)
+
+// randomMapTerrain is the pool open terrain is drawn from, weighted by repeats
+var randomMapTerrain = []int{
+	TileTypeGrass, TileTypeGrass, TileTypeGrass, TileTypeGrass, TileTypeGrass,
+	tileTypeForest, tileTypeForest,
+	tileTypeMountains,
+	TileTypeDesert,
+	TileTypeWaterShallow,
+}
+
+// ValidateRandomMap checks the parameters of a random map
+func ValidateRandomMap(size string, numPlayers int) error {
+	if _, ok := RandomMapRadius[size]; !ok {
+		return fmt.Errorf("unknown random map size %q", size)
+	}
+	if numPlayers < MinRandomMapPlayers || numPlayers > MaxRandomMapPlayers {
+		return fmt.Errorf("random maps support %d to %d players, got %d", MinRandomMapPlayers, MaxRandomMapPlayers, numPlayers)
+	}
+	return nil
+}
+
+// GenerateRandomMap generates a hex shaped map for numPlayers. The same
+// size, player count and seed always generate the same map. Players start
+// evenly spaced around the edge with a land base and a soldier on it, and
+// an equal number of neutral bases are scattered across the middle.
+func GenerateRandomMap(size string, numPlayers int, seed int64) (*v1.WorldData, error) {
+	if err := ValidateRandomMap(size, numPlayers); err != nil {
+		return nil, err
+	}
+	radius := RandomMapRadius[size]
+	rng := rand.New(rand.NewSource(seed))
+	center := AxialCoord{}
+
+	tiles := map[AxialCoord]int{}
+	for _, coord := range center.Range(radius) {
+		tiles[coord] = randomMapTerrain[rng.Intn(len(randomMapTerrain))]
+	}
+
+	// Starting bases and their surroundings are always open grass
+	ring := center.Ring(radius - 1)
+	starts := make([]AxialCoord, numPlayers)
+	for i := range starts {
+		starts[i] = ring[i*len(ring)/numPlayers]
+		for _, coord := range starts[i].Range(1) {
+			if _, ok := tiles[coord]; ok {
+				tiles[coord] = TileTypeGrass
+			}
+		}
+	}
+
+	// Neutral bases go on the inner tiles so every start is as far from them
+	inner := center.Range(radius - 3)
+	sortCoords(inner)
+	neutral := map[AxialCoord]bool{}
+	for len(neutral) < numPlayers {
+		neutral[inner[rng.Intn(len(inner))]] = true
+	}
+
+	data := &v1.WorldData{
+		TilesMap:  map[string]*v1.Tile{},
+		UnitsMap:  map[string]*v1.Unit{},
+		Crossings: map[string]*v1.Crossing{},
+	}
+	for coord, tileType := range tiles {
+		if neutral[coord] {
+			tileType = TileTypeLandBase
+		}
+		data.TilesMap[CoordKeyFromAxial(coord)] = NewTile(coord, tileType)
+	}
+	for i, coord := range starts {
+		player := i + 1
+		base := NewTile(coord, TileTypeLandBase)
+		base.Player = int32(player)
+		data.TilesMap[CoordKeyFromAxial(coord)] = base
+		data.UnitsMap[CoordKeyFromAxial(coord)] = NewUnit(UnitTypeSoldier, player, coord)
+	}
+	return data, nil
+}
+
+// RandomMapLabel describes a random map without giving the map away, eg
+// "random map (small, 2 players)"
+func RandomMapLabel(m *v1.RandomMap) string {
+	return fmt.Sprintf("random map (%s, %d players)", m.GetSize(), m.GetNumPlayers())
+}
@@ -0,0 +1,48 @@
+package lib
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// TestGenerateRandomMap_Deterministic tests the same seed generates the same map
+func TestGenerateRandomMap_Deterministic(t *testing.T) {
+	first, err := GenerateRandomMap("small", 2, 42)
+	if err != nil {
+		t.Fatalf("GenerateRandomMap failed: %v", err)
+	}
+	second, _ := GenerateRandomMap("small", 2, 42)
+	if !proto.Equal(first, second) {
+		t.Error("Same seed should generate the same map")
+	}
+	other, _ := GenerateRandomMap("small", 2, 43)
+	if proto.Equal(first, other) {
+		t.Error("Different seeds should generate different maps")
+	}
+}
+
+// TestGenerateRandomMap_StartPositions tests every player gets a base and a soldier
+func TestGenerateRandomMap_StartPositions(t *testing.T) {
+	data, err := GenerateRandomMap("medium", 4, 7)
+	if err != nil {
+		t.Fatalf("GenerateRandomMap failed: %v", err)
+	}
+	world := NewWorld("random", data)
+	if got := world.GetSupportedPlayerCount(); got != 4 {
+		t.Errorf("Expected 4 players on the map, got %d", got)
+	}
+	for _, unit := range world.UnitsByCoord() {
+		tile := world.TileAt(UnitGetCoord(unit))
+		if tile.TileType != TileTypeLandBase || tile.Player != unit.Player {
+			t.Errorf("Player %d's soldier should start on their own base", unit.Player)
+		}
+	}
+
+	if _, err := GenerateRandomMap("huge", 2, 1); err == nil {
+		t.Error("Unknown sizes should be rejected")
+	}
+	if _, err := GenerateRandomMap("small", 1, 1); err == nil {
+		t.Error("Single player maps should be rejected")
+	}
+}
@@ -0,0 +1,204 @@
+package lib
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Move Resolution Debugging
+// =============================================================================
+//
+// Moves name units and hexes by shortcut, coordinates, row/col or direction
+// chains, all resolved by the server against the position at the time.
+// When a move does something other than what was typed, its resolution
+// shows what each position turned into: the coordinates, the unit found
+// there and the option at the source the move matched.
+
+// ProcessMovesResolved processes moves like ProcessMoves, also returning how
+// each move was resolved up to and including any move that failed
+func (g *Game) ProcessMovesResolved(moves []*v1.GameMove) ([]*v1.MoveResolution, error) {
+	var resolutions []*v1.MoveResolution
+	for i, move := range moves {
+		resolution := g.ResolveMove(move)
+		resolution.MoveIndex = int32(i)
+		resolutions = append(resolutions, resolution)
+		if err := g.ProcessMove(move); err != nil {
+			resolution.Error = err.Error()
+			return resolutions, err
+		}
+	}
+	return resolutions, nil
+}
+
+// ResolveMove reports how the move's positions resolve against the current
+// position, without making the move or changing it
+func (g *Game) ResolveMove(move *v1.GameMove) *v1.MoveResolution {
+	resolution := &v1.MoveResolution{}
+	source, target, relative := movePositions(move)
+	if source == nil {
+		return resolution
+	}
+	resolution.Source = g.resolvePosition(source, nil)
+	if !resolution.Source.Resolved {
+		return resolution
+	}
+	sourceCoord := CoordFromInt32(resolution.Source.Q, resolution.Source.R)
+
+	var targetCoord *AxialCoord
+	if target != nil {
+		var base *AxialCoord
+		if relative {
+			base = &sourceCoord
+		}
+		resolution.Target = g.resolvePosition(target, base)
+		if resolution.Target.Resolved {
+			coord := CoordFromInt32(resolution.Target.Q, resolution.Target.R)
+			targetCoord = &coord
+		}
+	}
+
+	options, err := g.GetOptionsAt(fmt.Sprintf("%d,%d", sourceCoord.Q, sourceCoord.R))
+	if err != nil {
+		return resolution
+	}
+	for _, option := range options.Options {
+		if optionMatches(option, move, targetCoord) {
+			resolution.MatchedOption = option
+			break
+		}
+	}
+	return resolution
+}
+
+// movePositions returns the position a move acts from and the one it acts
+// on, if any, and whether directions in the latter are relative to the
+// former
+func movePositions(move *v1.GameMove) (source, target *v1.Position, relative bool) {
+	switch a := move.MoveType.(type) {
+	case *v1.GameMove_MoveUnit:
+		return a.MoveUnit.From, a.MoveUnit.To, true
+	case *v1.GameMove_AttackUnit:
+		return a.AttackUnit.Attacker, a.AttackUnit.Defender, false
+	case *v1.GameMove_FixUnit:
+		return a.FixUnit.Fixer, a.FixUnit.Target, true
+	case *v1.GameMove_ConstructTerrain:
+		return a.ConstructTerrain.Pos, a.ConstructTerrain.Target, true
+	case *v1.GameMove_BuildUnit:
+		return a.BuildUnit.Pos, nil, false
+	case *v1.GameMove_CaptureBuilding:
+		return a.CaptureBuilding.Pos, nil, false
+	case *v1.GameMove_HealUnit:
+		return a.HealUnit.Pos, nil, false
+	case *v1.GameMove_SubmergeUnit:
+		return a.SubmergeUnit.Pos, nil, false
+	case *v1.GameMove_TransformUnit:
+		return a.TransformUnit.Pos, nil, false
+	}
+	return nil, nil, false
+}
+
+// resolvePosition resolves a copy of pos, leaving pos as it was
+func (g *Game) resolvePosition(pos *v1.Position, base *AxialCoord) *v1.ResolvedPosition {
+	if pos == nil {
+		return &v1.ResolvedPosition{Error: "position is missing"}
+	}
+	resolved := &v1.ResolvedPosition{Input: pos.Label}
+	if pos.Label == "" {
+		resolved.Input = fmt.Sprintf("%d,%d", pos.Q, pos.R)
+	}
+	coord, err := g.FromPosWithBase(proto.Clone(pos).(*v1.Position), base)
+	if err != nil {
+		resolved.Error = err.Error()
+		return resolved
+	}
+	resolved.Resolved, resolved.Q, resolved.R = true, int32(coord.Q), int32(coord.R)
+	if unit := g.World.UnitAt(coord); unit != nil {
+		resolved.UnitId, resolved.UnitShortcut, resolved.UnitPlayer = unit.Id, unit.Shortcut, unit.Player
+	}
+	return resolved
+}
+
+// optionMatches reports whether the option is the move, given where the
+// move's target resolved to
+func optionMatches(option *v1.GameOption, move *v1.GameMove, target *AxialCoord) bool {
+	atTarget := func(pos *v1.Position) bool {
+		return target != nil && pos != nil && CoordFromInt32(pos.Q, pos.R) == *target
+	}
+	switch o := option.OptionType.(type) {
+	case *v1.GameOption_Move:
+		return move.GetMoveUnit() != nil && atTarget(o.Move.To)
+	case *v1.GameOption_Attack:
+		return move.GetAttackUnit() != nil && atTarget(o.Attack.Defender)
+	case *v1.GameOption_Construct:
+		construct := move.GetConstructTerrain()
+		return construct != nil && construct.TargetTerrain == o.Construct.TargetTerrain && atTarget(o.Construct.Target)
+	case *v1.GameOption_Build:
+		return move.GetBuildUnit() != nil && move.GetBuildUnit().UnitType == o.Build.UnitType
+	case *v1.GameOption_Capture:
+		return move.GetCaptureBuilding() != nil
+	case *v1.GameOption_Heal:
+		return move.GetHealUnit() != nil
+	case *v1.GameOption_Submerge:
+		return move.GetSubmergeUnit() != nil
+	}
+	return false
+}
+
+// FormatMoveResolution describes how a move was resolved on one line
+func FormatMoveResolution(resolution *v1.MoveResolution) string {
+	parts := []string{fmt.Sprintf("move %d", resolution.MoveIndex+1)}
+	if resolution.Source != nil {
+		parts = append(parts, "source "+formatResolvedPosition(resolution.Source))
+	}
+	if resolution.Target != nil {
+		parts = append(parts, "target "+formatResolvedPosition(resolution.Target))
+	}
+	if option := resolution.MatchedOption; option != nil {
+		parts = append(parts, "matched "+optionKind(option)+" option")
+	} else if resolution.Source.GetResolved() {
+		parts = append(parts, "matched no option")
+	}
+	if resolution.Error != "" {
+		parts = append(parts, "failed: "+resolution.Error)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// formatResolvedPosition describes what a position resolved to
+func formatResolvedPosition(pos *v1.ResolvedPosition) string {
+	if !pos.Resolved {
+		return fmt.Sprintf("%q did not resolve: %s", pos.Input, pos.Error)
+	}
+	out := fmt.Sprintf("%q -> %d,%d", pos.Input, pos.Q, pos.R)
+	if pos.UnitShortcut != "" || pos.UnitId != 0 {
+		out += fmt.Sprintf(" (unit %s, id %d, player %d)", pos.UnitShortcut, pos.UnitId, pos.UnitPlayer)
+	}
+	return out
+}
+
+// optionKind names the kind of action an option is
+func optionKind(option *v1.GameOption) string {
+	switch option.OptionType.(type) {
+	case *v1.GameOption_Move:
+		return "move"
+	case *v1.GameOption_Attack:
+		return "attack"
+	case *v1.GameOption_Build:
+		return "build"
+	case *v1.GameOption_Capture:
+		return "capture"
+	case *v1.GameOption_EndTurn:
+		return "end turn"
+	case *v1.GameOption_Heal:
+		return "heal"
+	case *v1.GameOption_Construct:
+		return "construct"
+	case *v1.GameOption_Submerge:
+		return "submerge"
+	}
+	return "unknown"
+}
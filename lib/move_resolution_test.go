@@ -0,0 +1,123 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// TestResolveMove_Inputs tests what shortcut, direction chain and row/col
+// positions resolve to, and that resolving leaves the move as typed
+func TestResolveMove_Inputs(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(4).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	soldier := game.World.UnitAt(AxialCoord{})
+	rowCol := RowColToHex(1, 1, UseEvenRowOffsetCoords)
+
+	tests := []struct {
+		name       string
+		from, to   string
+		wantTarget AxialCoord
+	}{
+		{"coordinates", "A1", "1,0", AxialCoord{Q: 1, R: 0}},
+		{"direction chain", "A1", "TL,TL", AxialCoord{}.Neighbor(mustDirection(t, "TL")).Neighbor(mustDirection(t, "TL"))},
+		{"row/col", "A1", "r1,1", rowCol},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			move := &v1.GameMove{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Label: tc.from},
+				To:   &v1.Position{Label: tc.to},
+			}}}
+			resolution := game.ResolveMove(move)
+
+			source := resolution.Source
+			if !source.Resolved || source.Q != 0 || source.R != 0 || source.UnitId != soldier.Id || source.UnitShortcut != "A1" {
+				t.Errorf("source = %v, want A1 at 0,0", source)
+			}
+			target := resolution.Target
+			if !target.Resolved || CoordFromInt32(target.Q, target.R) != tc.wantTarget || target.Input != tc.to {
+				t.Errorf("target = %v, want %q at %v", target, tc.to, tc.wantTarget)
+			}
+			if to := resolution.MatchedOption.GetMove().GetTo(); to == nil || CoordFromInt32(to.Q, to.R) != tc.wantTarget {
+				t.Errorf("matched option = %v, want the move to %v", resolution.MatchedOption, tc.wantTarget)
+			}
+			if pos := move.GetMoveUnit().To; pos.Q != 0 || pos.R != 0 {
+				t.Errorf("resolving changed the move's target to %d,%d", pos.Q, pos.R)
+			}
+		})
+	}
+}
+
+// TestResolveMove_StaleShortcut tests that a shortcut naming no unit left on
+// the board resolves to nothing, and the failure carries the resolution
+func TestResolveMove_StaleShortcut(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+
+	move := &v1.GameMove{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+		From: &v1.Position{Label: "A2"},
+		To:   &v1.Position{Label: "R"},
+	}}}
+	resolutions, err := game.ProcessMovesResolved([]*v1.GameMove{move})
+	if err == nil {
+		t.Fatal("moving a unit that is gone succeeded")
+	}
+	if len(resolutions) != 1 {
+		t.Fatalf("got %d resolutions, want 1", len(resolutions))
+	}
+	resolution := resolutions[0]
+	if resolution.Source.Resolved || resolution.Source.Error == "" || resolution.Source.Input != "A2" {
+		t.Errorf("source = %v, want A2 unresolved with a reason", resolution.Source)
+	}
+	if resolution.Target != nil || resolution.MatchedOption != nil || resolution.Error == "" {
+		t.Errorf("resolution = %v, want no target or option and the move's error", resolution)
+	}
+	if got := FormatMoveResolution(resolution); !strings.Contains(got, `"A2" did not resolve`) {
+		t.Errorf("FormatMoveResolution = %q, want it to say A2 did not resolve", got)
+	}
+}
+
+// TestProcessMovesResolved_AgainstEachMovesPosition tests that each move
+// resolves against the position left by the moves before it
+func TestProcessMovesResolved_AgainstEachMovesPosition(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(3, -1, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+
+	moves := []*v1.GameMove{
+		{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{From: &v1.Position{Label: "A1"}, To: &v1.Position{Label: "2,-1"}}}},
+		{MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{Attacker: &v1.Position{Label: "A1"}, Defender: &v1.Position{Label: "B1"}}}},
+	}
+	resolutions, err := game.ProcessMovesResolved(moves)
+	if err != nil {
+		t.Fatalf("ProcessMovesResolved failed: %v", err)
+	}
+	attack := resolutions[1]
+	if attack.MoveIndex != 1 || attack.Source.Q != 2 || attack.Source.R != -1 {
+		t.Errorf("attack source = %v, want A1 where it moved to, 2,-1", attack.Source)
+	}
+	if attack.Target.UnitShortcut != "B1" || attack.Target.UnitPlayer != 2 || attack.MatchedOption.GetAttack() == nil {
+		t.Errorf("attack resolution = %v, want B1 matching an attack option", attack)
+	}
+}
+
+func mustDirection(t *testing.T, name string) NeighborDirection {
+	t.Helper()
+	dir, err := ParseDirection(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
@@ -0,0 +1,53 @@
+package lib
+
+import (
+	"math"
+	"strconv"
+)
+
+// =============================================================================
+// Movement Points
+// =============================================================================
+//
+// Movement points are counted in whole quarters of a point.  Terrain costs
+// like 1.25 and budgets like 2.5 are exact in quarters, so reachability, move
+// validation and top-up always agree on what a unit can afford, however the
+// float it came in as was rounded on its way.  Floats are only used at the
+// edges, in protos and rules files, and are converted with the helpers here.
+
+// MovementQuartersPerPoint is how many quarters a movement point is counted in
+const MovementQuartersPerPoint = 4
+
+// MovementQuarters converts movement points to the nearest whole quarter
+func (re *RulesEngine) MovementQuarters(points float64) int {
+	return int(math.Round(points * MovementQuartersPerPoint))
+}
+
+// MovementPoints converts quarters back to movement points
+func (re *RulesEngine) MovementPoints(quarters int) float64 {
+	return float64(quarters) / MovementQuartersPerPoint
+}
+
+// RoundMovementPoints rounds movement points to the nearest quarter
+func (re *RulesEngine) RoundMovementPoints(points float64) float64 {
+	return re.MovementPoints(re.MovementQuarters(points))
+}
+
+// HasMovementLeft reports whether the unit has at least a quarter of a
+// movement point left
+func (re *RulesEngine) HasMovementLeft(distanceLeft float64) bool {
+	return re.MovementQuarters(distanceLeft) > 0
+}
+
+// SpendMovement returns what is left of distanceLeft after spending cost,
+// rounded to a quarter
+func (re *RulesEngine) SpendMovement(distanceLeft, cost float64) float64 {
+	return re.MovementPoints(re.MovementQuarters(distanceLeft) - re.MovementQuarters(cost))
+}
+
+// FormatMovementPoints formats movement points for display, rounded to a
+// quarter and without trailing zeros: "2", "2.5", "1.25"
+func FormatMovementPoints(points float64) string {
+	quarters := math.Round(points * MovementQuartersPerPoint)
+	return strconv.FormatFloat(quarters/MovementQuartersPerPoint, 'f', -1, 64)
+}
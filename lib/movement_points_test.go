@@ -0,0 +1,103 @@
+package lib
+
+import (
+	"math/rand"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+func TestMovementQuarters(t *testing.T) {
+	re := DefaultRulesEngine()
+	for _, tc := range []struct {
+		points float64
+		want   int
+	}{
+		{0, 0},
+		{0.25, 1},
+		{0.2499999, 1},
+		{0.2500001, 1},
+		{1.75, 7},
+		{2.5 - 1e-12, 10},
+		{0.1, 0},
+		{0.13, 1},
+	} {
+		if got := re.MovementQuarters(tc.points); got != tc.want {
+			t.Errorf("MovementQuarters(%v) = %d, want %d", tc.points, got, tc.want)
+		}
+	}
+	if re.HasMovementLeft(0.0000001) {
+		t.Error("a unit with a rounding error of movement left can still move")
+	}
+	if got := re.SpendMovement(3, 1.25); got != 1.75 {
+		t.Errorf("SpendMovement(3, 1.25) = %v, want 1.75", got)
+	}
+}
+
+func TestFormatMovementPoints(t *testing.T) {
+	for points, want := range map[float64]string{
+		0:         "0",
+		2:         "2",
+		2.5:       "2.5",
+		1.25:      "1.25",
+		0.2499999: "0.25",
+		2.9999999: "3",
+	} {
+		if got := FormatMovementPoints(points); got != want {
+			t.Errorf("FormatMovementPoints(%v) = %q, want %q", points, got, want)
+		}
+	}
+}
+
+// TestMoveOptions_AlwaysAccepted tests that every move GetOptionsAt offers
+// is accepted by ProcessMove, over random maps with fractional terrain costs
+// and random budgets carrying float rounding errors
+func TestMoveOptions_AlwaysAccepted(t *testing.T) {
+	terrains := []int32{TileTypeGrass, TileTypeDesert, testTileTypeForest}
+	noises := []float64{0, 1e-7, -1e-7, 1e-12, -1e-12}
+	offers := 0
+
+	for seed := int64(1); seed <= 200; seed++ {
+		rng := rand.New(rand.NewSource(seed))
+		builder := newTestGameBuilder()
+		for q := -3; q <= 3; q++ {
+			for r := -3; r <= 3; r++ {
+				builder.tile(q, r, terrains[rng.Intn(len(terrains))], 0)
+			}
+		}
+		builder.unit(0, 0, 1, testUnitTypeSoldier).currentPlayer(1)
+		budget := float64(rng.Intn(13))/MovementQuartersPerPoint + noises[rng.Intn(len(noises))]
+		newGame := func() *Game {
+			game := builder.build()
+			unit := game.World.UnitAt(AxialCoord{})
+			unit.DistanceLeft = budget
+			unit.LastToppedupTurn = game.TurnCounter
+			return game
+		}
+
+		game := newGame()
+		options, _, err := game.GetUnitOptions(game.World.UnitAt(AxialCoord{}))
+		if err != nil {
+			t.Fatalf("seed %d: GetUnitOptions failed: %v", seed, err)
+		}
+		for _, option := range options {
+			offered := option.GetMove()
+			if offered == nil {
+				continue
+			}
+			offers++
+			game := newGame()
+			move := &v1.GameMove{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Q: 0, R: 0},
+				To:   &v1.Position{Q: offered.To.Q, R: offered.To.R},
+			}}}
+			if err := game.ProcessMove(move); err != nil {
+				t.Errorf("seed %d: move to %d,%d with %v points offered but rejected: %v",
+					seed, offered.To.Q, offered.To.R, budget, err)
+			}
+		}
+	}
+	if offers == 0 {
+		t.Fatal("no moves were offered")
+	}
+}
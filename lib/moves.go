@@ -1,6 +1,7 @@
 package lib
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -44,7 +45,10 @@ func copyUnit(unit *v1.Unit) *v1.Unit {
 		AttackHistory:           attackHistory,
 		ProgressionStep:         unit.ProgressionStep,
 		ChosenAlternative:       unit.ChosenAlternative,
+		StepActions:             unit.StepActions,
 		CaptureStartedTurn:      unit.CaptureStartedTurn,
+		Submerged:               unit.Submerged,
+		Id:                      unit.Id,
 	}
 }
 
@@ -77,6 +81,29 @@ func (g *Game) ProcessMove(move *v1.GameMove) (err error) {
 	move.SequenceNum = 0 // TODO: Set proper sequence number
 	move.Changes = []*v1.WorldChange{}
 
+	// Only draft turns can be taken until the draft is over
+	if _, drafting := move.MoveType.(*v1.GameMove_DraftUnit); g.IsDrafting() && !drafting {
+		return fmt.Errorf("the game is still drafting")
+	}
+	if g.GameState.Status == v1.GameStatus_GAME_STATUS_WAITING {
+		return fmt.Errorf("the game is waiting for players to join")
+	}
+	// Simultaneous turns are only played by submitting plans
+	if _, planning := move.MoveType.(*v1.GameMove_SubmitPlan); g.IsPlanning() && !planning {
+		return fmt.Errorf("moves in a simultaneous turns game are submitted as a plan")
+	}
+
+	player, turnCounter := g.CurrentPlayer, g.TurnCounter
+	defer func() {
+		if err == nil {
+			g.checkPuzzle(move, player, turnCounter)
+			g.recordChanges(player, turnCounter, move)
+		}
+	}()
+
+	// Sandboxes may let moves be made as the owner of the acting unit or base
+	defer g.actAs(g.moveActor(move))()
+
 	switch a := move.MoveType.(type) {
 	case *v1.GameMove_MoveUnit:
 		return g.ProcessMoveUnit(move, a.MoveUnit, false)
@@ -90,8 +117,24 @@ func (g *Game) ProcessMove(move *v1.GameMove) (err error) {
 		return g.ProcessHealUnit(move, a.HealUnit)
 	case *v1.GameMove_FixUnit:
 		return g.ProcessFixUnit(move, a.FixUnit)
+	case *v1.GameMove_ConstructTerrain:
+		return g.ProcessConstructTerrain(move, a.ConstructTerrain)
+	case *v1.GameMove_SubmergeUnit:
+		return g.ProcessSubmergeUnit(move, a.SubmergeUnit)
+	case *v1.GameMove_DelegateTurn:
+		return g.ProcessDelegateTurn(move, a.DelegateTurn)
+	case *v1.GameMove_DraftUnit:
+		return g.ProcessDraftUnit(move, a.DraftUnit)
+	case *v1.GameMove_TransformUnit:
+		return g.ProcessTransformUnit(move, a.TransformUnit)
 	case *v1.GameMove_EndTurn:
 		return g.ProcessEndTurn(move, a.EndTurn)
+	case *v1.GameMove_SubmitPlan:
+		return g.ProcessSubmitPlan(move, a.SubmitPlan)
+	case *v1.GameMove_SpawnUnit:
+		return g.ProcessSpawnUnit(move, a.SpawnUnit)
+	case *v1.GameMove_DeleteUnit:
+		return g.ProcessDeleteUnit(move, a.DeleteUnit)
 	default:
 		return fmt.Errorf("unknown move type: %T", move.MoveType)
 	}
@@ -154,16 +197,28 @@ func (g *Game) ProcessBuildUnit(move *v1.GameMove, action *v1.BuildUnitAction) (
 		}
 	}
 
+	// Check the draft hasn't banned the unit type or given it to another player
+	if !DraftAllows(g.GameState.Draft, g.CurrentPlayer, action.UnitType) {
+		return fmt.Errorf("unit type %d was drafted away in this game", action.UnitType)
+	}
+
+	if err := g.checkUnitCap(g.CurrentPlayer); err != nil {
+		return err
+	}
+
 	// Check if tile has already built this turn (one build per turn per tile)
 	if tile.LastActedTurn == g.TurnCounter {
 		return fmt.Errorf("tile at %v has already built a unit this turn", coord)
 	}
 
 	// Check if there's already a unit at this position
-	existingUnit := g.World.UnitAt(coord)
+	existingUnit := g.RulesEngine.OccupantAt(g.World, coord)
 	if existingUnit != nil {
 		return fmt.Errorf("cannot build unit at %v: position already occupied by unit %s", coord, existingUnit.Shortcut)
 	}
+	if g.RulesEngine.footprintBlocked(g.World, action.UnitType, coord, LEFT, nil) {
+		return fmt.Errorf("cannot build unit at %v: no room for its footprint", coord)
+	}
 
 	// Get unit definition for cost validation
 	unitData, err := g.RulesEngine.GetUnitData(action.UnitType)
@@ -177,13 +232,14 @@ func (g *Game) ProcessBuildUnit(move *v1.GameMove, action *v1.BuildUnitAction) (
 		return fmt.Errorf("player state not found for player %d", g.CurrentPlayer)
 	}
 	playerCoins := playerState.Coins
+	cost := g.coinCost(unitData.Coins)
 
-	if playerCoins < unitData.Coins {
-		return fmt.Errorf("insufficient coins: need %d, have %d", unitData.Coins, playerCoins)
+	if playerCoins < cost {
+		return fmt.Errorf("insufficient coins: need %d, have %d", cost, playerCoins)
 	}
 
 	// Deduct coins from player (in GameState.PlayerStates)
-	playerState.Coins -= unitData.Coins
+	playerState.Coins -= cost
 
 	// Generate a shortcut for the new unit
 	newShortcut := g.World.GenerateUnitShortcut(g.CurrentPlayer)
@@ -195,6 +251,7 @@ func (g *Game) ProcessBuildUnit(move *v1.GameMove, action *v1.BuildUnitAction) (
 		Player:           g.CurrentPlayer,
 		UnitType:         action.UnitType,
 		Shortcut:         newShortcut,
+		Id:               g.nextUnitID(),
 		AvailableHealth:  unitData.Health,
 		DistanceLeft:     0, // Newly built units cannot move this turn
 		LastActedTurn:    g.TurnCounter,
@@ -218,8 +275,8 @@ func (g *Game) ProcessBuildUnit(move *v1.GameMove, action *v1.BuildUnitAction) (
 				Unit:        copyUnit(newUnit),
 				TileQ:       tile.Q,
 				TileR:       tile.R,
-				CoinsCost:   unitData.Coins,
-				PlayerCoins: playerCoins - unitData.Coins,
+				CoinsCost:   cost,
+				PlayerCoins: playerCoins - cost,
 			},
 		},
 	}
@@ -231,7 +288,7 @@ func (g *Game) ProcessBuildUnit(move *v1.GameMove, action *v1.BuildUnitAction) (
 			CoinsChanged: &v1.CoinsChangedChange{
 				PlayerId:      g.CurrentPlayer,
 				PreviousCoins: playerCoins,
-				NewCoins:      playerCoins - unitData.Coins,
+				NewCoins:      playerCoins - cost,
 				Reason:        "build",
 			},
 		},
@@ -266,6 +323,12 @@ func (g *Game) ProcessCaptureBuilding(move *v1.GameMove, action *v1.CaptureBuild
 		return fmt.Errorf("failed to top-up unit: %w", err)
 	}
 
+	unitDef := g.progressionUnitDef(unit)
+	step, err := g.checkActionAllowed(unit, unitDef, "capture")
+	if err != nil {
+		return err
+	}
+
 	// Get the tile at the position
 	tile := g.World.TileAt(coord)
 	if tile == nil {
@@ -295,25 +358,7 @@ func (g *Game) ProcessCaptureBuilding(move *v1.GameMove, action *v1.CaptureBuild
 	unit.CaptureStartedTurn = g.TurnCounter
 
 	// Update progression: record chosen alternative and advance step
-	unitDef, err := g.RulesEngine.GetUnitData(unit.UnitType)
-	if err == nil && unitDef != nil {
-		actionOrder := unitDef.ActionOrder
-		if len(actionOrder) == 0 {
-			actionOrder = []string{"move", "attack|capture"}
-		}
-
-		// If current step has pipe-separated alternatives, record the choice
-		if int(unit.ProgressionStep) < len(actionOrder) {
-			stepActions := actionOrder[unit.ProgressionStep]
-			if strings.Contains(stepActions, "|") {
-				unit.ChosenAlternative = "capture"
-			}
-		}
-
-		// Advance to next step (capture action consumed)
-		unit.ProgressionStep++
-		unit.ChosenAlternative = "" // Clear for next step
-	}
+	g.RulesEngine.AdvanceProgression(unit, unitDef, "capture", step)
 
 	// Update timestamp
 	g.GameState.UpdatedAt = tspb.New(time.Now())
@@ -349,7 +394,9 @@ func (g *Game) ProcessCaptureBuilding(move *v1.GameMove, action *v1.CaptureBuild
 	return nil
 }
 
-// ProcessHealUnit executes manual healing for a unit
+// ProcessHealUnit spends a unit's turn healing on friendly supply terrain.
+// The amount comes from the rules' healing bonus for the terrain; any amount
+// in the action is ignored.
 func (g *Game) ProcessHealUnit(move *v1.GameMove, action *v1.HealUnitAction) (err error) {
 	// Parse position
 	coord, err := g.FromPos(action.Pos)
@@ -368,42 +415,34 @@ func (g *Game) ProcessHealUnit(move *v1.GameMove, action *v1.HealUnitAction) (er
 		return fmt.Errorf("unit belongs to player %d, not current player %d", unit.Player, g.CurrentPlayer)
 	}
 
+	// Apply lazy top-up pattern
+	if err := g.TopUpUnitIfNeeded(unit); err != nil {
+		return fmt.Errorf("failed to top-up unit: %w", err)
+	}
+
 	// Get unit definition
 	unitData, err := g.RulesEngine.GetUnitData(unit.UnitType)
 	if err != nil {
 		return fmt.Errorf("failed to get unit data: %w", err)
 	}
 
-	// Check if unit is already at max health
-	if unit.AvailableHealth >= unitData.Health {
-		return fmt.Errorf("unit already at max health")
-	}
-
-	// Calculate heal amount (use the value from action, or calculate if not provided)
-	healAmount := action.HealAmount
-	if healAmount <= 0 {
-		healAmount = g.calculateHealAmount(unit, unitData)
-		if healAmount <= 0 {
-			return fmt.Errorf("unit cannot heal on this terrain")
-		}
+	healAmount, err := g.activeHealAmount(unit, unitData)
+	if err != nil {
+		return err
 	}
 
 	// Capture previous state
 	previousUnit := copyUnit(unit)
 
 	// Apply healing
-	newHealth := unit.AvailableHealth + healAmount
-	if newHealth > unitData.Health {
-		newHealth = unitData.Health
-	}
-	unit.AvailableHealth = newHealth
+	unit.AvailableHealth += healAmount
 
-	// Mark unit as having acted this turn (so it doesn't auto-heal next turn)
+	// Healing uses up the unit's turn (and so it doesn't auto-heal next turn)
 	unit.LastActedTurn = g.TurnCounter
-
-	// Advance progression (healing counts as an action)
-	unit.ProgressionStep++
+	unit.DistanceLeft = 0
+	unit.ProgressionStep = int32(len(unitActionOrder(unitData)))
 	unit.ChosenAlternative = ""
+	unit.StepActions = 0
 
 	// Capture updated state
 	updatedUnit := copyUnit(unit)
@@ -483,6 +522,11 @@ func (g *Game) ProcessFixUnit(move *v1.GameMove, action *v1.FixUnitAction) (err
 		return fmt.Errorf("unit type %s cannot fix other units", fixerData.Name)
 	}
 
+	step, err := g.checkActionAllowed(fixer, fixerData, "fix")
+	if err != nil {
+		return err
+	}
+
 	// Verify fixer can fix this target type (terrain compatibility)
 	canFix, err := g.RulesEngine.CanUnitFixTarget(fixer, target)
 	if err != nil {
@@ -528,22 +572,7 @@ func (g *Game) ProcessFixUnit(move *v1.GameMove, action *v1.FixUnitAction) (err
 	target.AvailableHealth += fixAmount
 
 	// Update progression: record chosen alternative and advance step
-	actionOrder := fixerData.ActionOrder
-	if len(actionOrder) == 0 {
-		actionOrder = []string{"move", "attack|capture"}
-	}
-
-	// If current step has pipe-separated alternatives, record the choice
-	if int(fixer.ProgressionStep) < len(actionOrder) {
-		stepActions := actionOrder[fixer.ProgressionStep]
-		if strings.Contains(stepActions, "|") {
-			fixer.ChosenAlternative = "fix"
-		}
-	}
-
-	// Advance to next step (fix action consumed)
-	fixer.ProgressionStep++
-	fixer.ChosenAlternative = "" // Clear for next step
+	g.RulesEngine.AdvanceProgression(fixer, fixerData, "fix", step)
 
 	// Mark fixer as having acted this turn
 	fixer.LastActedTurn = g.TurnCounter
@@ -605,20 +634,36 @@ func (g *Game) calculateFixAmount(fixer *v1.Unit, fixerData *v1.UnitDefinition)
 }
 
 // ProcessEndTurn advances to next player's turn.
-// For now a player can just end turn but in other games there may be some mandatory
-// moves left.
+// Fails while the rules' mandatory actions are pending, unless forced, in
+// which case the skipped obligations are recorded as a game event.
 func (g *Game) ProcessEndTurn(move *v1.GameMove, action *v1.EndTurnAction) (err error) {
+	if err := CheckEndTurnEpoch(action, g.GameState); err != nil {
+		return err
+	}
+	if pending := g.pendingMandatoryActions(); len(pending) > 0 {
+		if !action.GetForce() {
+			return fmt.Errorf("%w: %s", ErrMandatoryActionsPending, FormatObligations(pending))
+		}
+		move.Changes = append(move.Changes, &v1.WorldChange{
+			ChangeType: &v1.WorldChange_GameEvent{
+				GameEvent: &v1.GameEventChange{
+					EventType:      GameEventMandatoryActionsSkipped,
+					PlayerId:       g.CurrentPlayer,
+					Description:    fmt.Sprintf("Player %d ended their turn skipping: %s", g.CurrentPlayer, FormatObligations(pending)),
+					SkippedActions: pending,
+				},
+			},
+		})
+	}
+
 	// Store previous state for GameLog
 	// TODO - use a pushed world at ProcessMoves level instead of g.World each time
 	previousPlayer := g.CurrentPlayer
 	previousTurn := g.TurnCounter
 
 	// Calculate income for ending player based on bases owned and their types
-	// Use IncomeConfig from game configuration if available
-	var incomeConfig *v1.IncomeConfig
-	if g.Config != nil {
-		incomeConfig = g.Config.IncomeConfigs
-	}
+	// Use IncomeConfig from game configuration (with any overrides) if available
+	incomeConfig := EffectiveIncomeConfig(g.Config)
 
 	totalIncome := int32(0)
 	for _, tile := range g.World.TilesByCoord() {
@@ -663,20 +708,39 @@ func (g *Game) ProcessEndTurn(move *v1.GameMove, action *v1.EndTurnAction) (err
 		move.Changes = append(move.Changes, coinsChange)
 	}
 
+	// Score the victory point markers the ending player holds
+	g.scoreVictoryPoints(move, previousPlayer)
+
 	// Advance to next player (1-based player system: Player 1, Player 2, etc.)
 	// Player 0 is reserved for neutral, so we cycle between 1, 2, ..., PlayerCount
 	// Use configured player count from game config, not from World (which counts units)
 	numPlayers := g.NumPlayers()
 
-	if g.CurrentPlayer == numPlayers {
-		// Last player completes their turn, go back to player 1 and increment turn counter
-		g.CurrentPlayer = 1
-		g.TurnCounter++
-	} else {
-		// Move to next player
-		g.CurrentPlayer++
+	// Stop the ending player's clock (this may forfeit them if they ran out)
+	timedOut := g.stopTurnClock(previousPlayer)
+	forfeited := timedOut && g.playerForfeited(previousPlayer)
+
+	// Any delegation only lasts for the rest of the turn
+	g.GameState.DelegatedTo = 0
+
+	for {
+		if g.CurrentPlayer == numPlayers {
+			// Last player completes their turn, go back to player 1 and increment turn counter
+			g.CurrentPlayer = 1
+			g.TurnCounter++
+		} else {
+			// Move to next player
+			g.CurrentPlayer++
+		}
+		// Players who forfeited on time no longer take turns
+		if !g.playerForfeited(g.CurrentPlayer) || g.CurrentPlayer == previousPlayer {
+			break
+		}
 	}
 
+	// Progress (or cancel) the incoming player's constructions before their units top up
+	g.advanceConstructions(move, g.CurrentPlayer)
+
 	// Top-up the INCOMING player's units and capture them as ResetUnits
 	// This ensures remote clients receive the refreshed values
 	incomingPlayerUnits := g.World.GetPlayerUnits(int(g.CurrentPlayer))
@@ -704,16 +768,22 @@ func (g *Game) ProcessEndTurn(move *v1.GameMove, action *v1.EndTurnAction) (err
 		// TODO - g.SetGameLogStatus("completed")
 	}
 
+	// Hand the clock to the incoming player
+	g.startTurnClock()
+
 	// Update timestamp
 	g.GameState.UpdatedAt = tspb.New(time.Now())
 	change := &v1.WorldChange{
 		ChangeType: &v1.WorldChange_PlayerChanged{
 			PlayerChanged: &v1.PlayerChangedChange{
-				PreviousPlayer: int32(previousPlayer),
-				NewPlayer:      int32(g.CurrentPlayer),
-				PreviousTurn:   int32(previousTurn),
-				NewTurn:        int32(g.TurnCounter),
-				ResetUnits:     resetUnits,
+				PreviousPlayer:          int32(previousPlayer),
+				NewPlayer:               int32(g.CurrentPlayer),
+				PreviousTurn:            int32(previousTurn),
+				NewTurn:                 int32(g.TurnCounter),
+				ResetUnits:              resetUnits,
+				TimeBanksMs:             g.timeBanksSnapshot(),
+				ClockStartedAt:          g.GameState.ClockStartedAt,
+				PreviousPlayerForfeited: forfeited,
 			},
 		},
 	}
@@ -751,6 +821,17 @@ func (g *Game) ProcessMoveUnit(move *v1.GameMove, action *v1.MoveUnitAction, pre
 		return fmt.Errorf("not player %d's turn", unit.Player)
 	}
 
+	// Moving is either a move or, after attacking, a retreat
+	unitDef := g.progressionUnitDef(unit)
+	moveKind := "move"
+	if _, ok := g.resolveActionStep(unit, unitDef, moveKind); !ok {
+		moveKind = "retreat"
+	}
+	step, err := g.checkActionAllowed(unit, unitDef, moveKind)
+	if err != nil {
+		return err
+	}
+
 	// Find path to destination (validates move and returns path for animation)
 	path, cost, err := g.RulesEngine.FindPathTo(unit, to, g.World, preventPassThrough)
 	if err != nil {
@@ -764,6 +845,9 @@ func (g *Game) ProcessMoveUnit(move *v1.GameMove, action *v1.MoveUnitAction, pre
 	// Capture unit state before move
 	previousUnit := copyUnit(unit)
 
+	// Moving away abandons any construction this unit was working on
+	g.cancelConstructionsBy(move, unit)
+
 	// Move unit using World unit management
 	err = g.World.MoveUnit(unit, to)
 	if err != nil {
@@ -777,12 +861,31 @@ func (g *Game) ProcessMoveUnit(move *v1.GameMove, action *v1.MoveUnitAction, pre
 	}
 
 	// Update unit stats on the moved unit
-	movedUnit.DistanceLeft -= cost
+	movedUnit.DistanceLeft = g.RulesEngine.SpendMovement(movedUnit.DistanceLeft, cost)
+
+	// A multi-hex unit turns to face the way its last step went
+	if g.RulesEngine.HasFootprint(movedUnit.UnitType) && len(path.Edges) > 0 {
+		last := path.Edges[len(path.Edges)-1]
+		movedUnit.Facing = int32(GetDirection(AxialCoord{Q: int(last.FromQ), R: int(last.FromR)}, AxialCoord{Q: int(last.ToQ), R: int(last.ToR)}))
+	}
 
-	// Update progression: if distance_left reaches 0, advance to next step
-	if movedUnit.DistanceLeft <= 0 {
+	// Hazards on the path damage the unit, and may halt it
+	alive := g.applyHazards(movedUnit, path)
+
+	// Update progression: moving commits the unit to moving for this step,
+	// and once distance_left reaches 0 it advances to the next step
+	if step != movedUnit.ProgressionStep {
+		movedUnit.ProgressionStep = step
+		movedUnit.ChosenAlternative = ""
+		movedUnit.StepActions = 0
+	}
+	if actionOrder := unitActionOrder(unitDef); int(step) < len(actionOrder) && strings.Contains(actionOrder[step], "|") {
+		movedUnit.ChosenAlternative = moveKind
+	}
+	if !g.RulesEngine.HasMovementLeft(movedUnit.DistanceLeft) {
 		movedUnit.ProgressionStep++
 		movedUnit.ChosenAlternative = "" // Clear for next step
+		movedUnit.StepActions = 0
 	}
 
 	// Capture unit state after move (using the moved unit, not the original)
@@ -804,6 +907,17 @@ func (g *Game) ProcessMoveUnit(move *v1.GameMove, action *v1.MoveUnitAction, pre
 	}
 
 	move.Changes = append(move.Changes, change)
+
+	if !alive {
+		move.Changes = append(move.Changes, &v1.WorldChange{
+			ChangeType: &v1.WorldChange_UnitKilled{
+				UnitKilled: &v1.UnitKilledChange{
+					PreviousUnit: copyUnit(updatedUnit),
+				},
+			},
+		})
+		g.World.RemoveUnit(movedUnit)
+	}
 	return nil
 }
 
@@ -821,10 +935,13 @@ func (g *Game) ProcessAttackUnit(move *v1.GameMove, action *v1.AttackUnitAction)
 		return fmt.Errorf("invalid defender position: %w", err)
 	}
 	attacker := g.World.UnitAt(attackerCoord)
-	defender := g.World.UnitAt(defenderCoord)
+	defender := g.RulesEngine.OccupantAt(g.World, defenderCoord)
 	if attacker == nil || defender == nil {
 		return fmt.Errorf("attacker or defender is nil")
 	}
+	// Any hex a multi-hex unit covers can be targeted; the unit itself is
+	// where it stands
+	defenderCoord = UnitGetCoord(defender)
 
 	// Apply lazy top-up pattern for both units
 	if err := g.TopUpUnitIfNeeded(attacker); err != nil {
@@ -839,6 +956,12 @@ func (g *Game) ProcessAttackUnit(move *v1.GameMove, action *v1.AttackUnitAction)
 		return fmt.Errorf("not player %d's turn", attacker.Player)
 	}
 
+	unitDef := g.progressionUnitDef(attacker)
+	step, err := g.checkActionAllowed(attacker, unitDef, "attack")
+	if err != nil {
+		return err
+	}
+
 	// Check if units can attack each other
 	if !g.CanAttackUnit(attacker, defender) {
 		return fmt.Errorf("attacker cannot attack defender")
@@ -848,78 +971,31 @@ func (g *Game) ProcessAttackUnit(move *v1.GameMove, action *v1.AttackUnitAction)
 	attackerOriginalHealth := attacker.AvailableHealth
 	defenderOriginalHealth := defender.AvailableHealth
 
-	// Calculate wound bonus from defender's attack history
-	woundBonus := g.RulesEngine.CalculateWoundBonus(defender, attackerCoord)
-
-	// Create combat context for attacker -> defender
-	attackerCtx := &CombatContext{
-		Attacker:       attacker,
-		AttackerTile:   g.World.TileAt(attackerCoord),
-		AttackerHealth: attacker.AvailableHealth,
-		Defender:       defender,
-		DefenderTile:   g.World.TileAt(defenderCoord),
-		DefenderHealth: defender.AvailableHealth,
-		WoundBonus:     woundBonus,
-	}
+	// Combat contexts for the attack and the defender's counter, with the
+	// wound bonus from the defender's attack history
+	attackerCtx, counterCtx := g.combatContexts(attacker, defender)
 
 	// Calculate damage using formula-based system
-	defenderDamage, err := g.RulesEngine.SimulateCombatDamage(attackerCtx, g.rng)
+	defenderDamage, err := g.calculateDamage(attackerCtx)
 	if err != nil {
 		return fmt.Errorf("failed to calculate combat damage: %w", err)
 	}
 
-	// Check if defender can counter-attack
+	// Counter-attack if the defender can
 	attackerDamage := int32(0)
-	if canCounter, err := g.RulesEngine.CanUnitAttackTarget(defender, attacker); err == nil && canCounter {
-		// Create combat context for counter-attack (no wound bonus)
-		counterCtx := &CombatContext{
-			Attacker:       defender,
-			AttackerTile:   g.World.TileAt(defenderCoord),
-			AttackerHealth: defender.AvailableHealth,
-			Defender:       attacker,
-			DefenderTile:   g.World.TileAt(attackerCoord),
-			DefenderHealth: attacker.AvailableHealth,
-			WoundBonus:     0, // No wound bonus for counter-attacks
-		}
-
-		attackerDamage, err = g.RulesEngine.SimulateCombatDamage(counterCtx, g.rng)
+	if counterCtx != nil {
+		attackerDamage, err = g.calculateDamage(counterCtx)
 		if err != nil {
 			// If counter-attack calculation fails, no counter damage
 			attackerDamage = 0
+			counterCtx = nil
 		}
 	}
+	summary := g.newCombatSummary(attackerCtx, counterCtx, defenderDamage, attackerDamage)
 
-	// Update progression: record chosen alternative and check if step is complete
-	unitDef, err := g.RulesEngine.GetUnitData(attacker.UnitType)
-	if err == nil && unitDef != nil {
-		// Get action_order for this unit
-		actionOrder := unitDef.ActionOrder
-		if len(actionOrder) == 0 {
-			actionOrder = []string{"move", "attack|capture"}
-		}
-
-		// If current step has pipe-separated alternatives, record the choice
-		if int(attacker.ProgressionStep) < len(actionOrder) {
-			stepActions := actionOrder[attacker.ProgressionStep]
-			if strings.Contains(stepActions, "|") {
-				attacker.ChosenAlternative = "attack"
-			}
-		}
-
-		// Check if we've reached the action limit for attacks
-		// For now, assume 1 attack per step (can enhance later with action_limits)
-		// Since attack was performed, advance to next step
-		attacker.ProgressionStep++
-		attacker.ChosenAlternative = "" // Clear for next step
-
-		// If next step is "retreat", set DistanceLeft to retreat_points
-		if int(attacker.ProgressionStep) < len(actionOrder) {
-			nextStepAction := actionOrder[attacker.ProgressionStep]
-			if nextStepAction == "retreat" {
-				attacker.DistanceLeft = unitDef.RetreatPoints
-			}
-		}
-	}
+	// Update progression: record chosen alternative and advance past the
+	// attack step, granting retreat points if a retreat follows
+	g.RulesEngine.AdvanceProgression(attacker, unitDef, "attack", step)
 
 	// Record attack in defender's history for future wound bonus calculations
 	distance := CubeDistance(attackerCoord, defenderCoord)
@@ -1018,29 +1094,27 @@ func (g *Game) ProcessAttackUnit(move *v1.GameMove, action *v1.AttackUnitAction)
 		g.World.RemoveUnit(attacker)
 	}
 
+	g.finishCombatSummary(summary, attacker, defender)
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitAttacked{
+			UnitAttacked: &v1.UnitAttackedChange{Summary: summary},
+		},
+	})
+
 	// Apply splash damage to adjacent units (if attacker has splash damage capability)
 	// Only if attacker is still alive (not killed by counter-attack)
 	if !attackerKilled {
-		// Get all 6 adjacent hexes around the defender
-		var adjacentCoords [6]AxialCoord
-		defenderCoord.Neighbors(&adjacentCoords)
-
-		adjacentUnits := make([]*v1.Unit, 0, 6) // Pre-allocate for max 6 neighbors
-		for _, coord := range adjacentCoords {
-			if unit := g.World.UnitAt(coord); unit != nil {
-				// Include all units (friendly and enemy), air units will be filtered by CalculateSplashDamage
-				adjacentUnits = append(adjacentUnits, unit)
-			}
-		}
+		// Include all units (friendly and enemy), air units will be filtered by CalculateSplashDamage
+		adjacentUnits := g.World.GetAdjacentUnits(defenderCoord)
 
 		if len(adjacentUnits) > 0 {
-			splashTargets, err := g.RulesEngine.CalculateSplashDamage(
+			splashTargets, err := g.RulesEngine.calculateSplashDamage(
 				attacker,
 				g.World.TileAt(attackerCoord),
 				defenderCoord,
 				adjacentUnits,
 				g.World,
-				g.rng,
+				g.calculateDamage,
 			)
 			if err == nil && len(splashTargets) > 0 {
 				// Apply splash damage to each target
@@ -1097,7 +1171,9 @@ func (g *Game) ProcessAttackUnit(move *v1.GameMove, action *v1.AttackUnitAction)
 }
 
 // CanMoveUnit validates potential movement using Dijkstra-based pathfinding
-// This checks if the target is reachable given terrain costs and available movement points
+// This checks if the target is in the unit's reachable set given terrain
+// costs and this turn's movement points - the same set GetOptionsAt offers
+// move options from
 func (g *Game) CanMoveUnit(unit *v1.Unit, to AxialCoord, preventPassThrough bool) bool {
 	if unit == nil {
 		return false
@@ -1108,14 +1184,30 @@ func (g *Game) CanMoveUnit(unit *v1.Unit, to AxialCoord, preventPassThrough bool
 		return false
 	}
 
+	// Lazy top-up, as GetOptionsAt and ProcessMoveUnit do, so the unit's
+	// movement points are this turn's
+	if err := g.TopUpUnitIfNeeded(unit); err != nil {
+		return false
+	}
+	if unit.AvailableHealth <= 0 || !g.RulesEngine.HasMovementLeft(unit.DistanceLeft) {
+		return false
+	}
+
+	// The unit's progression must allow a move or a retreat
+	unitDef := g.progressionUnitDef(unit)
+	_, moveAllowed := g.resolveActionStep(unit, unitDef, "move")
+	_, retreatAllowed := g.resolveActionStep(unit, unitDef, "retreat")
+	if !moveAllowed && !retreatAllowed {
+		return false
+	}
+
 	// Check if destination is occupied by another unit
-	destUnit := g.World.UnitAt(to)
-	if destUnit != nil {
+	if g.RulesEngine.occupiedByOther(g.World, to, unit) {
 		return false
 	}
 
 	// Use Dijkstra to compute all reachable tiles based on terrain and movement points
-	allPaths, err := g.RulesEngine.GetMovementOptions(g.World, unit, int(unit.DistanceLeft), preventPassThrough)
+	allPaths, err := g.RulesEngine.GetMovementOptions(g.World, unit, unit.DistanceLeft, preventPassThrough)
 	if err != nil {
 		return false
 	}
@@ -1126,6 +1218,12 @@ func (g *Game) CanMoveUnit(unit *v1.Unit, to AxialCoord, preventPassThrough bool
 	return reachable
 }
 
+// FriendlyFireAllowed reports whether the game lets units attack other units
+// of their own player
+func (g *Game) FriendlyFireAllowed() bool {
+	return g.Config.GetSettings().GetAllowFriendlyFire()
+}
+
 // CanAttackUnit validates potential attack
 func (g *Game) CanAttackUnit(attacker, defender *v1.Unit) bool {
 	if attacker == nil || defender == nil {
@@ -1137,13 +1235,18 @@ func (g *Game) CanAttackUnit(attacker, defender *v1.Unit) bool {
 		return false
 	}
 
-	// Check if units are enemies
-	if attacker.Player == defender.Player {
+	// Check if units are enemies, unless friendly fire is allowed
+	if attacker == defender || (attacker.Player == defender.Player && !g.FriendlyFireAllowed()) {
+		return false
+	}
+
+	// Submerged units cannot attack, and hidden units cannot be targeted
+	if attacker.Submerged || !g.IsUnitVisibleToPlayer(defender, attacker.Player) {
 		return false
 	}
 
 	// Use rules engine for attack validation
-	canAttack, err := g.RulesEngine.CanUnitAttackTarget(attacker, defender)
+	canAttack, err := g.RulesEngine.canReachTarget(attacker, defender)
 	if err != nil {
 		return false
 	}
@@ -1157,7 +1260,7 @@ func (g *Game) CanAttack(from, to AxialCoord) (bool, error) {
 		return false, fmt.Errorf("no unit at attacker position (%d, %d)", from.Q, from.R)
 	}
 
-	defender := g.World.UnitAt(to)
+	defender := g.RulesEngine.OccupantAt(g.World, to)
 	if defender == nil {
 		return false, fmt.Errorf("no unit at target position (%d, %d)", to.Q, to.R)
 	}
@@ -1167,6 +1270,12 @@ func (g *Game) CanAttack(from, to AxialCoord) (bool, error) {
 
 // GetMovementOptions returns movement options for unit at given coordinates with full validation
 func (g *Game) GetMovementOptions(q, r int32, preventPassThrough bool) (*v1.AllPaths, error) {
+	return g.GetMovementOptionsContext(context.Background(), q, r, preventPassThrough)
+}
+
+// GetMovementOptionsContext is GetMovementOptions that stops with the
+// context's error once it is done
+func (g *Game) GetMovementOptionsContext(ctx context.Context, q, r int32, preventPassThrough bool) (*v1.AllPaths, error) {
 	unit := g.World.UnitAt(AxialCoord{Q: int(q), R: int(r)})
 	if unit == nil {
 		return nil, fmt.Errorf("no unit found at position (%d, %d)", q, r)
@@ -1177,10 +1286,10 @@ func (g *Game) GetMovementOptions(q, r int32, preventPassThrough bool) (*v1.AllP
 	if unit.AvailableHealth <= 0 {
 		return nil, fmt.Errorf("unit has no health remaining")
 	}
-	if unit.DistanceLeft <= 0 {
+	if !g.RulesEngine.HasMovementLeft(unit.DistanceLeft) {
 		return nil, fmt.Errorf("unit has no movement points remaining")
 	}
-	return g.RulesEngine.GetMovementOptions(g.World, unit, int(unit.DistanceLeft), preventPassThrough)
+	return g.RulesEngine.GetMovementOptionsContext(ctx, g.World, unit, unit.DistanceLeft, preventPassThrough)
 }
 
 // GetAttackOptions returns attack options for unit at given coordinates with full validation
@@ -0,0 +1,136 @@
+package lib
+
+import "math"
+
+// =============================================================================
+// Hex Orientation
+// =============================================================================
+//
+// Maps are laid out pointy-top by default: rows of hexes with every other
+// row shifted by half a tile. Flat-top maps instead use columns of hexes with
+// every other column shifted down by half a tile. Either way each hex fills a
+// TileWidth x TileHeight box, with YIncrement being the spacing between rows
+// (pointy-top) or columns (flat-top).
+
+// Orientation names as stored on worlds and games
+const (
+	OrientationPointyTop = "pointy"
+	OrientationFlatTop   = "flat"
+)
+
+// Orientation describes how hexes are placed and shaped on screen
+type Orientation struct {
+	Name string
+
+	// Pixel offset of a +1 step in q and in r, as multiples of
+	// (TileWidth, TileHeight). The 0.75 components are the row or column
+	// spacing, which Basis takes from YIncrement.
+	QBasis, RBasis [2]float64
+
+	// Corners of the hex as fractions of its tile box, clockwise from the
+	// top (pointy-top) or top-left (flat-top)
+	Corners [6][2]float64
+
+	// Angles of the corners in degrees, clockwise from the positive x axis
+	CornerAngles [6]float64
+
+	flatTop bool
+}
+
+var (
+	PointyTop = &Orientation{
+		Name:         OrientationPointyTop,
+		QBasis:       [2]float64{1, 0},
+		RBasis:       [2]float64{0.5, 0.75},
+		Corners:      [6][2]float64{{0.5, 0}, {1, 0.25}, {1, 0.75}, {0.5, 1}, {0, 0.75}, {0, 0.25}},
+		CornerAngles: [6]float64{-90, -30, 30, 90, 150, 210},
+	}
+	FlatTop = &Orientation{
+		Name:         OrientationFlatTop,
+		QBasis:       [2]float64{0.75, 0.5},
+		RBasis:       [2]float64{0, 1},
+		Corners:      [6][2]float64{{0.25, 0}, {0.75, 0}, {1, 0.5}, {0.75, 1}, {0.25, 1}, {0, 0.5}},
+		CornerAngles: [6]float64{-120, -60, 0, 60, 120, 180},
+		flatTop:      true,
+	}
+)
+
+// GetOrientation returns the orientation with the given name, defaulting to
+// pointy-top for empty or unknown names
+func GetOrientation(name string) *Orientation {
+	if name == OrientationFlatTop {
+		return FlatTop
+	}
+	return PointyTop
+}
+
+// HexOrientation returns the render options' orientation, pointy-top if unset
+func (opts *RenderOptions) HexOrientation() *Orientation {
+	if opts.Orientation == nil {
+		return PointyTop
+	}
+	return opts.Orientation
+}
+
+// Basis returns the pixel offsets of a +1 step in q and in r for the given
+// tile dimensions
+func (o *Orientation) Basis(opts *RenderOptions) (q, r [2]float64) {
+	w, h, inc := float64(opts.TileWidth), float64(opts.TileHeight), float64(opts.YIncrement)
+	if o.flatTop {
+		return [2]float64{inc, h * o.QBasis[1]}, [2]float64{w * o.RBasis[0], h * o.RBasis[1]}
+	}
+	return [2]float64{w * o.QBasis[0], h * o.QBasis[1]}, [2]float64{w * o.RBasis[0], inc}
+}
+
+// HexCorners returns the corners of the hex filling the tile whose top-left
+// corner is at x, y
+func (o *Orientation) HexCorners(x, y int, opts *RenderOptions) (corners [6][2]int) {
+	for i, c := range o.Corners {
+		corners[i] = [2]int{x + int(c[0]*float64(opts.TileWidth)), y + int(c[1]*float64(opts.TileHeight))}
+	}
+	return
+}
+
+// ContainsPoint reports whether a point, given as fractions of the tile box,
+// lies inside the hex
+func (o *Orientation) ContainsPoint(fx, fy float64) bool {
+	for i := range o.Corners {
+		a, b := o.Corners[i], o.Corners[(i+1)%6]
+		// Corners run clockwise (y down), so inside is to the right of each edge
+		if (b[0]-a[0])*(fy-a[1])-(b[1]-a[1])*(fx-a[0]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// PixelToHex returns the hex under the pixel x, y, in the same pixel space
+// as HexToPixel (for hit testing). EvenRowOffsetCoords layouts are not
+// supported.
+func PixelToHex(x, y int, opts *RenderOptions) AxialCoord {
+	if opts == nil {
+		opts = DefaultRenderOptions()
+	}
+	px := float64(x) - float64(opts.TileWidth)/2
+	py := float64(y) - float64(opts.TileHeight)/2
+
+	// Invert the basis to get fractional axial coordinates
+	qb, rb := opts.HexOrientation().Basis(opts)
+	det := qb[0]*rb[1] - rb[0]*qb[1]
+	q := (px*rb[1] - py*rb[0]) / det
+	r := (py*qb[0] - px*qb[1]) / det
+	return roundAxial(q, r)
+}
+
+// roundAxial rounds fractional axial coordinates to the nearest hex
+func roundAxial(q, r float64) AxialCoord {
+	s := -q - r
+	rq, rr, rs := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(rq-q), math.Abs(rr-r), math.Abs(rs-s)
+	if dq > dr && dq > ds {
+		rq = -rr - rs
+	} else if dr > ds {
+		rr = -rq - rs
+	}
+	return AxialCoord{Q: int(rq), R: int(rr)}
+}
@@ -0,0 +1,79 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+func TestHexToPixel_PointyTopDefault(t *testing.T) {
+	opts := DefaultRenderOptions()
+	for _, tc := range []struct {
+		coord AxialCoord
+		x, y  int
+	}{
+		{AxialCoord{Q: 0, R: 0}, 0, 0},
+		{AxialCoord{Q: 1, R: 0}, 64, 0},
+		{AxialCoord{Q: 0, R: 1}, 32, 48},
+		{AxialCoord{Q: 0, R: -1}, -32, -48},
+	} {
+		if x, y := HexToPixel(tc.coord, opts); x != tc.x || y != tc.y {
+			t.Errorf("HexToPixel(%v) = (%d,%d), want (%d,%d)", tc.coord, x, y, tc.x, tc.y)
+		}
+	}
+}
+
+func TestOrientation_BoundsAndHitTesting(t *testing.T) {
+	coords := []AxialCoord{{Q: 0, R: 0}, {Q: 1, R: 0}, {Q: 0, R: 1}}
+	tiles := map[string]*v1.Tile{}
+	for _, c := range coords {
+		tiles[CoordKey(int32(c.Q), int32(c.R))] = &v1.Tile{Q: int32(c.Q), R: int32(c.R)}
+	}
+
+	pointy := DefaultRenderOptions()
+	flat := DefaultRenderOptions()
+	flat.Orientation = GetOrientation(OrientationFlatTop)
+
+	pointyBounds := ComputeWorldBounds(tiles, nil, pointy)
+	flatBounds := ComputeWorldBounds(tiles, nil, flat)
+	if pointyBounds.Width <= 0 || pointyBounds.Height <= 0 || flatBounds.Width <= 0 || flatBounds.Height <= 0 {
+		t.Fatalf("empty bounds: pointy %+v, flat %+v", pointyBounds, flatBounds)
+	}
+	if pointyBounds == flatBounds {
+		t.Errorf("pointy-top and flat-top bounds are both %+v", pointyBounds)
+	}
+	// Pointy-top: two hexes side by side over a half-offset row
+	if pointyBounds.Width != 128 || pointyBounds.Height != 112 {
+		t.Errorf("pointy-top bounds %dx%d, want 128x112", pointyBounds.Width, pointyBounds.Height)
+	}
+	// Flat-top: (0,1) sits below (0,0) and (1,0) half a tile down to its right
+	if flatBounds.Width != 112 || flatBounds.Height != 128 {
+		t.Errorf("flat-top bounds %dx%d, want 112x128", flatBounds.Width, flatBounds.Height)
+	}
+
+	for _, opts := range []*RenderOptions{pointy, flat} {
+		name := opts.HexOrientation().Name
+		for _, c := range coords {
+			x, y := HexToPixel(c, opts)
+			if got := PixelToHex(x+opts.TileWidth/2, y+opts.TileHeight/2, opts); got != c {
+				t.Errorf("%s: center of %v hit %v", name, c, got)
+			}
+		}
+		// The top-left corner of (1,0)'s tile box lies outside its hex
+		x, y := HexToPixel(AxialCoord{Q: 1, R: 0}, opts)
+		if got := PixelToHex(x+2, y+2, opts); got == (AxialCoord{Q: 1, R: 0}) {
+			t.Errorf("%s: tile box corner of (1,0) hit (1,0)", name)
+		}
+	}
+}
+
+func TestGetOrientation_DefaultsToPointyTop(t *testing.T) {
+	for _, name := range []string{"", "pointy", "hexagonal"} {
+		if GetOrientation(name) != PointyTop {
+			t.Errorf("GetOrientation(%q) is not pointy-top", name)
+		}
+	}
+	if GetOrientation("flat") != FlatTop {
+		t.Error(`GetOrientation("flat") is not flat-top`)
+	}
+}
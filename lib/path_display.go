@@ -44,7 +44,7 @@ func FormatPathDetailed(path *v1.Path, indent string) string {
 	}
 
 	var lines []string
-	lines = append(lines, fmt.Sprintf("%sPath (total cost: %.0f):", indent, path.TotalCost))
+	lines = append(lines, fmt.Sprintf("%sPath (total cost: %s):", indent, FormatMovementPoints(path.TotalCost)))
 
 	for i, edge := range path.Edges {
 		fromCoord := AxialCoord{Q: int(edge.FromQ), R: int(edge.FromR)}
@@ -55,9 +55,9 @@ func FormatPathDetailed(path *v1.Path, indent string) string {
 		dirLong := DirectionToLongString(dir)
 
 		// Format each step with arrow
-		line := fmt.Sprintf("%s  %d. %s %s to (%d,%d) - %s (cost: %.0f)",
+		line := fmt.Sprintf("%s  %d. %s %s to (%d,%d) - %s (cost: %s)",
 			indent, i+1, arrow, dirLong,
-			edge.ToQ, edge.ToR, edge.TerrainType, edge.MovementCost)
+			edge.ToQ, edge.ToR, edge.TerrainType, FormatMovementPoints(edge.MovementCost))
 
 		// Add explanation if available
 		if edge.Explanation != "" {
@@ -55,11 +55,17 @@ func ProtoInt(val int32) int {
 // ProtoToRuntimeGame converts protobuf game/state to runtime game
 // This is LilBattle-specific and doesn't belong in TurnEngine
 func ProtoToRuntimeGame(game *v1.Game, gameState *v1.GameState) *Game {
+	// Create the runtime game with loaded default rules engine
+	return ProtoToRuntimeGameWithRules(game, gameState, DefaultRulesEngine())
+}
+
+// ProtoToRuntimeGameWithRules converts protobuf game/state to a runtime game
+// using the given rules engine (e.g. one loaded from a custom rules file).
+// The game's rules overrides are merged onto the rules engine.
+func ProtoToRuntimeGameWithRules(game *v1.Game, gameState *v1.GameState, rulesEngine *RulesEngine) *Game {
 	// Create the runtime game from the protobuf data
 	world := NewWorld(game.Name, gameState.WorldData)
-
-	// Create the runtime game with loaded default rules engine
-	rulesEngine := DefaultRulesEngine() // Use loaded default rules engine
+	rulesEngine = rulesEngine.WithOverrides(GameRulesOverrides(game.Config))
 
 	// Use NewGameFromState instead of NewGame to preserve unit stats
 	return NewGame(game, gameState, world, rulesEngine, 12345) // Default seed
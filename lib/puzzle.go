@@ -0,0 +1,152 @@
+package lib
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Puzzles
+// =============================================================================
+//
+// A puzzle game starts from a fixed position where the solver has to reach a
+// goal, written in the assert condition language, within a turn budget. The
+// goal is checked after every move: reaching it solves the puzzle, and the
+// solver ending the last turn of the budget without reaching it fails it.
+// Either way the game ends. The other seats are played by a scripted
+// opponent.
+
+// PuzzleSolver returns the player the solver plays as
+func PuzzleSolver(puzzle *v1.PuzzleSettings) int32 {
+	if puzzle.GetSolverPlayer() == 0 {
+		return 1
+	}
+	return puzzle.GetSolverPlayer()
+}
+
+// ValidatePuzzleSettings checks a puzzle has a well formed goal and a budget
+func ValidatePuzzleSettings(puzzle *v1.PuzzleSettings) error {
+	if puzzle.GetGoal() == "" {
+		return fmt.Errorf("a goal is required")
+	}
+	if err := ValidateAssertions(puzzle.Goal); err != nil {
+		return fmt.Errorf("invalid goal: %w", err)
+	}
+	if puzzle.TurnBudget < 1 {
+		return fmt.Errorf("the turn budget must be at least 1, got %d", puzzle.TurnBudget)
+	}
+	return nil
+}
+
+// checkPuzzle ends a puzzle game once the goal is reached, or once the
+// solver has ended the last turn of the budget without reaching it. A game
+// that ends any other way fails the puzzle.
+func (g *Game) checkPuzzle(move *v1.GameMove, player, turnCounter int32) {
+	puzzle := g.Config.GetSettings().GetPuzzle()
+	if puzzle == nil || g.GameState.PuzzleResult != v1.PuzzleResult_PUZZLE_RESULT_UNSPECIFIED {
+		return
+	}
+	solver := PuzzleSolver(puzzle)
+
+	// Goals about a unit that is gone do not hold
+	reached, _ := g.puzzleAssertions().Holds(puzzle.Goal)
+	switch {
+	case reached:
+		g.endPuzzle(v1.PuzzleResult_PUZZLE_RESULT_SOLVED, solver)
+	case g.GameState.Finished,
+		move.GetEndTurn() != nil && player == solver && turnCounter >= puzzle.TurnBudget:
+		g.endPuzzle(v1.PuzzleResult_PUZZLE_RESULT_FAILED, g.puzzleOpponent(solver))
+	}
+}
+
+func (g *Game) endPuzzle(result v1.PuzzleResult, winner int32) {
+	g.GameState.PuzzleResult = result
+	g.GameState.WinningPlayer = winner
+	g.GameState.Finished = true
+	g.GameState.Status = v1.GameStatus_GAME_STATUS_ENDED
+}
+
+// puzzleOpponent returns the first player other than the solver
+func (g *Game) puzzleOpponent(solver int32) int32 {
+	for _, player := range g.Config.GetPlayers() {
+		if player.PlayerId != solver {
+			return player.PlayerId
+		}
+	}
+	return 0
+}
+
+// puzzleAssertions returns an assertion context over the current position,
+// flattening any transaction layers the world is in
+func (g *Game) puzzleAssertions() *AssertionContext {
+	data := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for coord, tile := range g.World.TilesByCoord() {
+		data.TilesMap[CoordKeyFromAxial(coord)] = tile
+	}
+	for coord, unit := range g.World.UnitsByCoord() {
+		data.UnitsMap[CoordKeyFromAxial(coord)] = unit
+	}
+	return &AssertionContext{Game: g.Game, State: &v1.GameState{
+		CurrentPlayer: g.CurrentPlayer,
+		TurnCounter:   g.TurnCounter,
+		Status:        g.GameState.Status,
+		Finished:      g.GameState.Finished,
+		WinningPlayer: g.WinningPlayer,
+		WinningTeam:   g.WinningTeam,
+		PlayerStates:  g.PlayerStates,
+		WorldData:     data,
+	}}
+}
+
+// PlayPuzzleOpponentTurn plays the current player's turn as the puzzle's
+// opponent: its scripted moves for the turn, or once the script runs out the
+// baseline AI's if the puzzle enables it. Scripted moves the rules reject (eg
+// by a unit the solver has since killed) are skipped. Returns the moves
+// played, ending with the end turn unless the game ended first.
+func (g *Game) PlayPuzzleOpponentTurn() ([]*v1.GameMove, error) {
+	puzzle := g.Config.GetSettings().GetPuzzle()
+	if puzzle == nil {
+		return nil, fmt.Errorf("game %s is not a puzzle", g.Id)
+	}
+	player := g.CurrentPlayer
+	if player == PuzzleSolver(puzzle) {
+		return nil, fmt.Errorf("player %d is the puzzle's solver", player)
+	}
+
+	turn := int(g.TurnCounter) - 1
+	if turn >= len(puzzle.OpponentTurns) && puzzle.OpponentAi {
+		return g.PlayBaselineTurn()
+	}
+
+	var moves []*v1.GameMove
+	if turn < len(puzzle.OpponentTurns) {
+		for _, scripted := range puzzle.OpponentTurns[turn].Moves {
+			if scripted.Player != 0 && scripted.Player != player {
+				continue
+			}
+			move := proto.Clone(scripted).(*v1.GameMove)
+			move.Player = player
+			if g.ProcessMove(move) != nil {
+				continue
+			}
+			moves = append(moves, move)
+			if g.GameState.Finished {
+				return moves, nil
+			}
+		}
+	}
+
+	endTurn := &v1.GameMove{Player: player, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	if err := g.ProcessMove(endTurn); err != nil {
+		return nil, err
+	}
+	return append(moves, endTurn), nil
+}
+
+// PuzzleTurnsLeft returns how many of the solver's turns are left in the
+// budget, counting the current one
+func PuzzleTurnsLeft(puzzle *v1.PuzzleSettings, state *v1.GameState) int32 {
+	return max(puzzle.GetTurnBudget()-state.GetTurnCounter()+1, 0)
+}
@@ -0,0 +1,89 @@
+package lib
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// movementCache holds the movement flood-fills computed for tactical
+// queries. It is dropped whenever the world's layout or the rules change.
+type movementCache struct {
+	world           *World
+	layoutVersion   int
+	rules           *RulesEngine
+	rulesGeneration uint64
+	fills           map[movementKey]*v1.AllPaths
+}
+
+type movementKey struct {
+	coord    AxialCoord
+	unitType int32
+	movement float64
+}
+
+// movementFill returns every tile a unit of unitType at coord can move to or
+// through with the given movement points
+func (g *Game) movementFill(coord AxialCoord, unitType int32, movement float64) *v1.AllPaths {
+	cache := g.movement
+	if cache == nil || cache.world != g.World || cache.layoutVersion != g.World.layoutVersion ||
+		cache.rules != g.RulesEngine || cache.rulesGeneration != g.RulesEngine.Generation() {
+		cache = &movementCache{
+			world:           g.World,
+			layoutVersion:   g.World.layoutVersion,
+			rules:           g.RulesEngine,
+			rulesGeneration: g.RulesEngine.Generation(),
+			fills:           map[movementKey]*v1.AllPaths{},
+		}
+		g.movement = cache
+	}
+	key := movementKey{coord, unitType, movement}
+	if fill, ok := cache.fills[key]; ok {
+		return fill
+	}
+	fill := g.RulesEngine.dijkstraMovement(g.World, unitType, coord, movement, false)
+	cache.fills[key] = fill
+	return fill
+}
+
+// movementRange is how far a unit can move: what it has left if it has
+// already been topped up this turn, otherwise a fresh turn's movement
+func (g *Game) movementRange(unit *v1.Unit) float64 {
+	if unit.Player == g.CurrentPlayer && unit.LastToppedupTurn >= g.TurnCounter {
+		return unit.DistanceLeft
+	}
+	unitData, err := g.RulesEngine.GetUnitData(unit.UnitType)
+	if err != nil {
+		return 0
+	}
+	return unitData.MovementPoints
+}
+
+// GetUnitsThatCanReach returns the player's units whose movement range
+// includes coord, sorted by position. A unit standing on coord counts, and
+// so does an occupied coord a unit could move through.
+func (g *Game) GetUnitsThatCanReach(coord AxialCoord, player int32) []*v1.Unit {
+	var coords []AxialCoord
+	for unitCoord, unit := range g.World.UnitsByCoord() {
+		if unit.Player != player {
+			continue
+		}
+		if unitCoord == coord {
+			coords = append(coords, unitCoord)
+			continue
+		}
+		movement := g.movementRange(unit)
+		if movement <= 0 {
+			continue
+		}
+		fill := g.movementFill(unitCoord, unit.UnitType, movement)
+		if _, ok := fill.Edges[CoordKeyFromAxial(coord)]; ok {
+			coords = append(coords, unitCoord)
+		}
+	}
+	sortCoords(coords)
+
+	units := make([]*v1.Unit, len(coords))
+	for i, c := range coords {
+		units[i] = g.World.UnitAt(c)
+	}
+	return units
+}
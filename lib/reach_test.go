@@ -0,0 +1,105 @@
+package lib
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+func reachCoords(units []*v1.Unit) []AxialCoord {
+	coords := make([]AxialCoord, len(units))
+	for i, unit := range units {
+		coords[i] = UnitGetCoord(unit)
+	}
+	return coords
+}
+
+// TestGetUnitsThatCanReach_Base tests only the player's units within
+// movement range of a base are returned
+func TestGetUnitsThatCanReach_Base(t *testing.T) {
+	game := newTestGameBuilder().
+		tile(3, 0, TileTypeLandBase, 0).
+		grassTiles(6).
+		unit(0, 0, 1, testUnitTypeSoldier).  // 3 hexes away
+		unit(-3, 0, 1, testUnitTypeSoldier). // 6 hexes away
+		unit(3, -1, 1, testUnitTypeTank).    // Adjacent
+		unit(2, 0, 2, testUnitTypeSoldier).  // Enemy next to the base
+		currentPlayer(1).
+		build()
+
+	got := reachCoords(game.GetUnitsThatCanReach(AxialCoord{Q: 3, R: 0}, 1))
+	want := []AxialCoord{{Q: 0, R: 0}, {Q: 3, R: -1}}
+	if len(got) != len(want) {
+		t.Fatalf("units that can reach the base = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("units that can reach the base = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+// TestGetUnitsThatCanReach_RefreshesAfterMove tests the cached flood-fills
+// are dropped once units move
+func TestGetUnitsThatCanReach_RefreshesAfterMove(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(6).
+		unit(-3, 0, 1, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	target := AxialCoord{Q: 3, R: 0}
+
+	if units := game.GetUnitsThatCanReach(target, 1); len(units) != 0 {
+		t.Fatalf("got %d units, want none before the move", len(units))
+	}
+
+	unit := game.World.UnitAt(AxialCoord{Q: -3, R: 0})
+	if err := game.World.MoveUnit(unit, AxialCoord{Q: 1, R: 0}); err != nil {
+		t.Fatalf("MoveUnit failed: %v", err)
+	}
+	if units := game.GetUnitsThatCanReach(target, 1); len(units) != 1 {
+		t.Errorf("got %d units, want the moved unit", len(units))
+	}
+}
+
+// TestGetUnitsThatCanReach_RefreshesAfterReload tests the cached flood-fills
+// are dropped once the rules are reloaded
+func TestGetUnitsThatCanReach_RefreshesAfterReload(t *testing.T) {
+	rulesFile := filepath.Join(t.TempDir(), "rules.json")
+	writeGrassCost := func(cost string) {
+		rules := strings.Replace(reloadFixture, "COINS", "75", 1)
+		rules = strings.Replace(rules, `"movement_cost": 1`, `"movement_cost": `+cost, 1)
+		if err := os.WriteFile(rulesFile, []byte(rules), 0644); err != nil {
+			t.Fatalf("failed to write fixture: %v", err)
+		}
+	}
+	writeGrassCost("1")
+	rules, err := LoadRulesEngineFromFile(rulesFile, "")
+	if err != nil {
+		t.Fatalf("failed to load rules: %v", err)
+	}
+
+	game := newTestGameBuilder().
+		grassTiles(6).
+		unit(-3, 0, 1, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	game.RulesEngine = rules
+	target := AxialCoord{Q: 0, R: 0}
+
+	if units := game.GetUnitsThatCanReach(target, 1); len(units) != 1 {
+		t.Fatalf("got %d units, want the soldier 3 hexes away", len(units))
+	}
+
+	writeGrassCost("2")
+	if err := rules.Reload(); err != nil {
+		t.Fatalf("Reload() failed: %v", err)
+	}
+	if units := game.GetUnitsThatCanReach(target, 1); len(units) != 0 {
+		t.Errorf("got %d units, want none once grass costs 2", len(units))
+	}
+}
@@ -0,0 +1,121 @@
+package lib
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+)
+
+// =============================================================================
+// Reach Traces
+// =============================================================================
+//
+// A reach trace records everything a unit's movement flood-fill looked at:
+// the cheapest cost each hex was reached at, where from, and why the fill did
+// not expand past it.  Tracing is off unless a trace is passed in, so normal
+// movement queries don't pay for it.
+
+// ReachStop says why a movement flood-fill did not expand past a hex
+type ReachStop string
+
+const (
+	ReachStopImpassable ReachStop = "impassable" // the unit can't enter the terrain
+	ReachStopOccupied   ReachStop = "occupied"   // another unit blocks moving through
+	ReachStopFootprint  ReachStop = "footprint"  // no room for a multi-hex unit
+	ReachStopBudget     ReachStop = "budget"     // entering costs more movement than is left
+	ReachStopHazard     ReachStop = "hazard"     // units entering the hex must stop there
+)
+
+// ReachHex is what a movement flood-fill found out about one hex
+type ReachHex struct {
+	Coord AxialCoord `json:"coord"`
+
+	// Cheapest cost the hex was reached at, or the cheapest attempt at it if
+	// it was never reached
+	Cost float64 `json:"cost"`
+
+	// Hex the cost was reached or attempted from
+	From AxialCoord `json:"from"`
+
+	Reached bool `json:"reached"`
+
+	// Occupied hexes can be moved through but not ended on
+	Occupied bool `json:"occupied,omitempty"`
+
+	// Why the fill went no further, empty if it expanded past the hex
+	Stop ReachStop `json:"stop,omitempty"`
+}
+
+// ReachTrace is the full record of one movement flood-fill
+type ReachTrace struct {
+	Unit        string               `json:"unit"`
+	Source      AxialCoord           `json:"source"`
+	MaxMovement float64              `json:"maxMovement"`
+	Hexes       map[string]*ReachHex `json:"hexes"` // keyed by "q,r"
+}
+
+// reached records a hex the fill reached at cost, replacing any costlier or
+// failed attempt at it
+func (t *ReachTrace) reached(coord, from AxialCoord, cost float64, occupied bool, stop ReachStop) {
+	if t == nil {
+		return
+	}
+	t.Hexes[CoordKeyFromAxial(coord)] = &ReachHex{Coord: coord, Cost: cost, From: from, Reached: true, Occupied: occupied, Stop: stop}
+}
+
+// blocked records a failed attempt to enter a hex, unless the hex was
+// reached or a cheaper attempt was already recorded
+func (t *ReachTrace) blocked(coord, from AxialCoord, cost float64, stop ReachStop) {
+	if t == nil {
+		return
+	}
+	if existing := t.Hexes[CoordKeyFromAxial(coord)]; existing != nil && (existing.Reached || existing.Cost <= cost) {
+		return
+	}
+	t.Hexes[CoordKeyFromAxial(coord)] = &ReachHex{Coord: coord, Cost: cost, From: from, Stop: stop}
+}
+
+// SortedHexes returns the traced hexes, reached ones first, then by cost and
+// position
+func (t *ReachTrace) SortedHexes() []*ReachHex {
+	hexes := make([]*ReachHex, 0, len(t.Hexes))
+	for _, hex := range t.Hexes {
+		hexes = append(hexes, hex)
+	}
+	slices.SortFunc(hexes, func(a, b *ReachHex) int {
+		if a.Reached != b.Reached {
+			if a.Reached {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Or(cmp.Compare(a.Cost, b.Cost), cmp.Compare(a.Coord.Q, b.Coord.Q), cmp.Compare(a.Coord.R, b.Coord.R))
+	})
+	return hexes
+}
+
+// TraceReach runs the movement flood-fill for the unit at position and
+// records everything it looked at.  The unit gets the movement it would have
+// when its options are shown, whole points only, as in GetMovementOptions.
+func (g *Game) TraceReach(position string) (*ReachTrace, error) {
+	target, err := g.Pos(position)
+	if err != nil {
+		return nil, fmt.Errorf("invalid position: %w", err)
+	}
+	unit := g.World.UnitAt(target.Coordinate)
+	if unit == nil {
+		return nil, fmt.Errorf("no unit at %s", target.Coordinate)
+	}
+
+	trace := &ReachTrace{
+		Unit:        unit.Shortcut,
+		Source:      target.Coordinate,
+		MaxMovement: float64(int(g.movementRange(unit))),
+		Hexes:       map[string]*ReachHex{},
+	}
+	if _, err := g.RulesEngine.dijkstraMovementContext(context.Background(), g.World, unit.UnitType, trace.Source, trace.MaxMovement, false, trace); err != nil {
+		return nil, err
+	}
+	return trace, nil
+}
@@ -0,0 +1,55 @@
+package lib
+
+import (
+	"testing"
+)
+
+// TestTraceReach_MatchesMovementOptions tests the trace agrees with the
+// movement options computed without one, and says why the fill stopped at
+// the edge of the unit's range
+func TestTraceReach_MatchesMovementOptions(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(5).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+
+	trace, err := game.TraceReach("A1")
+	if err != nil {
+		t.Fatalf("TraceReach failed: %v", err)
+	}
+	if trace.Unit != "A1" || trace.MaxMovement != 3 {
+		t.Fatalf("traced %s with %v movement, want A1 with 3", trace.Unit, trace.MaxMovement)
+	}
+
+	allPaths, err := game.RulesEngine.GetMovementOptions(game.World, game.World.UnitAt(AxialCoord{}), 3, false)
+	if err != nil {
+		t.Fatalf("GetMovementOptions failed: %v", err)
+	}
+	reached := 0
+	for _, hex := range trace.Hexes {
+		if hex.Reached {
+			reached++
+		}
+	}
+	if reached != len(allPaths.Edges)+1 {
+		t.Errorf("trace reached %d hexes, want the %d movement edges and the source", reached, len(allPaths.Edges))
+	}
+	for key, edge := range allPaths.Edges {
+		hex := trace.Hexes[key]
+		if hex == nil || !hex.Reached || hex.Cost != edge.TotalCost || hex.From != CoordFromInt32(edge.FromQ, edge.FromR) {
+			t.Errorf("trace has %+v at %s, want it reached at cost %v from %d,%d", hex, key, edge.TotalCost, edge.FromQ, edge.FromR)
+		}
+	}
+
+	if hex := trace.Hexes["1,0"]; hex == nil || !hex.Occupied || hex.Stop != "" {
+		t.Errorf("enemy's hex traced as %+v, want reached, occupied and expanded past", hex)
+	}
+	if hex := trace.Hexes["4,0"]; hex == nil || hex.Reached || hex.Stop != ReachStopBudget || hex.Cost <= trace.MaxMovement {
+		t.Errorf("hex 4 away traced as %+v, want stopped by the movement budget", hex)
+	}
+	if sorted := trace.SortedHexes(); len(sorted) != len(trace.Hexes) || sorted[0].Coord != (AxialCoord{}) || sorted[len(sorted)-1].Reached {
+		t.Error("SortedHexes does not start at the source and end with the hexes never reached")
+	}
+}
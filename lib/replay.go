@@ -0,0 +1,97 @@
+package lib
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Replays
+// =============================================================================
+//
+// Replays rebuild a game part way through its move log, eg for a scrubber
+// over a finished game.  Moves are replayed from the changes they recorded, so
+// dice rolls come out as they did when the game was played.  The game replayed
+// from must be the game as it started, and is left untouched.
+
+// GetStateAtMove returns a copy of the game with the first index moves
+// replayed
+func (g *Game) GetStateAtMove(moves []*v1.GameMove, index int) (*Game, error) {
+	if index < 0 || index > len(moves) {
+		return nil, fmt.Errorf("move index %d is outside the %d moves played", index, len(moves))
+	}
+	return g.replay(moves[:index], func(*Game) bool { return false })
+}
+
+// GetReplayState returns a copy of the game as it was at the start of a turn
+func (g *Game) GetReplayState(moves []*v1.GameMove, atTurn int32) (*Game, error) {
+	out, err := g.replay(moves, func(replayed *Game) bool { return replayed.TurnCounter >= atTurn })
+	if err != nil {
+		return nil, err
+	}
+	if out.TurnCounter < atTurn {
+		return nil, fmt.Errorf("turn %d was never reached, the game ended in turn %d", atTurn, out.TurnCounter)
+	}
+	return out, nil
+}
+
+// replay applies moves to a copy of the game until done says to stop
+func (g *Game) replay(moves []*v1.GameMove, done func(*Game) bool) (*Game, error) {
+	state := proto.Clone(g.GameState).(*v1.GameState)
+	state.WorldData = mergedWorldData(g.World)
+	out := NewGame(g.Game, state, NewWorld(g.World.Name, state.WorldData), g.RulesEngine, g.Seed)
+
+	for i, move := range moves {
+		if done(out) {
+			break
+		}
+		// Applying a built unit's change adds that very unit to the world, so
+		// moves are copied before they are replayed
+		if err := out.ApplyChanges([]*v1.GameMove{proto.Clone(move).(*v1.GameMove)}); err != nil {
+			return nil, fmt.Errorf("failed to replay move %d: %w", i, err)
+		}
+	}
+	out.GameState.WorldData = out.World.WorldData()
+	return out, nil
+}
+
+// mergedWorldData returns a copy of the world's data with any transaction
+// layers it is pushed on merged in, as a layer's own data only holds what
+// changed in it
+func mergedWorldData(w *World) *v1.WorldData {
+	if w.parent == nil {
+		return proto.Clone(w.data).(*v1.WorldData)
+	}
+	out := mergedWorldData(w.parent)
+	if out.TilesMap == nil {
+		out.TilesMap = map[string]*v1.Tile{}
+	}
+	if out.UnitsMap == nil {
+		out.UnitsMap = map[string]*v1.Unit{}
+	}
+	if out.Crossings == nil {
+		out.Crossings = map[string]*v1.Crossing{}
+	}
+	for key, tile := range w.data.TilesMap {
+		out.TilesMap[key] = proto.Clone(tile).(*v1.Tile)
+	}
+	for key, deleted := range w.tileDeleted {
+		if _, ok := w.data.TilesMap[key]; deleted && !ok {
+			delete(out.TilesMap, key)
+		}
+	}
+	for key, unit := range w.data.UnitsMap {
+		out.UnitsMap[key] = proto.Clone(unit).(*v1.Unit)
+	}
+	for key, deleted := range w.unitDeleted {
+		if _, ok := w.data.UnitsMap[key]; deleted && !ok {
+			delete(out.UnitsMap, key)
+		}
+	}
+	for key, crossing := range w.data.Crossings {
+		out.Crossings[key] = proto.Clone(crossing).(*v1.Crossing)
+	}
+	return out
+}
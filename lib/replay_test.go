@@ -0,0 +1,93 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// playReplayGame plays two turns each on a copy of the game built by
+// builder, and returns the moves played
+func playReplayGame(t *testing.T, builder *testGameBuilder) []*v1.GameMove {
+	t.Helper()
+	game := builder.build()
+	moves := []*v1.GameMove{
+		moveUnitMove(0, 0, 1, 0), endTurnMove(),
+		moveUnitMove(0, 2, -1, 2), endTurnMove(),
+		moveUnitMove(1, 0, 2, 0), endTurnMove(),
+		moveUnitMove(-1, 2, -2, 2), endTurnMove(),
+	}
+	for i, move := range moves {
+		if err := game.ProcessMove(move); err != nil {
+			t.Fatalf("move %d failed: %v", i, err)
+		}
+	}
+	return moves
+}
+
+// TestGetStateAtMove_Midpoint tests replaying half a game puts the units
+// where they were after that many moves, and leaves the starting game as it
+// was
+func TestGetStateAtMove_Midpoint(t *testing.T) {
+	builder := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(0, 2, 2, testUnitTypeSoldier).
+		currentPlayer(1)
+	moves := playReplayGame(t, builder)
+	start := builder.build()
+
+	mid, err := start.GetStateAtMove(moves, len(moves)/2)
+	if err != nil {
+		t.Fatalf("GetStateAtMove failed: %v", err)
+	}
+	for _, want := range []struct {
+		coord  AxialCoord
+		player int32
+	}{{AxialCoord{Q: 1, R: 0}, 1}, {AxialCoord{Q: -1, R: 2}, 2}} {
+		if unit := mid.World.UnitAt(want.coord); unit == nil || unit.Player != want.player {
+			t.Errorf("unit at %v = %v, want player %d's soldier", want.coord, unit, want.player)
+		}
+	}
+	if mid.World.NumUnits() != 2 {
+		t.Errorf("%d units at the midpoint, want 2", mid.World.NumUnits())
+	}
+	if mid.CurrentPlayer != 1 || mid.TurnCounter != 2 {
+		t.Errorf("midpoint is player %d's turn %d, want player 1's turn 2", mid.CurrentPlayer, mid.TurnCounter)
+	}
+	if mid.GameState.WorldData.UnitsMap["1,0"] == nil {
+		t.Error("midpoint state's world data does not have the moved unit")
+	}
+
+	if start.World.UnitAt(AxialCoord{Q: 0, R: 0}) == nil || start.TurnCounter != 1 {
+		t.Error("replaying changed the game it started from")
+	}
+
+	if _, err := start.GetStateAtMove(moves, len(moves)+1); err == nil {
+		t.Error("replaying past the last move was accepted")
+	}
+}
+
+// TestGetReplayState_StartOfTurn tests replaying to a turn stops at its
+// first move
+func TestGetReplayState_StartOfTurn(t *testing.T) {
+	builder := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(0, 2, 2, testUnitTypeSoldier).
+		currentPlayer(1)
+	moves := playReplayGame(t, builder)
+	start := builder.build()
+
+	turn2, err := start.GetReplayState(moves, 2)
+	if err != nil {
+		t.Fatalf("GetReplayState failed: %v", err)
+	}
+	if turn2.World.UnitAt(AxialCoord{Q: 1, R: 0}) == nil || turn2.World.UnitAt(AxialCoord{Q: 2, R: 0}) != nil {
+		t.Error("turn 2 replay did not stop before player 1's second move")
+	}
+
+	if _, err := start.GetReplayState(moves, 5); err == nil {
+		t.Error("replaying to a turn the game never reached was accepted")
+	}
+}
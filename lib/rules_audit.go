@@ -0,0 +1,159 @@
+package lib
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Rules Audit
+// =============================================================================
+//
+// Units missing data the engine relies on (eg an empty action_order) fall
+// back to permissive defaults instead of failing, so the gaps are easy to
+// miss.  Loading rules audits every unit and records a warning for each gap.
+// Known gaps are annotated in the rules file under "auditAnnotations", keyed
+// by unit ID then field, with the reason the gap is expected:
+//
+//	"auditAnnotations": {"11": {"action_order": "never built"}}
+
+// Fields the rules audit checks on every unit
+const (
+	AuditActionOrder   = "action_order"
+	AuditUnitClass     = "unit_class"
+	AuditUnitTerrain   = "unit_terrain"
+	AuditMovementCosts = "movement_costs"
+	AuditHealth        = "health"
+)
+
+// RulesWarning is a gap in a unit's rules data
+type RulesWarning struct {
+	UnitID   int32  `json:"unit_id"`
+	UnitName string `json:"unit_name"`
+	Field    string `json:"field"`
+	Message  string `json:"message"`
+
+	// Why the gap is expected, when the rules file annotates it
+	Annotation string `json:"annotation,omitempty"`
+}
+
+func (w RulesWarning) String() string {
+	s := fmt.Sprintf("unit %d (%s) %s: %s", w.UnitID, w.UnitName, w.Field, w.Message)
+	if w.Annotation != "" {
+		s += fmt.Sprintf(" [annotated: %s]", w.Annotation)
+	}
+	return s
+}
+
+// GetRulesAudit returns the warnings found when the rules were loaded,
+// ordered by unit ID
+func (re *RulesEngine) GetRulesAudit() []RulesWarning {
+	return re.tables.Load().audit
+}
+
+// UnannotatedWarnings returns the audit warnings the rules file does not
+// explain
+func (re *RulesEngine) UnannotatedWarnings() (warnings []RulesWarning) {
+	for _, w := range re.GetRulesAudit() {
+		if w.Annotation == "" {
+			warnings = append(warnings, w)
+		}
+	}
+	return
+}
+
+// CheckRulesAudit is the strict audit: it fails when any warning is not
+// annotated in the rules file
+func (re *RulesEngine) CheckRulesAudit() error {
+	warnings := re.UnannotatedWarnings()
+	if len(warnings) == 0 {
+		return nil
+	}
+	lines := make([]string, len(warnings))
+	for i, w := range warnings {
+		lines[i] = w.String()
+	}
+	return fmt.Errorf("rules audit found %d unannotated gaps:\n  %s", len(warnings), strings.Join(lines, "\n  "))
+}
+
+// auditRules checks every unit has the data the engine relies on
+func (re *RulesEngine) auditRules(annotations map[int32]map[string]string) (warnings []RulesWarning) {
+	units := re.GetUnits()
+	ids := make([]int32, 0, len(units))
+	for id := range units {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		unit := units[id]
+		warn := func(field, message string) {
+			warnings = append(warnings, RulesWarning{
+				UnitID:     id,
+				UnitName:   unit.Name,
+				Field:      field,
+				Message:    message,
+				Annotation: annotations[id][field],
+			})
+		}
+		if len(unit.ActionOrder) == 0 {
+			warn(AuditActionOrder, "empty, falls back to move then attack|capture")
+		}
+		if unit.UnitClass == "" {
+			warn(AuditUnitClass, "missing")
+		}
+		if unit.UnitTerrain == "" {
+			warn(AuditUnitTerrain, "missing")
+		}
+		if !hasMovementCost(unit) {
+			warn(AuditMovementCosts, "no terrain the unit can move on")
+		}
+		if unit.Health <= 0 {
+			warn(AuditHealth, "no max health")
+		}
+	}
+	return
+}
+
+// hasMovementCost reports whether the unit can move on at least one terrain
+func hasMovementCost(unit *v1.UnitDefinition) bool {
+	for _, props := range unit.TerrainProperties {
+		if props.MovementCost > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAuditAnnotations reads the rules file's auditAnnotations
+func parseAuditAnnotations(rawData map[string]any) (map[int32]map[string]string, error) {
+	raw, ok := rawData["auditAnnotations"].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	annotations := make(map[int32]map[string]string)
+	for idStr, fieldsRaw := range raw {
+		parsed, err := strconv.ParseInt(idStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("audit annotation for invalid unit ID %q", idStr)
+		}
+		id := int32(parsed)
+		fields, ok := fieldsRaw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("audit annotations for unit %d must map fields to reasons", id)
+		}
+		annotations[id] = make(map[string]string)
+		for field, reason := range fields {
+			text, ok := reason.(string)
+			if !ok || text == "" {
+				return nil, fmt.Errorf("audit annotation for unit %d %s needs a reason", id, field)
+			}
+			annotations[id][field] = text
+		}
+	}
+	return annotations, nil
+}
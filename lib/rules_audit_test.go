@@ -0,0 +1,56 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+)
+
+// brokenRulesJSON has one complete unit and one missing everything the audit
+// checks, with its missing health annotated
+const brokenRulesJSON = `{
+  "terrains": {"5": {"id": 5, "name": "Grass"}},
+  "units": {
+    "1": {"id": 1, "name": "Soldier", "health": 10, "unit_class": "Light", "unit_terrain": "Land", "action_order": ["move", "attack"]},
+    "2": {"id": 2, "name": "Ghost"}
+  },
+  "terrainUnitProperties": {"5:1": {"terrain_id": 5, "unit_id": 1, "movement_cost": 1}},
+  "auditAnnotations": {"2": {"health": "ghosts cannot be hurt"}}
+}`
+
+func TestRulesAuditCatchesGaps(t *testing.T) {
+	re, err := LoadRulesEngineFromJSON([]byte(brokenRulesJSON), nil)
+	if err != nil {
+		t.Fatalf("LoadRulesEngineFromJSON error: %v", err)
+	}
+
+	fields := map[string]string{}
+	for _, w := range re.GetRulesAudit() {
+		if w.UnitID != 2 {
+			t.Errorf("unexpected warning for a complete unit: %v", w)
+		}
+		fields[w.Field] = w.Annotation
+	}
+	for _, field := range []string{AuditActionOrder, AuditUnitClass, AuditUnitTerrain, AuditMovementCosts, AuditHealth} {
+		if _, ok := fields[field]; !ok {
+			t.Errorf("audit missed the ghost's %s", field)
+		}
+	}
+	if fields[AuditHealth] != "ghosts cannot be hurt" {
+		t.Errorf("health annotation = %q, want the rules file's reason", fields[AuditHealth])
+	}
+
+	if got := len(re.UnannotatedWarnings()); got != 4 {
+		t.Errorf("%d unannotated warnings, want 4", got)
+	}
+	err = re.CheckRulesAudit()
+	if err == nil || !strings.Contains(err.Error(), "unit 2 (Ghost) action_order") {
+		t.Errorf("CheckRulesAudit() = %v, want it to fail naming the ghost's action order", err)
+	}
+}
+
+// TestShippedRulesAudit tests every gap in the shipped rules is annotated
+func TestShippedRulesAudit(t *testing.T) {
+	if err := DefaultRulesEngine().CheckRulesAudit(); err != nil {
+		t.Error(err)
+	}
+}
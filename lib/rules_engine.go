@@ -2,7 +2,12 @@ package lib
 
 import (
 	"container/heap"
+	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/turnforge/lilbattle/assets"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
@@ -12,10 +17,11 @@ import (
 // Priority Queue for Dijkstra's Algorithm (heap-based, O(log n) operations)
 // =============================================================================
 
-// dijkstraItem represents a coordinate with its movement cost for the priority queue
+// dijkstraItem represents a coordinate with its movement cost, in quarters,
+// for the priority queue
 type dijkstraItem struct {
 	coord AxialCoord
-	cost  float64
+	cost  int
 	index int // index in the heap, maintained by heap.Interface
 }
 
@@ -51,9 +57,33 @@ func (h *dijkstraHeap) Pop() any {
 // Rules Engine - Extends existing types with data-driven rules
 // =============================================================================
 
-// RulesEngine embeds the proto-based rules engine
+// RulesEngine holds the proto-based rules tables.  The tables sit behind an
+// atomic pointer so that Reload can swap them while games read them; every
+// read goes through Rules or one of the Get accessors.
 type RulesEngine struct {
-	*v1.RulesEngine
+	tables atomic.Pointer[rulesTables]
+
+	// Bumped by every Reload, so that caches of results derived from the
+	// rules can tell they are stale
+	generation atomic.Uint64
+
+	// Source files this engine was loaded from (set by LoadRulesEngineFromFile)
+	// so that Reload can re-read them during development.
+	rulesFile  string
+	damageFile string
+}
+
+// rulesTables is one version of an engine's rules.  It is never modified
+// once the engine is shared; Reload replaces it as a whole.
+type rulesTables struct {
+	rules *v1.RulesEngine
+
+	// Gaps in the unit data found when loading, see GetRulesAudit
+	audit []RulesWarning
+
+	// Fingerprint of rules, computed on first use, see Hash
+	hashOnce sync.Once
+	hash     string
 }
 
 // =============================================================================
@@ -62,60 +92,126 @@ type RulesEngine struct {
 
 // NewRulesEngine creates a new rules engine instance
 func NewRulesEngine() *RulesEngine {
-	return &RulesEngine{
-		RulesEngine: &v1.RulesEngine{
-			Units:                 make(map[int32]*v1.UnitDefinition),
-			Terrains:              make(map[int32]*v1.TerrainDefinition),
-			TerrainUnitProperties: make(map[string]*v1.TerrainUnitProperties),
-			UnitUnitProperties:    make(map[string]*v1.UnitUnitProperties),
-		},
-	}
+	return NewRulesEngineFrom(&v1.RulesEngine{
+		Units:                 make(map[int32]*v1.UnitDefinition),
+		Terrains:              make(map[int32]*v1.TerrainDefinition),
+		TerrainUnitProperties: make(map[string]*v1.TerrainUnitProperties),
+		UnitUnitProperties:    make(map[string]*v1.UnitUnitProperties),
+	})
+}
+
+// NewRulesEngineFrom creates a rules engine over the given tables, which the
+// engine takes ownership of
+func NewRulesEngineFrom(rules *v1.RulesEngine) *RulesEngine {
+	re := &RulesEngine{}
+	re.tables.Store(&rulesTables{rules: rules})
+	return re
+}
+
+// Rules returns the engine's current tables.  A reload swaps in new tables
+// rather than changing these, so a caller that reads several of them should
+// call Rules once and read them all from its result.
+func (re *RulesEngine) Rules() *v1.RulesEngine {
+	return re.tables.Load().rules
+}
+
+// Generation counts the reloads of the engine's tables
+func (re *RulesEngine) Generation() uint64 {
+	return re.generation.Load()
+}
+
+// GetUnits returns the unit definitions by unit type
+func (re *RulesEngine) GetUnits() map[int32]*v1.UnitDefinition {
+	return re.Rules().GetUnits()
+}
+
+// GetTerrains returns the terrain definitions by tile type
+func (re *RulesEngine) GetTerrains() map[int32]*v1.TerrainDefinition {
+	return re.Rules().GetTerrains()
+}
+
+// GetTerrainUnitProperties returns the terrain-unit properties keyed by
+// "terrain:unit"
+func (re *RulesEngine) GetTerrainUnitProperties() map[string]*v1.TerrainUnitProperties {
+	return re.Rules().GetTerrainUnitProperties()
+}
+
+// GetUnitUnitProperties returns the combat properties keyed by
+// "attacker:defender"
+func (re *RulesEngine) GetUnitUnitProperties() map[string]*v1.UnitUnitProperties {
+	return re.Rules().GetUnitUnitProperties()
+}
+
+// GetTerrainTypes returns the terrain type (city, nature, ...) of each tile
+// type
+func (re *RulesEngine) GetTerrainTypes() map[int32]v1.TerrainType {
+	return re.Rules().GetTerrainTypes()
+}
+
+// GetMultiHexUnits reports whether units with a footprint cover more than
+// one hex
+func (re *RulesEngine) GetMultiHexUnits() bool {
+	return re.Rules().GetMultiHexUnits()
+}
+
+// GetMandatoryActions returns the kinds of pending obligation a player must
+// resolve before ending a turn
+func (re *RulesEngine) GetMandatoryActions() []string {
+	return re.Rules().GetMandatoryActions()
+}
+
+// GetMaxUnitsPerPlayer returns the unit cap, 0 for none
+func (re *RulesEngine) GetMaxUnitsPerPlayer() int32 {
+	return re.Rules().GetMaxUnitsPerPlayer()
 }
 
 // Note: Default terrain data has been migrated to proto definitions.
 // Use LoadRulesEngineFromJSON to load terrain definitions from proto-based data.
 
 var (
-	defaultRulesEngine *RulesEngine
+	// Held atomically so dev-mode hot reloads can swap it while serving requests
+	defaultRulesEngine atomic.Pointer[RulesEngine]
 )
 
 func init() {
-	var err error
-	defaultRulesEngine, err = LoadRulesEngineFromJSON(assets.RulesDataJSON, assets.RulesDamageDataJSON)
+	re, err := LoadRulesEngineFromJSON(assets.RulesDataJSON, assets.RulesDamageDataJSON)
 	if err != nil {
 		panic(err)
 	}
+	defaultRulesEngine.Store(re)
 }
 
 // GetDefaultRulesEngine returns a font family that works in WASM environments
 func DefaultRulesEngine() *RulesEngine {
-	return defaultRulesEngine
+	return defaultRulesEngine.Load()
 }
 
 // PopulateReferenceMaps populates the terrain/unit property reference maps for fast lookup
 // This should be called after loading the centralized properties
 func (re *RulesEngine) PopulateReferenceMaps() {
+	rules := re.Rules()
+
 	// Initialize reference maps in units and terrains
-	for _, unit := range re.Units {
+	for _, unit := range rules.Units {
 		if unit.TerrainProperties == nil {
 			unit.TerrainProperties = make(map[int32]*v1.TerrainUnitProperties)
 		}
 	}
-	for _, terrain := range re.Terrains {
+	for _, terrain := range rules.Terrains {
 		if terrain.UnitProperties == nil {
 			terrain.UnitProperties = make(map[int32]*v1.TerrainUnitProperties)
 		}
 	}
 
 	// Populate reference maps from centralized properties
-	for _, props := range re.TerrainUnitProperties {
+	for _, props := range rules.TerrainUnitProperties {
 		// Add to unit's terrain map
-		if unit := re.Units[props.UnitId]; unit != nil {
+		if unit := rules.Units[props.UnitId]; unit != nil {
 			unit.TerrainProperties[props.TerrainId] = props
 		}
 
 		// Add to terrain's unit map
-		if terrain := re.Terrains[props.TerrainId]; terrain != nil {
+		if terrain := rules.Terrains[props.TerrainId]; terrain != nil {
 			terrain.UnitProperties[props.UnitId] = props
 		}
 	}
@@ -127,7 +223,7 @@ func (re *RulesEngine) PopulateReferenceMaps() {
 
 // GetUnitData returns unit data by ID (enhanced version of existing function)
 func (re *RulesEngine) GetUnitData(unitID int32) (*v1.UnitDefinition, error) {
-	unit, exists := re.Units[unitID]
+	unit, exists := re.GetUnits()[unitID]
 	if !exists {
 		return nil, fmt.Errorf("unit ID %d not found", unitID)
 	}
@@ -137,7 +233,7 @@ func (re *RulesEngine) GetUnitData(unitID int32) (*v1.UnitDefinition, error) {
 
 // GetTerrainData returns terrain data by ID
 func (re *RulesEngine) GetTerrainData(terrainID int32) (*v1.TerrainDefinition, error) {
-	terrain, exists := re.Terrains[terrainID]
+	terrain, exists := re.GetTerrains()[terrainID]
 	if !exists {
 		return nil, fmt.Errorf("terrain ID %d not found", terrainID)
 	}
@@ -151,7 +247,7 @@ func (re *RulesEngine) GetTerrainData(terrainID int32) (*v1.TerrainDefinition, e
 
 // GetTerrainType returns the terrain type classification for a terrain ID
 func (re *RulesEngine) GetTerrainType(terrainID int32) v1.TerrainType {
-	if t, ok := re.TerrainTypes[terrainID]; ok {
+	if t, ok := re.GetTerrainTypes()[terrainID]; ok {
 		return t
 	}
 	return v1.TerrainType_TERRAIN_TYPE_UNSPECIFIED
@@ -186,7 +282,7 @@ func (re *RulesEngine) IsRoadTerrain(terrainID int32) bool {
 // This is useful for themes that need to know which terrains use player colors.
 func (re *RulesEngine) GetCityTerrains() map[int32]bool {
 	result := make(map[int32]bool)
-	for terrainID, terrainType := range re.TerrainTypes {
+	for terrainID, terrainType := range re.GetTerrainTypes() {
 		if terrainType == v1.TerrainType_TERRAIN_TYPE_CITY {
 			result[terrainID] = true
 		}
@@ -194,6 +290,16 @@ func (re *RulesEngine) GetCityTerrains() map[int32]bool {
 	return result
 }
 
+// GetUnitMaxHealth returns the max health of each unit type, for renderers
+// drawing health relative to it
+func (re *RulesEngine) GetUnitMaxHealth() map[int32]int32 {
+	result := make(map[int32]int32)
+	for unitID, unitDef := range re.GetUnits() {
+		result[unitID] = unitDef.Health
+	}
+	return result
+}
+
 // =============================================================================
 // Action Progression System
 // =============================================================================
@@ -201,11 +307,7 @@ func (re *RulesEngine) GetCityTerrains() map[int32]bool {
 // GetAllowedActionsForUnit returns which actions are currently valid for a unit
 // based on its progression_step index into the UnitDefinition.action_order
 func (re *RulesEngine) GetAllowedActionsForUnit(unit *v1.Unit, unitDef *v1.UnitDefinition) []string {
-	// Get action_order, default to ["move", "attack|capture"]
-	actionOrder := unitDef.ActionOrder
-	if len(actionOrder) == 0 {
-		actionOrder = []string{"move", "attack|capture"}
-	}
+	actionOrder := unitActionOrder(unitDef)
 
 	// Check if all steps complete
 	if unit.ProgressionStep >= int32(len(actionOrder)) {
@@ -233,6 +335,92 @@ func (re *RulesEngine) GetAllowedActionsForUnit(unit *v1.Unit, unitDef *v1.UnitD
 	return allowed
 }
 
+// ErrInvalidActionForProgression is returned when a unit attempts an action
+// its action_order doesn't allow at its current progression step.
+var ErrInvalidActionForProgression = errors.New("invalid action for progression")
+
+// ResolveActionStep returns the progression step at which the unit can
+// perform action right now. Besides the current step's own actions, a move
+// or retreat step also allows the next step's actions, since performing one
+// of them ends the point-based step early.
+func (re *RulesEngine) ResolveActionStep(unit *v1.Unit, unitDef *v1.UnitDefinition, action string) (int32, bool) {
+	if ContainsAction(re.GetAllowedActionsForUnit(unit, unitDef), action) {
+		return unit.ProgressionStep, true
+	}
+	if !isPointBasedStep(unit, unitDef) {
+		return 0, false
+	}
+	nextStepUnit := &v1.Unit{ProgressionStep: unit.ProgressionStep + 1}
+	if ContainsAction(re.GetAllowedActionsForUnit(nextStepUnit, unitDef), action) {
+		return nextStepUnit.ProgressionStep, true
+	}
+	return 0, false
+}
+
+// AdvanceProgression records that the unit performed a single-shot action
+// (attack, capture, fix, construct) at step (as returned by
+// ResolveActionStep). The unit stays on the step, committed to the action,
+// until it has performed it as many times as its action_limits allow, then
+// moves on to the following step. If that step is a retreat, the unit gets
+// its retreat points to spend.
+func (re *RulesEngine) AdvanceProgression(unit *v1.Unit, unitDef *v1.UnitDefinition, action string, step int32) {
+	actionOrder := unitActionOrder(unitDef)
+	if step != unit.ProgressionStep {
+		unit.ProgressionStep = step
+		unit.ChosenAlternative = ""
+		unit.StepActions = 0
+	}
+
+	unit.StepActions++
+	if unit.StepActions < actionLimit(unitDef, action) {
+		if int(step) < len(actionOrder) && strings.Contains(actionOrder[step], "|") {
+			unit.ChosenAlternative = action
+		}
+		return
+	}
+
+	// The action has used up its step
+	unit.ProgressionStep++
+	unit.ChosenAlternative = ""
+	unit.StepActions = 0
+
+	if int(unit.ProgressionStep) < len(actionOrder) && actionOrder[unit.ProgressionStep] == "retreat" {
+		unit.DistanceLeft = re.RoundMovementPoints(unitDef.RetreatPoints)
+	}
+}
+
+// actionLimit returns how many times a unit may perform action at one step
+// of its action_order, from its action_limits (default 1)
+func actionLimit(unitDef *v1.UnitDefinition, action string) int32 {
+	if limit := unitDef.GetActionLimits()[action]; limit > 0 {
+		return limit
+	}
+	return 1
+}
+
+// unitActionOrder returns the unit's action_order, defaulting to
+// ["move", "attack|capture"]
+func unitActionOrder(unitDef *v1.UnitDefinition) []string {
+	if len(unitDef.ActionOrder) == 0 {
+		return []string{"move", "attack|capture"}
+	}
+	return unitDef.ActionOrder
+}
+
+// isPointBasedStep reports whether the unit's current step is spent through
+// movement points (move or retreat) rather than by a single action
+func isPointBasedStep(unit *v1.Unit, unitDef *v1.UnitDefinition) bool {
+	actionOrder := unitActionOrder(unitDef)
+	if int(unit.ProgressionStep) >= len(actionOrder) {
+		return false
+	}
+	alternatives := ParseActionAlternatives(actionOrder[unit.ProgressionStep])
+	if unit.ChosenAlternative != "" {
+		alternatives = []string{unit.ChosenAlternative}
+	}
+	return ContainsAction(alternatives, "move") || ContainsAction(alternatives, "retreat")
+}
+
 // GetAllowedActionsForTile returns which actions are currently valid for a tile
 // for a given player with specified coin balance.
 // NOTE: Caller should only call this for tiles belonging to the player being checked.
@@ -271,11 +459,11 @@ func (re *RulesEngine) canPerformAction(unit *v1.Unit, unitDef *v1.UnitDefinitio
 	switch action {
 	case "move":
 		// Can move if has movement points remaining
-		return unit.DistanceLeft > 0
+		return re.HasMovementLeft(unit.DistanceLeft)
 
 	case "attack":
-		// Can attack if hasn't reached attack limit
-		// TODO: Implement attack counting at current step and check against action_limits
+		// The attack limit is enforced by AdvanceProgression, which keeps the
+		// unit on its step until it has made all its attacks
 		return true
 
 	case "capture":
@@ -290,12 +478,16 @@ func (re *RulesEngine) canPerformAction(unit *v1.Unit, unitDef *v1.UnitDefinitio
 
 	case "retreat":
 		// Can retreat if has retreat points remaining (DistanceLeft is set to retreat_points after attack)
-		return unit.DistanceLeft > 0
+		return re.HasMovementLeft(unit.DistanceLeft)
 
 	case "fix":
 		// Can fix if unit has fix_value > 0 (unit is a repair unit)
 		return unitDef.FixValue > 0
 
+	case "construct":
+		// Can construct if the unit has any terrain conversions
+		return len(unitDef.Constructions) > 0
+
 	default:
 		return false
 	}
@@ -333,7 +525,13 @@ func ParseActionAlternatives(stepActions string) []string {
 // GetMovementOptions returns all tiles a unit can move to using Dijkstra's algorithm
 // Returns AllPaths structure containing all reachable tiles and path information
 // When preventPassThrough is false (default), units can traverse through occupied tiles but cannot land on them
-func (re *RulesEngine) GetMovementOptions(world *World, unit *v1.Unit, remainingMovement int, preventPassThrough bool) (*v1.AllPaths, error) {
+func (re *RulesEngine) GetMovementOptions(world *World, unit *v1.Unit, remainingMovement float64, preventPassThrough bool) (*v1.AllPaths, error) {
+	return re.GetMovementOptionsContext(context.Background(), world, unit, remainingMovement, preventPassThrough)
+}
+
+// GetMovementOptionsContext is GetMovementOptions that stops with the
+// context's error once it is done
+func (re *RulesEngine) GetMovementOptionsContext(ctx context.Context, world *World, unit *v1.Unit, remainingMovement float64, preventPassThrough bool) (*v1.AllPaths, error) {
 	if unit == nil {
 		return nil, fmt.Errorf("unit is nil")
 	}
@@ -344,8 +542,7 @@ func (re *RulesEngine) GetMovementOptions(world *World, unit *v1.Unit, remaining
 	}
 
 	unitCoord := UnitGetCoord(unit)
-	allPaths := re.dijkstraMovement(world, unit.UnitType, unitCoord, float64(remainingMovement), preventPassThrough)
-	return allPaths, nil
+	return re.dijkstraMovementContext(ctx, world, unit.UnitType, unitCoord, remainingMovement, preventPassThrough, nil)
 }
 
 // GetMovementCost calculates movement cost for a unit to move to a specific destination
@@ -361,7 +558,7 @@ func (re *RulesEngine) GetMovementCost(world *World, unit *v1.Unit, to AxialCoor
 	}
 
 	// Use dijkstraMovement to get accurate costs
-	allPaths := re.dijkstraMovement(world, unit.UnitType, from, float64(unit.DistanceLeft), preventPassThrough)
+	allPaths := re.dijkstraMovement(world, unit.UnitType, from, unit.DistanceLeft, preventPassThrough)
 
 	// Look up the destination in AllPaths
 	key := fmt.Sprintf("%d,%d", to.Q, to.R)
@@ -370,7 +567,7 @@ func (re *RulesEngine) GetMovementCost(world *World, unit *v1.Unit, to AxialCoor
 		return 0, fmt.Errorf("destination %v is not reachable from %v", to, from)
 	}
 
-	return float64(edge.TotalCost), nil
+	return edge.TotalCost, nil
 }
 
 // calculatePathCost uses dijkstraMovement to find minimum cost path
@@ -382,7 +579,7 @@ func (re *RulesEngine) calculatePathCost(world *World, unitType int32, from, to
 	}
 
 	// Use the unit's maximum movement points as limit
-	maxMovement := float64(unitData.MovementPoints)
+	maxMovement := unitData.MovementPoints
 
 	allPaths := re.dijkstraMovement(world, unitType, from, maxMovement, preventPassThrough)
 
@@ -415,14 +612,15 @@ func (re *RulesEngine) FindPathTo(unit *v1.Unit, dest AxialCoord, world *World,
 		return &v1.Path{Edges: []*v1.PathEdge{}, TotalCost: 0}, 0, nil
 	}
 
-	maxMovement := unit.DistanceLeft
+	budget := re.MovementQuarters(unit.DistanceLeft)
 
-	// Track visited nodes, their costs, and parent info for path reconstruction
+	// Track visited nodes, their costs in quarters, and parent info for path
+	// reconstruction
 	type nodeInfo struct {
-		cost       float64
+		cost       int
 		parentQ    int32
 		parentR    int32
-		moveCost   float64
+		moveCost   int
 		isOccupied bool
 	}
 	visited := make(map[AxialCoord]*nodeInfo)
@@ -430,7 +628,7 @@ func (re *RulesEngine) FindPathTo(unit *v1.Unit, dest AxialCoord, world *World,
 	// Priority queue for Dijkstra
 	type queueItem struct {
 		coord AxialCoord
-		cost  float64
+		cost  int
 	}
 
 	queue := []queueItem{{coord: startCoord, cost: 0}}
@@ -464,21 +662,25 @@ func (re *RulesEngine) FindPathTo(unit *v1.Unit, dest AxialCoord, world *World,
 
 		// Explore neighbors
 		for neighborCoord := range world.Neighbors(current.coord) {
-			isOccupied := world.UnitAt(neighborCoord) != nil
+			isOccupied := re.occupiedByOther(world, neighborCoord, unit)
 
 			if preventPassThrough && isOccupied {
 				continue
 			}
+			if re.footprintBlocked(world, unit.UnitType, neighborCoord, GetDirection(current.coord, neighborCoord), unit) {
+				continue
+			}
 
 			effectiveTileType := re.GetEffectiveTileType(world, neighborCoord)
-			moveCost, err := re.GetUnitTerrainCost(unit.UnitType, effectiveTileType)
+			terrainCost, err := re.GetUnitTerrainCost(unit.UnitType, effectiveTileType)
 			if err != nil {
 				continue
 			}
 
+			moveCost := re.MovementQuarters(terrainCost)
 			newCost := current.cost + moveCost
 
-			if newCost <= maxMovement {
+			if newCost <= budget {
 				if existingInfo, exists := visited[neighborCoord]; !exists || newCost < existingInfo.cost {
 					visited[neighborCoord] = &nodeInfo{
 						cost:       newCost,
@@ -487,7 +689,9 @@ func (re *RulesEngine) FindPathTo(unit *v1.Unit, dest AxialCoord, world *World,
 						moveCost:   moveCost,
 						isOccupied: isOccupied,
 					}
-					queue = append(queue, queueItem{coord: neighborCoord, cost: newCost})
+					if !world.StopsMovementAt(neighborCoord) {
+						queue = append(queue, queueItem{coord: neighborCoord, cost: newCost})
+					}
 				}
 			}
 		}
@@ -520,8 +724,8 @@ func (re *RulesEngine) FindPathTo(unit *v1.Unit, dest AxialCoord, world *World,
 			FromR:        info.parentR,
 			ToQ:          currentQ,
 			ToR:          currentR,
-			MovementCost: info.moveCost,
-			TotalCost:    info.cost,
+			MovementCost: re.MovementPoints(info.moveCost),
+			TotalCost:    re.MovementPoints(info.cost),
 		})
 
 		currentQ, currentR = info.parentQ, info.parentR
@@ -533,10 +737,11 @@ func (re *RulesEngine) FindPathTo(unit *v1.Unit, dest AxialCoord, world *World,
 		pathEdges[i], pathEdges[j] = pathEdges[j], pathEdges[i]
 	}
 
+	totalCost := re.MovementPoints(destInfo.cost)
 	return &v1.Path{
 		Edges:     pathEdges,
-		TotalCost: destInfo.cost,
-	}, destInfo.cost, nil
+		TotalCost: totalCost,
+	}, totalCost, nil
 }
 
 // IsValidPath validates if a unit can traverse a specific path (legacy compatibility)
@@ -557,24 +762,30 @@ func (re *RulesEngine) IsValidPath(unit *v1.Unit, path []AxialCoord, world *Worl
 
 // GetLoadedUnitsCount returns number of loaded units
 func (re *RulesEngine) GetLoadedUnitsCount() int {
-	return len(re.Units)
+	return len(re.GetUnits())
 }
 
 // GetLoadedTerrainsCount returns number of loaded terrains
 func (re *RulesEngine) GetLoadedTerrainsCount() int {
-	return len(re.Terrains)
+	return len(re.GetTerrains())
 }
 
 // ValidateRules performs basic validation
 func (re *RulesEngine) ValidateRules() error {
-	if len(re.Units) == 0 {
+	if len(re.GetUnits()) == 0 {
 		return fmt.Errorf("no units loaded")
 	}
 
-	if len(re.Terrains) == 0 {
+	if len(re.GetTerrains()) == 0 {
 		return fmt.Errorf("no terrains loaded")
 	}
 
+	for _, kind := range re.GetMandatoryActions() {
+		if kind != MandatoryRetreat {
+			return fmt.Errorf("unknown mandatory action %q", kind)
+		}
+	}
+
 	return nil
 }
 
@@ -585,6 +796,16 @@ func (re *RulesEngine) ValidateRules() error {
 // dijkstraMovement implements Dijkstra's algorithm to find all reachable tiles with minimum cost
 // When preventPassThrough is false (default), units can traverse through occupied tiles but cannot land on them
 func (re *RulesEngine) dijkstraMovement(world *World, unitType int32, startCoord AxialCoord, maxMovement float64, preventPassThrough bool) *v1.AllPaths {
+	allPaths, _ := re.dijkstraMovementContext(context.Background(), world, unitType, startCoord, maxMovement, preventPassThrough, nil)
+	return allPaths
+}
+
+// dijkstraMovementContext is dijkstraMovement that stops with the context's
+// error once it is done.  Every hex it looks at is recorded in trace unless
+// trace is nil.
+func (re *RulesEngine) dijkstraMovementContext(ctx context.Context, world *World, unitType int32, startCoord AxialCoord, maxMovement float64, preventPassThrough bool, trace *ReachTrace) (*v1.AllPaths, error) {
+	check := canceller{ctx: ctx, progress: &reachProgress}
+
 	// Initialize AllPaths
 	allPaths := &v1.AllPaths{
 		SourceQ: int32(startCoord.Q),
@@ -592,22 +813,28 @@ func (re *RulesEngine) dijkstraMovement(world *World, unitType int32, startCoord
 		Edges:   make(map[string]*v1.PathEdge),
 	}
 
-	// Track visited nodes and their costs
-	visited := make(map[AxialCoord]float64)
+	// Track visited nodes and their costs, in quarters
+	visited := make(map[AxialCoord]int)
+	budget := re.MovementQuarters(maxMovement)
 
 	// Get unit data for explanations
 	unitData, _ := re.GetUnitData(unitType)
+	self := world.UnitAt(startCoord)
 
 	// Priority queue using heap for O(log n) operations instead of O(n)
 	pq := &dijkstraHeap{}
 	heap.Init(pq)
 	heap.Push(pq, &dijkstraItem{coord: startCoord, cost: 0})
 	visited[startCoord] = 0
+	trace.reached(startCoord, startCoord, 0, false, "")
 
 	// Dijkstra's algorithm
 	for pq.Len() > 0 {
 		// Extract minimum cost item in O(log n)
 		current := heap.Pop(pq).(*dijkstraItem)
+		if err := check.step(); err != nil {
+			return nil, err
+		}
 
 		// Skip if we've already processed this with lower cost
 		if cost, exists := visited[current.coord]; exists && current.cost > cost {
@@ -617,31 +844,50 @@ func (re *RulesEngine) dijkstraMovement(world *World, unitType int32, startCoord
 		// Explore neighbors
 		for neighborCoord := range world.Neighbors(current.coord) {
 			// Check if tile is occupied by another unit
-			isOccupied := world.UnitAt(neighborCoord) != nil
+			isOccupied := re.occupiedByOther(world, neighborCoord, self)
 
 			// If preventPassThrough is true, skip occupied tiles entirely
 			if preventPassThrough && isOccupied {
+				trace.blocked(neighborCoord, current.coord, re.MovementPoints(current.cost), ReachStopOccupied)
 				continue // Occupied tile blocks traversal
 			}
 
+			// A multi-hex unit also needs room for its footprint, turned
+			// the way it moves
+			if re.footprintBlocked(world, unitType, neighborCoord, GetDirection(current.coord, neighborCoord), self) {
+				trace.blocked(neighborCoord, current.coord, re.MovementPoints(current.cost), ReachStopFootprint)
+				continue
+			}
+
 			// Get effective tile type (considers crossings like roads/bridges)
 			effectiveTileType := re.GetEffectiveTileType(world, neighborCoord)
 
 			// Get movement cost to this terrain (using effective type)
-			moveCost, err := re.GetUnitTerrainCost(unitType, effectiveTileType)
+			terrainCost, err := re.GetUnitTerrainCost(unitType, effectiveTileType)
 			if err != nil {
+				trace.blocked(neighborCoord, current.coord, re.MovementPoints(current.cost), ReachStopImpassable)
 				continue // Cannot move on this terrain
 			}
 
+			moveCost := re.MovementQuarters(terrainCost)
 			newCost := current.cost + moveCost
+			if newCost > budget {
+				trace.blocked(neighborCoord, current.coord, re.MovementPoints(newCost), ReachStopBudget)
+			}
 
-			if newCost <= maxMovement {
+			if newCost <= budget {
 				// Check if this is a better path to the neighbor
 				if existingCost, exists := visited[neighborCoord]; !exists || newCost < existingCost {
 					visited[neighborCoord] = newCost
 
-					// Add to heap for further exploration (pass-through)
-					heap.Push(pq, &dijkstraItem{coord: neighborCoord, cost: newCost})
+					// Add to heap for further exploration (pass-through),
+					// unless a hazard stops units entering the tile
+					if !world.StopsMovementAt(neighborCoord) {
+						heap.Push(pq, &dijkstraItem{coord: neighborCoord, cost: newCost})
+						trace.reached(neighborCoord, current.coord, re.MovementPoints(newCost), isOccupied, "")
+					} else {
+						trace.reached(neighborCoord, current.coord, re.MovementPoints(newCost), isOccupied, ReachStopHazard)
+					}
 
 					// Get terrain data for explanation (use effective type for display)
 					terrainData, _ := re.GetTerrainData(effectiveTileType)
@@ -655,7 +901,7 @@ func (re *RulesEngine) dijkstraMovement(world *World, unitType int32, startCoord
 					if unitData != nil {
 						unitName = unitData.Name
 					}
-					explanation := fmt.Sprintf("%s costs %s %.0f movement points", terrainName, unitName, moveCost)
+					explanation := fmt.Sprintf("%s costs %s %s movement points", terrainName, unitName, FormatMovementPoints(terrainCost))
 
 					// Always add edges to AllPaths for path reconstruction
 					// Mark occupied tiles with IsOccupied=true to indicate pass-through only
@@ -666,8 +912,8 @@ func (re *RulesEngine) dijkstraMovement(world *World, unitType int32, startCoord
 						FromR:        int32(current.coord.R),
 						ToQ:          int32(neighborCoord.Q),
 						ToR:          int32(neighborCoord.R),
-						MovementCost: moveCost,
-						TotalCost:    newCost,
+						MovementCost: re.MovementPoints(moveCost),
+						TotalCost:    re.MovementPoints(newCost),
 						TerrainType:  terrainName,
 						Explanation:  explanation,
 						IsOccupied:   isOccupied,
@@ -677,7 +923,7 @@ func (re *RulesEngine) dijkstraMovement(world *World, unitType int32, startCoord
 		}
 	}
 
-	return allPaths
+	return allPaths, nil
 }
 
 // GetUnitTerrainCost returns movement cost for unit type on terrain type (internal helper)
@@ -687,7 +933,7 @@ func (re *RulesEngine) GetUnitTerrainCost(unitID, terrainID int32) (float64, err
 	key := fmt.Sprintf("%d:%d", terrainID, unitID)
 
 	// First, try centralized properties (source of truth)
-	if props, exists := re.TerrainUnitProperties[key]; exists {
+	if props, exists := re.GetTerrainUnitProperties()[key]; exists {
 		if props.MovementCost > 0 {
 			return props.MovementCost, nil
 		}
@@ -0,0 +1,269 @@
+package lib
+
+import (
+	"cmp"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Rules Export
+// =============================================================================
+//
+// Stat tables generated from the rules, for the wiki.  Rows and columns are
+// always in ID order and numbers are formatted the same way every time, so
+// diffing two exports shows exactly what a balance change did.
+
+// Tables the rules can be exported as
+const (
+	ExportUnits    = "units"
+	ExportTerrains = "terrains"
+	ExportMovement = "movement"
+	ExportDamage   = "damage"
+)
+
+// ExportTables lists the tables ExportTable can generate
+var ExportTables = []string{ExportUnits, ExportTerrains, ExportMovement, ExportDamage}
+
+// RulesTable is a table of rules data, every row as wide as the header
+type RulesTable struct {
+	Header []string
+	Rows   [][]string
+}
+
+// ExportTable generates one of the ExportTables from the rules
+func (re *RulesEngine) ExportTable(what string) (*RulesTable, error) {
+	switch what {
+	case ExportUnits:
+		return re.unitsTable(), nil
+	case ExportTerrains:
+		return re.terrainsTable(), nil
+	case ExportMovement:
+		return re.movementTable(), nil
+	case ExportDamage:
+		return re.damageTable(), nil
+	}
+	return nil, fmt.Errorf("unknown rules table %q, expected one of %s", what, strings.Join(ExportTables, ", "))
+}
+
+// unitsTable has a row of stats for every unit
+func (re *RulesEngine) unitsTable() *RulesTable {
+	table := &RulesTable{Header: []string{
+		"ID", "Name", "Class", "Terrain", "Health", "Coins", "Movement", "Retreat", "Defense",
+		"Attack Range", "Splash", "Sight", "Fix", "Actions", "Properties",
+	}}
+	for _, id := range sortedKeys(re.GetUnits()) {
+		unit := re.GetUnits()[id]
+		table.Rows = append(table.Rows, []string{
+			itoa(unit.Id),
+			unit.Name,
+			unit.UnitClass,
+			unit.UnitTerrain,
+			itoa(unit.Health),
+			itoa(unit.Coins),
+			ftoa(unit.MovementPoints),
+			ftoa(unit.RetreatPoints),
+			itoa(unit.Defense),
+			attackRange(unit),
+			itoa(unit.SplashDamage),
+			itoa(unit.SightRange),
+			itoa(unit.FixValue),
+			strings.Join(unit.ActionOrder, ", "),
+			strings.Join(unit.Properties, ", "),
+		})
+	}
+	return table
+}
+
+// attackRange formats a unit's min-max attack range, blank if it cannot attack
+func attackRange(unit *v1.UnitDefinition) string {
+	if unit.AttackRange <= 0 {
+		return ""
+	}
+	return formatRange(float64(max(1, unit.MinAttackRange)), float64(unit.AttackRange))
+}
+
+// unitClass is a unit's class and terrain, eg "Light Land"
+func unitClass(unit *v1.UnitDefinition) string {
+	return strings.TrimSpace(unit.UnitClass + " " + unit.UnitTerrain)
+}
+
+// terrainsTable has a row for every terrain and unit class that can enter
+// it, with the range of each effect over the units in the class
+func (re *RulesEngine) terrainsTable() *RulesTable {
+	table := &RulesTable{Header: []string{
+		"Terrain ID", "Terrain", "Unit Class", "Units", "Movement", "Attack Bonus", "Defense Bonus", "Healing", "Can Capture",
+	}}
+
+	classes := map[string][]int32{}
+	for _, id := range sortedKeys(re.GetUnits()) {
+		class := unitClass(re.GetUnits()[id])
+		classes[class] = append(classes[class], id)
+	}
+	classNames := sortedKeys(classes)
+
+	for _, terrainID := range sortedKeys(re.GetTerrains()) {
+		for _, class := range classNames {
+			var props []*v1.TerrainUnitProperties
+			for _, unitID := range classes[class] {
+				if p := re.GetTerrainUnitPropertiesForUnit(terrainID, unitID); p != nil && p.MovementCost > 0 {
+					props = append(props, p)
+				}
+			}
+			if len(props) == 0 {
+				continue
+			}
+			field := func(get func(p *v1.TerrainUnitProperties) float64) string {
+				lo, hi := get(props[0]), get(props[0])
+				for _, p := range props[1:] {
+					lo, hi = min(lo, get(p)), max(hi, get(p))
+				}
+				return formatRange(lo, hi)
+			}
+			captures := 0
+			for _, p := range props {
+				if p.CanCapture {
+					captures++
+				}
+			}
+			table.Rows = append(table.Rows, []string{
+				itoa(terrainID),
+				re.GetTerrains()[terrainID].Name,
+				class,
+				fmt.Sprintf("%d/%d", len(props), len(classes[class])),
+				field(func(p *v1.TerrainUnitProperties) float64 { return p.MovementCost }),
+				field(func(p *v1.TerrainUnitProperties) float64 { return float64(p.AttackBonus) }),
+				field(func(p *v1.TerrainUnitProperties) float64 { return float64(p.DefenseBonus) }),
+				field(func(p *v1.TerrainUnitProperties) float64 { return float64(p.HealingBonus) }),
+				fmt.Sprintf("%d/%d", captures, len(props)),
+			})
+		}
+	}
+	return table
+}
+
+// movementTable is the movement cost of every unit on every terrain, blank
+// where the unit cannot enter the terrain
+func (re *RulesEngine) movementTable() *RulesTable {
+	terrainIDs := sortedKeys(re.GetTerrains())
+	table := &RulesTable{Header: []string{"Unit ID", "Unit"}}
+	for _, terrainID := range terrainIDs {
+		table.Header = append(table.Header, re.GetTerrains()[terrainID].Name)
+	}
+	for _, unitID := range sortedKeys(re.GetUnits()) {
+		row := []string{itoa(unitID), re.GetUnits()[unitID].Name}
+		for _, terrainID := range terrainIDs {
+			cost := ""
+			if p := re.GetTerrainUnitPropertiesForUnit(terrainID, unitID); p != nil && p.MovementCost > 0 {
+				cost = ftoa(p.MovementCost)
+			}
+			row = append(row, cost)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
+
+// damageTable is the damage every unit deals to every other unit, as the
+// expected damage followed by the min–max range, blank where it cannot
+// attack
+func (re *RulesEngine) damageTable() *RulesTable {
+	unitIDs := sortedKeys(re.GetUnits())
+	table := &RulesTable{Header: []string{"Attacker ID", "Attacker"}}
+	for _, defenderID := range unitIDs {
+		table.Header = append(table.Header, re.GetUnits()[defenderID].Name)
+	}
+	for _, attackerID := range unitIDs {
+		row := []string{itoa(attackerID), re.GetUnits()[attackerID].Name}
+		for _, defenderID := range unitIDs {
+			cell := ""
+			if damage, ok := re.GetCombatPrediction(attackerID, defenderID); ok {
+				lo, hi := damageSpread(damage)
+				cell = fmt.Sprintf("%.2f (%s)", damage.ExpectedDamage, formatRange(lo, hi))
+			}
+			row = append(row, cell)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
+
+// damageSpread is the least and most damage a distribution can roll,
+// ignoring ranges it never rolls
+func damageSpread(damage *v1.DamageDistribution) (lo, hi float64) {
+	first := true
+	for _, r := range damage.Ranges {
+		if r.Probability <= 0 {
+			continue
+		}
+		if first {
+			lo, hi, first = r.MinValue, r.MaxValue, false
+		}
+		lo, hi = min(lo, r.MinValue), max(hi, r.MaxValue)
+	}
+	return
+}
+
+// WriteCSV writes the table as CSV with a header row
+func (t *RulesTable) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(t.Header); err != nil {
+		return err
+	}
+	if err := out.WriteAll(t.Rows); err != nil {
+		return err
+	}
+	return out.Error()
+}
+
+// WriteMarkdown writes the table as a GitHub flavored markdown table
+func (t *RulesTable) WriteMarkdown(w io.Writer) error {
+	line := func(cells []string) string {
+		escaped := make([]string, len(cells))
+		for i, cell := range cells {
+			escaped[i] = strings.ReplaceAll(cell, "|", `\|`)
+		}
+		return "| " + strings.Join(escaped, " | ") + " |\n"
+	}
+	var sb strings.Builder
+	sb.WriteString(line(t.Header))
+	sb.WriteString("|" + strings.Repeat(" --- |", len(t.Header)) + "\n")
+	for _, row := range t.Rows {
+		sb.WriteString(line(row))
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// sortedKeys returns a map's keys in order
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func itoa(n int32) string {
+	return strconv.Itoa(int(n))
+}
+
+// ftoa formats a number with as few digits as needed
+func ftoa(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// formatRange formats lo–hi, or just the value when they are equal
+func formatRange(lo, hi float64) string {
+	if lo == hi {
+		return ftoa(lo)
+	}
+	return ftoa(lo) + "–" + ftoa(hi)
+}
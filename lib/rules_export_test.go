@@ -0,0 +1,135 @@
+package lib
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+)
+
+// exportRulesJSON has two units on one terrain, with exportDamageJSON
+// letting the soldier attack the tank
+const exportRulesJSON = `{
+  "terrains": {"5": {"id": 5, "name": "Grass"}},
+  "units": {
+    "3": {"id": 3, "name": "Tank", "health": 10, "coins": 300, "movement_points": 3, "defense": 10, "attack_range": 1,
+          "unit_class": "Heavy", "unit_terrain": "Land", "action_order": ["move", "attack"]},
+    "1": {"id": 1, "name": "Soldier", "health": 10, "coins": 75, "movement_points": 3, "defense": 6, "attack_range": 1,
+          "unit_class": "Light", "unit_terrain": "Land", "action_order": ["move", "attack|capture"]}
+  },
+  "terrainUnitProperties": {
+    "5:1": {"terrain_id": 5, "unit_id": 1, "movement_cost": 1, "healing_bonus": 1},
+    "5:3": {"terrain_id": 5, "unit_id": 3, "movement_cost": 1.5, "defense_bonus": -1}
+  }
+}`
+
+const exportDamageJSON = `{
+  "unitUnitProperties": {
+    "1:3": {"attacker_id": 1, "defender_id": 3, "damage": {"ranges": [
+      {"min_value": 1, "max_value": 1, "probability": 0.5},
+      {"min_value": 2, "max_value": 2, "probability": 0.5},
+      {"min_value": 3, "max_value": 3, "probability": 0}
+    ]}}
+  }
+}`
+
+func exportTable(t *testing.T, re *RulesEngine, what string) *RulesTable {
+	t.Helper()
+	table, err := re.ExportTable(what)
+	if err != nil {
+		t.Fatalf("ExportTable(%q) error: %v", what, err)
+	}
+	return table
+}
+
+func TestExportTableGolden(t *testing.T) {
+	re, err := LoadRulesEngineFromJSON([]byte(exportRulesJSON), []byte(exportDamageJSON))
+	if err != nil {
+		t.Fatalf("LoadRulesEngineFromJSON error: %v", err)
+	}
+
+	for what, want := range map[string]string{
+		ExportUnits: "" +
+			"| ID | Name | Class | Terrain | Health | Coins | Movement | Retreat | Defense | Attack Range | Splash | Sight | Fix | Actions | Properties |\n" +
+			"| --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- |\n" +
+			"| 1 | Soldier | Light | Land | 10 | 75 | 3 | 0 | 6 | 1 | 0 | 0 | 0 | move, attack\\|capture |  |\n" +
+			"| 3 | Tank | Heavy | Land | 10 | 300 | 3 | 0 | 10 | 1 | 0 | 0 | 0 | move, attack |  |\n",
+		ExportMovement: "" +
+			"| Unit ID | Unit | Grass |\n" +
+			"| --- | --- | --- |\n" +
+			"| 1 | Soldier | 1 |\n" +
+			"| 3 | Tank | 1.5 |\n",
+		ExportTerrains: "" +
+			"| Terrain ID | Terrain | Unit Class | Units | Movement | Attack Bonus | Defense Bonus | Healing | Can Capture |\n" +
+			"| --- | --- | --- | --- | --- | --- | --- | --- | --- |\n" +
+			"| 5 | Grass | Heavy Land | 1/1 | 1.5 | 0 | -1 | 0 | 0/1 |\n" +
+			"| 5 | Grass | Light Land | 1/1 | 1 | 0 | 0 | 1 | 0/1 |\n",
+	} {
+		var out bytes.Buffer
+		if err := exportTable(t, re, what).WriteMarkdown(&out); err != nil {
+			t.Fatalf("WriteMarkdown error: %v", err)
+		}
+		if out.String() != want {
+			t.Errorf("%s table:\n%s\nwant:\n%s", what, out.String(), want)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := exportTable(t, re, ExportDamage).WriteCSV(&out); err != nil {
+		t.Fatalf("WriteCSV error: %v", err)
+	}
+	want := "Attacker ID,Attacker,Soldier,Tank\n" +
+		"1,Soldier,,1.50 (1–2)\n" +
+		"3,Tank,,\n"
+	if out.String() != want {
+		t.Errorf("damage table:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+// TestExportTableColumns tests every table covers every unit and terrain
+// in the shipped rules, with a cell for every column
+func TestExportTableColumns(t *testing.T) {
+	re := DefaultRulesEngine()
+	wantRows := map[string]int{
+		ExportUnits:    len(re.GetUnits()),
+		ExportMovement: len(re.GetUnits()),
+		ExportDamage:   len(re.GetUnits()),
+	}
+	wantColumns := map[string]int{
+		ExportMovement: 2 + len(re.GetTerrains()),
+		ExportDamage:   2 + len(re.GetUnits()),
+	}
+
+	for _, what := range ExportTables {
+		table := exportTable(t, re, what)
+		if want, ok := wantRows[what]; ok && len(table.Rows) != want {
+			t.Errorf("%s table has %d rows, want %d", what, len(table.Rows), want)
+		}
+		if want, ok := wantColumns[what]; ok && len(table.Header) != want {
+			t.Errorf("%s table has %d columns, want %d", what, len(table.Header), want)
+		}
+		for i, row := range table.Rows {
+			if len(row) != len(table.Header) {
+				t.Errorf("%s row %d has %d cells, want %d", what, i, len(row), len(table.Header))
+			}
+		}
+
+		// CSV output parses back to the same table, every time
+		var first, second bytes.Buffer
+		table.WriteCSV(&first)
+		exportTable(t, re, what).WriteCSV(&second)
+		if first.String() != second.String() {
+			t.Errorf("%s export is not deterministic", what)
+		}
+		records, err := csv.NewReader(&first).ReadAll()
+		if err != nil {
+			t.Fatalf("%s CSV does not parse: %v", what, err)
+		}
+		if len(records) != len(table.Rows)+1 {
+			t.Errorf("%s CSV has %d records, want %d", what, len(records), len(table.Rows)+1)
+		}
+	}
+
+	if _, err := re.ExportTable("weather"); err == nil {
+		t.Error("exporting an unknown table succeeded")
+	}
+}
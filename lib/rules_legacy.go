@@ -0,0 +1,179 @@
+package lib
+
+import (
+	"fmt"
+)
+
+// RulesFormat identifies the schema of a rules JSON document
+type RulesFormat int
+
+const (
+	// RulesFormatCanonical is the current schema: units/terrains keyed by ID
+	// with a separate terrainUnitProperties map.
+	RulesFormatCanonical RulesFormat = iota
+
+	// RulesFormatLegacyFlat is the older keyed schema that used short field
+	// names (movement, cost, hp, range) on units.
+	RulesFormatLegacyFlat
+
+	// RulesFormatLegacyList is the oldest schema: units and terrains as arrays
+	// with per-unit movementCosts maps (terrain ID -> cost).
+	RulesFormatLegacyList
+)
+
+func (f RulesFormat) String() string {
+	switch f {
+	case RulesFormatCanonical:
+		return "canonical"
+	case RulesFormatLegacyFlat:
+		return "legacy-flat"
+	case RulesFormatLegacyList:
+		return "legacy-list"
+	default:
+		return fmt.Sprintf("RulesFormat(%d)", int(f))
+	}
+}
+
+// legacyUnitFields maps short legacy unit field names to canonical ones
+var legacyUnitFields = map[string]string{
+	"movement": "movement_points",
+	"cost":     "coins",
+	"hp":       "health",
+	"range":    "attack_range",
+}
+
+// DetectRulesFormat inspects a decoded rules document and reports its schema.
+// Returns an error if the document doesn't match any known format.
+func DetectRulesFormat(rawData map[string]any) (RulesFormat, error) {
+	switch units := rawData["units"].(type) {
+	case []any:
+		return RulesFormatLegacyList, nil
+	case map[string]any:
+		for _, unitRaw := range units {
+			unit, ok := unitRaw.(map[string]any)
+			if !ok {
+				return 0, fmt.Errorf("unrecognized rules format: unit entries must be objects")
+			}
+			for legacy, canonical := range legacyUnitFields {
+				if _, hasLegacy := unit[legacy]; hasLegacy && !hasField(unit, canonical) {
+					return RulesFormatLegacyFlat, nil
+				}
+			}
+		}
+		return RulesFormatCanonical, nil
+	case nil:
+		return 0, fmt.Errorf("unrecognized rules format: missing \"units\"")
+	default:
+		return 0, fmt.Errorf("unrecognized rules format: \"units\" must be an object or array, got %T", units)
+	}
+}
+
+// normalizeRulesFormat rewrites legacy rules documents in place into the
+// canonical schema understood by LoadRulesEngineFromJSON.
+func normalizeRulesFormat(rawData map[string]any) error {
+	format, err := DetectRulesFormat(rawData)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case RulesFormatLegacyFlat:
+		for _, unitRaw := range rawData["units"].(map[string]any) {
+			renameLegacyUnitFields(unitRaw.(map[string]any))
+		}
+	case RulesFormatLegacyList:
+		return normalizeLegacyList(rawData)
+	}
+	return nil
+}
+
+// normalizeLegacyList converts array-based units/terrains to ID keyed maps and
+// expands per-unit movementCosts into terrainUnitProperties
+func normalizeLegacyList(rawData map[string]any) error {
+	units := map[string]any{}
+	terrainUnitProps, _ := rawData["terrainUnitProperties"].(map[string]any)
+	if terrainUnitProps == nil {
+		terrainUnitProps = map[string]any{}
+	}
+
+	for i, unitRaw := range rawData["units"].([]any) {
+		unit, ok := unitRaw.(map[string]any)
+		if !ok {
+			return fmt.Errorf("legacy rules: unit %d is not an object", i)
+		}
+		id, ok := unit["id"].(float64)
+		if !ok {
+			return fmt.Errorf("legacy rules: unit %d has no numeric id", i)
+		}
+		renameLegacyUnitFields(unit)
+
+		if costs, ok := unit["movementCosts"].(map[string]any); ok {
+			for terrainID, cost := range costs {
+				var tid int
+				if _, err := fmt.Sscanf(terrainID, "%d", &tid); err != nil {
+					return fmt.Errorf("legacy rules: unit %d has invalid terrain id %q in movementCosts", int(id), terrainID)
+				}
+				terrainUnitProps[fmt.Sprintf("%d:%d", tid, int(id))] = map[string]any{
+					"terrain_id":    tid,
+					"unit_id":       int(id),
+					"movement_cost": cost,
+				}
+			}
+			delete(unit, "movementCosts")
+		}
+		units[fmt.Sprintf("%d", int(id))] = unit
+	}
+	rawData["units"] = units
+	rawData["terrainUnitProperties"] = terrainUnitProps
+
+	if terrainList, ok := rawData["terrains"].([]any); ok {
+		terrains := map[string]any{}
+		for i, terrainRaw := range terrainList {
+			terrain, ok := terrainRaw.(map[string]any)
+			if !ok {
+				return fmt.Errorf("legacy rules: terrain %d is not an object", i)
+			}
+			id, ok := terrain["id"].(float64)
+			if !ok {
+				return fmt.Errorf("legacy rules: terrain %d has no numeric id", i)
+			}
+			terrains[fmt.Sprintf("%d", int(id))] = terrain
+		}
+		rawData["terrains"] = terrains
+	}
+	return nil
+}
+
+func renameLegacyUnitFields(unit map[string]any) {
+	for legacy, canonical := range legacyUnitFields {
+		if value, ok := unit[legacy]; ok {
+			if !hasField(unit, canonical) {
+				unit[canonical] = value
+			}
+			delete(unit, legacy)
+		}
+	}
+}
+
+// hasField checks for a canonical snake_case field or its camelCase JSON name
+func hasField(obj map[string]any, snakeName string) bool {
+	if _, ok := obj[snakeName]; ok {
+		return true
+	}
+	camel := []byte{}
+	upper := false
+	for i := 0; i < len(snakeName); i++ {
+		c := snakeName[i]
+		if c == '_' {
+			upper = true
+			continue
+		}
+		if upper && c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upper = false
+		camel = append(camel, c)
+	}
+	_, ok := obj[string(camel)]
+	return ok
+}
@@ -0,0 +1,88 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+)
+
+const legacyFlatRules = `{
+  "terrainTypes": {"5": "nature"},
+  "terrains": {"5": {"id": 5, "name": "Grass"}},
+  "units": {"1": {"id": 1, "name": "Soldier", "hp": 10, "cost": 75, "movement": 3, "range": 1}},
+  "terrainUnitProperties": {"5:1": {"terrain_id": 5, "unit_id": 1, "movement_cost": 1}}
+}`
+
+const legacyListRules = `{
+  "terrainTypes": {"5": "nature"},
+  "terrains": [{"id": 5, "name": "Grass"}],
+  "units": [{"id": 1, "name": "Soldier", "hp": 10, "cost": 75, "movement": 3, "range": 1, "movementCosts": {"5": 1.5}}]
+}`
+
+func TestLoadLegacyRulesFormats(t *testing.T) {
+	tests := []struct {
+		name   string
+		json   string
+		format RulesFormat
+	}{
+		{"legacy flat", legacyFlatRules, RulesFormatLegacyFlat},
+		{"legacy list", legacyListRules, RulesFormatLegacyList},
+		{"canonical", strings.Replace(reloadFixture, "COINS", "75", 1), RulesFormatCanonical},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			re, err := LoadRulesEngineFromJSON([]byte(tt.json), nil)
+			if err != nil {
+				t.Fatalf("LoadRulesEngineFromJSON() error: %v", err)
+			}
+
+			unit, err := re.GetUnitData(1)
+			if err != nil {
+				t.Fatalf("GetUnitData(1) error: %v", err)
+			}
+			if unit.Coins != 75 || unit.MovementPoints != 3 {
+				t.Errorf("unit 1 coins=%d movement=%v, want 75 and 3", unit.Coins, unit.MovementPoints)
+			}
+			if _, err := re.GetTerrainData(5); err != nil {
+				t.Errorf("GetTerrainData(5) error: %v", err)
+			}
+			if _, ok := re.GetTerrainUnitProperties()["5:1"]; !ok {
+				t.Error("missing terrain unit properties for 5:1")
+			}
+		})
+	}
+}
+
+func TestLegacyListMovementCosts(t *testing.T) {
+	re, err := LoadRulesEngineFromJSON([]byte(legacyListRules), nil)
+	if err != nil {
+		t.Fatalf("LoadRulesEngineFromJSON() error: %v", err)
+	}
+	unit, _ := re.GetUnitData(1)
+	if unit.Health != 10 || unit.AttackRange != 1 {
+		t.Errorf("unit 1 health=%d range=%d, want 10 and 1", unit.Health, unit.AttackRange)
+	}
+	if cost := re.GetTerrainUnitProperties()["5:1"].MovementCost; cost != 1.5 {
+		t.Errorf("movement cost = %v, want 1.5", cost)
+	}
+}
+
+func TestUnknownRulesFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"missing units", `{"terrains": {}}`},
+		{"units is a string", `{"units": "soldier"}`},
+		{"unit is not an object", `{"units": {"1": 3}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRulesEngineFromJSON([]byte(tt.json), nil)
+			if err == nil || !strings.Contains(err.Error(), "unrecognized rules format") {
+				t.Errorf("LoadRulesEngineFromJSON() error = %v, want unrecognized rules format", err)
+			}
+		})
+	}
+}
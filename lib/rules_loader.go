@@ -1,13 +1,20 @@
 package lib
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"log"
+	"math"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 const DefaultStartingCoins = 300
@@ -25,8 +32,10 @@ const (
 	TileTypeAirport     = 3
 	TileTypeDesert      = 4  // Desert terrain (cost 1.75 for infantry)
 	TileTypeGrass       = 5  // Basic traversable terrain (cost 1.0 for infantry)
+	TileTypeMountains   = 7
 	TileTypeMissileSilo = 16
 	TileTypeMines       = 20
+	TileTypeGuardTower  = 25
 )
 
 // Unit type constants
@@ -65,6 +74,13 @@ var DefaultFixTargets = map[int32][]string{
 	UnitTypeAircraftCarrier: {"Air"},
 }
 
+// Default vision bonuses of high ground terrain if this is not already in our
+// rules data json.  Units standing on these see this many hexes farther.
+var DefaultVisionBonuses = map[int32]int32{
+	TileTypeMountains:  1,
+	TileTypeGuardTower: 2,
+}
+
 // Default Income available from various tile types if this is not already in our rules data json
 // All other tiles do not generate income
 var DefaultIncomeMap = map[int32]int32{
@@ -148,7 +164,13 @@ func LoadRulesEngineFromFile(rulesFilename string, damageFilename string) (*Rule
 		}
 	}
 
-	return LoadRulesEngineFromJSON(rulesData, damageData)
+	rulesEngine, err := LoadRulesEngineFromJSON(rulesData, damageData)
+	if err != nil {
+		return nil, err
+	}
+	rulesEngine.rulesFile = rulesFilename
+	rulesEngine.damageFile = damageFilename
+	return rulesEngine, nil
 }
 
 // LoadRulesEngineFromJSON loads a RulesEngine from separate rules and damage JSON bytes
@@ -162,16 +184,20 @@ func LoadRulesEngineFromJSON(rulesJSON []byte, damageJSON []byte) (*RulesEngine,
 		return nil, fmt.Errorf("failed to unmarshal rules JSON: %w", err)
 	}
 
-	rulesEngine := &RulesEngine{
-		RulesEngine: &v1.RulesEngine{
-			Units:                 make(map[int32]*v1.UnitDefinition),
-			Terrains:              make(map[int32]*v1.TerrainDefinition),
-			TerrainUnitProperties: make(map[string]*v1.TerrainUnitProperties),
-			UnitUnitProperties:    make(map[string]*v1.UnitUnitProperties),
-			TerrainTypes:          make(map[int32]v1.TerrainType),
-		},
+	// Older dumps use different schemas - convert them to the canonical one
+	if err := normalizeRulesFormat(rawData); err != nil {
+		return nil, err
 	}
 
+	rules := &v1.RulesEngine{
+		Units:                 make(map[int32]*v1.UnitDefinition),
+		Terrains:              make(map[int32]*v1.TerrainDefinition),
+		TerrainUnitProperties: make(map[string]*v1.TerrainUnitProperties),
+		UnitUnitProperties:    make(map[string]*v1.UnitUnitProperties),
+		TerrainTypes:          make(map[int32]v1.TerrainType),
+	}
+	rulesEngine := NewRulesEngineFrom(rules)
+
 	// Load terrain types (city, nature, bridge, water, road)
 	if terrainTypesData, ok := rawData["terrainTypes"].(map[string]any); ok {
 		for idStr, typeStr := range terrainTypesData {
@@ -180,7 +206,7 @@ func LoadRulesEngineFromJSON(rulesJSON []byte, damageJSON []byte) (*RulesEngine,
 				continue
 			}
 			if typeName, ok := typeStr.(string); ok {
-				rulesEngine.TerrainTypes[int32(id)] = parseTerrainType(typeName)
+				rules.TerrainTypes[int32(id)] = parseTerrainType(typeName)
 			}
 		}
 	}
@@ -207,7 +233,7 @@ func LoadRulesEngineFromJSON(rulesJSON []byte, damageJSON []byte) (*RulesEngine,
 				return nil, fmt.Errorf("failed to unmarshal terrain %d: %w", id, err)
 			}
 
-			rulesEngine.Terrains[int32(id)] = terrain
+			rules.Terrains[int32(id)] = terrain
 		}
 	}
 
@@ -233,7 +259,7 @@ func LoadRulesEngineFromJSON(rulesJSON []byte, damageJSON []byte) (*RulesEngine,
 				return nil, fmt.Errorf("failed to unmarshal unit %d: %w", id, err)
 			}
 
-			rulesEngine.Units[int32(id)] = unit
+			rules.Units[int32(id)] = unit
 		}
 	}
 
@@ -250,7 +276,7 @@ func LoadRulesEngineFromJSON(rulesJSON []byte, damageJSON []byte) (*RulesEngine,
 				DiscardUnknown: true,
 			}
 			if err := unmarshaler.Unmarshal(propBytes, props); err == nil {
-				rulesEngine.TerrainUnitProperties[key] = props
+				rules.TerrainUnitProperties[key] = props
 			}
 		}
 	}
@@ -263,6 +289,7 @@ func LoadRulesEngineFromJSON(rulesJSON []byte, damageJSON []byte) (*RulesEngine,
 		}
 
 		if unitUnitPropsData, ok := damageData["unitUnitProperties"].(map[string]any); ok {
+			var incomplete []string
 			for key, propRaw := range unitUnitPropsData {
 				propBytes, err := json.Marshal(propRaw)
 				if err != nil {
@@ -276,20 +303,53 @@ func LoadRulesEngineFromJSON(rulesJSON []byte, damageJSON []byte) (*RulesEngine,
 				if err := unmarshaler.Unmarshal(propBytes, props); err == nil {
 					// Deduplicate damage ranges (source data may have duplicates)
 					deduplicateDamageRanges(props.Damage)
+					// Verify min/max and probabilities against the buckets
+					minMaxFixed, err := validateDamageDistribution(props.Damage)
+					if minMaxFixed {
+						log.Printf("damage %s: min/max did not match ranges, using %.0f-%.0f",
+							key, props.Damage.MinDamage, props.Damage.MaxDamage)
+					}
+					if err != nil {
+						incomplete = append(incomplete, fmt.Sprintf("%s (%v)", key, err))
+					}
 					// Calculate expected damage from distribution
 					calculateExpectedDamage(props.Damage)
-					rulesEngine.UnitUnitProperties[key] = props
+					rules.UnitUnitProperties[key] = props
 				}
 			}
+			if len(incomplete) > 0 {
+				// Most likely the data was extracted with ranges missing;
+				// rescaling what is left would only hide that
+				slices.Sort(incomplete)
+				log.Printf("WARNING: damage: %d distributions are incomplete and were left as is, re-extract the damage data: %s",
+					len(incomplete), strings.Join(incomplete, ", "))
+			}
 		}
 	}
 
+	// Obligations that must be resolved before ending a turn
+	if kinds, ok := rawData["mandatoryActions"].([]any); ok {
+		for _, kind := range kinds {
+			if name, ok := kind.(string); ok {
+				rules.MandatoryActions = append(rules.MandatoryActions, name)
+			}
+		}
+	}
+
+	// Cap on units per player, uncapped when missing
+	if maxUnits, ok := rawData["maxUnitsPerPlayer"].(float64); ok {
+		rules.MaxUnitsPerPlayer = int32(maxUnits)
+	}
+
 	// Set default income values for terrains
 	SetDefaultIncomeValues(rulesEngine)
 
 	// Set default fix values for repair units
 	SetDefaultFixValues(rulesEngine)
 
+	// Set default vision bonuses for high ground terrains
+	SetDefaultVisionBonuses(rulesEngine)
+
 	// Populate reference maps from centralized properties for fast lookup
 	rulesEngine.PopulateReferenceMaps()
 
@@ -298,6 +358,17 @@ func LoadRulesEngineFromJSON(rulesJSON []byte, damageJSON []byte) (*RulesEngine,
 		return nil, fmt.Errorf("invalid rules data: %w", err)
 	}
 
+	// Audit for missing unit data the engine would silently paper over
+	annotations, err := parseAuditAnnotations(rawData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rules data: %w", err)
+	}
+	// The engine is not shared yet, so its tables can still be filled in
+	rulesEngine.tables.Load().audit = rulesEngine.auditRules(annotations)
+	if n := len(rulesEngine.UnannotatedWarnings()); n > 0 {
+		log.Printf("rules audit: %d unannotated gaps in the unit data", n)
+	}
+
 	return rulesEngine, nil
 }
 
@@ -315,6 +386,23 @@ func SaveRulesEngineToFile(rulesEngine *RulesEngine, filename string) error {
 	return nil
 }
 
+// Hash returns a fingerprint of the rules content. Results derived from the
+// rules (eg world ratings) record it so they can be invalidated when the
+// rules change.  It is computed once per version of the tables, so a reload
+// gives a fresh one.
+func (re *RulesEngine) Hash() string {
+	tables := re.tables.Load()
+	tables.hashOnce.Do(func() {
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(tables.rules)
+		if err != nil {
+			return
+		}
+		sum := sha256.Sum256(data)
+		tables.hash = hex.EncodeToString(sum[:])
+	})
+	return tables.hash
+}
+
 // deduplicateDamageRanges removes duplicate damage values from DamageDistribution
 // This handles legacy data files that may have duplicate ranges
 func deduplicateDamageRanges(damage *v1.DamageDistribution) {
@@ -343,6 +431,41 @@ func deduplicateDamageRanges(damage *v1.DamageDistribution) {
 	damage.Ranges = uniqueRanges
 }
 
+// damageProbabilityTolerance is how far a distribution's probabilities may sum
+// away from 1.0, eg from rounding, before the distribution is incomplete
+const damageProbabilityTolerance = 0.02
+
+// validateDamageDistribution recomputes MinDamage/MaxDamage from the ranges,
+// reporting whether they had to be corrected, and checks that the range
+// probabilities sum to 1.0. Probabilities are never rescaled: a distribution
+// summing further than damageProbabilityTolerance away from 1.0 is missing
+// ranges, and is left as is with an error. Distributions with no
+// probabilities at all are left as is.
+func validateDamageDistribution(damage *v1.DamageDistribution) (minMaxFixed bool, err error) {
+	if damage == nil || len(damage.Ranges) == 0 {
+		return false, nil
+	}
+
+	minDamage, maxDamage := math.Inf(1), math.Inf(-1)
+	totalProbability := 0.0
+	for _, damageRange := range damage.Ranges {
+		minDamage = math.Min(minDamage, damageRange.MinValue)
+		maxDamage = math.Max(maxDamage, damageRange.MaxValue)
+		totalProbability += damageRange.Probability
+	}
+
+	if damage.MinDamage != minDamage || damage.MaxDamage != maxDamage {
+		damage.MinDamage = minDamage
+		damage.MaxDamage = maxDamage
+		minMaxFixed = true
+	}
+
+	if totalProbability > 0 && math.Abs(totalProbability-1.0) > damageProbabilityTolerance {
+		return minMaxFixed, fmt.Errorf("probabilities sum to %.3f", totalProbability)
+	}
+	return minMaxFixed, nil
+}
+
 // calculateExpectedDamage calculates and sets the expected damage from the distribution ranges
 func calculateExpectedDamage(damage *v1.DamageDistribution) {
 	if damage == nil || len(damage.Ranges) == 0 {
@@ -377,7 +500,7 @@ func calculateExpectedDamage(damage *v1.DamageDistribution) {
 
 // SetDefaultIncomeValues sets default income_per_turn values for terrain types using DefaultIncomeMap
 func SetDefaultIncomeValues(re *RulesEngine) {
-	for tileID, terrain := range re.Terrains {
+	for tileID, terrain := range re.GetTerrains() {
 		// Check if this tile ID has a default income value
 		if income, ok := DefaultIncomeMap[tileID]; ok {
 			terrain.IncomePerTurn = income
@@ -385,10 +508,23 @@ func SetDefaultIncomeValues(re *RulesEngine) {
 	}
 }
 
+// SetDefaultVisionBonuses sets default vision_bonus values for terrain types
+// using DefaultVisionBonuses.  Only sets the value if it's not already
+// specified in the loaded data.
+func SetDefaultVisionBonuses(re *RulesEngine) {
+	for tileID, terrain := range re.GetTerrains() {
+		if terrain.VisionBonus == 0 {
+			if bonus, ok := DefaultVisionBonuses[tileID]; ok {
+				terrain.VisionBonus = bonus
+			}
+		}
+	}
+}
+
 // SetDefaultFixValues sets default fix_value for units that can repair other units
 // Only sets the value if it's not already specified in the loaded data
 func SetDefaultFixValues(re *RulesEngine) {
-	for unitID, unit := range re.Units {
+	for unitID, unit := range re.GetUnits() {
 		// Only set if fix_value is not already defined (0 means unset)
 		if unit.FixValue == 0 {
 			if fixValue, ok := DefaultFixValues[unitID]; ok {
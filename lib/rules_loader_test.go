@@ -1,9 +1,14 @@
 package lib
 
 import (
+	"encoding/json"
+	"math"
+	"strings"
 	"testing"
 
+	"github.com/turnforge/lilbattle/assets"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func TestCalculatePlayerBaseIncome(t *testing.T) {
@@ -205,3 +210,93 @@ func TestGetTileIncomeFromConfig(t *testing.T) {
 		})
 	}
 }
+
+// damageFixture has probabilities summing to 0.95 and a max_damage that
+// doesn't match its buckets
+const damageFixture = `{
+  "unitUnitProperties": {
+    "1:1": {
+      "attacker_id": 1,
+      "defender_id": 1,
+      "damage": {
+        "min_damage": 2,
+        "max_damage": 9,
+        "ranges": [
+          {"min_value": 2, "max_value": 2, "probability": 0.25},
+          {"min_value": 3, "max_value": 3, "probability": 0.5},
+          {"min_value": 4, "max_value": 4, "probability": 0.2}
+        ]
+      }
+    }
+  }
+}`
+
+func TestDamageDistributionValidation(t *testing.T) {
+	rules := strings.Replace(reloadFixture, "COINS", "75", 1)
+	re, err := LoadRulesEngineFromJSON([]byte(rules), []byte(damageFixture))
+	if err != nil {
+		t.Fatalf("LoadRulesEngineFromJSON() error: %v", err)
+	}
+
+	damage := re.GetUnitUnitProperties()["1:1"].Damage
+	if damage.MinDamage != 2 || damage.MaxDamage != 4 {
+		t.Errorf("min/max = %v/%v, want 2/4 recomputed from ranges", damage.MinDamage, damage.MaxDamage)
+	}
+
+	// The probabilities sum to 0.95, which is reported but never rescaled
+	if got := damage.Ranges[1].Probability; got != 0.5 {
+		t.Errorf("range 3 probability = %v, want 0.5 as loaded", got)
+	}
+	if _, err := validateDamageDistribution(damage); err == nil {
+		t.Error("validateDamageDistribution() = nil, want an error for probabilities summing to 0.95")
+	}
+
+	wantExpected := (2*0.25 + 3*0.5 + 4*0.2) / 0.95
+	if math.Abs(damage.ExpectedDamage-wantExpected) > 1e-9 {
+		t.Errorf("expected damage = %v, want %v", damage.ExpectedDamage, wantExpected)
+	}
+}
+
+func TestValidateDamageDistributionWithinTolerance(t *testing.T) {
+	damage := &v1.DamageDistribution{
+		MinDamage: 1,
+		MaxDamage: 2,
+		Ranges: []*v1.DamageRange{
+			{MinValue: 1, MaxValue: 1, Probability: 0.5},
+			{MinValue: 2, MaxValue: 2, Probability: 0.49},
+		},
+	}
+	minMaxFixed, err := validateDamageDistribution(damage)
+	if minMaxFixed || err != nil {
+		t.Errorf("validateDamageDistribution() = %v, %v, want no changes", minMaxFixed, err)
+	}
+	if damage.Ranges[1].Probability != 0.49 {
+		t.Errorf("probability changed to %v", damage.Ranges[1].Probability)
+	}
+}
+
+// The shipped damage data loads with the probabilities it was extracted
+// with, incomplete distributions included
+func TestEmbeddedDamageDataIsNotRescaled(t *testing.T) {
+	var data struct {
+		UnitUnitProperties map[string]json.RawMessage `json:"unitUnitProperties"`
+	}
+	if err := json.Unmarshal(assets.RulesDamageDataJSON, &data); err != nil {
+		t.Fatalf("failed to parse damage data: %v", err)
+	}
+	re := DefaultRulesEngine()
+	for key, raw := range data.UnitUnitProperties {
+		props := &v1.UnitUnitProperties{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, props); err != nil {
+			t.Fatalf("damage %s: %v", key, err)
+		}
+		deduplicateDamageRanges(props.Damage)
+		loaded := re.GetUnitUnitProperties()[key].GetDamage().GetRanges()
+		for i, damageRange := range props.GetDamage().GetRanges() {
+			if loaded[i].Probability != damageRange.Probability {
+				t.Errorf("damage %s range %d probability = %v, want %v as extracted",
+					key, i, loaded[i].Probability, damageRange.Probability)
+			}
+		}
+	}
+}
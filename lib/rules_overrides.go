@@ -0,0 +1,152 @@
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Rules Overrides
+// =============================================================================
+//
+// Worlds and games can tweak the base rules: movement costs per terrain,
+// income values and the cap on units per player. Overrides are layered with game-level overrides taking
+// precedence over world-level ones, which take precedence over the base
+// rules. Combat is never overridden.
+
+// MaxOverrideMovementCost is the largest movement cost an override may set
+const MaxOverrideMovementCost = 99
+
+// MergeRulesOverrides layers overrides on top of each other, later ones
+// taking precedence. Returns nil when no layer overrides anything.
+func MergeRulesOverrides(layers ...*v1.RulesOverrides) *v1.RulesOverrides {
+	out := &v1.RulesOverrides{}
+	for _, layer := range layers {
+		if layer != nil {
+			proto.Merge(out, layer)
+		}
+	}
+	if IsEmptyRulesOverrides(out) {
+		return nil
+	}
+	return out
+}
+
+// IsEmptyRulesOverrides reports whether the overrides leave the rules unchanged
+func IsEmptyRulesOverrides(o *v1.RulesOverrides) bool {
+	return len(o.GetTerrainMovementCosts()) == 0 && proto.Size(o.GetIncome()) == 0 &&
+		o.GetMaxUnitsPerPlayer() == 0
+}
+
+// GameRulesOverrides returns the overrides a game is played with: its own
+// merged over those of the world it was created from
+func GameRulesOverrides(config *v1.GameConfiguration) *v1.RulesOverrides {
+	return MergeRulesOverrides(config.GetWorldRulesOverrides(), config.GetRulesOverrides())
+}
+
+// EffectiveIncomeConfig returns the income a game pays out: the game's income
+// config with any income overrides applied. Fields left at zero still fall
+// back to the defaults in GetTileIncomeFromConfig.
+func EffectiveIncomeConfig(config *v1.GameConfiguration) *v1.IncomeConfig {
+	income := GameRulesOverrides(config).GetIncome()
+	if income == nil {
+		return config.GetIncomeConfigs()
+	}
+	out := &v1.IncomeConfig{}
+	if base := config.GetIncomeConfigs(); base != nil {
+		proto.Merge(out, base)
+	}
+	proto.Merge(out, income)
+	return out
+}
+
+// ValidateRulesOverrides checks overrides against the rules they will be
+// applied to
+func (re *RulesEngine) ValidateRulesOverrides(o *v1.RulesOverrides) error {
+	for terrainID, cost := range o.GetTerrainMovementCosts() {
+		if _, err := re.GetTerrainData(terrainID); err != nil {
+			return fmt.Errorf("movement cost override: %w", err)
+		}
+		if math.IsNaN(cost) || cost <= 0 || cost > MaxOverrideMovementCost {
+			return fmt.Errorf("movement cost for terrain %d must be between 0 and %d, got %v",
+				terrainID, MaxOverrideMovementCost, cost)
+		}
+	}
+	income := o.GetIncome()
+	for name, value := range map[string]int32{
+		"starting_coins":     income.GetStartingCoins(),
+		"game_income":        income.GetGameIncome(),
+		"landbase_income":    income.GetLandbaseIncome(),
+		"navalbase_income":   income.GetNavalbaseIncome(),
+		"airportbase_income": income.GetAirportbaseIncome(),
+		"missilesilo_income": income.GetMissilesiloIncome(),
+		"mines_income":       income.GetMinesIncome(),
+	} {
+		if value < 0 {
+			return fmt.Errorf("income override %s cannot be negative, got %d", name, value)
+		}
+	}
+	if o.GetMaxUnitsPerPlayer() < 0 {
+		return fmt.Errorf("unit cap override cannot be negative, got %d", o.GetMaxUnitsPerPlayer())
+	}
+	return nil
+}
+
+// WithOverrides returns a copy of the rules with the overrides' movement
+// costs and unit cap applied. Costs only change on terrain a unit can already
+// enter; overrides never make terrain passable. Returns re itself when there
+// is nothing to change.
+func (re *RulesEngine) WithOverrides(o *v1.RulesOverrides) *RulesEngine {
+	if len(o.GetTerrainMovementCosts()) == 0 && o.GetMaxUnitsPerPlayer() == 0 {
+		return re
+	}
+
+	rules := proto.Clone(re.Rules()).(*v1.RulesEngine)
+	if o.MaxUnitsPerPlayer > 0 {
+		rules.MaxUnitsPerPlayer = o.MaxUnitsPerPlayer
+	}
+	for _, props := range rules.TerrainUnitProperties {
+		if cost, ok := o.TerrainMovementCosts[props.TerrainId]; ok && props.MovementCost > 0 {
+			props.MovementCost = cost
+		}
+	}
+	for _, unit := range rules.Units {
+		for terrainID, props := range unit.TerrainProperties {
+			if cost, ok := o.TerrainMovementCosts[terrainID]; ok && props.MovementCost > 0 {
+				props.MovementCost = cost
+			}
+		}
+	}
+	return NewRulesEngineFrom(rules)
+}
+
+// RulesHash fingerprints the rules the game is played with: the rules
+// engine with overrides merged in, and the income the game pays out
+func (g *Game) RulesHash() string {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(EffectiveIncomeConfig(g.Config))
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(append([]byte(g.RulesEngine.Hash()), data...))
+	return hex.EncodeToString(sum[:])
+}
+
+// StateHash fingerprints a game state together with the hash of the rules
+// it is played with, so the same position under different rules hashes
+// differently
+func StateHash(state *v1.GameState, rulesHash string) string {
+	state = proto.Clone(state).(*v1.GameState)
+	state.StateHash = ""
+	state.UpdatedAt = nil
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(state)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(append([]byte(rulesHash), data...))
+	return hex.EncodeToString(sum[:])
+}
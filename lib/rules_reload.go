@@ -0,0 +1,231 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// =============================================================================
+// Rules Hot-Reload (development only)
+// =============================================================================
+
+// ErrRulesHotSwapDisabled is returned when swapping the default rules engine
+// without EnableRulesHotSwap having been called (e.g. in production builds).
+var ErrRulesHotSwapDisabled = errors.New("rules hot swap is disabled")
+
+var rulesHotSwapEnabled atomic.Bool
+
+// EnableRulesHotSwap allows SetDefaultRulesEngine to replace the default
+// rules. Only dev-mode entry points should call this.
+func EnableRulesHotSwap() {
+	rulesHotSwapEnabled.Store(true)
+}
+
+// RulesHotSwapEnabled reports whether the default rules may be replaced
+func RulesHotSwapEnabled() bool {
+	return rulesHotSwapEnabled.Load()
+}
+
+// SetDefaultRulesEngine replaces the engine returned by DefaultRulesEngine so
+// that games created afterwards use it. Games already holding the previous
+// engine keep their pointer.
+func SetDefaultRulesEngine(re *RulesEngine) error {
+	if !RulesHotSwapEnabled() {
+		return ErrRulesHotSwapDisabled
+	}
+	defaultRulesEngine.Store(re)
+	return nil
+}
+
+// ReloadDefaultRulesFromJSON validates the given rules and damage JSON and, if
+// valid, installs it as the default rules engine. The returned diff describes
+// what changed relative to the previous default. On error the current default
+// is left active.
+func ReloadDefaultRulesFromJSON(rulesJSON, damageJSON []byte) (*RulesEngine, []string, error) {
+	if !RulesHotSwapEnabled() {
+		return nil, nil, ErrRulesHotSwapDisabled
+	}
+	fresh, err := LoadRulesEngineFromJSON(rulesJSON, damageJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+	diff := DiffRules(DefaultRulesEngine(), fresh)
+	if err := SetDefaultRulesEngine(fresh); err != nil {
+		return nil, nil, err
+	}
+	return fresh, diff, nil
+}
+
+// SourceFiles returns the rules and damage file paths this engine was loaded
+// from. Both are empty for engines loaded from embedded JSON.
+func (re *RulesEngine) SourceFiles() (rulesFile, damageFile string) {
+	return re.rulesFile, re.damageFile
+}
+
+// Reload re-reads the source files and swaps the in-memory tables, with their
+// audit, in one atomic step.  Games holding this *RulesEngine see the new
+// values on their next query.  If the files fail to load or validate, the
+// current tables are left untouched.
+func (re *RulesEngine) Reload() error {
+	if re.rulesFile == "" {
+		return fmt.Errorf("rules engine was not loaded from a file")
+	}
+
+	fresh, err := LoadRulesEngineFromFile(re.rulesFile, re.damageFile)
+	if err != nil {
+		return err
+	}
+
+	re.tables.Store(fresh.tables.Load())
+	re.generation.Add(1)
+	return nil
+}
+
+// WatchSourceFiles polls the source files every interval and calls Reload when
+// either modification time changes. onReload (optional) is called with the
+// result of every reload attempt. The returned function stops the watcher.
+//
+// This is intended for rules tuning in dev mode, not for production servers.
+func (re *RulesEngine) WatchSourceFiles(interval time.Duration, onReload func(error)) (stop func()) {
+	return pollFiles([]string{re.rulesFile, re.damageFile}, interval, func() {
+		err := re.Reload()
+		if err != nil {
+			log.Printf("rules reload failed: %v", err)
+		}
+		if onReload != nil {
+			onReload(err)
+		}
+	})
+}
+
+// WatchRulesFiles polls the given rules and damage files and loads a fresh
+// engine whenever they change. Unlike WatchSourceFiles the existing engine is
+// not modified; onChange decides what to do with the new one (or the error).
+func WatchRulesFiles(rulesFile, damageFile string, interval time.Duration, onChange func(*RulesEngine, error)) (stop func()) {
+	return pollFiles([]string{rulesFile, damageFile}, interval, func() {
+		onChange(LoadRulesEngineFromFile(rulesFile, damageFile))
+	})
+}
+
+// WatchDefaultRules installs the rules loaded from the given files as the
+// default rules and installs a fresh engine whenever the files change, logging
+// what changed.  Changes that fail to load keep the current rules.  Hot swap
+// must be enabled; only dev-mode entry points should call this.
+func WatchDefaultRules(rulesFile, damageFile string, interval time.Duration) (stop func(), err error) {
+	re, err := LoadRulesEngineFromFile(rulesFile, damageFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := SetDefaultRulesEngine(re); err != nil {
+		return nil, err
+	}
+
+	return WatchRulesFiles(rulesFile, damageFile, interval, func(fresh *RulesEngine, err error) {
+		if err != nil {
+			log.Printf("rejected rules change, keeping current rules: %v", err)
+			return
+		}
+		diff := DiffRules(DefaultRulesEngine(), fresh)
+		if err := SetDefaultRulesEngine(fresh); err != nil {
+			log.Printf("failed to install changed rules: %v", err)
+			return
+		}
+		for _, change := range diff {
+			log.Printf("rules changed: %s", change)
+		}
+	}), nil
+}
+
+// pollFiles calls onChange whenever the latest modification time across paths changes
+func pollFiles(paths []string, interval time.Duration, onChange func()) (stop func()) {
+	done := make(chan struct{})
+	lastMod := latestModTime(paths)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				mod := latestModTime(paths)
+				if mod.Equal(lastMod) {
+					continue
+				}
+				lastMod = mod
+				onChange()
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+// latestModTime returns the latest modification time across the given files
+func latestModTime(paths []string) (latest time.Time) {
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil && info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return
+}
+
+// =============================================================================
+// Rules Diff
+// =============================================================================
+
+// DiffRules returns a human readable, sorted list of balance values that differ
+// between two engines: unit costs, movement points and expected damage.
+func DiffRules(old, new *RulesEngine) (changes []string) {
+	for id, newUnit := range new.GetUnits() {
+		oldUnit, ok := old.GetUnits()[id]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("unit %d (%s): added", id, newUnit.Name))
+			continue
+		}
+		if oldUnit.Coins != newUnit.Coins {
+			changes = append(changes, fmt.Sprintf("unit %d (%s): coins %d -> %d", id, newUnit.Name, oldUnit.Coins, newUnit.Coins))
+		}
+		if oldUnit.MovementPoints != newUnit.MovementPoints {
+			changes = append(changes, fmt.Sprintf("unit %d (%s): movement_points %v -> %v", id, newUnit.Name, oldUnit.MovementPoints, newUnit.MovementPoints))
+		}
+	}
+	for id, oldUnit := range old.GetUnits() {
+		if _, ok := new.GetUnits()[id]; !ok {
+			changes = append(changes, fmt.Sprintf("unit %d (%s): removed", id, oldUnit.Name))
+		}
+	}
+
+	for key, newProps := range new.GetUnitUnitProperties() {
+		oldExpected := 0.0
+		if oldProps, ok := old.GetUnitUnitProperties()[key]; ok && oldProps.Damage != nil {
+			oldExpected = oldProps.Damage.ExpectedDamage
+		}
+		newExpected := 0.0
+		if newProps.Damage != nil {
+			newExpected = newProps.Damage.ExpectedDamage
+		}
+		if math.Abs(oldExpected-newExpected) > 1e-9 {
+			changes = append(changes, fmt.Sprintf("damage %s: expected %.2f -> %.2f", key, oldExpected, newExpected))
+		}
+	}
+	for key := range old.GetUnitUnitProperties() {
+		if _, ok := new.GetUnitUnitProperties()[key]; !ok {
+			changes = append(changes, fmt.Sprintf("damage %s: removed", key))
+		}
+	}
+
+	sort.Strings(changes)
+	return
+}
@@ -0,0 +1,275 @@
+package lib
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const reloadFixture = `{
+  "terrainTypes": {"5": "nature"},
+  "terrains": {"5": {"id": 5, "name": "Grass"}},
+  "units": {"1": {"id": 1, "name": "Soldier", "health": 10, "coins": COINS, "movement_points": 3}},
+  "terrainUnitProperties": {"5:1": {"terrain_id": 5, "unit_id": 1, "movement_cost": 1}}
+}`
+
+func writeReloadFixture(t *testing.T, path string, coins string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(strings.Replace(reloadFixture, "COINS", coins, 1)), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+}
+
+func TestRulesEngineReload(t *testing.T) {
+	rulesFile := filepath.Join(t.TempDir(), "rules.json")
+	writeReloadFixture(t, rulesFile, "75")
+
+	re, err := LoadRulesEngineFromFile(rulesFile, "")
+	if err != nil {
+		t.Fatalf("failed to load rules: %v", err)
+	}
+	// Simulate a game holding on to the engine pointer
+	held := re
+	hash := re.Hash()
+
+	writeReloadFixture(t, rulesFile, "120")
+	if err := re.Reload(); err != nil {
+		t.Fatalf("Reload() failed: %v", err)
+	}
+	if held.Hash() == hash {
+		t.Error("Hash() did not change after reloading changed rules")
+	}
+
+	unit, err := held.GetUnitData(1)
+	if err != nil {
+		t.Fatalf("GetUnitData() failed: %v", err)
+	}
+	if unit.Coins != 120 {
+		t.Errorf("after reload unit coins = %d, want 120", unit.Coins)
+	}
+}
+
+func TestRulesEngineReloadKeepsOldRulesOnError(t *testing.T) {
+	rulesFile := filepath.Join(t.TempDir(), "rules.json")
+	writeReloadFixture(t, rulesFile, "75")
+
+	re, err := LoadRulesEngineFromFile(rulesFile, "")
+	if err != nil {
+		t.Fatalf("failed to load rules: %v", err)
+	}
+
+	if err := os.WriteFile(rulesFile, []byte("{not json"), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+	if err := re.Reload(); err == nil {
+		t.Fatal("Reload() should fail on invalid JSON")
+	}
+
+	unit, _ := re.GetUnitData(1)
+	if unit.Coins != 75 {
+		t.Errorf("unit coins = %d, want 75 (old rules)", unit.Coins)
+	}
+}
+
+func TestRulesEngineReloadReplacesAudit(t *testing.T) {
+	rulesFile := filepath.Join(t.TempDir(), "rules.json")
+	writeReloadFixture(t, rulesFile, "75")
+
+	re, err := LoadRulesEngineFromFile(rulesFile, "")
+	if err != nil {
+		t.Fatalf("failed to load rules: %v", err)
+	}
+	if len(re.UnannotatedWarnings()) == 0 {
+		t.Fatal("the fixture soldier should have unannotated audit gaps")
+	}
+
+	// Annotate every gap the first load found and reload
+	annotations := map[string]string{}
+	for _, w := range re.GetRulesAudit() {
+		annotations[w.Field] = "fixture"
+	}
+	annotated, _ := json.Marshal(map[string]any{"1": annotations})
+	fixture := strings.Replace(reloadFixture, "COINS", "75", 1)
+	fixture = strings.Replace(fixture, "{\n", "{\n  \"auditAnnotations\": "+string(annotated)+",\n", 1)
+	if err := os.WriteFile(rulesFile, []byte(fixture), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+	if err := re.Reload(); err != nil {
+		t.Fatalf("Reload() failed: %v", err)
+	}
+
+	if warnings := re.UnannotatedWarnings(); len(warnings) != 0 {
+		t.Errorf("after reload unannotated warnings = %v, want none", warnings)
+	}
+}
+
+func TestRulesEngineReloadWhileReading(t *testing.T) {
+	rulesFile := filepath.Join(t.TempDir(), "rules.json")
+	writeReloadFixture(t, rulesFile, "75")
+
+	re, err := LoadRulesEngineFromFile(rulesFile, "")
+	if err != nil {
+		t.Fatalf("failed to load rules: %v", err)
+	}
+
+	// Run with -race: games keep reading while the rules are reloaded
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if unit, err := re.GetUnitData(1); err != nil || (unit.Coins != 75 && unit.Coins != 120) {
+					t.Errorf("GetUnitData() during reload = %v, %v", unit, err)
+					return
+				}
+				re.GetRulesAudit()
+			}
+		}()
+	}
+	for i := range 20 {
+		writeReloadFixture(t, rulesFile, []string{"75", "120"}[i%2])
+		if err := re.Reload(); err != nil {
+			t.Errorf("Reload() failed: %v", err)
+		}
+	}
+	close(done)
+	wg.Wait()
+}
+
+func TestRulesEngineReloadRequiresSourceFile(t *testing.T) {
+	if err := NewRulesEngine().Reload(); err == nil {
+		t.Error("Reload() should fail for engines not loaded from a file")
+	}
+}
+
+func TestRulesEngineWatchSourceFiles(t *testing.T) {
+	rulesFile := filepath.Join(t.TempDir(), "rules.json")
+	writeReloadFixture(t, rulesFile, "75")
+
+	re, err := LoadRulesEngineFromFile(rulesFile, "")
+	if err != nil {
+		t.Fatalf("failed to load rules: %v", err)
+	}
+
+	reloaded := make(chan error, 1)
+	stop := re.WatchSourceFiles(10*time.Millisecond, func(err error) { reloaded <- err })
+	defer stop()
+
+	writeReloadFixture(t, rulesFile, "90")
+	future := time.Now().Add(time.Second)
+	os.Chtimes(rulesFile, future, future)
+
+	select {
+	case err := <-reloaded:
+		if err != nil {
+			t.Fatalf("watch reload failed: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("watcher did not reload after file change")
+	}
+
+	unit, _ := re.GetUnitData(1)
+	if unit.Coins != 90 {
+		t.Errorf("after watch reload unit coins = %d, want 90", unit.Coins)
+	}
+}
+
+func TestSetDefaultRulesEngineRequiresHotSwap(t *testing.T) {
+	rulesHotSwapEnabled.Store(false)
+	if err := SetDefaultRulesEngine(NewRulesEngine()); err != ErrRulesHotSwapDisabled {
+		t.Errorf("SetDefaultRulesEngine() error = %v, want ErrRulesHotSwapDisabled", err)
+	}
+}
+
+func TestWatchDefaultRules(t *testing.T) {
+	original := DefaultRulesEngine()
+	EnableRulesHotSwap()
+	defer func() {
+		defaultRulesEngine.Store(original)
+		rulesHotSwapEnabled.Store(false)
+	}()
+
+	rulesFile := filepath.Join(t.TempDir(), "rules.json")
+	writeReloadFixture(t, rulesFile, "75")
+	stop, err := WatchDefaultRules(rulesFile, "", 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("WatchDefaultRules() failed: %v", err)
+	}
+	defer stop()
+	if unit, _ := DefaultRulesEngine().GetUnitData(1); unit == nil || unit.Coins != 75 {
+		t.Fatalf("default rules unit = %v, want the rules file's soldier", unit)
+	}
+
+	writeReloadFixture(t, rulesFile, "90")
+	future := time.Now().Add(time.Second)
+	os.Chtimes(rulesFile, future, future)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if unit, _ := DefaultRulesEngine().GetUnitData(1); unit.Coins == 90 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("changed rules file was not installed as the default")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestReloadDefaultRulesRejectsInvalidRules(t *testing.T) {
+	original := DefaultRulesEngine()
+	EnableRulesHotSwap()
+	defer func() {
+		defaultRulesEngine.Store(original)
+		rulesHotSwapEnabled.Store(false)
+	}()
+
+	if _, _, err := ReloadDefaultRulesFromJSON([]byte("{not json"), nil); err == nil {
+		t.Fatal("ReloadDefaultRulesFromJSON() should fail on invalid JSON")
+	}
+	if DefaultRulesEngine() != original {
+		t.Error("invalid rules replaced the default rules engine")
+	}
+
+	valid := []byte(strings.Replace(reloadFixture, "COINS", "75", 1))
+	fresh, diff, err := ReloadDefaultRulesFromJSON(valid, nil)
+	if err != nil {
+		t.Fatalf("ReloadDefaultRulesFromJSON() failed: %v", err)
+	}
+	if DefaultRulesEngine() != fresh {
+		t.Error("valid rules were not installed as the default")
+	}
+	if len(diff) == 0 {
+		t.Error("expected a non-empty diff against the default rules")
+	}
+}
+
+func TestDiffRules(t *testing.T) {
+	old, err := LoadRulesEngineFromJSON([]byte(strings.Replace(reloadFixture, "COINS", "75", 1)), nil)
+	if err != nil {
+		t.Fatalf("failed to load rules: %v", err)
+	}
+	updated, err := LoadRulesEngineFromJSON([]byte(strings.Replace(reloadFixture, "COINS", "80", 1)), nil)
+	if err != nil {
+		t.Fatalf("failed to load rules: %v", err)
+	}
+
+	if diff := DiffRules(old, old); len(diff) != 0 {
+		t.Errorf("DiffRules(same) = %v, want empty", diff)
+	}
+	diff := DiffRules(old, updated)
+	if len(diff) != 1 || diff[0] != "unit 1 (Soldier): coins 75 -> 80" {
+		t.Errorf("DiffRules() = %v", diff)
+	}
+}
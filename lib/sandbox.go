@@ -0,0 +1,235 @@
+package lib
+
+import (
+	"fmt"
+	"math"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Sandbox Games
+// =============================================================================
+//
+// A sandbox is a practice board: its creator holds every seat and can spawn
+// and delete units of any player at will.  Its settings can also let units
+// and bases of any player act whoever's turn it is (the move is made as
+// their owner), let units take their actions in any order and make builds
+// and constructions free.  Sandbox games are never rated or counted in
+// stats.
+
+// SandboxEnabled reports whether a game is a sandbox
+func SandboxEnabled(config *v1.GameConfiguration) bool {
+	return config.GetSettings().GetSandbox().GetEnabled()
+}
+
+// ValidateSandbox checks a sandbox game leaves out the features that would
+// make its results count
+func ValidateSandbox(config *v1.GameConfiguration) error {
+	if !SandboxEnabled(config) {
+		return nil
+	}
+	settings := config.GetSettings()
+	if settings.GetRated() {
+		return fmt.Errorf("sandbox games cannot be rated")
+	}
+	if settings.GetPuzzle() != nil {
+		return fmt.Errorf("puzzles cannot be played as a sandbox")
+	}
+	return nil
+}
+
+// IsSandbox reports whether the game is a sandbox
+func (g *Game) IsSandbox() bool {
+	return SandboxEnabled(g.Config)
+}
+
+// relaxesTurnOrder reports whether any player's units and bases can act
+// whoever's turn it is
+func (g *Game) relaxesTurnOrder() bool {
+	return g.Config.GetSettings().GetSandbox().GetRelaxTurnOrder()
+}
+
+// relaxesActionOrder reports whether units can take their actions in any
+// order
+func (g *Game) relaxesActionOrder() bool {
+	return g.Config.GetSettings().GetSandbox().GetRelaxActionOrder()
+}
+
+// hasInfiniteCoins reports whether builds and constructions are free
+func (g *Game) hasInfiniteCoins() bool {
+	return g.Config.GetSettings().GetSandbox().GetInfiniteCoins()
+}
+
+// coinCost returns what something priced at coins costs in this game
+func (g *Game) coinCost(coins int32) int32 {
+	if g.hasInfiniteCoins() {
+		return 0
+	}
+	return coins
+}
+
+// spendableCoins returns the coins a player can spend, unlimited when
+// builds are free
+func (g *Game) spendableCoins(player int32) int32 {
+	if g.hasInfiniteCoins() {
+		return math.MaxInt32
+	}
+	if playerState := g.GameState.PlayerStates[player]; playerState != nil {
+		return playerState.Coins
+	}
+	return 0
+}
+
+// resolveActionStep is RulesEngine.ResolveActionStep, except that when
+// the action order is relaxed a unit can also take any action of its
+// action_order it has already passed
+func (g *Game) resolveActionStep(unit *v1.Unit, unitDef *v1.UnitDefinition, action string) (int32, bool) {
+	if step, ok := g.RulesEngine.ResolveActionStep(unit, unitDef, action); ok || !g.relaxesActionOrder() {
+		return step, ok
+	}
+	for step := range unitActionOrder(unitDef) {
+		atStep := &v1.Unit{ProgressionStep: int32(step), DistanceLeft: unit.DistanceLeft}
+		if ContainsAction(g.RulesEngine.GetAllowedActionsForUnit(atStep, unitDef), action) {
+			return int32(step), true
+		}
+	}
+	return 0, false
+}
+
+// sandboxActor returns the player a move acting from a hex with the given
+// unit and tile is made as, 0 if it is made as the current player
+func (g *Game) sandboxActor(unit *v1.Unit, tile *v1.Tile) int32 {
+	switch {
+	case !g.relaxesTurnOrder():
+		return 0
+	case unit != nil:
+		return unit.Player
+	case tile != nil:
+		return tile.Player
+	}
+	return 0
+}
+
+// moveActor returns the player a move is made as, 0 for the current player
+func (g *Game) moveActor(move *v1.GameMove) int32 {
+	source, _, _ := movePositions(move)
+	if source == nil || !g.relaxesTurnOrder() {
+		return 0
+	}
+	coord, err := g.FromPos(proto.Clone(source).(*v1.Position))
+	if err != nil {
+		return 0
+	}
+	return g.sandboxActor(g.RulesEngine.OccupantAt(g.World, coord), g.World.TileAt(coord))
+}
+
+// actAs makes player the current player until the returned function is
+// called.  Player 0 leaves the current player as is.
+func (g *Game) actAs(player int32) (restore func()) {
+	current := g.CurrentPlayer
+	if player < 1 || player == current {
+		return func() {}
+	}
+	g.CurrentPlayer, g.GameState.CurrentPlayer = player, player
+	return func() {
+		g.CurrentPlayer, g.GameState.CurrentPlayer = current, current
+	}
+}
+
+// ProcessSpawnUnit places a unit of any type for any player on an empty
+// tile.  Only sandbox games accept it.
+func (g *Game) ProcessSpawnUnit(move *v1.GameMove, action *v1.SpawnUnitAction) (err error) {
+	if !g.IsSandbox() {
+		return fmt.Errorf("units can only be spawned in sandbox games")
+	}
+	move.IsPermanent = true
+
+	coord, err := g.FromPos(action.Pos)
+	if err != nil {
+		return fmt.Errorf("invalid spawn position: %w", err)
+	}
+	tile := g.World.TileAt(coord)
+	if tile == nil {
+		return fmt.Errorf("no tile at position %v", coord)
+	}
+	player := action.Player
+	if player == 0 {
+		player = g.CurrentPlayer
+	}
+	if player < 1 || player > g.NumPlayers() {
+		return fmt.Errorf("invalid player %d", player)
+	}
+	unitData, err := g.RulesEngine.GetUnitData(action.UnitType)
+	if err != nil {
+		return fmt.Errorf("unit data not found for unit type %d", action.UnitType)
+	}
+	if existingUnit := g.RulesEngine.OccupantAt(g.World, coord); existingUnit != nil {
+		return fmt.Errorf("cannot spawn unit at %v: position already occupied by unit %s", coord, existingUnit.Shortcut)
+	}
+	if g.RulesEngine.footprintBlocked(g.World, action.UnitType, coord, LEFT, nil) {
+		return fmt.Errorf("cannot spawn unit at %v: no room for its footprint", coord)
+	}
+	if terrain := g.RulesEngine.GetEffectiveTileType(g.World, coord); !g.RulesEngine.CanUnitEnterTerrain(action.UnitType, terrain) {
+		return fmt.Errorf("%s cannot stand on %s", unitData.Name, g.RulesEngine.terrainName(terrain))
+	}
+
+	// Spawned units are ready to act straight away
+	newUnit := &v1.Unit{
+		Q:                int32(coord.Q),
+		R:                int32(coord.R),
+		Player:           player,
+		UnitType:         action.UnitType,
+		Shortcut:         g.World.GenerateUnitShortcut(player),
+		Id:               g.nextUnitID(),
+		AvailableHealth:  unitData.Health,
+		DistanceLeft:     g.RulesEngine.RoundMovementPoints(unitData.MovementPoints),
+		LastToppedupTurn: g.TurnCounter,
+	}
+	g.World.AddUnit(newUnit)
+
+	// As with builds, the tile has acted for the turn
+	tile.LastActedTurn = g.TurnCounter
+
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitBuilt{
+			UnitBuilt: &v1.UnitBuiltChange{
+				Unit:        copyUnit(newUnit),
+				TileQ:       tile.Q,
+				TileR:       tile.R,
+				PlayerCoins: g.GameState.PlayerStates[player].GetCoins(),
+			},
+		},
+	})
+	return nil
+}
+
+// ProcessDeleteUnit removes a unit from the board.  Only sandbox games
+// accept it.
+func (g *Game) ProcessDeleteUnit(move *v1.GameMove, action *v1.DeleteUnitAction) (err error) {
+	if !g.IsSandbox() {
+		return fmt.Errorf("units can only be deleted in sandbox games")
+	}
+	move.IsPermanent = true
+
+	coord, err := g.FromPos(action.Pos)
+	if err != nil {
+		return fmt.Errorf("invalid position: %w", err)
+	}
+	unit := g.RulesEngine.OccupantAt(g.World, coord)
+	if unit == nil {
+		return fmt.Errorf("no unit at position %v", coord)
+	}
+
+	previousUnit := copyUnit(unit)
+	if err := g.World.RemoveUnit(unit); err != nil {
+		return err
+	}
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitKilled{
+			UnitKilled: &v1.UnitKilledChange{PreviousUnit: previousUnit},
+		},
+	})
+	return nil
+}
@@ -0,0 +1,37 @@
+package lib
+
+import "math/rand"
+
+// scriptedSource is a rand.Source that plays back a fixed cycle of rolls
+type scriptedSource struct {
+	values []int64
+	next   int
+}
+
+// NewScriptedRNG returns an RNG whose Float64 returns the given rolls in
+// order, starting over after the last.  Rolls must be in [0, 1).  For use
+// with Game.SetRNG to make combat deal an exact, known damage in tests.
+func NewScriptedRNG(rolls ...float64) *rand.Rand {
+	if len(rolls) == 0 {
+		panic("scripted RNG needs at least one roll")
+	}
+	source := &scriptedSource{}
+	for _, roll := range rolls {
+		if roll < 0 || roll >= 1 {
+			panic("scripted rolls must be in [0, 1)")
+		}
+		// rand.Float64 divides Int63 by 2^63
+		source.values = append(source.values, int64(roll*(1<<63)))
+	}
+	return rand.New(source)
+}
+
+func (s *scriptedSource) Int63() int64 {
+	value := s.values[s.next]
+	s.next = (s.next + 1) % len(s.values)
+	return value
+}
+
+func (s *scriptedSource) Seed(int64) {
+	s.next = 0
+}
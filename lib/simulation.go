@@ -0,0 +1,167 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Simulation Harness
+// =============================================================================
+//
+// Plays games out with the baseline AI on every side. Each game runs on its
+// own copy of the world with its own seed, so a batch is reproducible.
+
+// SimulationConfig controls a batch of AI-vs-AI games
+type SimulationConfig struct {
+	// Number of games to play
+	Simulations int
+
+	// Games still running after this many turns count as draws
+	MaxTurns int32
+
+	// Seed of the first game; game i is played with Seed+i
+	Seed int64
+
+	// Players, income and settings. If nil or without players, every player
+	// owning units or tiles in the world takes part.
+	Config *v1.GameConfiguration
+
+	// BeforeTurn, if set, runs before the AI plays each turn, eg to fire
+	// scripted scenario triggers. An error aborts the batch.
+	BeforeTurn func(g *Game) error
+}
+
+// SimulationResult is the outcome of a single simulated game
+type SimulationResult struct {
+	Seed int64
+
+	// Winning player, or 0 if the game hit MaxTurns
+	Winner int32
+
+	// Turn the game ended on
+	Turns int32
+}
+
+// WorldDataPlayerIDs returns the players owning at least one unit or tile, in order
+func WorldDataPlayerIDs(worldData *v1.WorldData) []int32 {
+	MigrateWorldData(worldData)
+
+	var playerIDs []int32
+	for _, tile := range worldData.TilesMap {
+		if tile != nil && tile.Player > 0 && !slices.Contains(playerIDs, tile.Player) {
+			playerIDs = append(playerIDs, tile.Player)
+		}
+	}
+	for _, unit := range worldData.UnitsMap {
+		if unit != nil && unit.Player > 0 && !slices.Contains(playerIDs, unit.Player) {
+			playerIDs = append(playerIDs, unit.Player)
+		}
+	}
+	slices.Sort(playerIDs)
+	return playerIDs
+}
+
+// SimulationGameConfig returns the game configuration simulations of the
+// world are played with, filling in the players if config has none
+func SimulationGameConfig(worldData *v1.WorldData, config *v1.GameConfiguration) *v1.GameConfiguration {
+	if config == nil {
+		config = &v1.GameConfiguration{}
+	} else {
+		config = proto.Clone(config).(*v1.GameConfiguration)
+	}
+	if len(config.Players) == 0 {
+		for _, playerID := range WorldDataPlayerIDs(worldData) {
+			config.Players = append(config.Players, &v1.GamePlayer{
+				PlayerId:   playerID,
+				PlayerType: "ai",
+				IsActive:   true,
+			})
+		}
+	}
+	return config
+}
+
+// NewSimulationGame sets up a fresh game on a copy of worldData
+func NewSimulationGame(worldData *v1.WorldData, config *v1.GameConfiguration, rulesEngine *RulesEngine, seed int64) (*Game, error) {
+	if len(config.GetPlayers()) < 2 {
+		return nil, fmt.Errorf("simulations need at least 2 players, found %d", len(config.GetPlayers()))
+	}
+	worldData = proto.Clone(worldData).(*v1.WorldData)
+	MigrateWorldData(worldData)
+
+	state := &v1.GameState{
+		GameId:        "simulation",
+		CurrentPlayer: config.Players[0].PlayerId,
+		TurnCounter:   1,
+		WorldData:     worldData,
+		PlayerStates:  make(map[int32]*v1.PlayerState),
+	}
+	for _, player := range config.Players {
+		state.PlayerStates[player.PlayerId] = &v1.PlayerState{
+			Coins:    player.StartingCoins + CalculatePlayerBaseIncome(player.PlayerId, worldData, EffectiveIncomeConfig(config)),
+			IsActive: true,
+		}
+	}
+	ApplyHandicaps(state, config, rulesEngine)
+
+	game := &v1.Game{Id: "simulation", Config: config}
+	return NewGame(game, state, NewWorld("simulation", worldData), rulesEngine, seed), nil
+}
+
+// PlayOut plays the game to completion (or maxTurns) with the baseline AI
+// controlling every player
+func (g *Game) PlayOut(maxTurns int32, beforeTurn func(g *Game) error) (SimulationResult, error) {
+	return g.PlayOutContext(context.Background(), maxTurns, beforeTurn)
+}
+
+// PlayOutContext is PlayOut that stops with the context's error once it is
+// done
+func (g *Game) PlayOutContext(ctx context.Context, maxTurns int32, beforeTurn func(g *Game) error) (SimulationResult, error) {
+	for !g.GameState.Finished && g.TurnCounter <= maxTurns {
+		if beforeTurn != nil {
+			if err := beforeTurn(g); err != nil {
+				return SimulationResult{}, err
+			}
+		}
+		if _, err := g.PlayBaselineTurnContext(ctx); err != nil {
+			return SimulationResult{}, fmt.Errorf("player %d failed to play turn %d: %w", g.CurrentPlayer, g.TurnCounter, err)
+		}
+	}
+
+	result := SimulationResult{Seed: g.Seed, Turns: min(g.TurnCounter, maxTurns)}
+	if g.GameState.Finished {
+		result.Winner = g.WinningPlayer
+	}
+	return result, nil
+}
+
+// RunSimulations plays sim.Simulations games of the world
+func RunSimulations(worldData *v1.WorldData, rulesEngine *RulesEngine, sim SimulationConfig) ([]*SimulationResult, error) {
+	return RunSimulationsContext(context.Background(), worldData, rulesEngine, sim)
+}
+
+// RunSimulationsContext is RunSimulations that stops with the context's error
+// once it is done
+func RunSimulationsContext(ctx context.Context, worldData *v1.WorldData, rulesEngine *RulesEngine, sim SimulationConfig) ([]*SimulationResult, error) {
+	config := SimulationGameConfig(worldData, sim.Config)
+
+	results := make([]*SimulationResult, 0, sim.Simulations)
+	for i := range sim.Simulations {
+		seed := sim.Seed + int64(i)
+		game, err := NewSimulationGame(worldData, config, rulesEngine, seed)
+		if err != nil {
+			return nil, err
+		}
+		result, err := game.PlayOutContext(ctx, sim.MaxTurns, sim.BeforeTurn)
+		if err != nil {
+			return nil, fmt.Errorf("simulation with seed %d failed: %w", seed, err)
+		}
+		results = append(results, &result)
+	}
+	return results, nil
+}
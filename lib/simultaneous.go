@@ -0,0 +1,371 @@
+package lib
+
+import (
+	"fmt"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Simultaneous Turns (experimental)
+// =============================================================================
+//
+// In a simultaneous turns game every player plans their whole turn at once.
+// Each plan is checked against the position as it stands when it is
+// submitted, as if nobody else moved, and then kept on the game state until
+// every player still in the game has submitted theirs.  The last submission
+// resolves them all, and its move carries the changes of the whole turn.
+//
+// Only moves and attacks can be planned.  Plans resolve by initiative: of
+// the next planned move of every player, the one whose unit has the most
+// movement points goes first, a tie going to the player who has resolved
+// fewer moves so far and then to the lower player number.  Each player's
+// own moves always resolve in the order they were planned.
+//
+// A planned move that can no longer be made as planned, eg because a unit
+// with more initiative took its destination, stops short: the unit moves to
+// the furthest hex along its planned path it can still end on, or stays put.
+// A planned attack whose target has gone or is out of reach is skipped, as
+// are the moves of units destroyed before their turn came.  Each of these is
+// recorded as a game event.  Once all plans have resolved, every player's
+// turn ends.
+
+// Game events recorded while resolving simultaneous turns
+const (
+	GameEventPlannedMoveStoppedShort = "planned_move_stopped_short"
+	GameEventPlannedMoveSkipped      = "planned_move_skipped"
+)
+
+// SimultaneousTurnsEnabled reports whether a game is played with
+// simultaneous turns
+func SimultaneousTurnsEnabled(config *v1.GameConfiguration) bool {
+	return config.GetSettings().GetSimultaneousTurns()
+}
+
+// ValidateSimultaneousTurns checks a game with simultaneous turns leaves out
+// the features they do not work with yet
+func ValidateSimultaneousTurns(config *v1.GameConfiguration) error {
+	if !SimultaneousTurnsEnabled(config) {
+		return nil
+	}
+	settings := config.GetSettings()
+	if settings.GetPuzzle() != nil {
+		return fmt.Errorf("puzzles cannot be played with simultaneous turns")
+	}
+	if GetTimeBankSettings(config) != nil {
+		return fmt.Errorf("time banks cannot be used with simultaneous turns")
+	}
+	for _, player := range config.GetPlayers() {
+		if player.PlayerType == "ai" {
+			return fmt.Errorf("player %d is an AI, which cannot play simultaneous turns", player.PlayerId)
+		}
+	}
+	return nil
+}
+
+// IsPlanning reports whether the game is waiting for turn plans
+func (g *Game) IsPlanning() bool {
+	switch {
+	case !SimultaneousTurnsEnabled(g.Config), g.GameState.Finished, g.IsDrafting():
+		return false
+	}
+	return g.GameState.Status != v1.GameStatus_GAME_STATUS_WAITING
+}
+
+// HasSubmittedPlan reports whether player has locked in their plan for the
+// current turn
+func (g *Game) HasSubmittedPlan(player int32) bool {
+	return slices.ContainsFunc(g.GameState.TurnPlans, func(plan *v1.TurnPlan) bool {
+		return plan.Player == player
+	})
+}
+
+// PlanningPlayers returns the players who plan this turn: those who have not
+// forfeited and still have a unit or a tile
+func (g *Game) PlanningPlayers() []int32 {
+	var players []int32
+	for player := int32(1); player <= g.NumPlayers(); player++ {
+		if !g.playerForfeited(player) && g.hasUnitOrTile(player) {
+			players = append(players, player)
+		}
+	}
+	return players
+}
+
+// WaitingForPlans returns the planning players who have not submitted their
+// plan yet
+func (g *Game) WaitingForPlans() []int32 {
+	var waiting []int32
+	for _, player := range g.PlanningPlayers() {
+		if !g.HasSubmittedPlan(player) {
+			waiting = append(waiting, player)
+		}
+	}
+	return waiting
+}
+
+// hasUnitOrTile reports whether player has any unit or tile left
+func (g *Game) hasUnitOrTile(player int32) bool {
+	if len(g.World.GetPlayerUnits(int(player))) > 0 {
+		return true
+	}
+	for _, tile := range g.World.TilesByCoord() {
+		if tile.Player == player {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessSubmitPlan locks in move.Player's plan for the turn, resolving the
+// turn if it was the last plan to come in
+func (g *Game) ProcessSubmitPlan(move *v1.GameMove, action *v1.SubmitPlanAction) (err error) {
+	if !g.IsPlanning() {
+		return fmt.Errorf("the game is not planning simultaneous turns")
+	}
+	player := move.Player
+	if !slices.Contains(g.PlanningPlayers(), player) {
+		return fmt.Errorf("player %d has no turn to plan", player)
+	}
+	if g.HasSubmittedPlan(player) {
+		return fmt.Errorf("player %d has already submitted their plan", player)
+	}
+
+	plan, err := g.checkPlan(player, action.Moves)
+	if err != nil {
+		return err
+	}
+	g.GameState.TurnPlans = append(g.GameState.TurnPlans, plan)
+
+	// The submitted move is broadcast and kept in the history, so it drops
+	// the plan rather than show it to the other players
+	action.Moves = nil
+
+	move.IsPermanent = true
+	submitted := &v1.PlanSubmittedChange{PlayerId: player, PlannedMoves: int32(len(plan.Moves))}
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_PlanSubmitted{PlanSubmitted: submitted},
+	})
+	if len(g.WaitingForPlans()) > 0 {
+		return nil
+	}
+
+	submitted.Resolved = true
+	return g.resolvePlans(move)
+}
+
+// checkPlan checks a player's planned moves can be made in order from the
+// current position, as if no other player moved, and returns them as the
+// player's plan with the unit making each move
+func (g *Game) checkPlan(player int32, moves []*v1.GameMove) (*v1.TurnPlan, error) {
+	scratch, err := g.replay(nil, func(*Game) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	scratch.CurrentPlayer = player
+
+	plan := &v1.TurnPlan{Player: player}
+	for i, planned := range moves {
+		planned = proto.Clone(planned).(*v1.GameMove)
+		planned.Player = player
+		planned.Changes = nil
+
+		unitID, err := scratch.processPlannedMove(planned)
+		if err != nil {
+			return nil, fmt.Errorf("planned move %d: %w", i+1, err)
+		}
+
+		// The path the move took is kept to stop short along if need be
+		planned.Changes = nil
+		plan.Moves = append(plan.Moves, &v1.PlannedMove{Move: planned, UnitId: unitID})
+	}
+	return plan, nil
+}
+
+// processPlannedMove makes a planned move or attack for the current player
+// and returns the ID of the unit making it.  Its positions are rewritten as
+// coordinates, as a direction or label would mean something else once the
+// unit or its target has moved.
+func (g *Game) processPlannedMove(move *v1.GameMove) (unitID int32, err error) {
+	var from *v1.Position
+	switch a := move.MoveType.(type) {
+	case *v1.GameMove_MoveUnit:
+		from = a.MoveUnit.From
+	case *v1.GameMove_AttackUnit:
+		from = a.AttackUnit.Attacker
+	default:
+		return 0, fmt.Errorf("only moves and attacks can be planned")
+	}
+	coord, err := g.FromPos(from)
+	if err != nil {
+		return 0, err
+	}
+	unit := g.World.UnitAt(coord)
+	if unit == nil {
+		return 0, fmt.Errorf("no unit at %v", coord)
+	}
+	unitID = unit.Id
+	at := &v1.Position{Q: int32(coord.Q), R: int32(coord.R)}
+
+	switch a := move.MoveType.(type) {
+	case *v1.GameMove_MoveUnit:
+		to, err := g.FromPosWithBase(a.MoveUnit.To, &coord)
+		if err != nil {
+			return 0, fmt.Errorf("invalid to position: %w", err)
+		}
+		a.MoveUnit.From, a.MoveUnit.To = at, &v1.Position{Q: int32(to.Q), R: int32(to.R)}
+		return unitID, g.ProcessMoveUnit(move, a.MoveUnit, false)
+	case *v1.GameMove_AttackUnit:
+		target, err := g.FromPos(a.AttackUnit.Defender)
+		if err != nil {
+			return 0, fmt.Errorf("invalid defender position: %w", err)
+		}
+		a.AttackUnit.Attacker, a.AttackUnit.Defender = at, &v1.Position{Q: int32(target.Q), R: int32(target.R)}
+		return unitID, g.ProcessAttackUnit(move, a.AttackUnit)
+	}
+	return unitID, nil
+}
+
+// resolvePlans plays out every submitted plan in initiative order and ends
+// the turn, adding all the changes to move
+func (g *Game) resolvePlans(move *v1.GameMove) error {
+	plans := g.GameState.TurnPlans
+	slices.SortFunc(plans, func(a, b *v1.TurnPlan) int { return int(a.Player - b.Player) })
+	next := make([]int, len(plans))
+
+	for !g.GameState.Finished {
+		i := g.nextPlannedMove(plans, next)
+		if i < 0 {
+			break
+		}
+		planned := plans[i].Moves[next[i]]
+		next[i]++
+		g.CurrentPlayer = plans[i].Player
+		move.Changes = append(move.Changes, g.resolvePlannedMove(planned)...)
+	}
+
+	// Every player's turn ends in player order, so each collects their
+	// income, and the next turn starts back at the first player
+	g.GameState.TurnPlans = nil
+	turn := g.TurnCounter
+	g.CurrentPlayer = 1
+	for g.TurnCounter == turn && !g.GameState.Finished {
+		if err := g.ProcessEndTurn(move, &v1.EndTurnAction{Force: true}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextPlannedMove returns the index of the plan whose next move has the
+// initiative, or -1 once every plan has resolved
+func (g *Game) nextPlannedMove(plans []*v1.TurnPlan, next []int) int {
+	best, bestSpeed := -1, 0.0
+	for i, plan := range plans {
+		if next[i] >= len(plan.Moves) {
+			continue
+		}
+		speed := g.plannedMoveSpeed(plan.Moves[next[i]])
+		switch {
+		case best < 0, speed > bestSpeed:
+		case speed == bestSpeed && next[i] < next[best]:
+		default:
+			continue
+		}
+		best, bestSpeed = i, speed
+	}
+	return best
+}
+
+// plannedMoveSpeed is a planned move's initiative: the movement points of
+// its unit's type, or 0 if the unit is gone
+func (g *Game) plannedMoveSpeed(planned *v1.PlannedMove) float64 {
+	unit := g.GetUnitByID(planned.UnitId)
+	if unit == nil {
+		return 0
+	}
+	unitDef, err := g.RulesEngine.GetUnitData(unit.UnitType)
+	if err != nil {
+		return 0
+	}
+	return unitDef.MovementPoints
+}
+
+// resolvePlannedMove makes a planned move from wherever its unit is now,
+// stopping it short or skipping it if it can no longer be made as planned,
+// and returns the changes it made
+func (g *Game) resolvePlannedMove(planned *v1.PlannedMove) []*v1.WorldChange {
+	move := proto.Clone(planned.Move).(*v1.GameMove)
+	unit := g.GetUnitByID(planned.UnitId)
+	if unit == nil {
+		return []*v1.WorldChange{g.plannedMoveEvent(GameEventPlannedMoveSkipped,
+			"%s was destroyed before its planned move", plannedMoveLabel(move))}
+	}
+	at := &v1.Position{Q: unit.Q, R: unit.R}
+
+	switch a := move.MoveType.(type) {
+	case *v1.GameMove_MoveUnit:
+		a.MoveUnit.From = at
+		path := a.MoveUnit.ReconstructedPath
+		a.MoveUnit.ReconstructedPath = nil
+		if err := g.ProcessMoveUnit(move, a.MoveUnit, false); err == nil {
+			return move.Changes
+		}
+		// Stop at the furthest hex of the planned path that can still be
+		// reached, if any
+		edges := path.GetEdges()
+		for i := len(edges) - 2; i >= 0; i-- {
+			move.Changes = nil
+			a.MoveUnit.To = &v1.Position{Q: edges[i].ToQ, R: edges[i].ToR}
+			if err := g.ProcessMoveUnit(move, a.MoveUnit, false); err == nil {
+				return append(move.Changes, g.plannedMoveEvent(GameEventPlannedMoveStoppedShort,
+					"%s stopped short at %d,%d", plannedMoveLabel(move), edges[i].ToQ, edges[i].ToR))
+			}
+		}
+		return []*v1.WorldChange{g.plannedMoveEvent(GameEventPlannedMoveStoppedShort,
+			"%s could not move and stayed at %d,%d", plannedMoveLabel(move), unit.Q, unit.R)}
+
+	case *v1.GameMove_AttackUnit:
+		a.AttackUnit.Attacker = at
+		if err := g.ProcessAttackUnit(move, a.AttackUnit); err != nil {
+			return []*v1.WorldChange{g.plannedMoveEvent(GameEventPlannedMoveSkipped,
+				"%s's planned attack was skipped: %v", plannedMoveLabel(move), err)}
+		}
+		return move.Changes
+	}
+	return nil
+}
+
+// FormatPlanSubmitted describes a locked in plan for the game log
+func FormatPlanSubmitted(change *v1.PlanSubmittedChange) string {
+	if change.Resolved {
+		return fmt.Sprintf("Player %d locked in their plan; all plans are in and the turn resolved", change.PlayerId)
+	}
+	return fmt.Sprintf("Player %d locked in their plan, waiting for opponent", change.PlayerId)
+}
+
+// plannedMoveEvent records what became of a planned move of the current
+// player
+func (g *Game) plannedMoveEvent(eventType, format string, args ...any) *v1.WorldChange {
+	return &v1.WorldChange{
+		ChangeType: &v1.WorldChange_GameEvent{
+			GameEvent: &v1.GameEventChange{
+				EventType:   eventType,
+				PlayerId:    g.CurrentPlayer,
+				Description: fmt.Sprintf(format, args...),
+			},
+		},
+	}
+}
+
+// plannedMoveLabel names the unit of a planned move by where it planned to
+// move or attack from
+func plannedMoveLabel(move *v1.GameMove) string {
+	from := move.GetMoveUnit().GetFrom()
+	if from == nil {
+		from = move.GetAttackUnit().GetAttacker()
+	}
+	return fmt.Sprintf("Player %d's unit from %d,%d", move.Player, from.GetQ(), from.GetR())
+}
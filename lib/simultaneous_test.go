@@ -0,0 +1,258 @@
+package lib
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// simultaneousGame marks a test game as played with simultaneous turns
+func simultaneousGame(game *Game) *Game {
+	game.Config.Settings.SimultaneousTurns = true
+	return game
+}
+
+// plannedMove plans moving the unit at from to to
+func plannedMove(fromQ, fromR, toQ, toR int32) *v1.GameMove {
+	return &v1.GameMove{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+		From: &v1.Position{Q: fromQ, R: fromR},
+		To:   &v1.Position{Q: toQ, R: toR},
+	}}}
+}
+
+// plannedAttack plans the unit at attacker attacking the one at defender
+func plannedAttack(attackerQ, attackerR, defenderQ, defenderR int32) *v1.GameMove {
+	return &v1.GameMove{MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+		Attacker: &v1.Position{Q: attackerQ, R: attackerR},
+		Defender: &v1.Position{Q: defenderQ, R: defenderR},
+	}}}
+}
+
+// submitPlan submits player's plan and returns the submission move
+func submitPlan(t *testing.T, game *Game, player int32, moves ...*v1.GameMove) *v1.GameMove {
+	t.Helper()
+	move := &v1.GameMove{
+		Player:   player,
+		MoveType: &v1.GameMove_SubmitPlan{SubmitPlan: &v1.SubmitPlanAction{Moves: moves}},
+	}
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("player %d's plan was rejected: %v", player, err)
+	}
+	return move
+}
+
+// gameEvents returns the descriptions of the game events of the given type
+// in changes
+func gameEvents(changes []*v1.WorldChange, eventType string) []string {
+	var events []string
+	for _, change := range changes {
+		if event := change.GetGameEvent(); event != nil && event.EventType == eventType {
+			events = append(events, event.Description)
+		}
+	}
+	return events
+}
+
+// TestSimultaneousTurns_FasterUnitTakesContestedHex tests that when two
+// units plan to end on the same hex the one with more movement points gets
+// there and the other stops short along its path
+func TestSimultaneousTurns_FasterUnitTakesContestedHex(t *testing.T) {
+	game := simultaneousGame(newTestGameBuilder().
+		grassTiles(3).
+		unit(2, 0, 1, testUnitTypeSoldier).
+		unit(-2, 0, 2, testUnitTypeTank).
+		currentPlayer(1).
+		build())
+	soldier, _ := game.RulesEngine.GetUnitData(testUnitTypeSoldier)
+	tank, _ := game.RulesEngine.GetUnitData(testUnitTypeTank)
+	if soldier.MovementPoints >= tank.MovementPoints {
+		t.Fatal("test needs tanks to be faster than soldiers")
+	}
+	turn := game.TurnCounter
+
+	// Player 1 plans first but its soldier is slower
+	first := submitPlan(t, game, 1, plannedMove(2, 0, 0, 0))
+	if game.World.UnitAt(AxialCoord{Q: 2, R: 0}) == nil {
+		t.Fatal("a plan resolved before every player submitted one")
+	}
+	if got := first.GetChanges(); len(got) != 1 || got[0].GetPlanSubmitted().GetResolved() {
+		t.Errorf("first plan changes = %v, want one unresolved plan submitted change", got)
+	}
+	if len(first.GetSubmitPlan().GetMoves()) != 0 {
+		t.Error("the submitted move kept the plan for other players to see")
+	}
+
+	last := submitPlan(t, game, 2, plannedMove(-2, 0, 0, 0))
+	if unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0}); unit == nil || unit.Player != 2 {
+		t.Fatalf("contested hex holds %v, want player 2's tank", unit)
+	}
+	if unit := game.World.UnitAt(AxialCoord{Q: 1, R: 0}); unit == nil || unit.Player != 1 {
+		t.Errorf("player 1's soldier did not stop short at 1,0: %v", unit)
+	}
+	if got := gameEvents(last.Changes, GameEventPlannedMoveStoppedShort); len(got) != 1 {
+		t.Errorf("stopped short events = %v, want one", got)
+	}
+	if !last.Changes[0].GetPlanSubmitted().GetResolved() {
+		t.Error("the last plan was not marked as resolving the turn")
+	}
+
+	if game.TurnCounter != turn+1 || game.CurrentPlayer != 1 {
+		t.Errorf("after resolving, turn = %d player = %d, want turn %d player 1", game.TurnCounter, game.CurrentPlayer, turn+1)
+	}
+	if len(game.GameState.TurnPlans) != 0 {
+		t.Errorf("%d plans left after resolving", len(game.GameState.TurnPlans))
+	}
+	if !game.IsPlanning() || len(game.WaitingForPlans()) != 2 {
+		t.Errorf("the next turn is not waiting for both plans: %v", game.WaitingForPlans())
+	}
+}
+
+// TestSimultaneousTurns_TiedInitiative tests that equally fast moves go to
+// the player who has resolved fewer moves, then to the lower player number
+func TestSimultaneousTurns_TiedInitiative(t *testing.T) {
+	game := simultaneousGame(newTestGameBuilder().
+		grassTiles(3).
+		unit(-2, 0, 1, testUnitTypeSoldier).
+		unit(-3, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build())
+
+	// Player 1's first move goes first on player number; player 2's only
+	// move then goes before player 1's second, having resolved fewer
+	submitPlan(t, game, 2, plannedMove(2, 0, 0, 0))
+	last := submitPlan(t, game, 1, plannedMove(-2, 0, -1, 0), plannedMove(-3, 0, -2, 1))
+
+	if unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0}); unit == nil || unit.Player != 2 {
+		t.Errorf("player 2's soldier did not reach 0,0: %v", unit)
+	}
+	var movers []int32
+	for _, change := range last.Changes {
+		if moved := change.GetUnitMoved(); moved != nil {
+			movers = append(movers, moved.UpdatedUnit.Player)
+		}
+	}
+	if want := []int32{1, 2, 1}; !slices.Equal(movers, want) {
+		t.Errorf("moves resolved for players %v, want %v", movers, want)
+	}
+}
+
+// TestSimultaneousTurns_AttackSkippedWhenTargetMoved tests that an attack
+// on a unit that moved away first is skipped rather than failing the turn
+func TestSimultaneousTurns_AttackSkippedWhenTargetMoved(t *testing.T) {
+	game := simultaneousGame(newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 2, testUnitTypeTank).
+		currentPlayer(1).
+		build())
+
+	submitPlan(t, game, 1, plannedAttack(0, 0, 1, 0))
+	last := submitPlan(t, game, 2, plannedMove(1, 0, 3, 0))
+
+	if game.World.UnitAt(AxialCoord{Q: 3, R: 0}) == nil {
+		t.Error("player 2's tank did not move away")
+	}
+	if got := gameEvents(last.Changes, GameEventPlannedMoveSkipped); len(got) != 1 {
+		t.Errorf("skipped events = %v, want one", got)
+	}
+	if unit := game.World.UnitAt(AxialCoord{Q: 3, R: 0}); unit != nil && unit.AvailableHealth != 10 {
+		t.Errorf("tank health = %d, want it untouched", unit.AvailableHealth)
+	}
+}
+
+// TestSimultaneousTurns_DestroyedUnitSkipsItsMoves tests that a unit
+// destroyed by a faster attack does not make its planned move
+func TestSimultaneousTurns_DestroyedUnitSkipsItsMoves(t *testing.T) {
+	game := simultaneousGame(newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeTank).
+		unitFull(1, 0, 2, testUnitTypeSoldier, "B1", 1, 3).
+		currentPlayer(1).
+		build())
+	game.SetRNG(NewScriptedRNG(0))
+
+	submitPlan(t, game, 2, plannedMove(1, 0, 3, 0))
+	last := submitPlan(t, game, 1, plannedAttack(0, 0, 1, 0))
+
+	if game.World.UnitAt(AxialCoord{Q: 1, R: 0}) != nil || game.World.UnitAt(AxialCoord{Q: 3, R: 0}) != nil {
+		t.Error("player 2's soldier survived the tank's attack")
+	}
+	if got := gameEvents(last.Changes, GameEventPlannedMoveSkipped); len(got) != 1 {
+		t.Errorf("skipped events = %v, want one", got)
+	}
+}
+
+// TestSimultaneousTurns_EveryPlayerCollectsIncome tests that resolving a
+// turn ends every player's turn, not only the last to plan
+func TestSimultaneousTurns_EveryPlayerCollectsIncome(t *testing.T) {
+	game := simultaneousGame(newTestGameBuilder().
+		grassTiles(3).
+		tile(-3, 0, TileTypeLandBase, 1).
+		tile(3, 0, TileTypeLandBase, 2).
+		unit(-2, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build())
+	before := map[int32]int32{1: game.PlayerStates[1].Coins, 2: game.PlayerStates[2].Coins}
+
+	submitPlan(t, game, 1)
+	submitPlan(t, game, 2)
+
+	for player, coins := range before {
+		if got := game.PlayerStates[player].Coins; got <= coins {
+			t.Errorf("player %d coins = %d, want more than %d", player, got, coins)
+		}
+	}
+}
+
+// TestSimultaneousTurns_RejectsInvalidSubmissions tests that a planning game
+// only takes one valid plan per player
+func TestSimultaneousTurns_RejectsInvalidSubmissions(t *testing.T) {
+	game := simultaneousGame(newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(3, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build())
+
+	if err := game.ProcessMove(plannedMove(0, 0, 1, 0)); err == nil {
+		t.Error("a move outside a plan was accepted")
+	}
+	reject := func(name string, player int32, moves ...*v1.GameMove) {
+		t.Helper()
+		move := &v1.GameMove{Player: player, MoveType: &v1.GameMove_SubmitPlan{SubmitPlan: &v1.SubmitPlanAction{Moves: moves}}}
+		if err := game.ProcessMove(move); err == nil {
+			t.Errorf("%s was accepted", name)
+		}
+	}
+	reject("moving another player's unit", 1, plannedMove(3, 0, 2, 0))
+	reject("moving out of reach", 1, plannedMove(0, 0, 0, 3), plannedMove(0, 0, -3, 0))
+	reject("ending the turn in a plan", 1, &v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}})
+	if game.HasSubmittedPlan(1) {
+		t.Fatal("a rejected plan was kept")
+	}
+
+	submitPlan(t, game, 1, plannedMove(0, 0, 1, 0))
+	reject("a second plan", 1)
+	if unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0}); unit == nil {
+		t.Error("checking the plan moved the unit")
+	}
+}
+
+// TestValidateSimultaneousTurns tests the settings simultaneous turns
+// cannot be combined with
+func TestValidateSimultaneousTurns(t *testing.T) {
+	config := &v1.GameConfiguration{
+		Players:  []*v1.GamePlayer{{PlayerId: 1, PlayerType: "human"}, {PlayerId: 2, PlayerType: "human"}},
+		Settings: &v1.GameSettings{SimultaneousTurns: true},
+	}
+	if err := ValidateSimultaneousTurns(config); err != nil {
+		t.Errorf("plain game rejected: %v", err)
+	}
+	config.Players[1].PlayerType = "ai"
+	if err := ValidateSimultaneousTurns(config); err == nil {
+		t.Error("AI player accepted")
+	}
+}
@@ -1,56 +1,93 @@
 package lib
 
 import (
+	"cmp"
+	"slices"
+
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 )
 
-// GameOptionLess compares two GameOptions for sorting
-// Order: moves < attacks < end turn
-// Within each type, uses type-specific sorting
-func GameOptionLess(a, b *v1.GameOption) bool {
-	// Get option type priorities
-	aPriority := getOptionTypePriority(a)
-	bPriority := getOptionTypePriority(b)
+// SortGameOptions puts options into their canonical order. GetOptionsAt
+// always returns options in this order so that scripts can index into them
+// and option lists don't jump around between calls:
+//
+//   - by type: move, attack, capture, build, heal, construct, submerge, end turn
+//   - then by target coordinate, r first and then q
+//   - builds by unit cost, then unit type
+func SortGameOptions(options []*v1.GameOption) {
+	slices.SortStableFunc(options, CompareGameOptions)
+}
 
-	if aPriority != bPriority {
-		return aPriority < bPriority
+// CompareGameOptions compares two GameOptions in canonical order
+func CompareGameOptions(a, b *v1.GameOption) int {
+	if c := cmp.Compare(getOptionTypePriority(a), getOptionTypePriority(b)); c != 0 {
+		return c
 	}
 
-	// Same type, use type-specific comparison
-	switch aOpt := a.OptionType.(type) {
-	case *v1.GameOption_Move:
-		if bOpt, ok := b.OptionType.(*v1.GameOption_Move); ok {
-			return MoveUnitActionLess(aOpt.Move, bOpt.Move)
-		}
-	case *v1.GameOption_Attack:
-		if bOpt, ok := b.OptionType.(*v1.GameOption_Attack); ok {
-			return AttackUnitActionLess(aOpt.Attack, bOpt.Attack)
-		}
+	if aBuild, bBuild := a.GetBuild(), b.GetBuild(); aBuild != nil && bBuild != nil {
+		return cmp.Or(
+			cmp.Compare(aBuild.Cost, bBuild.Cost),
+			cmp.Compare(aBuild.UnitType, bBuild.UnitType),
+		)
 	}
 
-	return false
+	aTarget, bTarget := optionTarget(a), optionTarget(b)
+	if c := cmp.Or(
+		cmp.Compare(aTarget.GetR(), bTarget.GetR()),
+		cmp.Compare(aTarget.GetQ(), bTarget.GetQ()),
+	); c != 0 {
+		return c
+	}
+
+	// Several constructions can target the same tile
+	return cmp.Compare(a.GetConstruct().GetTargetTerrain(), b.GetConstruct().GetTargetTerrain())
 }
 
 // getOptionTypePriority returns sort priority for option types
 func getOptionTypePriority(opt *v1.GameOption) int {
 	switch opt.OptionType.(type) {
-	case *v1.GameOption_Capture:
+	case *v1.GameOption_Move:
 		return 0
-	case *v1.GameOption_Heal:
+	case *v1.GameOption_Attack:
 		return 1
-	case *v1.GameOption_Move:
+	case *v1.GameOption_Capture:
 		return 2
-	case *v1.GameOption_Attack:
-		return 3
 	case *v1.GameOption_Build:
+		return 3
+	case *v1.GameOption_Heal:
 		return 4
-	case *v1.GameOption_EndTurn:
+	case *v1.GameOption_Construct:
 		return 5
+	case *v1.GameOption_Submerge:
+		return 6
+	case *v1.GameOption_EndTurn:
+		return 7
 	default:
 		return 99
 	}
 }
 
+// optionTarget returns the position an option acts on
+func optionTarget(opt *v1.GameOption) *v1.Position {
+	switch o := opt.OptionType.(type) {
+	case *v1.GameOption_Move:
+		return o.Move.To
+	case *v1.GameOption_Attack:
+		return o.Attack.Defender
+	case *v1.GameOption_Capture:
+		return o.Capture.Pos
+	case *v1.GameOption_Build:
+		return o.Build.Pos
+	case *v1.GameOption_Heal:
+		return o.Heal.Pos
+	case *v1.GameOption_Construct:
+		return o.Construct.Target
+	case *v1.GameOption_Submerge:
+		return o.Submerge.Pos
+	}
+	return nil
+}
+
 // MoveUnitActionLess compares two MoveUnitActions
 // First by movement cost, then by direction
 func MoveUnitActionLess(a, b *v1.MoveUnitAction) bool {
@@ -0,0 +1,147 @@
+package lib
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// unitDiffFields are the unit fields a changed unit reports deltas for.
+// Bookkeeping such as the turn a unit was last topped up is left out.
+var unitDiffFields = []struct {
+	name  string
+	value func(*v1.Unit) string
+}{
+	{"position", func(u *v1.Unit) string { return CoordKey(u.Q, u.R) }},
+	{"player", func(u *v1.Unit) string { return fmt.Sprint(u.Player) }},
+	{"unit_type", func(u *v1.Unit) string { return fmt.Sprint(u.UnitType) }},
+	{"available_health", func(u *v1.Unit) string { return fmt.Sprint(u.AvailableHealth) }},
+	{"distance_left", func(u *v1.Unit) string { return fmt.Sprint(u.DistanceLeft) }},
+	{"capture_started_turn", func(u *v1.Unit) string { return fmt.Sprint(u.CaptureStartedTurn) }},
+	{"submerged", func(u *v1.Unit) string { return fmt.Sprint(u.Submerged) }},
+}
+
+// unitDiffKey identifies a unit across states by its shortcut, falling back
+// to its position for units without one
+func unitDiffKey(unit *v1.Unit) string {
+	if unit.Shortcut != "" {
+		return unit.Shortcut
+	}
+	return CoordKey(unit.Q, unit.R)
+}
+
+// DiffStates returns everything that changed from state a to state b: units
+// added, removed or changed, tiles that changed owner and players whose
+// coins or status changed
+func DiffStates(a, b *v1.GameState) *v1.StateDiff {
+	diff := &v1.StateDiff{FromTurn: a.TurnCounter, ToTurn: b.TurnCounter}
+
+	before := map[string]*v1.Unit{}
+	for _, unit := range a.GetWorldData().GetUnitsMap() {
+		before[unitDiffKey(unit)] = unit
+	}
+	after := map[string]*v1.Unit{}
+	for _, unit := range b.GetWorldData().GetUnitsMap() {
+		after[unitDiffKey(unit)] = unit
+	}
+	keys := slices.Collect(maps.Keys(before))
+	for key := range after {
+		if before[key] == nil {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		prev, next := before[key], after[key]
+		switch {
+		case prev == nil:
+			diff.Units = append(diff.Units, &v1.UnitDiff{Kind: v1.UnitDiffKind_UNIT_DIFF_KIND_ADDED, After: next})
+		case next == nil:
+			diff.Units = append(diff.Units, &v1.UnitDiff{Kind: v1.UnitDiffKind_UNIT_DIFF_KIND_REMOVED, Before: prev})
+		default:
+			if deltas := unitDeltas(prev, next); len(deltas) > 0 {
+				diff.Units = append(diff.Units, &v1.UnitDiff{
+					Kind:   v1.UnitDiffKind_UNIT_DIFF_KIND_CHANGED,
+					Before: prev,
+					After:  next,
+					Deltas: deltas,
+				})
+			}
+		}
+	}
+
+	prevTiles := a.GetWorldData().GetTilesMap()
+	tiles := b.GetWorldData().GetTilesMap()
+	for _, key := range slices.Sorted(maps.Keys(tiles)) {
+		tile := tiles[key]
+		prevOwner := prevTiles[key].GetPlayer()
+		if prevOwner != tile.Player {
+			diff.Tiles = append(diff.Tiles, &v1.TileOwnerDiff{
+				Q:             tile.Q,
+				R:             tile.R,
+				TileType:      tile.TileType,
+				PreviousOwner: prevOwner,
+				NewOwner:      tile.Player,
+			})
+		}
+	}
+
+	players := slices.Collect(maps.Keys(a.PlayerStates))
+	for id := range b.PlayerStates {
+		if _, ok := a.PlayerStates[id]; !ok {
+			players = append(players, id)
+		}
+	}
+	slices.Sort(players)
+	for _, id := range players {
+		prev, next := a.PlayerStates[id], b.PlayerStates[id]
+		if prev.GetCoins() != next.GetCoins() || prev.GetIsActive() != next.GetIsActive() {
+			diff.Players = append(diff.Players, &v1.PlayerDiff{
+				PlayerId:      id,
+				PreviousCoins: prev.GetCoins(),
+				NewCoins:      next.GetCoins(),
+				WasActive:     prev.GetIsActive(),
+				IsActive:      next.GetIsActive(),
+			})
+		}
+	}
+	return diff
+}
+
+// DiffSpan is DiffStates for two states the given changes lead from one to
+// the other. Units built and killed within the span are in neither state, so
+// they are picked out of the changes and reported as transient.
+func DiffSpan(a, b *v1.GameState, changes []*v1.WorldChange) *v1.StateDiff {
+	diff := DiffStates(a, b)
+	built := map[string]*v1.Unit{}
+	for _, change := range changes {
+		switch c := change.ChangeType.(type) {
+		case *v1.WorldChange_UnitBuilt:
+			built[unitDiffKey(c.UnitBuilt.Unit)] = c.UnitBuilt.Unit
+		case *v1.WorldChange_UnitKilled:
+			key := unitDiffKey(c.UnitKilled.PreviousUnit)
+			if unit, ok := built[key]; ok {
+				diff.Units = append(diff.Units, &v1.UnitDiff{
+					Kind:   v1.UnitDiffKind_UNIT_DIFF_KIND_TRANSIENT,
+					Before: unit,
+					After:  c.UnitKilled.PreviousUnit,
+				})
+				delete(built, key)
+			}
+		}
+	}
+	return diff
+}
+
+// unitDeltas returns the fields that differ between two states of a unit
+func unitDeltas(prev, next *v1.Unit) (deltas []*v1.FieldDelta) {
+	for _, field := range unitDiffFields {
+		before, after := field.value(prev), field.value(next)
+		if before != after {
+			deltas = append(deltas, &v1.FieldDelta{Field: field.name, Before: before, After: after})
+		}
+	}
+	return deltas
+}
@@ -0,0 +1,118 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+func newDiffTestState() *v1.GameState {
+	return &v1.GameState{
+		TurnCounter: 12,
+		WorldData: &v1.WorldData{
+			TilesMap: map[string]*v1.Tile{
+				"0,1": {Q: 0, R: 1, TileType: TileTypeLandBase},
+			},
+			UnitsMap: map[string]*v1.Unit{
+				"0,0": {Q: 0, R: 0, Player: 1, UnitType: testUnitTypeSoldier, Shortcut: "A1", AvailableHealth: 10},
+				"2,0": {Q: 2, R: 0, Player: 2, UnitType: testUnitTypeSoldier, Shortcut: "B1", AvailableHealth: 10},
+			},
+		},
+		PlayerStates: map[int32]*v1.PlayerState{
+			1: {Coins: 300, IsActive: true},
+			2: {Coins: 300, IsActive: true},
+		},
+	}
+}
+
+// TestDiffStates tests units, tiles and players are diffed between states
+func TestDiffStates(t *testing.T) {
+	from := newDiffTestState()
+	to := proto.Clone(from).(*v1.GameState)
+	to.TurnCounter = 15
+	units := to.WorldData.UnitsMap
+	moved := units["0,0"]
+	delete(units, "0,0")
+	moved.Q, moved.R = 0, 1
+	moved.AvailableHealth = 7
+	units["0,1"] = moved
+	delete(units, "2,0")
+	units["1,1"] = &v1.Unit{Q: 1, R: 1, Player: 1, UnitType: testUnitTypeSoldier, Shortcut: "A2"}
+	to.WorldData.TilesMap["0,1"].Player = 1
+	to.PlayerStates[1].Coins = 450
+	to.PlayerStates[2].IsActive = false
+
+	diff := DiffStates(from, to)
+	if diff.FromTurn != 12 || diff.ToTurn != 15 {
+		t.Errorf("turns = %d..%d, want 12..15", diff.FromTurn, diff.ToTurn)
+	}
+
+	want := []struct {
+		shortcut string
+		kind     v1.UnitDiffKind
+	}{
+		{"A1", v1.UnitDiffKind_UNIT_DIFF_KIND_CHANGED},
+		{"A2", v1.UnitDiffKind_UNIT_DIFF_KIND_ADDED},
+		{"B1", v1.UnitDiffKind_UNIT_DIFF_KIND_REMOVED},
+	}
+	if len(diff.Units) != len(want) {
+		t.Fatalf("got %d unit diffs, want %d: %v", len(diff.Units), len(want), diff.Units)
+	}
+	for i, w := range want {
+		got := diff.Units[i]
+		unit := got.After
+		if unit == nil {
+			unit = got.Before
+		}
+		if unit.Shortcut != w.shortcut || got.Kind != w.kind {
+			t.Errorf("unit diff %d = %s %v, want %s %v", i, unit.Shortcut, got.Kind, w.shortcut, w.kind)
+		}
+	}
+	deltas := map[string]string{}
+	for _, delta := range diff.Units[0].Deltas {
+		deltas[delta.Field] = delta.Before + " -> " + delta.After
+	}
+	if len(deltas) != 2 || deltas["position"] != "0,0 -> 0,1" || deltas["available_health"] != "10 -> 7" {
+		t.Errorf("A1 deltas = %v, want position and available_health", deltas)
+	}
+
+	if len(diff.Tiles) != 1 || diff.Tiles[0].PreviousOwner != 0 || diff.Tiles[0].NewOwner != 1 {
+		t.Errorf("tile diffs = %v, want 0,1 captured by player 1", diff.Tiles)
+	}
+	if len(diff.Players) != 2 {
+		t.Fatalf("got %d player diffs, want 2", len(diff.Players))
+	}
+	if p := diff.Players[0]; p.PlayerId != 1 || p.PreviousCoins != 300 || p.NewCoins != 450 {
+		t.Errorf("player 1 diff = %v, want coins 300 -> 450", p)
+	}
+	if p := diff.Players[1]; p.PlayerId != 2 || !p.WasActive || p.IsActive {
+		t.Errorf("player 2 diff = %v, want eliminated", p)
+	}
+}
+
+// TestDiffSpan_TransientUnit tests a unit built and killed within the span
+// shows up even though neither state has it
+func TestDiffSpan_TransientUnit(t *testing.T) {
+	from := newDiffTestState()
+	to := proto.Clone(from).(*v1.GameState)
+
+	built := &v1.Unit{Q: 0, R: 1, Player: 1, UnitType: testUnitTypeSoldier, Shortcut: "A2", AvailableHealth: 10}
+	killed := &v1.Unit{Q: 1, R: 1, Player: 1, UnitType: testUnitTypeSoldier, Shortcut: "A2", AvailableHealth: 3}
+	changes := []*v1.WorldChange{
+		{ChangeType: &v1.WorldChange_UnitBuilt{UnitBuilt: &v1.UnitBuiltChange{Unit: built, TileQ: 0, TileR: 1}}},
+		{ChangeType: &v1.WorldChange_UnitKilled{UnitKilled: &v1.UnitKilledChange{PreviousUnit: killed}}},
+	}
+
+	if diff := DiffStates(from, to); len(diff.Units) != 0 {
+		t.Fatalf("DiffStates found %d unit diffs, want none", len(diff.Units))
+	}
+	diff := DiffSpan(from, to, changes)
+	if len(diff.Units) != 1 {
+		t.Fatalf("got %d unit diffs, want the transient unit", len(diff.Units))
+	}
+	got := diff.Units[0]
+	if got.Kind != v1.UnitDiffKind_UNIT_DIFF_KIND_TRANSIENT || got.Before != built || got.After != killed {
+		t.Errorf("unit diff = %v, want A2 as built and as killed", got)
+	}
+}
@@ -0,0 +1,125 @@
+package lib
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Stealth
+// =============================================================================
+//
+// When enabled in the game settings, Stealth-class units can submerge. A
+// submerged unit is hidden from every enemy that does not have a unit
+// adjacent to it, and cannot attack (or counter-attack) until it surfaces.
+
+// StealthEnabled reports whether the game allows Stealth-class units to submerge
+func (g *Game) StealthEnabled() bool {
+	return g.Config.GetSettings().GetStealthEnabled()
+}
+
+// CanSubmerge reports whether units of this type can submerge
+func CanSubmerge(unitDef *v1.UnitDefinition) bool {
+	return unitDef.GetUnitClass() == "Stealth"
+}
+
+// IsUnitVisibleToPlayer reports whether a player can see the unit. Submerged
+// units are only visible to their owner and to players with an adjacent unit,
+// and with fog of war enemy units must also be within the player's sight.
+func (g *Game) IsUnitVisibleToPlayer(unit *v1.Unit, player int32) bool {
+	return g.isUnitVisible(unit, player, g.fogVisibleHexes(player))
+}
+
+// isUnitVisible is IsUnitVisibleToPlayer with the player's fog of war
+// visible hexes (nil when fog is off) already worked out
+func (g *Game) isUnitVisible(unit *v1.Unit, player int32, visible map[AxialCoord]bool) bool {
+	if unit.Player == player {
+		return true
+	}
+	if visible != nil && !visible[UnitGetCoord(unit)] {
+		return false
+	}
+	if !unit.Submerged {
+		return true
+	}
+	for _, neighbor := range g.World.GetAdjacentUnits(UnitGetCoord(unit)) {
+		if neighbor.Player == player {
+			return true
+		}
+	}
+	return false
+}
+
+// GetVisibleUnitsForPlayer returns all units the player can see
+func (g *Game) GetVisibleUnitsForPlayer(player int32) (units []*v1.Unit) {
+	visible := g.fogVisibleHexes(player)
+	for _, unit := range g.World.UnitsByCoord() {
+		if g.isUnitVisible(unit, player, visible) {
+			units = append(units, unit)
+		}
+	}
+	return
+}
+
+// GetSubmergeOptions returns the submerge (or surface) option for a unit
+func (g *Game) GetSubmergeOptions(unit *v1.Unit, unitDef *v1.UnitDefinition) []*v1.GameOption {
+	if !g.StealthEnabled() || !CanSubmerge(unitDef) || unit.AvailableHealth <= 0 {
+		return nil
+	}
+	return []*v1.GameOption{{
+		OptionType: &v1.GameOption_Submerge{
+			Submerge: &v1.SubmergeUnitAction{
+				Pos:      &v1.Position{Label: unit.Shortcut, Q: unit.Q, R: unit.R},
+				Submerge: !unit.Submerged,
+			},
+		},
+	}}
+}
+
+// ProcessSubmergeUnit submerges or surfaces a Stealth-class unit. This does
+// not use up the unit's move or attack.
+func (g *Game) ProcessSubmergeUnit(move *v1.GameMove, action *v1.SubmergeUnitAction) (err error) {
+	if !g.StealthEnabled() {
+		return fmt.Errorf("stealth is not enabled for this game")
+	}
+
+	coord, err := g.FromPos(action.Pos)
+	if err != nil {
+		return fmt.Errorf("invalid position: %w", err)
+	}
+	unit := g.World.UnitAt(coord)
+	if unit == nil {
+		return fmt.Errorf("no unit at position %v", coord)
+	}
+	if unit.Player != g.CurrentPlayer {
+		return fmt.Errorf("unit belongs to player %d, not current player %d", unit.Player, g.CurrentPlayer)
+	}
+
+	unitDef, err := g.RulesEngine.GetUnitData(unit.UnitType)
+	if err != nil {
+		return fmt.Errorf("failed to get unit data: %w", err)
+	}
+	if !CanSubmerge(unitDef) {
+		return fmt.Errorf("%s cannot submerge", unitDef.Name)
+	}
+	if unit.Submerged == action.Submerge {
+		if unit.Submerged {
+			return fmt.Errorf("unit is already submerged")
+		}
+		return fmt.Errorf("unit is not submerged")
+	}
+
+	previousUnit := copyUnit(unit)
+	unit.Submerged = action.Submerge
+
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitSubmerged{
+			UnitSubmerged: &v1.UnitSubmergedChange{
+				PreviousUnit: previousUnit,
+				UpdatedUnit:  copyUnit(unit),
+			},
+		},
+	})
+	return nil
+}
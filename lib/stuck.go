@@ -0,0 +1,241 @@
+package lib
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Stuck Game Detection
+// =============================================================================
+//
+// A game is stuck when the forces left can never meet again, e.g. every unit
+// is stranded on its own island, and nobody can capture or build their way
+// out. Reachability here is over many turns: a unit reaches every tile its
+// movement class can ever enter from where it stands, ignoring movement
+// points and other units.
+
+// CanUnitEnterTerrain reports whether the rules let a unit type move onto a
+// terrain at all
+func (re *RulesEngine) CanUnitEnterTerrain(unitID, terrainID int32) bool {
+	if props := re.GetTerrainUnitPropertiesForUnit(terrainID, unitID); props != nil {
+		return props.MovementCost > 0
+	}
+	if unit, err := re.GetUnitData(unitID); err == nil {
+		if props, ok := unit.TerrainProperties[terrainID]; ok {
+			return props.MovementCost > 0
+		}
+	}
+	return false
+}
+
+// StuckAnalysis checks whether the game is stuck for the current player:
+// none of their units can reach an enemy or a building to capture, nothing
+// they can build could either, and no unit of any player can reach an
+// opposing unit.
+func (g *Game) StuckAnalysis() *v1.StuckAnalysis {
+	return g.StuckAnalysisFor(g.CurrentPlayer)
+}
+
+// StuckAnalysisFor runs StuckAnalysis from the point of view of a player
+func (g *Game) StuckAnalysisFor(player int32) *v1.StuckAnalysis {
+	r := newReachAnalyzer(g)
+	analysis := &v1.StuckAnalysis{Player: player, ForcesUnreachable: true}
+
+	units := g.activeUnits()
+	contact := map[*v1.Unit]bool{}
+	for _, unit := range units {
+		if r.canContactAny(unit, units) {
+			contact[unit] = true
+			analysis.ForcesUnreachable = false
+		}
+	}
+
+	capturable := map[AxialCoord]bool{}
+	for _, unit := range units {
+		if unit.Player != player {
+			continue
+		}
+		captures := r.captureTargets(player, unit.UnitType, r.reach(unit.UnitType, UnitGetCoord(unit)))
+		if contact[unit] || len(captures) > 0 {
+			analysis.ActionableUnits++
+		}
+		for coord := range captures {
+			capturable[coord] = true
+		}
+	}
+	analysis.IncomeGrowthPaths = int32(len(capturable))
+	analysis.AffordableBuilds = int32(len(r.usefulBuilds(player, units)))
+
+	analysis.Stuck = analysis.ForcesUnreachable && analysis.ActionableUnits == 0 &&
+		analysis.AffordableBuilds == 0 && analysis.IncomeGrowthPaths == 0
+	if analysis.Stuck {
+		analysis.Reason = fmt.Sprintf("no unit can reach an opposing unit, and player %d cannot capture or build anything that could", player)
+	}
+	return analysis
+}
+
+// NoContactDraw checks whether the game is stuck for every player still in
+// it. Returns the analysis of the current player, or of the first player
+// who can still make progress.
+func (g *Game) NoContactDraw() (*v1.StuckAnalysis, bool) {
+	current := g.StuckAnalysis()
+	if !current.Stuck {
+		return current, false
+	}
+	for _, player := range g.Config.GetPlayers() {
+		if player.PlayerId == g.CurrentPlayer || g.playerForfeited(player.PlayerId) {
+			continue
+		}
+		if analysis := g.StuckAnalysisFor(player.PlayerId); !analysis.Stuck {
+			return analysis, false
+		}
+	}
+	return current, true
+}
+
+// areOpponents reports whether two players are on opposing sides
+func (g *Game) areOpponents(player, otherPlayer int32) bool {
+	return player != otherPlayer && !(g.IsTeamGame() && g.AreTeammates(player, otherPlayer))
+}
+
+// activeUnits returns the units of players still in the game
+func (g *Game) activeUnits() (units []*v1.Unit) {
+	for _, unit := range g.World.UnitsByCoord() {
+		if unit.Player > 0 && !g.playerForfeited(unit.Player) {
+			units = append(units, unit)
+		}
+	}
+	return
+}
+
+// playerIncome is what a player earns at the end of each turn
+func (g *Game) playerIncome(player int32) int32 {
+	return CalculatePlayerBaseIncome(player, g.World.WorldData(), EffectiveIncomeConfig(g.Config))
+}
+
+// reachAnalyzer floods and caches the regions each unit type can reach
+type reachAnalyzer struct {
+	g       *Game
+	regions map[int32][]map[AxialCoord]bool
+}
+
+func newReachAnalyzer(g *Game) *reachAnalyzer {
+	return &reachAnalyzer{g: g, regions: map[int32][]map[AxialCoord]bool{}}
+}
+
+// reach returns every tile a unit type starting at from can ever get to
+func (r *reachAnalyzer) reach(unitType int32, from AxialCoord) map[AxialCoord]bool {
+	for _, region := range r.regions[unitType] {
+		if region[from] {
+			return region
+		}
+	}
+
+	region := map[AxialCoord]bool{from: true}
+	queue := []AxialCoord{from}
+	for len(queue) > 0 {
+		coord := queue[0]
+		queue = queue[1:]
+		for neighbor := range r.g.World.Neighbors(coord) {
+			if region[neighbor] {
+				continue
+			}
+			terrain := r.g.RulesEngine.GetEffectiveTileType(r.g.World, neighbor)
+			if r.g.RulesEngine.CanUnitEnterTerrain(unitType, terrain) {
+				region[neighbor] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	r.regions[unitType] = append(r.regions[unitType], region)
+	return region
+}
+
+// canContact reports whether a unit of attackerType roaming attackerReach
+// could ever attack the defender roaming its own reach
+func (r *reachAnalyzer) canContact(attackerType int32, attackerReach map[AxialCoord]bool, defender *v1.Unit) bool {
+	if _, canAttack := r.g.RulesEngine.GetCombatPrediction(attackerType, defender.UnitType); !canAttack {
+		return false
+	}
+	unitData, err := r.g.RulesEngine.GetUnitData(attackerType)
+	if err != nil {
+		return false
+	}
+	defenderReach := r.reach(defender.UnitType, UnitGetCoord(defender))
+	for from := range attackerReach {
+		for _, target := range from.Range(int(unitData.AttackRange)) {
+			if target != from && defenderReach[target] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// canContactAny reports whether the unit could ever attack, or be attacked
+// by, an opposing unit
+func (r *reachAnalyzer) canContactAny(unit *v1.Unit, units []*v1.Unit) bool {
+	unitReach := r.reach(unit.UnitType, UnitGetCoord(unit))
+	for _, other := range units {
+		if !r.g.areOpponents(unit.Player, other.Player) {
+			continue
+		}
+		if r.canContact(unit.UnitType, unitReach, other) ||
+			r.canContact(other.UnitType, r.reach(other.UnitType, UnitGetCoord(other)), unit) {
+			return true
+		}
+	}
+	return false
+}
+
+// captureTargets returns the buildings in reach that a unit type could
+// capture for player
+func (r *reachAnalyzer) captureTargets(player, unitType int32, reach map[AxialCoord]bool) map[AxialCoord]bool {
+	targets := map[AxialCoord]bool{}
+	for coord := range reach {
+		tile := r.g.World.TileAt(coord)
+		if tile == nil || tile.Player == player || r.g.AreTeammates(player, tile.Player) {
+			continue
+		}
+		if props := r.g.RulesEngine.GetTerrainUnitPropertiesForUnit(tile.TileType, unitType); props != nil && props.CanCapture {
+			targets[coord] = true
+		}
+	}
+	return targets
+}
+
+// usefulBuilds returns the unit types player can build, now or once their
+// income pays for them, that could reach an opposing unit or a building to
+// capture from where they are built
+func (r *reachAnalyzer) usefulBuilds(player int32, units []*v1.Unit) map[int32]bool {
+	coins := int32(0)
+	if playerState := r.g.GameState.PlayerStates[player]; playerState != nil {
+		coins = playerState.Coins
+	}
+	earns := r.g.playerIncome(player) > 0
+
+	builds := map[int32]bool{}
+	for coord, tile := range r.g.World.TilesByCoord() {
+		if tile.Player != player {
+			continue
+		}
+		terrainDef, err := r.g.RulesEngine.GetTerrainData(tile.TileType)
+		if err != nil {
+			continue
+		}
+		allowed := FilterBuildOptionsByAllowedUnits(terrainDef.BuildableUnitIds, r.g.Config.GetSettings().GetAllowedUnits())
+		for _, unitType := range FilterBuildOptionsByDraft(allowed, r.g.GameState.Draft, player) {
+			unitDef, err := r.g.RulesEngine.GetUnitData(unitType)
+			if err != nil || builds[unitType] || (unitDef.Coins > coins && !earns) {
+				continue
+			}
+			built := &v1.Unit{Q: int32(coord.Q), R: int32(coord.R), Player: player, UnitType: unitType}
+			if r.canContactAny(built, units) || len(r.captureTargets(player, unitType, r.reach(unitType, coord))) > 0 {
+				builds[unitType] = true
+			}
+		}
+	}
+	return builds
+}
@@ -0,0 +1,41 @@
+package lib
+
+// DominantTerrainPercent is the share of a map above which a single terrain
+// is considered to dominate it
+const DominantTerrainPercent = 60.0
+
+// GetTerrainCounts returns how many tiles of each terrain type the world has
+func (w *World) GetTerrainCounts() map[int]int {
+	counts := map[int]int{}
+	for _, tile := range w.TilesByCoord() {
+		counts[int(tile.TileType)]++
+	}
+	return counts
+}
+
+// GetTerrainBalance returns each terrain type's share of the world's tiles,
+// as a percentage from 0 to 100
+func (w *World) GetTerrainBalance() map[int]float64 {
+	counts := w.GetTerrainCounts()
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+
+	balance := make(map[int]float64, len(counts))
+	for terrain, count := range counts {
+		balance[terrain] = float64(count) * 100 / float64(total)
+	}
+	return balance
+}
+
+// GetDominantTerrain returns the terrain covering more than
+// DominantTerrainPercent of the world, if there is one
+func (w *World) GetDominantTerrain() (terrain int, percent float64, dominant bool) {
+	for t, p := range w.GetTerrainBalance() {
+		if p > DominantTerrainPercent {
+			return t, p, true
+		}
+	}
+	return 0, 0, false
+}
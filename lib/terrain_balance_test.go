@@ -0,0 +1,47 @@
+package lib
+
+import (
+	"math"
+	"testing"
+)
+
+// TestGetTerrainBalance_MostlyWater tests the balance of a map that is 80% water
+func TestGetTerrainBalance_MostlyWater(t *testing.T) {
+	world := NewWorld("balance", nil)
+	for q := range 10 {
+		for r := range 5 {
+			terrain := TileTypeWaterRegular
+			if q < 2 {
+				terrain = TileTypeGrass
+			}
+			world.SetTileType(AxialCoord{Q: q, R: r}, terrain)
+		}
+	}
+
+	balance := world.GetTerrainBalance()
+	if len(balance) != 2 {
+		t.Fatalf("balance has %d terrains, want 2: %v", len(balance), balance)
+	}
+	if got := balance[TileTypeWaterRegular]; math.Abs(got-80) > 1e-9 {
+		t.Errorf("water = %v%%, want 80%%", got)
+	}
+	if got := balance[TileTypeGrass]; math.Abs(got-20) > 1e-9 {
+		t.Errorf("grass = %v%%, want 20%%", got)
+	}
+
+	terrain, percent, dominant := world.GetDominantTerrain()
+	if !dominant || terrain != TileTypeWaterRegular || math.Abs(percent-80) > 1e-9 {
+		t.Errorf("dominant terrain = %d at %v%% (%v), want water at 80%%", terrain, percent, dominant)
+	}
+}
+
+// TestGetTerrainBalance_Empty tests an empty map has no terrain and none dominates
+func TestGetTerrainBalance_Empty(t *testing.T) {
+	world := NewWorld("empty", nil)
+	if balance := world.GetTerrainBalance(); len(balance) != 0 {
+		t.Errorf("empty world balance = %v, want none", balance)
+	}
+	if _, _, dominant := world.GetDominantTerrain(); dominant {
+		t.Error("empty world reported a dominant terrain")
+	}
+}
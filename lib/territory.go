@@ -0,0 +1,68 @@
+package lib
+
+// =============================================================================
+// Territory
+// =============================================================================
+//
+// A player's territory is the tiles they own.  Counting influence, it also
+// takes in the unowned tiles their units dominate: tiles in sight of one of
+// their units that no other player's unit is as close to.  Used for map
+// control displays and territory based scoring.
+
+// GetTerritory returns the hexes in the player's territory, sorted.  With
+// withInfluence, unowned tiles dominated by the player's units are included.
+func (g *Game) GetTerritory(playerID int, withInfluence bool) []AxialCoord {
+	player := int32(playerID)
+	var territory []AxialCoord
+	for coord, tile := range g.World.TilesByCoord() {
+		if tile.Player == player {
+			territory = append(territory, coord)
+		}
+	}
+	if withInfluence {
+		territory = append(territory, g.dominatedTiles(player)...)
+	}
+	sortCoords(territory)
+	return territory
+}
+
+// dominatedTiles returns the unowned tiles in sight of the player's units
+// that are strictly closer to one of them than to any other player's unit
+func (g *Game) dominatedTiles(player int32) (dominated []AxialCoord) {
+	type unitAt struct {
+		coord  AxialCoord
+		player int32
+	}
+	var units []unitAt
+	inSight := map[AxialCoord]bool{}
+	for coord, unit := range g.World.UnitsByCoord() {
+		units = append(units, unitAt{coord, unit.Player})
+		if unit.Player != player {
+			continue
+		}
+		for _, c := range g.hexesInSight(coord, g.unitSightRange(unit, coord)) {
+			inSight[c] = true
+		}
+	}
+
+	for coord := range inSight {
+		if g.World.TileAt(coord).GetPlayer() != 0 {
+			continue
+		}
+		own, other := -1, -1
+		for _, unit := range units {
+			distance := coord.Distance(unit.coord)
+			if unit.player == player {
+				if own < 0 || distance < own {
+					own = distance
+				}
+			} else if other < 0 || distance < other {
+				other = distance
+			}
+		}
+		if other < 0 || own < other {
+			dominated = append(dominated, coord)
+		}
+	}
+	return dominated
+}
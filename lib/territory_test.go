@@ -0,0 +1,51 @@
+package lib
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestGetTerritory_OwnedBases tests a player's territory is the bases they
+// own, and that their units add the unowned tiles they dominate
+func TestGetTerritory_OwnedBases(t *testing.T) {
+	game := newTestGameBuilder().
+		tile(-3, 0, TileTypeLandBase, 1).
+		tile(-3, 1, TileTypeLandBase, 1).
+		tile(3, 0, TileTypeLandBase, 2).
+		grassTiles(3).
+		unit(-1, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		build()
+
+	owned := game.GetTerritory(1, false)
+	if want := []AxialCoord{{Q: -3, R: 0}, {Q: -3, R: 1}}; !slices.Equal(owned, want) {
+		t.Errorf("player 1 territory = %v, want %v", owned, want)
+	}
+	if got, want := game.GetTerritory(2, false), []AxialCoord{{Q: 3, R: 0}}; !slices.Equal(got, want) {
+		t.Errorf("player 2 territory = %v, want %v", got, want)
+	}
+	if got := game.GetTerritory(3, false); len(got) != 0 {
+		t.Errorf("player 3 territory = %v, want none", got)
+	}
+
+	withInfluence := game.GetTerritory(1, true)
+	for _, coord := range owned {
+		if !slices.Contains(withInfluence, coord) {
+			t.Errorf("territory with influence is missing owned base %v", coord)
+		}
+	}
+	// Hexes nearer the soldier than the enemy's are dominated, those nearer
+	// the enemy's soldier are not and the enemy's base stays theirs
+	for coord, want := range map[AxialCoord]bool{
+		{Q: -1, R: 0}: true,
+		{Q: -2, R: 0}: true,
+		{Q: 0, R: 0}:  true,
+		{Q: 1, R: 0}:  false,
+		{Q: 2, R: 0}:  false,
+		{Q: 3, R: 0}:  false,
+	} {
+		if got := slices.Contains(withInfluence, coord); got != want {
+			t.Errorf("%v in player 1 territory with influence = %v, want %v", coord, got, want)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package lib
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Text Map Import
+// =============================================================================
+//
+// Community maps are often shared as plain text in the style of Advance Wars
+// map files: one line per row of hexes and one character per hex, each
+// character standing for a terrain in a legend.  Lines are offset rows, so
+// the character at row R and column C is the hex the position "rR,C" names.
+// Spaces not in the legend leave their hex off the map, so maps need not be
+// rectangular.
+
+// TextMap is a world imported from a text map
+type TextMap struct {
+	WorldData *v1.WorldData
+
+	// Rows is the number of lines of the map and Cols the length of the
+	// longest one
+	Rows int
+	Cols int
+}
+
+// ParseTextMap reads a text map, turning each character into the tile of
+// the terrain the legend gives it.  Characters mapped to terrain 0 leave
+// their hex off the map, as do spaces unless the legend maps them.
+func ParseTextMap(r io.Reader, legend map[rune]int) (*TextMap, error) {
+	if len(legend) == 0 {
+		return nil, fmt.Errorf("the terrain legend is empty")
+	}
+	out := &TextMap{WorldData: &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}}
+
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimRight(scanner.Text(), "\r"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read text map: %w", err)
+	}
+	// Trailing blank lines are not rows of the map
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	for row, line := range lines {
+		col := 0
+		for _, ch := range line {
+			tileType, ok := legend[ch]
+			if !ok && ch != ' ' {
+				return nil, fmt.Errorf("row %d, column %d: %q is not in the legend", row, col, ch)
+			}
+			if tileType != 0 {
+				coord := RowColToHex(row, col, UseEvenRowOffsetCoords)
+				out.WorldData.TilesMap[CoordKeyFromAxial(coord)] = NewTile(coord, tileType)
+			}
+			col++
+		}
+		out.Cols = max(out.Cols, col)
+	}
+	out.Rows = len(lines)
+
+	if len(out.WorldData.TilesMap) == 0 {
+		return nil, fmt.Errorf("no characters of the text map mapped to terrain")
+	}
+	return out, nil
+}
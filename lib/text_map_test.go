@@ -0,0 +1,57 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseTextMap tests that each character of a text map becomes the
+// legend's terrain at its offset row and column
+func TestParseTextMap(t *testing.T) {
+	legend := map[rune]int{
+		'.': TileTypeGrass,
+		'^': TileTypeMountains,
+		'~': TileTypeWaterShallow,
+		'B': TileTypeLandBase,
+		'x': 0,
+	}
+	text := "" +
+		".^.B\r\n" +
+		"~~ .\n" +
+		"B.x\n" +
+		"\n"
+
+	textMap, err := ParseTextMap(strings.NewReader(text), legend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if textMap.Rows != 3 || textMap.Cols != 4 {
+		t.Errorf("map is %dx%d, want 3 rows of 4 columns", textMap.Rows, textMap.Cols)
+	}
+
+	want := map[[2]int]int32{
+		{0, 0}: TileTypeGrass, {0, 1}: TileTypeMountains, {0, 2}: TileTypeGrass, {0, 3}: TileTypeLandBase,
+		{1, 0}: TileTypeWaterShallow, {1, 1}: TileTypeWaterShallow, {1, 3}: TileTypeGrass,
+		{2, 0}: TileTypeLandBase, {2, 1}: TileTypeGrass,
+	}
+	tiles := textMap.WorldData.TilesMap
+	if len(tiles) != len(want) {
+		t.Errorf("imported %d tiles, want %d", len(tiles), len(want))
+	}
+	for rowCol, tileType := range want {
+		coord := RowColToHex(rowCol[0], rowCol[1], UseEvenRowOffsetCoords)
+		tile := tiles[CoordKeyFromAxial(coord)]
+		if tile == nil || tile.TileType != tileType {
+			t.Errorf("tile at row %d, column %d (%v) = %v, want terrain %d", rowCol[0], rowCol[1], coord, tile, tileType)
+		}
+	}
+
+	for _, bad := range []string{".?.\n", "xx\n", ""} {
+		if _, err := ParseTextMap(strings.NewReader(bad), legend); err == nil {
+			t.Errorf("text map %q was accepted", bad)
+		}
+	}
+	if _, err := ParseTextMap(strings.NewReader(text), nil); err == nil {
+		t.Error("an empty legend was accepted")
+	}
+}
@@ -0,0 +1,146 @@
+package lib
+
+import (
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// =============================================================================
+// Time banks
+// =============================================================================
+//
+// Each player's bank is stored in PlayerState.TimeBankMs. Only the current
+// player's clock runs: their bank is the stored value minus the time elapsed
+// since GameState.ClockStartedAt. Because elapsed time is always derived from
+// the persisted checkpoint (never from in-memory timers) a backend restart
+// neither loses nor double-charges time.
+
+// Now returns the current time from the game's clock
+func (g *Game) Now() time.Time {
+	if g.Clock == nil {
+		return time.Now()
+	}
+	return g.Clock.Now()
+}
+
+// GetTimeBankSettings returns the configured time bank settings, or nil if
+// the game is not played with time banks
+func GetTimeBankSettings(config *v1.GameConfiguration) *v1.TimeBankSettings {
+	settings := config.GetSettings().GetTimeBank()
+	if settings.GetInitialSeconds() <= 0 {
+		return nil
+	}
+	return settings
+}
+
+// TimeBankSettings returns the game's time bank settings (nil if disabled)
+func (g *Game) TimeBankSettings() *v1.TimeBankSettings {
+	if g.Game == nil {
+		return nil
+	}
+	return GetTimeBankSettings(g.Config)
+}
+
+// RemainingTimeBanks returns each player's remaining time bank in ms at now
+func RemainingTimeBanks(state *v1.GameState, now time.Time) map[int32]int64 {
+	banks := make(map[int32]int64, len(state.PlayerStates))
+	for playerId, playerState := range state.PlayerStates {
+		banks[playerId] = playerState.TimeBankMs
+	}
+	if bank, ok := banks[state.CurrentPlayer]; ok && state.ClockStartedAt != nil {
+		banks[state.CurrentPlayer] = max(0, bank-now.Sub(state.ClockStartedAt.AsTime()).Milliseconds())
+	}
+	return banks
+}
+
+// TimeoutMove returns the EndTurn move (or a passing draft turn while
+// drafting) to process on behalf of the current player once their time bank
+// has run out, or nil if they still have time.  The end turn is forced, so
+// obligations the player left pending are skipped and recorded rather than
+// holding the game up.
+func (g *Game) TimeoutMove() *v1.GameMove {
+	if g.TimeBankSettings() == nil || g.GameState.Finished || g.GameState.ClockStartedAt == nil {
+		return nil
+	}
+	if RemainingTimeBanks(g.GameState, g.Now())[g.CurrentPlayer] > 0 {
+		return nil
+	}
+	if g.IsDrafting() {
+		pick, _ := g.DraftRound()
+		return &v1.GameMove{
+			Player:      g.CurrentPlayer,
+			MoveType:    &v1.GameMove_DraftUnit{DraftUnit: &v1.DraftUnitAction{Pick: pick}},
+			Description: "time bank expired",
+		}
+	}
+	return &v1.GameMove{
+		Player:      g.CurrentPlayer,
+		MoveType:    &v1.GameMove_EndTurn{EndTurn: NewEndTurnAction(g.GameState, true)},
+		Description: "time bank expired",
+	}
+}
+
+// stopTurnClock charges the ending player for the time used this turn and
+// credits the increment. A player who ran out of time either forfeits or
+// keeps just the increment for their next turn. Returns whether they timed
+// out.
+func (g *Game) stopTurnClock(player int32) (timedOut bool) {
+	now := g.Now()
+	settings := g.TimeBankSettings()
+	playerState := g.GameState.PlayerStates[player]
+	if settings == nil || playerState == nil || g.GameState.ClockStartedAt == nil {
+		return false
+	}
+
+	startedAt := g.GameState.ClockStartedAt.AsTime()
+	used := now.Sub(startedAt).Milliseconds()
+	if used >= playerState.TimeBankMs {
+		playerState.TimeBankMs = int64(settings.IncrementSeconds) * 1000
+		if settings.OnTimeout == v1.TimeoutAction_TIMEOUT_ACTION_FORFEIT {
+			playerState.TimeBankMs = 0
+			playerState.IsActive = false
+		}
+		return true
+	}
+	playerState.TimeBankMs += int64(settings.IncrementSeconds)*1000 - used
+	return false
+}
+
+// startTurnClock starts the current player's clock now unless the clock is
+// paused or the game is over.  After a timeout the incoming player's clock
+// starts when the timeout is processed, not when the bank ran out, so one
+// late timeout cannot run out the next player's time as well.
+func (g *Game) startTurnClock() {
+	if g.TimeBankSettings() == nil {
+		return
+	}
+	if g.GameState.ClockPaused || g.GameState.Finished {
+		g.GameState.ClockStartedAt = nil
+		return
+	}
+	g.GameState.ClockStartedAt = tspb.New(g.Now())
+}
+
+// playerForfeited reports whether a player has been eliminated by running
+// out of time
+func (g *Game) playerForfeited(player int32) bool {
+	if g.TimeBankSettings() == nil {
+		return false
+	}
+	playerState := g.GameState.PlayerStates[player]
+	return playerState != nil && !playerState.IsActive
+}
+
+// timeBanksSnapshot copies the stored banks for recording in a PlayerChanged change
+func (g *Game) timeBanksSnapshot() map[int32]int64 {
+	if g.TimeBankSettings() == nil {
+		return nil
+	}
+	banks := make(map[int32]int64, len(g.GameState.PlayerStates))
+	for playerId, playerState := range g.GameState.PlayerStates {
+		banks[playerId] = playerState.TimeBankMs
+	}
+	return banks
+}
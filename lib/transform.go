@@ -0,0 +1,94 @@
+package lib
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Unit Transformation
+// =============================================================================
+//
+// A unit can transform into any unit type listed in its definition's
+// transforms_to, eg infantry upgrading to mechanized.  The unit keeps its
+// position, owner and shortcut, and its health is scaled to the new type's
+// maximum.  Transforming uses up the unit's turn.
+
+// CanTransform reports whether units of one type can transform into another
+func CanTransform(unitDef *v1.UnitDefinition, newType int32) bool {
+	return slices.Contains(unitDef.GetTransformsTo(), newType)
+}
+
+// scaledHealth is the health a unit keeps when its maximum health changes,
+// at least 1 so a transformation never destroys the unit
+func scaledHealth(health, oldMax, newMax int32) int32 {
+	if oldMax <= 0 {
+		return newMax
+	}
+	scaled := int32(math.Round(float64(health) * float64(newMax) / float64(oldMax)))
+	return max(1, min(scaled, newMax))
+}
+
+// ProcessTransformUnit turns a unit into another unit type its rules allow,
+// keeping its position and scaling its health to the new type's maximum
+func (g *Game) ProcessTransformUnit(move *v1.GameMove, action *v1.TransformUnitAction) (err error) {
+	coord, err := g.FromPos(action.Pos)
+	if err != nil {
+		return fmt.Errorf("invalid position: %w", err)
+	}
+
+	unit := g.World.UnitAt(coord)
+	if unit == nil {
+		return fmt.Errorf("no unit at position %v", coord)
+	}
+	if unit.Player != g.CurrentPlayer {
+		return fmt.Errorf("unit belongs to player %d, not current player %d", unit.Player, g.CurrentPlayer)
+	}
+
+	if err := g.TopUpUnitIfNeeded(unit); err != nil {
+		return fmt.Errorf("failed to top-up unit: %w", err)
+	}
+	if unit.LastActedTurn == g.TurnCounter {
+		return fmt.Errorf("unit %s has already acted this turn", unit.Shortcut)
+	}
+
+	oldDef, err := g.RulesEngine.GetUnitData(unit.UnitType)
+	if err != nil {
+		return fmt.Errorf("failed to get unit data: %w", err)
+	}
+	if !CanTransform(oldDef, action.NewUnitType) {
+		return fmt.Errorf("%s cannot transform into unit type %d", oldDef.Name, action.NewUnitType)
+	}
+	newDef, err := g.RulesEngine.GetUnitData(action.NewUnitType)
+	if err != nil {
+		return fmt.Errorf("failed to get unit data: %w", err)
+	}
+
+	previousUnit := copyUnit(unit)
+
+	unit.UnitType = newDef.Id
+	unit.AvailableHealth = scaledHealth(unit.AvailableHealth, oldDef.Health, newDef.Health)
+	if !CanSubmerge(newDef) {
+		unit.Submerged = false
+	}
+
+	// Transforming uses up the unit's turn
+	unit.LastActedTurn = g.TurnCounter
+	unit.DistanceLeft = 0
+	unit.ProgressionStep = int32(len(unitActionOrder(newDef)))
+	unit.ChosenAlternative = ""
+	unit.StepActions = 0
+
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitTransformed{
+			UnitTransformed: &v1.UnitTransformedChange{
+				PreviousUnit: previousUnit,
+				UpdatedUnit:  copyUnit(unit),
+			},
+		},
+	})
+	return nil
+}
@@ -0,0 +1,34 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// ErrTurnAlreadyEnded is returned for an EndTurn sent for a turn that has
+// already ended, eg by the time bank or an earlier copy of the same request
+var ErrTurnAlreadyEnded = errors.New("turn already ended")
+
+// NewEndTurnAction returns an EndTurnAction for the current turn in state
+func NewEndTurnAction(state *v1.GameState, force bool) *v1.EndTurnAction {
+	return &v1.EndTurnAction{
+		Force:       force,
+		TurnCounter: state.TurnCounter,
+		Player:      state.CurrentPlayer,
+	}
+}
+
+// CheckEndTurnEpoch returns ErrTurnAlreadyEnded if action was sent for a turn
+// other than the current one in state.  Actions without a turn pass.
+func CheckEndTurnEpoch(action *v1.EndTurnAction, state *v1.GameState) error {
+	if action.GetTurnCounter() == 0 {
+		return nil
+	}
+	if action.TurnCounter != state.TurnCounter || action.Player != state.CurrentPlayer {
+		return fmt.Errorf("%w: player %d's turn %d is over, it is player %d's turn %d",
+			ErrTurnAlreadyEnded, action.Player, action.TurnCounter, state.CurrentPlayer, state.TurnCounter)
+	}
+	return nil
+}
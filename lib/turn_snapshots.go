@@ -0,0 +1,171 @@
+package lib
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Turn Snapshots
+// =============================================================================
+//
+// Turn snapshots save a game's state at the start of each player's turn, so
+// history queries (replays, diffs, assertions at a past turn) replay at most
+// a few turns of moves rather than the whole game.  Snapshots are only a
+// shortcut: a state whose snapshot was pruned or never saved is rebuilt by
+// replaying from the nearest earlier one, or from the start of the game.
+
+// TurnSnapshotPolicy decides which turn snapshots a game keeps
+type TurnSnapshotPolicy struct {
+	// Snapshots of the last RecentTurns turns are all kept
+	RecentTurns int32
+
+	// Before those, only the snapshots of every Every'th turn are kept
+	Every int32
+}
+
+// DefaultTurnSnapshotPolicy keeps every turn for the last 20 turns and every
+// 5th turn before them
+var DefaultTurnSnapshotPolicy = TurnSnapshotPolicy{RecentTurns: 20, Every: 5}
+
+// Keeps reports whether a snapshot taken in turn is kept once the game is in
+// currentTurn
+func (p TurnSnapshotPolicy) Keeps(turn, currentTurn int32) bool {
+	if turn > currentTurn-p.RecentTurns {
+		return true
+	}
+	return p.Every > 0 && turn%p.Every == 0
+}
+
+// NewTurnSnapshot snapshots the state, which must be at the start of its
+// current player's turn
+func NewTurnSnapshot(state *v1.GameState) (*v1.TurnSnapshot, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return &v1.TurnSnapshot{
+		TurnCounter:   state.TurnCounter,
+		CurrentPlayer: state.CurrentPlayer,
+		GroupNumber:   state.CurrentGroupNumber,
+		State:         buf.Bytes(),
+	}, nil
+}
+
+// TurnSnapshotState decodes the state saved in a snapshot
+func TurnSnapshotState(snapshot *v1.TurnSnapshot) (*v1.GameState, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(snapshot.State))
+	if err != nil {
+		return nil, fmt.Errorf("snapshot of turn %d player %d is corrupt: %w", snapshot.TurnCounter, snapshot.CurrentPlayer, err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot of turn %d player %d is corrupt: %w", snapshot.TurnCounter, snapshot.CurrentPlayer, err)
+	}
+	state := &v1.GameState{}
+	if err := proto.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("snapshot of turn %d player %d is corrupt: %w", snapshot.TurnCounter, snapshot.CurrentPlayer, err)
+	}
+	return state, nil
+}
+
+// AddTurnSnapshot adds a snapshot to a game's snapshots and prunes the ones
+// the policy no longer keeps.  Snapshots at or after the new one's turn and
+// player are replaced, since the game has been rewound past them.
+func AddTurnSnapshot(snapshots *v1.TurnSnapshots, snapshot *v1.TurnSnapshot, policy TurnSnapshotPolicy) {
+	kept := snapshots.Snapshots[:0]
+	for _, s := range snapshots.Snapshots {
+		if turnBefore(s.TurnCounter, s.CurrentPlayer, snapshot.TurnCounter, snapshot.CurrentPlayer) &&
+			policy.Keeps(s.TurnCounter, snapshot.TurnCounter) {
+			kept = append(kept, s)
+		}
+	}
+	snapshots.Snapshots = append(kept, snapshot)
+}
+
+// turnBefore reports whether player a's turn in turn aTurn comes before
+// player b's turn in bTurn
+func turnBefore(aTurn, aPlayer, bTurn, bPlayer int32) bool {
+	return aTurn < bTurn || (aTurn == bTurn && aPlayer < bPlayer)
+}
+
+// ErrTurnNotReached is returned for a turn the game has not reached
+var ErrTurnNotReached = errors.New("turn was never reached")
+
+// TurnState is a game rebuilt as it was at the start of a turn
+type TurnState struct {
+	Game *Game
+
+	// Snapshot the game was rebuilt from, nil if it was replayed from the
+	// start
+	Snapshot *v1.TurnSnapshot
+
+	// Number of moves replayed to reach the turn
+	ReplayedMoves int
+
+	// Moves played from the start of the turn on
+	Moves []*v1.GameMove
+}
+
+// GetStateAtTurn returns a copy of the game as it was at the start of
+// player's turn in turn, or at the start of the turn if player is 0.  It
+// replays the move groups played after the latest snapshot before then, or
+// after the game itself when there is none, which must then be the game as
+// it started.
+func (g *Game) GetStateAtTurn(snapshots []*v1.TurnSnapshot, groups []*v1.GameMoveGroup, turn, player int32) (*TurnState, error) {
+	out := &TurnState{}
+	start := g
+	for _, snapshot := range snapshots {
+		if turnBefore(turn, player, snapshot.TurnCounter, snapshot.CurrentPlayer) {
+			break
+		}
+		out.Snapshot = snapshot
+	}
+	var moves []*v1.GameMove
+	for _, group := range groups {
+		if out.Snapshot == nil || group.GroupNumber > out.Snapshot.GroupNumber {
+			moves = append(moves, group.Moves...)
+		}
+	}
+	if out.Snapshot != nil {
+		state, err := TurnSnapshotState(out.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		start = NewGame(g.Game, state, NewWorld(g.World.Name, state.WorldData), g.RulesEngine, g.Seed)
+	}
+
+	reached := func(replayed *Game) bool {
+		return !turnBefore(replayed.TurnCounter, replayed.CurrentPlayer, turn, player)
+	}
+	game, err := start.replay(moves, func(replayed *Game) bool {
+		if reached(replayed) {
+			return true
+		}
+		out.ReplayedMoves++
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !reached(game) {
+		return nil, fmt.Errorf("%w: turn %d player %d, the game is in turn %d", ErrTurnNotReached, turn, player, game.TurnCounter)
+	}
+	out.Game = game
+	out.Moves = moves[out.ReplayedMoves:]
+	return out, nil
+}
@@ -0,0 +1,163 @@
+package lib
+
+import (
+	"errors"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// playTurns ends turns on the game until it reaches turn, one move group per
+// player turn, snapshotting the start of each player's turn like the service
+// does.  Returns the game as it started, the move groups and the snapshots.
+func playTurns(t *testing.T, game *Game, turn int32, policy TurnSnapshotPolicy) (*Game, []*v1.GameMoveGroup, *v1.TurnSnapshots) {
+	t.Helper()
+	start, err := game.GetStateAtMove(nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var groups []*v1.GameMoveGroup
+	snapshots := &v1.TurnSnapshots{}
+	for game.TurnCounter < turn {
+		move := &v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+		if err := game.ProcessMove(move); err != nil {
+			t.Fatalf("ending turn %d failed: %v", game.TurnCounter, err)
+		}
+		game.GameState.CurrentGroupNumber++
+		groups = append(groups, &v1.GameMoveGroup{GroupNumber: game.GameState.CurrentGroupNumber, Moves: []*v1.GameMove{move}})
+
+		game.GameState.WorldData = game.World.WorldData()
+		snapshot, err := NewTurnSnapshot(game.GameState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AddTurnSnapshot(snapshots, snapshot, policy)
+	}
+	return start, groups, snapshots
+}
+
+// playedState returns the game's state without the bookkeeping the service
+// keeps alongside play, which snapshots have and replays from the start don't
+func playedState(game *Game) *v1.GameState {
+	state := proto.Clone(game.GameState).(*v1.GameState)
+	state.UpdatedAt, state.CurrentGroupNumber, state.StateHash = nil, 0, ""
+	return state
+}
+
+// snapshotTestGame returns a game whose players earn income every turn, so
+// each turn's state differs from the last
+func snapshotTestGame(radius int) *Game {
+	return newTestGameBuilder().
+		tile(-2, 0, TileTypeLandBase, 1).
+		tile(2, 0, TileTypeLandBase, 2).
+		grassTiles(radius).
+		unit(-1, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 2, testUnitTypeTank).
+		currentPlayer(1).
+		build()
+}
+
+// TestTurnSnapshotPolicy_Keeps tests that recent turns are all kept and
+// earlier ones thinned out
+func TestTurnSnapshotPolicy_Keeps(t *testing.T) {
+	policy := DefaultTurnSnapshotPolicy
+	for _, tc := range []struct {
+		turn, current int32
+		want          bool
+	}{
+		{100, 100, true},
+		{81, 100, true},
+		{80, 100, true},
+		{79, 100, false},
+		{76, 100, false},
+		{75, 100, true},
+		{5, 100, true},
+		{1, 100, false},
+		{1, 20, true},
+	} {
+		if got := policy.Keeps(tc.turn, tc.current); got != tc.want {
+			t.Errorf("Keeps(%d, %d) = %v, want %v", tc.turn, tc.current, got, tc.want)
+		}
+	}
+}
+
+// TestGetStateAtTurn_MatchesFullReplay tests that states rebuilt from kept
+// and pruned snapshots are the states a replay from the start rebuilds
+func TestGetStateAtTurn_MatchesFullReplay(t *testing.T) {
+	start, groups, snapshots := playTurns(t, snapshotTestGame(3), 30, TurnSnapshotPolicy{RecentTurns: 4, Every: 5})
+
+	for turn := int32(1); turn < 30; turn++ {
+		for player := int32(0); player <= 2; player++ {
+			fromSnapshot, err := start.GetStateAtTurn(snapshots.Snapshots, groups, turn, player)
+			if err != nil {
+				t.Fatalf("turn %d player %d from snapshots: %v", turn, player, err)
+			}
+			replayed, err := start.GetStateAtTurn(nil, groups, turn, player)
+			if err != nil {
+				t.Fatalf("turn %d player %d replayed: %v", turn, player, err)
+			}
+			if !proto.Equal(playedState(fromSnapshot.Game), playedState(replayed.Game)) {
+				t.Errorf("turn %d player %d: state from the snapshot of turn %d differs from the replayed state",
+					turn, player, fromSnapshot.Snapshot.GetTurnCounter())
+			}
+			if fromSnapshot.ReplayedMoves > replayed.ReplayedMoves {
+				t.Errorf("turn %d player %d: replayed %d moves from snapshots, more than the %d from the start",
+					turn, player, fromSnapshot.ReplayedMoves, replayed.ReplayedMoves)
+			}
+		}
+	}
+
+	// A kept snapshot is used as is
+	kept, err := start.GetStateAtTurn(snapshots.Snapshots, groups, 29, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kept.ReplayedMoves != 0 || kept.Snapshot.GetTurnCounter() != 29 {
+		t.Errorf("turn 29 player 2 replayed %d moves from %v, want the turn's own snapshot", kept.ReplayedMoves, kept.Snapshot)
+	}
+
+	if _, err := start.GetStateAtTurn(snapshots.Snapshots, groups, 30, 2); !errors.Is(err, ErrTurnNotReached) {
+		t.Error("a turn the game never reached was rebuilt")
+	}
+}
+
+// TestAddTurnSnapshot_Rewound tests that snapshotting an earlier turn again
+// drops the snapshots after it
+func TestAddTurnSnapshot_Rewound(t *testing.T) {
+	snapshots := &v1.TurnSnapshots{}
+	for _, s := range [][2]int32{{1, 2}, {2, 1}, {2, 2}, {3, 1}} {
+		AddTurnSnapshot(snapshots, &v1.TurnSnapshot{TurnCounter: s[0], CurrentPlayer: s[1]}, DefaultTurnSnapshotPolicy)
+	}
+	AddTurnSnapshot(snapshots, &v1.TurnSnapshot{TurnCounter: 2, CurrentPlayer: 2}, DefaultTurnSnapshotPolicy)
+	if len(snapshots.Snapshots) != 3 {
+		t.Errorf("%d snapshots left, want turn 1 player 2, turn 2 player 1 and the new turn 2 player 2", len(snapshots.Snapshots))
+	}
+}
+
+// TestTurnSnapshots_StorageFor200Turns measures what the snapshots of a 200
+// turn game cost to keep under the default policy
+func TestTurnSnapshots_StorageFor200Turns(t *testing.T) {
+	game := snapshotTestGame(10)
+	_, _, snapshots := playTurns(t, game, 200, DefaultTurnSnapshotPolicy)
+
+	// Turns 181-200 are all kept (turn 200 only has its first player's
+	// snapshot), and turns 5, 10 ... 180 before them
+	if want := 20*2 - 1 + 36*2; len(snapshots.Snapshots) != want {
+		t.Errorf("%d snapshots kept, want %d", len(snapshots.Snapshots), want)
+	}
+
+	stateBytes := proto.Size(game.GameState)
+	var snapshotBytes int
+	for _, snapshot := range snapshots.Snapshots {
+		snapshotBytes += len(snapshot.State)
+	}
+	fileBytes := len(protojson.Format(snapshots))
+	t.Logf("200 turns, %d tiles, %d snapshots: state %d bytes, snapshots %d bytes (%d each), stored as JSON %d bytes",
+		len(game.World.WorldData().TilesMap), len(snapshots.Snapshots), stateBytes, snapshotBytes,
+		snapshotBytes/len(snapshots.Snapshots), fileBytes)
+	if snapshotBytes >= stateBytes*len(snapshots.Snapshots) {
+		t.Errorf("snapshots take %d bytes, no smaller than %d uncompressed states", snapshotBytes, len(snapshots.Snapshots))
+	}
+}
@@ -0,0 +1,53 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Unit Cap
+// =============================================================================
+//
+// The rules can cap how many units each player has on the board, and worlds
+// or games can override the cap.  Builds are refused at the cap and build
+// options are listed as disabled.  There are no transports, so every unit a
+// player has is on the board and counts.
+
+// ErrUnitCapReached is returned for a build by a player who already has as
+// many units as the rules allow
+var ErrUnitCapReached = errors.New("unit cap reached")
+
+// MaxUnitsPerPlayer returns the most units a player may have, or 0 if the
+// game has no cap.  The game's rules already have its overrides applied.
+func (g *Game) MaxUnitsPerPlayer() int32 {
+	return g.RulesEngine.GetMaxUnitsPerPlayer()
+}
+
+// UnitCap returns the most units a player may have in a game played with
+// the given base rules, or 0 if uncapped
+func UnitCap(rules *v1.RulesEngine, config *v1.GameConfiguration) int32 {
+	if limit := GameRulesOverrides(config).GetMaxUnitsPerPlayer(); limit > 0 {
+		return limit
+	}
+	return rules.GetMaxUnitsPerPlayer()
+}
+
+// PlayerUnitCount returns how many units a player has towards the cap
+func (g *Game) PlayerUnitCount(player int32) int32 {
+	return int32(len(g.World.GetPlayerUnits(int(player))))
+}
+
+// checkUnitCap returns ErrUnitCapReached if player can't have another unit
+func (g *Game) checkUnitCap(player int32) error {
+	limit := g.MaxUnitsPerPlayer()
+	if limit <= 0 {
+		return nil
+	}
+	if count := g.PlayerUnitCount(player); count >= limit {
+		return fmt.Errorf("%w: player %d has %d of %d units", ErrUnitCapReached, player, count, limit)
+	}
+	return nil
+}
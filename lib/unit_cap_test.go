@@ -0,0 +1,109 @@
+package lib
+
+import (
+	"errors"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// newUnitCapGame returns a game where player 1 has a free base and two
+// soldiers, and the world caps players at two units
+func newUnitCapGame() *Game {
+	game := newTestGameBuilder().
+		tile(0, 0, TileTypeLandBase, 1).
+		grassTiles(3).
+		unit(1, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 1, testUnitTypeSoldier).
+		unit(-2, 2, 2, testUnitTypeSoldier).
+		coins(1, 1000).
+		currentPlayer(1).
+		build()
+	game.Config.WorldRulesOverrides = &v1.RulesOverrides{MaxUnitsPerPlayer: 2}
+	game.RulesEngine = game.RulesEngine.WithOverrides(GameRulesOverrides(game.Config))
+	return game
+}
+
+func buildSoldierMove() *v1.GameMove {
+	return &v1.GameMove{
+		MoveType: &v1.GameMove_BuildUnit{
+			BuildUnit: &v1.BuildUnitAction{Pos: &v1.Position{Q: 0, R: 0}, UnitType: testUnitTypeSoldier},
+		},
+	}
+}
+
+// TestUnitCap_BuildRejectedAtCap tests a player at the cap can't build and
+// sees the base's build options disabled
+func TestUnitCap_BuildRejectedAtCap(t *testing.T) {
+	game := newUnitCapGame()
+	if got := game.MaxUnitsPerPlayer(); got != 2 {
+		t.Fatalf("MaxUnitsPerPlayer = %d, want the world's 2", got)
+	}
+	if got := UnitCap(DefaultRulesEngine().Rules(), game.Config); got != 2 {
+		t.Errorf("UnitCap = %d, want the world's 2", got)
+	}
+
+	options, err := game.GetTileOptions(game.World.TileAt(AxialCoord{Q: 0, R: 0}))
+	if err != nil {
+		t.Fatalf("GetTileOptions failed: %v", err)
+	}
+	if len(options) == 0 {
+		t.Fatal("base at the cap lists no build options")
+	}
+	for _, option := range options {
+		if option.GetBuild() != nil && option.DisabledReason == "" {
+			t.Errorf("build of unit type %d is enabled at the cap", option.GetBuild().UnitType)
+		}
+	}
+
+	err = game.ProcessMove(buildSoldierMove())
+	if !errors.Is(err, ErrUnitCapReached) {
+		t.Fatalf("build at the cap returned %v, want ErrUnitCapReached", err)
+	}
+	if game.World.UnitAt(AxialCoord{Q: 0, R: 0}) != nil {
+		t.Error("build at the cap placed a unit")
+	}
+}
+
+// TestUnitCap_LosingUnitFreesSlot tests a player back under the cap after
+// losing a unit can build again, and is capped again after that build
+func TestUnitCap_LosingUnitFreesSlot(t *testing.T) {
+	game := newUnitCapGame()
+	if err := game.World.RemoveUnit(game.World.UnitAt(AxialCoord{Q: 2, R: 0})); err != nil {
+		t.Fatalf("RemoveUnit failed: %v", err)
+	}
+
+	options, err := game.GetTileOptions(game.World.TileAt(AxialCoord{Q: 0, R: 0}))
+	if err != nil {
+		t.Fatalf("GetTileOptions failed: %v", err)
+	}
+	for _, option := range options {
+		if option.DisabledReason != "" {
+			t.Errorf("option %v disabled under the cap: %s", option, option.DisabledReason)
+		}
+	}
+
+	if err := game.ProcessMove(buildSoldierMove()); err != nil {
+		t.Fatalf("build under the cap failed: %v", err)
+	}
+	if got := game.PlayerUnitCount(1); got != 2 {
+		t.Errorf("player 1 has %d units, want 2", got)
+	}
+	if err := game.checkUnitCap(1); !errors.Is(err, ErrUnitCapReached) {
+		t.Errorf("checkUnitCap after building back to the cap = %v, want ErrUnitCapReached", err)
+	}
+}
+
+// TestUnitCap_UncappedByDefault tests games without a cap build freely
+func TestUnitCap_UncappedByDefault(t *testing.T) {
+	game := newUnitCapGame()
+	game.Config.WorldRulesOverrides = nil
+	game.RulesEngine = DefaultRulesEngine()
+
+	if got := game.MaxUnitsPerPlayer(); got != 0 {
+		t.Fatalf("MaxUnitsPerPlayer = %d, want 0 for the default rules", got)
+	}
+	if err := game.ProcessMove(buildSoldierMove()); err != nil {
+		t.Fatalf("build without a cap failed: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package lib
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Stable Unit IDs
+// =============================================================================
+//
+// Shortcuts like A1 are for display and can be regenerated, so anything that
+// needs to follow a unit across turns uses its ID instead.  IDs come from a
+// counter on the game state and are never reused, even after the unit that
+// had one is removed.
+
+// GetUnitByID returns the unit with the given stable ID, or nil if it is no
+// longer on the board
+func (g *Game) GetUnitByID(id int32) *v1.Unit {
+	return g.World.GetUnitByID(id)
+}
+
+// nextUnitID hands out the ID for a unit being created
+func (g *Game) nextUnitID() int32 {
+	if g.GameState.NextUnitId <= 0 {
+		g.GameState.NextUnitId = 1
+	}
+	id := g.GameState.NextUnitId
+	g.GameState.NextUnitId++
+	return id
+}
+
+// noteUnitID keeps the counter past an ID assigned elsewhere, such as a unit
+// built by another player whose change is being applied here
+func (g *Game) noteUnitID(id int32) {
+	if id >= g.GameState.NextUnitId {
+		g.GameState.NextUnitId = id + 1
+	}
+}
+
+// assignUnitIDs gives an ID to every unit that does not have one yet, eg in
+// games saved before units had IDs or units placed by the map editor.  Units
+// are numbered in board order so every client loading the same state agrees.
+func (g *Game) assignUnitIDs() {
+	var missing []AxialCoord
+	for coord, unit := range g.World.UnitsByCoord() {
+		if unit.Id > 0 {
+			g.noteUnitID(unit.Id)
+		} else {
+			missing = append(missing, coord)
+		}
+	}
+	sortCoords(missing)
+	for _, coord := range missing {
+		unit := g.World.UnitAt(coord)
+		unit.Id = g.nextUnitID()
+		g.World.unitsByID[unit.Id] = unit
+	}
+}
@@ -0,0 +1,85 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// TestUnitIDs_AssignedOnLoad tests units without IDs get distinct ones
+// numbered in board order when the game is loaded
+func TestUnitIDs_AssignedOnLoad(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unit(1, 0, 1, testUnitTypeSoldier).
+		unit(-1, 0, 2, testUnitTypeSoldier).
+		build()
+
+	first := game.World.UnitAt(AxialCoord{Q: -1, R: 0})
+	second := game.World.UnitAt(AxialCoord{Q: 1, R: 0})
+	if first.Id != 1 || second.Id != 2 {
+		t.Errorf("IDs are %d and %d, want 1 and 2 in board order", first.Id, second.Id)
+	}
+	if game.GameState.NextUnitId != 3 {
+		t.Errorf("NextUnitId = %d, want 3", game.GameState.NextUnitId)
+	}
+}
+
+// TestUnitIDs_PersistAcrossMoves tests a unit keeps its ID when it moves and
+// can still be found by it
+func TestUnitIDs_PersistAcrossMoves(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		currentPlayer(1).
+		build()
+	id := game.World.UnitAt(AxialCoord{Q: 0, R: 0}).Id
+
+	if err := game.ProcessMove(moveUnitMove(0, 0, 1, 0)); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+
+	unit := game.GetUnitByID(id)
+	if unit == nil {
+		t.Fatalf("unit %d not found after moving", id)
+	}
+	if unit.Q != 1 || unit.R != 0 || unit.Shortcut != "A1" {
+		t.Errorf("unit %d is %s at (%d, %d), want A1 at (1, 0)", id, unit.Shortcut, unit.Q, unit.R)
+	}
+}
+
+// TestUnitIDs_NotReusedAfterRemoval tests a unit built after another was
+// removed gets a fresh ID, and the removed unit's ID finds nothing
+func TestUnitIDs_NotReusedAfterRemoval(t *testing.T) {
+	game := newTestGameBuilder().
+		tile(0, 0, TileTypeLandBase, 1).
+		grassTiles(2).
+		unit(1, 0, 1, testUnitTypeSoldier).
+		unit(-1, 0, 2, testUnitTypeSoldier).
+		coins(1, 500).
+		currentPlayer(1).
+		build()
+	removed := game.World.UnitAt(AxialCoord{Q: -1, R: 0})
+	if err := game.World.RemoveUnit(removed); err != nil {
+		t.Fatalf("RemoveUnit failed: %v", err)
+	}
+
+	build := &v1.GameMove{MoveType: &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{
+		Pos:      &v1.Position{Q: 0, R: 0},
+		UnitType: testUnitTypeSoldier,
+	}}}
+	if err := game.ProcessMove(build); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+
+	built := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	if built.Id != 3 {
+		t.Errorf("built unit has ID %d, want 3 after the 2 assigned on load", built.Id)
+	}
+	if unit := game.GetUnitByID(removed.Id); unit != nil {
+		t.Errorf("removed unit's ID %d found %s", removed.Id, unit.Shortcut)
+	}
+	if unit := game.GetUnitByID(built.Id); unit != built {
+		t.Errorf("GetUnitByID(%d) = %v, want the built unit", built.Id, unit)
+	}
+}
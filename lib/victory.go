@@ -0,0 +1,172 @@
+package lib
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Victory Conditions
+// =============================================================================
+//
+// Each victory condition looks at the game after a turn has ended and names
+// a winner if it has one.  Conditions are checked in order and the first
+// winner found wins the game.
+
+// victoryCondition returns the winner of a game by one way of winning, if
+// anyone has won that way
+type victoryCondition func(g *Game) (winner int32, hasWinner bool)
+
+var victoryConditions = []victoryCondition{
+	(*Game).victoryPointsWinner,
+	(*Game).lastPlayerStanding,
+}
+
+// checkVictoryConditions checks if any player has won
+func (g *Game) checkVictoryConditions() (winner int32, hasWinner bool) {
+	for _, condition := range victoryConditions {
+		if winner, hasWinner := condition(g); hasWinner {
+			return winner, true
+		}
+	}
+	return -1, false
+}
+
+// lastPlayerStanding wins the game for the last player with units
+func (g *Game) lastPlayerStanding() (winner int32, hasWinner bool) {
+	playersWithUnits := 0
+	lastPlayerWithUnits := int32(-1)
+
+	for playerID := int32(1); playerID <= g.World.PlayerCount(); playerID++ {
+		if g.playerForfeited(playerID) {
+			continue
+		}
+		units := g.World.GetPlayerUnits(int(playerID))
+		if len(units) > 0 {
+			playersWithUnits++
+			lastPlayerWithUnits = playerID
+		}
+	}
+
+	if playersWithUnits == 1 {
+		return lastPlayerWithUnits, true
+	}
+
+	return -1, false
+}
+
+// =============================================================================
+// Victory Points
+// =============================================================================
+//
+// Worlds can mark tiles as victory point markers.  Whoever holds a marker at
+// the end of their turn scores its points, and with a points-based win
+// configured the first player to reach the target wins.  A marker is held by
+// the player with a unit on it, or else by the player owning the tile, so an
+// enemy unit standing on a captured base contests it.
+
+// VictoryPointsToWin returns the points a player needs to win, or 0 if the
+// game has no points-based win
+func VictoryPointsToWin(config *v1.GameConfiguration) int32 {
+	return config.GetSettings().GetVictoryPointsToWin()
+}
+
+// WonOnVictoryPoints reports whether a finished game was won by reaching the
+// victory point target
+func WonOnVictoryPoints(config *v1.GameConfiguration, state *v1.GameState) bool {
+	target := VictoryPointsToWin(config)
+	return state.Finished && target > 0 && state.WinningPlayer > 0 &&
+		state.PlayerStates[state.WinningPlayer].GetVictoryPoints() >= target
+}
+
+// markerHolder returns the player holding a victory point marker, or 0 if
+// nobody does
+func (g *Game) markerHolder(tile *v1.Tile) int32 {
+	if unit := g.World.UnitAt(TileGetCoord(tile)); unit != nil {
+		return unit.Player
+	}
+	return tile.Player
+}
+
+// heldMarkers returns the victory point markers a player holds, in board
+// order
+func (g *Game) heldMarkers(player int32) (markers []*v1.Tile) {
+	var coords []AxialCoord
+	for coord, tile := range g.World.TilesByCoord() {
+		if tile.VictoryPoints > 0 && g.markerHolder(tile) == player {
+			coords = append(coords, coord)
+		}
+	}
+	sortCoords(coords)
+	for _, coord := range coords {
+		markers = append(markers, g.World.TileAt(coord))
+	}
+	return
+}
+
+// scoreVictoryPoints credits the player ending their turn with the points of
+// every marker they hold
+func (g *Game) scoreVictoryPoints(move *v1.GameMove, player int32) {
+	markers := g.heldMarkers(player)
+	if len(markers) == 0 {
+		return
+	}
+	playerState := g.GameState.PlayerStates[player]
+	previous := playerState.VictoryPoints
+	for _, marker := range markers {
+		playerState.VictoryPoints += marker.VictoryPoints
+	}
+
+	scored := &v1.VictoryPointsScoredChange{
+		PlayerId:       player,
+		PreviousPoints: previous,
+		NewPoints:      playerState.VictoryPoints,
+	}
+	for _, marker := range markers {
+		scored.Markers = append(scored.Markers, copyTile(marker))
+	}
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_VictoryPointsScored{VictoryPointsScored: scored},
+	})
+}
+
+// victoryPointsWinner wins the game for the first player to reach the
+// victory point target.  Only the player whose turn just ended can have
+// scored, so at most one player reaches it at a time.
+func (g *Game) victoryPointsWinner() (winner int32, hasWinner bool) {
+	target := VictoryPointsToWin(g.Config)
+	if target <= 0 {
+		return -1, false
+	}
+	for playerID := int32(1); playerID <= g.NumPlayers(); playerID++ {
+		if g.playerForfeited(playerID) {
+			continue
+		}
+		if g.GameState.PlayerStates[playerID].GetVictoryPoints() >= target {
+			return playerID, true
+		}
+	}
+	return -1, false
+}
+
+// applyVictoryPointsScored updates a player's victory points in the runtime
+// game
+func (g *Game) applyVictoryPointsScored(change *v1.VictoryPointsScoredChange) error {
+	playerState := g.GameState.PlayerStates[change.PlayerId]
+	if playerState == nil {
+		return fmt.Errorf("player state not found for player %d", change.PlayerId)
+	}
+	if change.NewPoints < change.PreviousPoints {
+		return fmt.Errorf("player %d can't lose victory points (%d -> %d)", change.PlayerId, change.PreviousPoints, change.NewPoints)
+	}
+	playerState.VictoryPoints = change.NewPoints
+	return nil
+}
+
+// FormatVictoryPointsScored describes a VictoryPointsScoredChange for turn
+// summaries and event logs
+func FormatVictoryPointsScored(change *v1.VictoryPointsScoredChange) string {
+	return fmt.Sprintf("Player %d scored %d victory points from %d markers (total %d)",
+		change.PlayerId, change.NewPoints-change.PreviousPoints, len(change.Markers), change.NewPoints)
+}
@@ -0,0 +1,125 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+func endTurnMove() *v1.GameMove {
+	return &v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+}
+
+// newVictoryPointsGame returns a game with a 2 point marker on player 1's
+// base at the origin and a 5 point target, where player 1 has 3 points and
+// it is player 2's turn with a soldier next to the marker
+func newVictoryPointsGame() *Game {
+	game := newTestGameBuilder().
+		tile(0, 0, TileTypeLandBase, 1).
+		grassTiles(3).
+		unit(-3, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 2, testUnitTypeSoldier).
+		currentPlayer(2).
+		build()
+	game.World.TileAt(AxialCoord{Q: 0, R: 0}).VictoryPoints = 2
+	game.Config.Settings.VictoryPointsToWin = 5
+	game.GameState.PlayerStates[1].VictoryPoints = 3
+	return game
+}
+
+// TestVictoryPoints_ContestedMarkerChangesHands tests a marker taken by the
+// enemy the turn before its holder would reach the target scores for the
+// enemy instead, and the holder does not win
+func TestVictoryPoints_ContestedMarkerChangesHands(t *testing.T) {
+	game := newVictoryPointsGame()
+
+	// Player 2 moves onto the marker and scores it
+	if err := game.ProcessMove(moveUnitMove(1, 0, 0, 0)); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+	end := endTurnMove()
+	if err := game.ProcessMove(end); err != nil {
+		t.Fatalf("player 2 EndTurn failed: %v", err)
+	}
+	var scored *v1.VictoryPointsScoredChange
+	for _, change := range end.Changes {
+		if c := change.GetVictoryPointsScored(); c != nil {
+			scored = c
+		}
+	}
+	if scored == nil || scored.PlayerId != 2 || scored.PreviousPoints != 0 || scored.NewPoints != 2 || len(scored.Markers) != 1 {
+		t.Fatalf("player 2 scored %v, want 0 -> 2 from 1 marker", scored)
+	}
+
+	// Player 1 still owns the base but no longer holds it
+	if err := game.ProcessMove(endTurnMove()); err != nil {
+		t.Fatalf("player 1 EndTurn failed: %v", err)
+	}
+	if got := game.GameState.PlayerStates[1].VictoryPoints; got != 3 {
+		t.Errorf("player 1 has %d points, want 3 after losing the marker", got)
+	}
+	if game.GameState.Finished {
+		t.Fatalf("game ended with player %d winning, want it to go on", game.GameState.WinningPlayer)
+	}
+
+	// Player 2 holds on and reaches the target first
+	for range 2 {
+		if err := game.ProcessMove(endTurnMove()); err != nil {
+			t.Fatalf("EndTurn failed: %v", err)
+		}
+	}
+	if got := game.GameState.PlayerStates[2].VictoryPoints; got != 4 {
+		t.Errorf("player 2 has %d points, want 4", got)
+	}
+	if err := game.ProcessMove(endTurnMove()); err != nil {
+		t.Fatalf("EndTurn failed: %v", err)
+	}
+	if !game.GameState.Finished || game.GameState.WinningPlayer != 2 || game.GameState.Status != v1.GameStatus_GAME_STATUS_ENDED {
+		t.Errorf("finished=%v winner=%d status=%v, want player 2 to win",
+			game.GameState.Finished, game.GameState.WinningPlayer, game.GameState.Status)
+	}
+	if !WonOnVictoryPoints(game.Config, game.GameState) {
+		t.Error("WonOnVictoryPoints = false for a game won on points")
+	}
+}
+
+// TestVictoryPoints_ScoreAppliesToOtherClients tests the scoring change
+// brings another copy of the game to the same totals
+func TestVictoryPoints_ScoreAppliesToOtherClients(t *testing.T) {
+	game := newVictoryPointsGame()
+	remote := newVictoryPointsGame()
+
+	moves := []*v1.GameMove{moveUnitMove(1, 0, 0, 0), endTurnMove()}
+	for _, move := range moves {
+		if err := game.ProcessMove(move); err != nil {
+			t.Fatalf("ProcessMove failed: %v", err)
+		}
+	}
+	if err := remote.ApplyChanges(moves); err != nil {
+		t.Fatalf("ApplyChanges failed: %v", err)
+	}
+	if got := remote.GameState.PlayerStates[2].VictoryPoints; got != 2 {
+		t.Errorf("remote copy has player 2 on %d points, want 2", got)
+	}
+}
+
+// TestVictoryPoints_NoTargetNoWin tests markers score without ending the
+// game when no points target is set
+func TestVictoryPoints_NoTargetNoWin(t *testing.T) {
+	game := newVictoryPointsGame()
+	game.Config.Settings.VictoryPointsToWin = 0
+	game.GameState.PlayerStates[2].VictoryPoints = 100
+
+	if err := game.ProcessMove(moveUnitMove(1, 0, 0, 0)); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+	if err := game.ProcessMove(endTurnMove()); err != nil {
+		t.Fatalf("EndTurn failed: %v", err)
+	}
+	if got := game.GameState.PlayerStates[2].VictoryPoints; got != 102 {
+		t.Errorf("player 2 has %d points, want 102", got)
+	}
+	if game.GameState.Finished {
+		t.Error("game ended on points without a target")
+	}
+}
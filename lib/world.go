@@ -47,6 +47,8 @@ type World struct {
 	unitsByShortcut      map[string]*v1.Unit `json:"-"` // Quick lookup by shortcut
 	unitCountersByPlayer map[int32]int32     `json:"-"` // Next unit number for each player
 
+	unitsByID map[int32]*v1.Unit `json:"-"` // Quick lookup by stable unit ID
+
 	tilesByShortcut      map[string]*v1.Tile `json:"-"` // Quick lookup by shortcut
 	tileCountersByPlayer map[int32]int32     `json:"-"` // Next tile number for each player
 
@@ -70,6 +72,10 @@ type World struct {
 	boundsChanged   bool
 	lastWorldBounds WorldBounds
 
+	// Bumped whenever tiles, crossings or units are added, removed or moved,
+	// so caches derived from the layout know when to refresh
+	layoutVersion int `json:"-"`
+
 	// Observer pattern for state changes
 	WorldSubject `json:"-"`
 }
@@ -86,6 +92,7 @@ func NewWorld(name string, protoWorld *v1.WorldData) *World {
 		Name:                 name,
 		unitsByShortcut:      map[string]*v1.Unit{},
 		unitCountersByPlayer: map[int32]int32{},
+		unitsByID:            map[int32]*v1.Unit{},
 		tilesByShortcut:      map[string]*v1.Tile{},
 		tileCountersByPlayer: map[int32]int32{},
 		tileDeleted:          map[string]bool{},
@@ -165,6 +172,9 @@ func (w *World) buildIndexes() {
 			unit.Shortcut = w.GenerateUnitShortcut(unit.Player)
 			w.unitsByShortcut[unit.Shortcut] = unit
 		}
+		if unit.Id > 0 {
+			w.unitsByID[unit.Id] = unit
+		}
 
 		// Build unitsByPlayer index
 		playerID := int(unit.Player)
@@ -194,6 +204,7 @@ func (w *World) Push() *World {
 		data:                 childData,
 		unitsByShortcut:      map[string]*v1.Unit{},
 		unitCountersByPlayer: map[int32]int32{},
+		unitsByID:            map[int32]*v1.Unit{},
 		tilesByShortcut:      map[string]*v1.Tile{},
 		tileCountersByPlayer: map[int32]int32{},
 		tileDeleted:          map[string]bool{},
@@ -244,6 +255,20 @@ func (w *World) Neighbors(coord AxialCoord) iter.Seq2[AxialCoord, *v1.Tile] {
 	}
 }
 
+// GetAdjacentUnits returns the units on the hexes next to coord, in neighbor
+// order
+func (w *World) GetAdjacentUnits(coord AxialCoord) []*v1.Unit {
+	var neighbors [6]AxialCoord
+	coord.Neighbors(&neighbors)
+	units := make([]*v1.Unit, 0, 6)
+	for _, neighbor := range neighbors {
+		if unit := w.UnitAt(neighbor); unit != nil {
+			units = append(units, unit)
+		}
+	}
+	return units
+}
+
 func (w *World) TilesByCoord() iter.Seq2[AxialCoord, *v1.Tile] {
 	// Merged iteration: child tiles override parent tiles, respect deletions
 	return func(yield func(AxialCoord, *v1.Tile) bool) {
@@ -314,6 +339,20 @@ func (w *World) GetUnitByShortcut(shortcut string) *v1.Unit {
 	return nil
 }
 
+// GetUnitByID returns a unit by its stable ID
+func (w *World) GetUnitByID(id int32) *v1.Unit {
+	if unit, ok := w.unitsByID[id]; ok {
+		return unit
+	}
+	// A unit from the parent layer may have been removed in this one
+	if w.parent != nil {
+		if unit := w.parent.GetUnitByID(id); unit != nil && w.UnitAt(UnitGetCoord(unit)) == unit {
+			return unit
+		}
+	}
+	return nil
+}
+
 // GenerateTileShortcut creates a new shortcut for a tile of the given player
 func (w *World) GenerateTileShortcut(playerID int32) string {
 	if playerID <= 0 || playerID > 26 {
@@ -453,6 +492,7 @@ func (w *World) HasBridge(coord AxialCoord) bool {
 
 // SetCrossing sets or removes a crossing at the given coordinate
 func (w *World) SetCrossing(coord AxialCoord, crossing *v1.Crossing) {
+	w.layoutVersion++
 	key := CoordKeyFromAxial(coord)
 	if crossing == nil || crossing.Type == v1.CrossingType_CROSSING_TYPE_UNSPECIFIED {
 		delete(w.data.Crossings, key)
@@ -476,6 +516,7 @@ func (w *World) SetCrossingType(coord AxialCoord, crossingType v1.CrossingType)
 
 // SetTileTypeCube changes the terrain type at the specified cube coordinates
 func (w *World) SetTileType(coord AxialCoord, terrainType int) bool {
+	w.layoutVersion++
 	// Get or create tile at position
 	tile := w.TileAt(coord)
 	if tile == nil {
@@ -492,6 +533,7 @@ func (w *World) SetTileType(coord AxialCoord, terrainType int) bool {
 
 // AddTileCube adds a tile at the specified cube coordinate (primary method)
 func (w *World) AddTile(tile *v1.Tile) {
+	w.layoutVersion++
 	coord := TileGetCoord(tile)
 	key := CoordKeyFromAxial(coord)
 	q, r := coord.Q, coord.R
@@ -514,6 +556,7 @@ func (w *World) AddTile(tile *v1.Tile) {
 
 // DeleteTile removes the tile at the specified cube coordinate
 func (w *World) DeleteTile(coord AxialCoord) {
+	w.layoutVersion++
 	tile := w.TileAt(coord)
 	if tile != nil {
 		key := CoordKeyFromAxial(coord)
@@ -533,6 +576,8 @@ func (w *World) AddUnit(unit *v1.Unit) (oldunit *v1.Unit, err error) {
 		return nil, fmt.Errorf("unit is nil")
 	}
 
+	w.layoutVersion++
+
 	playerID := int(unit.Player)
 	if playerID < 0 {
 		return nil, fmt.Errorf("invalid player ID: %d", playerID)
@@ -573,6 +618,9 @@ func (w *World) AddUnit(unit *v1.Unit) (oldunit *v1.Unit, err error) {
 		if oldunit.Shortcut != "" {
 			delete(w.unitsByShortcut, oldunit.Shortcut)
 		}
+		if oldunit.Id > 0 {
+			delete(w.unitsByID, oldunit.Id)
+		}
 	}
 
 	// Generate shortcut if not already set
@@ -584,6 +632,9 @@ func (w *World) AddUnit(unit *v1.Unit) (oldunit *v1.Unit, err error) {
 	if unit.Shortcut != "" {
 		w.unitsByShortcut[unit.Shortcut] = unit
 	}
+	if unit.Id > 0 {
+		w.unitsByID[unit.Id] = unit
+	}
 
 	w.unitsByPlayer[playerID] = append(w.unitsByPlayer[playerID], unit)
 	w.data.UnitsMap[key] = unit
@@ -597,6 +648,8 @@ func (w *World) RemoveUnit(unit *v1.Unit) error {
 		return fmt.Errorf("unit is nil")
 	}
 
+	w.layoutVersion++
+
 	coord := UnitGetCoord(unit)
 	key := CoordKeyFromAxial(coord)
 	p := int(unit.Player)
@@ -620,6 +673,9 @@ func (w *World) RemoveUnit(unit *v1.Unit) error {
 	if unit.Shortcut != "" {
 		delete(w.unitsByShortcut, unit.Shortcut)
 	}
+	if unit.Id > 0 {
+		delete(w.unitsByID, unit.Id)
+	}
 
 	// Remove from player's unit list if it exists
 	if p < len(w.unitsByPlayer) && w.unitsByPlayer[p] != nil {
@@ -659,6 +715,7 @@ func (w *World) MoveUnit(unit *v1.Unit, newCoord AxialCoord) error {
 				LastActedTurn:    unit.LastActedTurn,
 				LastToppedupTurn: unit.LastToppedupTurn,
 				Shortcut:         unit.Shortcut,
+				Id:               unit.Id,
 			}
 		}
 	}
@@ -700,11 +757,12 @@ func (w *World) Clone() *World {
 	// Clone tiles
 	for key, tile := range w.data.TilesMap {
 		clonedData.TilesMap[key] = &v1.Tile{
-			Q:        tile.Q,
-			R:        tile.R,
-			TileType: tile.TileType,
-			Player:   tile.Player,
-			Shortcut: tile.Shortcut,
+			Q:             tile.Q,
+			R:             tile.R,
+			TileType:      tile.TileType,
+			Player:        tile.Player,
+			Shortcut:      tile.Shortcut,
+			VictoryPoints: tile.VictoryPoints,
 		}
 	}
 
@@ -720,6 +778,7 @@ func (w *World) Clone() *World {
 			LastActedTurn:    unit.LastActedTurn,
 			LastToppedupTurn: unit.LastToppedupTurn,
 			Shortcut:         unit.Shortcut,
+			Id:               unit.Id,
 		}
 	}
 
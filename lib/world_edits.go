@@ -0,0 +1,134 @@
+package lib
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// World Edits
+// =============================================================================
+//
+// Batches of map edits (paint terrain, set owner, place or remove units, mark
+// victory points) made by the editor or by external tools.  They follow the
+// editor's rules: terrain and units must exist in the rules, only city tiles
+// can be owned, and units and markers need a tile to stand on.  A batch is
+// applied to a copy of the world data so an invalid edit leaves the world
+// untouched.
+
+// MaxWorldPlayers is the highest player a world's tiles and units can belong to
+const MaxWorldPlayers = 12
+
+// ApplyWorldEdits applies a batch of edits to a copy of the world data and
+// returns the copy.  Fails without changing data if any edit is invalid,
+// naming the first invalid edit.
+func (re *RulesEngine) ApplyWorldEdits(data *v1.WorldData, edits []*v1.WorldEdit) (*v1.WorldData, error) {
+	out := proto.Clone(data).(*v1.WorldData)
+	world := NewWorld("", out)
+	for i, edit := range edits {
+		if err := re.applyWorldEdit(world, edit); err != nil {
+			return nil, fmt.Errorf("edit %d: %w", i, err)
+		}
+	}
+	return world.WorldData(), nil
+}
+
+func (re *RulesEngine) applyWorldEdit(world *World, edit *v1.WorldEdit) error {
+	switch e := edit.GetEdit().(type) {
+	case *v1.WorldEdit_PaintTerrain:
+		coord := AxialCoord{Q: int(e.PaintTerrain.Q), R: int(e.PaintTerrain.R)}
+		if _, err := re.GetTerrainData(e.PaintTerrain.TileType); err != nil {
+			return err
+		}
+		world.SetTileType(coord, int(e.PaintTerrain.TileType))
+		if !re.IsCityTerrain(e.PaintTerrain.TileType) {
+			// Only cities can be owned, as in the editor
+			tile := world.TileAt(coord)
+			tile.Player = 0
+			tile.Shortcut = ""
+		}
+
+	case *v1.WorldEdit_SetOwner:
+		coord := AxialCoord{Q: int(e.SetOwner.Q), R: int(e.SetOwner.R)}
+		tile := world.TileAt(coord)
+		if tile == nil {
+			return fmt.Errorf("no tile at %d,%d", coord.Q, coord.R)
+		}
+		if !re.IsCityTerrain(tile.TileType) {
+			return fmt.Errorf("tile at %d,%d is not a city and cannot be owned", coord.Q, coord.R)
+		}
+		if e.SetOwner.Player < 0 || e.SetOwner.Player > MaxWorldPlayers {
+			return fmt.Errorf("player must be between 0 and %d, got %d", MaxWorldPlayers, e.SetOwner.Player)
+		}
+		owned := NewTile(coord, int(tile.TileType))
+		owned.Player = e.SetOwner.Player
+		owned.VictoryPoints = tile.VictoryPoints
+		world.AddTile(owned)
+
+	case *v1.WorldEdit_SetVictoryPoints:
+		coord := AxialCoord{Q: int(e.SetVictoryPoints.Q), R: int(e.SetVictoryPoints.R)}
+		tile := world.TileAt(coord)
+		if tile == nil {
+			return fmt.Errorf("no tile at %d,%d", coord.Q, coord.R)
+		}
+		if e.SetVictoryPoints.Points < 0 {
+			return fmt.Errorf("victory points cannot be negative, got %d", e.SetVictoryPoints.Points)
+		}
+		tile.VictoryPoints = e.SetVictoryPoints.Points
+
+	case *v1.WorldEdit_PlaceUnit:
+		coord := AxialCoord{Q: int(e.PlaceUnit.Q), R: int(e.PlaceUnit.R)}
+		if world.TileAt(coord) == nil {
+			return fmt.Errorf("no tile at %d,%d to place a unit on", coord.Q, coord.R)
+		}
+		if _, err := re.GetUnitData(e.PlaceUnit.UnitType); err != nil {
+			return err
+		}
+		if e.PlaceUnit.Player < 1 || e.PlaceUnit.Player > MaxWorldPlayers {
+			return fmt.Errorf("player must be between 1 and %d, got %d", MaxWorldPlayers, e.PlaceUnit.Player)
+		}
+		if _, err := world.AddUnit(NewUnit(int(e.PlaceUnit.UnitType), int(e.PlaceUnit.Player), coord)); err != nil {
+			return err
+		}
+
+	case *v1.WorldEdit_Remove:
+		coord := AxialCoord{Q: int(e.Remove.Q), R: int(e.Remove.R)}
+		if unit := world.UnitAt(coord); unit != nil {
+			if err := world.RemoveUnit(unit); err != nil {
+				return err
+			}
+		}
+		if e.Remove.RemoveTile {
+			world.DeleteTile(coord)
+		}
+
+	default:
+		return fmt.Errorf("empty edit")
+	}
+	return nil
+}
+
+// WorldTilesInRegion returns the tiles and units of the world data inside the
+// region, or all of them if region is nil, in row order
+func WorldTilesInRegion(data *v1.WorldData, region *v1.HexRegion) (tiles []*v1.Tile, units []*v1.Unit) {
+	inRegion := func(q, r int32) bool {
+		return region == nil || (q >= region.MinQ && q <= region.MaxQ && r >= region.MinR && r <= region.MaxR)
+	}
+	for _, tile := range data.GetTilesMap() {
+		if inRegion(tile.Q, tile.R) {
+			tiles = append(tiles, tile)
+		}
+	}
+	for _, unit := range data.GetUnitsMap() {
+		if inRegion(unit.Q, unit.R) {
+			units = append(units, unit)
+		}
+	}
+	slices.SortFunc(tiles, func(a, b *v1.Tile) int { return cmp.Or(cmp.Compare(a.R, b.R), cmp.Compare(a.Q, b.Q)) })
+	slices.SortFunc(units, func(a, b *v1.Unit) int { return cmp.Or(cmp.Compare(a.R, b.R), cmp.Compare(a.Q, b.Q)) })
+	return
+}
@@ -0,0 +1,120 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// =============================================================================
+// World Difficulty Ratings
+// =============================================================================
+
+// RateWorld estimates how hard a world is for a human playing humanPlayer by
+// simulating games with the baseline AI on every side
+func RateWorld(worldData *v1.WorldData, humanPlayer int32, rulesEngine *RulesEngine, sim SimulationConfig) (*v1.WorldRating, error) {
+	return RateWorldContext(context.Background(), worldData, humanPlayer, rulesEngine, sim)
+}
+
+// RateWorldContext is RateWorld that stops with the context's error once it
+// is done
+func RateWorldContext(ctx context.Context, worldData *v1.WorldData, humanPlayer int32, rulesEngine *RulesEngine, sim SimulationConfig) (*v1.WorldRating, error) {
+	if sim.Simulations <= 0 {
+		return nil, fmt.Errorf("at least one simulation is required")
+	}
+	sim.Config = SimulationGameConfig(worldData, sim.Config)
+	if !slices.ContainsFunc(sim.Config.Players, func(p *v1.GamePlayer) bool { return p.PlayerId == humanPlayer }) {
+		return nil, fmt.Errorf("player %d is not in the world", humanPlayer)
+	}
+
+	results, err := RunSimulationsContext(ctx, worldData, rulesEngine, sim)
+	if err != nil {
+		return nil, err
+	}
+
+	var humanWins, aiWins int
+	var turns int32
+	for _, result := range results {
+		switch result.Winner {
+		case 0:
+		case humanPlayer:
+			humanWins++
+		default:
+			aiWins++
+		}
+		turns += result.Turns
+	}
+
+	total := float64(len(results))
+	rating := &v1.WorldRating{
+		HumanPlayer:      humanPlayer,
+		Simulations:      int32(len(results)),
+		HumanWinRate:     float64(humanWins) / total,
+		AiWinRate:        float64(aiWins) / total,
+		AverageTurns:     float64(turns) / total,
+		RulesHash:        rulesEngine.Hash(),
+		AiVersion:        BaselineAIVersion,
+		RatedAt:          tspb.Now(),
+		WorldDataVersion: worldData.GetVersion(),
+	}
+	rating.Difficulty = DifficultyBucket(rating.AiWinRate)
+	rating.Label = WorldRatingLabel(rating.AiWinRate)
+	return rating, nil
+}
+
+// WorldRatingLabel is the display label for a rating with this AI win rate
+func WorldRatingLabel(aiWinRate float64) string {
+	return fmt.Sprintf("%s (AI wins %.0f%%)", DifficultyBucket(aiWinRate), aiWinRate*100)
+}
+
+// ValidateWorldRating checks that a rating is self consistent: its rates are
+// fractions of its simulations and its difficulty and label follow from them
+func ValidateWorldRating(rating *v1.WorldRating) error {
+	if rating.GetSimulations() <= 0 {
+		return fmt.Errorf("rating must cover at least one simulation")
+	}
+	if rating.HumanWinRate < 0 || rating.AiWinRate < 0 || rating.HumanWinRate+rating.AiWinRate > 1 {
+		return fmt.Errorf("rating win rates must be fractions that add up to at most 1")
+	}
+	if rating.AverageTurns < 0 {
+		return fmt.Errorf("rating average turns cannot be negative")
+	}
+	if rating.Difficulty != DifficultyBucket(rating.AiWinRate) {
+		return fmt.Errorf("rating difficulty %q does not match an AI win rate of %.2f", rating.Difficulty, rating.AiWinRate)
+	}
+	if rating.Label != WorldRatingLabel(rating.AiWinRate) {
+		return fmt.Errorf("rating label %q does not match an AI win rate of %.2f", rating.Label, rating.AiWinRate)
+	}
+	return nil
+}
+
+// DifficultyBucket maps the AI's win rate against the human side to a difficulty
+func DifficultyBucket(aiWinRate float64) string {
+	switch {
+	case aiWinRate < 0.25:
+		return "Easy"
+	case aiWinRate < 0.5:
+		return "Medium"
+	case aiWinRate < 0.8:
+		return "Hard"
+	default:
+		return "Very Hard"
+	}
+}
+
+// IsWorldRatingCurrent reports whether a rating was computed on this version
+// of the world data, with these rules and the current baseline AI. Stale
+// ratings should not be shown.
+func IsWorldRatingCurrent(rating *v1.WorldRating, worldDataVersion int64, rulesEngine *RulesEngine) bool {
+	return rating.GetWorldDataVersion() == worldDataVersion && IsWorldRatingEngineCurrent(rating, rulesEngine)
+}
+
+// IsWorldRatingEngineCurrent is IsWorldRatingCurrent without the world data
+// check, for callers that only have the world. The worlds service drops a
+// rating when the world data it was computed on changes.
+func IsWorldRatingEngineCurrent(rating *v1.WorldRating, rulesEngine *RulesEngine) bool {
+	return rating.GetAiVersion() == BaselineAIVersion && rating.GetRulesHash() == rulesEngine.Hash()
+}
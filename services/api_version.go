@@ -0,0 +1,86 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// API Versioning
+// =============================================================================
+//
+// Browser bundles lag the backend, so clients send the API version they were
+// built against. Requests from the previous version are translated to the
+// current shape by the compatibility shims below; anything older is rejected
+// so the client knows to refresh.
+
+const (
+	// ApiVersion is the API version this build implements
+	ApiVersion = 2
+
+	// MinApiVersion is the oldest client API version still accepted
+	MinApiVersion = 1
+)
+
+// ApiDeprecations lists the older API shapes still accepted through the shims
+var ApiDeprecations = []*v1.ApiDeprecation{
+	{
+		Api:          "ProcessMovesRequest.moves.player",
+		DeprecatedIn: 2,
+		RemovedIn:    3,
+		Message:      "Set player on every move. Moves without one are made for the current player.",
+	},
+}
+
+// GetServerInfo describes the API versions this server implements and accepts
+func GetServerInfo() *v1.ServerInfo {
+	return &v1.ServerInfo{
+		ApiVersion:    ApiVersion,
+		MinApiVersion: MinApiVersion,
+		Deprecations:  ApiDeprecations,
+	}
+}
+
+// ErrApiVersionUnsupported is returned for requests from clients older than
+// MinApiVersion or newer than ApiVersion
+var ErrApiVersionUnsupported = errors.New("client API version is not supported, refresh for the new version")
+
+// clientApiVersion checks the API version a request was sent with. Clients
+// from before versioning don't send one and are treated as version 1.
+func clientApiVersion(version int32) (int32, error) {
+	if version == 0 {
+		version = 1
+	}
+	if version < MinApiVersion || version > ApiVersion {
+		return 0, fmt.Errorf("%w: got %d, server accepts %d to %d", ErrApiVersionUnsupported, version, MinApiVersion, ApiVersion)
+	}
+	return version, nil
+}
+
+// upgradeProcessMovesRequest translates a ProcessMoves request from an older
+// client to the current shape.
+//
+// Version 1 clients could leave a move's player unset, in which case it was
+// made for whoever's turn it was. Since version 2 every move names its
+// player, so a stale tab can't act for the wrong seat.
+func upgradeProcessMovesRequest(req *v1.ProcessMovesRequest, version int32, currentPlayer int32) {
+	if version < 2 {
+		for _, move := range req.Moves {
+			if move.Player == 0 {
+				move.Player = currentPlayer
+			}
+		}
+	}
+}
+
+// checkMovePlayers rejects moves made for a player whose turn it isn't
+func checkMovePlayers(moves []*v1.GameMove, currentPlayer int32) error {
+	for i, move := range moves {
+		if move.Player != currentPlayer {
+			return fmt.Errorf("move %d is for player %d but it is player %d's turn", i, move.Player, currentPlayer)
+		}
+	}
+	return nil
+}
@@ -84,6 +84,24 @@ func RequireGamePlayer(ctx context.Context, game *v1.Game) (int32, error) {
 	return 0, ErrNotPlayer
 }
 
+// RequireSeat checks that the authenticated user holds the given player slot.
+// If playerID is 0 the user's first slot is used.  Returns the slot.
+func RequireSeat(ctx context.Context, game *v1.Game, playerID int32) (int32, error) {
+	if playerID == 0 {
+		return RequireGamePlayer(ctx, game)
+	}
+	userID, err := RequireAuthenticated(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, player := range game.GetConfig().GetPlayers() {
+		if player.PlayerId == playerID && player.UserId == userID {
+			return playerID, nil
+		}
+	}
+	return 0, ErrNotPlayer
+}
+
 // RequireCurrentPlayer checks if it's the authenticated user's turn.
 // Returns the player's ID if it's their turn.
 func RequireCurrentPlayer(ctx context.Context, game *v1.Game, currentPlayer int32) (int32, error) {
@@ -99,6 +117,25 @@ func RequireCurrentPlayer(ctx context.Context, game *v1.Game, currentPlayer int3
 	return playerID, nil
 }
 
+// RequireTurnController checks that the authenticated user controls the
+// current turn, either as the current player or as the teammate the turn was
+// delegated to (0 = no delegation). Returns the seat the user is acting from.
+func RequireTurnController(ctx context.Context, game *v1.Game, currentPlayer, delegatedTo int32) (int32, error) {
+	playerID, err := RequireGamePlayer(ctx, game)
+	if err != nil {
+		return 0, err
+	}
+
+	userID := GetUserIDFromContext(ctx)
+	for _, player := range game.Config.Players {
+		if player.UserId == userID && (player.PlayerId == currentPlayer || (delegatedTo > 0 && player.PlayerId == delegatedTo)) {
+			return player.PlayerId, nil
+		}
+	}
+
+	return playerID, ErrNotYourTurn
+}
+
 // CanSubmitMoves checks if user can submit moves to a game.
 // User must be a player in the game AND it must be their turn.
 func CanSubmitMoves(ctx context.Context, game *v1.Game, currentPlayer int32) error {
@@ -224,6 +224,34 @@ func TestCanSubmitMoves_Success(t *testing.T) {
 	}
 }
 
+func TestRequireTurnController_Delegate(t *testing.T) {
+	ctx := contextWithUserID("user123")
+	game := &v1.Game{
+		Id: "game1",
+		Config: &v1.GameConfiguration{
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: "user456"},
+				{PlayerId: 2, UserId: "user123"},
+			},
+		},
+	}
+
+	// User123 is player 2; player 1 has delegated their turn to them
+	playerID, err := RequireTurnController(ctx, game, 1, 2)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if playerID != 2 {
+		t.Errorf("Expected player ID 2, got %d", playerID)
+	}
+
+	// Without the delegation it is not their turn
+	_, err = RequireTurnController(ctx, game, 1, 0)
+	if err != ErrNotYourTurn {
+		t.Errorf("Expected ErrNotYourTurn, got %v", err)
+	}
+}
+
 func TestCanModifyGame_NotOwner(t *testing.T) {
 	ctx := contextWithUserID("user123")
 	game := &v1.Game{
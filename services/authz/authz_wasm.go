@@ -51,11 +51,25 @@ func RequireGamePlayer(ctx context.Context, game *v1.Game) (int32, error) {
 	return 1, nil
 }
 
+// RequireSeat returns the given player slot, or player 1 if it is 0, in WASM
+// context.
+func RequireSeat(ctx context.Context, game *v1.Game, playerID int32) (int32, error) {
+	if playerID == 0 {
+		return 1, nil
+	}
+	return playerID, nil
+}
+
 // RequireCurrentPlayer returns player 1 in WASM context.
 func RequireCurrentPlayer(ctx context.Context, game *v1.Game, currentPlayer int32) (int32, error) {
 	return currentPlayer, nil
 }
 
+// RequireTurnController returns the current player in WASM context.
+func RequireTurnController(ctx context.Context, game *v1.Game, currentPlayer, delegatedTo int32) (int32, error) {
+	return currentPlayer, nil
+}
+
 // CanSubmitMoves always succeeds in WASM context.
 func CanSubmitMoves(ctx context.Context, game *v1.Game, currentPlayer int32) error {
 	return nil
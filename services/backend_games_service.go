@@ -5,14 +5,20 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"sync"
 	"time"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	"github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/services/authz"
+	"github.com/turnforge/lilbattle/services/observability"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -50,6 +56,25 @@ type GameStorageProvider interface {
 	DeleteFromStorage(ctx context.Context, id string) error
 }
 
+// ErrStaleGameState rejects moves that were applied to a state another
+// request has committed over since it was loaded.  Clients reload the game
+// and retry.
+var ErrStaleGameState = status.Error(codes.Aborted, "the game changed while the moves were being processed")
+
+// MoveGroupCommitter is implemented by storage providers that can save a move
+// group and the state it leads to in one transaction.  The commit fails with
+// ErrStaleGameState if another one, possibly from another server, got there
+// first.
+type MoveGroupCommitter interface {
+	CommitMoveGroup(ctx context.Context, gameId string, group *v1.GameMoveGroup, state *v1.GameState) error
+}
+
+// GameStatusCounter is implemented by storage providers that can count their
+// games by status without loading each one
+type GameStatusCounter interface {
+	CountGamesByStatus(ctx context.Context) (map[v1.GameStatus]int, error)
+}
+
 // BackendGamesService provides shared caching and screenshot indexing logic for backend game services
 // It embeds BaseGamesService and adds caching + screenshot management
 type BackendGamesService struct {
@@ -58,16 +83,42 @@ type BackendGamesService struct {
 	ScreenShotIndexer *ScreenShotIndexer
 	GameStateUpdater  GameStateUpdater
 	StorageProvider   GameStorageProvider // Set by concrete implementations
+	RulesEngine       *lib.RulesEngine    // Rules for runtime games; nil uses lib.DefaultRulesEngine()
+	Clock             lib.Clock           // Clock for time banks; nil uses the system clock
 
 	// Cache configuration
 	CacheEnabled bool // Set to true to enable in-memory caching
 
+	// Embed a thumbnail of the board in the game's metadata on every save
+	Thumbnails bool
+
+	// Snapshots of each turn's starting state, set by backends that keep
+	// them, pruned to TurnSnapshotPolicy (zero uses lib.DefaultTurnSnapshotPolicy)
+	TurnSnapshots      TurnSnapshotStore
+	TurnSnapshotPolicy lib.TurnSnapshotPolicy
+
+	// Users' notification preferences, served by Get/SetNotificationPreferences
+	// when set
+	Notifications *NotificationService
+
 	// In-memory cache for game data - shared across all backend implementations
 	gameCache    map[string]*v1.Game
 	stateCache   map[string]*v1.GameState
 	historyCache map[string]*v1.GameMoveHistory
 	runtimeCache map[string]*lib.Game
 	cacheMu      sync.RWMutex
+
+	// Per game locks serializing moves, see lockGame
+	gameLocks   map[string]*gameLock
+	gameLocksMu sync.Mutex
+}
+
+// gameLock serializes the moves made on one game
+type gameLock struct {
+	sync.Mutex
+
+	// Requests holding or waiting for the lock, guarded by gameLocksMu
+	users int
 }
 
 // InitializeCache sets up the in-memory cache maps and enables caching
@@ -161,7 +212,7 @@ func (s *BackendGamesService) GetRuntimeGameCached(id string, game *v1.Game, sta
 	}
 
 	// Create new runtime game
-	rtGame := lib.ProtoToRuntimeGame(game, state)
+	rtGame := s.newRuntimeGame(game, state)
 
 	if s.CacheEnabled {
 		s.cacheMu.Lock()
@@ -174,7 +225,62 @@ func (s *BackendGamesService) GetRuntimeGameCached(id string, game *v1.Game, sta
 
 // GetRuntimeGame implements the GamesService interface
 func (s *BackendGamesService) GetRuntimeGame(game *v1.Game, gameState *v1.GameState) (*lib.Game, error) {
-	return lib.ProtoToRuntimeGame(game, gameState), nil
+	return s.newRuntimeGame(game, gameState), nil
+}
+
+// newRuntimeGame creates a runtime game using the configured rules engine
+func (s *BackendGamesService) newRuntimeGame(game *v1.Game, gameState *v1.GameState) (rtGame *lib.Game) {
+	if s.RulesEngine != nil {
+		rtGame = lib.ProtoToRuntimeGameWithRules(game, gameState, s.RulesEngine)
+	} else {
+		rtGame = lib.ProtoToRuntimeGame(game, gameState)
+	}
+	rtGame.Clock = s.Clock
+	return rtGame
+}
+
+// ProcessMoves wraps BaseGamesService.ProcessMoves with latency and error metrics.
+// An expired time bank is enforced before the moves are validated.
+func (s *BackendGamesService) ProcessMoves(ctx context.Context, req *v1.ProcessMovesRequest) (resp *v1.ProcessMovesResponse, err error) {
+	defer func(start time.Time) { observability.ObserveProcessMoves(start, err) }(time.Now())
+	if err := s.enforceTimeBank(ctx, req.GameId); err != nil {
+		return nil, err
+	}
+	defer s.lockGame(req.GameId)()
+	return s.BaseGamesService.ProcessMoves(ctx, req)
+}
+
+// lockGame serializes the moves made on a game by this server, so two
+// requests racing to end the same turn can't both commit on top of the
+// state they read.  Races between servers are caught when committing, by
+// backends that are MoveGroupCommitters.  Returns the function that unlocks
+// it.
+func (s *BackendGamesService) lockGame(gameId string) func() {
+	s.gameLocksMu.Lock()
+	if s.gameLocks == nil {
+		s.gameLocks = map[string]*gameLock{}
+	}
+	lock, ok := s.gameLocks[gameId]
+	if !ok {
+		lock = &gameLock{}
+		s.gameLocks[gameId] = lock
+	}
+	lock.users++
+	s.gameLocksMu.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+
+		// Drop the lock once no request uses it, so only games being played
+		// right now keep one
+		s.gameLocksMu.Lock()
+		lock.users--
+		if lock.users == 0 {
+			delete(s.gameLocks, gameId)
+		}
+		s.gameLocksMu.Unlock()
+	}
 }
 
 // UpdateGame updates an existing game with transparent caching.
@@ -249,7 +355,7 @@ func (s *BackendGamesService) UpdateGame(ctx context.Context, req *v1.UpdateGame
 
 		// Top up units
 		if req.NewState.WorldData != nil {
-			rg := lib.ProtoToRuntimeGame(game, req.NewState)
+			rg := s.newRuntimeGame(game, req.NewState)
 			for _, unit := range req.NewState.WorldData.UnitsMap {
 				rg.TopUpUnitIfNeeded(unit)
 			}
@@ -322,14 +428,33 @@ func (s *BackendGamesService) SaveMoveGroup(ctx context.Context, gameId string,
 		return fmt.Errorf("storage provider not configured")
 	}
 
-	// Save moves using backend-specific implementation
-	if err := s.StorageProvider.SaveMoves(ctx, gameId, group, state.CurrentGroupNumber); err != nil {
-		return fmt.Errorf("failed to save moves: %w", err)
+	if committer, ok := s.StorageProvider.(MoveGroupCommitter); ok {
+		// Save moves and state together, checking no one committed first
+		if err := committer.CommitMoveGroup(ctx, gameId, group, state); err != nil {
+			// The cached state may be the stale one the moves were applied to
+			s.invalidateCache(gameId)
+			return fmt.Errorf("failed to commit moves: %w", err)
+		}
+	} else {
+		// Save moves using backend-specific implementation
+		if err := s.StorageProvider.SaveMoves(ctx, gameId, group, state.CurrentGroupNumber); err != nil {
+			return fmt.Errorf("failed to save moves: %w", err)
+		}
+
+		// Save state (this is the "commit point")
+		if err := s.StorageProvider.SaveGameState(ctx, gameId, state); err != nil {
+			return fmt.Errorf("failed to save state: %w", err)
+		}
+	}
+
+	if s.Thumbnails {
+		if err := s.saveThumbnail(ctx, gameId, state); err != nil {
+			log.Printf("Failed to save thumbnail for game %s: %v", gameId, err)
+		}
 	}
 
-	// Save state (this is the "commit point")
-	if err := s.StorageProvider.SaveGameState(ctx, gameId, state); err != nil {
-		return fmt.Errorf("failed to save state: %w", err)
+	if err := s.saveTurnSnapshot(ctx, gameId, state, group); err != nil {
+		log.Printf("Failed to save turn snapshot for game %s: %v", gameId, err)
 	}
 
 	// Load updated history for cache
@@ -427,6 +552,8 @@ func (s *BackendGamesService) InitializeSyncBroadcast() {
 		if err != nil {
 			log.Printf("Failed to broadcast moves for game %s: %v", gameId, err)
 		}
+
+		s.announceTurnEnd(ctx, gameId, moves)
 	}
 }
 
@@ -445,10 +572,20 @@ func (s *BackendGamesService) ValidateCreateGameRequest(game *v1.Game, worldData
 				return fmt.Errorf("duplicate player ID: %d", player.PlayerId)
 			}
 			seenPlayerIds[player.PlayerId] = true
+			if err := s.rules().ValidateHandicap(player.Handicap); err != nil {
+				return fmt.Errorf("invalid handicap for player %d: %w", player.PlayerId, err)
+			}
 		}
 
-		// Check that each player has at least one unit or tile in the world
+		// Check that the world has enough start positions, and that each
+		// player has at least one unit or tile in it
 		if worldData != nil {
+			world := lib.NewWorld("", proto.Clone(worldData).(*v1.WorldData))
+			supported := world.GetSupportedPlayerCount()
+			if len(game.Config.Players) > supported {
+				return fmt.Errorf("world supports %d players, game has %d", supported, len(game.Config.Players))
+			}
+
 			for _, player := range game.Config.Players {
 				hasUnitOrTile := false
 
@@ -474,14 +611,89 @@ func (s *BackendGamesService) ValidateCreateGameRequest(game *v1.Game, worldData
 					return fmt.Errorf("player %d has no units or tiles in the world", player.PlayerId)
 				}
 			}
+
+			if errs := s.rules().ValidateMapForGame(world, len(game.Config.Players)); len(errs) > 0 {
+				return fmt.Errorf("world cannot host the game: %w", errors.Join(errs...))
+			}
 		}
 	}
 
+	if err := s.rules().ValidateRulesOverrides(game.GetConfig().GetRulesOverrides()); err != nil {
+		return fmt.Errorf("invalid rules overrides: %w", err)
+	}
+
+	if puzzle := game.GetConfig().GetSettings().GetPuzzle(); puzzle != nil {
+		if err := lib.ValidatePuzzleSettings(puzzle); err != nil {
+			return fmt.Errorf("invalid puzzle: %w", err)
+		}
+		solver := lib.PuzzleSolver(puzzle)
+		if !slices.ContainsFunc(game.Config.Players, func(p *v1.GamePlayer) bool { return p.PlayerId == solver }) {
+			return fmt.Errorf("puzzle solver %d is not a player in the game", solver)
+		}
+	}
+
+	if err := lib.ValidateSimultaneousTurns(game.GetConfig()); err != nil {
+		return fmt.Errorf("invalid simultaneous turns: %w", err)
+	}
+
+	if err := lib.ValidateSandbox(game.GetConfig()); err != nil {
+		return fmt.Errorf("invalid sandbox: %w", err)
+	}
+
+	if delay := game.GetConfig().GetSettings().GetSpectatorDelay(); delay.GetTurns() < 0 || delay.GetSeconds() < 0 {
+		return fmt.Errorf("spectator delay can't be negative, got %d turns and %d seconds", delay.GetTurns(), delay.GetSeconds())
+	}
+
 	return nil
 }
 
+// rules returns the configured rules engine, or the default one
+func (s *BackendGamesService) rules() *lib.RulesEngine {
+	if s.RulesEngine != nil {
+		return s.RulesEngine
+	}
+	return lib.DefaultRulesEngine()
+}
+
+// InheritWorldRulesOverrides copies the rules overrides of the world a game
+// is created from into the game, so later edits to the world leave games
+// already in progress alone
+func (s *BackendGamesService) InheritWorldRulesOverrides(game *v1.Game, world *v1.World) {
+	if world.GetRulesOverrides() == nil {
+		return
+	}
+	if game.Config == nil {
+		game.Config = &v1.GameConfiguration{}
+	}
+	game.Config.WorldRulesOverrides = proto.Clone(world.RulesOverrides).(*v1.RulesOverrides)
+}
+
+// SeatSandboxCreator gives every seat of a sandbox game to its creator, the
+// calling user unless the game names one
+func (s *BackendGamesService) SeatSandboxCreator(ctx context.Context, game *v1.Game) {
+	if !lib.SandboxEnabled(game.GetConfig()) {
+		return
+	}
+	if game.CreatorId == "" {
+		game.CreatorId = authz.GetUserIDFromContext(ctx)
+	}
+	for _, player := range game.Config.Players {
+		player.PlayerType, player.UserId = "human", game.CreatorId
+	}
+}
+
+// InheritWorldOrientation copies the hex layout of the world a game is
+// created from into the game, unless the game already sets one
+func (s *BackendGamesService) InheritWorldOrientation(game *v1.Game, world *v1.World) {
+	if game.Orientation == "" {
+		game.Orientation = world.GetOrientation()
+	}
+}
+
 // InitializePlayerStates initializes the PlayerStates map in GameState from game config.
-// This sets up initial coins (starting_coins + base income) for each player.
+// This sets up initial coins (starting_coins + base income) for each player and,
+// when time banks are enabled, fills each bank and starts the first player's clock.
+// Games with a draft start out in the draft phase.
 // Called during game creation by both fsbe and gormbe.
 func (s *BackendGamesService) InitializePlayerStates(gameState *v1.GameState, config *v1.GameConfiguration) {
 	if config == nil {
@@ -492,10 +704,7 @@ func (s *BackendGamesService) InitializePlayerStates(gameState *v1.GameState, co
 		gameState.PlayerStates = make(map[int32]*v1.PlayerState)
 	}
 
-	var incomeConfig *v1.IncomeConfig
-	if config.IncomeConfigs != nil {
-		incomeConfig = config.IncomeConfigs
-	}
+	incomeConfig := lib.EffectiveIncomeConfig(config)
 
 	for _, player := range config.Players {
 		baseIncome := lib.CalculatePlayerBaseIncome(player.PlayerId, gameState.WorldData, incomeConfig)
@@ -505,6 +714,15 @@ func (s *BackendGamesService) InitializePlayerStates(gameState *v1.GameState, co
 			IsActive: true,
 		}
 	}
+	lib.ApplyHandicaps(gameState, config, s.rules())
+
+	if timeBank := lib.GetTimeBankSettings(config); timeBank != nil {
+		for _, playerState := range gameState.PlayerStates {
+			playerState.TimeBankMs = int64(timeBank.InitialSeconds) * 1000
+		}
+		gameState.ClockStartedAt = timestamppb.New(s.now())
+	}
+	lib.StartDraft(gameState, config)
 }
 
 // handleScreenshotCompletion updates IndexInfo after screenshots are generated
@@ -625,6 +843,13 @@ func (s *BackendGamesService) JoinGame(ctx context.Context, req *v1.JoinGameRequ
 	// Update cache if enabled
 	s.updateCache(req.GameId, game, nil, nil)
 
+	// Filling the last seat starts a random map game
+	if IsRandomMap(game) && seatsFilled(game) {
+		if err := s.startRandomMap(ctx, game); err != nil {
+			return nil, err
+		}
+	}
+
 	log.Printf("User %s joined game %s as player %d", userID, req.GameId, req.PlayerId)
 
 	return &v1.JoinGameResponse{
@@ -7,8 +7,13 @@ import (
 	"context"
 	"log"
 	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 )
 
+// WorldPurgeInterval is how often deleted worlds are checked for purging
+const WorldPurgeInterval = time.Hour
+
 // WorldDataUpdater is an interface for updating WorldData with optimistic locking
 type WorldDataUpdater interface {
 	// GetWorldData retrieves WorldData by ID and returns version
@@ -19,6 +24,15 @@ type WorldDataUpdater interface {
 	UpdateWorldDataIndexInfo(ctx context.Context, id string, oldVersion int64, lastIndexedAt time.Time, needsIndexing bool) error
 }
 
+// WorldPurger is implemented by world stores that soft delete worlds
+type WorldPurger interface {
+	// ListDeletedWorlds returns the IDs of the worlds that were soft deleted
+	ListDeletedWorlds(ctx context.Context) ([]string, error)
+
+	// PurgeWorld removes a soft deleted world and its data for good
+	PurgeWorld(ctx context.Context, id string) error
+}
+
 // BackendWorldsService provides shared screenshot indexing logic for backend world services
 // It embeds BaseWorldsService and adds screenshot management
 type BackendWorldsService struct {
@@ -26,6 +40,7 @@ type BackendWorldsService struct {
 	ClientMgr         *ClientMgr
 	ScreenShotIndexer *ScreenShotIndexer
 	WorldDataUpdater  WorldDataUpdater
+	WorldPurger       WorldPurger
 }
 
 // InitializeScreenshotIndexer sets up the screenshot indexer with completion callback
@@ -79,3 +94,47 @@ func (s *BackendWorldsService) handleScreenshotCompletion(items []ScreenShotItem
 	}
 	return nil
 }
+
+// PurgeDeletedWorlds purges the soft deleted worlds that no game is played on
+// any more, returning the IDs of the purged worlds
+func (s *BackendWorldsService) PurgeDeletedWorlds(ctx context.Context) (purged []string, err error) {
+	deleted, err := s.WorldPurger.ListDeletedWorlds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	games := s.ClientMgr.GetGamesSvcClient()
+	for _, id := range deleted {
+		resp, err := games.ListGames(ctx, &v1.ListGamesRequest{WorldId: id, IncludeForks: true})
+		if err != nil {
+			return purged, err
+		}
+		if len(resp.Items) > 0 {
+			continue
+		}
+		if err := s.WorldPurger.PurgeWorld(ctx, id); err != nil {
+			return purged, err
+		}
+		purged = append(purged, id)
+	}
+	return purged, nil
+}
+
+// StartWorldPurger runs PurgeDeletedWorlds every interval in the background
+func (s *BackendWorldsService) StartWorldPurger(interval time.Duration) {
+	// Nothing to check games against (e.g., in tests or the local CLI)
+	if s.ClientMgr == nil {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		for range ticker.C {
+			purged, err := s.PurgeDeletedWorlds(context.Background())
+			if err != nil {
+				log.Printf("Failed to purge deleted worlds: %v", err)
+			}
+			if len(purged) > 0 {
+				log.Printf("Purged deleted worlds: %v", purged)
+			}
+		}
+	}()
+}
@@ -31,6 +31,7 @@ type ClientMgr struct {
 	gamesSvcClient     v1s.GamesServiceClient
 	filestoreSvcClient v1s.FileStoreServiceClient
 	gameSyncSvcClient  v1s.GameSyncServiceClient
+	puzzlesSvcClient   v1s.PuzzlesServiceClient
 	authSvc *goalservices.AuthService
 }
 
@@ -102,6 +103,18 @@ func (c *ClientMgr) GetWorldsSvcClient() (out v1s.WorldsServiceClient) {
 	return c.worldsSvcClient
 }
 
+func (c *ClientMgr) GetPuzzlesSvcClient() (out v1s.PuzzlesServiceClient) {
+	if c.puzzlesSvcClient == nil {
+		puzzlesSvcConn, err := grpc.NewClient(c.svcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			panic(fmt.Sprintf("cannot connect with server %v", err))
+		}
+
+		c.puzzlesSvcClient = v1s.NewPuzzlesServiceClient(puzzlesSvcConn)
+	}
+	return c.puzzlesSvcClient
+}
+
 func (c *ClientMgr) GetFileStoreSvcClient() (out v1s.FileStoreServiceClient) {
 	log.Println("C = ", c)
 	if c.filestoreSvcClient == nil {
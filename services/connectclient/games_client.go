@@ -159,6 +159,87 @@ func (c *ConnectGamesClient) JoinGame(ctx context.Context, req *v1.JoinGameReque
 	return resp.Msg, nil
 }
 
+// SetClockPaused pauses or resumes a game's clock via Connect
+func (c *ConnectGamesClient) SetClockPaused(ctx context.Context, req *v1.SetClockPausedRequest) (*v1.SetClockPausedResponse, error) {
+	resp, err := c.client.SetClockPaused(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// DelegateTurn hands the rest of the current turn to a teammate via Connect
+func (c *ConnectGamesClient) DelegateTurn(ctx context.Context, req *v1.DelegateTurnRequest) (*v1.DelegateTurnResponse, error) {
+	resp, err := c.client.DelegateTurn(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ClaimNoContactDraw ends a stuck game as a draw via Connect
+func (c *ConnectGamesClient) ClaimNoContactDraw(ctx context.Context, req *v1.ClaimNoContactDrawRequest) (*v1.ClaimNoContactDrawResponse, error) {
+	resp, err := c.client.ClaimNoContactDraw(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// DraftUnit bans or picks a unit type during the draft via Connect
+func (c *ConnectGamesClient) DraftUnit(ctx context.Context, req *v1.DraftUnitRequest) (*v1.DraftUnitResponse, error) {
+	resp, err := c.client.DraftUnit(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetStateDiff gets what changed in a game between two turns via Connect
+func (c *ConnectGamesClient) GetStateDiff(ctx context.Context, req *v1.GetStateDiffRequest) (*v1.GetStateDiffResponse, error) {
+	resp, err := c.client.GetStateDiff(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ForkGame forks a game into a private copy via Connect
+func (c *ConnectGamesClient) ForkGame(ctx context.Context, req *v1.ForkGameRequest) (*v1.ForkGameResponse, error) {
+	resp, err := c.client.ForkGame(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetStateAtTurn gets a game's state at the start of a player's turn via Connect
+func (c *ConnectGamesClient) GetStateAtTurn(ctx context.Context, req *v1.GetStateAtTurnRequest) (*v1.GetStateAtTurnResponse, error) {
+	resp, err := c.client.GetStateAtTurn(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetNotificationPreferences gets the caller's notification preferences via Connect
+func (c *ConnectGamesClient) GetNotificationPreferences(ctx context.Context, req *v1.GetNotificationPreferencesRequest) (*v1.GetNotificationPreferencesResponse, error) {
+	resp, err := c.client.GetNotificationPreferences(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// SetNotificationPreferences replaces the caller's notification preferences via Connect
+func (c *ConnectGamesClient) SetNotificationPreferences(ctx context.Context, req *v1.SetNotificationPreferencesRequest) (*v1.SetNotificationPreferencesResponse, error) {
+	resp, err := c.client.SetNotificationPreferences(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
 // GetRuntimeGame converts proto game data to runtime game
 // This is a local operation that doesn't require the server
 func (c *ConnectGamesClient) GetRuntimeGame(game *v1.Game, gameState *v1.GameState) (*lib.Game, error) {
@@ -0,0 +1,40 @@
+package connectclient
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services/lilbattlev1connect"
+)
+
+// ConnectIndexerClient wraps a Connect client for the IndexerService
+type ConnectIndexerClient struct {
+	client lilbattlev1connect.IndexerServiceClient
+}
+
+// NewConnectIndexerClientWithAuth creates a new Connect client with authentication
+func NewConnectIndexerClientWithAuth(serverURL, token string) *ConnectIndexerClient {
+	httpClient := http.DefaultClient
+	if token != "" {
+		httpClient = &http.Client{
+			Transport: &authTransport{
+				base:  http.DefaultTransport,
+				token: token,
+			},
+		}
+	}
+	return &ConnectIndexerClient{
+		client: lilbattlev1connect.NewIndexerServiceClient(httpClient, serverURL),
+	}
+}
+
+// GetMetaStats returns the meta statistics of worlds via Connect
+func (c *ConnectIndexerClient) GetMetaStats(ctx context.Context, req *v1.GetMetaStatsRequest) (*v1.GetMetaStatsResponse, error) {
+	resp, err := c.client.GetMetaStats(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
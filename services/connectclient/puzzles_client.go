@@ -0,0 +1,67 @@
+package connectclient
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services/lilbattlev1connect"
+)
+
+// ConnectPuzzlesClient wraps a Connect client for the PuzzlesService
+type ConnectPuzzlesClient struct {
+	client lilbattlev1connect.PuzzlesServiceClient
+}
+
+// NewConnectPuzzlesClientWithAuth creates a new Connect client with authentication
+func NewConnectPuzzlesClientWithAuth(serverURL, token string) *ConnectPuzzlesClient {
+	httpClient := http.DefaultClient
+	if token != "" {
+		httpClient = &http.Client{
+			Transport: &authTransport{
+				base:  http.DefaultTransport,
+				token: token,
+			},
+		}
+	}
+	return &ConnectPuzzlesClient{
+		client: lilbattlev1connect.NewPuzzlesServiceClient(httpClient, serverURL),
+	}
+}
+
+// GetPuzzle returns a puzzle via Connect
+func (c *ConnectPuzzlesClient) GetPuzzle(ctx context.Context, req *v1.GetPuzzleRequest) (*v1.GetPuzzleResponse, error) {
+	resp, err := c.client.GetPuzzle(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListPuzzles returns the puzzles via Connect
+func (c *ConnectPuzzlesClient) ListPuzzles(ctx context.Context, req *v1.ListPuzzlesRequest) (*v1.ListPuzzlesResponse, error) {
+	resp, err := c.client.ListPuzzles(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// PlayPuzzle starts an attempt at a puzzle via Connect
+func (c *ConnectPuzzlesClient) PlayPuzzle(ctx context.Context, req *v1.PlayPuzzleRequest) (*v1.PlayPuzzleResponse, error) {
+	resp, err := c.client.PlayPuzzle(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListPuzzleAttempts returns the caller's puzzle attempts via Connect
+func (c *ConnectPuzzlesClient) ListPuzzleAttempts(ctx context.Context, req *v1.ListPuzzleAttemptsRequest) (*v1.ListPuzzleAttemptsResponse, error) {
+	resp, err := c.client.ListPuzzleAttempts(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
@@ -0,0 +1,45 @@
+package connectclient
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services/lilbattlev1connect"
+)
+
+// ConnectSyncClient wraps a Connect client for the GameSyncService
+type ConnectSyncClient struct {
+	client lilbattlev1connect.GameSyncServiceClient
+}
+
+// NewConnectSyncClientWithAuth creates a new Connect client with authentication
+func NewConnectSyncClientWithAuth(serverURL, token string) *ConnectSyncClient {
+	httpClient := http.DefaultClient
+	if token != "" {
+		httpClient = &http.Client{
+			Transport: &authTransport{
+				base:  http.DefaultTransport,
+				token: token,
+			},
+		}
+	}
+	return &ConnectSyncClient{
+		client: lilbattlev1connect.NewGameSyncServiceClient(httpClient, serverURL),
+	}
+}
+
+// SendPing marks a hex for the caller's teammates via Connect
+func (c *ConnectSyncClient) SendPing(ctx context.Context, req *v1.SendPingRequest) (*v1.SendPingResponse, error) {
+	resp, err := c.client.SendPing(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// Subscribe opens a stream of game updates via Connect
+func (c *ConnectSyncClient) Subscribe(ctx context.Context, req *v1.SubscribeRequest) (*connect.ServerStreamForClient[v1.GameUpdate], error) {
+	return c.client.Subscribe(ctx, connect.NewRequest(req))
+}
@@ -86,6 +86,33 @@ func (c *ConnectWorldsClient) UpdateWorld(ctx context.Context, req *v1.UpdateWor
 	return resp.Msg, nil
 }
 
+// SetWorldRulesOverrides replaces a world's rules overrides via Connect
+func (c *ConnectWorldsClient) SetWorldRulesOverrides(ctx context.Context, req *v1.SetWorldRulesOverridesRequest) (*v1.SetWorldRulesOverridesResponse, error) {
+	resp, err := c.client.SetWorldRulesOverrides(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ApplyWorldEdits applies a batch of map edits to a world via Connect
+func (c *ConnectWorldsClient) ApplyWorldEdits(ctx context.Context, req *v1.ApplyWorldEditsRequest) (*v1.ApplyWorldEditsResponse, error) {
+	resp, err := c.client.ApplyWorldEdits(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetWorldTiles gets the tiles and units of a world via Connect
+func (c *ConnectWorldsClient) GetWorldTiles(ctx context.Context, req *v1.GetWorldTilesRequest) (*v1.GetWorldTilesResponse, error) {
+	resp, err := c.client.GetWorldTiles(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
 // DeleteWorld deletes a world via Connect
 func (c *ConnectWorldsClient) DeleteWorld(ctx context.Context, req *v1.DeleteWorldRequest) (*v1.DeleteWorldResponse, error) {
 	resp, err := c.client.DeleteWorld(ctx, connect.NewRequest(req))
@@ -0,0 +1,53 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/authz"
+)
+
+// DelegateTurn hands the rest of the current turn over to a teammate. The
+// delegation is recorded in the move history and revoked at EndTurn.
+func (s *BackendGamesService) DelegateTurn(ctx context.Context, req *v1.DelegateTurnRequest) (*v1.DelegateTurnResponse, error) {
+	if req.GameId == "" {
+		return nil, fmt.Errorf("game ID is required")
+	}
+	if err := s.enforceTimeBank(ctx, req.GameId); err != nil {
+		return nil, err
+	}
+	defer s.lockGame(req.GameId)()
+
+	gameresp, err := s.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	if gameresp.State.Finished {
+		return nil, fmt.Errorf("game %s has already finished", req.GameId)
+	}
+
+	playerId, err := requireSeat(ctx, gameresp.Game, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+	if playerId != gameresp.State.CurrentPlayer {
+		return nil, authz.ErrNotYourTurn
+	}
+
+	move := &v1.GameMove{
+		Player: playerId,
+		MoveType: &v1.GameMove_DelegateTurn{
+			DelegateTurn: &v1.DelegateTurnAction{DelegatePlayerId: req.DelegatePlayerId},
+		},
+	}
+	movesReq := &v1.ProcessMovesRequest{GameId: req.GameId, Moves: []*v1.GameMove{move}}
+	if _, err := s.commitMoves(ctx, movesReq, gameresp); err != nil {
+		return nil, err
+	}
+
+	return &v1.DelegateTurnResponse{DelegatedTo: req.DelegatePlayerId}, nil
+}
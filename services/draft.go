@@ -0,0 +1,58 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/authz"
+)
+
+// DraftUnit bans or picks a unit type for the caller's seat during the
+// pre-game draft. The draft turn is recorded in the move history.
+func (s *BackendGamesService) DraftUnit(ctx context.Context, req *v1.DraftUnitRequest) (*v1.DraftUnitResponse, error) {
+	if req.GameId == "" {
+		return nil, fmt.Errorf("game ID is required")
+	}
+	if err := s.enforceTimeBank(ctx, req.GameId); err != nil {
+		return nil, err
+	}
+	defer s.lockGame(req.GameId)()
+
+	gameresp, err := s.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	if gameresp.State.Status != v1.GameStatus_GAME_STATUS_DRAFTING {
+		return nil, fmt.Errorf("game %s is not drafting", req.GameId)
+	}
+
+	playerId, err := requireSeat(ctx, gameresp.Game, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+	if playerId != gameresp.State.CurrentPlayer {
+		return nil, authz.ErrNotYourTurn
+	}
+
+	move := &v1.GameMove{
+		Player: playerId,
+		MoveType: &v1.GameMove_DraftUnit{
+			DraftUnit: &v1.DraftUnitAction{UnitType: req.UnitType, Pick: req.Pick},
+		},
+	}
+	movesReq := &v1.ProcessMovesRequest{GameId: req.GameId, Moves: []*v1.GameMove{move}}
+	if _, err := s.commitMoves(ctx, movesReq, gameresp); err != nil {
+		return nil, err
+	}
+
+	state := gameresp.State
+	return &v1.DraftUnitResponse{
+		Draft:         state.Draft,
+		CurrentPlayer: state.CurrentPlayer,
+		DraftComplete: state.Status != v1.GameStatus_GAME_STATUS_DRAFTING,
+	}, nil
+}
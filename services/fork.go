@@ -0,0 +1,169 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/protobuf/proto"
+)
+
+// ForkedGame returns the game a user explores a fork of a game in.  The user
+// plays every seat except the ones in aiPlayers, which the AI plays.  Forks
+// are never rated and have no clocks, puzzle or random map of their own.
+func ForkedGame(source *v1.Game, userId string, atMove int32, aiPlayers []int32) *v1.Game {
+	game := proto.Clone(source).(*v1.Game)
+	game.Id = ""
+	game.Name = source.Name + " (fork)"
+	game.CreatorId = userId
+	game.CreatedAt, game.UpdatedAt = nil, nil
+	game.RandomMap = nil
+	game.Thumbnail = ""
+	game.ForkedFromGameId = source.Id
+	game.ForkedAtMove = atMove
+
+	if game.Config == nil {
+		game.Config = &v1.GameConfiguration{}
+	}
+	if game.Config.Settings == nil {
+		game.Config.Settings = &v1.GameSettings{}
+	}
+	settings := game.Config.Settings
+	settings.Rated = false
+	settings.Puzzle = nil
+	settings.TimeBank = nil
+
+	for _, player := range game.Config.Players {
+		player.PlayerType, player.UserId = "human", userId
+		if slices.Contains(aiPlayers, player.PlayerId) {
+			player.PlayerType, player.UserId = "ai", ""
+		}
+	}
+	return game
+}
+
+// forkedHistory returns the groups of a history holding its first count
+// moves, cutting the last group short if needed
+func forkedHistory(history *v1.GameMoveHistory, count int) (groups []*v1.GameMoveGroup) {
+	for _, group := range history.GetGroups() {
+		if count <= 0 {
+			break
+		}
+		group = proto.Clone(group).(*v1.GameMoveGroup)
+		if len(group.Moves) > count {
+			group.Moves = group.Moves[:count]
+		}
+		count -= len(group.Moves)
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// ForkGame copies a game, as it is now or as it was after a number of its
+// moves, into a new game the caller plays every seat of.  The source game is
+// left untouched.  Only its players may fork a game before it ends.
+func (s *BackendGamesService) ForkGame(ctx context.Context, req *v1.ForkGameRequest) (*v1.ForkGameResponse, error) {
+	if req.GameId == "" {
+		return nil, fmt.Errorf("game ID is required")
+	}
+	if s.StorageProvider == nil {
+		return nil, fmt.Errorf("storage provider not configured")
+	}
+	userId, err := authz.RequireAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := s.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	if source.State.GetStatus() == v1.GameStatus_GAME_STATUS_WAITING {
+		return nil, fmt.Errorf("game %s has not started yet", req.GameId)
+	}
+	// Players may fork their games at any point, anyone else only once they
+	// have ended, so a fork never shows an outsider a game in progress
+	seats := callerSeats(ctx, source.Game)
+	if len(seats) == 0 && !source.State.GetFinished() {
+		return nil, fmt.Errorf("game %s has not ended: %w", req.GameId, authz.ErrNotPlayer)
+	}
+	for _, playerId := range req.AiPlayers {
+		if !slices.ContainsFunc(source.Game.GetConfig().GetPlayers(), func(p *v1.GamePlayer) bool { return p.PlayerId == playerId }) {
+			return nil, fmt.Errorf("game %s has no player %d", req.GameId, playerId)
+		}
+	}
+
+	// Forking at a move replays the game up to it, otherwise the fork starts
+	// from the game as it is now.  Spectators of a delayed game fork no
+	// further than they are shown.
+	moves := historyMoves(source.History)
+	atMove, replay := len(moves), req.MoveIndex > 0
+	if replay {
+		atMove = int(req.MoveIndex)
+	}
+	if delay := GameSpectatorDelay(source.Game); delay != nil && len(seats) == 0 {
+		if visible, _, _, held := SpectatorCutoff(source.History, delay, s.now()); held {
+			if shown := len(historyMoves(&v1.GameMoveHistory{Groups: visible})); atMove > shown {
+				atMove, replay = shown, true
+			}
+		}
+	}
+	state := proto.Clone(source.State).(*v1.GameState)
+	if replay {
+		initial, err := s.initialGameState(ctx, source.Game)
+		if err != nil {
+			return nil, err
+		}
+		replayed, err := s.newRuntimeGame(source.Game, initial).GetStateAtMove(moves, atMove)
+		if err != nil {
+			return nil, err
+		}
+		state = replayed.GameState
+	}
+
+	created, err := s.Self.CreateGame(ctx, &v1.CreateGameRequest{
+		Game: ForkedGame(source.Game, userId, int32(atMove), req.AiPlayers),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fork: %w", err)
+	}
+	if created.Game == nil {
+		return nil, fmt.Errorf("failed to create fork of game %s", req.GameId)
+	}
+	fork := created.Game
+
+	groups := forkedHistory(source.History, atMove)
+	for _, group := range groups {
+		if err := s.StorageProvider.SaveMoves(ctx, fork.Id, group, group.GroupNumber); err != nil {
+			return nil, fmt.Errorf("failed to copy moves: %w", err)
+		}
+	}
+
+	state.GameId = fork.Id
+	state.Version = created.GameState.GetVersion()
+	state.PuzzleResult = v1.PuzzleResult_PUZZLE_RESULT_UNSPECIFIED
+	// The forker plays every seat, so the plans of the source game's players
+	// would all be theirs to read
+	state.TurnPlans = nil
+	state.CurrentGroupNumber = 0
+	if len(groups) > 0 {
+		state.CurrentGroupNumber = groups[len(groups)-1].GroupNumber
+	}
+	state.StateHash = lib.StateHash(state, s.newRuntimeGame(fork, state).RulesHash())
+	if err := s.StorageProvider.SaveGameState(ctx, fork.Id, state); err != nil {
+		return nil, fmt.Errorf("failed to save fork state: %w", err)
+	}
+
+	history, err := s.StorageProvider.LoadGameHistory(ctx, fork.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load fork history: %w", err)
+	}
+	s.updateCache(fork.Id, fork, state, history)
+	return &v1.ForkGameResponse{Game: fork, GameState: state}, nil
+}
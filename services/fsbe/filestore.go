@@ -12,6 +12,7 @@ import (
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/observability"
 	tspb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -92,6 +93,8 @@ func (s *FileStoreService) resolvePathOrRoot(relativePath string) (string, error
 
 // PutFile stores a file at the specified path
 func (s *FileStoreService) PutFile(ctx context.Context, req *v1.PutFileRequest) (resp *v1.PutFileResponse, err error) {
+	defer func() { observability.ObserveFilestoreOp("put", err) }()
+
 	if req.File == nil {
 		return nil, fmt.Errorf("file is required")
 	}
@@ -137,6 +140,8 @@ func (s *FileStoreService) PutFile(ctx context.Context, req *v1.PutFileRequest)
 
 // DeleteFile deletes a file at the specified path
 func (s *FileStoreService) DeleteFile(ctx context.Context, req *v1.DeleteFileRequest) (resp *v1.DeleteFileResponse, err error) {
+	defer func() { observability.ObserveFilestoreOp("delete", err) }()
+
 	if req.Path == "" {
 		return nil, fmt.Errorf("path is required")
 	}
@@ -175,6 +180,8 @@ func (s *FileStoreService) DeleteFile(ctx context.Context, req *v1.DeleteFileReq
 
 // GetFile returns metadata about a file at the specified path
 func (s *FileStoreService) GetFile(ctx context.Context, req *v1.GetFileRequest) (resp *v1.GetFileResponse, err error) {
+	defer func() { observability.ObserveFilestoreOp("get", err) }()
+
 	if req.Path == "" {
 		return nil, fmt.Errorf("path is required")
 	}
@@ -208,6 +215,8 @@ func (s *FileStoreService) GetFile(ctx context.Context, req *v1.GetFileRequest)
 
 // ListFiles lists files in a directory
 func (s *FileStoreService) ListFiles(ctx context.Context, req *v1.ListFilesRequest) (resp *v1.ListFilesResponse, err error) {
+	defer func() { observability.ObserveFilestoreOp("list", err) }()
+
 	resp = &v1.ListFilesResponse{
 		Items: []*v1.File{},
 		Pagination: &v1.PaginationResponse{
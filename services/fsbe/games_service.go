@@ -46,6 +46,7 @@ func NewFSGamesService(storageDir string, clientMgr *services.ClientMgr) *FSGame
 	service.Self = service
 	service.StorageProvider = service // FSGamesService implements GameStorageProvider
 	service.GameStateUpdater = service
+	service.TurnSnapshots = service
 	service.InitializeCache() // Initialize cache at BackendGamesService level
 	service.InitializeScreenshotIndexer()
 	service.InitializeSyncBroadcast()
@@ -128,6 +129,28 @@ func (s *FSGamesService) SaveMoves(ctx context.Context, gameId string, group *v1
 	return s.storage.SaveArtifact(gameId, "history", history)
 }
 
+// LoadTurnSnapshots implements TurnSnapshotStore - loads a game's turn
+// snapshots from file storage
+func (s *FSGamesService) LoadTurnSnapshots(ctx context.Context, gameId string) (*v1.TurnSnapshots, error) {
+	// Most games have no snapshots yet, so a missing file is not logged as
+	// LoadFSArtifact would
+	snapshots := &v1.TurnSnapshots{}
+	err := s.storage.LoadArtifact(gameId, "snapshots", snapshots)
+	if errors.Is(err, os.ErrNotExist) {
+		return &v1.TurnSnapshots{GameId: gameId}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load turn snapshots: %w", err)
+	}
+	return snapshots, nil
+}
+
+// SaveTurnSnapshots implements TurnSnapshotStore - saves a game's turn
+// snapshots to file storage
+func (s *FSGamesService) SaveTurnSnapshots(ctx context.Context, gameId string, snapshots *v1.TurnSnapshots) error {
+	return s.storage.SaveArtifact(gameId, "snapshots", snapshots)
+}
+
 // GetGameStateVersion implements GameStateUpdater interface
 func (s *FSGamesService) GetGameStateVersion(ctx context.Context, id string) (int64, error) {
 	gameState, err := storage.LoadFSArtifact[*v1.GameState](s.storage, id, "state")
@@ -176,7 +199,12 @@ func (s *FSGamesService) ListGames(ctx context.Context, req *v1.ListGamesRequest
 			TotalResults: 0,
 		},
 	}
-	resp.Items, err = storage.ListFSEntities[*v1.Game](s.storage, nil)
+	resp.Items, err = storage.ListFSEntities[*v1.Game](s.storage, func(game *v1.Game) bool {
+		if game.ForkedFromGameId != "" && !req.IncludeForks {
+			return false
+		}
+		return req.WorldId == "" || game.WorldId == req.WorldId
+	})
 	resp.Pagination.TotalResults = int32(len(resp.Items))
 
 	// Populate screenshot URLs for all games
@@ -192,16 +220,18 @@ func (s *FSGamesService) ListGames(ctx context.Context, req *v1.ListGamesRequest
 // CreateGame creates a new game
 func (s *FSGamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest) (resp *v1.CreateGameResponse, err error) {
 	// Load world data first so we can validate players have units/tiles
-	worldsSvcClient := s.ClientMgr.GetWorldsSvcClient()
-	world, err := worldsSvcClient.GetWorld(ctx, &v1.GetWorldRequest{Id: req.Game.WorldId})
+	world, err := s.LoadCreateGameWorld(ctx, req.Game)
 	if err != nil {
-		return nil, fmt.Errorf("Error loading world: %w", err)
+		return nil, err
 	}
 
 	// Validate the request (duplicate players, players with units/tiles, etc.)
 	if err := s.ValidateCreateGameRequest(req.Game, world.WorldData); err != nil {
 		return nil, err
 	}
+	s.InheritWorldRulesOverrides(req.Game, world.World)
+	s.SeatSandboxCreator(ctx, req.Game)
+	s.InheritWorldOrientation(req.Game, world.World)
 
 	// Create game entity directory
 	customId := req.Game.Id
@@ -220,6 +250,9 @@ func (s *FSGamesService) CreateGame(ctx context.Context, req *v1.CreateGameReque
 		}
 		return nil, err
 	}
+	if err := s.AttachRandomMap(ctx, req.Game, world); err != nil {
+		return nil, err
+	}
 
 	now := time.Now()
 	req.Game.CreatedAt = tspb.New(now)
@@ -233,16 +266,20 @@ func (s *FSGamesService) CreateGame(ctx context.Context, req *v1.CreateGameReque
 		WorldData:     world.WorldData,
 	}
 
-	// Auto-migrate WorldData from old list-based format to new map-based format
-	lib.MigrateWorldData(gs.WorldData)
+	// Random map games wait with an empty board until their seats are filled
+	if !s.WaitForRandomMap(req.Game, gs) {
+		// Auto-migrate WorldData from old list-based format to new map-based format
+		lib.MigrateWorldData(gs.WorldData)
 
-	// Generate shortcuts for tiles and units
-	lib.EnsureShortcuts(gs.WorldData)
+		// Generate shortcuts for tiles and units
+		lib.EnsureShortcuts(gs.WorldData)
 
-	// Initialize player runtime state with starting coins + base income
-	s.InitializePlayerStates(gs, req.Game.Config)
+		// Initialize player runtime state with starting coins + base income
+		s.InitializePlayerStates(gs, req.Game.Config)
+	}
 
 	// Save game metadata (after adding base income to player coins)
+	s.EmbedThumbnail(req.Game, gs)
 	if err := s.storage.SaveArtifact(req.Game.Id, "metadata", req.Game); err != nil {
 		return nil, fmt.Errorf("failed to create game: %w", err)
 	}
@@ -259,6 +296,11 @@ func (s *FSGamesService) CreateGame(ctx context.Context, req *v1.CreateGameReque
 		log.Printf("Failed to create state for game %s: %v", req.Game.Id, err)
 	}
 
+	// Puzzles where the opponent moves first start with its turn played
+	if err := s.PlayPuzzleOpponent(ctx, &v1.GetGameResponse{Game: req.Game, State: gs}); err != nil {
+		return nil, err
+	}
+
 	resp = &v1.CreateGameResponse{
 		Game:      req.Game,
 		GameState: gs,
@@ -298,7 +340,7 @@ func computeHash(msg proto.Message) string {
 func (s *FSGamesService) ProcessMoves(ctx context.Context, req *v1.ProcessMovesRequest) (*v1.ProcessMovesResponse, error) {
 	// If client didn't provide expected results, run ProcessMoves locally
 	if req.ExpectedResponse == nil {
-		return s.BaseGamesService.ProcessMoves(ctx, req)
+		return s.BackendGamesService.ProcessMoves(ctx, req)
 	}
 
 	// Client provided expected results - validate through coordinator
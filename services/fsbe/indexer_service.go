@@ -0,0 +1,100 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/panyam/goutils/storage"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/protobuf/proto"
+)
+
+var INDEXER_STORAGE_DIR = ""
+
+// FSIndexerService serves the indexer's tables, kept on the file system.
+// Each table is a directory with an entity per row.
+type FSIndexerService struct {
+	services.BaseIndexerService
+	worlds  *storage.FileStorage
+	games   *storage.FileStorage
+	cursors *storage.FileStorage
+}
+
+// NewFSIndexerService creates a new FSIndexerService
+func NewFSIndexerService(storageDir string) *FSIndexerService {
+	if storageDir == "" {
+		if INDEXER_STORAGE_DIR == "" {
+			INDEXER_STORAGE_DIR = DevDataPath("storage/indexer")
+		}
+		storageDir = INDEXER_STORAGE_DIR
+	}
+	service := &FSIndexerService{
+		worlds:  storage.NewFileStorage(filepath.Join(storageDir, "world_meta_stats")),
+		games:   storage.NewFileStorage(filepath.Join(storageDir, "game_meta_summaries")),
+		cursors: storage.NewFileStorage(filepath.Join(storageDir, "meta_stats_cursors")),
+	}
+	service.Self = service
+	return service
+}
+
+// GetMetaStats returns the aggregated statistics of the requested worlds
+func (s *FSIndexerService) GetMetaStats(ctx context.Context, req *v1.GetMetaStatsRequest) (*v1.GetMetaStatsResponse, error) {
+	worlds, err := s.ListWorldMetaStats()
+	if err != nil {
+		return nil, err
+	}
+	if len(req.WorldIds) > 0 {
+		worlds = slices.DeleteFunc(worlds, func(stats *v1.WorldMetaStats) bool {
+			return !slices.Contains(req.WorldIds, stats.WorldId)
+		})
+	}
+	return &v1.GetMetaStatsResponse{Worlds: worlds}, nil
+}
+
+func (s *FSIndexerService) LoadMetaStatsCursor(shard int32) (*v1.MetaStatsCursor, error) {
+	return loadRow[*v1.MetaStatsCursor](s.cursors, fmt.Sprint(shard))
+}
+
+func (s *FSIndexerService) SaveMetaStatsCursor(cursor *v1.MetaStatsCursor) error {
+	return s.cursors.SaveArtifact(fmt.Sprint(cursor.Shard), "metadata", cursor)
+}
+
+func (s *FSIndexerService) LoadGameMetaSummary(gameId string) (*v1.GameMetaSummary, error) {
+	return loadRow[*v1.GameMetaSummary](s.games, gameId)
+}
+
+func (s *FSIndexerService) SaveGameMetaSummary(summary *v1.GameMetaSummary) error {
+	return s.games.SaveArtifact(summary.GameId, "metadata", summary)
+}
+
+func (s *FSIndexerService) LoadWorldMetaStats(worldId string) (*v1.WorldMetaStats, error) {
+	return loadRow[*v1.WorldMetaStats](s.worlds, worldId)
+}
+
+func (s *FSIndexerService) SaveWorldMetaStats(stats *v1.WorldMetaStats) error {
+	return s.worlds.SaveArtifact(stats.WorldId, "metadata", stats)
+}
+
+func (s *FSIndexerService) ListWorldMetaStats() ([]*v1.WorldMetaStats, error) {
+	worlds, err := storage.ListFSEntities[*v1.WorldMetaStats](s.worlds, nil)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(worlds, func(a, b *v1.WorldMetaStats) int { return cmp.Compare(a.WorldId, b.WorldId) })
+	return worlds, nil
+}
+
+// loadRow loads a row of a table, or nil if there is no such row
+func loadRow[T proto.Message](table *storage.FileStorage, id string) (out T, err error) {
+	if exists, _ := table.EntityExists(id); !exists {
+		return
+	}
+	return storage.LoadFSArtifact[T](table, id, "metadata")
+}
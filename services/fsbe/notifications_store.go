@@ -0,0 +1,36 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"github.com/panyam/goutils/storage"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+var NOTIFICATIONS_STORAGE_DIR = ""
+
+// FSNotificationPrefsStore keeps users' notification preferences on the
+// file system, an entity per user
+type FSNotificationPrefsStore struct {
+	prefs *storage.FileStorage
+}
+
+// NewFSNotificationPrefsStore creates a new FSNotificationPrefsStore
+func NewFSNotificationPrefsStore(storageDir string) *FSNotificationPrefsStore {
+	if storageDir == "" {
+		if NOTIFICATIONS_STORAGE_DIR == "" {
+			NOTIFICATIONS_STORAGE_DIR = DevDataPath("storage/notification_preferences")
+		}
+		storageDir = NOTIFICATIONS_STORAGE_DIR
+	}
+	return &FSNotificationPrefsStore{prefs: storage.NewFileStorage(storageDir)}
+}
+
+func (s *FSNotificationPrefsStore) LoadNotificationPreferences(userId string) (*v1.NotificationPreferences, error) {
+	return loadRow[*v1.NotificationPreferences](s.prefs, userId)
+}
+
+func (s *FSNotificationPrefsStore) SaveNotificationPreferences(userId string, prefs *v1.NotificationPreferences) error {
+	return s.prefs.SaveArtifact(userId, "metadata", prefs)
+}
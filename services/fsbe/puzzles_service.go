@@ -0,0 +1,310 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/panyam/goutils/storage"
+	oagrpc "github.com/panyam/oneauth/grpc"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var PUZZLES_STORAGE_DIR = ""
+
+// FSPuzzlesService keeps puzzles, and the attempts made at each, on the
+// file system
+type FSPuzzlesService struct {
+	ClientMgr *services.ClientMgr
+	storage   *storage.FileStorage
+
+	// Guards the attempts artifacts
+	attemptsMu sync.Mutex
+}
+
+// NewFSPuzzlesService creates a new FSPuzzlesService
+func NewFSPuzzlesService(storageDir string, clientMgr *services.ClientMgr) *FSPuzzlesService {
+	if storageDir == "" {
+		if PUZZLES_STORAGE_DIR == "" {
+			PUZZLES_STORAGE_DIR = DevDataPath("storage/puzzles")
+		}
+		storageDir = PUZZLES_STORAGE_DIR
+	}
+	return &FSPuzzlesService{ClientMgr: clientMgr, storage: storage.NewFileStorage(storageDir)}
+}
+
+// CreatePuzzle creates a new puzzle owned by the caller
+func (s *FSPuzzlesService) CreatePuzzle(ctx context.Context, req *v1.CreatePuzzleRequest) (*v1.CreatePuzzleResponse, error) {
+	userId, err := authz.RequireAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Puzzle == nil {
+		return nil, fmt.Errorf("puzzle is required")
+	}
+	if err := s.validatePuzzle(ctx, req.Puzzle); err != nil {
+		return nil, err
+	}
+
+	puzzleId, err := s.storage.CreateEntity(req.Puzzle.Id)
+	if err != nil {
+		return nil, err
+	}
+	now := tspb.New(time.Now())
+	req.Puzzle.Id = puzzleId
+	req.Puzzle.CreatorId = userId
+	req.Puzzle.CreatedAt = now
+	req.Puzzle.UpdatedAt = now
+	if err := s.storage.SaveArtifact(puzzleId, "metadata", req.Puzzle); err != nil {
+		return nil, fmt.Errorf("failed to create puzzle: %w", err)
+	}
+	if err := s.storage.SaveArtifact(puzzleId, "attempts", &v1.PuzzleAttempts{}); err != nil {
+		return nil, fmt.Errorf("failed to create puzzle: %w", err)
+	}
+	return &v1.CreatePuzzleResponse{Puzzle: req.Puzzle}, nil
+}
+
+// validatePuzzle checks a puzzle's settings, and that its world has a seat
+// for the solver and the opponent
+func (s *FSPuzzlesService) validatePuzzle(ctx context.Context, puzzle *v1.Puzzle) error {
+	if puzzle.Name == "" {
+		return fmt.Errorf("puzzle name is required")
+	}
+	if err := lib.ValidatePuzzleSettings(puzzle.Settings); err != nil {
+		return fmt.Errorf("invalid puzzle: %w", err)
+	}
+	world, err := s.ClientMgr.GetWorldsSvcClient().GetWorld(ctx, &v1.GetWorldRequest{Id: puzzle.WorldId})
+	if err != nil {
+		return fmt.Errorf("Error loading world: %w", err)
+	}
+	players := lib.NewWorld("", world.WorldData).GetStartingPlayers()
+	if !slices.Contains(players, lib.PuzzleSolver(puzzle.Settings)) {
+		return fmt.Errorf("puzzle solver %d has no units or tiles in world %s", lib.PuzzleSolver(puzzle.Settings), puzzle.WorldId)
+	}
+	if len(players) < 2 {
+		return fmt.Errorf("world %s has no players for the puzzle's opponent", puzzle.WorldId)
+	}
+	return nil
+}
+
+// GetPuzzle returns a puzzle
+func (s *FSPuzzlesService) GetPuzzle(ctx context.Context, req *v1.GetPuzzleRequest) (*v1.GetPuzzleResponse, error) {
+	puzzle, err := s.loadPuzzle(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetPuzzleResponse{Puzzle: puzzle}, nil
+}
+
+func (s *FSPuzzlesService) loadPuzzle(id string) (*v1.Puzzle, error) {
+	if id == "" {
+		return nil, fmt.Errorf("puzzle ID is required")
+	}
+	puzzle, err := storage.LoadFSArtifact[*v1.Puzzle](s.storage, id, "metadata")
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, status.Errorf(codes.NotFound, "puzzle %s not found", id)
+		}
+		return nil, fmt.Errorf("failed to load puzzle: %w", err)
+	}
+	return puzzle, nil
+}
+
+// ListPuzzles returns all puzzles, optionally only those of one creator
+func (s *FSPuzzlesService) ListPuzzles(ctx context.Context, req *v1.ListPuzzlesRequest) (*v1.ListPuzzlesResponse, error) {
+	puzzles, err := storage.ListFSEntities[*v1.Puzzle](s.storage, func(puzzle *v1.Puzzle) bool {
+		return req.CreatorId == "" || puzzle.CreatorId == req.CreatorId
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(puzzles, func(a, b *v1.Puzzle) int { return cmp.Compare(a.Name, b.Name) })
+	return &v1.ListPuzzlesResponse{
+		Items:      puzzles,
+		Pagination: &v1.PaginationResponse{TotalResults: int32(len(puzzles))},
+	}, nil
+}
+
+// UpdatePuzzle updates a puzzle.
+// Authorization: Only the puzzle creator can update a puzzle.
+func (s *FSPuzzlesService) UpdatePuzzle(ctx context.Context, req *v1.UpdatePuzzleRequest) (*v1.UpdatePuzzleResponse, error) {
+	if req.Puzzle == nil {
+		return nil, fmt.Errorf("puzzle is required")
+	}
+	puzzle, err := s.loadPuzzle(req.Puzzle.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.RequireOwnership(ctx, puzzle.CreatorId); err != nil {
+		return nil, err
+	}
+
+	if req.Puzzle.Name != "" {
+		puzzle.Name = req.Puzzle.Name
+	}
+	if req.Puzzle.Description != "" {
+		puzzle.Description = req.Puzzle.Description
+	}
+	if req.Puzzle.Difficulty != "" {
+		puzzle.Difficulty = req.Puzzle.Difficulty
+	}
+	if req.Puzzle.WorldId != "" {
+		puzzle.WorldId = req.Puzzle.WorldId
+	}
+	if req.Puzzle.Settings != nil {
+		puzzle.Settings = req.Puzzle.Settings
+	}
+	if err := s.validatePuzzle(ctx, puzzle); err != nil {
+		return nil, err
+	}
+	puzzle.UpdatedAt = tspb.New(time.Now())
+	if err := s.storage.SaveArtifact(puzzle.Id, "metadata", puzzle); err != nil {
+		return nil, fmt.Errorf("failed to update puzzle: %w", err)
+	}
+	return &v1.UpdatePuzzleResponse{Puzzle: puzzle}, nil
+}
+
+// DeletePuzzle deletes a puzzle and its attempts. Games already started from
+// it can still be played out.
+// Authorization: Only the puzzle creator can delete a puzzle.
+func (s *FSPuzzlesService) DeletePuzzle(ctx context.Context, req *v1.DeletePuzzleRequest) (*v1.DeletePuzzleResponse, error) {
+	puzzle, err := s.loadPuzzle(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.RequireOwnership(ctx, puzzle.CreatorId); err != nil {
+		return nil, err
+	}
+	if err := s.storage.DeleteEntity(req.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete puzzle: %w", err)
+	}
+	return &v1.DeletePuzzleResponse{}, nil
+}
+
+// PlayPuzzle starts a new attempt at a puzzle in a new game with the caller
+// as the solver
+func (s *FSPuzzlesService) PlayPuzzle(ctx context.Context, req *v1.PlayPuzzleRequest) (*v1.PlayPuzzleResponse, error) {
+	userId, err := authz.RequireAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+	puzzle, err := s.loadPuzzle(req.PuzzleId)
+	if err != nil {
+		return nil, err
+	}
+	world, err := s.ClientMgr.GetWorldsSvcClient().GetWorld(ctx, &v1.GetWorldRequest{Id: puzzle.WorldId})
+	if err != nil {
+		return nil, fmt.Errorf("Error loading world: %w", err)
+	}
+
+	// The game is created on the caller's behalf
+	created, err := s.ClientMgr.GetGamesSvcClient().CreateGame(oagrpc.UserIDToOutgoingContext(ctx, userId), &v1.CreateGameRequest{
+		Game: services.PuzzleGame(puzzle, world.WorldData, userId),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start puzzle: %w", err)
+	}
+
+	attempt := &v1.PuzzleAttempt{
+		PuzzleId:  puzzle.Id,
+		GameId:    created.Game.Id,
+		UserId:    userId,
+		StartedAt: tspb.New(time.Now()),
+	}
+	// The opponent moving first may already have decided the puzzle
+	finishAttempt(attempt, created.GameState)
+
+	s.attemptsMu.Lock()
+	defer s.attemptsMu.Unlock()
+	attempts, err := storage.LoadFSArtifact[*v1.PuzzleAttempts](s.storage, puzzle.Id, "attempts")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load puzzle attempts: %w", err)
+	}
+	attempts.Items = append(attempts.Items, attempt)
+	if err := s.storage.SaveArtifact(puzzle.Id, "attempts", attempts); err != nil {
+		return nil, fmt.Errorf("failed to save puzzle attempt: %w", err)
+	}
+
+	return &v1.PlayPuzzleResponse{Game: created.Game, GameState: created.GameState, Attempt: attempt}, nil
+}
+
+// ListPuzzleAttempts returns the caller's attempts, newest first. Attempts
+// still in progress are brought up to date with their games first.
+func (s *FSPuzzlesService) ListPuzzleAttempts(ctx context.Context, req *v1.ListPuzzleAttemptsRequest) (*v1.ListPuzzleAttemptsResponse, error) {
+	userId, err := authz.RequireAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	puzzleIds := []string{req.PuzzleId}
+	if req.PuzzleId == "" {
+		puzzles, err := storage.ListFSEntities[*v1.Puzzle](s.storage, nil)
+		if err != nil {
+			return nil, err
+		}
+		puzzleIds = puzzleIds[:0]
+		for _, puzzle := range puzzles {
+			puzzleIds = append(puzzleIds, puzzle.Id)
+		}
+	}
+
+	resp := &v1.ListPuzzleAttemptsResponse{}
+	games := s.ClientMgr.GetGamesSvcClient()
+	s.attemptsMu.Lock()
+	defer s.attemptsMu.Unlock()
+	for _, puzzleId := range puzzleIds {
+		attempts, err := storage.LoadFSArtifact[*v1.PuzzleAttempts](s.storage, puzzleId, "attempts")
+		if err != nil {
+			return nil, fmt.Errorf("failed to load attempts of puzzle %s: %w", puzzleId, err)
+		}
+		updated := false
+		for _, attempt := range attempts.Items {
+			if attempt.UserId != userId {
+				continue
+			}
+			if attempt.Result == v1.PuzzleResult_PUZZLE_RESULT_UNSPECIFIED {
+				game, err := games.GetGame(oagrpc.UserIDToOutgoingContext(ctx, userId), &v1.GetGameRequest{Id: attempt.GameId})
+				if err == nil && finishAttempt(attempt, game.State) {
+					updated = true
+				}
+			}
+			resp.Items = append(resp.Items, proto.Clone(attempt).(*v1.PuzzleAttempt))
+		}
+		if updated {
+			if err := s.storage.SaveArtifact(puzzleId, "attempts", attempts); err != nil {
+				return nil, fmt.Errorf("failed to save attempts of puzzle %s: %w", puzzleId, err)
+			}
+		}
+	}
+
+	slices.SortFunc(resp.Items, func(a, b *v1.PuzzleAttempt) int {
+		return b.StartedAt.AsTime().Compare(a.StartedAt.AsTime())
+	})
+	return resp, nil
+}
+
+// finishAttempt records the result of an attempt whose game has decided the
+// puzzle, reporting whether it did
+func finishAttempt(attempt *v1.PuzzleAttempt, state *v1.GameState) bool {
+	if state.GetPuzzleResult() == v1.PuzzleResult_PUZZLE_RESULT_UNSPECIFIED {
+		return false
+	}
+	attempt.Result = state.PuzzleResult
+	attempt.FinishedAt = state.UpdatedAt
+	return true
+}
@@ -0,0 +1,30 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/panyam/goutils/storage"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// CountGamesByStatus implements services.GameStatusCounter - reads each
+// game's state file, without loading its history or runtime game
+func (s *FSGamesService) CountGamesByStatus(ctx context.Context) (map[v1.GameStatus]int, error) {
+	games, err := storage.ListFSEntities[*v1.Game](s.storage, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count games by status: %w", err)
+	}
+	counts := make(map[v1.GameStatus]int)
+	for _, game := range games {
+		state, err := storage.LoadFSArtifact[*v1.GameState](s.storage, game.Id, "state")
+		if err != nil {
+			continue
+		}
+		counts[state.Status]++
+	}
+	return counts, nil
+}
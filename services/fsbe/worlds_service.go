@@ -41,7 +41,9 @@ func NewFSWorldsService(storageDir string, clientMgr *services.ClientMgr) *FSWor
 	service.ClientMgr = clientMgr
 	service.Self = service
 	service.WorldDataUpdater = service // Implement WorldDataUpdater interface
+	service.WorldPurger = service
 	service.InitializeScreenshotIndexer()
+	service.StartWorldPurger(services.WorldPurgeInterval)
 	return service
 }
 
@@ -93,7 +95,11 @@ func (s *FSWorldsService) ListWorlds(ctx context.Context, req *v1.ListWorldsRequ
 			TotalResults: 0,
 		},
 	}
-	resp.Items, err = storage.ListFSEntities[*v1.World](s.storage, nil)
+	// Deleted worlds are only kept around for the games still using them, and
+	// random maps belong to their game
+	resp.Items, err = storage.ListFSEntities[*v1.World](s.storage, func(world *v1.World) bool {
+		return world.DeletedAt == nil && world.AttachedGameId == ""
+	})
 	resp.Pagination.TotalResults = int32(len(resp.Items))
 
 	// Populate screenshot URLs for all worlds
@@ -119,6 +125,9 @@ func (s *FSWorldsService) GetWorld(ctx context.Context, req *v1.GetWorldRequest)
 		}
 		return nil, fmt.Errorf("failed to load world metadata: %w", err)
 	}
+	if err := s.CheckAttachedWorld(ctx, world); err != nil {
+		return nil, err
+	}
 
 	// Populate screenshot URL if not set
 	if len(world.PreviewUrls) == 0 {
@@ -163,6 +172,14 @@ func (s *FSWorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRe
 		return nil, err
 	}
 
+	worldData, err := storage.LoadFSArtifact[*v1.WorldData](s.storage, req.World.Id, "data")
+	if err != nil {
+		return nil, fmt.Errorf("world not found: %w", err)
+	}
+
+	// Auto-migrate from old list-based format to new map-based format
+	lib.MigrateWorldData(worldData)
+
 	// Update metadata fields
 	if req.World.Name != "" {
 		world.Name = req.World.Name
@@ -179,19 +196,16 @@ func (s *FSWorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRe
 	if req.World.DefaultGameConfig != nil {
 		world.DefaultGameConfig = req.World.DefaultGameConfig
 	}
-	world.UpdatedAt = tspb.New(time.Now())
-
-	if err := s.storage.SaveArtifact(req.World.Id, "metadata", world); err != nil {
-		return nil, fmt.Errorf("failed to update world metadata: %w", err)
+	if req.World.Rating != nil {
+		if err := services.CheckWorldRating(req.World.Rating, worldData.Version); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		world.Rating = req.World.Rating
 	}
-
-	worldData, err := storage.LoadFSArtifact[*v1.WorldData](s.storage, req.World.Id, "data")
-	if err != nil {
-		return nil, fmt.Errorf("world not found: %w", err)
+	if req.World.RulesOverrides != nil {
+		world.RulesOverrides = req.World.RulesOverrides
 	}
-
-	// Auto-migrate from old list-based format to new map-based format
-	lib.MigrateWorldData(worldData)
+	world.UpdatedAt = tspb.New(time.Now())
 
 	// Update world data if provided
 	worldDataSaved := false
@@ -228,6 +242,14 @@ func (s *FSWorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRe
 		worldData = req.WorldData
 	}
 
+	// A rating only holds for the world data it was computed on
+	if worldDataSaved {
+		world.Rating = nil
+	}
+	if err := s.storage.SaveArtifact(req.World.Id, "metadata", world); err != nil {
+		return nil, fmt.Errorf("failed to update world metadata: %w", err)
+	}
+
 	if worldDataSaved {
 		if worldData.ScreenshotIndexInfo == nil {
 			worldData.ScreenshotIndexInfo = &v1.IndexInfo{}
@@ -258,7 +280,8 @@ func (s *FSWorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRe
 	return resp, nil
 }
 
-// DeleteWorld deletes a world
+// DeleteWorld soft deletes a world: it disappears from listings but games
+// played on it can still load it until the purger removes it.
 // Authorization: Only the world creator can delete a world.
 func (s *FSWorldsService) DeleteWorld(ctx context.Context, req *v1.DeleteWorldRequest) (resp *v1.DeleteWorldResponse, err error) {
 	if req.Id == "" {
@@ -276,10 +299,27 @@ func (s *FSWorldsService) DeleteWorld(ctx context.Context, req *v1.DeleteWorldRe
 		return nil, err
 	}
 
-	err = s.storage.DeleteEntity(req.Id)
+	world.DeletedAt = tspb.New(time.Now())
+	if err := s.storage.SaveArtifact(req.Id, "metadata", world); err != nil {
+		return nil, fmt.Errorf("failed to delete world: %w", err)
+	}
+	return &v1.DeleteWorldResponse{}, nil
+}
+
+// ListDeletedWorlds implements WorldPurger interface
+func (s *FSWorldsService) ListDeletedWorlds(ctx context.Context) (ids []string, err error) {
+	worlds, err := storage.ListFSEntities[*v1.World](s.storage, func(world *v1.World) bool {
+		return world.DeletedAt != nil
+	})
+	for _, world := range worlds {
+		ids = append(ids, world.Id)
+	}
+	return ids, err
+}
 
-	resp = &v1.DeleteWorldResponse{}
-	return resp, err
+// PurgeWorld implements WorldPurger interface
+func (s *FSWorldsService) PurgeWorld(ctx context.Context, id string) error {
+	return s.storage.DeleteEntity(id)
 }
 
 // CreateWorld creates a new world
@@ -315,6 +315,9 @@ func (s *GamesService) ListGames(ctx context.Context, req *v1.ListGamesRequest)
 
 	query := NamespacedQuery("Game", s.namespace).
 		Order("-updated_at")
+	if req.WorldId != "" {
+		query = query.FilterField("world_id", "=", req.WorldId)
+	}
 
 	var entities []*v1ds.GameDatastore
 	keys, err := s.client.GetAll(ctx, query, &entities)
@@ -340,11 +343,17 @@ func (s *GamesService) ListGames(ctx context.Context, req *v1.ListGamesRequest)
 			log.Printf("Warning: failed to convert game: %v", err)
 			continue
 		}
+		// Games saved before forks existed have no forked_from_game_id to
+		// filter on, so forks are left out here
+		if game.ForkedFromGameId != "" && !req.IncludeForks {
+			continue
+		}
 		if len(game.PreviewUrls) == 0 {
 			game.PreviewUrls = []string{fmt.Sprintf("/screenshots/games/%s/default.png", game.Id)}
 		}
 		resp.Items = append(resp.Items, game)
 	}
+	resp.Pagination.TotalResults = int32(len(resp.Items))
 
 	return resp, nil
 }
@@ -365,6 +374,9 @@ func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest
 	if err := s.ValidateCreateGameRequest(req.Game, world.WorldData); err != nil {
 		return nil, err
 	}
+	s.InheritWorldRulesOverrides(req.Game, world.World)
+	s.SeatSandboxCreator(ctx, req.Game)
+	s.InheritWorldOrientation(req.Game, world.World)
 
 	// Try to assign ID (custom or generated)
 	assignedId := NewID(ctx, s.client, s.namespace, "games", req.Game.Id)
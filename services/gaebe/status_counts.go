@@ -0,0 +1,32 @@
+//go:build !wasm
+// +build !wasm
+
+package gaebe
+
+import (
+	"context"
+	"fmt"
+
+	pb "cloud.google.com/go/datastore/apiv1/datastorepb"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// CountGamesByStatus implements services.GameStatusCounter - counts the game
+// states with a count aggregation per status
+func (s *GamesService) CountGamesByStatus(ctx context.Context) (map[v1.GameStatus]int, error) {
+	counts := make(map[v1.GameStatus]int)
+	for value := range v1.GameStatus_name {
+		query := NamespacedQuery("GameState", s.namespace).
+			FilterField("status", "=", int64(value)).
+			NewAggregationQuery().
+			WithCount("count")
+		result, err := s.client.RunAggregationQuery(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count games by status: %w", err)
+		}
+		if count, ok := result["count"].(*pb.Value); ok && count.GetIntegerValue() > 0 {
+			counts[v1.GameStatus(value)] = int(count.GetIntegerValue())
+		}
+	}
+	return counts, nil
+}
@@ -279,6 +279,20 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 		if req.World.Difficulty != "" {
 			worldDs.Difficulty = req.World.Difficulty
 		}
+		if req.World.Rating != nil {
+			if err := services.CheckWorldRating(req.World.Rating, worldDataDs.Version); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			if _, err := v1ds.WorldRatingToWorldRatingDatastore(req.World.Rating, &worldDs.Rating, nil); err != nil {
+				return err
+			}
+		}
+		if req.World.RulesOverrides != nil {
+			worldDs.RulesOverrides = v1ds.RulesOverridesDatastore{}
+			if _, err := v1ds.RulesOverridesToRulesOverridesDatastore(req.World.RulesOverrides, &worldDs.RulesOverrides, nil); err != nil {
+				return err
+			}
+		}
 		worldDs.UpdatedAt = time.Now()
 
 		// Update world data if provided
@@ -330,6 +344,11 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 			worldDataSaved = true
 		}
 
+		// A rating only holds for the world data it was computed on
+		if worldDataSaved {
+			worldDs.Rating = v1ds.WorldRatingDatastore{}
+		}
+
 		// Save world
 		if _, err := tx.Put(worldKey, &worldDs); err != nil {
 			return err
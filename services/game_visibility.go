@@ -0,0 +1,212 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Game Visibility
+// =============================================================================
+//
+// The games service returns a game's full state, which holds things not
+// every caller may see, eg the plans players have submitted in a
+// simultaneous turn, or moves spectators of a delayed game are held back
+// from.  ViewerGamesService wraps the service served to clients and cuts its
+// responses down to what the caller may see; the server's own calls go to
+// the wrapped service and see everything.
+
+// ViewerGamesService serves a games service to clients, hiding from each
+// caller what only other players may see and holding spectators of delayed
+// games back
+type ViewerGamesService struct {
+	v1s.GamesServiceServer
+	Clock lib.Clock // Clock for spectator delays; nil uses the system clock
+}
+
+// NewViewerGamesService wraps a games service for serving to clients
+func NewViewerGamesService(games v1s.GamesServiceServer) *ViewerGamesService {
+	return &ViewerGamesService{GamesServiceServer: games}
+}
+
+func (s *ViewerGamesService) now() time.Time {
+	if s.Clock == nil {
+		return time.Now()
+	}
+	return s.Clock.Now()
+}
+
+// GetGame returns the game with other players' turn plans hidden.
+// Spectators of a game with a spectator delay get it rewound to the start
+// of the turn its first held back moves were played in, with its history
+// cut off there.
+func (s *ViewerGamesService) GetGame(ctx context.Context, req *v1.GetGameRequest) (*v1.GetGameResponse, error) {
+	resp, err := s.GamesServiceServer.GetGame(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	seats := callerSeats(ctx, resp.Game)
+	visible, turn, player, held := s.spectatorCutoff(resp, seats)
+	if !held && !hasTurnPlans(resp.State) {
+		return resp, nil
+	}
+
+	resp = proto.Clone(resp).(*v1.GetGameResponse)
+	if held {
+		if resp.State, err = s.stateAt(ctx, resp.Game.Id, turn, player); err != nil {
+			return nil, err
+		}
+		resp.History.Groups = resp.History.Groups[:len(visible)]
+	}
+	redactTurnPlans(resp.State, seats)
+	return resp, nil
+}
+
+// GetGameState returns the game's state with other players' turn plans
+// hidden, rewound for spectators of a delayed game like GetGame
+func (s *ViewerGamesService) GetGameState(ctx context.Context, req *v1.GetGameStateRequest) (*v1.GetGameStateResponse, error) {
+	resp, err := s.GamesServiceServer.GetGameState(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	gameresp, err := s.GamesServiceServer.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	seats := callerSeats(ctx, gameresp.Game)
+	_, turn, player, held := s.spectatorCutoff(gameresp, seats)
+	if !held && !hasTurnPlans(resp.State) {
+		return resp, nil
+	}
+
+	resp = proto.Clone(resp).(*v1.GetGameStateResponse)
+	if held {
+		if resp.State, err = s.stateAt(ctx, req.GameId, turn, player); err != nil {
+			return nil, err
+		}
+		resp.StuckWarning = nil
+	}
+	redactTurnPlans(resp.State, seats)
+	return resp, nil
+}
+
+// GetStateAtTurn returns the game's past state with other players' turn
+// plans hidden.  Spectators of a delayed game cannot get turns past the ones
+// they are shown.
+func (s *ViewerGamesService) GetStateAtTurn(ctx context.Context, req *v1.GetStateAtTurnRequest) (*v1.GetStateAtTurnResponse, error) {
+	gameresp, err := s.GamesServiceServer.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	seats := callerSeats(ctx, gameresp.Game)
+	if _, turn, player, held := s.spectatorCutoff(gameresp, seats); held &&
+		(turn < req.Turn || (turn == req.Turn && player < req.Player)) {
+		return nil, status.Errorf(codes.OutOfRange, "turn %d of game %s is still held back from spectators", req.Turn, req.GameId)
+	}
+
+	resp, err := s.GamesServiceServer.GetStateAtTurn(ctx, req)
+	if err != nil || !hasTurnPlans(resp.State) {
+		return resp, err
+	}
+	resp = proto.Clone(resp).(*v1.GetStateAtTurnResponse)
+	redactTurnPlans(resp.State, seats)
+	return resp, nil
+}
+
+// ListMoves returns the game's move groups, stopping spectators of a
+// delayed game at the ones they are shown
+func (s *ViewerGamesService) ListMoves(ctx context.Context, req *v1.ListMovesRequest) (*v1.ListMovesResponse, error) {
+	gameresp, err := s.GamesServiceServer.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	visible, _, _, held := s.spectatorCutoff(gameresp, callerSeats(ctx, gameresp.Game))
+	if !held {
+		return s.GamesServiceServer.ListMoves(ctx, req)
+	}
+	if len(visible) == 0 {
+		return &v1.ListMovesResponse{}, nil
+	}
+	req = proto.Clone(req).(*v1.ListMovesRequest)
+	if last := visible[len(visible)-1].GroupNumber; req.ToGroup <= 0 || req.ToGroup > last {
+		req.ToGroup = last
+	}
+	return s.GamesServiceServer.ListMoves(ctx, req)
+}
+
+// GetStateDiff returns what changed in the game between two turns.
+// Spectators of a delayed game get diffs no further than the start of the
+// turn they are held back in.
+func (s *ViewerGamesService) GetStateDiff(ctx context.Context, req *v1.GetStateDiffRequest) (*v1.GetStateDiffResponse, error) {
+	gameresp, err := s.GamesServiceServer.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	if _, turn, _, held := s.spectatorCutoff(gameresp, callerSeats(ctx, gameresp.Game)); held && req.ToTurn > turn {
+		req = proto.Clone(req).(*v1.GetStateDiffRequest)
+		req.ToTurn = turn
+		req.FromTurn = min(req.FromTurn, turn)
+	}
+	return s.GamesServiceServer.GetStateDiff(ctx, req)
+}
+
+// spectatorCutoff returns how much of the game a caller holding seats may
+// see, as SpectatorCutoff does.  Players see their games live.
+func (s *ViewerGamesService) spectatorCutoff(game *v1.GetGameResponse, seats map[int32]bool) (visible []*v1.GameMoveGroup, turn, player int32, ok bool) {
+	delay := GameSpectatorDelay(game.Game)
+	if delay == nil || len(seats) > 0 {
+		return nil, 0, 0, false
+	}
+	return SpectatorCutoff(game.History, delay, s.now())
+}
+
+// stateAt returns the game's state at the start of player's turn in turn
+func (s *ViewerGamesService) stateAt(ctx context.Context, gameId string, turn, player int32) (*v1.GameState, error) {
+	resp, err := s.GamesServiceServer.GetStateAtTurn(ctx, &v1.GetStateAtTurnRequest{GameId: gameId, Turn: turn, Player: player})
+	if err != nil {
+		return nil, rpcError(fmt.Errorf("failed to rewind game %s for spectators: %w", gameId, err))
+	}
+	return resp.State, nil
+}
+
+// callerSeats returns the seats the caller holds in the game, none for
+// spectators and anonymous callers
+func callerSeats(ctx context.Context, game *v1.Game) map[int32]bool {
+	seats := map[int32]bool{}
+	userID := authz.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return seats
+	}
+	for _, player := range game.GetConfig().GetPlayers() {
+		if player.UserId == userID {
+			seats[player.PlayerId] = true
+		}
+	}
+	return seats
+}
+
+func hasTurnPlans(state *v1.GameState) bool {
+	return len(state.GetTurnPlans()) > 0
+}
+
+// redactTurnPlans strips the moves from the turn plans of seats the caller
+// does not hold, leaving only that those players have submitted a plan
+func redactTurnPlans(state *v1.GameState, seats map[int32]bool) {
+	for i, plan := range state.GetTurnPlans() {
+		if !seats[plan.Player] {
+			state.TurnPlans[i] = &v1.TurnPlan{Player: plan.Player}
+		}
+	}
+}
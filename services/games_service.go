@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
 	"time"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	lib "github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -47,6 +49,20 @@ type GamesService interface {
 	SimulateFix(context.Context, *v1.SimulateFixRequest) (*v1.SimulateFixResponse, error)
 	// Join a game as an open player slot
 	JoinGame(context.Context, *v1.JoinGameRequest) (*v1.JoinGameResponse, error)
+	// Pause or resume the game clock when time banks are enabled
+	SetClockPaused(context.Context, *v1.SetClockPausedRequest) (*v1.SetClockPausedResponse, error)
+	// Hand the rest of the current turn over to a teammate
+	DelegateTurn(context.Context, *v1.DelegateTurnRequest) (*v1.DelegateTurnResponse, error)
+	// Ban or pick a unit type during the pre-game draft
+	DraftUnit(context.Context, *v1.DraftUnitRequest) (*v1.DraftUnitResponse, error)
+	// End a stuck game as a draw
+	ClaimNoContactDraw(context.Context, *v1.ClaimNoContactDrawRequest) (*v1.ClaimNoContactDrawResponse, error)
+	// Everything that changed in a game between two turns
+	GetStateDiff(context.Context, *v1.GetStateDiffRequest) (*v1.GetStateDiffResponse, error)
+	// Copy a game into a private game where the caller plays every seat
+	ForkGame(context.Context, *v1.ForkGameRequest) (*v1.ForkGameResponse, error)
+	// A game's state at the start of a player's turn
+	GetStateAtTurn(context.Context, *v1.GetStateAtTurnRequest) (*v1.GetStateAtTurnResponse, error)
 	GetRuntimeGame(game *v1.Game, gameState *v1.GameState) (*lib.Game, error)
 
 	// SaveMoveGroup saves a move group atomically with the game state.
@@ -72,11 +88,16 @@ func (s *BaseGamesService) ListMoves(ctx context.Context, req *v1.ListMovesReque
 
 // ProcessMoves processes moves for an existing game.
 // It validates and applies moves, then delegates persistence to SaveMoveGroup.
-// Authorization: User must be a player in the game AND it must be their turn.
+// Authorization: User must be a player in the game AND it must be their turn
+// (or the current player must have delegated their turn to them).
 func (s *BaseGamesService) ProcessMoves(ctx context.Context, req *v1.ProcessMovesRequest) (resp *v1.ProcessMovesResponse, err error) {
 	if len(req.Moves) == 0 {
 		return nil, fmt.Errorf("at least one move is required")
 	}
+	apiVersion, err := clientApiVersion(req.ApiVersion)
+	if err != nil {
+		return nil, err
+	}
 
 	gameresp, err := s.Self.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
 	if err != nil || gameresp.Game == nil {
@@ -87,28 +108,143 @@ func (s *BaseGamesService) ProcessMoves(ctx context.Context, req *v1.ProcessMove
 	}
 
 	// Authorization: user must be a player in the game AND it must be their turn
-	if err := authz.CanSubmitMoves(ctx, gameresp.Game, gameresp.State.CurrentPlayer); err != nil {
+	state := gameresp.State
+	if state.PuzzleResult != v1.PuzzleResult_PUZZLE_RESULT_UNSPECIFIED {
+		return nil, fmt.Errorf("puzzle game %s has ended", req.GameId)
+	}
+	if err := checkEndTurnEpochs(req.Moves, state); err != nil {
+		return nil, err
+	}
+	// Simultaneous turns games take each player's plan whoever's turn it is
+	if lib.SimultaneousTurnsEnabled(gameresp.Game.GetConfig()) && state.Status != v1.GameStatus_GAME_STATUS_DRAFTING {
+		if err := authorizePlan(ctx, req, gameresp.Game); err != nil {
+			return nil, err
+		}
+		resp, err = s.commitMoves(ctx, req, gameresp)
+		if err != nil {
+			return nil, err
+		}
+		resp.ServerInfo = GetServerInfo()
+		return resp, nil
+	}
+
+	seat, err := authz.RequireTurnController(ctx, gameresp.Game, state.CurrentPlayer, state.DelegatedTo)
+	if err != nil {
+		return nil, err
+	}
+
+	upgradeProcessMovesRequest(req, apiVersion, state.CurrentPlayer)
+	if err := checkMovePlayers(req.Moves, state.CurrentPlayer); err != nil {
+		return nil, err
+	}
+
+	// Record moves a teammate makes on the current player's behalf
+	if seat != state.CurrentPlayer {
+		for _, move := range req.Moves {
+			move.Player = state.CurrentPlayer
+			move.SubmittedBy = seat
+		}
+	}
+
+	resp, err = s.commitMoves(ctx, req, gameresp)
+	if err != nil {
 		return nil, err
 	}
+	if !req.DryRun {
+		if err := s.PlayPuzzleOpponent(ctx, gameresp); err != nil {
+			return nil, err
+		}
+	}
+	resp.ServerInfo = GetServerInfo()
+	return resp, nil
+}
 
+// authorizePlan checks that a simultaneous turns game's moves are a single
+// plan submitted for a seat the user holds, recording the seat as the mover
+func authorizePlan(ctx context.Context, req *v1.ProcessMovesRequest, game *v1.Game) error {
+	if len(req.Moves) != 1 || req.Moves[0].GetSubmitPlan() == nil {
+		return fmt.Errorf("moves in a simultaneous turns game are submitted as a single plan")
+	}
+	seat, err := authz.RequireSeat(ctx, game, req.Moves[0].Player)
+	if err != nil {
+		return err
+	}
+	req.Moves[0].Player = seat
+	return nil
+}
+
+// commitMoves validates and applies already authorized moves to a loaded
+// game, then persists them as a new move group
+func (s *BaseGamesService) commitMoves(ctx context.Context, req *v1.ProcessMovesRequest, gameresp *v1.GetGameResponse) (resp *v1.ProcessMovesResponse, err error) {
 	// Get the runtime game corresponding to this game Id
 	rtGame, err := s.Self.GetRuntimeGame(gameresp.Game, gameresp.State)
 	if err != nil {
 		return nil, err
 	}
 
+	// Coach mode reviews the moves from the position before they were made
+	var coach *lib.CoachEvaluator
+	if req.Coach && !gameresp.Game.GetConfig().GetSettings().GetRated() {
+		coach = lib.NewCoachEvaluator(rtGame)
+	}
+
+	// Dry runs report what the moves would change from the current state
+	var before *v1.GameState
+	if req.DryRun {
+		before = proto.Clone(gameresp.State).(*v1.GameState)
+	}
+
 	// TRANSACTIONAL FIX: Create transaction snapshot for move processing
 	// ProcessMoves will operate on the snapshot, ApplyChangeResults will apply to original
 	originalWorld := rtGame.World
 	rtGame.World = originalWorld.Push() // Create transaction layer
 
-	// Validate and process moves in transaction layer
-	err = rtGame.ProcessMoves(req.Moves)
+	// Validate and process moves in transaction layer, reporting how they
+	// resolved if asked to
+	var resolutions []*v1.MoveResolution
+	if req.DebugResolve {
+		resolutions, err = rtGame.ProcessMovesResolved(req.Moves)
+		if err != nil && len(resolutions) > 0 {
+			err = fmt.Errorf("%w (%s)", err, lib.FormatMoveResolution(resolutions[len(resolutions)-1]))
+		}
+	} else {
+		err = rtGame.ProcessMoves(req.Moves)
+	}
+	if errors.Is(err, lib.ErrUnitCapReached) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp = &v1.ProcessMovesResponse{Moves: req.Moves, Resolutions: resolutions}
+	if coach != nil {
+		resp.Moves = coachedMoves(coach, req.Moves)
+	}
+
+	moveGroup, err := s.applyMoves(req.Moves, rtGame, gameresp)
 	if err != nil {
 		return nil, err
 	}
-	resp = &v1.ProcessMovesResponse{Moves: req.Moves}
 
+	// Skip persistence in dry run mode
+	if req.DryRun {
+		var changes []*v1.WorldChange
+		for _, move := range req.Moves {
+			changes = append(changes, move.Changes...)
+		}
+		resp.StateDiff = lib.DiffSpan(before, gameresp.State, changes)
+		return resp, nil
+	}
+
+	if err := s.saveMoves(ctx, req.GameId, gameresp.State, moveGroup); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// applyMoves applies moves the runtime game has processed to the game's
+// state, returning them as the game's next move group
+func (s *BaseGamesService) applyMoves(moves []*v1.GameMove, rtGame *lib.Game, gameresp *v1.GetGameResponse) (*v1.GameMoveGroup, error) {
 	// Increment group number for this batch
 	nextGroupNumber := gameresp.State.CurrentGroupNumber + 1
 
@@ -117,36 +253,77 @@ func (s *BaseGamesService) ProcessMoves(ctx context.Context, req *v1.ProcessMove
 	moveGroup := &v1.GameMoveGroup{
 		StartedAt:   timestamppb.New(startTime),
 		EndedAt:     timestamppb.New(startTime),
-		Moves:       req.Moves,
+		Moves:       moves,
 		GroupNumber: nextGroupNumber,
 	}
 
 	// Apply the changes to update gamestate
-	s.ApplyChangeResults(req.Moves, rtGame, gameresp.Game, gameresp.State)
+	if err := s.ApplyChangeResults(moves, rtGame, gameresp.Game, gameresp.State); err != nil {
+		return nil, err
+	}
 
 	// Update state with new group number (this is the "commit marker")
 	gameresp.State.CurrentGroupNumber = nextGroupNumber
+	gameresp.State.StateHash = lib.StateHash(gameresp.State, rtGame.RulesHash())
 
 	// Update the end time after processing is complete
 	moveGroup.EndedAt = timestamppb.New(time.Now())
+	return moveGroup, nil
+}
 
-	// Skip persistence in dry run mode
-	if req.DryRun {
-		return resp, nil
-	}
-
+// saveMoves persists a move group and broadcasts it to sync subscribers
+func (s *BaseGamesService) saveMoves(ctx context.Context, gameId string, state *v1.GameState, moveGroup *v1.GameMoveGroup) error {
 	// Delegate persistence to SaveMoveGroup - backend handles atomicity
-	err = s.Self.SaveMoveGroup(ctx, req.GameId, gameresp.State, moveGroup)
-	if err != nil {
-		return nil, fmt.Errorf("failed to save move group: %w", err)
+	if err := s.Self.SaveMoveGroup(ctx, gameId, state, moveGroup); err != nil {
+		return fmt.Errorf("failed to save move group: %w", err)
 	}
 
 	// Broadcast to sync subscribers (multiplayer)
 	if s.OnMovesSaved != nil {
-		s.OnMovesSaved(ctx, req.GameId, req.Moves, nextGroupNumber)
+		s.OnMovesSaved(ctx, gameId, moveGroup.Moves, moveGroup.GroupNumber)
 	}
+	return nil
+}
 
-	return resp, err
+// PlayPuzzleOpponent plays a puzzle game's opponent turns until it is the
+// solver's turn again or the game ends, saving each turn as its own move
+// group. Games that are not puzzles are left alone.
+func (s *BaseGamesService) PlayPuzzleOpponent(ctx context.Context, gameresp *v1.GetGameResponse) error {
+	puzzle := gameresp.Game.GetConfig().GetSettings().GetPuzzle()
+	if puzzle == nil {
+		return nil
+	}
+	for !gameresp.State.Finished && gameresp.State.CurrentPlayer != lib.PuzzleSolver(puzzle) {
+		rtGame, err := s.Self.GetRuntimeGame(gameresp.Game, gameresp.State)
+		if err != nil {
+			return err
+		}
+		rtGame.World = rtGame.World.Push()
+		moves, err := rtGame.PlayPuzzleOpponentTurn()
+		if err != nil {
+			return fmt.Errorf("puzzle opponent failed to play: %w", err)
+		}
+		moveGroup, err := s.applyMoves(moves, rtGame, gameresp)
+		if err != nil {
+			return err
+		}
+		if err := s.saveMoves(ctx, gameresp.Game.Id, gameresp.State, moveGroup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// coachedMoves returns copies of the moves with coach verdicts attached. The
+// verdicts are private to the acting player, so the moves that are saved and
+// broadcast are left without them.
+func coachedMoves(coach *lib.CoachEvaluator, moves []*v1.GameMove) []*v1.GameMove {
+	out := make([]*v1.GameMove, len(moves))
+	for i, move := range moves {
+		out[i] = proto.Clone(move).(*v1.GameMove)
+		out[i].CoachVerdict = coach.Evaluate(move)
+	}
+	return out
 }
 
 // GetOptionsAt returns all available options at a specific position
@@ -188,17 +365,8 @@ func (s *BaseGamesService) GetOptionsAt(ctx context.Context, req *v1.GetOptionsA
 		}
 	}
 
-	out, err = rtGame.GetOptionsAt(posLabel)
-	if err != nil {
-		return out, err
-	}
-
-	// Sort options for convenience
-	sort.Slice(out.Options, func(i, j int) bool {
-		return lib.GameOptionLess(out.Options[i], out.Options[j])
-	})
-
-	return
+	out, err = rtGame.GetOptionsAtContext(ctx, posLabel)
+	return out, rpcError(err)
 }
 
 func (b *BaseGamesService) ApplyChangeResults(changes []*v1.GameMove, rtGame *lib.Game, game *v1.Game, state *v1.GameState) error {
@@ -265,9 +433,13 @@ func (s *BaseGamesService) SimulateAttack(ctx context.Context, req *v1.SimulateA
 		WoundBonus:     req.WoundBonus,
 	}
 
-	attackerDist, err := rulesEngine.GenerateDamageDistribution(attackerCtx, int(numSims))
+	attackerDist, err := rulesEngine.GenerateDamageDistributionContext(ctx, attackerCtx, int(numSims))
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate attacker damage distribution: %w", err)
+		return nil, rpcError(fmt.Errorf("failed to generate attacker damage distribution: %w", err))
+	}
+	terrainDefense, err := rulesEngine.TerrainDefenseApplied(attackerCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate terrain defense: %w", err)
 	}
 
 	// Convert attacker distribution to map
@@ -295,7 +467,10 @@ func (s *BaseGamesService) SimulateAttack(ctx context.Context, req *v1.SimulateA
 		WoundBonus:     0, // No wound bonus for counter-attack
 	}
 
-	defenderDist, err := rulesEngine.GenerateDamageDistribution(defenderCtx, int(numSims))
+	defenderDist, err := rulesEngine.GenerateDamageDistributionContext(ctx, defenderCtx, int(numSims))
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, rpcError(ctxErr)
+	}
 	defenderDamageMap := make(map[int32]int32)
 	defenderMeanDamage := 0.0
 	defenderKillCount := int32(0)
@@ -318,6 +493,7 @@ func (s *BaseGamesService) SimulateAttack(ctx context.Context, req *v1.SimulateA
 	resp.DefenderMeanDamage = defenderMeanDamage
 	resp.AttackerKillProbability = float64(attackerKillCount) / float64(numSims)
 	resp.DefenderKillProbability = float64(defenderKillCount) / float64(numSims)
+	resp.TerrainDefenseApplied = terrainDefense
 
 	return resp, nil
 }
@@ -374,9 +550,9 @@ func (s *BaseGamesService) SimulateFix(ctx context.Context, req *v1.SimulateFixR
 	}
 
 	// Generate fix distribution
-	fixDist, err := rulesEngine.GenerateFixDistribution(fixCtx, int(numSims))
+	fixDist, err := rulesEngine.GenerateFixDistributionContext(ctx, fixCtx, int(numSims))
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate fix distribution: %w", err)
+		return nil, rpcError(fmt.Errorf("failed to generate fix distribution: %w", err))
 	}
 
 	// Convert distribution to map
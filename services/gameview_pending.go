@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Remote Changes During Input
+// =============================================================================
+//
+// Remote change sets that arrive while the player has a unit or tile selected
+// would clear the selection out from under them.  Instead they are queued and
+// applied, in the order they arrived, when the player completes or cancels
+// what they were doing.  Before an action is submitted on top of queued
+// changes it is checked again against the position they leave behind, and
+// cancelled with a notice if they made it impossible.
+
+// InputInProgress reports whether the player has a unit or tile selected and
+// is choosing what to do with it
+func (s *GameViewPresenter) InputInProgress() bool {
+	return s.selectedQ != nil && s.selectedR != nil
+}
+
+// PendingRemoteChanges is how many remote change sets are waiting for the
+// player to finish their input
+func (s *GameViewPresenter) PendingRemoteChanges() int {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	return len(s.pendingRemote)
+}
+
+func (s *GameViewPresenter) queueRemoteChanges(req *v1.ApplyRemoteChangesRequest) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	s.pendingRemote = append(s.pendingRemote, req)
+}
+
+// flushRemoteChanges applies the queued change sets in order and returns
+// whether there were any.  A change set that fails to apply drops the rest,
+// since they were made on top of it.
+func (s *GameViewPresenter) flushRemoteChanges(ctx context.Context) bool {
+	s.pendingMu.Lock()
+	pending := s.pendingRemote
+	s.pendingRemote = nil
+	s.pendingMu.Unlock()
+
+	for _, req := range pending {
+		if resp := s.applyRemoteChanges(ctx, req); !resp.Success {
+			s.showNotice(ctx, fmt.Sprintf("Could not apply another player's moves, reload the game: %s", resp.Error))
+			break
+		}
+	}
+	return len(pending) > 0
+}
+
+// resolvePendingRemote applies the change sets queued while the player chose
+// move and checks the move against the position they leave.  It returns the
+// move to submit, rebuilt from the fresh options, or nil once the player has
+// been told why it was cancelled.
+func (s *GameViewPresenter) resolvePendingRemote(ctx context.Context, gameId string, move *v1.GameMove) *v1.GameMove {
+	if s.PendingRemoteChanges() == 0 {
+		return move
+	}
+	selected := s.TurnOptionsPanel.CurrentUnit()
+	s.flushRemoteChanges(ctx)
+
+	fresh, reason := s.revalidateMove(ctx, gameId, move, selected)
+	if fresh == nil {
+		s.showNotice(ctx, "Action cancelled: "+reason)
+	}
+	return fresh
+}
+
+// revalidateMove finds move among the options in the current position, or
+// explains why it is no longer there
+func (s *GameViewPresenter) revalidateMove(ctx context.Context, gameId string, move *v1.GameMove, selected *v1.Unit) (*v1.GameMove, string) {
+	getGameResp, err := s.GamesService.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		return nil, err.Error()
+	}
+	if getGameResp.State.CurrentPlayer != move.Player {
+		return nil, "the turn has passed to another player"
+	}
+	rg, err := s.GamesService.GetRuntimeGame(getGameResp.Game, getGameResp.State)
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	var from, target *v1.Position
+	switch m := move.MoveType.(type) {
+	case *v1.GameMove_MoveUnit:
+		from, target = m.MoveUnit.From, m.MoveUnit.To
+	case *v1.GameMove_AttackUnit:
+		from, target = m.AttackUnit.Attacker, m.AttackUnit.Defender
+	case *v1.GameMove_CaptureBuilding:
+		from, target = m.CaptureBuilding.Pos, m.CaptureBuilding.Pos
+	case *v1.GameMove_BuildUnit:
+		from, target = m.BuildUnit.Pos, m.BuildUnit.Pos
+	default:
+		return move, ""
+	}
+	unit := rg.World.UnitAt(CoordFromInt32(from.Q, from.R))
+	targetUnit := rg.World.UnitAt(CoordFromInt32(target.Q, target.R))
+
+	if move.GetBuildUnit() != nil {
+		if unit != nil {
+			return nil, fmt.Sprintf("(%d, %d) is now occupied", target.Q, target.R)
+		}
+	} else if unit == nil || unit.Player != move.Player || (selected != nil && unit.Shortcut != selected.Shortcut) {
+		return nil, fmt.Sprintf("the selected unit at (%d, %d) was destroyed or moved", from.Q, from.R)
+	}
+	switch {
+	case move.GetMoveUnit() != nil && targetUnit != nil:
+		return nil, fmt.Sprintf("the destination (%d, %d) is now occupied", target.Q, target.R)
+	case move.GetAttackUnit() != nil && (targetUnit == nil || targetUnit.Player == move.Player):
+		return nil, fmt.Sprintf("the target at (%d, %d) is gone", target.Q, target.R)
+	}
+
+	optionsResp, err := s.GamesService.GetOptionsAt(ctx, &v1.GetOptionsAtRequest{
+		GameId: gameId,
+		Pos:    &v1.Position{Q: from.Q, R: from.R},
+	})
+	if err != nil {
+		return nil, err.Error()
+	}
+	for _, option := range optionsResp.Options {
+		if fresh := sameAction(option, move); fresh != nil {
+			if option.DisabledReason != "" {
+				return nil, option.DisabledReason
+			}
+			return fresh, ""
+		}
+	}
+	return nil, "it is no longer possible after another player's moves"
+}
+
+// sameAction returns option as a move when it is the same action as move
+func sameAction(option *v1.GameOption, move *v1.GameMove) *v1.GameMove {
+	samePos := func(a, b *v1.Position) bool { return a.Q == b.Q && a.R == b.R }
+	fresh := &v1.GameMove{Player: move.Player}
+	switch {
+	case option.GetMove() != nil && move.GetMoveUnit() != nil:
+		if !samePos(option.GetMove().To, move.GetMoveUnit().To) {
+			return nil
+		}
+		fresh.MoveType = &v1.GameMove_MoveUnit{MoveUnit: option.GetMove()}
+	case option.GetAttack() != nil && move.GetAttackUnit() != nil:
+		if !samePos(option.GetAttack().Defender, move.GetAttackUnit().Defender) {
+			return nil
+		}
+		fresh.MoveType = &v1.GameMove_AttackUnit{AttackUnit: option.GetAttack()}
+	case option.GetCapture() != nil && move.GetCaptureBuilding() != nil:
+		fresh.MoveType = &v1.GameMove_CaptureBuilding{CaptureBuilding: option.GetCapture()}
+	case option.GetBuild() != nil && move.GetBuildUnit() != nil:
+		if option.GetBuild().UnitType != move.GetBuildUnit().UnitType {
+			return nil
+		}
+		fresh.MoveType = &v1.GameMove_BuildUnit{BuildUnit: option.GetBuild()}
+	default:
+		return nil
+	}
+	return fresh
+}
+
+// showNotice tells the player something happened that they did not ask for
+func (s *GameViewPresenter) showNotice(ctx context.Context, message string) {
+	fmt.Printf("[Presenter] %s\n", message)
+	if s.GameViewerPage != nil {
+		go s.GameViewerPage.LogMessage(ctx, &v1.LogMessageRequest{Message: message})
+	}
+}
@@ -3,6 +3,9 @@ package services
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	lib "github.com/turnforge/lilbattle/lib"
@@ -18,6 +21,7 @@ type GameState interface {
 	SetGameState(context.Context, *v1.SetGameStateRequest) (*v1.SetGameStateResponse, error)
 	RemoveUnitAt(context.Context, *v1.RemoveUnitAtRequest) (*v1.RemoveUnitAtResponse, error)
 	SetUnitAt(context.Context, *v1.SetUnitAtRequest) (*v1.SetUnitAtResponse, error)
+	SetTileAt(context.Context, *v1.SetTileAtRequest) (*v1.SetTileAtResponse, error)
 	UpdateGameStatus(context.Context, *v1.UpdateGameStatusRequest) (*v1.UpdateGameStatusResponse, error)
 }
 
@@ -55,6 +59,10 @@ type BuildOptionsModal interface {
 type CompactSummaryCardPanel interface {
 	BasePanel
 	SetCurrentData(context.Context, *v1.Tile, *v1.Unit)
+	// Shows an attack's recap in place of the current data until it is
+	// hidden or new data is set
+	ShowBattleSummary(context.Context, *v1.CombatSummary)
+	HideBattleSummary(context.Context)
 }
 
 type GameStatePanel interface {
@@ -82,6 +90,9 @@ type GameScene interface {
 type GameViewerPageClient interface {
 	SetAllowedPanels(context.Context, *v1.SetAllowedPanelsRequest) (*v1.SetAllowedPanelsResponse, error)
 	SetCompactSummaryCard(context.Context, *v1.SetContentRequest) (*v1.SetContentResponse, error)
+	ShowCoachVerdict(context.Context, *v1.ShowCoachVerdictRequest) (*v1.ShowCoachVerdictResponse, error)
+	ShowTurnObligations(context.Context, *v1.ShowTurnObligationsRequest) (*v1.ShowTurnObligationsResponse, error)
+	LogMessage(context.Context, *v1.LogMessageRequest) (*v1.LogMessageResponse, error)
 }
 
 type BaseGameViewPresenter struct {
@@ -109,6 +120,28 @@ type BaseGameViewPresenter struct {
 
 type GameViewPresenter struct {
 	BaseGameViewPresenter
+
+	// Bumped on every ping so only the latest one clears the ping markers
+	pingGeneration atomic.Int64
+
+	// Bumped on every battle summary so only the latest one hides itself
+	battleSummaryGeneration atomic.Int64
+
+	// Whether the player turned on coach mode
+	coach bool
+
+	// Where offline games are saved, nil when they are not
+	SlotStore SlotStore
+
+	// Log how the server resolved each submitted move, set in dev builds
+	DebugResolve bool
+
+	// Bumped on every applied change set so only the latest one autosaves
+	autosaveGeneration atomic.Int64
+
+	// Remote change sets that arrived while the player was mid-input
+	pendingMu     sync.Mutex
+	pendingRemote []*v1.ApplyRemoteChangesRequest
 }
 
 // NOTE - ONly API really needed here are "getters" and "move processors" so no Creations, Deletions, Listing or even
@@ -118,7 +151,7 @@ func NewGameViewPresenter() *GameViewPresenter {
 	w := &GameViewPresenter{
 		BaseGameViewPresenter: BaseGameViewPresenter{
 			// WorldsService: WorldsService
-			RulesEngine: re.RulesEngine,
+			RulesEngine: re.Rules(),
 			Theme:       themes.NewDefaultTheme(re.GetCityTerrains()), // Start with default theme
 		},
 	}
@@ -180,6 +213,7 @@ func (s *GameViewPresenter) ClientReady(ctx context.Context, req *v1.ClientReady
 	}
 	game := getGameResp.Game
 	gameState := getGameResp.State
+	s.coach = req.Coach
 
 	// Now that the scene is ready, apply visual state
 	s.refreshExhaustedHighlights(ctx, game, gameState)
@@ -224,9 +258,20 @@ func (s *GameViewPresenter) SceneClicked(ctx context.Context, req *v1.SceneClick
 		if s.selectedQ != nil && s.selectedR != nil && *s.selectedQ == q && *s.selectedR == r {
 			s.clearHighlightsAndSelection(ctx)
 			s.TurnOptionsPanel.SetCurrentUnit(ctx, nil, nil)
+			s.flushRemoteChanges(ctx)
 			return
 		}
 
+		// Selecting another tile abandons the current selection, so catch up
+		// on the remote changes held back for it first
+		if s.flushRemoteChanges(ctx) {
+			getGameResp, _ = s.GetGame(ctx, req.GameId)
+			game, gameState = getGameResp.Game, getGameResp.State
+			if rg, err = s.GamesService.GetRuntimeGame(game, gameState); err != nil {
+				return resp, err
+			}
+		}
+
 		wd := rg.World
 		unit := wd.UnitAt(coord)
 		tile := wd.TileAt(coord)
@@ -270,7 +315,7 @@ func (s *GameViewPresenter) SceneClicked(ctx context.Context, req *v1.SceneClick
 		})
 		if err == nil && optionsResp != nil && len(optionsResp.Options) > 0 {
 			// Check if there are ONLY build options (no movement/attack options)
-			buildOptions := extractBuildOptions(optionsResp)
+			buildOptions, disabledReason := extractBuildOptions(optionsResp)
 			hasOnlyBuildOptions := len(buildOptions) > 0 && len(buildOptions) == len(optionsResp.Options)
 			fmt.Printf("[SceneClicked] Options count: %d, Build options count: %d, hasOnlyBuildOptions: %v\n",
 				len(optionsResp.Options), len(buildOptions), hasOnlyBuildOptions)
@@ -278,7 +323,10 @@ func (s *GameViewPresenter) SceneClicked(ctx context.Context, req *v1.SceneClick
 			// Always populate TurnOptionsPanel so CLI can access options
 			s.TurnOptionsPanel.SetCurrentUnit(ctx, unit, optionsResp)
 
-			if hasOnlyBuildOptions {
+			if len(buildOptions) == 0 && disabledReason != "" {
+				// Every build here is disabled, eg at the unit cap
+				s.showNotice(ctx, "Can't build here: "+disabledReason)
+			} else if hasOnlyBuildOptions {
 				// Show build modal for web UI
 				playerCoins := getPlayerCoins(gameState, gameState.CurrentPlayer)
 				fmt.Printf("[SceneClicked] Showing build modal with %d options, playerCoins=%d\n",
@@ -294,6 +342,9 @@ func (s *GameViewPresenter) SceneClicked(ctx context.Context, req *v1.SceneClick
 			} else {
 				// Send visualization commands to show highlights
 				highlights := buildHighlightSpecs(optionsResp, q, r)
+				if unit != nil {
+					highlights = append(highlights, attackRangeHighlights(rg, unit)...)
+				}
 				if len(highlights) > 0 {
 					s.GameScene.ShowHighlights(ctx, &v1.ShowHighlightsRequest{
 						Highlights: highlights,
@@ -307,6 +358,7 @@ func (s *GameViewPresenter) SceneClicked(ctx context.Context, req *v1.SceneClick
 			// No options available - clear options and highlights
 			s.TurnOptionsPanel.SetCurrentUnit(ctx, nil, nil)
 			s.clearHighlightsAndSelection(ctx)
+			s.flushRemoteChanges(ctx)
 		}
 	default:
 		fmt.Println("[GameViewerPage] Unhandled layer click: ", req.Layer)
@@ -320,7 +372,7 @@ func (s *GameViewPresenter) clearHighlightsAndSelection(ctx context.Context) {
 	if s.hasHighlights {
 		// Clear only interactive highlights, not exhausted
 		s.GameScene.ClearHighlights(ctx, &v1.ClearHighlightsRequest{
-			Types: []string{"selection", "movement", "attack", "build", "capture"},
+			Types: []string{"selection", "movement", "attack", "attack-range", "build", "capture"},
 		})
 		s.hasHighlights = false
 		s.selectedQ = nil
@@ -328,19 +380,25 @@ func (s *GameViewPresenter) clearHighlightsAndSelection(ctx context.Context) {
 	}
 }
 
-// extractBuildOptions extracts all build options from the response
-func extractBuildOptions(optionsResp *v1.GetOptionsAtResponse) []*v1.BuildUnitAction {
+// extractBuildOptions extracts the build options that can be taken from the
+// response, along with why any others can't be
+func extractBuildOptions(optionsResp *v1.GetOptionsAtResponse) (buildOptions []*v1.BuildUnitAction, disabledReason string) {
 	if optionsResp == nil {
-		return nil
+		return nil, ""
 	}
 
-	buildOptions := []*v1.BuildUnitAction{}
+	buildOptions = []*v1.BuildUnitAction{}
 	for _, option := range optionsResp.Options {
-		if buildOpt := option.GetBuild(); buildOpt != nil {
+		buildOpt := option.GetBuild()
+		switch {
+		case buildOpt == nil:
+		case option.DisabledReason != "":
+			disabledReason = option.DisabledReason
+		default:
 			buildOptions = append(buildOptions, buildOpt)
 		}
 	}
-	return buildOptions
+	return buildOptions, disabledReason
 }
 
 // getPlayerCoins returns the current player's coin count from game state
@@ -388,7 +446,7 @@ func buildHighlightSpecs(optionsResp *v1.GetOptionsAtResponse, selectedQ, select
 				Type:   "attack",
 				Action: &v1.HighlightSpec_Attack{Attack: attackOpt},
 			})
-		} else if buildOpt := option.GetBuild(); buildOpt != nil {
+		} else if buildOpt := option.GetBuild(); buildOpt != nil && option.DisabledReason == "" {
 			// Add build highlight
 			highlights = append(highlights, &v1.HighlightSpec{
 				Q:      buildOpt.Pos.Q,
@@ -410,6 +468,20 @@ func buildHighlightSpecs(optionsResp *v1.GetOptionsAtResponse, selectedQ, select
 	return highlights
 }
 
+// attackRangeHighlights shades the hexes a unit could attack from where it
+// stands, leaving out the hole inside an indirect unit's minimum range
+func attackRangeHighlights(rg *lib.Game, unit *v1.Unit) []*v1.HighlightSpec {
+	var highlights []*v1.HighlightSpec
+	for _, coord := range rg.GetAttackRangeTiles(unit) {
+		highlights = append(highlights, &v1.HighlightSpec{
+			Q:    int32(coord.Q),
+			R:    int32(coord.R),
+			Type: "attack-range",
+		})
+	}
+	return highlights
+}
+
 // TurnOptionClicked handles when user clicks on a turn option in the TurnOptionsPanel
 func (s *GameViewPresenter) TurnOptionClicked(ctx context.Context, req *v1.TurnOptionClickedRequest) (resp *v1.TurnOptionClickedResponse, err error) {
 	resp = &v1.TurnOptionClickedResponse{}
@@ -481,12 +553,12 @@ func (s *GameViewPresenter) executeCaptureFromOption(ctx context.Context, req *v
 			CaptureBuilding: captureOpt,
 		},
 	}
+	if gameMove = s.resolvePendingRemote(ctx, game.Id, gameMove); gameMove == nil {
+		return nil
+	}
 
 	// Process the move
-	resp, err := s.GamesService.ProcessMoves(ctx, &v1.ProcessMovesRequest{
-		GameId: game.Id,
-		Moves:  []*v1.GameMove{gameMove},
-	})
+	resp, err := s.processMoves(ctx, game.Id, gameMove)
 	if err != nil {
 		return err
 	}
@@ -532,9 +604,13 @@ func (s *GameViewPresenter) BuildOptionClicked(ctx context.Context, req *v1.Buil
 
 	// Execute the build move
 	game, gameState := getGameResp.Game, getGameResp.State
+	if gameMove = s.resolvePendingRemote(ctx, game.Id, gameMove); gameMove == nil {
+		s.BuildOptionsModal.Hide(ctx)
+		return
+	}
 
 	// Call ProcessMoves to execute the build
-	procesMovesResp, err := s.GamesService.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: game.Id, Moves: []*v1.GameMove{gameMove}})
+	procesMovesResp, err := s.processMoves(ctx, game.Id, gameMove)
 	if err != nil {
 		fmt.Printf("[Presenter] Build action failed: %v\n", err)
 		return nil, err
@@ -553,27 +629,43 @@ func (s *GameViewPresenter) BuildOptionClicked(ctx context.Context, req *v1.Buil
 // EndTurnButtonClicked handles when user clicks the end turn button
 func (s *GameViewPresenter) EndTurnButtonClicked(ctx context.Context, req *v1.EndTurnButtonClickedRequest) (resp *v1.EndTurnButtonClickedResponse, err error) {
 	resp = &v1.EndTurnButtonClickedResponse{}
+	s.flushRemoteChanges(ctx)
 
 	// Get current game state
 	getGameResp, err := s.GetGame(ctx, req.GameId)
 	game, gameState := getGameResp.Game, getGameResp.State
 
+	// Ask the player before leaving obligations behind
+	if !req.Force {
+		rtGame, err := s.GamesService.GetRuntimeGame(game, gameState)
+		if err != nil {
+			return nil, err
+		}
+		if obligations := rtGame.MandatoryActions(gameState.CurrentPlayer); len(obligations) > 0 {
+			go s.GameViewerPage.ShowTurnObligations(ctx, &v1.ShowTurnObligationsRequest{Obligations: obligations})
+			return resp, nil
+		}
+	}
+
 	fmt.Printf("[Presenter] Ending turn for player %d\n", gameState.CurrentPlayer)
 
 	// Create end turn move
 	gameMove := &v1.GameMove{
 		Player: gameState.CurrentPlayer,
 		MoveType: &v1.GameMove_EndTurn{
-			EndTurn: &v1.EndTurnAction{},
+			EndTurn: lib.NewEndTurnAction(gameState, req.Force),
 		},
 	}
 
 	// Call ProcessMoves to execute end turn
-	processMovesResp, err := s.GamesService.ProcessMoves(ctx, &v1.ProcessMovesRequest{
-		GameId: game.Id,
-		Moves:  []*v1.GameMove{gameMove},
-	})
+	processMovesResp, err := s.processMoves(ctx, game.Id, gameMove)
 
+	// The turn was already ended, eg by the time bank, and the new turn
+	// arrives with the remote changes
+	if IsTurnAlreadyEnded(err) {
+		fmt.Printf("[Presenter] Turn %d already ended: %v\n", gameState.TurnCounter, err)
+		return resp, nil
+	}
 	if err != nil {
 		fmt.Printf("[Presenter] End turn failed: %v\n", err)
 		return
@@ -638,9 +730,12 @@ func (s *GameViewPresenter) executeMovementAction(ctx context.Context, game *v1.
 	if gameMove == nil {
 		return fmt.Errorf("no valid move, attack, or capture option found for target position (%d,%d)", targetQ, targetR)
 	}
+	if gameMove = s.resolvePendingRemote(ctx, game.Id, gameMove); gameMove == nil {
+		return nil
+	}
 
 	// Call ProcessMoves to execute the move
-	resp, err := s.GamesService.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: game.Id, Moves: []*v1.GameMove{gameMove}})
+	resp, err := s.processMoves(ctx, game.Id, gameMove)
 	if err != nil {
 		return fmt.Errorf("move execution failed: %w", err)
 	}
@@ -653,6 +748,30 @@ func (s *GameViewPresenter) executeMovementAction(ctx context.Context, game *v1.
 	return nil
 }
 
+// processMoves submits the player's moves, asking for coach verdicts when
+// coach mode is on, and shows the player any move the coach flagged
+func (s *GameViewPresenter) processMoves(ctx context.Context, gameId string, moves ...*v1.GameMove) (*v1.ProcessMovesResponse, error) {
+	resp, err := s.GamesService.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+		GameId:       gameId,
+		Moves:        moves,
+		Coach:        s.coach,
+		ApiVersion:   ApiVersion,
+		DebugResolve: s.DebugResolve,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, resolution := range resp.Resolutions {
+		fmt.Printf("[Presenter] Resolved %s\n", lib.FormatMoveResolution(resolution))
+	}
+	for _, move := range resp.Moves {
+		if verdict := move.CoachVerdict; verdict.GetFlagged() && s.GameViewerPage != nil {
+			go s.GameViewerPage.ShowCoachVerdict(ctx, &v1.ShowCoachVerdictRequest{Verdict: verdict})
+		}
+	}
+	return resp, nil
+}
+
 // applyIncrementalChanges processes WorldChange objects and calls incremental browser update methods
 func (s *GameViewPresenter) applyIncrementalChanges(ctx context.Context, game *v1.Game, gameState *v1.GameState, moveResults []*v1.GameMove, gameMove *v1.GameMove) {
 	// Clear selection and highlights
@@ -791,6 +910,61 @@ func (s *GameViewPresenter) applyIncrementalChanges(ctx context.Context, game *v
 					// The game state will be refreshed below
 				}
 
+			case *v1.WorldChange_TerrainChanged:
+				// Terrain converted (or construction started/cancelled) - re-render the hex
+				updatedTile := changeType.TerrainChanged.UpdatedTile
+				if updatedTile != nil {
+					s.GameState.SetTileAt(ctx, &v1.SetTileAtRequest{
+						Q:    updatedTile.Q,
+						R:    updatedTile.R,
+						Tile: updatedTile,
+					})
+				}
+
+			case *v1.WorldChange_UnitSubmerged:
+				updatedUnit := changeType.UnitSubmerged.UpdatedUnit
+				if updatedUnit != nil {
+					s.GameScene.SetUnitAt(ctx, &v1.SetUnitAtRequest{
+						Q:    updatedUnit.Q,
+						R:    updatedUnit.R,
+						Unit: updatedUnit,
+					})
+				}
+
+			case *v1.WorldChange_UnitTransformed:
+				updatedUnit := changeType.UnitTransformed.UpdatedUnit
+				if updatedUnit != nil {
+					s.GameScene.SetUnitAt(ctx, &v1.SetUnitAtRequest{
+						Q:    updatedUnit.Q,
+						R:    updatedUnit.R,
+						Unit: updatedUnit,
+					})
+				}
+
+			case *v1.WorldChange_UnitDrafted:
+				// The players panel shows the draft once the game state is refreshed below
+				fmt.Printf("[Presenter] Player %d drafted unit type %d (pick: %v)\n",
+					changeType.UnitDrafted.PlayerId, changeType.UnitDrafted.UnitType, changeType.UnitDrafted.Pick)
+
+			case *v1.WorldChange_TurnDelegated:
+				// The players panel shows the delegate once the game state is refreshed below
+				fmt.Printf("[Presenter] Player %d delegated their turn to player %d\n",
+					changeType.TurnDelegated.PlayerId, changeType.TurnDelegated.DelegatePlayerId)
+
+			case *v1.WorldChange_GameEvent:
+				fmt.Printf("[Presenter] %s\n", changeType.GameEvent.Description)
+
+			case *v1.WorldChange_PlanSubmitted:
+				// The players panel shows who has locked in once the game state is refreshed below
+				s.showNotice(ctx, lib.FormatPlanSubmitted(changeType.PlanSubmitted))
+
+			case *v1.WorldChange_UnitAttacked:
+				s.showBattleSummary(ctx, changeType.UnitAttacked.Summary)
+
+			case *v1.WorldChange_VictoryPointsScored:
+				// The players panel shows the new total once the game state is refreshed below
+				s.showNotice(ctx, lib.FormatVictoryPointsScored(changeType.VictoryPointsScored))
+
 			default:
 				fmt.Printf("[Presenter] Unknown world change type: %T\n", changeType)
 			}
@@ -801,6 +975,7 @@ func (s *GameViewPresenter) applyIncrementalChanges(ctx context.Context, game *v
 	s.refreshExhaustedHighlights(ctx, game, gameState)
 	s.refreshCapturingHighlights(ctx, game, gameState)
 	s.GameStatePanel.Update(ctx, game, gameState)
+	s.scheduleAutosave(game.Id)
 }
 
 // refreshExhaustedHighlights updates the exhausted highlights for all units with no movement points
@@ -865,10 +1040,31 @@ func (s *GameViewPresenter) refreshCapturingHighlights(ctx context.Context, _ *v
 // ApplyRemoteChanges applies WorldChanges from remote players (received via SyncService).
 // Updates local game state and triggers UI updates.
 func (s *GameViewPresenter) ApplyRemoteChanges(ctx context.Context, req *v1.ApplyRemoteChangesRequest) (*v1.ApplyRemoteChangesResponse, error) {
+	if req.EncodedMoves != nil {
+		published, err := DecodeMovesPublished(req.EncodedMoves)
+		if err != nil {
+			return &v1.ApplyRemoteChangesResponse{
+				Success:        false,
+				Error:          fmt.Sprintf("failed to decode moves: %v", err),
+				RequiresReload: true,
+			}, nil
+		}
+		req.Moves = published.Moves
+	}
 	if len(req.Moves) == 0 {
 		return &v1.ApplyRemoteChangesResponse{Success: true}, nil
 	}
 
+	// Hold the changes back until the player finishes with their selection
+	if s.InputInProgress() {
+		s.queueRemoteChanges(req)
+		return &v1.ApplyRemoteChangesResponse{Success: true}, nil
+	}
+	return s.applyRemoteChanges(ctx, req), nil
+}
+
+// applyRemoteChanges applies decoded remote changes to the local game and the UI
+func (s *GameViewPresenter) applyRemoteChanges(ctx context.Context, req *v1.ApplyRemoteChangesRequest) *v1.ApplyRemoteChangesResponse {
 	// Load current game state
 	getGameResp, err := s.GamesService.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
 	if err != nil {
@@ -876,7 +1072,7 @@ func (s *GameViewPresenter) ApplyRemoteChanges(ctx context.Context, req *v1.Appl
 			Success:        false,
 			Error:          fmt.Sprintf("failed to load game state: %v", err),
 			RequiresReload: true,
-		}, nil
+		}
 	}
 
 	game, gameState := getGameResp.Game, getGameResp.State
@@ -890,7 +1086,7 @@ func (s *GameViewPresenter) ApplyRemoteChanges(ctx context.Context, req *v1.Appl
 			Success:        false,
 			Error:          fmt.Sprintf("failed to get runtime game: %v", err),
 			RequiresReload: true,
-		}, nil
+		}
 	}
 
 	// Apply changes to local state via lib (handles PlayerChanged, unit resets, etc.)
@@ -899,7 +1095,7 @@ func (s *GameViewPresenter) ApplyRemoteChanges(ctx context.Context, req *v1.Appl
 			Success:        false,
 			Error:          fmt.Sprintf("failed to apply changes: %v", err),
 			RequiresReload: true,
-		}, nil
+		}
 	}
 
 	// Apply UI updates for each move (gameState is now updated via lib)
@@ -913,5 +1109,209 @@ func (s *GameViewPresenter) ApplyRemoteChanges(ctx context.Context, req *v1.Appl
 		State: gameState,
 	})
 
-	return &v1.ApplyRemoteChangesResponse{Success: true}, nil
+	return &v1.ApplyRemoteChangesResponse{Success: true}
+}
+
+// PingDuration is how long a teammate's ping stays on the board
+const PingDuration = 5 * time.Second
+
+// ShowPing shows a teammate's ping (received via SyncService) as a transient
+// highlight. The markers clear themselves PingDuration after the latest ping.
+func (s *GameViewPresenter) ShowPing(ctx context.Context, req *v1.ShowPingRequest) (*v1.ShowPingResponse, error) {
+	ping := req.Ping
+	if ping == nil || ping.Pos == nil {
+		return &v1.ShowPingResponse{}, nil
+	}
+
+	s.GameScene.ShowHighlights(ctx, &v1.ShowHighlightsRequest{
+		Highlights: []*v1.HighlightSpec{{
+			Q:      ping.Pos.Q,
+			R:      ping.Pos.R,
+			Type:   "ping-" + ping.PingType,
+			Player: ping.PlayerId,
+		}},
+	})
+
+	generation := s.pingGeneration.Add(1)
+	time.AfterFunc(PingDuration, func() {
+		if s.pingGeneration.Load() != generation {
+			return
+		}
+		var types []string
+		for _, pingType := range PingTypes {
+			types = append(types, "ping-"+pingType)
+		}
+		s.GameScene.ClearHighlights(context.Background(), &v1.ClearHighlightsRequest{Types: types})
+	})
+	return &v1.ShowPingResponse{}, nil
+}
+
+// BattleSummaryDuration is how long an attack's recap stays on the summary
+// card
+const BattleSummaryDuration = 4 * time.Second
+
+// showBattleSummary adds an attack to the kill feed and shows its recap on
+// the summary card, until BattleSummaryDuration after the latest attack
+func (s *GameViewPresenter) showBattleSummary(ctx context.Context, summary *v1.CombatSummary) {
+	if summary == nil {
+		return
+	}
+	s.showNotice(ctx, lib.FormatCombatSummary(summary))
+	if s.CompactSummaryCardPanel == nil {
+		return
+	}
+	s.CompactSummaryCardPanel.ShowBattleSummary(ctx, summary)
+
+	generation := s.battleSummaryGeneration.Add(1)
+	time.AfterFunc(BattleSummaryDuration, func() {
+		if s.battleSummaryGeneration.Load() != generation {
+			return
+		}
+		s.CompactSummaryCardPanel.HideBattleSummary(context.Background())
+	})
+}
+
+// AutosaveDelay is how long after the last applied change set the game is
+// autosaved, so a burst of change sets is saved once
+const AutosaveDelay = 2 * time.Second
+
+// SaveSlot saves the current game into a named save slot
+func (s *GameViewPresenter) SaveSlot(ctx context.Context, req *v1.SaveSlotRequest) (*v1.SaveSlotResponse, error) {
+	if req.Slot == "" {
+		return nil, fmt.Errorf("a slot name is required")
+	}
+	info, err := s.saveSlot(ctx, req.Slot)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SaveSlotResponse{Slot: info}, nil
+}
+
+// LoadSlot replaces the current game with the one saved in a slot and shows
+// it. Corrupted slots fail with a *BundleValidationError.
+func (s *GameViewPresenter) LoadSlot(ctx context.Context, req *v1.LoadSlotRequest) (*v1.LoadSlotResponse, error) {
+	games, err := s.snapshotGamesService()
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.SlotStore.GetSlot(ctx, req.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read slot %q: %w", req.Slot, err)
+	}
+	bundle, err := games.ImportState(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.InitializeGame(ctx, &v1.InitializeGameRequest{GameId: bundle.Game.Id})
+	if err != nil {
+		return nil, err
+	}
+	s.refreshExhaustedHighlights(ctx, bundle.Game, bundle.State)
+	s.refreshCapturingHighlights(ctx, bundle.Game, bundle.State)
+	return &v1.LoadSlotResponse{Response: resp}, nil
+}
+
+// ListSlots lists the save slots with the game saved in each
+func (s *GameViewPresenter) ListSlots(ctx context.Context, req *v1.ListSlotsRequest) (*v1.ListSlotsResponse, error) {
+	if _, err := s.snapshotGamesService(); err != nil {
+		return nil, err
+	}
+	names, err := s.SlotStore.ListSlots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListSlotsResponse{}
+	for _, name := range names {
+		data, err := s.SlotStore.GetSlot(ctx, name)
+		if err != nil {
+			resp.Slots = append(resp.Slots, &v1.SaveSlotInfo{Name: name, Error: err.Error()})
+			continue
+		}
+		bundle, err := DecodeGameBundle(data)
+		if err != nil {
+			resp.Slots = append(resp.Slots, &v1.SaveSlotInfo{Name: name, Error: err.Error()})
+			continue
+		}
+		resp.Slots = append(resp.Slots, SaveSlotInfoFor(name, bundle))
+	}
+	return resp, nil
+}
+
+// ExploreFromHere forks the game at the move being viewed so other lines can
+// be played out from there, leaving the game itself as it is
+func (s *GameViewPresenter) ExploreFromHere(ctx context.Context, req *v1.ExploreFromHereRequest) (*v1.ExploreFromHereResponse, error) {
+	if req.GameId == "" {
+		return nil, fmt.Errorf("game ID is required")
+	}
+	if req.MoveIndex < 0 {
+		return nil, fmt.Errorf("move index %d is before the start of the game", req.MoveIndex)
+	}
+	resp, err := s.GamesService.ForkGame(ctx, &v1.ForkGameRequest{GameId: req.GameId, MoveIndex: req.MoveIndex})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ExploreFromHereResponse{Fork: resp.Game}, nil
+}
+
+func (s *GameViewPresenter) saveSlot(ctx context.Context, name string) (*v1.SaveSlotInfo, error) {
+	games, err := s.snapshotGamesService()
+	if err != nil {
+		return nil, err
+	}
+	data, err := games.ExportState()
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := DecodeGameBundle(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.SlotStore.PutSlot(ctx, name, data); err != nil {
+		return nil, fmt.Errorf("failed to write slot %q: %w", name, err)
+	}
+	return SaveSlotInfoFor(name, bundle), nil
+}
+
+func (s *GameViewPresenter) snapshotGamesService() (SnapshotGamesService, error) {
+	if s.SlotStore == nil {
+		return nil, fmt.Errorf("no save slot store is registered")
+	}
+	games, ok := s.GamesService.(SnapshotGamesService)
+	if !ok {
+		return nil, fmt.Errorf("games service %T does not support save slots", s.GamesService)
+	}
+	return games, nil
+}
+
+// scheduleAutosave saves the game into its autosave slot once no change set
+// has been applied for AutosaveDelay
+func (s *GameViewPresenter) scheduleAutosave(gameId string) {
+	if s.SlotStore == nil {
+		return
+	}
+	generation := s.autosaveGeneration.Add(1)
+	time.AfterFunc(AutosaveDelay, func() {
+		if s.autosaveGeneration.Load() != generation {
+			return
+		}
+		if _, err := s.saveSlot(context.Background(), AutosaveSlot(gameId)); err != nil {
+			fmt.Printf("[Presenter] Autosave failed: %v\n", err)
+		}
+	})
+}
+
+// TraceReach dumps the movement flood-fill for the unit at position, for
+// debugging why a unit can or cannot reach a hex.  It is not part of the
+// presenter RPCs; dev builds expose it to the browser's dev tools.
+func (s *GameViewPresenter) TraceReach(ctx context.Context, gameId string, position string) (*lib.ReachTrace, error) {
+	getGameResp, err := s.GamesService.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		return nil, err
+	}
+	rg, err := s.GamesService.GetRuntimeGame(getGameResp.Game, getGameResp.State)
+	if err != nil {
+		return nil, err
+	}
+	return rg.TraceReach(position)
 }
@@ -7,7 +7,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"time"
 
 	gfn "github.com/panyam/goutils/fn"
@@ -19,6 +18,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	tspb "google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GamesService implements the GamesService gRPC interface
@@ -37,6 +37,7 @@ func NewGamesService(db *gorm.DB, clientMgr *services.ClientMgr) *GamesService {
 	db.AutoMigrate(&v1gorm.GameGORM{})
 	db.AutoMigrate(&v1gorm.GameStateGORM{})
 	db.AutoMigrate(&v1gorm.GameMoveGORM{})
+	db.AutoMigrate(&GameTurnSnapshots{})
 
 	service := &GamesService{
 		storage:     db,
@@ -51,6 +52,7 @@ func NewGamesService(db *gorm.DB, clientMgr *services.ClientMgr) *GamesService {
 	service.Self = service
 	service.StorageProvider = service // GamesService implements GameStorageProvider
 	service.GameStateUpdater = service
+	service.TurnSnapshots = service
 	service.InitializeCache() // Enable caching (optional - can be disabled via CacheEnabled = false)
 	service.InitializeScreenshotIndexer()
 	service.InitializeSyncBroadcast()
@@ -91,33 +93,29 @@ func (s *GamesService) LoadGameState(ctx context.Context, id string) (*v1.GameSt
 // LoadGameHistory implements GameStorageProvider - loads game history directly from database
 func (s *GamesService) LoadGameHistory(ctx context.Context, id string) (*v1.GameMoveHistory, error) {
 	// Load moves and convert to history format
-	moves, err := s.GameMoveDAL.List(ctx, s.storage.Where("game_id = ?", id).Order("group_number asc").Order("timestamp asc"))
+	moves, err := s.GameMoveDAL.List(ctx, s.storage.Where("game_id = ?", id).Order("group_number asc").Order("move_number asc"))
 	if err != nil {
 		return nil, fmt.Errorf("failed to load moves: %w", err)
 	}
 
-	// Group moves into GameMoveHistory
+	// Group moves into GameMoveHistory, keeping the groups in the order
+	// the moves were listed in
 	history := &v1.GameMoveHistory{GameId: id}
-	groupMap := make(map[int64]*v1.GameMoveGroup)
+	var group *v1.GameMoveGroup
 
 	for _, moveGorm := range moves {
 		move, err := v1gorm.GameMoveFromGameMoveGORM(nil, moveGorm, nil)
 		if err != nil {
 			continue
 		}
-		groupNum := move.GroupNumber
-		if _, exists := groupMap[groupNum]; !exists {
-			groupMap[groupNum] = &v1.GameMoveGroup{
-				GroupNumber: groupNum,
+		if group == nil || group.GroupNumber != move.GroupNumber {
+			group = &v1.GameMoveGroup{
+				GroupNumber: move.GroupNumber,
 				Moves:       []*v1.GameMove{},
 			}
+			history.Groups = append(history.Groups, group)
 		}
-		groupMap[groupNum].Moves = append(groupMap[groupNum].Moves, move)
-	}
-
-	// Convert map to sorted slice
-	for _, group := range groupMap {
-		history.Groups = append(history.Groups, group)
+		group.Moves = append(group.Moves, move)
 	}
 
 	return history, nil
@@ -143,6 +141,37 @@ func (s *GamesService) SaveGameState(ctx context.Context, id string, state *v1.G
 	return s.GameStateDAL.Save(ctx, s.storage, stateGorm)
 }
 
+// CommitMoveGroup implements services.MoveGroupCommitter - saves a move group
+// and the state it leads to in one transaction.  The state row is locked and
+// must still be at the group before this one, so that of two servers racing
+// to commit moves to a game only the first succeeds.
+func (s *GamesService) CommitMoveGroup(ctx context.Context, gameId string, group *v1.GameMoveGroup, state *v1.GameState) error {
+	stateGorm, err := v1gorm.GameStateToGameStateGORM(state, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to convert game state: %w", err)
+	}
+	stateGorm.GameId = gameId
+
+	return s.storage.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current v1gorm.GameStateGORM
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("current_group_number").
+			Where("game_id = ?", gameId).
+			Take(&current).Error
+		if err != nil {
+			return fmt.Errorf("failed to lock game state: %w", err)
+		}
+		if current.CurrentGroupNumber != group.GroupNumber-1 {
+			return services.ErrStaleGameState
+		}
+
+		if err := s.saveMoves(tx, gameId, group, state.CurrentGroupNumber); err != nil {
+			return err
+		}
+		return s.GameStateDAL.Save(ctx, tx, stateGorm)
+	})
+}
+
 // SaveGameHistory implements GameStorageProvider - saves game history to database
 // For GORM backend, this saves individual moves (history is virtual, built from moves)
 func (s *GamesService) SaveGameHistory(ctx context.Context, id string, history *v1.GameMoveHistory) error {
@@ -153,18 +182,30 @@ func (s *GamesService) SaveGameHistory(ctx context.Context, id string, history *
 }
 
 // DeleteFromStorage implements GameStorageProvider - deletes game from database
+// The game, its state and its moves are deleted together or not at all
 func (s *GamesService) DeleteFromStorage(ctx context.Context, id string) error {
-	err := s.GameDAL.Delete(ctx, s.storage, id)
-	err = errors.Join(err, s.GameStateDAL.Delete(ctx, s.storage, id))
-	err = errors.Join(err, s.storage.Where("game_id = ?", id).Delete(&v1gorm.GameMoveGORM{}).Error)
-	return err
+	return s.storage.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := s.GameDAL.Delete(ctx, tx, id)
+		err = errors.Join(err, s.GameStateDAL.Delete(ctx, tx, id))
+		err = errors.Join(err, tx.Where("game_id = ?", id).Delete(&v1gorm.GameMoveGORM{}).Error)
+		err = errors.Join(err, tx.Where("game_id = ?", id).Delete(&GameTurnSnapshots{}).Error)
+		return err
+	})
 }
 
 // SaveMoves implements GameStorageProvider - saves moves as individual rows with orphan cleanup
+// The cleanup and the group's moves are saved in one transaction so a failed
+// save never leaves part of a group behind
 func (s *GamesService) SaveMoves(ctx context.Context, gameId string, group *v1.GameMoveGroup, currentGroupNumber int64) error {
+	return s.storage.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return s.saveMoves(tx, gameId, group, currentGroupNumber)
+	})
+}
+
+func (s *GamesService) saveMoves(tx *gorm.DB, gameId string, group *v1.GameMoveGroup, currentGroupNumber int64) error {
 	// Delete any orphan moves from previous failed ProcessMoves calls
 	// (moves with group_number > current_group_number are orphans)
-	if err := s.storage.Where("game_id = ? AND group_number > ?", gameId, currentGroupNumber-1).
+	if err := tx.Where("game_id = ? AND group_number > ?", gameId, currentGroupNumber-1).
 		Delete(&v1gorm.GameMoveGORM{}).Error; err != nil {
 		return fmt.Errorf("failed to delete orphan moves: %w", err)
 	}
@@ -190,7 +231,7 @@ func (s *GamesService) SaveMoves(ctx context.Context, gameId string, group *v1.G
 			return fmt.Errorf("failed to convert move %d: %w", i, err)
 		}
 
-		if err := s.storage.Create(moveGorm).Error; err != nil {
+		if err := tx.Create(moveGorm).Error; err != nil {
 			return fmt.Errorf("failed to save move %d: %w", i, err)
 		}
 	}
@@ -245,7 +286,14 @@ func (s *GamesService) ListGames(ctx context.Context, req *v1.ListGamesRequest)
 			TotalResults: 0,
 		},
 	}
-	games, err := s.GameDAL.List(ctx, s.storage.Order("updated_at desc").Order("name asc"))
+	query := s.storage.Order("updated_at desc").Order("name asc")
+	if req.WorldId != "" {
+		query = query.Where("world_id = ?", req.WorldId)
+	}
+	if !req.IncludeForks {
+		query = query.Where("COALESCE(forked_from_game_id, '') = ''")
+	}
+	games, err := s.GameDAL.List(ctx, query)
 	if err != nil {
 		return
 	}
@@ -268,16 +316,19 @@ func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest
 	defer span.End()
 	resp = &v1.CreateGameResponse{}
 
-	// Make sure world exists - for now we must be given a worldId to create game from
-	world, err := s.ClientMgr.GetWorldsSvcClient().GetWorld(ctx, &v1.GetWorldRequest{Id: req.Game.WorldId})
+	// Make sure world exists, or generate one for random map games
+	world, err := s.LoadCreateGameWorld(ctx, req.Game)
 	if err != nil {
-		return nil, fmt.Errorf("Error loading world: %w", err)
+		return nil, err
 	}
 
 	// Validate the request (duplicate players, players with units/tiles, etc.)
 	if err := s.ValidateCreateGameRequest(req.Game, world.WorldData); err != nil {
 		return nil, err
 	}
+	s.InheritWorldRulesOverrides(req.Game, world.World)
+	s.SeatSandboxCreator(ctx, req.Game)
+	s.InheritWorldOrientation(req.Game, world.World)
 
 	now := time.Now()
 	req.Game.CreatedAt = tspb.New(now)
@@ -301,10 +352,11 @@ func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest
 		return resp, nil
 	}
 	gameGorm.Id = assignedId
-	if err = s.GameDAL.Save(ctx, s.storage, gameGorm); err != nil {
+	req.Game.Id = assignedId
+	if err = s.AttachRandomMap(ctx, req.Game, world); err != nil {
 		return
 	}
-	resp.Game, err = v1gorm.GameFromGameGORM(nil, gameGorm, nil)
+	gameGorm.WorldId = req.Game.WorldId
 	// TODO - investigate why keys arent copied in protoc-gen-dal
 	req.Game.Id = gameGorm.Id
 
@@ -316,30 +368,48 @@ func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest
 		WorldData:     world.WorldData,
 	}
 
-	// Auto-migrate WorldData from old list-based format to new map-based format
-	lib.MigrateWorldData(gs.WorldData)
+	// Random map games wait with an empty board until their seats are filled
+	if !s.WaitForRandomMap(req.Game, gs) {
+		// Auto-migrate WorldData from old list-based format to new map-based format
+		lib.MigrateWorldData(gs.WorldData)
 
-	// Generate shortcuts for tiles and units
-	lib.EnsureShortcuts(gs.WorldData)
+		// Generate shortcuts for tiles and units
+		lib.EnsureShortcuts(gs.WorldData)
 
-	// Initialize player runtime state with starting coins + base income
-	s.InitializePlayerStates(gs, req.Game.Config)
+		// Initialize player runtime state with starting coins + base income
+		s.InitializePlayerStates(gs, req.Game.Config)
+	}
 
 	gameStateGorm, err := v1gorm.GameStateToGameStateGORM(gs, nil, nil)
 	if err != nil {
-		log.Println("Here 1 ????: ", err)
-		return
+		return nil, fmt.Errorf("failed to convert game state: %w", err)
 	}
 	gameStateGorm.GameId = gameGorm.Id
-	if err = s.GameStateDAL.Save(ctx, s.storage, gameStateGorm); err != nil {
-		log.Println("Here2 ????: ", err)
-		return
+
+	// Save the game and its state together so a failed state save does not
+	// leave a game that cannot be loaded
+	err = s.storage.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.GameDAL.Save(ctx, tx, gameGorm); err != nil {
+			return fmt.Errorf("failed to save game: %w", err)
+		}
+		if err := s.GameStateDAL.Save(ctx, tx, gameStateGorm); err != nil {
+			return fmt.Errorf("failed to save game state: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Units start with default zero values (current_turn=0, distance_left=0, available_health=0)
 	// They will be lazily topped-up when accessed if unit.current_turn < game.turn_counter
 	// This eliminates the need to initialize all units at game creation
 
+	// Puzzles where the opponent moves first start with its turn played
+	if err = s.PlayPuzzleOpponent(ctx, &v1.GetGameResponse{Game: req.Game, State: gs}); err != nil {
+		return nil, err
+	}
+
 	resp = &v1.CreateGameResponse{
 		Game:      req.Game,
 		GameState: gs,
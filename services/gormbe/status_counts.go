@@ -0,0 +1,33 @@
+//go:build !wasm
+// +build !wasm
+
+package gormbe
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1gorm "github.com/turnforge/lilbattle/gen/gorm"
+)
+
+// CountGamesByStatus implements services.GameStatusCounter - counts the game
+// states by status in one query
+func (s *GamesService) CountGamesByStatus(ctx context.Context) (map[v1.GameStatus]int, error) {
+	var rows []struct {
+		Status int32
+		Count  int
+	}
+	err := s.storage.WithContext(ctx).Model(&v1gorm.GameStateGORM{}).
+		Select("status, count(*) as count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count games by status: %w", err)
+	}
+	counts := make(map[v1.GameStatus]int, len(rows))
+	for _, row := range rows {
+		counts[v1.GameStatus(row.Status)] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,53 @@
+//go:build !wasm
+// +build !wasm
+
+package gormbe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// GameTurnSnapshots holds a game's turn snapshots.  The snapshots are only
+// ever loaded and saved together, so they are kept as one wire format blob
+// rather than a row each.
+type GameTurnSnapshots struct {
+	GameId    string `gorm:"primaryKey"`
+	Snapshots []byte
+	UpdatedAt time.Time
+}
+
+// LoadTurnSnapshots implements TurnSnapshotStore - loads a game's turn
+// snapshots from the database
+func (s *GamesService) LoadTurnSnapshots(ctx context.Context, gameId string) (*v1.TurnSnapshots, error) {
+	var row GameTurnSnapshots
+	err := s.storage.WithContext(ctx).Where("game_id = ?", gameId).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &v1.TurnSnapshots{GameId: gameId}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load turn snapshots: %w", err)
+	}
+	snapshots := &v1.TurnSnapshots{}
+	if err := proto.Unmarshal(row.Snapshots, snapshots); err != nil {
+		return nil, fmt.Errorf("failed to decode turn snapshots: %w", err)
+	}
+	return snapshots, nil
+}
+
+// SaveTurnSnapshots implements TurnSnapshotStore - saves a game's turn
+// snapshots to the database
+func (s *GamesService) SaveTurnSnapshots(ctx context.Context, gameId string, snapshots *v1.TurnSnapshots) error {
+	data, err := proto.Marshal(snapshots)
+	if err != nil {
+		return fmt.Errorf("failed to encode turn snapshots: %w", err)
+	}
+	row := &GameTurnSnapshots{GameId: gameId, Snapshots: data, UpdatedAt: time.Now()}
+	return s.storage.WithContext(ctx).Save(row).Error
+}
@@ -47,7 +47,9 @@ func NewWorldsService(db *gorm.DB, clientMgr *services.ClientMgr) *WorldsService
 	}
 	service.Self = service
 	service.WorldDataUpdater = service // Implement WorldDataUpdater interface
+	service.WorldPurger = service
 	service.InitializeScreenshotIndexer()
+	service.StartWorldPurger(services.WorldPurgeInterval)
 
 	return service
 }
@@ -153,7 +155,12 @@ func (s *WorldsService) ListWorlds(ctx context.Context, req *v1.ListWorldsReques
 			TotalResults: 0,
 		},
 	}
-	gormWorlds, err := s.WorldDAL.List(ctx, s.storage.Order("name asc"))
+	// Deleted worlds are only kept around for the games still using them, and
+	// random maps belong to their game
+	gormWorlds, err := s.WorldDAL.List(ctx, s.storage.
+		Where("deleted_at IS NULL OR deleted_at = ?", time.Time{}).
+		Where("attached_game_id IS NULL OR attached_game_id = ''").
+		Order("name asc"))
 	if err != nil {
 		return
 	}
@@ -206,6 +213,9 @@ func (s *WorldsService) GetWorld(ctx context.Context, req *v1.GetWorldRequest) (
 	if err != nil {
 		return
 	}
+	if err = s.CheckAttachedWorld(ctx, resp.World); err != nil {
+		return nil, err
+	}
 	resp.WorldData, err = v1gorm.WorldDataFromWorldDataGORM(nil, worldData, nil)
 	if err != nil {
 		return
@@ -247,6 +257,20 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 	if req.World.Difficulty != "" {
 		world.Difficulty = req.World.Difficulty
 	}
+	if req.World.Rating != nil {
+		if err = services.CheckWorldRating(req.World.Rating, worldData.Version); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if _, err = v1gorm.WorldRatingToWorldRatingGORM(req.World.Rating, &world.Rating, nil); err != nil {
+			return
+		}
+	}
+	if req.World.RulesOverrides != nil {
+		world.RulesOverrides = v1gorm.RulesOverridesGORM{}
+		if _, err = v1gorm.RulesOverridesToRulesOverridesGORM(req.World.RulesOverrides, &world.RulesOverrides, nil); err != nil {
+			return
+		}
+	}
 	world.UpdatedAt = time.Now()
 
 	// Update world data if provided
@@ -292,6 +316,11 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 		worldData.WorldId = req.World.Id
 	}
 
+	// A rating only holds for the world data it was computed on
+	if worldDataSaved {
+		world.Rating = v1gorm.WorldRatingGORM{}
+	}
+
 	err = s.WorldDAL.Save(ctx, s.storage, world)
 	if err != nil {
 		return
@@ -325,12 +354,29 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 	return resp, err
 }
 
-// DeleteWorld deletes a world
+// DeleteWorld soft deletes a world: it disappears from listings but games
+// played on it can still load it until the purger removes it.
 func (s *WorldsService) DeleteWorld(ctx context.Context, req *v1.DeleteWorldRequest) (resp *v1.DeleteWorldResponse, err error) {
-	err = s.WorldDAL.Delete(ctx, s.storage, req.Id)
-	err = errors.Join(err, s.WorldDataDAL.Delete(ctx, s.storage, req.Id))
-	resp = &v1.DeleteWorldResponse{}
-	return resp, err
+	result := s.storage.Model(&v1gorm.WorldGORM{}).Where("id = ?", req.Id).Update("deleted_at", time.Now())
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to delete world: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("World with id '%s' not found", req.Id))
+	}
+	return &v1.DeleteWorldResponse{}, nil
+}
+
+// ListDeletedWorlds implements WorldPurger interface
+func (s *WorldsService) ListDeletedWorlds(ctx context.Context) (ids []string, err error) {
+	err = s.storage.Model(&v1gorm.WorldGORM{}).Where("deleted_at > ?", time.Time{}).Pluck("id", &ids).Error
+	return
+}
+
+// PurgeWorld implements WorldPurger interface
+func (s *WorldsService) PurgeWorld(ctx context.Context, id string) (err error) {
+	err = s.WorldDAL.Delete(ctx, s.storage, id)
+	return errors.Join(err, s.WorldDataDAL.Delete(ctx, s.storage, id))
 }
 
 func (s *WorldsService) getWorldAndData(ctx context.Context, worldId string) (world *v1gorm.WorldGORM, worldData *v1gorm.WorldDataGORM, err error) {
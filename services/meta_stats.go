@@ -0,0 +1,327 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"maps"
+	"slices"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"github.com/turnforge/lilbattle/lib"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MetaStatsStore is where the indexer keeps the meta statistics it
+// aggregates. Loads return nil when there is nothing saved yet.
+type MetaStatsStore interface {
+	LoadMetaStatsCursor(shard int32) (*v1.MetaStatsCursor, error)
+	SaveMetaStatsCursor(cursor *v1.MetaStatsCursor) error
+	LoadGameMetaSummary(gameId string) (*v1.GameMetaSummary, error)
+	SaveGameMetaSummary(summary *v1.GameMetaSummary) error
+	LoadWorldMetaStats(worldId string) (*v1.WorldMetaStats, error)
+	SaveWorldMetaStats(stats *v1.WorldMetaStats) error
+	ListWorldMetaStats() ([]*v1.WorldMetaStats, error)
+}
+
+// MetaStatsAggregator scans finished games and aggregates their unit builds,
+// captures and lengths into per world statistics.
+//
+// Games are split into shards by id and each shard keeps a cursor of how far
+// it has got, so a run only loads the games created since the last one plus
+// the ones the cursor passed while they were still being played. Each game's
+// contribution is saved as well, so
+// aggregating a game again replaces what it added instead of counting it
+// twice.
+type MetaStatsAggregator struct {
+	Games  v1s.GamesServiceClient
+	Store  MetaStatsStore
+	Shards int32
+}
+
+// Run aggregates the finished games not yet aggregated, returning how many
+// games were aggregated
+func (a *MetaStatsAggregator) Run(ctx context.Context) (int, error) {
+	resp, err := a.Games.ListGames(ctx, &v1.ListGamesRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list games: %w", err)
+	}
+	shards := make([][]*v1.Game, a.shardCount())
+	for _, game := range resp.Items {
+		shard := metaStatsShard(game.Id, a.shardCount())
+		shards[shard] = append(shards[shard], game)
+	}
+
+	aggregated := 0
+	for shard, games := range shards {
+		count, err := a.runShard(ctx, int32(shard), games)
+		aggregated += count
+		if err != nil {
+			return aggregated, err
+		}
+	}
+	return aggregated, nil
+}
+
+// Loop runs the aggregator every interval until the context is done
+func (a *MetaStatsAggregator) Loop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if count, err := a.Run(ctx); err != nil {
+			log.Printf("Meta stats aggregation failed: %v", err)
+		} else if count > 0 {
+			log.Printf("Aggregated meta stats of %d games", count)
+		}
+	}
+}
+
+func (a *MetaStatsAggregator) shardCount() int32 {
+	return max(a.Shards, 1)
+}
+
+func metaStatsShard(gameId string, shards int32) int32 {
+	h := fnv.New32a()
+	h.Write([]byte(gameId))
+	return int32(h.Sum32() % uint32(shards))
+}
+
+// compareGameOrder orders games by when they were created, then by id
+func compareGameOrder(createdAt *tspb.Timestamp, id string, otherCreatedAt *tspb.Timestamp, otherId string) int {
+	return cmp.Or(createdAt.AsTime().Compare(otherCreatedAt.AsTime()), cmp.Compare(id, otherId))
+}
+
+// runShard aggregates the finished games of a shard after its cursor, and
+// the pending ones that have finished since. The cursor moves past every
+// game it reaches, keeping the ones still being played as pending so they
+// are the only ones looked at again on the next run.
+func (a *MetaStatsAggregator) runShard(ctx context.Context, shard int32, games []*v1.Game) (int, error) {
+	cursor, err := a.Store.LoadMetaStatsCursor(shard)
+	if err != nil {
+		return 0, err
+	}
+	if cursor == nil {
+		cursor = &v1.MetaStatsCursor{Shard: shard}
+	}
+	slices.SortFunc(games, func(x, y *v1.Game) int {
+		return compareGameOrder(x.CreatedAt, x.Id, y.CreatedAt, y.Id)
+	})
+	pending := map[string]bool{}
+	for _, id := range cursor.PendingGameIds {
+		pending[id] = true
+	}
+	saveCursor := func() error {
+		cursor.PendingGameIds = slices.Sorted(maps.Keys(pending))
+		return a.Store.SaveMetaStatsCursor(cursor)
+	}
+
+	aggregated := 0
+	listed := map[string]bool{}
+	for _, game := range games {
+		listed[game.Id] = true
+		after := cursor.LastGameId == "" || compareGameOrder(game.CreatedAt, game.Id, cursor.LastCreatedAt, cursor.LastGameId) > 0
+		if !after && !pending[game.Id] {
+			continue
+		}
+		resp, err := a.Games.GetGame(ctx, &v1.GetGameRequest{Id: game.Id})
+		if err != nil {
+			return aggregated, fmt.Errorf("failed to load game %s: %w", game.Id, err)
+		}
+		// Sandbox games are practice and never count towards the meta
+		sandbox := lib.SandboxEnabled(resp.Game.GetConfig())
+		finished := resp.State.GetFinished() || resp.State.GetStatus() == v1.GameStatus_GAME_STATUS_ENDED
+		if !sandbox && finished {
+			if err := a.aggregateGame(SummarizeFinishedGame(resp.Game, resp.State, resp.History)); err != nil {
+				return aggregated, err
+			}
+			aggregated++
+		}
+		if sandbox || finished {
+			delete(pending, game.Id)
+		} else {
+			pending[game.Id] = true
+		}
+		if after {
+			cursor.LastGameId = game.Id
+			cursor.LastCreatedAt = game.CreatedAt
+		}
+		if err := saveCursor(); err != nil {
+			return aggregated, err
+		}
+	}
+
+	// Games deleted while they were pending will never finish
+	dropped := false
+	for id := range pending {
+		if !listed[id] {
+			delete(pending, id)
+			dropped = true
+		}
+	}
+	if dropped {
+		return aggregated, saveCursor()
+	}
+	return aggregated, nil
+}
+
+// aggregateGame adds a game's summary to its world's statistics, replacing
+// the summary aggregated for the game before if there is one
+func (a *MetaStatsAggregator) aggregateGame(summary *v1.GameMetaSummary) error {
+	previous, err := a.Store.LoadGameMetaSummary(summary.GameId)
+	if err != nil {
+		return err
+	}
+	if previous != nil && previous.WorldId != summary.WorldId {
+		if err := a.addToWorld(previous.WorldId, previous, -1); err != nil {
+			return err
+		}
+		previous = nil
+	}
+
+	stats, err := a.Store.LoadWorldMetaStats(summary.WorldId)
+	if err != nil {
+		return err
+	}
+	if stats == nil {
+		stats = &v1.WorldMetaStats{WorldId: summary.WorldId}
+	}
+	if previous != nil {
+		AddGameMetaSummary(stats, previous, -1)
+	}
+	AddGameMetaSummary(stats, summary, 1)
+	if err := a.Store.SaveWorldMetaStats(stats); err != nil {
+		return err
+	}
+	return a.Store.SaveGameMetaSummary(summary)
+}
+
+func (a *MetaStatsAggregator) addToWorld(worldId string, summary *v1.GameMetaSummary, sign int32) error {
+	stats, err := a.Store.LoadWorldMetaStats(worldId)
+	if err != nil || stats == nil {
+		return err
+	}
+	AddGameMetaSummary(stats, summary, sign)
+	return a.Store.SaveWorldMetaStats(stats)
+}
+
+// SummarizeFinishedGame works out what a finished game contributes to its
+// world's statistics from its move history
+func SummarizeFinishedGame(game *v1.Game, state *v1.GameState, history *v1.GameMoveHistory) *v1.GameMetaSummary {
+	summary := &v1.GameMetaSummary{
+		GameId:   game.Id,
+		WorldId:  game.WorldId,
+		Turns:    state.TurnCounter,
+		Captures: map[int32]int32{},
+	}
+	seats := map[int32]*v1.SeatMetaSummary{}
+	for _, player := range game.GetConfig().GetPlayers() {
+		won := player.PlayerId == state.WinningPlayer
+		if state.WinningTeam != 0 {
+			won = player.TeamId == state.WinningTeam
+		}
+		seat := &v1.SeatMetaSummary{Player: player.PlayerId, Won: won, UnitsBuilt: map[int32]int32{}}
+		seats[player.PlayerId] = seat
+		summary.Seats = append(summary.Seats, seat)
+	}
+
+	for _, group := range history.GetGroups() {
+		for _, move := range group.Moves {
+			for _, change := range move.Changes {
+				if built := change.GetUnitBuilt(); built != nil {
+					if seat := seats[built.Unit.GetPlayer()]; seat != nil {
+						seat.UnitsBuilt[built.Unit.UnitType]++
+					}
+				}
+				if captured := change.GetTileCaptured(); captured != nil {
+					summary.Captures[captured.TileType]++
+				}
+			}
+		}
+	}
+	return summary
+}
+
+// AddGameMetaSummary adds (sign 1) or removes (sign -1) a game's summary
+// from its world's statistics and updates the derived rates
+func AddGameMetaSummary(stats *v1.WorldMetaStats, summary *v1.GameMetaSummary, sign int32) {
+	stats.Games += sign
+	stats.TotalTurns += sign * summary.Turns
+
+	units := map[int32]*v1.UnitMetaStats{}
+	for _, unit := range stats.Units {
+		units[unit.UnitType] = unit
+	}
+	for _, seat := range summary.Seats {
+		for unitType, count := range seat.UnitsBuilt {
+			unit := units[unitType]
+			if unit == nil {
+				unit = &v1.UnitMetaStats{UnitType: unitType}
+				units[unitType] = unit
+			}
+			unit.UnitsBuilt += sign * count
+			unit.Builders += sign
+			if seat.Won {
+				unit.BuilderWins += sign
+			}
+		}
+	}
+
+	terrains := map[int32]*v1.TerrainMetaStats{}
+	for _, terrain := range stats.Terrains {
+		terrains[terrain.TileType] = terrain
+	}
+	for tileType, count := range summary.Captures {
+		terrain := terrains[tileType]
+		if terrain == nil {
+			terrain = &v1.TerrainMetaStats{TileType: tileType}
+			terrains[tileType] = terrain
+		}
+		terrain.Captures += sign * count
+	}
+
+	stats.Units = stats.Units[:0]
+	for _, unit := range units {
+		if unit.Builders > 0 {
+			stats.Units = append(stats.Units, unit)
+		}
+	}
+	slices.SortFunc(stats.Units, func(x, y *v1.UnitMetaStats) int { return cmp.Compare(x.UnitType, y.UnitType) })
+	stats.Terrains = stats.Terrains[:0]
+	for _, terrain := range terrains {
+		if terrain.Captures > 0 {
+			stats.Terrains = append(stats.Terrains, terrain)
+		}
+	}
+	slices.SortFunc(stats.Terrains, func(x, y *v1.TerrainMetaStats) int { return cmp.Compare(x.TileType, y.TileType) })
+
+	stats.AverageGameLength = 0
+	for _, unit := range stats.Units {
+		unit.BuildRate = 0
+		unit.WinRateWhenBuilt = float64(unit.BuilderWins) / float64(unit.Builders)
+	}
+	for _, terrain := range stats.Terrains {
+		terrain.CaptureRate = 0
+	}
+	if stats.Games > 0 {
+		stats.AverageGameLength = float64(stats.TotalTurns) / float64(stats.Games)
+		for _, unit := range stats.Units {
+			unit.BuildRate = float64(unit.UnitsBuilt) / float64(stats.Games)
+		}
+		for _, terrain := range stats.Terrains {
+			terrain.CaptureRate = float64(terrain.Captures) / float64(stats.Games)
+		}
+	}
+	stats.UpdatedAt = tspb.Now()
+}
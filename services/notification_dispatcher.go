@@ -0,0 +1,311 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// NotificationKind is what happened in a game a user is notified about
+type NotificationKind string
+
+const (
+	TurnStartedNotification NotificationKind = "turn_started"
+	GameEndedNotification   NotificationKind = "game_ended"
+)
+
+// NotificationEvent is something that happened in a game that one of its
+// players is notified about
+type NotificationEvent struct {
+	Kind     NotificationKind `json:"kind"`
+	GameId   string           `json:"game_id"`
+	GameName string           `json:"game_name"`
+	UserId   string           `json:"user_id"`
+	Player   int32            `json:"player"`
+	Turn     int32            `json:"turn,omitempty"`
+	Winner   int32            `json:"winner,omitempty"`
+	Reason   string           `json:"reason,omitempty"`
+	At       time.Time        `json:"at"`
+}
+
+// Describe returns the event as a line of text
+func (e NotificationEvent) Describe() string {
+	switch {
+	case e.Kind == TurnStartedNotification:
+		return fmt.Sprintf("It's your turn in %s (turn %d)", e.GameName, e.Turn)
+	case e.Winner != 0:
+		return fmt.Sprintf("%s was won by player %d", e.GameName, e.Winner)
+	case e.Reason != "":
+		return fmt.Sprintf("%s ended in a %s", e.GameName, e.Reason)
+	default:
+		return fmt.Sprintf("%s has ended", e.GameName)
+	}
+}
+
+// Mailer sends email
+type Mailer interface {
+	SendMail(to, subject, body string) error
+}
+
+// ConsoleMailer logs emails instead of sending them, for development
+type ConsoleMailer struct{}
+
+func (ConsoleMailer) SendMail(to, subject, body string) error {
+	log.Printf("Email to %s: %s\n%s", to, subject, body)
+	return nil
+}
+
+// SMTPMailer sends email through an SMTP server
+type SMTPMailer struct {
+	Addr string // host:port
+	From string
+	Auth smtp.Auth
+}
+
+func (m *SMTPMailer) SendMail(to, subject, body string) error {
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s", m.From, to, subject, body)
+	return smtp.SendMail(m.Addr, m.Auth, m.From, []string{to}, []byte(msg))
+}
+
+// pendingDigest is the events waiting to go out in a user's next digest
+type pendingDigest struct {
+	due    time.Time
+	events []NotificationEvent
+}
+
+// NotificationDispatcher turns game updates into notifications for the
+// players of the game, sending them right away or batching them into
+// digests as each player prefers
+type NotificationDispatcher struct {
+	Prefs  *NotificationService
+	Games  GameLoader
+	Mailer Mailer
+
+	// Client webhooks are posted with (nil uses http.DefaultClient)
+	HTTPClient *http.Client
+
+	// Base URL unsubscribe links point at
+	BaseURL string
+
+	// Clock for digest windows (nil uses the system clock)
+	Clock lib.Clock
+
+	pending map[string]*pendingDigest
+	mu      sync.Mutex
+}
+
+// NewNotificationDispatcher creates a dispatcher sending notifications by
+// the preferences kept in prefs
+func NewNotificationDispatcher(prefs *NotificationService, games GameLoader, mailer Mailer) *NotificationDispatcher {
+	return &NotificationDispatcher{
+		Prefs:   prefs,
+		Games:   games,
+		Mailer:  mailer,
+		pending: make(map[string]*pendingDigest),
+	}
+}
+
+// HandleUpdate notifies the players of a game about a GameSync update: the
+// player whose turn starts, or everyone when the game ends
+func (d *NotificationDispatcher) HandleUpdate(gameId string, update *v1.GameUpdate) {
+	for _, event := range d.events(gameId, update) {
+		d.Dispatch(event)
+	}
+}
+
+// Dispatch sends an event to its user, or queues it for their next digest
+func (d *NotificationDispatcher) Dispatch(event NotificationEvent) {
+	prefs, err := d.Prefs.GetPreferences(event.UserId)
+	if err != nil {
+		log.Printf("Failed to load the notification preferences of user %s: %v", event.UserId, err)
+		return
+	}
+	if prefs.Channel == NotifyNone {
+		return
+	}
+	if prefs.Immediacy == NotifyInstant {
+		d.deliver(event.UserId, prefs, []NotificationEvent{event})
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	digest, ok := d.pending[event.UserId]
+	if !ok {
+		digest = &pendingDigest{due: digestDue(event.At, prefs.Immediacy)}
+		d.pending[event.UserId] = digest
+	}
+	digest.events = append(digest.events, event)
+}
+
+// Flush sends every digest that is due
+func (d *NotificationDispatcher) Flush() {
+	now := d.now()
+	due := map[string][]NotificationEvent{}
+	d.mu.Lock()
+	for userId, digest := range d.pending {
+		if !now.Before(digest.due) {
+			due[userId] = digest.events
+			delete(d.pending, userId)
+		}
+	}
+	d.mu.Unlock()
+
+	for userId, events := range due {
+		// Users may have unsubscribed since the events were queued
+		prefs, err := d.Prefs.GetPreferences(userId)
+		if err != nil {
+			log.Printf("Failed to load the notification preferences of user %s: %v", userId, err)
+			continue
+		}
+		if prefs.Channel != NotifyNone {
+			d.deliver(userId, prefs, events)
+		}
+	}
+}
+
+// Run flushes due digests every interval until the context is done
+func (d *NotificationDispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.Flush()
+		}
+	}
+}
+
+// digestDue returns when a digest started at t goes out: at the top of the
+// next hour, or at the next midnight (UTC)
+func digestDue(t time.Time, immediacy NotifyImmediacy) time.Time {
+	if immediacy == NotifyHourlyDigest {
+		return t.Truncate(time.Hour).Add(time.Hour)
+	}
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+}
+
+// events returns the notifications a GameSync update raises
+func (d *NotificationDispatcher) events(gameId string, update *v1.GameUpdate) (events []NotificationEvent) {
+	var turns []*v1.PlayerChangedChange
+	var ended *v1.GameEnded
+	switch u := update.UpdateType.(type) {
+	case *v1.GameUpdate_MovesPublished:
+		for _, move := range u.MovesPublished.Moves {
+			for _, change := range move.Changes {
+				if pc := change.GetPlayerChanged(); pc != nil {
+					turns = append(turns, pc)
+				}
+			}
+		}
+	case *v1.GameUpdate_GameEnded:
+		ended = u.GameEnded
+	}
+	if len(turns) == 0 && ended == nil {
+		return nil
+	}
+
+	resp, err := d.Games.GetGame(context.Background(), &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		log.Printf("Failed to load game %s for notifications: %v", gameId, err)
+		return nil
+	}
+	game := resp.Game
+	now := d.now()
+	event := func(kind NotificationKind, player *v1.GamePlayer) NotificationEvent {
+		return NotificationEvent{Kind: kind, GameId: gameId, GameName: game.Name, UserId: player.UserId, Player: player.PlayerId, At: now}
+	}
+
+	// A turn that ended the game is followed by a GameEnded update instead
+	if !resp.State.GetFinished() {
+		for _, turn := range turns {
+			for _, player := range game.GetConfig().GetPlayers() {
+				if player.PlayerId == turn.NewPlayer && player.UserId != "" {
+					e := event(TurnStartedNotification, player)
+					e.Turn = turn.NewTurn
+					events = append(events, e)
+				}
+			}
+		}
+	}
+	if ended != nil {
+		for _, player := range game.GetConfig().GetPlayers() {
+			if player.UserId != "" {
+				e := event(GameEndedNotification, player)
+				e.Winner, e.Reason = ended.Winner, ended.Reason
+				events = append(events, e)
+			}
+		}
+	}
+	return events
+}
+
+// deliver sends events to a user over their channel
+func (d *NotificationDispatcher) deliver(userId string, prefs NotificationPrefs, events []NotificationEvent) {
+	var err error
+	switch prefs.Channel {
+	case NotifyEmail:
+		subject := events[0].Describe()
+		if len(events) > 1 {
+			subject = fmt.Sprintf("%d updates from your games", len(events))
+		}
+		var body strings.Builder
+		for _, event := range events {
+			fmt.Fprintf(&body, "%s\n", event.Describe())
+		}
+		fmt.Fprintf(&body, "\nUnsubscribe: %s/notifications/unsubscribe?token=%s\n",
+			d.BaseURL, url.QueryEscape(d.Prefs.UnsubscribeToken(userId)))
+		err = d.Mailer.SendMail(prefs.Email, subject, body.String())
+	case NotifyWebhook:
+		err = d.postWebhook(prefs.WebhookURL, events)
+	}
+	if err != nil {
+		log.Printf("Failed to notify user %s: %v", userId, err)
+	}
+}
+
+// postWebhook posts events to a webhook as JSON
+func (d *NotificationDispatcher) postWebhook(webhookURL string, events []NotificationEvent) error {
+	payload, err := json.Marshal(map[string]any{"events": events})
+	if err != nil {
+		return err
+	}
+	client := d.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Post(webhookURL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// now returns the current time from the configured clock
+func (d *NotificationDispatcher) now() time.Time {
+	if d.Clock == nil {
+		return time.Now()
+	}
+	return d.Clock.Now()
+}
@@ -0,0 +1,226 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/authz"
+)
+
+// NotifyChannel is how a user is told about their games
+type NotifyChannel string
+
+const (
+	NotifyEmail   NotifyChannel = "email"
+	NotifyWebhook NotifyChannel = "webhook"
+	NotifyNone    NotifyChannel = "none"
+)
+
+// NotifyImmediacy is whether notifications are sent right away or batched
+// into a digest
+type NotifyImmediacy string
+
+const (
+	NotifyInstant      NotifyImmediacy = "instant"
+	NotifyHourlyDigest NotifyImmediacy = "hourly"
+	NotifyDailyDigest  NotifyImmediacy = "daily"
+)
+
+// NotificationPrefs are a user's notification preferences
+type NotificationPrefs struct {
+	Channel    NotifyChannel   `json:"channel"`
+	Immediacy  NotifyImmediacy `json:"immediacy"`
+	Email      string          `json:"email,omitempty"`
+	WebhookURL string          `json:"webhook_url,omitempty"`
+}
+
+// prefsFromProto returns the preferences kept in a stored message
+func prefsFromProto(prefs *v1.NotificationPreferences) NotificationPrefs {
+	return NotificationPrefs{
+		Channel:    NotifyChannel(prefs.GetChannel()),
+		Immediacy:  NotifyImmediacy(prefs.GetImmediacy()),
+		Email:      prefs.GetEmail(),
+		WebhookURL: prefs.GetWebhookUrl(),
+	}
+}
+
+// ToProto returns the preferences as the message they are stored and served as
+func (p NotificationPrefs) ToProto() *v1.NotificationPreferences {
+	return &v1.NotificationPreferences{
+		Channel:    string(p.Channel),
+		Immediacy:  string(p.Immediacy),
+		Email:      p.Email,
+		WebhookUrl: p.WebhookURL,
+	}
+}
+
+// NotificationPrefsStore is where users' notification preferences are kept
+type NotificationPrefsStore interface {
+	// LoadNotificationPreferences returns nil for users who never set any
+	LoadNotificationPreferences(userId string) (*v1.NotificationPreferences, error)
+	SaveNotificationPreferences(userId string, prefs *v1.NotificationPreferences) error
+}
+
+// NotificationService keeps users' notification preferences and issues the
+// signed tokens that let them unsubscribe without logging in
+type NotificationService struct {
+	// Key unsubscribe tokens are signed with
+	Secret []byte
+
+	// Where the preferences are kept
+	Store NotificationPrefsStore
+
+	// Serializes changes to preferences, so an unsubscribe can't undo a
+	// change made while it was loading them
+	mu sync.Mutex
+}
+
+// NewNotificationService creates a notification service keeping
+// preferences in store and signing its unsubscribe tokens with secret
+func NewNotificationService(secret string, store NotificationPrefsStore) *NotificationService {
+	return &NotificationService{
+		Secret: []byte(secret),
+		Store:  store,
+	}
+}
+
+// GetPreferences returns a user's preferences. Users who never set any are
+// not notified.
+func (n *NotificationService) GetPreferences(userId string) (NotificationPrefs, error) {
+	prefs, err := n.Store.LoadNotificationPreferences(userId)
+	if err != nil || prefs == nil {
+		return NotificationPrefs{Channel: NotifyNone, Immediacy: NotifyInstant}, err
+	}
+	return prefsFromProto(prefs), nil
+}
+
+// SetPreferences replaces a user's preferences
+func (n *NotificationService) SetPreferences(userId string, prefs NotificationPrefs) error {
+	switch prefs.Channel {
+	case NotifyEmail:
+		if prefs.Email == "" {
+			return fmt.Errorf("an email address is required for email notifications")
+		}
+	case NotifyWebhook:
+		if !strings.HasPrefix(prefs.WebhookURL, "https://") && !strings.HasPrefix(prefs.WebhookURL, "http://") {
+			return fmt.Errorf("invalid webhook URL %q", prefs.WebhookURL)
+		}
+	case NotifyNone:
+	default:
+		return fmt.Errorf("invalid notification channel %q", prefs.Channel)
+	}
+	switch prefs.Immediacy {
+	case NotifyInstant, NotifyHourlyDigest, NotifyDailyDigest:
+	default:
+		return fmt.Errorf("invalid notification immediacy %q", prefs.Immediacy)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.Store.SaveNotificationPreferences(userId, prefs.ToProto())
+}
+
+// Unsubscribe turns off all notifications for a user, keeping the rest of
+// their preferences for if they subscribe again
+func (n *NotificationService) Unsubscribe(userId string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	prefs, err := n.Store.LoadNotificationPreferences(userId)
+	if err != nil || prefs == nil {
+		return err
+	}
+	prefs.Channel = string(NotifyNone)
+	return n.Store.SaveNotificationPreferences(userId, prefs)
+}
+
+// UnsubscribeToken returns the token that unsubscribes a user
+func (n *NotificationService) UnsubscribeToken(userId string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(userId)) + "." + enc.EncodeToString(n.sign(userId))
+}
+
+// VerifyUnsubscribeToken returns the user an unsubscribe token was issued to
+func (n *NotificationService) VerifyUnsubscribeToken(token string) (string, error) {
+	enc := base64.RawURLEncoding
+	encodedUser, encodedSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", fmt.Errorf("malformed unsubscribe token")
+	}
+	userId, err := enc.DecodeString(encodedUser)
+	if err != nil {
+		return "", fmt.Errorf("malformed unsubscribe token")
+	}
+	sig, err := enc.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, n.sign(string(userId))) {
+		return "", fmt.Errorf("invalid unsubscribe token")
+	}
+	return string(userId), nil
+}
+
+func (n *NotificationService) sign(userId string) []byte {
+	mac := hmac.New(sha256.New, n.Secret)
+	mac.Write([]byte("unsubscribe:" + userId))
+	return mac.Sum(nil)
+}
+
+// Handler serves the unsubscribe links sent with notifications at
+// /notifications/unsubscribe?token=... No login is needed, the signed token
+// is proof enough.
+func (n *NotificationService) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/notifications/unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		userId, err := n.VerifyUnsubscribeToken(r.URL.Query().Get("token"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := n.Unsubscribe(userId); err != nil {
+			http.Error(w, "Failed to unsubscribe, please try again later", http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintln(w, "You have been unsubscribed from game notifications.")
+	})
+	return mux
+}
+
+// GetNotificationPreferences returns the caller's notification preferences
+func (s *BackendGamesService) GetNotificationPreferences(ctx context.Context, req *v1.GetNotificationPreferencesRequest) (*v1.GetNotificationPreferencesResponse, error) {
+	if s.Notifications == nil {
+		return nil, ErrNotImplemented
+	}
+	userId, err := authz.RequireAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefs, err := s.Notifications.GetPreferences(userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load notification preferences: %w", err)
+	}
+	return &v1.GetNotificationPreferencesResponse{Preferences: prefs.ToProto()}, nil
+}
+
+// SetNotificationPreferences replaces the caller's notification preferences
+func (s *BackendGamesService) SetNotificationPreferences(ctx context.Context, req *v1.SetNotificationPreferencesRequest) (*v1.SetNotificationPreferencesResponse, error) {
+	if s.Notifications == nil {
+		return nil, ErrNotImplemented
+	}
+	userId, err := authz.RequireAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefs := prefsFromProto(req.Preferences)
+	if err := s.Notifications.SetPreferences(userId, prefs); err != nil {
+		return nil, err
+	}
+	return &v1.SetNotificationPreferencesResponse{Preferences: prefs.ToProto()}, nil
+}
@@ -0,0 +1,152 @@
+//go:build !wasm
+// +build !wasm
+
+// Package observability holds the Prometheus metrics shared by the backend
+// and indexer binaries. Metrics are registered on a dedicated Registry and
+// exposed via Handler() (served at /metrics on an internal listener, never
+// on the public gateway).
+package observability
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "lilbattle"
+
+// Registry holds all lilbattle metrics plus the Go runtime/process collectors
+var Registry = prometheus.NewRegistry()
+
+var (
+	ProcessMovesTotal = promauto.With(Registry).NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "process_moves_total",
+		Help:      "Number of ProcessMoves calls handled.",
+	})
+
+	ProcessMovesErrors = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "process_moves_errors_total",
+		Help:      "Number of failed ProcessMoves calls by gRPC status code.",
+	}, []string{"code"})
+
+	ProcessMovesDuration = promauto.With(Registry).NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "process_moves_duration_seconds",
+		Help:      "Latency of ProcessMoves calls.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	GamesByStatus = promauto.With(Registry).NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "games",
+		Help:      "Number of games by status, refreshed periodically.",
+	}, []string{"status"})
+
+	FilestoreOps = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "filestore_operations_total",
+		Help:      "Filestore operations by operation and result.",
+	}, []string{"op", "result"})
+
+	SyncPayloads = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gamesync_payloads_total",
+		Help:      "GameSync updates sent to subscribers by encoding.",
+	}, []string{"encoding"})
+
+	SyncPayloadBytes = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gamesync_payload_bytes_total",
+		Help:      "Bytes of GameSync updates sent to subscribers by encoding.",
+	}, []string{"encoding"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// ObserveProcessMoves records a ProcessMoves call that started at start
+func ObserveProcessMoves(start time.Time, err error) {
+	ProcessMovesTotal.Inc()
+	ProcessMovesDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		ProcessMovesErrors.WithLabelValues(status.Code(err).String()).Inc()
+	}
+}
+
+// ObserveFilestoreOp records a filestore operation and whether it succeeded
+func ObserveFilestoreOp(op string, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	FilestoreOps.WithLabelValues(op, result).Inc()
+}
+
+// ObserveSyncPayload records a GameSync update of size bytes sent in an encoding
+func ObserveSyncPayload(encoding string, size int) {
+	SyncPayloads.WithLabelValues(encoding).Inc()
+	SyncPayloadBytes.WithLabelValues(encoding).Add(float64(size))
+}
+
+// RegisterSyncSubscribers exposes the active GameSync subscriber count
+func RegisterSyncSubscribers(count func() int) {
+	Registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "gamesync_subscribers",
+		Help:      "Active GameSync subscribers across all games.",
+	}, func() float64 { return float64(count()) }))
+}
+
+// RegisterDBStats exposes connection pool stats for the given database
+func RegisterDBStats(db *sql.DB, dbName string) {
+	Registry.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// RefreshGamesByStatus calls countByStatus every interval (and once
+// immediately) and publishes the result in GamesByStatus until ctx is done.
+func RefreshGamesByStatus(ctx context.Context, interval time.Duration, countByStatus func(context.Context) (map[string]int, error)) {
+	refresh := func() {
+		counts, err := countByStatus(ctx)
+		if err != nil {
+			log.Println("Failed to refresh games by status: ", err)
+			return
+		}
+		GamesByStatus.Reset()
+		for status, count := range counts {
+			GamesByStatus.WithLabelValues(status).Set(float64(count))
+		}
+	}
+
+	go func() {
+		refresh()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				refresh()
+			}
+		}
+	}()
+}
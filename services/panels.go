@@ -3,6 +3,9 @@ package services
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	"github.com/turnforge/lilbattle/lib"
@@ -54,6 +57,21 @@ func (b *BaseGameState) SetUnitAt(_ context.Context, req *v1.SetUnitAtRequest) (
 	return nil, nil
 }
 
+func (b *BaseGameState) SetTileAt(_ context.Context, req *v1.SetTileAtRequest) (*v1.SetTileAtResponse, error) {
+	if b.State == nil || b.State.WorldData == nil {
+		return nil, fmt.Errorf("game state not initialized")
+	}
+
+	if b.State.WorldData.TilesMap == nil {
+		b.State.WorldData.TilesMap = make(map[string]*v1.Tile)
+	}
+
+	key := lib.CoordKey(req.Q, req.R)
+	b.State.WorldData.TilesMap[key] = req.Tile
+
+	return nil, nil
+}
+
 func (b *BaseGameState) RemoveUnitAt(_ context.Context, req *v1.RemoveUnitAtRequest) (*v1.RemoveUnitAtResponse, error) {
 	if b.State == nil || b.State.WorldData == nil {
 		return nil, fmt.Errorf("game state not initialized")
@@ -195,17 +213,30 @@ type BaseCompactSummaryCardPanel struct {
 	PanelBase
 	Tile *v1.Tile
 	Unit *v1.Unit
+
+	// The last attack's recap while it is shown
+	BattleSummary *v1.CombatSummary
 }
 
 func (b *BaseCompactSummaryCardPanel) SetCurrentData(_ context.Context, tile *v1.Tile, unit *v1.Unit) {
 	b.Tile = tile
 	b.Unit = unit
+	b.BattleSummary = nil
+}
+
+func (b *BaseCompactSummaryCardPanel) ShowBattleSummary(_ context.Context, summary *v1.CombatSummary) {
+	b.BattleSummary = summary
+}
+
+func (b *BaseCompactSummaryCardPanel) HideBattleSummary(_ context.Context) {
+	b.BattleSummary = nil
 }
 
 // PlayerStats holds computed stats for a player (bases, units counts)
 type PlayerStats struct {
 	Bases int32
 	Units int32
+	Clock string // Remaining time bank as m:ss, empty when time banks are off
 }
 
 // BaseGameStatePanel is a non-UI implementation of GameStatePanel
@@ -255,6 +286,22 @@ func (b *BaseGameStatePanel) ComputePlayerStats() {
 			b.PlayerStats[unit.Player].Units++
 		}
 	}
+
+	// Remaining time banks
+	if b.Game != nil && lib.GetTimeBankSettings(b.Game.Config) != nil {
+		for playerId, remainingMs := range lib.RemainingTimeBanks(b.State, time.Now()) {
+			if b.PlayerStats[playerId] == nil {
+				b.PlayerStats[playerId] = &PlayerStats{}
+			}
+			b.PlayerStats[playerId].Clock = formatClock(remainingMs)
+		}
+	}
+}
+
+// formatClock formats a duration in milliseconds as m:ss
+func formatClock(ms int64) string {
+	seconds := ms / 1000
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
 }
 
 // ComputeCurrentPlayerIncome calculates income for the current player
@@ -279,7 +326,7 @@ func (b *BaseGameStatePanel) ComputeCurrentPlayerIncome() {
 		return
 	}
 
-	incomeConfig := b.Game.Config.IncomeConfigs
+	incomeConfig := lib.EffectiveIncomeConfig(b.Game.Config)
 	baseCounts := make(map[int32]int32) // tileType -> count
 
 	for _, tile := range b.State.WorldData.TilesMap {
@@ -308,6 +355,150 @@ func (b *BaseGameStatePanel) GetPlayerColorHex(playerId int32) string {
 	return "#888888"
 }
 
+// VictoryPointsToWin returns the victory points a player needs to win, or 0
+// if the game has no points-based win
+func (b *BaseGameStatePanel) VictoryPointsToWin() int32 {
+	if b.Game == nil {
+		return 0
+	}
+	return lib.VictoryPointsToWin(b.Game.Config)
+}
+
+// MaxUnitsPerPlayer returns the most units a player may have, or 0 if the
+// game has no cap
+func (b *BaseGameStatePanel) MaxUnitsPerPlayer() int32 {
+	if b.Game == nil {
+		return 0
+	}
+	return lib.UnitCap(b.RulesEngine, b.Game.Config)
+}
+
+// DelegateName returns the name of the teammate controlling the current turn,
+// or empty if the turn has not been delegated
+func (b *BaseGameStatePanel) DelegateName() string {
+	if b.State == nil || b.State.DelegatedTo == 0 || b.Game == nil {
+		return ""
+	}
+	for _, p := range b.Game.Config.GetPlayers() {
+		if p.PlayerId == b.State.DelegatedTo {
+			if p.Name != "" {
+				return p.Name
+			}
+			break
+		}
+	}
+	return fmt.Sprintf("Player %d", b.State.DelegatedTo)
+}
+
+// DraftSummary describes a game's pre-game draft for the players panel
+type DraftSummary struct {
+	Drafting bool     // Whether the draft is still going on
+	Round    string   // "ban" or "pick" while drafting
+	Banned   []string // Banned unit names
+	Picks    []string // Picked unit names with who picked them
+}
+
+// Draft summarizes the game's draft, or returns nil if it had none
+func (b *BaseGameStatePanel) Draft() *DraftSummary {
+	if b.State == nil || b.State.Draft == nil {
+		return nil
+	}
+	draft := b.State.Draft
+	summary := &DraftSummary{Drafting: b.State.Status == v1.GameStatus_GAME_STATUS_DRAFTING}
+	if summary.Drafting {
+		summary.Round = "ban"
+		if bans := b.Game.GetConfig().GetSettings().GetDraft().GetBansPerPlayer(); draft.TurnsTaken >= bans*int32(len(b.Game.GetConfig().GetPlayers())) {
+			summary.Round = "pick"
+		}
+	}
+	for _, unitType := range draft.BannedUnits {
+		summary.Banned = append(summary.Banned, b.unitName(unitType))
+	}
+	for _, unitType := range slices.Sorted(maps.Keys(draft.PickedUnits)) {
+		summary.Picks = append(summary.Picks, fmt.Sprintf("%s (Player %d)", b.unitName(unitType), draft.PickedUnits[unitType]))
+	}
+	return summary
+}
+
+// PlanningSummary describes a simultaneous turn's planning for the players
+// panel
+type PlanningSummary struct {
+	Locked []int32 // Players who have locked in their plan
+}
+
+// Planning summarizes who has locked in their plan this turn, or returns nil
+// if the game does not play simultaneous turns
+func (b *BaseGameStatePanel) Planning() *PlanningSummary {
+	if b.State == nil || !lib.SimultaneousTurnsEnabled(b.Game.GetConfig()) || b.State.Finished ||
+		b.State.Status == v1.GameStatus_GAME_STATUS_DRAFTING {
+		return nil
+	}
+	summary := &PlanningSummary{}
+	for _, plan := range b.State.TurnPlans {
+		summary.Locked = append(summary.Locked, plan.Player)
+	}
+	return summary
+}
+
+// PuzzleSummary describes a puzzle game's goal and progress for the players
+// panel
+type PuzzleSummary struct {
+	Goal      string
+	TurnsLeft int32
+	Result    string // "solved" or "failed" once the puzzle is over
+}
+
+// Puzzle summarizes the game's puzzle, or returns nil if it is not one
+func (b *BaseGameStatePanel) Puzzle() *PuzzleSummary {
+	puzzle := b.Game.GetConfig().GetSettings().GetPuzzle()
+	if puzzle == nil || b.State == nil {
+		return nil
+	}
+	summary := &PuzzleSummary{Goal: puzzle.Goal, TurnsLeft: lib.PuzzleTurnsLeft(puzzle, b.State)}
+	switch b.State.PuzzleResult {
+	case v1.PuzzleResult_PUZZLE_RESULT_SOLVED:
+		summary.Result = "solved"
+	case v1.PuzzleResult_PUZZLE_RESULT_FAILED:
+		summary.Result = "failed"
+	}
+	return summary
+}
+
+// SandboxSummary describes a sandbox game's relaxed rules for the players
+// panel
+type SandboxSummary struct {
+	Relaxed []string // The rules the sandbox relaxes, eg "Infinite coins"
+}
+
+// Sandbox summarizes the game's sandbox settings, or returns nil if it is
+// not a sandbox
+func (b *BaseGameStatePanel) Sandbox() *SandboxSummary {
+	config := b.Game.GetConfig()
+	if !lib.SandboxEnabled(config) {
+		return nil
+	}
+	sandbox := config.GetSettings().GetSandbox()
+	summary := &SandboxSummary{}
+	if sandbox.RelaxTurnOrder {
+		summary.Relaxed = append(summary.Relaxed, "Any player's units act on any turn")
+	}
+	if sandbox.RelaxActionOrder {
+		summary.Relaxed = append(summary.Relaxed, "Units act in any order")
+	}
+	if sandbox.InfiniteCoins {
+		summary.Relaxed = append(summary.Relaxed, "Infinite coins")
+	}
+	return summary
+}
+
+// unitName returns a unit type's name from the rules, or its ID if unknown
+func (b *BaseGameStatePanel) unitName(unitType int32) string {
+	if unitDef := b.RulesEngine.GetUnits()[unitType]; unitDef != nil {
+		return unitDef.Name
+	}
+	return fmt.Sprintf("Unit %d", unitType)
+}
+
 // buildIncomeBreakdown creates a human-readable income breakdown string
 func (b *BaseGameStatePanel) buildIncomeBreakdown(baseCounts map[int32]int32, incomeConfig *v1.IncomeConfig) string {
 	if len(baseCounts) == 0 {
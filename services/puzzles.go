@@ -0,0 +1,55 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/proto"
+)
+
+// GameEnded reasons sent when a puzzle game ends
+const (
+	PuzzleSolvedReason = "puzzle solved"
+	PuzzleFailedReason = "puzzle failed"
+)
+
+// puzzleEndReason returns the GameEnded reason for a finished game, empty if
+// it is not a puzzle
+func puzzleEndReason(state *v1.GameState) string {
+	switch state.PuzzleResult {
+	case v1.PuzzleResult_PUZZLE_RESULT_SOLVED:
+		return PuzzleSolvedReason
+	case v1.PuzzleResult_PUZZLE_RESULT_FAILED:
+		return PuzzleFailedReason
+	}
+	return ""
+}
+
+// PuzzleGame returns the game a user plays a puzzle in. The user takes the
+// solver's seat and the puzzle's opponent plays every other player that
+// starts on the world.
+func PuzzleGame(puzzle *v1.Puzzle, worldData *v1.WorldData, userId string) *v1.Game {
+	settings := proto.Clone(puzzle.Settings).(*v1.PuzzleSettings)
+	settings.PuzzleId = puzzle.Id
+	solver := lib.PuzzleSolver(settings)
+
+	game := &v1.Game{
+		Name:      puzzle.Name,
+		WorldId:   puzzle.WorldId,
+		CreatorId: userId,
+		Config: &v1.GameConfiguration{
+			Settings: &v1.GameSettings{Puzzle: settings},
+		},
+	}
+	starting := lib.NewWorld("", proto.Clone(worldData).(*v1.WorldData)).GetStartingPlayers()
+	for _, playerId := range starting {
+		player := &v1.GamePlayer{PlayerId: playerId, PlayerType: "ai", IsActive: true}
+		if playerId == solver {
+			player.PlayerType, player.UserId = "human", userId
+		}
+		game.Config.Players = append(game.Config.Players, player)
+	}
+	return game
+}
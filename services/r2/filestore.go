@@ -11,6 +11,7 @@ import (
 	"time"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/observability"
 	tspb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -67,7 +68,9 @@ func (s *R2FileStoreService) populateSignedURLs(ctx context.Context, file *v1.Fi
 }
 
 // PutFile uploads a file to R2
-func (s *R2FileStoreService) PutFile(ctx context.Context, req *v1.PutFileRequest) (*v1.PutFileResponse, error) {
+func (s *R2FileStoreService) PutFile(ctx context.Context, req *v1.PutFileRequest) (resp *v1.PutFileResponse, err error) {
+	defer func() { observability.ObserveFilestoreOp("put", err) }()
+
 	if req.File == nil {
 		return nil, fmt.Errorf("file is required")
 	}
@@ -80,7 +83,7 @@ func (s *R2FileStoreService) PutFile(ctx context.Context, req *v1.PutFileRequest
 		contentType = "application/octet-stream"
 	}
 
-	_, err := s.Client.Upload(ctx, req.File.Path, req.Content, contentType)
+	_, err = s.Client.Upload(ctx, req.File.Path, req.Content, contentType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload file: %w", err)
 	}
@@ -106,7 +109,9 @@ func (s *R2FileStoreService) PutFile(ctx context.Context, req *v1.PutFileRequest
 }
 
 // GetFile returns metadata about a file in R2
-func (s *R2FileStoreService) GetFile(ctx context.Context, req *v1.GetFileRequest) (*v1.GetFileResponse, error) {
+func (s *R2FileStoreService) GetFile(ctx context.Context, req *v1.GetFileRequest) (resp *v1.GetFileResponse, err error) {
+	defer func() { observability.ObserveFilestoreOp("get", err) }()
+
 	if err := validatePath(req.Path); err != nil {
 		return nil, err
 	}
@@ -147,7 +152,9 @@ func (s *R2FileStoreService) GetFile(ctx context.Context, req *v1.GetFileRequest
 }
 
 // DeleteFile removes a file from R2
-func (s *R2FileStoreService) DeleteFile(ctx context.Context, req *v1.DeleteFileRequest) (*v1.DeleteFileResponse, error) {
+func (s *R2FileStoreService) DeleteFile(ctx context.Context, req *v1.DeleteFileRequest) (resp *v1.DeleteFileResponse, err error) {
+	defer func() { observability.ObserveFilestoreOp("delete", err) }()
+
 	if err := validatePath(req.Path); err != nil {
 		return nil, err
 	}
@@ -170,7 +177,9 @@ func (s *R2FileStoreService) DeleteFile(ctx context.Context, req *v1.DeleteFileR
 }
 
 // ListFiles lists files in R2 with a given prefix
-func (s *R2FileStoreService) ListFiles(ctx context.Context, req *v1.ListFilesRequest) (*v1.ListFilesResponse, error) {
+func (s *R2FileStoreService) ListFiles(ctx context.Context, req *v1.ListFilesRequest) (resp *v1.ListFilesResponse, err error) {
+	defer func() { observability.ObserveFilestoreOp("list", err) }()
+
 	prefix := req.Path
 	if prefix != "" {
 		if err := validatePath(prefix); err != nil {
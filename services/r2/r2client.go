@@ -25,6 +25,10 @@ type R2Config struct {
 	SecretAccessKey string
 	Bucket          string
 	PublicURL       string // Optional: only needed if bucket is public
+
+	// Optional: any other S3 compatible endpoint (e.g. MinIO) used instead of
+	// the account's R2 endpoint, with path style bucket addressing
+	Endpoint string
 }
 
 func NewR2Client(cfg R2Config) (*R2Client, error) {
@@ -39,7 +43,12 @@ func NewR2Client(cfg R2Config) (*R2Client, error) {
 	}
 
 	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
-		o.BaseEndpoint = aws.String(fmt.Sprintf("https://%s.r2.cloudflarestorage.com", cfg.AccountID))
+		if cfg.Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.Endpoint)
+			o.UsePathStyle = true
+		} else {
+			o.BaseEndpoint = aws.String(fmt.Sprintf("https://%s.r2.cloudflarestorage.com", cfg.AccountID))
+		}
 	})
 
 	return &R2Client{
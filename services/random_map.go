@@ -0,0 +1,170 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// =============================================================================
+// Random Maps
+// =============================================================================
+//
+// A game created with random_map set instead of a world_id is played on a map
+// generated at creation with a seed chosen by the server. The map is saved as
+// a world attached to the game that is left out of listings and can't be
+// fetched until the game starts, ie once every open seat is filled. Until
+// then the game waits with an empty board and hides the seed; on start the
+// board is filled in from the attached world and the seed is revealed so
+// players can regenerate the map and check it wasn't tampered with.
+
+// IsRandomMap reports whether a game is played on a generated map
+func IsRandomMap(game *v1.Game) bool {
+	return game.GetRandomMap().GetSize() != ""
+}
+
+// seatsFilled reports whether no seat of the game is still open for joining
+func seatsFilled(game *v1.Game) bool {
+	for _, player := range game.GetConfig().GetPlayers() {
+		if player.PlayerType == "open" {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadCreateGameWorld loads the world a new game is created on. Random map
+// games get a freshly generated world, which AttachRandomMap saves once the
+// game has an ID.
+func (s *BackendGamesService) LoadCreateGameWorld(ctx context.Context, game *v1.Game) (*v1.GetWorldResponse, error) {
+	if IsRandomMap(game) {
+		return s.generateRandomMap(game)
+	}
+	world, err := s.ClientMgr.GetWorldsSvcClient().GetWorld(ctx, &v1.GetWorldRequest{Id: game.WorldId})
+	if err != nil {
+		return nil, fmt.Errorf("Error loading world: %w", err)
+	}
+	if world.World.DeletedAt != nil {
+		return nil, fmt.Errorf("world %s has been deleted", game.WorldId)
+	}
+	return world, nil
+}
+
+// generateRandomMap generates the world for a random map game, with a map
+// for as many players as the game has seats
+func (s *BackendGamesService) generateRandomMap(game *v1.Game) (*v1.GetWorldResponse, error) {
+	randomMap := &v1.RandomMap{
+		Size:       game.RandomMap.Size,
+		NumPlayers: int32(len(game.GetConfig().GetPlayers())),
+		Seed:       rand.Int63n(1<<62) + 1,
+	}
+	worldData, err := lib.GenerateRandomMap(randomMap.Size, int(randomMap.NumPlayers), randomMap.Seed)
+	if err != nil {
+		return nil, err
+	}
+
+	game.WorldId = ""
+	game.RandomMap = &v1.RandomMap{Size: randomMap.Size, NumPlayers: randomMap.NumPlayers}
+	if seatsFilled(game) {
+		game.RandomMap.Seed = randomMap.Seed
+	}
+	return &v1.GetWorldResponse{
+		World: &v1.World{
+			Name:      lib.RandomMapLabel(randomMap),
+			RandomMap: randomMap,
+		},
+		WorldData: worldData,
+	}, nil
+}
+
+// AttachRandomMap saves the generated world of a random map game as a world
+// attached to it
+func (s *BackendGamesService) AttachRandomMap(ctx context.Context, game *v1.Game, world *v1.GetWorldResponse) error {
+	if !IsRandomMap(game) {
+		return nil
+	}
+	world.World.CreatorId = game.CreatorId
+	world.World.AttachedGameId = game.Id
+	resp, err := s.ClientMgr.GetWorldsSvcClient().CreateWorld(ctx, &v1.CreateWorldRequest{
+		World:     world.World,
+		WorldData: world.WorldData,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save random map: %w", err)
+	}
+	game.WorldId = resp.World.Id
+	return nil
+}
+
+// WaitForRandomMap holds back the board of a random map game until its open
+// seats are filled: the state starts out empty and waiting. It reports
+// whether the game has to wait.
+func (s *BackendGamesService) WaitForRandomMap(game *v1.Game, state *v1.GameState) bool {
+	if !IsRandomMap(game) || seatsFilled(game) {
+		return false
+	}
+	state.WorldData = &v1.WorldData{
+		TilesMap:  map[string]*v1.Tile{},
+		UnitsMap:  map[string]*v1.Unit{},
+		Crossings: map[string]*v1.Crossing{},
+	}
+	state.Status = v1.GameStatus_GAME_STATUS_WAITING
+	return true
+}
+
+// startRandomMap starts a waiting random map game once its last seat is
+// filled: the board is loaded from the attached world and the seed revealed
+func (s *BackendGamesService) startRandomMap(ctx context.Context, game *v1.Game) error {
+	state, err := s.StorageProvider.LoadGameState(ctx, game.Id)
+	if err != nil {
+		return fmt.Errorf("failed to load game state: %w", err)
+	}
+	if state.Status != v1.GameStatus_GAME_STATUS_WAITING {
+		return nil
+	}
+	world, err := s.ClientMgr.GetWorldsSvcClient().GetWorld(ctx, &v1.GetWorldRequest{Id: game.WorldId})
+	if err != nil {
+		return fmt.Errorf("failed to load random map: %w", err)
+	}
+
+	state.WorldData = world.WorldData
+	state.Status = v1.GameStatus_GAME_STATUS_UNSPECIFIED
+	lib.MigrateWorldData(state.WorldData)
+	lib.EnsureShortcuts(state.WorldData)
+	s.InitializePlayerStates(state, game.Config)
+
+	game.RandomMap.Seed = world.World.GetRandomMap().GetSeed()
+	game.UpdatedAt = timestamppb.New(s.now())
+	if err := s.StorageProvider.SaveGame(ctx, game.Id, game); err != nil {
+		return fmt.Errorf("failed to save game: %w", err)
+	}
+	if err := s.StorageProvider.SaveGameState(ctx, game.Id, state); err != nil {
+		return fmt.Errorf("failed to save game state: %w", err)
+	}
+	s.updateCache(game.Id, game, state, nil)
+	return nil
+}
+
+// CheckAttachedWorld rejects fetching a random map before its game starts
+func (s *BackendWorldsService) CheckAttachedWorld(ctx context.Context, world *v1.World) error {
+	if world.AttachedGameId == "" {
+		return nil
+	}
+	resp, err := s.ClientMgr.GetGamesSvcClient().GetGame(ctx, &v1.GetGameRequest{Id: world.AttachedGameId})
+	if err != nil {
+		return fmt.Errorf("failed to load game of world %s: %w", world.Id, err)
+	}
+	if !seatsFilled(resp.Game) {
+		return status.Errorf(codes.PermissionDenied, "world %s is a random map hidden until its game starts", world.Id)
+	}
+	return nil
+}
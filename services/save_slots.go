@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/proto"
+)
+
+// GameBundleVersion is the version of the game bundles written by EncodeGameBundle
+const GameBundleVersion = 1
+
+// AutosaveSlot returns the save slot the presenter autosaves a game into
+func AutosaveSlot(gameId string) string {
+	return "autosave:" + gameId
+}
+
+// SlotStore keeps serialized game bundles under named save slots, eg in the
+// browser's IndexedDB
+type SlotStore interface {
+	PutSlot(ctx context.Context, name string, data []byte) error
+	GetSlot(ctx context.Context, name string) ([]byte, error)
+	ListSlots(ctx context.Context) ([]string, error)
+}
+
+// SnapshotGamesService is a games service holding a single game that can be
+// exported as a whole and replaced by an exported one
+type SnapshotGamesService interface {
+	ExportState() ([]byte, error)
+	ImportState(data []byte) (*v1.GameBundle, error)
+}
+
+// BundleProblem is one reason a game bundle was rejected
+type BundleProblem struct {
+	Field   string
+	Message string
+}
+
+// BundleValidationError lists everything wrong with a game bundle
+type BundleValidationError struct {
+	Problems []BundleProblem
+}
+
+func (e *BundleValidationError) Error() string {
+	var problems []string
+	for _, problem := range e.Problems {
+		problems = append(problems, problem.Field+": "+problem.Message)
+	}
+	return "invalid game bundle: " + strings.Join(problems, "; ")
+}
+
+// EncodeGameBundle serializes a game with its state and history. The same
+// game always encodes to the same bytes.
+func EncodeGameBundle(game *v1.Game, state *v1.GameState, history *v1.GameMoveHistory, rulesHash string) ([]byte, error) {
+	bundle := &v1.GameBundle{
+		Version:   GameBundleVersion,
+		Game:      game,
+		State:     state,
+		History:   history,
+		RulesHash: rulesHash,
+		StateHash: lib.StateHash(state, rulesHash),
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(bundle)
+}
+
+// DecodeGameBundle parses and validates a game bundle, returning a
+// *BundleValidationError if it is malformed or corrupted
+func DecodeGameBundle(data []byte) (*v1.GameBundle, error) {
+	bundle := &v1.GameBundle{}
+	if err := proto.Unmarshal(data, bundle); err != nil {
+		return nil, &BundleValidationError{Problems: []BundleProblem{{Field: "bundle", Message: err.Error()}}}
+	}
+
+	var problems []BundleProblem
+	problem := func(field, format string, args ...any) {
+		problems = append(problems, BundleProblem{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+	if bundle.Version < 1 || bundle.Version > GameBundleVersion {
+		problem("version", "unsupported version %d", bundle.Version)
+	}
+	if bundle.Game.GetId() == "" {
+		problem("game", "missing game id")
+	}
+	if bundle.State == nil {
+		problem("state", "missing game state")
+	} else {
+		if bundle.State.WorldData == nil {
+			problem("state.world_data", "missing world data")
+		}
+		if bundle.State.GameId != "" && bundle.State.GameId != bundle.Game.GetId() {
+			problem("state.game_id", "state is for game %q, not %q", bundle.State.GameId, bundle.Game.GetId())
+		}
+		if hash := lib.StateHash(bundle.State, bundle.RulesHash); hash != bundle.StateHash {
+			problem("state_hash", "state hashes to %s, saved as %s", hash, bundle.StateHash)
+		}
+	}
+	if len(problems) > 0 {
+		return nil, &BundleValidationError{Problems: problems}
+	}
+	if bundle.History == nil {
+		bundle.History = &v1.GameMoveHistory{GameId: bundle.Game.Id}
+	}
+	return bundle, nil
+}
+
+// SaveSlotInfoFor summarizes a game bundle saved in a slot
+func SaveSlotInfoFor(name string, bundle *v1.GameBundle) *v1.SaveSlotInfo {
+	return &v1.SaveSlotInfo{
+		Name:          name,
+		GameId:        bundle.Game.Id,
+		GameName:      bundle.Game.Name,
+		TurnCounter:   bundle.State.TurnCounter,
+		CurrentPlayer: bundle.State.CurrentPlayer,
+	}
+}
@@ -106,6 +106,10 @@ func (s *ScreenShotIndexer) startBatchProcessing(batch map[string]ScreenShotItem
 }
 
 func (s *ScreenShotIndexer) Send(kind string, id string, version int64, worldData *v1.WorldData) {
+	// Nowhere to upload screenshots to (e.g., in tests or the local CLI)
+	if s.ClientMgr == nil {
+		return
+	}
 	s.reducer.InputChan() <- ScreenShotItem{kind, id, version, worldData, make(map[string]error), make(map[string]*v1.File)}
 }
 
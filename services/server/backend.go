@@ -0,0 +1,203 @@
+//go:build !wasm
+// +build !wasm
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"cloud.google.com/go/datastore"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"github.com/turnforge/lilbattle/services/gaebe"
+	"github.com/turnforge/lilbattle/services/gormbe"
+	"github.com/turnforge/lilbattle/services/observability"
+	"github.com/turnforge/lilbattle/services/r2"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+)
+
+// PublicMethods are the gRPC methods that don't require a logged-in user
+var PublicMethods = []string{
+	// Worlds - allow browsing without login
+	"/lilbattle.v1.WorldsService/ListWorlds",
+	"/lilbattle.v1.WorldsService/GetWorld",
+	"/lilbattle.v1.WorldsService/GetWorlds",
+	// Games - allow browsing without login
+	"/lilbattle.v1.GamesService/ListGames",
+	"/lilbattle.v1.GamesService/GetGame",
+	"/lilbattle.v1.GamesService/GetGames",
+	"/lilbattle.v1.GamesService/SimulateAttack",
+	"/lilbattle.v1.GamesService/SimulateFix",
+	// Puzzles - allow browsing without login
+	"/lilbattle.v1.PuzzlesService/ListPuzzles",
+	"/lilbattle.v1.PuzzlesService/GetPuzzle",
+	// GameSync - allow spectating without login
+	"/lilbattle.v1.GameSyncService/Subscribe",
+	// Indexer - meta stats are public
+	"/lilbattle.v1.IndexerService/GetMetaStats",
+}
+
+// BackendConfig picks the storage behind each backend service
+type BackendConfig struct {
+	WorldsBE    string // "local", "pg" or "gae"
+	GamesBE     string // "local", "pg" or "gae"
+	FilestoreBE string // "local", "r2" or "gae"
+
+	// LocalDataDir is the root of "local" storage. Empty uses the dev data
+	// path under the home directory.
+	LocalDataDir string
+
+	DBEndpoint        string // Postgres endpoint for "pg"
+	DefaultDBEndpoint string // Used when DBEndpoint and LILBATTLE_DB_ENDPOINT are unset
+	GAEProject        string // Datastore project for "gae"
+	GAENamespace      string // Datastore namespace for "gae", optional
+
+	// Users' notification preferences, served by the games service when set
+	Notifications *services.NotificationService
+}
+
+// BackendServices are the services a backend serves over gRPC
+type BackendServices struct {
+	Worlds    v1s.WorldsServiceServer
+	Games     v1s.GamesServiceServer
+	FileStore v1s.FileStoreServiceServer
+	Puzzles   v1s.PuzzlesServiceServer
+	Sync      *services.GameSyncService
+	Indexer   *fsbe.FSIndexerService
+}
+
+// NewBackendServices creates the backend services for a configuration.
+// clientMgr is how the services reach each other, through the gRPC server
+// they are registered on.
+func NewBackendServices(cfg BackendConfig, clientMgr *services.ClientMgr) (*BackendServices, error) {
+	out := &BackendServices{}
+
+	var db *gorm.DB = nil
+	ensureDB := func() *gorm.DB {
+		if db == nil {
+			db = gormbe.OpenLilBattleDB(cfg.DBEndpoint, cfg.DefaultDBEndpoint)
+			if sqlDB, err := db.DB(); err == nil {
+				observability.RegisterDBStats(sqlDB, "lilbattledb")
+			}
+		}
+		return db
+	}
+
+	var dsClient *datastore.Client = nil
+	ensureDatastore := func() (*datastore.Client, error) {
+		if dsClient == nil {
+			// Fall back to GOOGLE_CLOUD_PROJECT (set by App Engine)
+			projectID := cfg.GAEProject
+			if projectID == "" {
+				projectID = os.Getenv("GOOGLE_CLOUD_PROJECT")
+			}
+			if projectID == "" {
+				return nil, fmt.Errorf("GAE_PROJECT or GOOGLE_CLOUD_PROJECT environment variable (or --gae_project flag) is required for GAE backend")
+			}
+			var err error
+			dsClient, err = datastore.NewClient(context.Background(), projectID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Datastore client: %w", err)
+			}
+			log.Printf("Datastore client initialized for project: %s", projectID)
+		}
+		return dsClient, nil
+	}
+
+	localDir := func(name string) string {
+		if cfg.LocalDataDir == "" {
+			return ""
+		}
+		return filepath.Join(cfg.LocalDataDir, name)
+	}
+
+	switch cfg.WorldsBE {
+	case "pg":
+		out.Worlds = gormbe.NewWorldsService(ensureDB(), clientMgr)
+	case "local":
+		out.Worlds = fsbe.NewFSWorldsService(localDir("worlds"), clientMgr)
+	case "gae":
+		client, err := ensureDatastore()
+		if err != nil {
+			return nil, err
+		}
+		out.Worlds = gaebe.NewWorldsService(client, cfg.GAENamespace, clientMgr)
+	default:
+		return nil, fmt.Errorf("invalid worlds_service_be: %s. Valid options: local, pg, gae", cfg.WorldsBE)
+	}
+
+	switch cfg.GamesBE {
+	case "local":
+		games := fsbe.NewFSGamesService(localDir("games"), clientMgr)
+		games.Notifications = cfg.Notifications
+		out.Games = games
+	case "pg":
+		games := gormbe.NewGamesService(ensureDB(), clientMgr)
+		games.Notifications = cfg.Notifications
+		out.Games = games
+	case "gae":
+		client, err := ensureDatastore()
+		if err != nil {
+			return nil, err
+		}
+		games := gaebe.NewGamesService(client, cfg.GAENamespace, clientMgr)
+		games.Notifications = cfg.Notifications
+		out.Games = games
+	default:
+		return nil, fmt.Errorf("invalid games_service_be: %s. Valid options: local, pg, gae", cfg.GamesBE)
+	}
+
+	switch cfg.FilestoreBE {
+	case "local":
+		out.FileStore = fsbe.NewFileStoreService(localDir("files"), clientMgr)
+	case "r2":
+		bucket := os.Getenv("R2_BUCKET")
+		if bucket == "" {
+			bucket = "lilbattle-assets"
+		}
+		r2Client, err := r2.NewR2Client(r2.R2Config{
+			AccountID:       os.Getenv("R2_ACCOUNT_ID"),
+			AccessKeyID:     os.Getenv("R2_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("R2_SECRET_ACCESS_KEY"),
+			Bucket:          bucket,
+			PublicURL:       "",                       // Leave empty for private bucket
+			Endpoint:        os.Getenv("R2_ENDPOINT"), // Set to use MinIO or another S3 compatible store
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not instantiate r2 client: %w", err)
+		}
+		out.FileStore = r2.NewR2FileStoreService(r2Client)
+	case "gae":
+		return nil, fmt.Errorf("GAE/Datastore backend not yet implemented for filestore")
+	default:
+		return nil, fmt.Errorf("invalid filestore_be: %s. Valid options: local, r2, gae", cfg.FilestoreBE)
+	}
+
+	// Puzzles are only kept on the file system for now
+	out.Puzzles = fsbe.NewFSPuzzlesService(localDir("puzzles"), clientMgr)
+
+	// Indexer tables are only kept on the file system for now
+	out.Indexer = fsbe.NewFSIndexerService(localDir("indexer"))
+
+	// Sync service for multiplayer real-time updates
+	out.Sync = services.NewGameSyncService()
+	out.Sync.Seats = services.GameSeats(out.Games)
+	out.Sync.Delays = services.GameSpectatorDelays(out.Games)
+	return out, nil
+}
+
+// Register registers the services on a gRPC server
+func (b *BackendServices) Register(server *grpc.Server) {
+	v1s.RegisterWorldsServiceServer(server, b.Worlds)
+	v1s.RegisterGamesServiceServer(server, services.NewViewerGamesService(b.Games))
+	v1s.RegisterFileStoreServiceServer(server, b.FileStore)
+	v1s.RegisterPuzzlesServiceServer(server, b.Puzzles)
+	v1s.RegisterGameSyncServiceServer(server, b.Sync)
+	v1s.RegisterIndexerServiceServer(server, b.Indexer)
+}
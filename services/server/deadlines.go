@@ -0,0 +1,38 @@
+//go:build !wasm
+// +build !wasm
+
+package server
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DefaultHeavyRPCTimeout is the deadline HeavyMethods get unless configured
+const DefaultHeavyRPCTimeout = 30 * time.Second
+
+// HeavyMethods are the gRPC methods whose cost grows with the map, the game's
+// history or the request, and so are given a deadline by default
+var HeavyMethods = []string{
+	"/lilbattle.v1.GamesService/ForkGame",
+	"/lilbattle.v1.GamesService/GetOptionsAt",
+	"/lilbattle.v1.GamesService/GetStateDiff",
+	"/lilbattle.v1.GamesService/SimulateAttack",
+	"/lilbattle.v1.GamesService/SimulateFix",
+}
+
+// UnaryDeadlineInterceptor gives calls to the methods a deadline of timeout,
+// unless the caller already set an earlier one
+func UnaryDeadlineInterceptor(timeout time.Duration, methods []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !slices.Contains(methods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"log/slog"
 	"net"
 	"os"
+	"time"
 
 	oagrpc "github.com/panyam/oneauth/grpc"
 	"google.golang.org/grpc"
@@ -26,6 +27,8 @@ type Server struct {
 	// PublicMethods is a list of gRPC method paths that don't require authentication.
 	// Format: "/package.Service/Method" e.g. "/lilbattle.v1.WorldsService/ListWorlds"
 	PublicMethods []string
+	// HeavyRPCTimeout is the deadline given to HeavyMethods, 0 for none
+	HeavyRPCTimeout time.Duration
 }
 
 func (s *Server) Start(ctx context.Context, srvErr chan error, srvChan chan bool) error {
@@ -48,10 +51,13 @@ func (s *Server) Start(ctx context.Context, srvErr chan error, srvChan chan bool
 		authConfig.Config.EnableSwitchAuth = true
 	}
 
+	unaryInterceptors := []grpc.UnaryServerInterceptor{oagrpc.UnaryAuthInterceptor(authConfig)}
+	if s.HeavyRPCTimeout > 0 {
+		unaryInterceptors = append(unaryInterceptors, UnaryDeadlineInterceptor(s.HeavyRPCTimeout, HeavyMethods))
+	}
+
 	server := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			oagrpc.UnaryAuthInterceptor(authConfig),
-		),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 		grpc.ChainStreamInterceptor(
 			oagrpc.StreamAuthInterceptor(authConfig),
 		),
@@ -74,7 +80,9 @@ func (s *Server) Start(ctx context.Context, srvErr chan error, srvChan chan bool
 	*/
 
 	// Register services
-	s.RegisterCallback(server)
+	if err := s.RegisterCallback(server); err != nil {
+		return fmt.Errorf("failed to register services: %w", err)
+	}
 
 	// turnengine.RegisterCoordinatorServiceServer(server, coordService)
 
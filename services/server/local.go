@@ -0,0 +1,176 @@
+//go:build !wasm
+// +build !wasm
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// LocalUserID is the user that owns the demo worlds and sits in every seat
+// of the demo game, the same user the web app's test login signs in as
+const LocalUserID = "test1"
+
+// LocalBackend is an all-in-one gRPC backend for local development and
+// tests, by default keeping all of its data under one directory
+type LocalBackend struct {
+	GrpcAddress string
+	DataDir     string
+	ClientMgr   *services.ClientMgr
+	Services    *BackendServices
+
+	stop chan bool
+}
+
+// StartLocalBackend starts a gRPC backend on address with every service
+// stored on the file system under dataDir. Authentication is relaxed so
+// clients can act as any user by sending their ID in the x-user-id header.
+func StartLocalBackend(ctx context.Context, address, dataDir string) (*LocalBackend, error) {
+	cfg := BackendConfig{WorldsBE: "local", GamesBE: "local", FilestoreBE: "local", LocalDataDir: dataDir}
+	return StartBackend(ctx, address, cfg)
+}
+
+// StartBackend is StartLocalBackend with the storage picked by cfg, eg to
+// run against Postgres and R2 in integration tests
+func StartBackend(ctx context.Context, address string, cfg BackendConfig) (*LocalBackend, error) {
+	os.Setenv("DISABLE_API_AUTH", "true")
+
+	b := &LocalBackend{
+		GrpcAddress: address,
+		DataDir:     cfg.LocalDataDir,
+		ClientMgr:   services.NewClientMgr(address),
+		stop:        make(chan bool),
+	}
+	grpcServer := &Server{
+		Address: address,
+		RegisterCallback: func(server *grpc.Server) (err error) {
+			if b.Services, err = NewBackendServices(cfg, b.ClientMgr); err != nil {
+				return err
+			}
+			b.Services.Register(server)
+			return nil
+		},
+	}
+	if err := grpcServer.Start(ctx, make(chan error, 1), b.stop); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Stop shuts the backend down
+func (b *LocalBackend) Stop() {
+	close(b.stop)
+}
+
+// LocalContext returns a context that calls the backend as LocalUserID
+func LocalContext(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "x-user-id", LocalUserID)
+}
+
+// DemoSeed is what SeedDemo created
+type DemoSeed struct {
+	WorldIds []string
+	GameId   string
+}
+
+// SeedDemo creates a couple of small demo worlds through the backend, and a
+// two player game on the first of them with LocalUserID in both seats
+func (b *LocalBackend) SeedDemo(ctx context.Context) (*DemoSeed, error) {
+	ctx = LocalContext(ctx)
+	worlds := b.ClientMgr.GetWorldsSvcClient()
+	games := b.ClientMgr.GetGamesSvcClient()
+
+	seed := &DemoSeed{}
+	for _, demo := range []struct {
+		name, desc string
+		data       *v1.WorldData
+	}{
+		{"Demo Skirmish", "Two armies facing off across open grassland", demoSkirmish()},
+		{"Demo Crossing", "A river split by a single ford", demoCrossing()},
+	} {
+		resp, err := worlds.CreateWorld(ctx, &v1.CreateWorldRequest{
+			World:     &v1.World{Name: demo.name, Description: demo.desc, CreatorId: LocalUserID},
+			WorldData: demo.data,
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create demo world %q: %w", demo.name, err)
+		}
+		seed.WorldIds = append(seed.WorldIds, resp.World.Id)
+	}
+
+	gameResp, err := games.CreateGame(ctx, &v1.CreateGameRequest{Game: &v1.Game{
+		WorldId:   seed.WorldIds[0],
+		Name:      "Demo Game",
+		CreatorId: LocalUserID,
+		Config: &v1.GameConfiguration{
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, PlayerType: "human", UserId: LocalUserID, IsActive: true},
+				{PlayerId: 2, PlayerType: "human", UserId: LocalUserID, IsActive: true},
+			},
+			IncomeConfigs: &v1.IncomeConfig{
+				StartingCoins:  300,
+				GameIncome:     100,
+				LandbaseIncome: lib.DefaultLandbaseIncome,
+			},
+		},
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create demo game: %w", err)
+	}
+	seed.GameId = gameResp.Game.Id
+	return seed, nil
+}
+
+// demoSkirmish is a grass field with a base and two soldiers per player at
+// either end
+func demoSkirmish() *v1.WorldData {
+	wd := demoField(8, 5, func(q, r int32) int32 { return lib.TileTypeGrass })
+	demoBase(wd, 0, 2, 1)
+	demoBase(wd, 7, 2, 2)
+	return wd
+}
+
+// demoCrossing is two banks of grass either side of a river, with a single
+// shallow ford across the middle
+func demoCrossing() *v1.WorldData {
+	wd := demoField(9, 5, func(q, r int32) int32 {
+		switch {
+		case q == 4 && r == 2:
+			return lib.TileTypeWaterShallow
+		case q == 4:
+			return lib.TileTypeWaterRegular
+		default:
+			return lib.TileTypeGrass
+		}
+	})
+	demoBase(wd, 0, 2, 1)
+	demoBase(wd, 8, 2, 2)
+	return wd
+}
+
+// demoField fills a width by height parallelogram of hexes
+func demoField(width, height int32, terrainAt func(q, r int32) int32) *v1.WorldData {
+	wd := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for q := range width {
+		for r := range height {
+			wd.TilesMap[lib.CoordKey(q, r)] = &v1.Tile{Q: q, R: r, TileType: terrainAt(q, r)}
+		}
+	}
+	return wd
+}
+
+// demoBase gives player a land base at q, r with a soldier either side of it
+func demoBase(wd *v1.WorldData, q, r, player int32) {
+	wd.TilesMap[lib.CoordKey(q, r)] = &v1.Tile{Q: q, R: r, TileType: lib.TileTypeLandBase, Player: player}
+	for _, dr := range []int32{-1, 1} {
+		wd.UnitsMap[lib.CoordKey(q, r+dr)] = &v1.Unit{Q: q, R: r + dr, Player: player, UnitType: lib.UnitTypeSoldier}
+	}
+}
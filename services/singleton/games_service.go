@@ -74,6 +74,30 @@ func (w *SingletonGamesService) Load(
 	}
 }
 
+// ExportState serializes the game with its state and history as a game bundle
+func (w *SingletonGamesService) ExportState() ([]byte, error) {
+	rtGame, err := w.GetRuntimeGame(w.SingletonGame, w.SingletonGameState)
+	if err != nil {
+		return nil, err
+	}
+	return services.EncodeGameBundle(w.SingletonGame, w.SingletonGameState, w.SingletonGameMoveHistory, rtGame.RulesHash())
+}
+
+// ImportState replaces the game with one exported by ExportState. Bundles
+// that fail validation return a *services.BundleValidationError and leave
+// the current game as it is.
+func (w *SingletonGamesService) ImportState(data []byte) (*v1.GameBundle, error) {
+	bundle, err := services.DecodeGameBundle(data)
+	if err != nil {
+		return nil, err
+	}
+	w.SingletonGame = bundle.Game
+	w.SingletonGameState = bundle.State
+	w.SingletonGameMoveHistory = bundle.History
+	w.RuntimeGame = nil
+	return bundle, nil
+}
+
 // WASM-specific implementations that operate on singleton data
 
 func (w *SingletonGamesService) GetGame(ctx context.Context, req *v1.GetGameRequest) (*v1.GetGameResponse, error) {
@@ -136,3 +160,48 @@ func (w *SingletonGamesService) ListMoves(ctx context.Context, req *v1.ListMoves
 func (w *SingletonGamesService) JoinGame(ctx context.Context, req *v1.JoinGameRequest) (*v1.JoinGameResponse, error) {
 	return nil, services.ErrNotImplemented
 }
+
+// SetClockPaused is not supported in WASM singleton context - the clock is tracked by the server
+func (w *SingletonGamesService) SetClockPaused(ctx context.Context, req *v1.SetClockPausedRequest) (*v1.SetClockPausedResponse, error) {
+	return nil, services.ErrNotImplemented
+}
+
+// DelegateTurn is not supported in WASM singleton context - seats are authorized by the server
+func (w *SingletonGamesService) DelegateTurn(ctx context.Context, req *v1.DelegateTurnRequest) (*v1.DelegateTurnResponse, error) {
+	return nil, services.ErrNotImplemented
+}
+
+// ClaimNoContactDraw is not supported in WASM singleton context - draws are validated by the server
+func (w *SingletonGamesService) ClaimNoContactDraw(ctx context.Context, req *v1.ClaimNoContactDrawRequest) (*v1.ClaimNoContactDrawResponse, error) {
+	return nil, services.ErrNotImplemented
+}
+
+// DraftUnit is not supported in WASM singleton context - seats are authorized by the server
+func (w *SingletonGamesService) DraftUnit(ctx context.Context, req *v1.DraftUnitRequest) (*v1.DraftUnitResponse, error) {
+	return nil, services.ErrNotImplemented
+}
+
+// GetStateDiff is not supported in WASM singleton context - the move history is kept by the server
+func (w *SingletonGamesService) GetStateDiff(ctx context.Context, req *v1.GetStateDiffRequest) (*v1.GetStateDiffResponse, error) {
+	return nil, services.ErrNotImplemented
+}
+
+// ForkGame is not supported in WASM singleton context - forks are new games on the server
+func (w *SingletonGamesService) ForkGame(ctx context.Context, req *v1.ForkGameRequest) (*v1.ForkGameResponse, error) {
+	return nil, services.ErrNotImplemented
+}
+
+// GetStateAtTurn is not supported in WASM singleton context - the move history is kept by the server
+func (w *SingletonGamesService) GetStateAtTurn(ctx context.Context, req *v1.GetStateAtTurnRequest) (*v1.GetStateAtTurnResponse, error) {
+	return nil, services.ErrNotImplemented
+}
+
+// GetNotificationPreferences is not supported in WASM singleton context - preferences are kept by the server
+func (w *SingletonGamesService) GetNotificationPreferences(ctx context.Context, req *v1.GetNotificationPreferencesRequest) (*v1.GetNotificationPreferencesResponse, error) {
+	return nil, services.ErrNotImplemented
+}
+
+// SetNotificationPreferences is not supported in WASM singleton context - preferences are kept by the server
+func (w *SingletonGamesService) SetNotificationPreferences(ctx context.Context, req *v1.SetNotificationPreferencesRequest) (*v1.SetNotificationPreferencesResponse, error) {
+	return nil, services.ErrNotImplemented
+}
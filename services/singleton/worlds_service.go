@@ -1,6 +1,8 @@
 package singleton
 
 import (
+	"context"
+
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	"github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/services"
@@ -49,3 +51,34 @@ func (w *SingletonWorldsService) Load(
 		panic(err)
 	}
 }
+
+// SetWorldRulesOverrides validates the overrides and applies them to the
+// loaded world so the editor can preview them before saving
+func (w *SingletonWorldsService) SetWorldRulesOverrides(ctx context.Context, req *v1.SetWorldRulesOverridesRequest) (*v1.SetWorldRulesOverridesResponse, error) {
+	overrides := req.Overrides
+	if overrides == nil {
+		overrides = &v1.RulesOverrides{}
+	}
+	if err := lib.DefaultRulesEngine().ValidateRulesOverrides(overrides); err != nil {
+		return nil, err
+	}
+	w.SingletonWorld.RulesOverrides = overrides
+	return &v1.SetWorldRulesOverridesResponse{World: w.SingletonWorld}, nil
+}
+
+// ApplyWorldEdits validates and applies a batch of edits to the loaded world.
+// Nothing is saved so the draft version is left for the save to bump.
+func (w *SingletonWorldsService) ApplyWorldEdits(ctx context.Context, req *v1.ApplyWorldEditsRequest) (*v1.ApplyWorldEditsResponse, error) {
+	worldData, err := lib.DefaultRulesEngine().ApplyWorldEdits(w.SingletonWorldData, req.Edits)
+	if err != nil {
+		return nil, err
+	}
+	w.SingletonWorldData = worldData
+	return &v1.ApplyWorldEditsResponse{Version: worldData.Version}, nil
+}
+
+// GetWorldTiles returns the tiles and units of the loaded world
+func (w *SingletonWorldsService) GetWorldTiles(ctx context.Context, req *v1.GetWorldTilesRequest) (*v1.GetWorldTilesResponse, error) {
+	tiles, units := lib.WorldTilesInRegion(w.SingletonWorldData, req.Region)
+	return &v1.GetWorldTilesResponse{Tiles: tiles, Units: units, Version: w.SingletonWorldData.Version}, nil
+}
@@ -0,0 +1,211 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	lib "github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Static Snapshot Spectating
+// =============================================================================
+//
+// Spectators without WASM watch a game through server-rendered snapshots.
+// Snapshots only ever show the spectator-neutral view of the world, are keyed
+// by a hash of that view, and are rendered once per hash and theme however
+// many spectators are watching.
+
+// DefaultSnapshotCacheSize is how many rendered snapshots a SnapshotCache keeps
+const DefaultSnapshotCacheSize = 256
+
+// SpectatorWorldData returns a copy of the world with everything a spectator
+// cannot see removed. Submerged units are only visible to players with a unit
+// next to them, so they are never shown to spectators.
+func SpectatorWorldData(worldData *v1.WorldData) *v1.WorldData {
+	out := proto.Clone(worldData).(*v1.WorldData)
+	for key, unit := range out.UnitsMap {
+		if unit.Submerged {
+			delete(out.UnitsMap, key)
+		}
+	}
+	return out
+}
+
+// SpectatorStateHash returns a hash of what spectators can see of the world.
+// It only changes when a spectator's snapshot would.
+func SpectatorStateHash(worldData *v1.WorldData) (string, error) {
+	view := SpectatorWorldData(worldData)
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(&v1.WorldData{
+		TilesMap: view.TilesMap,
+		UnitsMap: view.UnitsMap,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash world: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// SpectatorEventLog describes the moves in a game's history for spectators,
+// oldest first. Positions are left out so hidden units are not given away.
+func SpectatorEventLog(history *v1.GameMoveHistory) (events []string) {
+	for _, group := range history.GetGroups() {
+		for _, move := range group.Moves {
+			events = append(events, fmt.Sprintf("Player %d %s", move.Player, spectatorMoveVerb(move)))
+		}
+	}
+	return
+}
+
+func spectatorMoveVerb(move *v1.GameMove) string {
+	switch move.MoveType.(type) {
+	case *v1.GameMove_MoveUnit:
+		return "moved a unit"
+	case *v1.GameMove_AttackUnit:
+		return "attacked"
+	case *v1.GameMove_EndTurn:
+		return "ended their turn"
+	case *v1.GameMove_BuildUnit:
+		return "built a unit"
+	case *v1.GameMove_CaptureBuilding:
+		return "captured a building"
+	case *v1.GameMove_HealUnit:
+		return "healed a unit"
+	case *v1.GameMove_FixUnit:
+		return "fixed a unit"
+	case *v1.GameMove_ConstructTerrain:
+		return "built terrain"
+	case *v1.GameMove_SubmergeUnit:
+		return "submerged or surfaced a unit"
+	case *v1.GameMove_DelegateTurn:
+		return "delegated their turn"
+	case *v1.GameMove_TransformUnit:
+		return "transformed a unit"
+	case *v1.GameMove_SpawnUnit:
+		return "spawned a unit"
+	case *v1.GameMove_DeleteUnit:
+		return "deleted a unit"
+	}
+	return "made a move"
+}
+
+// Snapshot is a rendered spectator view of a world
+type Snapshot struct {
+	Hash        string
+	Data        []byte
+	ContentType string
+}
+
+// SnapshotRenderer renders a world with a theme, returning the image and its
+// content type
+type SnapshotRenderer func(theme string, worldData *v1.WorldData) ([]byte, string, error)
+
+type snapshotEntry struct {
+	once     sync.Once
+	snapshot *Snapshot
+	err      error
+}
+
+// SnapshotCache renders spectator snapshots, sharing each rendering between
+// every spectator of the same view and theme
+type SnapshotCache struct {
+	// Render draws a snapshot. Defaults to RenderSnapshot.
+	Render SnapshotRenderer
+
+	// MaxEntries is how many snapshots are kept before the oldest is dropped
+	MaxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*snapshotEntry
+	order   []string
+}
+
+// NewSnapshotCache creates a cache holding up to maxEntries snapshots
+func NewSnapshotCache(maxEntries int) *SnapshotCache {
+	return &SnapshotCache{
+		Render:     RenderSnapshot,
+		MaxEntries: maxEntries,
+		entries:    map[string]*snapshotEntry{},
+	}
+}
+
+// Get returns the spectator snapshot of the world in the theme, rendering it
+// only if no spectator has asked for this view in this theme before
+func (c *SnapshotCache) Get(theme string, worldData *v1.WorldData) (*Snapshot, error) {
+	hash, err := SpectatorStateHash(worldData)
+	if err != nil {
+		return nil, err
+	}
+
+	key := theme + ":" + hash
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &snapshotEntry{}
+		c.entries[key] = entry
+		c.order = append(c.order, key)
+		for len(c.order) > c.MaxEntries {
+			delete(c.entries, c.order[0])
+			c.order = c.order[1:]
+		}
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		data, contentType, err := c.Render(theme, SpectatorWorldData(worldData))
+		if err != nil {
+			entry.err = err
+			return
+		}
+		entry.snapshot = &Snapshot{Hash: hash, Data: data, ContentType: contentType}
+	})
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+			c.order = removeKey(c.order, key)
+		}
+		c.mu.Unlock()
+	}
+	return entry.snapshot, entry.err
+}
+
+func removeKey(keys []string, key string) []string {
+	for i, k := range keys {
+		if k == key {
+			return append(keys[:i], keys[i+1:]...)
+		}
+	}
+	return keys
+}
+
+// RenderSnapshot renders a world with one of the built in themes
+func RenderSnapshot(themeName string, worldData *v1.WorldData) ([]byte, string, error) {
+	theme, err := themes.CreateTheme(themeName, lib.DefaultRulesEngine().GetCityTerrains())
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid theme %q: %w", themeName, err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create renderer for theme %q: %w", themeName, err)
+	}
+	return renderer.Render(worldData.TilesMap, worldData.UnitsMap, nil)
+}
+
+// SpectatorThemeName returns the theme to render with, defaulting to "default"
+func SpectatorThemeName(theme string) string {
+	if theme = strings.TrimSpace(theme); theme == "" {
+		return "default"
+	}
+	return theme
+}
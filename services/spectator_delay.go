@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// =============================================================================
+// Spectator Delay
+// =============================================================================
+//
+// Games can hold spectators a few turns or minutes behind the players, so
+// nobody watching a streamer's game can relay what they see back to the
+// opponent.  The sync service keeps each game's recent change-sets with when
+// and in which turn they were made, and releases them to delayed spectators
+// once they are old enough; players get them live.
+
+const (
+	// maxBufferedChangeSets is how many change-sets are kept per game for
+	// backfill and delayed spectators
+	maxBufferedChangeSets = 512
+
+	// DefaultSpectatorDelayPollInterval is how often delayed spectators are
+	// sent the change-sets whose delay has passed
+	DefaultSpectatorDelayPollInterval = time.Second
+)
+
+// SpectatorDelayResolver returns a game's spectator delay, nil if spectators
+// watch it live
+type SpectatorDelayResolver func(ctx context.Context, gameId string) (*v1.SpectatorDelay, error)
+
+// GameSpectatorDelays resolves spectator delays by loading the game from a
+// games service
+func GameSpectatorDelays(games GameLoader) SpectatorDelayResolver {
+	return func(ctx context.Context, gameId string) (*v1.SpectatorDelay, error) {
+		resp, err := games.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+		if err != nil {
+			return nil, err
+		}
+		return GameSpectatorDelay(resp.Game), nil
+	}
+}
+
+// GameSpectatorDelay returns the game's spectator delay, nil if it has none
+func GameSpectatorDelay(game *v1.Game) *v1.SpectatorDelay {
+	delay := game.GetConfig().GetSettings().GetSpectatorDelay()
+	if delay.GetTurns() <= 0 && delay.GetSeconds() <= 0 {
+		return nil
+	}
+	return delay
+}
+
+// SpectatorDelayNote describes a spectator delay for the spectator UI, eg
+// "Delayed by 2 turns"
+func SpectatorDelayNote(delay *v1.SpectatorDelay) string {
+	var parts []string
+	if turns := delay.GetTurns(); turns > 0 {
+		parts = append(parts, pluralize(int(turns), "turn"))
+	}
+	if seconds := delay.GetSeconds(); seconds > 0 {
+		if seconds%60 == 0 {
+			parts = append(parts, pluralize(int(seconds/60), "minute"))
+		} else {
+			parts = append(parts, pluralize(int(seconds), "second"))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "Delayed by " + strings.Join(parts, " and ")
+}
+
+func pluralize(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// IsSpectatorStateChange reports whether a GameSync update may have changed
+// what spectators see
+func IsSpectatorStateChange(update *v1.GameUpdate) bool {
+	switch update.UpdateType.(type) {
+	case *v1.GameUpdate_MovesPublished, *v1.GameUpdate_EncodedMoves, *v1.GameUpdate_GameEnded:
+		return true
+	}
+	return false
+}
+
+// delayPassed reports whether something made in turn at time at may be shown
+// to spectators delayed by delay, now that the game is in currentTurn
+func delayPassed(delay *v1.SpectatorDelay, turn int32, at time.Time, currentTurn int32, now time.Time) bool {
+	return currentTurn-turn >= delay.GetTurns() && now.Sub(at) >= time.Duration(delay.GetSeconds())*time.Second
+}
+
+// moveTurns returns the turn moves were made in and the turn the game is in
+// after them, given the turn it was in before them (0 if unknown)
+func moveTurns(moves []*v1.GameMove, turn int32) (madeIn, after int32) {
+	madeIn, after = turn, turn
+	changed := false
+	for _, move := range moves {
+		for _, change := range move.Changes {
+			if playerChanged := change.GetPlayerChanged(); playerChanged != nil {
+				if !changed {
+					madeIn, changed = playerChanged.PreviousTurn, true
+				}
+				after = playerChanged.NewTurn
+			}
+		}
+	}
+	return
+}
+
+// SpectatorCutoff returns how much of a game's history spectators delayed by
+// delay may see: the turn and player the first move group still held back
+// was played in, and the move groups before that player's turn started.  ok
+// is false when nothing is held back.
+func SpectatorCutoff(history *v1.GameMoveHistory, delay *v1.SpectatorDelay, now time.Time) (visible []*v1.GameMoveGroup, turn, player int32, ok bool) {
+	groups := history.GetGroups()
+	turns := make([]int32, len(groups))
+	currentTurn := int32(1)
+	for i, group := range groups {
+		turns[i], currentTurn = moveTurns(group.Moves, currentTurn)
+	}
+	for i, group := range groups {
+		endedAt := now
+		if group.EndedAt != nil {
+			endedAt = group.EndedAt.AsTime()
+		}
+		if !delayPassed(delay, turns[i], endedAt, currentTurn, now) {
+			player = groupPlayer(group)
+			for i > 0 && turns[i-1] == turns[i] && groupPlayer(groups[i-1]) == player {
+				i--
+			}
+			return groups[:i], turns[i], player, true
+		}
+	}
+	return groups, 0, 0, false
+}
+
+// groupPlayer returns the player who played a move group
+func groupPlayer(group *v1.GameMoveGroup) int32 {
+	if len(group.Moves) == 0 {
+		return 0
+	}
+	return group.Moves[0].Player
+}
+
+// bufferedChangeSet is a change-set broadcast to a game, kept for
+// subscribers that get it late
+type bufferedChangeSet struct {
+	update *v1.GameUpdate
+	at     time.Time
+	turn   int32
+}
+
+// changeSetLog is the ring buffer of a game's recent change-sets
+type changeSetLog struct {
+	entries []bufferedChangeSet
+
+	// Turn the game is in as far as its change-sets tell, 0 until one of
+	// them ends a turn
+	turn int32
+}
+
+// add keeps a change-set broadcast at the given time
+func (l *changeSetLog) add(update *v1.GameUpdate, at time.Time) {
+	madeIn, after := moveTurns(update.GetMovesPublished().GetMoves(), l.turn)
+	if l.turn == 0 && madeIn != 0 {
+		// Change-sets from before the first turn change were made in the
+		// turn it ended
+		for i := range l.entries {
+			if l.entries[i].turn == 0 {
+				l.entries[i].turn = madeIn
+			}
+		}
+	}
+	l.turn = after
+	l.entries = append(l.entries, bufferedChangeSet{update: update, at: at, turn: madeIn})
+	if len(l.entries) > maxBufferedChangeSets {
+		l.entries = l.entries[len(l.entries)-maxBufferedChangeSets:]
+	}
+}
+
+// since returns the kept change-sets after a sequence number, up to the first
+// one the delay (if any) still holds back
+func (l *changeSetLog) since(sequence int64, delay *v1.SpectatorDelay, now time.Time) (out []*v1.GameUpdate) {
+	for _, entry := range l.entries {
+		if entry.update.Sequence <= sequence {
+			continue
+		}
+		if delay != nil && !delayPassed(delay, entry.turn, entry.at, l.turn, now) {
+			break
+		}
+		out = append(out, entry.update)
+	}
+	return
+}
+
+// releasedSequence returns the sequence number delayed spectators are caught
+// up to: the one before the first change-set still held back from them
+func (l *changeSetLog) releasedSequence(current int64, delay *v1.SpectatorDelay, now time.Time) int64 {
+	released := l.since(0, delay, now)
+	held := len(l.entries) - len(released)
+	if held == 0 {
+		return current
+	}
+	return l.entries[len(released)].update.Sequence - 1
+}
+
+// recordChangeSet keeps a change-set broadcast to a game
+func (s *GameSyncService) recordChangeSet(gameId string, update *v1.GameUpdate) {
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changeSets, ok := s.changeSets[gameId]
+	if !ok {
+		changeSets = &changeSetLog{}
+		s.changeSets[gameId] = changeSets
+	}
+	changeSets.add(update, now)
+}
+
+// changeSetsSince returns a game's kept change-sets after a sequence number
+// that are not held back by the delay
+func (s *GameSyncService) changeSetsSince(gameId string, sequence int64, delay *v1.SpectatorDelay) []*v1.GameUpdate {
+	now := s.now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	changeSets, ok := s.changeSets[gameId]
+	if !ok {
+		return nil
+	}
+	return changeSets.since(sequence, delay, now)
+}
+
+// releasedSequence returns the sequence number spectators delayed by delay
+// are caught up to in a game
+func (s *GameSyncService) releasedSequence(gameId string, delay *v1.SpectatorDelay) int64 {
+	now := s.now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	current := s.sequences[gameId]
+	changeSets, ok := s.changeSets[gameId]
+	if !ok {
+		return current
+	}
+	return changeSets.releasedSequence(current, delay, now)
+}
+
+// spectatorDelay returns the delay a subscriber to a game is held to, nil
+// for players and for spectators of games without one
+func (s *GameSyncService) spectatorDelay(ctx context.Context, gameId string, spectator bool) (*v1.SpectatorDelay, error) {
+	if !spectator || s.Delays == nil {
+		return nil, nil
+	}
+	return s.Delays(ctx, gameId)
+}
+
+// delayPollInterval returns how often delayed spectators are caught up
+func (s *GameSyncService) delayPollInterval() time.Duration {
+	if s.DelayPollInterval <= 0 {
+		return DefaultSpectatorDelayPollInterval
+	}
+	return s.DelayPollInterval
+}
@@ -0,0 +1,104 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/proto"
+)
+
+// GetStateDiff returns everything that changed in a game from the start of
+// one turn to the start of another. Both states are rebuilt by replaying the
+// game's move history from the nearest turn snapshot, so units that were
+// built and killed in between show up too.
+func (s *BackendGamesService) GetStateDiff(ctx context.Context, req *v1.GetStateDiffRequest) (*v1.GetStateDiffResponse, error) {
+	if req.GameId == "" {
+		return nil, fmt.Errorf("game ID is required")
+	}
+	if req.FromTurn > req.ToTurn {
+		return nil, fmt.Errorf("from turn %d is after to turn %d", req.FromTurn, req.ToTurn)
+	}
+	gameresp, err := s.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.initialGameState(ctx, gameresp.Game)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := s.loadTurnSnapshots(ctx, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The diff starts from the nearest turn snapshot, replayed on to the
+	// start of from_turn, and a from_turn past the current turn is no change
+	turnState, err := s.newRuntimeGame(gameresp.Game, state).GetStateAtTurn(snapshots, gameresp.History.GetGroups(), req.FromTurn, 0)
+	if errors.Is(err, lib.ErrTurnNotReached) {
+		return &v1.GetStateDiffResponse{Diff: lib.DiffSpan(gameresp.State, gameresp.State, nil)}, nil
+	}
+	if err != nil {
+		return nil, rpcError(fmt.Errorf("failed to replay game %s: %w", req.GameId, err))
+	}
+	rtGame := turnState.Game
+
+	snapshot := func() *v1.GameState {
+		rtGame.GameState.WorldData = rtGame.World.WorldData()
+		return proto.Clone(rtGame.GameState).(*v1.GameState)
+	}
+	from := snapshot()
+	var to *v1.GameState
+	var changes []*v1.WorldChange
+	for _, move := range turnState.Moves {
+		if rtGame.TurnCounter >= req.ToTurn {
+			to = snapshot()
+			break
+		}
+
+		// Applying a built unit's change adds that very unit to the world, so
+		// moves are copied before they are recorded or replayed
+		changes = append(changes, proto.Clone(move).(*v1.GameMove).Changes...)
+		if err := rtGame.ApplyChangesContext(ctx, []*v1.GameMove{proto.Clone(move).(*v1.GameMove)}); err != nil {
+			return nil, rpcError(fmt.Errorf("failed to replay game %s: %w", req.GameId, err))
+		}
+	}
+	if to == nil {
+		to = snapshot()
+	}
+	return &v1.GetStateDiffResponse{Diff: lib.DiffSpan(from, to, changes)}, nil
+}
+
+// initialGameState rebuilds the state a game started from out of its world
+func (s *BackendGamesService) initialGameState(ctx context.Context, game *v1.Game) (*v1.GameState, error) {
+	if s.ClientMgr == nil {
+		return nil, fmt.Errorf("worlds service not configured")
+	}
+	world, err := s.ClientMgr.GetWorldsSvcClient().GetWorld(ctx, &v1.GetWorldRequest{Id: game.WorldId})
+	if err != nil {
+		return nil, fmt.Errorf("Error loading world: %w", err)
+	}
+	state := &v1.GameState{
+		GameId:        game.Id,
+		CurrentPlayer: 1,
+		TurnCounter:   1,
+		WorldData:     proto.Clone(world.WorldData).(*v1.WorldData),
+	}
+	lib.MigrateWorldData(state.WorldData)
+	lib.EnsureShortcuts(state.WorldData)
+	s.InitializePlayerStates(state, game.Config)
+	return state, nil
+}
+
+// historyMoves flattens a game's move history into its moves, in order
+func historyMoves(history *v1.GameMoveHistory) (moves []*v1.GameMove) {
+	for _, group := range history.GetGroups() {
+		moves = append(moves, group.Moves...)
+	}
+	return moves
+}
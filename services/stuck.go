@@ -0,0 +1,125 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// NoContactDrawReason is the GameEnded reason sent when a stuck game is
+// ended as a draw
+const NoContactDrawReason = "no-contact draw"
+
+// stuckWarning returns the game's stuck analysis if a game in progress is
+// stuck, or nil
+func (s *BackendGamesService) stuckWarning(game *v1.Game, state *v1.GameState) *v1.StuckAnalysis {
+	if state.Finished {
+		return nil
+	}
+	if analysis := s.newRuntimeGame(game, state).StuckAnalysis(); analysis.Stuck {
+		return analysis
+	}
+	return nil
+}
+
+// ClaimNoContactDraw ends a stuck game as a draw. The claim is only accepted
+// when the game is stuck for every player still in it.
+func (s *BackendGamesService) ClaimNoContactDraw(ctx context.Context, req *v1.ClaimNoContactDrawRequest) (*v1.ClaimNoContactDrawResponse, error) {
+	if req.GameId == "" {
+		return nil, fmt.Errorf("game ID is required")
+	}
+	if s.StorageProvider == nil {
+		return nil, fmt.Errorf("storage provider not configured")
+	}
+	if err := s.enforceTimeBank(ctx, req.GameId); err != nil {
+		return nil, err
+	}
+
+	gameresp, err := s.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	game, state := gameresp.Game, gameresp.State
+	if state.Finished {
+		return nil, fmt.Errorf("game %s has already finished", req.GameId)
+	}
+	if _, err := requireSeat(ctx, game, req.PlayerId); err != nil {
+		return nil, err
+	}
+
+	analysis, stuck := s.newRuntimeGame(game, state).NoContactDraw()
+	if !stuck {
+		return nil, fmt.Errorf("game %s is not stuck: player %d has %d actionable units, %d useful builds and %d buildings to capture",
+			req.GameId, analysis.Player, analysis.ActionableUnits, analysis.AffordableBuilds, analysis.IncomeGrowthPaths)
+	}
+
+	state.Finished = true
+	state.Status = v1.GameStatus_GAME_STATUS_ENDED
+	state.WinningPlayer = 0
+	state.ClockStartedAt = nil
+	if err := s.StorageProvider.SaveGameState(ctx, req.GameId, state); err != nil {
+		return nil, fmt.Errorf("failed to save game state: %w", err)
+	}
+	s.updateCache(req.GameId, nil, state, nil)
+
+	s.broadcastUpdate(ctx, req.GameId, &v1.GameUpdate{
+		UpdateType: &v1.GameUpdate_GameEnded{
+			GameEnded: &v1.GameEnded{Reason: NoContactDrawReason},
+		},
+	})
+	return &v1.ClaimNoContactDrawResponse{Analysis: analysis}, nil
+}
+
+// announceTurnEnd lets the game's subscribers know when moves end the game,
+// or when a turn ends with the game stuck so either player can claim a draw
+func (s *BackendGamesService) announceTurnEnd(ctx context.Context, gameId string, moves []*v1.GameMove) {
+	endsTurn := false
+	for _, move := range moves {
+		if move.GetEndTurn() != nil {
+			endsTurn = true
+		}
+	}
+
+	gameresp, err := s.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		log.Printf("Failed to check whether game %s is stuck: %v", gameId, err)
+		return
+	}
+	// Puzzles can end on any move, other games only when a turn ends
+	if gameresp.State.Finished {
+		s.broadcastUpdate(ctx, gameId, &v1.GameUpdate{
+			UpdateType: &v1.GameUpdate_GameEnded{
+				GameEnded: &v1.GameEnded{Winner: gameresp.State.WinningPlayer, Reason: gameEndReason(gameresp.Game, gameresp.State)},
+			},
+		})
+		return
+	}
+	if !endsTurn {
+		return
+	}
+	if warning := s.stuckWarning(gameresp.Game, gameresp.State); warning != nil {
+		s.broadcastUpdate(ctx, gameId, &v1.GameUpdate{
+			UpdateType: &v1.GameUpdate_StuckWarning{StuckWarning: warning},
+		})
+	}
+}
+
+// broadcastUpdate sends an update to the game's sync subscribers, if there
+// is a sync service to send it through
+func (s *BackendGamesService) broadcastUpdate(ctx context.Context, gameId string, update *v1.GameUpdate) {
+	if s.ClientMgr == nil {
+		return
+	}
+	syncClient := s.ClientMgr.GetGameSyncSvcClient()
+	if syncClient == nil {
+		return
+	}
+	if _, err := syncClient.Broadcast(ctx, &v1.BroadcastRequest{GameId: gameId, Update: update}); err != nil {
+		log.Printf("Failed to broadcast update for game %s: %v", gameId, err)
+	}
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Encodings a GameSync subscriber can negotiate for move payloads
+const (
+	// EncodingJSON sends moves as plain MovesPublished updates
+	EncodingJSON = "json"
+
+	// EncodingProtoGzip sends moves as gzip-compressed binary proto
+	EncodingProtoGzip = "proto+gzip"
+)
+
+// NegotiateEncoding returns the first of the client's accepted encodings that
+// the server supports, falling back to EncodingJSON
+func NegotiateEncoding(accepted []string) string {
+	for _, encoding := range accepted {
+		if encoding == EncodingJSON || encoding == EncodingProtoGzip {
+			return encoding
+		}
+	}
+	return EncodingJSON
+}
+
+// EncodeUpdate returns the update as it should be sent to a subscriber using
+// the given encoding. Only MovesPublished updates are re-encoded; everything
+// else is small and sent as is.
+func EncodeUpdate(update *v1.GameUpdate, encoding string) (*v1.GameUpdate, error) {
+	moves := update.GetMovesPublished()
+	if moves == nil || encoding != EncodingProtoGzip {
+		return update, nil
+	}
+
+	data, err := proto.Marshal(moves)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal moves: %w", err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to compress moves: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress moves: %w", err)
+	}
+
+	return &v1.GameUpdate{
+		Sequence: update.Sequence,
+		UpdateType: &v1.GameUpdate_EncodedMoves{
+			EncodedMoves: &v1.EncodedPayload{Encoding: encoding, Data: buf.Bytes()},
+		},
+	}, nil
+}
+
+// DecodeMovesPublished decodes moves sent with EncodeUpdate
+func DecodeMovesPublished(payload *v1.EncodedPayload) (*v1.MovesPublished, error) {
+	if payload.Encoding != EncodingProtoGzip {
+		return nil, fmt.Errorf("unsupported encoding %q", payload.Encoding)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(payload.Data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress moves: %w", err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress moves: %w", err)
+	}
+
+	moves := &v1.MovesPublished{}
+	if err := proto.Unmarshal(data, moves); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal moves: %w", err)
+	}
+	return moves, nil
+}
+
+// PayloadSize returns the size of an update on the wire for an encoding:
+// JSON for EncodingJSON subscribers, binary proto otherwise
+func PayloadSize(update *v1.GameUpdate, encoding string) int {
+	if encoding == EncodingJSON {
+		data, _ := protojson.Marshal(update)
+		return len(data)
+	}
+	return proto.Size(update)
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PingTypes are the alerts a player can mark a hex with
+var PingTypes = []string{"attack", "defend", "danger"}
+
+const (
+	// MaxPingsPerMinute limits how often a player can ping their team
+	MaxPingsPerMinute = 5
+
+	// maxRecentPings is how many pings are kept per game for late subscribers
+	maxRecentPings = 50
+)
+
+// ErrPingRateLimited is returned when a player pings too often
+var ErrPingRateLimited = errors.New("too many pings, try again in a minute")
+
+// SeatResolver returns the player slot and team of the authenticated user in
+// a game. The team is 0 when the game is not played in teams.
+type SeatResolver func(ctx context.Context, gameId string) (player int32, team int32, err error)
+
+// GameLoader loads a game by ID
+type GameLoader interface {
+	GetGame(context.Context, *v1.GetGameRequest) (*v1.GetGameResponse, error)
+}
+
+// GameSeats resolves seats by loading the game from a games service
+func GameSeats(games GameLoader) SeatResolver {
+	return func(ctx context.Context, gameId string) (int32, int32, error) {
+		resp, err := games.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+		if err != nil {
+			return 0, 0, err
+		}
+		player, err := authz.RequireGamePlayer(ctx, resp.Game)
+		if err != nil {
+			return 0, 0, err
+		}
+		return player, PlayerTeam(resp.Game, player), nil
+	}
+}
+
+// PlayerTeam returns a player's team in a team game, or 0
+func PlayerTeam(game *v1.Game, player int32) int32 {
+	if game.Config.GetSettings().GetTeamMode() != "teams" {
+		return 0
+	}
+	for _, p := range game.Config.GetPlayers() {
+		if p.PlayerId == player {
+			return p.TeamId
+		}
+	}
+	return 0
+}
+
+// SendPing marks a hex for the sender's teammates. The ping is broadcast to
+// subscribers on the same team only and kept for teammates who subscribe later.
+func (s *GameSyncService) SendPing(ctx context.Context, req *v1.SendPingRequest) (*v1.SendPingResponse, error) {
+	if !slices.Contains(PingTypes, req.PingType) {
+		return nil, fmt.Errorf("unknown ping type %q (expected %s)", req.PingType, strings.Join(PingTypes, ", "))
+	}
+	if req.Pos == nil {
+		return nil, fmt.Errorf("ping position is required")
+	}
+	if s.Seats == nil {
+		return nil, ErrNotImplemented
+	}
+
+	player, team, err := s.Seats(ctx, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+	if team == 0 {
+		return nil, fmt.Errorf("pings are only available in team games")
+	}
+
+	now := s.now()
+	if !s.allowPing(req.GameId, player, now) {
+		return nil, ErrPingRateLimited
+	}
+
+	ping := &v1.Ping{
+		PlayerId: player,
+		TeamId:   team,
+		Pos:      req.Pos,
+		PingType: req.PingType,
+		SentAt:   timestamppb.New(now),
+	}
+
+	s.mu.Lock()
+	pings := append(s.pings[req.GameId], ping)
+	if len(pings) > maxRecentPings {
+		pings = pings[len(pings)-maxRecentPings:]
+	}
+	s.pings[req.GameId] = pings
+	s.mu.Unlock()
+
+	s.broadcastInternal(req.GameId, &v1.GameUpdate{
+		Sequence:   s.nextSequence(req.GameId),
+		UpdateType: &v1.GameUpdate_Ping{Ping: ping},
+	})
+	return &v1.SendPingResponse{Ping: ping}, nil
+}
+
+// allowPing records a ping by a player unless they have already sent
+// MaxPingsPerMinute pings in the last minute
+func (s *GameSyncService) allowPing(gameId string, player int32, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	times := s.pingTimes[gameId]
+	if times == nil {
+		times = make(map[int32][]time.Time)
+		s.pingTimes[gameId] = times
+	}
+	// Forget pings older than a minute, and players with none left
+	for p, sent := range times {
+		recent := slices.DeleteFunc(sent, func(t time.Time) bool {
+			return now.Sub(t) >= time.Minute
+		})
+		if len(recent) == 0 {
+			delete(times, p)
+		} else {
+			times[p] = recent
+		}
+	}
+	if len(times[player]) >= MaxPingsPerMinute {
+		return false
+	}
+	times[player] = append(times[player], now)
+	return true
+}
+
+// dropPings forgets a game's pings and ping times once the game has ended or
+// its last subscriber has left
+func (s *GameSyncService) dropPings(gameId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pings, gameId)
+	delete(s.pingTimes, gameId)
+}
+
+// recentPings returns the pings kept for a game that are visible to a team
+func (s *GameSyncService) recentPings(gameId string, team int32) (out []*v1.Ping) {
+	if team == 0 {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, ping := range s.pings[gameId] {
+		if ping.TeamId == team {
+			out = append(out, ping)
+		}
+	}
+	return
+}
+
+// visibleToTeam reports whether a subscriber on the team may receive the update
+func visibleToTeam(update *v1.GameUpdate, team int32) bool {
+	ping := update.GetPing()
+	return ping == nil || (team != 0 && ping.TeamId == team)
+}
+
+// now returns the current time from the configured clock
+func (s *GameSyncService) now() time.Time {
+	if s.Clock == nil {
+		return time.Now()
+	}
+	return s.Clock.Now()
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/panyam/gocurrent"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"github.com/turnforge/lilbattle/lib"
 	"google.golang.org/grpc"
 )
 
@@ -33,14 +35,49 @@ type GameSyncService struct {
 	// Per-game sequence numbers for ordering
 	sequences map[string]int64
 
+	// Per-game recent change-sets, for backfill and delayed spectators
+	changeSets map[string]*changeSetLog
+
+	// Resolves a subscriber's seat so pings only reach their team.
+	// Without it pings are disabled.
+	Seats SeatResolver
+
+	// Resolves a game's spectator delay. Spectators (subscribers Seats
+	// does not seat) of a delayed game only get change-sets once they are old
+	// enough. Without it everyone watches live.
+	Delays SpectatorDelayResolver
+
+	// How often delayed spectators are sent change-sets whose delay has
+	// passed (0 uses DefaultSpectatorDelayPollInterval)
+	DelayPollInterval time.Duration
+
+	// Clock for ping rate limits and spectator delays (nil uses the system
+	// clock)
+	Clock lib.Clock
+
+	// OnPayloadSent is called with the encoding and size of every update
+	// sent to a subscriber (for payload-size metrics)
+	OnPayloadSent func(encoding string, size int)
+
+	// OnBroadcast is called with every update broadcast to a game, whether
+	// or not anyone is subscribed (eg to notify players who are away)
+	OnBroadcast func(gameId string, update *v1.GameUpdate)
+
+	// Recent pings per game, and when each player of a game last pinged
+	pings     map[string][]*v1.Ping
+	pingTimes map[string]map[int32][]time.Time
+
 	mu sync.RWMutex
 }
 
 // NewGameSyncService creates a new sync service
 func NewGameSyncService() *GameSyncService {
 	return &GameSyncService{
-		fanOuts:   make(map[string]*gocurrent.FanOut[*v1.GameUpdate]),
-		sequences: make(map[string]int64),
+		fanOuts:    make(map[string]*gocurrent.FanOut[*v1.GameUpdate]),
+		sequences:  make(map[string]int64),
+		changeSets: make(map[string]*changeSetLog),
+		pings:      make(map[string][]*v1.Ping),
+		pingTimes:  make(map[string]map[int32][]time.Time),
 	}
 }
 
@@ -72,12 +109,47 @@ func (s *GameSyncService) Subscribe(req *v1.SubscribeRequest, stream grpc.Server
 	currentSeq := s.sequences[gameId]
 	s.mu.RUnlock()
 
+	// Pings are only delivered to the subscriber's team (spectators get none)
+	var team int32
+	spectator := false
+	if s.Seats != nil {
+		var err error
+		_, team, err = s.Seats(stream.Context(), gameId)
+		spectator = err != nil
+	}
+
+	// Spectators of a delayed game start from the last change-set released
+	// to them
+	delay, err := s.spectatorDelay(stream.Context(), gameId, spectator)
+	if err != nil {
+		return fmt.Errorf("failed to get spectator delay: %w", err)
+	}
+	if delay != nil {
+		currentSeq = s.releasedSequence(gameId, delay)
+	}
+
+	// Moves go out in the encoding the subscriber negotiated
+	encoding := NegotiateEncoding(req.AcceptedEncodings)
+	send := func(update *v1.GameUpdate) error {
+		out, err := EncodeUpdate(update, encoding)
+		if err != nil {
+			return err
+		}
+		if s.OnPayloadSent != nil {
+			s.OnPayloadSent(encoding, PayloadSize(out, encoding))
+		}
+		return stream.Send(out)
+	}
+
 	// Send initial state (game state should be loaded separately by client via GetGame)
 	initialState := &v1.SubscribeResponse{
 		CurrentSequence: currentSeq,
+		RecentPings:     s.recentPings(gameId, team),
+		Encoding:        encoding,
+		SpectatorDelay:  delay,
 	}
 
-	err := stream.Send(&v1.GameUpdate{
+	err = send(&v1.GameUpdate{
 		Sequence: currentSeq,
 		UpdateType: &v1.GameUpdate_InitialState{
 			InitialState: initialState,
@@ -87,9 +159,6 @@ func (s *GameSyncService) Subscribe(req *v1.SubscribeRequest, stream grpc.Server
 		return fmt.Errorf("failed to send initial state: %w", err)
 	}
 
-	// TODO: If req.FromSequence > 0, send missed updates from history
-	// For now, we only support fresh subscriptions
-
 	// Get or create FanOut for this game
 	fanOut := s.getFanOut(gameId)
 
@@ -97,8 +166,35 @@ func (s *GameSyncService) Subscribe(req *v1.SubscribeRequest, stream grpc.Server
 	outputChan := fanOut.New(nil)
 	defer func() {
 		<-fanOut.Remove(outputChan, true)
+		if fanOut.Count() == 0 {
+			s.dropPings(gameId)
+		}
 	}()
 
+	// Resend the change-sets missed since from_sequence that are still
+	// kept, and catch delayed spectators up as their delay passes
+	sent := max(req.FromSequence, currentSeq)
+	catchUp := func(after int64) error {
+		for _, update := range s.changeSetsSince(gameId, after, delay) {
+			if err := send(update); err != nil {
+				return err
+			}
+			sent = update.Sequence
+		}
+		return nil
+	}
+	if req.FromSequence > 0 && req.FromSequence < currentSeq {
+		if err := catchUp(req.FromSequence); err != nil {
+			return err
+		}
+	}
+	var delayTicks <-chan time.Time
+	if delay != nil {
+		ticker := time.NewTicker(s.delayPollInterval())
+		defer ticker.Stop()
+		delayTicks = ticker.C
+	}
+
 	// Broadcast player joined
 	s.broadcastInternal(gameId, &v1.GameUpdate{
 		Sequence: s.nextSequence(gameId),
@@ -130,7 +226,26 @@ func (s *GameSyncService) Subscribe(req *v1.SubscribeRequest, stream grpc.Server
 				// Channel closed (FanOut stopped)
 				return nil
 			}
-			if err := stream.Send(update); err != nil {
+			if !visibleToTeam(update, team) {
+				continue
+			}
+			if delay != nil && IsSpectatorStateChange(update) {
+				// Held back until catchUp releases it
+				if err := catchUp(sent); err != nil {
+					return err
+				}
+				continue
+			}
+			if update.Sequence > 0 && update.Sequence <= sent && IsSpectatorStateChange(update) {
+				// Already resent from the change-set log
+				continue
+			}
+			if err := send(update); err != nil {
+				return err
+			}
+
+		case <-delayTicks:
+			if err := catchUp(sent); err != nil {
 				return err
 			}
 		}
@@ -147,8 +262,17 @@ func (s *GameSyncService) Broadcast(ctx context.Context, req *v1.BroadcastReques
 	if update.Sequence == 0 {
 		update.Sequence = s.nextSequence(gameId)
 	}
+	if IsSpectatorStateChange(update) {
+		s.recordChangeSet(gameId, update)
+	}
 
 	count := s.broadcastInternal(gameId, update)
+	if s.OnBroadcast != nil {
+		s.OnBroadcast(gameId, update)
+	}
+	if update.GetGameEnded() != nil {
+		s.dropPings(gameId)
+	}
 
 	return &v1.BroadcastResponse{
 		SubscriberCount: int32(count),
@@ -193,3 +317,15 @@ func (s *GameSyncService) SubscriberCount(gameId string) int {
 	}
 	return fo.Count()
 }
+
+// TotalSubscriberCount returns the number of subscribers across all games
+func (s *GameSyncService) TotalSubscriberCount() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	total := 0
+	for _, fo := range s.fanOuts {
+		total += fo.Count()
+	}
+	return total
+}
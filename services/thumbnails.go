@@ -0,0 +1,70 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// Size in pixels of the previews embedded in saved games
+const (
+	ThumbnailWidth  = 160
+	ThumbnailHeight = 120
+)
+
+// RenderThumbnail renders a small preview of a game's board as a base64
+// encoded PNG, to embed in the game's saved metadata
+func RenderThumbnail(game *v1.Game, state *v1.GameState, rulesEngine *lib.RulesEngine) (string, error) {
+	if state.GetWorldData() == nil {
+		return "", fmt.Errorf("game %s has no world data", game.Id)
+	}
+	renderer, err := themes.NewPNGWorldRenderer(themes.NewDefaultTheme(rulesEngine.GetCityTerrains()))
+	if err != nil {
+		return "", err
+	}
+	options := lib.DefaultRenderOptions()
+	options.Orientation = lib.GetOrientation(game.GetOrientation())
+	data, err := renderer.RenderToFit(state.WorldData.TilesMap, state.WorldData.UnitsMap, options, ThumbnailWidth, ThumbnailHeight, false)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// EmbedThumbnail sets the game's thumbnail to its board in state when
+// thumbnails are on.  A board that cannot be rendered leaves the game as it
+// is, since the thumbnail is only a convenience for game lists.
+func (s *BackendGamesService) EmbedThumbnail(game *v1.Game, state *v1.GameState) {
+	if !s.Thumbnails {
+		return
+	}
+	thumbnail, err := RenderThumbnail(game, state, s.rules())
+	if err != nil {
+		log.Printf("Failed to render thumbnail for game %s: %v", game.Id, err)
+		return
+	}
+	game.Thumbnail = thumbnail
+}
+
+// saveThumbnail re-renders the thumbnail of a saved game after its state
+// changed
+func (s *BackendGamesService) saveThumbnail(ctx context.Context, gameId string, state *v1.GameState) error {
+	game, err := s.StorageProvider.LoadGame(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	s.EmbedThumbnail(game, state)
+	if err := s.StorageProvider.SaveGame(ctx, gameId, game); err != nil {
+		return err
+	}
+	s.updateCache(gameId, game, nil, nil)
+	return nil
+}
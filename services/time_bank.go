@@ -0,0 +1,164 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// now returns the current time from the configured clock
+func (s *BackendGamesService) now() time.Time {
+	if s.Clock == nil {
+		return time.Now()
+	}
+	return s.Clock.Now()
+}
+
+// GetGameState returns the latest game state along with each player's
+// remaining time bank and a warning if the game is stuck. Expired clocks are
+// enforced first.
+func (s *BackendGamesService) GetGameState(ctx context.Context, req *v1.GetGameStateRequest) (*v1.GetGameStateResponse, error) {
+	if req.GameId == "" {
+		return nil, fmt.Errorf("game ID is required")
+	}
+	if err := s.enforceTimeBank(ctx, req.GameId); err != nil {
+		return nil, err
+	}
+
+	gameresp, err := s.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.GetGameStateResponse{State: gameresp.State}
+	if lib.GetTimeBankSettings(gameresp.Game.Config) != nil {
+		resp.RemainingTimeMs = lib.RemainingTimeBanks(gameresp.State, s.now())
+	}
+	resp.StuckWarning = s.stuckWarning(gameresp.Game, gameresp.State)
+	return resp, nil
+}
+
+// enforceTimeBank ends the turn on behalf of a current player whose time
+// bank has run out (forfeiting them if so configured). This is applied
+// whenever the game is read or moved, and by the TimeBankSweeper as soon as
+// the bank runs out. At most one round of timeouts is processed per call.
+func (s *BackendGamesService) enforceTimeBank(ctx context.Context, gameId string) error {
+	for timeouts := 0; ; timeouts++ {
+		timedOut, err := s.enforceTimeout(ctx, gameId, timeouts)
+		if err != nil || !timedOut {
+			return err
+		}
+	}
+}
+
+// enforceTimeout ends the current player's turn if their time bank has run
+// out, returning whether it did
+func (s *BackendGamesService) enforceTimeout(ctx context.Context, gameId string, timeouts int) (bool, error) {
+	defer s.lockGame(gameId)()
+	gameresp, err := s.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		return false, err
+	}
+	if timeouts >= len(gameresp.Game.Config.GetPlayers()) {
+		return false, nil
+	}
+	move := s.newRuntimeGame(gameresp.Game, gameresp.State).TimeoutMove()
+	if move == nil {
+		return false, nil
+	}
+	req := &v1.ProcessMovesRequest{GameId: gameId, Moves: []*v1.GameMove{move}}
+	if _, err := s.commitMoves(ctx, req, gameresp); err != nil {
+		return false, fmt.Errorf("failed to end turn for player %d on timeout: %w", move.Player, err)
+	}
+	return true, nil
+}
+
+// SetClockPaused records a player's request to pause or resume the clock.
+// The clock pauses once every active player has asked; any player can resume.
+func (s *BackendGamesService) SetClockPaused(ctx context.Context, req *v1.SetClockPausedRequest) (*v1.SetClockPausedResponse, error) {
+	if req.GameId == "" {
+		return nil, fmt.Errorf("game ID is required")
+	}
+	if s.StorageProvider == nil {
+		return nil, fmt.Errorf("storage provider not configured")
+	}
+	if err := s.enforceTimeBank(ctx, req.GameId); err != nil {
+		return nil, err
+	}
+
+	gameresp, err := s.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	game, state := gameresp.Game, gameresp.State
+	if lib.GetTimeBankSettings(game.Config) == nil {
+		return nil, fmt.Errorf("game %s does not use time banks", req.GameId)
+	}
+	if state.Finished {
+		return nil, fmt.Errorf("game %s has already finished", req.GameId)
+	}
+
+	playerId, err := requireSeat(ctx, game, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if req.Paused {
+		if !slices.Contains(state.PauseRequests, playerId) {
+			state.PauseRequests = append(state.PauseRequests, playerId)
+		}
+		if !state.ClockPaused && allActivePlayersAgree(state) {
+			// Checkpoint the running bank so the pause isn't charged
+			remaining := lib.RemainingTimeBanks(state, now)
+			if playerState := state.PlayerStates[state.CurrentPlayer]; playerState != nil {
+				playerState.TimeBankMs = remaining[state.CurrentPlayer]
+			}
+			state.ClockPaused = true
+			state.ClockStartedAt = nil
+			state.PauseRequests = nil
+		}
+	} else {
+		state.PauseRequests = slices.DeleteFunc(state.PauseRequests, func(p int32) bool { return p == playerId })
+		if state.ClockPaused {
+			state.ClockPaused = false
+			state.ClockStartedAt = timestamppb.New(now)
+			state.PauseRequests = nil
+		}
+	}
+
+	if err := s.StorageProvider.SaveGameState(ctx, req.GameId, state); err != nil {
+		return nil, fmt.Errorf("failed to save game state: %w", err)
+	}
+	s.updateCache(req.GameId, nil, state, nil)
+
+	return &v1.SetClockPausedResponse{
+		Paused:        state.ClockPaused,
+		PauseRequests: state.PauseRequests,
+	}, nil
+}
+
+// requireSeat checks that the authenticated user controls the given player
+// slot. If playerId is 0 the user's first slot is used.
+func requireSeat(ctx context.Context, game *v1.Game, playerId int32) (int32, error) {
+	return authz.RequireSeat(ctx, game, playerId)
+}
+
+// allActivePlayersAgree reports whether every active player has requested a pause
+func allActivePlayersAgree(state *v1.GameState) bool {
+	for playerId, playerState := range state.PlayerStates {
+		if playerState.IsActive && !slices.Contains(state.PauseRequests, playerId) {
+			return false
+		}
+	}
+	return true
+}
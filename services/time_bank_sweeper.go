@@ -0,0 +1,148 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// TimeBankSweeper ends the turns of players whose time banks run out as
+// soon as they do, rather than when the game is next read or moved, so the
+// timeout reaches the other players over GameSync right away.  It keeps when
+// the current player of each game with a running clock runs out of time,
+// refreshed from every GameSync update passed to HandleUpdate and from a
+// periodic rescan of all games.
+type TimeBankSweeper struct {
+	Games v1s.GamesServiceServer
+
+	// How often every game is rescanned for clocks no update announced, eg
+	// new games or resumed clocks (zero uses 10 minutes)
+	RescanInterval time.Duration
+
+	// Clock deadlines are kept by (nil uses the system clock)
+	Clock lib.Clock
+
+	deadlines map[string]time.Time
+	mu        sync.Mutex
+}
+
+// NewTimeBankSweeper creates a sweeper enforcing the time banks of games
+func NewTimeBankSweeper(games v1s.GamesServiceServer) *TimeBankSweeper {
+	return &TimeBankSweeper{
+		Games:     games,
+		deadlines: make(map[string]time.Time),
+	}
+}
+
+func (w *TimeBankSweeper) now() time.Time {
+	if w.Clock == nil {
+		return time.Now()
+	}
+	return w.Clock.Now()
+}
+
+// HandleUpdate refreshes when a game's current player runs out of time after
+// a GameSync update
+func (w *TimeBankSweeper) HandleUpdate(gameId string, update *v1.GameUpdate) {
+	if update.GetMovesPublished() == nil {
+		return
+	}
+	if err := w.watch(context.Background(), gameId); err != nil {
+		log.Printf("Failed to watch the clock of game %s: %v", gameId, err)
+	}
+}
+
+// Scan watches the clock of every game played with time banks
+func (w *TimeBankSweeper) Scan(ctx context.Context) error {
+	resp, err := w.Games.ListGames(ctx, &v1.ListGamesRequest{IncludeForks: true})
+	if err != nil {
+		return err
+	}
+	for _, game := range resp.Items {
+		if lib.GetTimeBankSettings(game.Config) == nil {
+			continue
+		}
+		if err := w.watch(ctx, game.Id); err != nil {
+			log.Printf("Failed to watch the clock of game %s: %v", game.Id, err)
+		}
+	}
+	return nil
+}
+
+// Sweep ends the turns of every watched game whose current player has run
+// out of time.  The games service broadcasts the timeouts like any other
+// move.
+func (w *TimeBankSweeper) Sweep(ctx context.Context) {
+	now := w.now()
+	var due []string
+	w.mu.Lock()
+	for gameId, deadline := range w.deadlines {
+		if !now.Before(deadline) {
+			due = append(due, gameId)
+		}
+	}
+	w.mu.Unlock()
+
+	for _, gameId := range due {
+		if err := w.watch(ctx, gameId); err != nil {
+			log.Printf("Failed to enforce the time bank of game %s: %v", gameId, err)
+		}
+	}
+}
+
+// Run scans every game, then sweeps due clocks every interval and rescans
+// every RescanInterval until the context is done
+func (w *TimeBankSweeper) Run(ctx context.Context, interval time.Duration) {
+	rescanInterval := w.RescanInterval
+	if rescanInterval <= 0 {
+		rescanInterval = 10 * time.Minute
+	}
+	if err := w.Scan(ctx); err != nil {
+		log.Printf("Failed to scan games for time banks: %v", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	rescan := time.NewTicker(rescanInterval)
+	defer rescan.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.Sweep(ctx)
+		case <-rescan.C:
+			if err := w.Scan(ctx); err != nil {
+				log.Printf("Failed to scan games for time banks: %v", err)
+			}
+		}
+	}
+}
+
+// watch loads a game's state, which enforces an expired time bank, and keeps
+// when its current player runs out of time, if their clock is running
+func (w *TimeBankSweeper) watch(ctx context.Context, gameId string) error {
+	resp, err := w.Games.GetGameState(ctx, &v1.GetGameStateRequest{GameId: gameId})
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.deadlines, gameId)
+	if err != nil {
+		return err
+	}
+	state := resp.State
+	remaining, ok := resp.RemainingTimeMs[state.GetCurrentPlayer()]
+	if !ok || state.Finished || state.ClockStartedAt == nil {
+		return nil
+	}
+	w.deadlines[gameId] = w.now().Add(time.Duration(remaining) * time.Millisecond)
+	return nil
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"errors"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TurnAlreadyEndedReason is the ErrorInfo reason of the status an EndTurn for
+// a turn that already ended is rejected with.  It tells that rejection apart
+// from other Aborted errors, like a commit that lost a race, which must not
+// be ignored.
+const TurnAlreadyEndedReason = "TURN_ALREADY_ENDED"
+
+// checkEndTurnEpochs rejects EndTurn moves sent for a turn that has already
+// ended.  This is checked before whose turn it is, so a late EndTurn gets
+// the error clients ignore rather than a not-your-turn error.
+func checkEndTurnEpochs(moves []*v1.GameMove, state *v1.GameState) error {
+	for _, move := range moves {
+		if err := lib.CheckEndTurnEpoch(move.GetEndTurn(), state); err != nil {
+			return turnAlreadyEndedStatus(err)
+		}
+	}
+	return nil
+}
+
+// turnAlreadyEndedStatus returns err as an Aborted status carrying
+// TurnAlreadyEndedReason
+func turnAlreadyEndedStatus(err error) error {
+	st, detailErr := status.New(codes.Aborted, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: TurnAlreadyEndedReason,
+		Domain: "lilbattle",
+	})
+	if detailErr != nil {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return st.Err()
+}
+
+// IsTurnAlreadyEnded reports whether err rejected an EndTurn because its
+// turn had already ended, which means the turn the client wanted to end did
+// end and the error can be ignored
+func IsTurnAlreadyEnded(err error) bool {
+	if errors.Is(err, lib.ErrTurnAlreadyEnded) {
+		return true
+	}
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == TurnAlreadyEndedReason {
+			return true
+		}
+	}
+	return false
+}
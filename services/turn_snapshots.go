@@ -0,0 +1,115 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TurnSnapshotStore is implemented by backends that keep the snapshots of a
+// game's state at the start of each turn.  Backends without one rebuild past
+// states by replaying the game from the start.
+type TurnSnapshotStore interface {
+	// LoadTurnSnapshots returns a game's snapshots, or none if it has none
+	LoadTurnSnapshots(ctx context.Context, gameId string) (*v1.TurnSnapshots, error)
+	SaveTurnSnapshots(ctx context.Context, gameId string, snapshots *v1.TurnSnapshots) error
+}
+
+// turnSnapshotPolicy returns the policy pruning saved turn snapshots
+func (s *BackendGamesService) turnSnapshotPolicy() lib.TurnSnapshotPolicy {
+	if s.TurnSnapshotPolicy == (lib.TurnSnapshotPolicy{}) {
+		return lib.DefaultTurnSnapshotPolicy
+	}
+	return s.TurnSnapshotPolicy
+}
+
+// saveTurnSnapshot snapshots the state after a move group that handed the
+// turn to the next player
+func (s *BackendGamesService) saveTurnSnapshot(ctx context.Context, gameId string, state *v1.GameState, group *v1.GameMoveGroup) error {
+	if s.TurnSnapshots == nil || !endsPlayerTurn(group) {
+		return nil
+	}
+	snapshot, err := lib.NewTurnSnapshot(state)
+	if err != nil {
+		return err
+	}
+	snapshots, err := s.TurnSnapshots.LoadTurnSnapshots(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	lib.AddTurnSnapshot(snapshots, snapshot, s.turnSnapshotPolicy())
+	return s.TurnSnapshots.SaveTurnSnapshots(ctx, gameId, snapshots)
+}
+
+// endsPlayerTurn reports whether a move group ends with the turn passing to
+// another player, leaving the game at the start of that player's turn
+func endsPlayerTurn(group *v1.GameMoveGroup) bool {
+	if len(group.Moves) == 0 {
+		return false
+	}
+	for _, change := range group.Moves[len(group.Moves)-1].Changes {
+		if change.GetPlayerChanged() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// loadTurnSnapshots returns a game's saved turn snapshots, if its backend
+// keeps them
+func (s *BackendGamesService) loadTurnSnapshots(ctx context.Context, gameId string) ([]*v1.TurnSnapshot, error) {
+	if s.TurnSnapshots == nil {
+		return nil, nil
+	}
+	snapshots, err := s.TurnSnapshots.LoadTurnSnapshots(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots.Snapshots, nil
+}
+
+// GetStateAtTurn returns a game's state at the start of a player's turn,
+// replayed from the latest saved snapshot before it or from the game's world
+// when there is none
+func (s *BackendGamesService) GetStateAtTurn(ctx context.Context, req *v1.GetStateAtTurnRequest) (*v1.GetStateAtTurnResponse, error) {
+	if req.GameId == "" {
+		return nil, fmt.Errorf("game ID is required")
+	}
+	gameresp, err := s.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := s.loadTurnSnapshots(ctx, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.initialGameState(ctx, gameresp.Game)
+	if err != nil {
+		return nil, err
+	}
+	rtGame := s.newRuntimeGame(gameresp.Game, state)
+
+	turnState, err := rtGame.GetStateAtTurn(snapshots, gameresp.History.GetGroups(), req.Turn, req.Player)
+	if errors.Is(err, lib.ErrTurnNotReached) {
+		return nil, status.Error(codes.OutOfRange, err.Error())
+	}
+	if err != nil {
+		return nil, rpcError(fmt.Errorf("failed to replay game %s: %w", req.GameId, err))
+	}
+	resp := &v1.GetStateAtTurnResponse{
+		State:         turnState.Game.GameState,
+		ReplayedMoves: int32(turnState.ReplayedMoves),
+	}
+	if snapshot := turnState.Snapshot; snapshot != nil {
+		resp.SnapshotTurn, resp.SnapshotPlayer = snapshot.TurnCounter, snapshot.CurrentPlayer
+	}
+	return resp, nil
+}
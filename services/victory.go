@@ -0,0 +1,22 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// VictoryPointsReason is the GameEnded reason sent when a player wins by
+// reaching the victory point target
+const VictoryPointsReason = "victory points"
+
+// gameEndReason returns the GameEnded reason for a finished game, empty if
+// it ended the usual way
+func gameEndReason(game *v1.Game, state *v1.GameState) string {
+	if lib.WonOnVictoryPoints(game.GetConfig(), state) {
+		return VictoryPointsReason
+	}
+	return puzzleEndReason(state)
+}
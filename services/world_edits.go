@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/authz"
+)
+
+const (
+	// MaxWorldEditsPerBatch limits how many edits one ApplyWorldEdits call can make
+	MaxWorldEditsPerBatch = 5000
+
+	// MaxWorldEditBatchesPerMinute limits how often a world can be edited
+	MaxWorldEditBatchesPerMinute = 30
+)
+
+// ErrWorldEditsRateLimited is returned when a world is edited too often
+var ErrWorldEditsRateLimited = errors.New("too many edit batches for this world, try again in a minute")
+
+// ApplyWorldEdits validates a batch of edits and saves them to the world in
+// one update, so the world data version is bumped once per batch.  If any
+// edit is invalid none are saved.
+func (s *BaseWorldsService) ApplyWorldEdits(ctx context.Context, req *v1.ApplyWorldEditsRequest) (*v1.ApplyWorldEditsResponse, error) {
+	if req.WorldId == "" {
+		return nil, fmt.Errorf("world ID is required")
+	}
+	if len(req.Edits) == 0 {
+		return nil, fmt.Errorf("no edits given")
+	}
+	if len(req.Edits) > MaxWorldEditsPerBatch {
+		return nil, fmt.Errorf("too many edits in one batch: %d (max %d)", len(req.Edits), MaxWorldEditsPerBatch)
+	}
+
+	current, err := s.Self.GetWorld(ctx, &v1.GetWorldRequest{Id: req.WorldId})
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.CanModifyWorld(ctx, current.World); err != nil {
+		return nil, err
+	}
+	if !s.allowWorldEdits(req.WorldId, time.Now()) {
+		return nil, ErrWorldEditsRateLimited
+	}
+
+	worldData, err := lib.DefaultRulesEngine().ApplyWorldEdits(current.WorldData, req.Edits)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.Self.UpdateWorld(ctx, &v1.UpdateWorldRequest{
+		World:     &v1.World{Id: req.WorldId},
+		WorldData: worldData,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ApplyWorldEditsResponse{Version: resp.WorldData.GetVersion()}, nil
+}
+
+// GetWorldTiles returns the tiles and units of a world inside the requested
+// region, or all of them if no region is given
+func (s *BaseWorldsService) GetWorldTiles(ctx context.Context, req *v1.GetWorldTilesRequest) (*v1.GetWorldTilesResponse, error) {
+	if req.WorldId == "" {
+		return nil, fmt.Errorf("world ID is required")
+	}
+	resp, err := s.Self.GetWorld(ctx, &v1.GetWorldRequest{Id: req.WorldId})
+	if err != nil {
+		return nil, err
+	}
+	tiles, units := lib.WorldTilesInRegion(resp.WorldData, req.Region)
+	return &v1.GetWorldTilesResponse{
+		Tiles:   tiles,
+		Units:   units,
+		Version: resp.WorldData.GetVersion(),
+	}, nil
+}
+
+// allowWorldEdits records an edit batch on a world unless it has already had
+// MaxWorldEditBatchesPerMinute batches in the last minute
+func (s *BaseWorldsService) allowWorldEdits(worldId string, now time.Time) bool {
+	s.editsMu.Lock()
+	defer s.editsMu.Unlock()
+
+	if s.editTimes == nil {
+		s.editTimes = map[string][]time.Time{}
+	}
+	recent := slices.DeleteFunc(s.editTimes[worldId], func(t time.Time) bool {
+		return now.Sub(t) >= time.Minute
+	})
+	if len(recent) >= MaxWorldEditBatchesPerMinute {
+		s.editTimes[worldId] = recent
+		return false
+	}
+	s.editTimes[worldId] = append(recent, now)
+	return true
+}
@@ -2,8 +2,12 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 )
 
 type WorldsService interface {
@@ -22,8 +26,53 @@ type WorldsService interface {
 	DeleteWorld(context.Context, *v1.DeleteWorldRequest) (*v1.DeleteWorldResponse, error)
 	// GetWorld returns a specific world with metadata
 	UpdateWorld(context.Context, *v1.UpdateWorldRequest) (*v1.UpdateWorldResponse, error)
+	// Replace a world's rules overrides
+	SetWorldRulesOverrides(context.Context, *v1.SetWorldRulesOverridesRequest) (*v1.SetWorldRulesOverridesResponse, error)
+	// Apply a batch of map edits to a world atomically
+	ApplyWorldEdits(context.Context, *v1.ApplyWorldEditsRequest) (*v1.ApplyWorldEditsResponse, error)
+	// GetWorldTiles returns the tiles and units of a world, optionally within a region
+	GetWorldTiles(context.Context, *v1.GetWorldTilesRequest) (*v1.GetWorldTilesResponse, error)
 }
 
 type BaseWorldsService struct {
 	Self WorldsService // The actual implementation
+
+	editsMu   sync.Mutex
+	editTimes map[string][]time.Time // recent edit batches per world
+}
+
+// SetWorldRulesOverrides validates the overrides against the rules and saves
+// them on the world. Empty overrides clear the world's overrides.
+func (s *BaseWorldsService) SetWorldRulesOverrides(ctx context.Context, req *v1.SetWorldRulesOverridesRequest) (*v1.SetWorldRulesOverridesResponse, error) {
+	if req.WorldId == "" {
+		return nil, fmt.Errorf("world ID is required")
+	}
+	overrides := req.Overrides
+	if overrides == nil {
+		overrides = &v1.RulesOverrides{}
+	}
+	if err := lib.DefaultRulesEngine().ValidateRulesOverrides(overrides); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Self.UpdateWorld(ctx, &v1.UpdateWorldRequest{
+		World: &v1.World{Id: req.WorldId, RulesOverrides: overrides},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SetWorldRulesOverridesResponse{World: resp.World}, nil
+}
+
+// CheckWorldRating rejects a rating a client asks to store on a world unless
+// its difficulty and label follow from its rates and it was computed on the
+// stored world data with the current rules and AI
+func CheckWorldRating(rating *v1.WorldRating, worldDataVersion int64) error {
+	if err := lib.ValidateWorldRating(rating); err != nil {
+		return err
+	}
+	if !lib.IsWorldRatingCurrent(rating, worldDataVersion, lib.DefaultRulesEngine()) {
+		return fmt.Errorf("rating is stale: rate world data version %d with the current rules and AI", worldDataVersion)
+	}
+	return nil
 }
@@ -0,0 +1,271 @@
+// Package testfixtures builds small, reproducible games and worlds for tests
+// anywhere in the repo: lib, services, the presenter and CLI integration
+// tests.  See SUMMARY.md for the pieces and how to add new fixtures.
+package testfixtures
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// GameBuilder provides a fluent API for creating minimal test games
+// without needing to specify full map files.
+//
+// Example usage:
+//
+//	game := testfixtures.NewGameBuilder().
+//	    Terrain(testfixtures.Hexagon(3), lib.TileTypeGrass).
+//	    Tile(0, 0, lib.TileTypeLandBase, 1).  // Player 1's base
+//	    Unit(0, 0, 1, lib.UnitTypeSoldier).   // Player 1's soldier at base
+//	    Coins(1, 500).                        // Player 1 has 500 coins
+//	    Build()
+//
+// Tiles are laid in order, so a later tile on the same hex replaces an
+// earlier one.
+type GameBuilder struct {
+	tiles        []*tileSpec
+	units        []*unitSpec
+	playerCoins  map[int32]int32
+	currentTurn  int32
+	turnCounter  int32
+	numPlayers   int32
+	rngSeed      int64
+	gameSettings *v1.GameSettings
+	income       *v1.IncomeConfig
+	rules        *lib.RulesEngine
+	combatMode   lib.CombatMode
+}
+
+type tileSpec struct {
+	q, r     int
+	tileType int32
+	player   int32
+}
+
+type unitSpec struct {
+	q, r            int
+	player          int32
+	unitType        int32
+	shortcut        string
+	health          int32
+	distanceLeft    float64
+	progressionStep int32
+}
+
+// NewGameBuilder creates a new game builder with sensible defaults: two
+// players, player 1 to move on turn 1 and a fixed RNG seed
+func NewGameBuilder() *GameBuilder {
+	return &GameBuilder{
+		tiles:       make([]*tileSpec, 0),
+		units:       make([]*unitSpec, 0),
+		playerCoins: make(map[int32]int32),
+		currentTurn: 1,
+		turnCounter: 1,
+		numPlayers:  2,
+		rngSeed:     12345,
+	}
+}
+
+// Tile adds a tile at the specified position with given type and player ownership
+// player=0 means neutral/unowned
+func (b *GameBuilder) Tile(q, r int, tileType int32, player int32) *GameBuilder {
+	b.tiles = append(b.tiles, &tileSpec{
+		q:        q,
+		r:        r,
+		tileType: tileType,
+		player:   player,
+	})
+	return b
+}
+
+// Terrain adds neutral tiles of one type on every hex of a shape, such as
+// Rect, Hexagon, Donut or Islands
+func (b *GameBuilder) Terrain(coords []lib.AxialCoord, tileType int32) *GameBuilder {
+	for _, coord := range coords {
+		b.Tile(coord.Q, coord.R, tileType, 0)
+	}
+	return b
+}
+
+// GrassTiles adds a grid of grass tiles centered at origin (for basic movement tests)
+func (b *GameBuilder) GrassTiles(radius int) *GameBuilder {
+	return b.Terrain(Square(radius), lib.TileTypeGrass)
+}
+
+// Unit adds a unit at the specified position
+func (b *GameBuilder) Unit(q, r int, player int32, unitType int32) *GameBuilder {
+	return b.UnitWithShortcut(q, r, player, unitType, "")
+}
+
+// UnitWithShortcut adds a unit with a specific shortcut identifier
+func (b *GameBuilder) UnitWithShortcut(q, r int, player int32, unitType int32, shortcut string) *GameBuilder {
+	return b.UnitFull(q, r, player, unitType, shortcut, 10, 3, 0)
+}
+
+// UnitFull adds a unit with full control over all attributes
+func (b *GameBuilder) UnitFull(q, r int, player int32, unitType int32, shortcut string, health int32, distanceLeft float64, progressionStep int32) *GameBuilder {
+	b.units = append(b.units, &unitSpec{
+		q:               q,
+		r:               r,
+		player:          player,
+		unitType:        unitType,
+		shortcut:        shortcut,
+		health:          health,
+		distanceLeft:    distanceLeft,
+		progressionStep: progressionStep,
+	})
+	return b
+}
+
+// Coins sets the coin balance for a player
+func (b *GameBuilder) Coins(player int32, amount int32) *GameBuilder {
+	b.playerCoins[player] = amount
+	return b
+}
+
+// CurrentPlayer sets which player's turn it is
+func (b *GameBuilder) CurrentPlayer(player int32) *GameBuilder {
+	b.currentTurn = player
+	return b
+}
+
+// Turn sets the turn counter
+func (b *GameBuilder) Turn(turn int32) *GameBuilder {
+	b.turnCounter = turn
+	return b
+}
+
+// Players sets the number of players (default 2)
+func (b *GameBuilder) Players(n int32) *GameBuilder {
+	b.numPlayers = n
+	return b
+}
+
+// Seed sets the RNG seed for random combat
+func (b *GameBuilder) Seed(seed int64) *GameBuilder {
+	b.rngSeed = seed
+	return b
+}
+
+// Settings sets game settings (for allowed units, etc.)
+func (b *GameBuilder) Settings(settings *v1.GameSettings) *GameBuilder {
+	b.gameSettings = settings
+	return b
+}
+
+// With applies rules and game variants, eg DeterministicCombat
+func (b *GameBuilder) With(variants ...Variant) *GameBuilder {
+	for _, variant := range variants {
+		variant(b)
+	}
+	return b
+}
+
+// WorldData returns the tiles and units laid so far
+func (b *GameBuilder) WorldData() *v1.WorldData {
+	tilesMap := make(map[string]*v1.Tile)
+	for _, ts := range b.tiles {
+		key := lib.CoordKey(int32(ts.q), int32(ts.r))
+		tilesMap[key] = &v1.Tile{
+			Q:        int32(ts.q),
+			R:        int32(ts.r),
+			TileType: ts.tileType,
+			Player:   ts.player,
+		}
+	}
+
+	// Units get shortcuts like "A1", "A2", "B1" unless given one
+	unitsMap := make(map[string]*v1.Unit)
+	shortcutCounters := make(map[int32]int) // per-player counters
+	for _, us := range b.units {
+		shortcut := us.shortcut
+		if shortcut == "" {
+			letter := 'A' + rune(us.player-1)
+			if us.player <= 0 {
+				letter = 'N' // Neutral
+			}
+			shortcutCounters[us.player]++
+			shortcut = fmt.Sprintf("%c%d", letter, shortcutCounters[us.player])
+		}
+
+		key := lib.CoordKey(int32(us.q), int32(us.r))
+		unitsMap[key] = &v1.Unit{
+			Q:               int32(us.q),
+			R:               int32(us.r),
+			Player:          us.player,
+			UnitType:        us.unitType,
+			Shortcut:        shortcut,
+			AvailableHealth: us.health,
+			DistanceLeft:    us.distanceLeft,
+			ProgressionStep: us.progressionStep,
+		}
+	}
+
+	return &v1.WorldData{
+		TilesMap: tilesMap,
+		UnitsMap: unitsMap,
+	}
+}
+
+// Protos returns the game and its state, for seeding services
+func (b *GameBuilder) Protos() (*v1.Game, *v1.GameState) {
+	// Build player states
+	playerStates := make(map[int32]*v1.PlayerState)
+	for i := int32(1); i <= b.numPlayers; i++ {
+		coins := b.playerCoins[i]
+		if coins == 0 {
+			coins = 300 // Default starting coins
+		}
+		playerStates[i] = &v1.PlayerState{
+			Coins:    coins,
+			IsActive: true,
+		}
+	}
+
+	// Build game configuration
+	players := make([]*v1.GamePlayer, 0, b.numPlayers)
+	for i := int32(1); i <= b.numPlayers; i++ {
+		players = append(players, &v1.GamePlayer{
+			PlayerId:      i,
+			StartingCoins: b.playerCoins[i],
+		})
+	}
+
+	settings := b.gameSettings
+	if settings == nil {
+		settings = &v1.GameSettings{}
+	}
+
+	game := &v1.Game{
+		Id:      "test-game",
+		WorldId: "test-world",
+		Config: &v1.GameConfiguration{
+			Players:       players,
+			Settings:      settings,
+			IncomeConfigs: b.income,
+		},
+	}
+
+	state := &v1.GameState{
+		GameId:        "test-game",
+		CurrentPlayer: b.currentTurn,
+		TurnCounter:   b.turnCounter,
+		WorldData:     b.WorldData(),
+		PlayerStates:  playerStates,
+	}
+	return game, state
+}
+
+// Build constructs the Game from the builder configuration
+func (b *GameBuilder) Build() *lib.Game {
+	game, state := b.Protos()
+	rules := b.rules
+	if rules == nil {
+		rules = lib.DefaultRulesEngine()
+	}
+	g := lib.NewGame(game, state, lib.NewWorld("test-world", state.WorldData), rules, b.rngSeed)
+	g.CombatMode = b.combatMode
+	return g
+}
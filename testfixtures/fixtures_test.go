@@ -0,0 +1,83 @@
+package testfixtures
+
+import (
+	"testing"
+
+	"github.com/turnforge/lilbattle/lib"
+)
+
+func TestShapes(t *testing.T) {
+	shapes := map[string]struct {
+		coords []lib.AxialCoord
+		want   int
+	}{
+		"rect":    {Rect(4, 3), 12},
+		"square":  {Square(1), 9},
+		"hexagon": {Hexagon(2), 19},
+		"donut":   {Donut(2, 3), 12 + 18},
+		"islands": {Islands(1, lib.AxialCoord{Q: -5, R: 0}, lib.AxialCoord{Q: 5, R: 0}), 14},
+	}
+	for name, shape := range shapes {
+		if len(shape.coords) != shape.want {
+			t.Errorf("%s has %d hexes, want %d", name, len(shape.coords), shape.want)
+		}
+	}
+	for _, coord := range Donut(2, 3) {
+		if d := coord.Distance(lib.AxialCoord{}); d < 2 || d > 3 {
+			t.Errorf("donut includes %v, %d hexes from the middle", coord, d)
+		}
+	}
+}
+
+// TestScenarios tests every scenario builds with each unit on a tile it can
+// move away from
+func TestScenarios(t *testing.T) {
+	for name, scenario := range Scenarios {
+		game := scenario().Build()
+		for _, unit := range game.World.UnitsByCoord() {
+			if game.World.TileAt(lib.UnitGetCoord(unit)) == nil {
+				t.Errorf("%s: %s has no tile", name, unit.Shortcut)
+				continue
+			}
+			paths, err := game.RulesEngine.GetMovementOptions(game.World, unit, unit.DistanceLeft, false)
+			if err != nil || len(paths.Edges) == 0 {
+				t.Errorf("%s: %s cannot move (%v)", name, unit.Shortcut, err)
+			}
+		}
+	}
+}
+
+// TestDeterministicCombat tests the same attack deals the same damage
+// whatever the seed
+func TestDeterministicCombat(t *testing.T) {
+	var healths []int32
+	for _, seed := range []int64{1, 2, 3} {
+		game := TwoSoldierDuel().
+			UnitWithShortcut(1, 0, 2, UnitTypeSoldier, "B2").
+			Seed(seed).
+			With(DeterministicCombat).
+			Build()
+		if _, err := game.Attack("A1", "B2"); err != nil {
+			t.Fatalf("Attack failed: %v", err)
+		}
+		healths = append(healths, game.World.UnitAt(lib.AxialCoord{Q: 1, R: 0}).AvailableHealth)
+	}
+	if healths[0] != healths[1] || healths[1] != healths[2] {
+		t.Errorf("defender health after the same attack = %v, want it the same for every seed", healths)
+	}
+}
+
+// TestFastIncome tests a player with a base earns the fast income
+func TestFastIncome(t *testing.T) {
+	game := CaptureRace().
+		Tile(-3, 0, lib.TileTypeLandBase, 1).
+		With(FastIncome).
+		Build()
+	before := game.GameState.PlayerStates[1].Coins
+	if _, err := game.EndTurn(); err != nil {
+		t.Fatalf("EndTurn failed: %v", err)
+	}
+	if got := game.GameState.PlayerStates[1].Coins - before; got != 2000 {
+		t.Errorf("player 1 earned %d, want 2000 for the game and one base", got)
+	}
+}
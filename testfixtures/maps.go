@@ -0,0 +1,68 @@
+package testfixtures
+
+import (
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// =============================================================================
+// Map Shapes
+// =============================================================================
+//
+// Shapes are lists of hexes to lay terrain on with GameBuilder.Terrain.
+// Combine them by laying several, eg water Islands over a grass Hexagon.
+
+var origin = lib.AxialCoord{Q: 0, R: 0}
+
+// Square returns the hexes with q and r both between -radius and radius
+func Square(radius int) []lib.AxialCoord {
+	var coords []lib.AxialCoord
+	for q := -radius; q <= radius; q++ {
+		for r := -radius; r <= radius; r++ {
+			coords = append(coords, lib.AxialCoord{Q: q, R: r})
+		}
+	}
+	return coords
+}
+
+// Rect returns width by height hexes, with q from 0 to width-1 and r from 0
+// to height-1
+func Rect(width, height int) []lib.AxialCoord {
+	var coords []lib.AxialCoord
+	for r := 0; r < height; r++ {
+		for q := 0; q < width; q++ {
+			coords = append(coords, lib.AxialCoord{Q: q, R: r})
+		}
+	}
+	return coords
+}
+
+// Hexagon returns the hexes up to radius away from the origin
+func Hexagon(radius int) []lib.AxialCoord {
+	return Donut(0, radius)
+}
+
+// Donut returns the hexes between inner and outer away from the origin,
+// leaving a hole in the middle
+func Donut(inner, outer int) []lib.AxialCoord {
+	var coords []lib.AxialCoord
+	for radius := inner; radius <= outer; radius++ {
+		if radius == 0 {
+			coords = append(coords, origin)
+			continue
+		}
+		coords = append(coords, origin.Ring(radius)...)
+	}
+	return coords
+}
+
+// Islands returns a hexagon of the given radius around each center.  Lay
+// them over water to get islands.
+func Islands(radius int, centers ...lib.AxialCoord) []lib.AxialCoord {
+	var coords []lib.AxialCoord
+	for _, center := range centers {
+		for _, coord := range Hexagon(radius) {
+			coords = append(coords, lib.AxialCoord{Q: center.Q + coord.Q, R: center.R + coord.R})
+		}
+	}
+	return coords
+}
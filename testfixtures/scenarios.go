@@ -0,0 +1,58 @@
+package testfixtures
+
+import (
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// =============================================================================
+// Named Scenarios
+// =============================================================================
+//
+// Ready-made games for tests to start from.  Each returns a builder so a
+// test can add to it, or change its variant, before building.
+
+// Unit types used by the scenarios, in the standard rules
+const (
+	UnitTypeSoldier    int32 = lib.UnitTypeSoldier
+	UnitTypeBattleship int32 = 12
+	UnitTypeDestroyer  int32 = 13
+	UnitTypeHelicopter int32 = 17
+)
+
+// Scenarios are the named scenarios, for tests that run against each
+var Scenarios = map[string]func() *GameBuilder{
+	"two-soldier-duel": TwoSoldierDuel,
+	"capture-race":     CaptureRace,
+	"naval-map":        NavalMap,
+}
+
+// TwoSoldierDuel puts player 1's soldier A1 and player 2's soldier B1 two
+// hexes apart on open grass
+func TwoSoldierDuel() *GameBuilder {
+	return NewGameBuilder().
+		Terrain(Hexagon(3), lib.TileTypeGrass).
+		UnitWithShortcut(0, 0, 1, UnitTypeSoldier, "A1").
+		UnitWithShortcut(2, 0, 2, UnitTypeSoldier, "B1")
+}
+
+// CaptureRace puts a neutral land base at the origin with each player's
+// soldier two hexes away from it on either side
+func CaptureRace() *GameBuilder {
+	return NewGameBuilder().
+		Terrain(Hexagon(3), lib.TileTypeGrass).
+		Tile(0, 0, lib.TileTypeLandBase, 0).
+		UnitWithShortcut(-2, 0, 1, UnitTypeSoldier, "A1").
+		UnitWithShortcut(2, 0, 2, UnitTypeSoldier, "B1")
+}
+
+// NavalMap is open water with an island at each end holding a player's
+// naval base, and a destroyer for each player off their island
+func NavalMap() *GameBuilder {
+	return NewGameBuilder().
+		Terrain(Hexagon(4), lib.TileTypeWaterRegular).
+		Terrain(Islands(1, lib.AxialCoord{Q: -5, R: 0}, lib.AxialCoord{Q: 5, R: 0}), lib.TileTypeGrass).
+		Tile(-4, 0, lib.TileTypeNavalBase, 1).
+		Tile(4, 0, lib.TileTypeNavalBase, 2).
+		UnitWithShortcut(-2, 0, 1, UnitTypeDestroyer, "A1").
+		UnitWithShortcut(2, 0, 2, UnitTypeDestroyer, "B1")
+}
@@ -0,0 +1,47 @@
+package testfixtures
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Rules and Game Variants
+// =============================================================================
+
+// Variant changes the rules or configuration of a game being built
+type Variant func(b *GameBuilder)
+
+// DefaultRules returns a copy of the standard rules that a test can change
+// without affecting other tests.  Games use the shared standard rules unless
+// given others.
+func DefaultRules() *lib.RulesEngine {
+	return lib.NewRulesEngineFrom(proto.Clone(lib.DefaultRulesEngine().Rules()).(*v1.RulesEngine))
+}
+
+// WithRules plays the game with the given rules
+func WithRules(rules *lib.RulesEngine) Variant {
+	return func(b *GameBuilder) {
+		b.rules = rules
+	}
+}
+
+// DeterministicCombat makes every attack deal its expected damage, rounded,
+// instead of rolling for it
+func DeterministicCombat(b *GameBuilder) {
+	b.combatMode = lib.CombatModeExpected
+}
+
+// FastIncome makes every base and the game itself pay 1000 coins a turn,
+// for tests that need players to afford anything quickly
+func FastIncome(b *GameBuilder) {
+	b.income = &v1.IncomeConfig{
+		GameIncome:        1000,
+		LandbaseIncome:    1000,
+		NavalbaseIncome:   1000,
+		AirportbaseIncome: 1000,
+		MissilesiloIncome: 1000,
+		MinesIncome:       1000,
+	}
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// =============================================================================
+// Tests for API versioning and the compatibility shims
+// =============================================================================
+
+func endTurnWithVersion(svc *fsbe.FSGamesService, apiVersion int32, player int32) (*v1.ProcessMovesResponse, error) {
+	return svc.ProcessMoves(ContextWithUserID("test-user-1"), &v1.ProcessMovesRequest{
+		GameId:     timeBankGameId,
+		ApiVersion: apiVersion,
+		Moves: []*v1.GameMove{{
+			Player:   player,
+			MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}},
+		}},
+	})
+}
+
+func TestApiVersion_OldProcessMovesThroughShim(t *testing.T) {
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+
+	// A client from before versioning sends neither a version nor the player
+	resp, err := endTurnWithVersion(svc, 0, 0)
+	if err != nil {
+		t.Fatalf("old-shaped ProcessMoves failed: %v", err)
+	}
+	if player := resp.Moves[0].Player; player != 1 {
+		t.Errorf("shim filled in player %d, want 1", player)
+	}
+
+	info := resp.ServerInfo
+	if info.GetApiVersion() != services.ApiVersion || info.GetMinApiVersion() != services.MinApiVersion {
+		t.Errorf("server info versions = %d/%d, want %d/%d",
+			info.GetApiVersion(), info.GetMinApiVersion(), services.ApiVersion, services.MinApiVersion)
+	}
+	if len(info.GetDeprecations()) == 0 {
+		t.Error("server info should list the deprecated APIs")
+	}
+}
+
+func TestApiVersion_CurrentRequiresPlayer(t *testing.T) {
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+
+	if _, err := endTurnWithVersion(svc, services.ApiVersion, 0); err == nil {
+		t.Error("current clients should be rejected for moves without a player")
+	}
+	if _, err := endTurnWithVersion(svc, services.ApiVersion, 2); err == nil {
+		t.Error("moves for a player whose turn it isn't should be rejected")
+	}
+	if _, err := endTurnWithVersion(svc, services.ApiVersion, 1); err != nil {
+		t.Errorf("current ProcessMoves failed: %v", err)
+	}
+}
+
+func TestApiVersion_NewerThanServer(t *testing.T) {
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+
+	_, err := endTurnWithVersion(svc, services.ApiVersion+1, 1)
+	if !errors.Is(err, services.ErrApiVersionUnsupported) {
+		t.Errorf("error = %v, want ErrApiVersionUnsupported", err)
+	}
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// =============================================================================
+// Tests for attack range highlighting with minimum range gaps
+// =============================================================================
+
+const (
+	unitTypeArtilleryBasic int32 = 8 // Artillery (Basic), range 2-3
+	unitTypeAntiAirBasic   int32 = 6 // Anti-aircraft (Basic), range 1-3
+)
+
+func coordSet(coords []AxialCoord) map[AxialCoord]bool {
+	set := map[AxialCoord]bool{}
+	for _, coord := range coords {
+		set[coord] = true
+	}
+	return set
+}
+
+// TestGetAttackRangeTiles_ArtilleryDoughnut tests an artillery's range is the
+// ring between 2 and 3 hexes away with a hole in the middle, and that it
+// fires over units in the way
+func TestGetAttackRangeTiles_ArtilleryDoughnut(t *testing.T) {
+	game := NewGameBuilder().
+		GrassTiles(4).
+		UnitWithShortcut(0, 0, 1, unitTypeArtilleryBasic, "A1").
+		UnitWithShortcut(1, 0, 1, UnitTypeSoldierBasic, "A2").
+		Build()
+	center := AxialCoord{Q: 0, R: 0}
+
+	tiles := game.GetAttackRangeTiles(game.World.UnitAt(center))
+	if len(tiles) != 12+18 {
+		t.Errorf("artillery range has %d hexes, want the 30 hexes 2 and 3 away", len(tiles))
+	}
+	for _, coord := range tiles {
+		if d := center.Distance(coord); d < 2 || d > 3 {
+			t.Errorf("artillery range includes %v, %d hexes away", coord, d)
+		}
+	}
+	if !coordSet(tiles)[AxialCoord{Q: 3, R: 0}] {
+		t.Error("the soldier at 1,0 blocks the artillery firing at 3,0")
+	}
+}
+
+// TestGetAttackableTiles_ArtilleryHole tests an artillery can only target
+// enemies in its ring, not ones inside its minimum range or beyond it
+func TestGetAttackableTiles_ArtilleryHole(t *testing.T) {
+	game := NewGameBuilder().
+		GrassTiles(4).
+		UnitWithShortcut(0, 0, 1, unitTypeArtilleryBasic, "A1").
+		UnitWithShortcut(1, 0, 2, UnitTypeSoldierBasic, "B1").
+		UnitWithShortcut(0, 2, 2, UnitTypeSoldierBasic, "B2").
+		UnitWithShortcut(-3, 0, 2, UnitTypeSoldierBasic, "B3").
+		UnitWithShortcut(4, 0, 2, UnitTypeSoldierBasic, "B4").
+		UnitWithShortcut(0, -2, 1, UnitTypeSoldierBasic, "A2").
+		Build()
+
+	tiles := coordSet(game.GetAttackableTiles(game.World.UnitAt(AxialCoord{Q: 0, R: 0})))
+	want := []AxialCoord{{Q: 0, R: 2}, {Q: -3, R: 0}}
+	if len(tiles) != len(want) {
+		t.Errorf("artillery can attack %v, want %v", tiles, want)
+	}
+	for _, coord := range want {
+		if !tiles[coord] {
+			t.Errorf("artillery cannot attack the enemy at %v", coord)
+		}
+	}
+}
+
+// TestGetAttackRangeTiles_DirectLineOfSight tests a direct fire unit's range
+// stops at units in the way but includes adjacent hexes
+func TestGetAttackRangeTiles_DirectLineOfSight(t *testing.T) {
+	game := NewGameBuilder().
+		GrassTiles(4).
+		UnitWithShortcut(0, 0, 1, unitTypeAntiAirBasic, "A1").
+		UnitWithShortcut(1, 0, 1, UnitTypeSoldierBasic, "A2").
+		Build()
+
+	tiles := coordSet(game.GetAttackRangeTiles(game.World.UnitAt(AxialCoord{Q: 0, R: 0})))
+	if !tiles[AxialCoord{Q: 1, R: 0}] || !tiles[AxialCoord{Q: -1, R: 0}] {
+		t.Error("anti-aircraft range misses adjacent hexes")
+	}
+	if tiles[AxialCoord{Q: 2, R: 0}] || tiles[AxialCoord{Q: 3, R: 0}] {
+		t.Error("anti-aircraft range sees through the soldier at 1,0")
+	}
+	if !tiles[AxialCoord{Q: -3, R: 0}] {
+		t.Error("anti-aircraft range misses 3 hexes away along a clear line")
+	}
+	if line := lib.HexLine(AxialCoord{Q: 0, R: 0}, AxialCoord{Q: 3, R: 0}); len(line) != 4 || line[1] != (AxialCoord{Q: 1, R: 0}) {
+		t.Errorf("line to 3,0 = %v", line)
+	}
+}
@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"github.com/turnforge/lilbattle/services/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// =============================================================================
+// Tests for cancelling expensive RPCs
+// =============================================================================
+
+// hugeSimulation asks for far more combat simulations than could finish
+var hugeSimulation = &v1.SimulateAttackRequest{
+	AttackerUnitType: UnitTypeSoldierBasic,
+	AttackerTerrain:  TileTypeGrass,
+	AttackerHealth:   10,
+	DefenderUnitType: UnitTypeSoldierBasic,
+	DefenderTerrain:  TileTypeGrass,
+	DefenderHealth:   10,
+	NumSimulations:   1 << 30,
+}
+
+func TestSimulateAttack_Cancelled(t *testing.T) {
+	svc := fsbe.NewFSGamesService(t.TempDir(), nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := svc.SimulateAttack(ctx, hugeSimulation)
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("SimulateAttack error = %v, want code Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("SimulateAttack took %v to notice it was cancelled", elapsed)
+	}
+}
+
+func TestDeadlineInterceptor(t *testing.T) {
+	svc := fsbe.NewFSGamesService(t.TempDir(), nil)
+	interceptor := server.UnaryDeadlineInterceptor(20*time.Millisecond, server.HeavyMethods)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return svc.SimulateAttack(ctx, req.(*v1.SimulateAttackRequest))
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/lilbattle.v1.GamesService/SimulateAttack"}
+	if _, err := interceptor(context.Background(), hugeSimulation, info, handler); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("heavy RPC error = %v, want code DeadlineExceeded", err)
+	}
+
+	// Other methods get no deadline
+	info = &grpc.UnaryServerInfo{FullMethod: "/lilbattle.v1.GamesService/GetGame"}
+	interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		if _, ok := ctx.Deadline(); ok {
+			t.Error("GetGame was given a deadline")
+		}
+		return nil, nil
+	})
+}
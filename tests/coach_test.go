@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// =============================================================================
+// Tests for coach mode
+// =============================================================================
+
+// setupCoachGame copies the test game and replaces its world with a player 1
+// soldier (A1) on open ground a couple of hexes from three enemy soldiers
+// surrounding 0,0
+func setupCoachGame(t *testing.T, rated bool) *fsbe.FSGamesService {
+	t.Helper()
+	ctx := context.Background()
+
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+	game, err := svc.LoadGame(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	game.Config.Settings.Rated = rated
+	if err := svc.SaveGame(ctx, timeBankGameId, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	world := NewGameBuilder().
+		GrassTiles(4).
+		UnitWithShortcut(-2, 0, 1, UnitTypeSoldier, "A1").
+		UnitWithShortcut(1, 0, 2, UnitTypeSoldier, "B1").
+		UnitWithShortcut(0, -1, 2, UnitTypeSoldier, "B2").
+		UnitWithShortcut(0, 1, 2, UnitTypeSoldier, "B3").
+		Build().World.WorldData()
+
+	state, err := svc.LoadGameState(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	state.WorldData = world
+	state.CurrentPlayer = 1
+	if err := svc.SaveGameState(ctx, timeBankGameId, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	return svc
+}
+
+// moveIntoTheOpen moves A1 to 0,0, next to all three enemies
+func moveIntoTheOpen(svc *fsbe.FSGamesService) (*v1.ProcessMovesResponse, error) {
+	return svc.ProcessMoves(ContextWithUserID("test-user-1"), &v1.ProcessMovesRequest{
+		GameId: timeBankGameId,
+		Coach:  true,
+		Moves: []*v1.GameMove{{
+			Player: 1,
+			MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Label: "A1"},
+				To:   &v1.Position{Label: "0,0"},
+			}},
+		}},
+	})
+}
+
+func TestCoach_FlagsMoveNextToThreeEnemies(t *testing.T) {
+	svc := setupCoachGame(t, false)
+
+	resp, err := moveIntoTheOpen(svc)
+	if err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+	verdict := resp.Moves[0].CoachVerdict
+	if verdict == nil {
+		t.Fatal("expected a coach verdict on the move")
+	}
+	if !verdict.Flagged {
+		t.Errorf("move next to three enemies was not flagged: %q", verdict.Message)
+	}
+	if verdict.ThreatenedBy != 3 {
+		t.Errorf("threatened by %d units, want 3", verdict.ThreatenedBy)
+	}
+	if verdict.ExpectedDamage <= 0 {
+		t.Errorf("expected damage = %v, want > 0", verdict.ExpectedDamage)
+	}
+}
+
+func TestCoach_VerdictIsPrivate(t *testing.T) {
+	svc := setupCoachGame(t, false)
+
+	var broadcast []*v1.GameMove
+	svc.OnMovesSaved = func(ctx context.Context, gameId string, moves []*v1.GameMove, groupNumber int64) {
+		broadcast = moves
+	}
+
+	resp, err := moveIntoTheOpen(svc)
+	if err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+	if resp.Moves[0].CoachVerdict == nil {
+		t.Fatal("the acting player should get the verdict")
+	}
+
+	if len(broadcast) != 1 {
+		t.Fatalf("broadcast %d moves, want 1", len(broadcast))
+	}
+	if broadcast[0].CoachVerdict != nil {
+		t.Error("the verdict was broadcast to other players")
+	}
+
+	history, err := svc.LoadGameHistory(context.Background(), timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGameHistory failed: %v", err)
+	}
+	for _, group := range history.Groups {
+		for _, move := range group.Moves {
+			if move.CoachVerdict != nil {
+				t.Error("the verdict was saved in the move history")
+			}
+		}
+	}
+}
+
+func TestCoach_DisabledInRatedGames(t *testing.T) {
+	svc := setupCoachGame(t, true)
+
+	resp, err := moveIntoTheOpen(svc)
+	if err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+	if verdict := resp.Moves[0].CoachVerdict; verdict != nil {
+		t.Errorf("rated game returned a coach verdict: %q", verdict.Message)
+	}
+}
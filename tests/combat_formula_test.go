@@ -1,10 +1,14 @@
 package tests
 
 import (
+	"context"
+	"math"
 	"math/rand"
 	"testing"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/fsbe"
 )
 
 // TestCalculateHitProbability tests the basic hit probability calculation
@@ -264,3 +268,149 @@ func TestCalculateWoundBonus(t *testing.T) {
 		})
 	}
 }
+
+// TestClassAttackFallback checks that a missing pairwise damage distribution
+// is resolved from the attacker's attack_vs_class table
+func TestClassAttackFallback(t *testing.T) {
+	rulesEngine, err := LoadRulesEngineFromFile(RULES_DATA_FILE, DAMAGE_DATA_FILE)
+	if err != nil {
+		t.Fatalf("Failed to load rules engine: %v", err)
+	}
+	delete(rulesEngine.GetUnitUnitProperties(), "1:1")
+
+	dist, canAttack := rulesEngine.GetCombatPrediction(1, 1)
+	if !canAttack {
+		t.Fatal("Soldier should be able to attack Soldier via the Light:Land class entry")
+	}
+
+	totalProb := 0.0
+	for _, r := range dist.Ranges {
+		totalProb += r.Probability
+	}
+	if math.Abs(totalProb-1) > 1e-6 {
+		t.Errorf("Total probability %f should be 1.0", totalProb)
+	}
+
+	// Should agree with simulating the same formula on bonus-free terrain
+	simulated, err := rulesEngine.GenerateDamageDistribution(&CombatContext{
+		Attacker:       &v1.Unit{UnitType: 1, Player: 1},
+		AttackerTile:   &v1.Tile{TileType: 5},
+		AttackerHealth: 10,
+		Defender:       &v1.Unit{UnitType: 1, Player: 2},
+		DefenderTile:   &v1.Tile{TileType: 5},
+		DefenderHealth: 10,
+	}, 10000)
+	if err != nil {
+		t.Fatalf("Failed to generate distribution: %v", err)
+	}
+	if math.Abs(dist.ExpectedDamage-simulated.ExpectedDamage) > 0.1 {
+		t.Errorf("class expected damage %f, simulated %f", dist.ExpectedDamage, simulated.ExpectedDamage)
+	}
+
+	damage, canAttack, err := rulesEngine.CalculateCombatDamage(1, 1, rand.New(rand.NewSource(42)))
+	if err != nil || !canAttack {
+		t.Fatalf("CalculateCombatDamage canAttack=%v err=%v", canAttack, err)
+	}
+	if float64(damage) < dist.MinDamage || float64(damage) > dist.MaxDamage {
+		t.Errorf("damage %d outside class range %.0f-%.0f", damage, dist.MinDamage, dist.MaxDamage)
+	}
+
+	// Without a class entry either, the attack is not possible
+	soldier, _ := rulesEngine.GetUnitData(1)
+	delete(soldier.AttackVsClass, "Light:Land")
+	if _, canAttack := rulesEngine.GetCombatPrediction(1, 1); canAttack {
+		t.Error("attack should be impossible with neither a pairwise nor a class entry")
+	}
+}
+
+const tileTypeMountains int32 = 7
+
+// TestTerrainDefenseApplied compares a tank attacking a soldier on grass and
+// on mountains, where the soldier gets a +4 defense bonus
+func TestTerrainDefenseApplied(t *testing.T) {
+	svc := fsbe.NewFSGamesService(t.TempDir(), nil)
+	predict := func(defenderTerrain int32) *v1.SimulateAttackResponse {
+		resp, err := svc.SimulateAttack(context.Background(), &v1.SimulateAttackRequest{
+			AttackerUnitType: unitTypeBasicTank,
+			AttackerTerrain:  TileTypeGrass,
+			AttackerHealth:   10,
+			DefenderUnitType: UnitTypeSoldier,
+			DefenderTerrain:  defenderTerrain,
+			DefenderHealth:   10,
+			NumSimulations:   20000,
+		})
+		if err != nil {
+			t.Fatalf("SimulateAttack failed: %v", err)
+		}
+		return resp
+	}
+
+	grass := predict(TileTypeGrass)
+	mountain := predict(tileTypeMountains)
+
+	if grass.TerrainDefenseApplied != 0 {
+		t.Errorf("grass reduced damage by %.2f, want no reduction", grass.TerrainDefenseApplied)
+	}
+	if mountain.TerrainDefenseApplied <= 0 {
+		t.Fatalf("mountain reduced damage by %.2f, want a reduction", mountain.TerrainDefenseApplied)
+	}
+
+	// The reported reduction should match the simulated mean damages
+	simulated := 1 - mountain.AttackerMeanDamage/grass.AttackerMeanDamage
+	if math.Abs(mountain.TerrainDefenseApplied-simulated) > 0.05 {
+		t.Errorf("reported reduction %.3f, simulated %.3f", mountain.TerrainDefenseApplied, simulated)
+	}
+}
+
+// TestExpectedCombatMode checks that Expected mode resolves an attack the
+// same way whatever the seed, dealing the rounded expected damage
+func TestExpectedCombatMode(t *testing.T) {
+	attack := func(seed int64, mode lib.CombatMode) (attacker, defender *v1.Unit) {
+		game := NewGameBuilder().
+			GrassTiles(2).
+			UnitWithShortcut(0, 0, 1, unitTypeBasicTank, "A1").
+			UnitWithShortcut(1, 0, 2, UnitTypeSoldier, "B1").
+			Seed(seed).
+			Build()
+		game.CombatMode = mode
+		err := game.ProcessMove(&v1.GameMove{
+			Player: 1,
+			MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+				Attacker: &v1.Position{Label: "A1"},
+				Defender: &v1.Position{Label: "B1"},
+			}},
+		})
+		if err != nil {
+			t.Fatalf("attack with seed %d failed: %v", seed, err)
+		}
+		return game.World.UnitAt(lib.AxialCoord{Q: 0, R: 0}), game.World.UnitAt(lib.AxialCoord{Q: 1, R: 0})
+	}
+
+	firstAttacker, firstDefender := attack(1, lib.CombatModeExpected)
+	if firstDefender == nil {
+		t.Fatal("defender should survive the expected damage")
+	}
+	for seed := int64(2); seed <= 20; seed++ {
+		attacker, defender := attack(seed, lib.CombatModeExpected)
+		if attacker.AvailableHealth != firstAttacker.AvailableHealth || defender.AvailableHealth != firstDefender.AvailableHealth {
+			t.Errorf("seed %d: health %d/%d, seed 1: %d/%d", seed,
+				attacker.AvailableHealth, defender.AvailableHealth,
+				firstAttacker.AvailableHealth, firstDefender.AvailableHealth)
+		}
+	}
+
+	expected, err := DefaultRulesEngine().ExpectedDamage(&CombatContext{
+		Attacker:       &v1.Unit{UnitType: unitTypeBasicTank, AvailableHealth: 10},
+		AttackerTile:   &v1.Tile{TileType: TileTypeGrass},
+		AttackerHealth: 10,
+		Defender:       &v1.Unit{UnitType: UnitTypeSoldier, AvailableHealth: 10},
+		DefenderTile:   &v1.Tile{TileType: TileTypeGrass},
+		DefenderHealth: 10,
+	})
+	if err != nil {
+		t.Fatalf("ExpectedDamage failed: %v", err)
+	}
+	if got, want := 10-firstDefender.AvailableHealth, int32(math.Round(expected)); got != want {
+		t.Errorf("defender took %d damage, want rounded expected damage %d", got, want)
+	}
+}
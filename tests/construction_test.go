@@ -0,0 +1,223 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// =============================================================================
+// Tests for terrain construction (Engineers building bridges)
+// =============================================================================
+
+// bridgeGame sets up an engineer (A1) next to shallow water at (1,0) with a
+// soldier (A2) that can only reach (2,0) across that water.
+func bridgeGame() *lib.Game {
+	return NewGameBuilder().
+		Tile(-1, 0, TileTypeGrass, 0).
+		Tile(0, 0, TileTypeGrass, 0).
+		Tile(0, 1, TileTypeGrass, 0).
+		Tile(1, 0, lib.TileTypeWaterShallow, 0).
+		Tile(2, 0, TileTypeGrass, 0).
+		Tile(5, 0, TileTypeGrass, 0).
+		UnitWithShortcut(0, 0, 1, UnitTypeEngineer, "A1").
+		UnitWithShortcut(0, 1, 1, UnitTypeSoldierBasic, "A2").
+		UnitWithShortcut(5, 0, 2, UnitTypeSoldierBasic, "B1").
+		Coins(1, 500).
+		Build()
+}
+
+// endRound ends the current player's turn and the opponent's turn
+func endRound(t *testing.T, game *lib.Game) {
+	t.Helper()
+	for range 2 {
+		if _, err := game.EndTurn(); err != nil {
+			t.Fatalf("EndTurn failed: %v", err)
+		}
+	}
+}
+
+func TestConstructBridgeOption(t *testing.T) {
+	game := bridgeGame()
+
+	resp, err := game.GetOptionsAt("A1")
+	if err != nil {
+		t.Fatalf("GetOptionsAt failed: %v", err)
+	}
+
+	var found *v1.ConstructTerrainAction
+	for _, opt := range resp.Options {
+		if construct := opt.GetConstruct(); construct != nil {
+			found = construct
+		}
+	}
+	if found == nil {
+		t.Fatal("expected a construct option for the engineer")
+	}
+	if found.Description != "build bridge R (2 turns, 150c)" {
+		t.Errorf("description = %q, want %q", found.Description, "build bridge R (2 turns, 150c)")
+	}
+	if found.TargetTerrain != lib.TileTypeBridgeShallow {
+		t.Errorf("target terrain = %d, want %d", found.TargetTerrain, lib.TileTypeBridgeShallow)
+	}
+}
+
+func TestConstructBridgeCompletes(t *testing.T) {
+	game := bridgeGame()
+
+	// Missing terrain/unit properties fall back to a cost of 1, so make the
+	// water explicitly too expensive for soldiers on a private rules engine.
+	rulesEngine, err := LoadRulesEngineFromFile(RULES_DATA_FILE, DAMAGE_DATA_FILE)
+	if err != nil {
+		t.Fatalf("Failed to load rules engine: %v", err)
+	}
+	rulesEngine.GetTerrainUnitProperties()["14:1"] = &v1.TerrainUnitProperties{
+		TerrainId:    lib.TileTypeWaterShallow,
+		UnitId:       UnitTypeSoldierBasic,
+		MovementCost: 99,
+	}
+	game.SetRulesEngine(rulesEngine)
+
+	bridge := lib.AxialCoord{Q: 1, R: 0}
+	target := lib.AxialCoord{Q: 2, R: 0}
+	soldier := game.World.UnitAt(lib.AxialCoord{Q: 0, R: 1})
+
+	if game.CanMoveUnit(soldier, target, false) {
+		t.Fatal("soldier should not reach (2,0) before the bridge exists")
+	}
+
+	changes, err := game.Construct("A1", "R")
+	if err != nil {
+		t.Fatalf("Construct failed: %v", err)
+	}
+	if coins := game.GameState.PlayerStates[1].Coins; coins != 350 {
+		t.Errorf("coins after construct = %d, want 350", coins)
+	}
+	if !hasTerrainChange(changes) {
+		t.Error("expected a TerrainChanged change when construction starts")
+	}
+
+	// First round: still under construction
+	endRound(t, game)
+	tile := game.World.TileAt(bridge)
+	if tile.TileType != lib.TileTypeWaterShallow || tile.Construction.GetTurnsRemaining() != 1 {
+		t.Fatalf("after 1 round tile type=%d remaining=%d, want water with 1 turn left",
+			tile.TileType, tile.Construction.GetTurnsRemaining())
+	}
+
+	// Second round: bridge completes at the start of player 1's turn
+	if _, err := game.EndTurn(); err != nil {
+		t.Fatalf("EndTurn failed: %v", err)
+	}
+	changes, err = game.EndTurn()
+	if err != nil {
+		t.Fatalf("EndTurn failed: %v", err)
+	}
+	if !hasTerrainChange(changes) {
+		t.Error("expected a TerrainChanged change when construction completes")
+	}
+
+	tile = game.World.TileAt(bridge)
+	if tile.TileType != lib.TileTypeBridgeShallow || tile.Construction != nil {
+		t.Fatalf("tile type = %d (construction %v), want completed bridge", tile.TileType, tile.Construction)
+	}
+
+	soldier = game.World.UnitAt(lib.AxialCoord{Q: 0, R: 1})
+	if !game.CanMoveUnit(soldier, target, false) {
+		t.Error("soldier should reach (2,0) across the new bridge")
+	}
+}
+
+func TestConstructCancelledWhenEngineerMoves(t *testing.T) {
+	game := bridgeGame()
+	bridge := lib.AxialCoord{Q: 1, R: 0}
+
+	if _, err := game.Construct("A1", "R"); err != nil {
+		t.Fatalf("Construct failed: %v", err)
+	}
+	endRound(t, game)
+
+	// Engineer walks away mid-construction
+	changes, err := game.Move("A1", "L")
+	if err != nil {
+		t.Fatalf("Move failed: %v", err)
+	}
+	if !hasTerrainChange(changes) {
+		t.Error("expected a TerrainChanged change when construction is cancelled")
+	}
+	if tile := game.World.TileAt(bridge); tile.Construction != nil {
+		t.Fatal("construction should be cancelled after the engineer moves away")
+	}
+
+	endRound(t, game)
+	if tile := game.World.TileAt(bridge); tile.TileType != lib.TileTypeWaterShallow {
+		t.Errorf("tile type = %d, want untouched shallow water", tile.TileType)
+	}
+}
+
+func TestConstructCancelledWhenEngineerDies(t *testing.T) {
+	game := bridgeGame()
+	bridge := lib.AxialCoord{Q: 1, R: 0}
+
+	if _, err := game.Construct("A1", "R"); err != nil {
+		t.Fatalf("Construct failed: %v", err)
+	}
+	endRound(t, game)
+
+	// The engineer is killed and a soldier takes its place
+	engineer := game.World.UnitAt(lib.AxialCoord{Q: 0, R: 0})
+	if err := game.World.RemoveUnit(engineer); err != nil {
+		t.Fatalf("RemoveUnit failed: %v", err)
+	}
+	if _, err := game.Move("A2", "TL"); err != nil {
+		t.Fatalf("Move failed: %v", err)
+	}
+	if tile := game.World.TileAt(bridge); tile.Construction == nil {
+		t.Fatal("soldier moving should not cancel the engineer's construction")
+	}
+
+	endRound(t, game)
+	tile := game.World.TileAt(bridge)
+	if tile.Construction != nil || tile.TileType != lib.TileTypeWaterShallow {
+		t.Errorf("tile type = %d (construction %v), want the construction cancelled", tile.TileType, tile.Construction)
+	}
+}
+
+func TestConstructValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(*lib.Game)
+		unit    string
+		target  string
+		wantErr string
+	}{
+		{"not adjacent", nil, "A1", "2,0", "adjacent"},
+		{"no conversion for terrain", nil, "A1", "L", "cannot construct"},
+		{"unit cannot construct", nil, "A2", "1,0", "cannot construct"},
+		{"not enough coins", func(g *lib.Game) { g.GameState.PlayerStates[1].Coins = 100 }, "A1", "R", "insufficient coins"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := bridgeGame()
+			if tt.setup != nil {
+				tt.setup(game)
+			}
+			_, err := game.Construct(tt.unit, tt.target)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Construct() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func hasTerrainChange(changes []*v1.WorldChange) bool {
+	for _, change := range changes {
+		if change.GetTerrainChanged() != nil {
+			return true
+		}
+	}
+	return false
+}
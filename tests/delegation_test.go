@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/authz"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// =============================================================================
+// Tests for delegating a turn to a teammate
+// =============================================================================
+
+// setupDelegationGame copies the test game and puts both players on the same
+// team when teams is set
+func setupDelegationGame(t *testing.T, teams bool) *fsbe.FSGamesService {
+	t.Helper()
+	ctx := context.Background()
+
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+	game, err := svc.LoadGame(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	if teams {
+		game.Config.Settings.TeamMode = "teams"
+		for _, player := range game.Config.Players {
+			player.TeamId = 1
+		}
+	}
+	if err := svc.SaveGame(ctx, timeBankGameId, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	return svc
+}
+
+func endTurnAs(svc *fsbe.FSGamesService, user string) (*v1.ProcessMovesResponse, error) {
+	return svc.ProcessMoves(ContextWithUserID(user), &v1.ProcessMovesRequest{
+		GameId: timeBankGameId,
+		Moves:  []*v1.GameMove{{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}},
+	})
+}
+
+func TestDelegateTurn_RevokedAtEndTurn(t *testing.T) {
+	svc := setupDelegationGame(t, true)
+
+	// Player 2 can't move during player 1's turn until it is delegated
+	if _, err := endTurnAs(svc, "test-user-2"); !errors.Is(err, authz.ErrNotYourTurn) {
+		t.Fatalf("error = %v, want ErrNotYourTurn before delegation", err)
+	}
+
+	resp, err := svc.DelegateTurn(ContextWithUserID("test-user-1"), &v1.DelegateTurnRequest{
+		GameId:           timeBankGameId,
+		DelegatePlayerId: 2,
+	})
+	if err != nil {
+		t.Fatalf("DelegateTurn failed: %v", err)
+	}
+	if resp.DelegatedTo != 2 {
+		t.Errorf("delegated to = %d, want 2", resp.DelegatedTo)
+	}
+	state, _ := remainingBanks(t, svc)
+	if state.DelegatedTo != 2 {
+		t.Fatalf("state delegated to = %d, want 2", state.DelegatedTo)
+	}
+
+	// The delegate finishes player 1's turn, and the move records who made it
+	moves, err := endTurnAs(svc, "test-user-2")
+	if err != nil {
+		t.Fatalf("delegate's EndTurn failed: %v", err)
+	}
+	if move := moves.Moves[0]; move.Player != 1 || move.SubmittedBy != 2 {
+		t.Errorf("move player = %d, submitted by %d, want player 1 submitted by 2", move.Player, move.SubmittedBy)
+	}
+
+	state, _ = remainingBanks(t, svc)
+	if state.DelegatedTo != 0 {
+		t.Errorf("delegation should be revoked at end of turn, got %d", state.DelegatedTo)
+	}
+
+	history, err := svc.LoadGameHistory(context.Background(), timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGameHistory failed: %v", err)
+	}
+	var delegated bool
+	for _, group := range history.Groups {
+		for _, move := range group.Moves {
+			for _, change := range move.Changes {
+				if change.GetTurnDelegated() != nil {
+					delegated = true
+				}
+			}
+		}
+	}
+	if !delegated {
+		t.Error("delegation should be recorded in the move history")
+	}
+
+	// Back on player 1's next turn, player 2 no longer controls it
+	if _, err := endTurnAs(svc, "test-user-2"); err != nil {
+		t.Fatalf("player 2's own EndTurn failed: %v", err)
+	}
+	if _, err := endTurnAs(svc, "test-user-2"); !errors.Is(err, authz.ErrNotYourTurn) {
+		t.Errorf("error = %v, want ErrNotYourTurn after the delegation ended", err)
+	}
+}
+
+func TestDelegateTurn_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		teams    bool
+		user     string
+		delegate int32
+	}{
+		{"not a team game", false, "test-user-1", 2},
+		{"not the current player", true, "test-user-2", 1},
+		{"not a teammate", true, "test-user-1", 3},
+		{"delegate to self", true, "test-user-1", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := setupDelegationGame(t, tt.teams)
+			_, err := svc.DelegateTurn(ContextWithUserID(tt.user), &v1.DelegateTurnRequest{
+				GameId:           timeBankGameId,
+				DelegatePlayerId: tt.delegate,
+			})
+			if err == nil {
+				t.Fatal("expected DelegateTurn to fail")
+			}
+			state, _ := remainingBanks(t, svc)
+			if state.DelegatedTo != 0 {
+				t.Errorf("delegated to = %d, want 0", state.DelegatedTo)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// =============================================================================
+// Tests for the pre-game unit draft
+// =============================================================================
+
+// draftBase is an empty land base owned by player 1 in the test game
+var draftBase = &v1.Position{Q: -3, R: 15}
+
+// setupDraftGame copies the test game and restarts it with a draft
+func setupDraftGame(t *testing.T, bans, picks int32) *fsbe.FSGamesService {
+	t.Helper()
+	ctx := context.Background()
+
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+	game, err := svc.LoadGame(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	game.Config.Settings.Draft = &v1.DraftSettings{BansPerPlayer: bans, PicksPerPlayer: picks}
+	if err := svc.SaveGame(ctx, timeBankGameId, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	state, err := svc.LoadGameState(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	svc.InitializePlayerStates(state, game.Config)
+	if err := svc.SaveGameState(ctx, timeBankGameId, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	return svc
+}
+
+func draftAs(svc *fsbe.FSGamesService, user string, unitType int32, pick bool) (*v1.DraftUnitResponse, error) {
+	return svc.DraftUnit(ContextWithUserID(user), &v1.DraftUnitRequest{
+		GameId:   timeBankGameId,
+		UnitType: unitType,
+		Pick:     pick,
+	})
+}
+
+func TestDraft_BannedUnitCannotBeBuilt(t *testing.T) {
+	svc := setupDraftGame(t, 1, 0)
+	const soldier, tank = 1, 5
+
+	state, _ := remainingBanks(t, svc)
+	if state.Status != v1.GameStatus_GAME_STATUS_DRAFTING {
+		t.Fatalf("status = %v, want DRAFTING", state.Status)
+	}
+	if _, err := endTurnAs(svc, "test-user-1"); err == nil || !strings.Contains(err.Error(), "still drafting") {
+		t.Fatalf("EndTurn during the draft: error = %v, want still drafting", err)
+	}
+
+	if _, err := draftAs(svc, "test-user-1", soldier, false); err != nil {
+		t.Fatalf("player 1's ban failed: %v", err)
+	}
+	resp, err := draftAs(svc, "test-user-2", tank, false)
+	if err != nil {
+		t.Fatalf("player 2's ban failed: %v", err)
+	}
+	if !resp.DraftComplete || resp.CurrentPlayer != 1 {
+		t.Fatalf("draft complete = %v, current player = %d, want complete with player 1 to move", resp.DraftComplete, resp.CurrentPlayer)
+	}
+	state, _ = remainingBanks(t, svc)
+	if state.Status != v1.GameStatus_GAME_STATUS_PLAYING {
+		t.Fatalf("status = %v, want PLAYING once the draft is over", state.Status)
+	}
+
+	ctx := ContextWithUserID("test-user-1")
+	options, err := svc.GetOptionsAt(ctx, &v1.GetOptionsAtRequest{GameId: timeBankGameId, Pos: draftBase})
+	if err != nil {
+		t.Fatalf("GetOptionsAt failed: %v", err)
+	}
+	builds := 0
+	for _, option := range options.Options {
+		if build := option.GetBuild(); build != nil {
+			builds++
+			if build.UnitType == soldier || build.UnitType == tank {
+				t.Errorf("banned unit type %d offered as a build option", build.UnitType)
+			}
+		}
+	}
+	if builds == 0 {
+		t.Fatal("expected the base to offer builds")
+	}
+
+	_, err = svc.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+		GameId: timeBankGameId,
+		Moves: []*v1.GameMove{{
+			MoveType: &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{Pos: draftBase, UnitType: soldier}},
+		}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "drafted away") {
+		t.Fatalf("building a banned unit: error = %v, want drafted away", err)
+	}
+}
+
+func TestDraft_CannotBanAlreadyBannedUnit(t *testing.T) {
+	svc := setupDraftGame(t, 1, 1)
+	const soldier = 1
+
+	if _, err := draftAs(svc, "test-user-1", soldier, false); err != nil {
+		t.Fatalf("player 1's ban failed: %v", err)
+	}
+	if _, err := draftAs(svc, "test-user-2", soldier, false); err == nil || !strings.Contains(err.Error(), "already banned") {
+		t.Fatalf("banning a banned unit: error = %v, want already banned", err)
+	}
+	if _, err := draftAs(svc, "test-user-2", soldier, true); err == nil {
+		t.Fatal("expected picking during the ban round to fail")
+	}
+
+	state, _ := remainingBanks(t, svc)
+	if state.Draft.TurnsTaken != 1 || state.CurrentPlayer != 2 {
+		t.Errorf("turns taken = %d, current player = %d, want 1 turn taken with player 2 to draft", state.Draft.TurnsTaken, state.CurrentPlayer)
+	}
+}
@@ -0,0 +1,216 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"github.com/turnforge/lilbattle/services/gormbe"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Tests for EndTurns racing each other for the same turn
+// =============================================================================
+
+type moveProcessor interface {
+	ProcessMoves(context.Context, *v1.ProcessMovesRequest) (*v1.ProcessMovesResponse, error)
+	GetGame(context.Context, *v1.GetGameRequest) (*v1.GetGameResponse, error)
+}
+
+// endTurnAt ends the turn in state for its current player, as a client
+// that last saw state would
+func endTurnAt(svc moveProcessor, gameId string, state *v1.GameState) error {
+	_, err := svc.ProcessMoves(ContextWithUserID("test-user-1"), &v1.ProcessMovesRequest{
+		GameId:     gameId,
+		ApiVersion: services.ApiVersion,
+		Moves: []*v1.GameMove{{
+			Player:   state.CurrentPlayer,
+			MoveType: &v1.GameMove_EndTurn{EndTurn: lib.NewEndTurnAction(state, false)},
+		}},
+	})
+	return err
+}
+
+// raceEndTurns sends several EndTurns for player 1's turn at the same time
+// and checks exactly one of them ended it
+func raceEndTurns(t *testing.T, svc moveProcessor, gameId string) {
+	t.Helper()
+	before, err := svc.GetGame(context.Background(), &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	epoch := &v1.GameState{TurnCounter: before.State.TurnCounter, CurrentPlayer: before.State.CurrentPlayer}
+	groups := before.State.CurrentGroupNumber
+
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	start := make(chan struct{})
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			errs[i] = endTurnAt(svc, gameId, epoch)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !services.IsTurnAlreadyEnded(err):
+			t.Errorf("losing EndTurn failed with %v, want the turn already ended", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("%d EndTurns succeeded, want exactly 1", succeeded)
+	}
+
+	after, err := svc.GetGame(context.Background(), &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	if after.State.CurrentPlayer != 2 || after.State.TurnCounter != epoch.TurnCounter {
+		t.Errorf("now player %d's turn %d, want player 2's turn %d",
+			after.State.CurrentPlayer, after.State.TurnCounter, epoch.TurnCounter)
+	}
+	if got := after.State.CurrentGroupNumber; got != groups+1 {
+		t.Errorf("%d move groups saved, want 1", got-groups)
+	}
+}
+
+func TestEndTurnEpoch_StaleEndTurnRejected(t *testing.T) {
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+	resp, err := svc.GetGame(context.Background(), &v1.GetGameRequest{Id: timeBankGameId})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	epoch := &v1.GameState{TurnCounter: resp.State.TurnCounter, CurrentPlayer: resp.State.CurrentPlayer}
+
+	if err := endTurnAt(svc, timeBankGameId, epoch); err != nil {
+		t.Fatalf("first EndTurn failed: %v", err)
+	}
+	err = endTurnAt(svc, timeBankGameId, epoch)
+	if !services.IsTurnAlreadyEnded(err) {
+		t.Fatalf("retried EndTurn returned %v, want the turn already ended", err)
+	}
+	if resp, _ := svc.GetGame(context.Background(), &v1.GetGameRequest{Id: timeBankGameId}); resp.State.CurrentPlayer != 2 {
+		t.Errorf("retried EndTurn moved the turn on to player %d", resp.State.CurrentPlayer)
+	}
+}
+
+func TestIsTurnAlreadyEnded_OnlyMatchesEpochRejections(t *testing.T) {
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+	resp, err := svc.GetGame(context.Background(), &v1.GetGameRequest{Id: timeBankGameId})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	stale := &v1.GameState{TurnCounter: resp.State.TurnCounter + 1, CurrentPlayer: resp.State.CurrentPlayer}
+	if err := endTurnAt(svc, timeBankGameId, stale); !services.IsTurnAlreadyEnded(err) {
+		t.Errorf("EndTurn for another turn returned %v, want the turn already ended", err)
+	}
+
+	// Other Aborted errors, like a commit that lost a race, are not benign
+	if services.IsTurnAlreadyEnded(status.Error(codes.Aborted, "concurrent commit")) {
+		t.Error("a bare Aborted status was taken for the turn already ended")
+	}
+	if services.IsTurnAlreadyEnded(nil) {
+		t.Error("no error was taken for the turn already ended")
+	}
+}
+
+func TestEndTurnEpoch_ConcurrentFS(t *testing.T) {
+	raceEndTurns(t, fsbe.NewFSGamesService(copyTestGame(t), nil), timeBankGameId)
+}
+
+// newGORMTestGame copies the test game into the Postgres database named by
+// LILBATTLE_TEST_DB_ENDPOINT under a fresh ID, skipping the test without one
+func newGORMTestGame(t *testing.T) (*gormbe.GamesService, string) {
+	t.Helper()
+	endpoint := os.Getenv("LILBATTLE_TEST_DB_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("LILBATTLE_TEST_DB_ENDPOINT not set")
+	}
+	db, err := gormbe.OpenDB(endpoint)
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", endpoint, err)
+	}
+	svc := gormbe.NewGamesService(db, nil)
+
+	ctx := context.Background()
+	fixture, err := fsbe.NewFSGamesService(copyTestGame(t), nil).GetGame(ctx, &v1.GetGameRequest{Id: timeBankGameId})
+	if err != nil {
+		t.Fatalf("failed to load the test game: %v", err)
+	}
+	gameId := fmt.Sprintf("endturnrace%d", time.Now().UnixNano())
+	fixture.Game.Id, fixture.State.GameId = gameId, gameId
+	if err := svc.SaveGame(ctx, gameId, fixture.Game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if err := svc.SaveGameState(ctx, gameId, fixture.State); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	t.Cleanup(func() { svc.DeleteFromStorage(ctx, gameId) })
+	return svc, gameId
+}
+
+// TestEndTurnEpoch_ConcurrentGORM runs the race against a Postgres database
+// named by LILBATTLE_TEST_DB_ENDPOINT, and is skipped without one
+func TestEndTurnEpoch_ConcurrentGORM(t *testing.T) {
+	svc, gameId := newGORMTestGame(t)
+	raceEndTurns(t, svc, gameId)
+}
+
+// TestCommitMoveGroup_StaleStateGORM commits two move groups on top of the
+// same state, as two servers would, and checks only the first is saved
+func TestCommitMoveGroup_StaleStateGORM(t *testing.T) {
+	svc, gameId := newGORMTestGame(t)
+	ctx := context.Background()
+	loaded, err := svc.LoadGameState(ctx, gameId)
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+
+	commit := func() error {
+		state := proto.Clone(loaded).(*v1.GameState)
+		state.CurrentGroupNumber++
+		group := &v1.GameMoveGroup{
+			GroupNumber: state.CurrentGroupNumber,
+			Moves: []*v1.GameMove{{
+				Player:   state.CurrentPlayer,
+				MoveType: &v1.GameMove_EndTurn{EndTurn: lib.NewEndTurnAction(loaded, false)},
+			}},
+		}
+		return svc.CommitMoveGroup(ctx, gameId, group, state)
+	}
+	if err := commit(); err != nil {
+		t.Fatalf("first commit failed: %v", err)
+	}
+	if err := commit(); !errors.Is(err, services.ErrStaleGameState) {
+		t.Errorf("commit on top of a stale state returned %v, want ErrStaleGameState", err)
+	}
+
+	history, err := svc.LoadGameHistory(ctx, gameId)
+	if err != nil {
+		t.Fatalf("LoadGameHistory failed: %v", err)
+	}
+	// Only the state was copied into the database, so the first commit's
+	// group is the only one
+	if len(history.Groups) != 1 || len(history.Groups[0].Moves) != 1 {
+		t.Errorf("saved move groups = %v, want the first commit's", history.Groups)
+	}
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// TestFogDiscovery_SavedWithGame tests that the hexes each player has
+// discovered in a fog of war game are saved with the game and survive a
+// restart
+func TestFogDiscovery_SavedWithGame(t *testing.T) {
+	ctx := context.Background()
+	gamesDir := copyTestGame(t)
+	svc := fsbe.NewFSGamesService(gamesDir, nil)
+	game, err := svc.LoadGame(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	game.Config.Settings.FogEnabled = true
+	if err := svc.SaveGame(ctx, timeBankGameId, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	if _, err := svc.ProcessMoves(ContextWithUserID("test-user-1"), endTurnRequest(1)); err != nil {
+		t.Fatalf("EndTurn failed: %v", err)
+	}
+
+	// A new service over the same dir reloads the game from disk
+	resp, err := fsbe.NewFSGamesService(gamesDir, nil).GetGameState(ctx, &v1.GetGameStateRequest{GameId: timeBankGameId})
+	if err != nil {
+		t.Fatalf("GetGameState failed: %v", err)
+	}
+	state := resp.State
+	for _, player := range []int32{1, 2} {
+		if got := len(state.PlayerStates[player].GetDiscoveredHexes()); got == 0 {
+			t.Errorf("player %d has no discovered hexes saved", player)
+		}
+	}
+}
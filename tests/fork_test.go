@@ -0,0 +1,233 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/server"
+	"google.golang.org/grpc/metadata"
+)
+
+// =============================================================================
+// Tests for forking a game into a private copy
+// =============================================================================
+
+// TestForkGame tests a fork plays on independently of the game it was forked
+// from, which is left untouched, and that forking at a move starts the fork
+// from the game as it was after that move
+func TestForkGame(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	backend, err := server.StartLocalBackend(context.Background(), address, t.TempDir())
+	if err != nil {
+		t.Fatalf("StartLocalBackend failed: %v", err)
+	}
+	defer backend.Stop()
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	games := backend.ClientMgr.GetGamesSvcClient()
+	player1 := server.LocalContext(context.Background())
+	player2 := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test2")
+
+	worldData := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for _, coord := range (lib.AxialCoord{}).Range(2) {
+		worldData.TilesMap[lib.CoordKeyFromAxial(coord)] = lib.NewTile(coord, lib.TileTypeGrass)
+	}
+	base := lib.NewTile(lib.AxialCoord{}, lib.TileTypeLandBase)
+	base.Player = 1
+	worldData.TilesMap[lib.CoordKeyFromAxial(lib.AxialCoord{})] = base
+	tankAt := lib.AxialCoord{Q: 1, R: 0}
+	worldData.UnitsMap[lib.CoordKeyFromAxial(tankAt)] = lib.NewUnit(int(UnitTypeTank), 2, tankAt)
+	world, err := worlds.CreateWorld(player1, &v1.CreateWorldRequest{World: &v1.World{Name: "Fork"}, WorldData: worldData})
+	if err != nil {
+		t.Fatalf("CreateWorld failed: %v", err)
+	}
+	created, err := games.CreateGame(player1, &v1.CreateGameRequest{Game: &v1.Game{
+		Name:    "Fork",
+		WorldId: world.World.Id,
+		Config: &v1.GameConfiguration{
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: server.LocalUserID, PlayerType: "human", StartingCoins: 500},
+				{PlayerId: 2, UserId: "test2", PlayerType: "human"},
+			},
+			Settings: &v1.GameSettings{Rated: true},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	gameId := created.Game.Id
+
+	play := func(ctx context.Context, id string, move *v1.GameMove) {
+		t.Helper()
+		if _, err := games.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: id, Moves: []*v1.GameMove{move}}); err != nil {
+			t.Fatalf("ProcessMoves on %s failed: %v", id, err)
+		}
+	}
+	endTurn := &v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	play(player1, gameId, &v1.GameMove{MoveType: &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{
+		Pos: &v1.Position{Label: "0,0"}, UnitType: UnitTypeSoldierBasic,
+	}}})
+	play(player1, gameId, endTurn)
+
+	before, err := games.GetGame(player1, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+
+	// Only players may fork a game that has not ended
+	outsider := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test3")
+	if _, err := games.ForkGame(outsider, &v1.ForkGameRequest{GameId: gameId}); err == nil {
+		t.Error("a user with no seat forked a game in progress")
+	}
+
+	// Player 2 forks the game as it is now and plays both seats in it
+	forked, err := games.ForkGame(player2, &v1.ForkGameRequest{GameId: gameId})
+	if err != nil {
+		t.Fatalf("ForkGame failed: %v", err)
+	}
+	fork := forked.Game
+	if fork.ForkedFromGameId != gameId || fork.ForkedAtMove != 2 {
+		t.Errorf("fork of %s after %d moves, want %s after 2", fork.ForkedFromGameId, fork.ForkedAtMove, gameId)
+	}
+	if fork.Config.Settings.GetRated() {
+		t.Error("fork is rated")
+	}
+	for _, player := range fork.Config.Players {
+		if player.UserId != "test2" {
+			t.Errorf("fork player %d is played by %q, want test2", player.PlayerId, player.UserId)
+		}
+	}
+	play(player2, fork.Id, &v1.GameMove{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+		From: &v1.Position{Label: "1,0"}, To: &v1.Position{Label: "2,0"},
+	}}})
+	play(player2, fork.Id, endTurn)
+	play(player2, fork.Id, endTurn)
+
+	forkNow, err := games.GetGame(player2, &v1.GetGameRequest{Id: fork.Id})
+	if err != nil {
+		t.Fatalf("GetGame on fork failed: %v", err)
+	}
+	if got := len(forkNow.History.Groups); got != 5 {
+		t.Errorf("fork has %d move groups, want 5", got)
+	}
+	if forkNow.State.WorldData.UnitsMap["2,0"] == nil {
+		t.Error("tank did not move in the fork")
+	}
+
+	after, err := games.GetGame(player1, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	if after.State.StateHash != before.State.StateHash || len(after.History.Groups) != len(before.History.Groups) {
+		t.Error("playing the fork changed the game it was forked from")
+	}
+	if after.State.WorldData.UnitsMap["1,0"] == nil {
+		t.Error("tank moved in the game the fork was forked from")
+	}
+
+	// Forking after the first move starts from the soldier just built
+	early, err := games.ForkGame(player1, &v1.ForkGameRequest{GameId: gameId, MoveIndex: 1})
+	if err != nil {
+		t.Fatalf("ForkGame at move 1 failed: %v", err)
+	}
+	earlyNow, err := games.GetGame(player1, &v1.GetGameRequest{Id: early.Game.Id})
+	if err != nil {
+		t.Fatalf("GetGame on early fork failed: %v", err)
+	}
+	moves := 0
+	for _, group := range earlyNow.History.Groups {
+		moves += len(group.Moves)
+	}
+	if moves != 1 {
+		t.Errorf("early fork has %d moves, want 1", moves)
+	}
+	if earlyNow.State.CurrentPlayer != 1 || earlyNow.State.WorldData.UnitsMap["0,0"] == nil {
+		t.Errorf("early fork has player %d to move, want player 1 with the built soldier", earlyNow.State.CurrentPlayer)
+	}
+	play(player1, early.Game.Id, endTurn)
+
+	// Forks are only listed when asked for
+	listed, err := games.ListGames(player1, &v1.ListGamesRequest{})
+	if err != nil {
+		t.Fatalf("ListGames failed: %v", err)
+	}
+	if len(listed.Items) != 1 || listed.Items[0].Id != gameId {
+		t.Errorf("listed %d games, want only the source game", len(listed.Items))
+	}
+	listed, err = games.ListGames(player1, &v1.ListGamesRequest{IncludeForks: true})
+	if err != nil {
+		t.Fatalf("ListGames with forks failed: %v", err)
+	}
+	if len(listed.Items) != 3 {
+		t.Errorf("listed %d games with forks, want 3", len(listed.Items))
+	}
+}
+
+// TestForkGame_DropsTurnPlans tests that forking a simultaneous turns game
+// does not carry over the plans submitted in it, which the forker would get
+// to read as the player of every seat
+func TestForkGame_DropsTurnPlans(t *testing.T) {
+	backend := startVisibilityBackend(t)
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	games := backend.ClientMgr.GetGamesSvcClient()
+	player1 := server.LocalContext(context.Background())
+	player2 := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test2")
+
+	worldData := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for _, coord := range (lib.AxialCoord{}).Range(2) {
+		worldData.TilesMap[lib.CoordKeyFromAxial(coord)] = lib.NewTile(coord, lib.TileTypeGrass)
+	}
+	for player, coord := range map[int32]lib.AxialCoord{1: {Q: 1, R: 0}, 2: {Q: -2, R: 0}} {
+		worldData.UnitsMap[lib.CoordKeyFromAxial(coord)] = lib.NewUnit(int(UnitTypeTank), int(player), coord)
+	}
+	world, err := worlds.CreateWorld(player1, &v1.CreateWorldRequest{World: &v1.World{Name: "Fork plans"}, WorldData: worldData})
+	if err != nil {
+		t.Fatalf("CreateWorld failed: %v", err)
+	}
+	created, err := games.CreateGame(player1, &v1.CreateGameRequest{Game: &v1.Game{
+		Name:    "Fork plans",
+		WorldId: world.World.Id,
+		Config: &v1.GameConfiguration{
+			Settings: &v1.GameSettings{SimultaneousTurns: true},
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: server.LocalUserID, PlayerType: "human"},
+				{PlayerId: 2, UserId: "test2", PlayerType: "human"},
+			},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	gameId := created.Game.Id
+	if _, err := games.ProcessMoves(player1, &v1.ProcessMovesRequest{GameId: gameId, Moves: []*v1.GameMove{{
+		Player: 1,
+		MoveType: &v1.GameMove_SubmitPlan{SubmitPlan: &v1.SubmitPlanAction{Moves: []*v1.GameMove{{
+			MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Q: 1, R: 0},
+				To:   &v1.Position{Q: 0, R: 0},
+			}},
+		}}}},
+	}}}); err != nil {
+		t.Fatalf("submitting player 1's plan failed: %v", err)
+	}
+
+	forked, err := games.ForkGame(player2, &v1.ForkGameRequest{GameId: gameId})
+	if err != nil {
+		t.Fatalf("ForkGame failed: %v", err)
+	}
+	fork, err := games.GetGame(player2, &v1.GetGameRequest{Id: forked.Game.Id})
+	if err != nil {
+		t.Fatalf("GetGame on fork failed: %v", err)
+	}
+	if got := fork.State.TurnPlans; len(got) != 0 {
+		t.Errorf("fork carried over %d turn plans, want none", len(got))
+	}
+}
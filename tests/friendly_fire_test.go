@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// =============================================================================
+// Tests for the friendly fire setting
+// =============================================================================
+
+// friendlyFireGame puts two player 1 soldiers next to each other
+func friendlyFireGame(allowFriendlyFire bool) *lib.Game {
+	return NewGameBuilder().
+		GrassTiles(2).
+		UnitWithShortcut(0, 0, 1, UnitTypeSoldierBasic, "A1").
+		UnitWithShortcut(1, 0, 1, UnitTypeSoldierBasic, "A2").
+		Settings(&v1.GameSettings{AllowFriendlyFire: allowFriendlyFire}).
+		Build()
+}
+
+func TestFriendlyFire_RejectedByDefault(t *testing.T) {
+	game := friendlyFireGame(false)
+	attacker := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	target := game.World.UnitAt(AxialCoord{Q: 1, R: 0})
+
+	if game.CanAttackUnit(attacker, target) {
+		t.Error("a unit can attack its own player's unit by default")
+	}
+	if tiles := game.GetAttackableTiles(attacker); len(tiles) != 0 {
+		t.Errorf("attackable tiles = %v, want none", tiles)
+	}
+	if _, err := game.Attack("A1", "A2"); err == nil {
+		t.Error("attacking an own unit was accepted")
+	}
+}
+
+func TestFriendlyFire_AllowedWithSetting(t *testing.T) {
+	game := friendlyFireGame(true)
+	attacker := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	target := game.World.UnitAt(AxialCoord{Q: 1, R: 0})
+
+	if !game.CanAttackUnit(attacker, target) {
+		t.Error("friendly fire is on but a unit cannot attack its own player's unit")
+	}
+	if game.CanAttackUnit(attacker, attacker) {
+		t.Error("a unit can attack itself")
+	}
+	if tiles := game.GetAttackableTiles(attacker); len(tiles) != 1 || tiles[0] != (AxialCoord{Q: 1, R: 0}) {
+		t.Errorf("attackable tiles = %v, want the other soldier at 1,0", tiles)
+	}
+
+	before := target.AvailableHealth
+	if _, err := game.Attack("A1", "A2"); err != nil {
+		t.Fatalf("Attack failed: %v", err)
+	}
+	if after := game.World.UnitAt(AxialCoord{Q: 1, R: 0}); after != nil && after.AvailableHealth >= before {
+		t.Errorf("attacked soldier's health went from %d to %d", before, after.AvailableHealth)
+	}
+}
@@ -0,0 +1,314 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"slices"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// =============================================================================
+// Tests for hiding what only other players may see
+// =============================================================================
+
+// startVisibilityBackend starts a local backend serving games as clients
+// see them
+func startVisibilityBackend(t *testing.T) *server.LocalBackend {
+	t.Helper()
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	backend, err := server.StartLocalBackend(context.Background(), address, t.TempDir())
+	if err != nil {
+		t.Fatalf("StartLocalBackend failed: %v", err)
+	}
+	t.Cleanup(backend.Stop)
+	return backend
+}
+
+// TestTurnPlans_HiddenFromOpponents tests that a submitted turn plan's moves
+// are only returned to the player who planned them
+func TestTurnPlans_HiddenFromOpponents(t *testing.T) {
+	backend := startVisibilityBackend(t)
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	games := backend.ClientMgr.GetGamesSvcClient()
+	player1 := server.LocalContext(context.Background())
+	player2 := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test2")
+
+	worldData := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for _, coord := range (lib.AxialCoord{}).Range(2) {
+		worldData.TilesMap[lib.CoordKeyFromAxial(coord)] = lib.NewTile(coord, lib.TileTypeGrass)
+	}
+	tankAt := lib.AxialCoord{Q: 1, R: 0}
+	worldData.UnitsMap[lib.CoordKeyFromAxial(tankAt)] = lib.NewUnit(int(UnitTypeTank), 1, tankAt)
+	enemyAt := lib.AxialCoord{Q: -2, R: 0}
+	worldData.UnitsMap[lib.CoordKeyFromAxial(enemyAt)] = lib.NewUnit(int(UnitTypeTank), 2, enemyAt)
+	world, err := worlds.CreateWorld(player1, &v1.CreateWorldRequest{World: &v1.World{Name: "Plans"}, WorldData: worldData})
+	if err != nil {
+		t.Fatalf("CreateWorld failed: %v", err)
+	}
+	created, err := games.CreateGame(player1, &v1.CreateGameRequest{Game: &v1.Game{
+		Name:    "Plans",
+		WorldId: world.World.Id,
+		Config: &v1.GameConfiguration{
+			Settings: &v1.GameSettings{SimultaneousTurns: true},
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: server.LocalUserID, PlayerType: "human"},
+				{PlayerId: 2, UserId: "test2", PlayerType: "human"},
+			},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	gameId := created.Game.Id
+
+	if _, err := games.ProcessMoves(player1, &v1.ProcessMovesRequest{GameId: gameId, Moves: []*v1.GameMove{{
+		Player: 1,
+		MoveType: &v1.GameMove_SubmitPlan{SubmitPlan: &v1.SubmitPlanAction{Moves: []*v1.GameMove{{
+			MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Q: 1, R: 0},
+				To:   &v1.Position{Q: 0, R: 0},
+			}},
+		}}}},
+	}}}); err != nil {
+		t.Fatalf("submitting player 1's plan failed: %v", err)
+	}
+
+	// turnPlan returns player 1's plan as the caller sees it
+	turnPlan := func(ctx context.Context) *v1.TurnPlan {
+		t.Helper()
+		resp, err := games.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+		if err != nil {
+			t.Fatalf("GetGame failed: %v", err)
+		}
+		for _, plan := range resp.State.TurnPlans {
+			if plan.Player == 1 {
+				return plan
+			}
+		}
+		t.Fatal("player 1's plan is missing from the game state")
+		return nil
+	}
+
+	if got := turnPlan(player1); len(got.Moves) != 1 {
+		t.Errorf("player 1 sees %d moves in their own plan, want 1", len(got.Moves))
+	}
+	if got := turnPlan(player2); len(got.Moves) != 0 {
+		t.Errorf("player 2 sees %d of player 1's planned moves, want none", len(got.Moves))
+	}
+	state, err := games.GetGameState(player2, &v1.GetGameStateRequest{GameId: gameId})
+	if err != nil {
+		t.Fatalf("GetGameState failed: %v", err)
+	}
+	for _, plan := range state.State.TurnPlans {
+		if len(plan.Moves) != 0 {
+			t.Errorf("player 2's game state shows %d of player %d's planned moves, want none", len(plan.Moves), plan.Player)
+		}
+	}
+}
+
+// TestSpectatorDelay_GamesRewoundForSpectators tests that a spectator of a
+// game delayed by a turn gets its state from the start of the held back turn
+// while the players get it live
+func TestSpectatorDelay_GamesRewoundForSpectators(t *testing.T) {
+	backend := startVisibilityBackend(t)
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	games := backend.ClientMgr.GetGamesSvcClient()
+	player1 := server.LocalContext(context.Background())
+	player2 := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test2")
+	spectator := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test3")
+
+	worldData := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for _, coord := range (lib.AxialCoord{}).Range(2) {
+		worldData.TilesMap[lib.CoordKeyFromAxial(coord)] = lib.NewTile(coord, lib.TileTypeGrass)
+	}
+	for player, coord := range map[int32]lib.AxialCoord{1: {Q: 1, R: 0}, 2: {Q: -2, R: 0}} {
+		worldData.UnitsMap[lib.CoordKeyFromAxial(coord)] = lib.NewUnit(int(UnitTypeTank), int(player), coord)
+	}
+	world, err := worlds.CreateWorld(player1, &v1.CreateWorldRequest{World: &v1.World{Name: "Delayed"}, WorldData: worldData})
+	if err != nil {
+		t.Fatalf("CreateWorld failed: %v", err)
+	}
+	created, err := games.CreateGame(player1, &v1.CreateGameRequest{Game: &v1.Game{
+		Name:    "Delayed",
+		WorldId: world.World.Id,
+		Config: &v1.GameConfiguration{
+			Settings: &v1.GameSettings{SpectatorDelay: &v1.SpectatorDelay{Turns: 1}},
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: server.LocalUserID, PlayerType: "human"},
+				{PlayerId: 2, UserId: "test2", PlayerType: "human"},
+			},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	gameId := created.Game.Id
+
+	// Two whole turns are played, then player 1 ends their turn in turn 3,
+	// which spectators are held back from
+	for _, ctx := range []context.Context{player1, player2, player1, player2, player1} {
+		if _, err := games.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: gameId, Moves: []*v1.GameMove{
+			{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}},
+		}}); err != nil {
+			t.Fatalf("ending a turn failed: %v", err)
+		}
+	}
+
+	live, err := games.GetGame(player2, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	if live.State.TurnCounter != 3 || live.State.CurrentPlayer != 2 {
+		t.Fatalf("players see turn %d player %d, want turn 3 player 2", live.State.TurnCounter, live.State.CurrentPlayer)
+	}
+
+	watched, err := games.GetGame(spectator, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	if watched.State.TurnCounter != 3 || watched.State.CurrentPlayer != 1 {
+		t.Errorf("spectators see turn %d player %d, want turn 3 player 1", watched.State.TurnCounter, watched.State.CurrentPlayer)
+	}
+	if got, want := len(watched.History.GetGroups()), len(live.History.GetGroups())-1; got != want {
+		t.Errorf("spectators see %d move groups, want %d", got, want)
+	}
+
+	state, err := games.GetGameState(spectator, &v1.GetGameStateRequest{GameId: gameId})
+	if err != nil {
+		t.Fatalf("GetGameState failed: %v", err)
+	}
+	if state.State.CurrentPlayer != 1 {
+		t.Errorf("spectators' game state is at player %d's turn, want player 1's", state.State.CurrentPlayer)
+	}
+
+	heldBack := &v1.GetStateAtTurnRequest{GameId: gameId, Turn: 3, Player: 2}
+	if _, err := games.GetStateAtTurn(spectator, heldBack); status.Code(err) != codes.OutOfRange {
+		t.Errorf("spectator's GetStateAtTurn for a held back turn returned %v, want OutOfRange", err)
+	}
+	if _, err := games.GetStateAtTurn(player1, heldBack); err != nil {
+		t.Errorf("player's GetStateAtTurn for the current turn failed: %v", err)
+	}
+}
+
+// TestSpectatorDelay_HistoryHeldBack tests that spectators of a delayed game
+// cannot read the held back moves through its move list, state diffs or a
+// fork of it once it has ended
+func TestSpectatorDelay_HistoryHeldBack(t *testing.T) {
+	backend := startVisibilityBackend(t)
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	games := backend.ClientMgr.GetGamesSvcClient()
+	player1 := server.LocalContext(context.Background())
+	player2 := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test2")
+	spectator := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test3")
+
+	// Each player's soldier is on its own island, so the game can be ended
+	// as a no-contact draw
+	worldData := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for q := -1; q <= 6; q++ {
+		for r := -1; r <= 1; r++ {
+			coord := lib.AxialCoord{Q: q, R: r}
+			worldData.TilesMap[lib.CoordKeyFromAxial(coord)] = lib.NewTile(coord, lib.TileTypeWaterRegular)
+		}
+	}
+	for _, coord := range []lib.AxialCoord{{Q: 0, R: 0}, {Q: 1, R: 0}, {Q: 5, R: 0}} {
+		worldData.TilesMap[lib.CoordKeyFromAxial(coord)] = lib.NewTile(coord, lib.TileTypeGrass)
+	}
+	for player, coord := range map[int32]lib.AxialCoord{1: {Q: 0, R: 0}, 2: {Q: 5, R: 0}} {
+		worldData.UnitsMap[lib.CoordKeyFromAxial(coord)] = lib.NewUnit(int(UnitTypeSoldier), int(player), coord)
+	}
+	world, err := worlds.CreateWorld(player1, &v1.CreateWorldRequest{World: &v1.World{Name: "Delayed islands"}, WorldData: worldData})
+	if err != nil {
+		t.Fatalf("CreateWorld failed: %v", err)
+	}
+	created, err := games.CreateGame(player1, &v1.CreateGameRequest{Game: &v1.Game{
+		Name:    "Delayed islands",
+		WorldId: world.World.Id,
+		Config: &v1.GameConfiguration{
+			Settings: &v1.GameSettings{SpectatorDelay: &v1.SpectatorDelay{Turns: 1}},
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: server.LocalUserID, PlayerType: "human"},
+				{PlayerId: 2, UserId: "test2", PlayerType: "human"},
+			},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	gameId := created.Game.Id
+
+	// Two whole turns are played, then player 1 moves their soldier in turn
+	// 3, which spectators are held back from
+	play := func(ctx context.Context, move *v1.GameMove) {
+		t.Helper()
+		if _, err := games.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: gameId, Moves: []*v1.GameMove{move}}); err != nil {
+			t.Fatalf("ProcessMoves failed: %v", err)
+		}
+	}
+	endTurn := &v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	for _, ctx := range []context.Context{player1, player2, player1, player2} {
+		play(ctx, endTurn)
+	}
+	play(player1, &v1.GameMove{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+		From: &v1.Position{Q: 0, R: 0}, To: &v1.Position{Q: 1, R: 0},
+	}}})
+	play(player1, endTurn)
+	if _, err := games.ClaimNoContactDraw(player2, &v1.ClaimNoContactDrawRequest{GameId: gameId}); err != nil {
+		t.Fatalf("ClaimNoContactDraw failed: %v", err)
+	}
+
+	listed, err := games.ListMoves(player1, &v1.ListMovesRequest{GameId: gameId})
+	if err != nil {
+		t.Fatalf("ListMoves failed: %v", err)
+	}
+	watched, err := games.ListMoves(spectator, &v1.ListMovesRequest{GameId: gameId})
+	if err != nil {
+		t.Fatalf("ListMoves failed: %v", err)
+	}
+	if got, want := len(watched.MoveGroups), len(listed.MoveGroups)-2; got != want {
+		t.Errorf("spectators list %d move groups, want %d", got, want)
+	}
+
+	// movedSoldier reports whether the caller's diff of the whole game shows
+	// player 1's soldier moved
+	movedSoldier := func(ctx context.Context) bool {
+		t.Helper()
+		resp, err := games.GetStateDiff(ctx, &v1.GetStateDiffRequest{GameId: gameId, FromTurn: 1, ToTurn: 9})
+		if err != nil {
+			t.Fatalf("GetStateDiff failed: %v", err)
+		}
+		return slices.ContainsFunc(resp.Diff.Units, func(diff *v1.UnitDiff) bool {
+			return diff.After.GetQ() == 1 && diff.After.GetR() == 0
+		})
+	}
+	if !movedSoldier(player1) {
+		t.Error("players' diff does not show the soldier moved")
+	}
+	if movedSoldier(spectator) {
+		t.Error("spectators' diff shows the held back move")
+	}
+
+	forked, err := games.ForkGame(spectator, &v1.ForkGameRequest{GameId: gameId})
+	if err != nil {
+		t.Fatalf("ForkGame failed: %v", err)
+	}
+	fork, err := games.GetGame(spectator, &v1.GetGameRequest{Id: forked.Game.Id})
+	if err != nil {
+		t.Fatalf("GetGame on fork failed: %v", err)
+	}
+	if fork.State.WorldData.UnitsMap["0,0"] == nil {
+		t.Error("spectator's fork has player 1's held back move in it")
+	}
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"github.com/turnforge/lilbattle/testfixtures"
+)
+
+// =============================================================================
+// Tests for per-player handicaps
+// =============================================================================
+
+// handicapGame is the naval map with player 2 given extra coins, a destroyer
+// and two soldiers
+func handicapGame() (*v1.Game, *v1.GameState) {
+	game, state := testfixtures.NavalMap().Protos()
+	game.Config.Players[1].Handicap = &v1.PlayerHandicap{
+		ExtraCoins: 200,
+		BonusUnits: []int32{testfixtures.UnitTypeDestroyer, testfixtures.UnitTypeSoldier, testfixtures.UnitTypeSoldier},
+	}
+	state.PlayerStates = nil
+	return game, state
+}
+
+func TestHandicap_InitializePlayerStates(t *testing.T) {
+	svc := fsbe.NewFSGamesService(t.TempDir(), nil)
+	game, state := handicapGame()
+	svc.InitializePlayerStates(state, game.Config)
+
+	if got, want := state.PlayerStates[2].Coins, state.PlayerStates[1].Coins+200; got != want {
+		t.Errorf("handicapped player has %d coins, want %d", got, want)
+	}
+
+	world := lib.NewWorld("", state.WorldData)
+	if got := len(world.GetPlayerUnits(1)); got != 1 {
+		t.Errorf("player 1 has %d units, want only their destroyer", got)
+	}
+
+	// The destroyer goes on the player's naval base and the soldiers on the
+	// island next to it
+	base := lib.AxialCoord{Q: 4, R: 0}
+	if unit := world.UnitAt(base); unit == nil || unit.Player != 2 || unit.UnitType != testfixtures.UnitTypeDestroyer {
+		t.Fatalf("unit on player 2's naval base = %v, want their bonus destroyer", unit)
+	}
+	soldiers := 0
+	for _, unit := range world.GetPlayerUnits(2) {
+		if unit.UnitType != testfixtures.UnitTypeSoldier {
+			continue
+		}
+		soldiers++
+		coord := lib.UnitGetCoord(unit)
+		if lib.CubeDistance(coord, base) != 1 || world.TileAt(coord).TileType != lib.TileTypeGrass {
+			t.Errorf("bonus soldier %s placed at %v, want on grass next to the base", unit.Shortcut, coord)
+		}
+		if unit.Shortcut == "" {
+			t.Errorf("bonus soldier at %v has no shortcut", coord)
+		}
+	}
+	if soldiers != 2 {
+		t.Errorf("player 2 has %d soldiers, want 2", soldiers)
+	}
+}
+
+func TestHandicap_NoRoomDropsUnits(t *testing.T) {
+	game, state := handicapGame()
+	game.Config.Players[1].Handicap.BonusUnits = make([]int32, 20)
+	for i := range game.Config.Players[1].Handicap.BonusUnits {
+		game.Config.Players[1].Handicap.BonusUnits[i] = testfixtures.UnitTypeSoldier
+	}
+	fsbe.NewFSGamesService(t.TempDir(), nil).InitializePlayerStates(state, game.Config)
+
+	// Only the base and the 3 island tiles next to it can hold a soldier
+	if got := len(lib.NewWorld("", state.WorldData).GetPlayerUnits(2)); got != 1+4 {
+		t.Errorf("player 2 has %d units, want 5", got)
+	}
+}
+
+func TestHandicap_Validation(t *testing.T) {
+	svc := fsbe.NewFSGamesService(t.TempDir(), nil)
+	for name, handicap := range map[string]*v1.PlayerHandicap{
+		"negative coins": {ExtraCoins: -10},
+		"unknown unit":   {BonusUnits: []int32{9999}},
+	} {
+		game, state := handicapGame()
+		game.Config.Players[1].Handicap = handicap
+		if err := svc.ValidateCreateGameRequest(game, state.WorldData); err == nil {
+			t.Errorf("%s: handicap was accepted", name)
+		}
+	}
+
+	game, state := handicapGame()
+	if err := svc.ValidateCreateGameRequest(game, state.WorldData); err != nil {
+		t.Errorf("valid handicap rejected: %v", err)
+	}
+}
+
+func TestHandicap_SimulationGame(t *testing.T) {
+	game, state := handicapGame()
+	sim, err := lib.NewSimulationGame(state.WorldData, game.Config, lib.DefaultRulesEngine(), 1)
+	if err != nil {
+		t.Fatalf("NewSimulationGame failed: %v", err)
+	}
+	if got, want := sim.GameState.PlayerStates[2].Coins, sim.GameState.PlayerStates[1].Coins+200; got != want {
+		t.Errorf("handicapped player has %d coins, want %d", got, want)
+	}
+	if got := len(sim.World.GetPlayerUnits(2)); got != 4 {
+		t.Errorf("player 2 has %d units, want their destroyer and 3 bonus units", got)
+	}
+}
@@ -469,3 +469,70 @@ func TestNoHealOptionForFullHealthUnit(t *testing.T) {
 		}
 	}
 }
+
+// TestHealUnit_OnFriendlyBase tests a unit on its own base heals by the
+// base's healing bonus and uses up its turn doing so
+func TestHealUnit_OnFriendlyBase(t *testing.T) {
+	game := NewGameBuilder().
+		Tile(0, 0, TileTypeLandBase, 1).
+		Tile(1, 0, TileTypeGrass, 0).
+		UnitFull(0, 0, 1, UnitTypeSoldierBasic, "A1", 5, 3, 0).
+		Build()
+	unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	if err := game.TopUpUnitIfNeeded(unit); err != nil {
+		t.Fatalf("TopUpUnitIfNeeded failed: %v", err)
+	}
+	before := unit.AvailableHealth
+
+	changes, err := game.HealUnit("A1")
+	if err != nil {
+		t.Fatalf("HealUnit failed: %v", err)
+	}
+	if unit.AvailableHealth != before+1 {
+		t.Errorf("health after healing = %d, want %d", unit.AvailableHealth, before+1)
+	}
+	if len(changes) != 1 || changes[0].GetUnitHealed().GetHealAmount() != 1 {
+		t.Errorf("changes = %v, want one UnitHealed change of 1", changes)
+	}
+
+	// Healing was the unit's action for the turn
+	if _, err := game.Move("A1", "1,0"); err == nil {
+		t.Error("unit moved after healing")
+	}
+	if _, err := game.HealUnit("A1"); err == nil {
+		t.Error("unit healed twice in one turn")
+	}
+}
+
+// TestHealUnit_FailsOnNeutralTerrain tests a unit cannot actively heal on
+// neutral terrain, even though it heals there passively at turn start
+func TestHealUnit_FailsOnNeutralTerrain(t *testing.T) {
+	game := NewGameBuilder().
+		Tile(0, 0, TileTypeGrass, 0).
+		UnitFull(0, 0, 1, UnitTypeSoldierBasic, "A1", 5, 3, 0).
+		Build()
+	unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	if err := game.TopUpUnitIfNeeded(unit); err != nil {
+		t.Fatalf("TopUpUnitIfNeeded failed: %v", err)
+	}
+	if unit.AvailableHealth <= 5 {
+		t.Errorf("unit did not heal passively on grass at turn start, health %d", unit.AvailableHealth)
+	}
+	before := unit.AvailableHealth
+
+	if _, err := game.HealUnit("A1"); err == nil {
+		t.Fatal("unit healed on neutral grass")
+	}
+	if unit.AvailableHealth != before || unit.LastActedTurn != 0 {
+		t.Errorf("failed heal changed the unit: health %d, last acted turn %d", unit.AvailableHealth, unit.LastActedTurn)
+	}
+	options, _, err := game.GetUnitOptions(unit)
+	if err != nil {
+		t.Fatalf("GetUnitOptions failed: %v", err)
+	}
+	for _, opt := range options {
+		if opt.GetHeal() != nil {
+			t.Error("heal option offered on neutral grass")
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// =============================================================================
+// Tests for gating the end of a turn on mandatory actions
+// =============================================================================
+
+// newRetreatGame builds a game where player 1's helicopter has attacked a
+// soldier and has its retreat left to make, with retreating mandatory
+func newRetreatGame(t *testing.T) *lib.Game {
+	game := NewGameBuilder().
+		GrassTiles(3).
+		UnitWithShortcut(0, 0, 1, unitTypeHelicopter, "A1").
+		UnitWithShortcut(1, 0, 2, UnitTypeSoldierBasic, "B1").
+		Build()
+	rules := proto.Clone(game.RulesEngine.Rules()).(*v1.RulesEngine)
+	rules.MandatoryActions = []string{lib.MandatoryRetreat}
+	game.RulesEngine = lib.NewRulesEngineFrom(rules)
+
+	if _, err := game.Attack("A1", "1,0"); err != nil {
+		t.Fatalf("Attack failed: %v", err)
+	}
+	return game
+}
+
+func TestEndTurn_BlockedByPendingRetreat(t *testing.T) {
+	game := newRetreatGame(t)
+
+	obligations := game.MandatoryActions(1)
+	if len(obligations) != 1 || obligations[0].Kind != lib.MandatoryRetreat || !obligations[0].Mandatory {
+		t.Fatalf("obligations = %v, want a mandatory retreat", obligations)
+	}
+	if _, err := game.EndTurn(); !errors.Is(err, lib.ErrMandatoryActionsPending) {
+		t.Fatalf("EndTurn with a pending retreat = %v, want ErrMandatoryActionsPending", err)
+	}
+	if game.CurrentPlayer != 1 {
+		t.Errorf("rejected end turn moved play to player %d", game.CurrentPlayer)
+	}
+
+	// Without the rules flag the retreat is only a reminder
+	game.RulesEngine.Rules().MandatoryActions = nil
+	if obligations := game.MandatoryActions(1); len(obligations) != 1 || obligations[0].Mandatory {
+		t.Errorf("obligations with no mandatory actions = %v, want an optional retreat", obligations)
+	}
+	if _, err := game.EndTurn(); err != nil {
+		t.Errorf("EndTurn with an optional retreat pending failed: %v", err)
+	}
+}
+
+func TestEndTurn_AllowedAfterRetreating(t *testing.T) {
+	game := newRetreatGame(t)
+
+	if _, err := game.Move("A1", "-1,0"); err != nil {
+		t.Fatalf("retreat failed: %v", err)
+	}
+	if obligations := game.MandatoryActions(1); len(obligations) != 0 {
+		t.Errorf("obligations after retreating = %v, want none", obligations)
+	}
+	if _, err := game.EndTurn(); err != nil {
+		t.Fatalf("EndTurn after retreating failed: %v", err)
+	}
+	if game.CurrentPlayer != 2 {
+		t.Errorf("current player = %d, want 2", game.CurrentPlayer)
+	}
+}
+
+func TestEndTurn_ForceRecordsSkippedActions(t *testing.T) {
+	game := newRetreatGame(t)
+
+	changes, err := game.ForceEndTurn()
+	if err != nil {
+		t.Fatalf("ForceEndTurn failed: %v", err)
+	}
+	var event *v1.GameEventChange
+	for _, change := range changes {
+		if e := change.GetGameEvent(); e != nil {
+			event = e
+		}
+	}
+	if event == nil {
+		t.Fatal("forced end turn recorded no game event")
+	}
+	if event.EventType != lib.GameEventMandatoryActionsSkipped || event.PlayerId != 1 ||
+		len(event.SkippedActions) != 1 || event.SkippedActions[0].Unit.Label != "A1" {
+		t.Errorf("game event = %v, want player 1 skipping A1's retreat", event)
+	}
+	if game.CurrentPlayer != 2 {
+		t.Errorf("current player = %d, want 2", game.CurrentPlayer)
+	}
+}
+
+func TestEndTurn_TimeoutSkipsPendingRetreat(t *testing.T) {
+	game := newRetreatGame(t)
+	clock := lib.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	game.Clock = clock
+	game.Config.Settings.TimeBank = &v1.TimeBankSettings{InitialSeconds: 60}
+	game.GameState.PlayerStates = map[int32]*v1.PlayerState{
+		1: {TimeBankMs: 60_000, IsActive: true},
+		2: {TimeBankMs: 60_000, IsActive: true},
+	}
+	game.GameState.ClockStartedAt = timestamppb.New(clock.Now())
+
+	// Player 1 runs out of time with the retreat still to make
+	clock.Advance(61 * time.Second)
+	move := game.TimeoutMove()
+	if move == nil {
+		t.Fatal("expected a timeout move once the time bank ran out")
+	}
+	if err := game.ProcessMoves([]*v1.GameMove{move}); err != nil {
+		t.Fatalf("timeout end turn failed: %v", err)
+	}
+	if game.CurrentPlayer != 2 {
+		t.Errorf("current player = %d, want 2 after the timeout", game.CurrentPlayer)
+	}
+	var event *v1.GameEventChange
+	for _, change := range move.Changes {
+		if e := change.GetGameEvent(); e != nil {
+			event = e
+		}
+	}
+	if event == nil || event.EventType != lib.GameEventMandatoryActionsSkipped || len(event.SkippedActions) != 1 {
+		t.Errorf("game event = %v, want player 1's skipped retreat recorded", event)
+	}
+}
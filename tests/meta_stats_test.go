@@ -0,0 +1,211 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"github.com/turnforge/lilbattle/services/server"
+	"google.golang.org/grpc"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// =============================================================================
+// Tests for aggregating meta statistics from finished games
+// =============================================================================
+
+// metaStatsGame is a fixture game between players 1 and 2
+type metaStatsGame struct {
+	id       string
+	turns    int32
+	winner   int32
+	finished bool
+	builds   map[int32][]int32 // unit types built by each player
+	captures []int32           // tile types captured
+}
+
+func saveMetaStatsGame(t *testing.T, svc *fsbe.FSGamesService, createdAt time.Time, game metaStatsGame) {
+	t.Helper()
+	ctx := context.Background()
+	err := svc.SaveGame(ctx, game.id, &v1.Game{
+		Id:        game.id,
+		WorldId:   "meta-world",
+		CreatedAt: tspb.New(createdAt),
+		Config: &v1.GameConfiguration{Players: []*v1.GamePlayer{
+			{PlayerId: 1, PlayerType: "human"},
+			{PlayerId: 2, PlayerType: "human"},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	state := &v1.GameState{GameId: game.id, TurnCounter: game.turns, Status: v1.GameStatus_GAME_STATUS_PLAYING}
+	if game.finished {
+		state.Status = v1.GameStatus_GAME_STATUS_ENDED
+		state.Finished = true
+		state.WinningPlayer = game.winner
+	}
+	if err := svc.SaveGameState(ctx, game.id, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+
+	move := &v1.GameMove{}
+	for player, unitTypes := range game.builds {
+		for _, unitType := range unitTypes {
+			move.Changes = append(move.Changes, &v1.WorldChange{ChangeType: &v1.WorldChange_UnitBuilt{UnitBuilt: &v1.UnitBuiltChange{
+				Unit: &v1.Unit{Player: player, UnitType: unitType},
+			}}})
+		}
+	}
+	for _, tileType := range game.captures {
+		move.Changes = append(move.Changes, &v1.WorldChange{ChangeType: &v1.WorldChange_TileCaptured{TileCaptured: &v1.TileCapturedChange{
+			TileType: tileType,
+		}}})
+	}
+	history := &v1.GameMoveHistory{GameId: game.id, Groups: []*v1.GameMoveGroup{{GroupNumber: 1, Moves: []*v1.GameMove{move}}}}
+	if err := svc.SaveGameHistory(ctx, game.id, history); err != nil {
+		t.Fatalf("SaveGameHistory failed: %v", err)
+	}
+}
+
+// TestMetaStats_AggregatesFinishedGames tests unit build and win rates,
+// capture rates and game lengths are aggregated from the finished games of a
+// world, that aggregating again changes nothing, and that a game finishing
+// later is picked up by the next run
+func TestMetaStats_AggregatesFinishedGames(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	backend, err := server.StartLocalBackend(context.Background(), address, t.TempDir())
+	if err != nil {
+		t.Fatalf("StartLocalBackend failed: %v", err)
+	}
+	defer backend.Stop()
+	indexer := backend.ClientMgr.GetIndexerSvcClient()
+
+	// Wait for the backend before reaching into its services
+	if _, err := indexer.GetMetaStats(context.Background(), &v1.GetMetaStatsRequest{}, grpc.WaitForReady(true)); err != nil {
+		t.Fatalf("GetMetaStats failed: %v", err)
+	}
+	games := backend.Services.Games.(*fsbe.FSGamesService)
+	games.CacheEnabled = false
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	saveMetaStatsGame(t, games, start, metaStatsGame{
+		id: "g1", turns: 10, winner: 1, finished: true,
+		builds:   map[int32][]int32{1: {UnitTypeSoldierBasic, UnitTypeSoldierBasic, UnitTypeTank}, 2: {UnitTypeSoldierBasic}},
+		captures: []int32{lib.TileTypeLandBase},
+	})
+	saveMetaStatsGame(t, games, start.Add(time.Hour), metaStatsGame{id: "g2", turns: 5})
+	saveMetaStatsGame(t, games, start.Add(2*time.Hour), metaStatsGame{
+		id: "g3", turns: 20, winner: 2, finished: true,
+		builds:   map[int32][]int32{2: {UnitTypeTank}},
+		captures: []int32{lib.TileTypeLandBase, lib.TileTypeLandBase},
+	})
+
+	aggregator := &services.MetaStatsAggregator{
+		Games:  backend.ClientMgr.GetGamesSvcClient(),
+		Store:  backend.Services.Indexer,
+		Shards: 2,
+	}
+	getStats := func() *v1.WorldMetaStats {
+		t.Helper()
+		resp, err := indexer.GetMetaStats(context.Background(), &v1.GetMetaStatsRequest{WorldIds: []string{"meta-world"}})
+		if err != nil {
+			t.Fatalf("GetMetaStats failed: %v", err)
+		}
+		if len(resp.Worlds) != 1 {
+			t.Fatalf("got stats of %d worlds, want 1", len(resp.Worlds))
+		}
+		return resp.Worlds[0]
+	}
+	unitStats := func(stats *v1.WorldMetaStats, unitType int32) *v1.UnitMetaStats {
+		for _, unit := range stats.Units {
+			if unit.UnitType == unitType {
+				return unit
+			}
+		}
+		t.Fatalf("no stats for unit type %d", unitType)
+		return nil
+	}
+
+	if _, err := aggregator.Run(context.Background()); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	stats := getStats()
+	if stats.Games != 2 || stats.AverageGameLength != 15 {
+		t.Errorf("%d games of %v turns on average, want 2 games of 15", stats.Games, stats.AverageGameLength)
+	}
+	soldier := unitStats(stats, UnitTypeSoldierBasic)
+	if soldier.UnitsBuilt != 3 || soldier.BuildRate != 1.5 || soldier.Builders != 2 || soldier.WinRateWhenBuilt != 0.5 {
+		t.Errorf("soldier stats = %v, want 3 built, 1.5 per game, 2 builders winning half the time", soldier)
+	}
+	tank := unitStats(stats, UnitTypeTank)
+	if tank.UnitsBuilt != 2 || tank.Builders != 2 || tank.WinRateWhenBuilt != 1 {
+		t.Errorf("tank stats = %v, want 2 built by 2 builders who both won", tank)
+	}
+	if len(stats.Terrains) != 1 || stats.Terrains[0].TileType != lib.TileTypeLandBase || stats.Terrains[0].Captures != 3 || stats.Terrains[0].CaptureRate != 1.5 {
+		t.Errorf("terrain stats = %v, want 3 land base captures, 1.5 per game", stats.Terrains)
+	}
+
+	// Reprocessing every game from scratch leaves the stats as they were
+	for shard := int32(0); shard < aggregator.Shards; shard++ {
+		if err := backend.Services.Indexer.SaveMetaStatsCursor(&v1.MetaStatsCursor{Shard: shard}); err != nil {
+			t.Fatalf("SaveMetaStatsCursor failed: %v", err)
+		}
+	}
+	if _, err := aggregator.Run(context.Background()); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	again := getStats()
+	if again.Games != 2 || again.TotalTurns != stats.TotalTurns || unitStats(again, UnitTypeSoldierBasic).UnitsBuilt != 3 || again.Terrains[0].Captures != 3 {
+		t.Errorf("reprocessing changed the stats to %v", again)
+	}
+
+	// The game still being played is aggregated once it finishes
+	saveMetaStatsGame(t, games, start.Add(time.Hour), metaStatsGame{
+		id: "g2", turns: 30, winner: 2, finished: true,
+		builds: map[int32][]int32{1: {UnitTypeTank}},
+	})
+	if _, err := aggregator.Run(context.Background()); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	stats = getStats()
+	if stats.Games != 3 || stats.AverageGameLength != 20 {
+		t.Errorf("%d games of %v turns on average, want 3 games of 20", stats.Games, stats.AverageGameLength)
+	}
+	if tank := unitStats(stats, UnitTypeTank); tank.UnitsBuilt != 3 || tank.Builders != 3 || tank.BuilderWins != 2 {
+		t.Errorf("tank stats = %v, want 3 built by 3 builders, 2 of whom won", tank)
+	}
+	if count, err := aggregator.Run(context.Background()); err != nil || count != 0 {
+		t.Errorf("Run with every game aggregated = %d, %v, want 0 games", count, err)
+	}
+
+	// A finished game after one still being played in the same shard is
+	// aggregated once, not on every run until the other one finishes
+	saveMetaStatsGame(t, games, start.Add(3*time.Hour), metaStatsGame{id: "g6", turns: 5})
+	saveMetaStatsGame(t, games, start.Add(4*time.Hour), metaStatsGame{id: "g8", turns: 10, winner: 1, finished: true})
+	if count, err := aggregator.Run(context.Background()); err != nil || count != 1 {
+		t.Errorf("Run with g8 finished = %d, %v, want 1 game", count, err)
+	}
+	if count, err := aggregator.Run(context.Background()); err != nil || count != 0 {
+		t.Errorf("Run behind the unfinished g6 = %d, %v, want 0 games", count, err)
+	}
+	saveMetaStatsGame(t, games, start.Add(3*time.Hour), metaStatsGame{id: "g6", turns: 20, winner: 2, finished: true})
+	if count, err := aggregator.Run(context.Background()); err != nil || count != 1 {
+		t.Errorf("Run with g6 finished = %d, %v, want 1 game", count, err)
+	}
+	if stats := getStats(); stats.Games != 5 {
+		t.Errorf("%d games aggregated, want 5", stats.Games)
+	}
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"github.com/turnforge/lilbattle/services/observability"
+)
+
+// scrapeMetric fetches the metrics endpoint and returns the value of an unlabeled metric
+func scrapeMetric(t *testing.T, url, name string) float64 {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("failed to scrape metrics: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("metrics endpoint returned %d", resp.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == name {
+			value, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				t.Fatalf("bad value for %s: %v", name, err)
+			}
+			return value
+		}
+	}
+	t.Fatalf("metric %s not found", name)
+	return 0
+}
+
+// TestMetrics_ProcessMovesCounter checks that /metrics serves and the move
+// counter increments after a ProcessMoves call
+func TestMetrics_ProcessMovesCounter(t *testing.T) {
+	ctx := AuthenticatedContext()
+
+	gamesDir := t.TempDir()
+	gameId := "testgame"
+	gameDir := filepath.Join(gamesDir, gameId)
+	if err := os.MkdirAll(gameDir, 0755); err != nil {
+		t.Fatalf("failed to create game dir: %v", err)
+	}
+	for _, filename := range []string{"metadata.json", "state.json", "history.json"} {
+		data, err := os.ReadFile(filepath.Join("testgame", filename))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", filename, err)
+		}
+		if err := os.WriteFile(filepath.Join(gameDir, filename), data, 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", filename, err)
+		}
+	}
+
+	server := httptest.NewServer(observability.Handler())
+	defer server.Close()
+	metricsURL := server.URL + "/metrics"
+
+	before := scrapeMetric(t, metricsURL, "lilbattle_process_moves_total")
+
+	gamesService := fsbe.NewFSGamesService(gamesDir, nil)
+	_, err := gamesService.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+		GameId: gameId,
+		Moves: []*v1.GameMove{
+			{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+
+	after := scrapeMetric(t, metricsURL, "lilbattle_process_moves_total")
+	if after != before+1 {
+		t.Errorf("lilbattle_process_moves_total = %v, want %v", after, before+1)
+	}
+}
+
+// TestMetrics_CountGamesByStatus checks that the file backend counts its
+// games by the status in their stored state
+func TestMetrics_CountGamesByStatus(t *testing.T) {
+	ctx := ContextWithUserID("test-user-1")
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+
+	state, err := svc.LoadGameState(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	state.Status = v1.GameStatus_GAME_STATUS_PLAYING
+	if err := svc.SaveGameState(ctx, timeBankGameId, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+
+	counts, err := svc.CountGamesByStatus(ctx)
+	if err != nil {
+		t.Fatalf("CountGamesByStatus failed: %v", err)
+	}
+	if len(counts) != 1 || counts[v1.GameStatus_GAME_STATUS_PLAYING] != 1 {
+		t.Errorf("counts = %v, want one playing game", counts)
+	}
+}
@@ -0,0 +1,147 @@
+package tests
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Tests for experimental multi-hex units
+// =============================================================================
+
+// wideTankRules enables multi-hex units and gives tanks a second hex to
+// their side: TOP_LEFT of them facing LEFT, BOTTOM_RIGHT facing RIGHT
+func wideTankRules(base *lib.RulesEngine) *lib.RulesEngine {
+	rules := proto.Clone(base.Rules()).(*v1.RulesEngine)
+	rules.MultiHexUnits = true
+	rules.Units[UnitTypeTank].Footprint = []*v1.HexOffset{{Dq: 0, Dr: -1}}
+	return lib.NewRulesEngineFrom(rules)
+}
+
+// newChokeGame builds a map two hexes wide (rows 0 and 1) with a choke one
+// hex wide at 2,0 and 3,0, and a tank facing RIGHT at 0,0
+func newChokeGame() *lib.Game {
+	game := NewGameBuilder().
+		Tile(0, 0, TileTypeGrass, 0).Tile(0, 1, TileTypeGrass, 0).
+		Tile(1, 0, TileTypeGrass, 0).Tile(1, 1, TileTypeGrass, 0).
+		Tile(2, 0, TileTypeGrass, 0).
+		Tile(3, 0, TileTypeGrass, 0).
+		Tile(4, 0, TileTypeGrass, 0).Tile(4, 1, TileTypeGrass, 0).
+		UnitWithShortcut(0, 0, 1, UnitTypeTank, "A1").
+		Build()
+	game.World.UnitAt(AxialCoord{Q: 0, R: 0}).Facing = int32(lib.RIGHT)
+	return game
+}
+
+func TestRotateHexOffset(t *testing.T) {
+	for dir := range lib.AxialCoordNeighbors {
+		got := lib.RotateHexOffset(lib.AxialCoordNeighbors[lib.LEFT], dir)
+		if got != lib.AxialCoordNeighbors[dir] {
+			t.Errorf("LEFT turned %d steps = %v, want %v", dir, got, lib.AxialCoordNeighbors[dir])
+		}
+	}
+}
+
+// TestMultiHexUnit_BlockedByChoke tests a 1-hex tank moves through a choke one
+// hex wide while a 2-hex tank cannot fit its footprint through it
+func TestMultiHexUnit_BlockedByChoke(t *testing.T) {
+	game := newChokeGame()
+	if _, err := game.Move("A1", "4,0"); err != nil {
+		t.Fatalf("1-hex tank could not move through the choke: %v", err)
+	}
+
+	game = newChokeGame()
+	game.RulesEngine = wideTankRules(game.RulesEngine)
+	tank := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	if game.CanMoveUnit(tank, AxialCoord{Q: 4, R: 0}, false) {
+		t.Error("2-hex tank can move through a choke 1 hex wide")
+	}
+	if _, err := game.Move("A1", "4,0"); err == nil {
+		t.Fatal("moving the 2-hex tank through the choke was accepted")
+	}
+
+	// It can still move along the wide part of the map
+	if !game.CanMoveUnit(tank, AxialCoord{Q: 1, R: 0}, false) {
+		t.Error("2-hex tank cannot move to 1,0 with room for its footprint on 1,1")
+	}
+	if _, err := game.Move("A1", "1,0"); err != nil {
+		t.Fatalf("Move failed: %v", err)
+	}
+	tank = game.World.UnitAt(AxialCoord{Q: 1, R: 0})
+	if lib.NeighborDirection(tank.Facing) != lib.RIGHT {
+		t.Errorf("tank faces %d after moving RIGHT", tank.Facing)
+	}
+	if got := game.RulesEngine.OccupantAt(game.World, AxialCoord{Q: 1, R: 1}); got != tank {
+		t.Errorf("1,1 is covered by %v, want the tank's footprint", got)
+	}
+}
+
+// TestMultiHexUnit_AttackAnyHex tests a unit can attack a 2-hex unit through
+// the hex its footprint covers, and the attack lands on the unit
+func TestMultiHexUnit_AttackAnyHex(t *testing.T) {
+	game := NewGameBuilder().
+		GrassTiles(3).
+		UnitWithShortcut(0, 0, 2, UnitTypeTank, "B1").
+		UnitWithShortcut(-1, -1, 1, UnitTypeSoldierBasic, "A1").
+		Build()
+	game.RulesEngine = wideTankRules(game.RulesEngine)
+
+	// Facing LEFT the tank covers 0,-1, next to the soldier
+	soldier := game.World.UnitAt(AxialCoord{Q: -1, R: -1})
+	targets, err := game.RulesEngine.GetAttackOptions(game.World, soldier)
+	if err != nil {
+		t.Fatalf("GetAttackOptions failed: %v", err)
+	}
+	found := false
+	for _, coord := range targets {
+		if coord == (AxialCoord{Q: 0, R: -1}) {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("attack targets %v miss the tank's footprint hex 0,-1", targets)
+	}
+
+	before := game.World.UnitAt(AxialCoord{Q: 0, R: 0}).AvailableHealth
+	if err := game.ProcessMoves([]*v1.GameMove{{Player: 1, MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+		Attacker: &v1.Position{Label: "A1"},
+		Defender: &v1.Position{Q: 0, R: -1},
+	}}}}); err != nil {
+		t.Fatalf("attacking the tank's footprint hex failed: %v", err)
+	}
+	if tank := game.World.UnitAt(AxialCoord{Q: 0, R: 0}); tank != nil && tank.AvailableHealth >= before {
+		t.Errorf("tank health went from %d to %d", before, tank.AvailableHealth)
+	}
+}
+
+// TestMultiHexUnit_StandardRulesUnchanged tests the standard rules have no
+// footprints and leave the flag off, so their hash and the units they move
+// are as before
+func TestMultiHexUnit_StandardRulesUnchanged(t *testing.T) {
+	rules := DefaultRulesEngine()
+	if rules.GetMultiHexUnits() {
+		t.Error("standard rules enable multi-hex units")
+	}
+	for id, unit := range rules.GetUnits() {
+		if len(unit.Footprint) > 0 {
+			t.Errorf("unit type %d has a footprint in the standard rules", id)
+		}
+	}
+	clone := proto.Clone(rules.Rules()).(*v1.RulesEngine)
+	clone.MultiHexUnits = false
+	if lib.NewRulesEngineFrom(clone).Hash() != rules.Hash() {
+		t.Error("an unset multi-hex flag changed the rules hash")
+	}
+
+	game := newChokeGame()
+	game.World.UnitAt(AxialCoord{Q: 0, R: 0}).Facing = 0
+	if _, err := game.Move("A1", "1,1"); err != nil {
+		t.Fatalf("Move failed: %v", err)
+	}
+	if tank := game.World.UnitAt(AxialCoord{Q: 1, R: 1}); tank.Facing != 0 {
+		t.Errorf("a 1-hex unit was turned to face %d", tank.Facing)
+	}
+}
@@ -0,0 +1,223 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/authz"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// =============================================================================
+// Tests for turn and game end notifications
+// =============================================================================
+
+var notifyTestGame = &v1.Game{
+	Id:   "notifygame",
+	Name: "Skirmish",
+	Config: &v1.GameConfiguration{
+		Players: []*v1.GamePlayer{
+			{PlayerId: 1, UserId: "user-1"},
+			{PlayerId: 2, UserId: "user-2"},
+		},
+	},
+}
+
+type sentMail struct{ to, subject, body string }
+
+// fakeMailer records the emails sent instead of sending them
+type fakeMailer struct{ sent []sentMail }
+
+func (m *fakeMailer) SendMail(to, subject, body string) error {
+	m.sent = append(m.sent, sentMail{to, subject, body})
+	return nil
+}
+
+func newTestDispatcher(t *testing.T, clock lib.Clock) (*services.NotificationDispatcher, *fakeMailer) {
+	t.Helper()
+	mailer := &fakeMailer{}
+	prefs := services.NewNotificationService("secret", fsbe.NewFSNotificationPrefsStore(t.TempDir()))
+	d := services.NewNotificationDispatcher(prefs, staticGames{notifyTestGame}, mailer)
+	d.Clock = clock
+	d.BaseURL = "https://lilbattle.test"
+	return d, mailer
+}
+
+// turnStarted is the update published when the given player's turn starts
+func turnStarted(player, turn int32) *v1.GameUpdate {
+	return &v1.GameUpdate{UpdateType: &v1.GameUpdate_MovesPublished{MovesPublished: &v1.MovesPublished{
+		Moves: []*v1.GameMove{{Changes: []*v1.WorldChange{{ChangeType: &v1.WorldChange_PlayerChanged{
+			PlayerChanged: &v1.PlayerChangedChange{NewPlayer: player, NewTurn: turn},
+		}}}}},
+	}}}
+}
+
+// TestNotifications_Instant tests a player is emailed as soon as their turn
+// starts, and everyone when the game ends
+func TestNotifications_Instant(t *testing.T) {
+	d, mailer := newTestDispatcher(t, lib.NewFakeClock(time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC)))
+	d.Prefs.SetPreferences("user-1", services.NotificationPrefs{Channel: services.NotifyEmail, Immediacy: services.NotifyInstant, Email: "one@example.com"})
+	d.Prefs.SetPreferences("user-2", services.NotificationPrefs{Channel: services.NotifyEmail, Immediacy: services.NotifyInstant, Email: "two@example.com"})
+
+	d.HandleUpdate(notifyTestGame.Id, turnStarted(2, 4))
+	if len(mailer.sent) != 1 || mailer.sent[0].to != "two@example.com" || mailer.sent[0].subject != "It's your turn in Skirmish (turn 4)" {
+		t.Fatalf("sent %v, want player 2 told it is their turn", mailer.sent)
+	}
+
+	d.HandleUpdate(notifyTestGame.Id, &v1.GameUpdate{UpdateType: &v1.GameUpdate_GameEnded{GameEnded: &v1.GameEnded{Winner: 1}}})
+	if len(mailer.sent) != 3 {
+		t.Fatalf("sent %d emails, want both players told the game ended", len(mailer.sent))
+	}
+	for _, mail := range mailer.sent[1:] {
+		if mail.subject != "Skirmish was won by player 1" {
+			t.Errorf("game end subject = %q", mail.subject)
+		}
+	}
+}
+
+// TestNotifications_HourlyDigest tests events are batched until the top of the
+// hour after the first one
+func TestNotifications_HourlyDigest(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC))
+	d, mailer := newTestDispatcher(t, clock)
+	d.Prefs.SetPreferences("user-1", services.NotificationPrefs{Channel: services.NotifyEmail, Immediacy: services.NotifyHourlyDigest, Email: "one@example.com"})
+
+	d.HandleUpdate(notifyTestGame.Id, turnStarted(1, 2))
+	clock.Advance(30 * time.Minute)
+	d.HandleUpdate(notifyTestGame.Id, turnStarted(1, 3))
+	clock.Advance(14 * time.Minute)
+	d.Flush()
+	if len(mailer.sent) != 0 {
+		t.Fatalf("sent %d emails at 10:59, want the digest held until 11:00", len(mailer.sent))
+	}
+
+	clock.Advance(time.Minute)
+	d.Flush()
+	if len(mailer.sent) != 1 {
+		t.Fatalf("sent %d emails at 11:00, want one digest", len(mailer.sent))
+	}
+	mail := mailer.sent[0]
+	if mail.subject != "2 updates from your games" || !strings.Contains(mail.body, "(turn 2)") || !strings.Contains(mail.body, "(turn 3)") {
+		t.Errorf("digest = %q: %q, want both turns", mail.subject, mail.body)
+	}
+
+	// The next event starts a new window
+	d.HandleUpdate(notifyTestGame.Id, turnStarted(1, 4))
+	d.Flush()
+	clock.Advance(time.Hour)
+	d.Flush()
+	if len(mailer.sent) != 2 {
+		t.Fatalf("sent %d emails, want a second digest an hour later", len(mailer.sent))
+	}
+}
+
+// TestNotifications_DailyDigest tests events are batched until midnight
+func TestNotifications_DailyDigest(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC))
+	d, mailer := newTestDispatcher(t, clock)
+	d.Prefs.SetPreferences("user-2", services.NotificationPrefs{Channel: services.NotifyEmail, Immediacy: services.NotifyDailyDigest, Email: "two@example.com"})
+
+	d.HandleUpdate(notifyTestGame.Id, turnStarted(2, 2))
+	clock.Advance(13*time.Hour + 44*time.Minute)
+	d.Flush()
+	if len(mailer.sent) != 0 {
+		t.Fatalf("sent %d emails at 23:59, want the digest held until midnight", len(mailer.sent))
+	}
+	clock.Advance(time.Minute)
+	d.Flush()
+	if len(mailer.sent) != 1 || mailer.sent[0].subject != "It's your turn in Skirmish (turn 2)" {
+		t.Fatalf("sent %v at midnight, want the day's digest", mailer.sent)
+	}
+}
+
+// TestNotifications_Unsubscribe tests the link in an email unsubscribes its
+// recipient without logging in, and that forged tokens are rejected
+func TestNotifications_Unsubscribe(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC))
+	d, mailer := newTestDispatcher(t, clock)
+	d.Prefs.SetPreferences("user-1", services.NotificationPrefs{Channel: services.NotifyEmail, Immediacy: services.NotifyHourlyDigest, Email: "one@example.com"})
+	d.Prefs.SetPreferences("user-2", services.NotificationPrefs{Channel: services.NotifyEmail, Immediacy: services.NotifyInstant, Email: "two@example.com"})
+
+	d.HandleUpdate(notifyTestGame.Id, turnStarted(2, 2))
+	_, link, _ := strings.Cut(mailer.sent[0].body, "Unsubscribe: ")
+	link = strings.TrimSpace(strings.TrimPrefix(link, d.BaseURL))
+
+	handler := d.Prefs.Handler()
+	forged := d.Prefs.UnsubscribeToken("user-2")[:10] + "x"
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/notifications/unsubscribe?token="+forged, nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("forged token returned %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, link, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unsubscribe link %q returned %d: %s", link, rec.Code, rec.Body)
+	}
+	if prefs, _ := d.Prefs.GetPreferences("user-2"); prefs.Channel != services.NotifyNone {
+		t.Errorf("user-2 channel = %q after unsubscribing, want none", prefs.Channel)
+	}
+	d.HandleUpdate(notifyTestGame.Id, turnStarted(2, 3))
+	if len(mailer.sent) != 1 {
+		t.Errorf("sent %d emails, want none after unsubscribing", len(mailer.sent))
+	}
+
+	// Digests already queued are dropped too
+	d.HandleUpdate(notifyTestGame.Id, turnStarted(1, 3))
+	d.Prefs.Unsubscribe("user-1")
+	clock.Advance(time.Hour)
+	d.Flush()
+	if len(mailer.sent) != 1 {
+		t.Errorf("sent %d emails, want the queued digest dropped", len(mailer.sent))
+	}
+}
+
+// TestNotifications_PreferencesRPC tests users set and read back their own
+// preferences, which outlive the service that saved them
+func TestNotifications_PreferencesRPC(t *testing.T) {
+	dir := t.TempDir()
+	svc := fsbe.NewFSGamesService(t.TempDir(), nil)
+	svc.Notifications = services.NewNotificationService("secret", fsbe.NewFSNotificationPrefsStore(dir))
+
+	ctx := ContextWithUserID("user-1")
+	if _, err := svc.GetNotificationPreferences(context.Background(), &v1.GetNotificationPreferencesRequest{}); !errors.Is(err, authz.ErrUnauthenticated) {
+		t.Errorf("anonymous GetNotificationPreferences returned %v, want ErrUnauthenticated", err)
+	}
+	resp, err := svc.GetNotificationPreferences(ctx, &v1.GetNotificationPreferencesRequest{})
+	if err != nil {
+		t.Fatalf("GetNotificationPreferences failed: %v", err)
+	}
+	if resp.Preferences.Channel != string(services.NotifyNone) {
+		t.Errorf("channel = %q before any were set, want none", resp.Preferences.Channel)
+	}
+
+	invalid := &v1.NotificationPreferences{Channel: "email", Immediacy: "instant"}
+	if _, err := svc.SetNotificationPreferences(ctx, &v1.SetNotificationPreferencesRequest{Preferences: invalid}); err == nil {
+		t.Error("email preferences without an address were saved")
+	}
+	want := &v1.NotificationPreferences{Channel: "email", Immediacy: "hourly", Email: "one@example.com"}
+	if _, err := svc.SetNotificationPreferences(ctx, &v1.SetNotificationPreferencesRequest{Preferences: want}); err != nil {
+		t.Fatalf("SetNotificationPreferences failed: %v", err)
+	}
+
+	restarted := services.NewNotificationService("secret", fsbe.NewFSNotificationPrefsStore(dir))
+	prefs, err := restarted.GetPreferences("user-1")
+	if err != nil {
+		t.Fatalf("GetPreferences failed: %v", err)
+	}
+	if prefs.Channel != services.NotifyEmail || prefs.Immediacy != services.NotifyHourlyDigest || prefs.Email != "one@example.com" {
+		t.Errorf("preferences after a restart = %+v, want the ones set", prefs)
+	}
+	if prefs, _ := restarted.GetPreferences("user-2"); prefs.Channel != services.NotifyNone {
+		t.Errorf("user-2 channel = %q, want user-1's preferences kept to themselves", prefs.Channel)
+	}
+}
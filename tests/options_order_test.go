@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"bytes"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Tests for the canonical ordering of GetOptionsAt
+// =============================================================================
+
+const unitTypeBasicTank int32 = 3
+
+// crowdedGame puts a player 1 tank (A1) in the middle of a grass field with
+// enemies on several sides, next to a player 1 land base
+func crowdedGame() *lib.Game {
+	return NewGameBuilder().
+		GrassTiles(4).
+		Tile(-2, 2, TileTypeLandBase, 1).
+		UnitWithShortcut(0, 0, 1, unitTypeBasicTank, "A1").
+		UnitWithShortcut(1, 0, 2, UnitTypeSoldier, "B1").
+		UnitWithShortcut(0, -1, 2, UnitTypeSoldier, "B2").
+		UnitWithShortcut(-1, 1, 2, unitTypeBasicTank, "B3").
+		Coins(1, 2000).
+		Build()
+}
+
+func serializeOptions(t *testing.T, resp *v1.GetOptionsAtResponse) []byte {
+	t.Helper()
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(resp)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	return data
+}
+
+func TestGetOptionsAtDeterministic(t *testing.T) {
+	for _, pos := range []string{"A1", "-2,2"} {
+		var first []byte
+		for i := range 50 {
+			resp, err := crowdedGame().GetOptionsAt(pos)
+			if err != nil {
+				t.Fatalf("GetOptionsAt(%s) failed: %v", pos, err)
+			}
+			data := serializeOptions(t, resp)
+			if i == 0 {
+				first = data
+			} else if !bytes.Equal(first, data) {
+				t.Fatalf("GetOptionsAt(%s) call %d returned different options than the first call", pos, i+1)
+			}
+		}
+	}
+}
+
+func TestGetOptionsAtCanonicalOrder(t *testing.T) {
+	resp, err := crowdedGame().GetOptionsAt("A1")
+	if err != nil {
+		t.Fatalf("GetOptionsAt failed: %v", err)
+	}
+
+	var moves, attacks []*v1.Position
+	for i, opt := range resp.Options {
+		if move := opt.GetMove(); move != nil {
+			if len(attacks) > 0 {
+				t.Fatalf("move option %d comes after an attack", i)
+			}
+			moves = append(moves, move.To)
+		}
+		if attack := opt.GetAttack(); attack != nil {
+			attacks = append(attacks, attack.Defender)
+		}
+	}
+	if len(moves) < 2 || len(attacks) != 3 {
+		t.Fatalf("got %d moves and %d attacks, want several moves and 3 attacks", len(moves), len(attacks))
+	}
+	for _, targets := range [][]*v1.Position{moves, attacks} {
+		for i := 1; i < len(targets); i++ {
+			a, b := targets[i-1], targets[i]
+			if a.R > b.R || (a.R == b.R && a.Q >= b.Q) {
+				t.Errorf("targets out of order: (%d,%d) before (%d,%d)", a.Q, a.R, b.Q, b.R)
+			}
+		}
+	}
+
+	builds, err := crowdedGame().GetOptionsAt("-2,2")
+	if err != nil {
+		t.Fatalf("GetOptionsAt failed: %v", err)
+	}
+	if len(builds.Options) < 2 {
+		t.Fatalf("expected several build options, got %d", len(builds.Options))
+	}
+	for i := 1; i < len(builds.Options); i++ {
+		a, b := builds.Options[i-1].GetBuild(), builds.Options[i].GetBuild()
+		if a.Cost > b.Cost || (a.Cost == b.Cost && a.UnitType >= b.UnitType) {
+			t.Errorf("builds out of order: unit %d (%dc) before unit %d (%dc)", a.UnitType, a.Cost, b.UnitType, b.Cost)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// =============================================================================
+// Tests for multi-tile moves along a path
+// =============================================================================
+
+// TestMoveUnit_MultiTilePath tests a tank moving 3 tiles along a road, road
+// and desert row pays each tile's cost (0.75 + 0.75 + 1.25 of its 4 points)
+// and takes damage from a hazard it passes through on the way
+func TestMoveUnit_MultiTilePath(t *testing.T) {
+	game := NewGameBuilder().
+		Tile(0, 0, TileTypeGrass, 0).
+		Tile(1, 0, lib.TileTypeRoad, 0).
+		Tile(2, 0, lib.TileTypeRoad, 0).
+		Tile(3, 0, lib.TileTypeDesert, 0).
+		UnitWithShortcut(0, 0, 1, UnitTypeTank, "A1").
+		Build()
+	game.World.TileAt(AxialCoord{Q: 2, R: 0}).Hazard = &v1.TileHazard{Damage: 2}
+
+	changes, err := game.Move("A1", "3,0")
+	if err != nil {
+		t.Fatalf("Move failed: %v", err)
+	}
+	if len(changes) == 0 || changes[0].GetUnitMoved() == nil {
+		t.Fatalf("expected a unit moved change, got %v", changes)
+	}
+
+	unit := game.World.UnitAt(AxialCoord{Q: 3, R: 0})
+	if unit == nil {
+		t.Fatal("tank did not reach 3,0")
+	}
+	if unit.DistanceLeft != 1.25 {
+		t.Errorf("tank has %v movement left, want 1.25", unit.DistanceLeft)
+	}
+	if unit.AvailableHealth != 8 {
+		t.Errorf("tank has health %d after crossing the hazard, want 8", unit.AvailableHealth)
+	}
+}
+
+// TestMoveUnit_PathTooExpensive tests a destination 3 tiles away is rejected
+// when the path across it costs more than the unit's movement points
+func TestMoveUnit_PathTooExpensive(t *testing.T) {
+	game := NewGameBuilder().
+		Tile(0, 0, TileTypeGrass, 0).
+		Tile(1, 0, lib.TileTypeDesert, 0).
+		Tile(2, 0, lib.TileTypeDesert, 0).
+		Tile(3, 0, lib.TileTypeDesert, 0).
+		UnitWithShortcut(0, 0, 1, UnitTypeSoldierBasic, "A1").
+		Build()
+
+	if _, err := game.Move("A1", "3,0"); err == nil {
+		t.Fatal("a soldier moved 5.25 points of desert with 3 movement points")
+	}
+	if unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0}); unit == nil || unit.DistanceLeft != 3 {
+		t.Errorf("a rejected move changed the soldier: %v", unit)
+	}
+}
+
+// TestCanMoveUnit_DistantReachable tests a destination 3 tiles away is a
+// valid move when it is in the unit's reachable set
+func TestCanMoveUnit_DistantReachable(t *testing.T) {
+	game := NewGameBuilder().
+		Tile(0, 0, TileTypeGrass, 0).
+		Tile(1, 0, lib.TileTypeRoad, 0).
+		Tile(2, 0, lib.TileTypeRoad, 0).
+		Tile(3, 0, lib.TileTypeDesert, 0).
+		UnitWithShortcut(0, 0, 1, UnitTypeTank, "A1").
+		Build()
+
+	tank := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	if !game.CanMoveUnit(tank, AxialCoord{Q: 3, R: 0}, false) {
+		t.Error("tank cannot move to 3,0 though the path there costs 2.75 of its 4 points")
+	}
+	allPaths, err := game.GetMovementOptions(0, 0, false)
+	if err != nil {
+		t.Fatalf("GetMovementOptions failed: %v", err)
+	}
+	if _, ok := allPaths.Edges["3,0"]; !ok {
+		t.Error("3,0 is missing from the tank's movement options")
+	}
+}
+
+// TestCanMoveUnit_AdjacentBlocked tests adjacent tiles are not valid moves
+// when the unit cannot afford the terrain or another unit is on them
+func TestCanMoveUnit_AdjacentBlocked(t *testing.T) {
+	game := NewGameBuilder().
+		Tile(0, 0, TileTypeGrass, 0).
+		Tile(1, 0, TileTypeGrass, 0).
+		Tile(2, 0, TileTypeGrass, 0).
+		Tile(3, 0, lib.TileTypeDesert, 0).
+		Tile(2, 1, TileTypeGrass, 0).
+		UnitWithShortcut(0, 0, 1, UnitTypeSoldierBasic, "A1").
+		UnitWithShortcut(2, 1, 2, UnitTypeSoldierBasic, "B1").
+		Build()
+
+	// Two grass tiles leave the soldier 1 of its 3 movement points
+	if _, err := game.Move("A1", "2,0"); err != nil {
+		t.Fatalf("Move failed: %v", err)
+	}
+	soldier := game.World.UnitAt(AxialCoord{Q: 2, R: 0})
+	if game.CanMoveUnit(soldier, AxialCoord{Q: 3, R: 0}, false) {
+		t.Error("soldier with 1 movement point left can move onto adjacent desert costing 1.75")
+	}
+	if game.CanMoveUnit(soldier, AxialCoord{Q: 2, R: 1}, false) {
+		t.Error("soldier can move onto an adjacent tile held by an enemy")
+	}
+	if _, err := game.Move("A1", "3,0"); err == nil {
+		t.Error("moving the soldier onto the adjacent desert was accepted")
+	}
+}
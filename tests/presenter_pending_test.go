@@ -0,0 +1,248 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/singleton"
+	"github.com/turnforge/lilbattle/testfixtures"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Tests for remote changes arriving while the player is mid-input
+// =============================================================================
+
+// fakeGameViewerPage records the notices the presenter shows the player
+type fakeGameViewerPage struct {
+	messages chan string
+}
+
+func (f *fakeGameViewerPage) SetAllowedPanels(context.Context, *v1.SetAllowedPanelsRequest) (*v1.SetAllowedPanelsResponse, error) {
+	return &v1.SetAllowedPanelsResponse{}, nil
+}
+
+func (f *fakeGameViewerPage) SetCompactSummaryCard(context.Context, *v1.SetContentRequest) (*v1.SetContentResponse, error) {
+	return &v1.SetContentResponse{}, nil
+}
+
+func (f *fakeGameViewerPage) ShowCoachVerdict(context.Context, *v1.ShowCoachVerdictRequest) (*v1.ShowCoachVerdictResponse, error) {
+	return &v1.ShowCoachVerdictResponse{}, nil
+}
+
+func (f *fakeGameViewerPage) ShowTurnObligations(context.Context, *v1.ShowTurnObligationsRequest) (*v1.ShowTurnObligationsResponse, error) {
+	return &v1.ShowTurnObligationsResponse{}, nil
+}
+
+func (f *fakeGameViewerPage) LogMessage(_ context.Context, req *v1.LogMessageRequest) (*v1.LogMessageResponse, error) {
+	f.messages <- req.Message
+	return &v1.LogMessageResponse{}, nil
+}
+
+// notice waits for the next notice shown to the player
+func (f *fakeGameViewerPage) notice(t *testing.T) string {
+	t.Helper()
+	select {
+	case msg := <-f.messages:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("no notice was shown")
+		return ""
+	}
+}
+
+// pendingTestPresenter is a presenter with data-only panels over the two
+// soldier duel, with player 1 (A1 at 0,0) to move against B1 at 2,0
+func pendingTestPresenter(t *testing.T) (*services.GameViewPresenter, *singleton.SingletonGamesService, *fakeGameViewerPage) {
+	t.Helper()
+	game, state := testfixtures.TwoSoldierDuel().Protos()
+	game.Config.Players[0].UserId = "test-user-1"
+
+	gamesService := singleton.NewSingletonGamesService()
+	gamesService.SingletonGame = game
+	gamesService.SingletonGameState = state
+
+	page := &fakeGameViewerPage{messages: make(chan string, 10)}
+	p := services.NewGameViewPresenter()
+	p.GamesService = gamesService
+	p.GameState = &services.BaseGameState{}
+	p.GameStatePanel = &services.BaseGameStatePanel{}
+	p.TurnOptionsPanel = &services.BaseTurnOptionsPanel{}
+	p.UnitStatsPanel = &services.BaseUnitPanel{}
+	p.DamageDistributionPanel = &services.BaseUnitPanel{}
+	p.TerrainStatsPanel = &services.BaseTilePanel{}
+	p.BuildOptionsModal = &services.BaseBuildOptionsModal{}
+	p.GameScene = &services.BaseGameScene{}
+	p.GameViewerPage = page
+	return p, gamesService, page
+}
+
+func clickAt(t *testing.T, p *services.GameViewPresenter, layer string, q, r int32) {
+	t.Helper()
+	_, err := p.SceneClicked(ContextWithUserID("test-user-1"), &v1.SceneClickedRequest{
+		GameId: "test-game",
+		Pos:    &v1.Position{Q: q, R: r},
+		Layer:  layer,
+	})
+	if err != nil {
+		t.Fatalf("click on %s at %d,%d failed: %v", layer, q, r, err)
+	}
+}
+
+func unitAt(t *testing.T, svc *singleton.SingletonGamesService, q, r int) *v1.Unit {
+	t.Helper()
+	rg, err := svc.GetRuntimeGame(svc.SingletonGame, svc.SingletonGameState)
+	if err != nil {
+		t.Fatalf("GetRuntimeGame failed: %v", err)
+	}
+	return rg.World.UnitAt(lib.AxialCoord{Q: q, R: r})
+}
+
+// sendRemote delivers another player's change set and expects it to be queued
+func sendRemote(t *testing.T, p *services.GameViewPresenter, changes ...*v1.WorldChange) {
+	t.Helper()
+	queued := p.PendingRemoteChanges()
+	resp, err := p.ApplyRemoteChanges(context.Background(), &v1.ApplyRemoteChangesRequest{
+		GameId: "test-game",
+		Moves:  []*v1.GameMove{{Player: 2, Changes: changes}},
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("ApplyRemoteChanges failed: %v %v", err, resp.GetError())
+	}
+	if got := p.PendingRemoteChanges(); got != queued+1 {
+		t.Fatalf("%d remote change sets pending, want %d", got, queued+1)
+	}
+}
+
+func remoteKill(unit *v1.Unit) *v1.WorldChange {
+	return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitKilled{UnitKilled: &v1.UnitKilledChange{
+		PreviousUnit: proto.Clone(unit).(*v1.Unit),
+	}}}
+}
+
+func remoteMove(unit *v1.Unit, q, r int32) *v1.WorldChange {
+	updated := proto.Clone(unit).(*v1.Unit)
+	updated.Q, updated.R = q, r
+	return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{
+		PreviousUnit: proto.Clone(unit).(*v1.Unit),
+		UpdatedUnit:  updated,
+	}}}
+}
+
+// selectA1 selects A1 and checks it can move to -1,0
+func selectA1(t *testing.T, p *services.GameViewPresenter) {
+	t.Helper()
+	clickAt(t, p, "base-map", 0, 0)
+	if !p.InputInProgress() {
+		t.Fatal("selecting A1 did not start input")
+	}
+	for _, option := range p.TurnOptionsPanel.CurrentOptions().Options {
+		if move := option.GetMove(); move != nil && move.To.Q == -1 && move.To.R == 0 {
+			return
+		}
+	}
+	t.Fatal("A1 cannot move to -1,0")
+}
+
+func TestPresenterPending_RemoteKillCancelsMove(t *testing.T) {
+	p, svc, page := pendingTestPresenter(t)
+	selectA1(t, p)
+
+	sendRemote(t, p, remoteKill(unitAt(t, svc, 0, 0)))
+	if unitAt(t, svc, 0, 0) == nil {
+		t.Fatal("remote kill was applied while A1 was selected")
+	}
+
+	clickAt(t, p, "movement-highlight", -1, 0)
+	if msg := page.notice(t); !strings.Contains(msg, "destroyed or moved") {
+		t.Errorf("notice = %q, want it to say the unit was destroyed", msg)
+	}
+	if unit := unitAt(t, svc, 0, 0); unit != nil {
+		t.Errorf("A1 still at 0,0 after the remote kill: %v", unit)
+	}
+	if unit := unitAt(t, svc, -1, 0); unit != nil {
+		t.Errorf("move was submitted, unit at -1,0: %v", unit)
+	}
+	if p.InputInProgress() || p.PendingRemoteChanges() != 0 {
+		t.Errorf("input in progress %v with %d pending changes after cancel, want neither",
+			p.InputInProgress(), p.PendingRemoteChanges())
+	}
+}
+
+func TestPresenterPending_RemoteOccupationCancelsMove(t *testing.T) {
+	p, svc, page := pendingTestPresenter(t)
+	selectA1(t, p)
+
+	sendRemote(t, p, remoteMove(unitAt(t, svc, 2, 0), -1, 0))
+
+	clickAt(t, p, "movement-highlight", -1, 0)
+	if msg := page.notice(t); !strings.Contains(msg, "occupied") {
+		t.Errorf("notice = %q, want it to say the destination is occupied", msg)
+	}
+	if unit := unitAt(t, svc, 0, 0); unit == nil || unit.Shortcut != "A1" {
+		t.Errorf("unit at 0,0 = %v, want A1 left where it was", unit)
+	}
+	if unit := unitAt(t, svc, -1, 0); unit == nil || unit.Shortcut != "B1" {
+		t.Errorf("unit at -1,0 = %v, want B1 from the remote move", unit)
+	}
+}
+
+func TestPresenterPending_BenignChangesKeepSelection(t *testing.T) {
+	p, svc, page := pendingTestPresenter(t)
+	selectA1(t, p)
+	scene := p.GameScene.(*services.BaseGameScene)
+	highlights := scene.CurrentHighlightsRequest
+
+	sendRemote(t, p, remoteMove(unitAt(t, svc, 2, 0), 3, -1))
+	sendRemote(t, p, &v1.WorldChange{ChangeType: &v1.WorldChange_CoinsChanged{CoinsChanged: &v1.CoinsChangedChange{
+		PlayerId: 2, PreviousCoins: 300, NewCoins: 250,
+	}}})
+
+	if !p.InputInProgress() || scene.CurrentHighlightsRequest != highlights {
+		t.Error("queued remote changes disturbed the selection")
+	}
+	if unit := p.TurnOptionsPanel.CurrentUnit(); unit == nil || unit.Shortcut != "A1" {
+		t.Errorf("turn options unit = %v, want A1", unit)
+	}
+	if unitAt(t, svc, 2, 0) == nil {
+		t.Error("remote move was applied before the player finished their input")
+	}
+
+	clickAt(t, p, "movement-highlight", -1, 0)
+	if unit := unitAt(t, svc, -1, 0); unit == nil || unit.Shortcut != "A1" {
+		t.Errorf("unit at -1,0 = %v, want A1 moved there", unit)
+	}
+	if unit := unitAt(t, svc, 3, -1); unit == nil || unit.Shortcut != "B1" {
+		t.Errorf("unit at 3,-1 = %v, want B1 from the remote move", unit)
+	}
+	if got := svc.SingletonGameState.PlayerStates[2].Coins; got != 250 {
+		t.Errorf("player 2 has %d coins, want 250 from the remote change", got)
+	}
+	if p.PendingRemoteChanges() != 0 {
+		t.Errorf("%d remote change sets still pending", p.PendingRemoteChanges())
+	}
+	select {
+	case msg := <-page.messages:
+		t.Errorf("unexpected notice %q", msg)
+	default:
+	}
+}
+
+func TestPresenterPending_DeselectAppliesChanges(t *testing.T) {
+	p, svc, _ := pendingTestPresenter(t)
+	selectA1(t, p)
+	sendRemote(t, p, remoteMove(unitAt(t, svc, 2, 0), 3, -1))
+
+	clickAt(t, p, "base-map", 0, 0)
+	if p.InputInProgress() || p.PendingRemoteChanges() != 0 {
+		t.Fatal("deselecting did not apply the pending remote changes")
+	}
+	if unit := unitAt(t, svc, 3, -1); unit == nil || unit.Shortcut != "B1" {
+		t.Errorf("unit at 3,-1 = %v, want B1 from the remote move", unit)
+	}
+}
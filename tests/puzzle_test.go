@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/server"
+)
+
+// =============================================================================
+// Tests for puzzle games
+// =============================================================================
+
+// TestPuzzle_AttackOrder tests a puzzle solvable only by attacking in the
+// right order: the artillery's counter attack kills the weak soldier if it
+// attacks first, while the tank softening the artillery up first lets the
+// soldier finish it off untouched. The wrong order fails once the budget's
+// only turn ends.
+func TestPuzzle_AttackOrder(t *testing.T) {
+	play := func(first, second string) *lib.Game {
+		game := NewGameBuilder().
+			GrassTiles(2).
+			UnitFull(0, 0, 1, UnitTypeSoldierBasic, "A1", 3, 3, 0).
+			UnitWithShortcut(2, 0, 1, UnitTypeTank, "A2").
+			UnitFull(1, 0, 2, UnitTypeArtillery, "B1", 4, 3, 0).
+			Settings(&v1.GameSettings{Puzzle: &v1.PuzzleSettings{
+				Goal:       "player 2 [unit_count==0] unit A1 [player==1]",
+				TurnBudget: 1,
+			}}).
+			Build()
+		game.CombatMode = lib.CombatModeExpected
+		for _, attacker := range []string{first, second} {
+			err := game.ProcessMove(&v1.GameMove{Player: 1, MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+				Attacker: &v1.Position{Label: attacker},
+				Defender: &v1.Position{Label: "B1"},
+			}}})
+			if err != nil {
+				t.Fatalf("attack by %s failed: %v", attacker, err)
+			}
+		}
+		return game
+	}
+
+	solved := play("A2", "A1")
+	if solved.PuzzleResult != v1.PuzzleResult_PUZZLE_RESULT_SOLVED || solved.WinningPlayer != 1 {
+		t.Errorf("tank first: result %v, winner %d, want solved by player 1", solved.PuzzleResult, solved.WinningPlayer)
+	}
+
+	failed := play("A1", "A2")
+	if err := failed.ProcessMove(&v1.GameMove{Player: 1, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}); err != nil {
+		t.Fatalf("end turn failed: %v", err)
+	}
+	if failed.PuzzleResult != v1.PuzzleResult_PUZZLE_RESULT_FAILED || failed.WinningPlayer != 2 {
+		t.Errorf("soldier first: result %v, winner %d, want failed with player 2 winning", failed.PuzzleResult, failed.WinningPlayer)
+	}
+	if !failed.GameState.Finished || failed.GameState.Status != v1.GameStatus_GAME_STATUS_ENDED {
+		t.Error("a failed puzzle should end the game")
+	}
+}
+
+// TestPuzzle_PlayAndHistory tests playing a puzzle against its scripted
+// opponent, solving it within the budget on one attempt and running out of
+// turns on another, and that both are recorded in the player's history
+func TestPuzzle_PlayAndHistory(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	backend, err := server.StartLocalBackend(context.Background(), address, t.TempDir())
+	if err != nil {
+		t.Fatalf("StartLocalBackend failed: %v", err)
+	}
+	defer backend.Stop()
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	games := backend.ClientMgr.GetGamesSvcClient()
+	puzzles := backend.ClientMgr.GetPuzzlesSvcClient()
+	ctx := server.LocalContext(context.Background())
+
+	// Player 1's soldier has to reach 1,0 while player 2's tank moves away
+	worldData := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for _, coord := range (lib.AxialCoord{}).Range(2) {
+		worldData.TilesMap[lib.CoordKeyFromAxial(coord)] = lib.NewTile(coord, lib.TileTypeGrass)
+	}
+	soldierAt, tankAt := lib.AxialCoord{Q: -1, R: 0}, lib.AxialCoord{Q: 1, R: -2}
+	worldData.UnitsMap[lib.CoordKeyFromAxial(soldierAt)] = lib.NewUnit(int(UnitTypeSoldierBasic), 1, soldierAt)
+	worldData.UnitsMap[lib.CoordKeyFromAxial(tankAt)] = lib.NewUnit(int(UnitTypeTank), 2, tankAt)
+	world, err := worlds.CreateWorld(ctx, &v1.CreateWorldRequest{World: &v1.World{Name: "Puzzle"}, WorldData: worldData})
+	if err != nil {
+		t.Fatalf("CreateWorld failed: %v", err)
+	}
+
+	if _, err := puzzles.CreatePuzzle(ctx, &v1.CreatePuzzleRequest{Puzzle: &v1.Puzzle{
+		Name:     "Bad goal",
+		WorldId:  world.World.Id,
+		Settings: &v1.PuzzleSettings{Goal: "unit 1,0 [health]", TurnBudget: 2},
+	}}); err == nil {
+		t.Error("CreatePuzzle accepted a malformed goal")
+	}
+	created, err := puzzles.CreatePuzzle(ctx, &v1.CreatePuzzleRequest{Puzzle: &v1.Puzzle{
+		Name:    "Crossing",
+		WorldId: world.World.Id,
+		Settings: &v1.PuzzleSettings{
+			Goal:       "unit 1,0 [player==1]",
+			TurnBudget: 2,
+			OpponentTurns: []*v1.PuzzleOpponentTurn{{Moves: []*v1.GameMove{{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Label: "1,-2"}, To: &v1.Position{Label: "2,-2"},
+			}}}}}},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("CreatePuzzle failed: %v", err)
+	}
+	puzzleId := created.Puzzle.Id
+
+	endTurn := &v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	play := func(gameId string, move *v1.GameMove) *v1.GameState {
+		t.Helper()
+		if _, err := games.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: gameId, Moves: []*v1.GameMove{move}}); err != nil {
+			t.Fatalf("ProcessMoves failed: %v", err)
+		}
+		resp, err := games.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+		if err != nil {
+			t.Fatalf("GetGame failed: %v", err)
+		}
+		return resp.State
+	}
+
+	// Solved on the second turn, after the opponent's scripted move
+	solving, err := puzzles.PlayPuzzle(ctx, &v1.PlayPuzzleRequest{PuzzleId: puzzleId})
+	if err != nil {
+		t.Fatalf("PlayPuzzle failed: %v", err)
+	}
+	state := play(solving.Game.Id, endTurn)
+	if state.CurrentPlayer != 1 || state.TurnCounter != 2 {
+		t.Fatalf("after ending turn 1 player %d is on turn %d, want the opponent's turn played back to player 1 on turn 2", state.CurrentPlayer, state.TurnCounter)
+	}
+	if unit := state.WorldData.UnitsMap[lib.CoordKey(2, -2)]; unit == nil || unit.Player != 2 {
+		t.Errorf("the opponent's tank did not make its scripted move")
+	}
+	state = play(solving.Game.Id, &v1.GameMove{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+		From: &v1.Position{Label: "-1,0"}, To: &v1.Position{Label: "1,0"},
+	}}})
+	if state.PuzzleResult != v1.PuzzleResult_PUZZLE_RESULT_SOLVED || !state.Finished || state.WinningPlayer != 1 {
+		t.Errorf("reaching the goal: result %v, finished %v, winner %d, want solved", state.PuzzleResult, state.Finished, state.WinningPlayer)
+	}
+
+	// Failed by running out of turns
+	failing, err := puzzles.PlayPuzzle(ctx, &v1.PlayPuzzleRequest{PuzzleId: puzzleId})
+	if err != nil {
+		t.Fatalf("PlayPuzzle failed: %v", err)
+	}
+	play(failing.Game.Id, endTurn)
+	state = play(failing.Game.Id, endTurn)
+	if state.PuzzleResult != v1.PuzzleResult_PUZZLE_RESULT_FAILED || !state.Finished {
+		t.Errorf("ending the last turn short of the goal: result %v, finished %v, want failed", state.PuzzleResult, state.Finished)
+	}
+	if _, err := games.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: failing.Game.Id, Moves: []*v1.GameMove{endTurn}}); err == nil {
+		t.Error("moves were accepted after the puzzle ended")
+	}
+
+	attempts, err := puzzles.ListPuzzleAttempts(ctx, &v1.ListPuzzleAttemptsRequest{PuzzleId: puzzleId})
+	if err != nil {
+		t.Fatalf("ListPuzzleAttempts failed: %v", err)
+	}
+	results := map[string]v1.PuzzleResult{}
+	for _, attempt := range attempts.Items {
+		results[attempt.GameId] = attempt.Result
+	}
+	if len(attempts.Items) != 2 ||
+		results[solving.Game.Id] != v1.PuzzleResult_PUZZLE_RESULT_SOLVED ||
+		results[failing.Game.Id] != v1.PuzzleResult_PUZZLE_RESULT_FAILED {
+		t.Errorf("puzzle history = %v, want one solved and one failed attempt", results)
+	}
+}
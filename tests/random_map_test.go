@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/server"
+	"google.golang.org/grpc/metadata"
+)
+
+// =============================================================================
+// Tests for games played on a random map generated at creation
+// =============================================================================
+
+func TestRandomMap_HiddenUntilStart(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	backend, err := server.StartLocalBackend(context.Background(), address, t.TempDir())
+	if err != nil {
+		t.Fatalf("StartLocalBackend failed: %v", err)
+	}
+	defer backend.Stop()
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	games := backend.ClientMgr.GetGamesSvcClient()
+	creator := server.LocalContext(context.Background())
+	joiner := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test2")
+
+	created, err := games.CreateGame(creator, &v1.CreateGameRequest{Game: &v1.Game{
+		Name:      "Iron man",
+		RandomMap: &v1.RandomMap{Size: "small"},
+		Config: &v1.GameConfiguration{Players: []*v1.GamePlayer{
+			{PlayerId: 1, UserId: server.LocalUserID, PlayerType: "human"},
+			{PlayerId: 2, PlayerType: "open"},
+		}},
+	}})
+	if err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	game := created.Game
+	if game.WorldId == "" {
+		t.Fatal("random map should be saved as a world attached to the game")
+	}
+	if game.RandomMap.Seed != 0 {
+		t.Error("seed should stay hidden until the game starts")
+	}
+	if got := lib.RandomMapLabel(game.RandomMap); got != "random map (small, 2 players)" {
+		t.Errorf("lobby label = %q", got)
+	}
+	if created.GameState.Status != v1.GameStatus_GAME_STATUS_WAITING || len(created.GameState.WorldData.TilesMap) != 0 {
+		t.Error("game should wait with an empty board until its seats are filled")
+	}
+
+	// Neither seat can see the map before the game starts
+	for name, ctx := range map[string]context.Context{"creator": creator, "joiner": joiner} {
+		if _, err := worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: game.WorldId}); err == nil {
+			t.Errorf("%s fetched the random map before the game started", name)
+		}
+	}
+	listed, err := worlds.ListWorlds(creator, &v1.ListWorldsRequest{})
+	if err != nil {
+		t.Fatalf("ListWorlds failed: %v", err)
+	}
+	for _, world := range listed.Items {
+		if world.Id == game.WorldId {
+			t.Error("random maps should not be listed")
+		}
+	}
+
+	// Filling the last seat starts the game and reveals the seed
+	if _, err := games.JoinGame(joiner, &v1.JoinGameRequest{GameId: game.Id, PlayerId: 2}); err != nil {
+		t.Fatalf("JoinGame failed: %v", err)
+	}
+	started, err := games.GetGame(joiner, &v1.GetGameRequest{Id: game.Id})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	if started.State.Status == v1.GameStatus_GAME_STATUS_WAITING {
+		t.Fatal("game should have started once both seats were filled")
+	}
+	seed := started.Game.RandomMap.Seed
+	if seed == 0 {
+		t.Fatal("seed should be revealed once the game starts")
+	}
+
+	// The revealed seed regenerates the board the game is played on
+	regenerated, err := lib.GenerateRandomMap("small", 2, seed)
+	if err != nil {
+		t.Fatalf("GenerateRandomMap failed: %v", err)
+	}
+	board := started.State.WorldData
+	if len(board.TilesMap) != len(regenerated.TilesMap) {
+		t.Fatalf("board has %d tiles, regenerated map has %d", len(board.TilesMap), len(regenerated.TilesMap))
+	}
+	for key, tile := range regenerated.TilesMap {
+		if board.TilesMap[key].GetTileType() != tile.TileType {
+			t.Fatalf("tile %s differs from the map regenerated from the seed", key)
+		}
+	}
+
+	for name, ctx := range map[string]context.Context{"creator": creator, "joiner": joiner} {
+		if _, err := worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: game.WorldId}); err != nil {
+			t.Errorf("%s can't fetch the random map after the start: %v", name, err)
+		}
+	}
+}
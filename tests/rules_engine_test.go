@@ -191,7 +191,7 @@ func TestRulesEngineAttackMatrix(t *testing.T) {
 
 	// Count how many attack combinations we have using UnitUnitProperties
 	totalAttacks := 0
-	for key, props := range rulesEngine.UnitUnitProperties {
+	for key, props := range rulesEngine.GetUnitUnitProperties() {
 		if props.Damage != nil {
 			totalAttacks++
 
@@ -218,13 +218,13 @@ func TestRulesEngineMovementMatrix(t *testing.T) {
 	}
 
 	// Check that TerrainUnitProperties is properly loaded (replaces MovementMatrix)
-	if rulesEngine.TerrainUnitProperties == nil {
+	if rulesEngine.GetTerrainUnitProperties() == nil {
 		t.Fatal("TerrainUnitProperties is nil - rules loading failed")
 	}
 
 	// Count how many movement cost entries we have using TerrainUnitProperties
 	totalCosts := 0
-	for key, props := range rulesEngine.TerrainUnitProperties {
+	for key, props := range rulesEngine.GetTerrainUnitProperties() {
 		if props.MovementCost > 0 {
 			totalCosts++
 
@@ -282,7 +282,7 @@ func TestRulesEngineDijkstraMovement(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		allPaths, err := rulesEngine.GetMovementOptions(world, unit, tc.movement, false)
+		allPaths, err := rulesEngine.GetMovementOptions(world, unit, float64(tc.movement), false)
 		if err != nil {
 			t.Fatalf("Failed to get movement options for %s: %v", tc.desc, err)
 		}
@@ -334,7 +334,7 @@ func TestRulesEngineDijkstraTerrainCosts(t *testing.T) {
 			// Make center tile more expensive if we have different terrain types
 			if q == 1 && r == 1 {
 				// Try to find a more expensive terrain type
-				for tID := range rulesEngine.Terrains {
+				for tID := range rulesEngine.GetTerrains() {
 					if _, err := rulesEngine.GetTerrainData(tID); err == nil {
 						// Use a different terrain ID for center (ID 2 - Mountain perhaps?)
 						if tID > 1 {
@@ -10,19 +10,17 @@ import (
 // TestSetDefaultIncomeValues verifies that default income values are set correctly for terrain types
 func TestSetDefaultIncomeValues(t *testing.T) {
 	// Create a minimal RulesEngine with test terrains
-	re := &lib.RulesEngine{
-		RulesEngine: &v1.RulesEngine{
-			Terrains: map[int32]*v1.TerrainDefinition{
-				1:  {Id: 1, Name: "Base", BuildableUnitIds: []int32{1, 2}},
-				2:  {Id: 2, Name: "Harbor", BuildableUnitIds: []int32{3}},
-				3:  {Id: 3, Name: "Airport", BuildableUnitIds: []int32{4}},
-				16: {Id: 16, Name: "Missile Silo", BuildableUnitIds: []int32{5}},
-				20: {Id: 20, Name: "Mines", BuildableUnitIds: []int32{6}},
-				// Non-income generating terrain
-				10: {Id: 10, Name: "Grass", BuildableUnitIds: []int32{}},
-			},
+	re := lib.NewRulesEngineFrom(&v1.RulesEngine{
+		Terrains: map[int32]*v1.TerrainDefinition{
+			1:  {Id: 1, Name: "Base", BuildableUnitIds: []int32{1, 2}},
+			2:  {Id: 2, Name: "Harbor", BuildableUnitIds: []int32{3}},
+			3:  {Id: 3, Name: "Airport", BuildableUnitIds: []int32{4}},
+			16: {Id: 16, Name: "Missile Silo", BuildableUnitIds: []int32{5}},
+			20: {Id: 20, Name: "Mines", BuildableUnitIds: []int32{6}},
+			// Non-income generating terrain
+			10: {Id: 10, Name: "Grass", BuildableUnitIds: []int32{}},
 		},
-	}
+	})
 
 	// Call setDefaultIncomeValues
 	lib.SetDefaultIncomeValues(re)
@@ -42,7 +40,7 @@ func TestSetDefaultIncomeValues(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		terrain := re.Terrains[tc.tileID]
+		terrain := re.GetTerrains()[tc.tileID]
 		if terrain.IncomePerTurn != tc.expectedIncome {
 			t.Errorf("%s: got %d, want %d", tc.description, terrain.IncomePerTurn, tc.expectedIncome)
 		}
@@ -0,0 +1,186 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+)
+
+// =============================================================================
+// Tests for world and game rules overrides
+// =============================================================================
+
+func grassCost(t *testing.T, re *lib.RulesEngine) float64 {
+	t.Helper()
+	cost, err := re.GetUnitTerrainCost(UnitTypeSoldier, TileTypeGrass)
+	if err != nil {
+		t.Fatalf("GetUnitTerrainCost failed: %v", err)
+	}
+	return cost
+}
+
+func TestRulesOverrides_GameBeatsWorldBeatsDefaults(t *testing.T) {
+	base := DefaultRulesEngine()
+	defaultCost := grassCost(t, base)
+
+	config := &v1.GameConfiguration{
+		IncomeConfigs: &v1.IncomeConfig{LandbaseIncome: 50, GameIncome: 5},
+		WorldRulesOverrides: &v1.RulesOverrides{
+			TerrainMovementCosts: map[int32]float64{TileTypeGrass: 2},
+			Income:               &v1.IncomeConfig{LandbaseIncome: 200, NavalbaseIncome: 300},
+		},
+	}
+
+	// World overrides beat the defaults and the game's income config
+	if cost := grassCost(t, base.WithOverrides(lib.GameRulesOverrides(config))); cost != 2 {
+		t.Errorf("grass cost with world overrides = %v, want 2", cost)
+	}
+	income := lib.EffectiveIncomeConfig(config)
+	if income.LandbaseIncome != 200 || income.NavalbaseIncome != 300 || income.GameIncome != 5 {
+		t.Errorf("income with world overrides = %v", income)
+	}
+
+	// Game overrides beat the world's
+	config.RulesOverrides = &v1.RulesOverrides{
+		TerrainMovementCosts: map[int32]float64{TileTypeGrass: 3},
+		Income:               &v1.IncomeConfig{LandbaseIncome: 400},
+	}
+	if cost := grassCost(t, base.WithOverrides(lib.GameRulesOverrides(config))); cost != 3 {
+		t.Errorf("grass cost with game overrides = %v, want 3", cost)
+	}
+	income = lib.EffectiveIncomeConfig(config)
+	if income.LandbaseIncome != 400 || income.NavalbaseIncome != 300 {
+		t.Errorf("income with game overrides = %v", income)
+	}
+
+	// The base rules are left alone
+	if cost := grassCost(t, base); cost != defaultCost {
+		t.Errorf("base grass cost changed to %v, want %v", cost, defaultCost)
+	}
+}
+
+func TestRulesOverrides_NoOverridesKeepsRules(t *testing.T) {
+	base := DefaultRulesEngine()
+	if base.WithOverrides(nil) != base {
+		t.Error("WithOverrides(nil) copied the rules")
+	}
+	if lib.MergeRulesOverrides(nil, &v1.RulesOverrides{}) != nil {
+		t.Error("merging empty overrides was not nil")
+	}
+	config := &v1.GameConfiguration{IncomeConfigs: &v1.IncomeConfig{GameIncome: 7}}
+	if lib.EffectiveIncomeConfig(config) != config.IncomeConfigs {
+		t.Error("income config changed without income overrides")
+	}
+}
+
+func TestRulesOverrides_NeverMakesTerrainPassable(t *testing.T) {
+	base := DefaultRulesEngine()
+	costs := map[int32]float64{}
+	for _, props := range base.GetTerrainUnitProperties() {
+		costs[props.TerrainId] = 1
+	}
+	overridden := base.WithOverrides(&v1.RulesOverrides{TerrainMovementCosts: costs})
+	for key, props := range base.GetTerrainUnitProperties() {
+		if props.MovementCost <= 0 && overridden.GetTerrainUnitProperties()[key].MovementCost > 0 {
+			t.Errorf("override made %s passable", key)
+		}
+	}
+}
+
+func TestValidateRulesOverrides(t *testing.T) {
+	re := DefaultRulesEngine()
+	cases := []struct {
+		name      string
+		overrides *v1.RulesOverrides
+		wantErr   bool
+	}{
+		{"nil", nil, false},
+		{"valid", &v1.RulesOverrides{
+			TerrainMovementCosts: map[int32]float64{TileTypeGrass: 2.5},
+			Income:               &v1.IncomeConfig{StartingCoins: 500},
+		}, false},
+		{"unknown terrain", &v1.RulesOverrides{TerrainMovementCosts: map[int32]float64{9999: 1}}, true},
+		{"zero cost", &v1.RulesOverrides{TerrainMovementCosts: map[int32]float64{TileTypeGrass: 0}}, true},
+		{"cost too high", &v1.RulesOverrides{TerrainMovementCosts: map[int32]float64{TileTypeGrass: 100}}, true},
+		{"negative income", &v1.RulesOverrides{Income: &v1.IncomeConfig{MinesIncome: -1}}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := re.ValidateRulesOverrides(tc.overrides)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateRulesOverrides() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetWorldRulesOverrides_RejectsInvalidOverrides(t *testing.T) {
+	svc := &services.BaseWorldsService{}
+	_, err := svc.SetWorldRulesOverrides(context.Background(), &v1.SetWorldRulesOverridesRequest{
+		WorldId:   "w1",
+		Overrides: &v1.RulesOverrides{TerrainMovementCosts: map[int32]float64{9999: 1}},
+	})
+	if err == nil {
+		t.Error("expected invalid overrides to be rejected")
+	}
+}
+
+func TestInheritWorldRulesOverrides(t *testing.T) {
+	svc := &services.BackendGamesService{}
+	world := &v1.World{RulesOverrides: &v1.RulesOverrides{
+		TerrainMovementCosts: map[int32]float64{TileTypeGrass: 2},
+	}}
+	game := &v1.Game{}
+	svc.InheritWorldRulesOverrides(game, world)
+	if game.Config.GetWorldRulesOverrides().GetTerrainMovementCosts()[TileTypeGrass] != 2 {
+		t.Fatalf("world overrides not copied into game: %v", game.Config)
+	}
+
+	// Later edits to the world do not reach the game
+	world.RulesOverrides.TerrainMovementCosts[TileTypeGrass] = 4
+	if game.Config.WorldRulesOverrides.TerrainMovementCosts[TileTypeGrass] != 2 {
+		t.Error("game shares overrides with the world")
+	}
+}
+
+func TestProtoToRuntimeGame_AppliesOverrides(t *testing.T) {
+	world := NewGameBuilder().GrassTiles(1).UnitWithShortcut(0, 0, 1, UnitTypeSoldier, "A1").Build().World.WorldData()
+	game := &v1.Game{Config: &v1.GameConfiguration{
+		WorldRulesOverrides: &v1.RulesOverrides{TerrainMovementCosts: map[int32]float64{TileTypeGrass: 2}},
+	}}
+	rtGame := lib.ProtoToRuntimeGame(game, &v1.GameState{WorldData: world, CurrentPlayer: 1, TurnCounter: 1})
+	if cost := grassCost(t, rtGame.RulesEngine); cost != 2 {
+		t.Errorf("runtime game grass cost = %v, want 2", cost)
+	}
+}
+
+func TestStateHash_IncludesRulesHash(t *testing.T) {
+	world := NewGameBuilder().GrassTiles(1).UnitWithShortcut(0, 0, 1, UnitTypeSoldier, "A1").Build().World.WorldData()
+	state := &v1.GameState{WorldData: world, CurrentPlayer: 1, TurnCounter: 1}
+	runtimeGame := func(overrides *v1.RulesOverrides) *lib.Game {
+		game := &v1.Game{Config: &v1.GameConfiguration{RulesOverrides: overrides}}
+		return lib.ProtoToRuntimeGame(game, state)
+	}
+
+	plain := runtimeGame(nil).RulesHash()
+	if plain != runtimeGame(nil).RulesHash() {
+		t.Error("rules hash is not stable")
+	}
+	moved := runtimeGame(&v1.RulesOverrides{TerrainMovementCosts: map[int32]float64{TileTypeGrass: 2}}).RulesHash()
+	paid := runtimeGame(&v1.RulesOverrides{Income: &v1.IncomeConfig{GameIncome: 10}}).RulesHash()
+	if moved == plain || paid == plain || moved == paid {
+		t.Error("rules hash does not reflect the overrides")
+	}
+
+	hash := lib.StateHash(state, plain)
+	state.StateHash = hash
+	if lib.StateHash(state, plain) != hash {
+		t.Error("state hash depends on the previous state hash")
+	}
+	if lib.StateHash(state, moved) == hash {
+		t.Error("state hash does not include the rules hash")
+	}
+}
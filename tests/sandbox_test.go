@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/testfixtures"
+)
+
+// =============================================================================
+// Tests for sandbox games
+// =============================================================================
+
+// sandboxGame is a soldier duel played as a sandbox with the given settings
+func sandboxGame(sandbox *v1.SandboxSettings) *lib.Game {
+	return testfixtures.TwoSoldierDuel().
+		Settings(&v1.GameSettings{Sandbox: sandbox}).
+		With(testfixtures.DeterministicCombat).
+		Build()
+}
+
+func spawnMove(q, r int32, unitType, player int32) *v1.GameMove {
+	return &v1.GameMove{MoveType: &v1.GameMove_SpawnUnit{SpawnUnit: &v1.SpawnUnitAction{
+		Pos: &v1.Position{Q: q, R: r}, UnitType: unitType, Player: player,
+	}}}
+}
+
+func deleteMove(q, r int32) *v1.GameMove {
+	return &v1.GameMove{MoveType: &v1.GameMove_DeleteUnit{DeleteUnit: &v1.DeleteUnitAction{
+		Pos: &v1.Position{Q: q, R: r},
+	}}}
+}
+
+func moveUnitMove(fromQ, fromR, toQ, toR int32) *v1.GameMove {
+	return &v1.GameMove{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+		From: &v1.Position{Q: fromQ, R: fromR}, To: &v1.Position{Q: toQ, R: toR},
+	}}}
+}
+
+func TestSandboxMoves_RejectedOutsideSandbox(t *testing.T) {
+	game := testfixtures.TwoSoldierDuel().Build()
+
+	// Settings that do not enable the sandbox, as stored games load with,
+	// leave the game a regular one
+	if disabled := sandboxGame(&v1.SandboxSettings{InfiniteCoins: true}); disabled.IsSandbox() {
+		t.Error("sandbox settings without enabled made a sandbox")
+	}
+	if err := game.ProcessMove(spawnMove(1, 1, testfixtures.UnitTypeSoldier, 2)); err == nil {
+		t.Error("spawning a unit in a regular game was accepted")
+	}
+	if game.World.UnitAt(AxialCoord{Q: 1, R: 1}) != nil {
+		t.Error("a rejected spawn placed a unit")
+	}
+	if err := game.ProcessMove(deleteMove(2, 0)); err == nil {
+		t.Error("deleting a unit in a regular game was accepted")
+	}
+	if game.World.UnitAt(AxialCoord{Q: 2, R: 0}) == nil {
+		t.Error("a rejected delete removed the unit")
+	}
+}
+
+func TestSandboxSpawnAndDelete(t *testing.T) {
+	game := sandboxGame(&v1.SandboxSettings{Enabled: true})
+
+	spawn := spawnMove(1, 1, testfixtures.UnitTypeSoldier, 2)
+	if err := game.ProcessMove(spawn); err != nil {
+		t.Fatalf("spawn failed: %v", err)
+	}
+	unit := game.World.UnitAt(AxialCoord{Q: 1, R: 1})
+	if unit == nil || unit.Player != 2 || unit.UnitType != testfixtures.UnitTypeSoldier {
+		t.Fatalf("unit at 1,1 = %v, want player 2's soldier", unit)
+	}
+	if !game.RulesEngine.HasMovementLeft(unit.DistanceLeft) {
+		t.Error("spawned unit cannot move")
+	}
+	if err := game.ProcessMove(spawnMove(1, 1, testfixtures.UnitTypeSoldier, 1)); err == nil {
+		t.Error("spawning on an occupied hex was accepted")
+	}
+	if err := game.ProcessMove(spawnMove(0, 1, testfixtures.UnitTypeSoldier, 3)); err == nil {
+		t.Error("spawning for a player not in the game was accepted")
+	}
+
+	remove := deleteMove(2, 0)
+	if err := game.ProcessMove(remove); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if game.World.UnitAt(AxialCoord{Q: 2, R: 0}) != nil {
+		t.Error("deleted unit is still on the board")
+	}
+
+	// Replaying the changes gives the same board
+	replay := sandboxGame(&v1.SandboxSettings{Enabled: true})
+	if err := replay.ApplyChanges([]*v1.GameMove{spawn, remove}); err != nil {
+		t.Fatalf("ApplyChanges failed: %v", err)
+	}
+	if replay.World.UnitAt(AxialCoord{Q: 1, R: 1}) == nil || replay.World.UnitAt(AxialCoord{Q: 2, R: 0}) != nil {
+		t.Error("replayed board differs")
+	}
+}
+
+func TestSandbox_RelaxedTurnOrder(t *testing.T) {
+	strict := sandboxGame(&v1.SandboxSettings{Enabled: true})
+	if err := strict.ProcessMove(moveUnitMove(2, 0, 3, 0)); err == nil {
+		t.Error("player 2's unit moved in player 1's turn without relaxed turn order")
+	}
+
+	game := sandboxGame(&v1.SandboxSettings{Enabled: true, RelaxTurnOrder: true})
+	options, err := game.GetOptionsAt("2,0")
+	if err != nil {
+		t.Fatalf("GetOptionsAt failed: %v", err)
+	}
+	if len(options.Options) == 0 || options.CurrentPlayer != 1 {
+		t.Errorf("player 2's unit has %d options with current player %d, want some with player 1 current",
+			len(options.Options), options.CurrentPlayer)
+	}
+	if err := game.ProcessMove(moveUnitMove(2, 0, 3, 0)); err != nil {
+		t.Fatalf("moving player 2's unit failed: %v", err)
+	}
+	if game.World.UnitAt(AxialCoord{Q: 3, R: 0}) == nil {
+		t.Error("player 2's unit did not move")
+	}
+	if game.CurrentPlayer != 1 || game.GameState.CurrentPlayer != 1 {
+		t.Errorf("current player = %d after the move, want 1", game.CurrentPlayer)
+	}
+}
+
+func TestSandbox_RelaxedActionOrder(t *testing.T) {
+	attack := &v1.GameMove{MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+		Attacker: &v1.Position{Q: 1, R: 0}, Defender: &v1.Position{Q: 2, R: 0},
+	}}}
+	for _, relaxed := range []bool{false, true} {
+		game := sandboxGame(&v1.SandboxSettings{Enabled: true, RelaxActionOrder: relaxed})
+		if err := game.ProcessMoves([]*v1.GameMove{moveUnitMove(0, 0, 1, 0), attack}); err != nil {
+			t.Fatalf("move and attack failed: %v", err)
+		}
+		game.World.UnitAt(AxialCoord{Q: 1, R: 0}).DistanceLeft = 1
+		err := game.ProcessMove(moveUnitMove(1, 0, 0, 0))
+		if relaxed && err != nil {
+			t.Errorf("moving after attacking with relaxed action order failed: %v", err)
+		}
+		if !relaxed && err == nil {
+			t.Error("moving after attacking was accepted without relaxed action order")
+		}
+	}
+}
+
+func TestSandbox_InfiniteCoins(t *testing.T) {
+	build := &v1.GameMove{MoveType: &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{
+		Pos: &v1.Position{Q: -1, R: 1}, UnitType: testfixtures.UnitTypeSoldier,
+	}}}
+	for _, infinite := range []bool{false, true} {
+		game := testfixtures.TwoSoldierDuel().
+			Tile(-1, 1, lib.TileTypeLandBase, 1).
+			Coins(1, 50).
+			Settings(&v1.GameSettings{Sandbox: &v1.SandboxSettings{Enabled: true, InfiniteCoins: infinite}}).
+			Build()
+		err := game.ProcessMove(build)
+		if infinite && err != nil {
+			t.Errorf("building with infinite coins failed: %v", err)
+		}
+		if !infinite && err == nil {
+			t.Error("building without enough coins was accepted")
+		}
+		if coins := game.GameState.PlayerStates[1].Coins; coins != 50 {
+			t.Errorf("player 1 has %d coins after building, want 50", coins)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/testfixtures"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Tests for exporting and importing offline games for save slots
+// =============================================================================
+
+func newExportedGame(t *testing.T) (*SingletonGamesService, []byte) {
+	t.Helper()
+	game := testfixtures.TwoSoldierDuel().Build()
+	game.Config.Players[0].UserId = TestUserID
+	svc := NewSingletonGamesService()
+	svc.SingletonGame = game.Game
+	svc.SingletonGameState = game.GameState
+	_, err := svc.ProcessMoves(AuthenticatedContext(), &v1.ProcessMovesRequest{
+		GameId: game.Id,
+		Moves: []*v1.GameMove{{Player: 1, MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+			From: &v1.Position{Label: "A1"},
+			To:   &v1.Position{Label: "1,0"},
+		}}}},
+	})
+	if err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+
+	data, err := svc.ExportState()
+	if err != nil {
+		t.Fatalf("ExportState failed: %v", err)
+	}
+	return svc, data
+}
+
+// TestSaveSlots_RoundTrip tests an exported game imports back to the same
+// state, and exports deterministically
+func TestSaveSlots_RoundTrip(t *testing.T) {
+	svc, data := newExportedGame(t)
+	rulesHash := svc.RuntimeGame.RulesHash()
+
+	again, err := svc.ExportState()
+	if err != nil {
+		t.Fatalf("ExportState failed: %v", err)
+	}
+	if !bytes.Equal(data, again) {
+		t.Error("exporting the same game twice gave different bytes")
+	}
+
+	restored := NewSingletonGamesService()
+	bundle, err := restored.ImportState(data)
+	if err != nil {
+		t.Fatalf("ImportState failed: %v", err)
+	}
+	if bundle.Game.Id != svc.SingletonGame.Id {
+		t.Errorf("imported game %q, want %q", bundle.Game.Id, svc.SingletonGame.Id)
+	}
+	if got, want := lib.StateHash(restored.SingletonGameState, rulesHash), lib.StateHash(svc.SingletonGameState, rulesHash); got != want {
+		t.Errorf("imported state hashes to %s, want %s", got, want)
+	}
+	if unit := restored.SingletonGameState.WorldData.UnitsMap[lib.CoordKey(1, 0)]; unit == nil || unit.Shortcut != "A1" {
+		t.Error("the imported state lost the move made before exporting")
+	}
+
+	reexported, err := restored.ExportState()
+	if err != nil {
+		t.Fatalf("ExportState of the imported game failed: %v", err)
+	}
+	if !bytes.Equal(data, reexported) {
+		t.Error("re-exporting an imported game gave different bytes")
+	}
+}
+
+// TestSaveSlots_CorruptedData tests corrupted slot data fails to import with
+// validation errors and leaves the current game untouched
+func TestSaveSlots_CorruptedData(t *testing.T) {
+	_, data := newExportedGame(t)
+
+	tampered := &v1.GameBundle{}
+	if err := proto.Unmarshal(data, tampered); err != nil {
+		t.Fatalf("failed to decode the exported bundle: %v", err)
+	}
+	tampered.State.WorldData.UnitsMap[lib.CoordKey(1, 0)].AvailableHealth = 1
+	tamperedData, err := proto.Marshal(tampered)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stateless := proto.Clone(tampered).(*v1.GameBundle)
+	stateless.State = nil
+	stateless.Version = 99
+	statelessData, err := proto.Marshal(stateless)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		data   []byte
+		fields []string
+	}{
+		{"truncated", data[:len(data)/2], []string{"bundle"}},
+		{"garbage", []byte("not a saved game"), []string{"bundle"}},
+		{"empty", nil, []string{"version", "game", "state"}},
+		{"tampered state", tamperedData, []string{"state_hash"}},
+		{"missing state", statelessData, []string{"version", "state"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, _ := newExportedGame(t)
+			before := proto.Clone(current.SingletonGameState)
+
+			_, err := current.ImportState(tt.data)
+			var invalid *services.BundleValidationError
+			if !errors.As(err, &invalid) {
+				t.Fatalf("ImportState error = %v, want a BundleValidationError", err)
+			}
+			var fields []string
+			for _, problem := range invalid.Problems {
+				fields = append(fields, problem.Field)
+			}
+			if len(fields) != len(tt.fields) {
+				t.Fatalf("problems with %v, want %v", fields, tt.fields)
+			}
+			for i := range fields {
+				if fields[i] != tt.fields[i] {
+					t.Errorf("problems with %v, want %v", fields, tt.fields)
+					break
+				}
+			}
+			if !proto.Equal(before, current.SingletonGameState) {
+				t.Error("a failed import changed the current game")
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// =============================================================================
+// Tests for spectator delays over GameSync
+// =============================================================================
+
+const delayedGameId = "delayedgame"
+
+// delayedGame is a 1v1 game holding spectators back by delay
+func delayedGame(delay *v1.SpectatorDelay) *v1.Game {
+	return &v1.Game{
+		Id: delayedGameId,
+		Config: &v1.GameConfiguration{
+			Settings: &v1.GameSettings{SpectatorDelay: delay},
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: "user-1"},
+				{PlayerId: 2, UserId: "user-2"},
+			},
+		},
+	}
+}
+
+func newDelayedSyncService(game *v1.Game, clock lib.Clock) *services.GameSyncService {
+	svc := services.NewGameSyncService()
+	svc.Seats = services.GameSeats(staticGames{game})
+	svc.Delays = services.GameSpectatorDelays(staticGames{game})
+	svc.Clock = clock
+	svc.DelayPollInterval = time.Millisecond
+	return svc
+}
+
+// subscribeFrom subscribes the user ("" for a spectator) to the delayed game
+// from a sequence and waits for its initial state
+func subscribeFrom(t *testing.T, svc *services.GameSyncService, user string, fromSequence int64) (*fakeUpdateStream, *v1.SubscribeResponse) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(ContextWithUserID(user))
+	t.Cleanup(cancel)
+	stream := &fakeUpdateStream{ctx: ctx, updates: make(chan *v1.GameUpdate, 100)}
+	go svc.Subscribe(&v1.SubscribeRequest{GameId: delayedGameId, FromSequence: fromSequence}, stream)
+	return stream, nextUpdate(t, stream).GetInitialState()
+}
+
+// nextMoves returns the next moves a subscriber receives within wait, nil if
+// none arrive
+func nextMoves(t *testing.T, stream *fakeUpdateStream, wait time.Duration) *v1.GameUpdate {
+	t.Helper()
+	timeout := time.After(wait)
+	for {
+		select {
+		case update := <-stream.updates:
+			if update.GetMovesPublished() != nil {
+				return update
+			}
+		case <-timeout:
+			return nil
+		}
+	}
+}
+
+// endTurn broadcasts player ending their turn in turn, handing it to the
+// next player
+func endTurn(t *testing.T, svc *services.GameSyncService, player, turn int32) int64 {
+	t.Helper()
+	nextPlayer, nextTurn := player%2+1, turn
+	if nextPlayer == 1 {
+		nextTurn++
+	}
+	resp, err := svc.Broadcast(context.Background(), &v1.BroadcastRequest{
+		GameId: delayedGameId,
+		Update: &v1.GameUpdate{UpdateType: &v1.GameUpdate_MovesPublished{MovesPublished: &v1.MovesPublished{
+			Player: player,
+			Moves: []*v1.GameMove{{
+				Player:   player,
+				MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}},
+				Changes: []*v1.WorldChange{{ChangeType: &v1.WorldChange_PlayerChanged{PlayerChanged: &v1.PlayerChangedChange{
+					PreviousPlayer: player, NewPlayer: nextPlayer, PreviousTurn: turn, NewTurn: nextTurn,
+				}}}},
+			}},
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("Broadcast failed: %v", err)
+	}
+	return resp.Sequence
+}
+
+func TestSpectatorDelay_Minutes(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	svc := newDelayedSyncService(delayedGame(&v1.SpectatorDelay{Seconds: 600}), clock)
+
+	player, playerInitial := subscribeFrom(t, svc, "user-2", 0)
+	spectator, spectatorInitial := subscribeFrom(t, svc, "", 0)
+	for svc.SubscriberCount(delayedGameId) < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	if playerInitial.SpectatorDelay != nil {
+		t.Error("player was told they are delayed")
+	}
+	if spectatorInitial.SpectatorDelay.GetSeconds() != 600 {
+		t.Errorf("spectator delay = %v, want 600 seconds", spectatorInitial.SpectatorDelay)
+	}
+
+	turn3 := endTurn(t, svc, 1, 3)
+	if update := nextMoves(t, player, 2*time.Second); update.GetSequence() != turn3 {
+		t.Fatalf("player got %v, want the turn 3 moves live", update)
+	}
+	if update := nextMoves(t, spectator, 50*time.Millisecond); update != nil {
+		t.Fatalf("spectator got turn 3 moves before the delay passed: %v", update)
+	}
+
+	clock.Advance(9 * time.Minute)
+	if update := nextMoves(t, spectator, 50*time.Millisecond); update != nil {
+		t.Fatalf("spectator got turn 3 moves a minute early: %v", update)
+	}
+	clock.Advance(time.Minute)
+	if update := nextMoves(t, spectator, 2*time.Second); update.GetSequence() != turn3 {
+		t.Fatalf("spectator got %v once the delay passed, want the turn 3 moves", update)
+	}
+}
+
+func TestSpectatorDelay_Turns(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	svc := newDelayedSyncService(delayedGame(&v1.SpectatorDelay{Turns: 2}), clock)
+
+	spectator, _ := subscribeFrom(t, svc, "", 0)
+	for svc.SubscriberCount(delayedGameId) < 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	turn3 := []int64{endTurn(t, svc, 1, 3), endTurn(t, svc, 2, 3)}
+	turn4 := endTurn(t, svc, 1, 4)
+	if update := nextMoves(t, spectator, 50*time.Millisecond); update != nil {
+		t.Fatalf("spectator got moves in turn 4: %v", update)
+	}
+
+	// Turn 3 is released once turn 5 starts; turn 4 is still held back
+	endTurn(t, svc, 2, 4)
+	for _, want := range turn3 {
+		if update := nextMoves(t, spectator, 2*time.Second); update.GetSequence() != want {
+			t.Fatalf("spectator got %v in turn 5, want turn 3's update %d", update, want)
+		}
+	}
+	if update := nextMoves(t, spectator, 50*time.Millisecond); update != nil {
+		t.Fatalf("spectator got turn 4 moves in turn 5: %v", update)
+	}
+
+	// A spectator joining now starts from turn 3 and is backfilled from
+	// there; a reconnecting player gets everything they missed
+	late, initial := subscribeFrom(t, svc, "", 0)
+	if initial.CurrentSequence != turn4-1 {
+		t.Errorf("late spectator starts at sequence %d, want %d", initial.CurrentSequence, turn4-1)
+	}
+	player, _ := subscribeFrom(t, svc, "user-1", turn3[0])
+	for _, want := range []int64{turn3[1], turn4, turn4 + 1} {
+		if update := nextMoves(t, player, 2*time.Second); update.GetSequence() != want {
+			t.Fatalf("reconnecting player got %v, want update %d", update, want)
+		}
+	}
+	endTurn(t, svc, 1, 5)
+	endTurn(t, svc, 2, 5)
+	if update := nextMoves(t, late, 2*time.Second); update.GetSequence() != turn4 {
+		t.Fatalf("late spectator got %v in turn 6, want turn 4's moves", update)
+	}
+}
+
+func TestSpectatorCutoff(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	group := func(number int64, player, turn int32, ended time.Duration) *v1.GameMoveGroup {
+		nextPlayer, nextTurn := player%2+1, turn
+		if nextPlayer == 1 {
+			nextTurn++
+		}
+		return &v1.GameMoveGroup{
+			GroupNumber: number,
+			EndedAt:     timestamppb.New(now.Add(-ended)),
+			Moves: []*v1.GameMove{
+				{Player: player, MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{}}},
+				{Player: player, Changes: []*v1.WorldChange{{ChangeType: &v1.WorldChange_PlayerChanged{PlayerChanged: &v1.PlayerChangedChange{
+					PreviousTurn: turn, NewTurn: nextTurn,
+				}}}}},
+			},
+		}
+	}
+	history := &v1.GameMoveHistory{Groups: []*v1.GameMoveGroup{
+		group(1, 1, 1, time.Hour),
+		group(2, 2, 1, 50*time.Minute),
+		group(3, 1, 2, 20*time.Minute),
+		group(4, 2, 2, 5*time.Minute),
+		group(5, 1, 3, time.Minute),
+	}}
+
+	for _, tc := range []struct {
+		delay        *v1.SpectatorDelay
+		visible      int
+		turn, player int32
+		held         bool
+	}{
+		{delay: &v1.SpectatorDelay{Turns: 1}, visible: 4, turn: 3, player: 1, held: true},
+		{delay: &v1.SpectatorDelay{Turns: 2}, visible: 2, turn: 2, player: 1, held: true},
+		{delay: &v1.SpectatorDelay{Seconds: 600}, visible: 3, turn: 2, player: 2, held: true},
+		{delay: &v1.SpectatorDelay{Turns: 1, Seconds: 1800}, visible: 2, turn: 2, player: 1, held: true},
+		{delay: &v1.SpectatorDelay{Seconds: 30}, visible: 5},
+	} {
+		visible, turn, player, held := services.SpectatorCutoff(history, tc.delay, now)
+		if len(visible) != tc.visible || turn != tc.turn || player != tc.player || held != tc.held {
+			t.Errorf("%s: %d groups visible up to turn %d player %d (held %v), want %d up to turn %d player %d (held %v)",
+				services.SpectatorDelayNote(tc.delay), len(visible), turn, player, held, tc.visible, tc.turn, tc.player, tc.held)
+		}
+	}
+
+	if note := services.SpectatorDelayNote(&v1.SpectatorDelay{Turns: 2, Seconds: 600}); note != "Delayed by 2 turns and 10 minutes" {
+		t.Errorf("note = %q", note)
+	}
+}
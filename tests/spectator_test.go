@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+)
+
+// =============================================================================
+// Tests for static snapshot spectating
+// =============================================================================
+
+// countingRenderer records the worlds it is asked to render
+type countingRenderer struct {
+	mu     sync.Mutex
+	worlds []*v1.WorldData
+}
+
+func (c *countingRenderer) Render(theme string, worldData *v1.WorldData) ([]byte, string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.worlds = append(c.worlds, worldData)
+	return []byte(theme), "image/png", nil
+}
+
+func (c *countingRenderer) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.worlds)
+}
+
+func newCountingCache() (*services.SnapshotCache, *countingRenderer) {
+	renderer := &countingRenderer{}
+	cache := services.NewSnapshotCache(services.DefaultSnapshotCacheSize)
+	cache.Render = renderer.Render
+	return cache, renderer
+}
+
+func spectatorWorld() *v1.WorldData {
+	return NewGameBuilder().
+		GrassTiles(2).
+		UnitWithShortcut(0, 0, 1, UnitTypeSoldier, "A1").
+		UnitWithShortcut(1, 0, 2, UnitTypeSoldier, "B1").
+		Build().World.WorldData()
+}
+
+func TestSnapshotCache_RendersEachViewOnce(t *testing.T) {
+	cache, renderer := newCountingCache()
+	world := spectatorWorld()
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.Get("default", world); err != nil {
+				t.Errorf("Get failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := renderer.count(); n != 1 {
+		t.Fatalf("rendered %d times for 20 spectators, want 1", n)
+	}
+
+	// Another theme is another rendering
+	if _, err := cache.Get("fantasy", world); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if n := renderer.count(); n != 2 {
+		t.Errorf("rendered %d times after a new theme, want 2", n)
+	}
+
+	// As is a change spectators can see
+	world.UnitsMap["0,0"].AvailableHealth = 3
+	before, _ := services.SpectatorStateHash(spectatorWorld())
+	snapshot, err := cache.Get("default", world)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if n := renderer.count(); n != 3 {
+		t.Errorf("rendered %d times after a move, want 3", n)
+	}
+	if snapshot.Hash == before {
+		t.Error("state hash did not change after a move")
+	}
+}
+
+func TestSnapshotCache_EvictsOldest(t *testing.T) {
+	cache, renderer := newCountingCache()
+	cache.MaxEntries = 1
+	world := spectatorWorld()
+
+	cache.Get("default", world)
+	cache.Get("fantasy", world)
+	cache.Get("default", world)
+	if n := renderer.count(); n != 3 {
+		t.Errorf("rendered %d times, want 3 with room for one snapshot", n)
+	}
+}
+
+func TestSpectatorSnapshot_HidesSubmergedUnits(t *testing.T) {
+	cache, renderer := newCountingCache()
+	world := spectatorWorld()
+	visibleHash, _ := services.SpectatorStateHash(world)
+
+	world.UnitsMap["1,0"].Submerged = true
+	snapshot, err := cache.Get("default", world)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	rendered := renderer.worlds[0]
+	if _, ok := rendered.UnitsMap["1,0"]; ok {
+		t.Error("submerged unit was rendered for spectators")
+	}
+	if _, ok := rendered.UnitsMap["0,0"]; !ok {
+		t.Error("visible unit was not rendered")
+	}
+	if _, ok := world.UnitsMap["1,0"]; !ok {
+		t.Error("rendering removed the submerged unit from the game's world")
+	}
+	if snapshot.Hash == visibleHash {
+		t.Error("hash did not change when the unit submerged")
+	}
+
+	// Moving a hidden unit changes nothing spectators can see
+	hidden := world.UnitsMap["1,0"]
+	delete(world.UnitsMap, "1,0")
+	hidden.Q, hidden.R = 1, -1
+	world.UnitsMap["1,-1"] = hidden
+	if hash, _ := services.SpectatorStateHash(world); hash != snapshot.Hash {
+		t.Error("hash changed when a submerged unit moved")
+	}
+	if _, err := cache.Get("default", world); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if n := renderer.count(); n != 1 {
+		t.Errorf("rendered %d times, want 1", n)
+	}
+}
+
+func TestSpectatorEventLog(t *testing.T) {
+	history := &v1.GameMoveHistory{Groups: []*v1.GameMoveGroup{{
+		Moves: []*v1.GameMove{
+			{Player: 1, MoveType: &v1.GameMove_SubmergeUnit{SubmergeUnit: &v1.SubmergeUnitAction{}}},
+			{Player: 1, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}},
+		},
+	}}}
+	events := services.SpectatorEventLog(history)
+	want := []string{"Player 1 submerged or surfaced a unit", "Player 1 ended their turn"}
+	if len(events) != len(want) {
+		t.Fatalf("events = %v, want %v", events, want)
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Errorf("event %d = %q, want %q", i, events[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/server"
+	"google.golang.org/grpc/metadata"
+)
+
+// =============================================================================
+// Tests for diffing a game's state between two turns
+// =============================================================================
+
+// TestGetStateDiff_BuiltAndKilled tests a unit built and killed between the
+// two turns is reported even though neither state has it
+func TestGetStateDiff_BuiltAndKilled(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	backend, err := server.StartLocalBackend(context.Background(), address, t.TempDir())
+	if err != nil {
+		t.Fatalf("StartLocalBackend failed: %v", err)
+	}
+	defer backend.Stop()
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	games := backend.ClientMgr.GetGamesSvcClient()
+	player1 := server.LocalContext(context.Background())
+	player2 := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test2")
+
+	// Player 1 builds on a base next to player 2's tank
+	worldData := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for _, coord := range (lib.AxialCoord{}).Range(2) {
+		worldData.TilesMap[lib.CoordKeyFromAxial(coord)] = lib.NewTile(coord, lib.TileTypeGrass)
+	}
+	base := lib.NewTile(lib.AxialCoord{}, lib.TileTypeLandBase)
+	base.Player = 1
+	worldData.TilesMap[lib.CoordKeyFromAxial(lib.AxialCoord{})] = base
+	tankAt := lib.AxialCoord{Q: 1, R: 0}
+	worldData.UnitsMap[lib.CoordKeyFromAxial(tankAt)] = lib.NewUnit(int(UnitTypeTank), 2, tankAt)
+	world, err := worlds.CreateWorld(player1, &v1.CreateWorldRequest{World: &v1.World{Name: "Diff"}, WorldData: worldData})
+	if err != nil {
+		t.Fatalf("CreateWorld failed: %v", err)
+	}
+	created, err := games.CreateGame(player1, &v1.CreateGameRequest{Game: &v1.Game{
+		Name:    "Diff",
+		WorldId: world.World.Id,
+		Config: &v1.GameConfiguration{Players: []*v1.GamePlayer{
+			{PlayerId: 1, UserId: server.LocalUserID, PlayerType: "human", StartingCoins: 500},
+			{PlayerId: 2, UserId: "test2", PlayerType: "human"},
+		}},
+	}})
+	if err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	gameId := created.Game.Id
+
+	play := func(ctx context.Context, move *v1.GameMove) *v1.ProcessMovesResponse {
+		t.Helper()
+		resp, err := games.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: gameId, Moves: []*v1.GameMove{move}})
+		if err != nil {
+			t.Fatalf("ProcessMoves failed: %v", err)
+		}
+		return resp
+	}
+	endTurn := &v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+
+	built := play(player1, &v1.GameMove{MoveType: &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{
+		Pos: &v1.Position{Label: "0,0"}, UnitType: UnitTypeSoldierBasic,
+	}}}).Moves[0].Changes[0].GetUnitBuilt().Unit
+	play(player1, endTurn)
+
+	// The tank attacks the new soldier every turn until it dies
+	killed := false
+	for round := 0; round < 10 && !killed; round++ {
+		resp := play(player2, &v1.GameMove{MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+			Attacker: &v1.Position{Label: "1,0"}, Defender: &v1.Position{Label: "0,0"},
+		}}})
+		for _, change := range resp.Moves[0].Changes {
+			if change.GetUnitKilled().GetPreviousUnit().GetShortcut() == built.Shortcut {
+				killed = true
+			}
+		}
+		play(player2, endTurn)
+		play(player1, endTurn)
+	}
+	if !killed {
+		t.Fatal("the tank never killed the built soldier")
+	}
+
+	resp, err := games.GetStateDiff(player1, &v1.GetStateDiffRequest{GameId: gameId, FromTurn: 1, ToTurn: 100})
+	if err != nil {
+		t.Fatalf("GetStateDiff failed: %v", err)
+	}
+	var transient *v1.UnitDiff
+	for _, diff := range resp.Diff.Units {
+		unit := diff.After
+		if unit == nil {
+			unit = diff.Before
+		}
+		if unit.Shortcut != built.Shortcut {
+			continue
+		}
+		if transient != nil || diff.Kind != v1.UnitDiffKind_UNIT_DIFF_KIND_TRANSIENT {
+			t.Fatalf("built soldier diffed as %v, want a single transient diff", diff.Kind)
+		}
+		transient = diff
+	}
+	if transient == nil {
+		t.Fatal("the soldier built and killed within the span is missing from the diff")
+	}
+	if transient.Before.AvailableHealth != built.AvailableHealth {
+		t.Errorf("transient unit health as built = %d, want %d", transient.Before.AvailableHealth, built.AvailableHealth)
+	}
+
+	// Coins are replayed from the start of the game up to the current state
+	current, err := games.GetGame(player1, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	if len(resp.Diff.Players) == 0 || resp.Diff.Players[0].PlayerId != 1 {
+		t.Fatalf("player diffs = %v, want player 1's coins", resp.Diff.Players)
+	}
+	coins := resp.Diff.Players[0]
+	if coins.PreviousCoins != created.GameState.PlayerStates[1].Coins || coins.NewCoins != current.State.PlayerStates[1].Coins {
+		t.Errorf("player 1 coins %d -> %d, want %d -> %d", coins.PreviousCoins, coins.NewCoins,
+			created.GameState.PlayerStates[1].Coins, current.State.PlayerStates[1].Coins)
+	}
+}
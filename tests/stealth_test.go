@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// =============================================================================
+// Tests for Stealth-class units submerging
+// =============================================================================
+
+const (
+	unitTypeStealthSubmarine int32 = 16 // Stealth:Water
+	unitTypeStealthHunter    int32 = 12 // Battleship, Heavy:Water
+)
+
+// stealthGame puts a player 2 submarine (B1) at (0,0) and a player 1
+// battleship (A1) at (battleshipQ,0) on open water
+func stealthGame(battleshipQ int, stealthEnabled bool) *lib.Game {
+	builder := NewGameBuilder()
+	for q := -1; q <= 4; q++ {
+		builder.Tile(q, 0, TileTypeWaterRegular, 0)
+	}
+	return builder.
+		UnitWithShortcut(0, 0, 2, unitTypeStealthSubmarine, "B1").
+		UnitWithShortcut(battleshipQ, 0, 1, unitTypeStealthHunter, "A1").
+		Settings(&v1.GameSettings{StealthEnabled: stealthEnabled}).
+		CurrentPlayer(2).
+		Build()
+}
+
+func isVisible(game *lib.Game, player int32, shortcut string) bool {
+	return slices.ContainsFunc(game.GetVisibleUnitsForPlayer(player), func(u *v1.Unit) bool {
+		return u.Shortcut == shortcut
+	})
+}
+
+func TestSubmergedUnitHiddenUnlessAdjacent(t *testing.T) {
+	game := stealthGame(3, true)
+	if !isVisible(game, 1, "B1") {
+		t.Fatal("surfaced submarine should be visible")
+	}
+
+	if _, err := game.Submerge("B1", true); err != nil {
+		t.Fatalf("Submerge failed: %v", err)
+	}
+	if isVisible(game, 1, "B1") {
+		t.Error("submerged submarine should be hidden from a distant enemy")
+	}
+	if !isVisible(game, 2, "B1") {
+		t.Error("owner should always see their submerged submarine")
+	}
+	if !isVisible(game, 1, "A1") {
+		t.Error("other units should stay visible")
+	}
+
+	adjacent := stealthGame(1, true)
+	if _, err := adjacent.Submerge("B1", true); err != nil {
+		t.Fatalf("Submerge failed: %v", err)
+	}
+	if !isVisible(adjacent, 1, "B1") {
+		t.Error("submerged submarine should be visible to an adjacent enemy")
+	}
+}
+
+func TestSubmergedUnitCannotAttack(t *testing.T) {
+	game := stealthGame(1, true)
+
+	changes, err := game.Submerge("B1", true)
+	if err != nil {
+		t.Fatalf("Submerge failed: %v", err)
+	}
+	if len(changes) != 1 || changes[0].GetUnitSubmerged() == nil {
+		t.Fatalf("expected a single UnitSubmerged change, got %v", changes)
+	}
+
+	resp, err := game.GetOptionsAt("B1")
+	if err != nil {
+		t.Fatalf("GetOptionsAt failed: %v", err)
+	}
+	var surfaceOffered bool
+	for _, opt := range resp.Options {
+		if opt.GetAttack() != nil {
+			t.Error("submerged unit should have no attack options")
+		}
+		if submerge := opt.GetSubmerge(); submerge != nil && !submerge.Submerge {
+			surfaceOffered = true
+		}
+	}
+	if !surfaceOffered {
+		t.Error("expected a surface option for the submerged unit")
+	}
+
+	if _, err := game.Attack("B1", "A1"); err == nil {
+		t.Error("expected attack from a submerged unit to fail")
+	}
+
+	// Surfacing restores the ability to attack
+	if _, err := game.Submerge("B1", false); err != nil {
+		t.Fatalf("surface failed: %v", err)
+	}
+	if _, err := game.Attack("B1", "A1"); err != nil {
+		t.Errorf("surfaced unit should be able to attack: %v", err)
+	}
+}
+
+func TestSubmergeValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		stealth bool
+		unit    string
+		wantErr string
+	}{
+		{"stealth disabled", false, "B1", "not enabled"},
+		{"not a stealth unit", true, "A1", "cannot submerge"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := stealthGame(3, tt.stealth)
+			game.CurrentPlayer = game.World.UnitAt(mustPos(t, game, tt.unit)).Player
+			_, err := game.Submerge(tt.unit, true)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Submerge() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Options only offer submerging when stealth is enabled
+	resp, err := stealthGame(3, false).GetOptionsAt("B1")
+	if err != nil {
+		t.Fatalf("GetOptionsAt failed: %v", err)
+	}
+	for _, opt := range resp.Options {
+		if opt.GetSubmerge() != nil {
+			t.Error("submerge option offered with stealth disabled")
+		}
+	}
+}
+
+func mustPos(t *testing.T, game *lib.Game, label string) lib.AxialCoord {
+	t.Helper()
+	pos, err := game.Pos(label)
+	if err != nil {
+		t.Fatalf("invalid position %q: %v", label, err)
+	}
+	return pos.Coordinate
+}
@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// =============================================================================
+// Tests for stuck game detection and no-contact draws
+// =============================================================================
+
+// unitTypeHelicopter is the Helicopter in the default rules
+const unitTypeHelicopter int32 = 17
+
+// islandBuilder puts each player's soldier on its own grass island, with
+// open water between them
+func islandBuilder() *GameBuilder {
+	b := NewGameBuilder()
+	for q := -1; q <= 6; q++ {
+		for r := -1; r <= 1; r++ {
+			b.Tile(q, r, lib.TileTypeWaterRegular, 0)
+		}
+	}
+	return b.
+		Tile(0, 0, TileTypeGrass, 0).
+		Tile(5, 0, TileTypeGrass, 0).
+		UnitWithShortcut(0, 0, 1, UnitTypeSoldier, "A1").
+		UnitWithShortcut(5, 0, 2, UnitTypeSoldier, "B1")
+}
+
+func TestStuckAnalysis_IslandStalemate(t *testing.T) {
+	game := islandBuilder().Build()
+
+	analysis := game.StuckAnalysis()
+	if !analysis.Stuck {
+		t.Fatalf("island stalemate not detected: %v", analysis)
+	}
+	if !analysis.ForcesUnreachable || analysis.ActionableUnits != 0 ||
+		analysis.AffordableBuilds != 0 || analysis.IncomeGrowthPaths != 0 {
+		t.Errorf("unexpected analysis: %v", analysis)
+	}
+	if _, draw := game.NoContactDraw(); !draw {
+		t.Error("no-contact draw not allowed in an island stalemate")
+	}
+}
+
+func TestStuckAnalysis_AirUnitCanStillCross(t *testing.T) {
+	game := islandBuilder().
+		Tile(1, 0, TileTypeGrass, 0).
+		UnitWithShortcut(1, 0, 1, unitTypeHelicopter, "A2").
+		Build()
+
+	analysis := game.StuckAnalysis()
+	if analysis.Stuck || analysis.ForcesUnreachable {
+		t.Fatalf("game with a helicopter that can cross the water reported stuck: %v", analysis)
+	}
+	if analysis.ActionableUnits != 1 {
+		t.Errorf("actionable units = %d, want 1 (the helicopter)", analysis.ActionableUnits)
+	}
+
+	// The stranded player can still be reached, so neither may claim a draw
+	if _, draw := game.NoContactDraw(); draw {
+		t.Error("no-contact draw allowed while a helicopter can cross")
+	}
+}
+
+func TestStuckAnalysis_AffordableAirBuild(t *testing.T) {
+	game := islandBuilder().
+		Tile(0, 1, TileTypeAirport, 1).
+		Coins(1, 5000).
+		Build()
+
+	analysis := game.StuckAnalysis()
+	if analysis.Stuck || analysis.AffordableBuilds == 0 {
+		t.Errorf("player with an airport and coins reported stuck: %v", analysis)
+	}
+}
+
+func TestStuckAnalysis_CapturableBuilding(t *testing.T) {
+	game := islandBuilder().
+		Tile(0, 1, TileTypeLandBase, 0).
+		Build()
+
+	analysis := game.StuckAnalysis()
+	if analysis.Stuck || analysis.IncomeGrowthPaths != 1 {
+		t.Errorf("player who can capture a base reported stuck: %v", analysis)
+	}
+}
+
+// setupStuckGame copies the test game and replaces its world with the
+// island stalemate
+func setupStuckGame(t *testing.T) *fsbe.FSGamesService {
+	t.Helper()
+	ctx := context.Background()
+
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+	state, err := svc.LoadGameState(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	state.WorldData = islandBuilder().Build().World.WorldData()
+	if err := svc.SaveGameState(ctx, timeBankGameId, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	return svc
+}
+
+func TestGetGameState_StuckWarning(t *testing.T) {
+	svc := setupStuckGame(t)
+	resp, err := svc.GetGameState(context.Background(), &v1.GetGameStateRequest{GameId: timeBankGameId})
+	if err != nil {
+		t.Fatalf("GetGameState failed: %v", err)
+	}
+	if !resp.StuckWarning.GetStuck() {
+		t.Errorf("stuck warning = %v, want a stuck analysis", resp.StuckWarning)
+	}
+}
+
+func TestClaimNoContactDraw(t *testing.T) {
+	svc := setupStuckGame(t)
+
+	// Either player may claim, not only the current one
+	resp, err := svc.ClaimNoContactDraw(ContextWithUserID("test-user-2"), &v1.ClaimNoContactDrawRequest{GameId: timeBankGameId})
+	if err != nil {
+		t.Fatalf("ClaimNoContactDraw failed: %v", err)
+	}
+	if !resp.Analysis.Stuck {
+		t.Errorf("draw accepted on analysis %v", resp.Analysis)
+	}
+
+	stateResp, err := svc.GetGameState(context.Background(), &v1.GetGameStateRequest{GameId: timeBankGameId})
+	if err != nil {
+		t.Fatalf("GetGameState failed: %v", err)
+	}
+	state := stateResp.State
+	if !state.Finished || state.Status != v1.GameStatus_GAME_STATUS_ENDED || state.WinningPlayer != 0 {
+		t.Errorf("state after draw: finished=%v status=%v winner=%d", state.Finished, state.Status, state.WinningPlayer)
+	}
+	if stateResp.StuckWarning != nil {
+		t.Error("finished game still carries a stuck warning")
+	}
+
+	if _, err := svc.ClaimNoContactDraw(ContextWithUserID("test-user-1"), &v1.ClaimNoContactDrawRequest{GameId: timeBankGameId}); err == nil {
+		t.Error("draw claimed twice")
+	}
+}
+
+func TestClaimNoContactDraw_RejectedWhenNotStuck(t *testing.T) {
+	svc := fsbe.NewFSGamesService(copyTestGame(t), nil)
+	_, err := svc.ClaimNoContactDraw(ContextWithUserID("test-user-1"), &v1.ClaimNoContactDrawRequest{GameId: timeBankGameId})
+	if err == nil {
+		t.Fatal("draw accepted in a game that is not stuck")
+	}
+
+	// Outsiders can't claim either
+	svc = setupStuckGame(t)
+	if _, err := svc.ClaimNoContactDraw(ContextWithUserID("someone-else"), &v1.ClaimNoContactDrawRequest{GameId: timeBankGameId}); err == nil {
+		t.Error("draw accepted from a user who is not playing")
+	}
+}
@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Tests for negotiated GameSync payload encodings
+// =============================================================================
+
+// largeMovesUpdate builds a MovesPublished update with many unit moves
+func largeMovesUpdate(numMoves int) *v1.GameUpdate {
+	published := &v1.MovesPublished{Player: 1, GroupNumber: 7}
+	for i := range numMoves {
+		from := &v1.Unit{
+			Q: int32(i % 20), R: int32(i / 20), Player: 1, UnitType: 3,
+			Shortcut: fmt.Sprintf("A%d", i+1), AvailableHealth: 10, DistanceLeft: 3,
+		}
+		to := proto.Clone(from).(*v1.Unit)
+		to.Q++
+		to.DistanceLeft = 2
+		published.Moves = append(published.Moves, &v1.GameMove{
+			Player: 1,
+			MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Label: from.Shortcut, Q: from.Q, R: from.R},
+				To:   &v1.Position{Q: to.Q, R: to.R},
+			}},
+			Changes: []*v1.WorldChange{{ChangeType: &v1.WorldChange_UnitMoved{
+				UnitMoved: &v1.UnitMovedChange{PreviousUnit: from, UpdatedUnit: to},
+			}}},
+		})
+	}
+	return &v1.GameUpdate{
+		Sequence:   42,
+		UpdateType: &v1.GameUpdate_MovesPublished{MovesPublished: published},
+	}
+}
+
+func TestEncodeUpdate_BinaryGzipIsSmaller(t *testing.T) {
+	update := largeMovesUpdate(200)
+
+	encoded, err := services.EncodeUpdate(update, services.EncodingProtoGzip)
+	if err != nil {
+		t.Fatalf("EncodeUpdate failed: %v", err)
+	}
+	if encoded.Sequence != update.Sequence || encoded.GetEncodedMoves() == nil {
+		t.Fatalf("expected encoded moves with sequence %d, got %v", update.Sequence, encoded)
+	}
+
+	jsonSize := services.PayloadSize(update, services.EncodingJSON)
+	binarySize := services.PayloadSize(encoded, services.EncodingProtoGzip)
+	t.Logf("json: %d bytes, proto+gzip: %d bytes", jsonSize, binarySize)
+	if binarySize*2 > jsonSize {
+		t.Errorf("proto+gzip payload is %d bytes, want at most half of the %d byte JSON payload", binarySize, jsonSize)
+	}
+
+	decoded, err := services.DecodeMovesPublished(encoded.GetEncodedMoves())
+	if err != nil {
+		t.Fatalf("DecodeMovesPublished failed: %v", err)
+	}
+	if !proto.Equal(decoded, update.GetMovesPublished()) {
+		t.Error("decoded moves differ from the original")
+	}
+
+	// JSON subscribers and non-move updates are sent unchanged
+	if same, _ := services.EncodeUpdate(update, services.EncodingJSON); same != update {
+		t.Error("json encoding should leave moves unchanged")
+	}
+	ping := &v1.GameUpdate{UpdateType: &v1.GameUpdate_Ping{Ping: &v1.Ping{PingType: "danger"}}}
+	if same, _ := services.EncodeUpdate(ping, services.EncodingProtoGzip); same != ping {
+		t.Error("only moves should be re-encoded")
+	}
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		accepted []string
+		want     string
+	}{
+		{nil, services.EncodingJSON},
+		{[]string{"proto+gzip"}, services.EncodingProtoGzip},
+		{[]string{"brotli", "proto+gzip", "json"}, services.EncodingProtoGzip},
+		{[]string{"json", "proto+gzip"}, services.EncodingJSON},
+		{[]string{"brotli"}, services.EncodingJSON},
+	}
+	for _, tt := range tests {
+		if got := services.NegotiateEncoding(tt.accepted); got != tt.want {
+			t.Errorf("NegotiateEncoding(%v) = %q, want %q", tt.accepted, got, tt.want)
+		}
+	}
+}
+
+func TestSubscribe_SendsNegotiatedEncoding(t *testing.T) {
+	svc := services.NewGameSyncService()
+	var mu sync.Mutex
+	sent := map[string]int{}
+	svc.OnPayloadSent = func(encoding string, size int) {
+		mu.Lock()
+		defer mu.Unlock()
+		sent[encoding] += size
+	}
+
+	subscribeWith := func(encodings ...string) (*fakeUpdateStream, *v1.SubscribeResponse) {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		stream := &fakeUpdateStream{ctx: ctx, updates: make(chan *v1.GameUpdate, 100)}
+		go svc.Subscribe(&v1.SubscribeRequest{GameId: pingGameId, AcceptedEncodings: encodings}, stream)
+		return stream, nextUpdate(t, stream).GetInitialState()
+	}
+
+	plain, plainInitial := subscribeWith()
+	binary, binaryInitial := subscribeWith(services.EncodingProtoGzip)
+	if plainInitial.Encoding != services.EncodingJSON || binaryInitial.Encoding != services.EncodingProtoGzip {
+		t.Fatalf("negotiated %q and %q, want json and proto+gzip", plainInitial.Encoding, binaryInitial.Encoding)
+	}
+	for svc.SubscriberCount(pingGameId) < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	update := largeMovesUpdate(10)
+	if _, err := svc.Broadcast(context.Background(), &v1.BroadcastRequest{GameId: pingGameId, Update: update}); err != nil {
+		t.Fatalf("Broadcast failed: %v", err)
+	}
+
+	nextMoves := func(stream *fakeUpdateStream) *v1.GameUpdate {
+		for {
+			if u := nextUpdate(t, stream); u.GetMovesPublished() != nil || u.GetEncodedMoves() != nil {
+				return u
+			}
+		}
+	}
+	if got := nextMoves(plain); !proto.Equal(got, update) {
+		t.Errorf("json subscriber got %s", protojson.Format(got))
+	}
+	encoded := nextMoves(binary).GetEncodedMoves()
+	if encoded == nil {
+		t.Fatal("binary subscriber should receive encoded moves")
+	}
+	if decoded, err := services.DecodeMovesPublished(encoded); err != nil || !proto.Equal(decoded, update.GetMovesPublished()) {
+		t.Errorf("binary subscriber's moves don't decode to the broadcast: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if sent[services.EncodingJSON] == 0 || sent[services.EncodingProtoGzip] == 0 {
+		t.Errorf("payload sizes not recorded per encoding: %v", sent)
+	}
+}
@@ -0,0 +1,243 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/grpc"
+)
+
+// =============================================================================
+// Tests for team pings over GameSync
+// =============================================================================
+
+const pingGameId = "pinggame"
+
+// pingTestGame is a 2v2 game: players 1 and 2 against players 3 and 4
+var pingTestGame = &v1.Game{
+	Id: pingGameId,
+	Config: &v1.GameConfiguration{
+		Settings: &v1.GameSettings{TeamMode: "teams"},
+		Players: []*v1.GamePlayer{
+			{PlayerId: 1, UserId: "user-1", TeamId: 1},
+			{PlayerId: 2, UserId: "user-2", TeamId: 1},
+			{PlayerId: 3, UserId: "user-3", TeamId: 2},
+			{PlayerId: 4, UserId: "user-4", TeamId: 2},
+		},
+	},
+}
+
+type staticGames struct{ game *v1.Game }
+
+func (g staticGames) GetGame(ctx context.Context, req *v1.GetGameRequest) (*v1.GetGameResponse, error) {
+	return &v1.GetGameResponse{Game: g.game}, nil
+}
+
+// fakeUpdateStream collects the updates sent to one subscriber
+type fakeUpdateStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates chan *v1.GameUpdate
+}
+
+func (f *fakeUpdateStream) Context() context.Context { return f.ctx }
+
+func (f *fakeUpdateStream) Send(update *v1.GameUpdate) error {
+	f.updates <- update
+	return nil
+}
+
+// subscribe starts a subscription for the user and waits for its initial state
+func subscribe(t *testing.T, svc *services.GameSyncService, user string) (*fakeUpdateStream, *v1.SubscribeResponse) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(ContextWithUserID(user))
+	t.Cleanup(cancel)
+	stream := &fakeUpdateStream{ctx: ctx, updates: make(chan *v1.GameUpdate, 100)}
+	go svc.Subscribe(&v1.SubscribeRequest{GameId: pingGameId}, stream)
+	return stream, nextUpdate(t, stream).GetInitialState()
+}
+
+func nextUpdate(t *testing.T, stream *fakeUpdateStream) *v1.GameUpdate {
+	t.Helper()
+	select {
+	case update := <-stream.updates:
+		return update
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for a game update")
+		return nil
+	}
+}
+
+// collectUntilMarker returns the pings a subscriber receives before the marker
+// broadcast that ends the test
+func collectUntilMarker(t *testing.T, stream *fakeUpdateStream) (pings []*v1.Ping) {
+	t.Helper()
+	for {
+		update := nextUpdate(t, stream)
+		if update.GetGameEnded() != nil {
+			return
+		}
+		if ping := update.GetPing(); ping != nil {
+			pings = append(pings, ping)
+		}
+	}
+}
+
+func newPingService(clock lib.Clock) *services.GameSyncService {
+	svc := services.NewGameSyncService()
+	svc.Seats = services.GameSeats(staticGames{pingTestGame})
+	svc.Clock = clock
+	return svc
+}
+
+func sendPing(svc *services.GameSyncService, user string, pingType string) (*v1.SendPingResponse, error) {
+	return svc.SendPing(ContextWithUserID(user), &v1.SendPingRequest{
+		GameId:   pingGameId,
+		Pos:      &v1.Position{Q: 4, R: -2},
+		PingType: pingType,
+	})
+}
+
+func TestSendPing_OnlyTeammatesReceive(t *testing.T) {
+	svc := newPingService(nil)
+
+	ally, _ := subscribe(t, svc, "user-2")
+	enemy, _ := subscribe(t, svc, "user-3")
+	spectator, _ := subscribe(t, svc, "")
+	for svc.SubscriberCount(pingGameId) < 3 {
+		time.Sleep(time.Millisecond)
+	}
+
+	resp, err := sendPing(svc, "user-1", "defend")
+	if err != nil {
+		t.Fatalf("SendPing failed: %v", err)
+	}
+	if resp.Ping.PlayerId != 1 || resp.Ping.TeamId != 1 {
+		t.Errorf("ping from player %d team %d, want player 1 team 1", resp.Ping.PlayerId, resp.Ping.TeamId)
+	}
+
+	// Teammates subscribing later catch up on recent pings; enemies don't
+	if _, initial := subscribe(t, svc, "user-2"); len(initial.RecentPings) != 1 {
+		t.Errorf("late teammate got %d recent pings, want 1", len(initial.RecentPings))
+	}
+	if _, initial := subscribe(t, svc, "user-4"); len(initial.RecentPings) != 0 {
+		t.Errorf("late enemy got %d recent pings, want 0", len(initial.RecentPings))
+	}
+
+	// Ending the game marks the end of the test for every subscriber
+	_, err = svc.Broadcast(context.Background(), &v1.BroadcastRequest{
+		GameId: pingGameId,
+		Update: &v1.GameUpdate{UpdateType: &v1.GameUpdate_GameEnded{GameEnded: &v1.GameEnded{}}},
+	})
+	if err != nil {
+		t.Fatalf("Broadcast failed: %v", err)
+	}
+
+	if pings := collectUntilMarker(t, ally); len(pings) != 1 || pings[0].PingType != "defend" {
+		t.Errorf("teammate received %v, want the defend ping", pings)
+	}
+	if pings := collectUntilMarker(t, enemy); len(pings) != 0 {
+		t.Errorf("enemy received pings: %v", pings)
+	}
+	if pings := collectUntilMarker(t, spectator); len(pings) != 0 {
+		t.Errorf("spectator received pings: %v", pings)
+	}
+
+	// Pings are dropped with the game
+	if _, initial := subscribe(t, svc, "user-2"); len(initial.RecentPings) != 0 {
+		t.Errorf("teammate got %d recent pings after the game ended, want 0", len(initial.RecentPings))
+	}
+}
+
+func TestSendPing_RateLimit(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	svc := newPingService(clock)
+
+	for i := range services.MaxPingsPerMinute {
+		if _, err := sendPing(svc, "user-1", "attack"); err != nil {
+			t.Fatalf("ping %d failed: %v", i+1, err)
+		}
+		clock.Advance(time.Second)
+	}
+	if _, err := sendPing(svc, "user-1", "attack"); !errors.Is(err, services.ErrPingRateLimited) {
+		t.Fatalf("error = %v, want ErrPingRateLimited", err)
+	}
+
+	// The limit is per player
+	if _, err := sendPing(svc, "user-2", "attack"); err != nil {
+		t.Errorf("teammate's ping failed: %v", err)
+	}
+
+	// And frees up once the minute has passed
+	clock.Advance(time.Minute)
+	if _, err := sendPing(svc, "user-1", "attack"); err != nil {
+		t.Errorf("ping after a minute failed: %v", err)
+	}
+}
+
+func TestSendPing_DroppedWhenLastSubscriberLeaves(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	svc := newPingService(clock)
+
+	ctx, cancel := context.WithCancel(ContextWithUserID("user-2"))
+	defer cancel()
+	stream := &fakeUpdateStream{ctx: ctx, updates: make(chan *v1.GameUpdate, 100)}
+	go svc.Subscribe(&v1.SubscribeRequest{GameId: pingGameId}, stream)
+	nextUpdate(t, stream)
+
+	for i := range services.MaxPingsPerMinute {
+		if _, err := sendPing(svc, "user-1", "attack"); err != nil {
+			t.Fatalf("ping %d failed: %v", i+1, err)
+		}
+	}
+	if _, err := sendPing(svc, "user-1", "attack"); !errors.Is(err, services.ErrPingRateLimited) {
+		t.Fatalf("error = %v, want ErrPingRateLimited", err)
+	}
+
+	// Once the only subscriber leaves the ping times are forgotten, so the
+	// rate limit no longer applies within the minute
+	cancel()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		_, err := sendPing(svc, "user-1", "attack")
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("still rate limited after the last subscriber left: %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// And only the ping sent since is kept for late teammates
+	if _, initial := subscribe(t, svc, "user-2"); len(initial.RecentPings) != 1 {
+		t.Errorf("late teammate got %d recent pings, want 1", len(initial.RecentPings))
+	}
+}
+
+func TestSendPing_Rejected(t *testing.T) {
+	svc := newPingService(nil)
+
+	if _, err := sendPing(svc, "user-1", "dance"); err == nil {
+		t.Error("expected an unknown ping type to be rejected")
+	}
+	if _, err := sendPing(svc, "outsider", "danger"); !errors.Is(err, authz.ErrNotPlayer) {
+		t.Errorf("error = %v, want ErrNotPlayer", err)
+	}
+
+	ffa := services.NewGameSyncService()
+	ffaGame := &v1.Game{Id: pingGameId, Config: &v1.GameConfiguration{
+		Settings: &v1.GameSettings{TeamMode: "ffa"},
+		Players:  pingTestGame.Config.Players,
+	}}
+	ffa.Seats = services.GameSeats(staticGames{ffaGame})
+	if _, err := sendPing(ffa, "user-1", "danger"); err == nil {
+		t.Error("expected pings to be rejected outside team games")
+	}
+}
@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/testfixtures"
+)
+
+// =============================================================================
+// Tests for threatened unit detection
+// =============================================================================
+
+func TestGetThreatenedUnits(t *testing.T) {
+	// A1 is pushed forward next to the enemy soldier, A2 is held back out of
+	// its reach
+	game := testfixtures.NewGameBuilder().
+		Terrain(testfixtures.Hexagon(6), lib.TileTypeGrass).
+		UnitWithShortcut(1, 0, 1, testfixtures.UnitTypeSoldier, "A1").
+		UnitWithShortcut(-6, 0, 1, testfixtures.UnitTypeSoldier, "A2").
+		UnitWithShortcut(3, 0, 2, testfixtures.UnitTypeSoldier, "B1").
+		Build()
+	forward := game.World.GetUnitByShortcut("A1")
+	rear := game.World.GetUnitByShortcut("A2")
+
+	threatened := game.GetThreatenedUnits(1)
+	if len(threatened) != 1 {
+		t.Fatalf("%d units threatened, want only A1", len(threatened))
+	}
+	damage, ok := threatened[forward]
+	if !ok {
+		t.Fatal("forward unit A1 was not flagged as threatened")
+	}
+	if damage <= 0 || damage > int(forward.AvailableHealth) {
+		t.Errorf("A1 worst-case damage = %d, want between 1 and its health %d", damage, forward.AvailableHealth)
+	}
+	if _, ok := threatened[rear]; ok {
+		t.Error("A2 out of the enemy's reach was flagged as threatened")
+	}
+
+	// Player 2's soldier is in reach of A1 in turn
+	if _, ok := game.GetThreatenedUnits(2)[game.World.GetUnitByShortcut("B1")]; !ok {
+		t.Error("B1 was not flagged as threatened by A1")
+	}
+}
+
+func TestGetThreatenedUnits_WorstCaseAddsUpThreats(t *testing.T) {
+	game := testfixtures.NewGameBuilder().
+		Terrain(testfixtures.Hexagon(4), lib.TileTypeGrass).
+		UnitWithShortcut(0, 0, 1, testfixtures.UnitTypeSoldier, "A1").
+		UnitWithShortcut(2, 0, 2, testfixtures.UnitTypeSoldier, "B1").
+		Build()
+	alone := game.World.GetUnitByShortcut("A1")
+	one := game.GetThreatenedUnits(1)[alone]
+	if threats := game.UnitThreats(alone); len(threats) != 1 {
+		t.Fatalf("A1 has %d threats, want 1", len(threats))
+	}
+
+	game = testfixtures.NewGameBuilder().
+		Terrain(testfixtures.Hexagon(4), lib.TileTypeGrass).
+		UnitWithShortcut(0, 0, 1, testfixtures.UnitTypeSoldier, "A1").
+		UnitWithShortcut(2, 0, 2, testfixtures.UnitTypeSoldier, "B1").
+		UnitWithShortcut(-2, 0, 2, testfixtures.UnitTypeSoldier, "B2").
+		Build()
+	two := game.GetThreatenedUnits(1)[game.World.GetUnitByShortcut("A1")]
+	if two <= one {
+		t.Errorf("worst-case damage from two soldiers = %d, want more than %d from one", two, one)
+	}
+}
@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// =============================================================================
+// Tests for thumbnails embedded in saved games
+// =============================================================================
+
+// savedThumbnail ends player 1's turn and returns the thumbnail in the saved
+// game's metadata JSON
+func savedThumbnail(t *testing.T, thumbnails bool) string {
+	t.Helper()
+	gamesDir := copyTestGame(t)
+	svc := fsbe.NewFSGamesService(gamesDir, nil)
+	svc.Thumbnails = thumbnails
+
+	// Tile and unit images are read relative to the repo root
+	t.Chdir("..")
+	if _, err := endTurnWithVersion(svc, services.ApiVersion, 1); err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(gamesDir, timeBankGameId, "metadata.json"))
+	if err != nil {
+		t.Fatalf("failed to read saved metadata: %v", err)
+	}
+	var metadata struct {
+		Thumbnail string `json:"thumbnail"`
+	}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		t.Fatalf("saved metadata is not JSON: %v", err)
+	}
+	return metadata.Thumbnail
+}
+
+func TestThumbnail_SavedGameEmbedsPNG(t *testing.T) {
+	thumbnail := savedThumbnail(t, true)
+	if thumbnail == "" {
+		t.Fatal("saved game has no thumbnail")
+	}
+	data, err := base64.StdEncoding.DecodeString(thumbnail)
+	if err != nil {
+		t.Fatalf("thumbnail is not base64: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("thumbnail is not a PNG: %v", err)
+	}
+	if size := img.Bounds().Size(); size.X != services.ThumbnailWidth || size.Y != services.ThumbnailHeight {
+		t.Errorf("thumbnail is %dx%d, want %dx%d", size.X, size.Y, services.ThumbnailWidth, services.ThumbnailHeight)
+	}
+}
+
+func TestThumbnail_OffByDefault(t *testing.T) {
+	if thumbnail := savedThumbnail(t, false); thumbnail != "" {
+		t.Errorf("saved game has a thumbnail of %d bytes without thumbnails on", len(thumbnail))
+	}
+}
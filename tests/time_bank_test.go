@@ -0,0 +1,290 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// =============================================================================
+// Tests for chess-clock style time banks
+// =============================================================================
+
+const timeBankGameId = "testgame"
+
+// newTimeBankService creates a games service over gamesDir using the given
+// clock. Creating a second service on the same dir simulates a restart.
+func newTimeBankService(gamesDir string, clock lib.Clock) *fsbe.FSGamesService {
+	svc := fsbe.NewFSGamesService(gamesDir, nil)
+	svc.Clock = clock
+	return svc
+}
+
+// copyTestGame copies the test game into a fresh temp games dir
+func copyTestGame(t *testing.T) string {
+	t.Helper()
+	gamesDir := t.TempDir()
+	gameDir := filepath.Join(gamesDir, timeBankGameId)
+	if err := os.MkdirAll(gameDir, 0755); err != nil {
+		t.Fatalf("failed to create game dir: %v", err)
+	}
+	for _, filename := range []string{"metadata.json", "state.json", "history.json"} {
+		data, err := os.ReadFile(filepath.Join("testgame", filename))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", filename, err)
+		}
+		if err := os.WriteFile(filepath.Join(gameDir, filename), data, 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", filename, err)
+		}
+	}
+	return gamesDir
+}
+
+// setupTimeBankGame copies the test game into a temp dir and enables time
+// banks on it, starting player 1's clock at the fake clock's current time
+func setupTimeBankGame(t *testing.T, clock lib.Clock, settings *v1.TimeBankSettings) string {
+	t.Helper()
+	ctx := context.Background()
+
+	gamesDir := copyTestGame(t)
+	svc := newTimeBankService(gamesDir, clock)
+	game, err := svc.LoadGame(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	game.Config.Settings.TimeBank = settings
+	if err := svc.SaveGame(ctx, timeBankGameId, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	state, err := svc.LoadGameState(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	svc.InitializePlayerStates(state, game.Config)
+	if err := svc.SaveGameState(ctx, timeBankGameId, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	return gamesDir
+}
+
+// remainingBanks fetches the game state and returns it with the remaining banks
+func remainingBanks(t *testing.T, svc *fsbe.FSGamesService) (*v1.GameState, map[int32]int64) {
+	t.Helper()
+	resp, err := svc.GetGameState(context.Background(), &v1.GetGameStateRequest{GameId: timeBankGameId})
+	if err != nil {
+		t.Fatalf("GetGameState failed: %v", err)
+	}
+	return resp.State, resp.RemainingTimeMs
+}
+
+func expectBanks(t *testing.T, got map[int32]int64, want1, want2 int64) {
+	t.Helper()
+	if got[1] != want1 || got[2] != want2 {
+		t.Errorf("banks = {1: %d, 2: %d}, want {1: %d, 2: %d}", got[1], got[2], want1, want2)
+	}
+}
+
+func endTurnRequest(player int32) *v1.ProcessMovesRequest {
+	return &v1.ProcessMovesRequest{
+		GameId: timeBankGameId,
+		Moves: []*v1.GameMove{
+			{Player: player, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}},
+		},
+	}
+}
+
+func TestTimeBank_SurvivesRestart(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	gamesDir := setupTimeBankGame(t, clock, &v1.TimeBankSettings{InitialSeconds: 300, IncrementSeconds: 10})
+
+	clock.Advance(100 * time.Second)
+
+	// Restart: a fresh service must charge only the elapsed time, once
+	svc := newTimeBankService(gamesDir, clock)
+	_, banks := remainingBanks(t, svc)
+	expectBanks(t, banks, 200_000, 300_000)
+	_, banks = remainingBanks(t, svc)
+	expectBanks(t, banks, 200_000, 300_000)
+
+	clock.Advance(50 * time.Second)
+	resp, err := svc.ProcessMoves(ContextWithUserID("test-user-1"), endTurnRequest(1))
+	if err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+
+	var playerChanged *v1.PlayerChangedChange
+	for _, change := range resp.Moves[0].Changes {
+		if pc := change.GetPlayerChanged(); pc != nil {
+			playerChanged = pc
+		}
+	}
+	if playerChanged == nil {
+		t.Fatal("expected a PlayerChanged change")
+	}
+	// 300s - 150s used + 10s increment
+	expectBanks(t, playerChanged.TimeBanksMs, 160_000, 300_000)
+	if !playerChanged.ClockStartedAt.AsTime().Equal(clock.Now()) {
+		t.Errorf("clock started at %v, want %v", playerChanged.ClockStartedAt.AsTime(), clock.Now())
+	}
+
+	clock.Advance(20 * time.Second)
+	svc = newTimeBankService(gamesDir, clock)
+	state, banks := remainingBanks(t, svc)
+	if state.CurrentPlayer != 2 {
+		t.Fatalf("current player = %d, want 2", state.CurrentPlayer)
+	}
+	expectBanks(t, banks, 160_000, 280_000)
+}
+
+func TestTimeBank_AutoEndTurnOnTimeout(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	gamesDir := setupTimeBankGame(t, clock, &v1.TimeBankSettings{InitialSeconds: 60, IncrementSeconds: 5})
+
+	// Late enough that player 2's bank would have run out too had their
+	// clock started when player 1's did
+	clock.Advance(130 * time.Second)
+	svc := newTimeBankService(gamesDir, clock)
+
+	// Player 1 is too late to move
+	_, err := svc.ProcessMoves(ContextWithUserID("test-user-1"), endTurnRequest(1))
+	if err == nil {
+		t.Fatal("expected ProcessMoves to fail after the time bank ran out")
+	}
+
+	state, banks := remainingBanks(t, svc)
+	if state.CurrentPlayer != 2 {
+		t.Fatalf("current player = %d, want 2 after timeout", state.CurrentPlayer)
+	}
+	// Player 2's clock started when the timeout was processed
+	expectBanks(t, banks, 5_000, 60_000)
+	if state.Finished {
+		t.Error("auto end-turn should not finish the game")
+	}
+}
+
+func TestTimeBank_SweeperEndsTurnOnTimeout(t *testing.T) {
+	ctx := context.Background()
+	clock := lib.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	gamesDir := setupTimeBankGame(t, clock, &v1.TimeBankSettings{InitialSeconds: 60, IncrementSeconds: 5})
+	svc := newTimeBankService(gamesDir, clock)
+	var published []int64
+	svc.OnMovesSaved = func(ctx context.Context, gameId string, moves []*v1.GameMove, groupNumber int64) {
+		published = append(published, groupNumber)
+	}
+
+	sweeper := services.NewTimeBankSweeper(svc)
+	sweeper.Clock = clock
+	if err := sweeper.Scan(ctx); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	clock.Advance(59 * time.Second)
+	sweeper.Sweep(ctx)
+	if len(published) != 0 {
+		t.Fatal("sweeper ended a turn before the time bank ran out")
+	}
+
+	// Nobody reads the game, the sweeper alone ends the turn and broadcasts it
+	clock.Advance(2 * time.Second)
+	sweeper.Sweep(ctx)
+	if len(published) != 1 {
+		t.Fatalf("broadcast %d move groups, want the timeout", len(published))
+	}
+	state, err := svc.LoadGameState(ctx, timeBankGameId)
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	if state.CurrentPlayer != 2 {
+		t.Fatalf("current player = %d, want 2 after timeout", state.CurrentPlayer)
+	}
+	if !state.ClockStartedAt.AsTime().Equal(clock.Now()) {
+		t.Errorf("player 2's clock started at %v, want %v", state.ClockStartedAt.AsTime(), clock.Now())
+	}
+
+	// Player 2's deadline is kept from the timeout
+	clock.Advance(60 * time.Second)
+	sweeper.Sweep(ctx)
+	if len(published) != 2 {
+		t.Fatalf("broadcast %d move groups, want player 2's timeout too", len(published))
+	}
+}
+
+func TestTimeBank_ForfeitOnTimeout(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	gamesDir := setupTimeBankGame(t, clock, &v1.TimeBankSettings{
+		InitialSeconds: 60,
+		OnTimeout:      v1.TimeoutAction_TIMEOUT_ACTION_FORFEIT,
+	})
+
+	clock.Advance(2 * time.Minute)
+	state, _ := remainingBanks(t, newTimeBankService(gamesDir, clock))
+
+	if state.PlayerStates[1].IsActive {
+		t.Error("player 1 should be inactive after forfeiting")
+	}
+	if !state.Finished || state.WinningPlayer != 2 {
+		t.Errorf("finished=%v winner=%d, want player 2 to win", state.Finished, state.WinningPlayer)
+	}
+	if state.ClockStartedAt != nil {
+		t.Error("clock should stop when the game ends")
+	}
+}
+
+func TestTimeBank_PauseByAgreement(t *testing.T) {
+	clock := lib.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	gamesDir := setupTimeBankGame(t, clock, &v1.TimeBankSettings{InitialSeconds: 300})
+	svc := newTimeBankService(gamesDir, clock)
+
+	pause := func(user string, player int32, paused bool) *v1.SetClockPausedResponse {
+		t.Helper()
+		resp, err := svc.SetClockPaused(ContextWithUserID(user), &v1.SetClockPausedRequest{
+			GameId:   timeBankGameId,
+			PlayerId: player,
+			Paused:   paused,
+		})
+		if err != nil {
+			t.Fatalf("SetClockPaused failed: %v", err)
+		}
+		return resp
+	}
+
+	if resp := pause("test-user-1", 1, true); resp.Paused {
+		t.Fatal("clock should not pause until every player agrees")
+	}
+	clock.Advance(10 * time.Second)
+	if resp := pause("test-user-2", 2, true); !resp.Paused {
+		t.Fatal("clock should pause once both players agree")
+	}
+
+	// A long pause across a restart costs nothing
+	clock.Advance(time.Hour)
+	svc = newTimeBankService(gamesDir, clock)
+	_, banks := remainingBanks(t, svc)
+	expectBanks(t, banks, 290_000, 300_000)
+
+	// Either player can resume
+	if resp := pause("test-user-2", 2, false); resp.Paused {
+		t.Fatal("clock should resume")
+	}
+	clock.Advance(5 * time.Second)
+	_, banks = remainingBanks(t, svc)
+	expectBanks(t, banks, 285_000, 300_000)
+
+	// Only a seat's own user can request a pause for it
+	_, err := svc.SetClockPaused(ContextWithUserID("test-user-1"), &v1.SetClockPausedRequest{
+		GameId:   timeBankGameId,
+		PlayerId: 2,
+		Paused:   true,
+	})
+	if err == nil {
+		t.Error("expected an error pausing on behalf of another player")
+	}
+}
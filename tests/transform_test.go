@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/testfixtures"
+)
+
+// =============================================================================
+// Tests for unit transformation
+// =============================================================================
+
+const (
+	soldierBasic    int32 = 1
+	soldierAdvanced int32 = 2
+	tankBasic       int32 = 3
+)
+
+// transformGame has a wounded basic soldier that can transform into an
+// advanced soldier with twice the maximum health
+func transformGame() *lib.Game {
+	rules := testfixtures.DefaultRules()
+	rules.GetUnits()[soldierBasic].TransformsTo = []int32{soldierAdvanced}
+	rules.GetUnits()[soldierAdvanced].Health = 20
+	return testfixtures.TwoSoldierDuel().
+		UnitFull(-1, 0, 1, soldierBasic, "A2", 5, 3, 0).
+		With(testfixtures.WithRules(rules)).
+		Build()
+}
+
+func TestTransformUnit(t *testing.T) {
+	game := transformGame()
+	// The soldier heals at the start of its turn before transforming
+	wounded := game.World.UnitAt(AxialCoord{Q: -1, R: 0})
+	if err := game.TopUpUnitIfNeeded(wounded); err != nil {
+		t.Fatalf("TopUpUnitIfNeeded failed: %v", err)
+	}
+	want := wounded.AvailableHealth * 2
+
+	changes, err := game.TransformUnit("A2", soldierAdvanced)
+	if err != nil {
+		t.Fatalf("TransformUnit failed: %v", err)
+	}
+
+	unit := game.World.UnitAt(AxialCoord{Q: -1, R: 0})
+	if unit == nil || unit.Shortcut != "A2" {
+		t.Fatalf("unit at -1,0 = %v, want A2 still there", unit)
+	}
+	if unit.UnitType != soldierAdvanced {
+		t.Errorf("unit type = %d, want %d", unit.UnitType, soldierAdvanced)
+	}
+	if unit.AvailableHealth != want {
+		t.Errorf("health = %d, want %d scaled to the new maximum 20", unit.AvailableHealth, want)
+	}
+	if unit.DistanceLeft != 0 || unit.LastActedTurn != game.TurnCounter {
+		t.Errorf("unit can still act after transforming (distance left %v)", unit.DistanceLeft)
+	}
+
+	// Replaying the changes gives the same unit
+	replay := transformGame()
+	if err := replay.ApplyChanges([]*v1.GameMove{{Changes: changes}}); err != nil {
+		t.Fatalf("ApplyChanges failed: %v", err)
+	}
+	replayed := replay.World.UnitAt(AxialCoord{Q: -1, R: 0})
+	if replayed.UnitType != soldierAdvanced || replayed.AvailableHealth != want {
+		t.Errorf("replayed unit is type %d with %d health, want type %d with %d",
+			replayed.UnitType, replayed.AvailableHealth, soldierAdvanced, want)
+	}
+}
+
+func TestTransformUnit_Rejected(t *testing.T) {
+	game := transformGame()
+
+	if _, err := game.TransformUnit("A2", tankBasic); err == nil {
+		t.Error("transforming into a type not in the table was accepted")
+	}
+	if _, err := game.TransformUnit("B1", soldierAdvanced); err == nil {
+		t.Error("transforming another player's unit was accepted")
+	}
+
+	if _, err := game.TransformUnit("A2", soldierAdvanced); err != nil {
+		t.Fatalf("TransformUnit failed: %v", err)
+	}
+	if _, err := game.TransformUnit("A1", soldierAdvanced); err != nil {
+		t.Fatalf("TransformUnit failed: %v", err)
+	}
+	unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	if unit.AvailableHealth != 20 {
+		t.Errorf("full health soldier has %d health after transforming, want 20", unit.AvailableHealth)
+	}
+	if _, err := game.TransformUnit("A1", soldierAdvanced); err == nil {
+		t.Error("an advanced soldier transformed again")
+	}
+}
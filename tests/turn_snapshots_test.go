@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Tests for rebuilding a game's state at a past turn
+// =============================================================================
+
+// TestGetStateAtTurn_FromSnapshots tests that each ended turn is snapshotted
+// and that past states are rebuilt from the snapshots
+func TestGetStateAtTurn_FromSnapshots(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	backend, err := server.StartLocalBackend(context.Background(), address, t.TempDir())
+	if err != nil {
+		t.Fatalf("StartLocalBackend failed: %v", err)
+	}
+	defer backend.Stop()
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	games := backend.ClientMgr.GetGamesSvcClient()
+	player1 := server.LocalContext(context.Background())
+	player2 := metadata.AppendToOutgoingContext(context.Background(), "x-user-id", "test2")
+
+	// Player 1 earns income from a base every turn
+	worldData := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for _, coord := range (lib.AxialCoord{}).Range(2) {
+		worldData.TilesMap[lib.CoordKeyFromAxial(coord)] = lib.NewTile(coord, lib.TileTypeGrass)
+	}
+	base := lib.NewTile(lib.AxialCoord{}, lib.TileTypeLandBase)
+	base.Player = 1
+	worldData.TilesMap[lib.CoordKeyFromAxial(lib.AxialCoord{})] = base
+	tankAt := lib.AxialCoord{Q: 1, R: 0}
+	worldData.UnitsMap[lib.CoordKeyFromAxial(tankAt)] = lib.NewUnit(int(UnitTypeTank), 2, tankAt)
+	world, err := worlds.CreateWorld(player1, &v1.CreateWorldRequest{World: &v1.World{Name: "Snapshots"}, WorldData: worldData})
+	if err != nil {
+		t.Fatalf("CreateWorld failed: %v", err)
+	}
+	created, err := games.CreateGame(player1, &v1.CreateGameRequest{Game: &v1.Game{
+		Name:    "Snapshots",
+		WorldId: world.World.Id,
+		Config: &v1.GameConfiguration{Players: []*v1.GamePlayer{
+			{PlayerId: 1, UserId: server.LocalUserID, PlayerType: "human"},
+			{PlayerId: 2, UserId: "test2", PlayerType: "human"},
+		}},
+	}})
+	if err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	gameId := created.Game.Id
+
+	coins := map[int32]int32{}
+	for turn := int32(1); turn <= 5; turn++ {
+		for _, ctx := range []context.Context{player1, player2} {
+			state, err := games.GetGameState(ctx, &v1.GetGameStateRequest{GameId: gameId})
+			if err != nil {
+				t.Fatalf("GetGameState failed: %v", err)
+			}
+			if state.State.CurrentPlayer == 1 {
+				coins[state.State.TurnCounter] = state.State.PlayerStates[1].Coins
+			}
+			if _, err := games.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: gameId, Moves: []*v1.GameMove{
+				{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}},
+			}}); err != nil {
+				t.Fatalf("ending turn %d failed: %v", turn, err)
+			}
+		}
+	}
+
+	// Player 1's turn in turn 3 was snapshotted when player 2 ended turn 2
+	resp, err := games.GetStateAtTurn(player1, &v1.GetStateAtTurnRequest{GameId: gameId, Turn: 3, Player: 1})
+	if err != nil {
+		t.Fatalf("GetStateAtTurn failed: %v", err)
+	}
+	if resp.SnapshotTurn != 3 || resp.SnapshotPlayer != 1 || resp.ReplayedMoves != 0 {
+		t.Errorf("rebuilt from turn %d player %d with %d moves, want the snapshot of turn 3 player 1 as is",
+			resp.SnapshotTurn, resp.SnapshotPlayer, resp.ReplayedMoves)
+	}
+	if resp.State.TurnCounter != 3 || resp.State.CurrentPlayer != 1 {
+		t.Errorf("state is at turn %d player %d, want turn 3 player 1", resp.State.TurnCounter, resp.State.CurrentPlayer)
+	}
+	if got := resp.State.PlayerStates[1].Coins; got != coins[3] {
+		t.Errorf("player 1 coins at turn 3 = %d, want %d", got, coins[3])
+	}
+
+	if _, err := games.GetStateAtTurn(player1, &v1.GetStateAtTurnRequest{GameId: gameId, Turn: 9}); status.Code(err) != codes.OutOfRange {
+		t.Errorf("GetStateAtTurn for a future turn returned %v, want OutOfRange", err)
+	}
+}
+
+// TestTurnSnapshots_GORM tests that the GORM backend saves and loads a game's
+// turn snapshots, and is skipped without LILBATTLE_TEST_DB_ENDPOINT
+func TestTurnSnapshots_GORM(t *testing.T) {
+	svc, gameId := newGORMTestGame(t)
+	ctx := context.Background()
+
+	loaded, err := svc.LoadTurnSnapshots(ctx, gameId)
+	if err != nil {
+		t.Fatalf("LoadTurnSnapshots failed: %v", err)
+	}
+	if len(loaded.Snapshots) != 0 {
+		t.Fatalf("a new game has %d snapshots, want none", len(loaded.Snapshots))
+	}
+
+	saved := &v1.TurnSnapshots{GameId: gameId, Snapshots: []*v1.TurnSnapshot{
+		{TurnCounter: 2, CurrentPlayer: 1, GroupNumber: 3, State: []byte("state")},
+	}}
+	for range 2 {
+		if err := svc.SaveTurnSnapshots(ctx, gameId, saved); err != nil {
+			t.Fatalf("SaveTurnSnapshots failed: %v", err)
+		}
+	}
+	loaded, err = svc.LoadTurnSnapshots(ctx, gameId)
+	if err != nil {
+		t.Fatalf("LoadTurnSnapshots failed: %v", err)
+	}
+	if !proto.Equal(loaded, saved) {
+		t.Errorf("loaded snapshots %v, want %v", loaded, saved)
+	}
+}
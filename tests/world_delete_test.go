@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"slices"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"github.com/turnforge/lilbattle/services/server"
+)
+
+// =============================================================================
+// Tests for deleting a world that games are still played on
+// =============================================================================
+
+func TestDeleteWorld_GameKeepsPlaying(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	ctx := server.LocalContext(context.Background())
+	backend, err := server.StartLocalBackend(context.Background(), address, t.TempDir())
+	if err != nil {
+		t.Fatalf("StartLocalBackend failed: %v", err)
+	}
+	defer backend.Stop()
+	seed, err := backend.SeedDemo(context.Background())
+	if err != nil {
+		t.Fatalf("SeedDemo failed: %v", err)
+	}
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	games := backend.ClientMgr.GetGamesSvcClient()
+	purger := backend.Services.Worlds.(*fsbe.FSWorldsService)
+	played, unplayed := seed.WorldIds[0], seed.WorldIds[1]
+
+	for _, id := range seed.WorldIds {
+		if _, err := worlds.DeleteWorld(ctx, &v1.DeleteWorldRequest{Id: id}); err != nil {
+			t.Fatalf("DeleteWorld %s failed: %v", id, err)
+		}
+	}
+	listed, err := worlds.ListWorlds(ctx, &v1.ListWorldsRequest{})
+	if err != nil {
+		t.Fatalf("ListWorlds failed: %v", err)
+	}
+	if len(listed.Items) != 0 {
+		t.Errorf("deleted worlds still listed: %v", listed.Items)
+	}
+	if _, err := games.CreateGame(ctx, &v1.CreateGameRequest{Game: &v1.Game{WorldId: played}}); err == nil {
+		t.Error("expected creating a game on a deleted world to fail")
+	}
+
+	// The world nobody plays on is purged, the demo game's world is kept
+	purged, err := purger.PurgeDeletedWorlds(ctx)
+	if err != nil {
+		t.Fatalf("PurgeDeletedWorlds failed: %v", err)
+	}
+	if !slices.Equal(purged, []string{unplayed}) {
+		t.Fatalf("purged = %v, want only %s", purged, unplayed)
+	}
+	if _, err := worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: played}); err != nil {
+		t.Errorf("GetWorld on the deleted but played world failed: %v", err)
+	}
+
+	// The game on the deleted world loads and can still be played
+	if _, err := games.GetGame(ctx, &v1.GetGameRequest{Id: seed.GameId}); err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	_, err = games.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+		GameId: seed.GameId,
+		Moves: []*v1.GameMove{{
+			MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Q: 0, R: 1},
+				To:   &v1.Position{Q: 1, R: 1},
+			}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+	resp, err := games.GetGame(ctx, &v1.GetGameRequest{Id: seed.GameId})
+	if err != nil {
+		t.Fatalf("GetGame after the move failed: %v", err)
+	}
+	if resp.State.WorldData.UnitsMap["1,1"] == nil {
+		t.Error("soldier did not move to 1,1")
+	}
+
+	// Once its last game is gone the world is purged too
+	if _, err := games.DeleteGame(ctx, &v1.DeleteGameRequest{Id: seed.GameId}); err != nil {
+		t.Fatalf("DeleteGame failed: %v", err)
+	}
+	if purged, err = purger.PurgeDeletedWorlds(ctx); err != nil {
+		t.Fatalf("PurgeDeletedWorlds failed: %v", err)
+	}
+	if !slices.Equal(purged, []string{played}) {
+		t.Errorf("purged = %v, want %s", purged, played)
+	}
+	if _, err := worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: played}); err == nil {
+		t.Error("expected the purged world to be gone")
+	}
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/server"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Tests for batch editing worlds
+// =============================================================================
+
+func paintEdit(q, r, tileType int32) *v1.WorldEdit {
+	return &v1.WorldEdit{Edit: &v1.WorldEdit_PaintTerrain{PaintTerrain: &v1.PaintTerrainEdit{Q: q, R: r, TileType: tileType}}}
+}
+
+func placeUnitEdit(q, r, unitType, player int32) *v1.WorldEdit {
+	return &v1.WorldEdit{Edit: &v1.WorldEdit_PlaceUnit{PlaceUnit: &v1.PlaceUnitEdit{Q: q, R: r, UnitType: unitType, Player: player}}}
+}
+
+func setOwnerEdit(q, r, player int32) *v1.WorldEdit {
+	return &v1.WorldEdit{Edit: &v1.WorldEdit_SetOwner{SetOwner: &v1.SetOwnerEdit{Q: q, R: r, Player: player}}}
+}
+
+func victoryPointsEdit(q, r, points int32) *v1.WorldEdit {
+	return &v1.WorldEdit{Edit: &v1.WorldEdit_SetVictoryPoints{SetVictoryPoints: &v1.SetVictoryPointsEdit{Q: q, R: r, Points: points}}}
+}
+
+// TestApplyWorldEdits_Validation tests each kind of edit follows the editor's
+// rules
+func TestApplyWorldEdits_Validation(t *testing.T) {
+	rules := lib.DefaultRulesEngine()
+	base, err := rules.ApplyWorldEdits(&v1.WorldData{}, []*v1.WorldEdit{
+		paintEdit(0, 0, TileTypeGrass),
+		paintEdit(1, 0, TileTypeLandBase),
+		setOwnerEdit(1, 0, 2),
+		victoryPointsEdit(1, 0, 3),
+	})
+	if err != nil {
+		t.Fatalf("ApplyWorldEdits failed: %v", err)
+	}
+
+	invalid := map[string]*v1.WorldEdit{
+		"unknown terrain":    paintEdit(0, 0, 9999),
+		"owning grass":       setOwnerEdit(0, 0, 1),
+		"owning no tile":     setOwnerEdit(5, 5, 1),
+		"unit off the map":   placeUnitEdit(5, 5, UnitTypeSoldierBasic, 1),
+		"unknown unit":       placeUnitEdit(0, 0, 9999, 1),
+		"neutral unit":       placeUnitEdit(0, 0, UnitTypeSoldierBasic, 0),
+		"too high a player":  placeUnitEdit(0, 0, UnitTypeSoldierBasic, lib.MaxWorldPlayers+1),
+		"marker off the map": victoryPointsEdit(5, 5, 1),
+		"negative points":    victoryPointsEdit(0, 0, -1),
+		"edit with no type":  {},
+	}
+	for name, edit := range invalid {
+		if _, err := rules.ApplyWorldEdits(base, []*v1.WorldEdit{edit}); err == nil {
+			t.Errorf("%s was accepted", name)
+		}
+	}
+
+	// Painting over a city with non-city terrain drops its owner
+	out, err := rules.ApplyWorldEdits(base, []*v1.WorldEdit{paintEdit(1, 0, TileTypeGrass)})
+	if err != nil {
+		t.Fatalf("ApplyWorldEdits failed: %v", err)
+	}
+	if tile := out.TilesMap["1,0"]; tile.TileType != TileTypeGrass || tile.Player != 0 {
+		t.Errorf("painted city = %v, want unowned grass", tile)
+	}
+	if base.TilesMap["1,0"].Player != 2 {
+		t.Error("ApplyWorldEdits changed the world data it was given")
+	}
+
+	// Changing a marker's owner keeps it a marker
+	out, err = rules.ApplyWorldEdits(base, []*v1.WorldEdit{setOwnerEdit(1, 0, 1)})
+	if err != nil {
+		t.Fatalf("ApplyWorldEdits failed: %v", err)
+	}
+	if tile := out.TilesMap["1,0"]; tile.Player != 1 || tile.VictoryPoints != 3 {
+		t.Errorf("recaptured marker = %v, want player 1's 3 point marker", tile)
+	}
+}
+
+// TestApplyWorldEdits_InvalidEditRejectsBatch tests a batch is saved with a
+// single version bump, and a batch with one invalid edit saves nothing
+func TestApplyWorldEdits_InvalidEditRejectsBatch(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	ctx := server.LocalContext(context.Background())
+	backend, err := server.StartLocalBackend(context.Background(), address, t.TempDir())
+	if err != nil {
+		t.Fatalf("StartLocalBackend failed: %v", err)
+	}
+	defer backend.Stop()
+	seed, err := backend.SeedDemo(context.Background())
+	if err != nil {
+		t.Fatalf("SeedDemo failed: %v", err)
+	}
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	worldId := seed.WorldIds[0]
+
+	before, err := worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: worldId})
+	if err != nil {
+		t.Fatalf("GetWorld failed: %v", err)
+	}
+
+	resp, err := worlds.ApplyWorldEdits(ctx, &v1.ApplyWorldEditsRequest{
+		WorldId: worldId,
+		Edits: []*v1.WorldEdit{
+			paintEdit(20, 20, TileTypeGrass),
+			paintEdit(21, 20, TileTypeGrass),
+			placeUnitEdit(20, 20, UnitTypeSoldierBasic, 1),
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyWorldEdits failed: %v", err)
+	}
+	if resp.Version != before.WorldData.Version+1 {
+		t.Errorf("version after one batch = %d, want %d", resp.Version, before.WorldData.Version+1)
+	}
+
+	tiles, err := worlds.GetWorldTiles(ctx, &v1.GetWorldTilesRequest{
+		WorldId: worldId,
+		Region:  &v1.HexRegion{MinQ: 20, MaxQ: 30, MinR: 20, MaxR: 30},
+	})
+	if err != nil {
+		t.Fatalf("GetWorldTiles failed: %v", err)
+	}
+	if len(tiles.Tiles) != 2 || len(tiles.Units) != 1 || tiles.Version != resp.Version {
+		t.Fatalf("region has %d tiles and %d units at version %d, want 2 and 1 at %d",
+			len(tiles.Tiles), len(tiles.Units), tiles.Version, resp.Version)
+	}
+
+	saved, err := worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: worldId})
+	if err != nil {
+		t.Fatalf("GetWorld failed: %v", err)
+	}
+
+	// The second edit places a unit on a hex with no tile
+	_, err = worlds.ApplyWorldEdits(ctx, &v1.ApplyWorldEditsRequest{
+		WorldId: worldId,
+		Edits: []*v1.WorldEdit{
+			paintEdit(22, 20, TileTypeGrass),
+			placeUnitEdit(23, 20, UnitTypeSoldierBasic, 1),
+			placeUnitEdit(21, 20, UnitTypeSoldierBasic, 2),
+		},
+	})
+	if err == nil {
+		t.Fatal("batch with an invalid edit was accepted")
+	}
+
+	after, err := worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: worldId})
+	if err != nil {
+		t.Fatalf("GetWorld failed: %v", err)
+	}
+	if after.WorldData.Version != saved.WorldData.Version {
+		t.Errorf("rejected batch bumped the version from %d to %d", saved.WorldData.Version, after.WorldData.Version)
+	}
+	if !proto.Equal(&v1.WorldData{TilesMap: after.WorldData.TilesMap, UnitsMap: after.WorldData.UnitsMap},
+		&v1.WorldData{TilesMap: saved.WorldData.TilesMap, UnitsMap: saved.WorldData.UnitsMap}) {
+		t.Error("rejected batch changed the world's tiles or units")
+	}
+}
@@ -0,0 +1,245 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/server"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Tests for world difficulty ratings
+// =============================================================================
+
+// trivialWorld has three player 1 tanks surrounding a nearly dead player 2
+// soldier, so player 1 wins on the first turn.
+func trivialWorld() *v1.WorldData {
+	worldData := &v1.WorldData{
+		TilesMap: map[string]*v1.Tile{},
+		UnitsMap: map[string]*v1.Unit{},
+	}
+	for q := int32(-2); q <= 2; q++ {
+		for r := int32(-2); r <= 2; r++ {
+			worldData.TilesMap[lib.CoordKey(q, r)] = &v1.Tile{Q: q, R: r, TileType: TileTypeGrass}
+		}
+	}
+	addUnit := func(q, r, player, unitType, health int32) {
+		worldData.UnitsMap[lib.CoordKey(q, r)] = &v1.Unit{Q: q, R: r, Player: player, UnitType: unitType, AvailableHealth: health}
+	}
+	addUnit(0, 0, 2, UnitTypeSoldierBasic, 1)
+	addUnit(1, 0, 1, UnitTypeTank, 10)
+	addUnit(-1, 0, 1, UnitTypeTank, 10)
+	addUnit(0, 1, 1, UnitTypeTank, 10)
+	return worldData
+}
+
+func rateTrivialWorld(t *testing.T, humanPlayer int32, rulesEngine *lib.RulesEngine) *v1.WorldRating {
+	t.Helper()
+	rating, err := lib.RateWorld(trivialWorld(), humanPlayer, rulesEngine, lib.SimulationConfig{
+		Simulations: 5,
+		MaxTurns:    20,
+		Seed:        1,
+	})
+	if err != nil {
+		t.Fatalf("RateWorld failed: %v", err)
+	}
+	return rating
+}
+
+func TestRateWorld_TriviallyWinnable(t *testing.T) {
+	rating := rateTrivialWorld(t, 1, lib.DefaultRulesEngine())
+
+	if rating.Simulations != 5 {
+		t.Errorf("simulations = %d, want 5", rating.Simulations)
+	}
+	if rating.HumanWinRate != 1 || rating.AiWinRate != 0 {
+		t.Errorf("human wins %.2f, AI wins %.2f, want the human side to always win", rating.HumanWinRate, rating.AiWinRate)
+	}
+	if rating.AverageTurns != 1 {
+		t.Errorf("average turns = %.2f, want 1", rating.AverageTurns)
+	}
+	if rating.Label != "Easy (AI wins 0%)" {
+		t.Errorf("label = %q, want %q", rating.Label, "Easy (AI wins 0%)")
+	}
+
+	// From the other side the same map is hopeless
+	rating = rateTrivialWorld(t, 2, lib.DefaultRulesEngine())
+	if rating.Label != "Very Hard (AI wins 100%)" {
+		t.Errorf("label = %q, want %q", rating.Label, "Very Hard (AI wins 100%)")
+	}
+}
+
+func TestRateWorld_UnknownPlayer(t *testing.T) {
+	_, err := lib.RateWorld(trivialWorld(), 3, lib.DefaultRulesEngine(), lib.SimulationConfig{Simulations: 1, MaxTurns: 5})
+	if err == nil {
+		t.Error("expected an error rating for a player not in the world")
+	}
+}
+
+func TestRunSimulations_BeforeTurn(t *testing.T) {
+	// Triggers run before each turn and can change the outcome
+	var calls int
+	ambush := func(g *lib.Game) error {
+		calls++
+		for _, unit := range slices.Clone(g.World.GetPlayerUnits(1)) {
+			if err := g.World.RemoveUnit(unit); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	results, err := lib.RunSimulations(trivialWorld(), lib.DefaultRulesEngine(), lib.SimulationConfig{
+		Simulations: 1,
+		MaxTurns:    3,
+		Seed:        1,
+		BeforeTurn:  ambush,
+	})
+	if err != nil {
+		t.Fatalf("RunSimulations failed: %v", err)
+	}
+	if calls == 0 {
+		t.Fatal("BeforeTurn was never called")
+	}
+	if results[0].Winner != 2 {
+		t.Errorf("winner = %d, want player 2 after the ambush", results[0].Winner)
+	}
+
+	_, err = lib.RunSimulations(trivialWorld(), lib.DefaultRulesEngine(), lib.SimulationConfig{
+		Simulations: 1,
+		MaxTurns:    3,
+		BeforeTurn:  func(g *lib.Game) error { return fmt.Errorf("trigger failed") },
+	})
+	if err == nil || !strings.Contains(err.Error(), "trigger failed") {
+		t.Errorf("error = %v, want the trigger's error", err)
+	}
+}
+
+func TestWorldRatingInvalidation(t *testing.T) {
+	rulesEngine, err := LoadRulesEngineFromFile(RULES_DATA_FILE, DAMAGE_DATA_FILE)
+	if err != nil {
+		t.Fatalf("Failed to load rules engine: %v", err)
+	}
+	rating := rateTrivialWorld(t, 1, rulesEngine)
+
+	if !lib.IsWorldRatingCurrent(rating, trivialWorld().Version, rulesEngine) {
+		t.Fatal("fresh rating should be current")
+	}
+
+	if lib.IsWorldRatingCurrent(rating, trivialWorld().Version+1, rulesEngine) {
+		t.Error("rating should be stale after the world data changes")
+	}
+
+	staleAI := proto.Clone(rating).(*v1.WorldRating)
+	staleAI.AiVersion = "baseline-0"
+	if lib.IsWorldRatingCurrent(staleAI, 0, rulesEngine) {
+		t.Error("rating from an older AI should be stale")
+	}
+
+	// Rules change by installing new tables, as a reload does
+	changed := proto.Clone(rulesEngine.Rules()).(*v1.RulesEngine)
+	changed.Units[UnitTypeTank].Coins++
+	if lib.IsWorldRatingCurrent(rating, 0, lib.NewRulesEngineFrom(changed)) {
+		t.Error("rating should be stale after the rules change")
+	}
+
+	if lib.IsWorldRatingCurrent(nil, 0, rulesEngine) {
+		t.Error("missing rating should not be current")
+	}
+}
+
+func TestValidateWorldRating(t *testing.T) {
+	rating := rateTrivialWorld(t, 1, lib.DefaultRulesEngine())
+	if err := lib.ValidateWorldRating(rating); err != nil {
+		t.Fatalf("computed rating should be valid: %v", err)
+	}
+
+	cases := map[string]func(r *v1.WorldRating){
+		"label":       func(r *v1.WorldRating) { r.Label = "Hard (AI wins 70%)" },
+		"difficulty":  func(r *v1.WorldRating) { r.Difficulty = "Very Hard" },
+		"rates":       func(r *v1.WorldRating) { r.HumanWinRate, r.AiWinRate = 0.8, 0.8 },
+		"simulations": func(r *v1.WorldRating) { r.Simulations = 0 },
+	}
+	for name, tamper := range cases {
+		forged := proto.Clone(rating).(*v1.WorldRating)
+		tamper(forged)
+		if err := lib.ValidateWorldRating(forged); err == nil {
+			t.Errorf("%s: forged rating should be rejected", name)
+		}
+	}
+}
+
+// TestUpdateWorld_Rating tests the worlds service only stores ratings that
+// match the stored world data, and drops them when the data changes
+func TestUpdateWorld_Rating(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	ctx := server.LocalContext(context.Background())
+	backend, err := server.StartLocalBackend(context.Background(), address, t.TempDir())
+	if err != nil {
+		t.Fatalf("StartLocalBackend failed: %v", err)
+	}
+	defer backend.Stop()
+	seed, err := backend.SeedDemo(context.Background())
+	if err != nil {
+		t.Fatalf("SeedDemo failed: %v", err)
+	}
+	worlds := backend.ClientMgr.GetWorldsSvcClient()
+	worldId := seed.WorldIds[0]
+
+	world, err := worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: worldId})
+	if err != nil {
+		t.Fatalf("GetWorld failed: %v", err)
+	}
+	rating, err := lib.RateWorld(world.WorldData, 1, lib.DefaultRulesEngine(), lib.SimulationConfig{
+		Simulations: 1,
+		MaxTurns:    2,
+		Seed:        1,
+	})
+	if err != nil {
+		t.Fatalf("RateWorld failed: %v", err)
+	}
+
+	forged := proto.Clone(rating).(*v1.WorldRating)
+	forged.Label = "Easy (AI wins 0%)"
+	forged.Difficulty = "Easy"
+	forged.AiWinRate, forged.HumanWinRate = 0.9, 0.1
+	if _, err := worlds.UpdateWorld(ctx, &v1.UpdateWorldRequest{World: &v1.World{Id: worldId, Rating: forged}}); err == nil {
+		t.Error("rating whose label does not match its rates was accepted")
+	}
+
+	stale := proto.Clone(rating).(*v1.WorldRating)
+	stale.WorldDataVersion++
+	if _, err := worlds.UpdateWorld(ctx, &v1.UpdateWorldRequest{World: &v1.World{Id: worldId, Rating: stale}}); err == nil {
+		t.Error("rating of another world data version was accepted")
+	}
+
+	if _, err := worlds.UpdateWorld(ctx, &v1.UpdateWorldRequest{World: &v1.World{Id: worldId, Rating: rating}}); err != nil {
+		t.Fatalf("UpdateWorld with a computed rating failed: %v", err)
+	}
+
+	if _, err := worlds.ApplyWorldEdits(ctx, &v1.ApplyWorldEditsRequest{
+		WorldId: worldId,
+		Edits:   []*v1.WorldEdit{{Edit: &v1.WorldEdit_PaintTerrain{PaintTerrain: &v1.PaintTerrainEdit{Q: 20, R: 20, TileType: TileTypeGrass}}}},
+	}); err != nil {
+		t.Fatalf("ApplyWorldEdits failed: %v", err)
+	}
+	edited, err := worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: worldId})
+	if err != nil {
+		t.Fatalf("GetWorld failed: %v", err)
+	}
+	if edited.World.Rating != nil {
+		t.Errorf("rating = %v after the world data changed, want none", edited.World.Rating)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 )
 
 // Helper function to create a test world with units and tiles
@@ -347,3 +348,17 @@ func TestWorldMoveUnitNoDuplication(t *testing.T) {
 		t.Error("Unit not found at new position")
 	}
 }
+
+func TestComputeWorldBoundsEmptyMap(t *testing.T) {
+	bounds := lib.ComputeWorldBounds(nil, nil, nil)
+	if bounds != (lib.WorldBounds{}) {
+		t.Errorf("empty map bounds = %+v, want zeroed bounds", bounds)
+	}
+
+	// A single tile is exactly one tile in size
+	opts := lib.DefaultRenderOptions()
+	bounds = lib.ComputeWorldBounds(map[string]*v1.Tile{"0,0": createTestTile(0, 0, 1)}, nil, opts)
+	if bounds.Width != opts.TileWidth || bounds.Height != opts.TileHeight {
+		t.Errorf("single tile bounds = %dx%d, want %dx%d", bounds.Width, bounds.Height, opts.TileWidth, opts.TileHeight)
+	}
+}
@@ -0,0 +1,61 @@
+package themes_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// TestPNGRenderer_HealthBarBands tests health bars take the band color for
+// the unit's health relative to its type's max health
+func TestPNGRenderer_HealthBarBands(t *testing.T) {
+	theme, err := themes.CreateTheme("default", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme failed: %v", err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer failed: %v", err)
+	}
+	style := lib.DefaultHealthBarStyle()
+	green, yellow, red := style.Bands[0].Color, style.Bands[1].Color, style.Bands[2].Color
+
+	tests := []struct {
+		name      string
+		health    int32
+		maxHealth int32
+		want      color.RGBA
+	}{
+		{"80%", 8, 10, green},
+		{"40%", 4, 10, yellow},
+		{"10%", 1, 10, red},
+		{"80% of a tougher unit", 16, 20, green},
+	}
+	for _, tt := range tests {
+		opts := lib.DefaultRenderOptions()
+		opts.ShowHealthBars = true
+		opts.UnitMaxHealth = map[int32]int32{1: tt.maxHealth}
+		tiles := map[string]*v1.Tile{"0,0": {Q: 0, R: 0, TileType: 5}}
+		units := map[string]*v1.Unit{"0,0": {Q: 0, R: 0, UnitType: 1, Player: 1, AvailableHealth: tt.health}}
+		data, _, err := renderer.Render(tiles, units, opts)
+		if err != nil {
+			t.Fatalf("%s: Render failed: %v", tt.name, err)
+		}
+		img, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("%s: failed to decode PNG: %v", tt.name, err)
+		}
+
+		bounds := lib.ComputeWorldBounds(tiles, units, opts)
+		bar := themes.HealthBarBounds(opts, style).Add(image.Point{X: -bounds.MinX, Y: -bounds.MinY})
+		if got := color.RGBAModel.Convert(img.At(bar.Min.X, bar.Min.Y)).(color.RGBA); got != tt.want {
+			t.Errorf("%s: bar color = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package themes
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"golang.org/x/image/font/basicfont"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// Heatmap legend layout, in pixels
+const (
+	heatmapLegendHeight = 28
+	heatmapLegendMargin = 6
+	heatmapLegendBarMax = 160
+)
+
+// HeatmapColor tints a hex with the given activity intensity, from a faint
+// yellow for little activity to a strong red for the busiest hexes
+func HeatmapColor(intensity float64) color.NRGBA {
+	intensity = min(max(intensity, 0), 1)
+	return color.NRGBA{
+		R: 255,
+		G: uint8(220 * (1 - intensity)),
+		B: 0,
+		A: uint8(64 + 160*intensity),
+	}
+}
+
+// RenderHeatmap renders the tiles with each hex tinted by its intensity, 0
+// to 1, and a legend strip below the map.  Hexes absent from intensities, or
+// at 0, are left untinted.
+func (r *PNGWorldRenderer) RenderHeatmap(tiles map[string]*v1.Tile, intensities map[lib.AxialCoord]float64, options *lib.RenderOptions) ([]byte, error) {
+	if options == nil {
+		options = lib.DefaultRenderOptions()
+	}
+	tinted := *options
+	tinted.TileTints = map[lib.AxialCoord]color.NRGBA{}
+	for coord, intensity := range intensities {
+		if intensity > 0 {
+			tinted.TileTints[coord] = HeatmapColor(intensity)
+		}
+	}
+
+	mapImg, err := r.RenderImage(tiles, nil, &tinted)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := mapImg.Bounds()
+	output := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()+heatmapLegendHeight))
+	draw.Draw(output, bounds, mapImg, image.Point{}, draw.Src)
+	r.renderHeatmapLegend(output, bounds.Dy())
+	return encodePNG(output)
+}
+
+// renderHeatmapLegend draws the color scale from low to high activity in
+// the strip starting at top
+func (r *PNGWorldRenderer) renderHeatmapLegend(output *image.RGBA, top int) {
+	width := output.Bounds().Dx()
+	strip := image.Rect(0, top, width, top+heatmapLegendHeight)
+	draw.Draw(output, strip, &image.Uniform{color.White}, image.Point{}, draw.Src)
+
+	face := basicfont.Face7x13
+	textY := top + heatmapLegendHeight/2 + 4
+	lowX := heatmapLegendMargin
+	r.drawText(output, "low", lowX, textY, color.Black, face)
+
+	barX := lowX + 3*7 + heatmapLegendMargin
+	barWidth := min(heatmapLegendBarMax, width-barX-4*7-2*heatmapLegendMargin)
+	if barWidth <= 0 {
+		return
+	}
+	barTop := top + heatmapLegendMargin
+	barBottom := top + heatmapLegendHeight - heatmapLegendMargin
+	for x := 0; x < barWidth; x++ {
+		col := HeatmapColor(float64(x) / float64(max(barWidth-1, 1)))
+		draw.Draw(output, image.Rect(barX+x, barTop, barX+x+1, barBottom), &image.Uniform{col}, image.Point{}, draw.Over)
+	}
+	r.drawText(output, "high", barX+barWidth+heatmapLegendMargin, textY, color.Black, face)
+}
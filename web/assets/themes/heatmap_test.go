@@ -0,0 +1,53 @@
+package themes_test
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+func TestPNGRenderer_RenderHeatmap(t *testing.T) {
+	useRepoAssets(t)
+	theme, err := themes.CreateTheme("default", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme failed: %v", err)
+	}
+	renderer, err := themes.NewPNGWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("NewPNGWorldRenderer failed: %v", err)
+	}
+
+	hot := lib.AxialCoord{Q: 1, R: 0}
+	opts := lib.DefaultRenderOptions()
+	data, err := renderer.RenderHeatmap(highlightTiles(), map[lib.AxialCoord]float64{hot: 1}, opts)
+	if err != nil {
+		t.Fatalf("RenderHeatmap failed: %v", err)
+	}
+	heat, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to decode PNG: %v", err)
+	}
+	plain := renderPNG(t, opts)
+
+	if got, want := heat.Bounds().Dy(), plain.Bounds().Dy(); got <= want {
+		t.Errorf("heatmap is %d pixels high, want a legend below the %d pixel map", got, want)
+	}
+	x, y := tileCenter(hot, opts)
+	if plain.At(x, y) == heat.At(x, y) {
+		t.Errorf("hot tile center (%d,%d) was not tinted", x, y)
+	}
+	x, y = tileCenter(lib.AxialCoord{Q: 0, R: 0}, opts)
+	if plain.At(x, y) != heat.At(x, y) {
+		t.Errorf("cold tile center (%d,%d) was tinted", x, y)
+	}
+}
+
+func TestHeatmapColor(t *testing.T) {
+	low, high := themes.HeatmapColor(0.1), themes.HeatmapColor(1)
+	if high.A <= low.A || high.G >= low.G {
+		t.Errorf("HeatmapColor(1) = %v is not hotter than HeatmapColor(0.1) = %v", high, low)
+	}
+}
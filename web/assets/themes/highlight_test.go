@@ -0,0 +1,336 @@
+package themes_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+func highlightTiles() map[string]*v1.Tile {
+	return map[string]*v1.Tile{
+		"0,0": {Q: 0, R: 0, TileType: 5},
+		"1,0": {Q: 1, R: 0, TileType: 5},
+		"0,1": {Q: 0, R: 1, TileType: 5},
+	}
+}
+
+func renderPNG(t *testing.T, opts *lib.RenderOptions) image.Image {
+	t.Helper()
+	theme, err := themes.CreateTheme("default", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme failed: %v", err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer failed: %v", err)
+	}
+	data, _, err := renderer.Render(highlightTiles(), nil, opts)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to decode PNG: %v", err)
+	}
+	return img
+}
+
+// tileCenter returns the pixel at the center of a tile in the rendered image
+func tileCenter(coord lib.AxialCoord, opts *lib.RenderOptions) (int, int) {
+	bounds := lib.ComputeWorldBounds(highlightTiles(), nil, opts)
+	x, y := lib.HexToPixel(coord, opts)
+	return x - bounds.MinX + opts.TileWidth/2, y - bounds.MinY + opts.TileHeight/2
+}
+
+func TestPNGRenderer_HoverHighlight(t *testing.T) {
+	plain := renderPNG(t, lib.DefaultRenderOptions())
+
+	hover := lib.AxialCoord{Q: 1, R: 0}
+	opts := lib.DefaultRenderOptions()
+	opts.HoverCoord = &hover
+	highlighted := renderPNG(t, opts)
+
+	x, y := tileCenter(hover, opts)
+	if plain.At(x, y) == highlighted.At(x, y) {
+		t.Errorf("hovered tile center (%d,%d) was not highlighted", x, y)
+	}
+
+	// Other tiles, and the hovered tile's corners outside the hex, are untouched
+	x, y = tileCenter(lib.AxialCoord{Q: 0, R: 0}, opts)
+	if plain.At(x, y) != highlighted.At(x, y) {
+		t.Errorf("tile center (%d,%d) was highlighted without being hovered", x, y)
+	}
+	hx, hy := lib.HexToPixel(hover, opts)
+	bounds := lib.ComputeWorldBounds(highlightTiles(), nil, opts)
+	cornerX, cornerY := hx-bounds.MinX+1, hy-bounds.MinY+1
+	if plain.At(cornerX, cornerY) != highlighted.At(cornerX, cornerY) {
+		t.Errorf("pixel (%d,%d) outside the hovered hex was highlighted", cornerX, cornerY)
+	}
+}
+
+func TestPNGRenderer_SelectedHighlight(t *testing.T) {
+	selected := lib.AxialCoord{Q: 0, R: 1}
+	hover := lib.AxialCoord{Q: 1, R: 0}
+	opts := lib.DefaultRenderOptions()
+	opts.SelectedCoord = &selected
+	opts.HoverCoord = &hover
+	both := renderPNG(t, opts)
+
+	opts.HoverCoord = nil
+	selectedOnly := renderPNG(t, opts)
+
+	x, y := tileCenter(selected, opts)
+	if both.At(x, y) != selectedOnly.At(x, y) {
+		t.Errorf("selected tile center (%d,%d) changed with the hover elsewhere", x, y)
+	}
+	x, y = tileCenter(hover, opts)
+	if both.At(x, y) == selectedOnly.At(x, y) {
+		t.Errorf("hovered tile center (%d,%d) was not highlighted", x, y)
+	}
+}
+
+func TestSVGRenderer_Highlights(t *testing.T) {
+	theme, err := themes.CreateTheme("fantasy", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme failed: %v", err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer failed: %v", err)
+	}
+
+	hover := lib.AxialCoord{Q: 1, R: 0}
+	opts := lib.DefaultRenderOptions()
+	opts.HoverCoord = &hover
+	data, _, err := renderer.Render(highlightTiles(), nil, opts)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	// (1,0) sits one tile right of (0,0), the left edge of the world
+	want := `<polygon class="highlight-hover" points="96,0 128,16 128,48 96,64 64,48 64,16"`
+	if !strings.Contains(string(data), want) {
+		t.Errorf("SVG missing hover highlight %s:\n%s", want, data)
+	}
+	if strings.Contains(string(data), "highlight-selected") {
+		t.Error("SVG has a selection highlight without a selected tile")
+	}
+}
+
+func TestSVGRenderer_FlatTopHighlight(t *testing.T) {
+	theme, err := themes.CreateTheme("fantasy", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme failed: %v", err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer failed: %v", err)
+	}
+
+	hover := lib.AxialCoord{Q: 1, R: 0}
+	opts := lib.DefaultRenderOptions()
+	opts.Orientation = lib.GetOrientation(lib.OrientationFlatTop)
+	opts.HoverCoord = &hover
+	data, _, err := renderer.Render(highlightTiles(), nil, opts)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	// (1,0) sits one column right of and half a tile below (0,0), with flat
+	// top and bottom edges and points on the left and right
+	want := `<polygon class="highlight-hover" points="64,32 96,32 112,64 96,96 64,96 48,64"`
+	if !strings.Contains(string(data), want) {
+		t.Errorf("SVG missing flat-top hover highlight %s:\n%s", want, data)
+	}
+}
+
+// useRepoAssets runs the test from the repo root, where the renderers find
+// the theme assets
+func useRepoAssets(t *testing.T) {
+	t.Chdir("../../..")
+}
+
+func TestPNGRenderer_PreviewTiles(t *testing.T) {
+	useRepoAssets(t)
+	plain := renderPNG(t, lib.DefaultRenderOptions())
+
+	// A size 0 brush painting water over (1,0)
+	world := lib.NewWorld("preview", &v1.WorldData{TilesMap: highlightTiles()})
+	opts := lib.DefaultRenderOptions()
+	opts.PreviewTiles = lib.BrushPreview(world, lib.AxialCoord{Q: 1, R: 0}, lib.BrushModeBrush, 0, 10, 0)
+	previewed := renderPNG(t, opts)
+
+	x, y := tileCenter(lib.AxialCoord{Q: 1, R: 0}, opts)
+	if plain.At(x, y) == previewed.At(x, y) {
+		t.Errorf("previewed tile center (%d,%d) unchanged", x, y)
+	}
+	x, y = tileCenter(lib.AxialCoord{Q: 0, R: 0}, opts)
+	if plain.At(x, y) != previewed.At(x, y) {
+		t.Errorf("tile center (%d,%d) outside the preview changed", x, y)
+	}
+}
+
+func TestSVGRenderer_PreviewTiles(t *testing.T) {
+	useRepoAssets(t)
+	theme, err := themes.CreateTheme("fantasy", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme failed: %v", err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer failed: %v", err)
+	}
+
+	world := lib.NewWorld("preview", &v1.WorldData{TilesMap: highlightTiles()})
+	opts := lib.DefaultRenderOptions()
+	opts.PreviewTiles = lib.BrushPreview(world, lib.AxialCoord{Q: 0, R: 0}, lib.BrushModeBrush, 2, 5, 0)
+	data, _, err := renderer.Render(highlightTiles(), nil, opts)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	if got := strings.Count(string(data), `<use class="preview"`); got != 19 {
+		t.Errorf("SVG has %d preview tiles, want 19 for a size 2 brush", got)
+	}
+	if !strings.Contains(string(data), `opacity="0.50"`) {
+		t.Error("preview tiles are not drawn semi-transparently")
+	}
+}
+
+func TestPNGRenderer_FlatTopOrientation(t *testing.T) {
+	useRepoAssets(t)
+	pointy := lib.DefaultRenderOptions()
+	flat := lib.DefaultRenderOptions()
+	flat.Orientation = lib.GetOrientation(lib.OrientationFlatTop)
+
+	pointyImg := renderPNG(t, pointy)
+	flatImg := renderPNG(t, flat)
+	for _, tc := range []struct {
+		img  image.Image
+		opts *lib.RenderOptions
+	}{{pointyImg, pointy}, {flatImg, flat}} {
+		bounds := lib.ComputeWorldBounds(highlightTiles(), nil, tc.opts)
+		size := tc.img.Bounds().Size()
+		if size.X != bounds.Width || size.Y != bounds.Height {
+			t.Errorf("%s: image is %dx%d, bounds are %dx%d", tc.opts.HexOrientation().Name, size.X, size.Y, bounds.Width, bounds.Height)
+		}
+	}
+	if pointyImg.Bounds() == flatImg.Bounds() {
+		t.Errorf("pointy-top and flat-top images are both %v", pointyImg.Bounds())
+	}
+
+	// Hovering highlights the flat-top hex under its center, not its tile box corner
+	hover := lib.AxialCoord{Q: 1, R: 0}
+	flat.HoverCoord = &hover
+	highlighted := renderPNG(t, flat)
+	x, y := tileCenter(hover, flat)
+	if flatImg.At(x, y) == highlighted.At(x, y) {
+		t.Errorf("hovered tile center (%d,%d) was not highlighted", x, y)
+	}
+	hx, hy := lib.HexToPixel(hover, flat)
+	bounds := lib.ComputeWorldBounds(highlightTiles(), nil, flat)
+	cornerX, cornerY := hx-bounds.MinX+1, hy-bounds.MinY+1
+	if flatImg.At(cornerX, cornerY) != highlighted.At(cornerX, cornerY) {
+		t.Errorf("pixel (%d,%d) outside the hovered flat-top hex was highlighted", cornerX, cornerY)
+	}
+}
+
+func TestPNGRenderer_GridAndCoords(t *testing.T) {
+	useRepoAssets(t)
+	plain := renderPNG(t, lib.DefaultRenderOptions())
+
+	opts := lib.DefaultRenderOptions()
+	opts.ShowGrid = true
+	gridded := renderPNG(t, opts)
+
+	// The top corner of (0,0)'s pointy-top hex lies on its outline
+	bounds := lib.ComputeWorldBounds(highlightTiles(), nil, opts)
+	x, y := lib.HexToPixel(lib.AxialCoord{Q: 0, R: 0}, opts)
+	top := lib.PointyTop.HexCorners(x-bounds.MinX, y-bounds.MinY, opts)[0]
+	if plain.At(top[0], top[1]) == gridded.At(top[0], top[1]) {
+		t.Errorf("hex corner (%d,%d) was not outlined", top[0], top[1])
+	}
+
+	opts = lib.DefaultRenderOptions()
+	opts.ShowCoords = true
+	labelled := renderPNG(t, opts)
+	if sameImage(plain, labelled) {
+		t.Error("coordinate labels were not drawn")
+	}
+}
+
+func sameImage(a, b image.Image) bool {
+	if a.Bounds() != b.Bounds() {
+		return false
+	}
+	for y := a.Bounds().Min.Y; y < a.Bounds().Max.Y; y++ {
+		for x := a.Bounds().Min.X; x < a.Bounds().Max.X; x++ {
+			if a.At(x, y) != b.At(x, y) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestPNGRenderer_RenderToFit(t *testing.T) {
+	useRepoAssets(t)
+	theme, err := themes.CreateTheme("default", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme failed: %v", err)
+	}
+	renderer, err := themes.NewPNGWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("NewPNGWorldRenderer failed: %v", err)
+	}
+
+	for _, crop := range []bool{false, true} {
+		data, err := renderer.RenderToFit(highlightTiles(), nil, nil, 400, 100, crop)
+		if err != nil {
+			t.Fatalf("RenderToFit(crop=%v) failed: %v", crop, err)
+		}
+		img, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("failed to decode PNG: %v", err)
+		}
+		size := img.Bounds().Size()
+		if !crop && (size.X != 400 || size.Y != 100) {
+			t.Errorf("uncropped image is %dx%d, want 400x100", size.X, size.Y)
+		}
+		if crop && (size.X > 400 || size.Y > 100 || size.Y < 90) {
+			t.Errorf("cropped image is %dx%d, want the map scaled to fit 400x100", size.X, size.Y)
+		}
+	}
+}
+
+func TestSVGRenderer_GridAndCoords(t *testing.T) {
+	useRepoAssets(t)
+	theme, err := themes.CreateTheme("fantasy", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme failed: %v", err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer failed: %v", err)
+	}
+
+	opts := lib.DefaultRenderOptions()
+	opts.ShowGrid = true
+	opts.ShowCoords = true
+	data, _, err := renderer.Render(highlightTiles(), nil, opts)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if got := strings.Count(string(data), `class="grid"`); got != 3 {
+		t.Errorf("SVG has %d grid hexes, want 3", got)
+	}
+	if !strings.Contains(string(data), `>1,0</text>`) {
+		t.Error("SVG is missing the 1,0 coordinate label")
+	}
+}
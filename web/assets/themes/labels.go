@@ -0,0 +1,157 @@
+package themes
+
+import (
+	"fmt"
+	"image"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/opentype"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+const (
+	// Space between a label's text and the edge of its background
+	labelPadding = 2
+
+	// Smallest font labels shrink to on small tiles
+	minLabelFontSize = 4
+)
+
+// MeasureText returns the width and height in pixels of text drawn in face
+func MeasureText(face font.Face, text string) (width, height int) {
+	metrics := face.Metrics()
+	return font.MeasureString(face, text).Ceil(), (metrics.Ascent + metrics.Descent).Ceil()
+}
+
+// LabelLayout is where a label is drawn, relative to its tile's top-left corner
+type LabelLayout struct {
+	Text string
+	Face font.Face
+
+	// Background behind the text
+	Bounds image.Rectangle
+
+	// Start of the text's baseline
+	Dot image.Point
+}
+
+// UnitLabelLayout lays out a unit's "Shortcut:MP/Health" label along the
+// bottom of its tile, shrinking the font as needed to fit the tile. Returns
+// false if the tile is too small for the label at the smallest font.
+func UnitLabelLayout(unit *v1.Unit, options *lib.RenderOptions) (LabelLayout, bool) {
+	health := unit.AvailableHealth
+	if health == 0 {
+		health = 10
+	}
+	text := fmt.Sprintf("%s/%d", lib.FormatMovementPoints(unit.DistanceLeft), health)
+	if unit.Shortcut != "" {
+		text = unit.Shortcut + ":" + text
+	}
+	face, ok := fitLabelFace(text, options)
+	if !ok {
+		return LabelLayout{}, false
+	}
+	width, height := MeasureText(face, text)
+	descent := face.Metrics().Descent.Ceil()
+
+	// Centered horizontally, resting on the bottom of the tile
+	dot := image.Point{X: (options.TileWidth - width) / 2, Y: options.TileHeight - descent - labelPadding}
+	return LabelLayout{Text: text, Face: face, Dot: dot, Bounds: labelBounds(dot, width, height, descent)}, true
+}
+
+// TileLabelLayout lays out a tile's shortcut label along the top of the
+// tile, shrinking the font as needed to fit the tile. Returns false if the
+// tile is too small for the label at the smallest font.
+func TileLabelLayout(tile *v1.Tile, options *lib.RenderOptions) (LabelLayout, bool) {
+	face, ok := fitLabelFace(tile.Shortcut, options)
+	if !ok {
+		return LabelLayout{}, false
+	}
+	width, height := MeasureText(face, tile.Shortcut)
+	descent := face.Metrics().Descent.Ceil()
+
+	// Centered horizontally, hanging from the top of the tile
+	dot := image.Point{X: (options.TileWidth - width) / 2, Y: height - descent + labelPadding}
+	return LabelLayout{Text: tile.Shortcut, Face: face, Dot: dot, Bounds: labelBounds(dot, width, height, descent)}, true
+}
+
+// VictoryPointsLabelLayout lays out a victory point marker's "N VP" label in
+// the middle of its tile, shrinking the font as needed to fit the tile.
+// Returns false if the tile is too small for the label at the smallest font.
+func VictoryPointsLabelLayout(tile *v1.Tile, options *lib.RenderOptions) (LabelLayout, bool) {
+	text := fmt.Sprintf("%d VP", tile.VictoryPoints)
+	face, ok := fitLabelFace(text, options)
+	if !ok {
+		return LabelLayout{}, false
+	}
+	width, height := MeasureText(face, text)
+	descent := face.Metrics().Descent.Ceil()
+
+	// Centered on the tile
+	dot := image.Point{X: (options.TileWidth - width) / 2, Y: (options.TileHeight+height)/2 - descent}
+	return LabelLayout{Text: text, Face: face, Dot: dot, Bounds: labelBounds(dot, width, height, descent)}, true
+}
+
+func labelBounds(dot image.Point, width, height, descent int) image.Rectangle {
+	return image.Rect(
+		dot.X-labelPadding,
+		dot.Y+descent-height-labelPadding,
+		dot.X+width+labelPadding,
+		dot.Y+descent+labelPadding,
+	)
+}
+
+// fitLabelFace returns the face to draw a label in so it fits across the
+// tile and within half its height: the 7x13 bitmap font when it fits, else
+// Go Mono at the largest size that does
+func fitLabelFace(text string, options *lib.RenderOptions) (font.Face, bool) {
+	maxWidth := options.TileWidth - 2*labelPadding
+	maxHeight := options.TileHeight/2 - 2*labelPadding
+	fits := func(face font.Face) bool {
+		width, height := MeasureText(face, text)
+		return width <= maxWidth && height <= maxHeight
+	}
+	if fits(basicfont.Face7x13) {
+		return basicfont.Face7x13, true
+	}
+	for size := 12; size >= minLabelFontSize; size-- {
+		if face := labelFace(size); fits(face) {
+			return face, true
+		}
+	}
+	return nil, false
+}
+
+var (
+	labelFont      *opentype.Font
+	labelFaces     = map[int]font.Face{}
+	labelFacesOnce sync.Once
+	labelFacesMu   sync.Mutex
+)
+
+// labelFace returns Go Mono at a pixel size
+func labelFace(size int) font.Face {
+	labelFacesOnce.Do(func() {
+		var err error
+		if labelFont, err = opentype.Parse(gomono.TTF); err != nil {
+			panic(err)
+		}
+	})
+	labelFacesMu.Lock()
+	defer labelFacesMu.Unlock()
+	face, ok := labelFaces[size]
+	if !ok {
+		var err error
+		face, err = opentype.NewFace(labelFont, &opentype.FaceOptions{Size: float64(size), DPI: 72, Hinting: font.HintingFull})
+		if err != nil {
+			panic(err)
+		}
+		labelFaces[size] = face
+	}
+	return face
+}
@@ -0,0 +1,71 @@
+package themes_test
+
+import (
+	"image"
+	"testing"
+
+	"golang.org/x/image/font/basicfont"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// TestUnitLabelLayout_FitsTinyTiles tests unit labels shrink to fit within
+// the pixel bounds of tiles too small for the default font, and are left out
+// on tiles too small for any readable font
+func TestUnitLabelLayout_FitsTinyTiles(t *testing.T) {
+	unit := &v1.Unit{Shortcut: "A12", DistanceLeft: 3, AvailableHealth: 10}
+	for _, size := range []int{24, 32, 40} {
+		opts := lib.DefaultRenderOptions()
+		opts.TileWidth, opts.TileHeight = size, size
+		tile := image.Rect(0, 0, size, size)
+
+		label, ok := themes.UnitLabelLayout(unit, opts)
+		if !ok {
+			t.Errorf("%dpx tile: no room for the label", size)
+			continue
+		}
+		if !label.Bounds.In(tile) {
+			t.Errorf("%dpx tile: label bounds %v overflow the tile %v", size, label.Bounds, tile)
+		}
+		width, height := themes.MeasureText(label.Face, label.Text)
+		top := label.Dot.Y - label.Face.Metrics().Ascent.Ceil()
+		text := image.Rect(label.Dot.X, top, label.Dot.X+width, top+height)
+		if !text.In(label.Bounds) {
+			t.Errorf("%dpx tile: text %v overflows its background %v", size, text, label.Bounds)
+		}
+		if label.Face == basicfont.Face7x13 {
+			t.Errorf("%dpx tile: label kept the 7x13 font, which is too wide for %q", size, label.Text)
+		}
+	}
+
+	opts := lib.DefaultRenderOptions()
+	if label, _ := themes.UnitLabelLayout(unit, opts); label.Face != basicfont.Face7x13 {
+		t.Error("default sized tiles should keep the 7x13 font")
+	}
+	opts.TileWidth, opts.TileHeight = 12, 12
+	if _, ok := themes.UnitLabelLayout(unit, opts); ok {
+		t.Error("a 12px tile has no room for a readable label")
+	}
+}
+
+// TestVictoryPointsLabelLayout_CenteredInTile tests a marker's value is
+// drawn within its tile, centered across it
+func TestVictoryPointsLabelLayout_CenteredInTile(t *testing.T) {
+	opts := lib.DefaultRenderOptions()
+	label, ok := themes.VictoryPointsLabelLayout(&v1.Tile{VictoryPoints: 3}, opts)
+	if !ok {
+		t.Fatal("no room for the label on a default sized tile")
+	}
+	if label.Text != "3 VP" {
+		t.Errorf("label text = %q, want %q", label.Text, "3 VP")
+	}
+	tile := image.Rect(0, 0, opts.TileWidth, opts.TileHeight)
+	if !label.Bounds.In(tile) {
+		t.Errorf("label bounds %v overflow the tile %v", label.Bounds, tile)
+	}
+	if left, right := label.Bounds.Min.X, opts.TileWidth-label.Bounds.Max.X; left-right > 1 || right-left > 1 {
+		t.Errorf("label bounds %v are not centered across a %dpx tile", label.Bounds, opts.TileWidth)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"image/color"
 	"image/draw"
 	"image/png"
+	"math"
 	"os"
 	"sync"
 
@@ -22,6 +23,9 @@ import (
 type PNGWorldRenderer struct {
 	theme *DefaultTheme
 
+	// readAsset reads an asset by its web path, from the web directory if nil
+	readAsset func(webPath string) ([]byte, error)
+
 	// Cache for loaded images
 	tileCache  map[string]image.Image
 	unitCache  map[string]image.Image
@@ -42,14 +46,74 @@ func NewPNGWorldRenderer(theme Theme) (*PNGWorldRenderer, error) {
 	}, nil
 }
 
+// SetAssetReader overrides how tile and unit PNGs are read, eg to fetch them
+// where there is no filesystem. The reader is given the theme's web path.
+func (r *PNGWorldRenderer) SetAssetReader(readAsset func(webPath string) ([]byte, error)) {
+	r.readAsset = readAsset
+}
+
 // Render produces a composite PNG image of the world
 func (r *PNGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v1.Unit, options *lib.RenderOptions) ([]byte, string, error) {
+	outputImg, err := r.RenderImage(tiles, units, options)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := encodePNG(outputImg)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, "image/png", nil
+}
+
+// RenderToFit renders the world scaled to fit within width x height pixels.
+// With crop the image is trimmed to the map's bounds, otherwise the map is
+// centered on a transparent canvas of exactly width x height.
+func (r *PNGWorldRenderer) RenderToFit(tiles map[string]*v1.Tile, units map[string]*v1.Unit, options *lib.RenderOptions, width, height int, crop bool) ([]byte, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid render size %dx%d", width, height)
+	}
+	if options == nil {
+		options = lib.DefaultRenderOptions()
+	}
+
+	bounds := lib.ComputeWorldBounds(tiles, units, options)
+	if bounds.Width > 0 && bounds.Height > 0 {
+		scale := min(float64(width)/float64(bounds.Width), float64(height)/float64(bounds.Height))
+		options = scaleRenderOptions(options, scale)
+	}
+
+	mapImg, err := r.RenderImage(tiles, units, options)
+	if err != nil {
+		return nil, err
+	}
+	if crop {
+		return encodePNG(mapImg)
+	}
+
+	canvas := image.NewRGBA(image.Rect(0, 0, width, height))
+	x := (width - mapImg.Bounds().Dx()) / 2
+	y := (height - mapImg.Bounds().Dy()) / 2
+	draw.Draw(canvas, mapImg.Bounds().Add(image.Pt(x, y)), mapImg, image.Point{}, draw.Src)
+	return encodePNG(canvas)
+}
+
+// scaleRenderOptions returns a copy of the options with tiles scaled by scale
+func scaleRenderOptions(options *lib.RenderOptions, scale float64) *lib.RenderOptions {
+	scaled := *options
+	scaled.TileWidth = max(1, int(float64(options.TileWidth)*scale))
+	scaled.TileHeight = max(1, int(float64(options.TileHeight)*scale))
+	scaled.YIncrement = max(1, int(float64(options.YIncrement)*scale))
+	return &scaled
+}
+
+// RenderImage draws the world into an image sized to its bounds
+func (r *PNGWorldRenderer) RenderImage(tiles map[string]*v1.Tile, units map[string]*v1.Unit, options *lib.RenderOptions) (*image.RGBA, error) {
 	if options == nil {
 		options = lib.DefaultRenderOptions()
 	}
 
 	if len(tiles) == 0 {
-		return nil, "", fmt.Errorf("no tiles to render")
+		return nil, fmt.Errorf("no tiles to render")
 	}
 
 	// Compute bounds
@@ -73,6 +137,36 @@ func (r *PNGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v
 		}
 	}
 
+	if options.ShowHealthBars {
+		for _, unit := range units {
+			r.renderHealthBar(outputImg, unit, minX, minY, options)
+		}
+	}
+
+	// Preview tiles show through to the world beneath them
+	for _, tile := range options.PreviewTiles {
+		if err := r.renderPreviewTile(outputImg, tile, minX, minY, options); err != nil {
+			fmt.Printf("Warning: failed to render preview tile at (%d,%d): %v\n", tile.Q, tile.R, err)
+		}
+	}
+
+	// Highlight the selected and hovered tiles over tiles and units
+	for _, highlight := range highlights(options) {
+		r.renderHighlight(outputImg, highlight, minX, minY, options)
+	}
+
+	if options.ShowGrid {
+		for _, tile := range tiles {
+			r.renderGridHex(outputImg, tile, minX, minY, options)
+		}
+	}
+
+	if options.ShowCoords {
+		for _, tile := range tiles {
+			r.renderCoordLabel(outputImg, tile, minX, minY, options)
+		}
+	}
+
 	// Render tile labels if enabled (below tiles, above units)
 	if options.ShowTileLabels {
 		for _, tile := range tiles {
@@ -80,6 +174,11 @@ func (r *PNGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v
 		}
 	}
 
+	// Victory point markers are part of the map, so always show their value
+	for _, tile := range tiles {
+		r.renderVictoryPointsLabel(outputImg, tile, minX, minY, options)
+	}
+
 	// Render unit labels if enabled (on top of everything)
 	if options.ShowUnitLabels {
 		for _, unit := range units {
@@ -87,13 +186,16 @@ func (r *PNGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v
 		}
 	}
 
-	// Encode to PNG
+	return outputImg, nil
+}
+
+// encodePNG encodes a rendered image as PNG bytes
+func encodePNG(img image.Image) ([]byte, error) {
 	var buf bytes.Buffer
-	if err := png.Encode(&buf, outputImg); err != nil {
-		return nil, "", fmt.Errorf("failed to encode PNG: %w", err)
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode PNG: %w", err)
 	}
-
-	return buf.Bytes(), "image/png", nil
+	return buf.Bytes(), nil
 }
 
 // renderTile draws a single tile onto the output image
@@ -136,6 +238,120 @@ func (r *PNGWorldRenderer) renderUnit(output *image.RGBA, unit *v1.Unit, offsetX
 	return nil
 }
 
+// renderHealthBar draws a bar across the top of a damaged unit, filled and
+// colored by how much of its max health it has left
+func (r *PNGWorldRenderer) renderHealthBar(output *image.RGBA, unit *v1.Unit, offsetX, offsetY int, options *lib.RenderOptions) {
+	fraction := options.HealthFraction(unit)
+	if unit.AvailableHealth <= 0 || fraction >= 1 {
+		return
+	}
+	style := options.HealthBars
+	if style == nil {
+		style = lib.DefaultHealthBarStyle()
+	}
+
+	x, y := lib.HexToPixelInt32(unit.Q, unit.R, options)
+	bar := HealthBarBounds(options, style).Add(image.Point{X: x - offsetX, Y: y - offsetY})
+	draw.Draw(output, bar, &image.Uniform{style.Background}, image.Point{}, draw.Over)
+	fill := bar
+	fill.Max.X = bar.Min.X + int(math.Ceil(float64(bar.Dx())*fraction))
+	draw.Draw(output, fill, &image.Uniform{style.ColorFor(fraction)}, image.Point{}, draw.Over)
+}
+
+// HealthBarBounds returns where health bars are drawn relative to their
+// tile's top-left corner: centered across the upper part of the tile
+func HealthBarBounds(options *lib.RenderOptions, style *lib.HealthBarStyle) image.Rectangle {
+	width := options.TileWidth / 2
+	x := (options.TileWidth - width) / 2
+	y := options.TileHeight / 6
+	return image.Rect(x, y, x+width, y+style.Height)
+}
+
+// renderPreviewTile draws a tile at PreviewOpacity over whatever is beneath it
+func (r *PNGWorldRenderer) renderPreviewTile(output *image.RGBA, tile *v1.Tile, offsetX, offsetY int, options *lib.RenderOptions) error {
+	tileImg, err := r.getTileImage(tile.TileType, tile.Player)
+	if err != nil {
+		return err
+	}
+
+	w, h := options.TileWidth, options.TileHeight
+	scaled := image.NewRGBA(image.Rect(0, 0, w, h))
+	r.drawImageAt(scaled, tileImg, 0, 0, w, h)
+
+	x, y := lib.HexToPixelInt32(tile.Q, tile.R, options)
+	x -= offsetX
+	y -= offsetY
+	draw.DrawMask(output, image.Rect(x, y, x+w, y+h), scaled, image.Point{}, image.NewUniform(color.Alpha{A: PreviewOpacity}), image.Point{}, draw.Over)
+	return nil
+}
+
+// renderHighlight tints the hex of a highlighted tile
+func (r *PNGWorldRenderer) renderHighlight(output *image.RGBA, highlight tileHighlight, offsetX, offsetY int, options *lib.RenderOptions) {
+	x, y := lib.HexToPixel(highlight.coord, options)
+	x -= offsetX
+	y -= offsetY
+
+	// Mask out everything outside the hex inscribed in the tile
+	w, h := options.TileWidth, options.TileHeight
+	orientation := options.HexOrientation()
+	mask := image.NewAlpha(image.Rect(0, 0, w, h))
+	for py := range h {
+		for px := range w {
+			if orientation.ContainsPoint((float64(px)+0.5)/float64(w), (float64(py)+0.5)/float64(h)) {
+				mask.SetAlpha(px, py, color.Alpha{A: 255})
+			}
+		}
+	}
+	draw.DrawMask(output, image.Rect(x, y, x+w, y+h), image.NewUniform(highlight.color), image.Point{}, mask, image.Point{}, draw.Over)
+}
+
+// renderGridHex outlines a tile's hex
+func (r *PNGWorldRenderer) renderGridHex(output *image.RGBA, tile *v1.Tile, offsetX, offsetY int, options *lib.RenderOptions) {
+	x, y := lib.HexToPixelInt32(tile.Q, tile.R, options)
+	corners := hexCorners(x-offsetX, y-offsetY, options)
+	for i, from := range corners {
+		to := corners[(i+1)%len(corners)]
+		drawLine(output, from[0], from[1], to[0], to[1], GridColor)
+	}
+}
+
+// drawLine blends a one pixel wide line from x0, y0 to x1, y1
+func drawLine(output *image.RGBA, x0, y0, x1, y1 int, col color.NRGBA) {
+	steps := max(abs(x1-x0), abs(y1-y0), 1)
+	src := image.NewUniform(col)
+	for i := 0; i <= steps; i++ {
+		px := x0 + (x1-x0)*i/steps
+		py := y0 + (y1-y0)*i/steps
+		draw.Draw(output, image.Rect(px, py, px+1, py+1), src, image.Point{}, draw.Over)
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// renderCoordLabel draws the tile's "Q,R" coordinate at its center
+func (r *PNGWorldRenderer) renderCoordLabel(output *image.RGBA, tile *v1.Tile, offsetX, offsetY int, options *lib.RenderOptions) {
+	x, y := lib.HexToPixelInt32(tile.Q, tile.R, options)
+	x -= offsetX
+	y -= offsetY
+
+	// Use basicfont (7x13 pixels per character)
+	labelText := fmt.Sprintf("%d,%d", tile.Q, tile.R)
+	textWidth := len(labelText) * 7
+	labelX := x + (options.TileWidth-textWidth)/2
+	labelY := y + (options.TileHeight+13)/2
+
+	// Dark outline so the label reads on any terrain
+	for _, d := range [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+		r.drawText(output, labelText, labelX+d[0], labelY+d[1], color.Black, basicfont.Face7x13)
+	}
+	r.drawText(output, labelText, labelX, labelY, color.White, basicfont.Face7x13)
+}
+
 // drawImageAt draws an image at the given top-left position with scaling and alpha blending
 func (r *PNGWorldRenderer) drawImageAt(output *image.RGBA, src image.Image, x, y, width, height int) {
 	srcBounds := src.Bounds()
@@ -190,12 +406,9 @@ func (r *PNGWorldRenderer) getTileImage(tileType, playerId int32) (image.Image,
 	}
 	r.cacheMutex.RUnlock()
 
-	// Convert web path to filesystem path (remove leading "/" and prepend "web")
-	path := "web" + webPath
-
-	img, err := r.loadPNG(path)
+	img, err := r.loadPNG(webPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load tile %d for player %d from %s: %w", tileType, playerId, path, err)
+		return nil, fmt.Errorf("failed to load tile %d for player %d from %s: %w", tileType, playerId, webPath, err)
 	}
 
 	// Cache it
@@ -218,19 +431,16 @@ func (r *PNGWorldRenderer) getUnitImage(unitType, playerId int32) (image.Image,
 	}
 	r.cacheMutex.RUnlock()
 
-	// Get path from theme and convert web path to filesystem path
+	// Get path from theme
 	// Theme returns "/static/assets/themes/default/Units/1/0.png"
-	// We need "web/static/assets/themes/default/Units/1/0.png"
 	webPath := r.theme.GetUnitAssetPath(unitType, playerId)
 	if webPath == "" {
 		return nil, fmt.Errorf("unit %d not found in theme", unitType)
 	}
-	// Convert web path to filesystem path (remove leading "/" and prepend "web")
-	path := "web" + webPath
 
-	img, err := r.loadPNG(path)
+	img, err := r.loadPNG(webPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load unit %d for player %d from %s: %w", unitType, playerId, path, err)
+		return nil, fmt.Errorf("failed to load unit %d for player %d from %s: %w", unitType, playerId, webPath, err)
 	}
 
 	// Cache it
@@ -241,15 +451,22 @@ func (r *PNGWorldRenderer) getUnitImage(unitType, playerId int32) (image.Image,
 	return img, nil
 }
 
-// loadPNG loads a PNG file from the filesystem
-func (r *PNGWorldRenderer) loadPNG(path string) (image.Image, error) {
-	file, err := os.Open(path)
+// loadPNG loads a PNG asset by its web path, from the filesystem unless an
+// asset reader is set
+func (r *PNGWorldRenderer) loadPNG(webPath string) (image.Image, error) {
+	var data []byte
+	var err error
+	if r.readAsset != nil {
+		data, err = r.readAsset(webPath)
+	} else {
+		// Convert web path to filesystem path (prepend "web")
+		data, err = os.ReadFile("web" + webPath)
+	}
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
 
-	img, err := png.Decode(file)
+	img, err := png.Decode(bytes.NewReader(data))
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode PNG: %w", err)
 	}
@@ -267,51 +484,15 @@ func (r *PNGWorldRenderer) ClearCache() {
 
 // renderUnitLabel draws a label below the unit showing "Shortcut:MP/Health"
 func (r *PNGWorldRenderer) renderUnitLabel(output *image.RGBA, unit *v1.Unit, offsetX, offsetY int, options *lib.RenderOptions) {
-	// Calculate position (adjusted for offset)
-	x, y := lib.HexToPixelInt32(unit.Q, unit.R, options)
-	x -= offsetX
-	y -= offsetY
-
-	// Format label: "Shortcut:MP/Health" or "MP/Health" if no shortcut
-	health := unit.AvailableHealth
-	if health == 0 {
-		health = 10
-	}
-	mp := int(unit.DistanceLeft)
-
-	var labelText string
-	if unit.Shortcut != "" {
-		labelText = fmt.Sprintf("%s:%d/%d", unit.Shortcut, mp, health)
-	} else {
-		labelText = fmt.Sprintf("%d/%d", mp, health)
+	label, ok := UnitLabelLayout(unit, options)
+	if !ok {
+		return
 	}
-
-	// Use basicfont (7x13 pixels per character)
-	face := basicfont.Face7x13
-
-	// Calculate text dimensions
-	textWidth := len(labelText) * 7
-	textHeight := 13
-
-	// Position: centered horizontally, near bottom of tile
-	labelX := x + (options.TileWidth-textWidth)/2
-	labelY := y + options.TileHeight - 4 // 4 pixels from bottom
+	x, y := lib.HexToPixelInt32(unit.Q, unit.R, options)
 
 	// Background color: brown with alpha (0x3d2817 from web)
 	bgColor := color.RGBA{R: 0x3d, G: 0x28, B: 0x17, A: 0xB3} // ~70% opacity
-	padding := 2
-
-	// Draw background rectangle
-	bgRect := image.Rect(
-		labelX-padding,
-		labelY-textHeight-padding,
-		labelX+textWidth+padding,
-		labelY+padding,
-	)
-	draw.Draw(output, bgRect, &image.Uniform{bgColor}, image.Point{}, draw.Over)
-
-	// Draw text in white
-	r.drawText(output, labelText, labelX, labelY, color.White, face)
+	r.drawLabel(output, label, x-offsetX, y-offsetY, bgColor)
 }
 
 // renderTileLabel draws a label at the top of the tile showing tile shortcut
@@ -320,40 +501,41 @@ func (r *PNGWorldRenderer) renderTileLabel(output *image.RGBA, tile *v1.Tile, of
 	if tile.Shortcut == "" {
 		return
 	}
-
-	// Calculate position (adjusted for offset)
+	label, ok := TileLabelLayout(tile, options)
+	if !ok {
+		return
+	}
 	x, y := lib.HexToPixelInt32(tile.Q, tile.R, options)
-	x -= offsetX
-	y -= offsetY
-
-	labelText := tile.Shortcut
 
-	// Use basicfont (7x13 pixels per character)
-	face := basicfont.Face7x13
+	// Background color: dark teal with alpha (different from unit label brown)
+	bgColor := color.RGBA{R: 0x17, G: 0x3d, B: 0x3d, A: 0xB3} // ~70% opacity
+	r.drawLabel(output, label, x-offsetX, y-offsetY, bgColor)
+}
 
-	// Calculate text dimensions
-	textWidth := len(labelText) * 7
-	textHeight := 13
+// renderVictoryPointsLabel draws a victory point marker's value in the
+// middle of its tile
+func (r *PNGWorldRenderer) renderVictoryPointsLabel(output *image.RGBA, tile *v1.Tile, offsetX, offsetY int, options *lib.RenderOptions) {
+	if tile.VictoryPoints <= 0 {
+		return
+	}
+	label, ok := VictoryPointsLabelLayout(tile, options)
+	if !ok {
+		return
+	}
+	x, y := lib.HexToPixelInt32(tile.Q, tile.R, options)
 
-	// Position: centered horizontally, near top of tile
-	labelX := x + (options.TileWidth-textWidth)/2
-	labelY := y + textHeight + 4 // 4 pixels from top
+	// Background color: gold with alpha
+	bgColor := color.RGBA{R: 0xb4, G: 0x83, B: 0x09, A: 0xD9} // ~85% opacity
+	r.drawLabel(output, label, x-offsetX, y-offsetY, bgColor)
+}
 
-	// Background color: dark teal with alpha (different from unit label brown)
-	bgColor := color.RGBA{R: 0x17, G: 0x3d, B: 0x3d, A: 0xB3} // ~70% opacity
-	padding := 2
-
-	// Draw background rectangle
-	bgRect := image.Rect(
-		labelX-padding,
-		labelY-textHeight-padding,
-		labelX+textWidth+padding,
-		labelY+padding,
-	)
-	draw.Draw(output, bgRect, &image.Uniform{bgColor}, image.Point{}, draw.Over)
-
-	// Draw text in white
-	r.drawText(output, labelText, labelX, labelY, color.White, face)
+// drawLabel draws a label in white over its background for the tile whose
+// top-left corner is at x, y
+func (r *PNGWorldRenderer) drawLabel(output *image.RGBA, label LabelLayout, x, y int, bgColor color.Color) {
+	origin := image.Point{X: x, Y: y}
+	draw.Draw(output, label.Bounds.Add(origin), &image.Uniform{bgColor}, image.Point{}, draw.Over)
+	dot := label.Dot.Add(origin)
+	r.drawText(output, label.Text, dot.X, dot.Y, color.White, label.Face)
 }
 
 // drawText draws text at the given position
@@ -1,7 +1,10 @@
 package themes
 
 import (
+	"cmp"
 	"fmt"
+	"image/color"
+	"slices"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	"github.com/turnforge/lilbattle/lib"
@@ -37,3 +40,49 @@ func computeBounds(tiles map[string]*v1.Tile, units map[string]*v1.Unit, opts *l
 	bounds := lib.ComputeWorldBounds(tiles, units, opts)
 	return bounds.MinX, bounds.MinY, bounds.Width, bounds.Height
 }
+
+// Highlight colors drawn over the hovered and selected tiles
+var (
+	HoverHighlightColor    = color.NRGBA{R: 255, G: 255, B: 255, A: 96}
+	SelectedHighlightColor = color.NRGBA{R: 255, G: 215, B: 0, A: 128}
+)
+
+// GridColor outlines every hex when RenderOptions.ShowGrid is set
+var GridColor = color.NRGBA{R: 0, G: 0, B: 0, A: 96}
+
+// PreviewOpacity is the alpha preview tiles are drawn with, out of 255
+const PreviewOpacity = 128
+
+// tileHighlight is a translucent overlay drawn over a single tile
+type tileHighlight struct {
+	name  string
+	coord lib.AxialCoord
+	color color.NRGBA
+}
+
+// highlights returns the tile overlays to draw, bottom-most first
+func highlights(opts *lib.RenderOptions) (out []tileHighlight) {
+	tinted := make([]lib.AxialCoord, 0, len(opts.TileTints))
+	for coord := range opts.TileTints {
+		tinted = append(tinted, coord)
+	}
+	slices.SortFunc(tinted, func(a, b lib.AxialCoord) int {
+		return cmp.Or(cmp.Compare(a.Q, b.Q), cmp.Compare(a.R, b.R))
+	})
+	for _, coord := range tinted {
+		out = append(out, tileHighlight{"tint", coord, opts.TileTints[coord]})
+	}
+	if opts.SelectedCoord != nil {
+		out = append(out, tileHighlight{"selected", *opts.SelectedCoord, SelectedHighlightColor})
+	}
+	if opts.HoverCoord != nil {
+		out = append(out, tileHighlight{"hover", *opts.HoverCoord, HoverHighlightColor})
+	}
+	return
+}
+
+// hexCorners returns the corners of the hex filling the tile whose top-left
+// corner is at x, y
+func hexCorners(x, y int, opts *lib.RenderOptions) [6][2]int {
+	return opts.HexOrientation().HexCorners(x, y, opts)
+}
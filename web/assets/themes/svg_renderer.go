@@ -86,6 +86,15 @@ func (r *SVGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v
 		tileSymbols[symbolId] = svgContent
 	}
 
+	for _, tile := range options.PreviewTiles {
+		symbolId, svgContent, err := r.getTileSymbol(tile.TileType, tile.Player, options)
+		if err != nil {
+			fmt.Printf("Warning: failed to load preview tile symbol for type %d: %v\n", tile.TileType, err)
+			continue
+		}
+		tileSymbols[symbolId] = svgContent
+	}
+
 	for _, unit := range units {
 		symbolId, svgContent, err := r.getUnitSymbol(unit.UnitType, unit.Player, options)
 		if err != nil {
@@ -149,6 +158,61 @@ func (r *SVGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v
 			symbolId, useX, useY, unitWidth, unitHeight))
 	}
 
+	// Preview tiles show through to the world beneath them
+	if len(options.PreviewTiles) > 0 {
+		svg.WriteString("\n  <!-- Preview -->\n")
+	}
+	for _, tile := range options.PreviewTiles {
+		symbolId := r.tileSymbolId(tile.TileType, tile.Player)
+		if _, ok := tileSymbols[symbolId]; !ok {
+			continue
+		}
+
+		x, y := lib.HexToPixelInt32(tile.Q, tile.R, options)
+		x -= minX
+		y -= minY
+
+		svg.WriteString(fmt.Sprintf("  <use class=\"preview\" href=\"#%s\" x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" opacity=\"%.2f\"/>\n",
+			symbolId, x, y, options.TileWidth, options.TileHeight, float64(PreviewOpacity)/255))
+	}
+
+	// Highlight the selected and hovered tiles on top
+	for _, highlight := range highlights(options) {
+		x, y := lib.HexToPixel(highlight.coord, options)
+		var points []string
+		for _, corner := range hexCorners(x-minX, y-minY, options) {
+			points = append(points, fmt.Sprintf("%d,%d", corner[0], corner[1]))
+		}
+		c := highlight.color
+		svg.WriteString(fmt.Sprintf("  <polygon class=\"highlight-%s\" points=\"%s\" fill=\"rgb(%d,%d,%d)\" fill-opacity=\"%.2f\"/>\n",
+			highlight.name, strings.Join(points, " "), c.R, c.G, c.B, float64(c.A)/255))
+	}
+
+	if options.ShowGrid {
+		svg.WriteString("\n  <!-- Grid -->\n")
+		for _, tile := range tiles {
+			x, y := lib.HexToPixelInt32(tile.Q, tile.R, options)
+			var points []string
+			for _, corner := range hexCorners(x-minX, y-minY, options) {
+				points = append(points, fmt.Sprintf("%d,%d", corner[0], corner[1]))
+			}
+			c := GridColor
+			svg.WriteString(fmt.Sprintf("  <polygon class=\"grid\" points=\"%s\" fill=\"none\" stroke=\"rgb(%d,%d,%d)\" stroke-opacity=\"%.2f\"/>\n",
+				strings.Join(points, " "), c.R, c.G, c.B, float64(c.A)/255))
+		}
+	}
+
+	if options.ShowCoords {
+		svg.WriteString("\n  <!-- Coordinates -->\n")
+		for _, tile := range tiles {
+			x, y := lib.HexToPixelInt32(tile.Q, tile.R, options)
+			x += options.TileWidth/2 - minX
+			y += options.TileHeight/2 - minY
+			svg.WriteString(fmt.Sprintf("  <text class=\"coord\" x=\"%d\" y=\"%d\" text-anchor=\"middle\" dominant-baseline=\"middle\" font-size=\"12\" fill=\"white\" stroke=\"black\" stroke-width=\"0.5\">%d,%d</text>\n",
+				x, y, tile.Q, tile.R))
+		}
+	}
+
 	svg.WriteString("</svg>\n")
 
 	return svg.Bytes(), "image/svg+xml", nil
@@ -4,6 +4,7 @@
  * Architecture:
  * - Subscribes to GameSyncService via HTTP/Connect streaming (direct to server)
  * - When MovesPublished updates arrive from other players, calls WASM presenter's ApplyRemoteChanges
+ * - Negotiates compact binary move payloads, which are decoded by the WASM presenter
  * - Handles reconnection with sequence tracking
  *
  * Usage:
@@ -29,6 +30,8 @@ export interface GameSyncManagerOptions {
     reconnectDelayMs?: number;
     /** Base URL for the sync service (default: current origin) */
     baseUrl?: string;
+    /** Move payload encodings to accept, most preferred first (default: ['proto+gzip']) */
+    acceptedEncodings?: string[];
 }
 
 export class GameSyncManager {
@@ -53,6 +56,7 @@ export class GameSyncManager {
             autoReconnect: options.autoReconnect ?? true,
             reconnectDelayMs: options.reconnectDelayMs ?? 2000,
             baseUrl: options.baseUrl || (window.location.origin + "/api"),
+            acceptedEncodings: options.acceptedEncodings ?? ['proto+gzip'],
         };
     }
 
@@ -105,6 +109,9 @@ export class GameSyncManager {
         const params = new URLSearchParams({
             from_sequence: this.lastSequence.toString(),
         });
+        for (const encoding of this.options.acceptedEncodings) {
+            params.append('accepted_encodings', encoding);
+        }
         const url = `${this.options.baseUrl}/v1/sync/games/${this.gameId}/subscribe?${params}`;
 
         console.log(`[GameSyncManager] Subscribing to ${url}`);
@@ -233,18 +240,20 @@ export class GameSyncManager {
 
             console.log(`[GameSyncManager] Received moves from player ${movesPublished.player}, group ${movesPublished.groupNumber}`);
 
-            const response = await this.presenterClient.applyRemoteChanges({
+            await this.applyRemoteChanges({
                 gameId: this.gameId,
                 moves: movesPublished.moves,
-            })
+            });
+        }
 
-            if (!response.success) {
-                console.error('[GameSyncManager] Failed to apply remote changes:', response.error);
-                if (response.requiresReload) {
-                    console.warn('[GameSyncManager] State desync detected - reload required');
-                    this.setState('error', 'State desync - reload required');
-                }
-            }
+        // Binary moves are passed through as is and decoded by the presenter
+        if (update.encodedMoves) {
+            console.log(`[GameSyncManager] Received ${update.encodedMoves.encoding} moves`);
+
+            await this.applyRemoteChanges({
+                gameId: this.gameId,
+                encodedMoves: update.encodedMoves,
+            });
         }
 
         // Handle PlayerJoined
@@ -263,6 +272,17 @@ export class GameSyncManager {
         }
     }
 
+    private async applyRemoteChanges(request: Parameters<GameViewPresenterClient['applyRemoteChanges']>[0]): Promise<void> {
+        const response = await this.presenterClient.applyRemoteChanges(request);
+        if (!response.success) {
+            console.error('[GameSyncManager] Failed to apply remote changes:', response.error);
+            if (response.requiresReload) {
+                console.warn('[GameSyncManager] State desync detected - reload required');
+                this.setState('error', 'State desync - reload required');
+            }
+        }
+    }
+
     private setState(state: SyncState, error?: string): void {
         if (this.state !== state) {
             console.log(`[GameSyncManager] State: ${this.state} -> ${state}`);
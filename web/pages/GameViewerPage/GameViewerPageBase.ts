@@ -6,6 +6,7 @@ import { GameViewPresenterClient as GameViewPresenterClient } from '../../gen/wa
 import { SingletonInitializerServiceClient as SingletonInitializerClient } from '../../gen/wasmjs/lilbattle/v1/services/singletonInitializerServiceClient';
 import { AssetThemePreference } from '../common/AssetThemePreference';
 import { PhaserGameScene } from './PhaserGameScene';
+import { SaveSlotsDB } from './SaveSlotsDB';
 import { Unit, Tile, World } from '../common/World';
 import {
     GameState as ProtoGameState,
@@ -25,6 +26,8 @@ import {
     SetUnitAtRequest, SetUnitAtResponse,
     RemoveUnitAtRequest, RemoveUnitAtResponse,
     SetAllowedPanelsRequest, SetAllowedPanelsResponse,
+    ShowCoachVerdictRequest, ShowCoachVerdictResponse,
+    ShowTurnObligationsRequest, ShowTurnObligationsResponse,
 } from '../../gen/wasmjs/lilbattle/v1/models/interfaces';
 import * as models from '../../gen/wasmjs/lilbattle/v1/models/models';
 import { create } from '@bufbuild/protobuf';
@@ -275,6 +278,18 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         return false;
     }
 
+    /**
+     * Check if coach mode is on. A coach=on/off query parameter turns it on or
+     * off and is remembered for later games.
+     */
+    protected isCoachModeEnabled(): boolean {
+        const coach = new URLSearchParams(window.location.search).get('coach');
+        if (coach === 'on' || coach === 'off') {
+            localStorage.setItem('lilbattle-coach-mode', coach);
+        }
+        return localStorage.getItem('lilbattle-coach-mode') === 'on';
+    }
+
     /**
      * Handle sync state changes
      */
@@ -334,6 +349,7 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         }
 
         this.gameScene = new PhaserGameScene(container, this.eventBus, true);
+        this.gameScene.setUnitFootprints(this.rulesTable.getUnitFootprints());
         this.onGameSceneCreated();
     }
 
@@ -347,6 +363,9 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         this.singletonInitializerClient = new SingletonInitializerClient(this.wasmBundle);
         await this.wasmBundle.loadWasm((document.getElementById("wasmBundlePathField") as HTMLInputElement).value);
         await this.wasmBundle.waitUntilReady();
+        if (typeof indexedDB !== 'undefined') {
+            (window as any).lilbattle.registerSlotStore(new SaveSlotsDB());
+        }
     }
 
     /**
@@ -633,7 +652,32 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         return {};
     }
 
+    /**
+     * Show a notice from the presenter, eg an action cancelled by another
+     * player's moves, in a panel that hides itself after a few seconds.
+     */
     logMessage(request: LogMessageRequest) {
+        if (!request.message) {
+            return {};
+        }
+        document.getElementById('presenter-notice-panel')?.remove();
+
+        const panel = document.createElement('div');
+        panel.id = 'presenter-notice-panel';
+        panel.className = 'fixed bottom-4 left-4 z-50 max-w-sm rounded-lg border border-blue-400 bg-blue-50 p-3 text-sm text-blue-900 shadow-lg dark:bg-blue-900 dark:text-blue-50';
+
+        const message = document.createElement('p');
+        message.textContent = request.message;
+        const dismiss = document.createElement('button');
+        dismiss.className = 'float-right ml-4 text-lg leading-none';
+        dismiss.setAttribute('aria-label', 'Dismiss');
+        dismiss.textContent = '×';
+        dismiss.addEventListener('click', () => panel.remove());
+
+        panel.append(dismiss, message);
+        document.body.appendChild(panel);
+        setTimeout(() => panel.remove(), 6000);
+        this.gameLogPanel.logGameEvent(request.message, 'system');
         return {};
     }
 
@@ -646,6 +690,7 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         const units = (worldData as any).unitsMap || (worldData as any).units_map || [];
         this.world.loadTilesAndUnits(tiles, units);
         this.world.setName(game.name || 'Untitled Game');
+        this.world.setOrientation(game.orientation || '');
 
         await this.gameScene.loadWorld(this.world);
         this.showToast('Success', `Game loaded: ${game.name || this.world.getName() || 'Untitled'}`, 'success');
@@ -660,7 +705,10 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         if (!this.clientReadySent && this.currentGameId) {
             this.clientReadySent = true;
             // Fire and forget - don't block setGameState return
-            this.gameViewPresenterClient.clientReady({ gameId: this.currentGameId }).catch(err => {
+            this.gameViewPresenterClient.clientReady({
+                gameId: this.currentGameId,
+                coach: this.isCoachModeEnabled(),
+            }).catch(err => {
                 console.error('[GameViewerPage] clientReady failed:', err);
             });
         }
@@ -758,6 +806,83 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         return {};
     }
 
+    /**
+     * Show coach mode's verdict on the player's last move in a dismissible
+     * panel. A newer verdict replaces the one on screen.
+     */
+    showCoachVerdict(request: ShowCoachVerdictRequest): ShowCoachVerdictResponse {
+        const verdict = request.verdict;
+        if (!verdict) {
+            return {};
+        }
+        document.getElementById('coach-verdict-panel')?.remove();
+
+        const panel = document.createElement('div');
+        panel.id = 'coach-verdict-panel';
+        panel.className = 'fixed bottom-4 right-4 z-50 max-w-sm rounded-lg border border-amber-400 bg-amber-50 p-3 text-sm text-amber-900 shadow-lg dark:bg-amber-900 dark:text-amber-50';
+
+        const header = document.createElement('div');
+        header.className = 'mb-1 flex items-center justify-between font-semibold';
+        header.textContent = 'Coach';
+        const dismiss = document.createElement('button');
+        dismiss.className = 'ml-4 text-lg leading-none';
+        dismiss.setAttribute('aria-label', 'Dismiss');
+        dismiss.textContent = '×';
+        dismiss.addEventListener('click', () => panel.remove());
+        header.appendChild(dismiss);
+
+        const message = document.createElement('p');
+        message.textContent = verdict.message;
+
+        panel.append(header, message);
+        document.body.appendChild(panel);
+        this.gameLogPanel.logGameEvent(`Coach: ${verdict.message}`, 'system');
+        return {};
+    }
+
+    showTurnObligations(request: ShowTurnObligationsRequest): ShowTurnObligationsResponse {
+        const obligations = request.obligations || [];
+        document.getElementById('turn-obligations-panel')?.remove();
+
+        const panel = document.createElement('div');
+        panel.id = 'turn-obligations-panel';
+        panel.className = 'fixed bottom-4 right-4 z-50 max-w-sm rounded-lg border border-red-400 bg-red-50 p-3 text-sm text-red-900 shadow-lg dark:bg-red-900 dark:text-red-50';
+
+        const header = document.createElement('div');
+        header.className = 'mb-1 font-semibold';
+        header.textContent = 'Before ending your turn';
+
+        const list = document.createElement('ul');
+        list.className = 'mb-2 list-disc pl-4';
+        for (const obligation of obligations) {
+            const item = document.createElement('li');
+            item.textContent = obligation.mandatory ? `${obligation.description} (mandatory)` : obligation.description;
+            list.appendChild(item);
+        }
+
+        const actions = document.createElement('div');
+        actions.className = 'flex justify-end gap-2';
+        const cancel = document.createElement('button');
+        cancel.className = 'rounded px-2 py-1';
+        cancel.textContent = 'Keep playing';
+        cancel.addEventListener('click', () => panel.remove());
+        const endAnyway = document.createElement('button');
+        endAnyway.className = 'rounded bg-red-600 px-2 py-1 text-white';
+        endAnyway.textContent = 'End turn anyway';
+        endAnyway.addEventListener('click', () => {
+            panel.remove();
+            this.gameViewPresenterClient.endTurnButtonClicked({
+                gameId: this.currentGameId || "",
+                force: true,
+            });
+        });
+        actions.append(cancel, endAnyway);
+
+        panel.append(header, list, actions);
+        document.body.appendChild(panel);
+        return {};
+    }
+
     async updateGameStatus(request: { currentPlayer: number, turnCounter: number }) {
         this.updateTurnCounter(request.turnCounter);
         this.updateEndTurnButtonState(request.currentPlayer);
@@ -2,7 +2,7 @@ import * as Phaser from 'phaser';
 import { PhaserWorldScene } from '../common/PhaserWorldScene';
 import { hexToPixel } from '../common/hexUtils';
 import { World } from '../common/World';
-import { SelectionHighlightLayer, MovementHighlightLayer, AttackHighlightLayer, CaptureHighlightLayer } from '../common/HexHighlightLayer';
+import { SelectionHighlightLayer, MovementHighlightLayer, AttackHighlightLayer, AttackRangeHighlightLayer, CaptureHighlightLayer } from '../common/HexHighlightLayer';
 import { EventBus } from '@panyam/tsappkit';
 import { GameViewPresenterClient as  GameViewPresenterClient } from '../../gen/wasmjs/lilbattle/v1/services/gameViewPresenterClient';
 import { MoveUnitAction, AttackUnitAction, HighlightSpec } from '../../gen/wasmjs/lilbattle/v1/models/interfaces';
@@ -36,6 +36,7 @@ export class PhaserGameScene extends PhaserWorldScene {
     private _selectionHighlightLayer: SelectionHighlightLayer | null = null;
     private _movementHighlightLayer: MovementHighlightLayer | null = null;
     private _attackHighlightLayer: AttackHighlightLayer | null = null;
+    private _attackRangeHighlightLayer: AttackRangeHighlightLayer | null = null;
     private _captureHighlightLayer: CaptureHighlightLayer | null = null;
     
     // Path preview graphics for movement/attack visualization
@@ -88,6 +89,10 @@ export class PhaserGameScene extends PhaserWorldScene {
         this._attackHighlightLayer = new AttackHighlightLayer(this, this.tileWidth);
         layerManager.addLayer(this._attackHighlightLayer);
 
+        // Create attack range highlight layer
+        this._attackRangeHighlightLayer = new AttackRangeHighlightLayer(this, this.tileWidth);
+        layerManager.addLayer(this._attackRangeHighlightLayer);
+
         // Create capture highlight layer
         this._captureHighlightLayer = new CaptureHighlightLayer(this, this.tileWidth);
         layerManager.addLayer(this._captureHighlightLayer);
@@ -121,6 +126,9 @@ export class PhaserGameScene extends PhaserWorldScene {
         if (this._attackHighlightLayer) {
             this._attackHighlightLayer.clearAttackOptions();
         }
+        if (this._attackRangeHighlightLayer) {
+            this._attackRangeHighlightLayer.clearAttackRange();
+        }
         
         // Disable distance labels
         this.setShowUnitDistance(false);
@@ -233,6 +241,7 @@ export class PhaserGameScene extends PhaserWorldScene {
         const selections: HighlightSpec[] = [];
         const movements: MoveUnitAction[] = [];
         const attacks: AttackUnitAction[] = [];
+        const attackRange: HighlightSpec[] = [];
         const captures: HighlightSpec[] = [];
         const exhausted: HighlightSpec[] = [];
         const capturing: HighlightSpec[] = [];
@@ -242,6 +251,7 @@ export class PhaserGameScene extends PhaserWorldScene {
                 case 'selection': selections.push(h); break;
                 case 'movement': if (h.move) movements.push(h.move); break;
                 case 'attack': if (h.attack) attacks.push(h.attack); break;
+                case 'attack-range': attackRange.push(h); break;
                 case 'capture': captures.push(h); break;
                 case 'exhausted': exhausted.push(h); break;
                 case 'capturing': capturing.push(h); break;
@@ -266,6 +276,11 @@ export class PhaserGameScene extends PhaserWorldScene {
             this._attackHighlightLayer.showAttackOptions(attacks);
         }
 
+        // Apply attack range highlights
+        if (this._attackRangeHighlightLayer && attackRange.length > 0) {
+            this._attackRangeHighlightLayer.showAttackRange(attackRange);
+        }
+
         // Apply capture highlights (interactive - for clicking to execute capture)
         if (this._captureHighlightLayer && captures.length > 0) {
             captures.forEach(h => {
@@ -309,6 +324,10 @@ export class PhaserGameScene extends PhaserWorldScene {
             this._attackHighlightLayer.clearAttackOptions();
         }
 
+        if ((clearAll || types.includes('attack-range')) && this._attackRangeHighlightLayer) {
+            this._attackRangeHighlightLayer.clearAttackRange();
+        }
+
         if ((clearAll || types.includes('capture')) && this._captureHighlightLayer) {
             this._captureHighlightLayer.clearCaptureOptions();
         }
@@ -0,0 +1,59 @@
+/**
+ * IndexedDB storage for offline game save slots
+ *
+ * Keeps the game bundles exported by the WASM presenter under slot names.
+ * Registered with lilbattle.registerSlotStore, which calls put/get/list.
+ */
+
+interface SaveSlotRecord {
+    name: string;
+    data: Uint8Array;
+    timestamp: number;
+}
+
+export class SaveSlotsDB {
+    private dbName = 'LilBattleSaveSlots';
+    private storeName = 'slots';
+    private version = 1;
+    private db: Promise<IDBDatabase> | null = null;
+
+    async put(name: string, data: Uint8Array): Promise<void> {
+        const record: SaveSlotRecord = { name, data, timestamp: Date.now() };
+        await this.request('readwrite', store => store.put(record));
+    }
+
+    async get(name: string): Promise<Uint8Array | null> {
+        const record = await this.request<SaveSlotRecord | undefined>('readonly', store => store.get(name));
+        return record ? record.data : null;
+    }
+
+    async list(): Promise<string[]> {
+        const keys = await this.request<IDBValidKey[]>('readonly', store => store.getAllKeys());
+        return keys.map(key => String(key));
+    }
+
+    private openDatabase(): Promise<IDBDatabase> {
+        if (!this.db) {
+            this.db = new Promise((resolve, reject) => {
+                const request = indexedDB.open(this.dbName, this.version);
+                request.onerror = () => reject(new Error('Failed to open save slots database'));
+                request.onsuccess = () => resolve(request.result);
+                request.onupgradeneeded = () => {
+                    if (!request.result.objectStoreNames.contains(this.storeName)) {
+                        request.result.createObjectStore(this.storeName, { keyPath: 'name' });
+                    }
+                };
+            });
+        }
+        return this.db;
+    }
+
+    private async request<T>(mode: IDBTransactionMode, op: (store: IDBObjectStore) => IDBRequest): Promise<T> {
+        const db = await this.openDatabase();
+        return new Promise((resolve, reject) => {
+            const request = op(db.transaction(this.storeName, mode).objectStore(this.storeName));
+            request.onsuccess = () => resolve(request.result);
+            request.onerror = () => reject(request.error);
+        });
+    }
+}
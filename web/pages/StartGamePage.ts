@@ -124,6 +124,21 @@ class StartGamePage extends BasePage implements LCMComponent {
             turnLimitSelect.addEventListener('change', this.handleTurnLimitChange.bind(this));
         }
 
+        // Bind victory points target input
+        const victoryPointsInput = document.querySelector('[data-config="victory-points"]');
+        if (victoryPointsInput) {
+            victoryPointsInput.addEventListener('change', this.handleVictoryPointsChange.bind(this));
+        }
+
+        // Bind sandbox toggles
+        const sandboxFields = ['sandbox', 'sandbox-relax-turn-order', 'sandbox-relax-action-order', 'sandbox-infinite-coins'];
+        sandboxFields.forEach(field => {
+            const input = document.querySelector(`[data-config="${field}"]`);
+            if (input) {
+                input.addEventListener('change', this.handleSandboxChange.bind(this));
+            }
+        });
+
         // Bind income input fields
         const incomeFields = ['starting-coins', 'game-income', 'landbase-income', 'navalbase-income', 'airportbase-income', 'missilesilo-income', 'mines-income'];
         incomeFields.forEach(field => {
@@ -393,6 +408,28 @@ class StartGamePage extends BasePage implements LCMComponent {
         this.validateGameConfiguration();
     }
 
+    private handleVictoryPointsChange(event: Event): void {
+        const input = event.target as HTMLInputElement;
+        if (this.gameConfig.settings) {
+            this.gameConfig.settings.victoryPointsToWin = Math.max(0, parseInt(input.value) || 0);
+        }
+        this.validateGameConfiguration();
+    }
+
+    private handleSandboxChange(): void {
+        const checked = (field: string) =>
+            (document.querySelector(`[data-config="${field}"]`) as HTMLInputElement | null)?.checked || false;
+        if (this.gameConfig.settings) {
+            this.gameConfig.settings.sandbox = {
+                enabled: checked('sandbox'),
+                relaxTurnOrder: checked('sandbox-relax-turn-order'),
+                relaxActionOrder: checked('sandbox-relax-action-order'),
+                infiniteCoins: checked('sandbox-infinite-coins')
+            };
+        }
+        this.validateGameConfiguration();
+    }
+
     private handleIncomeChange(event: Event): void {
         const input = event.target as HTMLInputElement;
         const configType = input.dataset.config;
@@ -530,7 +567,14 @@ class StartGamePage extends BasePage implements LCMComponent {
                         allowed_units: this.gameConfig.settings?.allowedUnits || [],
                         turn_time_limit: this.gameConfig.settings?.turnTimeLimit || 0,
                         team_mode: this.gameConfig.settings?.teamMode || 'ffa',
-                        max_turns: 0 // Unlimited for now
+                        max_turns: 0, // Unlimited for now
+                        victory_points_to_win: this.gameConfig.settings?.victoryPointsToWin || 0,
+                        sandbox: this.gameConfig.settings?.sandbox?.enabled ? {
+                            enabled: true,
+                            relax_turn_order: this.gameConfig.settings.sandbox.relaxTurnOrder,
+                            relax_action_order: this.gameConfig.settings.sandbox.relaxActionOrder,
+                            infinite_coins: this.gameConfig.settings.sandbox.infiniteCoins
+                        } : undefined
                     }
                 }
             }
@@ -56,6 +56,7 @@ export class EditorToolsPanel extends BaseComponent {
         this.bindTerrainButtons();
         this.bindCrossingButtons();
         this.bindCrossingDirectionPreset();
+        this.bindVictoryPointsControl();
         this.bindUnitButtons();
         this.bindBrushSizeControl();
         this.bindPlayerControl();
@@ -396,6 +397,30 @@ export class EditorToolsPanel extends BaseComponent {
         this.log(`Bound ${crossingButtons.length} crossing buttons`);
     }
 
+    /**
+     * Bind the victory point marker button and its point value input
+     */
+    private bindVictoryPointsControl(): void {
+        const button = this.findElement('#victory-points-button') as HTMLElement;
+        const input = this.findElement('#victory-points-value') as HTMLInputElement;
+        if (!button || !input) {
+            this.log('Victory points controls not found');
+            return;
+        }
+
+        const select = () => {
+            const points = parseInt(input.value, 10) || 0;
+            this.executeWhenReady(() => {
+                this.updateButtonSelection(button);
+                this.presenter!.selectVictoryPoints(points);
+                this.log(`Selected victory points: ${points}`);
+            });
+        };
+        button.addEventListener('click', select);
+        input.addEventListener('change', select);
+        this.log('Bound victory points controls');
+    }
+
     // Crossing direction presets: [LEFT, TOP_LEFT, TOP_RIGHT, RIGHT, BOTTOM_RIGHT, BOTTOM_LEFT]
     private static readonly CROSSING_PRESETS: { [key: string]: boolean[] } = {
         'horizontal': [true, false, false, true, false, false],        // L + R
@@ -624,8 +649,8 @@ export class EditorToolsPanel extends BaseComponent {
      * Update visual selection state for terrain/unit/crossing buttons
      */
     private updateButtonSelection(selectedButton: HTMLElement): void {
-        // Remove selection from all terrain, unit, and crossing buttons within this component
-        const allButtons = this.findElements('.terrain-button, .unit-button, .crossing-button');
+        // Remove selection from all terrain, unit, crossing and victory point buttons within this component
+        const allButtons = this.findElements('.terrain-button, .unit-button, .crossing-button, #victory-points-button');
         allButtons.forEach(btn => {
             btn.classList.remove('bg-blue-100', 'dark:bg-blue-900', 'border-blue-500');
         });
@@ -30,7 +30,9 @@ export interface ToolState {
     selectedCrossing: 'road' | 'bridge' | null;
     /** Preset connectsTo pattern for crossing placement (6 booleans for hex directions) */
     crossingConnectsTo: boolean[];
-    placementMode: 'terrain' | 'unit' | 'crossing' | 'clear';
+    /** Points per turn held for victory point markers (0 clears markers) */
+    victoryPoints: number;
+    placementMode: 'terrain' | 'unit' | 'crossing' | 'victory' | 'clear';
     brushMode: string;
     brushSize: number;
 }
@@ -54,7 +56,7 @@ export interface SavedUIState {
     crossing: 'road' | 'bridge' | null;
     brushMode: string;
     brushSize: number;
-    placementMode: 'terrain' | 'unit' | 'crossing' | 'clear';
+    placementMode: 'terrain' | 'unit' | 'crossing' | 'victory' | 'clear';
     crossingConnectsTo: boolean[];
 }
 
@@ -69,8 +71,9 @@ export interface IWorldEditorPresenter {
     selectPlayer(playerId: number): void;
     selectCrossing(crossingType: 'road' | 'bridge'): void;
     setCrossingConnectsTo(connectsTo: boolean[]): void;
+    selectVictoryPoints(points: number): void;
     setBrushSize(mode: string, size: number): void;
-    setPlacementMode(mode: 'terrain' | 'unit' | 'crossing' | 'clear'): void;
+    setPlacementMode(mode: 'terrain' | 'unit' | 'crossing' | 'victory' | 'clear'): void;
 
     // Visual State Actions
     setShowGrid(show: boolean): void;
@@ -107,7 +110,7 @@ export interface IWorldEditorPresenter {
     getCurrentTerrain(): number;
     getCurrentUnit(): number;
     getCurrentPlayer(): number;
-    getCurrentPlacementMode(): 'terrain' | 'unit' | 'crossing' | 'clear';
+    getCurrentPlacementMode(): 'terrain' | 'unit' | 'crossing' | 'victory' | 'clear';
     getCurrentCrossing(): 'road' | 'bridge' | null;
     getCurrentBrushMode(): string;
     getCurrentBrushSize(): number;
@@ -157,6 +160,7 @@ export class WorldEditorPresenter implements IWorldEditorPresenter, EventSubscri
         selectedCrossing: null,
         // Default preset: horizontal crossing (LEFT + RIGHT)
         crossingConnectsTo: [true, false, false, true, false, false],
+        victoryPoints: 1,
         placementMode: 'terrain',
         brushMode: 'brush',
         brushSize: 0
@@ -342,6 +346,16 @@ export class WorldEditorPresenter implements IWorldEditorPresenter, EventSubscri
         return [...this.toolState.crossingConnectsTo];
     }
 
+    /**
+     * Mark tiles as victory point markers worth points per turn held
+     */
+    public selectVictoryPoints(points: number): void {
+        this.toolState.victoryPoints = Math.max(0, Math.floor(points));
+        this.toolState.placementMode = 'victory';
+        this.workflowState.lastAction = 'select-victory-points';
+        this.syncToolStateToPhaser();
+    }
+
     public setBrushSize(mode: string, size: number): void {
         this.toolState.brushMode = mode;
         this.toolState.brushSize = size;
@@ -349,7 +363,7 @@ export class WorldEditorPresenter implements IWorldEditorPresenter, EventSubscri
         this.syncToolStateToPhaser();
     }
 
-    public setPlacementMode(mode: 'terrain' | 'unit' | 'crossing' | 'clear'): void {
+    public setPlacementMode(mode: 'terrain' | 'unit' | 'crossing' | 'victory' | 'clear'): void {
         this.toolState.placementMode = mode;
         this.workflowState.lastAction = 'set-placement-mode';
         this.syncToolStateToPhaser();
@@ -440,6 +454,9 @@ export class WorldEditorPresenter implements IWorldEditorPresenter, EventSubscri
             case 'crossing':
                 this.toggleCrossing(q, r);
                 break;
+            case 'victory':
+                this.markVictoryPoints(q, r);
+                break;
             case 'clear':
                 this.clearTile(q, r);
                 break;
@@ -547,6 +564,23 @@ export class WorldEditorPresenter implements IWorldEditorPresenter, EventSubscri
         }
     }
 
+    private markVictoryPoints(q: number, r: number): void {
+        if (!this.world) return;
+
+        const points = this.toolState.victoryPoints;
+        if (this.toolState.brushSize === 0) {
+            // Toggle: clicking a marker already worth these points clears it
+            const existingTile = this.world.getTileAt(q, r);
+            const marked = existingTile?.victoryPoints === points;
+            this.world.setTileVictoryPoints(q, r, marked ? 0 : points);
+        } else {
+            const tiles = this.getTilesForBrush(q, r);
+            tiles.forEach(([tq, tr]) => {
+                this.world!.setTileVictoryPoints(tq, tr, points);
+            });
+        }
+    }
+
     private clearTile(q: number, r: number): void {
         if (!this.world) return;
 
@@ -679,7 +713,7 @@ export class WorldEditorPresenter implements IWorldEditorPresenter, EventSubscri
         return this.toolState.selectedPlayer;
     }
 
-    public getCurrentPlacementMode(): 'terrain' | 'unit' | 'crossing' | 'clear' {
+    public getCurrentPlacementMode(): 'terrain' | 'unit' | 'crossing' | 'victory' | 'clear' {
         return this.toolState.placementMode;
     }
 
@@ -774,7 +808,8 @@ export class WorldEditorPresenter implements IWorldEditorPresenter, EventSubscri
             placementMode: 'terrain',
             brushMode: 'brush',
             brushSize: 0,
-            crossingConnectsTo: [true, false, false, true, false, false]  // Default: horizontal
+            crossingConnectsTo: [true, false, false, true, false, false],  // Default: horizontal
+            victoryPoints: 1
         };
 
         this.syncToolStateToPhaser();
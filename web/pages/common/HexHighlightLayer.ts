@@ -7,7 +7,7 @@
 
 import * as Phaser from 'phaser';
 import { BaseLayer, LayerConfig, ClickContext, LayerHitResult } from './LayerSystem';
-import { hexToPixel } from './hexUtils';
+import { hexToPixel, createHexagonPath } from './hexUtils';
 import { MoveUnitAction, AttackUnitAction } from '../../gen/wasmjs/lilbattle/v1/models/interfaces';
 import { AnimationConfig } from './animations/AnimationConfig';
 import { DEFAULT_PLAYER_COLORS } from '../../assets/themes/BaseTheme';
@@ -54,19 +54,10 @@ export abstract class HexHighlightLayer extends BaseLayer {
         const position = hexToPixel(q, r);
         highlight.setPosition(position.x, position.y);
 
-        const points: Phaser.Geom.Point[] = [];
-        const halfWidth = this.tileWidth / 2;
-        const halfHeight = this.tileWidth / 2;  // Use tileWidth for both to maintain aspect ratio
-        const halfWidth2 = halfWidth;
-        
-        // Pointy-topped hexagon vertices (starting from top point, going clockwise)
-        points.push(new Phaser.Geom.Point(0, - halfHeight))                    // Top
-        points.push(new Phaser.Geom.Point(halfWidth2, - halfHeight * 0.5 )) // Top-right
-        points.push(new Phaser.Geom.Point(halfWidth2, halfHeight * 0.5 )) // Bottom-right
-        points.push(new Phaser.Geom.Point(0, + halfHeight ))                    // Bottom
-        points.push(new Phaser.Geom.Point(- halfWidth2, + halfHeight * 0.5 )) // Bottom-left
-        points.push(new Phaser.Geom.Point(- halfWidth2, - halfHeight * 0.5 ))  // Top-left
-        
+        // Use tileWidth for both to maintain aspect ratio
+        const points = createHexagonPath(0, 0, this.tileWidth, this.tileWidth)
+            .map(corner => new Phaser.Geom.Point(corner.x, corner.y));
+
         // Create and draw polygon
         const polygon = new Phaser.Geom.Polygon(points);
         highlight.fillPoints(polygon.points, true);
@@ -432,6 +423,47 @@ export class AttackHighlightLayer extends HexHighlightLayer {
     }
 }
 
+// =============================================================================
+// Attack Range Highlight Layer
+// =============================================================================
+
+/**
+ * Shades the hexes a selected unit could attack, whether or not an enemy is
+ * there.  Indirect units show a ring with their minimum range left out.
+ */
+export class AttackRangeHighlightLayer extends HexHighlightLayer {
+    constructor(scene: Phaser.Scene, tileWidth: number) {
+        super(scene, {
+            name: 'attack-range-highlight',
+            coordinateSpace: 'hex',
+            interactive: false, // Clicks pass through to the hexes below
+            depth: 10.5, // Below movement/attack highlights
+            tileWidth
+        });
+    }
+
+    public hitTest(context: ClickContext): LayerHitResult | null {
+        return LayerHitResult.TRANSPARENT;
+    }
+
+    /**
+     * Show the attack range hexes
+     */
+    public showAttackRange(coords: { q: number, r: number }[]): void {
+        this.clearHighlights();
+        coords.forEach(coord => {
+            this.addHighlight(coord.q, coord.r, 0xFF6600, 0.1);
+        });
+    }
+
+    /**
+     * Clear the attack range
+     */
+    public clearAttackRange(): void {
+        this.clearHighlights();
+    }
+}
+
 // =============================================================================
 // Capture Highlight Layer
 // =============================================================================
@@ -1,5 +1,5 @@
 import * as Phaser from 'phaser';
-import { TILE_HEIGHT, TILE_WIDTH, Y_INCREMENT, hexToRowCol, hexToPixel, pixelToHex, HexCoord, PixelCoord } from './hexUtils';
+import { TILE_HEIGHT, TILE_WIDTH, Y_INCREMENT, hexToRowCol, hexToPixel, pixelToHex, createHexagonPath, setHexOrientation, footprintCenter, HexCoord, PixelCoord } from './hexUtils';
 import { TilesChangedEventData, UnitsChangedEventData, CrossingsChangedEventData, WorldLoadedEventData, Unit, Tile, World } from './World';
 import { LayerManager } from './LayerSystem';
 import { BaseMapLayer } from './BaseMapLayer';
@@ -16,6 +16,7 @@ import { HealBubblesEffect } from './animations/effects/HealBubblesEffect';
 import { CaptureEffect } from './animations/effects/CaptureEffect';
 import { ExhaustedUnitsHighlightLayer, CapturingFlagLayer } from './HexHighlightLayer';
 import { perfMon } from './PerformanceMonitor';
+import { formatMovementPoints } from './movementPoints';
 
 const UNIT_TILE_RATIO = 0.9
 
@@ -39,11 +40,17 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
     // Visual sprite maps (for rendering only, not game data)
     protected tileSprites: Map<string, Phaser.GameObjects.Sprite> = new Map();
     protected unitSprites: Map<string, Phaser.GameObjects.Sprite> = new Map();
+
+    // Extra hexes covered by multi-hex unit types, relative to the unit when
+    // facing LEFT.  Unit types without an entry cover a single hex.
+    protected unitFootprints: { [unitType: number]: { dq: number, dr: number }[] } = {};
     protected unitLabels: Map<string, {
         healthText: Phaser.GameObjects.Text,
         healthBg?: Phaser.GameObjects.Graphics,
         distanceText?: Phaser.GameObjects.Text
     }> = new Map();
+    // Point value labels on victory point marker tiles
+    protected victoryPointLabels: Map<string, Phaser.GameObjects.Text> = new Map();
     protected gridGraphics: Phaser.GameObjects.Graphics | null = null;
     protected coordinateTexts: Map<string, Phaser.GameObjects.Text> = new Map();
 
@@ -949,11 +956,40 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
             }
         }
         
+        this.setVictoryPointLabel(tile, position);
+
         // Update coordinate text if enabled
         if (this.showCoordinates) {
             this.createOrReuseCoordinateText(q, r);
         }
     }
+
+    /**
+     * Show the point value of a victory point marker, or remove the label
+     * from a tile that is no longer one
+     */
+    protected setVictoryPointLabel(tile: Tile, position: { x: number, y: number }) {
+        const key = `${tile.q},${tile.r}`;
+        this.victoryPointLabels.get(key)?.destroy();
+        this.victoryPointLabels.delete(key);
+        if (!tile.victoryPoints) {
+            return;
+        }
+
+        // Along the top of the tile so units on the marker do not hide it
+        const labelY = position.y - (this.assetProvider.getDisplaySize().height / 2) + 8;
+        const label = this.add.text(position.x, labelY, `\u2605${tile.victoryPoints}`, {
+            fontSize: '12px',
+            color: '#ffffff',
+            fontFamily: 'Arial',
+            fontStyle: 'bold',
+            backgroundColor: '#b48309',
+            padding: { x: 3, y: 1 }
+        });
+        label.setOrigin(0.5, 0.5);
+        label.setDepth(16); // Above units, like unit labels
+        this.victoryPointLabels.set(key, label);
+    }
     
     public removeTile(q: number, r: number) {
         const key = `${q},${r}`;
@@ -962,6 +998,8 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
             this.tileSprites.get(key)?.destroy();
             this.tileSprites.delete(key);
         }
+        this.victoryPointLabels.get(key)?.destroy();
+        this.victoryPointLabels.delete(key);
         
         // Remove coordinate text (return to pool)
         if (this.coordinateTexts.has(key)) {
@@ -975,6 +1013,8 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
     public clearAllTiles() {
         this.tileSprites.forEach(tile => tile.destroy());
         this.tileSprites.clear();
+        this.victoryPointLabels.forEach(label => label.destroy());
+        this.victoryPointLabels.clear();
 
         // Return coordinate texts to pool
         this.coordinateTexts.forEach(text => {
@@ -986,6 +1026,38 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
     }
     
     // Unit management methods
+    public setUnitFootprints(footprints: { [unitType: number]: { dq: number, dr: number }[] }): void {
+        this.unitFootprints = footprints;
+    }
+
+    /**
+     * Where a unit is drawn: its hex's center, or the middle of all the hexes
+     * a multi-hex unit covers
+     */
+    private unitPosition(unit: Unit): PixelCoord {
+        const footprint = this.unitFootprints[unit.unitType];
+        if (!footprint?.length) {
+            return hexToPixel(unit.q, unit.r);
+        }
+        return footprintCenter(unit.q, unit.r, footprint, (unit as any).facing || 0);
+    }
+
+    /**
+     * Stretch a multi-hex unit's sprite across its hexes, turned from its own
+     * hex towards the rest of its footprint
+     */
+    private spanFootprint(sprite: Phaser.GameObjects.Sprite, unit: Unit): void {
+        const footprint = this.unitFootprints[unit.unitType];
+        if (!footprint?.length) {
+            return;
+        }
+        const anchor = hexToPixel(unit.q, unit.r);
+        const center = this.unitPosition(unit);
+        const displaySize = this.assetProvider.getDisplaySize();
+        sprite.setDisplaySize(displaySize.width * UNIT_TILE_RATIO * (footprint.length + 1), displaySize.height * UNIT_TILE_RATIO);
+        sprite.setRotation(Math.atan2(center.y - anchor.y, center.x - anchor.x));
+    }
+
     public setUnit(unit: Unit, options?: { flash?: boolean, appear?: boolean }): Promise<void> {
         return new Promise((resolve) => {
             const q = unit.q;
@@ -993,7 +1065,7 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
             const unitType = unit.unitType;
             const color = unit.player;
             const key = `${q},${r}`;
-            const position = hexToPixel(q, r);
+            const position = this.unitPosition(unit);
 
             // Preserve shortcut from World if incoming unit doesn't have one
             if (!unit.shortcut && this.world) {
@@ -1021,6 +1093,7 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
                 // Scale sprite to match hex tile size - use provider's display size
                 const displaySize = this.assetProvider.getDisplaySize();
                 unitSprite.setDisplaySize(displaySize.width * UNIT_TILE_RATIO, displaySize.height * UNIT_TILE_RATIO);
+                this.spanFootprint(unitSprite, unit);
                 this.unitSprites.set(key, unitSprite);
             } else {
                 // Try fallback without player color
@@ -1031,6 +1104,7 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
                     unitSprite.setDepth(10);
                     const displaySize = this.assetProvider.getDisplaySize();
                     unitSprite.setDisplaySize(displaySize.width * UNIT_TILE_RATIO, displaySize.height * UNIT_TILE_RATIO);
+                    this.spanFootprint(unitSprite, unit);
                     this.unitSprites.set(key, unitSprite);
                 } else {
                     console.error(`[PhaserWorldScene] Unit texture not found: ${textureKey} or ${fallbackKey}`);
@@ -1141,12 +1215,14 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
 
             // Calculate pixel positions for the path
             const pixelPath = path.map(coord => hexToPixel(coord.q, coord.r));
+            pixelPath[pixelPath.length - 1] = this.unitPosition(unit);
             const totalDuration = (path.length - 1) * AnimationConfig.MOVE_DURATION_PER_HEX;
 
             if (totalDuration === 0) {
                 // Instant mode - just update position
                 const endPos = pixelPath[pixelPath.length - 1];
                 sprite.setPosition(endPos.x, endPos.y);
+                this.spanFootprint(sprite, unit);
 
                 // Update sprite map key
                 this.unitSprites.delete(startKey);
@@ -1170,6 +1246,7 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
                     this.unitSprites.delete(startKey);
                     const endKey = `${path[path.length - 1].q},${path[path.length - 1].r}`;
                     this.unitSprites.set(endKey, sprite);
+                    this.spanFootprint(sprite, unit);
 
                     this.removeUnitLabels(startKey);
                     const endPos = pixelPath[pixelPath.length - 1];
@@ -1334,7 +1411,7 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
         // Create combined label if enabled
         if (this.showUnitHealth) {
             const health = unit.availableHealth || 10;
-            const movementPoints = unit.distanceLeft || 0;
+            const movementPoints = formatMovementPoints(unit.distanceLeft || 0);
 
             // Format: "Shortcut: MP/Health" (e.g., "B1: 3/10") or "MP/Health" if no shortcut
             const labelText = unit.shortcut
@@ -1422,7 +1499,7 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
 
         if (labels && labels.healthText && this.showUnitHealth) {
             const health = unit.availableHealth || 10;
-            const movementPoints = unit.distanceLeft || 0;
+            const movementPoints = formatMovementPoints(unit.distanceLeft || 0);
 
             // Format: "Shortcut: MP/Health" (e.g., "B1: 3/10") or "MP/Health" if no shortcut
             const labelText = unit.shortcut
@@ -1527,7 +1604,7 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
         // Recreate labels for all units
         for (const unit of this.world.getAllUnits()) {
             const key = `${unit.q},${unit.r}`;
-            const position = hexToPixel(unit.q, unit.r);
+            const position = this.unitPosition(unit);
             
             // Remove existing labels
             this.removeUnitLabels(key);
@@ -1737,22 +1814,9 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
         if (!this.gridGraphics) return;
         
         const position = hexToPixel(q, r);
-        const halfWidth = this.tileWidth / 2;
-        const halfHeight = this.tileHeight / 2;
-        
-        // Draw pointy-topped hexagon outline based on tile dimensions
+        const vertices = createHexagonPath(position.x, position.y, this.tileWidth, this.tileHeight);
+
         this.gridGraphics.beginPath();
-        
-        // Pointy-topped hexagon vertices (starting from top point, going clockwise)
-        const vertices = [
-            { x: position.x, y: position.y - halfHeight },                    // Top
-            { x: position.x + halfWidth * 0.866, y: position.y - halfHeight * 0.5 }, // Top-right
-            { x: position.x + halfWidth * 0.866, y: position.y + halfHeight * 0.5 }, // Bottom-right
-            { x: position.x, y: position.y + halfHeight },                    // Bottom
-            { x: position.x - halfWidth * 0.866, y: position.y + halfHeight * 0.5 }, // Bottom-left
-            { x: position.x - halfWidth * 0.866, y: position.y - halfHeight * 0.5 }    // Top-left
-        ];
-        
         this.gridGraphics.moveTo(vertices[0].x, vertices[0].y);
         for (let i = 1; i < vertices.length; i++) {
             this.gridGraphics.lineTo(vertices[i].x, vertices[i].y);
@@ -1788,6 +1852,7 @@ export class PhaserWorldScene extends Phaser.Scene implements LCMComponent {
 
         // Set world as source of truth
         this.setWorld(world);
+        setHexOrientation(world.getOrientation());
 
         // Wait for assets to be ready before placing tiles/units
         await this.waitForAssetsReady();
@@ -44,6 +44,20 @@ export class RulesTable {
         return this.unitDefinitions[unitId] || null;
     }
 
+    /**
+     * Get the footprints of multi-hex unit types, keyed by unit type
+     */
+    public getUnitFootprints(): { [unitType: number]: { dq: number, dr: number }[] } {
+        const footprints: { [unitType: number]: { dq: number, dr: number }[] } = {};
+        for (const [id, unitDef] of Object.entries(this.unitDefinitions)) {
+            const footprint = (unitDef as any).footprint as { dq?: number, dr?: number }[] | undefined;
+            if (footprint?.length) {
+                footprints[Number(id)] = footprint.map(offset => ({ dq: offset.dq || 0, dr: offset.dr || 0 }));
+            }
+        }
+        return footprints;
+    }
+
     /**
      * Get terrain definition by ID
      */
@@ -89,6 +89,7 @@ export interface WorldMetadata {
     width: number;
     height: number;
     defaultGameConfig?: any; // GameConfiguration proto object
+    orientation?: string; // Hex layout, "pointy" (default) or "flat"
 }
 
 /**
@@ -283,6 +284,14 @@ export class World {
         });
     }
 
+    public getOrientation(): string {
+        return this.metadata.orientation || '';
+    }
+
+    public setOrientation(orientation: string): void {
+        this.metadata.orientation = orientation;
+    }
+
     public getMetadata(): WorldMetadata {
         return { ...this.metadata };
     }
@@ -300,9 +309,27 @@ export class World {
     
     public setTileAt(q: number, r: number, tileType: number, player: number): void {
         const key = `${q},${r}`;
-        const tile = { q, r, tileType, player, number: 0, shortcut: "", lastActedTurn: 0, lastToppedupTurn: 0} as Tile;
+        // Repainting a victory point marker keeps it a marker
+        const victoryPoints = this.tiles[key]?.victoryPoints || 0;
+        const tile = { q, r, tileType, player, number: 0, shortcut: "", lastActedTurn: 0, lastToppedupTurn: 0, victoryPoints} as Tile;
+        this.tiles[key] = tile;
+        this.addTileChange(q, r, tile);
+    }
+
+    /**
+     * Make a tile a victory point marker worth points per turn held, or
+     * clear the marker with 0 points
+     */
+    public setTileVictoryPoints(q: number, r: number, points: number): boolean {
+        const key = `${q},${r}`;
+        const existing = this.tiles[key];
+        if (!existing) {
+            return false;
+        }
+        const tile = { ...existing, victoryPoints: points } as Tile;
         this.tiles[key] = tile;
         this.addTileChange(q, r, tile);
+        return true;
     }
 
     /**
@@ -835,6 +862,7 @@ export class World {
             Name: worldMetadata.name || 'Untitled World', // Both for compatibility
             id: worldMetadata.id,
             defaultGameConfig: worldMetadata.defaultGameConfig || worldMetadata.default_game_config,
+            orientation: worldMetadata.orientation,
 
             // Calculate dimensions from tiles if present
             width: 40,  // Default
@@ -882,6 +910,7 @@ export class World {
         if (data.width) this.metadata.width = data.width;
         if (data.height) this.metadata.height = data.height;
         if (data.defaultGameConfig) this.metadata.defaultGameConfig = data.defaultGameConfig;
+        if (data.orientation) this.metadata.orientation = data.orientation;
 
         // Load version for optimistic locking
         if (data.version !== undefined) this.version = data.version;
@@ -932,7 +961,8 @@ export class World {
                 continue; // Skip invalid tile
             }
 
-            const tile: Tile = { q, r, tileType, player, shortcut: (tileData as any).shortcut || "", lastActedTurn: 0, lastToppedupTurn: 0 };
+            const victoryPoints = (tileData as any).victoryPoints || (tileData as any).victory_points || 0;
+            const tile: Tile = { q, r, tileType, player, shortcut: (tileData as any).shortcut || "", lastActedTurn: 0, lastToppedupTurn: 0, victoryPoints };
             this.tiles[coordKey] = tile;
             tileChanges.push({ q, r, tile });
         }
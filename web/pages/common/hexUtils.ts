@@ -18,11 +18,53 @@ export const TILE_WIDTH = 64;
 export const TILE_HEIGHT = 64;
 export const Y_INCREMENT = 48;
 
+/**
+ * Hex layout, matching lib/orientation.go.  Pointy-top maps are rows of hexes
+ * with every other row shifted by half a tile; flat-top maps are columns of
+ * hexes with every other column shifted down by half a tile.
+ */
+export interface HexOrientation {
+    name: 'pointy' | 'flat';
+
+    // Corners of the hex as fractions of its tile box, clockwise from the
+    // top (pointy-top) or top-left (flat-top)
+    corners: [number, number][];
+}
+
+export const POINTY_TOP: HexOrientation = {
+    name: 'pointy',
+    corners: [[0.5, 0], [1, 0.25], [1, 0.75], [0.5, 1], [0, 0.75], [0, 0.25]],
+};
+
+export const FLAT_TOP: HexOrientation = {
+    name: 'flat',
+    corners: [[0.25, 0], [0.75, 0], [1, 0.5], [0.75, 1], [0.25, 1], [0, 0.5]],
+};
+
+let currentOrientation: HexOrientation = POINTY_TOP;
+
+/**
+ * Set the hex layout used by hexToPixel, pixelToHex and createHexagonPath.
+ * Empty or unknown names are pointy-top, like lib.GetOrientation.
+ */
+export function setHexOrientation(name: string | undefined): void {
+  currentOrientation = name === FLAT_TOP.name ? FLAT_TOP : POINTY_TOP;
+}
+
+export function getHexOrientation(): HexOrientation {
+  return currentOrientation;
+}
+
 /**
  * Convert hex coordinates to pixel coordinates
  * Matches lib/map.go CenterXYForTile
  */
 export function hexToPixel(q: number, r: number, tileWidth=TILE_WIDTH, tileHeight=TILE_HEIGHT, yIncrement=Y_INCREMENT): PixelCoord {
+  // Flat-top columns are yIncrement apart, each shifted down by half a tile
+  if (currentOrientation === FLAT_TOP) {
+    return { x: yIncrement * q, y: tileHeight * (q / 2 + r) };
+  }
+
   // Match the Go implementation from map.go CenterXYForTile
   const { row, col } = hexToRowCol(q, r);
 
@@ -40,6 +82,11 @@ export function hexToPixel(q: number, r: number, tileWidth=TILE_WIDTH, tileHeigh
  * Matches lib/map.go XYToQR
  */
 export function pixelToHex(x: number, y: number, tileWidth=TILE_WIDTH, tileHeight=TILE_HEIGHT, yIncrement=Y_INCREMENT): HexCoord {
+  if (currentOrientation === FLAT_TOP) {
+    const q = x / yIncrement;
+    return roundAxial(q, y / tileHeight - q / 2);
+  }
+
     // Match the Go implementation from map.go XYToQR
   const row = Math.floor((y + tileHeight / 2) / yIncrement);
   let halfDists = Math.floor(1 + Math.abs(x * 2 / tileWidth));
@@ -55,6 +102,34 @@ export function pixelToHex(x: number, y: number, tileWidth=TILE_WIDTH, tileHeigh
   return rowColToHex(row, col);
 }
 
+/**
+ * Round fractional axial coordinates to the nearest hex
+ * Matches lib/orientation.go roundAxial
+ */
+function roundAxial(q: number, r: number): HexCoord {
+  const s = -q - r;
+  let rq = Math.round(q), rr = Math.round(r);
+  const rs = Math.round(s);
+  const dq = Math.abs(rq - q), dr = Math.abs(rr - r), ds = Math.abs(rs - s);
+  if (dq > dr && dq > ds) {
+    rq = -rr - rs;
+  } else if (dr > ds) {
+    rr = -rq - rs;
+  }
+  return { q: rq, r: rr };
+}
+
+/**
+ * Corners of the hex centered on x, y in the current orientation
+ * Matches lib/orientation.go HexCorners
+ */
+export function createHexagonPath(x: number, y: number, tileWidth=TILE_WIDTH, tileHeight=TILE_HEIGHT): PixelCoord[] {
+  return currentOrientation.corners.map(([fx, fy]) => ({
+    x: x + (fx - 0.5) * tileWidth,
+    y: y + (fy - 0.5) * tileHeight,
+  }));
+}
+
 /**
  * Convert row/col coordinates to hex coordinates
  * RowColToHex: oddr_to_cube conversion
@@ -150,3 +225,32 @@ export function getNeighborCoord(q: number, r: number, direction: number): [numb
     const delta = AxialNeighborDeltas[direction];
     return [q + delta.q, r + delta.r];
 }
+
+/**
+ * Rotate a hex offset by steps of 60 degrees in direction index order
+ * (LEFT turns to TOP_LEFT), matching lib/footprint.go RotateHexOffset
+ */
+export function rotateHexOffset(dq: number, dr: number, steps: number): { dq: number; dr: number } {
+    for (let i = 0; i < ((steps % 6) + 6) % 6; i++) {
+        [dq, dr] = [-dr, dq + dr];
+    }
+    return { dq, dr };
+}
+
+/**
+ * Pixel center of a multi-hex unit: the average of the centers of its own
+ * hex and its footprint (offsets relative to it when facing LEFT), turned
+ * to face the given direction
+ */
+export function footprintCenter(q: number, r: number, footprint: { dq: number; dr: number }[], facing: number): PixelCoord {
+    const anchor = hexToPixel(q, r);
+    let x = anchor.x;
+    let y = anchor.y;
+    for (const offset of footprint) {
+        const rotated = rotateHexOffset(offset.dq, offset.dr, facing);
+        const center = hexToPixel(q + rotated.dq, r + rotated.dr);
+        x += center.x;
+        y += center.y;
+    }
+    return { x: x / (footprint.length + 1), y: y / (footprint.length + 1) };
+}
@@ -0,0 +1,16 @@
+/**
+ * Movement point formatting
+ * These match the Go implementation from lib/movement_points.go
+ */
+
+// Movement points are counted in whole quarters of a point
+export const MOVEMENT_QUARTERS_PER_POINT = 4;
+
+/**
+ * Formats movement points for display, rounded to a quarter and without
+ * trailing zeros: "2", "2.5", "1.25"
+ */
+export function formatMovementPoints(points: number): string {
+    const quarters = Math.round(points * MOVEMENT_QUARTERS_PER_POINT);
+    return String(quarters / MOVEMENT_QUARTERS_PER_POINT);
+}
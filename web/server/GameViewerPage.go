@@ -100,7 +100,7 @@ func (p *GameViewerPage) GetTerrainDataJSON() string {
 	}
 
 	terrainMap := make(map[string]json.RawMessage)
-	for id, terrain := range rulesEngine.Terrains {
+	for id, terrain := range rulesEngine.GetTerrains() {
 		terrainJSON, err := marshaler.Marshal(terrain)
 		if err != nil {
 			log.Printf("Error marshaling terrain %d: %v", id, err)
@@ -128,7 +128,7 @@ func (p *GameViewerPage) GetUnitDataJSON() string {
 	}
 
 	unitMap := make(map[string]json.RawMessage)
-	for id, unit := range rulesEngine.Units {
+	for id, unit := range rulesEngine.GetUnits() {
 		unitJSON, err := marshaler.Marshal(unit)
 		if err != nil {
 			log.Printf("Error marshaling unit %d: %v", id, err)
@@ -156,7 +156,7 @@ func (p *GameViewerPage) GetTerrainUnitPropertiesJSON() string {
 	}
 
 	terrainUnitMap := make(map[string]json.RawMessage)
-	for key, props := range rulesEngine.TerrainUnitProperties {
+	for key, props := range rulesEngine.GetTerrainUnitProperties() {
 		propsJSON, err := marshaler.Marshal(props)
 		if err != nil {
 			log.Printf("Error marshaling terrain-unit property %s: %v", key, err)
@@ -184,7 +184,7 @@ func (p *GameViewerPage) GetUnitUnitPropertiesJSON() string {
 	}
 
 	unitUnitMap := make(map[string]json.RawMessage)
-	for key, props := range rulesEngine.UnitUnitProperties {
+	for key, props := range rulesEngine.GetUnitUnitProperties() {
 		propsJSON, err := marshaler.Marshal(props)
 		if err != nil {
 			log.Printf("Error marshaling unit-unit property %s: %v", key, err)
@@ -28,6 +28,10 @@ func (g *GamesGroup) RegisterRoutes(app *goal.App[*LilBattleApp]) *http.ServeMux
 	mux.HandleFunc("/{gameId}/copy", gameCopyHandler)
 	// Screenshot handler delegates to LilBattleApp's ViewsRoot method
 	mux.HandleFunc("/{gameId}/screenshot/live", g.lilbattleApp.ViewsRoot.handleGameScreenshotLive)
+	// Static snapshot spectating for browsers without WASM
+	goal.Register[*SpectatorPage](app, mux, "/{gameId}/spectate")
+	mux.HandleFunc("/{gameId}/spectate/snapshot", g.lilbattleApp.ViewsRoot.handleSpectatorSnapshot)
+	mux.HandleFunc("/{gameId}/spectate/events", g.lilbattleApp.ViewsRoot.handleSpectatorEvents)
 	mux.HandleFunc("/{gameId}", gameActionsHandler(app))
 
 	return mux
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	goal "github.com/panyam/goapplib"
+	protos "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// MetaStatsPage shows the statistics the indexer aggregates from finished
+// games, per world
+type MetaStatsPage struct {
+	BasePage
+	Header Header
+
+	Worlds []MetaStatsWorld
+}
+
+// MetaStatsWorld is a world's statistics with its units and terrains named
+type MetaStatsWorld struct {
+	*protos.WorldMetaStats
+	Name     string
+	Units    []MetaStatsRow
+	Terrains []MetaStatsRow
+}
+
+// MetaStatsRow is a named row of a world's unit or terrain statistics
+type MetaStatsRow struct {
+	Name       string
+	Unit       *protos.UnitMetaStats
+	Tiles      *protos.TerrainMetaStats
+	WinPercent float64
+}
+
+func (p *MetaStatsPage) Load(r *http.Request, w http.ResponseWriter, app *goal.App[*LilBattleApp]) (err error, finished bool) {
+	p.DisableSplashScreen = true
+	p.Title = "Meta Stats"
+	p.Header.Load(r, w, app)
+
+	ctx := app.Context
+	userId := ctx.AuthMiddleware.GetLoggedInUserId(r)
+	resp, err := ctx.ClientMgr.GetIndexerSvcClient().GetMetaStats(GrpcAuthContext(userId), &protos.GetMetaStatsRequest{
+		WorldIds: r.URL.Query()["worldId"],
+	})
+	if err != nil {
+		log.Println("error getting meta stats: ", err)
+		return HandleGRPCError(err, w, r, app)
+	}
+
+	rulesEngine := lib.DefaultRulesEngine()
+	worlds := ctx.ClientMgr.GetWorldsSvcClient()
+	for _, stats := range resp.Worlds {
+		world := MetaStatsWorld{WorldMetaStats: stats, Name: stats.WorldId}
+		if worldResp, err := worlds.GetWorld(GrpcAuthContext(userId), &protos.GetWorldRequest{Id: stats.WorldId}); err == nil {
+			world.Name = worldResp.World.Name
+		}
+		for _, unit := range stats.Units {
+			row := MetaStatsRow{Name: fmt.Sprintf("Unit %d", unit.UnitType), Unit: unit, WinPercent: unit.WinRateWhenBuilt * 100}
+			if unitDef, err := rulesEngine.GetUnitData(unit.UnitType); err == nil {
+				row.Name = unitDef.Name
+			}
+			world.Units = append(world.Units, row)
+		}
+		for _, terrain := range stats.Terrains {
+			row := MetaStatsRow{Name: fmt.Sprintf("Terrain %d", terrain.TileType), Tiles: terrain}
+			if terrainDef, err := rulesEngine.GetTerrainData(terrain.TileType); err == nil {
+				row.Name = terrainDef.Name
+			}
+			world.Terrains = append(world.Terrains, row)
+		}
+		p.Worlds = append(p.Worlds, world)
+	}
+	return nil, false
+}
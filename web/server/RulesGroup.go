@@ -19,5 +19,8 @@ func (g *RulesGroup) RegisterRoutes(app *goal.App[*LilBattleApp]) *http.ServeMux
 	// Fix (repair) simulator page
 	goal.Register[*FixSimulatorPage](app, mux, "/fixsim")
 
+	// Statistics aggregated from finished games
+	goal.Register[*MetaStatsPage](app, mux, "/meta")
+
 	return mux
 }
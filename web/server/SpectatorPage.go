@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"time"
+
+	goal "github.com/panyam/goapplib"
+	protos "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"github.com/turnforge/lilbattle/services"
+)
+
+// spectatorPollInterval is how often the spectator page polls for a new
+// snapshot when server-sent events are unavailable
+const spectatorPollInterval = 10 * time.Second
+
+// SpectatorPage shows a game as a server-rendered snapshot for spectators
+// whose browsers cannot run the WASM game viewer
+type SpectatorPage struct {
+	BasePage
+	Header       Header
+	GameId       string
+	GameName     string
+	Theme        string
+	StateHash    string
+	Events       []string
+	PollInterval int64
+
+	// Eg "Delayed by 2 turns" when the game holds spectators back
+	DelayNote string
+}
+
+func (p *SpectatorPage) Load(r *http.Request, w http.ResponseWriter, app *goal.App[*LilBattleApp]) (err error, finished bool) {
+	p.GameId = r.PathValue("gameId")
+	if p.GameId == "" {
+		http.Error(w, "Game ID is required", http.StatusBadRequest)
+		return nil, true
+	}
+	p.Theme = services.SpectatorThemeName(r.URL.Query().Get("theme"))
+	p.PollInterval = spectatorPollInterval.Milliseconds()
+	p.Title = "Spectate"
+	p.Header.Load(r, w, app)
+
+	ctx := app.Context
+	loggedInUserId := ctx.AuthMiddleware.GetLoggedInUserId(r)
+	resp, delay, err := spectatedGame(ctx.ClientMgr.GetGamesSvcClient(), loggedInUserId, p.GameId)
+	if err != nil {
+		log.Printf("Error fetching Game %s: %v", p.GameId, err)
+		return HandleGRPCError(err, w, r, app)
+	}
+	p.DelayNote = services.SpectatorDelayNote(delay)
+
+	if resp.Game != nil {
+		p.GameName = resp.Game.Name
+		p.Title = "Spectating " + resp.Game.Name
+	}
+	if resp.State != nil && resp.State.WorldData != nil {
+		if p.StateHash, err = services.SpectatorStateHash(resp.State.WorldData); err != nil {
+			return err, false
+		}
+	}
+	p.Events = services.SpectatorEventLog(resp.History)
+	return nil, false
+}
+
+// spectatedGame fetches a game as the user may watch it.  Unless they play
+// in it, the games service holds a game with a spectator delay back to the
+// start of the turn its first held back moves were played in.  Returns the
+// delay the game was held back by, nil if it is shown live.
+func spectatedGame(games v1s.GamesServiceClient, userId string, gameId string) (*protos.GetGameResponse, *protos.SpectatorDelay, error) {
+	resp, err := games.GetGame(GrpcAuthContext(userId), &protos.GetGameRequest{Id: gameId})
+	if err != nil {
+		return nil, nil, err
+	}
+	delay := services.GameSpectatorDelay(resp.Game)
+	if delay == nil || (userId != "" && slices.ContainsFunc(resp.Game.Config.GetPlayers(), func(p *protos.GamePlayer) bool {
+		return p.UserId == userId
+	})) {
+		return resp, nil, nil
+	}
+	return resp, delay, nil
+}
+
+// spectatorWorld fetches the game's world as a spectator may see it
+func (r *RootViewsHandler) spectatorWorld(req *http.Request, gameId string) (*protos.WorldData, error) {
+	loggedInUserId := r.LilBattleApp.AuthMiddleware.GetLoggedInUserId(req)
+	resp, _, err := spectatedGame(r.LilBattleApp.ClientMgr.GetGamesSvcClient(), loggedInUserId, gameId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.State == nil || resp.State.WorldData == nil {
+		return nil, fmt.Errorf("game %s has no state data", gameId)
+	}
+	return resp.State.WorldData, nil
+}
+
+// handleSpectatorSnapshot serves the cached spectator snapshot of a game
+// GET /games/{gameId}/spectate/snapshot?theme=fantasy
+func (r *RootViewsHandler) handleSpectatorSnapshot(w http.ResponseWriter, req *http.Request) {
+	gameId := req.PathValue("gameId")
+	worldData, err := r.spectatorWorld(req, gameId)
+	if err != nil {
+		log.Printf("Failed to get game %s: %v", gameId, err)
+		http.Error(w, "Game not found", http.StatusNotFound)
+		return
+	}
+
+	snapshot, err := r.LilBattleApp.Snapshots.Get(services.SpectatorThemeName(req.URL.Query().Get("theme")), worldData)
+	if err != nil {
+		log.Printf("Failed to render snapshot for game %s: %v", gameId, err)
+		http.Error(w, "Failed to render snapshot", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", snapshot.ContentType)
+	w.Header().Set("ETag", `"`+snapshot.Hash+`"`)
+	w.Header().Set("Cache-Control", "no-cache")
+	if req.Header.Get("If-None-Match") == `"`+snapshot.Hash+`"` {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(snapshot.Data)
+}
+
+// handleSpectatorEvents streams a state-hash event whenever what spectators
+// can see of the game changes
+// GET /games/{gameId}/spectate/events
+func (r *RootViewsHandler) handleSpectatorEvents(w http.ResponseWriter, req *http.Request) {
+	gameId := req.PathValue("gameId")
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+	stream, err := r.LilBattleApp.ClientMgr.GetGameSyncSvcClient().Subscribe(ctx, &protos.SubscribeRequest{GameId: gameId})
+	if err != nil {
+		log.Printf("Failed to subscribe to game %s: %v", gameId, err)
+		http.Error(w, "Failed to subscribe", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	lastHash := req.URL.Query().Get("hash")
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return
+		}
+		if !services.IsSpectatorStateChange(update) {
+			continue
+		}
+		worldData, err := r.spectatorWorld(req, gameId)
+		if err != nil {
+			log.Printf("Failed to get game %s: %v", gameId, err)
+			return
+		}
+		hash, err := services.SpectatorStateHash(worldData)
+		if err != nil || hash == lastHash {
+			continue
+		}
+		lastHash = hash
+		fmt.Fprintf(w, "event: state-hash\ndata: %s\n\n", hash)
+		flusher.Flush()
+	}
+}
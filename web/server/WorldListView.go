@@ -6,6 +6,7 @@ import (
 
 	goal "github.com/panyam/goapplib"
 	protos "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 )
 
 type WorldListView struct {
@@ -49,6 +50,14 @@ func (p *WorldListView) Load(r *http.Request, w http.ResponseWriter, app *goal.A
 		return HandleGRPCError(err, w, r, app)
 	}
 	p.Worlds = resp.Items
+
+	// Hide difficulty ratings computed against older rules or AI
+	rulesEngine := lib.DefaultRulesEngine()
+	for _, world := range p.Worlds {
+		if !lib.IsWorldRatingEngineCurrent(world.Rating, rulesEngine) {
+			world.Rating = nil
+		}
+	}
 	p.HasPrevPage = p.CurrentPage > 0
 	if resp.Pagination != nil {
 		p.HasNextPage = resp.Pagination.HasMore
@@ -1,11 +1,14 @@
 package server
 
 import (
+	"fmt"
 	"log"
 	"net/http"
+	"sort"
 
 	goal "github.com/panyam/goapplib"
 	protos "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 )
 
 type WorldViewerPage struct {
@@ -14,6 +17,15 @@ type WorldViewerPage struct {
 	World     *protos.World
 	WorldData *protos.WorldData
 	WorldId   string
+
+	// RulesOverrides lists the world's rule changes as label/value rows
+	RulesOverrides []RulesOverrideRow
+}
+
+// RulesOverrideRow is one rule a world changes
+type RulesOverrideRow struct {
+	Label string
+	Value string
 }
 
 func (p *WorldViewerPage) Load(r *http.Request, w http.ResponseWriter, app *goal.App[*LilBattleApp]) (err error, finished bool) {
@@ -48,7 +60,49 @@ func (p *WorldViewerPage) Load(r *http.Request, w http.ResponseWriter, app *goal
 		} else {
 			p.MetaDescription = "View " + p.World.Name + " - a custom world for LilBattle."
 		}
+		p.RulesOverrides = rulesOverrideRows(p.World.RulesOverrides)
 	}
 
 	return nil, false
 }
+
+// rulesOverrideRows describes overrides for display, terrains by name first
+// and then the income values and unit cap that are set
+func rulesOverrideRows(o *protos.RulesOverrides) (rows []RulesOverrideRow) {
+	rulesEngine := lib.DefaultRulesEngine()
+	var terrainIDs []int32
+	for terrainID := range o.GetTerrainMovementCosts() {
+		terrainIDs = append(terrainIDs, terrainID)
+	}
+	sort.Slice(terrainIDs, func(i, j int) bool { return terrainIDs[i] < terrainIDs[j] })
+	for _, terrainID := range terrainIDs {
+		name := fmt.Sprintf("Terrain %d", terrainID)
+		if terrain, err := rulesEngine.GetTerrainData(terrainID); err == nil {
+			name = terrain.Name
+		}
+		rows = append(rows, RulesOverrideRow{
+			Label: name + " movement cost",
+			Value: fmt.Sprintf("%g", o.TerrainMovementCosts[terrainID]),
+		})
+	}
+
+	income := o.GetIncome()
+	for _, field := range []struct {
+		label string
+		value int32
+	}{
+		{"Starting coins", income.GetStartingCoins()},
+		{"Game income", income.GetGameIncome()},
+		{"Land base income", income.GetLandbaseIncome()},
+		{"Naval base income", income.GetNavalbaseIncome()},
+		{"Airport income", income.GetAirportbaseIncome()},
+		{"Missile silo income", income.GetMissilesiloIncome()},
+		{"Mines income", income.GetMinesIncome()},
+		{"Max units per player", o.GetMaxUnitsPerPlayer()},
+	} {
+		if field.value != 0 {
+			rows = append(rows, RulesOverrideRow{Label: field.label, Value: fmt.Sprintf("%d", field.value)})
+		}
+	}
+	return
+}
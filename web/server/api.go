@@ -64,9 +64,10 @@ func (a *ApiHandler) Init() error {
 				fromSeq, _ = strconv.ParseInt(fs, 10, 64)
 			}
 			return &models.SubscribeRequest{
-				GameId:       gameId,
-				PlayerId:     playerId,
-				FromSequence: fromSeq,
+				GameId:            gameId,
+				PlayerId:          playerId,
+				FromSequence:      fromSeq,
+				AcceptedEncodings: r.URL.Query()["accepted_encodings"],
 			}, nil
 		},
 	)
@@ -105,17 +106,18 @@ func (out *ApiHandler) setupConnectHandlers() error {
 		log.Printf("Registered Worlds Connect handler at: %s", worldsConnectPath)
 	}
 
+	puzzlesAdapter := NewConnectPuzzlesServiceAdapter(out.ClientMgr.GetPuzzlesSvcClient())
+	puzzlesConnectPath, puzzlesConnectHandler := v1connect.NewPuzzlesServiceHandler(puzzlesAdapter)
+	out.mux.Handle(puzzlesConnectPath, wrapWithAuth(puzzlesConnectHandler))
+	log.Printf("Registered Puzzles Connect handler at: %s", puzzlesConnectPath)
+
 	// if we are colocating indexer in our current bundle
 	if !out.DisableIndexer {
-		/* - TODO we are creating a new service via NewIndexService - instead this pattern should be using the client.
-		* Needs to be investigated
-		log.Println("Adding Indexer Connect handler...")
 		indexerSvcClient := out.ClientMgr.GetIndexerSvcClient()
 		indexerAdapter := NewConnectIndexerServiceAdapter(indexerSvcClient)
 		indexerConnectPath, indexerConnectHandler := v1connect.NewIndexerServiceHandler(indexerAdapter)
 		out.mux.Handle(indexerConnectPath, wrapWithAuth(indexerConnectHandler))
 		log.Printf("Registered Indexer Connect handler at: %s", indexerConnectPath)
-		*/
 	}
 
 	// Register GameSyncService for multiplayer real-time updates
@@ -215,6 +217,12 @@ func (web *ApiHandler) createSvcMux(grpc_addr string) (*runtime.ServeMux, error)
 		}
 	}
 
+	err = v1s.RegisterPuzzlesServiceHandlerFromEndpoint(ctx, svcMux, grpc_addr, opts)
+	if err != nil {
+		log.Fatal("Unable to register puzzles service: ", err)
+		return nil, err
+	}
+
 	if web.DisableIndexer {
 		err = v1s.RegisterIndexerServiceHandlerFromEndpoint(ctx, svcMux, grpc_addr, opts)
 		if err != nil {
@@ -12,6 +12,7 @@ import (
 	oagrpc "github.com/panyam/oneauth/grpc"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	v1connect "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services/lilbattlev1connect"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -35,6 +36,7 @@ func injectAuthMetadata(ctx context.Context) context.Context {
 
 // ConnectIndexerServiceAdapter adapts the gRPC IndexerService to Connect's interface
 type ConnectIndexerServiceAdapter struct {
+	v1connect.UnimplementedIndexerServiceHandler
 	client v1s.IndexerServiceClient
 }
 
@@ -51,6 +53,15 @@ func (a *ConnectIndexerServiceAdapter) EnsureIndexState(ctx context.Context, req
 	return connect.NewResponse(resp), nil
 }
 
+func (a *ConnectIndexerServiceAdapter) GetMetaStats(ctx context.Context, req *connect.Request[v1.GetMetaStatsRequest]) (*connect.Response[v1.GetMetaStatsResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.GetMetaStats(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // ConnectGamesServiceAdapter adapts the gRPC GamesService to Connect's interface
 type ConnectGamesServiceAdapter struct {
 	client v1s.GamesServiceClient
@@ -177,6 +188,87 @@ func (a *ConnectGamesServiceAdapter) JoinGame(ctx context.Context, req *connect.
 	return connect.NewResponse(resp), nil
 }
 
+func (a *ConnectGamesServiceAdapter) SetClockPaused(ctx context.Context, req *connect.Request[v1.SetClockPausedRequest]) (*connect.Response[v1.SetClockPausedResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.SetClockPaused(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) DelegateTurn(ctx context.Context, req *connect.Request[v1.DelegateTurnRequest]) (*connect.Response[v1.DelegateTurnResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.DelegateTurn(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) ClaimNoContactDraw(ctx context.Context, req *connect.Request[v1.ClaimNoContactDrawRequest]) (*connect.Response[v1.ClaimNoContactDrawResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ClaimNoContactDraw(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) DraftUnit(ctx context.Context, req *connect.Request[v1.DraftUnitRequest]) (*connect.Response[v1.DraftUnitResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.DraftUnit(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) GetStateDiff(ctx context.Context, req *connect.Request[v1.GetStateDiffRequest]) (*connect.Response[v1.GetStateDiffResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.GetStateDiff(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) ForkGame(ctx context.Context, req *connect.Request[v1.ForkGameRequest]) (*connect.Response[v1.ForkGameResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ForkGame(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) GetStateAtTurn(ctx context.Context, req *connect.Request[v1.GetStateAtTurnRequest]) (*connect.Response[v1.GetStateAtTurnResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.GetStateAtTurn(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) GetNotificationPreferences(ctx context.Context, req *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.GetNotificationPreferences(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) SetNotificationPreferences(ctx context.Context, req *connect.Request[v1.SetNotificationPreferencesRequest]) (*connect.Response[v1.SetNotificationPreferencesResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.SetNotificationPreferences(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 /** If you had a streamer than you can use this to act as a bridge between websocket and grpc streams
 func (a *ConnectGameServiceAdapter) StreamSomeThing(ctx context.Context, req *connect.Request[v1.StreamSomeThingRequest], stream *connect.ServerStream[v1.StreamSomeThingResponse]) error {
 	// Create a custom stream implementation that bridges to Connect
@@ -253,6 +345,105 @@ func (a *ConnectWorldsServiceAdapter) UpdateWorld(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
+func (a *ConnectWorldsServiceAdapter) SetWorldRulesOverrides(ctx context.Context, req *connect.Request[v1.SetWorldRulesOverridesRequest]) (*connect.Response[v1.SetWorldRulesOverridesResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.SetWorldRulesOverrides(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectWorldsServiceAdapter) ApplyWorldEdits(ctx context.Context, req *connect.Request[v1.ApplyWorldEditsRequest]) (*connect.Response[v1.ApplyWorldEditsResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ApplyWorldEdits(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectWorldsServiceAdapter) GetWorldTiles(ctx context.Context, req *connect.Request[v1.GetWorldTilesRequest]) (*connect.Response[v1.GetWorldTilesResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.GetWorldTiles(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ConnectPuzzlesServiceAdapter adapts the gRPC PuzzlesService to Connect's interface
+type ConnectPuzzlesServiceAdapter struct {
+	client v1s.PuzzlesServiceClient
+}
+
+func NewConnectPuzzlesServiceAdapter(client v1s.PuzzlesServiceClient) *ConnectPuzzlesServiceAdapter {
+	return &ConnectPuzzlesServiceAdapter{client: client}
+}
+
+func (a *ConnectPuzzlesServiceAdapter) CreatePuzzle(ctx context.Context, req *connect.Request[v1.CreatePuzzleRequest]) (*connect.Response[v1.CreatePuzzleResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.CreatePuzzle(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectPuzzlesServiceAdapter) ListPuzzles(ctx context.Context, req *connect.Request[v1.ListPuzzlesRequest]) (*connect.Response[v1.ListPuzzlesResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ListPuzzles(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectPuzzlesServiceAdapter) GetPuzzle(ctx context.Context, req *connect.Request[v1.GetPuzzleRequest]) (*connect.Response[v1.GetPuzzleResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.GetPuzzle(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectPuzzlesServiceAdapter) UpdatePuzzle(ctx context.Context, req *connect.Request[v1.UpdatePuzzleRequest]) (*connect.Response[v1.UpdatePuzzleResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.UpdatePuzzle(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectPuzzlesServiceAdapter) DeletePuzzle(ctx context.Context, req *connect.Request[v1.DeletePuzzleRequest]) (*connect.Response[v1.DeletePuzzleResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.DeletePuzzle(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectPuzzlesServiceAdapter) PlayPuzzle(ctx context.Context, req *connect.Request[v1.PlayPuzzleRequest]) (*connect.Response[v1.PlayPuzzleResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.PlayPuzzle(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectPuzzlesServiceAdapter) ListPuzzleAttempts(ctx context.Context, req *connect.Request[v1.ListPuzzleAttemptsRequest]) (*connect.Response[v1.ListPuzzleAttemptsResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ListPuzzleAttempts(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // ConnectGameSyncServiceAdapter adapts the gRPC GameSyncService to Connect's interface
 // This enables multiplayer sync via HTTP/Connect for frontend clients
 type ConnectGameSyncServiceAdapter struct {
@@ -292,3 +483,12 @@ func (a *ConnectGameSyncServiceAdapter) Broadcast(ctx context.Context, req *conn
 	}
 	return connect.NewResponse(resp), nil
 }
+
+func (a *ConnectGameSyncServiceAdapter) SendPing(ctx context.Context, req *connect.Request[v1.SendPingRequest]) (*connect.Response[v1.SendPingResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.SendPing(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	goal "github.com/panyam/goapplib"
+	"github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/services/fsbe"
 )
 
@@ -55,6 +56,7 @@ func NewRootViewsHandler(lilbattleApp *LilBattleApp, goalApp *goal.App[*LilBattl
 			}
 			return false
 		},
+		"RandomMapLabel": lib.RandomMapLabel,
 	})
 
 	out.setupRoutes()
@@ -48,6 +48,9 @@ type LilBattleApp struct {
 	// Views (thin wrapper for page routing)
 	ViewsRoot *RootViewsHandler
 
+	// Rendered snapshots shared by every static spectator
+	Snapshots *services.SnapshotCache
+
 	// App config
 	HideGames  bool
 	HideWorlds bool
@@ -78,6 +81,7 @@ func NewLilBattleApp(clientMgr *services.ClientMgr) (lilbattleApp *LilBattleApp,
 		UsernameStore:  usernameStore,
 		Session:        session,
 		ClientMgr:      clientMgr,
+		Snapshots:      services.NewSnapshotCache(services.DefaultSnapshotCacheSize),
 		HideGames:      os.Getenv("LILBATTLE_HIDE_GAMES") == "true",
 		HideWorlds:     os.Getenv("LILBATTLE_HIDE_WORLDS") == "true",
 		// Ads default to enabled, can be disabled per-placement
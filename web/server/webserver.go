@@ -2,21 +2,50 @@ package server
 
 import (
 	"context"
+	"net/http"
 
 	goal "github.com/panyam/goapplib"
 	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/observability"
 )
 
 type WebAppServer struct {
 	goal.WebAppServer
+
+	// Serves /notifications/ (eg unsubscribe links), when set
+	Notifications http.Handler
 }
 
 func (s *WebAppServer) Start(ctx context.Context, srvErr chan error, stopChan chan bool) error {
 	cm := services.NewClientMgr(s.GrpcAddress)
 	lilbattleApp, _, _ := NewLilBattleApp(cm)
-	return s.StartWithHandler(ctx, lilbattleApp.Handler(), srvErr, stopChan)
+	if s.Notifications == nil {
+		return s.StartWithHandler(ctx, lilbattleApp.Handler(), srvErr, stopChan)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/notifications/", s.Notifications)
+	mux.Handle("/", lilbattleApp.Handler())
+	return s.StartWithHandler(ctx, mux, srvErr, stopChan)
 }
 
 type IndexerAppServer struct {
 	goal.WebAppServer
 }
+
+func (s *IndexerAppServer) Start(ctx context.Context, srvErr chan error, stopChan chan bool) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", observability.Handler())
+	return s.StartWithHandler(ctx, mux, srvErr, stopChan)
+}
+
+// MetricsServer serves /metrics on an internal address of its own, so the
+// metrics are never exposed on the public gateway
+type MetricsServer struct {
+	goal.WebAppServer
+}
+
+func (s *MetricsServer) Start(ctx context.Context, srvErr chan error, stopChan chan bool) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", observability.Handler())
+	return s.StartWithHandler(ctx, mux, srvErr, stopChan)
+}
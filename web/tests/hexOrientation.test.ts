@@ -0,0 +1,35 @@
+/**
+ * Hex Orientation Tests
+ * Checks hexUtils lays out and outlines hexes like lib/orientation.go
+ */
+
+import { createHexagonPath, hexToPixel, pixelToHex, setHexOrientation } from '../pages/common/hexUtils';
+
+const points = (q: number, r: number) => {
+  const center = hexToPixel(q, r);
+  return createHexagonPath(center.x, center.y).map(p => [p.x, p.y]);
+};
+
+describe('hex orientation', () => {
+  afterEach(() => setHexOrientation('pointy'));
+
+  test('pointy-top hexes have a point at the top and sit in rows', () => {
+    setHexOrientation('');
+    expect(points(1, 0)).toEqual([[64, -32], [96, -16], [96, 16], [64, 32], [32, 16], [32, -16]]);
+    expect(hexToPixel(0, 1)).toEqual({ x: 32, y: 48 });
+  });
+
+  test('flat-top hexes have a flat top and sit in columns', () => {
+    setHexOrientation('flat');
+    expect(points(1, 0)).toEqual([[32, 0], [64, 0], [80, 32], [64, 64], [32, 64], [16, 32]]);
+    expect(hexToPixel(0, 1)).toEqual({ x: 0, y: 64 });
+  });
+
+  test.each(['pointy', 'flat'])('%s-top pixelToHex inverts hexToPixel', (name) => {
+    setHexOrientation(name);
+    for (const [q, r] of [[0, 0], [1, 0], [0, 1], [-2, 3], [3, -1]]) {
+      const center = hexToPixel(q, r);
+      expect(pixelToHex(center.x + 5, center.y - 5)).toEqual({ q, r });
+    }
+  });
+});
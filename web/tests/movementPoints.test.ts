@@ -0,0 +1,19 @@
+/**
+ * Movement Points Tests
+ * Checks movement points are shown like lib.FormatMovementPoints shows them
+ */
+
+import { formatMovementPoints } from '../pages/common/movementPoints';
+
+describe('formatMovementPoints', () => {
+  test.each([
+    [3, '3'],
+    [2.5, '2.5'],
+    [1.25, '1.25'],
+    [0.7499999999999999, '0.75'],
+    [1.9000000000000001, '2'],
+    [0, '0'],
+  ])('%p is shown as %s', (points, want) => {
+    expect(formatMovementPoints(points)).toBe(want);
+  });
+});
@@ -0,0 +1,91 @@
+/**
+ * Headless PNG Rendering Tests
+ * Renders the loaded game through lilbattle.renderToPNG without a canvas
+ */
+
+import * as fs from 'fs';
+import * as path from 'path';
+
+const STATIC_BASE_PATH = path.join(__dirname, '../static');
+const WEB_PATH = path.join(__dirname, '..');
+const PNG_SIGNATURE = [0x89, 0x50, 0x4e, 0x47, 0x0d, 0x0a, 0x1a, 0x0a];
+
+// Assets are read from disk, there is no server to fetch them from
+const loadAsset = (webPath: string) => fs.promises.readFile(path.join(WEB_PATH, webPath));
+
+async function loadWASM(): Promise<any> {
+  if ((global as any).lilbattle?.renderToPNG) {
+    return (global as any).lilbattle;
+  }
+
+  eval(fs.readFileSync(path.join(STATIC_BASE_PATH, 'wasm/wasm_exec.js'), 'utf8'));
+  const wasmBuffer = fs.readFileSync(path.join(STATIC_BASE_PATH, 'wasm/lilbattle-cli.wasm'));
+  const go = new (global as any).Go();
+  const wasmModule = await WebAssembly.instantiate(wasmBuffer, go.importObject);
+  go.run(wasmModule.instance);
+
+  // Wait for the exports to be registered
+  await new Promise(resolve => setTimeout(resolve, 1000));
+  return (global as any).lilbattle;
+}
+
+function loadTestGame(lilbattle: any) {
+  const tilesMap: { [key: string]: any } = {};
+  for (let q = 0; q < 4; q++) {
+    for (let r = 0; r < 3; r++) {
+      tilesMap[`${q},${r}`] = { q, r, tileType: 5 };
+    }
+  }
+  const game = { id: 'render-test', config: { players: [{ playerId: 1 }, { playerId: 2 }] } };
+  const state = {
+    currentPlayer: 1,
+    turnCounter: 1,
+    worldData: {
+      tilesMap,
+      unitsMap: {
+        '0,0': { q: 0, r: 0, player: 1, unitType: 1, availableHealth: 10, distanceLeft: 3 },
+        '3,2': { q: 3, r: 2, player: 2, unitType: 1, availableHealth: 10, distanceLeft: 3 },
+      },
+    },
+  };
+  const history = { gameId: 'render-test', groups: [] };
+
+  const encode = (value: any) => new TextEncoder().encode(JSON.stringify(value));
+  const result = lilbattle.loadGameData(encode(game), encode(state), encode(history));
+  expect(result.success).toBe(true);
+}
+
+describe('renderToPNG', () => {
+  let lilbattle: any;
+
+  beforeAll(async () => {
+    lilbattle = await loadWASM();
+    loadTestGame(lilbattle);
+  });
+
+  test('should render the game to a PNG', async () => {
+    const png: Uint8Array = await lilbattle.renderToPNG(320, 240, { loadAsset, grid: true, coords: true });
+
+    expect(png.constructor.name).toBe('Uint8Array');
+    expect(png.length).toBeGreaterThan(PNG_SIGNATURE.length);
+    expect(Array.from(png.slice(0, 8))).toEqual(PNG_SIGNATURE);
+  });
+
+  test('should render different bytes after a move', async () => {
+    const before: Uint8Array = await lilbattle.renderToPNG(320, 240, { loadAsset });
+
+    const response = lilbattle.gamesService.processMoves(JSON.stringify({
+      gameId: 'render-test',
+      moves: [{ player: 1, moveUnit: { from: { q: 0, r: 0 }, to: { q: 1, r: 0 } } }],
+    }));
+    expect(response.success).toBe(true);
+
+    const after: Uint8Array = await lilbattle.renderToPNG(320, 240, { loadAsset });
+    expect(Array.from(after.slice(0, 8))).toEqual(PNG_SIGNATURE);
+    expect(Buffer.from(after).equals(Buffer.from(before))).toBe(false);
+  });
+
+  test('should reject themes without PNG assets', async () => {
+    await expect(lilbattle.renderToPNG(320, 240, { theme: 'fantasy', loadAsset })).rejects.toThrow(/can't be rendered to PNG/);
+  });
+});